@@ -33,7 +33,7 @@ type StructReq struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	StructStr string `protobuf:"bytes,1,opt,name=structStr,proto3" form:"structStr, required" json:"structStr, required"`
+	StructStr string `protobuf:"bytes,1,opt,name=structStr,proto3" form:"structStr,required" json:"structStr,required"`
 }
 
 func (x *StructReq) Reset() {
@@ -804,6 +804,9 @@ type RegisterReq struct {
 	CaptchaID string `protobuf:"bytes,3,opt,name=captchaID,proto3" json:"captchaID" form:"captchaID" query:"captchaID"`
 	Captcha   string `protobuf:"bytes,4,opt,name=captcha,proto3" json:"captcha" form:"captcha" query:"captcha"`
 	Email     string `protobuf:"bytes,5,opt,name=email,proto3" json:"email" form:"email" query:"email"`
+	Mobile    string `protobuf:"bytes,6,opt,name=mobile,proto3" json:"mobile" form:"mobile" query:"mobile"`
+	// the code mailed by SendRegisterCode when the registration is verified by email | 邮箱验证时, SendRegisterCode发送的验证码
+	EmailCode string `protobuf:"bytes,7,opt,name=emailCode,proto3" json:"emailCode" form:"emailCode" query:"emailCode"`
 }
 
 func (x *RegisterReq) Reset() {
@@ -873,19 +876,33 @@ func (x *RegisterReq) GetEmail() string {
 	return ""
 }
 
-// change user's password request | 修改密码请求参数
-type ChangePasswordReq struct {
+func (x *RegisterReq) GetMobile() string {
+	if x != nil {
+		return x.Mobile
+	}
+	return ""
+}
+
+func (x *RegisterReq) GetEmailCode() string {
+	if x != nil {
+		return x.EmailCode
+	}
+	return ""
+}
+
+// register email verification code request | 注册邮箱验证码请求参数
+type RegisterCodeReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserID      uint64 `protobuf:"varint,1,opt,name=userID,proto3" json:"userID" form:"userID" query:"userID"`
-	OldPassword string `protobuf:"bytes,2,opt,name=oldPassword,proto3" json:"oldPassword" form:"oldPassword" query:"oldPassword"`
-	NewPassword string `protobuf:"bytes,3,opt,name=newPassword,proto3" json:"newPassword" form:"newPassword" query:"newPassword"`
+	Email     string `protobuf:"bytes,1,opt,name=email,proto3" json:"email" form:"email" query:"email"`
+	CaptchaID string `protobuf:"bytes,2,opt,name=captchaID,proto3" json:"captchaID" form:"captchaID" query:"captchaID"`
+	Captcha   string `protobuf:"bytes,3,opt,name=captcha,proto3" json:"captcha" form:"captcha" query:"captcha"`
 }
 
-func (x *ChangePasswordReq) Reset() {
-	*x = ChangePasswordReq{}
+func (x *RegisterCodeReq) Reset() {
+	*x = RegisterCodeReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -893,13 +910,13 @@ func (x *ChangePasswordReq) Reset() {
 	}
 }
 
-func (x *ChangePasswordReq) String() string {
+func (x *RegisterCodeReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChangePasswordReq) ProtoMessage() {}
+func (*RegisterCodeReq) ProtoMessage() {}
 
-func (x *ChangePasswordReq) ProtoReflect() protoreflect.Message {
+func (x *RegisterCodeReq) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -911,51 +928,44 @@ func (x *ChangePasswordReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChangePasswordReq.ProtoReflect.Descriptor instead.
-func (*ChangePasswordReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use RegisterCodeReq.ProtoReflect.Descriptor instead.
+func (*RegisterCodeReq) Descriptor() ([]byte, []int) {
 	return file_admin_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *ChangePasswordReq) GetUserID() uint64 {
+func (x *RegisterCodeReq) GetEmail() string {
 	if x != nil {
-		return x.UserID
+		return x.Email
 	}
-	return 0
+	return ""
 }
 
-func (x *ChangePasswordReq) GetOldPassword() string {
+func (x *RegisterCodeReq) GetCaptchaID() string {
 	if x != nil {
-		return x.OldPassword
+		return x.CaptchaID
 	}
 	return ""
 }
 
-func (x *ChangePasswordReq) GetNewPassword() string {
+func (x *RegisterCodeReq) GetCaptcha() string {
 	if x != nil {
-		return x.NewPassword
+		return x.Captcha
 	}
 	return ""
 }
 
-// Create or update user information request | 创建或更新用户信息
-type CreateOrUpdateUserReq struct {
+// approve a self-registered user, rejected users are deleted | 审批自助注册的用户, 拒绝则删除该用户
+type ApproveUserReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID       uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID" form:"ID" query:"ID"`
-	Avatar   string `protobuf:"bytes,2,opt,name=avatar,proto3" json:"avatar" form:"avatar" query:"avatar"`
-	RoleID   uint64 `protobuf:"varint,3,opt,name=roleID,proto3" json:"roleID" form:"roleID" query:"roleID"`
-	Mobile   string `protobuf:"bytes,4,opt,name=mobile,proto3" json:"mobile" form:"mobile" query:"mobile"`
-	Email    string `protobuf:"bytes,5,opt,name=email,proto3" json:"email" form:"email" query:"email"`
-	Status   uint64 `protobuf:"varint,6,opt,name=status,proto3" json:"status" form:"status" query:"status"`
-	Username string `protobuf:"bytes,7,opt,name=username,proto3" json:"username" form:"username" query:"username"`
-	Nickname string `protobuf:"bytes,8,opt,name=nickname,proto3" json:"nickname" form:"nickname" query:"nickname"`
-	Password string `protobuf:"bytes,9,opt,name=password,proto3" json:"password" form:"password" query:"password"`
+	ID      uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID" form:"ID" query:"ID"`
+	Approve bool   `protobuf:"varint,2,opt,name=approve,proto3" json:"approve" form:"approve" query:"approve"`
 }
 
-func (x *CreateOrUpdateUserReq) Reset() {
-	*x = CreateOrUpdateUserReq{}
+func (x *ApproveUserReq) Reset() {
+	*x = ApproveUserReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -963,13 +973,13 @@ func (x *CreateOrUpdateUserReq) Reset() {
 	}
 }
 
-func (x *CreateOrUpdateUserReq) String() string {
+func (x *ApproveUserReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateOrUpdateUserReq) ProtoMessage() {}
+func (*ApproveUserReq) ProtoMessage() {}
 
-func (x *CreateOrUpdateUserReq) ProtoReflect() protoreflect.Message {
+func (x *ApproveUserReq) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -981,115 +991,117 @@ func (x *CreateOrUpdateUserReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateOrUpdateUserReq.ProtoReflect.Descriptor instead.
-func (*CreateOrUpdateUserReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use ApproveUserReq.ProtoReflect.Descriptor instead.
+func (*ApproveUserReq) Descriptor() ([]byte, []int) {
 	return file_admin_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *CreateOrUpdateUserReq) GetID() uint64 {
+func (x *ApproveUserReq) GetID() uint64 {
 	if x != nil {
 		return x.ID
 	}
 	return 0
 }
 
-func (x *CreateOrUpdateUserReq) GetAvatar() string {
+func (x *ApproveUserReq) GetApprove() bool {
 	if x != nil {
-		return x.Avatar
+		return x.Approve
 	}
-	return ""
+	return false
 }
 
-func (x *CreateOrUpdateUserReq) GetRoleID() uint64 {
-	if x != nil {
-		return x.RoleID
-	}
-	return 0
+// change user's password request | 修改密码请求参数
+type ChangePasswordReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID      uint64 `protobuf:"varint,1,opt,name=userID,proto3" json:"userID" form:"userID" query:"userID"`
+	OldPassword string `protobuf:"bytes,2,opt,name=oldPassword,proto3" json:"oldPassword" form:"oldPassword" query:"oldPassword"`
+	NewPassword string `protobuf:"bytes,3,opt,name=newPassword,proto3" json:"newPassword" form:"newPassword" query:"newPassword"`
 }
 
-func (x *CreateOrUpdateUserReq) GetMobile() string {
-	if x != nil {
-		return x.Mobile
+func (x *ChangePasswordReq) Reset() {
+	*x = ChangePasswordReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *CreateOrUpdateUserReq) GetEmail() string {
-	if x != nil {
-		return x.Email
-	}
-	return ""
+func (x *ChangePasswordReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *CreateOrUpdateUserReq) GetStatus() uint64 {
-	if x != nil {
-		return x.Status
+func (*ChangePasswordReq) ProtoMessage() {}
+
+func (x *ChangePasswordReq) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *CreateOrUpdateUserReq) GetUsername() string {
+// Deprecated: Use ChangePasswordReq.ProtoReflect.Descriptor instead.
+func (*ChangePasswordReq) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ChangePasswordReq) GetUserID() uint64 {
 	if x != nil {
-		return x.Username
+		return x.UserID
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateOrUpdateUserReq) GetNickname() string {
+func (x *ChangePasswordReq) GetOldPassword() string {
 	if x != nil {
-		return x.Nickname
+		return x.OldPassword
 	}
 	return ""
 }
 
-func (x *CreateOrUpdateUserReq) GetPassword() string {
+func (x *ChangePasswordReq) GetNewPassword() string {
 	if x != nil {
-		return x.Password
+		return x.NewPassword
 	}
 	return ""
 }
 
-// The response data of user's information | 用户信息返回数据
-type UserInfoResp struct {
+// forgot password request | 忘记密码请求参数
+type ForgotPasswordReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ErrCode       base.ErrCode `protobuf:"varint,1,opt,name=errCode,proto3,enum=base.ErrCode" json:"errCode" form:"errCode" query:"errCode"`
-	ErrMsg        string       `protobuf:"bytes,2,opt,name=errMsg,proto3" json:"errMsg" form:"errMsg" query:"errMsg"`
-	ID            uint64       `protobuf:"varint,3,opt,name=ID,proto3" json:"ID" form:"ID" query:"ID"`
-	Avatar        string       `protobuf:"bytes,4,opt,name=avatar,proto3" json:"avatar" form:"avatar" query:"avatar"`
-	RoleID        uint64       `protobuf:"varint,5,opt,name=roleID,proto3" json:"roleID" form:"roleID" query:"roleID"`
-	Mobile        string       `protobuf:"bytes,6,opt,name=mobile,proto3" json:"mobile" form:"mobile" query:"mobile"`
-	Email         string       `protobuf:"bytes,7,opt,name=email,proto3" json:"email" form:"email" query:"email"`
-	Status        uint64       `protobuf:"varint,8,opt,name=status,proto3" json:"status" form:"status" query:"status"`
-	Username      string       `protobuf:"bytes,9,opt,name=username,proto3" json:"username" form:"username" query:"username"`
-	Nickname      string       `protobuf:"bytes,10,opt,name=nickname,proto3" json:"nickname" form:"nickname" query:"nickname"`
-	RoleName      string       `protobuf:"bytes,11,opt,name=roleName,proto3" json:"roleName" form:"roleName" query:"roleName"`
-	CreatedAt     string       `protobuf:"bytes,12,opt,name=createdAt,proto3" json:"createdAt" form:"createdAt" query:"createdAt"`
-	UpdatedAt     string       `protobuf:"bytes,13,opt,name=updatedAt,proto3" json:"updatedAt" form:"updatedAt" query:"updatedAt"`
-	RoleValue     string       `protobuf:"bytes,14,opt,name=roleValue,proto3" json:"roleValue" form:"roleValue" query:"roleValue"`
-	SideMode      string       `protobuf:"bytes,15,opt,name=sideMode,proto3" json:"sideMode" form:"sideMode" query:"sideMode"`
-	DefaultRouter string       `protobuf:"bytes,16,opt,name=defaultRouter,proto3" json:"defaultRouter" form:"defaultRouter" query:"defaultRouter"`
+	Email     string `protobuf:"bytes,1,opt,name=email,proto3" json:"email" form:"email" query:"email"`
+	CaptchaID string `protobuf:"bytes,2,opt,name=captchaID,proto3" json:"captchaID" form:"captchaID" query:"captchaID"`
+	Captcha   string `protobuf:"bytes,3,opt,name=captcha,proto3" json:"captcha" form:"captcha" query:"captcha"`
 }
 
-func (x *UserInfoResp) Reset() {
-	*x = UserInfoResp{}
+func (x *ForgotPasswordReq) Reset() {
+	*x = ForgotPasswordReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_proto_msgTypes[15]
+		mi := &file_admin_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UserInfoResp) String() string {
+func (x *ForgotPasswordReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserInfoResp) ProtoMessage() {}
+func (*ForgotPasswordReq) ProtoMessage() {}
 
-func (x *UserInfoResp) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_proto_msgTypes[15]
+func (x *ForgotPasswordReq) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1100,152 +1112,117 @@ func (x *UserInfoResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserInfoResp.ProtoReflect.Descriptor instead.
-func (*UserInfoResp) Descriptor() ([]byte, []int) {
-	return file_admin_proto_rawDescGZIP(), []int{15}
-}
-
-func (x *UserInfoResp) GetErrCode() base.ErrCode {
-	if x != nil {
-		return x.ErrCode
-	}
-	return base.ErrCode(0)
-}
-
-func (x *UserInfoResp) GetErrMsg() string {
-	if x != nil {
-		return x.ErrMsg
-	}
-	return ""
-}
-
-func (x *UserInfoResp) GetID() uint64 {
-	if x != nil {
-		return x.ID
-	}
-	return 0
+// Deprecated: Use ForgotPasswordReq.ProtoReflect.Descriptor instead.
+func (*ForgotPasswordReq) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *UserInfoResp) GetAvatar() string {
+func (x *ForgotPasswordReq) GetEmail() string {
 	if x != nil {
-		return x.Avatar
+		return x.Email
 	}
 	return ""
 }
 
-func (x *UserInfoResp) GetRoleID() uint64 {
-	if x != nil {
-		return x.RoleID
-	}
-	return 0
-}
-
-func (x *UserInfoResp) GetMobile() string {
+func (x *ForgotPasswordReq) GetCaptchaID() string {
 	if x != nil {
-		return x.Mobile
+		return x.CaptchaID
 	}
 	return ""
 }
 
-func (x *UserInfoResp) GetEmail() string {
+func (x *ForgotPasswordReq) GetCaptcha() string {
 	if x != nil {
-		return x.Email
+		return x.Captcha
 	}
 	return ""
 }
 
-func (x *UserInfoResp) GetStatus() uint64 {
-	if x != nil {
-		return x.Status
-	}
-	return 0
-}
+// reset password request, the token comes from the reset link | 重置密码请求参数, 令牌来自重置链接
+type ResetPasswordReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *UserInfoResp) GetUsername() string {
-	if x != nil {
-		return x.Username
-	}
-	return ""
+	Token       string `protobuf:"bytes,1,opt,name=token,proto3" json:"token" form:"token" query:"token"`
+	NewPassword string `protobuf:"bytes,2,opt,name=newPassword,proto3" json:"newPassword" form:"newPassword" query:"newPassword"`
 }
 
-func (x *UserInfoResp) GetNickname() string {
-	if x != nil {
-		return x.Nickname
+func (x *ResetPasswordReq) Reset() {
+	*x = ResetPasswordReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *UserInfoResp) GetRoleName() string {
-	if x != nil {
-		return x.RoleName
-	}
-	return ""
+func (x *ResetPasswordReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *UserInfoResp) GetCreatedAt() string {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return ""
-}
+func (*ResetPasswordReq) ProtoMessage() {}
 
-func (x *UserInfoResp) GetUpdatedAt() string {
-	if x != nil {
-		return x.UpdatedAt
+func (x *ResetPasswordReq) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *UserInfoResp) GetRoleValue() string {
-	if x != nil {
-		return x.RoleValue
-	}
-	return ""
+// Deprecated: Use ResetPasswordReq.ProtoReflect.Descriptor instead.
+func (*ResetPasswordReq) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *UserInfoResp) GetSideMode() string {
+func (x *ResetPasswordReq) GetToken() string {
 	if x != nil {
-		return x.SideMode
+		return x.Token
 	}
 	return ""
 }
 
-func (x *UserInfoResp) GetDefaultRouter() string {
+func (x *ResetPasswordReq) GetNewPassword() string {
 	if x != nil {
-		return x.DefaultRouter
+		return x.NewPassword
 	}
 	return ""
 }
 
-// The response data of user list | 用户列表数据
-type UserListResp struct {
+// TOTP enrollment response, the uri is rendered as QR code | TOTP绑定返回数据, uri用于生成二维码
+type TwoFactorEnrollResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ErrCode base.ErrCode    `protobuf:"varint,1,opt,name=errCode,proto3,enum=base.ErrCode" json:"errCode" form:"errCode" query:"errCode"`
-	ErrMsg  string          `protobuf:"bytes,2,opt,name=errMsg,proto3" json:"errMsg" form:"errMsg" query:"errMsg"`
-	Total   uint64          `protobuf:"varint,3,opt,name=total,proto3" json:"total" form:"total" query:"total"`
-	Data    []*UserInfoResp `protobuf:"bytes,4,rep,name=data,proto3" json:"data" form:"data" query:"data"`
+	ErrCode base.ErrCode `protobuf:"varint,1,opt,name=errCode,proto3,enum=base.ErrCode" json:"errCode" form:"errCode" query:"errCode"`
+	ErrMsg  string       `protobuf:"bytes,2,opt,name=errMsg,proto3" json:"errMsg" form:"errMsg" query:"errMsg"`
+	Secret  string       `protobuf:"bytes,3,opt,name=secret,proto3" json:"secret" form:"secret" query:"secret"`
+	Uri     string       `protobuf:"bytes,4,opt,name=uri,proto3" json:"uri" form:"uri" query:"uri"`
 }
 
-func (x *UserListResp) Reset() {
-	*x = UserListResp{}
+func (x *TwoFactorEnrollResp) Reset() {
+	*x = TwoFactorEnrollResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_proto_msgTypes[16]
+		mi := &file_admin_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UserListResp) String() string {
+func (x *TwoFactorEnrollResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserListResp) ProtoMessage() {}
+func (*TwoFactorEnrollResp) ProtoMessage() {}
 
-func (x *UserListResp) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_proto_msgTypes[16]
+func (x *TwoFactorEnrollResp) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1256,71 +1233,65 @@ func (x *UserListResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserListResp.ProtoReflect.Descriptor instead.
-func (*UserListResp) Descriptor() ([]byte, []int) {
-	return file_admin_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use TwoFactorEnrollResp.ProtoReflect.Descriptor instead.
+func (*TwoFactorEnrollResp) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *UserListResp) GetErrCode() base.ErrCode {
+func (x *TwoFactorEnrollResp) GetErrCode() base.ErrCode {
 	if x != nil {
 		return x.ErrCode
 	}
 	return base.ErrCode(0)
 }
 
-func (x *UserListResp) GetErrMsg() string {
+func (x *TwoFactorEnrollResp) GetErrMsg() string {
 	if x != nil {
 		return x.ErrMsg
 	}
 	return ""
 }
 
-func (x *UserListResp) GetTotal() uint64 {
+func (x *TwoFactorEnrollResp) GetSecret() string {
 	if x != nil {
-		return x.Total
+		return x.Secret
 	}
-	return 0
+	return ""
 }
 
-func (x *UserListResp) GetData() []*UserInfoResp {
+func (x *TwoFactorEnrollResp) GetUri() string {
 	if x != nil {
-		return x.Data
+		return x.Uri
 	}
-	return nil
+	return ""
 }
 
-// Get user list request | 获取用户列表请求参数
-type UserListReq struct {
+// TOTP code request | TOTP验证码请求参数
+type TwoFactorCodeReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Page     uint64 `protobuf:"varint,1,opt,name=page,proto3" json:"page" form:"page" query:"page"`
-	PageSize uint64 `protobuf:"varint,2,opt,name=pageSize,proto3" json:"pageSize" form:"pageSize" query:"pageSize"`
-	Username string `protobuf:"bytes,3,opt,name=username,proto3" json:"username" form:"username" query:"username"`
-	Nickname string `protobuf:"bytes,4,opt,name=nickname,proto3" json:"nickname" form:"nickname" query:"nickname"`
-	Email    string `protobuf:"bytes,5,opt,name=email,proto3" json:"email" form:"email" query:"email"`
-	Mobile   string `protobuf:"bytes,6,opt,name=mobile,proto3" json:"mobile" form:"mobile" query:"mobile"`
-	RoleID   uint64 `protobuf:"varint,7,opt,name=roleID,proto3" json:"roleID" form:"roleID" query:"roleID"`
+	Code string `protobuf:"bytes,1,opt,name=code,proto3" form:"code,required" json:"code,required"`
 }
 
-func (x *UserListReq) Reset() {
-	*x = UserListReq{}
+func (x *TwoFactorCodeReq) Reset() {
+	*x = TwoFactorCodeReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_proto_msgTypes[17]
+		mi := &file_admin_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UserListReq) String() string {
+func (x *TwoFactorCodeReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserListReq) ProtoMessage() {}
+func (*TwoFactorCodeReq) ProtoMessage() {}
 
-func (x *UserListReq) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_proto_msgTypes[17]
+func (x *TwoFactorCodeReq) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1331,88 +1302,46 @@ func (x *UserListReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserListReq.ProtoReflect.Descriptor instead.
-func (*UserListReq) Descriptor() ([]byte, []int) {
-	return file_admin_proto_rawDescGZIP(), []int{17}
-}
-
-func (x *UserListReq) GetPage() uint64 {
-	if x != nil {
-		return x.Page
-	}
-	return 0
-}
-
-func (x *UserListReq) GetPageSize() uint64 {
-	if x != nil {
-		return x.PageSize
-	}
-	return 0
-}
-
-func (x *UserListReq) GetUsername() string {
-	if x != nil {
-		return x.Username
-	}
-	return ""
-}
-
-func (x *UserListReq) GetNickname() string {
-	if x != nil {
-		return x.Nickname
-	}
-	return ""
-}
-
-func (x *UserListReq) GetEmail() string {
-	if x != nil {
-		return x.Email
-	}
-	return ""
+// Deprecated: Use TwoFactorCodeReq.ProtoReflect.Descriptor instead.
+func (*TwoFactorCodeReq) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *UserListReq) GetMobile() string {
+func (x *TwoFactorCodeReq) GetCode() string {
 	if x != nil {
-		return x.Mobile
+		return x.Code
 	}
 	return ""
 }
 
-func (x *UserListReq) GetRoleID() uint64 {
-	if x != nil {
-		return x.RoleID
-	}
-	return 0
-}
-
-// The permission code for front end permission control | 权限码： 用于前端权限控制
-type PermCodeResp struct {
+// recovery codes response, shown only once | 恢复码返回数据, 仅显示一次
+type TwoFactorRecoveryCodesResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ErrCode base.ErrCode `protobuf:"varint,1,opt,name=errCode,proto3,enum=base.ErrCode" json:"errCode" form:"errCode" query:"errCode"`
-	ErrMsg  string       `protobuf:"bytes,2,opt,name=errMsg,proto3" json:"errMsg" form:"errMsg" query:"errMsg"`
-	Data    []string     `protobuf:"bytes,3,rep,name=data,proto3" json:"data" form:"data" query:"data"`
+	ErrCode       base.ErrCode `protobuf:"varint,1,opt,name=errCode,proto3,enum=base.ErrCode" json:"errCode" form:"errCode" query:"errCode"`
+	ErrMsg        string       `protobuf:"bytes,2,opt,name=errMsg,proto3" json:"errMsg" form:"errMsg" query:"errMsg"`
+	RecoveryCodes []string     `protobuf:"bytes,3,rep,name=recoveryCodes,proto3" json:"recoveryCodes" form:"recoveryCodes" query:"recoveryCodes"`
 }
 
-func (x *PermCodeResp) Reset() {
-	*x = PermCodeResp{}
+func (x *TwoFactorRecoveryCodesResp) Reset() {
+	*x = TwoFactorRecoveryCodesResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_proto_msgTypes[18]
+		mi := &file_admin_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PermCodeResp) String() string {
+func (x *TwoFactorRecoveryCodesResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PermCodeResp) ProtoMessage() {}
+func (*TwoFactorRecoveryCodesResp) ProtoMessage() {}
 
-func (x *PermCodeResp) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_proto_msgTypes[18]
+func (x *TwoFactorRecoveryCodesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1423,65 +1352,59 @@ func (x *PermCodeResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PermCodeResp.ProtoReflect.Descriptor instead.
-func (*PermCodeResp) Descriptor() ([]byte, []int) {
-	return file_admin_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use TwoFactorRecoveryCodesResp.ProtoReflect.Descriptor instead.
+func (*TwoFactorRecoveryCodesResp) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *PermCodeResp) GetErrCode() base.ErrCode {
+func (x *TwoFactorRecoveryCodesResp) GetErrCode() base.ErrCode {
 	if x != nil {
 		return x.ErrCode
 	}
 	return base.ErrCode(0)
 }
 
-func (x *PermCodeResp) GetErrMsg() string {
+func (x *TwoFactorRecoveryCodesResp) GetErrMsg() string {
 	if x != nil {
 		return x.ErrMsg
 	}
 	return ""
 }
 
-func (x *PermCodeResp) GetData() []string {
+func (x *TwoFactorRecoveryCodesResp) GetRecoveryCodes() []string {
 	if x != nil {
-		return x.Data
+		return x.RecoveryCodes
 	}
 	return nil
 }
 
-// api message
-// The response data of API information | API信息
-type ApiInfo struct {
+// unlock login request, username and/or ip | 解除登录锁定请求参数, 用户名和/或IP
+type UnlockLoginReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID          uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID" form:"ID" query:"ID"`
-	CreatedAt   string `protobuf:"bytes,2,opt,name=createdAt,proto3" json:"createdAt" form:"createdAt" query:"createdAt"`
-	UpdatedAt   string `protobuf:"bytes,3,opt,name=updatedAt,proto3" json:"updatedAt" form:"updatedAt" query:"updatedAt"`
-	Path        string `protobuf:"bytes,4,opt,name=path,proto3" json:"path" form:"path" query:"path"`
-	Description string `protobuf:"bytes,5,opt,name=description,proto3" json:"description" form:"description" query:"description"`
-	Group       string `protobuf:"bytes,6,opt,name=group,proto3" json:"group" form:"group" query:"group"`
-	Method      string `protobuf:"bytes,7,opt,name=method,proto3" json:"method" form:"method" query:"method"`
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username" form:"username" query:"username"`
+	Ip       string `protobuf:"bytes,2,opt,name=ip,proto3" json:"ip" form:"ip" query:"ip"`
 }
 
-func (x *ApiInfo) Reset() {
-	*x = ApiInfo{}
+func (x *UnlockLoginReq) Reset() {
+	*x = UnlockLoginReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_proto_msgTypes[19]
+		mi := &file_admin_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ApiInfo) String() string {
+func (x *UnlockLoginReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ApiInfo) ProtoMessage() {}
+func (*UnlockLoginReq) ProtoMessage() {}
 
-func (x *ApiInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_proto_msgTypes[19]
+func (x *UnlockLoginReq) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1492,89 +1415,118 @@ func (x *ApiInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ApiInfo.ProtoReflect.Descriptor instead.
-func (*ApiInfo) Descriptor() ([]byte, []int) {
-	return file_admin_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use UnlockLoginReq.ProtoReflect.Descriptor instead.
+func (*UnlockLoginReq) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *ApiInfo) GetID() uint64 {
+func (x *UnlockLoginReq) GetUsername() string {
 	if x != nil {
-		return x.ID
+		return x.Username
 	}
-	return 0
+	return ""
 }
 
-func (x *ApiInfo) GetCreatedAt() string {
+func (x *UnlockLoginReq) GetIp() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.Ip
 	}
 	return ""
 }
 
-func (x *ApiInfo) GetUpdatedAt() string {
-	if x != nil {
-		return x.UpdatedAt
+// WebAuthn ceremony options response | WebAuthn注册参数返回数据
+type WebauthnOptionsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ErrCode base.ErrCode `protobuf:"varint,1,opt,name=errCode,proto3,enum=base.ErrCode" json:"errCode" form:"errCode" query:"errCode"`
+	ErrMsg  string       `protobuf:"bytes,2,opt,name=errMsg,proto3" json:"errMsg" form:"errMsg" query:"errMsg"`
+	// PublicKeyCredentialCreationOptions JSON for navigator.credentials.create
+	Options string `protobuf:"bytes,3,opt,name=options,proto3" json:"options" form:"options" query:"options"`
+}
+
+func (x *WebauthnOptionsResp) Reset() {
+	*x = WebauthnOptionsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *ApiInfo) GetPath() string {
-	if x != nil {
-		return x.Path
+func (x *WebauthnOptionsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebauthnOptionsResp) ProtoMessage() {}
+
+func (x *WebauthnOptionsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *ApiInfo) GetDescription() string {
+// Deprecated: Use WebauthnOptionsResp.ProtoReflect.Descriptor instead.
+func (*WebauthnOptionsResp) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *WebauthnOptionsResp) GetErrCode() base.ErrCode {
 	if x != nil {
-		return x.Description
+		return x.ErrCode
 	}
-	return ""
+	return base.ErrCode(0)
 }
 
-func (x *ApiInfo) GetGroup() string {
+func (x *WebauthnOptionsResp) GetErrMsg() string {
 	if x != nil {
-		return x.Group
+		return x.ErrMsg
 	}
 	return ""
 }
 
-func (x *ApiInfo) GetMethod() string {
+func (x *WebauthnOptionsResp) GetOptions() string {
 	if x != nil {
-		return x.Method
+		return x.Options
 	}
 	return ""
 }
 
-// The response data of API list | API列表数据
-type ApiListResp struct {
+// finish passkey registration request | 完成注册通行密钥请求参数
+type WebauthnRegisterReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ErrCode base.ErrCode `protobuf:"varint,1,opt,name=errCode,proto3,enum=base.ErrCode" json:"errCode" form:"errCode" query:"errCode"`
-	ErrMsg  string       `protobuf:"bytes,2,opt,name=errMsg,proto3" json:"errMsg" form:"errMsg" query:"errMsg"`
-	Total   uint64       `protobuf:"varint,3,opt,name=total,proto3" json:"total" form:"total" query:"total"`
-	Data    []*ApiInfo   `protobuf:"bytes,4,rep,name=data,proto3" json:"data" form:"data" query:"data"`
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name" form:"name" query:"name"`
+	// PublicKeyCredential JSON returned by navigator.credentials.create
+	Credential string `protobuf:"bytes,2,opt,name=credential,proto3" form:"credential,required" json:"credential,required"`
 }
 
-func (x *ApiListResp) Reset() {
-	*x = ApiListResp{}
+func (x *WebauthnRegisterReq) Reset() {
+	*x = WebauthnRegisterReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_proto_msgTypes[20]
+		mi := &file_admin_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ApiListResp) String() string {
+func (x *WebauthnRegisterReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ApiListResp) ProtoMessage() {}
+func (*WebauthnRegisterReq) ProtoMessage() {}
 
-func (x *ApiListResp) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_proto_msgTypes[20]
+func (x *WebauthnRegisterReq) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1585,70 +1537,57 @@ func (x *ApiListResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ApiListResp.ProtoReflect.Descriptor instead.
-func (*ApiListResp) Descriptor() ([]byte, []int) {
-	return file_admin_proto_rawDescGZIP(), []int{20}
-}
-
-func (x *ApiListResp) GetErrCode() base.ErrCode {
-	if x != nil {
-		return x.ErrCode
-	}
-	return base.ErrCode(0)
+// Deprecated: Use WebauthnRegisterReq.ProtoReflect.Descriptor instead.
+func (*WebauthnRegisterReq) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *ApiListResp) GetErrMsg() string {
+func (x *WebauthnRegisterReq) GetName() string {
 	if x != nil {
-		return x.ErrMsg
+		return x.Name
 	}
 	return ""
 }
 
-func (x *ApiListResp) GetTotal() uint64 {
-	if x != nil {
-		return x.Total
-	}
-	return 0
-}
-
-func (x *ApiListResp) GetData() []*ApiInfo {
+func (x *WebauthnRegisterReq) GetCredential() string {
 	if x != nil {
-		return x.Data
+		return x.Credential
 	}
-	return nil
+	return ""
 }
 
-// The request data of API list | API列表请求数据
-type ApiPageReq struct {
+// passkey information | 通行密钥信息
+type WebauthnCredentialInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Page        uint64 `protobuf:"varint,1,opt,name=page,proto3" json:"page" form:"page" query:"page"`
-	PageSize    uint64 `protobuf:"varint,2,opt,name=pageSize,proto3" json:"pageSize" form:"pageSize" query:"pageSize"`
-	Path        string `protobuf:"bytes,3,opt,name=path,proto3" json:"path" form:"path" query:"path"`
-	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description" form:"description" query:"description"`
-	Method      string `protobuf:"bytes,5,opt,name=method,proto3" json:"method" form:"method" query:"method"`
-	Group       string `protobuf:"bytes,6,opt,name=group,proto3" json:"group" form:"group" query:"group"`
+	ID           uint64   `protobuf:"varint,1,opt,name=ID,proto3" form:"ID,required" json:"ID,required"`
+	CreatedAt    string   `protobuf:"bytes,2,opt,name=createdAt,proto3" json:"createdAt" form:"createdAt" query:"createdAt"`
+	Name         string   `protobuf:"bytes,3,opt,name=name,proto3" form:"name,required" json:"name,required"`
+	CredentialID string   `protobuf:"bytes,4,opt,name=credentialID,proto3" json:"credentialID" form:"credentialID" query:"credentialID"`
+	Transports   []string `protobuf:"bytes,5,rep,name=transports,proto3" json:"transports" form:"transports" query:"transports"`
+	SignCount    uint32   `protobuf:"varint,6,opt,name=signCount,proto3" json:"signCount" form:"signCount" query:"signCount"`
+	LastUsedAt   string   `protobuf:"bytes,7,opt,name=lastUsedAt,proto3" json:"lastUsedAt" form:"lastUsedAt" query:"lastUsedAt"`
 }
 
-func (x *ApiPageReq) Reset() {
-	*x = ApiPageReq{}
+func (x *WebauthnCredentialInfo) Reset() {
+	*x = WebauthnCredentialInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_proto_msgTypes[21]
+		mi := &file_admin_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ApiPageReq) String() string {
+func (x *WebauthnCredentialInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ApiPageReq) ProtoMessage() {}
+func (*WebauthnCredentialInfo) ProtoMessage() {}
 
-func (x *ApiPageReq) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_proto_msgTypes[21]
+func (x *WebauthnCredentialInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1659,81 +1598,89 @@ func (x *ApiPageReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ApiPageReq.ProtoReflect.Descriptor instead.
-func (*ApiPageReq) Descriptor() ([]byte, []int) {
-	return file_admin_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use WebauthnCredentialInfo.ProtoReflect.Descriptor instead.
+func (*WebauthnCredentialInfo) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *ApiPageReq) GetPage() uint64 {
+func (x *WebauthnCredentialInfo) GetID() uint64 {
 	if x != nil {
-		return x.Page
+		return x.ID
 	}
 	return 0
 }
 
-func (x *ApiPageReq) GetPageSize() uint64 {
+func (x *WebauthnCredentialInfo) GetCreatedAt() string {
 	if x != nil {
-		return x.PageSize
+		return x.CreatedAt
 	}
-	return 0
+	return ""
 }
 
-func (x *ApiPageReq) GetPath() string {
+func (x *WebauthnCredentialInfo) GetName() string {
 	if x != nil {
-		return x.Path
+		return x.Name
 	}
 	return ""
 }
 
-func (x *ApiPageReq) GetDescription() string {
+func (x *WebauthnCredentialInfo) GetCredentialID() string {
 	if x != nil {
-		return x.Description
+		return x.CredentialID
 	}
 	return ""
 }
 
-func (x *ApiPageReq) GetMethod() string {
+func (x *WebauthnCredentialInfo) GetTransports() []string {
 	if x != nil {
-		return x.Method
+		return x.Transports
 	}
-	return ""
+	return nil
 }
 
-func (x *ApiPageReq) GetGroup() string {
+func (x *WebauthnCredentialInfo) GetSignCount() uint32 {
 	if x != nil {
-		return x.Group
+		return x.SignCount
+	}
+	return 0
+}
+
+func (x *WebauthnCredentialInfo) GetLastUsedAt() string {
+	if x != nil {
+		return x.LastUsedAt
 	}
 	return ""
 }
 
-// authorization message
-// The response data of api authorization | API授权数据
-type ApiAuthorityInfo struct {
+// passkey list response | 通行密钥列表返回数据
+type WebauthnCredentialListResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Path   string `protobuf:"bytes,1,opt,name=path,proto3" json:"path" form:"path" query:"path"`
-	Method string `protobuf:"bytes,2,opt,name=method,proto3" json:"method" form:"method" query:"method"`
+	ErrCode base.ErrCode              `protobuf:"varint,1,opt,name=errCode,proto3,enum=base.ErrCode" json:"errCode" form:"errCode" query:"errCode"`
+	ErrMsg  string                    `protobuf:"bytes,2,opt,name=errMsg,proto3" json:"errMsg" form:"errMsg" query:"errMsg"`
+	Total   uint64                    `protobuf:"varint,3,opt,name=total,proto3" json:"total" form:"total" query:"total"`
+	Data    []*WebauthnCredentialInfo `protobuf:"bytes,4,rep,name=data,proto3" json:"data" form:"data" query:"data"`
 }
 
-func (x *ApiAuthorityInfo) Reset() {
-	*x = ApiAuthorityInfo{}
+func (x *WebauthnCredentialListResp) Reset() {
+	*x = WebauthnCredentialListResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_proto_msgTypes[22]
+		mi := &file_admin_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ApiAuthorityInfo) String() string {
+func (x *WebauthnCredentialListResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ApiAuthorityInfo) ProtoMessage() {}
+func (*WebauthnCredentialListResp) ProtoMessage() {}
 
-func (x *ApiAuthorityInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_proto_msgTypes[22]
+func (x *WebauthnCredentialListResp) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1744,52 +1691,71 @@ func (x *ApiAuthorityInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ApiAuthorityInfo.ProtoReflect.Descriptor instead.
-func (*ApiAuthorityInfo) Descriptor() ([]byte, []int) {
-	return file_admin_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use WebauthnCredentialListResp.ProtoReflect.Descriptor instead.
+func (*WebauthnCredentialListResp) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *ApiAuthorityInfo) GetPath() string {
+func (x *WebauthnCredentialListResp) GetErrCode() base.ErrCode {
 	if x != nil {
-		return x.Path
+		return x.ErrCode
 	}
-	return ""
+	return base.ErrCode(0)
 }
 
-func (x *ApiAuthorityInfo) GetMethod() string {
+func (x *WebauthnCredentialListResp) GetErrMsg() string {
 	if x != nil {
-		return x.Method
+		return x.ErrMsg
 	}
 	return ""
 }
 
-// Create or update api authorization information request | 创建或更新API授权信息
-type CreateOrUpdateApiAuthorityReq struct {
+func (x *WebauthnCredentialListResp) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *WebauthnCredentialListResp) GetData() []*WebauthnCredentialInfo {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// linked external account information | 关联的第三方账号信息
+type UserIdentityInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RoleID uint64              `protobuf:"varint,1,opt,name=roleID,proto3" json:"roleID" form:"roleID" query:"roleID"`
-	Data   []*ApiAuthorityInfo `protobuf:"bytes,2,rep,name=data,proto3" json:"data" form:"data" query:"data"`
+	ID          uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID" form:"ID" query:"ID"`
+	CreatedAt   string `protobuf:"bytes,2,opt,name=createdAt,proto3" json:"createdAt" form:"createdAt" query:"createdAt"`
+	Provider    string `protobuf:"bytes,3,opt,name=provider,proto3" json:"provider" form:"provider" query:"provider"`
+	Subject     string `protobuf:"bytes,4,opt,name=subject,proto3" json:"subject" form:"subject" query:"subject"`
+	Username    string `protobuf:"bytes,5,opt,name=username,proto3" json:"username" form:"username" query:"username"`
+	Email       string `protobuf:"bytes,6,opt,name=email,proto3" json:"email" form:"email" query:"email"`
+	LastLoginAt string `protobuf:"bytes,7,opt,name=lastLoginAt,proto3" json:"lastLoginAt" form:"lastLoginAt" query:"lastLoginAt"`
 }
 
-func (x *CreateOrUpdateApiAuthorityReq) Reset() {
-	*x = CreateOrUpdateApiAuthorityReq{}
+func (x *UserIdentityInfo) Reset() {
+	*x = UserIdentityInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_proto_msgTypes[23]
+		mi := &file_admin_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateOrUpdateApiAuthorityReq) String() string {
+func (x *UserIdentityInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateOrUpdateApiAuthorityReq) ProtoMessage() {}
+func (*UserIdentityInfo) ProtoMessage() {}
 
-func (x *CreateOrUpdateApiAuthorityReq) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_proto_msgTypes[23]
+func (x *UserIdentityInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1800,27 +1766,62 @@ func (x *CreateOrUpdateApiAuthorityReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateOrUpdateApiAuthorityReq.ProtoReflect.Descriptor instead.
-func (*CreateOrUpdateApiAuthorityReq) Descriptor() ([]byte, []int) {
-	return file_admin_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use UserIdentityInfo.ProtoReflect.Descriptor instead.
+func (*UserIdentityInfo) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *CreateOrUpdateApiAuthorityReq) GetRoleID() uint64 {
+func (x *UserIdentityInfo) GetID() uint64 {
 	if x != nil {
-		return x.RoleID
+		return x.ID
 	}
 	return 0
 }
 
-func (x *CreateOrUpdateApiAuthorityReq) GetData() []*ApiAuthorityInfo {
+func (x *UserIdentityInfo) GetCreatedAt() string {
 	if x != nil {
-		return x.Data
+		return x.CreatedAt
 	}
-	return nil
+	return ""
 }
 
-// The  data of api authorization list | API授权列表数据
-type ApiAuthorityListInfoResp struct {
+func (x *UserIdentityInfo) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *UserIdentityInfo) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *UserIdentityInfo) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *UserIdentityInfo) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *UserIdentityInfo) GetLastLoginAt() string {
+	if x != nil {
+		return x.LastLoginAt
+	}
+	return ""
+}
+
+// linked external account list response | 关联的第三方账号列表返回数据
+type UserIdentityListResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -1828,26 +1829,26 @@ type ApiAuthorityListInfoResp struct {
 	ErrCode base.ErrCode        `protobuf:"varint,1,opt,name=errCode,proto3,enum=base.ErrCode" json:"errCode" form:"errCode" query:"errCode"`
 	ErrMsg  string              `protobuf:"bytes,2,opt,name=errMsg,proto3" json:"errMsg" form:"errMsg" query:"errMsg"`
 	Total   uint64              `protobuf:"varint,3,opt,name=total,proto3" json:"total" form:"total" query:"total"`
-	Data    []*ApiAuthorityInfo `protobuf:"bytes,4,rep,name=data,proto3" json:"data" form:"data" query:"data"`
+	Data    []*UserIdentityInfo `protobuf:"bytes,4,rep,name=data,proto3" json:"data" form:"data" query:"data"`
 }
 
-func (x *ApiAuthorityListInfoResp) Reset() {
-	*x = ApiAuthorityListInfoResp{}
+func (x *UserIdentityListResp) Reset() {
+	*x = UserIdentityListResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_proto_msgTypes[24]
+		mi := &file_admin_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ApiAuthorityListInfoResp) String() string {
+func (x *UserIdentityListResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ApiAuthorityListInfoResp) ProtoMessage() {}
+func (*UserIdentityListResp) ProtoMessage() {}
 
-func (x *ApiAuthorityListInfoResp) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_proto_msgTypes[24]
+func (x *UserIdentityListResp) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1858,66 +1859,67 @@ func (x *ApiAuthorityListInfoResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ApiAuthorityListInfoResp.ProtoReflect.Descriptor instead.
-func (*ApiAuthorityListInfoResp) Descriptor() ([]byte, []int) {
-	return file_admin_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use UserIdentityListResp.ProtoReflect.Descriptor instead.
+func (*UserIdentityListResp) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *ApiAuthorityListInfoResp) GetErrCode() base.ErrCode {
+func (x *UserIdentityListResp) GetErrCode() base.ErrCode {
 	if x != nil {
 		return x.ErrCode
 	}
 	return base.ErrCode(0)
 }
 
-func (x *ApiAuthorityListInfoResp) GetErrMsg() string {
+func (x *UserIdentityListResp) GetErrMsg() string {
 	if x != nil {
 		return x.ErrMsg
 	}
 	return ""
 }
 
-func (x *ApiAuthorityListInfoResp) GetTotal() uint64 {
+func (x *UserIdentityListResp) GetTotal() uint64 {
 	if x != nil {
 		return x.Total
 	}
 	return 0
 }
 
-func (x *ApiAuthorityListInfoResp) GetData() []*ApiAuthorityInfo {
+func (x *UserIdentityListResp) GetData() []*UserIdentityInfo {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-// The request data of menu authorization | 菜单授权请求数据
-type MenuAuthorityInfoReq struct {
+// create API key request, scopes are casbin policies as "METHOD path" allowed to the role | 创建API密钥请求参数, scopes为角色已有的casbin策略, 格式为"METHOD path"
+type CreateAPIKeyReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RoleID  uint64   `protobuf:"varint,1,opt,name=roleID,proto3" json:"roleID" form:"roleID" query:"roleID"`
-	MenuIDs []uint64 `protobuf:"varint,2,rep,packed,name=MenuIDs,proto3" json:"MenuIDs" form:"MenuIDs" query:"MenuIDs"`
+	Name       string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name" form:"name" query:"name"`
+	Scopes     []string `protobuf:"bytes,2,rep,name=scopes,proto3" json:"scopes" form:"scopes" query:"scopes"`
+	ExpireDays uint64   `protobuf:"varint,3,opt,name=expireDays,proto3" json:"expireDays" form:"expireDays" query:"expireDays"`
 }
 
-func (x *MenuAuthorityInfoReq) Reset() {
-	*x = MenuAuthorityInfoReq{}
+func (x *CreateAPIKeyReq) Reset() {
+	*x = CreateAPIKeyReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_proto_msgTypes[25]
+		mi := &file_admin_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MenuAuthorityInfoReq) String() string {
+func (x *CreateAPIKeyReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MenuAuthorityInfoReq) ProtoMessage() {}
+func (*CreateAPIKeyReq) ProtoMessage() {}
 
-func (x *MenuAuthorityInfoReq) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_proto_msgTypes[25]
+func (x *CreateAPIKeyReq) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1928,54 +1930,60 @@ func (x *MenuAuthorityInfoReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MenuAuthorityInfoReq.ProtoReflect.Descriptor instead.
-func (*MenuAuthorityInfoReq) Descriptor() ([]byte, []int) {
-	return file_admin_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use CreateAPIKeyReq.ProtoReflect.Descriptor instead.
+func (*CreateAPIKeyReq) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *MenuAuthorityInfoReq) GetRoleID() uint64 {
+func (x *CreateAPIKeyReq) GetName() string {
 	if x != nil {
-		return x.RoleID
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *MenuAuthorityInfoReq) GetMenuIDs() []uint64 {
+func (x *CreateAPIKeyReq) GetScopes() []string {
 	if x != nil {
-		return x.MenuIDs
+		return x.Scopes
 	}
 	return nil
 }
 
-// The response data of menu authorization | 菜单授权数据
-type MenuAuthorityInfoResp struct {
+func (x *CreateAPIKeyReq) GetExpireDays() uint64 {
+	if x != nil {
+		return x.ExpireDays
+	}
+	return 0
+}
+
+// the key is returned only once | 密钥仅返回这一次
+type CreateAPIKeyResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	ErrCode base.ErrCode `protobuf:"varint,1,opt,name=errCode,proto3,enum=base.ErrCode" json:"errCode" form:"errCode" query:"errCode"`
 	ErrMsg  string       `protobuf:"bytes,2,opt,name=errMsg,proto3" json:"errMsg" form:"errMsg" query:"errMsg"`
-	RoleID  uint64       `protobuf:"varint,3,opt,name=roleID,proto3" json:"roleID" form:"roleID" query:"roleID"`
-	MenuIDs []uint64     `protobuf:"varint,4,rep,packed,name=MenuIDs,proto3" json:"MenuIDs" form:"MenuIDs" query:"MenuIDs"`
+	Key     string       `protobuf:"bytes,3,opt,name=key,proto3" json:"key" form:"key" query:"key"`
 }
 
-func (x *MenuAuthorityInfoResp) Reset() {
-	*x = MenuAuthorityInfoResp{}
+func (x *CreateAPIKeyResp) Reset() {
+	*x = CreateAPIKeyResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_proto_msgTypes[26]
+		mi := &file_admin_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MenuAuthorityInfoResp) String() string {
+func (x *CreateAPIKeyResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MenuAuthorityInfoResp) ProtoMessage() {}
+func (*CreateAPIKeyResp) ProtoMessage() {}
 
-func (x *MenuAuthorityInfoResp) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_proto_msgTypes[26]
+func (x *CreateAPIKeyResp) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1986,75 +1994,67 @@ func (x *MenuAuthorityInfoResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MenuAuthorityInfoResp.ProtoReflect.Descriptor instead.
-func (*MenuAuthorityInfoResp) Descriptor() ([]byte, []int) {
-	return file_admin_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use CreateAPIKeyResp.ProtoReflect.Descriptor instead.
+func (*CreateAPIKeyResp) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *MenuAuthorityInfoResp) GetErrCode() base.ErrCode {
+func (x *CreateAPIKeyResp) GetErrCode() base.ErrCode {
 	if x != nil {
 		return x.ErrCode
 	}
 	return base.ErrCode(0)
 }
 
-func (x *MenuAuthorityInfoResp) GetErrMsg() string {
+func (x *CreateAPIKeyResp) GetErrMsg() string {
 	if x != nil {
 		return x.ErrMsg
 	}
 	return ""
 }
 
-func (x *MenuAuthorityInfoResp) GetRoleID() uint64 {
-	if x != nil {
-		return x.RoleID
-	}
-	return 0
-}
-
-func (x *MenuAuthorityInfoResp) GetMenuIDs() []uint64 {
+func (x *CreateAPIKeyResp) GetKey() string {
 	if x != nil {
-		return x.MenuIDs
+		return x.Key
 	}
-	return nil
+	return ""
 }
 
-// Create or update menu information request params | 创建或更新菜单信息参数
-type CreateOrUpdateMenuReq struct {
+// API key information, the key itself is never returned | API密钥信息, 不含密钥本身
+type APIKeyInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID        uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID" form:"ID" query:"ID"`
-	ParentID  uint64 `protobuf:"varint,2,opt,name=parentID,proto3" json:"parentID" form:"parentID" query:"parentID"`
-	Level     uint32 `protobuf:"varint,3,opt,name=level,proto3" json:"level" form:"level" query:"level"`
-	Path      string `protobuf:"bytes,4,opt,name=path,proto3" json:"path" form:"path" query:"path"`
-	Name      string `protobuf:"bytes,5,opt,name=name,proto3" json:"name" form:"name" query:"name"`
-	Redirect  string `protobuf:"bytes,6,opt,name=redirect,proto3" json:"redirect" form:"redirect" query:"redirect"`
-	Component string `protobuf:"bytes,7,opt,name=component,proto3" json:"component" form:"component" query:"component"`
-	OrderNo   uint32 `protobuf:"varint,8,opt,name=orderNo,proto3" json:"orderNo" form:"orderNo" query:"orderNo"`
-	Disabled  bool   `protobuf:"varint,9,opt,name=disabled,proto3" json:"disabled" form:"disabled" query:"disabled"`
-	MenuType  uint32 `protobuf:"varint,10,opt,name=menuType,proto3" json:"menuType" form:"menuType" query:"menuType"`
-	Meta      *Meta  `protobuf:"bytes,11,opt,name=meta,proto3" json:"meta" form:"meta" query:"meta"`
+	ID         uint64   `protobuf:"varint,1,opt,name=ID,proto3" json:"ID" form:"ID" query:"ID"`
+	CreatedAt  string   `protobuf:"bytes,2,opt,name=createdAt,proto3" json:"createdAt" form:"createdAt" query:"createdAt"`
+	UserID     uint64   `protobuf:"varint,3,opt,name=userID,proto3" json:"userID" form:"userID" query:"userID"`
+	Name       string   `protobuf:"bytes,4,opt,name=name,proto3" json:"name" form:"name" query:"name"`
+	Prefix     string   `protobuf:"bytes,5,opt,name=prefix,proto3" json:"prefix" form:"prefix" query:"prefix"`
+	Scopes     []string `protobuf:"bytes,6,rep,name=scopes,proto3" json:"scopes" form:"scopes" query:"scopes"`
+	ExpiredAt  string   `protobuf:"bytes,7,opt,name=expiredAt,proto3" json:"expiredAt" form:"expiredAt" query:"expiredAt"`
+	LastUsedAt string   `protobuf:"bytes,8,opt,name=lastUsedAt,proto3" json:"lastUsedAt" form:"lastUsedAt" query:"lastUsedAt"`
+	LastUsedIp string   `protobuf:"bytes,9,opt,name=lastUsedIp,proto3" json:"lastUsedIp" form:"lastUsedIp" query:"lastUsedIp"`
+	RevokedAt  string   `protobuf:"bytes,10,opt,name=revokedAt,proto3" json:"revokedAt" form:"revokedAt" query:"revokedAt"`
 }
 
-func (x *CreateOrUpdateMenuReq) Reset() {
-	*x = CreateOrUpdateMenuReq{}
+func (x *APIKeyInfo) Reset() {
+	*x = APIKeyInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_proto_msgTypes[27]
+		mi := &file_admin_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateOrUpdateMenuReq) String() string {
+func (x *APIKeyInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateOrUpdateMenuReq) ProtoMessage() {}
+func (*APIKeyInfo) ProtoMessage() {}
 
-func (x *CreateOrUpdateMenuReq) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_proto_msgTypes[27]
+func (x *APIKeyInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2065,127 +2065,109 @@ func (x *CreateOrUpdateMenuReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateOrUpdateMenuReq.ProtoReflect.Descriptor instead.
-func (*CreateOrUpdateMenuReq) Descriptor() ([]byte, []int) {
-	return file_admin_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use APIKeyInfo.ProtoReflect.Descriptor instead.
+func (*APIKeyInfo) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *CreateOrUpdateMenuReq) GetID() uint64 {
+func (x *APIKeyInfo) GetID() uint64 {
 	if x != nil {
 		return x.ID
 	}
 	return 0
 }
 
-func (x *CreateOrUpdateMenuReq) GetParentID() uint64 {
+func (x *APIKeyInfo) GetCreatedAt() string {
 	if x != nil {
-		return x.ParentID
+		return x.CreatedAt
 	}
-	return 0
+	return ""
 }
 
-func (x *CreateOrUpdateMenuReq) GetLevel() uint32 {
+func (x *APIKeyInfo) GetUserID() uint64 {
 	if x != nil {
-		return x.Level
+		return x.UserID
 	}
 	return 0
 }
 
-func (x *CreateOrUpdateMenuReq) GetPath() string {
-	if x != nil {
-		return x.Path
-	}
-	return ""
-}
-
-func (x *CreateOrUpdateMenuReq) GetName() string {
+func (x *APIKeyInfo) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *CreateOrUpdateMenuReq) GetRedirect() string {
+func (x *APIKeyInfo) GetPrefix() string {
 	if x != nil {
-		return x.Redirect
+		return x.Prefix
 	}
 	return ""
 }
 
-func (x *CreateOrUpdateMenuReq) GetComponent() string {
+func (x *APIKeyInfo) GetScopes() []string {
 	if x != nil {
-		return x.Component
+		return x.Scopes
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateOrUpdateMenuReq) GetOrderNo() uint32 {
+func (x *APIKeyInfo) GetExpiredAt() string {
 	if x != nil {
-		return x.OrderNo
+		return x.ExpiredAt
 	}
-	return 0
+	return ""
 }
 
-func (x *CreateOrUpdateMenuReq) GetDisabled() bool {
+func (x *APIKeyInfo) GetLastUsedAt() string {
 	if x != nil {
-		return x.Disabled
+		return x.LastUsedAt
 	}
-	return false
+	return ""
 }
 
-func (x *CreateOrUpdateMenuReq) GetMenuType() uint32 {
+func (x *APIKeyInfo) GetLastUsedIp() string {
 	if x != nil {
-		return x.MenuType
+		return x.LastUsedIp
 	}
-	return 0
+	return ""
 }
 
-func (x *CreateOrUpdateMenuReq) GetMeta() *Meta {
+func (x *APIKeyInfo) GetRevokedAt() string {
 	if x != nil {
-		return x.Meta
+		return x.RevokedAt
 	}
-	return nil
+	return ""
 }
 
-// The response data of menu information | 菜单返回数据
-type MenuInfo struct {
+// API key list request | API密钥列表请求参数
+type APIKeyListReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID        uint64      `protobuf:"varint,1,opt,name=ID,proto3" json:"ID" form:"ID" query:"ID"`
-	CreatedAt string      `protobuf:"bytes,2,opt,name=createdAt,proto3" json:"createdAt" form:"createdAt" query:"createdAt"`
-	UpdatedAt string      `protobuf:"bytes,3,opt,name=updatedAt,proto3" json:"updatedAt" form:"updatedAt" query:"updatedAt"`
-	Level     uint32      `protobuf:"varint,4,opt,name=level,proto3" json:"level" form:"level" query:"level"`
-	ParentID  uint64      `protobuf:"varint,5,opt,name=parentID,proto3" json:"parentID" form:"parentID" query:"parentID"`
-	Path      string      `protobuf:"bytes,6,opt,name=path,proto3" json:"path" form:"path" query:"path"`
-	Name      string      `protobuf:"bytes,7,opt,name=name,proto3" json:"name" form:"name" query:"name"`
-	Redirect  string      `protobuf:"bytes,8,opt,name=redirect,proto3" json:"redirect" form:"redirect" query:"redirect"`
-	Component string      `protobuf:"bytes,9,opt,name=component,proto3" json:"component" form:"component" query:"component"`
-	OrderNo   uint32      `protobuf:"varint,10,opt,name=orderNo,proto3" json:"orderNo" form:"orderNo" query:"orderNo"`
-	Disabled  bool        `protobuf:"varint,11,opt,name=disabled,proto3" json:"disabled" form:"disabled" query:"disabled"`
-	MenuType  uint32      `protobuf:"varint,12,opt,name=menuType,proto3" json:"menuType" form:"menuType" query:"menuType"`
-	Children  []*MenuInfo `protobuf:"bytes,13,rep,name=children,proto3" json:"children" form:"children" query:"children"`
-	Meta      *Meta       `protobuf:"bytes,14,opt,name=meta,proto3" json:"meta" form:"meta" query:"meta"`
+	Page     uint64 `protobuf:"varint,1,opt,name=page,proto3" json:"page" form:"page" query:"page"`
+	PageSize uint64 `protobuf:"varint,2,opt,name=pageSize,proto3" json:"pageSize" form:"pageSize" query:"pageSize"`
+	UserID   uint64 `protobuf:"varint,3,opt,name=userID,proto3" json:"userID" form:"userID" query:"userID"`
 }
 
-func (x *MenuInfo) Reset() {
-	*x = MenuInfo{}
+func (x *APIKeyListReq) Reset() {
+	*x = APIKeyListReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_proto_msgTypes[28]
+		mi := &file_admin_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MenuInfo) String() string {
+func (x *APIKeyListReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MenuInfo) ProtoMessage() {}
+func (*APIKeyListReq) ProtoMessage() {}
 
-func (x *MenuInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_proto_msgTypes[28]
+func (x *APIKeyListReq) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2196,147 +2178,141 @@ func (x *MenuInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MenuInfo.ProtoReflect.Descriptor instead.
-func (*MenuInfo) Descriptor() ([]byte, []int) {
-	return file_admin_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use APIKeyListReq.ProtoReflect.Descriptor instead.
+func (*APIKeyListReq) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *MenuInfo) GetID() uint64 {
+func (x *APIKeyListReq) GetPage() uint64 {
 	if x != nil {
-		return x.ID
+		return x.Page
 	}
 	return 0
 }
 
-func (x *MenuInfo) GetCreatedAt() string {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return ""
-}
-
-func (x *MenuInfo) GetUpdatedAt() string {
-	if x != nil {
-		return x.UpdatedAt
-	}
-	return ""
-}
-
-func (x *MenuInfo) GetLevel() uint32 {
+func (x *APIKeyListReq) GetPageSize() uint64 {
 	if x != nil {
-		return x.Level
+		return x.PageSize
 	}
 	return 0
 }
 
-func (x *MenuInfo) GetParentID() uint64 {
+func (x *APIKeyListReq) GetUserID() uint64 {
 	if x != nil {
-		return x.ParentID
+		return x.UserID
 	}
 	return 0
 }
 
-func (x *MenuInfo) GetPath() string {
-	if x != nil {
-		return x.Path
-	}
-	return ""
+// API key list response | API密钥列表返回数据
+type APIKeyListResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ErrCode base.ErrCode  `protobuf:"varint,1,opt,name=errCode,proto3,enum=base.ErrCode" json:"errCode" form:"errCode" query:"errCode"`
+	ErrMsg  string        `protobuf:"bytes,2,opt,name=errMsg,proto3" json:"errMsg" form:"errMsg" query:"errMsg"`
+	Total   uint64        `protobuf:"varint,3,opt,name=total,proto3" json:"total" form:"total" query:"total"`
+	Data    []*APIKeyInfo `protobuf:"bytes,4,rep,name=data,proto3" json:"data" form:"data" query:"data"`
 }
 
-func (x *MenuInfo) GetName() string {
-	if x != nil {
-		return x.Name
+func (x *APIKeyListResp) Reset() {
+	*x = APIKeyListResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *MenuInfo) GetRedirect() string {
-	if x != nil {
-		return x.Redirect
-	}
-	return ""
+func (x *APIKeyListResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *MenuInfo) GetComponent() string {
-	if x != nil {
-		return x.Component
+func (*APIKeyListResp) ProtoMessage() {}
+
+func (x *APIKeyListResp) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *MenuInfo) GetOrderNo() uint32 {
-	if x != nil {
-		return x.OrderNo
-	}
-	return 0
+// Deprecated: Use APIKeyListResp.ProtoReflect.Descriptor instead.
+func (*APIKeyListResp) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *MenuInfo) GetDisabled() bool {
+func (x *APIKeyListResp) GetErrCode() base.ErrCode {
 	if x != nil {
-		return x.Disabled
+		return x.ErrCode
 	}
-	return false
+	return base.ErrCode(0)
 }
 
-func (x *MenuInfo) GetMenuType() uint32 {
+func (x *APIKeyListResp) GetErrMsg() string {
 	if x != nil {
-		return x.MenuType
+		return x.ErrMsg
 	}
-	return 0
+	return ""
 }
 
-func (x *MenuInfo) GetChildren() []*MenuInfo {
+func (x *APIKeyListResp) GetTotal() uint64 {
 	if x != nil {
-		return x.Children
+		return x.Total
 	}
-	return nil
+	return 0
 }
 
-func (x *MenuInfo) GetMeta() *Meta {
+func (x *APIKeyListResp) GetData() []*APIKeyInfo {
 	if x != nil {
-		return x.Meta
+		return x.Data
 	}
 	return nil
 }
 
-// The meta data of menu | 菜单的meta数据
-type Meta struct {
+// Create or update user information request | 创建或更新用户信息
+type CreateOrUpdateUserReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Title              string `protobuf:"bytes,1,opt,name=title,proto3" json:"title" form:"title" query:"title"`
-	Icon               string `protobuf:"bytes,2,opt,name=icon,proto3" json:"icon" form:"icon" query:"icon"`
-	HideMenu           bool   `protobuf:"varint,3,opt,name=hideMenu,proto3" json:"hideMenu" form:"hideMenu" query:"hideMenu"`
-	HideBreadcrumb     bool   `protobuf:"varint,4,opt,name=hideBreadcrumb,proto3" json:"hideBreadcrumb" form:"hideBreadcrumb" query:"hideBreadcrumb"`
-	CurrentActiveMenu  string `protobuf:"bytes,5,opt,name=currentActiveMenu,proto3" json:"currentActiveMenu" form:"currentActiveMenu" query:"currentActiveMenu"`
-	IgnoreKeepAlive    bool   `protobuf:"varint,6,opt,name=ignoreKeepAlive,proto3" json:"ignoreKeepAlive" form:"ignoreKeepAlive" query:"ignoreKeepAlive"`
-	HideTab            bool   `protobuf:"varint,7,opt,name=hideTab,proto3" json:"hideTab" form:"hideTab" query:"hideTab"`
-	FrameSrc           string `protobuf:"bytes,8,opt,name=frameSrc,proto3" json:"frameSrc" form:"frameSrc" query:"frameSrc"`
-	CarryParam         bool   `protobuf:"varint,9,opt,name=carryParam,proto3" json:"carryParam" form:"carryParam" query:"carryParam"`
-	HideChildrenInMenu bool   `protobuf:"varint,10,opt,name=hideChildrenInMenu,proto3" json:"hideChildrenInMenu" form:"hideChildrenInMenu" query:"hideChildrenInMenu"`
-	Affix              bool   `protobuf:"varint,11,opt,name=affix,proto3" json:"affix" form:"affix" query:"affix"`
-	DynamicLevel       uint32 `protobuf:"varint,12,opt,name=dynamicLevel,proto3" json:"dynamicLevel" form:"dynamicLevel" query:"dynamicLevel"`
-	RealPath           string `protobuf:"bytes,13,opt,name=realPath,proto3" json:"realPath" form:"realPath" query:"realPath"`
+	ID     uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID" form:"ID" query:"ID"`
+	Avatar string `protobuf:"bytes,2,opt,name=avatar,proto3" json:"avatar" form:"avatar" query:"avatar"`
+	// the default role used by the logins, the first of roleIDs if empty | 登录使用的默认角色, 为空时取roleIDs的第一个
+	RoleID   uint64 `protobuf:"varint,3,opt,name=roleID,proto3" json:"roleID" form:"roleID" query:"roleID"`
+	Mobile   string `protobuf:"bytes,4,opt,name=mobile,proto3" json:"mobile" form:"mobile" query:"mobile"`
+	Email    string `protobuf:"bytes,5,opt,name=email,proto3" json:"email" form:"email" query:"email"`
+	Status   uint64 `protobuf:"varint,6,opt,name=status,proto3" json:"status" form:"status" query:"status"`
+	Username string `protobuf:"bytes,7,opt,name=username,proto3" json:"username" form:"username" query:"username"`
+	Nickname string `protobuf:"bytes,8,opt,name=nickname,proto3" json:"nickname" form:"nickname" query:"nickname"`
+	Password string `protobuf:"bytes,9,opt,name=password,proto3" json:"password" form:"password" query:"password"`
+	// all roles of the user, a request passes if one of them allows it | 用户的全部角色, 任一角色允许即可访问
+	RoleIDs []uint64 `protobuf:"varint,10,rep,packed,name=roleIDs,proto3" json:"roleIDs" form:"roleIDs" query:"roleIDs"`
 }
 
-func (x *Meta) Reset() {
-	*x = Meta{}
+func (x *CreateOrUpdateUserReq) Reset() {
+	*x = CreateOrUpdateUserReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_proto_msgTypes[29]
+		mi := &file_admin_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Meta) String() string {
+func (x *CreateOrUpdateUserReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Meta) ProtoMessage() {}
+func (*CreateOrUpdateUserReq) ProtoMessage() {}
 
-func (x *Meta) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_proto_msgTypes[29]
+func (x *CreateOrUpdateUserReq) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2347,131 +2323,107 @@ func (x *Meta) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Meta.ProtoReflect.Descriptor instead.
-func (*Meta) Descriptor() ([]byte, []int) {
-	return file_admin_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use CreateOrUpdateUserReq.ProtoReflect.Descriptor instead.
+func (*CreateOrUpdateUserReq) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *Meta) GetTitle() string {
+func (x *CreateOrUpdateUserReq) GetID() uint64 {
 	if x != nil {
-		return x.Title
+		return x.ID
 	}
-	return ""
+	return 0
 }
 
-func (x *Meta) GetIcon() string {
+func (x *CreateOrUpdateUserReq) GetAvatar() string {
 	if x != nil {
-		return x.Icon
+		return x.Avatar
 	}
 	return ""
 }
 
-func (x *Meta) GetHideMenu() bool {
-	if x != nil {
-		return x.HideMenu
-	}
-	return false
-}
-
-func (x *Meta) GetHideBreadcrumb() bool {
+func (x *CreateOrUpdateUserReq) GetRoleID() uint64 {
 	if x != nil {
-		return x.HideBreadcrumb
+		return x.RoleID
 	}
-	return false
+	return 0
 }
 
-func (x *Meta) GetCurrentActiveMenu() string {
+func (x *CreateOrUpdateUserReq) GetMobile() string {
 	if x != nil {
-		return x.CurrentActiveMenu
+		return x.Mobile
 	}
 	return ""
 }
 
-func (x *Meta) GetIgnoreKeepAlive() bool {
-	if x != nil {
-		return x.IgnoreKeepAlive
-	}
-	return false
-}
-
-func (x *Meta) GetHideTab() bool {
-	if x != nil {
-		return x.HideTab
-	}
-	return false
-}
-
-func (x *Meta) GetFrameSrc() string {
+func (x *CreateOrUpdateUserReq) GetEmail() string {
 	if x != nil {
-		return x.FrameSrc
+		return x.Email
 	}
 	return ""
 }
 
-func (x *Meta) GetCarryParam() bool {
+func (x *CreateOrUpdateUserReq) GetStatus() uint64 {
 	if x != nil {
-		return x.CarryParam
+		return x.Status
 	}
-	return false
+	return 0
 }
 
-func (x *Meta) GetHideChildrenInMenu() bool {
+func (x *CreateOrUpdateUserReq) GetUsername() string {
 	if x != nil {
-		return x.HideChildrenInMenu
+		return x.Username
 	}
-	return false
+	return ""
 }
 
-func (x *Meta) GetAffix() bool {
+func (x *CreateOrUpdateUserReq) GetNickname() string {
 	if x != nil {
-		return x.Affix
+		return x.Nickname
 	}
-	return false
+	return ""
 }
 
-func (x *Meta) GetDynamicLevel() uint32 {
+func (x *CreateOrUpdateUserReq) GetPassword() string {
 	if x != nil {
-		return x.DynamicLevel
+		return x.Password
 	}
-	return 0
+	return ""
 }
 
-func (x *Meta) GetRealPath() string {
+func (x *CreateOrUpdateUserReq) GetRoleIDs() []uint64 {
 	if x != nil {
-		return x.RealPath
+		return x.RoleIDs
 	}
-	return ""
+	return nil
 }
 
-// The request data of menu list | 菜单列表请求数据
-type MenuListReq struct {
+// switch role request | 切换角色请求参数
+type SwitchRoleReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Page     uint64 `protobuf:"varint,1,opt,name=page,proto3" json:"page" form:"page" query:"page"`
-	PageSize uint64 `protobuf:"varint,2,opt,name=pageSize,proto3" json:"pageSize" form:"pageSize" query:"pageSize"`
-	Name     string `protobuf:"bytes,3,opt,name=name,proto3" json:"name" form:"name" query:"name"`
-	Title    string `protobuf:"bytes,4,opt,name=title,proto3" json:"title" form:"title" query:"title"`
+	RoleID uint64 `protobuf:"varint,1,opt,name=roleID,proto3" json:"roleID" form:"roleID" query:"roleID"`
 }
 
-func (x *MenuListReq) Reset() {
-	*x = MenuListReq{}
+func (x *SwitchRoleReq) Reset() {
+	*x = SwitchRoleReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_proto_msgTypes[30]
+		mi := &file_admin_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MenuListReq) String() string {
+func (x *SwitchRoleReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MenuListReq) ProtoMessage() {}
+func (*SwitchRoleReq) ProtoMessage() {}
 
-func (x *MenuListReq) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_proto_msgTypes[30]
+func (x *SwitchRoleReq) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2482,68 +2434,64 @@ func (x *MenuListReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MenuListReq.ProtoReflect.Descriptor instead.
-func (*MenuListReq) Descriptor() ([]byte, []int) {
-	return file_admin_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use SwitchRoleReq.ProtoReflect.Descriptor instead.
+func (*SwitchRoleReq) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *MenuListReq) GetPage() uint64 {
+func (x *SwitchRoleReq) GetRoleID() uint64 {
 	if x != nil {
-		return x.Page
+		return x.RoleID
 	}
 	return 0
 }
 
-func (x *MenuListReq) GetPageSize() uint64 {
-	if x != nil {
-		return x.PageSize
-	}
-	return 0
+// The response data of user's information | 用户信息返回数据
+type UserInfoResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ErrCode base.ErrCode `protobuf:"varint,1,opt,name=errCode,proto3,enum=base.ErrCode" json:"errCode" form:"errCode" query:"errCode"`
+	ErrMsg  string       `protobuf:"bytes,2,opt,name=errMsg,proto3" json:"errMsg" form:"errMsg" query:"errMsg"`
+	ID      uint64       `protobuf:"varint,3,opt,name=ID,proto3" json:"ID" form:"ID" query:"ID"`
+	Avatar  string       `protobuf:"bytes,4,opt,name=avatar,proto3" json:"avatar" form:"avatar" query:"avatar"`
+	// the current role of the session | 当前会话的角色
+	RoleID           uint64 `protobuf:"varint,5,opt,name=roleID,proto3" json:"roleID" form:"roleID" query:"roleID"`
+	Mobile           string `protobuf:"bytes,6,opt,name=mobile,proto3" json:"mobile" form:"mobile" query:"mobile"`
+	Email            string `protobuf:"bytes,7,opt,name=email,proto3" json:"email" form:"email" query:"email"`
+	Status           uint64 `protobuf:"varint,8,opt,name=status,proto3" json:"status" form:"status" query:"status"`
+	Username         string `protobuf:"bytes,9,opt,name=username,proto3" json:"username" form:"username" query:"username"`
+	Nickname         string `protobuf:"bytes,10,opt,name=nickname,proto3" json:"nickname" form:"nickname" query:"nickname"`
+	RoleName         string `protobuf:"bytes,11,opt,name=roleName,proto3" json:"roleName" form:"roleName" query:"roleName"`
+	CreatedAt        string `protobuf:"bytes,12,opt,name=createdAt,proto3" json:"createdAt" form:"createdAt" query:"createdAt"`
+	UpdatedAt        string `protobuf:"bytes,13,opt,name=updatedAt,proto3" json:"updatedAt" form:"updatedAt" query:"updatedAt"`
+	RoleValue        string `protobuf:"bytes,14,opt,name=roleValue,proto3" json:"roleValue" form:"roleValue" query:"roleValue"`
+	SideMode         string `protobuf:"bytes,15,opt,name=sideMode,proto3" json:"sideMode" form:"sideMode" query:"sideMode"`
+	DefaultRouter    string `protobuf:"bytes,16,opt,name=defaultRouter,proto3" json:"defaultRouter" form:"defaultRouter" query:"defaultRouter"`
+	TwoFactorEnabled bool   `protobuf:"varint,17,opt,name=twoFactorEnabled,proto3" json:"twoFactorEnabled" form:"twoFactorEnabled" query:"twoFactorEnabled"`
+	PendingApproval  bool   `protobuf:"varint,18,opt,name=pendingApproval,proto3" json:"pendingApproval" form:"pendingApproval" query:"pendingApproval"`
+	// all roles of the user, the default one first | 用户的全部角色, 默认角色在前
+	RoleIDs []uint64 `protobuf:"varint,19,rep,packed,name=roleIDs,proto3" json:"roleIDs" form:"roleIDs" query:"roleIDs"`
 }
 
-func (x *MenuListReq) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *MenuListReq) GetTitle() string {
-	if x != nil {
-		return x.Title
-	}
-	return ""
-}
-
-// The data of menu list | 菜单列表数据
-type MenuInfoListResp struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	ErrCode base.ErrCode `protobuf:"varint,1,opt,name=errCode,proto3,enum=base.ErrCode" json:"errCode" form:"errCode" query:"errCode"`
-	ErrMsg  string       `protobuf:"bytes,2,opt,name=errMsg,proto3" json:"errMsg" form:"errMsg" query:"errMsg"`
-	Total   uint64       `protobuf:"varint,3,opt,name=total,proto3" json:"total" form:"total" query:"total"`
-	Data    []*MenuInfo  `protobuf:"bytes,4,rep,name=data,proto3" json:"data" form:"data" query:"data"`
-}
-
-func (x *MenuInfoListResp) Reset() {
-	*x = MenuInfoListResp{}
+func (x *UserInfoResp) Reset() {
+	*x = UserInfoResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_proto_msgTypes[31]
+		mi := &file_admin_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MenuInfoListResp) String() string {
+func (x *UserInfoResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MenuInfoListResp) ProtoMessage() {}
+func (*UserInfoResp) ProtoMessage() {}
 
-func (x *MenuInfoListResp) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_proto_msgTypes[31]
+func (x *UserInfoResp) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2554,193 +2502,173 @@ func (x *MenuInfoListResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MenuInfoListResp.ProtoReflect.Descriptor instead.
-func (*MenuInfoListResp) Descriptor() ([]byte, []int) {
-	return file_admin_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use UserInfoResp.ProtoReflect.Descriptor instead.
+func (*UserInfoResp) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *MenuInfoListResp) GetErrCode() base.ErrCode {
+func (x *UserInfoResp) GetErrCode() base.ErrCode {
 	if x != nil {
 		return x.ErrCode
 	}
 	return base.ErrCode(0)
 }
 
-func (x *MenuInfoListResp) GetErrMsg() string {
+func (x *UserInfoResp) GetErrMsg() string {
 	if x != nil {
 		return x.ErrMsg
 	}
 	return ""
 }
 
-func (x *MenuInfoListResp) GetTotal() uint64 {
+func (x *UserInfoResp) GetID() uint64 {
 	if x != nil {
-		return x.Total
+		return x.ID
 	}
 	return 0
 }
 
-func (x *MenuInfoListResp) GetData() []*MenuInfo {
+func (x *UserInfoResp) GetAvatar() string {
 	if x != nil {
-		return x.Data
+		return x.Avatar
 	}
-	return nil
+	return ""
 }
 
-// The response data of role menu list data | 角色菜单列表数据
-type MenuListBase struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	ParentID  uint32          `protobuf:"varint,1,opt,name=parentID,proto3" json:"parentID" form:"parentID" query:"parentID"`
-	Level     uint32          `protobuf:"varint,2,opt,name=level,proto3" json:"level" form:"level" query:"level"`
-	Path      string          `protobuf:"bytes,3,opt,name=path,proto3" json:"path" form:"path" query:"path"`
-	Name      string          `protobuf:"bytes,4,opt,name=name,proto3" json:"name" form:"name" query:"name"`
-	Redirect  string          `protobuf:"bytes,5,opt,name=redirect,proto3" json:"redirect" form:"redirect" query:"redirect"`
-	Component string          `protobuf:"bytes,6,opt,name=component,proto3" json:"component" form:"component" query:"component"`
-	OrderNo   uint32          `protobuf:"varint,7,opt,name=orderNo,proto3" json:"orderNo" form:"orderNo" query:"orderNo"`
-	Disabled  bool            `protobuf:"varint,8,opt,name=disabled,proto3" json:"disabled" form:"disabled" query:"disabled"`
-	Children  []*MenuListBase `protobuf:"bytes,9,rep,name=children,proto3" json:"children" form:"children" query:"children"`
-	Meta      *Meta           `protobuf:"bytes,10,opt,name=meta,proto3" json:"meta" form:"meta" query:"meta"`
+func (x *UserInfoResp) GetRoleID() uint64 {
+	if x != nil {
+		return x.RoleID
+	}
+	return 0
 }
 
-func (x *MenuListBase) Reset() {
-	*x = MenuListBase{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_proto_msgTypes[32]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *UserInfoResp) GetMobile() string {
+	if x != nil {
+		return x.Mobile
 	}
+	return ""
 }
 
-func (x *MenuListBase) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *UserInfoResp) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
 }
 
-func (*MenuListBase) ProtoMessage() {}
-
-func (x *MenuListBase) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_proto_msgTypes[32]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *UserInfoResp) GetStatus() uint64 {
+	if x != nil {
+		return x.Status
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use MenuListBase.ProtoReflect.Descriptor instead.
-func (*MenuListBase) Descriptor() ([]byte, []int) {
-	return file_admin_proto_rawDescGZIP(), []int{32}
+func (x *UserInfoResp) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
 }
 
-func (x *MenuListBase) GetParentID() uint32 {
+func (x *UserInfoResp) GetNickname() string {
 	if x != nil {
-		return x.ParentID
+		return x.Nickname
 	}
-	return 0
+	return ""
 }
 
-func (x *MenuListBase) GetLevel() uint32 {
+func (x *UserInfoResp) GetRoleName() string {
 	if x != nil {
-		return x.Level
+		return x.RoleName
 	}
-	return 0
+	return ""
 }
 
-func (x *MenuListBase) GetPath() string {
+func (x *UserInfoResp) GetCreatedAt() string {
 	if x != nil {
-		return x.Path
+		return x.CreatedAt
 	}
 	return ""
 }
 
-func (x *MenuListBase) GetName() string {
+func (x *UserInfoResp) GetUpdatedAt() string {
 	if x != nil {
-		return x.Name
+		return x.UpdatedAt
 	}
 	return ""
 }
 
-func (x *MenuListBase) GetRedirect() string {
+func (x *UserInfoResp) GetRoleValue() string {
 	if x != nil {
-		return x.Redirect
+		return x.RoleValue
 	}
 	return ""
 }
 
-func (x *MenuListBase) GetComponent() string {
+func (x *UserInfoResp) GetSideMode() string {
 	if x != nil {
-		return x.Component
+		return x.SideMode
 	}
 	return ""
 }
 
-func (x *MenuListBase) GetOrderNo() uint32 {
+func (x *UserInfoResp) GetDefaultRouter() string {
 	if x != nil {
-		return x.OrderNo
+		return x.DefaultRouter
 	}
-	return 0
+	return ""
 }
 
-func (x *MenuListBase) GetDisabled() bool {
+func (x *UserInfoResp) GetTwoFactorEnabled() bool {
 	if x != nil {
-		return x.Disabled
+		return x.TwoFactorEnabled
 	}
 	return false
 }
 
-func (x *MenuListBase) GetChildren() []*MenuListBase {
+func (x *UserInfoResp) GetPendingApproval() bool {
 	if x != nil {
-		return x.Children
+		return x.PendingApproval
 	}
-	return nil
+	return false
 }
 
-func (x *MenuListBase) GetMeta() *Meta {
+func (x *UserInfoResp) GetRoleIDs() []uint64 {
 	if x != nil {
-		return x.Meta
+		return x.RoleIDs
 	}
 	return nil
 }
 
-// Create or update role information params | 创建或更新角色信息参数
-type RoleInfo struct {
+// The response data of user list | 用户列表数据
+type UserListResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID            uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID" form:"ID" query:"ID"`
-	Name          string `protobuf:"bytes,2,opt,name=name,proto3" json:"name" form:"name" query:"name"`
-	Value         string `protobuf:"bytes,3,opt,name=value,proto3" json:"value" form:"value" query:"value"`
-	DefaultRouter string `protobuf:"bytes,4,opt,name=defaultRouter,proto3" json:"defaultRouter" form:"defaultRouter" query:"defaultRouter"`
-	Status        uint64 `protobuf:"varint,5,opt,name=status,proto3" json:"status" form:"status" query:"status"`
-	Remark        string `protobuf:"bytes,6,opt,name=remark,proto3" json:"remark" form:"remark" query:"remark"`
-	OrderNo       uint32 `protobuf:"varint,7,opt,name=orderNo,proto3" json:"orderNo" form:"orderNo" query:"orderNo"`
-	CreatedAt     string `protobuf:"bytes,8,opt,name=createdAt,proto3" json:"createdAt" form:"createdAt" query:"createdAt"`
-	UpdatedAt     string `protobuf:"bytes,9,opt,name=updatedAt,proto3" json:"updatedAt" form:"updatedAt" query:"updatedAt"`
+	ErrCode base.ErrCode    `protobuf:"varint,1,opt,name=errCode,proto3,enum=base.ErrCode" json:"errCode" form:"errCode" query:"errCode"`
+	ErrMsg  string          `protobuf:"bytes,2,opt,name=errMsg,proto3" json:"errMsg" form:"errMsg" query:"errMsg"`
+	Total   uint64          `protobuf:"varint,3,opt,name=total,proto3" json:"total" form:"total" query:"total"`
+	Data    []*UserInfoResp `protobuf:"bytes,4,rep,name=data,proto3" json:"data" form:"data" query:"data"`
 }
 
-func (x *RoleInfo) Reset() {
-	*x = RoleInfo{}
+func (x *UserListResp) Reset() {
+	*x = UserListResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_proto_msgTypes[33]
+		mi := &file_admin_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RoleInfo) String() string {
+func (x *UserListResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RoleInfo) ProtoMessage() {}
+func (*UserListResp) ProtoMessage() {}
 
-func (x *RoleInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_proto_msgTypes[33]
+func (x *UserListResp) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2751,110 +2679,73 @@ func (x *RoleInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RoleInfo.ProtoReflect.Descriptor instead.
-func (*RoleInfo) Descriptor() ([]byte, []int) {
-	return file_admin_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use UserListResp.ProtoReflect.Descriptor instead.
+func (*UserListResp) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *RoleInfo) GetID() uint64 {
+func (x *UserListResp) GetErrCode() base.ErrCode {
 	if x != nil {
-		return x.ID
+		return x.ErrCode
 	}
-	return 0
+	return base.ErrCode(0)
 }
 
-func (x *RoleInfo) GetName() string {
+func (x *UserListResp) GetErrMsg() string {
 	if x != nil {
-		return x.Name
+		return x.ErrMsg
 	}
 	return ""
 }
 
-func (x *RoleInfo) GetValue() string {
+func (x *UserListResp) GetTotal() uint64 {
 	if x != nil {
-		return x.Value
+		return x.Total
 	}
-	return ""
+	return 0
 }
 
-func (x *RoleInfo) GetDefaultRouter() string {
+func (x *UserListResp) GetData() []*UserInfoResp {
 	if x != nil {
-		return x.DefaultRouter
+		return x.Data
 	}
-	return ""
+	return nil
 }
 
-func (x *RoleInfo) GetStatus() uint64 {
-	if x != nil {
-		return x.Status
-	}
-	return 0
-}
+// Get user list request | 获取用户列表请求参数
+type UserListReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *RoleInfo) GetRemark() string {
-	if x != nil {
-		return x.Remark
-	}
-	return ""
+	Page     uint64 `protobuf:"varint,1,opt,name=page,proto3" json:"page" form:"page" query:"page"`
+	PageSize uint64 `protobuf:"varint,2,opt,name=pageSize,proto3" json:"pageSize" form:"pageSize" query:"pageSize"`
+	Username string `protobuf:"bytes,3,opt,name=username,proto3" json:"username" form:"username" query:"username"`
+	Nickname string `protobuf:"bytes,4,opt,name=nickname,proto3" json:"nickname" form:"nickname" query:"nickname"`
+	Email    string `protobuf:"bytes,5,opt,name=email,proto3" json:"email" form:"email" query:"email"`
+	Mobile   string `protobuf:"bytes,6,opt,name=mobile,proto3" json:"mobile" form:"mobile" query:"mobile"`
+	RoleID   uint64 `protobuf:"varint,7,opt,name=roleID,proto3" json:"roleID" form:"roleID" query:"roleID"`
+	// only the self-registered users waiting for approval | 仅查询待审批的自助注册用户
+	PendingApproval bool `protobuf:"varint,8,opt,name=pendingApproval,proto3" json:"pendingApproval" form:"pendingApproval" query:"pendingApproval"`
 }
 
-func (x *RoleInfo) GetOrderNo() uint32 {
-	if x != nil {
-		return x.OrderNo
+func (x *UserListReq) Reset() {
+	*x = UserListReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *RoleInfo) GetCreatedAt() string {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return ""
+func (x *UserListReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *RoleInfo) GetUpdatedAt() string {
-	if x != nil {
-		return x.UpdatedAt
-	}
-	return ""
-}
-
-// The response data of role info | 角色信息返回数据
-type RoleInfoResp struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	ErrCode       base.ErrCode `protobuf:"varint,1,opt,name=errCode,proto3,enum=base.ErrCode" json:"errCode" form:"errCode" query:"errCode"`
-	ErrMsg        string       `protobuf:"bytes,2,opt,name=errMsg,proto3" json:"errMsg" form:"errMsg" query:"errMsg"`
-	ID            uint64       `protobuf:"varint,3,opt,name=ID,proto3" json:"ID" form:"ID" query:"ID"`
-	Name          string       `protobuf:"bytes,4,opt,name=name,proto3" json:"name" form:"name" query:"name"`
-	Value         string       `protobuf:"bytes,5,opt,name=value,proto3" json:"value" form:"value" query:"value"`
-	DefaultRouter string       `protobuf:"bytes,6,opt,name=defaultRouter,proto3" json:"defaultRouter" form:"defaultRouter" query:"defaultRouter"`
-	Status        uint64       `protobuf:"varint,7,opt,name=status,proto3" json:"status" form:"status" query:"status"`
-	Remark        string       `protobuf:"bytes,8,opt,name=remark,proto3" json:"remark" form:"remark" query:"remark"`
-	OrderNo       uint32       `protobuf:"varint,9,opt,name=orderNo,proto3" json:"orderNo" form:"orderNo" query:"orderNo"`
-	CreatedAt     string       `protobuf:"bytes,10,opt,name=createdAt,proto3" json:"createdAt" form:"createdAt" query:"createdAt"`
-	UpdatedAt     string       `protobuf:"bytes,11,opt,name=updatedAt,proto3" json:"updatedAt" form:"updatedAt" query:"updatedAt"`
-}
-
-func (x *RoleInfoResp) Reset() {
-	*x = RoleInfoResp{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_proto_msgTypes[34]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *RoleInfoResp) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*RoleInfoResp) ProtoMessage() {}
+func (*UserListReq) ProtoMessage() {}
 
-func (x *RoleInfoResp) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_proto_msgTypes[34]
+func (x *UserListReq) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2865,117 +2756,164 @@ func (x *RoleInfoResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RoleInfoResp.ProtoReflect.Descriptor instead.
-func (*RoleInfoResp) Descriptor() ([]byte, []int) {
-	return file_admin_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use UserListReq.ProtoReflect.Descriptor instead.
+func (*UserListReq) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *RoleInfoResp) GetErrCode() base.ErrCode {
+func (x *UserListReq) GetPage() uint64 {
 	if x != nil {
-		return x.ErrCode
+		return x.Page
 	}
-	return base.ErrCode(0)
+	return 0
 }
 
-func (x *RoleInfoResp) GetErrMsg() string {
+func (x *UserListReq) GetPageSize() uint64 {
 	if x != nil {
-		return x.ErrMsg
+		return x.PageSize
 	}
-	return ""
+	return 0
 }
 
-func (x *RoleInfoResp) GetID() uint64 {
+func (x *UserListReq) GetUsername() string {
 	if x != nil {
-		return x.ID
+		return x.Username
 	}
-	return 0
+	return ""
 }
 
-func (x *RoleInfoResp) GetName() string {
+func (x *UserListReq) GetNickname() string {
 	if x != nil {
-		return x.Name
+		return x.Nickname
 	}
 	return ""
 }
 
-func (x *RoleInfoResp) GetValue() string {
+func (x *UserListReq) GetEmail() string {
 	if x != nil {
-		return x.Value
+		return x.Email
 	}
 	return ""
 }
 
-func (x *RoleInfoResp) GetDefaultRouter() string {
+func (x *UserListReq) GetMobile() string {
 	if x != nil {
-		return x.DefaultRouter
+		return x.Mobile
 	}
 	return ""
 }
 
-func (x *RoleInfoResp) GetStatus() uint64 {
+func (x *UserListReq) GetRoleID() uint64 {
 	if x != nil {
-		return x.Status
+		return x.RoleID
 	}
 	return 0
 }
 
-func (x *RoleInfoResp) GetRemark() string {
+func (x *UserListReq) GetPendingApproval() bool {
 	if x != nil {
-		return x.Remark
+		return x.PendingApproval
 	}
-	return ""
+	return false
 }
 
-func (x *RoleInfoResp) GetOrderNo() uint32 {
+// The permission code for front end permission control | 权限码： 用于前端权限控制
+type PermCodeResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ErrCode base.ErrCode `protobuf:"varint,1,opt,name=errCode,proto3,enum=base.ErrCode" json:"errCode" form:"errCode" query:"errCode"`
+	ErrMsg  string       `protobuf:"bytes,2,opt,name=errMsg,proto3" json:"errMsg" form:"errMsg" query:"errMsg"`
+	Data    []string     `protobuf:"bytes,3,rep,name=data,proto3" json:"data" form:"data" query:"data"`
+}
+
+func (x *PermCodeResp) Reset() {
+	*x = PermCodeResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PermCodeResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PermCodeResp) ProtoMessage() {}
+
+func (x *PermCodeResp) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PermCodeResp.ProtoReflect.Descriptor instead.
+func (*PermCodeResp) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *PermCodeResp) GetErrCode() base.ErrCode {
 	if x != nil {
-		return x.OrderNo
+		return x.ErrCode
 	}
-	return 0
+	return base.ErrCode(0)
 }
 
-func (x *RoleInfoResp) GetCreatedAt() string {
+func (x *PermCodeResp) GetErrMsg() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.ErrMsg
 	}
 	return ""
 }
 
-func (x *RoleInfoResp) GetUpdatedAt() string {
+func (x *PermCodeResp) GetData() []string {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Data
 	}
-	return ""
+	return nil
 }
 
-// The request data of role list | 角色列表请求数据
-type RoleListReq struct {
+// api message
+// The response data of API information | API信息
+type ApiInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Page     uint64 `protobuf:"varint,1,opt,name=page,proto3" json:"page" form:"page" query:"page"`
-	PageSize uint64 `protobuf:"varint,2,opt,name=pageSize,proto3" json:"pageSize" form:"pageSize" query:"pageSize"`
-	Name     string `protobuf:"bytes,3,opt,name=name,proto3" json:"name" form:"name" query:"name"`
-	Status   string `protobuf:"bytes,4,opt,name=status,proto3" json:"status" form:"status" query:"status"`
+	ID          uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID" form:"ID" query:"ID"`
+	CreatedAt   string `protobuf:"bytes,2,opt,name=createdAt,proto3" json:"createdAt" form:"createdAt" query:"createdAt"`
+	UpdatedAt   string `protobuf:"bytes,3,opt,name=updatedAt,proto3" json:"updatedAt" form:"updatedAt" query:"updatedAt"`
+	Path        string `protobuf:"bytes,4,opt,name=path,proto3" json:"path" form:"path" query:"path"`
+	Description string `protobuf:"bytes,5,opt,name=description,proto3" json:"description" form:"description" query:"description"`
+	Group       string `protobuf:"bytes,6,opt,name=group,proto3" json:"group" form:"group" query:"group"`
+	Method      string `protobuf:"bytes,7,opt,name=method,proto3" json:"method" form:"method" query:"method"`
 }
 
-func (x *RoleListReq) Reset() {
-	*x = RoleListReq{}
+func (x *ApiInfo) Reset() {
+	*x = ApiInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_proto_msgTypes[35]
+		mi := &file_admin_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RoleListReq) String() string {
+func (x *ApiInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RoleListReq) ProtoMessage() {}
+func (*ApiInfo) ProtoMessage() {}
 
-func (x *RoleListReq) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_proto_msgTypes[35]
+func (x *ApiInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2986,41 +2924,62 @@ func (x *RoleListReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RoleListReq.ProtoReflect.Descriptor instead.
-func (*RoleListReq) Descriptor() ([]byte, []int) {
-	return file_admin_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use ApiInfo.ProtoReflect.Descriptor instead.
+func (*ApiInfo) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *RoleListReq) GetPage() uint64 {
+func (x *ApiInfo) GetID() uint64 {
 	if x != nil {
-		return x.Page
+		return x.ID
 	}
 	return 0
 }
 
-func (x *RoleListReq) GetPageSize() uint64 {
+func (x *ApiInfo) GetCreatedAt() string {
 	if x != nil {
-		return x.PageSize
+		return x.CreatedAt
 	}
-	return 0
+	return ""
 }
 
-func (x *RoleListReq) GetName() string {
+func (x *ApiInfo) GetUpdatedAt() string {
 	if x != nil {
-		return x.Name
+		return x.UpdatedAt
 	}
 	return ""
 }
 
-func (x *RoleListReq) GetStatus() string {
+func (x *ApiInfo) GetPath() string {
 	if x != nil {
-		return x.Status
+		return x.Path
 	}
 	return ""
 }
 
-// Get role list response | 获取角色列表响应
-type RoleListResp struct {
+func (x *ApiInfo) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ApiInfo) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+func (x *ApiInfo) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+// The response data of API list | API列表数据
+type ApiListResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -3028,26 +2987,26 @@ type RoleListResp struct {
 	ErrCode base.ErrCode `protobuf:"varint,1,opt,name=errCode,proto3,enum=base.ErrCode" json:"errCode" form:"errCode" query:"errCode"`
 	ErrMsg  string       `protobuf:"bytes,2,opt,name=errMsg,proto3" json:"errMsg" form:"errMsg" query:"errMsg"`
 	Total   uint64       `protobuf:"varint,3,opt,name=total,proto3" json:"total" form:"total" query:"total"`
-	Data    []*RoleInfo  `protobuf:"bytes,4,rep,name=data,proto3" json:"data" form:"data" query:"data"`
+	Data    []*ApiInfo   `protobuf:"bytes,4,rep,name=data,proto3" json:"data" form:"data" query:"data"`
 }
 
-func (x *RoleListResp) Reset() {
-	*x = RoleListResp{}
+func (x *ApiListResp) Reset() {
+	*x = ApiListResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_proto_msgTypes[36]
+		mi := &file_admin_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RoleListResp) String() string {
+func (x *ApiListResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RoleListResp) ProtoMessage() {}
+func (*ApiListResp) ProtoMessage() {}
 
-func (x *RoleListResp) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_proto_msgTypes[36]
+func (x *ApiListResp) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3058,72 +3017,70 @@ func (x *RoleListResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RoleListResp.ProtoReflect.Descriptor instead.
-func (*RoleListResp) Descriptor() ([]byte, []int) {
-	return file_admin_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use ApiListResp.ProtoReflect.Descriptor instead.
+func (*ApiListResp) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *RoleListResp) GetErrCode() base.ErrCode {
+func (x *ApiListResp) GetErrCode() base.ErrCode {
 	if x != nil {
 		return x.ErrCode
 	}
 	return base.ErrCode(0)
 }
 
-func (x *RoleListResp) GetErrMsg() string {
+func (x *ApiListResp) GetErrMsg() string {
 	if x != nil {
 		return x.ErrMsg
 	}
 	return ""
 }
 
-func (x *RoleListResp) GetTotal() uint64 {
+func (x *ApiListResp) GetTotal() uint64 {
 	if x != nil {
 		return x.Total
 	}
 	return 0
 }
 
-func (x *RoleListResp) GetData() []*RoleInfo {
+func (x *ApiListResp) GetData() []*ApiInfo {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-// The response data of Token information | Token信息
-type TokenInfo struct {
+// The request data of API list | API列表请求数据
+type ApiPageReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID        uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID" form:"ID" query:"ID"`
-	CreatedAt string `protobuf:"bytes,2,opt,name=createdAt,proto3" json:"createdAt" form:"createdAt" query:"createdAt"`
-	UpdatedAt string `protobuf:"bytes,3,opt,name=updatedAt,proto3" json:"updatedAt" form:"updatedAt" query:"updatedAt"`
-	UserID    uint64 `protobuf:"varint,4,opt,name=userID,proto3" json:"userID" form:"userID" query:"userID"`
-	UserName  string `protobuf:"bytes,5,opt,name=userName,proto3" json:"userName" form:"userName" query:"userName"`
-	Token     string `protobuf:"bytes,6,opt,name=token,proto3" json:"token" form:"token" query:"token"`
-	Source    string `protobuf:"bytes,7,opt,name=source,proto3" json:"source" form:"source" query:"source"`
-	ExpiredAt string `protobuf:"bytes,8,opt,name=expiredAt,proto3" json:"expiredAt" form:"expiredAt" query:"expiredAt"`
+	Page        uint64 `protobuf:"varint,1,opt,name=page,proto3" json:"page" form:"page" query:"page"`
+	PageSize    uint64 `protobuf:"varint,2,opt,name=pageSize,proto3" json:"pageSize" form:"pageSize" query:"pageSize"`
+	Path        string `protobuf:"bytes,3,opt,name=path,proto3" json:"path" form:"path" query:"path"`
+	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description" form:"description" query:"description"`
+	Method      string `protobuf:"bytes,5,opt,name=method,proto3" json:"method" form:"method" query:"method"`
+	Group       string `protobuf:"bytes,6,opt,name=group,proto3" json:"group" form:"group" query:"group"`
 }
 
-func (x *TokenInfo) Reset() {
-	*x = TokenInfo{}
+func (x *ApiPageReq) Reset() {
+	*x = ApiPageReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_proto_msgTypes[37]
+		mi := &file_admin_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TokenInfo) String() string {
+func (x *ApiPageReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TokenInfo) ProtoMessage() {}
+func (*ApiPageReq) ProtoMessage() {}
 
-func (x *TokenInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_proto_msgTypes[37]
+func (x *ApiPageReq) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3134,96 +3091,83 @@ func (x *TokenInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TokenInfo.ProtoReflect.Descriptor instead.
-func (*TokenInfo) Descriptor() ([]byte, []int) {
-	return file_admin_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use ApiPageReq.ProtoReflect.Descriptor instead.
+func (*ApiPageReq) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *TokenInfo) GetID() uint64 {
+func (x *ApiPageReq) GetPage() uint64 {
 	if x != nil {
-		return x.ID
+		return x.Page
 	}
 	return 0
 }
 
-func (x *TokenInfo) GetCreatedAt() string {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return ""
-}
-
-func (x *TokenInfo) GetUpdatedAt() string {
-	if x != nil {
-		return x.UpdatedAt
-	}
-	return ""
-}
-
-func (x *TokenInfo) GetUserID() uint64 {
+func (x *ApiPageReq) GetPageSize() uint64 {
 	if x != nil {
-		return x.UserID
+		return x.PageSize
 	}
 	return 0
 }
 
-func (x *TokenInfo) GetUserName() string {
+func (x *ApiPageReq) GetPath() string {
 	if x != nil {
-		return x.UserName
+		return x.Path
 	}
 	return ""
 }
 
-func (x *TokenInfo) GetToken() string {
+func (x *ApiPageReq) GetDescription() string {
 	if x != nil {
-		return x.Token
+		return x.Description
 	}
 	return ""
 }
 
-func (x *TokenInfo) GetSource() string {
+func (x *ApiPageReq) GetMethod() string {
 	if x != nil {
-		return x.Source
+		return x.Method
 	}
 	return ""
 }
 
-func (x *TokenInfo) GetExpiredAt() string {
+func (x *ApiPageReq) GetGroup() string {
 	if x != nil {
-		return x.ExpiredAt
+		return x.Group
 	}
 	return ""
 }
 
-// The response data of Token list | Token列表返回数据
-type TokenListResp struct {
+// authorization message
+// The response data of api authorization | API授权数据
+type ApiAuthorityInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ErrCode base.ErrCode `protobuf:"varint,1,opt,name=errCode,proto3,enum=base.ErrCode" json:"errCode" form:"errCode" query:"errCode"`
-	ErrMsg  string       `protobuf:"bytes,2,opt,name=errMsg,proto3" json:"errMsg" form:"errMsg" query:"errMsg"`
-	Total   uint64       `protobuf:"varint,3,opt,name=total,proto3" json:"total" form:"total" query:"total"`
-	Data    []*TokenInfo `protobuf:"bytes,4,rep,name=data,proto3" json:"data" form:"data" query:"data"`
+	Path   string `protobuf:"bytes,1,opt,name=path,proto3" json:"path" form:"path" query:"path"`
+	Method string `protobuf:"bytes,2,opt,name=method,proto3" json:"method" form:"method" query:"method"`
+	// the policy is inherited from an ancestor, not stored on the role | 策略继承自上级角色, 未保存在角色上
+	Inherited bool `protobuf:"varint,3,opt,name=inherited,proto3" json:"inherited" form:"inherited" query:"inherited"`
 }
 
-func (x *TokenListResp) Reset() {
-	*x = TokenListResp{}
+func (x *ApiAuthorityInfo) Reset() {
+	*x = ApiAuthorityInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_proto_msgTypes[38]
+		mi := &file_admin_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TokenListResp) String() string {
+func (x *ApiAuthorityInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TokenListResp) ProtoMessage() {}
+func (*ApiAuthorityInfo) ProtoMessage() {}
 
-func (x *TokenListResp) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_proto_msgTypes[38]
+func (x *ApiAuthorityInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3234,68 +3178,59 @@ func (x *TokenListResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TokenListResp.ProtoReflect.Descriptor instead.
-func (*TokenListResp) Descriptor() ([]byte, []int) {
-	return file_admin_proto_rawDescGZIP(), []int{38}
-}
-
-func (x *TokenListResp) GetErrCode() base.ErrCode {
-	if x != nil {
-		return x.ErrCode
-	}
-	return base.ErrCode(0)
+// Deprecated: Use ApiAuthorityInfo.ProtoReflect.Descriptor instead.
+func (*ApiAuthorityInfo) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *TokenListResp) GetErrMsg() string {
+func (x *ApiAuthorityInfo) GetPath() string {
 	if x != nil {
-		return x.ErrMsg
+		return x.Path
 	}
 	return ""
 }
 
-func (x *TokenListResp) GetTotal() uint64 {
+func (x *ApiAuthorityInfo) GetMethod() string {
 	if x != nil {
-		return x.Total
+		return x.Method
 	}
-	return 0
+	return ""
 }
 
-func (x *TokenListResp) GetData() []*TokenInfo {
+func (x *ApiAuthorityInfo) GetInherited() bool {
 	if x != nil {
-		return x.Data
+		return x.Inherited
 	}
-	return nil
+	return false
 }
 
-// Get token list request params | token列表请求参数
-type TokenListReq struct {
+// Create or update api authorization information request | 创建或更新API授权信息
+type CreateOrUpdateApiAuthorityReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Page     uint64 `protobuf:"varint,1,opt,name=page,proto3" json:"page" form:"page" query:"page"`
-	PageSize uint64 `protobuf:"varint,2,opt,name=pageSize,proto3" json:"pageSize" form:"pageSize" query:"pageSize"`
-	Username string `protobuf:"bytes,3,opt,name=username,proto3" json:"username" form:"username" query:"username"`
-	UserID   uint64 `protobuf:"varint,4,opt,name=userID,proto3" json:"userID" form:"userID" query:"userID"`
+	RoleID uint64              `protobuf:"varint,1,opt,name=roleID,proto3" json:"roleID" form:"roleID" query:"roleID"`
+	Data   []*ApiAuthorityInfo `protobuf:"bytes,2,rep,name=data,proto3" json:"data" form:"data" query:"data"`
 }
 
-func (x *TokenListReq) Reset() {
-	*x = TokenListReq{}
+func (x *CreateOrUpdateApiAuthorityReq) Reset() {
+	*x = CreateOrUpdateApiAuthorityReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_proto_msgTypes[39]
+		mi := &file_admin_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TokenListReq) String() string {
+func (x *CreateOrUpdateApiAuthorityReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TokenListReq) ProtoMessage() {}
+func (*CreateOrUpdateApiAuthorityReq) ProtoMessage() {}
 
-func (x *TokenListReq) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_proto_msgTypes[39]
+func (x *CreateOrUpdateApiAuthorityReq) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3306,65 +3241,54 @@ func (x *TokenListReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TokenListReq.ProtoReflect.Descriptor instead.
-func (*TokenListReq) Descriptor() ([]byte, []int) {
-	return file_admin_proto_rawDescGZIP(), []int{39}
-}
-
-func (x *TokenListReq) GetPage() uint64 {
-	if x != nil {
-		return x.Page
-	}
-	return 0
+// Deprecated: Use CreateOrUpdateApiAuthorityReq.ProtoReflect.Descriptor instead.
+func (*CreateOrUpdateApiAuthorityReq) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *TokenListReq) GetPageSize() uint64 {
+func (x *CreateOrUpdateApiAuthorityReq) GetRoleID() uint64 {
 	if x != nil {
-		return x.PageSize
+		return x.RoleID
 	}
 	return 0
 }
 
-func (x *TokenListReq) GetUsername() string {
-	if x != nil {
-		return x.Username
-	}
-	return ""
-}
-
-func (x *TokenListReq) GetUserID() uint64 {
+func (x *CreateOrUpdateApiAuthorityReq) GetData() []*ApiAuthorityInfo {
 	if x != nil {
-		return x.UserID
+		return x.Data
 	}
-	return 0
+	return nil
 }
 
-// Delete request params | token删除请求参数
-type DeleteReq struct {
+// The  data of api authorization list | API授权列表数据
+type ApiAuthorityListInfoResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserID uint64 `protobuf:"varint,1,opt,name=userID,proto3" json:"userID" form:"userID" query:"userID"`
+	ErrCode base.ErrCode        `protobuf:"varint,1,opt,name=errCode,proto3,enum=base.ErrCode" json:"errCode" form:"errCode" query:"errCode"`
+	ErrMsg  string              `protobuf:"bytes,2,opt,name=errMsg,proto3" json:"errMsg" form:"errMsg" query:"errMsg"`
+	Total   uint64              `protobuf:"varint,3,opt,name=total,proto3" json:"total" form:"total" query:"total"`
+	Data    []*ApiAuthorityInfo `protobuf:"bytes,4,rep,name=data,proto3" json:"data" form:"data" query:"data"`
 }
 
-func (x *DeleteReq) Reset() {
-	*x = DeleteReq{}
+func (x *ApiAuthorityListInfoResp) Reset() {
+	*x = ApiAuthorityListInfoResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_proto_msgTypes[40]
+		mi := &file_admin_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DeleteReq) String() string {
+func (x *ApiAuthorityListInfoResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteReq) ProtoMessage() {}
+func (*ApiAuthorityListInfoResp) ProtoMessage() {}
 
-func (x *DeleteReq) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_proto_msgTypes[40]
+func (x *ApiAuthorityListInfoResp) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3375,50 +3299,66 @@ func (x *DeleteReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteReq.ProtoReflect.Descriptor instead.
-func (*DeleteReq) Descriptor() ([]byte, []int) {
-	return file_admin_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use ApiAuthorityListInfoResp.ProtoReflect.Descriptor instead.
+func (*ApiAuthorityListInfoResp) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *DeleteReq) GetUserID() uint64 {
+func (x *ApiAuthorityListInfoResp) GetErrCode() base.ErrCode {
 	if x != nil {
-		return x.UserID
+		return x.ErrCode
+	}
+	return base.ErrCode(0)
+}
+
+func (x *ApiAuthorityListInfoResp) GetErrMsg() string {
+	if x != nil {
+		return x.ErrMsg
+	}
+	return ""
+}
+
+func (x *ApiAuthorityListInfoResp) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
 	}
 	return 0
 }
 
-// The response data of dictionary information | 字典信息
-type DictionaryInfo struct {
+func (x *ApiAuthorityListInfoResp) GetData() []*ApiAuthorityInfo {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// The request data of menu authorization | 菜单授权请求数据
+type MenuAuthorityInfoReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID          uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID" form:"ID" query:"ID"`
-	Title       string `protobuf:"bytes,2,opt,name=title,proto3" json:"title" form:"title" query:"title"`
-	Name        string `protobuf:"bytes,3,opt,name=name,proto3" json:"name" form:"name" query:"name"`
-	Status      uint64 `protobuf:"varint,4,opt,name=status,proto3" json:"status" form:"status" query:"status"`
-	Description string `protobuf:"bytes,5,opt,name=description,proto3" json:"description" form:"description" query:"description"`
-	CreatedAt   string `protobuf:"bytes,6,opt,name=createdAt,proto3" json:"createdAt" form:"createdAt" query:"createdAt"`
-	UpdatedAt   string `protobuf:"bytes,7,opt,name=updatedAt,proto3" json:"updatedAt" form:"updatedAt" query:"updatedAt"`
+	RoleID  uint64   `protobuf:"varint,1,opt,name=roleID,proto3" json:"roleID" form:"roleID" query:"roleID"`
+	MenuIDs []uint64 `protobuf:"varint,2,rep,packed,name=MenuIDs,proto3" json:"MenuIDs" form:"MenuIDs" query:"MenuIDs"`
 }
 
-func (x *DictionaryInfo) Reset() {
-	*x = DictionaryInfo{}
+func (x *MenuAuthorityInfoReq) Reset() {
+	*x = MenuAuthorityInfoReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_proto_msgTypes[41]
+		mi := &file_admin_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DictionaryInfo) String() string {
+func (x *MenuAuthorityInfoReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DictionaryInfo) ProtoMessage() {}
+func (*MenuAuthorityInfoReq) ProtoMessage() {}
 
-func (x *DictionaryInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_proto_msgTypes[41]
+func (x *MenuAuthorityInfoReq) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3429,89 +3369,57 @@ func (x *DictionaryInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DictionaryInfo.ProtoReflect.Descriptor instead.
-func (*DictionaryInfo) Descriptor() ([]byte, []int) {
-	return file_admin_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use MenuAuthorityInfoReq.ProtoReflect.Descriptor instead.
+func (*MenuAuthorityInfoReq) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *DictionaryInfo) GetID() uint64 {
+func (x *MenuAuthorityInfoReq) GetRoleID() uint64 {
 	if x != nil {
-		return x.ID
+		return x.RoleID
 	}
 	return 0
 }
 
-func (x *DictionaryInfo) GetTitle() string {
+func (x *MenuAuthorityInfoReq) GetMenuIDs() []uint64 {
 	if x != nil {
-		return x.Title
-	}
-	return ""
-}
-
-func (x *DictionaryInfo) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *DictionaryInfo) GetStatus() uint64 {
-	if x != nil {
-		return x.Status
-	}
-	return 0
-}
-
-func (x *DictionaryInfo) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
-}
-
-func (x *DictionaryInfo) GetCreatedAt() string {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return ""
-}
-
-func (x *DictionaryInfo) GetUpdatedAt() string {
-	if x != nil {
-		return x.UpdatedAt
+		return x.MenuIDs
 	}
-	return ""
+	return nil
 }
 
-// The data of dictionary list | 字典列表数据
-type DictionaryListResp struct {
+// The response data of menu authorization | 菜单授权数据
+type MenuAuthorityInfoResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ErrCode base.ErrCode      `protobuf:"varint,1,opt,name=errCode,proto3,enum=base.ErrCode" json:"errCode" form:"errCode" query:"errCode"`
-	ErrMsg  string            `protobuf:"bytes,2,opt,name=errMsg,proto3" json:"errMsg" form:"errMsg" query:"errMsg"`
-	Total   uint64            `protobuf:"varint,3,opt,name=total,proto3" json:"total" form:"total" query:"total"`
-	Data    []*DictionaryInfo `protobuf:"bytes,4,rep,name=data,proto3" json:"data" form:"data" query:"data"`
+	ErrCode base.ErrCode `protobuf:"varint,1,opt,name=errCode,proto3,enum=base.ErrCode" json:"errCode" form:"errCode" query:"errCode"`
+	ErrMsg  string       `protobuf:"bytes,2,opt,name=errMsg,proto3" json:"errMsg" form:"errMsg" query:"errMsg"`
+	RoleID  uint64       `protobuf:"varint,3,opt,name=roleID,proto3" json:"roleID" form:"roleID" query:"roleID"`
+	// the menus of the role merged with the inherited ones | 角色的菜单, 含继承的菜单
+	MenuIDs []uint64 `protobuf:"varint,4,rep,packed,name=MenuIDs,proto3" json:"MenuIDs" form:"MenuIDs" query:"MenuIDs"`
+	// the menus inherited from the ancestors only | 仅从上级角色继承的菜单
+	InheritedMenuIDs []uint64 `protobuf:"varint,5,rep,packed,name=inheritedMenuIDs,proto3" json:"inheritedMenuIDs" form:"inheritedMenuIDs" query:"inheritedMenuIDs"`
 }
 
-func (x *DictionaryListResp) Reset() {
-	*x = DictionaryListResp{}
+func (x *MenuAuthorityInfoResp) Reset() {
+	*x = MenuAuthorityInfoResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_proto_msgTypes[42]
+		mi := &file_admin_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DictionaryListResp) String() string {
+func (x *MenuAuthorityInfoResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DictionaryListResp) ProtoMessage() {}
+func (*MenuAuthorityInfoResp) ProtoMessage() {}
 
-func (x *DictionaryListResp) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_proto_msgTypes[42]
+func (x *MenuAuthorityInfoResp) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3522,72 +3430,82 @@ func (x *DictionaryListResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DictionaryListResp.ProtoReflect.Descriptor instead.
-func (*DictionaryListResp) Descriptor() ([]byte, []int) {
-	return file_admin_proto_rawDescGZIP(), []int{42}
+// Deprecated: Use MenuAuthorityInfoResp.ProtoReflect.Descriptor instead.
+func (*MenuAuthorityInfoResp) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *DictionaryListResp) GetErrCode() base.ErrCode {
+func (x *MenuAuthorityInfoResp) GetErrCode() base.ErrCode {
 	if x != nil {
 		return x.ErrCode
 	}
 	return base.ErrCode(0)
 }
 
-func (x *DictionaryListResp) GetErrMsg() string {
+func (x *MenuAuthorityInfoResp) GetErrMsg() string {
 	if x != nil {
 		return x.ErrMsg
 	}
 	return ""
 }
 
-func (x *DictionaryListResp) GetTotal() uint64 {
+func (x *MenuAuthorityInfoResp) GetRoleID() uint64 {
 	if x != nil {
-		return x.Total
+		return x.RoleID
 	}
 	return 0
 }
 
-func (x *DictionaryListResp) GetData() []*DictionaryInfo {
+func (x *MenuAuthorityInfoResp) GetMenuIDs() []uint64 {
 	if x != nil {
-		return x.Data
+		return x.MenuIDs
 	}
 	return nil
 }
 
-// The dictionary KV information | 字典键值信息
-type DictionaryDetail struct {
+func (x *MenuAuthorityInfoResp) GetInheritedMenuIDs() []uint64 {
+	if x != nil {
+		return x.InheritedMenuIDs
+	}
+	return nil
+}
+
+// Create or update menu information request params | 创建或更新菜单信息参数
+type CreateOrUpdateMenuReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	ID        uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID" form:"ID" query:"ID"`
-	Title     string `protobuf:"bytes,2,opt,name=title,proto3" json:"title" form:"title" query:"title"`
-	Key       string `protobuf:"bytes,3,opt,name=key,proto3" json:"key" form:"key" query:"key"`
-	Value     string `protobuf:"bytes,4,opt,name=value,proto3" json:"value" form:"value" query:"value"`
-	Status    uint64 `protobuf:"varint,5,opt,name=status,proto3" json:"status" form:"status" query:"status"`
-	CreatedAt string `protobuf:"bytes,6,opt,name=createdAt,proto3" json:"createdAt" form:"createdAt" query:"createdAt"`
-	UpdatedAt string `protobuf:"bytes,7,opt,name=updatedAt,proto3" json:"updatedAt" form:"updatedAt" query:"updatedAt"`
-	ParentID  uint64 `protobuf:"varint,8,opt,name=parentID,proto3" json:"parentID" form:"parentID" query:"parentID"`
+	ParentID  uint64 `protobuf:"varint,2,opt,name=parentID,proto3" json:"parentID" form:"parentID" query:"parentID"`
+	Level     uint32 `protobuf:"varint,3,opt,name=level,proto3" json:"level" form:"level" query:"level"`
+	Path      string `protobuf:"bytes,4,opt,name=path,proto3" json:"path" form:"path" query:"path"`
+	Name      string `protobuf:"bytes,5,opt,name=name,proto3" json:"name" form:"name" query:"name"`
+	Redirect  string `protobuf:"bytes,6,opt,name=redirect,proto3" json:"redirect" form:"redirect" query:"redirect"`
+	Component string `protobuf:"bytes,7,opt,name=component,proto3" json:"component" form:"component" query:"component"`
+	OrderNo   uint32 `protobuf:"varint,8,opt,name=orderNo,proto3" json:"orderNo" form:"orderNo" query:"orderNo"`
+	Disabled  bool   `protobuf:"varint,9,opt,name=disabled,proto3" json:"disabled" form:"disabled" query:"disabled"`
+	MenuType  uint32 `protobuf:"varint,10,opt,name=menuType,proto3" json:"menuType" form:"menuType" query:"menuType"`
+	Meta      *Meta  `protobuf:"bytes,11,opt,name=meta,proto3" json:"meta" form:"meta" query:"meta"`
 }
 
-func (x *DictionaryDetail) Reset() {
-	*x = DictionaryDetail{}
+func (x *CreateOrUpdateMenuReq) Reset() {
+	*x = CreateOrUpdateMenuReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_proto_msgTypes[43]
+		mi := &file_admin_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DictionaryDetail) String() string {
+func (x *CreateOrUpdateMenuReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DictionaryDetail) ProtoMessage() {}
+func (*CreateOrUpdateMenuReq) ProtoMessage() {}
 
-func (x *DictionaryDetail) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_proto_msgTypes[43]
+func (x *CreateOrUpdateMenuReq) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3598,96 +3516,127 @@ func (x *DictionaryDetail) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DictionaryDetail.ProtoReflect.Descriptor instead.
-func (*DictionaryDetail) Descriptor() ([]byte, []int) {
-	return file_admin_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use CreateOrUpdateMenuReq.ProtoReflect.Descriptor instead.
+func (*CreateOrUpdateMenuReq) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *DictionaryDetail) GetID() uint64 {
+func (x *CreateOrUpdateMenuReq) GetID() uint64 {
 	if x != nil {
 		return x.ID
 	}
 	return 0
 }
 
-func (x *DictionaryDetail) GetTitle() string {
+func (x *CreateOrUpdateMenuReq) GetParentID() uint64 {
 	if x != nil {
-		return x.Title
+		return x.ParentID
 	}
-	return ""
+	return 0
 }
 
-func (x *DictionaryDetail) GetKey() string {
+func (x *CreateOrUpdateMenuReq) GetLevel() uint32 {
 	if x != nil {
-		return x.Key
+		return x.Level
 	}
-	return ""
+	return 0
 }
 
-func (x *DictionaryDetail) GetValue() string {
+func (x *CreateOrUpdateMenuReq) GetPath() string {
 	if x != nil {
-		return x.Value
+		return x.Path
 	}
 	return ""
 }
 
-func (x *DictionaryDetail) GetStatus() uint64 {
+func (x *CreateOrUpdateMenuReq) GetName() string {
 	if x != nil {
-		return x.Status
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *DictionaryDetail) GetCreatedAt() string {
+func (x *CreateOrUpdateMenuReq) GetRedirect() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.Redirect
 	}
 	return ""
 }
 
-func (x *DictionaryDetail) GetUpdatedAt() string {
+func (x *CreateOrUpdateMenuReq) GetComponent() string {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Component
 	}
 	return ""
 }
 
-func (x *DictionaryDetail) GetParentID() uint64 {
+func (x *CreateOrUpdateMenuReq) GetOrderNo() uint32 {
 	if x != nil {
-		return x.ParentID
+		return x.OrderNo
 	}
 	return 0
 }
 
-// The response data of dictionary KV information list | 字典键值列表数据
-type DictionaryDetailListResp struct {
+func (x *CreateOrUpdateMenuReq) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *CreateOrUpdateMenuReq) GetMenuType() uint32 {
+	if x != nil {
+		return x.MenuType
+	}
+	return 0
+}
+
+func (x *CreateOrUpdateMenuReq) GetMeta() *Meta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+// The response data of menu information | 菜单返回数据
+type MenuInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ErrCode base.ErrCode        `protobuf:"varint,1,opt,name=errCode,proto3,enum=base.ErrCode" json:"errCode" form:"errCode" query:"errCode"`
-	ErrMsg  string              `protobuf:"bytes,2,opt,name=errMsg,proto3" json:"errMsg" form:"errMsg" query:"errMsg"`
-	Total   uint64              `protobuf:"varint,3,opt,name=total,proto3" json:"total" form:"total" query:"total"`
-	Data    []*DictionaryDetail `protobuf:"bytes,4,rep,name=data,proto3" json:"data" form:"data" query:"data"`
+	ID        uint64      `protobuf:"varint,1,opt,name=ID,proto3" json:"ID" form:"ID" query:"ID"`
+	CreatedAt string      `protobuf:"bytes,2,opt,name=createdAt,proto3" json:"createdAt" form:"createdAt" query:"createdAt"`
+	UpdatedAt string      `protobuf:"bytes,3,opt,name=updatedAt,proto3" json:"updatedAt" form:"updatedAt" query:"updatedAt"`
+	Level     uint32      `protobuf:"varint,4,opt,name=level,proto3" json:"level" form:"level" query:"level"`
+	ParentID  uint64      `protobuf:"varint,5,opt,name=parentID,proto3" json:"parentID" form:"parentID" query:"parentID"`
+	Path      string      `protobuf:"bytes,6,opt,name=path,proto3" json:"path" form:"path" query:"path"`
+	Name      string      `protobuf:"bytes,7,opt,name=name,proto3" json:"name" form:"name" query:"name"`
+	Redirect  string      `protobuf:"bytes,8,opt,name=redirect,proto3" json:"redirect" form:"redirect" query:"redirect"`
+	Component string      `protobuf:"bytes,9,opt,name=component,proto3" json:"component" form:"component" query:"component"`
+	OrderNo   uint32      `protobuf:"varint,10,opt,name=orderNo,proto3" json:"orderNo" form:"orderNo" query:"orderNo"`
+	Disabled  bool        `protobuf:"varint,11,opt,name=disabled,proto3" json:"disabled" form:"disabled" query:"disabled"`
+	MenuType  uint32      `protobuf:"varint,12,opt,name=menuType,proto3" json:"menuType" form:"menuType" query:"menuType"`
+	Children  []*MenuInfo `protobuf:"bytes,13,rep,name=children,proto3" json:"children" form:"children" query:"children"`
+	Meta      *Meta       `protobuf:"bytes,14,opt,name=meta,proto3" json:"meta" form:"meta" query:"meta"`
 }
 
-func (x *DictionaryDetailListResp) Reset() {
-	*x = DictionaryDetailListResp{}
+func (x *MenuInfo) Reset() {
+	*x = MenuInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_proto_msgTypes[44]
+		mi := &file_admin_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DictionaryDetailListResp) String() string {
+func (x *MenuInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DictionaryDetailListResp) ProtoMessage() {}
+func (*MenuInfo) ProtoMessage() {}
 
-func (x *DictionaryDetailListResp) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_proto_msgTypes[44]
+func (x *MenuInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3698,116 +3647,147 @@ func (x *DictionaryDetailListResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DictionaryDetailListResp.ProtoReflect.Descriptor instead.
-func (*DictionaryDetailListResp) Descriptor() ([]byte, []int) {
-	return file_admin_proto_rawDescGZIP(), []int{44}
+// Deprecated: Use MenuInfo.ProtoReflect.Descriptor instead.
+func (*MenuInfo) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *DictionaryDetailListResp) GetErrCode() base.ErrCode {
+func (x *MenuInfo) GetID() uint64 {
 	if x != nil {
-		return x.ErrCode
+		return x.ID
 	}
-	return base.ErrCode(0)
+	return 0
 }
 
-func (x *DictionaryDetailListResp) GetErrMsg() string {
+func (x *MenuInfo) GetCreatedAt() string {
 	if x != nil {
-		return x.ErrMsg
+		return x.CreatedAt
 	}
 	return ""
 }
 
-func (x *DictionaryDetailListResp) GetTotal() uint64 {
+func (x *MenuInfo) GetUpdatedAt() string {
 	if x != nil {
-		return x.Total
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *MenuInfo) GetLevel() uint32 {
+	if x != nil {
+		return x.Level
 	}
 	return 0
 }
 
-func (x *DictionaryDetailListResp) GetData() []*DictionaryDetail {
+func (x *MenuInfo) GetParentID() uint64 {
 	if x != nil {
-		return x.Data
+		return x.ParentID
 	}
-	return nil
+	return 0
 }
 
-// The request data of dictionary KV information | 字典名获取字典键值请求数据
-type DictionaryDetailReq struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *MenuInfo) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name" form:"name" query:"name"`
+func (x *MenuInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
 }
 
-func (x *DictionaryDetailReq) Reset() {
-	*x = DictionaryDetailReq{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_proto_msgTypes[45]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *MenuInfo) GetRedirect() string {
+	if x != nil {
+		return x.Redirect
 	}
+	return ""
 }
 
-func (x *DictionaryDetailReq) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *MenuInfo) GetComponent() string {
+	if x != nil {
+		return x.Component
+	}
+	return ""
 }
 
-func (*DictionaryDetailReq) ProtoMessage() {}
+func (x *MenuInfo) GetOrderNo() uint32 {
+	if x != nil {
+		return x.OrderNo
+	}
+	return 0
+}
 
-func (x *DictionaryDetailReq) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_proto_msgTypes[45]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *MenuInfo) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
 	}
-	return mi.MessageOf(x)
+	return false
 }
 
-// Deprecated: Use DictionaryDetailReq.ProtoReflect.Descriptor instead.
-func (*DictionaryDetailReq) Descriptor() ([]byte, []int) {
-	return file_admin_proto_rawDescGZIP(), []int{45}
+func (x *MenuInfo) GetMenuType() uint32 {
+	if x != nil {
+		return x.MenuType
+	}
+	return 0
 }
 
-func (x *DictionaryDetailReq) GetName() string {
+func (x *MenuInfo) GetChildren() []*MenuInfo {
 	if x != nil {
-		return x.Name
+		return x.Children
 	}
-	return ""
+	return nil
 }
 
-// The request data of dictionary list | 字典列表请求数据
-type DictionaryPageReq struct {
+func (x *MenuInfo) GetMeta() *Meta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+// The meta data of menu | 菜单的meta数据
+type Meta struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Title    string `protobuf:"bytes,1,opt,name=title,proto3" json:"title" form:"title" query:"title"`
-	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name" form:"name" query:"name"`
-	Page     uint64 `protobuf:"varint,3,opt,name=page,proto3" json:"page" form:"page" query:"page"`
-	PageSize uint64 `protobuf:"varint,4,opt,name=pageSize,proto3" json:"pageSize" form:"pageSize" query:"pageSize"`
+	Title              string `protobuf:"bytes,1,opt,name=title,proto3" json:"title" form:"title" query:"title"`
+	Icon               string `protobuf:"bytes,2,opt,name=icon,proto3" json:"icon" form:"icon" query:"icon"`
+	HideMenu           bool   `protobuf:"varint,3,opt,name=hideMenu,proto3" json:"hideMenu" form:"hideMenu" query:"hideMenu"`
+	HideBreadcrumb     bool   `protobuf:"varint,4,opt,name=hideBreadcrumb,proto3" json:"hideBreadcrumb" form:"hideBreadcrumb" query:"hideBreadcrumb"`
+	CurrentActiveMenu  string `protobuf:"bytes,5,opt,name=currentActiveMenu,proto3" json:"currentActiveMenu" form:"currentActiveMenu" query:"currentActiveMenu"`
+	IgnoreKeepAlive    bool   `protobuf:"varint,6,opt,name=ignoreKeepAlive,proto3" json:"ignoreKeepAlive" form:"ignoreKeepAlive" query:"ignoreKeepAlive"`
+	HideTab            bool   `protobuf:"varint,7,opt,name=hideTab,proto3" json:"hideTab" form:"hideTab" query:"hideTab"`
+	FrameSrc           string `protobuf:"bytes,8,opt,name=frameSrc,proto3" json:"frameSrc" form:"frameSrc" query:"frameSrc"`
+	CarryParam         bool   `protobuf:"varint,9,opt,name=carryParam,proto3" json:"carryParam" form:"carryParam" query:"carryParam"`
+	HideChildrenInMenu bool   `protobuf:"varint,10,opt,name=hideChildrenInMenu,proto3" json:"hideChildrenInMenu" form:"hideChildrenInMenu" query:"hideChildrenInMenu"`
+	Affix              bool   `protobuf:"varint,11,opt,name=affix,proto3" json:"affix" form:"affix" query:"affix"`
+	DynamicLevel       uint32 `protobuf:"varint,12,opt,name=dynamicLevel,proto3" json:"dynamicLevel" form:"dynamicLevel" query:"dynamicLevel"`
+	RealPath           string `protobuf:"bytes,13,opt,name=realPath,proto3" json:"realPath" form:"realPath" query:"realPath"`
 }
 
-func (x *DictionaryPageReq) Reset() {
-	*x = DictionaryPageReq{}
+func (x *Meta) Reset() {
+	*x = Meta{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_proto_msgTypes[46]
+		mi := &file_admin_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DictionaryPageReq) String() string {
+func (x *Meta) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DictionaryPageReq) ProtoMessage() {}
+func (*Meta) ProtoMessage() {}
 
-func (x *DictionaryPageReq) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_proto_msgTypes[46]
+func (x *Meta) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3818,133 +3798,131 @@ func (x *DictionaryPageReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DictionaryPageReq.ProtoReflect.Descriptor instead.
-func (*DictionaryPageReq) Descriptor() ([]byte, []int) {
-	return file_admin_proto_rawDescGZIP(), []int{46}
+// Deprecated: Use Meta.ProtoReflect.Descriptor instead.
+func (*Meta) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *DictionaryPageReq) GetTitle() string {
+func (x *Meta) GetTitle() string {
 	if x != nil {
 		return x.Title
 	}
 	return ""
 }
 
-func (x *DictionaryPageReq) GetName() string {
+func (x *Meta) GetIcon() string {
 	if x != nil {
-		return x.Name
+		return x.Icon
 	}
 	return ""
 }
 
-func (x *DictionaryPageReq) GetPage() uint64 {
+func (x *Meta) GetHideMenu() bool {
 	if x != nil {
-		return x.Page
+		return x.HideMenu
 	}
-	return 0
+	return false
 }
 
-func (x *DictionaryPageReq) GetPageSize() uint64 {
+func (x *Meta) GetHideBreadcrumb() bool {
 	if x != nil {
-		return x.PageSize
+		return x.HideBreadcrumb
 	}
-	return 0
+	return false
 }
 
-// Oauth log in request | Oauth 登录请求
-type OauthLoginReq struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// format: random string-provider name | 格式：随机字符串-供应商名称
-	State    string `protobuf:"bytes,1,opt,name=state,proto3" json:"state" form:"state" query:"state"`
-	Provider string `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider" form:"provider" query:"provider"`
-	// Login type for wecom: QRCode, Inside, Quick | 企业微信登录类型：二维码，应用内部，快速 （其他应用的OAuth不需要传）
-	LoginType string `protobuf:"bytes,3,opt,name=LoginType,proto3" json:"LoginType" form:"LoginType" query:"LoginType"`
+func (x *Meta) GetCurrentActiveMenu() string {
+	if x != nil {
+		return x.CurrentActiveMenu
+	}
+	return ""
 }
 
-func (x *OauthLoginReq) Reset() {
-	*x = OauthLoginReq{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_proto_msgTypes[47]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Meta) GetIgnoreKeepAlive() bool {
+	if x != nil {
+		return x.IgnoreKeepAlive
 	}
+	return false
 }
 
-func (x *OauthLoginReq) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Meta) GetHideTab() bool {
+	if x != nil {
+		return x.HideTab
+	}
+	return false
 }
 
-func (*OauthLoginReq) ProtoMessage() {}
+func (x *Meta) GetFrameSrc() string {
+	if x != nil {
+		return x.FrameSrc
+	}
+	return ""
+}
 
-func (x *OauthLoginReq) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_proto_msgTypes[47]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Meta) GetCarryParam() bool {
+	if x != nil {
+		return x.CarryParam
 	}
-	return mi.MessageOf(x)
+	return false
 }
 
-// Deprecated: Use OauthLoginReq.ProtoReflect.Descriptor instead.
-func (*OauthLoginReq) Descriptor() ([]byte, []int) {
-	return file_admin_proto_rawDescGZIP(), []int{47}
+func (x *Meta) GetHideChildrenInMenu() bool {
+	if x != nil {
+		return x.HideChildrenInMenu
+	}
+	return false
 }
 
-func (x *OauthLoginReq) GetState() string {
+func (x *Meta) GetAffix() bool {
 	if x != nil {
-		return x.State
+		return x.Affix
 	}
-	return ""
+	return false
 }
 
-func (x *OauthLoginReq) GetProvider() string {
+func (x *Meta) GetDynamicLevel() uint32 {
 	if x != nil {
-		return x.Provider
+		return x.DynamicLevel
 	}
-	return ""
+	return 0
 }
 
-func (x *OauthLoginReq) GetLoginType() string {
+func (x *Meta) GetRealPath() string {
 	if x != nil {
-		return x.LoginType
+		return x.RealPath
 	}
 	return ""
 }
 
-// Redirect information | 跳转网址
-type OauthRedirectResp struct {
+// The request data of menu list | 菜单列表请求数据
+type MenuListReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ErrCode base.ErrCode `protobuf:"varint,1,opt,name=errCode,proto3,enum=base.ErrCode" json:"errCode" form:"errCode" query:"errCode"`
-	ErrMsg  string       `protobuf:"bytes,2,opt,name=errMsg,proto3" json:"errMsg" form:"errMsg" query:"errMsg"`
-	Url     string       `protobuf:"bytes,3,opt,name=url,proto3" json:"url" form:"url" query:"url"`
+	Page     uint64 `protobuf:"varint,1,opt,name=page,proto3" json:"page" form:"page" query:"page"`
+	PageSize uint64 `protobuf:"varint,2,opt,name=pageSize,proto3" json:"pageSize" form:"pageSize" query:"pageSize"`
+	Name     string `protobuf:"bytes,3,opt,name=name,proto3" json:"name" form:"name" query:"name"`
+	Title    string `protobuf:"bytes,4,opt,name=title,proto3" json:"title" form:"title" query:"title"`
 }
 
-func (x *OauthRedirectResp) Reset() {
-	*x = OauthRedirectResp{}
+func (x *MenuListReq) Reset() {
+	*x = MenuListReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_proto_msgTypes[48]
+		mi := &file_admin_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *OauthRedirectResp) String() string {
+func (x *MenuListReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OauthRedirectResp) ProtoMessage() {}
+func (*MenuListReq) ProtoMessage() {}
 
-func (x *OauthRedirectResp) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_proto_msgTypes[48]
+func (x *MenuListReq) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3955,69 +3933,68 @@ func (x *OauthRedirectResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OauthRedirectResp.ProtoReflect.Descriptor instead.
-func (*OauthRedirectResp) Descriptor() ([]byte, []int) {
-	return file_admin_proto_rawDescGZIP(), []int{48}
+// Deprecated: Use MenuListReq.ProtoReflect.Descriptor instead.
+func (*MenuListReq) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *OauthRedirectResp) GetErrCode() base.ErrCode {
+func (x *MenuListReq) GetPage() uint64 {
 	if x != nil {
-		return x.ErrCode
+		return x.Page
 	}
-	return base.ErrCode(0)
+	return 0
 }
 
-func (x *OauthRedirectResp) GetErrMsg() string {
+func (x *MenuListReq) GetPageSize() uint64 {
 	if x != nil {
-		return x.ErrMsg
+		return x.PageSize
 	}
-	return ""
+	return 0
 }
 
-func (x *OauthRedirectResp) GetUrl() string {
+func (x *MenuListReq) GetName() string {
 	if x != nil {
-		return x.Url
+		return x.Name
 	}
 	return ""
 }
 
-// The response data of oauth provider information | 提供者信息
-type ProviderInfo struct {
+func (x *MenuListReq) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+// The data of menu list | 菜单列表数据
+type MenuInfoListResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id           uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id" form:"id" query:"id"`
-	Name         string `protobuf:"bytes,2,opt,name=name,proto3" json:"name" form:"name" query:"name"`
-	ClientID     string `protobuf:"bytes,3,opt,name=clientID,proto3" json:"clientID" form:"clientID" query:"clientID"`
-	ClientSecret string `protobuf:"bytes,4,opt,name=clientSecret,proto3" json:"clientSecret" form:"clientSecret" query:"clientSecret"`
-	RedirectUrl  string `protobuf:"bytes,5,opt,name=redirectUrl,proto3" json:"redirectUrl" form:"redirectUrl" query:"redirectUrl"`
-	Scopes       string `protobuf:"bytes,6,opt,name=scopes,proto3" json:"scopes" form:"scopes" query:"scopes"`
-	AuthUrl      string `protobuf:"bytes,7,opt,name=authUrl,proto3" json:"authUrl" form:"authUrl" query:"authUrl"`
-	TokenUrl     string `protobuf:"bytes,8,opt,name=tokenUrl,proto3" json:"tokenUrl" form:"tokenUrl" query:"tokenUrl"`
-	AuthStyle    uint64 `protobuf:"varint,9,opt,name=authStyle,proto3" json:"authStyle" form:"authStyle" query:"authStyle"`
-	InfoUrl      string `protobuf:"bytes,10,opt,name=infoUrl,proto3" json:"infoUrl" form:"infoUrl" query:"infoUrl"`
-	CreatedAt    string `protobuf:"bytes,11,opt,name=createdAt,proto3" json:"createdAt" form:"createdAt" query:"createdAt"`
-	UpdatedAt    string `protobuf:"bytes,12,opt,name=updatedAt,proto3" json:"updatedAt" form:"updatedAt" query:"updatedAt"`
+	ErrCode base.ErrCode `protobuf:"varint,1,opt,name=errCode,proto3,enum=base.ErrCode" json:"errCode" form:"errCode" query:"errCode"`
+	ErrMsg  string       `protobuf:"bytes,2,opt,name=errMsg,proto3" json:"errMsg" form:"errMsg" query:"errMsg"`
+	Total   uint64       `protobuf:"varint,3,opt,name=total,proto3" json:"total" form:"total" query:"total"`
+	Data    []*MenuInfo  `protobuf:"bytes,4,rep,name=data,proto3" json:"data" form:"data" query:"data"`
 }
 
-func (x *ProviderInfo) Reset() {
-	*x = ProviderInfo{}
+func (x *MenuInfoListResp) Reset() {
+	*x = MenuInfoListResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_proto_msgTypes[49]
+		mi := &file_admin_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ProviderInfo) String() string {
+func (x *MenuInfoListResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProviderInfo) ProtoMessage() {}
+func (*MenuInfoListResp) ProtoMessage() {}
 
-func (x *ProviderInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_proto_msgTypes[49]
+func (x *MenuInfoListResp) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4028,187 +4005,198 @@ func (x *ProviderInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProviderInfo.ProtoReflect.Descriptor instead.
-func (*ProviderInfo) Descriptor() ([]byte, []int) {
-	return file_admin_proto_rawDescGZIP(), []int{49}
+// Deprecated: Use MenuInfoListResp.ProtoReflect.Descriptor instead.
+func (*MenuInfoListResp) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *ProviderInfo) GetId() uint64 {
+func (x *MenuInfoListResp) GetErrCode() base.ErrCode {
 	if x != nil {
-		return x.Id
+		return x.ErrCode
 	}
-	return 0
+	return base.ErrCode(0)
 }
 
-func (x *ProviderInfo) GetName() string {
+func (x *MenuInfoListResp) GetErrMsg() string {
 	if x != nil {
-		return x.Name
+		return x.ErrMsg
 	}
 	return ""
 }
 
-func (x *ProviderInfo) GetClientID() string {
+func (x *MenuInfoListResp) GetTotal() uint64 {
 	if x != nil {
-		return x.ClientID
+		return x.Total
 	}
-	return ""
+	return 0
 }
 
-func (x *ProviderInfo) GetClientSecret() string {
+func (x *MenuInfoListResp) GetData() []*MenuInfo {
 	if x != nil {
-		return x.ClientSecret
+		return x.Data
 	}
-	return ""
+	return nil
 }
 
-func (x *ProviderInfo) GetRedirectUrl() string {
-	if x != nil {
-		return x.RedirectUrl
-	}
-	return ""
+// The response data of role menu list data | 角色菜单列表数据
+type MenuListBase struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ParentID  uint32          `protobuf:"varint,1,opt,name=parentID,proto3" json:"parentID" form:"parentID" query:"parentID"`
+	Level     uint32          `protobuf:"varint,2,opt,name=level,proto3" json:"level" form:"level" query:"level"`
+	Path      string          `protobuf:"bytes,3,opt,name=path,proto3" json:"path" form:"path" query:"path"`
+	Name      string          `protobuf:"bytes,4,opt,name=name,proto3" json:"name" form:"name" query:"name"`
+	Redirect  string          `protobuf:"bytes,5,opt,name=redirect,proto3" json:"redirect" form:"redirect" query:"redirect"`
+	Component string          `protobuf:"bytes,6,opt,name=component,proto3" json:"component" form:"component" query:"component"`
+	OrderNo   uint32          `protobuf:"varint,7,opt,name=orderNo,proto3" json:"orderNo" form:"orderNo" query:"orderNo"`
+	Disabled  bool            `protobuf:"varint,8,opt,name=disabled,proto3" json:"disabled" form:"disabled" query:"disabled"`
+	Children  []*MenuListBase `protobuf:"bytes,9,rep,name=children,proto3" json:"children" form:"children" query:"children"`
+	Meta      *Meta           `protobuf:"bytes,10,opt,name=meta,proto3" json:"meta" form:"meta" query:"meta"`
 }
 
-func (x *ProviderInfo) GetScopes() string {
-	if x != nil {
-		return x.Scopes
+func (x *MenuListBase) Reset() {
+	*x = MenuListBase{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *ProviderInfo) GetAuthUrl() string {
-	if x != nil {
-		return x.AuthUrl
+func (x *MenuListBase) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MenuListBase) ProtoMessage() {}
+
+func (x *MenuListBase) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *ProviderInfo) GetTokenUrl() string {
+// Deprecated: Use MenuListBase.ProtoReflect.Descriptor instead.
+func (*MenuListBase) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *MenuListBase) GetParentID() uint32 {
 	if x != nil {
-		return x.TokenUrl
+		return x.ParentID
 	}
-	return ""
+	return 0
 }
 
-func (x *ProviderInfo) GetAuthStyle() uint64 {
+func (x *MenuListBase) GetLevel() uint32 {
 	if x != nil {
-		return x.AuthStyle
+		return x.Level
 	}
 	return 0
 }
 
-func (x *ProviderInfo) GetInfoUrl() string {
+func (x *MenuListBase) GetPath() string {
 	if x != nil {
-		return x.InfoUrl
+		return x.Path
 	}
 	return ""
 }
 
-func (x *ProviderInfo) GetCreatedAt() string {
+func (x *MenuListBase) GetName() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.Name
 	}
 	return ""
 }
 
-func (x *ProviderInfo) GetUpdatedAt() string {
+func (x *MenuListBase) GetRedirect() string {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Redirect
 	}
 	return ""
 }
 
-type ProviderListReq struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Page     uint64 `protobuf:"varint,1,opt,name=page,proto3" json:"page" form:"page" query:"page"`
-	PageSize uint64 `protobuf:"varint,2,opt,name=pageSize,proto3" json:"pageSize" form:"pageSize" query:"pageSize"`
-	Name     string `protobuf:"bytes,3,opt,name=name,proto3" json:"name" form:"name" query:"name"`
-}
-
-func (x *ProviderListReq) Reset() {
-	*x = ProviderListReq{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_proto_msgTypes[50]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *MenuListBase) GetComponent() string {
+	if x != nil {
+		return x.Component
 	}
+	return ""
 }
 
-func (x *ProviderListReq) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ProviderListReq) ProtoMessage() {}
-
-func (x *ProviderListReq) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_proto_msgTypes[50]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *MenuListBase) GetOrderNo() uint32 {
+	if x != nil {
+		return x.OrderNo
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ProviderListReq.ProtoReflect.Descriptor instead.
-func (*ProviderListReq) Descriptor() ([]byte, []int) {
-	return file_admin_proto_rawDescGZIP(), []int{50}
+	return 0
 }
 
-func (x *ProviderListReq) GetPage() uint64 {
+func (x *MenuListBase) GetDisabled() bool {
 	if x != nil {
-		return x.Page
+		return x.Disabled
 	}
-	return 0
+	return false
 }
 
-func (x *ProviderListReq) GetPageSize() uint64 {
+func (x *MenuListBase) GetChildren() []*MenuListBase {
 	if x != nil {
-		return x.PageSize
+		return x.Children
 	}
-	return 0
+	return nil
 }
 
-func (x *ProviderListReq) GetName() string {
+func (x *MenuListBase) GetMeta() *Meta {
 	if x != nil {
-		return x.Name
+		return x.Meta
 	}
-	return ""
+	return nil
 }
 
-// The response data of provider list | 提供商列表返回数据
-type ProviderListResp struct {
+// Create or update role information params | 创建或更新角色信息参数
+type RoleInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ErrCode base.ErrCode    `protobuf:"varint,1,opt,name=errCode,proto3,enum=base.ErrCode" json:"errCode" form:"errCode" query:"errCode"`
-	ErrMsg  string          `protobuf:"bytes,2,opt,name=errMsg,proto3" json:"errMsg" form:"errMsg" query:"errMsg"`
-	Total   uint64          `protobuf:"varint,3,opt,name=total,proto3" json:"total" form:"total" query:"total"`
-	Data    []*ProviderInfo `protobuf:"bytes,4,rep,name=data,proto3" json:"data" form:"data" query:"data"`
+	ID             uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID" form:"ID" query:"ID"`
+	Name           string `protobuf:"bytes,2,opt,name=name,proto3" json:"name" form:"name" query:"name"`
+	Value          string `protobuf:"bytes,3,opt,name=value,proto3" json:"value" form:"value" query:"value"`
+	DefaultRouter  string `protobuf:"bytes,4,opt,name=defaultRouter,proto3" json:"defaultRouter" form:"defaultRouter" query:"defaultRouter"`
+	Status         uint64 `protobuf:"varint,5,opt,name=status,proto3" json:"status" form:"status" query:"status"`
+	Remark         string `protobuf:"bytes,6,opt,name=remark,proto3" json:"remark" form:"remark" query:"remark"`
+	OrderNo        uint32 `protobuf:"varint,7,opt,name=orderNo,proto3" json:"orderNo" form:"orderNo" query:"orderNo"`
+	CreatedAt      string `protobuf:"bytes,8,opt,name=createdAt,proto3" json:"createdAt" form:"createdAt" query:"createdAt"`
+	UpdatedAt      string `protobuf:"bytes,9,opt,name=updatedAt,proto3" json:"updatedAt" form:"updatedAt" query:"updatedAt"`
+	ForceTwoFactor bool   `protobuf:"varint,10,opt,name=forceTwoFactor,proto3" json:"forceTwoFactor" form:"forceTwoFactor" query:"forceTwoFactor"`
+	// the role inherits the api and menu permissions of the parent, 0 if none | 父角色, 继承其接口与菜单权限, 无则为0
+	ParentID uint64 `protobuf:"varint,11,opt,name=parentID,proto3" json:"parentID" form:"parentID" query:"parentID"`
+	// child roles, only in the role tree | 子角色, 仅角色树返回
+	Children []*RoleInfo `protobuf:"bytes,12,rep,name=children,proto3" json:"children" form:"children" query:"children"`
 }
 
-func (x *ProviderListResp) Reset() {
-	*x = ProviderListResp{}
+func (x *RoleInfo) Reset() {
+	*x = RoleInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_proto_msgTypes[51]
+		mi := &file_admin_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ProviderListResp) String() string {
+func (x *RoleInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProviderListResp) ProtoMessage() {}
+func (*RoleInfo) ProtoMessage() {}
 
-func (x *ProviderListResp) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_proto_msgTypes[51]
+func (x *RoleInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4219,132 +4207,133 @@ func (x *ProviderListResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProviderListResp.ProtoReflect.Descriptor instead.
-func (*ProviderListResp) Descriptor() ([]byte, []int) {
-	return file_admin_proto_rawDescGZIP(), []int{51}
+// Deprecated: Use RoleInfo.ProtoReflect.Descriptor instead.
+func (*RoleInfo) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *ProviderListResp) GetErrCode() base.ErrCode {
+func (x *RoleInfo) GetID() uint64 {
 	if x != nil {
-		return x.ErrCode
+		return x.ID
 	}
-	return base.ErrCode(0)
+	return 0
 }
 
-func (x *ProviderListResp) GetErrMsg() string {
+func (x *RoleInfo) GetName() string {
 	if x != nil {
-		return x.ErrMsg
+		return x.Name
 	}
 	return ""
 }
 
-func (x *ProviderListResp) GetTotal() uint64 {
+func (x *RoleInfo) GetValue() string {
 	if x != nil {
-		return x.Total
+		return x.Value
 	}
-	return 0
+	return ""
 }
 
-func (x *ProviderListResp) GetData() []*ProviderInfo {
+func (x *RoleInfo) GetDefaultRouter() string {
 	if x != nil {
-		return x.Data
+		return x.DefaultRouter
 	}
-	return nil
+	return ""
 }
 
-// Oauth login callback request | Oauth 登录回调请求
-type CallbackReq struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	State string `protobuf:"bytes,1,opt,name=state,proto3" json:"state" form:"state" query:"state"`
-	Code  string `protobuf:"bytes,2,opt,name=code,proto3" json:"code" form:"code" query:"code"`
+func (x *RoleInfo) GetStatus() uint64 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
 }
 
-func (x *CallbackReq) Reset() {
-	*x = CallbackReq{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_proto_msgTypes[52]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *RoleInfo) GetRemark() string {
+	if x != nil {
+		return x.Remark
 	}
+	return ""
 }
 
-func (x *CallbackReq) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *RoleInfo) GetOrderNo() uint32 {
+	if x != nil {
+		return x.OrderNo
+	}
+	return 0
 }
 
-func (*CallbackReq) ProtoMessage() {}
+func (x *RoleInfo) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
 
-func (x *CallbackReq) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_proto_msgTypes[52]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *RoleInfo) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use CallbackReq.ProtoReflect.Descriptor instead.
-func (*CallbackReq) Descriptor() ([]byte, []int) {
-	return file_admin_proto_rawDescGZIP(), []int{52}
+func (x *RoleInfo) GetForceTwoFactor() bool {
+	if x != nil {
+		return x.ForceTwoFactor
+	}
+	return false
 }
 
-func (x *CallbackReq) GetState() string {
+func (x *RoleInfo) GetParentID() uint64 {
 	if x != nil {
-		return x.State
+		return x.ParentID
 	}
-	return ""
+	return 0
 }
 
-func (x *CallbackReq) GetCode() string {
+func (x *RoleInfo) GetChildren() []*RoleInfo {
 	if x != nil {
-		return x.Code
+		return x.Children
 	}
-	return ""
+	return nil
 }
 
-// The response data of logs | 日志信息
-type LogsInfo struct {
+// The response data of role info | 角色信息返回数据
+type RoleInfoResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Type        string `protobuf:"bytes,1,opt,name=type,proto3" json:"type" form:"type" query:"type"`
-	Method      string `protobuf:"bytes,2,opt,name=method,proto3" json:"method" form:"method" query:"method"`
-	Api         string `protobuf:"bytes,3,opt,name=api,proto3" json:"api" form:"api" query:"api"`
-	Success     bool   `protobuf:"varint,4,opt,name=success,proto3" json:"success" form:"success" query:"success"`
-	ReqContent  string `protobuf:"bytes,5,opt,name=reqContent,proto3" json:"reqContent" form:"reqContent" query:"reqContent"`
-	RespContent string `protobuf:"bytes,6,opt,name=respContent,proto3" json:"respContent" form:"respContent" query:"respContent"`
-	Ip          string `protobuf:"bytes,7,opt,name=ip,proto3" json:"ip" form:"ip" query:"ip"`
-	UserAgent   string `protobuf:"bytes,8,opt,name=userAgent,proto3" json:"userAgent" form:"userAgent" query:"userAgent"`
-	Operator    string `protobuf:"bytes,9,opt,name=operator,proto3" json:"operator" form:"operator" query:"operator"`
-	Time        uint32 `protobuf:"varint,10,opt,name=time,proto3" json:"time" form:"time" query:"time"`
-	CreatedAt   string `protobuf:"bytes,11,opt,name=createdAt,proto3" json:"createdAt" form:"createdAt" query:"createdAt"`
-	UpdatedAt   string `protobuf:"bytes,12,opt,name=updatedAt,proto3" json:"updatedAt" form:"updatedAt" query:"updatedAt"`
+	ErrCode        base.ErrCode `protobuf:"varint,1,opt,name=errCode,proto3,enum=base.ErrCode" json:"errCode" form:"errCode" query:"errCode"`
+	ErrMsg         string       `protobuf:"bytes,2,opt,name=errMsg,proto3" json:"errMsg" form:"errMsg" query:"errMsg"`
+	ID             uint64       `protobuf:"varint,3,opt,name=ID,proto3" json:"ID" form:"ID" query:"ID"`
+	Name           string       `protobuf:"bytes,4,opt,name=name,proto3" json:"name" form:"name" query:"name"`
+	Value          string       `protobuf:"bytes,5,opt,name=value,proto3" json:"value" form:"value" query:"value"`
+	DefaultRouter  string       `protobuf:"bytes,6,opt,name=defaultRouter,proto3" json:"defaultRouter" form:"defaultRouter" query:"defaultRouter"`
+	Status         uint64       `protobuf:"varint,7,opt,name=status,proto3" json:"status" form:"status" query:"status"`
+	Remark         string       `protobuf:"bytes,8,opt,name=remark,proto3" json:"remark" form:"remark" query:"remark"`
+	OrderNo        uint32       `protobuf:"varint,9,opt,name=orderNo,proto3" json:"orderNo" form:"orderNo" query:"orderNo"`
+	CreatedAt      string       `protobuf:"bytes,10,opt,name=createdAt,proto3" json:"createdAt" form:"createdAt" query:"createdAt"`
+	UpdatedAt      string       `protobuf:"bytes,11,opt,name=updatedAt,proto3" json:"updatedAt" form:"updatedAt" query:"updatedAt"`
+	ForceTwoFactor bool         `protobuf:"varint,12,opt,name=forceTwoFactor,proto3" json:"forceTwoFactor" form:"forceTwoFactor" query:"forceTwoFactor"`
+	ParentID       uint64       `protobuf:"varint,13,opt,name=parentID,proto3" json:"parentID" form:"parentID" query:"parentID"`
 }
 
-func (x *LogsInfo) Reset() {
-	*x = LogsInfo{}
+func (x *RoleInfoResp) Reset() {
+	*x = RoleInfoResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_proto_msgTypes[53]
+		mi := &file_admin_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LogsInfo) String() string {
+func (x *RoleInfoResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LogsInfo) ProtoMessage() {}
+func (*RoleInfoResp) ProtoMessage() {}
 
-func (x *LogsInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_proto_msgTypes[53]
+func (x *RoleInfoResp) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4355,127 +4344,131 @@ func (x *LogsInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LogsInfo.ProtoReflect.Descriptor instead.
-func (*LogsInfo) Descriptor() ([]byte, []int) {
-	return file_admin_proto_rawDescGZIP(), []int{53}
-}
-
-func (x *LogsInfo) GetType() string {
-	if x != nil {
-		return x.Type
-	}
-	return ""
+// Deprecated: Use RoleInfoResp.ProtoReflect.Descriptor instead.
+func (*RoleInfoResp) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *LogsInfo) GetMethod() string {
+func (x *RoleInfoResp) GetErrCode() base.ErrCode {
 	if x != nil {
-		return x.Method
+		return x.ErrCode
 	}
-	return ""
+	return base.ErrCode(0)
 }
 
-func (x *LogsInfo) GetApi() string {
+func (x *RoleInfoResp) GetErrMsg() string {
 	if x != nil {
-		return x.Api
+		return x.ErrMsg
 	}
 	return ""
 }
 
-func (x *LogsInfo) GetSuccess() bool {
+func (x *RoleInfoResp) GetID() uint64 {
 	if x != nil {
-		return x.Success
+		return x.ID
 	}
-	return false
+	return 0
 }
 
-func (x *LogsInfo) GetReqContent() string {
+func (x *RoleInfoResp) GetName() string {
 	if x != nil {
-		return x.ReqContent
+		return x.Name
 	}
 	return ""
 }
 
-func (x *LogsInfo) GetRespContent() string {
+func (x *RoleInfoResp) GetValue() string {
 	if x != nil {
-		return x.RespContent
+		return x.Value
 	}
 	return ""
 }
 
-func (x *LogsInfo) GetIp() string {
+func (x *RoleInfoResp) GetDefaultRouter() string {
 	if x != nil {
-		return x.Ip
+		return x.DefaultRouter
 	}
 	return ""
 }
 
-func (x *LogsInfo) GetUserAgent() string {
+func (x *RoleInfoResp) GetStatus() uint64 {
 	if x != nil {
-		return x.UserAgent
+		return x.Status
 	}
-	return ""
+	return 0
 }
 
-func (x *LogsInfo) GetOperator() string {
+func (x *RoleInfoResp) GetRemark() string {
 	if x != nil {
-		return x.Operator
+		return x.Remark
 	}
 	return ""
 }
 
-func (x *LogsInfo) GetTime() uint32 {
+func (x *RoleInfoResp) GetOrderNo() uint32 {
 	if x != nil {
-		return x.Time
+		return x.OrderNo
 	}
 	return 0
 }
 
-func (x *LogsInfo) GetCreatedAt() string {
+func (x *RoleInfoResp) GetCreatedAt() string {
 	if x != nil {
 		return x.CreatedAt
 	}
 	return ""
 }
 
-func (x *LogsInfo) GetUpdatedAt() string {
+func (x *RoleInfoResp) GetUpdatedAt() string {
 	if x != nil {
 		return x.UpdatedAt
 	}
 	return ""
 }
 
-// The request data of logs list | 日志列表请求数据
-type LogsListReq struct {
+func (x *RoleInfoResp) GetForceTwoFactor() bool {
+	if x != nil {
+		return x.ForceTwoFactor
+	}
+	return false
+}
+
+func (x *RoleInfoResp) GetParentID() uint64 {
+	if x != nil {
+		return x.ParentID
+	}
+	return 0
+}
+
+// The request data of role list | 角色列表请求数据
+type RoleListReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Page     uint64 `protobuf:"varint,1,opt,name=page,proto3" json:"page" form:"page" query:"page"`
 	PageSize uint64 `protobuf:"varint,2,opt,name=pageSize,proto3" json:"pageSize" form:"pageSize" query:"pageSize"`
-	Type     string `protobuf:"bytes,3,opt,name=type,proto3" json:"type" form:"type" query:"type"`
-	Method   string `protobuf:"bytes,4,opt,name=method,proto3" json:"method" form:"method" query:"method"`
-	Api      string `protobuf:"bytes,5,opt,name=api,proto3" json:"api" form:"api" query:"api"`
-	Success  string `protobuf:"bytes,6,opt,name=success,proto3" json:"success" form:"success" query:"success"`
-	Operator string `protobuf:"bytes,7,opt,name=operator,proto3" json:"operator" form:"operator" query:"operator"`
+	Name     string `protobuf:"bytes,3,opt,name=name,proto3" json:"name" form:"name" query:"name"`
+	Status   string `protobuf:"bytes,4,opt,name=status,proto3" json:"status" form:"status" query:"status"` // 0: 禁用, 1: 启用
 }
 
-func (x *LogsListReq) Reset() {
-	*x = LogsListReq{}
+func (x *RoleListReq) Reset() {
+	*x = RoleListReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_proto_msgTypes[54]
+		mi := &file_admin_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LogsListReq) String() string {
+func (x *RoleListReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LogsListReq) ProtoMessage() {}
+func (*RoleListReq) ProtoMessage() {}
 
-func (x *LogsListReq) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_proto_msgTypes[54]
+func (x *RoleListReq) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4486,62 +4479,41 @@ func (x *LogsListReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LogsListReq.ProtoReflect.Descriptor instead.
-func (*LogsListReq) Descriptor() ([]byte, []int) {
-	return file_admin_proto_rawDescGZIP(), []int{54}
+// Deprecated: Use RoleListReq.ProtoReflect.Descriptor instead.
+func (*RoleListReq) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *LogsListReq) GetPage() uint64 {
+func (x *RoleListReq) GetPage() uint64 {
 	if x != nil {
 		return x.Page
 	}
 	return 0
 }
 
-func (x *LogsListReq) GetPageSize() uint64 {
+func (x *RoleListReq) GetPageSize() uint64 {
 	if x != nil {
 		return x.PageSize
 	}
 	return 0
 }
 
-func (x *LogsListReq) GetType() string {
-	if x != nil {
-		return x.Type
-	}
-	return ""
-}
-
-func (x *LogsListReq) GetMethod() string {
-	if x != nil {
-		return x.Method
-	}
-	return ""
-}
-
-func (x *LogsListReq) GetApi() string {
-	if x != nil {
-		return x.Api
-	}
-	return ""
-}
-
-func (x *LogsListReq) GetSuccess() string {
+func (x *RoleListReq) GetName() string {
 	if x != nil {
-		return x.Success
+		return x.Name
 	}
 	return ""
 }
 
-func (x *LogsListReq) GetOperator() string {
+func (x *RoleListReq) GetStatus() string {
 	if x != nil {
-		return x.Operator
+		return x.Status
 	}
 	return ""
 }
 
-// The response data of logs list | 日志列表返回数据
-type LogsListResp struct {
+// Get role list response | 获取角色列表响应
+type RoleListResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -4549,26 +4521,26 @@ type LogsListResp struct {
 	ErrCode base.ErrCode `protobuf:"varint,1,opt,name=errCode,proto3,enum=base.ErrCode" json:"errCode" form:"errCode" query:"errCode"`
 	ErrMsg  string       `protobuf:"bytes,2,opt,name=errMsg,proto3" json:"errMsg" form:"errMsg" query:"errMsg"`
 	Total   uint64       `protobuf:"varint,3,opt,name=total,proto3" json:"total" form:"total" query:"total"`
-	Data    []*LogsInfo  `protobuf:"bytes,4,rep,name=data,proto3" json:"data" form:"data" query:"data"`
+	Data    []*RoleInfo  `protobuf:"bytes,4,rep,name=data,proto3" json:"data" form:"data" query:"data"`
 }
 
-func (x *LogsListResp) Reset() {
-	*x = LogsListResp{}
+func (x *RoleListResp) Reset() {
+	*x = RoleListResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_proto_msgTypes[55]
+		mi := &file_admin_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LogsListResp) String() string {
+func (x *RoleListResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LogsListResp) ProtoMessage() {}
+func (*RoleListResp) ProtoMessage() {}
 
-func (x *LogsListResp) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_proto_msgTypes[55]
+func (x *RoleListResp) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4579,1151 +4551,6161 @@ func (x *LogsListResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LogsListResp.ProtoReflect.Descriptor instead.
-func (*LogsListResp) Descriptor() ([]byte, []int) {
-	return file_admin_proto_rawDescGZIP(), []int{55}
+// Deprecated: Use RoleListResp.ProtoReflect.Descriptor instead.
+func (*RoleListResp) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *LogsListResp) GetErrCode() base.ErrCode {
+func (x *RoleListResp) GetErrCode() base.ErrCode {
 	if x != nil {
 		return x.ErrCode
 	}
 	return base.ErrCode(0)
 }
 
-func (x *LogsListResp) GetErrMsg() string {
+func (x *RoleListResp) GetErrMsg() string {
 	if x != nil {
 		return x.ErrMsg
 	}
 	return ""
 }
 
-func (x *LogsListResp) GetTotal() uint64 {
+func (x *RoleListResp) GetTotal() uint64 {
 	if x != nil {
 		return x.Total
 	}
 	return 0
 }
 
-func (x *LogsListResp) GetData() []*LogsInfo {
+func (x *RoleListResp) GetData() []*RoleInfo {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-var File_admin_proto protoreflect.FileDescriptor
+// The response data of Token information | Token信息
+type TokenInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_admin_proto_rawDesc = []byte{
-	0x0a, 0x0b, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x61,
-	0x64, 0x6d, 0x69, 0x6e, 0x1a, 0x09, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
-	0x0a, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x42, 0x0a, 0x09, 0x53,
-	0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x65, 0x71, 0x12, 0x35, 0x0a, 0x09, 0x73, 0x74, 0x72, 0x75,
-	0x63, 0x74, 0x53, 0x74, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x17, 0xca, 0xbb, 0x18,
-	0x13, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x53, 0x74, 0x72, 0x2c, 0x20, 0x72, 0x65, 0x71, 0x75,
-	0x69, 0x72, 0x65, 0x64, 0x52, 0x09, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x53, 0x74, 0x72, 0x22,
-	0x6b, 0x0a, 0x0a, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x27, 0x0a,
-	0x07, 0x65, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d,
-	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x07, 0x65,
-	0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x4d, 0x73, 0x67,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x4d, 0x73, 0x67, 0x12, 0x1c,
-	0x0a, 0x09, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x53, 0x74, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x53, 0x74, 0x72, 0x22, 0x68, 0x0a, 0x09,
-	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x12, 0x27, 0x0a, 0x07, 0x65, 0x72, 0x72,
-	0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x62, 0x61, 0x73,
-	0x65, 0x2e, 0x45, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x07, 0x65, 0x72, 0x72, 0x43, 0x6f,
-	0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x4d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x4d, 0x73, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x53, 0x74, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x53, 0x74, 0x72, 0x22, 0x8a, 0x01, 0x0a, 0x0f, 0x43, 0x61, 0x70, 0x74, 0x63,
-	0x68, 0x61, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x12, 0x27, 0x0a, 0x07, 0x65, 0x72,
-	0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x62, 0x61,
-	0x73, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x07, 0x65, 0x72, 0x72, 0x43,
-	0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x4d, 0x73, 0x67, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x4d, 0x73, 0x67, 0x12, 0x1c, 0x0a, 0x09, 0x63,
-	0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x63, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6d, 0x67,
-	0x50, 0x61, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x69, 0x6d, 0x67, 0x50,
-	0x61, 0x74, 0x68, 0x22, 0x56, 0x0a, 0x0f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x6c,
-	0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x44,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x44, 0x12, 0x2b,
-	0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e,
-	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x50, 0x61, 0x72, 0x74,
-	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x22, 0x3c, 0x0a, 0x0e, 0x50,
-	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x50, 0x61, 0x72, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a,
-	0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74,
-	0x68, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x22, 0x54, 0x0a, 0x0f, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06,
-	0x72, 0x6f, 0x6c, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x72, 0x6f,
-	0x6c, 0x65, 0x49, 0x44, 0x12, 0x29, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63,
-	0x79, 0x50, 0x61, 0x72, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x22,
-	0x7a, 0x0a, 0x08, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08, 0x75,
-	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75,
-	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77,
-	0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77,
-	0x6f, 0x72, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x49, 0x44,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x49,
-	0x44, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x63, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x22, 0x3e, 0x0a, 0x0a, 0x53,
-	0x69, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x6f, 0x6c,
-	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x6f, 0x6c,
-	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x4d, 0x0a, 0x09, 0x4c,
-	0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05,
-	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b,
-	0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x22, 0xb0, 0x01, 0x0a, 0x0b, 0x50,
-	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x27, 0x0a, 0x07, 0x65, 0x72,
-	0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x62, 0x61,
-	0x73, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x07, 0x65, 0x72, 0x72, 0x43,
-	0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x4d, 0x73, 0x67, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x4d, 0x73, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x6e,
-	0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e,
-	0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x76, 0x61, 0x74, 0x61,
-	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x12,
-	0x16, 0x0a, 0x06, 0x6d, 0x6f, 0x62, 0x69, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x6d, 0x6f, 0x62, 0x69, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x6e, 0x0a,
-	0x0a, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08, 0x6e,
-	0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e,
-	0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x76, 0x61, 0x74, 0x61,
-	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x12,
-	0x16, 0x0a, 0x06, 0x6d, 0x6f, 0x62, 0x69, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x6d, 0x6f, 0x62, 0x69, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x93, 0x01,
-	0x0a, 0x0b, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a,
-	0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73,
-	0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73,
-	0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61,
-	0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x61, 0x70, 0x74, 0x63, 0x68,
-	0x61, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x12, 0x14, 0x0a,
-	0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d,
-	0x61, 0x69, 0x6c, 0x22, 0x6f, 0x0a, 0x11, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73,
-	0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72,
-	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
-	0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x6c, 0x64, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x6c, 0x64, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f,
-	0x72, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x6e, 0x65, 0x77, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
-	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x65, 0x77, 0x50, 0x61, 0x73, 0x73,
-	0x77, 0x6f, 0x72, 0x64, 0x22, 0xf1, 0x01, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f,
-	0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x0e,
-	0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x16,
-	0x0a, 0x06, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x44,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x44, 0x12, 0x16,
-	0x0a, 0x06, 0x6d, 0x6f, 0x62, 0x69, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x6d, 0x6f, 0x62, 0x69, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x16, 0x0a, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08,
-	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0xc5, 0x03, 0x0a, 0x0c, 0x55, 0x73, 0x65,
-	0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x12, 0x27, 0x0a, 0x07, 0x65, 0x72, 0x72,
-	0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x62, 0x61, 0x73,
-	0x65, 0x2e, 0x45, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x07, 0x65, 0x72, 0x72, 0x43, 0x6f,
-	0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x4d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x4d, 0x73, 0x67, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x76,
-	0x61, 0x74, 0x61, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x76, 0x61, 0x74,
-	0x61, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x6f,
-	0x62, 0x69, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x6f, 0x62, 0x69,
-	0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08,
-	0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x6f, 0x6c, 0x65,
-	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x6f, 0x6c, 0x65,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
-	0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
-	0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18,
-	0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
-	0x12, 0x1c, 0x0a, 0x09, 0x72, 0x6f, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x0e, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x6f, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1a,
-	0x0a, 0x08, 0x73, 0x69, 0x64, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x73, 0x69, 0x64, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x64, 0x65,
-	0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x18, 0x10, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72,
-	0x22, 0x8e, 0x01, 0x0a, 0x0c, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x12, 0x27, 0x0a, 0x07, 0x65, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x43, 0x6f, 0x64,
-	0x65, 0x52, 0x07, 0x65, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72,
-	0x72, 0x4d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x4d,
-	0x73, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x27, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61,
-	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x55,
-	0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x52, 0x04, 0x64, 0x61, 0x74,
-	0x61, 0x22, 0xbb, 0x01, 0x0a, 0x0b, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
-	0x71, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a,
-	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a,
-	0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61,
-	0x69, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12,
-	0x16, 0x0a, 0x06, 0x6d, 0x6f, 0x62, 0x69, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x6d, 0x6f, 0x62, 0x69, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49,
-	0x44, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x44, 0x22,
-	0x63, 0x0a, 0x0c, 0x50, 0x65, 0x72, 0x6d, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12,
-	0x27, 0x0a, 0x07, 0x65, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x0d, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52,
-	0x07, 0x65, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x4d,
-	0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x4d, 0x73, 0x67,
-	0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04,
-	0x64, 0x61, 0x74, 0x61, 0x22, 0xb9, 0x01, 0x0a, 0x07, 0x41, 0x70, 0x69, 0x49, 0x6e, 0x66, 0x6f,
-	0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44,
-	0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c,
-	0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x12, 0x0a, 0x04,
-	0x70, 0x61, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68,
-	0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68,
-	0x6f, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64,
-	0x22, 0x88, 0x01, 0x0a, 0x0b, 0x41, 0x70, 0x69, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x12, 0x27, 0x0a, 0x07, 0x65, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x0d, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65,
-	0x52, 0x07, 0x65, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72,
-	0x4d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x4d, 0x73,
-	0x67, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x22, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
-	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x41, 0x70,
-	0x69, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xa0, 0x01, 0x0a, 0x0a,
-	0x41, 0x70, 0x69, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61,
-	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1a,
-	0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61,
-	0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x20,
-	0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75,
-	0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x3e,
-	0x0a, 0x10, 0x41, 0x70, 0x69, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x49, 0x6e,
-	0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x22, 0x64,
-	0x0a, 0x1d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x41, 0x70, 0x69, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x12,
-	0x16, 0x0a, 0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x44, 0x12, 0x2b, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x41, 0x70,
-	0x69, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04,
-	0x64, 0x61, 0x74, 0x61, 0x22, 0x9e, 0x01, 0x0a, 0x18, 0x41, 0x70, 0x69, 0x41, 0x75, 0x74, 0x68,
-	0x6f, 0x72, 0x69, 0x74, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73,
-	0x70, 0x12, 0x27, 0x0a, 0x07, 0x65, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x43, 0x6f, 0x64,
-	0x65, 0x52, 0x07, 0x65, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72,
-	0x72, 0x4d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x4d,
-	0x73, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x2b, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61,
-	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x41,
-	0x70, 0x69, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x52,
-	0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x48, 0x0a, 0x14, 0x4d, 0x65, 0x6e, 0x75, 0x41, 0x75, 0x74,
-	0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a,
-	0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x72,
-	0x6f, 0x6c, 0x65, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x4d, 0x65, 0x6e, 0x75, 0x49, 0x44, 0x73,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x04, 0x52, 0x07, 0x4d, 0x65, 0x6e, 0x75, 0x49, 0x44, 0x73, 0x22,
-	0x8a, 0x01, 0x0a, 0x15, 0x4d, 0x65, 0x6e, 0x75, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74,
-	0x79, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x12, 0x27, 0x0a, 0x07, 0x65, 0x72, 0x72,
-	0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x62, 0x61, 0x73,
-	0x65, 0x2e, 0x45, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x07, 0x65, 0x72, 0x72, 0x43, 0x6f,
-	0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x4d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x4d, 0x73, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f,
-	0x6c, 0x65, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x72, 0x6f, 0x6c, 0x65,
-	0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x4d, 0x65, 0x6e, 0x75, 0x49, 0x44, 0x73, 0x18, 0x04, 0x20,
-	0x03, 0x28, 0x04, 0x52, 0x07, 0x4d, 0x65, 0x6e, 0x75, 0x49, 0x44, 0x73, 0x22, 0xae, 0x02, 0x0a,
-	0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d,
-	0x65, 0x6e, 0x75, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74,
-	0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74,
-	0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x12, 0x1c, 0x0a, 0x09,
-	0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72,
-	0x64, 0x65, 0x72, 0x4e, 0x6f, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x6f, 0x72, 0x64,
-	0x65, 0x72, 0x4e, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64,
-	0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64,
-	0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x6e, 0x75, 0x54, 0x79, 0x70, 0x65, 0x18, 0x0a, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x08, 0x6d, 0x65, 0x6e, 0x75, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x04,
-	0x6d, 0x65, 0x74, 0x61, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x61, 0x64, 0x6d,
-	0x69, 0x6e, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x22, 0x8a, 0x03,
-	0x0a, 0x08, 0x4d, 0x65, 0x6e, 0x75, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x1a, 0x0a, 0x08,
-	0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
-	0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x12, 0x1c, 0x0a, 0x09,
-	0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72,
-	0x64, 0x65, 0x72, 0x4e, 0x6f, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x6f, 0x72, 0x64,
-	0x65, 0x72, 0x4e, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64,
-	0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64,
-	0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x6e, 0x75, 0x54, 0x79, 0x70, 0x65, 0x18, 0x0c, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x08, 0x6d, 0x65, 0x6e, 0x75, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2b, 0x0a, 0x08,
-	0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f,
-	0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x4d, 0x65, 0x6e, 0x75, 0x49, 0x6e, 0x66, 0x6f, 0x52,
-	0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x12, 0x1f, 0x0a, 0x04, 0x6d, 0x65, 0x74,
-	0x61, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e,
-	0x4d, 0x65, 0x74, 0x61, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x22, 0xa8, 0x03, 0x0a, 0x04, 0x4d,
-	0x65, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x63, 0x6f,
-	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x12, 0x1a, 0x0a,
-	0x08, 0x68, 0x69, 0x64, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x08, 0x68, 0x69, 0x64, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x12, 0x26, 0x0a, 0x0e, 0x68, 0x69, 0x64,
-	0x65, 0x42, 0x72, 0x65, 0x61, 0x64, 0x63, 0x72, 0x75, 0x6d, 0x62, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x0e, 0x68, 0x69, 0x64, 0x65, 0x42, 0x72, 0x65, 0x61, 0x64, 0x63, 0x72, 0x75, 0x6d,
-	0x62, 0x12, 0x2c, 0x0a, 0x11, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x74, 0x69,
-	0x76, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x63, 0x75,
-	0x72, 0x72, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x12,
-	0x28, 0x0a, 0x0f, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x4b, 0x65, 0x65, 0x70, 0x41, 0x6c, 0x69,
-	0x76, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65,
-	0x4b, 0x65, 0x65, 0x70, 0x41, 0x6c, 0x69, 0x76, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x69, 0x64,
-	0x65, 0x54, 0x61, 0x62, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x68, 0x69, 0x64, 0x65,
-	0x54, 0x61, 0x62, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x53, 0x72, 0x63, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x53, 0x72, 0x63, 0x12,
-	0x1e, 0x0a, 0x0a, 0x63, 0x61, 0x72, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x18, 0x09, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x0a, 0x63, 0x61, 0x72, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x12,
-	0x2e, 0x0a, 0x12, 0x68, 0x69, 0x64, 0x65, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x49,
-	0x6e, 0x4d, 0x65, 0x6e, 0x75, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x68, 0x69, 0x64,
-	0x65, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x49, 0x6e, 0x4d, 0x65, 0x6e, 0x75, 0x12,
-	0x14, 0x0a, 0x05, 0x61, 0x66, 0x66, 0x69, 0x78, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
-	0x61, 0x66, 0x66, 0x69, 0x78, 0x12, 0x22, 0x0a, 0x0c, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63,
-	0x4c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x64, 0x79, 0x6e,
-	0x61, 0x6d, 0x69, 0x63, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x61,
-	0x6c, 0x50, 0x61, 0x74, 0x68, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x61,
-	0x6c, 0x50, 0x61, 0x74, 0x68, 0x22, 0x67, 0x0a, 0x0b, 0x4d, 0x65, 0x6e, 0x75, 0x4c, 0x69, 0x73,
-	0x74, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65,
-	0x53, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65,
-	0x53, 0x69, 0x7a, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c,
-	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x22, 0x8e,
-	0x01, 0x0a, 0x10, 0x4d, 0x65, 0x6e, 0x75, 0x49, 0x6e, 0x66, 0x6f, 0x4c, 0x69, 0x73, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x12, 0x27, 0x0a, 0x07, 0x65, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x43,
-	0x6f, 0x64, 0x65, 0x52, 0x07, 0x65, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06,
-	0x65, 0x72, 0x72, 0x4d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72,
-	0x72, 0x4d, 0x73, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x23, 0x0a, 0x04, 0x64, 0x61,
-	0x74, 0x61, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e,
-	0x2e, 0x4d, 0x65, 0x6e, 0x75, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22,
-	0xaa, 0x02, 0x0a, 0x0c, 0x4d, 0x65, 0x6e, 0x75, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x73, 0x65,
-	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05,
-	0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x65, 0x76,
-	0x65, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65,
-	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65,
-	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e,
-	0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6f,
-	0x6e, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x4e, 0x6f, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x4e, 0x6f, 0x12, 0x1a,
-	0x0a, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x2f, 0x0a, 0x08, 0x63, 0x68,
-	0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61,
-	0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x4d, 0x65, 0x6e, 0x75, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x73,
-	0x65, 0x52, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x12, 0x1f, 0x0a, 0x04, 0x6d,
-	0x65, 0x74, 0x61, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x61, 0x64, 0x6d, 0x69,
-	0x6e, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x22, 0xf0, 0x01, 0x0a,
-	0x08, 0x52, 0x6f, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x6f,
-	0x75, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x64, 0x65, 0x66, 0x61,
-	0x75, 0x6c, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x72, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72, 0x64,
-	0x65, 0x72, 0x4e, 0x6f, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65,
-	0x72, 0x4e, 0x6f, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
-	0x74, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x09,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22,
-	0xb5, 0x02, 0x0a, 0x0c, 0x52, 0x6f, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70,
-	0x12, 0x27, 0x0a, 0x07, 0x65, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x0d, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65,
-	0x52, 0x07, 0x65, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72,
-	0x4d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x4d, 0x73,
-	0x67, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49,
-	0x44, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x64,
-	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65,
-	0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x6d,
-	0x61, 0x72, 0x6b, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x6d, 0x61, 0x72,
-	0x6b, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x4e, 0x6f, 0x18, 0x09, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x4e, 0x6f, 0x12, 0x1c, 0x0a, 0x09, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x69, 0x0a, 0x0b, 0x52, 0x6f, 0x6c, 0x65, 0x4c,
-	0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61,
-	0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x70, 0x61,
-	0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x22, 0x8a, 0x01, 0x0a, 0x0c, 0x52, 0x6f, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x12, 0x27, 0x0a, 0x07, 0x65, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x43,
-	0x6f, 0x64, 0x65, 0x52, 0x07, 0x65, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06,
-	0x65, 0x72, 0x72, 0x4d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72,
-	0x72, 0x4d, 0x73, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x23, 0x0a, 0x04, 0x64, 0x61,
-	0x74, 0x61, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e,
-	0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22,
-	0xd7, 0x01, 0x0a, 0x09, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a,
-	0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x1c, 0x0a,
-	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x75,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65,
-	0x72, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
-	0x44, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a,
-	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f,
-	0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x65,
-	0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x41, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x41, 0x74, 0x22, 0x8c, 0x01, 0x0a, 0x0d, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x27, 0x0a, 0x07, 0x65,
-	0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x62,
-	0x61, 0x73, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x07, 0x65, 0x72, 0x72,
-	0x43, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x4d, 0x73, 0x67, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x4d, 0x73, 0x67, 0x12, 0x14, 0x0a, 0x05,
-	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x74, 0x6f, 0x74,
-	0x61, 0x6c, 0x12, 0x24, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x10, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e,
-	0x66, 0x6f, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x72, 0x0a, 0x0c, 0x54, 0x6f, 0x6b, 0x65,
-	0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08,
-	0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
-	0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x23, 0x0a, 0x09,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65,
-	0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
-	0x44, 0x22, 0xc0, 0x01, 0x0a, 0x0e, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79,
-	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x02, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16,
-	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x64, 0x41, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x64, 0x41, 0x74, 0x22, 0x96, 0x01, 0x0a, 0x12, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x61, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x27, 0x0a, 0x07, 0x65,
-	0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x62,
-	0x61, 0x73, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x07, 0x65, 0x72, 0x72,
-	0x43, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x4d, 0x73, 0x67, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x4d, 0x73, 0x67, 0x12, 0x14, 0x0a, 0x05,
-	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x74, 0x6f, 0x74,
-	0x61, 0x6c, 0x12, 0x29, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x15, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x61, 0x72, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xd0, 0x01,
-	0x0a, 0x10, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79, 0x44, 0x65, 0x74, 0x61,
-	0x69, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02,
-	0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x64, 0x41, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x64, 0x41, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x44,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x44,
-	0x22, 0x9e, 0x01, 0x0a, 0x18, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79, 0x44,
-	0x65, 0x74, 0x61, 0x69, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x27, 0x0a,
-	0x07, 0x65, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d,
-	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x07, 0x65,
-	0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x4d, 0x73, 0x67,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x4d, 0x73, 0x67, 0x12, 0x14,
-	0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x74,
-	0x6f, 0x74, 0x61, 0x6c, 0x12, 0x2b, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x44, 0x69, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x61, 0x72, 0x79, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x04, 0x64, 0x61, 0x74,
-	0x61, 0x22, 0x29, 0x0a, 0x13, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79, 0x44,
-	0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x6d, 0x0a, 0x11,
-	0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65,
-	0x71, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70,
-	0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12,
-	0x1a, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x5f, 0x0a, 0x0d, 0x4f,
-	0x61, 0x75, 0x74, 0x68, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05,
-	0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61,
-	0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x1c,
-	0x0a, 0x09, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x22, 0x66, 0x0a, 0x11,
-	0x4f, 0x61, 0x75, 0x74, 0x68, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x12, 0x27, 0x0a, 0x07, 0x65, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x43, 0x6f, 0x64,
-	0x65, 0x52, 0x07, 0x65, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72,
-	0x72, 0x4d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x4d,
-	0x73, 0x67, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x75, 0x72, 0x6c, 0x22, 0xd6, 0x02, 0x0a, 0x0c, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
-	0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6c, 0x69,
-	0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69,
-	0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53,
-	0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6c, 0x69,
-	0x65, 0x6e, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x64,
-	0x69, 0x72, 0x65, 0x63, 0x74, 0x55, 0x72, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x55, 0x72, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x73,
-	0x63, 0x6f, 0x70, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x6f,
-	0x70, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x75, 0x74, 0x68, 0x55, 0x72, 0x6c, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x75, 0x74, 0x68, 0x55, 0x72, 0x6c, 0x12, 0x1a, 0x0a,
-	0x08, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x55, 0x72, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x55, 0x72, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x75, 0x74,
-	0x68, 0x53, 0x74, 0x79, 0x6c, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x61, 0x75,
-	0x74, 0x68, 0x53, 0x74, 0x79, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6e, 0x66, 0x6f, 0x55,
-	0x72, 0x6c, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x69, 0x6e, 0x66, 0x6f, 0x55, 0x72,
-	0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0b,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
-	0x1c, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0c, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x55, 0x0a,
-	0x0f, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71,
-	0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04,
-	0x70, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x22, 0x92, 0x01, 0x0a, 0x10, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
-	0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x27, 0x0a, 0x07, 0x65, 0x72, 0x72,
-	0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x62, 0x61, 0x73,
-	0x65, 0x2e, 0x45, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x07, 0x65, 0x72, 0x72, 0x43, 0x6f,
-	0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x4d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x4d, 0x73, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
-	0x74, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c,
-	0x12, 0x27, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13,
-	0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x37, 0x0a, 0x0b, 0x43, 0x61, 0x6c,
-	0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x12,
-	0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f,
-	0x64, 0x65, 0x22, 0xbe, 0x02, 0x0a, 0x08, 0x4c, 0x6f, 0x67, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x12,
-	0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74,
-	0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x61,
-	0x70, 0x69, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x61, 0x70, 0x69, 0x12, 0x18, 0x0a,
-	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
-	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x43, 0x6f,
-	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x71,
-	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x70, 0x43,
-	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65,
-	0x73, 0x70, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x73, 0x65,
-	0x72, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x73,
-	0x65, 0x72, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61,
-	0x74, 0x6f, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61,
-	0x74, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x64, 0x41, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
-	0x41, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x64, 0x41, 0x74, 0x22, 0xb1, 0x01, 0x0a, 0x0b, 0x4c, 0x6f, 0x67, 0x73, 0x4c, 0x69, 0x73, 0x74,
-	0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53,
-	0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53,
-	0x69, 0x7a, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f,
-	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12,
-	0x10, 0x0a, 0x03, 0x61, 0x70, 0x69, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x61, 0x70,
-	0x69, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6f,
-	0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f,
-	0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x8a, 0x01, 0x0a, 0x0c, 0x4c, 0x6f, 0x67, 0x73,
-	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x27, 0x0a, 0x07, 0x65, 0x72, 0x72, 0x43,
-	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x62, 0x61, 0x73, 0x65,
-	0x2e, 0x45, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x07, 0x65, 0x72, 0x72, 0x43, 0x6f, 0x64,
-	0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x4d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x4d, 0x73, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74,
-	0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12,
-	0x23, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
-	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x4c, 0x6f, 0x67, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04,
-	0x64, 0x61, 0x74, 0x61, 0x32, 0xe9, 0x02, 0x0a, 0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x42,
-	0x0a, 0x0c, 0x49, 0x6e, 0x69, 0x74, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x0b,
-	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x0e, 0x2e, 0x62, 0x61,
-	0x73, 0x65, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x15, 0xca, 0xc1, 0x18,
-	0x11, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x69, 0x6e, 0x69, 0x74, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61,
-	0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63,
-	0x6b, 0x12, 0x0b, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x0e,
-	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x0f,
-	0xca, 0xc1, 0x18, 0x0b, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12,
-	0x40, 0x0a, 0x07, 0x43, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x12, 0x0b, 0x2e, 0x62, 0x61, 0x73,
-	0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e,
-	0x43, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x22,
-	0x10, 0xca, 0xc1, 0x18, 0x0c, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x61, 0x70, 0x74, 0x63, 0x68,
-	0x61, 0x12, 0x50, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x74, 0x72, 0x75, 0x63,
-	0x74, 0x54, 0x61, 0x67, 0x12, 0x10, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x53, 0x74, 0x72,
-	0x75, 0x63, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x11, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x53,
-	0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x22, 0x18, 0xd2, 0xc1, 0x18, 0x14, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74,
-	0x54, 0x61, 0x67, 0x12, 0x4b, 0x0a, 0x0d, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x54, 0x6f, 0x50,
-	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x10, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x53, 0x74, 0x72,
-	0x75, 0x63, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x50,
-	0x72, 0x6f, 0x74, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x22, 0x16, 0xd2, 0xc1, 0x18, 0x12, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x54, 0x6f, 0x50, 0x72, 0x6f, 0x74, 0x6f,
-	0x32, 0xf0, 0x06, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x41, 0x0a, 0x08, 0x52, 0x65, 0x67,
-	0x69, 0x73, 0x74, 0x65, 0x72, 0x12, 0x12, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x52, 0x65,
-	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x62, 0x61, 0x73, 0x65,
-	0x2e, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x11, 0xd2, 0xc1, 0x18, 0x0d, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x12, 0x4a, 0x0a, 0x0c,
-	0x55, 0x73, 0x65, 0x72, 0x50, 0x65, 0x72, 0x6d, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x0b, 0x2e, 0x62,
-	0x61, 0x73, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x13, 0x2e, 0x61, 0x64, 0x6d, 0x69,
-	0x6e, 0x2e, 0x50, 0x65, 0x72, 0x6d, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x18,
-	0xca, 0xc1, 0x18, 0x14, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x75,
-	0x73, 0x65, 0x72, 0x2f, 0x70, 0x65, 0x72, 0x6d, 0x12, 0x5f, 0x0a, 0x0e, 0x43, 0x68, 0x61, 0x6e,
-	0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x18, 0x2e, 0x61, 0x64, 0x6d,
-	0x69, 0x6e, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
-	0x64, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x42, 0x61, 0x73, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x22, 0x23, 0xd2, 0xc1, 0x18, 0x1f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61,
-	0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x2f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
-	0x2d, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x56, 0x0a, 0x0a, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1c, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73,
-	0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x42, 0x61, 0x73,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x1a, 0xd2, 0xc1, 0x18, 0x16, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x2f, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x12, 0x56, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12,
-	0x1c, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e,
-	0x62, 0x61, 0x73, 0x65, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x1a, 0xd2,
-	0xc1, 0x18, 0x16, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x75, 0x73,
-	0x65, 0x72, 0x2f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x46, 0x0a, 0x08, 0x55, 0x73, 0x65,
-	0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0b, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x1a, 0x13, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x22, 0x18, 0xca, 0xc1, 0x18, 0x14, 0x2f, 0x61, 0x70,
-	0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x2f, 0x69, 0x6e, 0x66,
-	0x6f, 0x12, 0x4d, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x12, 0x2e,
-	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
-	0x71, 0x1a, 0x13, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69,
-	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x22, 0x18, 0xd2, 0xc1, 0x18, 0x14, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x2f, 0x6c, 0x69, 0x73, 0x74,
-	0x12, 0x3e, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x0b,
-	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x49, 0x44, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x62, 0x61,
-	0x73, 0x65, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x13, 0xe2, 0xc1, 0x18,
-	0x0f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x75, 0x73, 0x65, 0x72,
-	0x12, 0x4f, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c,
-	0x65, 0x12, 0x11, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c,
-	0x65, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x42, 0x61, 0x73, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x22, 0x1b, 0xd2, 0xc1, 0x18, 0x17, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61,
-	0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x2f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c,
-	0x65, 0x12, 0x4b, 0x0a, 0x0b, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
-	0x12, 0x0b, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x12, 0x2e,
-	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x22, 0x1b, 0xca, 0xc1, 0x18, 0x17, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69,
-	0x6e, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x2f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x53,
-	0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x12, 0x13, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x42,
-	0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x1a, 0xd2, 0xc1, 0x18, 0x16, 0x2f, 0x61, 0x70,
-	0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x2f, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x32, 0x9f, 0x02, 0x0a, 0x04, 0x61, 0x70, 0x69, 0x73, 0x12, 0x46, 0x0a, 0x09,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x70, 0x69, 0x12, 0x0e, 0x2e, 0x61, 0x64, 0x6d, 0x69,
-	0x6e, 0x2e, 0x41, 0x70, 0x69, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x0e, 0x2e, 0x62, 0x61, 0x73, 0x65,
-	0x2e, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x19, 0xd2, 0xc1, 0x18, 0x15, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x12, 0x46, 0x0a, 0x09, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x70,
-	0x69, 0x12, 0x0e, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x41, 0x70, 0x69, 0x49, 0x6e, 0x66,
-	0x6f, 0x1a, 0x0e, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x22, 0x19, 0xd2, 0xc1, 0x18, 0x15, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69,
-	0x6e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x3c, 0x0a, 0x09,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x70, 0x69, 0x12, 0x0b, 0x2e, 0x62, 0x61, 0x73, 0x65,
-	0x2e, 0x49, 0x44, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x42, 0x61,
-	0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x12, 0xe2, 0xc1, 0x18, 0x0e, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x61, 0x70, 0x69, 0x12, 0x49, 0x0a, 0x07, 0x41, 0x70,
-	0x69, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x11, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x41, 0x70,
-	0x69, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e,
-	0x2e, 0x41, 0x70, 0x69, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x22, 0x17, 0xd2, 0xc1,
-	0x18, 0x13, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x6c, 0x69, 0x73, 0x74, 0x32, 0xff, 0x04, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
-	0x69, 0x74, 0x79, 0x12, 0x6c, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74,
-	0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x24, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x70, 0x69,
-	0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x62,
-	0x61, 0x73, 0x65, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x23, 0xd2, 0xc1,
-	0x18, 0x1f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x61, 0x75, 0x74,
-	0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x12, 0x6f, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x70, 0x69, 0x41, 0x75,
-	0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x24, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x70,
-	0x69, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e,
-	0x62, 0x61, 0x73, 0x65, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x23, 0xd2,
-	0xc1, 0x18, 0x1f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x61, 0x75,
-	0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x75, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x12, 0x5f, 0x0a, 0x0c, 0x41, 0x70, 0x69, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
-	0x74, 0x79, 0x12, 0x0b, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x49, 0x44, 0x52, 0x65, 0x71, 0x1a,
-	0x1f, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x41, 0x70, 0x69, 0x41, 0x75, 0x74, 0x68, 0x6f,
-	0x72, 0x69, 0x74, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70,
-	0x22, 0x21, 0xd2, 0xc1, 0x18, 0x1d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e,
-	0x2f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72,
-	0x6f, 0x6c, 0x65, 0x12, 0x68, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x6e,
-	0x75, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x1b, 0x2e, 0x61, 0x64, 0x6d,
-	0x69, 0x6e, 0x2e, 0x4d, 0x65, 0x6e, 0x75, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79,
-	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x42,
-	0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x24, 0xd2, 0xc1, 0x18, 0x20, 0x2f, 0x61, 0x70,
-	0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74,
-	0x79, 0x2f, 0x6d, 0x65, 0x6e, 0x75, 0x2f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x68, 0x0a,
-	0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x41, 0x75, 0x74, 0x68, 0x6f,
-	0x72, 0x69, 0x74, 0x79, 0x12, 0x1b, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x4d, 0x65, 0x6e,
-	0x75, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65,
-	0x71, 0x1a, 0x0e, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x22, 0x24, 0xd2, 0xc1, 0x18, 0x20, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69,
-	0x6e, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x2f, 0x6d, 0x65, 0x6e, 0x75,
-	0x2f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x5e, 0x0a, 0x0d, 0x4d, 0x65, 0x6e, 0x75, 0x41,
-	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x0b, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
-	0x49, 0x44, 0x52, 0x65, 0x71, 0x1a, 0x1c, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x4d, 0x65,
-	0x6e, 0x75, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x52,
-	0x65, 0x73, 0x70, 0x22, 0x22, 0xd2, 0xc1, 0x18, 0x1e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64,
-	0x6d, 0x69, 0x6e, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x2f, 0x6d, 0x65,
-	0x6e, 0x75, 0x2f, 0x72, 0x6f, 0x6c, 0x65, 0x32, 0x97, 0x03, 0x0a, 0x04, 0x6d, 0x65, 0x6e, 0x75,
-	0x12, 0x56, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x12, 0x1c,
-	0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x62,
-	0x61, 0x73, 0x65, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x1a, 0xd2, 0xc1,
-	0x18, 0x16, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x6d, 0x65, 0x6e,
-	0x75, 0x2f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x56, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x12, 0x1c, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x6e,
-	0x75, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x42, 0x61, 0x73, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x22, 0x1a, 0xd2, 0xc1, 0x18, 0x16, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61,
-	0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x6d, 0x65, 0x6e, 0x75, 0x2f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x12, 0x3e, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x12, 0x0b,
-	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x49, 0x44, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x62, 0x61,
-	0x73, 0x65, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x13, 0xe2, 0xc1, 0x18,
-	0x0f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x6d, 0x65, 0x6e, 0x75,
-	0x12, 0x4c, 0x0a, 0x0a, 0x4d, 0x65, 0x6e, 0x75, 0x42, 0x79, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x0b,
-	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x17, 0x2e, 0x61, 0x64,
-	0x6d, 0x69, 0x6e, 0x2e, 0x4d, 0x65, 0x6e, 0x75, 0x49, 0x6e, 0x66, 0x6f, 0x4c, 0x69, 0x73, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x22, 0x18, 0xca, 0xc1, 0x18, 0x14, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61,
-	0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x6d, 0x65, 0x6e, 0x75, 0x2f, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x51,
-	0x0a, 0x08, 0x4d, 0x65, 0x6e, 0x75, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x12, 0x2e, 0x61, 0x64, 0x6d,
-	0x69, 0x6e, 0x2e, 0x4d, 0x65, 0x6e, 0x75, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x17,
-	0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x4d, 0x65, 0x6e, 0x75, 0x49, 0x6e, 0x66, 0x6f, 0x4c,
-	0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x22, 0x18, 0xd2, 0xc1, 0x18, 0x14, 0x2f, 0x61, 0x70,
-	0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x6d, 0x65, 0x6e, 0x75, 0x2f, 0x6c, 0x69, 0x73,
-	0x74, 0x32, 0xc3, 0x03, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x49, 0x0a, 0x0a, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x0f, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e,
-	0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x0e, 0x2e, 0x62, 0x61, 0x73, 0x65,
-	0x2e, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x1a, 0xd2, 0xc1, 0x18, 0x16, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x72, 0x6f, 0x6c, 0x65, 0x2f, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x49, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52,
-	0x6f, 0x6c, 0x65, 0x12, 0x0f, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x52, 0x6f, 0x6c, 0x65,
-	0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x0e, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x42, 0x61, 0x73, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x22, 0x1a, 0xd2, 0xc1, 0x18, 0x16, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61,
-	0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x72, 0x6f, 0x6c, 0x65, 0x2f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x12, 0x3e, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x0b,
-	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x49, 0x44, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x62, 0x61,
-	0x73, 0x65, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x13, 0xe2, 0xc1, 0x18,
-	0x0f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x72, 0x6f, 0x6c, 0x65,
-	0x12, 0x41, 0x0a, 0x08, 0x52, 0x6f, 0x6c, 0x65, 0x42, 0x79, 0x49, 0x44, 0x12, 0x0b, 0x2e, 0x62,
-	0x61, 0x73, 0x65, 0x2e, 0x49, 0x44, 0x52, 0x65, 0x71, 0x1a, 0x13, 0x2e, 0x61, 0x64, 0x6d, 0x69,
-	0x6e, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x22, 0x13,
-	0xca, 0xc1, 0x18, 0x0f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x72,
-	0x6f, 0x6c, 0x65, 0x12, 0x4d, 0x0a, 0x08, 0x52, 0x6f, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12,
-	0x12, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74,
-	0x52, 0x65, 0x71, 0x1a, 0x13, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x52, 0x6f, 0x6c, 0x65,
-	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x22, 0x18, 0xd2, 0xc1, 0x18, 0x14, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x72, 0x6f, 0x6c, 0x65, 0x2f, 0x6c, 0x69,
-	0x73, 0x74, 0x12, 0x53, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x13, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x62, 0x61,
-	0x73, 0x65, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x1a, 0xd2, 0xc1, 0x18,
-	0x16, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x72, 0x6f, 0x6c, 0x65,
-	0x2f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x32, 0xef, 0x01, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x12, 0x4c, 0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x12, 0x10, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e,
-	0x66, 0x6f, 0x1a, 0x0e, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x22, 0x1b, 0xd2, 0xc1, 0x18, 0x17, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d,
-	0x69, 0x6e, 0x2f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12,
-	0x45, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x10,
-	0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71,
-	0x1a, 0x0e, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x22, 0x14, 0xe2, 0xc1, 0x18, 0x10, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e,
-	0x2f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x51, 0x0a, 0x09, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4c,
-	0x69, 0x73, 0x74, 0x12, 0x13, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x54, 0x6f, 0x6b, 0x65,
-	0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e,
-	0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x22, 0x19,
-	0xd2, 0xc1, 0x18, 0x15, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x74,
-	0x6f, 0x6b, 0x65, 0x6e, 0x2f, 0x6c, 0x69, 0x73, 0x74, 0x32, 0xf8, 0x05, 0x0a, 0x0a, 0x64, 0x69,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79, 0x12, 0x55, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79, 0x12, 0x15, 0x2e, 0x61,
-	0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79, 0x49,
-	0x6e, 0x66, 0x6f, 0x1a, 0x0e, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x22, 0x1a, 0xd2, 0xc1, 0x18, 0x16, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64,
-	0x6d, 0x69, 0x6e, 0x2f, 0x64, 0x69, 0x63, 0x74, 0x2f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12,
-	0x55, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x61, 0x72, 0x79, 0x12, 0x15, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x44, 0x69, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x0e, 0x2e, 0x62, 0x61, 0x73,
-	0x65, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x1a, 0xd2, 0xc1, 0x18, 0x16,
-	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x64, 0x69, 0x63, 0x74, 0x2f,
-	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x44, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79, 0x12, 0x0b, 0x2e, 0x62, 0x61, 0x73,
-	0x65, 0x2e, 0x49, 0x44, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x42,
-	0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x13, 0xe2, 0xc1, 0x18, 0x0f, 0x2f, 0x61, 0x70,
-	0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x64, 0x69, 0x63, 0x74, 0x12, 0x5f, 0x0a, 0x0e,
-	0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x18,
-	0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72,
-	0x79, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e,
-	0x2e, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x22, 0x18, 0xd2, 0xc1, 0x18, 0x14, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64,
-	0x6d, 0x69, 0x6e, 0x2f, 0x64, 0x69, 0x63, 0x74, 0x2f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x64, 0x0a,
-	0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72,
-	0x79, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x17, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e,
-	0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c,
-	0x1a, 0x0e, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x22, 0x21, 0xd2, 0xc1, 0x18, 0x1d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e,
-	0x2f, 0x64, 0x69, 0x63, 0x74, 0x2f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x2f, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x12, 0x64, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x69, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x17, 0x2e,
-	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79,
-	0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x1a, 0x0e, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x42, 0x61,
-	0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x21, 0xd2, 0xc1, 0x18, 0x1d, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x64, 0x69, 0x63, 0x74, 0x2f, 0x64, 0x65, 0x74, 0x61,
-	0x69, 0x6c, 0x2f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x51, 0x0a, 0x16, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79, 0x44, 0x65, 0x74,
-	0x61, 0x69, 0x6c, 0x12, 0x0b, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x49, 0x44, 0x52, 0x65, 0x71,
-	0x1a, 0x0e, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x22, 0x1a, 0xe2, 0xc1, 0x18, 0x16, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e,
-	0x2f, 0x64, 0x69, 0x63, 0x74, 0x2f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x76, 0x0a, 0x16,
-	0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x42, 0x79, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61,
-	0x72, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x44,
-	0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52,
-	0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x44, 0x69, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x61, 0x72, 0x79, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x22, 0x1f, 0xd2, 0xc1, 0x18, 0x1b, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64,
-	0x6d, 0x69, 0x6e, 0x2f, 0x64, 0x69, 0x63, 0x74, 0x2f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x2f,
-	0x6c, 0x69, 0x73, 0x74, 0x32, 0x96, 0x04, 0x0a, 0x05, 0x6f, 0x61, 0x75, 0x74, 0x68, 0x12, 0x5b,
-	0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
-	0x12, 0x13, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
-	0x72, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x0e, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x42, 0x61, 0x73,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x24, 0xd2, 0xc1, 0x18, 0x20, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x6f, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x70, 0x72, 0x6f, 0x76,
-	0x69, 0x64, 0x65, 0x72, 0x2f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x5b, 0x0a, 0x0e, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x13, 0x2e,
-	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x49, 0x6e,
-	0x66, 0x6f, 0x1a, 0x0e, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x22, 0x24, 0xd2, 0xc1, 0x18, 0x20, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d,
-	0x69, 0x6e, 0x2f, 0x6f, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
-	0x72, 0x2f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x4c, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x0b, 0x2e, 0x62, 0x61, 0x73,
-	0x65, 0x2e, 0x49, 0x44, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x42,
-	0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x1d, 0xe2, 0xc1, 0x18, 0x19, 0x2f, 0x61, 0x70,
-	0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x6f, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x70, 0x72,
-	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x61, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f,
-	0x76, 0x69, 0x64, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x11, 0x2e, 0x62, 0x61, 0x73, 0x65,
-	0x2e, 0x50, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x1a, 0x17, 0x2e, 0x61,
-	0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x4c, 0x69, 0x73,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x22, 0x22, 0xd2, 0xc1, 0x18, 0x1e, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x6f, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x70, 0x72, 0x6f, 0x76,
-	0x69, 0x64, 0x65, 0x72, 0x2f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x52, 0x0a, 0x0a, 0x4f, 0x61, 0x75,
-	0x74, 0x68, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x14, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e,
-	0x4f, 0x61, 0x75, 0x74, 0x68, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x18, 0x2e,
-	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x4f, 0x61, 0x75, 0x74, 0x68, 0x52, 0x65, 0x64, 0x69, 0x72,
-	0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x22, 0x14, 0xd2, 0xc1, 0x18, 0x10, 0x2f, 0x61, 0x70,
-	0x69, 0x2f, 0x6f, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x4e, 0x0a,
-	0x0d, 0x4f, 0x61, 0x75, 0x74, 0x68, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x12,
-	0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52,
-	0x65, 0x71, 0x1a, 0x10, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e,
-	0x52, 0x65, 0x73, 0x70, 0x22, 0x17, 0xca, 0xc1, 0x18, 0x13, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6f,
-	0x61, 0x75, 0x74, 0x68, 0x2f, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x32, 0xa2, 0x01,
-	0x0a, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x12, 0x50, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x67,
-	0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x12, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x4c, 0x6f,
-	0x67, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x13, 0x2e, 0x61, 0x64, 0x6d, 0x69,
-	0x6e, 0x2e, 0x4c, 0x6f, 0x67, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x22, 0x18,
-	0xd2, 0xc1, 0x18, 0x14, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x6c,
-	0x6f, 0x67, 0x73, 0x2f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x48, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x4c, 0x6f, 0x67, 0x73, 0x12, 0x0b, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x1a, 0x0e, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x22, 0x1d, 0xe2, 0xc1, 0x18, 0x19, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64,
-	0x6d, 0x69, 0x6e, 0x2f, 0x6c, 0x6f, 0x67, 0x73, 0x2f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41,
-	0x6c, 0x6c, 0x42, 0x1b, 0x5a, 0x19, 0x66, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x67, 0x6f, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	ID             uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID" form:"ID" query:"ID"`
+	CreatedAt      string `protobuf:"bytes,2,opt,name=createdAt,proto3" json:"createdAt" form:"createdAt" query:"createdAt"`
+	UpdatedAt      string `protobuf:"bytes,3,opt,name=updatedAt,proto3" json:"updatedAt" form:"updatedAt" query:"updatedAt"`
+	UserID         uint64 `protobuf:"varint,4,opt,name=userID,proto3" json:"userID" form:"userID" query:"userID"`
+	UserName       string `protobuf:"bytes,5,opt,name=userName,proto3" json:"userName" form:"userName" query:"userName"`
+	Token          string `protobuf:"bytes,6,opt,name=token,proto3" json:"token" form:"token" query:"token"`
+	Source         string `protobuf:"bytes,7,opt,name=source,proto3" json:"source" form:"source" query:"source"`
+	ExpiredAt      string `protobuf:"bytes,8,opt,name=expiredAt,proto3" json:"expiredAt" form:"expiredAt" query:"expiredAt"`
+	Jti            string `protobuf:"bytes,9,opt,name=jti,proto3" json:"jti" form:"jti" query:"jti"`
+	Device         string `protobuf:"bytes,10,opt,name=device,proto3" json:"device" form:"device" query:"device"`
+	Ip             string `protobuf:"bytes,11,opt,name=ip,proto3" json:"ip" form:"ip" query:"ip"`
+	UserAgent      string `protobuf:"bytes,12,opt,name=userAgent,proto3" json:"userAgent" form:"userAgent" query:"userAgent"`
+	IssuedAt       string `protobuf:"bytes,13,opt,name=issuedAt,proto3" json:"issuedAt" form:"issuedAt" query:"issuedAt"`
+	ImpersonatorID uint64 `protobuf:"varint,14,opt,name=impersonatorID,proto3" json:"impersonatorID" form:"impersonatorID" query:"impersonatorID"`
 }
 
-var (
-	file_admin_proto_rawDescOnce sync.Once
-	file_admin_proto_rawDescData = file_admin_proto_rawDesc
-)
+func (x *TokenInfo) Reset() {
+	*x = TokenInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_admin_proto_rawDescGZIP() []byte {
-	file_admin_proto_rawDescOnce.Do(func() {
-		file_admin_proto_rawDescData = protoimpl.X.CompressGZIP(file_admin_proto_rawDescData)
-	})
-	return file_admin_proto_rawDescData
+func (x *TokenInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 56)
-var file_admin_proto_goTypes = []interface{}{
-	(*StructReq)(nil),                     // 0: admin.StructReq
-	(*StructResp)(nil),                    // 1: admin.StructResp
-	(*ProtoResp)(nil),                     // 2: admin.ProtoResp
-	(*CaptchaInfoResp)(nil),               // 3: admin.CaptchaInfoResp
-	(*UpdatePolicyReq)(nil),               // 4: admin.UpdatePolicyReq
-	(*PolicyPartInfo)(nil),                // 5: admin.PolicyPartInfo
-	(*CreatePolicyReq)(nil),               // 6: admin.CreatePolicyReq
-	(*LoginReq)(nil),                      // 7: admin.LoginReq
-	(*SimpleRole)(nil),                    // 8: admin.SimpleRole
-	(*LoginResp)(nil),                     // 9: admin.LoginResp
-	(*ProfileResp)(nil),                   // 10: admin.ProfileResp
-	(*ProfileReq)(nil),                    // 11: admin.ProfileReq
-	(*RegisterReq)(nil),                   // 12: admin.RegisterReq
-	(*ChangePasswordReq)(nil),             // 13: admin.ChangePasswordReq
-	(*CreateOrUpdateUserReq)(nil),         // 14: admin.CreateOrUpdateUserReq
-	(*UserInfoResp)(nil),                  // 15: admin.UserInfoResp
-	(*UserListResp)(nil),                  // 16: admin.UserListResp
-	(*UserListReq)(nil),                   // 17: admin.UserListReq
-	(*PermCodeResp)(nil),                  // 18: admin.PermCodeResp
-	(*ApiInfo)(nil),                       // 19: admin.ApiInfo
-	(*ApiListResp)(nil),                   // 20: admin.ApiListResp
-	(*ApiPageReq)(nil),                    // 21: admin.ApiPageReq
-	(*ApiAuthorityInfo)(nil),              // 22: admin.ApiAuthorityInfo
-	(*CreateOrUpdateApiAuthorityReq)(nil), // 23: admin.CreateOrUpdateApiAuthorityReq
-	(*ApiAuthorityListInfoResp)(nil),      // 24: admin.ApiAuthorityListInfoResp
-	(*MenuAuthorityInfoReq)(nil),          // 25: admin.MenuAuthorityInfoReq
-	(*MenuAuthorityInfoResp)(nil),         // 26: admin.MenuAuthorityInfoResp
-	(*CreateOrUpdateMenuReq)(nil),         // 27: admin.CreateOrUpdateMenuReq
-	(*MenuInfo)(nil),                      // 28: admin.MenuInfo
-	(*Meta)(nil),                          // 29: admin.Meta
-	(*MenuListReq)(nil),                   // 30: admin.MenuListReq
-	(*MenuInfoListResp)(nil),              // 31: admin.MenuInfoListResp
-	(*MenuListBase)(nil),                  // 32: admin.MenuListBase
-	(*RoleInfo)(nil),                      // 33: admin.RoleInfo
-	(*RoleInfoResp)(nil),                  // 34: admin.RoleInfoResp
-	(*RoleListReq)(nil),                   // 35: admin.RoleListReq
-	(*RoleListResp)(nil),                  // 36: admin.RoleListResp
-	(*TokenInfo)(nil),                     // 37: admin.TokenInfo
-	(*TokenListResp)(nil),                 // 38: admin.TokenListResp
-	(*TokenListReq)(nil),                  // 39: admin.TokenListReq
-	(*DeleteReq)(nil),                     // 40: admin.DeleteReq
-	(*DictionaryInfo)(nil),                // 41: admin.DictionaryInfo
-	(*DictionaryListResp)(nil),            // 42: admin.DictionaryListResp
-	(*DictionaryDetail)(nil),              // 43: admin.DictionaryDetail
-	(*DictionaryDetailListResp)(nil),      // 44: admin.DictionaryDetailListResp
-	(*DictionaryDetailReq)(nil),           // 45: admin.DictionaryDetailReq
-	(*DictionaryPageReq)(nil),             // 46: admin.DictionaryPageReq
-	(*OauthLoginReq)(nil),                 // 47: admin.OauthLoginReq
-	(*OauthRedirectResp)(nil),             // 48: admin.OauthRedirectResp
-	(*ProviderInfo)(nil),                  // 49: admin.ProviderInfo
-	(*ProviderListReq)(nil),               // 50: admin.ProviderListReq
-	(*ProviderListResp)(nil),              // 51: admin.ProviderListResp
-	(*CallbackReq)(nil),                   // 52: admin.CallbackReq
-	(*LogsInfo)(nil),                      // 53: admin.LogsInfo
-	(*LogsListReq)(nil),                   // 54: admin.LogsListReq
-	(*LogsListResp)(nil),                  // 55: admin.LogsListResp
-	(base.ErrCode)(0),                     // 56: base.ErrCode
-	(*base.Empty)(nil),                    // 57: base.Empty
-	(*base.IDReq)(nil),                    // 58: base.IDReq
-	(*base.StatusCodeReq)(nil),            // 59: base.StatusCodeReq
-	(*base.PageInfoReq)(nil),              // 60: base.PageInfoReq
-	(*base.BaseResp)(nil),                 // 61: base.BaseResp
+func (*TokenInfo) ProtoMessage() {}
+
+func (x *TokenInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_admin_proto_depIdxs = []int32{
-	56, // 0: admin.StructResp.errCode:type_name -> base.ErrCode
-	56, // 1: admin.ProtoResp.errCode:type_name -> base.ErrCode
-	56, // 2: admin.CaptchaInfoResp.errCode:type_name -> base.ErrCode
-	5,  // 3: admin.UpdatePolicyReq.rules:type_name -> admin.PolicyPartInfo
-	5,  // 4: admin.CreatePolicyReq.info:type_name -> admin.PolicyPartInfo
-	56, // 5: admin.ProfileResp.errCode:type_name -> base.ErrCode
-	56, // 6: admin.UserInfoResp.errCode:type_name -> base.ErrCode
-	56, // 7: admin.UserListResp.errCode:type_name -> base.ErrCode
-	15, // 8: admin.UserListResp.data:type_name -> admin.UserInfoResp
-	56, // 9: admin.PermCodeResp.errCode:type_name -> base.ErrCode
-	56, // 10: admin.ApiListResp.errCode:type_name -> base.ErrCode
-	19, // 11: admin.ApiListResp.data:type_name -> admin.ApiInfo
-	22, // 12: admin.CreateOrUpdateApiAuthorityReq.data:type_name -> admin.ApiAuthorityInfo
-	56, // 13: admin.ApiAuthorityListInfoResp.errCode:type_name -> base.ErrCode
-	22, // 14: admin.ApiAuthorityListInfoResp.data:type_name -> admin.ApiAuthorityInfo
-	56, // 15: admin.MenuAuthorityInfoResp.errCode:type_name -> base.ErrCode
-	29, // 16: admin.CreateOrUpdateMenuReq.meta:type_name -> admin.Meta
-	28, // 17: admin.MenuInfo.children:type_name -> admin.MenuInfo
-	29, // 18: admin.MenuInfo.meta:type_name -> admin.Meta
-	56, // 19: admin.MenuInfoListResp.errCode:type_name -> base.ErrCode
-	28, // 20: admin.MenuInfoListResp.data:type_name -> admin.MenuInfo
-	32, // 21: admin.MenuListBase.children:type_name -> admin.MenuListBase
-	29, // 22: admin.MenuListBase.meta:type_name -> admin.Meta
-	56, // 23: admin.RoleInfoResp.errCode:type_name -> base.ErrCode
-	56, // 24: admin.RoleListResp.errCode:type_name -> base.ErrCode
-	33, // 25: admin.RoleListResp.data:type_name -> admin.RoleInfo
-	56, // 26: admin.TokenListResp.errCode:type_name -> base.ErrCode
-	37, // 27: admin.TokenListResp.data:type_name -> admin.TokenInfo
-	56, // 28: admin.DictionaryListResp.errCode:type_name -> base.ErrCode
-	41, // 29: admin.DictionaryListResp.data:type_name -> admin.DictionaryInfo
-	56, // 30: admin.DictionaryDetailListResp.errCode:type_name -> base.ErrCode
-	43, // 31: admin.DictionaryDetailListResp.data:type_name -> admin.DictionaryDetail
-	56, // 32: admin.OauthRedirectResp.errCode:type_name -> base.ErrCode
-	56, // 33: admin.ProviderListResp.errCode:type_name -> base.ErrCode
-	49, // 34: admin.ProviderListResp.data:type_name -> admin.ProviderInfo
-	56, // 35: admin.LogsListResp.errCode:type_name -> base.ErrCode
-	53, // 36: admin.LogsListResp.data:type_name -> admin.LogsInfo
-	57, // 37: admin.admin.InitDatabase:input_type -> base.Empty
-	57, // 38: admin.admin.HealthCheck:input_type -> base.Empty
-	57, // 39: admin.admin.Captcha:input_type -> base.Empty
-	0,  // 40: admin.admin.DeleteStructTag:input_type -> admin.StructReq
-	0,  // 41: admin.admin.StructToProto:input_type -> admin.StructReq
-	12, // 42: admin.user.Register:input_type -> admin.RegisterReq
-	57, // 43: admin.user.UserPermCode:input_type -> base.Empty
-	13, // 44: admin.user.ChangePassword:input_type -> admin.ChangePasswordReq
-	14, // 45: admin.user.CreateUser:input_type -> admin.CreateOrUpdateUserReq
-	14, // 46: admin.user.UpdateUser:input_type -> admin.CreateOrUpdateUserReq
-	57, // 47: admin.user.UserInfo:input_type -> base.Empty
-	17, // 48: admin.user.UserList:input_type -> admin.UserListReq
-	58, // 49: admin.user.DeleteUser:input_type -> base.IDReq
-	11, // 50: admin.user.UpdateProfile:input_type -> admin.ProfileReq
-	57, // 51: admin.user.UserProfile:input_type -> base.Empty
-	59, // 52: admin.user.UpdateUserStatus:input_type -> base.StatusCodeReq
-	19, // 53: admin.apis.CreateApi:input_type -> admin.ApiInfo
-	19, // 54: admin.apis.UpdateApi:input_type -> admin.ApiInfo
-	58, // 55: admin.apis.DeleteApi:input_type -> base.IDReq
-	21, // 56: admin.apis.ApiList:input_type -> admin.ApiPageReq
-	23, // 57: admin.authority.CreateAuthority:input_type -> admin.CreateOrUpdateApiAuthorityReq
-	23, // 58: admin.authority.UpdateApiAuthority:input_type -> admin.CreateOrUpdateApiAuthorityReq
-	58, // 59: admin.authority.ApiAuthority:input_type -> base.IDReq
-	25, // 60: admin.authority.CreateMenuAuthority:input_type -> admin.MenuAuthorityInfoReq
-	25, // 61: admin.authority.UpdateMenuAuthority:input_type -> admin.MenuAuthorityInfoReq
-	58, // 62: admin.authority.MenuAuthority:input_type -> base.IDReq
-	27, // 63: admin.menu.CreateMenu:input_type -> admin.CreateOrUpdateMenuReq
-	27, // 64: admin.menu.UpdateMenu:input_type -> admin.CreateOrUpdateMenuReq
-	58, // 65: admin.menu.DeleteMenu:input_type -> base.IDReq
-	57, // 66: admin.menu.MenuByRole:input_type -> base.Empty
-	30, // 67: admin.menu.MenuList:input_type -> admin.MenuListReq
-	33, // 68: admin.role.CreateRole:input_type -> admin.RoleInfo
-	33, // 69: admin.role.UpdateRole:input_type -> admin.RoleInfo
-	58, // 70: admin.role.DeleteRole:input_type -> base.IDReq
-	58, // 71: admin.role.RoleByID:input_type -> base.IDReq
-	35, // 72: admin.role.RoleList:input_type -> admin.RoleListReq
-	59, // 73: admin.role.UpdateRoleStatus:input_type -> base.StatusCodeReq
-	37, // 74: admin.token.UpdateToken:input_type -> admin.TokenInfo
-	40, // 75: admin.token.DeleteToken:input_type -> admin.DeleteReq
-	39, // 76: admin.token.TokenList:input_type -> admin.TokenListReq
-	41, // 77: admin.dictionary.CreateDictionary:input_type -> admin.DictionaryInfo
-	41, // 78: admin.dictionary.UpdateDictionary:input_type -> admin.DictionaryInfo
-	58, // 79: admin.dictionary.DeleteDictionary:input_type -> base.IDReq
-	46, // 80: admin.dictionary.DictionaryList:input_type -> admin.DictionaryPageReq
-	43, // 81: admin.dictionary.CreateDictionaryDetail:input_type -> admin.DictionaryDetail
-	43, // 82: admin.dictionary.UpdateDictionaryDetail:input_type -> admin.DictionaryDetail
-	58, // 83: admin.dictionary.DeleteDictionaryDetail:input_type -> base.IDReq
-	45, // 84: admin.dictionary.DetailByDictionaryName:input_type -> admin.DictionaryDetailReq
-	49, // 85: admin.oauth.CreateProvider:input_type -> admin.ProviderInfo
-	49, // 86: admin.oauth.UpdateProvider:input_type -> admin.ProviderInfo
-	58, // 87: admin.oauth.DeleteProvider:input_type -> base.IDReq
-	60, // 88: admin.oauth.GetProviderList:input_type -> base.PageInfoReq
-	47, // 89: admin.oauth.OauthLogin:input_type -> admin.OauthLoginReq
-	52, // 90: admin.oauth.OauthCallback:input_type -> admin.CallbackReq
-	54, // 91: admin.logs.GetLogsList:input_type -> admin.LogsListReq
-	57, // 92: admin.logs.DeleteLogs:input_type -> base.Empty
-	61, // 93: admin.admin.InitDatabase:output_type -> base.BaseResp
-	61, // 94: admin.admin.HealthCheck:output_type -> base.BaseResp
-	3,  // 95: admin.admin.Captcha:output_type -> admin.CaptchaInfoResp
-	1,  // 96: admin.admin.DeleteStructTag:output_type -> admin.StructResp
-	2,  // 97: admin.admin.StructToProto:output_type -> admin.ProtoResp
-	61, // 98: admin.user.Register:output_type -> base.BaseResp
-	18, // 99: admin.user.UserPermCode:output_type -> admin.PermCodeResp
-	61, // 100: admin.user.ChangePassword:output_type -> base.BaseResp
-	61, // 101: admin.user.CreateUser:output_type -> base.BaseResp
-	61, // 102: admin.user.UpdateUser:output_type -> base.BaseResp
-	15, // 103: admin.user.UserInfo:output_type -> admin.UserInfoResp
-	16, // 104: admin.user.UserList:output_type -> admin.UserListResp
-	61, // 105: admin.user.DeleteUser:output_type -> base.BaseResp
-	61, // 106: admin.user.UpdateProfile:output_type -> base.BaseResp
-	10, // 107: admin.user.UserProfile:output_type -> admin.ProfileResp
-	61, // 108: admin.user.UpdateUserStatus:output_type -> base.BaseResp
-	61, // 109: admin.apis.CreateApi:output_type -> base.BaseResp
-	61, // 110: admin.apis.UpdateApi:output_type -> base.BaseResp
-	61, // 111: admin.apis.DeleteApi:output_type -> base.BaseResp
-	20, // 112: admin.apis.ApiList:output_type -> admin.ApiListResp
-	61, // 113: admin.authority.CreateAuthority:output_type -> base.BaseResp
-	61, // 114: admin.authority.UpdateApiAuthority:output_type -> base.BaseResp
-	24, // 115: admin.authority.ApiAuthority:output_type -> admin.ApiAuthorityListInfoResp
-	61, // 116: admin.authority.CreateMenuAuthority:output_type -> base.BaseResp
-	61, // 117: admin.authority.UpdateMenuAuthority:output_type -> base.BaseResp
-	26, // 118: admin.authority.MenuAuthority:output_type -> admin.MenuAuthorityInfoResp
-	61, // 119: admin.menu.CreateMenu:output_type -> base.BaseResp
-	61, // 120: admin.menu.UpdateMenu:output_type -> base.BaseResp
-	61, // 121: admin.menu.DeleteMenu:output_type -> base.BaseResp
-	31, // 122: admin.menu.MenuByRole:output_type -> admin.MenuInfoListResp
-	31, // 123: admin.menu.MenuList:output_type -> admin.MenuInfoListResp
-	61, // 124: admin.role.CreateRole:output_type -> base.BaseResp
-	61, // 125: admin.role.UpdateRole:output_type -> base.BaseResp
-	61, // 126: admin.role.DeleteRole:output_type -> base.BaseResp
-	34, // 127: admin.role.RoleByID:output_type -> admin.RoleInfoResp
-	36, // 128: admin.role.RoleList:output_type -> admin.RoleListResp
-	61, // 129: admin.role.UpdateRoleStatus:output_type -> base.BaseResp
-	61, // 130: admin.token.UpdateToken:output_type -> base.BaseResp
-	61, // 131: admin.token.DeleteToken:output_type -> base.BaseResp
-	38, // 132: admin.token.TokenList:output_type -> admin.TokenListResp
-	61, // 133: admin.dictionary.CreateDictionary:output_type -> base.BaseResp
-	61, // 134: admin.dictionary.UpdateDictionary:output_type -> base.BaseResp
-	61, // 135: admin.dictionary.DeleteDictionary:output_type -> base.BaseResp
-	42, // 136: admin.dictionary.DictionaryList:output_type -> admin.DictionaryListResp
-	61, // 137: admin.dictionary.CreateDictionaryDetail:output_type -> base.BaseResp
-	61, // 138: admin.dictionary.UpdateDictionaryDetail:output_type -> base.BaseResp
-	61, // 139: admin.dictionary.DeleteDictionaryDetail:output_type -> base.BaseResp
-	44, // 140: admin.dictionary.DetailByDictionaryName:output_type -> admin.DictionaryDetailListResp
-	61, // 141: admin.oauth.CreateProvider:output_type -> base.BaseResp
-	61, // 142: admin.oauth.UpdateProvider:output_type -> base.BaseResp
-	61, // 143: admin.oauth.DeleteProvider:output_type -> base.BaseResp
-	51, // 144: admin.oauth.GetProviderList:output_type -> admin.ProviderListResp
-	48, // 145: admin.oauth.OauthLogin:output_type -> admin.OauthRedirectResp
-	9,  // 146: admin.oauth.OauthCallback:output_type -> admin.LoginResp
-	55, // 147: admin.logs.GetLogsList:output_type -> admin.LogsListResp
-	61, // 148: admin.logs.DeleteLogs:output_type -> base.BaseResp
-	93, // [93:149] is the sub-list for method output_type
-	37, // [37:93] is the sub-list for method input_type
-	37, // [37:37] is the sub-list for extension type_name
-	37, // [37:37] is the sub-list for extension extendee
-	0,  // [0:37] is the sub-list for field type_name
+
+// Deprecated: Use TokenInfo.ProtoReflect.Descriptor instead.
+func (*TokenInfo) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{57}
 }
 
-func init() { file_admin_proto_init() }
-func file_admin_proto_init() {
-	if File_admin_proto != nil {
-		return
+func (x *TokenInfo) GetID() uint64 {
+	if x != nil {
+		return x.ID
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_admin_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StructReq); i {
+	return 0
+}
+
+func (x *TokenInfo) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *TokenInfo) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *TokenInfo) GetUserID() uint64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *TokenInfo) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *TokenInfo) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *TokenInfo) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *TokenInfo) GetExpiredAt() string {
+	if x != nil {
+		return x.ExpiredAt
+	}
+	return ""
+}
+
+func (x *TokenInfo) GetJti() string {
+	if x != nil {
+		return x.Jti
+	}
+	return ""
+}
+
+func (x *TokenInfo) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+func (x *TokenInfo) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *TokenInfo) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *TokenInfo) GetIssuedAt() string {
+	if x != nil {
+		return x.IssuedAt
+	}
+	return ""
+}
+
+func (x *TokenInfo) GetImpersonatorID() uint64 {
+	if x != nil {
+		return x.ImpersonatorID
+	}
+	return 0
+}
+
+// The response data of Token list | Token列表返回数据
+type TokenListResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ErrCode base.ErrCode `protobuf:"varint,1,opt,name=errCode,proto3,enum=base.ErrCode" json:"errCode" form:"errCode" query:"errCode"`
+	ErrMsg  string       `protobuf:"bytes,2,opt,name=errMsg,proto3" json:"errMsg" form:"errMsg" query:"errMsg"`
+	Total   uint64       `protobuf:"varint,3,opt,name=total,proto3" json:"total" form:"total" query:"total"`
+	Data    []*TokenInfo `protobuf:"bytes,4,rep,name=data,proto3" json:"data" form:"data" query:"data"`
+}
+
+func (x *TokenListResp) Reset() {
+	*x = TokenListResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TokenListResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenListResp) ProtoMessage() {}
+
+func (x *TokenListResp) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenListResp.ProtoReflect.Descriptor instead.
+func (*TokenListResp) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *TokenListResp) GetErrCode() base.ErrCode {
+	if x != nil {
+		return x.ErrCode
+	}
+	return base.ErrCode(0)
+}
+
+func (x *TokenListResp) GetErrMsg() string {
+	if x != nil {
+		return x.ErrMsg
+	}
+	return ""
+}
+
+func (x *TokenListResp) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *TokenListResp) GetData() []*TokenInfo {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// Get token list request params | token列表请求参数
+type TokenListReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Page     uint64 `protobuf:"varint,1,opt,name=page,proto3" json:"page" form:"page" query:"page"`
+	PageSize uint64 `protobuf:"varint,2,opt,name=pageSize,proto3" json:"pageSize" form:"pageSize" query:"pageSize"`
+	Username string `protobuf:"bytes,3,opt,name=username,proto3" json:"username" form:"username" query:"username"`
+	UserID   uint64 `protobuf:"varint,4,opt,name=userID,proto3" json:"userID" form:"userID" query:"userID"`
+}
+
+func (x *TokenListReq) Reset() {
+	*x = TokenListReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TokenListReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenListReq) ProtoMessage() {}
+
+func (x *TokenListReq) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenListReq.ProtoReflect.Descriptor instead.
+func (*TokenListReq) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *TokenListReq) GetPage() uint64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *TokenListReq) GetPageSize() uint64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *TokenListReq) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *TokenListReq) GetUserID() uint64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+// Delete request params, delete the session by jti if set, or all sessions of the user | token删除请求参数, 传jti时仅删除该会话, 否则删除用户所有会话
+type DeleteReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID uint64 `protobuf:"varint,1,opt,name=userID,proto3" json:"userID" form:"userID" query:"userID"`
+	Jti    string `protobuf:"bytes,2,opt,name=jti,proto3" json:"jti" form:"jti" query:"jti"`
+}
+
+func (x *DeleteReq) Reset() {
+	*x = DeleteReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteReq) ProtoMessage() {}
+
+func (x *DeleteReq) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteReq.ProtoReflect.Descriptor instead.
+func (*DeleteReq) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *DeleteReq) GetUserID() uint64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *DeleteReq) GetJti() string {
+	if x != nil {
+		return x.Jti
+	}
+	return ""
+}
+
+// The active session of a user | 用户的活跃会话
+type SessionInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Jti            string `protobuf:"bytes,1,opt,name=jti,proto3" json:"jti" form:"jti" query:"jti"`
+	UserID         uint64 `protobuf:"varint,2,opt,name=userID,proto3" json:"userID" form:"userID" query:"userID"`
+	UserName       string `protobuf:"bytes,3,opt,name=userName,proto3" json:"userName" form:"userName" query:"userName"`
+	Source         string `protobuf:"bytes,4,opt,name=source,proto3" json:"source" form:"source" query:"source"`
+	Device         string `protobuf:"bytes,5,opt,name=device,proto3" json:"device" form:"device" query:"device"`
+	Ip             string `protobuf:"bytes,6,opt,name=ip,proto3" json:"ip" form:"ip" query:"ip"`
+	UserAgent      string `protobuf:"bytes,7,opt,name=userAgent,proto3" json:"userAgent" form:"userAgent" query:"userAgent"`
+	IssuedAt       string `protobuf:"bytes,8,opt,name=issuedAt,proto3" json:"issuedAt" form:"issuedAt" query:"issuedAt"`
+	ExpiredAt      string `protobuf:"bytes,9,opt,name=expiredAt,proto3" json:"expiredAt" form:"expiredAt" query:"expiredAt"`
+	ImpersonatorID uint64 `protobuf:"varint,10,opt,name=impersonatorID,proto3" json:"impersonatorID" form:"impersonatorID" query:"impersonatorID"`
+	// the session of the request | 当前请求所属会话
+	Current bool `protobuf:"varint,11,opt,name=current,proto3" json:"current" form:"current" query:"current"`
+}
+
+func (x *SessionInfo) Reset() {
+	*x = SessionInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SessionInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionInfo) ProtoMessage() {}
+
+func (x *SessionInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionInfo.ProtoReflect.Descriptor instead.
+func (*SessionInfo) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *SessionInfo) GetJti() string {
+	if x != nil {
+		return x.Jti
+	}
+	return ""
+}
+
+func (x *SessionInfo) GetUserID() uint64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *SessionInfo) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *SessionInfo) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *SessionInfo) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+func (x *SessionInfo) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *SessionInfo) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *SessionInfo) GetIssuedAt() string {
+	if x != nil {
+		return x.IssuedAt
+	}
+	return ""
+}
+
+func (x *SessionInfo) GetExpiredAt() string {
+	if x != nil {
+		return x.ExpiredAt
+	}
+	return ""
+}
+
+func (x *SessionInfo) GetImpersonatorID() uint64 {
+	if x != nil {
+		return x.ImpersonatorID
+	}
+	return 0
+}
+
+func (x *SessionInfo) GetCurrent() bool {
+	if x != nil {
+		return x.Current
+	}
+	return false
+}
+
+// Get session list request params | 会话列表请求参数
+type SessionListReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Page     uint64 `protobuf:"varint,1,opt,name=page,proto3" json:"page" form:"page" query:"page"`
+	PageSize uint64 `protobuf:"varint,2,opt,name=pageSize,proto3" json:"pageSize" form:"pageSize" query:"pageSize"`
+	Username string `protobuf:"bytes,3,opt,name=username,proto3" json:"username" form:"username" query:"username"`
+	UserID   uint64 `protobuf:"varint,4,opt,name=userID,proto3" json:"userID" form:"userID" query:"userID"`
+}
+
+func (x *SessionListReq) Reset() {
+	*x = SessionListReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SessionListReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionListReq) ProtoMessage() {}
+
+func (x *SessionListReq) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionListReq.ProtoReflect.Descriptor instead.
+func (*SessionListReq) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *SessionListReq) GetPage() uint64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *SessionListReq) GetPageSize() uint64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *SessionListReq) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *SessionListReq) GetUserID() uint64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+// The response data of session list | 会话列表返回数据
+type SessionListResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ErrCode base.ErrCode   `protobuf:"varint,1,opt,name=errCode,proto3,enum=base.ErrCode" json:"errCode" form:"errCode" query:"errCode"`
+	ErrMsg  string         `protobuf:"bytes,2,opt,name=errMsg,proto3" json:"errMsg" form:"errMsg" query:"errMsg"`
+	Total   uint64         `protobuf:"varint,3,opt,name=total,proto3" json:"total" form:"total" query:"total"`
+	Data    []*SessionInfo `protobuf:"bytes,4,rep,name=data,proto3" json:"data" form:"data" query:"data"`
+}
+
+func (x *SessionListResp) Reset() {
+	*x = SessionListResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SessionListResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionListResp) ProtoMessage() {}
+
+func (x *SessionListResp) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionListResp.ProtoReflect.Descriptor instead.
+func (*SessionListResp) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *SessionListResp) GetErrCode() base.ErrCode {
+	if x != nil {
+		return x.ErrCode
+	}
+	return base.ErrCode(0)
+}
+
+func (x *SessionListResp) GetErrMsg() string {
+	if x != nil {
+		return x.ErrMsg
+	}
+	return ""
+}
+
+func (x *SessionListResp) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *SessionListResp) GetData() []*SessionInfo {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// Terminate session request params | 终止会话请求参数
+type SessionRevokeReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Jti string `protobuf:"bytes,1,opt,name=jti,proto3" json:"jti" form:"jti" query:"jti"`
+}
+
+func (x *SessionRevokeReq) Reset() {
+	*x = SessionRevokeReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SessionRevokeReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionRevokeReq) ProtoMessage() {}
+
+func (x *SessionRevokeReq) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionRevokeReq.ProtoReflect.Descriptor instead.
+func (*SessionRevokeReq) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *SessionRevokeReq) GetJti() string {
+	if x != nil {
+		return x.Jti
+	}
+	return ""
+}
+
+// A login attempt | 登录记录
+type LoginEventInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id" form:"id" query:"id"`
+	// 0 if the user is unknown | 未知用户为0
+	UserID   uint64 `protobuf:"varint,2,opt,name=userID,proto3" json:"userID" form:"userID" query:"userID"`
+	Username string `protobuf:"bytes,3,opt,name=username,proto3" json:"username" form:"username" query:"username"`
+	// password, webauthn, api_key or the OAuth provider | 登录方式
+	Method    string `protobuf:"bytes,4,opt,name=method,proto3" json:"method" form:"method" query:"method"`
+	Ip        string `protobuf:"bytes,5,opt,name=ip,proto3" json:"ip" form:"ip" query:"ip"`
+	UserAgent string `protobuf:"bytes,6,opt,name=userAgent,proto3" json:"userAgent" form:"userAgent" query:"userAgent"`
+	Device    string `protobuf:"bytes,7,opt,name=device,proto3" json:"device" form:"device" query:"device"`
+	Success   bool   `protobuf:"varint,8,opt,name=success,proto3" json:"success" form:"success" query:"success"`
+	Reason    string `protobuf:"bytes,9,opt,name=reason,proto3" json:"reason" form:"reason" query:"reason"`
+	// new_ip, new_device or many_failures | 异常标记
+	Flags     []string `protobuf:"bytes,10,rep,name=flags,proto3" json:"flags" form:"flags" query:"flags"`
+	CreatedAt string   `protobuf:"bytes,11,opt,name=createdAt,proto3" json:"createdAt" form:"createdAt" query:"createdAt"`
+}
+
+func (x *LoginEventInfo) Reset() {
+	*x = LoginEventInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoginEventInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginEventInfo) ProtoMessage() {}
+
+func (x *LoginEventInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginEventInfo.ProtoReflect.Descriptor instead.
+func (*LoginEventInfo) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *LoginEventInfo) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *LoginEventInfo) GetUserID() uint64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *LoginEventInfo) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *LoginEventInfo) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *LoginEventInfo) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *LoginEventInfo) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *LoginEventInfo) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+func (x *LoginEventInfo) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *LoginEventInfo) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *LoginEventInfo) GetFlags() []string {
+	if x != nil {
+		return x.Flags
+	}
+	return nil
+}
+
+func (x *LoginEventInfo) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+// Get login event list request params | 登录记录列表请求参数
+type LoginEventListReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Page     uint64 `protobuf:"varint,1,opt,name=page,proto3" json:"page" form:"page" query:"page"`
+	PageSize uint64 `protobuf:"varint,2,opt,name=pageSize,proto3" json:"pageSize" form:"pageSize" query:"pageSize"`
+	UserID   uint64 `protobuf:"varint,3,opt,name=userID,proto3" json:"userID" form:"userID" query:"userID"`
+	Username string `protobuf:"bytes,4,opt,name=username,proto3" json:"username" form:"username" query:"username"`
+	Method   string `protobuf:"bytes,5,opt,name=method,proto3" json:"method" form:"method" query:"method"`
+	Ip       string `protobuf:"bytes,6,opt,name=ip,proto3" json:"ip" form:"ip" query:"ip"`
+	// true, false or empty for all | true, false或为空表示全部
+	Success string `protobuf:"bytes,7,opt,name=success,proto3" json:"success" form:"success" query:"success"`
+	// only the flagged attempts | 仅异常记录
+	Anomalous bool `protobuf:"varint,8,opt,name=anomalous,proto3" json:"anomalous" form:"anomalous" query:"anomalous"`
+}
+
+func (x *LoginEventListReq) Reset() {
+	*x = LoginEventListReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoginEventListReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginEventListReq) ProtoMessage() {}
+
+func (x *LoginEventListReq) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginEventListReq.ProtoReflect.Descriptor instead.
+func (*LoginEventListReq) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *LoginEventListReq) GetPage() uint64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *LoginEventListReq) GetPageSize() uint64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *LoginEventListReq) GetUserID() uint64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *LoginEventListReq) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *LoginEventListReq) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *LoginEventListReq) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *LoginEventListReq) GetSuccess() string {
+	if x != nil {
+		return x.Success
+	}
+	return ""
+}
+
+func (x *LoginEventListReq) GetAnomalous() bool {
+	if x != nil {
+		return x.Anomalous
+	}
+	return false
+}
+
+// The response data of login event list | 登录记录列表返回数据
+type LoginEventListResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ErrCode base.ErrCode      `protobuf:"varint,1,opt,name=errCode,proto3,enum=base.ErrCode" json:"errCode" form:"errCode" query:"errCode"`
+	ErrMsg  string            `protobuf:"bytes,2,opt,name=errMsg,proto3" json:"errMsg" form:"errMsg" query:"errMsg"`
+	Total   uint64            `protobuf:"varint,3,opt,name=total,proto3" json:"total" form:"total" query:"total"`
+	Data    []*LoginEventInfo `protobuf:"bytes,4,rep,name=data,proto3" json:"data" form:"data" query:"data"`
+}
+
+func (x *LoginEventListResp) Reset() {
+	*x = LoginEventListResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoginEventListResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginEventListResp) ProtoMessage() {}
+
+func (x *LoginEventListResp) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginEventListResp.ProtoReflect.Descriptor instead.
+func (*LoginEventListResp) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *LoginEventListResp) GetErrCode() base.ErrCode {
+	if x != nil {
+		return x.ErrCode
+	}
+	return base.ErrCode(0)
+}
+
+func (x *LoginEventListResp) GetErrMsg() string {
+	if x != nil {
+		return x.ErrMsg
+	}
+	return ""
+}
+
+func (x *LoginEventListResp) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *LoginEventListResp) GetData() []*LoginEventInfo {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// A tenant | 租户信息
+type TenantInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID        uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID" form:"ID" query:"ID"`
+	Name      string `protobuf:"bytes,2,opt,name=name,proto3" json:"name" form:"name" query:"name"`
+	Remark    string `protobuf:"bytes,3,opt,name=remark,proto3" json:"remark" form:"remark" query:"remark"`
+	Status    uint64 `protobuf:"varint,4,opt,name=status,proto3" json:"status" form:"status" query:"status"`
+	CreatedAt string `protobuf:"bytes,5,opt,name=createdAt,proto3" json:"createdAt" form:"createdAt" query:"createdAt"`
+	UpdatedAt string `protobuf:"bytes,6,opt,name=updatedAt,proto3" json:"updatedAt" form:"updatedAt" query:"updatedAt"`
+}
+
+func (x *TenantInfo) Reset() {
+	*x = TenantInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TenantInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TenantInfo) ProtoMessage() {}
+
+func (x *TenantInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TenantInfo.ProtoReflect.Descriptor instead.
+func (*TenantInfo) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *TenantInfo) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *TenantInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TenantInfo) GetRemark() string {
+	if x != nil {
+		return x.Remark
+	}
+	return ""
+}
+
+func (x *TenantInfo) GetStatus() uint64 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *TenantInfo) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *TenantInfo) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+// Create tenant request params | 创建租户请求参数
+type CreateTenantReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name" form:"name" query:"name"`
+	Remark string `protobuf:"bytes,2,opt,name=remark,proto3" json:"remark" form:"remark" query:"remark"`
+	// the first admin of the tenant | 租户的首个管理员
+	AdminUsername string `protobuf:"bytes,3,opt,name=adminUsername,proto3" json:"adminUsername" form:"adminUsername" query:"adminUsername"`
+	AdminPassword string `protobuf:"bytes,4,opt,name=adminPassword,proto3" json:"adminPassword" form:"adminPassword" query:"adminPassword"`
+	AdminEmail    string `protobuf:"bytes,5,opt,name=adminEmail,proto3" json:"adminEmail" form:"adminEmail" query:"adminEmail"`
+	AdminMobile   string `protobuf:"bytes,6,opt,name=adminMobile,proto3" json:"adminMobile" form:"adminMobile" query:"adminMobile"`
+}
+
+func (x *CreateTenantReq) Reset() {
+	*x = CreateTenantReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateTenantReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTenantReq) ProtoMessage() {}
+
+func (x *CreateTenantReq) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTenantReq.ProtoReflect.Descriptor instead.
+func (*CreateTenantReq) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *CreateTenantReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateTenantReq) GetRemark() string {
+	if x != nil {
+		return x.Remark
+	}
+	return ""
+}
+
+func (x *CreateTenantReq) GetAdminUsername() string {
+	if x != nil {
+		return x.AdminUsername
+	}
+	return ""
+}
+
+func (x *CreateTenantReq) GetAdminPassword() string {
+	if x != nil {
+		return x.AdminPassword
+	}
+	return ""
+}
+
+func (x *CreateTenantReq) GetAdminEmail() string {
+	if x != nil {
+		return x.AdminEmail
+	}
+	return ""
+}
+
+func (x *CreateTenantReq) GetAdminMobile() string {
+	if x != nil {
+		return x.AdminMobile
+	}
+	return ""
+}
+
+// Get tenant list request params | 租户列表请求参数
+type TenantListReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Page     uint64 `protobuf:"varint,1,opt,name=page,proto3" json:"page" form:"page" query:"page"`
+	PageSize uint64 `protobuf:"varint,2,opt,name=pageSize,proto3" json:"pageSize" form:"pageSize" query:"pageSize"`
+	Name     string `protobuf:"bytes,3,opt,name=name,proto3" json:"name" form:"name" query:"name"`
+}
+
+func (x *TenantListReq) Reset() {
+	*x = TenantListReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TenantListReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TenantListReq) ProtoMessage() {}
+
+func (x *TenantListReq) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TenantListReq.ProtoReflect.Descriptor instead.
+func (*TenantListReq) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *TenantListReq) GetPage() uint64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *TenantListReq) GetPageSize() uint64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *TenantListReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// The response data of tenant list | 租户列表返回数据
+type TenantListResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ErrCode base.ErrCode  `protobuf:"varint,1,opt,name=errCode,proto3,enum=base.ErrCode" json:"errCode" form:"errCode" query:"errCode"`
+	ErrMsg  string        `protobuf:"bytes,2,opt,name=errMsg,proto3" json:"errMsg" form:"errMsg" query:"errMsg"`
+	Total   uint64        `protobuf:"varint,3,opt,name=total,proto3" json:"total" form:"total" query:"total"`
+	Data    []*TenantInfo `protobuf:"bytes,4,rep,name=data,proto3" json:"data" form:"data" query:"data"`
+}
+
+func (x *TenantListResp) Reset() {
+	*x = TenantListResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TenantListResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TenantListResp) ProtoMessage() {}
+
+func (x *TenantListResp) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TenantListResp.ProtoReflect.Descriptor instead.
+func (*TenantListResp) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *TenantListResp) GetErrCode() base.ErrCode {
+	if x != nil {
+		return x.ErrCode
+	}
+	return base.ErrCode(0)
+}
+
+func (x *TenantListResp) GetErrMsg() string {
+	if x != nil {
+		return x.ErrMsg
+	}
+	return ""
+}
+
+func (x *TenantListResp) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *TenantListResp) GetData() []*TenantInfo {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// The response data of dictionary information | 字典信息
+type DictionaryInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID          uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID" form:"ID" query:"ID"`
+	Title       string `protobuf:"bytes,2,opt,name=title,proto3" json:"title" form:"title" query:"title"`
+	Name        string `protobuf:"bytes,3,opt,name=name,proto3" json:"name" form:"name" query:"name"`
+	Status      uint64 `protobuf:"varint,4,opt,name=status,proto3" json:"status" form:"status" query:"status"`
+	Description string `protobuf:"bytes,5,opt,name=description,proto3" json:"description" form:"description" query:"description"`
+	CreatedAt   string `protobuf:"bytes,6,opt,name=createdAt,proto3" json:"createdAt" form:"createdAt" query:"createdAt"`
+	UpdatedAt   string `protobuf:"bytes,7,opt,name=updatedAt,proto3" json:"updatedAt" form:"updatedAt" query:"updatedAt"`
+}
+
+func (x *DictionaryInfo) Reset() {
+	*x = DictionaryInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DictionaryInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DictionaryInfo) ProtoMessage() {}
+
+func (x *DictionaryInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DictionaryInfo.ProtoReflect.Descriptor instead.
+func (*DictionaryInfo) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *DictionaryInfo) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *DictionaryInfo) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *DictionaryInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DictionaryInfo) GetStatus() uint64 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *DictionaryInfo) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *DictionaryInfo) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *DictionaryInfo) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+// The data of dictionary list | 字典列表数据
+type DictionaryListResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ErrCode base.ErrCode      `protobuf:"varint,1,opt,name=errCode,proto3,enum=base.ErrCode" json:"errCode" form:"errCode" query:"errCode"`
+	ErrMsg  string            `protobuf:"bytes,2,opt,name=errMsg,proto3" json:"errMsg" form:"errMsg" query:"errMsg"`
+	Total   uint64            `protobuf:"varint,3,opt,name=total,proto3" json:"total" form:"total" query:"total"`
+	Data    []*DictionaryInfo `protobuf:"bytes,4,rep,name=data,proto3" json:"data" form:"data" query:"data"`
+}
+
+func (x *DictionaryListResp) Reset() {
+	*x = DictionaryListResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DictionaryListResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DictionaryListResp) ProtoMessage() {}
+
+func (x *DictionaryListResp) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DictionaryListResp.ProtoReflect.Descriptor instead.
+func (*DictionaryListResp) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *DictionaryListResp) GetErrCode() base.ErrCode {
+	if x != nil {
+		return x.ErrCode
+	}
+	return base.ErrCode(0)
+}
+
+func (x *DictionaryListResp) GetErrMsg() string {
+	if x != nil {
+		return x.ErrMsg
+	}
+	return ""
+}
+
+func (x *DictionaryListResp) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *DictionaryListResp) GetData() []*DictionaryInfo {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// The dictionary KV information | 字典键值信息
+type DictionaryDetail struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID        uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID" form:"ID" query:"ID"`
+	Title     string `protobuf:"bytes,2,opt,name=title,proto3" json:"title" form:"title" query:"title"`
+	Key       string `protobuf:"bytes,3,opt,name=key,proto3" json:"key" form:"key" query:"key"`
+	Value     string `protobuf:"bytes,4,opt,name=value,proto3" json:"value" form:"value" query:"value"`
+	Status    uint64 `protobuf:"varint,5,opt,name=status,proto3" json:"status" form:"status" query:"status"`
+	CreatedAt string `protobuf:"bytes,6,opt,name=createdAt,proto3" json:"createdAt" form:"createdAt" query:"createdAt"`
+	UpdatedAt string `protobuf:"bytes,7,opt,name=updatedAt,proto3" json:"updatedAt" form:"updatedAt" query:"updatedAt"`
+	ParentID  uint64 `protobuf:"varint,8,opt,name=parentID,proto3" json:"parentID" form:"parentID" query:"parentID"`
+}
+
+func (x *DictionaryDetail) Reset() {
+	*x = DictionaryDetail{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DictionaryDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DictionaryDetail) ProtoMessage() {}
+
+func (x *DictionaryDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DictionaryDetail.ProtoReflect.Descriptor instead.
+func (*DictionaryDetail) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *DictionaryDetail) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *DictionaryDetail) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *DictionaryDetail) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *DictionaryDetail) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *DictionaryDetail) GetStatus() uint64 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *DictionaryDetail) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *DictionaryDetail) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *DictionaryDetail) GetParentID() uint64 {
+	if x != nil {
+		return x.ParentID
+	}
+	return 0
+}
+
+// The response data of dictionary KV information list | 字典键值列表数据
+type DictionaryDetailListResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ErrCode base.ErrCode        `protobuf:"varint,1,opt,name=errCode,proto3,enum=base.ErrCode" json:"errCode" form:"errCode" query:"errCode"`
+	ErrMsg  string              `protobuf:"bytes,2,opt,name=errMsg,proto3" json:"errMsg" form:"errMsg" query:"errMsg"`
+	Total   uint64              `protobuf:"varint,3,opt,name=total,proto3" json:"total" form:"total" query:"total"`
+	Data    []*DictionaryDetail `protobuf:"bytes,4,rep,name=data,proto3" json:"data" form:"data" query:"data"`
+}
+
+func (x *DictionaryDetailListResp) Reset() {
+	*x = DictionaryDetailListResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DictionaryDetailListResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DictionaryDetailListResp) ProtoMessage() {}
+
+func (x *DictionaryDetailListResp) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DictionaryDetailListResp.ProtoReflect.Descriptor instead.
+func (*DictionaryDetailListResp) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *DictionaryDetailListResp) GetErrCode() base.ErrCode {
+	if x != nil {
+		return x.ErrCode
+	}
+	return base.ErrCode(0)
+}
+
+func (x *DictionaryDetailListResp) GetErrMsg() string {
+	if x != nil {
+		return x.ErrMsg
+	}
+	return ""
+}
+
+func (x *DictionaryDetailListResp) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *DictionaryDetailListResp) GetData() []*DictionaryDetail {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// The request data of dictionary KV information | 字典名获取字典键值请求数据
+type DictionaryDetailReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name" form:"name" query:"name"`
+}
+
+func (x *DictionaryDetailReq) Reset() {
+	*x = DictionaryDetailReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DictionaryDetailReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DictionaryDetailReq) ProtoMessage() {}
+
+func (x *DictionaryDetailReq) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DictionaryDetailReq.ProtoReflect.Descriptor instead.
+func (*DictionaryDetailReq) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *DictionaryDetailReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// The request data of dictionary list | 字典列表请求数据
+type DictionaryPageReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Title    string `protobuf:"bytes,1,opt,name=title,proto3" json:"title" form:"title" query:"title"`
+	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name" form:"name" query:"name"`
+	Page     uint64 `protobuf:"varint,3,opt,name=page,proto3" json:"page" form:"page" query:"page"`
+	PageSize uint64 `protobuf:"varint,4,opt,name=pageSize,proto3" json:"pageSize" form:"pageSize" query:"pageSize"`
+}
+
+func (x *DictionaryPageReq) Reset() {
+	*x = DictionaryPageReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DictionaryPageReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DictionaryPageReq) ProtoMessage() {}
+
+func (x *DictionaryPageReq) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DictionaryPageReq.ProtoReflect.Descriptor instead.
+func (*DictionaryPageReq) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *DictionaryPageReq) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *DictionaryPageReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DictionaryPageReq) GetPage() uint64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *DictionaryPageReq) GetPageSize() uint64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// Oauth log in request | Oauth 登录请求
+type OauthLoginReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// deprecated, the state is generated and stored by the server | 已弃用, state由服务端生成并保存
+	State    string `protobuf:"bytes,1,opt,name=state,proto3" json:"state" form:"state" query:"state"`
+	Provider string `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider" form:"provider" query:"provider"`
+	// Login type for wecom: QRCode, Inside, Quick | 企业微信登录类型：二维码，应用内部，快速 （其他应用的OAuth不需要传）
+	LoginType string `protobuf:"bytes,3,opt,name=LoginType,proto3" json:"LoginType" form:"LoginType" query:"LoginType"`
+}
+
+func (x *OauthLoginReq) Reset() {
+	*x = OauthLoginReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OauthLoginReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OauthLoginReq) ProtoMessage() {}
+
+func (x *OauthLoginReq) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OauthLoginReq.ProtoReflect.Descriptor instead.
+func (*OauthLoginReq) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *OauthLoginReq) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *OauthLoginReq) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *OauthLoginReq) GetLoginType() string {
+	if x != nil {
+		return x.LoginType
+	}
+	return ""
+}
+
+// Redirect information | 跳转网址
+type OauthRedirectResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ErrCode base.ErrCode `protobuf:"varint,1,opt,name=errCode,proto3,enum=base.ErrCode" json:"errCode" form:"errCode" query:"errCode"`
+	ErrMsg  string       `protobuf:"bytes,2,opt,name=errMsg,proto3" json:"errMsg" form:"errMsg" query:"errMsg"`
+	Url     string       `protobuf:"bytes,3,opt,name=url,proto3" json:"url" form:"url" query:"url"`
+}
+
+func (x *OauthRedirectResp) Reset() {
+	*x = OauthRedirectResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OauthRedirectResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OauthRedirectResp) ProtoMessage() {}
+
+func (x *OauthRedirectResp) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OauthRedirectResp.ProtoReflect.Descriptor instead.
+func (*OauthRedirectResp) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *OauthRedirectResp) GetErrCode() base.ErrCode {
+	if x != nil {
+		return x.ErrCode
+	}
+	return base.ErrCode(0)
+}
+
+func (x *OauthRedirectResp) GetErrMsg() string {
+	if x != nil {
+		return x.ErrMsg
+	}
+	return ""
+}
+
+func (x *OauthRedirectResp) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+// The response data of oauth provider information | 提供者信息
+type ProviderInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id" form:"id" query:"id"`
+	Name         string `protobuf:"bytes,2,opt,name=name,proto3" json:"name" form:"name" query:"name"`
+	ClientID     string `protobuf:"bytes,3,opt,name=clientID,proto3" json:"clientID" form:"clientID" query:"clientID"`
+	ClientSecret string `protobuf:"bytes,4,opt,name=clientSecret,proto3" json:"clientSecret" form:"clientSecret" query:"clientSecret"`
+	RedirectUrl  string `protobuf:"bytes,5,opt,name=redirectUrl,proto3" json:"redirectUrl" form:"redirectUrl" query:"redirectUrl"`
+	Scopes       string `protobuf:"bytes,6,opt,name=scopes,proto3" json:"scopes" form:"scopes" query:"scopes"`
+	AuthUrl      string `protobuf:"bytes,7,opt,name=authUrl,proto3" json:"authUrl" form:"authUrl" query:"authUrl"`
+	TokenUrl     string `protobuf:"bytes,8,opt,name=tokenUrl,proto3" json:"tokenUrl" form:"tokenUrl" query:"tokenUrl"`
+	AuthStyle    uint64 `protobuf:"varint,9,opt,name=authStyle,proto3" json:"authStyle" form:"authStyle" query:"authStyle"`
+	InfoUrl      string `protobuf:"bytes,10,opt,name=infoUrl,proto3" json:"infoUrl" form:"infoUrl" query:"infoUrl"`
+	CreatedAt    string `protobuf:"bytes,11,opt,name=createdAt,proto3" json:"createdAt" form:"createdAt" query:"createdAt"`
+	UpdatedAt    string `protobuf:"bytes,12,opt,name=updatedAt,proto3" json:"updatedAt" form:"updatedAt" query:"updatedAt"`
+	// OIDC issuer, replaces authUrl, tokenUrl and infoUrl and verifies the id_token | OIDC签发者地址, 替代authUrl、tokenUrl与infoUrl并校验id_token
+	DiscoveryUrl string `protobuf:"bytes,13,opt,name=discoveryUrl,proto3" json:"discoveryUrl" form:"discoveryUrl" query:"discoveryUrl"`
+	// JSON paths of subject, credential, username, email, email_verified, mobile, nickname, avatar and groups in the user info, e.g. data.user.email or emails[0].value,
+	// the built-in template of the same provider name is used if empty | 用户信息中subject、credential、username、email、mobile、nickname、avatar与groups的JSON路径, 为空时使用同名内置模板
+	ClaimMapping map[string]string `protobuf:"bytes,14,rep,name=claimMapping,proto3" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3" json:"claimMapping" form:"claimMapping" query:"claimMapping"`
+	// create the user on the first login of an account not linked yet | 未关联账号首次登录时自动创建用户
+	AutoProvision bool `protobuf:"varint,15,opt,name=autoProvision,proto3" json:"autoProvision" form:"autoProvision" query:"autoProvision"`
+	// the created users wait for the approval of an admin | 自动创建的用户需管理员审批
+	ProvisionApproval bool `protobuf:"varint,16,opt,name=provisionApproval,proto3" json:"provisionApproval" form:"provisionApproval" query:"provisionApproval"`
+	// the role of the created users no rule matches, 0 rejects them | 未匹配规则的自动创建用户角色, 0为拒绝
+	DefaultRoleID uint64 `protobuf:"varint,17,opt,name=defaultRoleID,proto3" json:"defaultRoleID" form:"defaultRoleID" query:"defaultRoleID"`
+	// the rules deciding the role of the created users, the first match wins | 决定自动创建用户角色的规则, 按顺序首个匹配生效
+	RoleRules []*RoleRule `protobuf:"bytes,18,rep,name=roleRules,proto3" json:"roleRules" form:"roleRules" query:"roleRules"`
+}
+
+func (x *ProviderInfo) Reset() {
+	*x = ProviderInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProviderInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderInfo) ProtoMessage() {}
+
+func (x *ProviderInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderInfo.ProtoReflect.Descriptor instead.
+func (*ProviderInfo) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *ProviderInfo) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ProviderInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ProviderInfo) GetClientID() string {
+	if x != nil {
+		return x.ClientID
+	}
+	return ""
+}
+
+func (x *ProviderInfo) GetClientSecret() string {
+	if x != nil {
+		return x.ClientSecret
+	}
+	return ""
+}
+
+func (x *ProviderInfo) GetRedirectUrl() string {
+	if x != nil {
+		return x.RedirectUrl
+	}
+	return ""
+}
+
+func (x *ProviderInfo) GetScopes() string {
+	if x != nil {
+		return x.Scopes
+	}
+	return ""
+}
+
+func (x *ProviderInfo) GetAuthUrl() string {
+	if x != nil {
+		return x.AuthUrl
+	}
+	return ""
+}
+
+func (x *ProviderInfo) GetTokenUrl() string {
+	if x != nil {
+		return x.TokenUrl
+	}
+	return ""
+}
+
+func (x *ProviderInfo) GetAuthStyle() uint64 {
+	if x != nil {
+		return x.AuthStyle
+	}
+	return 0
+}
+
+func (x *ProviderInfo) GetInfoUrl() string {
+	if x != nil {
+		return x.InfoUrl
+	}
+	return ""
+}
+
+func (x *ProviderInfo) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *ProviderInfo) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *ProviderInfo) GetDiscoveryUrl() string {
+	if x != nil {
+		return x.DiscoveryUrl
+	}
+	return ""
+}
+
+func (x *ProviderInfo) GetClaimMapping() map[string]string {
+	if x != nil {
+		return x.ClaimMapping
+	}
+	return nil
+}
+
+func (x *ProviderInfo) GetAutoProvision() bool {
+	if x != nil {
+		return x.AutoProvision
+	}
+	return false
+}
+
+func (x *ProviderInfo) GetProvisionApproval() bool {
+	if x != nil {
+		return x.ProvisionApproval
+	}
+	return false
+}
+
+func (x *ProviderInfo) GetDefaultRoleID() uint64 {
+	if x != nil {
+		return x.DefaultRoleID
+	}
+	return 0
+}
+
+func (x *ProviderInfo) GetRoleRules() []*RoleRule {
+	if x != nil {
+		return x.RoleRules
+	}
+	return nil
+}
+
+// role rule of the provisioned users | 自动创建用户的角色规则
+type RoleRule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// email_domain, wecom_department or group, the groups are read by the groups claim mapping,
+	// email_domain only matches the emails verified by the email_verified claim mapping
+	// | 邮箱域名email_domain、企业微信部门wecom_department或分组group, 分组由groups声明映射读取, 邮箱域名仅匹配email_verified声明映射验证过的邮箱
+	Type   string `protobuf:"bytes,1,opt,name=type,proto3" json:"type" form:"type" query:"type"`
+	Value  string `protobuf:"bytes,2,opt,name=value,proto3" json:"value" form:"value" query:"value"`
+	RoleID uint64 `protobuf:"varint,3,opt,name=roleID,proto3" json:"roleID" form:"roleID" query:"roleID"`
+}
+
+func (x *RoleRule) Reset() {
+	*x = RoleRule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RoleRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoleRule) ProtoMessage() {}
+
+func (x *RoleRule) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoleRule.ProtoReflect.Descriptor instead.
+func (*RoleRule) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *RoleRule) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *RoleRule) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *RoleRule) GetRoleID() uint64 {
+	if x != nil {
+		return x.RoleID
+	}
+	return 0
+}
+
+type ProviderListReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Page     uint64 `protobuf:"varint,1,opt,name=page,proto3" json:"page" form:"page" query:"page"`
+	PageSize uint64 `protobuf:"varint,2,opt,name=pageSize,proto3" json:"pageSize" form:"pageSize" query:"pageSize"`
+	Name     string `protobuf:"bytes,3,opt,name=name,proto3" json:"name" form:"name" query:"name"`
+}
+
+func (x *ProviderListReq) Reset() {
+	*x = ProviderListReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProviderListReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderListReq) ProtoMessage() {}
+
+func (x *ProviderListReq) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderListReq.ProtoReflect.Descriptor instead.
+func (*ProviderListReq) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *ProviderListReq) GetPage() uint64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ProviderListReq) GetPageSize() uint64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ProviderListReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// The response data of provider list | 提供商列表返回数据
+type ProviderListResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ErrCode base.ErrCode    `protobuf:"varint,1,opt,name=errCode,proto3,enum=base.ErrCode" json:"errCode" form:"errCode" query:"errCode"`
+	ErrMsg  string          `protobuf:"bytes,2,opt,name=errMsg,proto3" json:"errMsg" form:"errMsg" query:"errMsg"`
+	Total   uint64          `protobuf:"varint,3,opt,name=total,proto3" json:"total" form:"total" query:"total"`
+	Data    []*ProviderInfo `protobuf:"bytes,4,rep,name=data,proto3" json:"data" form:"data" query:"data"`
+}
+
+func (x *ProviderListResp) Reset() {
+	*x = ProviderListResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProviderListResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderListResp) ProtoMessage() {}
+
+func (x *ProviderListResp) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderListResp.ProtoReflect.Descriptor instead.
+func (*ProviderListResp) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *ProviderListResp) GetErrCode() base.ErrCode {
+	if x != nil {
+		return x.ErrCode
+	}
+	return base.ErrCode(0)
+}
+
+func (x *ProviderListResp) GetErrMsg() string {
+	if x != nil {
+		return x.ErrMsg
+	}
+	return ""
+}
+
+func (x *ProviderListResp) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ProviderListResp) GetData() []*ProviderInfo {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// Oauth login callback request | Oauth 登录回调请求
+type CallbackReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the state returned by the provider, valid once | 提供商返回的state, 仅可使用一次
+	State string `protobuf:"bytes,1,opt,name=state,proto3" json:"state" form:"state" query:"state"`
+	Code  string `protobuf:"bytes,2,opt,name=code,proto3" json:"code" form:"code" query:"code"`
+}
+
+func (x *CallbackReq) Reset() {
+	*x = CallbackReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CallbackReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CallbackReq) ProtoMessage() {}
+
+func (x *CallbackReq) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CallbackReq.ProtoReflect.Descriptor instead.
+func (*CallbackReq) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *CallbackReq) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *CallbackReq) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+// The SAML identity provider information | SAML身份提供商信息
+type SamlProviderInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id" form:"id" query:"id"`
+	CreatedAt string `protobuf:"bytes,2,opt,name=createdAt,proto3" json:"createdAt" form:"createdAt" query:"createdAt"`
+	UpdatedAt string `protobuf:"bytes,3,opt,name=updatedAt,proto3" json:"updatedAt" form:"updatedAt" query:"updatedAt"`
+	Name      string `protobuf:"bytes,4,opt,name=name,proto3" json:"name" form:"name" query:"name"`
+	// the entity id of the service provider registered at the IdP, the metadata URL if empty | 服务提供商实体ID, 为空时使用元数据地址
+	EntityID string `protobuf:"bytes,5,opt,name=entityID,proto3" json:"entityID" form:"entityID" query:"entityID"`
+	// the URL of the IdP metadata | 身份提供商元数据地址
+	IdpMetadataUrl string `protobuf:"bytes,6,opt,name=idpMetadataUrl,proto3" json:"idpMetadataUrl" form:"idpMetadataUrl" query:"idpMetadataUrl"`
+	// the IdP metadata, used if idpMetadataUrl is empty | 身份提供商元数据XML, 未设置元数据地址时使用
+	IdpMetadataXml string `protobuf:"bytes,7,opt,name=idpMetadataXml,proto3" json:"idpMetadataXml" form:"idpMetadataXml" query:"idpMetadataXml"`
+	// attribute names of subject, username, email, email_verified, mobile, nickname, avatar and groups, the subject is the NameID if empty
+	// | subject、username、email、mobile、nickname、avatar与groups对应的属性名, subject为空时使用NameID
+	AttributeMapping map[string]string `protobuf:"bytes,8,rep,name=attributeMapping,proto3" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3" json:"attributeMapping" form:"attributeMapping" query:"attributeMapping"`
+	// accept the logins started at the IdP | 允许身份提供商发起的登录
+	AllowIdpInitiated bool `protobuf:"varint,9,opt,name=allowIdpInitiated,proto3" json:"allowIdpInitiated" form:"allowIdpInitiated" query:"allowIdpInitiated"`
+	// create the user on the first login of an account not linked yet | 未关联账号首次登录时自动创建用户
+	AutoProvision bool `protobuf:"varint,10,opt,name=autoProvision,proto3" json:"autoProvision" form:"autoProvision" query:"autoProvision"`
+	// the created users wait for the approval of an admin | 自动创建的用户需管理员审批
+	ProvisionApproval bool `protobuf:"varint,11,opt,name=provisionApproval,proto3" json:"provisionApproval" form:"provisionApproval" query:"provisionApproval"`
+	// the role of the created users no rule matches, 0 rejects them | 未匹配规则的自动创建用户角色, 0为拒绝
+	DefaultRoleID uint64 `protobuf:"varint,12,opt,name=defaultRoleID,proto3" json:"defaultRoleID" form:"defaultRoleID" query:"defaultRoleID"`
+	// the rules deciding the role of the created users, the first match wins | 决定自动创建用户角色的规则, 按顺序首个匹配生效
+	RoleRules []*RoleRule `protobuf:"bytes,13,rep,name=roleRules,proto3" json:"roleRules" form:"roleRules" query:"roleRules"`
+	// read only, the metadata and ACS URLs to register at the IdP | 只读, 在身份提供商注册的元数据与ACS地址
+	MetadataUrl string `protobuf:"bytes,14,opt,name=metadataUrl,proto3" json:"metadataUrl" form:"metadataUrl" query:"metadataUrl"`
+	AcsUrl      string `protobuf:"bytes,15,opt,name=acsUrl,proto3" json:"acsUrl" form:"acsUrl" query:"acsUrl"`
+}
+
+func (x *SamlProviderInfo) Reset() {
+	*x = SamlProviderInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SamlProviderInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SamlProviderInfo) ProtoMessage() {}
+
+func (x *SamlProviderInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SamlProviderInfo.ProtoReflect.Descriptor instead.
+func (*SamlProviderInfo) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *SamlProviderInfo) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SamlProviderInfo) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *SamlProviderInfo) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *SamlProviderInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SamlProviderInfo) GetEntityID() string {
+	if x != nil {
+		return x.EntityID
+	}
+	return ""
+}
+
+func (x *SamlProviderInfo) GetIdpMetadataUrl() string {
+	if x != nil {
+		return x.IdpMetadataUrl
+	}
+	return ""
+}
+
+func (x *SamlProviderInfo) GetIdpMetadataXml() string {
+	if x != nil {
+		return x.IdpMetadataXml
+	}
+	return ""
+}
+
+func (x *SamlProviderInfo) GetAttributeMapping() map[string]string {
+	if x != nil {
+		return x.AttributeMapping
+	}
+	return nil
+}
+
+func (x *SamlProviderInfo) GetAllowIdpInitiated() bool {
+	if x != nil {
+		return x.AllowIdpInitiated
+	}
+	return false
+}
+
+func (x *SamlProviderInfo) GetAutoProvision() bool {
+	if x != nil {
+		return x.AutoProvision
+	}
+	return false
+}
+
+func (x *SamlProviderInfo) GetProvisionApproval() bool {
+	if x != nil {
+		return x.ProvisionApproval
+	}
+	return false
+}
+
+func (x *SamlProviderInfo) GetDefaultRoleID() uint64 {
+	if x != nil {
+		return x.DefaultRoleID
+	}
+	return 0
+}
+
+func (x *SamlProviderInfo) GetRoleRules() []*RoleRule {
+	if x != nil {
+		return x.RoleRules
+	}
+	return nil
+}
+
+func (x *SamlProviderInfo) GetMetadataUrl() string {
+	if x != nil {
+		return x.MetadataUrl
+	}
+	return ""
+}
+
+func (x *SamlProviderInfo) GetAcsUrl() string {
+	if x != nil {
+		return x.AcsUrl
+	}
+	return ""
+}
+
+// SAML identity provider list request | SAML身份提供商列表请求参数
+type SamlProviderListReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Page     uint64 `protobuf:"varint,1,opt,name=page,proto3" json:"page" form:"page" query:"page"`
+	PageSize uint64 `protobuf:"varint,2,opt,name=pageSize,proto3" json:"pageSize" form:"pageSize" query:"pageSize"`
+	Name     string `protobuf:"bytes,3,opt,name=name,proto3" json:"name" form:"name" query:"name"`
+}
+
+func (x *SamlProviderListReq) Reset() {
+	*x = SamlProviderListReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SamlProviderListReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SamlProviderListReq) ProtoMessage() {}
+
+func (x *SamlProviderListReq) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SamlProviderListReq.ProtoReflect.Descriptor instead.
+func (*SamlProviderListReq) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *SamlProviderListReq) GetPage() uint64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *SamlProviderListReq) GetPageSize() uint64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *SamlProviderListReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// SAML identity provider list response | SAML身份提供商列表返回数据
+type SamlProviderListResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ErrCode base.ErrCode        `protobuf:"varint,1,opt,name=errCode,proto3,enum=base.ErrCode" json:"errCode" form:"errCode" query:"errCode"`
+	ErrMsg  string              `protobuf:"bytes,2,opt,name=errMsg,proto3" json:"errMsg" form:"errMsg" query:"errMsg"`
+	Total   uint64              `protobuf:"varint,3,opt,name=total,proto3" json:"total" form:"total" query:"total"`
+	Data    []*SamlProviderInfo `protobuf:"bytes,4,rep,name=data,proto3" json:"data" form:"data" query:"data"`
+}
+
+func (x *SamlProviderListResp) Reset() {
+	*x = SamlProviderListResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SamlProviderListResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SamlProviderListResp) ProtoMessage() {}
+
+func (x *SamlProviderListResp) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SamlProviderListResp.ProtoReflect.Descriptor instead.
+func (*SamlProviderListResp) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *SamlProviderListResp) GetErrCode() base.ErrCode {
+	if x != nil {
+		return x.ErrCode
+	}
+	return base.ErrCode(0)
+}
+
+func (x *SamlProviderListResp) GetErrMsg() string {
+	if x != nil {
+		return x.ErrMsg
+	}
+	return ""
+}
+
+func (x *SamlProviderListResp) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *SamlProviderListResp) GetData() []*SamlProviderInfo {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// SAML log in request | SAML 登录请求
+type SamlLoginReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Provider string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider" form:"provider" query:"provider"`
+}
+
+func (x *SamlLoginReq) Reset() {
+	*x = SamlLoginReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SamlLoginReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SamlLoginReq) ProtoMessage() {}
+
+func (x *SamlLoginReq) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SamlLoginReq.ProtoReflect.Descriptor instead.
+func (*SamlLoginReq) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *SamlLoginReq) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+// The response posted by the identity provider | 身份提供商回传的响应
+type SamlAcsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Provider     string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider" form:"provider" query:"provider"`
+	SAMLResponse string `protobuf:"bytes,2,opt,name=SAMLResponse,proto3" json:"SAMLResponse" form:"SAMLResponse" query:"SAMLResponse"`
+	// the relay state of the request, valid once | 登录请求的relay state, 仅可使用一次
+	RelayState string `protobuf:"bytes,3,opt,name=RelayState,proto3" json:"RelayState" form:"RelayState" query:"RelayState"`
+}
+
+func (x *SamlAcsReq) Reset() {
+	*x = SamlAcsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SamlAcsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SamlAcsReq) ProtoMessage() {}
+
+func (x *SamlAcsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SamlAcsReq.ProtoReflect.Descriptor instead.
+func (*SamlAcsReq) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *SamlAcsReq) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *SamlAcsReq) GetSAMLResponse() string {
+	if x != nil {
+		return x.SAMLResponse
+	}
+	return ""
+}
+
+func (x *SamlAcsReq) GetRelayState() string {
+	if x != nil {
+		return x.RelayState
+	}
+	return ""
+}
+
+// Consent request, approve is used by the answer only | 授权请求参数, approve仅在答复时使用
+type OIDCConsentReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RequestID string `protobuf:"bytes,1,opt,name=requestID,proto3" json:"requestID" form:"requestID" query:"requestID"`
+	Approve   bool   `protobuf:"varint,2,opt,name=approve,proto3" json:"approve" form:"approve" query:"approve"`
+}
+
+func (x *OIDCConsentReq) Reset() {
+	*x = OIDCConsentReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OIDCConsentReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OIDCConsentReq) ProtoMessage() {}
+
+func (x *OIDCConsentReq) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OIDCConsentReq.ProtoReflect.Descriptor instead.
+func (*OIDCConsentReq) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *OIDCConsentReq) GetRequestID() string {
+	if x != nil {
+		return x.RequestID
+	}
+	return ""
+}
+
+func (x *OIDCConsentReq) GetApprove() bool {
+	if x != nil {
+		return x.Approve
+	}
+	return false
+}
+
+// The authorization request shown on the consent page | 授权页面显示的授权请求信息
+type OIDCConsentInfoResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ErrCode    base.ErrCode `protobuf:"varint,1,opt,name=errCode,proto3,enum=base.ErrCode" json:"errCode" form:"errCode" query:"errCode"`
+	ErrMsg     string       `protobuf:"bytes,2,opt,name=errMsg,proto3" json:"errMsg" form:"errMsg" query:"errMsg"`
+	ClientID   string       `protobuf:"bytes,3,opt,name=clientID,proto3" json:"clientID" form:"clientID" query:"clientID"`
+	ClientName string       `protobuf:"bytes,4,opt,name=clientName,proto3" json:"clientName" form:"clientName" query:"clientName"`
+	Scopes     []string     `protobuf:"bytes,5,rep,name=scopes,proto3" json:"scopes" form:"scopes" query:"scopes"`
+	// granted before or trusted client, the page may approve at once | 已授权过或受信任的客户端, 页面可直接同意
+	Consented bool `protobuf:"varint,6,opt,name=consented,proto3" json:"consented" form:"consented" query:"consented"`
+}
+
+func (x *OIDCConsentInfoResp) Reset() {
+	*x = OIDCConsentInfoResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OIDCConsentInfoResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OIDCConsentInfoResp) ProtoMessage() {}
+
+func (x *OIDCConsentInfoResp) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OIDCConsentInfoResp.ProtoReflect.Descriptor instead.
+func (*OIDCConsentInfoResp) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *OIDCConsentInfoResp) GetErrCode() base.ErrCode {
+	if x != nil {
+		return x.ErrCode
+	}
+	return base.ErrCode(0)
+}
+
+func (x *OIDCConsentInfoResp) GetErrMsg() string {
+	if x != nil {
+		return x.ErrMsg
+	}
+	return ""
+}
+
+func (x *OIDCConsentInfoResp) GetClientID() string {
+	if x != nil {
+		return x.ClientID
+	}
+	return ""
+}
+
+func (x *OIDCConsentInfoResp) GetClientName() string {
+	if x != nil {
+		return x.ClientName
+	}
+	return ""
+}
+
+func (x *OIDCConsentInfoResp) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *OIDCConsentInfoResp) GetConsented() bool {
+	if x != nil {
+		return x.Consented
+	}
+	return false
+}
+
+// The redirect URL back to the client | 返回客户端的跳转地址
+type OIDCConsentResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ErrCode     base.ErrCode `protobuf:"varint,1,opt,name=errCode,proto3,enum=base.ErrCode" json:"errCode" form:"errCode" query:"errCode"`
+	ErrMsg      string       `protobuf:"bytes,2,opt,name=errMsg,proto3" json:"errMsg" form:"errMsg" query:"errMsg"`
+	RedirectURL string       `protobuf:"bytes,3,opt,name=redirectURL,proto3" json:"redirectURL" form:"redirectURL" query:"redirectURL"`
+}
+
+func (x *OIDCConsentResp) Reset() {
+	*x = OIDCConsentResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OIDCConsentResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OIDCConsentResp) ProtoMessage() {}
+
+func (x *OIDCConsentResp) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OIDCConsentResp.ProtoReflect.Descriptor instead.
+func (*OIDCConsentResp) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *OIDCConsentResp) GetErrCode() base.ErrCode {
+	if x != nil {
+		return x.ErrCode
+	}
+	return base.ErrCode(0)
+}
+
+func (x *OIDCConsentResp) GetErrMsg() string {
+	if x != nil {
+		return x.ErrMsg
+	}
+	return ""
+}
+
+func (x *OIDCConsentResp) GetRedirectURL() string {
+	if x != nil {
+		return x.RedirectURL
+	}
+	return ""
+}
+
+// The OIDC client information | OIDC客户端信息
+type OIDCClientInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID        uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID" form:"ID" query:"ID"`
+	CreatedAt string `protobuf:"bytes,2,opt,name=createdAt,proto3" json:"createdAt" form:"createdAt" query:"createdAt"`
+	UpdatedAt string `protobuf:"bytes,3,opt,name=updatedAt,proto3" json:"updatedAt" form:"updatedAt" query:"updatedAt"`
+	Status    uint64 `protobuf:"varint,4,opt,name=status,proto3" json:"status" form:"status" query:"status"`
+	// generated if empty | 为空时自动生成
+	ClientID     string   `protobuf:"bytes,5,opt,name=clientID,proto3" json:"clientID" form:"clientID" query:"clientID"`
+	Name         string   `protobuf:"bytes,6,opt,name=name,proto3" json:"name" form:"name" query:"name"`
+	RedirectURIs []string `protobuf:"bytes,7,rep,name=redirectURIs,proto3" json:"redirectURIs" form:"redirectURIs" query:"redirectURIs"`
+	// authorization_code, client_credentials | 授权类型
+	GrantTypes []string `protobuf:"bytes,8,rep,name=grantTypes,proto3" json:"grantTypes" form:"grantTypes" query:"grantTypes"`
+	// openid, profile, email, phone, roles or custom scopes | 权限范围
+	Scopes []string `protobuf:"bytes,9,rep,name=scopes,proto3" json:"scopes" form:"scopes" query:"scopes"`
+	// public client without secret, PKCE only | 无密钥的公共客户端, 仅支持PKCE
+	Public bool `protobuf:"varint,10,opt,name=public,proto3" json:"public" form:"public" query:"public"`
+	// trusted first party client, consent is not asked | 受信任的内部客户端, 不询问用户授权
+	SkipConsent bool `protobuf:"varint,11,opt,name=skipConsent,proto3" json:"skipConsent" form:"skipConsent" query:"skipConsent"`
+	// issue a new secret on update | 更新时重新生成密钥
+	ResetSecret bool `protobuf:"varint,12,opt,name=resetSecret,proto3" json:"resetSecret" form:"resetSecret" query:"resetSecret"`
+}
+
+func (x *OIDCClientInfo) Reset() {
+	*x = OIDCClientInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OIDCClientInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OIDCClientInfo) ProtoMessage() {}
+
+func (x *OIDCClientInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OIDCClientInfo.ProtoReflect.Descriptor instead.
+func (*OIDCClientInfo) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *OIDCClientInfo) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *OIDCClientInfo) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *OIDCClientInfo) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *OIDCClientInfo) GetStatus() uint64 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *OIDCClientInfo) GetClientID() string {
+	if x != nil {
+		return x.ClientID
+	}
+	return ""
+}
+
+func (x *OIDCClientInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *OIDCClientInfo) GetRedirectURIs() []string {
+	if x != nil {
+		return x.RedirectURIs
+	}
+	return nil
+}
+
+func (x *OIDCClientInfo) GetGrantTypes() []string {
+	if x != nil {
+		return x.GrantTypes
+	}
+	return nil
+}
+
+func (x *OIDCClientInfo) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *OIDCClientInfo) GetPublic() bool {
+	if x != nil {
+		return x.Public
+	}
+	return false
+}
+
+func (x *OIDCClientInfo) GetSkipConsent() bool {
+	if x != nil {
+		return x.SkipConsent
+	}
+	return false
+}
+
+func (x *OIDCClientInfo) GetResetSecret() bool {
+	if x != nil {
+		return x.ResetSecret
+	}
+	return false
+}
+
+// The client credentials, the secret is empty unless newly issued | 客户端凭据, 仅新生成时返回密钥
+type OIDCClientSecretResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ErrCode      base.ErrCode `protobuf:"varint,1,opt,name=errCode,proto3,enum=base.ErrCode" json:"errCode" form:"errCode" query:"errCode"`
+	ErrMsg       string       `protobuf:"bytes,2,opt,name=errMsg,proto3" json:"errMsg" form:"errMsg" query:"errMsg"`
+	ClientID     string       `protobuf:"bytes,3,opt,name=clientID,proto3" json:"clientID" form:"clientID" query:"clientID"`
+	ClientSecret string       `protobuf:"bytes,4,opt,name=clientSecret,proto3" json:"clientSecret" form:"clientSecret" query:"clientSecret"`
+}
+
+func (x *OIDCClientSecretResp) Reset() {
+	*x = OIDCClientSecretResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OIDCClientSecretResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OIDCClientSecretResp) ProtoMessage() {}
+
+func (x *OIDCClientSecretResp) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OIDCClientSecretResp.ProtoReflect.Descriptor instead.
+func (*OIDCClientSecretResp) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *OIDCClientSecretResp) GetErrCode() base.ErrCode {
+	if x != nil {
+		return x.ErrCode
+	}
+	return base.ErrCode(0)
+}
+
+func (x *OIDCClientSecretResp) GetErrMsg() string {
+	if x != nil {
+		return x.ErrMsg
+	}
+	return ""
+}
+
+func (x *OIDCClientSecretResp) GetClientID() string {
+	if x != nil {
+		return x.ClientID
+	}
+	return ""
+}
+
+func (x *OIDCClientSecretResp) GetClientSecret() string {
+	if x != nil {
+		return x.ClientSecret
+	}
+	return ""
+}
+
+// OIDC client list request | OIDC客户端列表请求参数
+type OIDCClientListReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Page     uint64 `protobuf:"varint,1,opt,name=page,proto3" json:"page" form:"page" query:"page"`
+	PageSize uint64 `protobuf:"varint,2,opt,name=pageSize,proto3" json:"pageSize" form:"pageSize" query:"pageSize"`
+	Name     string `protobuf:"bytes,3,opt,name=name,proto3" json:"name" form:"name" query:"name"`
+}
+
+func (x *OIDCClientListReq) Reset() {
+	*x = OIDCClientListReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OIDCClientListReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OIDCClientListReq) ProtoMessage() {}
+
+func (x *OIDCClientListReq) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OIDCClientListReq.ProtoReflect.Descriptor instead.
+func (*OIDCClientListReq) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *OIDCClientListReq) GetPage() uint64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *OIDCClientListReq) GetPageSize() uint64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *OIDCClientListReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// OIDC client list response | OIDC客户端列表返回数据
+type OIDCClientListResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ErrCode base.ErrCode      `protobuf:"varint,1,opt,name=errCode,proto3,enum=base.ErrCode" json:"errCode" form:"errCode" query:"errCode"`
+	ErrMsg  string            `protobuf:"bytes,2,opt,name=errMsg,proto3" json:"errMsg" form:"errMsg" query:"errMsg"`
+	Total   uint64            `protobuf:"varint,3,opt,name=total,proto3" json:"total" form:"total" query:"total"`
+	Data    []*OIDCClientInfo `protobuf:"bytes,4,rep,name=data,proto3" json:"data" form:"data" query:"data"`
+}
+
+func (x *OIDCClientListResp) Reset() {
+	*x = OIDCClientListResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OIDCClientListResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OIDCClientListResp) ProtoMessage() {}
+
+func (x *OIDCClientListResp) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OIDCClientListResp.ProtoReflect.Descriptor instead.
+func (*OIDCClientListResp) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *OIDCClientListResp) GetErrCode() base.ErrCode {
+	if x != nil {
+		return x.ErrCode
+	}
+	return base.ErrCode(0)
+}
+
+func (x *OIDCClientListResp) GetErrMsg() string {
+	if x != nil {
+		return x.ErrMsg
+	}
+	return ""
+}
+
+func (x *OIDCClientListResp) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *OIDCClientListResp) GetData() []*OIDCClientInfo {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// The response data of logs | 日志信息
+type LogsInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type             string `protobuf:"bytes,1,opt,name=type,proto3" json:"type" form:"type" query:"type"`
+	Method           string `protobuf:"bytes,2,opt,name=method,proto3" json:"method" form:"method" query:"method"`
+	Api              string `protobuf:"bytes,3,opt,name=api,proto3" json:"api" form:"api" query:"api"`
+	Success          bool   `protobuf:"varint,4,opt,name=success,proto3" json:"success" form:"success" query:"success"`
+	ReqContent       string `protobuf:"bytes,5,opt,name=reqContent,proto3" json:"reqContent" form:"reqContent" query:"reqContent"`
+	RespContent      string `protobuf:"bytes,6,opt,name=respContent,proto3" json:"respContent" form:"respContent" query:"respContent"`
+	Ip               string `protobuf:"bytes,7,opt,name=ip,proto3" json:"ip" form:"ip" query:"ip"`
+	UserAgent        string `protobuf:"bytes,8,opt,name=userAgent,proto3" json:"userAgent" form:"userAgent" query:"userAgent"`
+	Operator         string `protobuf:"bytes,9,opt,name=operator,proto3" json:"operator" form:"operator" query:"operator"`
+	Time             uint32 `protobuf:"varint,10,opt,name=time,proto3" json:"time" form:"time" query:"time"`
+	CreatedAt        string `protobuf:"bytes,11,opt,name=createdAt,proto3" json:"createdAt" form:"createdAt" query:"createdAt"`
+	UpdatedAt        string `protobuf:"bytes,12,opt,name=updatedAt,proto3" json:"updatedAt" form:"updatedAt" query:"updatedAt"`
+	ImpersonatedUser string `protobuf:"bytes,13,opt,name=impersonatedUser,proto3" json:"impersonatedUser" form:"impersonatedUser" query:"impersonatedUser"`
+}
+
+func (x *LogsInfo) Reset() {
+	*x = LogsInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LogsInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogsInfo) ProtoMessage() {}
+
+func (x *LogsInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogsInfo.ProtoReflect.Descriptor instead.
+func (*LogsInfo) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *LogsInfo) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *LogsInfo) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *LogsInfo) GetApi() string {
+	if x != nil {
+		return x.Api
+	}
+	return ""
+}
+
+func (x *LogsInfo) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *LogsInfo) GetReqContent() string {
+	if x != nil {
+		return x.ReqContent
+	}
+	return ""
+}
+
+func (x *LogsInfo) GetRespContent() string {
+	if x != nil {
+		return x.RespContent
+	}
+	return ""
+}
+
+func (x *LogsInfo) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *LogsInfo) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *LogsInfo) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *LogsInfo) GetTime() uint32 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *LogsInfo) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *LogsInfo) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *LogsInfo) GetImpersonatedUser() string {
+	if x != nil {
+		return x.ImpersonatedUser
+	}
+	return ""
+}
+
+// The request data of logs list | 日志列表请求数据
+type LogsListReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Page     uint64 `protobuf:"varint,1,opt,name=page,proto3" json:"page" form:"page" query:"page"`
+	PageSize uint64 `protobuf:"varint,2,opt,name=pageSize,proto3" json:"pageSize" form:"pageSize" query:"pageSize"`
+	Type     string `protobuf:"bytes,3,opt,name=type,proto3" json:"type" form:"type" query:"type"`
+	Method   string `protobuf:"bytes,4,opt,name=method,proto3" json:"method" form:"method" query:"method"`
+	Api      string `protobuf:"bytes,5,opt,name=api,proto3" json:"api" form:"api" query:"api"`
+	Success  string `protobuf:"bytes,6,opt,name=success,proto3" json:"success" form:"success" query:"success"`
+	Operator string `protobuf:"bytes,7,opt,name=operator,proto3" json:"operator" form:"operator" query:"operator"`
+}
+
+func (x *LogsListReq) Reset() {
+	*x = LogsListReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LogsListReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogsListReq) ProtoMessage() {}
+
+func (x *LogsListReq) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogsListReq.ProtoReflect.Descriptor instead.
+func (*LogsListReq) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *LogsListReq) GetPage() uint64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *LogsListReq) GetPageSize() uint64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *LogsListReq) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *LogsListReq) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *LogsListReq) GetApi() string {
+	if x != nil {
+		return x.Api
+	}
+	return ""
+}
+
+func (x *LogsListReq) GetSuccess() string {
+	if x != nil {
+		return x.Success
+	}
+	return ""
+}
+
+func (x *LogsListReq) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+// The response data of logs list | 日志列表返回数据
+type LogsListResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ErrCode base.ErrCode `protobuf:"varint,1,opt,name=errCode,proto3,enum=base.ErrCode" json:"errCode" form:"errCode" query:"errCode"`
+	ErrMsg  string       `protobuf:"bytes,2,opt,name=errMsg,proto3" json:"errMsg" form:"errMsg" query:"errMsg"`
+	Total   uint64       `protobuf:"varint,3,opt,name=total,proto3" json:"total" form:"total" query:"total"`
+	Data    []*LogsInfo  `protobuf:"bytes,4,rep,name=data,proto3" json:"data" form:"data" query:"data"`
+}
+
+func (x *LogsListResp) Reset() {
+	*x = LogsListResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LogsListResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogsListResp) ProtoMessage() {}
+
+func (x *LogsListResp) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogsListResp.ProtoReflect.Descriptor instead.
+func (*LogsListResp) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *LogsListResp) GetErrCode() base.ErrCode {
+	if x != nil {
+		return x.ErrCode
+	}
+	return base.ErrCode(0)
+}
+
+func (x *LogsListResp) GetErrMsg() string {
+	if x != nil {
+		return x.ErrMsg
+	}
+	return ""
+}
+
+func (x *LogsListResp) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *LogsListResp) GetData() []*LogsInfo {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+var File_admin_proto protoreflect.FileDescriptor
+
+var file_admin_proto_rawDesc = []byte{
+	0x0a, 0x0b, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x1a, 0x09, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x0a, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x41, 0x0a, 0x09, 0x53,
+	0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x65, 0x71, 0x12, 0x34, 0x0a, 0x09, 0x73, 0x74, 0x72, 0x75,
+	0x63, 0x74, 0x53, 0x74, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x16, 0xca, 0xbb, 0x18,
+	0x12, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x53, 0x74, 0x72, 0x2c, 0x72, 0x65, 0x71, 0x75, 0x69,
+	0x72, 0x65, 0x64, 0x52, 0x09, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x53, 0x74, 0x72, 0x22, 0x6b,
+	0x0a, 0x0a, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x27, 0x0a, 0x07,
+	0x65, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x07, 0x65, 0x72,
+	0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x4d, 0x73, 0x67, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x4d, 0x73, 0x67, 0x12, 0x1c, 0x0a,
+	0x09, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x53, 0x74, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x53, 0x74, 0x72, 0x22, 0x68, 0x0a, 0x09, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x12, 0x27, 0x0a, 0x07, 0x65, 0x72, 0x72, 0x43,
+	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x62, 0x61, 0x73, 0x65,
+	0x2e, 0x45, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x07, 0x65, 0x72, 0x72, 0x43, 0x6f, 0x64,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x4d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x4d, 0x73, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x53, 0x74, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x53, 0x74, 0x72, 0x22, 0x8a, 0x01, 0x0a, 0x0f, 0x43, 0x61, 0x70, 0x74, 0x63, 0x68,
+	0x61, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x12, 0x27, 0x0a, 0x07, 0x65, 0x72, 0x72,
+	0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x62, 0x61, 0x73,
+	0x65, 0x2e, 0x45, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x07, 0x65, 0x72, 0x72, 0x43, 0x6f,
+	0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x4d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x4d, 0x73, 0x67, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x61,
+	0x70, 0x74, 0x63, 0x68, 0x61, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63,
+	0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6d, 0x67, 0x50,
+	0x61, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x69, 0x6d, 0x67, 0x50, 0x61,
+	0x74, 0x68, 0x22, 0x56, 0x0a, 0x0f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x44, 0x12, 0x2b, 0x0a,
+	0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x50, 0x61, 0x72, 0x74, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x22, 0x3c, 0x0a, 0x0e, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x50, 0x61, 0x72, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04,
+	0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68,
+	0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x22, 0x54, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x72,
+	0x6f, 0x6c, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x72, 0x6f, 0x6c,
+	0x65, 0x49, 0x44, 0x12, 0x29, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x50, 0x61, 0x72, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x22, 0x7a,
+	0x0a, 0x08, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73,
+	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73,
+	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x49, 0x44, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x49, 0x44,
+	0x12, 0x18, 0x0a, 0x07, 0x63, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x63, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x22, 0x3e, 0x0a, 0x0a, 0x53, 0x69,
+	0x6d, 0x70, 0x6c, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x6f, 0x6c, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x6f, 0x6c, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x4d, 0x0a, 0x09, 0x4c, 0x6f,
+	0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x22, 0xb0, 0x01, 0x0a, 0x0b, 0x50, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x27, 0x0a, 0x07, 0x65, 0x72, 0x72,
+	0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x62, 0x61, 0x73,
+	0x65, 0x2e, 0x45, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x07, 0x65, 0x72, 0x72, 0x43, 0x6f,
+	0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x4d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x4d, 0x73, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69,
+	0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69,
+	0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x12, 0x16,
+	0x0a, 0x06, 0x6d, 0x6f, 0x62, 0x69, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x6d, 0x6f, 0x62, 0x69, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x6e, 0x0a, 0x0a,
+	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69,
+	0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69,
+	0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x12, 0x16,
+	0x0a, 0x06, 0x6d, 0x6f, 0x62, 0x69, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x6d, 0x6f, 0x62, 0x69, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0xc9, 0x01, 0x0a,
+	0x0b, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08,
+	0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x49,
+	0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61,
+	0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x12, 0x14, 0x0a, 0x05,
+	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61,
+	0x69, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x6f, 0x62, 0x69, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x6d, 0x6f, 0x62, 0x69, 0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x6d,
+	0x61, 0x69, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65,
+	0x6d, 0x61, 0x69, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x5f, 0x0a, 0x0f, 0x52, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69,
+	0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x49, 0x44, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x49, 0x44, 0x12,
+	0x18, 0x0a, 0x07, 0x63, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x63, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x22, 0x3a, 0x0a, 0x0e, 0x41, 0x70, 0x70,
+	0x72, 0x6f, 0x76, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x61,
+	0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x61, 0x70,
+	0x70, 0x72, 0x6f, 0x76, 0x65, 0x22, 0x6f, 0x0a, 0x11, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x6c, 0x64, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x6c, 0x64, 0x50, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x6e, 0x65, 0x77, 0x50, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x65, 0x77, 0x50, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x61, 0x0a, 0x11, 0x46, 0x6f, 0x72, 0x67, 0x6f, 0x74,
+	0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69,
+	0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x49, 0x44, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x49, 0x44, 0x12,
+	0x18, 0x0a, 0x07, 0x63, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x63, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x22, 0x4a, 0x0a, 0x10, 0x52, 0x65, 0x73,
+	0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x6e, 0x65, 0x77, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x65, 0x77, 0x50, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x80, 0x01, 0x0a, 0x13, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63,
+	0x74, 0x6f, 0x72, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x12, 0x27, 0x0a,
+	0x07, 0x65, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d,
+	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x07, 0x65,
+	0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x4d, 0x73, 0x67,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x4d, 0x73, 0x67, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x22, 0x39, 0x0a, 0x10, 0x54, 0x77, 0x6f, 0x46,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x12, 0x25, 0x0a, 0x04,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0xca, 0xbb, 0x18, 0x0d,
+	0x63, 0x6f, 0x64, 0x65, 0x2c, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x52, 0x04, 0x63,
+	0x6f, 0x64, 0x65, 0x22, 0x83, 0x01, 0x0a, 0x1a, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f,
+	0x72, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x27, 0x0a, 0x07, 0x65, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x43, 0x6f,
+	0x64, 0x65, 0x52, 0x07, 0x65, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65,
+	0x72, 0x72, 0x4d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72,
+	0x4d, 0x73, 0x67, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x43,
+	0x6f, 0x64, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x63, 0x6f,
+	0x76, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x22, 0x3c, 0x0a, 0x0e, 0x55, 0x6e, 0x6c,
+	0x6f, 0x63, 0x6b, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08, 0x75,
+	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75,
+	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x22, 0x70, 0x0a, 0x13, 0x57, 0x65, 0x62, 0x61, 0x75,
+	0x74, 0x68, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x27,
+	0x0a, 0x07, 0x65, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x0d, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x07,
+	0x65, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x4d, 0x73,
+	0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x4d, 0x73, 0x67, 0x12,
+	0x18, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x62, 0x0a, 0x13, 0x57, 0x65, 0x62,
+	0x61, 0x75, 0x74, 0x68, 0x6e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x37, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x17, 0xca, 0xbb, 0x18, 0x13, 0x63, 0x72,
+	0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x2c, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
+	0x64, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x22, 0x80, 0x02,
+	0x0a, 0x16, 0x57, 0x65, 0x62, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1f, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x42, 0x0f, 0xca, 0xbb, 0x18, 0x0b, 0x49, 0x44, 0x2c, 0x72, 0x65, 0x71,
+	0x75, 0x69, 0x72, 0x65, 0x64, 0x52, 0x02, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x25, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0xca, 0xbb, 0x18, 0x0d, 0x6e, 0x61, 0x6d, 0x65, 0x2c,
+	0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x22,
+	0x0a, 0x0c, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x49, 0x44, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c,
+	0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72,
+	0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x1e, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x73, 0x65, 0x64, 0x41, 0x74, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x73, 0x65, 0x64, 0x41, 0x74,
+	0x22, 0xa6, 0x01, 0x0a, 0x1a, 0x57, 0x65, 0x62, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x43, 0x72, 0x65,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x27, 0x0a, 0x07, 0x65, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x0d, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52,
+	0x07, 0x65, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x4d,
+	0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x4d, 0x73, 0x67,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x31, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x57, 0x65, 0x62,
+	0x61, 0x75, 0x74, 0x68, 0x6e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xca, 0x01, 0x0a, 0x10, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e,
+	0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x1c,
+	0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
+	0x6d, 0x61, 0x69, 0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x4c, 0x6f, 0x67, 0x69,
+	0x6e, 0x41, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x4c,
+	0x6f, 0x67, 0x69, 0x6e, 0x41, 0x74, 0x22, 0x9a, 0x01, 0x0a, 0x14, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x27, 0x0a, 0x07, 0x65, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x0d, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52,
+	0x07, 0x65, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x4d,
+	0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x4d, 0x73, 0x67,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x2b, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x22, 0x5d, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x50, 0x49,
+	0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63,
+	0x6f, 0x70, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x70,
+	0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x44, 0x61, 0x79, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x44, 0x61,
+	0x79, 0x73, 0x22, 0x65, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x50, 0x49, 0x4b,
+	0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x12, 0x27, 0x0a, 0x07, 0x65, 0x72, 0x72, 0x43, 0x6f, 0x64,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x45,
+	0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x07, 0x65, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x4d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x65, 0x72, 0x72, 0x4d, 0x73, 0x67, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x92, 0x02, 0x0a, 0x0a, 0x41, 0x50,
+	0x49, 0x4b, 0x65, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63,
+	0x6f, 0x70, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x70,
+	0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x41, 0x74, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x1e, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x73, 0x65, 0x64, 0x41, 0x74, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x73, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x1e, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x73, 0x65, 0x64, 0x49, 0x70, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x73, 0x65, 0x64, 0x49, 0x70,
+	0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x41, 0x74, 0x22, 0x57,
+	0x0a, 0x0d, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12,
+	0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x70,
+	0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x8e, 0x01, 0x0a, 0x0e, 0x41, 0x50, 0x49, 0x4b,
+	0x65, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x27, 0x0a, 0x07, 0x65, 0x72,
+	0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x07, 0x65, 0x72, 0x72, 0x43,
+	0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x4d, 0x73, 0x67, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x4d, 0x73, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x12, 0x25, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x8b, 0x02, 0x0a, 0x15, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x4f, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02,
+	0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f,
+	0x6c, 0x65, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x72, 0x6f, 0x6c, 0x65,
+	0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x6f, 0x62, 0x69, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x6d, 0x6f, 0x62, 0x69, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d,
+	0x61, 0x69, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x72, 0x6f, 0x6c, 0x65, 0x49, 0x44, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x04, 0x52, 0x07, 0x72,
+	0x6f, 0x6c, 0x65, 0x49, 0x44, 0x73, 0x22, 0x27, 0x0a, 0x0d, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68,
+	0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x44, 0x22,
+	0xb5, 0x04, 0x0a, 0x0c, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x27, 0x0a, 0x07, 0x65, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x0d, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65,
+	0x52, 0x07, 0x65, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72,
+	0x4d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x4d, 0x73,
+	0x67, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49,
+	0x44, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f, 0x6c,
+	0x65, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49,
+	0x44, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x6f, 0x62, 0x69, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x6d, 0x6f, 0x62, 0x69, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61,
+	0x69, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x72, 0x6f, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x72, 0x6f, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x6f, 0x6c, 0x65, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x6f, 0x6c, 0x65,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x69, 0x64, 0x65, 0x4d, 0x6f, 0x64,
+	0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x69, 0x64, 0x65, 0x4d, 0x6f, 0x64,
+	0x65, 0x12, 0x24, 0x0a, 0x0d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x6f, 0x75, 0x74,
+	0x65, 0x72, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x10, 0x74, 0x77, 0x6f, 0x46, 0x61,
+	0x63, 0x74, 0x6f, 0x72, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x11, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x10, 0x74, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x45, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x70,
+	0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x18, 0x12, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x70, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x12, 0x18, 0x0a,
+	0x07, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x44, 0x73, 0x18, 0x13, 0x20, 0x03, 0x28, 0x04, 0x52, 0x07,
+	0x72, 0x6f, 0x6c, 0x65, 0x49, 0x44, 0x73, 0x22, 0x8e, 0x01, 0x0a, 0x0c, 0x55, 0x73, 0x65, 0x72,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x27, 0x0a, 0x07, 0x65, 0x72, 0x72, 0x43,
+	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x62, 0x61, 0x73, 0x65,
+	0x2e, 0x45, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x07, 0x65, 0x72, 0x72, 0x43, 0x6f, 0x64,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x4d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x4d, 0x73, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12,
+	0x27, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65,
+	0x73, 0x70, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xe5, 0x01, 0x0a, 0x0b, 0x55, 0x73, 0x65,
+	0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
+	0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x6f, 0x62, 0x69, 0x6c, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x6f, 0x62, 0x69, 0x6c, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x44, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06,
+	0x72, 0x6f, 0x6c, 0x65, 0x49, 0x44, 0x12, 0x28, 0x0a, 0x0f, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0f, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c,
+	0x22, 0x63, 0x0a, 0x0c, 0x50, 0x65, 0x72, 0x6d, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x27, 0x0a, 0x07, 0x65, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x0d, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65,
+	0x52, 0x07, 0x65, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72,
+	0x4d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x4d, 0x73,
+	0x67, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xb9, 0x01, 0x0a, 0x07, 0x41, 0x70, 0x69, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49,
+	0x44, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x1c, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74,
+	0x68, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74,
+	0x68, 0x6f, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f,
+	0x64, 0x22, 0x88, 0x01, 0x0a, 0x0b, 0x41, 0x70, 0x69, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x27, 0x0a, 0x07, 0x65, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x43, 0x6f, 0x64,
+	0x65, 0x52, 0x07, 0x65, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72,
+	0x72, 0x4d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x4d,
+	0x73, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x22, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x41,
+	0x70, 0x69, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xa0, 0x01, 0x0a,
+	0x0a, 0x41, 0x70, 0x69, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x70,
+	0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70,
+	0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12,
+	0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x22,
+	0x5c, 0x0a, 0x10, 0x41, 0x70, 0x69, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12,
+	0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x68, 0x65, 0x72, 0x69, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x09, 0x69, 0x6e, 0x68, 0x65, 0x72, 0x69, 0x74, 0x65, 0x64, 0x22, 0x64, 0x0a,
+	0x1d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41,
+	0x70, 0x69, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06,
+	0x72, 0x6f, 0x6c, 0x65, 0x49, 0x44, 0x12, 0x2b, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x41, 0x70, 0x69,
+	0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x22, 0x9e, 0x01, 0x0a, 0x18, 0x41, 0x70, 0x69, 0x41, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x69, 0x74, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x27, 0x0a, 0x07, 0x65, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x0d, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65,
+	0x52, 0x07, 0x65, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72,
+	0x4d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x4d, 0x73,
+	0x67, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x2b, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x41, 0x70,
+	0x69, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x22, 0x48, 0x0a, 0x14, 0x4d, 0x65, 0x6e, 0x75, 0x41, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x69, 0x74, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06,
+	0x72, 0x6f, 0x6c, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x72, 0x6f,
+	0x6c, 0x65, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x4d, 0x65, 0x6e, 0x75, 0x49, 0x44, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x04, 0x52, 0x07, 0x4d, 0x65, 0x6e, 0x75, 0x49, 0x44, 0x73, 0x22, 0xb6,
+	0x01, 0x0a, 0x15, 0x4d, 0x65, 0x6e, 0x75, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x12, 0x27, 0x0a, 0x07, 0x65, 0x72, 0x72, 0x43,
+	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x62, 0x61, 0x73, 0x65,
+	0x2e, 0x45, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x07, 0x65, 0x72, 0x72, 0x43, 0x6f, 0x64,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x4d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x4d, 0x73, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f, 0x6c,
+	0x65, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49,
+	0x44, 0x12, 0x18, 0x0a, 0x07, 0x4d, 0x65, 0x6e, 0x75, 0x49, 0x44, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x04, 0x52, 0x07, 0x4d, 0x65, 0x6e, 0x75, 0x49, 0x44, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x69,
+	0x6e, 0x68, 0x65, 0x72, 0x69, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x6e, 0x75, 0x49, 0x44, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x04, 0x52, 0x10, 0x69, 0x6e, 0x68, 0x65, 0x72, 0x69, 0x74, 0x65, 0x64,
+	0x4d, 0x65, 0x6e, 0x75, 0x49, 0x44, 0x73, 0x22, 0xae, 0x02, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x4f, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x52, 0x65,
+	0x71, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49,
+	0x44, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x14, 0x0a,
+	0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x65,
+	0x76, 0x65, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72,
+	0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72,
+	0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6f,
+	0x6e, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70,
+	0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x4e, 0x6f,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x4e, 0x6f, 0x12,
+	0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6d,
+	0x65, 0x6e, 0x75, 0x54, 0x79, 0x70, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6d,
+	0x65, 0x6e, 0x75, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x4d, 0x65,
+	0x74, 0x61, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x22, 0x8a, 0x03, 0x0a, 0x08, 0x4d, 0x65, 0x6e,
+	0x75, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x72, 0x65, 0x6e,
+	0x74, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x70, 0x61, 0x72, 0x65, 0x6e,
+	0x74, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72,
+	0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72,
+	0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6f,
+	0x6e, 0x65, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70,
+	0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x4e, 0x6f,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x4e, 0x6f, 0x12,
+	0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6d,
+	0x65, 0x6e, 0x75, 0x54, 0x79, 0x70, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6d,
+	0x65, 0x6e, 0x75, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2b, 0x0a, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64,
+	0x72, 0x65, 0x6e, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x2e, 0x4d, 0x65, 0x6e, 0x75, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x63, 0x68, 0x69, 0x6c,
+	0x64, 0x72, 0x65, 0x6e, 0x12, 0x1f, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x0e, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x52,
+	0x04, 0x6d, 0x65, 0x74, 0x61, 0x22, 0xa8, 0x03, 0x0a, 0x04, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x14,
+	0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74,
+	0x69, 0x74, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x69, 0x64, 0x65,
+	0x4d, 0x65, 0x6e, 0x75, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x68, 0x69, 0x64, 0x65,
+	0x4d, 0x65, 0x6e, 0x75, 0x12, 0x26, 0x0a, 0x0e, 0x68, 0x69, 0x64, 0x65, 0x42, 0x72, 0x65, 0x61,
+	0x64, 0x63, 0x72, 0x75, 0x6d, 0x62, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x68, 0x69,
+	0x64, 0x65, 0x42, 0x72, 0x65, 0x61, 0x64, 0x63, 0x72, 0x75, 0x6d, 0x62, 0x12, 0x2c, 0x0a, 0x11,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4d, 0x65, 0x6e,
+	0x75, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
+	0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x12, 0x28, 0x0a, 0x0f, 0x69, 0x67,
+	0x6e, 0x6f, 0x72, 0x65, 0x4b, 0x65, 0x65, 0x70, 0x41, 0x6c, 0x69, 0x76, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x4b, 0x65, 0x65, 0x70, 0x41,
+	0x6c, 0x69, 0x76, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x69, 0x64, 0x65, 0x54, 0x61, 0x62, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x68, 0x69, 0x64, 0x65, 0x54, 0x61, 0x62, 0x12, 0x1a,
+	0x0a, 0x08, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x53, 0x72, 0x63, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x53, 0x72, 0x63, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x61,
+	0x72, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a,
+	0x63, 0x61, 0x72, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x2e, 0x0a, 0x12, 0x68, 0x69,
+	0x64, 0x65, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x49, 0x6e, 0x4d, 0x65, 0x6e, 0x75,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x68, 0x69, 0x64, 0x65, 0x43, 0x68, 0x69, 0x6c,
+	0x64, 0x72, 0x65, 0x6e, 0x49, 0x6e, 0x4d, 0x65, 0x6e, 0x75, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x66,
+	0x66, 0x69, 0x78, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x61, 0x66, 0x66, 0x69, 0x78,
+	0x12, 0x22, 0x0a, 0x0c, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x4c, 0x65, 0x76, 0x65, 0x6c,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x4c,
+	0x65, 0x76, 0x65, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x61, 0x6c, 0x50, 0x61, 0x74, 0x68,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x61, 0x6c, 0x50, 0x61, 0x74, 0x68,
+	0x22, 0x67, 0x0a, 0x0b, 0x4d, 0x65, 0x6e, 0x75, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12,
+	0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x70,
+	0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x22, 0x8e, 0x01, 0x0a, 0x10, 0x4d, 0x65,
+	0x6e, 0x75, 0x49, 0x6e, 0x66, 0x6f, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x27,
+	0x0a, 0x07, 0x65, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x0d, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x07,
+	0x65, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x4d, 0x73,
+	0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x4d, 0x73, 0x67, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x23, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x4d, 0x65, 0x6e, 0x75,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xaa, 0x02, 0x0a, 0x0c, 0x4d,
+	0x65, 0x6e, 0x75, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70,
+	0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x70,
+	0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x12, 0x0a,
+	0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74,
+	0x68, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x4e, 0x6f, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x4e, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73,
+	0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73,
+	0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x2f, 0x0a, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65,
+	0x6e, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e,
+	0x4d, 0x65, 0x6e, 0x75, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x73, 0x65, 0x52, 0x08, 0x63, 0x68,
+	0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x12, 0x1f, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x4d, 0x65, 0x74,
+	0x61, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x22, 0xe1, 0x02, 0x0a, 0x08, 0x52, 0x6f, 0x6c, 0x65,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x02, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x24,
+	0x0a, 0x0d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x6f,
+	0x75, 0x74, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x72, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65,
+	0x6d, 0x61, 0x72, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x4e, 0x6f, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x4e, 0x6f, 0x12, 0x1c,
+	0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x66, 0x6f,
+	0x72, 0x63, 0x65, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0e, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74,
+	0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x2b,
+	0x0a, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0f, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x22, 0xf9, 0x02, 0x0a, 0x0c,
+	0x52, 0x6f, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x12, 0x27, 0x0a, 0x07,
+	0x65, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x07, 0x65, 0x72,
+	0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x4d, 0x73, 0x67, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x4d, 0x73, 0x67, 0x12, 0x0e, 0x0a,
+	0x02, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x64, 0x65, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x18, 0x0a,
+	0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x4e, 0x6f, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x4e, 0x6f, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x54, 0x77, 0x6f, 0x46,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x66, 0x6f, 0x72,
+	0x63, 0x65, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x70,
+	0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x70,
+	0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x22, 0x69, 0x0a, 0x0b, 0x52, 0x6f, 0x6c, 0x65, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61,
+	0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x70, 0x61,
+	0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x22, 0x8a, 0x01, 0x0a, 0x0c, 0x52, 0x6f, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x27, 0x0a, 0x07, 0x65, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x43,
+	0x6f, 0x64, 0x65, 0x52, 0x07, 0x65, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x65, 0x72, 0x72, 0x4d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72,
+	0x72, 0x4d, 0x73, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x23, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22,
+	0xf3, 0x02, 0x0a, 0x09, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a,
+	0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x1c, 0x0a,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x41, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x41, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6a, 0x74, 0x69,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6a, 0x74, 0x69, 0x12, 0x16, 0x0a, 0x06, 0x64,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x41, 0x67, 0x65, 0x6e, 0x74,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x41, 0x67, 0x65, 0x6e,
+	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x73, 0x73, 0x75, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x73, 0x73, 0x75, 0x65, 0x64, 0x41, 0x74, 0x12, 0x26, 0x0a,
+	0x0e, 0x69, 0x6d, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x44, 0x18,
+	0x0e, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x69, 0x6d, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61,
+	0x74, 0x6f, 0x72, 0x49, 0x44, 0x22, 0x8c, 0x01, 0x0a, 0x0d, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x27, 0x0a, 0x07, 0x65, 0x72, 0x72, 0x43, 0x6f,
+	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x45, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x07, 0x65, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x4d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x65, 0x72, 0x72, 0x4d, 0x73, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x24,
+	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x22, 0x72, 0x0a, 0x0c, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65,
+	0x53, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65,
+	0x53, 0x69, 0x7a, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x35, 0x0a, 0x09, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x10, 0x0a,
+	0x03, 0x6a, 0x74, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6a, 0x74, 0x69, 0x22,
+	0xad, 0x02, 0x0a, 0x0b, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12,
+	0x10, 0x0a, 0x03, 0x6a, 0x74, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6a, 0x74,
+	0x69, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65,
+	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65,
+	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x41, 0x67, 0x65,
+	0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x41, 0x67,
+	0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x73, 0x73, 0x75, 0x65, 0x64, 0x41, 0x74, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x73, 0x73, 0x75, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x1c, 0x0a, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x41, 0x74, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x41, 0x74, 0x12, 0x26, 0x0a,
+	0x0e, 0x69, 0x6d, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x44, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x69, 0x6d, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61,
+	0x74, 0x6f, 0x72, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x22,
+	0x74, 0x0a, 0x0e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x71, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x90, 0x01, 0x0a, 0x0f, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x27, 0x0a, 0x07, 0x65, 0x72, 0x72,
+	0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x62, 0x61, 0x73,
+	0x65, 0x2e, 0x45, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x07, 0x65, 0x72, 0x72, 0x43, 0x6f,
+	0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x4d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x4d, 0x73, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x12, 0x26, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x24, 0x0a, 0x10, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03,
+	0x6a, 0x74, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6a, 0x74, 0x69, 0x22, 0x98,
+	0x02, 0x0a, 0x0e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x12, 0x1c, 0x0a,
+	0x09, 0x75, 0x73, 0x65, 0x72, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x64,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x0a,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xd7, 0x01, 0x0a, 0x11, 0x4c, 0x6f,
+	0x67, 0x69, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12,
+	0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x70,
+	0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x6f,
+	0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x6e, 0x6f, 0x6d, 0x61, 0x6c,
+	0x6f, 0x75, 0x73, 0x22, 0x96, 0x01, 0x0a, 0x12, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x27, 0x0a, 0x07, 0x65, 0x72,
+	0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x07, 0x65, 0x72, 0x72, 0x43,
+	0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x4d, 0x73, 0x67, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x4d, 0x73, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x12, 0x29, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x9c, 0x01, 0x0a,
+	0x0a, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x72, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x72, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a,
+	0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xcb, 0x01, 0x0a, 0x0f,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x24, 0x0a, 0x0d, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x24, 0x0a, 0x0d, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x50,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x45, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x4d, 0x6f, 0x62, 0x69, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x4d, 0x6f, 0x62, 0x69, 0x6c, 0x65, 0x22, 0x53, 0x0a, 0x0d, 0x54, 0x65, 0x6e,
+	0x61, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61,
+	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x8e,
+	0x01, 0x0a, 0x0e, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x27, 0x0a, 0x07, 0x65, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x43, 0x6f, 0x64,
+	0x65, 0x52, 0x07, 0x65, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72,
+	0x72, 0x4d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x4d,
+	0x73, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x25, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x54,
+	0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22,
+	0xc0, 0x01, 0x0a, 0x0e, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02,
+	0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x22, 0x96, 0x01, 0x0a, 0x12, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72,
+	0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x27, 0x0a, 0x07, 0x65, 0x72, 0x72,
+	0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x62, 0x61, 0x73,
+	0x65, 0x2e, 0x45, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x07, 0x65, 0x72, 0x72, 0x43, 0x6f,
+	0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x4d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x4d, 0x73, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x12, 0x29, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72,
+	0x79, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xd0, 0x01, 0x0a, 0x10,
+	0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x22, 0x9e,
+	0x01, 0x0a, 0x18, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79, 0x44, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x27, 0x0a, 0x07, 0x65,
+	0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x62,
+	0x61, 0x73, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x07, 0x65, 0x72, 0x72,
+	0x43, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x4d, 0x73, 0x67, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x4d, 0x73, 0x67, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x12, 0x2b, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x61, 0x72, 0x79, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22,
+	0x29, 0x0a, 0x13, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79, 0x44, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x6d, 0x0a, 0x11, 0x44, 0x69,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x5f, 0x0a, 0x0d, 0x4f, 0x61, 0x75,
+	0x74, 0x68, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09,
+	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x22, 0x66, 0x0a, 0x11, 0x4f, 0x61,
+	0x75, 0x74, 0x68, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x27, 0x0a, 0x07, 0x65, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x0d, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52,
+	0x07, 0x65, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x4d,
+	0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x4d, 0x73, 0x67,
+	0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75,
+	0x72, 0x6c, 0x22, 0xaf, 0x05, 0x0a, 0x0c, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x64, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x55, 0x72, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65,
+	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x55, 0x72, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x6f,
+	0x70, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x75, 0x74, 0x68, 0x55, 0x72, 0x6c, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x61, 0x75, 0x74, 0x68, 0x55, 0x72, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x55, 0x72, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x55, 0x72, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x53,
+	0x74, 0x79, 0x6c, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68,
+	0x53, 0x74, 0x79, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6e, 0x66, 0x6f, 0x55, 0x72, 0x6c,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x69, 0x6e, 0x66, 0x6f, 0x55, 0x72, 0x6c, 0x12,
+	0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a,
+	0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x64,
+	0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x55, 0x72, 0x6c, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x55, 0x72, 0x6c, 0x12,
+	0x49, 0x0a, 0x0c, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x18,
+	0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x50, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x43, 0x6c, 0x61, 0x69, 0x6d,
+	0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x63, 0x6c,
+	0x61, 0x69, 0x6d, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x24, 0x0a, 0x0d, 0x61, 0x75,
+	0x74, 0x6f, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0f, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0d, 0x61, 0x75, 0x74, 0x6f, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x2c, 0x0a, 0x11, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x70, 0x70,
+	0x72, 0x6f, 0x76, 0x61, 0x6c, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x70, 0x72, 0x6f,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x12, 0x24,
+	0x0a, 0x0d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x49, 0x44, 0x18,
+	0x11, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x6f,
+	0x6c, 0x65, 0x49, 0x44, 0x12, 0x2d, 0x0a, 0x09, 0x72, 0x6f, 0x6c, 0x65, 0x52, 0x75, 0x6c, 0x65,
+	0x73, 0x18, 0x12, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e,
+	0x52, 0x6f, 0x6c, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x09, 0x72, 0x6f, 0x6c, 0x65, 0x52, 0x75,
+	0x6c, 0x65, 0x73, 0x1a, 0x3f, 0x0a, 0x11, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x4d, 0x61, 0x70, 0x70,
+	0x69, 0x6e, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x22, 0x4c, 0x0a, 0x08, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x75, 0x6c, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f,
+	0x6c, 0x65, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x72, 0x6f, 0x6c, 0x65,
+	0x49, 0x44, 0x22, 0x55, 0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x67,
+	0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x70, 0x61, 0x67,
+	0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x92, 0x01, 0x0a, 0x10, 0x50, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x27,
+	0x0a, 0x07, 0x65, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x0d, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x07,
+	0x65, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x4d, 0x73,
+	0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x4d, 0x73, 0x67, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x27, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x50, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x37,
+	0x0a, 0x0b, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x8f, 0x05, 0x0a, 0x10, 0x53, 0x61, 0x6d, 0x6c,
+	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x44, 0x12, 0x26, 0x0a, 0x0e, 0x69, 0x64, 0x70, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x55, 0x72, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x69, 0x64, 0x70, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x55, 0x72, 0x6c,
+	0x12, 0x26, 0x0a, 0x0e, 0x69, 0x64, 0x70, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x58,
+	0x6d, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x64, 0x70, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x58, 0x6d, 0x6c, 0x12, 0x59, 0x0a, 0x10, 0x61, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x65, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x08, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x53, 0x61, 0x6d, 0x6c, 0x50,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x41, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x65, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x10, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x4d, 0x61, 0x70, 0x70,
+	0x69, 0x6e, 0x67, 0x12, 0x2c, 0x0a, 0x11, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x49, 0x64, 0x70, 0x49,
+	0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11,
+	0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x49, 0x64, 0x70, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x65,
+	0x64, 0x12, 0x24, 0x0a, 0x0d, 0x61, 0x75, 0x74, 0x6f, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x61, 0x75, 0x74, 0x6f, 0x50, 0x72,
+	0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x11, 0x70, 0x72, 0x6f, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x11, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x70, 0x70,
+	0x72, 0x6f, 0x76, 0x61, 0x6c, 0x12, 0x24, 0x0a, 0x0d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x52, 0x6f, 0x6c, 0x65, 0x49, 0x44, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x64, 0x65,
+	0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x49, 0x44, 0x12, 0x2d, 0x0a, 0x09, 0x72,
+	0x6f, 0x6c, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f,
+	0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x52,
+	0x09, 0x72, 0x6f, 0x6c, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x55, 0x72, 0x6c, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x55, 0x72, 0x6c, 0x12, 0x16, 0x0a, 0x06,
+	0x61, 0x63, 0x73, 0x55, 0x72, 0x6c, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63,
+	0x73, 0x55, 0x72, 0x6c, 0x1a, 0x43, 0x0a, 0x15, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x65, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x59, 0x0a, 0x13, 0x53, 0x61, 0x6d,
+	0x6c, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71,
+	0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04,
+	0x70, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x22, 0x9a, 0x01, 0x0a, 0x14, 0x53, 0x61, 0x6d, 0x6c, 0x50, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x27, 0x0a,
+	0x07, 0x65, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d,
+	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x07, 0x65,
+	0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x4d, 0x73, 0x67,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x4d, 0x73, 0x67, 0x12, 0x14,
+	0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x12, 0x2b, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x53, 0x61, 0x6d, 0x6c, 0x50,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x22, 0x2a, 0x0a, 0x0c, 0x53, 0x61, 0x6d, 0x6c, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65,
+	0x71, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x22, 0x6c, 0x0a,
+	0x0a, 0x53, 0x61, 0x6d, 0x6c, 0x41, 0x63, 0x73, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08, 0x70,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x53, 0x41, 0x4d, 0x4c, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x53,
+	0x41, 0x4d, 0x4c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x52,
+	0x65, 0x6c, 0x61, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x52, 0x65, 0x6c, 0x61, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x22, 0x48, 0x0a, 0x0e, 0x4f,
+	0x49, 0x44, 0x43, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a,
+	0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x61,
+	0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x61, 0x70,
+	0x70, 0x72, 0x6f, 0x76, 0x65, 0x22, 0xc8, 0x01, 0x0a, 0x13, 0x4f, 0x49, 0x44, 0x43, 0x43, 0x6f,
+	0x6e, 0x73, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x12, 0x27, 0x0a,
+	0x07, 0x65, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d,
+	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x07, 0x65,
+	0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x4d, 0x73, 0x67,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x4d, 0x73, 0x67, 0x12, 0x1a,
+	0x0a, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63,
+	0x6f, 0x70, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x70,
+	0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74, 0x65, 0x64, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74, 0x65, 0x64,
+	0x22, 0x74, 0x0a, 0x0f, 0x4f, 0x49, 0x44, 0x43, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x27, 0x0a, 0x07, 0x65, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x43,
+	0x6f, 0x64, 0x65, 0x52, 0x07, 0x65, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x65, 0x72, 0x72, 0x4d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72,
+	0x72, 0x4d, 0x73, 0x67, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x55, 0x52, 0x4c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x64, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x55, 0x52, 0x4c, 0x22, 0xdc, 0x02, 0x0a, 0x0e, 0x4f, 0x49, 0x44, 0x43, 0x43,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a,
+	0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a,
+	0x0c, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x55, 0x52, 0x49, 0x73, 0x18, 0x07, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x55, 0x52, 0x49,
+	0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x73, 0x18,
+	0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x75, 0x62, 0x6c, 0x69,
+	0x63, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x6b, 0x69, 0x70, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x73, 0x6b, 0x69, 0x70, 0x43, 0x6f, 0x6e, 0x73,
+	0x65, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x65, 0x74, 0x53, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x65, 0x74, 0x53,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0x97, 0x01, 0x0a, 0x14, 0x4f, 0x49, 0x44, 0x43, 0x43, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x27,
+	0x0a, 0x07, 0x65, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x0d, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x07,
+	0x65, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x4d, 0x73,
+	0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x4d, 0x73, 0x67, 0x12,
+	0x1a, 0x0a, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22,
+	0x57, 0x0a, 0x11, 0x4f, 0x49, 0x44, 0x43, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65,
+	0x53, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65,
+	0x53, 0x69, 0x7a, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x96, 0x01, 0x0a, 0x12, 0x4f, 0x49, 0x44,
+	0x43, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x27, 0x0a, 0x07, 0x65, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x0d, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52,
+	0x07, 0x65, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x4d,
+	0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x4d, 0x73, 0x67,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x29, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x4f, 0x49, 0x44,
+	0x43, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x22, 0xea, 0x02, 0x0a, 0x08, 0x4c, 0x6f, 0x67, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x70,
+	0x69, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x61, 0x70, 0x69, 0x12, 0x18, 0x0a, 0x07,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x43, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x71, 0x43,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x70, 0x43, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x73,
+	0x70, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72,
+	0x41, 0x67, 0x65, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x73, 0x65,
+	0x72, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x6f, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x2a, 0x0a, 0x10, 0x69, 0x6d, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x74,
+	0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x69, 0x6d,
+	0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x22, 0xb1,
+	0x01, 0x0a, 0x0b, 0x4c, 0x6f, 0x67, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x12,
+	0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x70, 0x61,
+	0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x70,
+	0x69, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x61, 0x70, 0x69, 0x12, 0x18, 0x0a, 0x07,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x6f, 0x72, 0x22, 0x8a, 0x01, 0x0a, 0x0c, 0x4c, 0x6f, 0x67, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x27, 0x0a, 0x07, 0x65, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x43,
+	0x6f, 0x64, 0x65, 0x52, 0x07, 0x65, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x65, 0x72, 0x72, 0x4d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72,
+	0x72, 0x4d, 0x73, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x23, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x2e, 0x4c, 0x6f, 0x67, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x32,
+	0xe9, 0x02, 0x0a, 0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x42, 0x0a, 0x0c, 0x49, 0x6e, 0x69,
+	0x74, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x0b, 0x2e, 0x62, 0x61, 0x73, 0x65,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x0e, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x42, 0x61,
+	0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x15, 0xca, 0xc1, 0x18, 0x11, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x69, 0x6e, 0x69, 0x74, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x3b, 0x0a,
+	0x0b, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x0b, 0x2e, 0x62,
+	0x61, 0x73, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x0e, 0x2e, 0x62, 0x61, 0x73, 0x65,
+	0x2e, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x0f, 0xca, 0xc1, 0x18, 0x0b, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x40, 0x0a, 0x07, 0x43, 0x61,
+	0x70, 0x74, 0x63, 0x68, 0x61, 0x12, 0x0b, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x1a, 0x16, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x43, 0x61, 0x70, 0x74, 0x63,
+	0x68, 0x61, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x22, 0x10, 0xca, 0xc1, 0x18, 0x0c,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x12, 0x50, 0x0a, 0x0f,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x54, 0x61, 0x67, 0x12,
+	0x10, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x65,
+	0x71, 0x1a, 0x11, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x22, 0x18, 0xd2, 0xc1, 0x18, 0x14, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x64,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x54, 0x61, 0x67, 0x12, 0x4b,
+	0x0a, 0x0d, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x54, 0x6f, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x10, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x65,
+	0x71, 0x1a, 0x10, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52,
+	0x65, 0x73, 0x70, 0x22, 0x16, 0xd2, 0xc1, 0x18, 0x12, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x74,
+	0x72, 0x75, 0x63, 0x74, 0x54, 0x6f, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0x80, 0x1a, 0x0a, 0x04,
+	0x75, 0x73, 0x65, 0x72, 0x12, 0x41, 0x0a, 0x08, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
+	0x12, 0x12, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x42, 0x61, 0x73, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x22, 0x11, 0xd2, 0xc1, 0x18, 0x0d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x12, 0x52, 0x0a, 0x10, 0x53, 0x65, 0x6e, 0x64, 0x52,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x2e, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x64, 0x65,
+	0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x22, 0x16, 0xd2, 0xc1, 0x18, 0x12, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x2f, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x54, 0x0a, 0x0e, 0x46,
+	0x6f, 0x72, 0x67, 0x6f, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x18, 0x2e,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x46, 0x6f, 0x72, 0x67, 0x6f, 0x74, 0x50, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x42,
+	0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x18, 0xd2, 0xc1, 0x18, 0x14, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x2f, 0x66, 0x6f, 0x72, 0x67, 0x6f,
+	0x74, 0x12, 0x51, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x12, 0x17, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74,
+	0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x17, 0xd2, 0xc1, 0x18,
+	0x13, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x2f, 0x72,
+	0x65, 0x73, 0x65, 0x74, 0x12, 0x4a, 0x0a, 0x0c, 0x55, 0x73, 0x65, 0x72, 0x50, 0x65, 0x72, 0x6d,
+	0x43, 0x6f, 0x64, 0x65, 0x12, 0x0b, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x1a, 0x13, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x50, 0x65, 0x72, 0x6d, 0x43, 0x6f,
+	0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x18, 0xca, 0xc1, 0x18, 0x14, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x2f, 0x70, 0x65, 0x72, 0x6d,
+	0x12, 0x5f, 0x0a, 0x0e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x12, 0x18, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x62,
+	0x61, 0x73, 0x65, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x23, 0xd2, 0xc1,
+	0x18, 0x1f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x75, 0x73, 0x65,
+	0x72, 0x2f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x2d, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x12, 0x56, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12,
+	0x1c, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x1a, 0xd2,
+	0xc1, 0x18, 0x16, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x75, 0x73,
+	0x65, 0x72, 0x2f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x56, 0x0a, 0x0a, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1c, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x42, 0x61, 0x73,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x1a, 0xd2, 0xc1, 0x18, 0x16, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x2f, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x12, 0x46, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0b, 0x2e,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x13, 0x2e, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x22,
+	0x18, 0xca, 0xc1, 0x18, 0x14, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f,
+	0x75, 0x73, 0x65, 0x72, 0x2f, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x4d, 0x0a, 0x08, 0x55, 0x73, 0x65,
+	0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x12, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x55, 0x73,
+	0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x13, 0x2e, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x22, 0x18,
+	0xd2, 0xc1, 0x18, 0x14, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x75,
+	0x73, 0x65, 0x72, 0x2f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x3e, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x0b, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x49, 0x44,
+	0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x22, 0x13, 0xe2, 0xc1, 0x18, 0x0f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x12, 0x4f, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x11, 0x2e, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x62,
+	0x61, 0x73, 0x65, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x1b, 0xd2, 0xc1,
+	0x18, 0x17, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x75, 0x73, 0x65,
+	0x72, 0x2f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x4b, 0x0a, 0x0b, 0x55, 0x73, 0x65,
+	0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x0b, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x12, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x50, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x1b, 0xca, 0xc1, 0x18, 0x17, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x2f, 0x70,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x53, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x13, 0x2e, 0x62, 0x61, 0x73,
+	0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x1a,
+	0x0e, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22,
+	0x1a, 0xd2, 0xc1, 0x18, 0x16, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f,
+	0x75, 0x73, 0x65, 0x72, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x53, 0x0a, 0x0a, 0x53,
+	0x77, 0x69, 0x74, 0x63, 0x68, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x14, 0x2e, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x2e, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x1a,
+	0x0e, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22,
+	0x1f, 0xd2, 0xc1, 0x18, 0x1b, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f,
+	0x75, 0x73, 0x65, 0x72, 0x2f, 0x72, 0x6f, 0x6c, 0x65, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68,
+	0x12, 0x5a, 0x0a, 0x0f, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63,
+	0x74, 0x6f, 0x72, 0x12, 0x0b, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x1a, 0x1a, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74,
+	0x6f, 0x72, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x22, 0x1e, 0xd2, 0xc1,
+	0x18, 0x1a, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x75, 0x73, 0x65,
+	0x72, 0x2f, 0x32, 0x66, 0x61, 0x2f, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x12, 0x6f, 0x0a, 0x10,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72,
+	0x12, 0x17, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74,
+	0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x21, 0x2e, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x2e, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x63, 0x6f, 0x76,
+	0x65, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x1f, 0xd2, 0xc1,
+	0x18, 0x1b, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x75, 0x73, 0x65,
+	0x72, 0x2f, 0x32, 0x66, 0x61, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x12, 0x4c, 0x0a,
+	0x0e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12,
+	0x0b, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x49, 0x44, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x62,
+	0x61, 0x73, 0x65, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x1d, 0xd2, 0xc1,
+	0x18, 0x19, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x75, 0x73, 0x65,
+	0x72, 0x2f, 0x32, 0x66, 0x61, 0x2f, 0x72, 0x65, 0x73, 0x65, 0x74, 0x12, 0x71, 0x0a, 0x19, 0x42,
+	0x65, 0x67, 0x69, 0x6e, 0x57, 0x65, 0x62, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x52, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0b, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1a, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x57, 0x65,
+	0x62, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x22, 0x2b, 0xd2, 0xc1, 0x18, 0x27, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x2f, 0x77, 0x65, 0x62, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x2f,
+	0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x2f, 0x62, 0x65, 0x67, 0x69, 0x6e, 0x12, 0x76,
+	0x0a, 0x1a, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x57, 0x65, 0x62, 0x61, 0x75, 0x74, 0x68, 0x6e,
+	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x2e, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x57, 0x65, 0x62, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x52, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x2c, 0xd2, 0xc1, 0x18, 0x28, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x2f, 0x77, 0x65,
+	0x62, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x2f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x2f,
+	0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x12, 0x6b, 0x0a, 0x16, 0x57, 0x65, 0x62, 0x61, 0x75, 0x74,
+	0x68, 0x6e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x4c, 0x69, 0x73, 0x74,
+	0x12, 0x0b, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x21, 0x2e,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x57, 0x65, 0x62, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x43, 0x72,
+	0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x22, 0x21, 0xca, 0xc1, 0x18, 0x1d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x2f, 0x75, 0x73, 0x65, 0x72, 0x2f, 0x77, 0x65, 0x62, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x2f, 0x6c,
+	0x69, 0x73, 0x74, 0x12, 0x6e, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x65, 0x62,
+	0x61, 0x75, 0x74, 0x68, 0x6e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x12,
+	0x1d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x57, 0x65, 0x62, 0x61, 0x75, 0x74, 0x68, 0x6e,
+	0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x0e,
+	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x23,
+	0xd2, 0xc1, 0x18, 0x1f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x75,
+	0x73, 0x65, 0x72, 0x2f, 0x77, 0x65, 0x62, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x2f, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x12, 0x55, 0x0a, 0x18, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x65, 0x62,
+	0x61, 0x75, 0x74, 0x68, 0x6e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x12,
+	0x0b, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x49, 0x44, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x62,
+	0x61, 0x73, 0x65, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x1c, 0xe2, 0xc1,
+	0x18, 0x18, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x75, 0x73, 0x65,
+	0x72, 0x2f, 0x77, 0x65, 0x62, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x12, 0x5f, 0x0a, 0x10, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x0b,
+	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1b, 0x2e, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x22, 0x21, 0xca, 0xc1, 0x18, 0x1d, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x2f, 0x69, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x65, 0x0a, 0x10, 0x4c,
+	0x69, 0x6e, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12,
+	0x14, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x4f, 0x61, 0x75, 0x74, 0x68, 0x4c, 0x6f, 0x67,
+	0x69, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x18, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x4f, 0x61,
+	0x75, 0x74, 0x68, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x22,
+	0x21, 0xd2, 0xc1, 0x18, 0x1d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f,
+	0x75, 0x73, 0x65, 0x72, 0x2f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2f, 0x6c, 0x69,
+	0x6e, 0x6b, 0x12, 0x4f, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x0b, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x49, 0x44, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x42, 0x61, 0x73,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x1c, 0xe2, 0xc1, 0x18, 0x18, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x2f, 0x69, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x12, 0x50, 0x0a, 0x0b, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x4c, 0x6f, 0x67,
+	0x69, 0x6e, 0x12, 0x15, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x55, 0x6e, 0x6c, 0x6f, 0x63,
+	0x6b, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x62, 0x61, 0x73, 0x65,
+	0x2e, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x1a, 0xd2, 0xc1, 0x18, 0x16, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x2f, 0x75,
+	0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x51, 0x0a, 0x0b, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65,
+	0x55, 0x73, 0x65, 0x72, 0x12, 0x15, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x41, 0x70, 0x70,
+	0x72, 0x6f, 0x76, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x1b, 0xd2, 0xc1, 0x18,
+	0x17, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x75, 0x73, 0x65, 0x72,
+	0x2f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x12, 0x62, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x12, 0x16, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71,
+	0x1a, 0x17, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41,
+	0x50, 0x49, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x22, 0x21, 0xd2, 0xc1, 0x18, 0x1d, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x2f, 0x61,
+	0x70, 0x69, 0x6b, 0x65, 0x79, 0x2f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x5c, 0x0a, 0x0c,
+	0x4d, 0x79, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x14, 0x2e, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x1a, 0x15, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x41, 0x50, 0x49, 0x4b, 0x65,
+	0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x22, 0x1f, 0xd2, 0xc1, 0x18, 0x1b, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x2f, 0x61,
+	0x70, 0x69, 0x6b, 0x65, 0x79, 0x2f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x49, 0x0a, 0x0e, 0x52, 0x65,
+	0x76, 0x6f, 0x6b, 0x65, 0x4d, 0x79, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x12, 0x0b, 0x2e, 0x62,
+	0x61, 0x73, 0x65, 0x2e, 0x49, 0x44, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x62, 0x61, 0x73, 0x65,
+	0x2e, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x1a, 0xe2, 0xc1, 0x18, 0x16, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x2f, 0x61,
+	0x70, 0x69, 0x6b, 0x65, 0x79, 0x12, 0x60, 0x0a, 0x0d, 0x4d, 0x79, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x15, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x22, 0x20, 0xd2, 0xc1, 0x18, 0x1c, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x2f, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x2f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x5e, 0x0a, 0x0f, 0x52, 0x65, 0x76, 0x6f, 0x6b,
+	0x65, 0x4d, 0x79, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x2e, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65,
+	0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x22, 0x22, 0xd2, 0xc1, 0x18, 0x1e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x2f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x2f, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x12, 0x5f, 0x0a, 0x15, 0x52, 0x65, 0x76, 0x6f, 0x6b,
+	0x65, 0x4d, 0x79, 0x4f, 0x74, 0x68, 0x65, 0x72, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x0b, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x0e, 0x2e,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x29, 0xd2,
+	0xc1, 0x18, 0x25, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x75, 0x73,
+	0x65, 0x72, 0x2f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2f, 0x72, 0x65, 0x76, 0x6f, 0x6b,
+	0x65, 0x2d, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x73, 0x12, 0x6d, 0x0a, 0x10, 0x4d, 0x79, 0x4c, 0x6f,
+	0x67, 0x69, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x18, 0x2e, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x4c,
+	0x6f, 0x67, 0x69, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x22, 0x24, 0xd2, 0xc1, 0x18, 0x20, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x2f, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x2d, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x2f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x4d, 0x0a, 0x0b, 0x49, 0x6d, 0x70, 0x65, 0x72,
+	0x73, 0x6f, 0x6e, 0x61, 0x74, 0x65, 0x12, 0x0b, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x49, 0x44,
+	0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x4c, 0x6f, 0x67, 0x69,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x22, 0x1f, 0xd2, 0xc1, 0x18, 0x1b, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x2f, 0x69, 0x6d, 0x70, 0x65, 0x72,
+	0x73, 0x6f, 0x6e, 0x61, 0x74, 0x65, 0x12, 0x56, 0x0a, 0x10, 0x45, 0x6e, 0x64, 0x49, 0x6d, 0x70,
+	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0b, 0x2e, 0x62, 0x61, 0x73,
+	0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x10, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e,
+	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x22, 0x23, 0xd2, 0xc1, 0x18, 0x1f, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x2f, 0x69,
+	0x6d, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x74, 0x65, 0x2f, 0x65, 0x6e, 0x64, 0x32, 0x9f,
+	0x02, 0x0a, 0x04, 0x61, 0x70, 0x69, 0x73, 0x12, 0x46, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x41, 0x70, 0x69, 0x12, 0x0e, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x41, 0x70, 0x69,
+	0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x0e, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x42, 0x61, 0x73, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x22, 0x19, 0xd2, 0xc1, 0x18, 0x15, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12,
+	0x46, 0x0a, 0x09, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x70, 0x69, 0x12, 0x0e, 0x2e, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x41, 0x70, 0x69, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x0e, 0x2e, 0x62,
+	0x61, 0x73, 0x65, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x19, 0xd2, 0xc1,
+	0x18, 0x15, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x3c, 0x0a, 0x09, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x41, 0x70, 0x69, 0x12, 0x0b, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x49, 0x44, 0x52, 0x65,
+	0x71, 0x1a, 0x0e, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x22, 0x12, 0xe2, 0xc1, 0x18, 0x0e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x2f, 0x61, 0x70, 0x69, 0x12, 0x49, 0x0a, 0x07, 0x41, 0x70, 0x69, 0x4c, 0x69, 0x73, 0x74,
+	0x12, 0x11, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x41, 0x70, 0x69, 0x50, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x41, 0x70, 0x69, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x22, 0x17, 0xd2, 0xc1, 0x18, 0x13, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6c, 0x69, 0x73, 0x74,
+	0x32, 0xff, 0x04, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x6c,
+	0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74,
+	0x79, 0x12, 0x24, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x4f, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x70, 0x69, 0x41, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x42,
+	0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x23, 0xd2, 0xc1, 0x18, 0x1f, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74,
+	0x79, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x6f, 0x0a, 0x12,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x70, 0x69, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
+	0x74, 0x79, 0x12, 0x24, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x4f, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x70, 0x69, 0x41, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x23, 0xd2, 0xc1, 0x18, 0x1f, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
+	0x74, 0x79, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x5f, 0x0a,
+	0x0c, 0x41, 0x70, 0x69, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x0b, 0x2e,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x49, 0x44, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x2e, 0x41, 0x70, 0x69, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x4c,
+	0x69, 0x73, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x22, 0x21, 0xd2, 0xc1, 0x18,
+	0x1d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x61, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x69, 0x74, 0x79, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x68,
+	0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x41, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x1b, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x4d, 0x65,
+	0x6e, 0x75, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x22, 0x24, 0xd2, 0xc1, 0x18, 0x20, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x2f, 0x6d, 0x65, 0x6e,
+	0x75, 0x2f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x68, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12,
+	0x1b, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x4d, 0x65, 0x6e, 0x75, 0x41, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x69, 0x74, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x62,
+	0x61, 0x73, 0x65, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x24, 0xd2, 0xc1,
+	0x18, 0x20, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x61, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x2f, 0x6d, 0x65, 0x6e, 0x75, 0x2f, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x12, 0x5e, 0x0a, 0x0d, 0x4d, 0x65, 0x6e, 0x75, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x69, 0x74, 0x79, 0x12, 0x0b, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x49, 0x44, 0x52, 0x65, 0x71,
+	0x1a, 0x1c, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x4d, 0x65, 0x6e, 0x75, 0x41, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x22, 0x22,
+	0xd2, 0xc1, 0x18, 0x1e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x61,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x2f, 0x6d, 0x65, 0x6e, 0x75, 0x2f, 0x72, 0x6f,
+	0x6c, 0x65, 0x32, 0x97, 0x03, 0x0a, 0x04, 0x6d, 0x65, 0x6e, 0x75, 0x12, 0x56, 0x0a, 0x0a, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x12, 0x1c, 0x2e, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x4d, 0x65, 0x6e, 0x75, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x42,
+	0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x1a, 0xd2, 0xc1, 0x18, 0x16, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x6d, 0x65, 0x6e, 0x75, 0x2f, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x12, 0x56, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x6e,
+	0x75, 0x12, 0x1c, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x4f, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x52, 0x65, 0x71, 0x1a,
+	0x0e, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22,
+	0x1a, 0xd2, 0xc1, 0x18, 0x16, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f,
+	0x6d, 0x65, 0x6e, 0x75, 0x2f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x3e, 0x0a, 0x0a, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x12, 0x0b, 0x2e, 0x62, 0x61, 0x73, 0x65,
+	0x2e, 0x49, 0x44, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x42, 0x61,
+	0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x13, 0xe2, 0xc1, 0x18, 0x0f, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x6d, 0x65, 0x6e, 0x75, 0x12, 0x4c, 0x0a, 0x0a, 0x4d,
+	0x65, 0x6e, 0x75, 0x42, 0x79, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x0b, 0x2e, 0x62, 0x61, 0x73, 0x65,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x17, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x4d,
+	0x65, 0x6e, 0x75, 0x49, 0x6e, 0x66, 0x6f, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x22,
+	0x18, 0xca, 0xc1, 0x18, 0x14, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f,
+	0x6d, 0x65, 0x6e, 0x75, 0x2f, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x51, 0x0a, 0x08, 0x4d, 0x65, 0x6e,
+	0x75, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x12, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x4d, 0x65,
+	0x6e, 0x75, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x17, 0x2e, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x2e, 0x4d, 0x65, 0x6e, 0x75, 0x49, 0x6e, 0x66, 0x6f, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x22, 0x18, 0xd2, 0xc1, 0x18, 0x14, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x2f, 0x6d, 0x65, 0x6e, 0x75, 0x2f, 0x6c, 0x69, 0x73, 0x74, 0x32, 0x8b, 0x04, 0x0a,
+	0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x49, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52,
+	0x6f, 0x6c, 0x65, 0x12, 0x0f, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x52, 0x6f, 0x6c, 0x65,
+	0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x0e, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x42, 0x61, 0x73, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x22, 0x1a, 0xd2, 0xc1, 0x18, 0x16, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x72, 0x6f, 0x6c, 0x65, 0x2f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x12, 0x49, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x0f,
+	0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x1a,
+	0x0e, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22,
+	0x1a, 0xd2, 0xc1, 0x18, 0x16, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f,
+	0x72, 0x6f, 0x6c, 0x65, 0x2f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x3e, 0x0a, 0x0a, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x0b, 0x2e, 0x62, 0x61, 0x73, 0x65,
+	0x2e, 0x49, 0x44, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x42, 0x61,
+	0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x13, 0xe2, 0xc1, 0x18, 0x0f, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x41, 0x0a, 0x08, 0x52,
+	0x6f, 0x6c, 0x65, 0x42, 0x79, 0x49, 0x44, 0x12, 0x0b, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x49,
+	0x44, 0x52, 0x65, 0x71, 0x1a, 0x13, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x52, 0x6f, 0x6c,
+	0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x22, 0x13, 0xca, 0xc1, 0x18, 0x0f, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x4d,
+	0x0a, 0x08, 0x52, 0x6f, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x12, 0x2e, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x13,
+	0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x22, 0x18, 0xd2, 0xc1, 0x18, 0x14, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x2f, 0x72, 0x6f, 0x6c, 0x65, 0x2f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x53, 0x0a,
+	0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x13, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43,
+	0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x42, 0x61,
+	0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x1a, 0xd2, 0xc1, 0x18, 0x16, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x72, 0x6f, 0x6c, 0x65, 0x2f, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x46, 0x0a, 0x08, 0x52, 0x6f, 0x6c, 0x65, 0x54, 0x72, 0x65, 0x65, 0x12, 0x0b,
+	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x13, 0x2e, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x22, 0x18, 0xca, 0xc1, 0x18, 0x14, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x2f, 0x72, 0x6f, 0x6c, 0x65, 0x2f, 0x74, 0x72, 0x65, 0x65, 0x32, 0xef, 0x01, 0x0a, 0x05, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x4c, 0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x10, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x0e, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x42, 0x61, 0x73,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x1b, 0xd2, 0xc1, 0x18, 0x17, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2f, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x12, 0x45, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x12, 0x10, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x22, 0x14, 0xe2, 0xc1, 0x18, 0x10, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x2f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x51, 0x0a, 0x09, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x13, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x22, 0x19, 0xd2, 0xc1, 0x18, 0x15, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x2f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2f, 0x6c, 0x69, 0x73, 0x74, 0x32, 0x94, 0x02, 0x0a,
+	0x07, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x59, 0x0a, 0x0b, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x15, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x16,
+	0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x22, 0x1b, 0xd2, 0xc1, 0x18, 0x17, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2f, 0x6c,
+	0x69, 0x73, 0x74, 0x12, 0x57, 0x0a, 0x0d, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x1d, 0xd2,
+	0xc1, 0x18, 0x19, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2f, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x12, 0x55, 0x0a, 0x12,
+	0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x0b, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x49, 0x44, 0x52, 0x65, 0x71, 0x1a,
+	0x0e, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22,
+	0x22, 0xd2, 0xc1, 0x18, 0x1e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2f, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x2d, 0x75,
+	0x73, 0x65, 0x72, 0x32, 0x74, 0x0a, 0x0a, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x12, 0x66, 0x0a, 0x0e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4c,
+	0x69, 0x73, 0x74, 0x12, 0x18, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x4c, 0x6f, 0x67, 0x69,
+	0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x22, 0x1f, 0xd2, 0xc1, 0x18, 0x1b, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x2d, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x2f, 0x6c, 0x69, 0x73, 0x74, 0x32, 0xdf, 0x02, 0x0a, 0x06, 0x74, 0x65,
+	0x6e, 0x61, 0x6e, 0x74, 0x12, 0x54, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x65,
+	0x6e, 0x61, 0x6e, 0x74, 0x12, 0x16, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x62,
+	0x61, 0x73, 0x65, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x1c, 0xd2, 0xc1,
+	0x18, 0x18, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x74, 0x65, 0x6e,
+	0x61, 0x6e, 0x74, 0x2f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x4f, 0x0a, 0x0c, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x11, 0x2e, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x2e, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x0e, 0x2e,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x1c, 0xd2,
+	0xc1, 0x18, 0x18, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x74, 0x65,
+	0x6e, 0x61, 0x6e, 0x74, 0x2f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x55, 0x0a, 0x0a, 0x54,
+	0x65, 0x6e, 0x61, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x14, 0x2e, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x2e, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a,
+	0x15, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x22, 0x1a, 0xd2, 0xc1, 0x18, 0x16, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x2f, 0x6c, 0x69,
+	0x73, 0x74, 0x12, 0x57, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x65, 0x6e, 0x61,
+	0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x13, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x1c, 0xd2,
+	0xc1, 0x18, 0x18, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x74, 0x65,
+	0x6e, 0x61, 0x6e, 0x74, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x32, 0xa3, 0x01, 0x0a, 0x06,
+	0x61, 0x70, 0x69, 0x6b, 0x65, 0x79, 0x12, 0x55, 0x0a, 0x0a, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79,
+	0x4c, 0x69, 0x73, 0x74, 0x12, 0x14, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x41, 0x50, 0x49,
+	0x4b, 0x65, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x2e, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x22, 0x1a, 0xd2, 0xc1, 0x18, 0x16, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x2f, 0x61, 0x70, 0x69, 0x6b, 0x65, 0x79, 0x2f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x42, 0x0a,
+	0x0c, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x12, 0x0b, 0x2e,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x49, 0x44, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x62, 0x61, 0x73,
+	0x65, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x15, 0xe2, 0xc1, 0x18, 0x11,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x61, 0x70, 0x69, 0x6b, 0x65,
+	0x79, 0x32, 0xf8, 0x05, 0x0a, 0x0a, 0x64, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79,
+	0x12, 0x55, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x61, 0x72, 0x79, 0x12, 0x15, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x44, 0x69, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x0e, 0x2e, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x1a, 0xd2, 0xc1, 0x18,
+	0x16, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x64, 0x69, 0x63, 0x74,
+	0x2f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x55, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79, 0x12, 0x15, 0x2e, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x2e, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79, 0x49, 0x6e,
+	0x66, 0x6f, 0x1a, 0x0e, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x22, 0x1a, 0xd2, 0xc1, 0x18, 0x16, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x2f, 0x64, 0x69, 0x63, 0x74, 0x2f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x44,
+	0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61,
+	0x72, 0x79, 0x12, 0x0b, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x49, 0x44, 0x52, 0x65, 0x71, 0x1a,
+	0x0e, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22,
+	0x13, 0xe2, 0xc1, 0x18, 0x0f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f,
+	0x64, 0x69, 0x63, 0x74, 0x12, 0x5f, 0x0a, 0x0e, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61,
+	0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x18, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x44,
+	0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71,
+	0x1a, 0x19, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x61, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x22, 0x18, 0xd2, 0xc1, 0x18,
+	0x14, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x64, 0x69, 0x63, 0x74,
+	0x2f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x64, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44,
+	0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12,
+	0x17, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61,
+	0x72, 0x79, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x1a, 0x0e, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x21, 0xd2, 0xc1, 0x18, 0x1d, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x64, 0x69, 0x63, 0x74, 0x2f, 0x64, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x2f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x64, 0x0a, 0x16, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79, 0x44,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x17, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x44, 0x69,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x1a, 0x0e,
+	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x21,
+	0xd2, 0xc1, 0x18, 0x1d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x64,
+	0x69, 0x63, 0x74, 0x2f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x2f, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x12, 0x51, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x69, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x61, 0x72, 0x79, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x0b, 0x2e, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x49, 0x44, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x1a, 0xe2, 0xc1, 0x18, 0x16, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x64, 0x69, 0x63, 0x74, 0x2f, 0x64, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x12, 0x76, 0x0a, 0x16, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x42, 0x79,
+	0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a,
+	0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72,
+	0x79, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x2e, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79, 0x44, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x22, 0x1f, 0xd2, 0xc1, 0x18,
+	0x1b, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x64, 0x69, 0x63, 0x74,
+	0x2f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x2f, 0x6c, 0x69, 0x73, 0x74, 0x32, 0xfd, 0x04, 0x0a,
+	0x05, 0x6f, 0x61, 0x75, 0x74, 0x68, 0x12, 0x5b, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x13, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x0e, 0x2e,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x24, 0xd2,
+	0xc1, 0x18, 0x20, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x6f, 0x61,
+	0x75, 0x74, 0x68, 0x2f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2f, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x12, 0x5b, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x13, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x50, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x0e, 0x2e, 0x62, 0x61, 0x73,
+	0x65, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x24, 0xd2, 0xc1, 0x18, 0x20,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x6f, 0x61, 0x75, 0x74, 0x68,
+	0x2f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x12, 0x4c, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x12, 0x0b, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x49, 0x44, 0x52, 0x65, 0x71, 0x1a,
+	0x0e, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22,
+	0x1d, 0xe2, 0xc1, 0x18, 0x19, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f,
+	0x6f, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x61,
+	0x0a, 0x0f, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x11, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x65, 0x71, 0x1a, 0x17, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x50, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x22, 0x22, 0xd2,
+	0xc1, 0x18, 0x1e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x6f, 0x61,
+	0x75, 0x74, 0x68, 0x2f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2f, 0x6c, 0x69, 0x73,
+	0x74, 0x12, 0x65, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x12, 0x0b, 0x2e, 0x62, 0x61, 0x73, 0x65,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x17, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x50,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x22,
+	0x27, 0xca, 0xc1, 0x18, 0x23, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f,
+	0x6f, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2f, 0x74,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x12, 0x52, 0x0a, 0x0a, 0x4f, 0x61, 0x75, 0x74,
+	0x68, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x14, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x4f,
+	0x61, 0x75, 0x74, 0x68, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x18, 0x2e, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x4f, 0x61, 0x75, 0x74, 0x68, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x22, 0x14, 0xd2, 0xc1, 0x18, 0x10, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x6f, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x4e, 0x0a, 0x0d,
+	0x4f, 0x61, 0x75, 0x74, 0x68, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x12, 0x2e,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65,
+	0x71, 0x1a, 0x10, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x22, 0x17, 0xca, 0xc1, 0x18, 0x13, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6f, 0x61,
+	0x75, 0x74, 0x68, 0x2f, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x32, 0xca, 0x05, 0x0a,
+	0x04, 0x73, 0x61, 0x6d, 0x6c, 0x12, 0x62, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53,
+	0x61, 0x6d, 0x6c, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x17, 0x2e, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x2e, 0x53, 0x61, 0x6d, 0x6c, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x0e, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x42, 0x61, 0x73, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x22, 0x23, 0xd2, 0xc1, 0x18, 0x1f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x73, 0x61, 0x6d, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x2f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x62, 0x0a, 0x12, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x53, 0x61, 0x6d, 0x6c, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12,
+	0x17, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x53, 0x61, 0x6d, 0x6c, 0x50, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x0e, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x23, 0xd2, 0xc1, 0x18, 0x1f, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x73, 0x61, 0x6d, 0x6c, 0x2f, 0x70, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x4f, 0x0a,
+	0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x61, 0x6d, 0x6c, 0x50, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x12, 0x0b, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x49, 0x44, 0x52, 0x65, 0x71,
+	0x1a, 0x0e, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x22, 0x1c, 0xe2, 0xc1, 0x18, 0x18, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x2f, 0x73, 0x61, 0x6d, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x6e,
+	0x0a, 0x10, 0x53, 0x61, 0x6d, 0x6c, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x4c, 0x69,
+	0x73, 0x74, 0x12, 0x1a, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x53, 0x61, 0x6d, 0x6c, 0x50,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x1b,
+	0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x53, 0x61, 0x6d, 0x6c, 0x50, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x22, 0x21, 0xd2, 0xc1, 0x18,
+	0x1d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x73, 0x61, 0x6d, 0x6c,
+	0x2f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x5b,
+	0x0a, 0x10, 0x4c, 0x69, 0x6e, 0x6b, 0x53, 0x61, 0x6d, 0x6c, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x12, 0x13, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x53, 0x61, 0x6d, 0x6c, 0x4c,
+	0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x18, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e,
+	0x4f, 0x61, 0x75, 0x74, 0x68, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x22, 0x18, 0xd2, 0xc1, 0x18, 0x14, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x2f, 0x73, 0x61, 0x6d, 0x6c, 0x2f, 0x6c, 0x69, 0x6e, 0x6b, 0x12, 0x48, 0x0a, 0x0c, 0x53,
+	0x61, 0x6d, 0x6c, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x13, 0x2e, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x2e, 0x53, 0x61, 0x6d, 0x6c, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71,
+	0x1a, 0x0b, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x16, 0xca,
+	0xc1, 0x18, 0x12, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x61, 0x6d, 0x6c, 0x2f, 0x6d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x4f, 0x0a, 0x09, 0x53, 0x61, 0x6d, 0x6c, 0x4c, 0x6f, 0x67,
+	0x69, 0x6e, 0x12, 0x13, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x53, 0x61, 0x6d, 0x6c, 0x4c,
+	0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x18, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e,
+	0x4f, 0x61, 0x75, 0x74, 0x68, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x22, 0x13, 0xd2, 0xc1, 0x18, 0x0f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x61, 0x6d, 0x6c,
+	0x2f, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x41, 0x0a, 0x07, 0x53, 0x61, 0x6d, 0x6c, 0x41, 0x63,
+	0x73, 0x12, 0x11, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x53, 0x61, 0x6d, 0x6c, 0x41, 0x63,
+	0x73, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x4c, 0x6f, 0x67,
+	0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x22, 0x11, 0xd2, 0xc1, 0x18, 0x0d, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x73, 0x61, 0x6d, 0x6c, 0x2f, 0x61, 0x63, 0x73, 0x32, 0xcf, 0x04, 0x0a, 0x04, 0x6f, 0x69,
+	0x64, 0x63, 0x12, 0x61, 0x0a, 0x0f, 0x4f, 0x49, 0x44, 0x43, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e,
+	0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x15, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x4f, 0x49,
+	0x44, 0x43, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x1a, 0x2e, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x4f, 0x49, 0x44, 0x43, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x22, 0x1b, 0xca, 0xc1, 0x18, 0x17, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x6f, 0x69, 0x64, 0x63, 0x2f, 0x63, 0x6f,
+	0x6e, 0x73, 0x65, 0x6e, 0x74, 0x12, 0x59, 0x0a, 0x0b, 0x4f, 0x49, 0x44, 0x43, 0x43, 0x6f, 0x6e,
+	0x73, 0x65, 0x6e, 0x74, 0x12, 0x15, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x4f, 0x49, 0x44,
+	0x43, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x2e, 0x4f, 0x49, 0x44, 0x43, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x22, 0x1b, 0xd2, 0xc1, 0x18, 0x17, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x2f, 0x6f, 0x69, 0x64, 0x63, 0x2f, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74,
+	0x12, 0x69, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x49, 0x44, 0x43, 0x43, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x12, 0x15, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x4f, 0x49, 0x44,
+	0x43, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x1b, 0x2e, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x2e, 0x4f, 0x49, 0x44, 0x43, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x22, 0x21, 0xd2, 0xc1, 0x18, 0x1d, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x6f, 0x69, 0x64, 0x63, 0x2f, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x2f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x69, 0x0a, 0x10, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x49, 0x44, 0x43, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12,
+	0x15, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x4f, 0x49, 0x44, 0x43, 0x43, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x1b, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x4f,
+	0x49, 0x44, 0x43, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x22, 0x21, 0xd2, 0xc1, 0x18, 0x1d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x2f, 0x6f, 0x69, 0x64, 0x63, 0x2f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2f,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x66, 0x0a, 0x0e, 0x4f, 0x49, 0x44, 0x43, 0x43, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x18, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x2e, 0x4f, 0x49, 0x44, 0x43, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x1a, 0x19, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x4f, 0x49, 0x44, 0x43, 0x43,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x22, 0x1f, 0xd2,
+	0xc1, 0x18, 0x1b, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x6f, 0x69,
+	0x64, 0x63, 0x2f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x4b,
+	0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f, 0x49, 0x44, 0x43, 0x43, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x12, 0x0b, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x49, 0x44, 0x52, 0x65, 0x71, 0x1a,
+	0x0e, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22,
+	0x1a, 0xe2, 0xc1, 0x18, 0x16, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f,
+	0x6f, 0x69, 0x64, 0x63, 0x2f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x32, 0xa2, 0x01, 0x0a, 0x04,
+	0x6c, 0x6f, 0x67, 0x73, 0x12, 0x50, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x73, 0x4c,
+	0x69, 0x73, 0x74, 0x12, 0x12, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x4c, 0x6f, 0x67, 0x73,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x13, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e,
+	0x4c, 0x6f, 0x67, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x22, 0x18, 0xd2, 0xc1,
+	0x18, 0x14, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x6c, 0x6f, 0x67,
+	0x73, 0x2f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x48, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x4c, 0x6f, 0x67, 0x73, 0x12, 0x0b, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x1a, 0x0e, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x22, 0x1d, 0xe2, 0xc1, 0x18, 0x19, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x2f, 0x6c, 0x6f, 0x67, 0x73, 0x2f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x6c, 0x6c,
+	0x42, 0x1b, 0x5a, 0x19, 0x66, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x67, 0x6f, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_admin_proto_rawDescOnce sync.Once
+	file_admin_proto_rawDescData = file_admin_proto_rawDesc
+)
+
+func file_admin_proto_rawDescGZIP() []byte {
+	file_admin_proto_rawDescOnce.Do(func() {
+		file_admin_proto_rawDescData = protoimpl.X.CompressGZIP(file_admin_proto_rawDescData)
+	})
+	return file_admin_proto_rawDescData
+}
+
+var file_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 102)
+var file_admin_proto_goTypes = []interface{}{
+	(*StructReq)(nil),                     // 0: admin.StructReq
+	(*StructResp)(nil),                    // 1: admin.StructResp
+	(*ProtoResp)(nil),                     // 2: admin.ProtoResp
+	(*CaptchaInfoResp)(nil),               // 3: admin.CaptchaInfoResp
+	(*UpdatePolicyReq)(nil),               // 4: admin.UpdatePolicyReq
+	(*PolicyPartInfo)(nil),                // 5: admin.PolicyPartInfo
+	(*CreatePolicyReq)(nil),               // 6: admin.CreatePolicyReq
+	(*LoginReq)(nil),                      // 7: admin.LoginReq
+	(*SimpleRole)(nil),                    // 8: admin.SimpleRole
+	(*LoginResp)(nil),                     // 9: admin.LoginResp
+	(*ProfileResp)(nil),                   // 10: admin.ProfileResp
+	(*ProfileReq)(nil),                    // 11: admin.ProfileReq
+	(*RegisterReq)(nil),                   // 12: admin.RegisterReq
+	(*RegisterCodeReq)(nil),               // 13: admin.RegisterCodeReq
+	(*ApproveUserReq)(nil),                // 14: admin.ApproveUserReq
+	(*ChangePasswordReq)(nil),             // 15: admin.ChangePasswordReq
+	(*ForgotPasswordReq)(nil),             // 16: admin.ForgotPasswordReq
+	(*ResetPasswordReq)(nil),              // 17: admin.ResetPasswordReq
+	(*TwoFactorEnrollResp)(nil),           // 18: admin.TwoFactorEnrollResp
+	(*TwoFactorCodeReq)(nil),              // 19: admin.TwoFactorCodeReq
+	(*TwoFactorRecoveryCodesResp)(nil),    // 20: admin.TwoFactorRecoveryCodesResp
+	(*UnlockLoginReq)(nil),                // 21: admin.UnlockLoginReq
+	(*WebauthnOptionsResp)(nil),           // 22: admin.WebauthnOptionsResp
+	(*WebauthnRegisterReq)(nil),           // 23: admin.WebauthnRegisterReq
+	(*WebauthnCredentialInfo)(nil),        // 24: admin.WebauthnCredentialInfo
+	(*WebauthnCredentialListResp)(nil),    // 25: admin.WebauthnCredentialListResp
+	(*UserIdentityInfo)(nil),              // 26: admin.UserIdentityInfo
+	(*UserIdentityListResp)(nil),          // 27: admin.UserIdentityListResp
+	(*CreateAPIKeyReq)(nil),               // 28: admin.CreateAPIKeyReq
+	(*CreateAPIKeyResp)(nil),              // 29: admin.CreateAPIKeyResp
+	(*APIKeyInfo)(nil),                    // 30: admin.APIKeyInfo
+	(*APIKeyListReq)(nil),                 // 31: admin.APIKeyListReq
+	(*APIKeyListResp)(nil),                // 32: admin.APIKeyListResp
+	(*CreateOrUpdateUserReq)(nil),         // 33: admin.CreateOrUpdateUserReq
+	(*SwitchRoleReq)(nil),                 // 34: admin.SwitchRoleReq
+	(*UserInfoResp)(nil),                  // 35: admin.UserInfoResp
+	(*UserListResp)(nil),                  // 36: admin.UserListResp
+	(*UserListReq)(nil),                   // 37: admin.UserListReq
+	(*PermCodeResp)(nil),                  // 38: admin.PermCodeResp
+	(*ApiInfo)(nil),                       // 39: admin.ApiInfo
+	(*ApiListResp)(nil),                   // 40: admin.ApiListResp
+	(*ApiPageReq)(nil),                    // 41: admin.ApiPageReq
+	(*ApiAuthorityInfo)(nil),              // 42: admin.ApiAuthorityInfo
+	(*CreateOrUpdateApiAuthorityReq)(nil), // 43: admin.CreateOrUpdateApiAuthorityReq
+	(*ApiAuthorityListInfoResp)(nil),      // 44: admin.ApiAuthorityListInfoResp
+	(*MenuAuthorityInfoReq)(nil),          // 45: admin.MenuAuthorityInfoReq
+	(*MenuAuthorityInfoResp)(nil),         // 46: admin.MenuAuthorityInfoResp
+	(*CreateOrUpdateMenuReq)(nil),         // 47: admin.CreateOrUpdateMenuReq
+	(*MenuInfo)(nil),                      // 48: admin.MenuInfo
+	(*Meta)(nil),                          // 49: admin.Meta
+	(*MenuListReq)(nil),                   // 50: admin.MenuListReq
+	(*MenuInfoListResp)(nil),              // 51: admin.MenuInfoListResp
+	(*MenuListBase)(nil),                  // 52: admin.MenuListBase
+	(*RoleInfo)(nil),                      // 53: admin.RoleInfo
+	(*RoleInfoResp)(nil),                  // 54: admin.RoleInfoResp
+	(*RoleListReq)(nil),                   // 55: admin.RoleListReq
+	(*RoleListResp)(nil),                  // 56: admin.RoleListResp
+	(*TokenInfo)(nil),                     // 57: admin.TokenInfo
+	(*TokenListResp)(nil),                 // 58: admin.TokenListResp
+	(*TokenListReq)(nil),                  // 59: admin.TokenListReq
+	(*DeleteReq)(nil),                     // 60: admin.DeleteReq
+	(*SessionInfo)(nil),                   // 61: admin.SessionInfo
+	(*SessionListReq)(nil),                // 62: admin.SessionListReq
+	(*SessionListResp)(nil),               // 63: admin.SessionListResp
+	(*SessionRevokeReq)(nil),              // 64: admin.SessionRevokeReq
+	(*LoginEventInfo)(nil),                // 65: admin.LoginEventInfo
+	(*LoginEventListReq)(nil),             // 66: admin.LoginEventListReq
+	(*LoginEventListResp)(nil),            // 67: admin.LoginEventListResp
+	(*TenantInfo)(nil),                    // 68: admin.TenantInfo
+	(*CreateTenantReq)(nil),               // 69: admin.CreateTenantReq
+	(*TenantListReq)(nil),                 // 70: admin.TenantListReq
+	(*TenantListResp)(nil),                // 71: admin.TenantListResp
+	(*DictionaryInfo)(nil),                // 72: admin.DictionaryInfo
+	(*DictionaryListResp)(nil),            // 73: admin.DictionaryListResp
+	(*DictionaryDetail)(nil),              // 74: admin.DictionaryDetail
+	(*DictionaryDetailListResp)(nil),      // 75: admin.DictionaryDetailListResp
+	(*DictionaryDetailReq)(nil),           // 76: admin.DictionaryDetailReq
+	(*DictionaryPageReq)(nil),             // 77: admin.DictionaryPageReq
+	(*OauthLoginReq)(nil),                 // 78: admin.OauthLoginReq
+	(*OauthRedirectResp)(nil),             // 79: admin.OauthRedirectResp
+	(*ProviderInfo)(nil),                  // 80: admin.ProviderInfo
+	(*RoleRule)(nil),                      // 81: admin.RoleRule
+	(*ProviderListReq)(nil),               // 82: admin.ProviderListReq
+	(*ProviderListResp)(nil),              // 83: admin.ProviderListResp
+	(*CallbackReq)(nil),                   // 84: admin.CallbackReq
+	(*SamlProviderInfo)(nil),              // 85: admin.SamlProviderInfo
+	(*SamlProviderListReq)(nil),           // 86: admin.SamlProviderListReq
+	(*SamlProviderListResp)(nil),          // 87: admin.SamlProviderListResp
+	(*SamlLoginReq)(nil),                  // 88: admin.SamlLoginReq
+	(*SamlAcsReq)(nil),                    // 89: admin.SamlAcsReq
+	(*OIDCConsentReq)(nil),                // 90: admin.OIDCConsentReq
+	(*OIDCConsentInfoResp)(nil),           // 91: admin.OIDCConsentInfoResp
+	(*OIDCConsentResp)(nil),               // 92: admin.OIDCConsentResp
+	(*OIDCClientInfo)(nil),                // 93: admin.OIDCClientInfo
+	(*OIDCClientSecretResp)(nil),          // 94: admin.OIDCClientSecretResp
+	(*OIDCClientListReq)(nil),             // 95: admin.OIDCClientListReq
+	(*OIDCClientListResp)(nil),            // 96: admin.OIDCClientListResp
+	(*LogsInfo)(nil),                      // 97: admin.LogsInfo
+	(*LogsListReq)(nil),                   // 98: admin.LogsListReq
+	(*LogsListResp)(nil),                  // 99: admin.LogsListResp
+	nil,                                   // 100: admin.ProviderInfo.ClaimMappingEntry
+	nil,                                   // 101: admin.SamlProviderInfo.AttributeMappingEntry
+	(base.ErrCode)(0),                     // 102: base.ErrCode
+	(*base.Empty)(nil),                    // 103: base.Empty
+	(*base.IDReq)(nil),                    // 104: base.IDReq
+	(*base.StatusCodeReq)(nil),            // 105: base.StatusCodeReq
+	(*base.PageInfoReq)(nil),              // 106: base.PageInfoReq
+	(*base.BaseResp)(nil),                 // 107: base.BaseResp
+}
+var file_admin_proto_depIdxs = []int32{
+	102, // 0: admin.StructResp.errCode:type_name -> base.ErrCode
+	102, // 1: admin.ProtoResp.errCode:type_name -> base.ErrCode
+	102, // 2: admin.CaptchaInfoResp.errCode:type_name -> base.ErrCode
+	5,   // 3: admin.UpdatePolicyReq.rules:type_name -> admin.PolicyPartInfo
+	5,   // 4: admin.CreatePolicyReq.info:type_name -> admin.PolicyPartInfo
+	102, // 5: admin.ProfileResp.errCode:type_name -> base.ErrCode
+	102, // 6: admin.TwoFactorEnrollResp.errCode:type_name -> base.ErrCode
+	102, // 7: admin.TwoFactorRecoveryCodesResp.errCode:type_name -> base.ErrCode
+	102, // 8: admin.WebauthnOptionsResp.errCode:type_name -> base.ErrCode
+	102, // 9: admin.WebauthnCredentialListResp.errCode:type_name -> base.ErrCode
+	24,  // 10: admin.WebauthnCredentialListResp.data:type_name -> admin.WebauthnCredentialInfo
+	102, // 11: admin.UserIdentityListResp.errCode:type_name -> base.ErrCode
+	26,  // 12: admin.UserIdentityListResp.data:type_name -> admin.UserIdentityInfo
+	102, // 13: admin.CreateAPIKeyResp.errCode:type_name -> base.ErrCode
+	102, // 14: admin.APIKeyListResp.errCode:type_name -> base.ErrCode
+	30,  // 15: admin.APIKeyListResp.data:type_name -> admin.APIKeyInfo
+	102, // 16: admin.UserInfoResp.errCode:type_name -> base.ErrCode
+	102, // 17: admin.UserListResp.errCode:type_name -> base.ErrCode
+	35,  // 18: admin.UserListResp.data:type_name -> admin.UserInfoResp
+	102, // 19: admin.PermCodeResp.errCode:type_name -> base.ErrCode
+	102, // 20: admin.ApiListResp.errCode:type_name -> base.ErrCode
+	39,  // 21: admin.ApiListResp.data:type_name -> admin.ApiInfo
+	42,  // 22: admin.CreateOrUpdateApiAuthorityReq.data:type_name -> admin.ApiAuthorityInfo
+	102, // 23: admin.ApiAuthorityListInfoResp.errCode:type_name -> base.ErrCode
+	42,  // 24: admin.ApiAuthorityListInfoResp.data:type_name -> admin.ApiAuthorityInfo
+	102, // 25: admin.MenuAuthorityInfoResp.errCode:type_name -> base.ErrCode
+	49,  // 26: admin.CreateOrUpdateMenuReq.meta:type_name -> admin.Meta
+	48,  // 27: admin.MenuInfo.children:type_name -> admin.MenuInfo
+	49,  // 28: admin.MenuInfo.meta:type_name -> admin.Meta
+	102, // 29: admin.MenuInfoListResp.errCode:type_name -> base.ErrCode
+	48,  // 30: admin.MenuInfoListResp.data:type_name -> admin.MenuInfo
+	52,  // 31: admin.MenuListBase.children:type_name -> admin.MenuListBase
+	49,  // 32: admin.MenuListBase.meta:type_name -> admin.Meta
+	53,  // 33: admin.RoleInfo.children:type_name -> admin.RoleInfo
+	102, // 34: admin.RoleInfoResp.errCode:type_name -> base.ErrCode
+	102, // 35: admin.RoleListResp.errCode:type_name -> base.ErrCode
+	53,  // 36: admin.RoleListResp.data:type_name -> admin.RoleInfo
+	102, // 37: admin.TokenListResp.errCode:type_name -> base.ErrCode
+	57,  // 38: admin.TokenListResp.data:type_name -> admin.TokenInfo
+	102, // 39: admin.SessionListResp.errCode:type_name -> base.ErrCode
+	61,  // 40: admin.SessionListResp.data:type_name -> admin.SessionInfo
+	102, // 41: admin.LoginEventListResp.errCode:type_name -> base.ErrCode
+	65,  // 42: admin.LoginEventListResp.data:type_name -> admin.LoginEventInfo
+	102, // 43: admin.TenantListResp.errCode:type_name -> base.ErrCode
+	68,  // 44: admin.TenantListResp.data:type_name -> admin.TenantInfo
+	102, // 45: admin.DictionaryListResp.errCode:type_name -> base.ErrCode
+	72,  // 46: admin.DictionaryListResp.data:type_name -> admin.DictionaryInfo
+	102, // 47: admin.DictionaryDetailListResp.errCode:type_name -> base.ErrCode
+	74,  // 48: admin.DictionaryDetailListResp.data:type_name -> admin.DictionaryDetail
+	102, // 49: admin.OauthRedirectResp.errCode:type_name -> base.ErrCode
+	100, // 50: admin.ProviderInfo.claimMapping:type_name -> admin.ProviderInfo.ClaimMappingEntry
+	81,  // 51: admin.ProviderInfo.roleRules:type_name -> admin.RoleRule
+	102, // 52: admin.ProviderListResp.errCode:type_name -> base.ErrCode
+	80,  // 53: admin.ProviderListResp.data:type_name -> admin.ProviderInfo
+	101, // 54: admin.SamlProviderInfo.attributeMapping:type_name -> admin.SamlProviderInfo.AttributeMappingEntry
+	81,  // 55: admin.SamlProviderInfo.roleRules:type_name -> admin.RoleRule
+	102, // 56: admin.SamlProviderListResp.errCode:type_name -> base.ErrCode
+	85,  // 57: admin.SamlProviderListResp.data:type_name -> admin.SamlProviderInfo
+	102, // 58: admin.OIDCConsentInfoResp.errCode:type_name -> base.ErrCode
+	102, // 59: admin.OIDCConsentResp.errCode:type_name -> base.ErrCode
+	102, // 60: admin.OIDCClientSecretResp.errCode:type_name -> base.ErrCode
+	102, // 61: admin.OIDCClientListResp.errCode:type_name -> base.ErrCode
+	93,  // 62: admin.OIDCClientListResp.data:type_name -> admin.OIDCClientInfo
+	102, // 63: admin.LogsListResp.errCode:type_name -> base.ErrCode
+	97,  // 64: admin.LogsListResp.data:type_name -> admin.LogsInfo
+	103, // 65: admin.admin.InitDatabase:input_type -> base.Empty
+	103, // 66: admin.admin.HealthCheck:input_type -> base.Empty
+	103, // 67: admin.admin.Captcha:input_type -> base.Empty
+	0,   // 68: admin.admin.DeleteStructTag:input_type -> admin.StructReq
+	0,   // 69: admin.admin.StructToProto:input_type -> admin.StructReq
+	12,  // 70: admin.user.Register:input_type -> admin.RegisterReq
+	13,  // 71: admin.user.SendRegisterCode:input_type -> admin.RegisterCodeReq
+	16,  // 72: admin.user.ForgotPassword:input_type -> admin.ForgotPasswordReq
+	17,  // 73: admin.user.ResetPassword:input_type -> admin.ResetPasswordReq
+	103, // 74: admin.user.UserPermCode:input_type -> base.Empty
+	15,  // 75: admin.user.ChangePassword:input_type -> admin.ChangePasswordReq
+	33,  // 76: admin.user.CreateUser:input_type -> admin.CreateOrUpdateUserReq
+	33,  // 77: admin.user.UpdateUser:input_type -> admin.CreateOrUpdateUserReq
+	103, // 78: admin.user.UserInfo:input_type -> base.Empty
+	37,  // 79: admin.user.UserList:input_type -> admin.UserListReq
+	104, // 80: admin.user.DeleteUser:input_type -> base.IDReq
+	11,  // 81: admin.user.UpdateProfile:input_type -> admin.ProfileReq
+	103, // 82: admin.user.UserProfile:input_type -> base.Empty
+	105, // 83: admin.user.UpdateUserStatus:input_type -> base.StatusCodeReq
+	34,  // 84: admin.user.SwitchRole:input_type -> admin.SwitchRoleReq
+	103, // 85: admin.user.EnrollTwoFactor:input_type -> base.Empty
+	19,  // 86: admin.user.ConfirmTwoFactor:input_type -> admin.TwoFactorCodeReq
+	104, // 87: admin.user.ResetTwoFactor:input_type -> base.IDReq
+	103, // 88: admin.user.BeginWebauthnRegistration:input_type -> base.Empty
+	23,  // 89: admin.user.FinishWebauthnRegistration:input_type -> admin.WebauthnRegisterReq
+	103, // 90: admin.user.WebauthnCredentialList:input_type -> base.Empty
+	24,  // 91: admin.user.UpdateWebauthnCredential:input_type -> admin.WebauthnCredentialInfo
+	104, // 92: admin.user.DeleteWebauthnCredential:input_type -> base.IDReq
+	103, // 93: admin.user.UserIdentityList:input_type -> base.Empty
+	78,  // 94: admin.user.LinkUserIdentity:input_type -> admin.OauthLoginReq
+	104, // 95: admin.user.DeleteUserIdentity:input_type -> base.IDReq
+	21,  // 96: admin.user.UnlockLogin:input_type -> admin.UnlockLoginReq
+	14,  // 97: admin.user.ApproveUser:input_type -> admin.ApproveUserReq
+	28,  // 98: admin.user.CreateAPIKey:input_type -> admin.CreateAPIKeyReq
+	31,  // 99: admin.user.MyAPIKeyList:input_type -> admin.APIKeyListReq
+	104, // 100: admin.user.RevokeMyAPIKey:input_type -> base.IDReq
+	62,  // 101: admin.user.MySessionList:input_type -> admin.SessionListReq
+	64,  // 102: admin.user.RevokeMySession:input_type -> admin.SessionRevokeReq
+	103, // 103: admin.user.RevokeMyOtherSessions:input_type -> base.Empty
+	66,  // 104: admin.user.MyLoginEventList:input_type -> admin.LoginEventListReq
+	104, // 105: admin.user.Impersonate:input_type -> base.IDReq
+	103, // 106: admin.user.EndImpersonation:input_type -> base.Empty
+	39,  // 107: admin.apis.CreateApi:input_type -> admin.ApiInfo
+	39,  // 108: admin.apis.UpdateApi:input_type -> admin.ApiInfo
+	104, // 109: admin.apis.DeleteApi:input_type -> base.IDReq
+	41,  // 110: admin.apis.ApiList:input_type -> admin.ApiPageReq
+	43,  // 111: admin.authority.CreateAuthority:input_type -> admin.CreateOrUpdateApiAuthorityReq
+	43,  // 112: admin.authority.UpdateApiAuthority:input_type -> admin.CreateOrUpdateApiAuthorityReq
+	104, // 113: admin.authority.ApiAuthority:input_type -> base.IDReq
+	45,  // 114: admin.authority.CreateMenuAuthority:input_type -> admin.MenuAuthorityInfoReq
+	45,  // 115: admin.authority.UpdateMenuAuthority:input_type -> admin.MenuAuthorityInfoReq
+	104, // 116: admin.authority.MenuAuthority:input_type -> base.IDReq
+	47,  // 117: admin.menu.CreateMenu:input_type -> admin.CreateOrUpdateMenuReq
+	47,  // 118: admin.menu.UpdateMenu:input_type -> admin.CreateOrUpdateMenuReq
+	104, // 119: admin.menu.DeleteMenu:input_type -> base.IDReq
+	103, // 120: admin.menu.MenuByRole:input_type -> base.Empty
+	50,  // 121: admin.menu.MenuList:input_type -> admin.MenuListReq
+	53,  // 122: admin.role.CreateRole:input_type -> admin.RoleInfo
+	53,  // 123: admin.role.UpdateRole:input_type -> admin.RoleInfo
+	104, // 124: admin.role.DeleteRole:input_type -> base.IDReq
+	104, // 125: admin.role.RoleByID:input_type -> base.IDReq
+	55,  // 126: admin.role.RoleList:input_type -> admin.RoleListReq
+	105, // 127: admin.role.UpdateRoleStatus:input_type -> base.StatusCodeReq
+	103, // 128: admin.role.RoleTree:input_type -> base.Empty
+	57,  // 129: admin.token.UpdateToken:input_type -> admin.TokenInfo
+	60,  // 130: admin.token.DeleteToken:input_type -> admin.DeleteReq
+	59,  // 131: admin.token.TokenList:input_type -> admin.TokenListReq
+	62,  // 132: admin.session.SessionList:input_type -> admin.SessionListReq
+	64,  // 133: admin.session.RevokeSession:input_type -> admin.SessionRevokeReq
+	104, // 134: admin.session.RevokeUserSessions:input_type -> base.IDReq
+	66,  // 135: admin.loginEvent.LoginEventList:input_type -> admin.LoginEventListReq
+	69,  // 136: admin.tenant.CreateTenant:input_type -> admin.CreateTenantReq
+	68,  // 137: admin.tenant.UpdateTenant:input_type -> admin.TenantInfo
+	70,  // 138: admin.tenant.TenantList:input_type -> admin.TenantListReq
+	105, // 139: admin.tenant.UpdateTenantStatus:input_type -> base.StatusCodeReq
+	31,  // 140: admin.apikey.APIKeyList:input_type -> admin.APIKeyListReq
+	104, // 141: admin.apikey.RevokeAPIKey:input_type -> base.IDReq
+	72,  // 142: admin.dictionary.CreateDictionary:input_type -> admin.DictionaryInfo
+	72,  // 143: admin.dictionary.UpdateDictionary:input_type -> admin.DictionaryInfo
+	104, // 144: admin.dictionary.DeleteDictionary:input_type -> base.IDReq
+	77,  // 145: admin.dictionary.DictionaryList:input_type -> admin.DictionaryPageReq
+	74,  // 146: admin.dictionary.CreateDictionaryDetail:input_type -> admin.DictionaryDetail
+	74,  // 147: admin.dictionary.UpdateDictionaryDetail:input_type -> admin.DictionaryDetail
+	104, // 148: admin.dictionary.DeleteDictionaryDetail:input_type -> base.IDReq
+	76,  // 149: admin.dictionary.DetailByDictionaryName:input_type -> admin.DictionaryDetailReq
+	80,  // 150: admin.oauth.CreateProvider:input_type -> admin.ProviderInfo
+	80,  // 151: admin.oauth.UpdateProvider:input_type -> admin.ProviderInfo
+	104, // 152: admin.oauth.DeleteProvider:input_type -> base.IDReq
+	106, // 153: admin.oauth.GetProviderList:input_type -> base.PageInfoReq
+	103, // 154: admin.oauth.GetProviderTemplates:input_type -> base.Empty
+	78,  // 155: admin.oauth.OauthLogin:input_type -> admin.OauthLoginReq
+	84,  // 156: admin.oauth.OauthCallback:input_type -> admin.CallbackReq
+	85,  // 157: admin.saml.CreateSamlProvider:input_type -> admin.SamlProviderInfo
+	85,  // 158: admin.saml.UpdateSamlProvider:input_type -> admin.SamlProviderInfo
+	104, // 159: admin.saml.DeleteSamlProvider:input_type -> base.IDReq
+	86,  // 160: admin.saml.SamlProviderList:input_type -> admin.SamlProviderListReq
+	88,  // 161: admin.saml.LinkSamlIdentity:input_type -> admin.SamlLoginReq
+	88,  // 162: admin.saml.SamlMetadata:input_type -> admin.SamlLoginReq
+	88,  // 163: admin.saml.SamlLogin:input_type -> admin.SamlLoginReq
+	89,  // 164: admin.saml.SamlAcs:input_type -> admin.SamlAcsReq
+	90,  // 165: admin.oidc.OIDCConsentInfo:input_type -> admin.OIDCConsentReq
+	90,  // 166: admin.oidc.OIDCConsent:input_type -> admin.OIDCConsentReq
+	93,  // 167: admin.oidc.CreateOIDCClient:input_type -> admin.OIDCClientInfo
+	93,  // 168: admin.oidc.UpdateOIDCClient:input_type -> admin.OIDCClientInfo
+	95,  // 169: admin.oidc.OIDCClientList:input_type -> admin.OIDCClientListReq
+	104, // 170: admin.oidc.DeleteOIDCClient:input_type -> base.IDReq
+	98,  // 171: admin.logs.GetLogsList:input_type -> admin.LogsListReq
+	103, // 172: admin.logs.DeleteLogs:input_type -> base.Empty
+	107, // 173: admin.admin.InitDatabase:output_type -> base.BaseResp
+	107, // 174: admin.admin.HealthCheck:output_type -> base.BaseResp
+	3,   // 175: admin.admin.Captcha:output_type -> admin.CaptchaInfoResp
+	1,   // 176: admin.admin.DeleteStructTag:output_type -> admin.StructResp
+	2,   // 177: admin.admin.StructToProto:output_type -> admin.ProtoResp
+	107, // 178: admin.user.Register:output_type -> base.BaseResp
+	107, // 179: admin.user.SendRegisterCode:output_type -> base.BaseResp
+	107, // 180: admin.user.ForgotPassword:output_type -> base.BaseResp
+	107, // 181: admin.user.ResetPassword:output_type -> base.BaseResp
+	38,  // 182: admin.user.UserPermCode:output_type -> admin.PermCodeResp
+	107, // 183: admin.user.ChangePassword:output_type -> base.BaseResp
+	107, // 184: admin.user.CreateUser:output_type -> base.BaseResp
+	107, // 185: admin.user.UpdateUser:output_type -> base.BaseResp
+	35,  // 186: admin.user.UserInfo:output_type -> admin.UserInfoResp
+	36,  // 187: admin.user.UserList:output_type -> admin.UserListResp
+	107, // 188: admin.user.DeleteUser:output_type -> base.BaseResp
+	107, // 189: admin.user.UpdateProfile:output_type -> base.BaseResp
+	10,  // 190: admin.user.UserProfile:output_type -> admin.ProfileResp
+	107, // 191: admin.user.UpdateUserStatus:output_type -> base.BaseResp
+	107, // 192: admin.user.SwitchRole:output_type -> base.BaseResp
+	18,  // 193: admin.user.EnrollTwoFactor:output_type -> admin.TwoFactorEnrollResp
+	20,  // 194: admin.user.ConfirmTwoFactor:output_type -> admin.TwoFactorRecoveryCodesResp
+	107, // 195: admin.user.ResetTwoFactor:output_type -> base.BaseResp
+	22,  // 196: admin.user.BeginWebauthnRegistration:output_type -> admin.WebauthnOptionsResp
+	107, // 197: admin.user.FinishWebauthnRegistration:output_type -> base.BaseResp
+	25,  // 198: admin.user.WebauthnCredentialList:output_type -> admin.WebauthnCredentialListResp
+	107, // 199: admin.user.UpdateWebauthnCredential:output_type -> base.BaseResp
+	107, // 200: admin.user.DeleteWebauthnCredential:output_type -> base.BaseResp
+	27,  // 201: admin.user.UserIdentityList:output_type -> admin.UserIdentityListResp
+	79,  // 202: admin.user.LinkUserIdentity:output_type -> admin.OauthRedirectResp
+	107, // 203: admin.user.DeleteUserIdentity:output_type -> base.BaseResp
+	107, // 204: admin.user.UnlockLogin:output_type -> base.BaseResp
+	107, // 205: admin.user.ApproveUser:output_type -> base.BaseResp
+	29,  // 206: admin.user.CreateAPIKey:output_type -> admin.CreateAPIKeyResp
+	32,  // 207: admin.user.MyAPIKeyList:output_type -> admin.APIKeyListResp
+	107, // 208: admin.user.RevokeMyAPIKey:output_type -> base.BaseResp
+	63,  // 209: admin.user.MySessionList:output_type -> admin.SessionListResp
+	107, // 210: admin.user.RevokeMySession:output_type -> base.BaseResp
+	107, // 211: admin.user.RevokeMyOtherSessions:output_type -> base.BaseResp
+	67,  // 212: admin.user.MyLoginEventList:output_type -> admin.LoginEventListResp
+	9,   // 213: admin.user.Impersonate:output_type -> admin.LoginResp
+	9,   // 214: admin.user.EndImpersonation:output_type -> admin.LoginResp
+	107, // 215: admin.apis.CreateApi:output_type -> base.BaseResp
+	107, // 216: admin.apis.UpdateApi:output_type -> base.BaseResp
+	107, // 217: admin.apis.DeleteApi:output_type -> base.BaseResp
+	40,  // 218: admin.apis.ApiList:output_type -> admin.ApiListResp
+	107, // 219: admin.authority.CreateAuthority:output_type -> base.BaseResp
+	107, // 220: admin.authority.UpdateApiAuthority:output_type -> base.BaseResp
+	44,  // 221: admin.authority.ApiAuthority:output_type -> admin.ApiAuthorityListInfoResp
+	107, // 222: admin.authority.CreateMenuAuthority:output_type -> base.BaseResp
+	107, // 223: admin.authority.UpdateMenuAuthority:output_type -> base.BaseResp
+	46,  // 224: admin.authority.MenuAuthority:output_type -> admin.MenuAuthorityInfoResp
+	107, // 225: admin.menu.CreateMenu:output_type -> base.BaseResp
+	107, // 226: admin.menu.UpdateMenu:output_type -> base.BaseResp
+	107, // 227: admin.menu.DeleteMenu:output_type -> base.BaseResp
+	51,  // 228: admin.menu.MenuByRole:output_type -> admin.MenuInfoListResp
+	51,  // 229: admin.menu.MenuList:output_type -> admin.MenuInfoListResp
+	107, // 230: admin.role.CreateRole:output_type -> base.BaseResp
+	107, // 231: admin.role.UpdateRole:output_type -> base.BaseResp
+	107, // 232: admin.role.DeleteRole:output_type -> base.BaseResp
+	54,  // 233: admin.role.RoleByID:output_type -> admin.RoleInfoResp
+	56,  // 234: admin.role.RoleList:output_type -> admin.RoleListResp
+	107, // 235: admin.role.UpdateRoleStatus:output_type -> base.BaseResp
+	56,  // 236: admin.role.RoleTree:output_type -> admin.RoleListResp
+	107, // 237: admin.token.UpdateToken:output_type -> base.BaseResp
+	107, // 238: admin.token.DeleteToken:output_type -> base.BaseResp
+	58,  // 239: admin.token.TokenList:output_type -> admin.TokenListResp
+	63,  // 240: admin.session.SessionList:output_type -> admin.SessionListResp
+	107, // 241: admin.session.RevokeSession:output_type -> base.BaseResp
+	107, // 242: admin.session.RevokeUserSessions:output_type -> base.BaseResp
+	67,  // 243: admin.loginEvent.LoginEventList:output_type -> admin.LoginEventListResp
+	107, // 244: admin.tenant.CreateTenant:output_type -> base.BaseResp
+	107, // 245: admin.tenant.UpdateTenant:output_type -> base.BaseResp
+	71,  // 246: admin.tenant.TenantList:output_type -> admin.TenantListResp
+	107, // 247: admin.tenant.UpdateTenantStatus:output_type -> base.BaseResp
+	32,  // 248: admin.apikey.APIKeyList:output_type -> admin.APIKeyListResp
+	107, // 249: admin.apikey.RevokeAPIKey:output_type -> base.BaseResp
+	107, // 250: admin.dictionary.CreateDictionary:output_type -> base.BaseResp
+	107, // 251: admin.dictionary.UpdateDictionary:output_type -> base.BaseResp
+	107, // 252: admin.dictionary.DeleteDictionary:output_type -> base.BaseResp
+	73,  // 253: admin.dictionary.DictionaryList:output_type -> admin.DictionaryListResp
+	107, // 254: admin.dictionary.CreateDictionaryDetail:output_type -> base.BaseResp
+	107, // 255: admin.dictionary.UpdateDictionaryDetail:output_type -> base.BaseResp
+	107, // 256: admin.dictionary.DeleteDictionaryDetail:output_type -> base.BaseResp
+	75,  // 257: admin.dictionary.DetailByDictionaryName:output_type -> admin.DictionaryDetailListResp
+	107, // 258: admin.oauth.CreateProvider:output_type -> base.BaseResp
+	107, // 259: admin.oauth.UpdateProvider:output_type -> base.BaseResp
+	107, // 260: admin.oauth.DeleteProvider:output_type -> base.BaseResp
+	83,  // 261: admin.oauth.GetProviderList:output_type -> admin.ProviderListResp
+	83,  // 262: admin.oauth.GetProviderTemplates:output_type -> admin.ProviderListResp
+	79,  // 263: admin.oauth.OauthLogin:output_type -> admin.OauthRedirectResp
+	9,   // 264: admin.oauth.OauthCallback:output_type -> admin.LoginResp
+	107, // 265: admin.saml.CreateSamlProvider:output_type -> base.BaseResp
+	107, // 266: admin.saml.UpdateSamlProvider:output_type -> base.BaseResp
+	107, // 267: admin.saml.DeleteSamlProvider:output_type -> base.BaseResp
+	87,  // 268: admin.saml.SamlProviderList:output_type -> admin.SamlProviderListResp
+	79,  // 269: admin.saml.LinkSamlIdentity:output_type -> admin.OauthRedirectResp
+	103, // 270: admin.saml.SamlMetadata:output_type -> base.Empty
+	79,  // 271: admin.saml.SamlLogin:output_type -> admin.OauthRedirectResp
+	9,   // 272: admin.saml.SamlAcs:output_type -> admin.LoginResp
+	91,  // 273: admin.oidc.OIDCConsentInfo:output_type -> admin.OIDCConsentInfoResp
+	92,  // 274: admin.oidc.OIDCConsent:output_type -> admin.OIDCConsentResp
+	94,  // 275: admin.oidc.CreateOIDCClient:output_type -> admin.OIDCClientSecretResp
+	94,  // 276: admin.oidc.UpdateOIDCClient:output_type -> admin.OIDCClientSecretResp
+	96,  // 277: admin.oidc.OIDCClientList:output_type -> admin.OIDCClientListResp
+	107, // 278: admin.oidc.DeleteOIDCClient:output_type -> base.BaseResp
+	99,  // 279: admin.logs.GetLogsList:output_type -> admin.LogsListResp
+	107, // 280: admin.logs.DeleteLogs:output_type -> base.BaseResp
+	173, // [173:281] is the sub-list for method output_type
+	65,  // [65:173] is the sub-list for method input_type
+	65,  // [65:65] is the sub-list for extension type_name
+	65,  // [65:65] is the sub-list for extension extendee
+	0,   // [0:65] is the sub-list for field type_name
+}
+
+func init() { file_admin_proto_init() }
+func file_admin_proto_init() {
+	if File_admin_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_admin_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StructReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StructResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CaptchaInfoResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdatePolicyReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PolicyPartInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreatePolicyReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoginReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SimpleRole); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoginResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProfileResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProfileReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisterReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisterCodeReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApproveUserReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangePasswordReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ForgotPasswordReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResetPasswordReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TwoFactorEnrollResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TwoFactorCodeReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TwoFactorRecoveryCodesResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnlockLoginReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WebauthnOptionsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WebauthnRegisterReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WebauthnCredentialInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WebauthnCredentialListResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserIdentityInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserIdentityListResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateAPIKeyReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateAPIKeyResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*APIKeyInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*APIKeyListReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*APIKeyListResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateOrUpdateUserReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SwitchRoleReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserInfoResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5734,8 +10716,8 @@ func file_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StructResp); i {
+		file_admin_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserListResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5746,8 +10728,8 @@ func file_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoResp); i {
+		file_admin_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserListReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5758,8 +10740,8 @@ func file_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CaptchaInfoResp); i {
+		file_admin_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PermCodeResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5770,8 +10752,8 @@ func file_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdatePolicyReq); i {
+		file_admin_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApiInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5782,8 +10764,8 @@ func file_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PolicyPartInfo); i {
+		file_admin_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApiListResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5794,8 +10776,8 @@ func file_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreatePolicyReq); i {
+		file_admin_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApiPageReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5806,8 +10788,8 @@ func file_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LoginReq); i {
+		file_admin_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApiAuthorityInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5818,8 +10800,8 @@ func file_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SimpleRole); i {
+		file_admin_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateOrUpdateApiAuthorityReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5830,8 +10812,8 @@ func file_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LoginResp); i {
+		file_admin_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApiAuthorityListInfoResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5842,8 +10824,8 @@ func file_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProfileResp); i {
+		file_admin_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MenuAuthorityInfoReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5854,8 +10836,8 @@ func file_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProfileReq); i {
+		file_admin_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MenuAuthorityInfoResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5866,8 +10848,8 @@ func file_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RegisterReq); i {
+		file_admin_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateOrUpdateMenuReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5878,8 +10860,8 @@ func file_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChangePasswordReq); i {
+		file_admin_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MenuInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5890,8 +10872,8 @@ func file_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateOrUpdateUserReq); i {
+		file_admin_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Meta); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5902,8 +10884,8 @@ func file_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserInfoResp); i {
+		file_admin_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MenuListReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5914,8 +10896,8 @@ func file_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserListResp); i {
+		file_admin_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MenuInfoListResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5926,8 +10908,8 @@ func file_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserListReq); i {
+		file_admin_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MenuListBase); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5938,8 +10920,8 @@ func file_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PermCodeResp); i {
+		file_admin_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoleInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5950,8 +10932,8 @@ func file_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ApiInfo); i {
+		file_admin_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoleInfoResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5962,8 +10944,8 @@ func file_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ApiListResp); i {
+		file_admin_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoleListReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5974,8 +10956,8 @@ func file_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ApiPageReq); i {
+		file_admin_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoleListResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5986,8 +10968,8 @@ func file_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ApiAuthorityInfo); i {
+		file_admin_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TokenInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5998,8 +10980,8 @@ func file_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateOrUpdateApiAuthorityReq); i {
+		file_admin_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TokenListResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6010,8 +10992,8 @@ func file_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ApiAuthorityListInfoResp); i {
+		file_admin_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TokenListReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6022,8 +11004,8 @@ func file_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MenuAuthorityInfoReq); i {
+		file_admin_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6034,8 +11016,8 @@ func file_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MenuAuthorityInfoResp); i {
+		file_admin_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SessionInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6046,8 +11028,8 @@ func file_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateOrUpdateMenuReq); i {
+		file_admin_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SessionListReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6058,8 +11040,8 @@ func file_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MenuInfo); i {
+		file_admin_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SessionListResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6070,8 +11052,8 @@ func file_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Meta); i {
+		file_admin_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SessionRevokeReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6082,8 +11064,8 @@ func file_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MenuListReq); i {
+		file_admin_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoginEventInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6094,8 +11076,8 @@ func file_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MenuInfoListResp); i {
+		file_admin_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoginEventListReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6106,8 +11088,8 @@ func file_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MenuListBase); i {
+		file_admin_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoginEventListResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6118,8 +11100,8 @@ func file_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RoleInfo); i {
+		file_admin_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TenantInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6130,8 +11112,8 @@ func file_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RoleInfoResp); i {
+		file_admin_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateTenantReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6142,8 +11124,8 @@ func file_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RoleListReq); i {
+		file_admin_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TenantListReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6154,8 +11136,8 @@ func file_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RoleListResp); i {
+		file_admin_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TenantListResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6166,8 +11148,8 @@ func file_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TokenInfo); i {
+		file_admin_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DictionaryInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6178,8 +11160,8 @@ func file_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TokenListResp); i {
+		file_admin_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DictionaryListResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6190,8 +11172,8 @@ func file_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TokenListReq); i {
+		file_admin_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DictionaryDetail); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6202,8 +11184,8 @@ func file_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteReq); i {
+		file_admin_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DictionaryDetailListResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6214,8 +11196,8 @@ func file_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DictionaryInfo); i {
+		file_admin_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DictionaryDetailReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6226,8 +11208,8 @@ func file_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DictionaryListResp); i {
+		file_admin_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DictionaryPageReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6238,8 +11220,8 @@ func file_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DictionaryDetail); i {
+		file_admin_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OauthLoginReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6250,8 +11232,8 @@ func file_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DictionaryDetailListResp); i {
+		file_admin_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OauthRedirectResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6262,8 +11244,8 @@ func file_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DictionaryDetailReq); i {
+		file_admin_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProviderInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6274,8 +11256,8 @@ func file_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DictionaryPageReq); i {
+		file_admin_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoleRule); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6286,8 +11268,8 @@ func file_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OauthLoginReq); i {
+		file_admin_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProviderListReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6298,8 +11280,8 @@ func file_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OauthRedirectResp); i {
+		file_admin_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProviderListResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6310,8 +11292,8 @@ func file_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProviderInfo); i {
+		file_admin_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CallbackReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6322,8 +11304,8 @@ func file_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProviderListReq); i {
+		file_admin_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SamlProviderInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6334,8 +11316,8 @@ func file_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProviderListResp); i {
+		file_admin_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SamlProviderListReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6346,8 +11328,8 @@ func file_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CallbackReq); i {
+		file_admin_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SamlProviderListResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6358,7 +11340,115 @@ func file_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+		file_admin_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SamlLoginReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SamlAcsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OIDCConsentReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OIDCConsentInfoResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OIDCConsentResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OIDCClientInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OIDCClientSecretResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OIDCClientListReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OIDCClientListResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*LogsInfo); i {
 			case 0:
 				return &v.state
@@ -6370,7 +11460,7 @@ func file_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+		file_admin_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*LogsListReq); i {
 			case 0:
 				return &v.state
@@ -6382,7 +11472,7 @@ func file_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+		file_admin_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*LogsListResp); i {
 			case 0:
 				return &v.state
@@ -6401,9 +11491,9 @@ func file_admin_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_admin_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   56,
+			NumMessages:   102,
 			NumExtensions: 0,
-			NumServices:   10,
+			NumServices:   16,
 		},
 		GoTypes:           file_admin_proto_goTypes,
 		DependencyIndexes: file_admin_proto_depIdxs,
@@ -0,0 +1,59 @@
+/*
+ * Copyright 2023 FormulaGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ */
+
+package admin
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrAPIKeyInvalid is returned by Authenticate for an unknown, expired or revoked key
+var ErrAPIKeyInvalid = errors.New("invalid, expired or revoked api key")
+
+type APIKey interface {
+	// Create returns the new key, which is shown only once, every scope must be allowed to the role of the user
+	Create(ctx context.Context, req CreateAPIKeyReq) (key string, err error)
+	List(ctx context.Context, req APIKeyListReq) (list []*APIKeyInfo, total int, err error)
+	// Revoke revokes a key of the user, userID 0 revokes the key of any user
+	Revoke(ctx context.Context, userID, id uint64) error
+	// Authenticate returns the active key and records its last use
+	Authenticate(ctx context.Context, key, ip string) (*APIKeyInfo, error)
+}
+
+type CreateAPIKeyReq struct {
+	UserID     uint64
+	Name       string
+	Scopes     []string
+	ExpireDays uint64
+}
+
+type APIKeyListReq struct {
+	Page     uint64
+	PageSize uint64
+	UserID   uint64
+}
+
+type APIKeyInfo struct {
+	ID         uint64
+	CreatedAt  string
+	UserID     uint64
+	Name       string
+	Prefix     string
+	Scopes     []string
+	ExpiredAt  string
+	LastUsedAt string
+	LastUsedIP string
+	RevokedAt  string
+	// RoleID the default role of the owner, only set by Authenticate
+	RoleID uint64
+	// Username the owner, only set by Authenticate
+	Username string
+	// TenantID the tenant of the owner, only set by Authenticate
+	TenantID uint64
+	// NewUse the key is used for the first time of the IP or of the interval, only set by Authenticate
+	NewUse bool
+}
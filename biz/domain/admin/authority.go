@@ -10,12 +10,17 @@ import "context"
 
 type Authority interface {
 	UpdateApiAuthority(ctx context.Context, roleIDStr string, infos []*ApiAuthorityInfo) error
+	// ApiAuthority returns the api policies of the role merged with those inherited from its active ancestors
 	ApiAuthority(ctx context.Context, roleIDStr string) (infos []*ApiAuthorityInfo, err error)
 	UpdateMenuAuthority(ctx context.Context, roleID uint64, menuIDs []uint64) error
-	MenuAuthority(ctx context.Context, roleID uint64) (menuIDs []uint64, err error)
+	// MenuAuthority returns the menus of the role merged with those inherited from its ancestors,
+	// inheritedMenuIDs are the ones only inherited
+	MenuAuthority(ctx context.Context, roleID uint64) (menuIDs, inheritedMenuIDs []uint64, err error)
 }
 
 type ApiAuthorityInfo struct {
 	Path   string
 	Method string
+	// Inherited the policy is inherited from an ancestor of the role
+	Inherited bool
 }
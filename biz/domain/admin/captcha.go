@@ -8,4 +8,6 @@ package admin
 
 type Captcha interface {
 	GetCaptcha() (id, b64s string, err error)
+	// Verify checks the answer once, the captcha may have been generated by another instance if redis is enabled
+	Verify(id, answer string) bool
 }
@@ -0,0 +1,18 @@
+/*
+ * Copyright 2023 FormulaGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ */
+
+package admin
+
+import "context"
+
+type Impersonation interface {
+	// Start checks the admin may log in as the user and returns the login of the user,
+	// users who may impersonate themselves cannot be impersonated
+	Start(ctx context.Context, impersonatorID, userID uint64) (*LoginResp, error)
+	// End revokes the impersonation session and returns the login of the admin,
+	// it fails if the session of the admin that started the impersonation has ended meanwhile
+	End(ctx context.Context, jti string, impersonatorID uint64, impersonatorJTI string) (*LoginResp, error)
+}
@@ -10,7 +10,7 @@ import "context"
 
 type Login interface {
 	Login(ctx context.Context, username, password string) (res *LoginResp, err error)
-	LoginByOAuth(ctx context.Context, provider, credential string) (res *LoginResp, err error)
+	LoginByOAuth(ctx context.Context, provider, subject string) (res *LoginResp, err error)
 }
 
 type LoginResp struct {
@@ -19,4 +19,8 @@ type LoginResp struct {
 	RoleName  string
 	RoleValue string
 	RoleID    uint64
+	// TokenVersion embedded in the jwt payload
+	TokenVersion uint64
+	// TenantID the tenant of the user, embedded in the jwt payload
+	TenantID uint64
 }
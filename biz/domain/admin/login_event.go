@@ -0,0 +1,55 @@
+/*
+ * Copyright 2023 FormulaGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ */
+
+package admin
+
+import "context"
+
+// the anomalies flagged on a login attempt
+const (
+	LoginFlagNewIP        = "new_ip"
+	LoginFlagNewDevice    = "new_device"
+	LoginFlagManyFailures = "many_failures"
+)
+
+// the login methods besides the OAuth and SAML providers, which are recorded by the provider name
+const (
+	LoginMethodPassword = "password"
+	LoginMethodWebauthn = "webauthn"
+	LoginMethodAPIKey   = "api_key"
+)
+
+type LoginEvent interface {
+	// Record stores the login attempt, flagging its anomalies against the history of the user, username and IP
+	Record(ctx context.Context, req *LoginEventInfo) error
+	List(ctx context.Context, req *LoginEventListReq) (list []*LoginEventInfo, total int, err error)
+}
+
+type LoginEventInfo struct {
+	ID uint64
+	// UserID 0 if the user is unknown
+	UserID    uint64
+	Username  string
+	Method    string
+	IP        string
+	UserAgent string
+	Device    string
+	Success   bool
+	Reason    string
+	Flags     []string
+	CreatedAt string
+}
+
+type LoginEventListReq struct {
+	Page      uint64
+	PageSize  uint64
+	UserID    uint64
+	Username  string
+	Method    string
+	IP        string
+	Success   *bool
+	Anomalous bool
+}
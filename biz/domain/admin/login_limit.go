@@ -0,0 +1,27 @@
+/*
+ * Copyright 2023 FormulaGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ */
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ErrLoginLocked is returned while the username or the IP is locked after too many failed logins
+var ErrLoginLocked = errors.New("too many failed logins")
+
+type LoginLimit interface {
+	// Check returns the delay to wait before verifying the password, or ErrLoginLocked
+	Check(ctx context.Context, username, ip string) (delay time.Duration, err error)
+	// Fail counts a failed login and locks the username or the IP when the limit is reached
+	Fail(ctx context.Context, username, ip string) error
+	// Succeed clears the failures of the username
+	Succeed(ctx context.Context, username string) error
+	// Unlock clears the lock and the failures of the username and/or the IP
+	Unlock(ctx context.Context, username, ip string) error
+}
@@ -27,6 +27,8 @@ type LogsInfo struct {
 	Time        uint32
 	CreatedAt   string
 	UpdatedAt   string
+	// ImpersonatedUser the user the operator logged in as, empty if the operator acted as itself
+	ImpersonatedUser string
 }
 
 type LogsListReq struct {
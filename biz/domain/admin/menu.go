@@ -12,7 +12,8 @@ type Menu interface {
 	Create(ctx context.Context, menuReq *MenuInfo) error
 	Update(ctx context.Context, menuReq *MenuInfo) error
 	Delete(ctx context.Context, id uint64) error
-	ListByRole(ctx context.Context, roleID uint64) (list []*MenuInfoTree, total uint64, err error)
+	// ListByRoles returns the union of the menus of the roles and their ancestors
+	ListByRoles(ctx context.Context, roleIDs []uint64) (list []*MenuInfoTree, total uint64, err error)
 	List(ctx context.Context, req *MenuListReq) (list []*MenuInfoTree, total int, err error)
 }
 
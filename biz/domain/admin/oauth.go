@@ -6,15 +6,28 @@
 
 package admin
 
-import "context"
+import (
+	"context"
+
+	"formulago/pkg/rolerule"
+)
 
 type Oauth interface {
 	Create(ctx context.Context, providerReq *ProviderInfo) error
 	Update(ctx context.Context, providerReq *ProviderInfo) error
 	Delete(ctx context.Context, providerID uint64) error
 	List(ctx context.Context, req *OauthListReq) (list []*ProviderInfo, total int, err error)
+	// Login returns the authorization URL, the state, PKCE verifier and nonce are kept server-side
 	Login(ctx context.Context, req *OauthLoginReq) (string, error)
+	// Callback redeems the state once, exchanges the code and verifies the id_token of OIDC providers
 	Callback(ctx context.Context, req *OauthCallbackReq) (*OauthUserInfo, error)
+	// Templates returns the built-in provider templates, the client id, secret and redirect url are left to fill in
+	Templates(ctx context.Context) []*ProviderInfo
+	// LinkURL returns the authorization URL to link an account of the provider to the user,
+	// Callback links it when called back by the same user
+	LinkURL(ctx context.Context, userID uint64, req *OauthLoginReq) (string, error)
+	Identities(ctx context.Context, userID uint64) ([]*UserIdentityInfo, error)
+	Unlink(ctx context.Context, userID, identityID uint64) error
 }
 
 type ProviderInfo struct {
@@ -28,8 +41,16 @@ type ProviderInfo struct {
 	TokenUrl     string
 	AuthStyle    uint64
 	InfoUrl      string
-	CreatedAt    string
-	UpdatedAt    string
+	DiscoveryUrl string
+	// ClaimMapping the JSON paths of the user info fields, keyed by subject, credential, username, email, email_verified, mobile, nickname, avatar and groups
+	ClaimMapping map[string]string
+	// AutoProvision creates the user on the first login of an account not linked yet, the role rules decide its role
+	AutoProvision     bool
+	ProvisionApproval bool
+	DefaultRoleID     uint64
+	RoleRules         []rolerule.Rule
+	CreatedAt         string
+	UpdatedAt         string
 }
 
 type OauthListReq struct {
@@ -39,23 +60,44 @@ type OauthListReq struct {
 }
 
 type OauthLoginReq struct {
-	State    string
 	Provider string
 	// Login type for wecom: QRCode, Inside, Quick | 企业微信登录类型：二维码，应用内部，快速 （其他应用的OAuth不需要传）
 	LoginType string
 }
 
 type OauthCallbackReq struct {
-	ProviderName string
-	State        string
-	Code         string
+	State string
+	Code  string
+	// UserID the signed-in user calling back, zero if anonymous, a link completes only for the user who started it
+	UserID uint64
 }
 
 type OauthUserInfo struct {
+	// Provider the provider the state was issued for
+	Provider string `json:"-"`
+	// LinkedUserID the user the account was linked to, zero for the logins
+	LinkedUserID uint64 `json:"-"`
+	// Subject the stable ID of the account at the provider
+	Subject    string `json:"subject"`
 	Credential string `json:"credential"`
 	Username   string `json:"username"`
 	Email      string `json:"email"`
-	Mobile     string `json:"mobile"`
-	NickName   string `json:"nickName"`
-	Picture    string `json:"picture"`
+	// EmailVerified the provider verified the email, only a verified email matches the email domain rules
+	EmailVerified bool     `json:"emailVerified"`
+	Mobile        string   `json:"mobile"`
+	NickName      string   `json:"nickName"`
+	Picture       string   `json:"picture"`
+	Groups        []string `json:"groups"`
+	// Departments the WeCom department IDs
+	Departments []string `json:"departments"`
+}
+
+type UserIdentityInfo struct {
+	ID          uint64
+	CreatedAt   string
+	Provider    string
+	Subject     string
+	Username    string
+	Email       string
+	LastLoginAt string
 }
@@ -0,0 +1,102 @@
+/*
+ * Copyright 2023 FormulaGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ */
+
+package admin
+
+import "context"
+
+// OIDC is the OpenID Connect provider, other apps sign in with the users and roles of FormulaGo
+type OIDC interface {
+	// Authorize validates the authorization request and keeps it for the consent page, returns its request id
+	Authorize(ctx context.Context, req *OIDCAuthorizeReq) (requestID string, err error)
+	// ConsentInfo returns the client and scopes of the authorization request to the signed-in user
+	ConsentInfo(ctx context.Context, userID uint64, requestID string) (*OIDCConsentInfo, error)
+	// Consent answers the authorization request and returns the redirect URL carrying the code or the error
+	Consent(ctx context.Context, userID uint64, requestID string, approve bool) (redirectURL string, err error)
+	// Exchange authenticates the client and redeems the grant of the token endpoint
+	Exchange(ctx context.Context, req *OIDCTokenReq) (*OIDCGrant, error)
+	// Claims returns the user claims released by the scopes, roles are the values of the user's role
+	Claims(ctx context.Context, userID uint64, scopes []string) (map[string]any, error)
+
+	// CreateClient registers a client, the client id is generated if empty,
+	// the secret of a confidential client is returned only once
+	CreateClient(ctx context.Context, req *OIDCClientInfo) (clientID, secret string, err error)
+	// UpdateClient updates a client, a new secret is returned if ResetSecret is set
+	UpdateClient(ctx context.Context, req *OIDCClientInfo) (secret string, err error)
+	DeleteClient(ctx context.Context, id uint64) error
+	ClientList(ctx context.Context, req *OIDCClientListReq) (list []*OIDCClientInfo, total int, err error)
+}
+
+// OIDCError is an OAuth 2.0 error response, it is sent to the redirect URI if set, otherwise shown to the user agent
+type OIDCError struct {
+	Code        string
+	Description string
+	RedirectURI string
+	State       string
+}
+
+func (e *OIDCError) Error() string {
+	return e.Code + ": " + e.Description
+}
+
+type OIDCAuthorizeReq struct {
+	ResponseType        string
+	ClientID            string
+	RedirectURI         string
+	Scope               string
+	State               string
+	Nonce               string
+	CodeChallenge       string
+	CodeChallengeMethod string
+}
+
+type OIDCConsentInfo struct {
+	ClientID   string
+	ClientName string
+	Scopes     []string
+	// Consented the scopes were granted before or the client skips consent, the page may approve at once
+	Consented bool
+}
+
+type OIDCTokenReq struct {
+	GrantType    string
+	Code         string
+	RedirectURI  string
+	CodeVerifier string
+	ClientID     string
+	ClientSecret string
+	Scope        string
+}
+
+// OIDCGrant is the redeemed grant, UserID is 0 for the client credentials grant
+type OIDCGrant struct {
+	ClientID string
+	UserID   uint64
+	Scopes   []string
+	Nonce    string
+}
+
+type OIDCClientInfo struct {
+	ID           uint64
+	CreatedAt    string
+	UpdatedAt    string
+	Status       uint64
+	ClientID     string
+	Name         string
+	RedirectURIs []string
+	GrantTypes   []string
+	Scopes       []string
+	Public       bool
+	SkipConsent  bool
+	// ResetSecret issues a new secret on update
+	ResetSecret bool
+}
+
+type OIDCClientListReq struct {
+	Page     uint64
+	PageSize uint64
+	Name     string
+}
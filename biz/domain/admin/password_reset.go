@@ -0,0 +1,16 @@
+/*
+ * Copyright 2023 FormulaGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ */
+
+package admin
+
+import "context"
+
+type PasswordReset interface {
+	// SendResetMail mails a reset link to the active users of the email, an unknown email is not reported
+	SendResetMail(ctx context.Context, email string) error
+	// Reset sets the new password of the user of a valid reset token, the token cannot be used again
+	Reset(ctx context.Context, token, newPassword string) error
+}
@@ -0,0 +1,33 @@
+/*
+ * Copyright 2023 FormulaGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ */
+
+package admin
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrRefreshTokenReused is returned when an already rotated or revoked refresh token is replayed
+var ErrRefreshTokenReused = errors.New("refresh token reused, the token family is revoked")
+
+type RefreshToken interface {
+	// Create issues a new refresh token for the session, the session jti is the token family
+	Create(ctx context.Context, userID uint64, family string) (refreshToken string, err error)
+	// Rotate consumes the refresh token and issues a new one in the same family
+	Rotate(ctx context.Context, refreshToken string) (res *RefreshTokenInfo, err error)
+	// RevokeFamily revokes all refresh tokens of the family
+	RevokeFamily(ctx context.Context, family string) error
+}
+
+type RefreshTokenInfo struct {
+	UserID       uint64
+	RoleID       uint64
+	TokenVersion uint64
+	TenantID     uint64
+	Family       string
+	RefreshToken string
+}
@@ -0,0 +1,26 @@
+/*
+ * Copyright 2023 FormulaGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ */
+
+package admin
+
+import "context"
+
+type Register interface {
+	// SendCode mails the verification code of the email, when the registration is verified by email
+	SendCode(ctx context.Context, email string) error
+	// Register creates the user with the default role after the captcha or the email code is verified
+	Register(ctx context.Context, req RegisterReq) error
+}
+
+type RegisterReq struct {
+	Username  string
+	Password  string
+	Email     string
+	Mobile    string
+	CaptchaID string
+	Captcha   string
+	EmailCode string
+}
@@ -15,6 +15,8 @@ type Role interface {
 	RoleInfoByID(ctx context.Context, ID uint64) (roleInfo *RoleInfo, err error)
 	List(ctx context.Context, req *RoleListReq) (roleInfoList []*RoleInfo, total int, err error)
 	UpdateStatus(ctx context.Context, ID uint64, status uint8) error
+	// Tree returns the roles of the tenant as a hierarchy, the roles without parent at the top
+	Tree(ctx context.Context) (tree []*RoleInfoTree, err error)
 }
 
 type RoleInfo struct {
@@ -27,6 +29,17 @@ type RoleInfo struct {
 	OrderNo       uint32
 	CreatedAt     string
 	UpdatedAt     string
+	// ForceTwoFactor users of the role must enable two-factor authentication
+	ForceTwoFactor bool
+	// TenantID the tenant of the role
+	TenantID uint64
+	// ParentID the parent role, 0 if none. The role inherits the api and menu permissions of the parent.
+	ParentID uint64
+}
+
+type RoleInfoTree struct {
+	RoleInfo
+	Children []*RoleInfoTree
 }
 
 type RoleListReq struct {
@@ -0,0 +1,61 @@
+/*
+ * Copyright 2023 FormulaGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ */
+
+package admin
+
+import (
+	"context"
+
+	"formulago/pkg/rolerule"
+)
+
+type Saml interface {
+	Create(ctx context.Context, req *SamlProviderInfo) error
+	Update(ctx context.Context, req *SamlProviderInfo) error
+	Delete(ctx context.Context, id uint64) error
+	List(ctx context.Context, req *SamlListReq) (list []*SamlProviderInfo, total int, err error)
+	// Metadata returns the service provider metadata XML to register at the identity provider
+	Metadata(ctx context.Context, provider string) ([]byte, error)
+	// Login returns the URL redirecting the authentication request to the identity provider,
+	// the request ID is kept server-side under the relay state
+	Login(ctx context.Context, provider string) (string, error)
+	// LinkURL returns the URL to link an account of the identity provider to the user, Acs links it
+	LinkURL(ctx context.Context, userID uint64, provider string) (string, error)
+	// Acs verifies the signature and the conditions of the posted response and maps the attributes to the user info
+	Acs(ctx context.Context, req *SamlAcsReq) (*OauthUserInfo, error)
+}
+
+type SamlProviderInfo struct {
+	ID             uint64
+	CreatedAt      string
+	UpdatedAt      string
+	Name           string
+	EntityID       string
+	IdpMetadataUrl string
+	IdpMetadataXml string
+	// AttributeMapping the attribute names of the user info fields, keyed by subject, username, email, email_verified, mobile, nickname, avatar and groups
+	AttributeMapping  map[string]string
+	AllowIdpInitiated bool
+	AutoProvision     bool
+	ProvisionApproval bool
+	DefaultRoleID     uint64
+	RoleRules         []rolerule.Rule
+	// MetadataUrl and AcsUrl the URLs of the service provider registered at the identity provider
+	MetadataUrl string
+	AcsUrl      string
+}
+
+type SamlListReq struct {
+	Page     uint64
+	PageSize uint64
+	Name     string
+}
+
+type SamlAcsReq struct {
+	Provider     string
+	SAMLResponse string
+	RelayState   string
+}
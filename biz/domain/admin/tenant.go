@@ -0,0 +1,50 @@
+/*
+ * Copyright 2023 FormulaGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ */
+
+package admin
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrTenantForbidden the tenants are managed by the default tenant only
+var ErrTenantForbidden = errors.New("tenants are managed by the default tenant only")
+
+type Tenant interface {
+	// Create creates the tenant with its admin role, a copy of the menus of the default tenant and its admin user
+	Create(ctx context.Context, req *CreateTenantReq) (id uint64, err error)
+	Update(ctx context.Context, req *TenantInfo) error
+	UpdateStatus(ctx context.Context, id uint64, status uint8) error
+	List(ctx context.Context, req *TenantListReq) (list []*TenantInfo, total int, err error)
+	// IsActive reports whether the users of the tenant may log in and call the apis
+	IsActive(ctx context.Context, id uint64) (bool, error)
+}
+
+type TenantInfo struct {
+	ID        uint64
+	Name      string
+	Remark    string
+	Status    uint8
+	CreatedAt string
+	UpdatedAt string
+}
+
+type CreateTenantReq struct {
+	Name   string
+	Remark string
+	// the first admin of the tenant
+	AdminUsername string
+	AdminPassword string
+	AdminEmail    string
+	AdminMobile   string
+}
+
+type TenantListReq struct {
+	Page     uint64
+	PageSize uint64
+	Name     string
+}
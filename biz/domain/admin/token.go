@@ -11,7 +11,17 @@ import "context"
 type Token interface {
 	Create(ctx context.Context, req *TokenInfo) error
 	Update(ctx context.Context, req *TokenInfo) error
-	IsExistByUserID(ctx context.Context, userID uint64) bool
+	IsActive(ctx context.Context, jti string) bool
+	Renew(ctx context.Context, jti string, expiredAt string) error
+	Revoke(ctx context.Context, jti string) error
+	// RevokeByUser revokes a session of the user, it fails if the session is not an active one of the user
+	RevokeByUser(ctx context.Context, userID uint64, jti string) error
+	// RevokeAll revokes all sessions of the user but the one of exceptJTI, if set
+	RevokeAll(ctx context.Context, userID uint64, exceptJTI string) error
+	// RevokeRole revokes all sessions of the users of the role
+	RevokeRole(ctx context.Context, roleID uint64) error
+	// RevokeTenant revokes all sessions of the users of the tenant
+	RevokeTenant(ctx context.Context, tenantID uint64) error
 	Delete(ctx context.Context, userID uint64) error
 	List(ctx context.Context, req *TokenListReq) (res []*TokenInfo, total int, err error)
 }
@@ -25,6 +35,15 @@ type TokenInfo struct {
 	Token     string
 	Source    string
 	ExpiredAt string
+	JTI       string
+	Device    string
+	IP        string
+	UserAgent string
+	IssuedAt  string
+	// ImpersonatorID the admin logged in as the user, 0 if it is the session of the user
+	ImpersonatorID uint64
+	// RoleID the current role of the session, the user may switch it
+	RoleID uint64
 }
 
 type TokenListReq struct {
@@ -0,0 +1,43 @@
+/*
+ * Copyright 2023 FormulaGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ */
+
+package admin
+
+import "context"
+
+type TwoFactor interface {
+	// Enroll generates a pending TOTP secret, it is enabled after Confirm
+	Enroll(ctx context.Context, userID uint64) (res *TwoFactorEnrollInfo, err error)
+	// Confirm enables TOTP by the first valid code and returns the one-time recovery codes
+	Confirm(ctx context.Context, userID uint64, code string) (recoveryCodes []string, err error)
+	Reset(ctx context.Context, userID uint64) error
+	Status(ctx context.Context, userID, roleID uint64) (res *TwoFactorStatus, err error)
+	// Verify checks a TOTP code or consumes a recovery code
+	Verify(ctx context.Context, userID uint64, code string) error
+	// CreateChallenge stores the login waiting for the second step and returns the mfa token
+	CreateChallenge(ctx context.Context, req *TwoFactorChallenge) (mfaToken string, err error)
+	// VerifyChallenge passes the login of the mfa token, the login is returned with the error of a wrong code
+	VerifyChallenge(ctx context.Context, mfaToken, code string) (res *TwoFactorChallenge, err error)
+}
+
+type TwoFactorEnrollInfo struct {
+	Secret string
+	URI    string
+}
+
+type TwoFactorStatus struct {
+	Enabled bool
+	// Enforced the role of the user forces two-factor authentication
+	Enforced bool
+}
+
+type TwoFactorChallenge struct {
+	Login     LoginResp `json:"login"`
+	Source    string    `json:"source"`
+	Device    string    `json:"device"`
+	Attempts  int       `json:"attempts"`
+	ExpiredAt int64     `json:"expiredAt"`
+}
@@ -15,44 +15,71 @@ type User interface {
 	Create(ctx context.Context, req CreateOrUpdateUserReq) error
 	Update(ctx context.Context, req CreateOrUpdateUserReq) error
 	ChangePassword(ctx context.Context, userID uint64, oldPassword, newPassword string) error
+	// ResetPassword sets the password without the old one, after the user has been verified otherwise
+	ResetPassword(ctx context.Context, userID uint64, newPassword string) error
 	UserInfo(ctx context.Context, id uint64) (userInfo *UserInfo, err error)
 	List(ctx context.Context, req UserListReq) (userList []*UserInfo, total int, err error)
 	UpdateUserStatus(ctx context.Context, id uint64, status uint64) error
 	DeleteUser(ctx context.Context, id uint64) error
 	UpdateProfile(ctx context.Context, req UpdateUserProfileReq) error
+	// TokenVersion returns the token version of an active user, tokens with another version are invalid
+	TokenVersion(ctx context.Context, id uint64) (version uint64, err error)
+	// PasswordExpired reports whether the password is older than the max age and must be changed before anything else
+	PasswordExpired(ctx context.Context, id uint64) (bool, error)
+	// ApproveUser activates a self-registered user waiting for approval, or deletes it if rejected
+	ApproveUser(ctx context.Context, id uint64, approve bool) error
+	// RoleIDs returns all roles of the user, the default role first
+	RoleIDs(ctx context.Context, id uint64) (roleIDs []uint64, err error)
+	// ActiveRoles returns the enabled roles of the user, a request passes if one of them allows it
+	ActiveRoles(ctx context.Context, id uint64) (roles []*RoleInfo, err error)
+	// SwitchRole makes another role of the user the current one of the session jti, which the front end shows.
+	// The refresh of the session keeps it, the roles of the user and the role of the next logins are left alone.
+	SwitchRole(ctx context.Context, id uint64, jti string, roleID uint64) error
+	// CurrentRole returns the current role of the session jti, the default role of the user if the session has not switched
+	CurrentRole(ctx context.Context, id uint64, jti string) (roleInfo *RoleInfo, err error)
 }
 
 type CreateOrUpdateUserReq struct {
-	ID       uint64
-	Avatar   string
-	RoleID   uint64
+	ID     uint64
+	Avatar string
+	// RoleID the default role used by the logins, the first of RoleIDs if not set
+	RoleID uint64
+	// RoleIDs all roles of the user, the default role is added if missing.
+	// An update without RoleIDs keeps the roles of the user.
+	RoleIDs  []uint64
 	Mobile   string
 	Email    string
 	Status   uint64
 	Username string
 	Password string
 	Nickname string
+	// PendingApproval only for Create, the user waits for approval
+	PendingApproval bool
 }
 
 type UserInfo struct {
-	ID            uint64
-	Status        uint8
-	Username      string
-	Password      string
-	Nickname      string
-	SideMode      string
-	BaseColor     string
-	ActiveColor   string
-	RoleID        uint64
-	Mobile        string
-	Email         string
-	Wecom         string
-	Avatar        string
-	CreatedAt     time.Time
-	UpdatedAt     time.Time
-	RoleName      string
-	RoleValue     string
-	DefaultRouter string
+	ID              uint64
+	Status          uint8
+	Username        string
+	Password        string
+	Nickname        string
+	SideMode        string
+	BaseColor       string
+	ActiveColor     string
+	RoleID          uint64
+	RoleIDs         []uint64
+	Mobile          string
+	Email           string
+	Wecom           string
+	Avatar          string
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+	RoleName        string
+	RoleValue       string
+	DefaultRouter   string
+	TotpEnabled     bool
+	PendingApproval bool
+	TenantID        uint64
 }
 
 type UserListReq struct {
@@ -63,6 +90,8 @@ type UserListReq struct {
 	Email    string
 	Mobile   string
 	RoleID   uint64
+	// PendingApproval lists only the users waiting for approval
+	PendingApproval bool
 }
 
 type UpdateUserProfileReq struct {
@@ -0,0 +1,36 @@
+/*
+ * Copyright 2023 FormulaGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ */
+
+package admin
+
+import "context"
+
+type Webauthn interface {
+	// BeginRegistration returns the options JSON for navigator.credentials.create
+	BeginRegistration(ctx context.Context, userID uint64) (options string, err error)
+	FinishRegistration(ctx context.Context, userID uint64, name, credential string) error
+	// BeginLogin returns the options JSON for navigator.credentials.get, an empty username starts a discoverable login
+	BeginLogin(ctx context.Context, username string) (res *WebauthnLoginOptions, err error)
+	FinishLogin(ctx context.Context, sessionID, credential string) (res *LoginResp, err error)
+	List(ctx context.Context, userID uint64) (res []*WebauthnCredentialInfo, err error)
+	Rename(ctx context.Context, userID, id uint64, name string) error
+	Delete(ctx context.Context, userID, id uint64) error
+}
+
+type WebauthnLoginOptions struct {
+	SessionID string
+	Options   string
+}
+
+type WebauthnCredentialInfo struct {
+	ID           uint64
+	CreatedAt    string
+	Name         string
+	CredentialID string
+	Transports   []string
+	SignCount    uint32
+	LastUsedAt   string
+}
@@ -0,0 +1,91 @@
+// Code generated by hertz generator.
+
+package admin
+
+import (
+	"context"
+	admin2 "formulago/biz/domain/admin"
+	logic "formulago/biz/logic/admin"
+	"formulago/data"
+
+	admin "formulago/api/model/admin"
+	base "formulago/api/model/base"
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+)
+
+// APIKeyList .
+// @router /api/admin/apikey/list [POST]
+func APIKeyList(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req admin.APIKeyListReq
+	resp := new(admin.APIKeyListResp)
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		resp.ErrCode = base.ErrCode_Fail
+		resp.ErrMsg = err.Error()
+		c.JSON(consts.StatusBadRequest, resp)
+		return
+	}
+
+	keys, total, err := logic.NewAPIKey(data.Default(), data.CasbinEnforcer()).List(ctx, admin2.APIKeyListReq{
+		Page:     req.Page,
+		PageSize: req.PageSize,
+		UserID:   req.UserID,
+	})
+	if err != nil {
+		resp.ErrCode = base.ErrCode_Fail
+		resp.ErrMsg = err.Error()
+		c.JSON(consts.StatusInternalServerError, resp)
+		return
+	}
+	for _, v := range keys {
+		resp.Data = append(resp.Data, apiKeyInfo(v))
+	}
+	resp.Total = uint64(total)
+	resp.ErrCode = base.ErrCode_Success
+	resp.ErrMsg = "success"
+	c.JSON(consts.StatusOK, resp)
+}
+
+// RevokeAPIKey .
+// @router /api/admin/apikey [DELETE]
+func RevokeAPIKey(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req base.IDReq
+	resp := new(base.BaseResp)
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		resp.ErrCode = base.ErrCode_Fail
+		resp.ErrMsg = err.Error()
+		c.JSON(consts.StatusBadRequest, resp)
+		return
+	}
+
+	err = logic.NewAPIKey(data.Default(), data.CasbinEnforcer()).Revoke(ctx, 0, req.ID)
+	if err != nil {
+		resp.ErrCode = base.ErrCode_Fail
+		resp.ErrMsg = err.Error()
+		c.JSON(consts.StatusInternalServerError, resp)
+		return
+	}
+
+	resp.ErrCode = base.ErrCode_Success
+	resp.ErrMsg = "success"
+	c.JSON(consts.StatusOK, resp)
+}
+
+func apiKeyInfo(v *admin2.APIKeyInfo) *admin.APIKeyInfo {
+	return &admin.APIKeyInfo{
+		ID:         v.ID,
+		CreatedAt:  v.CreatedAt,
+		UserID:     v.UserID,
+		Name:       v.Name,
+		Prefix:     v.Prefix,
+		Scopes:     v.Scopes,
+		ExpiredAt:  v.ExpiredAt,
+		LastUsedAt: v.LastUsedAt,
+		LastUsedIp: v.LastUsedIP,
+		RevokedAt:  v.RevokedAt,
+	}
+}
@@ -121,8 +121,9 @@ func ApiAuthority(ctx context.Context, c *app.RequestContext) {
 	resp.Total = uint64(len(policies))
 	for _, v := range policies {
 		resp.Data = append(resp.Data, &admin.ApiAuthorityInfo{
-			Path:   v.Path,
-			Method: v.Method,
+			Path:      v.Path,
+			Method:    v.Method,
+			Inherited: v.Inherited,
 		})
 	}
 	resp.ErrCode = base.ErrCode_Success
@@ -207,7 +208,7 @@ func MenuAuthority(ctx context.Context, c *app.RequestContext) {
 		return
 	}
 
-	menuIDs, err := logic.NewAuthority(data.Default(), data.CasbinEnforcer()).MenuAuthority(ctx, req.ID)
+	menuIDs, inheritedMenuIDs, err := logic.NewAuthority(data.Default(), data.CasbinEnforcer()).MenuAuthority(ctx, req.ID)
 	if err != nil {
 		resp.ErrCode = base.ErrCode_Fail
 		resp.ErrMsg = err.Error()
@@ -216,6 +217,7 @@ func MenuAuthority(ctx context.Context, c *app.RequestContext) {
 	}
 	resp.RoleID = req.ID
 	resp.MenuIDs = menuIDs
+	resp.InheritedMenuIDs = inheritedMenuIDs
 	resp.ErrCode = base.ErrCode_Success
 	resp.ErrMsg = "success"
 
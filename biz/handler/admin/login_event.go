@@ -0,0 +1,81 @@
+// Code generated by hertz generator.
+
+package admin
+
+import (
+	"context"
+
+	admin2 "formulago/biz/domain/admin"
+	logic "formulago/biz/logic/admin"
+	"formulago/configs"
+	"formulago/data"
+
+	admin "formulago/api/model/admin"
+	base "formulago/api/model/base"
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+)
+
+// LoginEventList .
+// @router /api/admin/login-event/list [POST]
+func LoginEventList(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req admin.LoginEventListReq
+	resp := new(admin.LoginEventListResp)
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		resp.ErrCode = base.ErrCode_Fail
+		resp.ErrMsg = err.Error()
+		c.JSON(consts.StatusBadRequest, resp)
+		return
+	}
+
+	var listReq admin2.LoginEventListReq
+	listReq.Page = req.Page
+	listReq.PageSize = req.PageSize
+	listReq.UserID = req.UserID
+	listReq.Username = req.Username
+	listReq.Method = req.Method
+	listReq.IP = req.Ip
+	listReq.Anomalous = req.Anomalous
+	switch req.Success {
+	case "true":
+		success := true
+		listReq.Success = &success
+	case "false":
+		success := false
+		listReq.Success = &success
+	default:
+		listReq.Success = nil
+	}
+	events, total, err := logic.NewLoginEvent(data.Default(), configs.Data()).List(ctx, &listReq)
+	if err != nil {
+		resp.ErrCode = base.ErrCode_Fail
+		resp.ErrMsg = err.Error()
+		c.JSON(consts.StatusInternalServerError, resp)
+		return
+	}
+	for _, v := range events {
+		resp.Data = append(resp.Data, loginEventInfo(v))
+	}
+	resp.Total = uint64(total)
+	resp.ErrCode = base.ErrCode_Success
+	resp.ErrMsg = "success"
+	c.JSON(consts.StatusOK, resp)
+}
+
+func loginEventInfo(v *admin2.LoginEventInfo) *admin.LoginEventInfo {
+	return &admin.LoginEventInfo{
+		Id:        v.ID,
+		UserID:    v.UserID,
+		Username:  v.Username,
+		Method:    v.Method,
+		Ip:        v.IP,
+		UserAgent: v.UserAgent,
+		Device:    v.Device,
+		Success:   v.Success,
+		Reason:    v.Reason,
+		Flags:     v.Flags,
+		CreatedAt: v.CreatedAt,
+	}
+}
@@ -68,6 +68,7 @@ func GetLogsList(ctx context.Context, c *app.RequestContext) {
 		logsInfo.Time = v.Time
 		logsInfo.CreatedAt = v.CreatedAt
 		logsInfo.UpdatedAt = v.UpdatedAt
+		logsInfo.ImpersonatedUser = v.ImpersonatedUser
 		list = append(list, &logsInfo)
 	}
 
@@ -131,17 +131,29 @@ func MenuByRole(ctx context.Context, c *app.RequestContext) {
 		return
 	}
 
-	roleIDInterface, exist := c.Get("roleID")
-	if !exist || roleIDInterface == nil {
+	userIDInterface, exist := c.Get("userID")
+	if !exist || userIDInterface == nil {
 		c.JSON(consts.StatusUnauthorized, "Unauthorized")
 		return
 	}
-	roleID, err := strconv.Atoi(roleIDInterface.(string))
+	userID, err := strconv.Atoi(userIDInterface.(string))
 	if err != nil {
-		c.JSON(consts.StatusUnauthorized, "Unauthorized, roleID is not int")
+		c.JSON(consts.StatusUnauthorized, "Unauthorized, userID is not int")
 		return
 	}
-	menuTree, total, err := logic.NewMenu(data.Default()).ListByRole(ctx, uint64(roleID))
+	// the menus of all active roles of the user
+	roles, err := logic.NewUser(data.Default()).ActiveRoles(ctx, uint64(userID))
+	if err != nil {
+		resp.ErrCode = base.ErrCode_Fail
+		resp.ErrMsg = err.Error()
+		c.JSON(consts.StatusInternalServerError, resp)
+		return
+	}
+	var roleIDs []uint64
+	for _, v := range roles {
+		roleIDs = append(roleIDs, v.ID)
+	}
+	menuTree, total, err := logic.NewMenu(data.Default()).ListByRoles(ctx, roleIDs)
 	if err != nil {
 		resp.ErrCode = base.ErrCode_Fail
 		resp.ErrMsg = err.Error()
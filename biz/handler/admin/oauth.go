@@ -10,7 +10,7 @@ import (
 	"formulago/configs"
 	"formulago/data"
 	"github.com/jinzhu/copier"
-	"strings"
+	"strconv"
 
 	admin "formulago/api/model/admin"
 	base "formulago/api/model/base"
@@ -163,17 +163,18 @@ func OauthLogin(ctx context.Context, c *app.RequestContext) {
 	if err != nil {
 		resp.ErrCode = base.ErrCode_Fail
 		resp.ErrMsg = err.Error()
+		c.JSON(consts.StatusBadRequest, resp)
 		return
 	}
 
 	var loginReq admin2.OauthLoginReq
 	loginReq.Provider = req.Provider
-	loginReq.State = req.State
 	loginReq.LoginType = req.LoginType
 	url, err := logic.NewOauth(data.Default(), configs.Data()).Login(ctx, &loginReq)
 	if err != nil {
 		resp.ErrCode = base.ErrCode_Fail
 		resp.ErrMsg = err.Error()
+		c.JSON(consts.StatusInternalServerError, resp)
 		return
 	}
 
@@ -194,25 +195,52 @@ func OauthCallback(ctx context.Context, c *app.RequestContext) {
 		return
 	}
 
-	stateList := strings.Split(req.State, "-")
-	if len(stateList) < 2 {
-		c.JSON(consts.StatusBadRequest, "state error, format should be: {random string}-{provider}")
-		return
-	}
-	providerName := stateList[1]
+	// the state is issued and stored by OauthLogin, it also tells the provider
 	var callbackReq admin2.OauthCallbackReq
-	callbackReq.ProviderName = providerName
 	callbackReq.Code = req.Code
 	callbackReq.State = req.State
+	// set by the optional auth middleware for the signed-in users linking an account
+	callbackReq.UserID, _ = strconv.ParseUint(c.GetString("userID"), 10, 64)
 	userInfo, err := logic.NewOauth(data.Default(), configs.Data()).Callback(ctx, &callbackReq)
 	if err != nil {
 		c.JSON(consts.StatusBadRequest, err.Error())
 		return
 	}
 
+	// the account is linked to the signed-in user who started the flow from the profile
+	if userInfo.LinkedUserID != 0 {
+		resp := new(base.BaseResp)
+		resp.ErrCode = base.ErrCode_Success
+		resp.ErrMsg = "success"
+		c.JSON(consts.StatusOK, resp)
+		return
+	}
+
 	// goto jwt login middleware handler
 	ctx = context.WithValue(ctx, "OAuthKey", configs.Data().Auth.OAuthKey)
-	c.Set("provider", callbackReq.ProviderName)
-	c.Set("credential", userInfo.Credential)
-	middleware.GetJWTMiddleware(configs.Data(), data.Default(), data.CasbinEnforcer()).LoginHandler(ctx, c)
+	c.Set("provider", userInfo.Provider)
+	c.Set("subject", userInfo.Subject)
+	middleware.GetLoginHandler(configs.Data(), data.Default(), data.CasbinEnforcer())(ctx, c)
+}
+
+// GetProviderTemplates .
+// @router /api/admin/oauth/provider/templates [GET]
+func GetProviderTemplates(ctx context.Context, c *app.RequestContext) {
+	var err error
+	resp := new(admin.ProviderListResp)
+
+	var list []*admin.ProviderInfo
+	err = copier.Copy(&list, logic.NewOauth(data.Default(), configs.Data()).Templates(ctx))
+	if err != nil {
+		resp.ErrCode = base.ErrCode_Fail
+		resp.ErrMsg = err.Error()
+		c.JSON(consts.StatusInternalServerError, resp)
+		return
+	}
+
+	resp.Data = list
+	resp.Total = uint64(len(list))
+	resp.ErrCode = base.ErrCode_Success
+	resp.ErrMsg = "success"
+	c.JSON(consts.StatusOK, resp)
 }
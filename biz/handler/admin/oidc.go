@@ -0,0 +1,255 @@
+// Code generated by hertz generator.
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	admin2 "formulago/biz/domain/admin"
+	logic "formulago/biz/logic/admin"
+	"formulago/configs"
+	"formulago/data"
+
+	admin "formulago/api/model/admin"
+	base "formulago/api/model/base"
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+)
+
+// OIDCConsentInfo .
+// @router /api/admin/oidc/consent [GET]
+func OIDCConsentInfo(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req admin.OIDCConsentReq
+	resp := new(admin.OIDCConsentInfoResp)
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		resp.ErrCode = base.ErrCode_Fail
+		resp.ErrMsg = err.Error()
+		c.JSON(consts.StatusBadRequest, resp)
+		return
+	}
+
+	v, exist := c.Get("userID")
+	if !exist || v == nil {
+		c.JSON(consts.StatusUnauthorized, "Unauthorized")
+		return
+	}
+	userID, err := strconv.Atoi(v.(string))
+	if err != nil {
+		c.JSON(consts.StatusUnauthorized, "Unauthorized,"+err.Error())
+		return
+	}
+
+	info, err := logic.NewOIDC(data.Default(), configs.Data()).ConsentInfo(ctx, uint64(userID), req.RequestID)
+	if err != nil {
+		resp.ErrCode = base.ErrCode_Fail
+		resp.ErrMsg = err.Error()
+		c.JSON(oidcErrorStatus(err), resp)
+		return
+	}
+
+	resp.ClientID = info.ClientID
+	resp.ClientName = info.ClientName
+	resp.Scopes = info.Scopes
+	resp.Consented = info.Consented
+	resp.ErrCode = base.ErrCode_Success
+	resp.ErrMsg = "success"
+	c.JSON(consts.StatusOK, resp)
+}
+
+// OIDCConsent .
+// @router /api/admin/oidc/consent [POST]
+func OIDCConsent(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req admin.OIDCConsentReq
+	resp := new(admin.OIDCConsentResp)
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		resp.ErrCode = base.ErrCode_Fail
+		resp.ErrMsg = err.Error()
+		c.JSON(consts.StatusBadRequest, resp)
+		return
+	}
+
+	v, exist := c.Get("userID")
+	if !exist || v == nil {
+		c.JSON(consts.StatusUnauthorized, "Unauthorized")
+		return
+	}
+	userID, err := strconv.Atoi(v.(string))
+	if err != nil {
+		c.JSON(consts.StatusUnauthorized, "Unauthorized,"+err.Error())
+		return
+	}
+
+	redirectURL, err := logic.NewOIDC(data.Default(), configs.Data()).Consent(ctx, uint64(userID), req.RequestID, req.Approve)
+	if err != nil {
+		resp.ErrCode = base.ErrCode_Fail
+		resp.ErrMsg = err.Error()
+		c.JSON(oidcErrorStatus(err), resp)
+		return
+	}
+
+	resp.RedirectURL = redirectURL
+	resp.ErrCode = base.ErrCode_Success
+	resp.ErrMsg = "success"
+	c.JSON(consts.StatusOK, resp)
+}
+
+// CreateOIDCClient .
+// @router /api/admin/oidc/client/create [POST]
+func CreateOIDCClient(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req admin.OIDCClientInfo
+	resp := new(admin.OIDCClientSecretResp)
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		resp.ErrCode = base.ErrCode_Fail
+		resp.ErrMsg = err.Error()
+		c.JSON(consts.StatusBadRequest, resp)
+		return
+	}
+
+	clientID, secret, err := logic.NewOIDC(data.Default(), configs.Data()).CreateClient(ctx, oidcClientInfo(&req))
+	if err != nil {
+		resp.ErrCode = base.ErrCode_Fail
+		resp.ErrMsg = err.Error()
+		c.JSON(consts.StatusInternalServerError, resp)
+		return
+	}
+
+	resp.ClientID = clientID
+	resp.ClientSecret = secret
+	resp.ErrCode = base.ErrCode_Success
+	resp.ErrMsg = "success"
+	c.JSON(consts.StatusOK, resp)
+}
+
+// UpdateOIDCClient .
+// @router /api/admin/oidc/client/update [POST]
+func UpdateOIDCClient(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req admin.OIDCClientInfo
+	resp := new(admin.OIDCClientSecretResp)
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		resp.ErrCode = base.ErrCode_Fail
+		resp.ErrMsg = err.Error()
+		c.JSON(consts.StatusBadRequest, resp)
+		return
+	}
+
+	secret, err := logic.NewOIDC(data.Default(), configs.Data()).UpdateClient(ctx, oidcClientInfo(&req))
+	if err != nil {
+		resp.ErrCode = base.ErrCode_Fail
+		resp.ErrMsg = err.Error()
+		c.JSON(consts.StatusInternalServerError, resp)
+		return
+	}
+
+	resp.ClientID = req.ClientID
+	resp.ClientSecret = secret
+	resp.ErrCode = base.ErrCode_Success
+	resp.ErrMsg = "success"
+	c.JSON(consts.StatusOK, resp)
+}
+
+// OIDCClientList .
+// @router /api/admin/oidc/client/list [POST]
+func OIDCClientList(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req admin.OIDCClientListReq
+	resp := new(admin.OIDCClientListResp)
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		resp.ErrCode = base.ErrCode_Fail
+		resp.ErrMsg = err.Error()
+		c.JSON(consts.StatusBadRequest, resp)
+		return
+	}
+
+	clients, total, err := logic.NewOIDC(data.Default(), configs.Data()).ClientList(ctx, &admin2.OIDCClientListReq{
+		Page:     req.Page,
+		PageSize: req.PageSize,
+		Name:     req.Name,
+	})
+	if err != nil {
+		resp.ErrCode = base.ErrCode_Fail
+		resp.ErrMsg = err.Error()
+		c.JSON(consts.StatusInternalServerError, resp)
+		return
+	}
+	for _, v := range clients {
+		resp.Data = append(resp.Data, &admin.OIDCClientInfo{
+			ID:           v.ID,
+			CreatedAt:    v.CreatedAt,
+			UpdatedAt:    v.UpdatedAt,
+			Status:       v.Status,
+			ClientID:     v.ClientID,
+			Name:         v.Name,
+			RedirectURIs: v.RedirectURIs,
+			GrantTypes:   v.GrantTypes,
+			Scopes:       v.Scopes,
+			Public:       v.Public,
+			SkipConsent:  v.SkipConsent,
+		})
+	}
+	resp.Total = uint64(total)
+	resp.ErrCode = base.ErrCode_Success
+	resp.ErrMsg = "success"
+	c.JSON(consts.StatusOK, resp)
+}
+
+// DeleteOIDCClient .
+// @router /api/admin/oidc/client [DELETE]
+func DeleteOIDCClient(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req base.IDReq
+	resp := new(base.BaseResp)
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		resp.ErrCode = base.ErrCode_Fail
+		resp.ErrMsg = err.Error()
+		c.JSON(consts.StatusBadRequest, resp)
+		return
+	}
+
+	err = logic.NewOIDC(data.Default(), configs.Data()).DeleteClient(ctx, req.ID)
+	if err != nil {
+		resp.ErrCode = base.ErrCode_Fail
+		resp.ErrMsg = err.Error()
+		c.JSON(consts.StatusInternalServerError, resp)
+		return
+	}
+
+	resp.ErrCode = base.ErrCode_Success
+	resp.ErrMsg = "success"
+	c.JSON(consts.StatusOK, resp)
+}
+
+func oidcClientInfo(req *admin.OIDCClientInfo) *admin2.OIDCClientInfo {
+	return &admin2.OIDCClientInfo{
+		ID:           req.ID,
+		Status:       req.Status,
+		ClientID:     req.ClientID,
+		Name:         req.Name,
+		RedirectURIs: req.RedirectURIs,
+		GrantTypes:   req.GrantTypes,
+		Scopes:       req.Scopes,
+		Public:       req.Public,
+		SkipConsent:  req.SkipConsent,
+		ResetSecret:  req.ResetSecret,
+	}
+}
+
+// oidcErrorStatus an expired request or a disabled client is an error of the request
+func oidcErrorStatus(err error) int {
+	var oidcErr *admin2.OIDCError
+	if errors.As(err, &oidcErr) {
+		return consts.StatusBadRequest
+	}
+	return consts.StatusInternalServerError
+}
@@ -31,13 +31,15 @@ func CreateRole(ctx context.Context, c *app.RequestContext) {
 		return
 	}
 
-	err = logic.NewRole(data.Default()).Create(ctx, admin2.RoleInfo{
-		Name:          req.Name,
-		Value:         req.Value,
-		DefaultRouter: req.DefaultRouter,
-		Status:        req.Status,
-		Remark:        req.Remark,
-		OrderNo:       req.OrderNo,
+	err = logic.NewRole(data.Default(), data.CasbinEnforcer()).Create(ctx, admin2.RoleInfo{
+		Name:           req.Name,
+		Value:          req.Value,
+		DefaultRouter:  req.DefaultRouter,
+		Status:         req.Status,
+		Remark:         req.Remark,
+		OrderNo:        req.OrderNo,
+		ForceTwoFactor: req.ForceTwoFactor,
+		ParentID:       req.ParentID,
 	})
 	if err != nil {
 		resp.ErrCode = base.ErrCode_Fail
@@ -65,14 +67,16 @@ func UpdateRole(ctx context.Context, c *app.RequestContext) {
 		return
 	}
 
-	err = logic.NewRole(data.Default()).Update(ctx, admin2.RoleInfo{
-		ID:            req.ID,
-		Name:          req.Name,
-		Value:         req.Value,
-		DefaultRouter: req.DefaultRouter,
-		Status:        req.Status,
-		Remark:        req.Remark,
-		OrderNo:       req.OrderNo,
+	err = logic.NewRole(data.Default(), data.CasbinEnforcer()).Update(ctx, admin2.RoleInfo{
+		ID:             req.ID,
+		Name:           req.Name,
+		Value:          req.Value,
+		DefaultRouter:  req.DefaultRouter,
+		Status:         req.Status,
+		Remark:         req.Remark,
+		OrderNo:        req.OrderNo,
+		ForceTwoFactor: req.ForceTwoFactor,
+		ParentID:       req.ParentID,
 	})
 	if err != nil {
 		resp.ErrCode = base.ErrCode_Fail
@@ -100,7 +104,7 @@ func DeleteRole(ctx context.Context, c *app.RequestContext) {
 		return
 	}
 
-	err = logic.NewRole(data.Default()).Delete(ctx, req.ID)
+	err = logic.NewRole(data.Default(), data.CasbinEnforcer()).Delete(ctx, req.ID)
 	if err != nil {
 		resp.ErrCode = base.ErrCode_Fail
 		resp.ErrMsg = err.Error()
@@ -127,7 +131,7 @@ func RoleByID(ctx context.Context, c *app.RequestContext) {
 		return
 	}
 
-	roleInfo, err := logic.NewRole(data.Default()).RoleInfoByID(ctx, req.ID)
+	roleInfo, err := logic.NewRole(data.Default(), data.CasbinEnforcer()).RoleInfoByID(ctx, req.ID)
 	if err != nil {
 		resp.ErrCode = base.ErrCode_Fail
 		resp.ErrMsg = err.Error()
@@ -175,7 +179,7 @@ func RoleList(ctx context.Context, c *app.RequestContext) {
 	default:
 		listReq.Status = nil
 	}
-	list, total, err := logic.NewRole(data.Default()).List(ctx, &listReq)
+	list, total, err := logic.NewRole(data.Default(), data.CasbinEnforcer()).List(ctx, &listReq)
 	if err != nil {
 		resp.ErrCode = base.ErrCode_Fail
 		resp.ErrMsg = err.Error()
@@ -212,7 +216,7 @@ func UpdateRoleStatus(ctx context.Context, c *app.RequestContext) {
 		return
 	}
 
-	err = logic.NewRole(data.Default()).UpdateStatus(ctx, req.ID, uint8(req.Status))
+	err = logic.NewRole(data.Default(), data.CasbinEnforcer()).UpdateStatus(ctx, req.ID, uint8(req.Status))
 	if err != nil {
 		resp.ErrCode = base.ErrCode_Fail
 		resp.ErrMsg = err.Error()
@@ -224,3 +228,40 @@ func UpdateRoleStatus(ctx context.Context, c *app.RequestContext) {
 	resp.ErrMsg = "success"
 	c.JSON(consts.StatusOK, resp)
 }
+
+// RoleTree .
+// @router /api/admin/role/tree [GET]
+func RoleTree(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req base.Empty
+	resp := new(admin.RoleListResp)
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		resp.ErrCode = base.ErrCode_Fail
+		resp.ErrMsg = err.Error()
+		c.JSON(consts.StatusBadRequest, resp)
+		return
+	}
+
+	tree, err := logic.NewRole(data.Default(), data.CasbinEnforcer()).Tree(ctx)
+	if err != nil {
+		resp.ErrCode = base.ErrCode_Fail
+		resp.ErrMsg = err.Error()
+		c.JSON(consts.StatusInternalServerError, resp)
+		return
+	}
+	var infos []*admin.RoleInfo
+	err = copier.Copy(&infos, &tree)
+	if err != nil {
+		resp.ErrCode = base.ErrCode_Fail
+		resp.ErrMsg = err.Error()
+		c.JSON(consts.StatusInternalServerError, resp)
+		return
+	}
+
+	resp.Data = infos
+	resp.Total = uint64(len(infos))
+	resp.ErrCode = base.ErrCode_Success
+	resp.ErrMsg = "success"
+	c.JSON(consts.StatusOK, resp)
+}
@@ -0,0 +1,284 @@
+// Code generated by hertz generator.
+
+package admin
+
+import (
+	"context"
+	"strconv"
+
+	admin2 "formulago/biz/domain/admin"
+	"formulago/biz/handler/middleware"
+	logic "formulago/biz/logic/admin"
+	"formulago/configs"
+	"formulago/data"
+	"github.com/jinzhu/copier"
+
+	admin "formulago/api/model/admin"
+	base "formulago/api/model/base"
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+)
+
+// CreateSamlProvider .
+// @router /api/admin/saml/provider/create [POST]
+func CreateSamlProvider(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req admin.SamlProviderInfo
+	resp := new(base.BaseResp)
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		resp.ErrCode = base.ErrCode_Fail
+		resp.ErrMsg = err.Error()
+		c.JSON(consts.StatusBadRequest, resp)
+		return
+	}
+
+	var providerInfo admin2.SamlProviderInfo
+	err = copier.Copy(&providerInfo, &req)
+	if err != nil {
+		resp.ErrCode = base.ErrCode_Fail
+		resp.ErrMsg = err.Error()
+		c.JSON(consts.StatusInternalServerError, resp)
+		return
+	}
+	err = logic.NewSaml(data.Default(), configs.Data()).Create(ctx, &providerInfo)
+	if err != nil {
+		resp.ErrCode = base.ErrCode_Fail
+		resp.ErrMsg = err.Error()
+		c.JSON(consts.StatusInternalServerError, resp)
+		return
+	}
+
+	resp.ErrCode = base.ErrCode_Success
+	resp.ErrMsg = "success"
+	c.JSON(consts.StatusOK, resp)
+}
+
+// UpdateSamlProvider .
+// @router /api/admin/saml/provider/update [POST]
+func UpdateSamlProvider(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req admin.SamlProviderInfo
+	resp := new(base.BaseResp)
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		resp.ErrCode = base.ErrCode_Fail
+		resp.ErrMsg = err.Error()
+		c.JSON(consts.StatusBadRequest, resp)
+		return
+	}
+
+	var providerInfo admin2.SamlProviderInfo
+	err = copier.Copy(&providerInfo, &req)
+	if err != nil {
+		resp.ErrCode = base.ErrCode_Fail
+		resp.ErrMsg = err.Error()
+		c.JSON(consts.StatusInternalServerError, resp)
+		return
+	}
+	err = logic.NewSaml(data.Default(), configs.Data()).Update(ctx, &providerInfo)
+	if err != nil {
+		resp.ErrCode = base.ErrCode_Fail
+		resp.ErrMsg = err.Error()
+		c.JSON(consts.StatusInternalServerError, resp)
+		return
+	}
+
+	resp.ErrCode = base.ErrCode_Success
+	resp.ErrMsg = "success"
+	c.JSON(consts.StatusOK, resp)
+}
+
+// DeleteSamlProvider .
+// @router /api/admin/saml/provider [DELETE]
+func DeleteSamlProvider(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req base.IDReq
+	resp := new(base.BaseResp)
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		resp.ErrCode = base.ErrCode_Fail
+		resp.ErrMsg = err.Error()
+		c.JSON(consts.StatusBadRequest, resp)
+		return
+	}
+
+	err = logic.NewSaml(data.Default(), configs.Data()).Delete(ctx, req.ID)
+	if err != nil {
+		resp.ErrCode = base.ErrCode_Fail
+		resp.ErrMsg = err.Error()
+		c.JSON(consts.StatusInternalServerError, resp)
+		return
+	}
+
+	resp.ErrCode = base.ErrCode_Success
+	resp.ErrMsg = "success"
+	c.JSON(consts.StatusOK, resp)
+}
+
+// SamlProviderList .
+// @router /api/admin/saml/provider/list [POST]
+func SamlProviderList(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req admin.SamlProviderListReq
+	resp := new(admin.SamlProviderListResp)
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		resp.ErrCode = base.ErrCode_Fail
+		resp.ErrMsg = err.Error()
+		c.JSON(consts.StatusBadRequest, resp)
+		return
+	}
+
+	l, total, err := logic.NewSaml(data.Default(), configs.Data()).List(ctx, &admin2.SamlListReq{
+		Page:     req.Page,
+		PageSize: req.PageSize,
+		Name:     req.Name,
+	})
+	if err != nil {
+		resp.ErrCode = base.ErrCode_Fail
+		resp.ErrMsg = err.Error()
+		c.JSON(consts.StatusInternalServerError, resp)
+		return
+	}
+
+	var list []*admin.SamlProviderInfo
+	err = copier.Copy(&list, &l)
+	if err != nil {
+		resp.ErrCode = base.ErrCode_Fail
+		resp.ErrMsg = err.Error()
+		c.JSON(consts.StatusInternalServerError, resp)
+		return
+	}
+
+	resp.Data = list
+	resp.Total = uint64(total)
+	resp.ErrCode = base.ErrCode_Success
+	resp.ErrMsg = "success"
+	c.JSON(consts.StatusOK, resp)
+}
+
+// LinkSamlIdentity .
+// @router /api/admin/saml/link [POST]
+func LinkSamlIdentity(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req admin.SamlLoginReq
+	resp := new(admin.OauthRedirectResp)
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		resp.ErrCode = base.ErrCode_Fail
+		resp.ErrMsg = err.Error()
+		c.JSON(consts.StatusBadRequest, resp)
+		return
+	}
+
+	v, exist := c.Get("userID")
+	if !exist || v == nil {
+		c.JSON(consts.StatusUnauthorized, "Unauthorized")
+		return
+	}
+	userID, err := strconv.Atoi(v.(string))
+	if err != nil {
+		c.JSON(consts.StatusUnauthorized, "Unauthorized,"+err.Error())
+		return
+	}
+
+	url, err := logic.NewSaml(data.Default(), configs.Data()).LinkURL(ctx, uint64(userID), req.Provider)
+	if err != nil {
+		resp.ErrCode = base.ErrCode_Fail
+		resp.ErrMsg = err.Error()
+		c.JSON(consts.StatusInternalServerError, resp)
+		return
+	}
+
+	resp.Url = url
+	resp.ErrCode = base.ErrCode_Success
+	resp.ErrMsg = "success"
+	c.JSON(consts.StatusOK, resp)
+}
+
+// SamlMetadata .
+// @router /api/saml/metadata [GET]
+func SamlMetadata(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req admin.SamlLoginReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.JSON(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	metadata, err := logic.NewSaml(data.Default(), configs.Data()).Metadata(ctx, req.Provider)
+	if err != nil {
+		c.JSON(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	c.Data(consts.StatusOK, "application/samlmetadata+xml", metadata)
+}
+
+// SamlLogin .
+// @router /api/saml/login [POST]
+func SamlLogin(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req admin.SamlLoginReq
+	resp := new(admin.OauthRedirectResp)
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		resp.ErrCode = base.ErrCode_Fail
+		resp.ErrMsg = err.Error()
+		c.JSON(consts.StatusBadRequest, resp)
+		return
+	}
+
+	url, err := logic.NewSaml(data.Default(), configs.Data()).Login(ctx, req.Provider)
+	if err != nil {
+		resp.ErrCode = base.ErrCode_Fail
+		resp.ErrMsg = err.Error()
+		c.JSON(consts.StatusInternalServerError, resp)
+		return
+	}
+
+	resp.Url = url
+	resp.ErrCode = base.ErrCode_Success
+	resp.ErrMsg = "success"
+	c.JSON(consts.StatusOK, resp)
+}
+
+// SamlAcs .
+// @router /api/saml/acs [POST]
+func SamlAcs(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req admin.SamlAcsReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.JSON(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// the provider is in the query of the ACS URL, the response and the relay state are posted by the browser
+	var acsReq admin2.SamlAcsReq
+	acsReq.Provider = req.Provider
+	acsReq.SAMLResponse = req.SAMLResponse
+	acsReq.RelayState = req.RelayState
+	userInfo, err := logic.NewSaml(data.Default(), configs.Data()).Acs(ctx, &acsReq)
+	if err != nil {
+		c.JSON(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// the account is linked to the signed-in user who started the flow from the profile
+	if userInfo.LinkedUserID != 0 {
+		resp := new(base.BaseResp)
+		resp.ErrCode = base.ErrCode_Success
+		resp.ErrMsg = "success"
+		c.JSON(consts.StatusOK, resp)
+		return
+	}
+
+	// goto jwt login middleware handler, the same token as the password login is issued
+	ctx = context.WithValue(ctx, "OAuthKey", configs.Data().Auth.OAuthKey)
+	c.Set("provider", userInfo.Provider)
+	c.Set("subject", userInfo.Subject)
+	middleware.GetLoginHandler(configs.Data(), data.Default(), data.CasbinEnforcer())(ctx, c)
+}
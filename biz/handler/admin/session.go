@@ -0,0 +1,122 @@
+// Code generated by hertz generator.
+
+package admin
+
+import (
+	"context"
+
+	admin2 "formulago/biz/domain/admin"
+	logic "formulago/biz/logic/admin"
+	"formulago/data"
+
+	admin "formulago/api/model/admin"
+	base "formulago/api/model/base"
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+)
+
+// SessionList .
+// @router /api/admin/session/list [POST]
+func SessionList(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req admin.SessionListReq
+	resp := new(admin.SessionListResp)
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		resp.ErrCode = base.ErrCode_Fail
+		resp.ErrMsg = err.Error()
+		c.JSON(consts.StatusBadRequest, resp)
+		return
+	}
+
+	sessions, total, err := logic.NewToken(data.Default()).List(ctx, &admin2.TokenListReq{
+		Page:     req.Page,
+		PageSize: req.PageSize,
+		Username: req.Username,
+		UserID:   req.UserID,
+	})
+	if err != nil {
+		resp.ErrCode = base.ErrCode_Fail
+		resp.ErrMsg = err.Error()
+		c.JSON(consts.StatusInternalServerError, resp)
+		return
+	}
+	for _, v := range sessions {
+		resp.Data = append(resp.Data, sessionInfo(v, c.GetString("jti")))
+	}
+	resp.Total = uint64(total)
+	resp.ErrCode = base.ErrCode_Success
+	resp.ErrMsg = "success"
+	c.JSON(consts.StatusOK, resp)
+}
+
+// RevokeSession .
+// @router /api/admin/session/revoke [POST]
+func RevokeSession(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req admin.SessionRevokeReq
+	resp := new(base.BaseResp)
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		resp.ErrCode = base.ErrCode_Fail
+		resp.ErrMsg = err.Error()
+		c.JSON(consts.StatusBadRequest, resp)
+		return
+	}
+
+	err = logic.NewToken(data.Default()).Revoke(ctx, req.Jti)
+	if err != nil {
+		resp.ErrCode = base.ErrCode_Fail
+		resp.ErrMsg = err.Error()
+		c.JSON(consts.StatusInternalServerError, resp)
+		return
+	}
+
+	resp.ErrCode = base.ErrCode_Success
+	resp.ErrMsg = "success"
+	c.JSON(consts.StatusOK, resp)
+}
+
+// RevokeUserSessions .
+// @router /api/admin/session/revoke-user [POST]
+func RevokeUserSessions(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req base.IDReq
+	resp := new(base.BaseResp)
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		resp.ErrCode = base.ErrCode_Fail
+		resp.ErrMsg = err.Error()
+		c.JSON(consts.StatusBadRequest, resp)
+		return
+	}
+
+	err = logic.NewToken(data.Default()).RevokeAll(ctx, req.ID, "")
+	if err != nil {
+		resp.ErrCode = base.ErrCode_Fail
+		resp.ErrMsg = err.Error()
+		c.JSON(consts.StatusInternalServerError, resp)
+		return
+	}
+
+	resp.ErrCode = base.ErrCode_Success
+	resp.ErrMsg = "success"
+	c.JSON(consts.StatusOK, resp)
+}
+
+// sessionInfo never exposes the token of the session
+func sessionInfo(v *admin2.TokenInfo, currentJTI string) *admin.SessionInfo {
+	return &admin.SessionInfo{
+		Jti:            v.JTI,
+		UserID:         v.UserID,
+		UserName:       v.UserName,
+		Source:         v.Source,
+		Device:         v.Device,
+		Ip:             v.IP,
+		UserAgent:      v.UserAgent,
+		IssuedAt:       v.IssuedAt,
+		ExpiredAt:      v.ExpiredAt,
+		ImpersonatorID: v.ImpersonatorID,
+		Current:        currentJTI != "" && v.JTI == currentJTI,
+	}
+}
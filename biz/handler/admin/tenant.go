@@ -0,0 +1,150 @@
+// Code generated by hertz generator.
+
+package admin
+
+import (
+	"context"
+
+	admin2 "formulago/biz/domain/admin"
+	logic "formulago/biz/logic/admin"
+	"formulago/data"
+
+	admin "formulago/api/model/admin"
+	base "formulago/api/model/base"
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+)
+
+// CreateTenant .
+// @router /api/admin/tenant/create [POST]
+func CreateTenant(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req admin.CreateTenantReq
+	resp := new(base.BaseResp)
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		resp.ErrCode = base.ErrCode_Fail
+		resp.ErrMsg = err.Error()
+		c.JSON(consts.StatusBadRequest, resp)
+		return
+	}
+
+	var createReq admin2.CreateTenantReq
+	createReq.Name = req.Name
+	createReq.Remark = req.Remark
+	createReq.AdminUsername = req.AdminUsername
+	createReq.AdminPassword = req.AdminPassword
+	createReq.AdminEmail = req.AdminEmail
+	createReq.AdminMobile = req.AdminMobile
+	_, err = logic.NewTenant(data.Default(), data.CasbinEnforcer()).Create(ctx, &createReq)
+	if err != nil {
+		resp.ErrCode = base.ErrCode_Fail
+		resp.ErrMsg = err.Error()
+		c.JSON(consts.StatusInternalServerError, resp)
+		return
+	}
+
+	resp.ErrCode = base.ErrCode_Success
+	resp.ErrMsg = "success"
+	c.JSON(consts.StatusOK, resp)
+}
+
+// UpdateTenant .
+// @router /api/admin/tenant/update [POST]
+func UpdateTenant(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req admin.TenantInfo
+	resp := new(base.BaseResp)
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		resp.ErrCode = base.ErrCode_Fail
+		resp.ErrMsg = err.Error()
+		c.JSON(consts.StatusBadRequest, resp)
+		return
+	}
+
+	var tenantInfo admin2.TenantInfo
+	tenantInfo.ID = req.ID
+	tenantInfo.Name = req.Name
+	tenantInfo.Remark = req.Remark
+	err = logic.NewTenant(data.Default(), data.CasbinEnforcer()).Update(ctx, &tenantInfo)
+	if err != nil {
+		resp.ErrCode = base.ErrCode_Fail
+		resp.ErrMsg = err.Error()
+		c.JSON(consts.StatusInternalServerError, resp)
+		return
+	}
+
+	resp.ErrCode = base.ErrCode_Success
+	resp.ErrMsg = "success"
+	c.JSON(consts.StatusOK, resp)
+}
+
+// TenantList .
+// @router /api/admin/tenant/list [POST]
+func TenantList(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req admin.TenantListReq
+	resp := new(admin.TenantListResp)
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		resp.ErrCode = base.ErrCode_Fail
+		resp.ErrMsg = err.Error()
+		c.JSON(consts.StatusBadRequest, resp)
+		return
+	}
+
+	var listReq admin2.TenantListReq
+	listReq.Page = req.Page
+	listReq.PageSize = req.PageSize
+	listReq.Name = req.Name
+	tenants, total, err := logic.NewTenant(data.Default(), data.CasbinEnforcer()).List(ctx, &listReq)
+	if err != nil {
+		resp.ErrCode = base.ErrCode_Fail
+		resp.ErrMsg = err.Error()
+		c.JSON(consts.StatusInternalServerError, resp)
+		return
+	}
+
+	for _, v := range tenants {
+		resp.Data = append(resp.Data, &admin.TenantInfo{
+			ID:        v.ID,
+			Name:      v.Name,
+			Remark:    v.Remark,
+			Status:    uint64(v.Status),
+			CreatedAt: v.CreatedAt,
+			UpdatedAt: v.UpdatedAt,
+		})
+	}
+	resp.Total = uint64(total)
+	resp.ErrCode = base.ErrCode_Success
+	resp.ErrMsg = "success"
+	c.JSON(consts.StatusOK, resp)
+}
+
+// UpdateTenantStatus .
+// @router /api/admin/tenant/status [POST]
+func UpdateTenantStatus(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req base.StatusCodeReq
+	resp := new(base.BaseResp)
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		resp.ErrCode = base.ErrCode_Fail
+		resp.ErrMsg = err.Error()
+		c.JSON(consts.StatusBadRequest, resp)
+		return
+	}
+
+	err = logic.NewTenant(data.Default(), data.CasbinEnforcer()).UpdateStatus(ctx, req.ID, uint8(req.Status))
+	if err != nil {
+		resp.ErrCode = base.ErrCode_Fail
+		resp.ErrMsg = err.Error()
+		c.JSON(consts.StatusInternalServerError, resp)
+		return
+	}
+
+	resp.ErrCode = base.ErrCode_Success
+	resp.ErrMsg = "success"
+	c.JSON(consts.StatusOK, resp)
+}
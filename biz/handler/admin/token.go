@@ -65,7 +65,12 @@ func DeleteToken(ctx context.Context, c *app.RequestContext) {
 		return
 	}
 
-	err = logic.NewToken(data.Default()).Delete(ctx, req.UserID)
+	// revoke the single session if jti is set, otherwise all sessions of the user
+	if req.Jti != "" {
+		err = logic.NewToken(data.Default()).Revoke(ctx, req.Jti)
+	} else {
+		err = logic.NewToken(data.Default()).Delete(ctx, req.UserID)
+	}
 	if err != nil {
 		resp.ErrCode = base.ErrCode_Fail
 		resp.ErrMsg = err.Error()
@@ -115,6 +120,13 @@ func TokenList(ctx context.Context, c *app.RequestContext) {
 		tokenInfo.CreatedAt = token.CreatedAt
 		tokenInfo.UpdatedAt = token.UpdatedAt
 		tokenInfo.ExpiredAt = token.ExpiredAt
+		tokenInfo.Source = token.Source
+		tokenInfo.Jti = token.JTI
+		tokenInfo.Device = token.Device
+		tokenInfo.Ip = token.IP
+		tokenInfo.UserAgent = token.UserAgent
+		tokenInfo.IssuedAt = token.IssuedAt
+		tokenInfo.ImpersonatorID = token.ImpersonatorID
 		resp.Data = append(resp.Data, &tokenInfo)
 	}
 	resp.Total = uint64(total)
@@ -4,11 +4,16 @@ package admin
 
 import (
 	"context"
+	"errors"
 	admin2 "formulago/biz/domain/admin"
+	"formulago/biz/handler/middleware"
 	logic "formulago/biz/logic/admin"
+	"formulago/configs"
 	"formulago/data"
+	"formulago/pkg/password"
 	"formulago/pkg/times"
 	"strconv"
+	"sync"
 
 	"github.com/cloudwego/hertz/pkg/protocol/consts"
 	"github.com/jinzhu/copier"
@@ -33,8 +38,27 @@ func Register(ctx context.Context, c *app.RequestContext) {
 		return
 	}
 
-	resp.ErrMsg = "Register are not supported"
-	resp.ErrCode = base.ErrCode_Fail
+	var registerReq admin2.RegisterReq
+	err = copier.Copy(&registerReq, &req)
+	if err != nil {
+		resp.ErrCode = base.ErrCode_Fail
+		resp.ErrMsg = err.Error()
+		c.JSON(consts.StatusInternalServerError, resp)
+		return
+	}
+	err = logic.NewRegister(data.Default(), configs.Data()).Register(ctx, registerReq)
+	if err != nil {
+		resp.ErrCode = base.ErrCode_Fail
+		resp.ErrMsg = err.Error()
+		c.JSON(consts.StatusInternalServerError, resp)
+		return
+	}
+
+	resp.ErrCode = base.ErrCode_Success
+	resp.ErrMsg = "success"
+	if configs.Data().Register.Approval {
+		resp.ErrMsg = "registered, waiting for approval"
+	}
 	c.JSON(consts.StatusOK, resp)
 }
 
@@ -52,12 +76,27 @@ func UserPermCode(ctx context.Context, c *app.RequestContext) {
 		return
 	}
 
-	roleID, exist := c.Get("roleID")
-	if !exist || roleID == nil {
+	userID, exist := c.Get("userID")
+	if !exist || userID == nil {
 		c.JSON(consts.StatusUnauthorized, "Unauthorized")
 		return
 	}
-	resp.Data = []string{roleID.(string)}
+	id, err := strconv.Atoi(userID.(string))
+	if err != nil {
+		c.JSON(consts.StatusUnauthorized, "Unauthorized,"+err.Error())
+		return
+	}
+	// the permission codes are the ids of all active roles
+	roles, err := logic.NewUser(data.Default()).ActiveRoles(ctx, uint64(id))
+	if err != nil {
+		resp.ErrCode = base.ErrCode_Fail
+		resp.ErrMsg = err.Error()
+		c.JSON(consts.StatusInternalServerError, resp)
+		return
+	}
+	for _, v := range roles {
+		resp.Data = append(resp.Data, strconv.FormatUint(v.ID, 10))
+	}
 	resp.ErrCode = base.ErrCode_Success
 	resp.ErrMsg = "success"
 	c.JSON(consts.StatusOK, resp)
@@ -83,6 +122,13 @@ func ChangePassword(ctx context.Context, c *app.RequestContext) {
 		c.JSON(consts.StatusUnauthorized, "Unauthorized")
 		return
 	}
+	userID, err := strconv.Atoi(userIDAny.(string))
+	if err != nil || uint64(userID) != req.UserID {
+		resp.ErrCode = base.ErrCode_Fail
+		resp.ErrMsg = "only the password of the current user can be changed"
+		c.JSON(consts.StatusForbidden, resp)
+		return
+	}
 
 	err = logic.NewUser(data.Default()).ChangePassword(ctx, req.UserID, req.OldPassword, req.NewPassword)
 	if err != nil {
@@ -117,6 +163,7 @@ func CreateUser(ctx context.Context, c *app.RequestContext) {
 		Email:    req.Email,
 		Mobile:   req.Mobile,
 		RoleID:   req.RoleID,
+		RoleIDs:  req.RoleIDs,
 		Avatar:   req.Avatar,
 		Nickname: req.Nickname,
 		Status:   req.Status,
@@ -155,6 +202,7 @@ func UpdateUser(ctx context.Context, c *app.RequestContext) {
 		Email:    req.Email,
 		Mobile:   req.Mobile,
 		RoleID:   req.RoleID,
+		RoleIDs:  req.RoleIDs,
 		Avatar:   req.Avatar,
 		Nickname: req.Nickname,
 		Status:   req.Status,
@@ -210,6 +258,7 @@ func UserInfo(ctx context.Context, c *app.RequestContext) {
 	resp.Email = user.Email
 	resp.Mobile = user.Mobile
 	resp.RoleID = user.RoleID
+	resp.RoleIDs = user.RoleIDs
 	resp.Avatar = user.Avatar
 	resp.Nickname = user.Nickname
 	resp.CreatedAt = user.CreatedAt.Format(times.TimeFormat)
@@ -218,6 +267,21 @@ func UserInfo(ctx context.Context, c *app.RequestContext) {
 	resp.RoleName = user.RoleName
 	resp.RoleValue = user.RoleValue
 	resp.DefaultRouter = user.DefaultRouter
+	resp.TwoFactorEnabled = user.TotpEnabled
+	// the session may have switched to another role of the user
+	if jti := c.GetString("jti"); jti != "" {
+		role, err := logic.NewUser(data.Default()).CurrentRole(ctx, userID, jti)
+		if err != nil {
+			resp.ErrCode = base.ErrCode_Fail
+			resp.ErrMsg = err.Error()
+			c.JSON(consts.StatusInternalServerError, resp)
+			return
+		}
+		resp.RoleID = role.ID
+		resp.RoleName = role.Name
+		resp.RoleValue = role.Value
+		resp.DefaultRouter = role.DefaultRouter
+	}
 
 	resp.ErrCode = base.ErrCode_Success
 	resp.ErrMsg = "success"
@@ -255,16 +319,19 @@ func UserList(ctx context.Context, c *app.RequestContext) {
 	}
 	for _, v := range userList {
 		resp.Data = append(resp.Data, &admin.UserInfoResp{
-			ID:        v.ID,
-			Avatar:    v.Avatar,
-			RoleID:    v.RoleID,
-			Mobile:    v.Mobile,
-			Email:     v.Email,
-			Status:    uint64(v.Status),
-			Username:  v.Username,
-			Nickname:  v.Nickname,
-			CreatedAt: v.CreatedAt.Format(times.TimeFormat),
-			UpdatedAt: v.UpdatedAt.Format(times.TimeFormat),
+			ID:               v.ID,
+			Avatar:           v.Avatar,
+			RoleID:           v.RoleID,
+			RoleIDs:          v.RoleIDs,
+			Mobile:           v.Mobile,
+			Email:            v.Email,
+			Status:           uint64(v.Status),
+			Username:         v.Username,
+			Nickname:         v.Nickname,
+			CreatedAt:        v.CreatedAt.Format(times.TimeFormat),
+			UpdatedAt:        v.UpdatedAt.Format(times.TimeFormat),
+			TwoFactorEnabled: v.TotpEnabled,
+			PendingApproval:  v.PendingApproval,
 		})
 	}
 	resp.Total = uint64(total)
@@ -405,3 +472,977 @@ func UpdateUserStatus(ctx context.Context, c *app.RequestContext) {
 	resp.ErrMsg = "success"
 	c.JSON(consts.StatusOK, resp)
 }
+
+// EnrollTwoFactor .
+// @router /api/admin/user/2fa/enroll [POST]
+func EnrollTwoFactor(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req base.Empty
+	resp := new(admin.TwoFactorEnrollResp)
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		resp.ErrCode = base.ErrCode_Fail
+		resp.ErrMsg = err.Error()
+		c.JSON(consts.StatusBadRequest, resp)
+		return
+	}
+
+	v, exist := c.Get("userID")
+	if !exist || v == nil {
+		c.JSON(consts.StatusUnauthorized, "Unauthorized")
+		return
+	}
+	userID, err := strconv.Atoi(v.(string))
+	if err != nil {
+		c.JSON(consts.StatusUnauthorized, "Unauthorized,"+err.Error())
+		return
+	}
+
+	enrollInfo, err := logic.NewTwoFactor(data.Default(), configs.Data()).Enroll(ctx, uint64(userID))
+	if err != nil {
+		resp.ErrCode = base.ErrCode_Fail
+		resp.ErrMsg = err.Error()
+		c.JSON(consts.StatusInternalServerError, resp)
+		return
+	}
+
+	resp.Secret = enrollInfo.Secret
+	resp.Uri = enrollInfo.URI
+	resp.ErrCode = base.ErrCode_Success
+	resp.ErrMsg = "success"
+	c.JSON(consts.StatusOK, resp)
+}
+
+// ConfirmTwoFactor .
+// @router /api/admin/user/2fa/confirm [POST]
+func ConfirmTwoFactor(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req admin.TwoFactorCodeReq
+	resp := new(admin.TwoFactorRecoveryCodesResp)
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		resp.ErrCode = base.ErrCode_Fail
+		resp.ErrMsg = err.Error()
+		c.JSON(consts.StatusBadRequest, resp)
+		return
+	}
+
+	v, exist := c.Get("userID")
+	if !exist || v == nil {
+		c.JSON(consts.StatusUnauthorized, "Unauthorized")
+		return
+	}
+	userID, err := strconv.Atoi(v.(string))
+	if err != nil {
+		c.JSON(consts.StatusUnauthorized, "Unauthorized,"+err.Error())
+		return
+	}
+
+	recoveryCodes, err := logic.NewTwoFactor(data.Default(), configs.Data()).Confirm(ctx, uint64(userID), req.Code)
+	if err != nil {
+		resp.ErrCode = base.ErrCode_Fail
+		resp.ErrMsg = err.Error()
+		c.JSON(consts.StatusBadRequest, resp)
+		return
+	}
+
+	resp.RecoveryCodes = recoveryCodes
+	resp.ErrCode = base.ErrCode_Success
+	resp.ErrMsg = "success"
+	c.JSON(consts.StatusOK, resp)
+}
+
+// ResetTwoFactor .
+// @router /api/admin/user/2fa/reset [POST]
+func ResetTwoFactor(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req base.IDReq
+	resp := new(base.BaseResp)
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		resp.ErrCode = base.ErrCode_Fail
+		resp.ErrMsg = err.Error()
+		c.JSON(consts.StatusBadRequest, resp)
+		return
+	}
+
+	err = logic.NewTwoFactor(data.Default(), configs.Data()).Reset(ctx, req.ID)
+	if err != nil {
+		resp.ErrCode = base.ErrCode_Fail
+		resp.ErrMsg = err.Error()
+		c.JSON(consts.StatusInternalServerError, resp)
+		return
+	}
+
+	resp.ErrCode = base.ErrCode_Success
+	resp.ErrMsg = "success"
+	c.JSON(consts.StatusOK, resp)
+}
+
+// BeginWebauthnRegistration .
+// @router /api/admin/user/webauthn/register/begin [POST]
+func BeginWebauthnRegistration(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req base.Empty
+	resp := new(admin.WebauthnOptionsResp)
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		resp.ErrCode = base.ErrCode_Fail
+		resp.ErrMsg = err.Error()
+		c.JSON(consts.StatusBadRequest, resp)
+		return
+	}
+
+	v, exist := c.Get("userID")
+	if !exist || v == nil {
+		c.JSON(consts.StatusUnauthorized, "Unauthorized")
+		return
+	}
+	userID, err := strconv.Atoi(v.(string))
+	if err != nil {
+		c.JSON(consts.StatusUnauthorized, "Unauthorized,"+err.Error())
+		return
+	}
+
+	options, err := logic.NewWebauthn(data.Default(), configs.Data()).BeginRegistration(ctx, uint64(userID))
+	if err != nil {
+		resp.ErrCode = base.ErrCode_Fail
+		resp.ErrMsg = err.Error()
+		c.JSON(consts.StatusInternalServerError, resp)
+		return
+	}
+
+	resp.Options = options
+	resp.ErrCode = base.ErrCode_Success
+	resp.ErrMsg = "success"
+	c.JSON(consts.StatusOK, resp)
+}
+
+// FinishWebauthnRegistration .
+// @router /api/admin/user/webauthn/register/finish [POST]
+func FinishWebauthnRegistration(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req admin.WebauthnRegisterReq
+	resp := new(base.BaseResp)
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		resp.ErrCode = base.ErrCode_Fail
+		resp.ErrMsg = err.Error()
+		c.JSON(consts.StatusBadRequest, resp)
+		return
+	}
+
+	v, exist := c.Get("userID")
+	if !exist || v == nil {
+		c.JSON(consts.StatusUnauthorized, "Unauthorized")
+		return
+	}
+	userID, err := strconv.Atoi(v.(string))
+	if err != nil {
+		c.JSON(consts.StatusUnauthorized, "Unauthorized,"+err.Error())
+		return
+	}
+
+	err = logic.NewWebauthn(data.Default(), configs.Data()).FinishRegistration(ctx, uint64(userID), req.Name, req.Credential)
+	if err != nil {
+		resp.ErrCode = base.ErrCode_Fail
+		resp.ErrMsg = err.Error()
+		c.JSON(consts.StatusBadRequest, resp)
+		return
+	}
+
+	resp.ErrCode = base.ErrCode_Success
+	resp.ErrMsg = "success"
+	c.JSON(consts.StatusOK, resp)
+}
+
+// WebauthnCredentialList .
+// @router /api/admin/user/webauthn/list [GET]
+func WebauthnCredentialList(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req base.Empty
+	resp := new(admin.WebauthnCredentialListResp)
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		resp.ErrCode = base.ErrCode_Fail
+		resp.ErrMsg = err.Error()
+		c.JSON(consts.StatusBadRequest, resp)
+		return
+	}
+
+	v, exist := c.Get("userID")
+	if !exist || v == nil {
+		c.JSON(consts.StatusUnauthorized, "Unauthorized")
+		return
+	}
+	userID, err := strconv.Atoi(v.(string))
+	if err != nil {
+		c.JSON(consts.StatusUnauthorized, "Unauthorized,"+err.Error())
+		return
+	}
+
+	credentials, err := logic.NewWebauthn(data.Default(), configs.Data()).List(ctx, uint64(userID))
+	if err != nil {
+		resp.ErrCode = base.ErrCode_Fail
+		resp.ErrMsg = err.Error()
+		c.JSON(consts.StatusInternalServerError, resp)
+		return
+	}
+
+	for _, v := range credentials {
+		resp.Data = append(resp.Data, &admin.WebauthnCredentialInfo{
+			ID:           v.ID,
+			CreatedAt:    v.CreatedAt,
+			Name:         v.Name,
+			CredentialID: v.CredentialID,
+			Transports:   v.Transports,
+			SignCount:    v.SignCount,
+			LastUsedAt:   v.LastUsedAt,
+		})
+	}
+	resp.Total = uint64(len(credentials))
+	resp.ErrCode = base.ErrCode_Success
+	resp.ErrMsg = "success"
+	c.JSON(consts.StatusOK, resp)
+}
+
+// UpdateWebauthnCredential .
+// @router /api/admin/user/webauthn/update [POST]
+func UpdateWebauthnCredential(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req admin.WebauthnCredentialInfo
+	resp := new(base.BaseResp)
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		resp.ErrCode = base.ErrCode_Fail
+		resp.ErrMsg = err.Error()
+		c.JSON(consts.StatusBadRequest, resp)
+		return
+	}
+
+	v, exist := c.Get("userID")
+	if !exist || v == nil {
+		c.JSON(consts.StatusUnauthorized, "Unauthorized")
+		return
+	}
+	userID, err := strconv.Atoi(v.(string))
+	if err != nil {
+		c.JSON(consts.StatusUnauthorized, "Unauthorized,"+err.Error())
+		return
+	}
+
+	err = logic.NewWebauthn(data.Default(), configs.Data()).Rename(ctx, uint64(userID), req.ID, req.Name)
+	if err != nil {
+		resp.ErrCode = base.ErrCode_Fail
+		resp.ErrMsg = err.Error()
+		c.JSON(consts.StatusBadRequest, resp)
+		return
+	}
+
+	resp.ErrCode = base.ErrCode_Success
+	resp.ErrMsg = "success"
+	c.JSON(consts.StatusOK, resp)
+}
+
+// DeleteWebauthnCredential .
+// @router /api/admin/user/webauthn [DELETE]
+func DeleteWebauthnCredential(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req base.IDReq
+	resp := new(base.BaseResp)
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		resp.ErrCode = base.ErrCode_Fail
+		resp.ErrMsg = err.Error()
+		c.JSON(consts.StatusBadRequest, resp)
+		return
+	}
+
+	v, exist := c.Get("userID")
+	if !exist || v == nil {
+		c.JSON(consts.StatusUnauthorized, "Unauthorized")
+		return
+	}
+	userID, err := strconv.Atoi(v.(string))
+	if err != nil {
+		c.JSON(consts.StatusUnauthorized, "Unauthorized,"+err.Error())
+		return
+	}
+
+	err = logic.NewWebauthn(data.Default(), configs.Data()).Delete(ctx, uint64(userID), req.ID)
+	if err != nil {
+		resp.ErrCode = base.ErrCode_Fail
+		resp.ErrMsg = err.Error()
+		c.JSON(consts.StatusBadRequest, resp)
+		return
+	}
+
+	resp.ErrCode = base.ErrCode_Success
+	resp.ErrMsg = "success"
+	c.JSON(consts.StatusOK, resp)
+}
+
+// UnlockLogin .
+// @router /api/admin/user/unlock [POST]
+func UnlockLogin(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req admin.UnlockLoginReq
+	resp := new(base.BaseResp)
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		resp.ErrCode = base.ErrCode_Fail
+		resp.ErrMsg = err.Error()
+		c.JSON(consts.StatusBadRequest, resp)
+		return
+	}
+
+	err = logic.NewLoginLimit(data.Default(), configs.Data()).Unlock(ctx, req.Username, req.Ip)
+	if err != nil {
+		resp.ErrCode = base.ErrCode_Fail
+		resp.ErrMsg = err.Error()
+		c.JSON(consts.StatusBadRequest, resp)
+		return
+	}
+
+	resp.ErrCode = base.ErrCode_Success
+	resp.ErrMsg = "success"
+	c.JSON(consts.StatusOK, resp)
+}
+
+// ForgotPassword .
+// @router /api/password/forgot [POST]
+func ForgotPassword(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req admin.ForgotPasswordReq
+	resp := new(base.BaseResp)
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		resp.ErrCode = base.ErrCode_Fail
+		resp.ErrMsg = err.Error()
+		c.JSON(consts.StatusBadRequest, resp)
+		return
+	}
+
+	// verify captcha while IsProd is true
+	if configs.Data().IsProd {
+		if !logic.NewCaptcha().Verify(req.CaptchaID, req.Captcha) {
+			resp.ErrCode = base.ErrCode_Fail
+			resp.ErrMsg = "invalid captcha"
+			c.JSON(consts.StatusBadRequest, resp)
+			return
+		}
+	}
+
+	err = logic.NewPasswordReset(data.Default(), configs.Data()).SendResetMail(ctx, req.Email)
+	if err != nil {
+		resp.ErrCode = base.ErrCode_Fail
+		resp.ErrMsg = err.Error()
+		c.JSON(consts.StatusInternalServerError, resp)
+		return
+	}
+
+	resp.ErrCode = base.ErrCode_Success
+	resp.ErrMsg = "if the email is registered, a reset link has been sent"
+	c.JSON(consts.StatusOK, resp)
+}
+
+// ResetPassword .
+// @router /api/password/reset [POST]
+func ResetPassword(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req admin.ResetPasswordReq
+	resp := new(base.BaseResp)
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		resp.ErrCode = base.ErrCode_Fail
+		resp.ErrMsg = err.Error()
+		c.JSON(consts.StatusBadRequest, resp)
+		return
+	}
+
+	err = logic.NewPasswordReset(data.Default(), configs.Data()).Reset(ctx, req.Token, req.NewPassword)
+	if err != nil {
+		resp.ErrCode = base.ErrCode_Fail
+		resp.ErrMsg = err.Error()
+		if errors.Is(err, password.ErrInvalidResetToken) {
+			c.JSON(consts.StatusBadRequest, resp)
+			return
+		}
+		c.JSON(consts.StatusInternalServerError, resp)
+		return
+	}
+
+	resp.ErrCode = base.ErrCode_Success
+	resp.ErrMsg = "success"
+	c.JSON(consts.StatusOK, resp)
+}
+
+// SendRegisterCode .
+// @router /api/register/code [POST]
+func SendRegisterCode(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req admin.RegisterCodeReq
+	resp := new(base.BaseResp)
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		resp.ErrCode = base.ErrCode_Fail
+		resp.ErrMsg = err.Error()
+		c.JSON(consts.StatusBadRequest, resp)
+		return
+	}
+
+	// verify captcha while IsProd is true
+	if configs.Data().IsProd {
+		if !logic.NewCaptcha().Verify(req.CaptchaID, req.Captcha) {
+			resp.ErrCode = base.ErrCode_Fail
+			resp.ErrMsg = "invalid captcha"
+			c.JSON(consts.StatusBadRequest, resp)
+			return
+		}
+	}
+
+	err = logic.NewRegister(data.Default(), configs.Data()).SendCode(ctx, req.Email)
+	if err != nil {
+		resp.ErrCode = base.ErrCode_Fail
+		resp.ErrMsg = err.Error()
+		c.JSON(consts.StatusInternalServerError, resp)
+		return
+	}
+
+	resp.ErrCode = base.ErrCode_Success
+	resp.ErrMsg = "success"
+	c.JSON(consts.StatusOK, resp)
+}
+
+// ApproveUser .
+// @router /api/admin/user/approve [POST]
+func ApproveUser(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req admin.ApproveUserReq
+	resp := new(base.BaseResp)
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		resp.ErrCode = base.ErrCode_Fail
+		resp.ErrMsg = err.Error()
+		c.JSON(consts.StatusBadRequest, resp)
+		return
+	}
+
+	err = logic.NewUser(data.Default()).ApproveUser(ctx, req.ID, req.Approve)
+	if err != nil {
+		resp.ErrCode = base.ErrCode_Fail
+		resp.ErrMsg = err.Error()
+		c.JSON(consts.StatusInternalServerError, resp)
+		return
+	}
+
+	resp.ErrCode = base.ErrCode_Success
+	resp.ErrMsg = "success"
+	c.JSON(consts.StatusOK, resp)
+}
+
+// CreateAPIKey .
+// @router /api/admin/user/apikey/create [POST]
+func CreateAPIKey(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req admin.CreateAPIKeyReq
+	resp := new(admin.CreateAPIKeyResp)
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		resp.ErrCode = base.ErrCode_Fail
+		resp.ErrMsg = err.Error()
+		c.JSON(consts.StatusBadRequest, resp)
+		return
+	}
+
+	v, exist := c.Get("userID")
+	if !exist || v == nil {
+		c.JSON(consts.StatusUnauthorized, "Unauthorized")
+		return
+	}
+	userID, err := strconv.Atoi(v.(string))
+	if err != nil {
+		c.JSON(consts.StatusUnauthorized, "Unauthorized,"+err.Error())
+		return
+	}
+
+	key, err := logic.NewAPIKey(data.Default(), data.CasbinEnforcer()).Create(ctx, admin2.CreateAPIKeyReq{
+		UserID:     uint64(userID),
+		Name:       req.Name,
+		Scopes:     req.Scopes,
+		ExpireDays: req.ExpireDays,
+	})
+	if err != nil {
+		resp.ErrCode = base.ErrCode_Fail
+		resp.ErrMsg = err.Error()
+		c.JSON(consts.StatusInternalServerError, resp)
+		return
+	}
+
+	resp.Key = key
+	resp.ErrCode = base.ErrCode_Success
+	resp.ErrMsg = "success"
+	c.JSON(consts.StatusOK, resp)
+}
+
+// MyAPIKeyList .
+// @router /api/admin/user/apikey/list [POST]
+func MyAPIKeyList(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req admin.APIKeyListReq
+	resp := new(admin.APIKeyListResp)
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		resp.ErrCode = base.ErrCode_Fail
+		resp.ErrMsg = err.Error()
+		c.JSON(consts.StatusBadRequest, resp)
+		return
+	}
+
+	v, exist := c.Get("userID")
+	if !exist || v == nil {
+		c.JSON(consts.StatusUnauthorized, "Unauthorized")
+		return
+	}
+	userID, err := strconv.Atoi(v.(string))
+	if err != nil {
+		c.JSON(consts.StatusUnauthorized, "Unauthorized,"+err.Error())
+		return
+	}
+
+	// only the keys of the current user
+	keys, total, err := logic.NewAPIKey(data.Default(), data.CasbinEnforcer()).List(ctx, admin2.APIKeyListReq{
+		Page:     req.Page,
+		PageSize: req.PageSize,
+		UserID:   uint64(userID),
+	})
+	if err != nil {
+		resp.ErrCode = base.ErrCode_Fail
+		resp.ErrMsg = err.Error()
+		c.JSON(consts.StatusInternalServerError, resp)
+		return
+	}
+	for _, v := range keys {
+		resp.Data = append(resp.Data, apiKeyInfo(v))
+	}
+	resp.Total = uint64(total)
+	resp.ErrCode = base.ErrCode_Success
+	resp.ErrMsg = "success"
+	c.JSON(consts.StatusOK, resp)
+}
+
+// RevokeMyAPIKey .
+// @router /api/admin/user/apikey [DELETE]
+func RevokeMyAPIKey(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req base.IDReq
+	resp := new(base.BaseResp)
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		resp.ErrCode = base.ErrCode_Fail
+		resp.ErrMsg = err.Error()
+		c.JSON(consts.StatusBadRequest, resp)
+		return
+	}
+
+	v, exist := c.Get("userID")
+	if !exist || v == nil {
+		c.JSON(consts.StatusUnauthorized, "Unauthorized")
+		return
+	}
+	userID, err := strconv.Atoi(v.(string))
+	if err != nil {
+		c.JSON(consts.StatusUnauthorized, "Unauthorized,"+err.Error())
+		return
+	}
+
+	err = logic.NewAPIKey(data.Default(), data.CasbinEnforcer()).Revoke(ctx, uint64(userID), req.ID)
+	if err != nil {
+		resp.ErrCode = base.ErrCode_Fail
+		resp.ErrMsg = err.Error()
+		c.JSON(consts.StatusInternalServerError, resp)
+		return
+	}
+
+	resp.ErrCode = base.ErrCode_Success
+	resp.ErrMsg = "success"
+	c.JSON(consts.StatusOK, resp)
+}
+
+// UserIdentityList .
+// @router /api/admin/user/identity/list [GET]
+func UserIdentityList(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req base.Empty
+	resp := new(admin.UserIdentityListResp)
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		resp.ErrCode = base.ErrCode_Fail
+		resp.ErrMsg = err.Error()
+		c.JSON(consts.StatusBadRequest, resp)
+		return
+	}
+
+	v, exist := c.Get("userID")
+	if !exist || v == nil {
+		c.JSON(consts.StatusUnauthorized, "Unauthorized")
+		return
+	}
+	userID, err := strconv.Atoi(v.(string))
+	if err != nil {
+		c.JSON(consts.StatusUnauthorized, "Unauthorized,"+err.Error())
+		return
+	}
+
+	identities, err := logic.NewOauth(data.Default(), configs.Data()).Identities(ctx, uint64(userID))
+	if err != nil {
+		resp.ErrCode = base.ErrCode_Fail
+		resp.ErrMsg = err.Error()
+		c.JSON(consts.StatusInternalServerError, resp)
+		return
+	}
+
+	for _, v := range identities {
+		resp.Data = append(resp.Data, &admin.UserIdentityInfo{
+			ID:          v.ID,
+			CreatedAt:   v.CreatedAt,
+			Provider:    v.Provider,
+			Subject:     v.Subject,
+			Username:    v.Username,
+			Email:       v.Email,
+			LastLoginAt: v.LastLoginAt,
+		})
+	}
+	resp.Total = uint64(len(identities))
+	resp.ErrCode = base.ErrCode_Success
+	resp.ErrMsg = "success"
+	c.JSON(consts.StatusOK, resp)
+}
+
+// LinkUserIdentity .
+// @router /api/admin/user/identity/link [POST]
+func LinkUserIdentity(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req admin.OauthLoginReq
+	resp := new(admin.OauthRedirectResp)
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		resp.ErrCode = base.ErrCode_Fail
+		resp.ErrMsg = err.Error()
+		c.JSON(consts.StatusBadRequest, resp)
+		return
+	}
+
+	v, exist := c.Get("userID")
+	if !exist || v == nil {
+		c.JSON(consts.StatusUnauthorized, "Unauthorized")
+		return
+	}
+	userID, err := strconv.Atoi(v.(string))
+	if err != nil {
+		c.JSON(consts.StatusUnauthorized, "Unauthorized,"+err.Error())
+		return
+	}
+
+	url, err := logic.NewOauth(data.Default(), configs.Data()).LinkURL(ctx, uint64(userID), &admin2.OauthLoginReq{
+		Provider:  req.Provider,
+		LoginType: req.LoginType,
+	})
+	if err != nil {
+		resp.ErrCode = base.ErrCode_Fail
+		resp.ErrMsg = err.Error()
+		c.JSON(consts.StatusInternalServerError, resp)
+		return
+	}
+
+	resp.Url = url
+	resp.ErrCode = base.ErrCode_Success
+	resp.ErrMsg = "success"
+	c.JSON(consts.StatusOK, resp)
+}
+
+// DeleteUserIdentity .
+// @router /api/admin/user/identity [DELETE]
+func DeleteUserIdentity(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req base.IDReq
+	resp := new(base.BaseResp)
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		resp.ErrCode = base.ErrCode_Fail
+		resp.ErrMsg = err.Error()
+		c.JSON(consts.StatusBadRequest, resp)
+		return
+	}
+
+	v, exist := c.Get("userID")
+	if !exist || v == nil {
+		c.JSON(consts.StatusUnauthorized, "Unauthorized")
+		return
+	}
+	userID, err := strconv.Atoi(v.(string))
+	if err != nil {
+		c.JSON(consts.StatusUnauthorized, "Unauthorized,"+err.Error())
+		return
+	}
+
+	err = logic.NewOauth(data.Default(), configs.Data()).Unlink(ctx, uint64(userID), req.ID)
+	if err != nil {
+		resp.ErrCode = base.ErrCode_Fail
+		resp.ErrMsg = err.Error()
+		c.JSON(consts.StatusBadRequest, resp)
+		return
+	}
+
+	resp.ErrCode = base.ErrCode_Success
+	resp.ErrMsg = "success"
+	c.JSON(consts.StatusOK, resp)
+}
+
+// Impersonate .
+// @router /api/admin/user/impersonate [POST]
+func Impersonate(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req base.IDReq
+	resp := new(base.BaseResp)
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		resp.ErrCode = base.ErrCode_Fail
+		resp.ErrMsg = err.Error()
+		c.JSON(consts.StatusBadRequest, resp)
+		return
+	}
+
+	// goto jwt impersonate handler, the token of the user is issued like a login
+	c.Set("impersonateUserID", req.ID)
+	initImpersonationHandlers()
+	impersonateHandler(ctx, c)
+}
+
+// EndImpersonation .
+// @router /api/admin/user/impersonate/end [POST]
+func EndImpersonation(ctx context.Context, c *app.RequestContext) {
+	// goto jwt end impersonation handler, the token of the session of the admin is issued again
+	initImpersonationHandlers()
+	endImpersonationHandler(ctx, c)
+}
+
+var (
+	impersonationOnce       sync.Once
+	impersonateHandler      app.HandlerFunc
+	endImpersonationHandler app.HandlerFunc
+)
+
+// initImpersonationHandlers builds the jwt impersonation handlers once, like the auth middleware
+func initImpersonationHandlers() {
+	impersonationOnce.Do(func() {
+		impersonateHandler = middleware.GetImpersonateHandler(configs.Data(), data.Default(), data.CasbinEnforcer())
+		endImpersonationHandler = middleware.GetEndImpersonationHandler(configs.Data(), data.Default(), data.CasbinEnforcer())
+	})
+}
+
+// MySessionList .
+// @router /api/admin/user/session/list [POST]
+func MySessionList(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req admin.SessionListReq
+	resp := new(admin.SessionListResp)
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		resp.ErrCode = base.ErrCode_Fail
+		resp.ErrMsg = err.Error()
+		c.JSON(consts.StatusBadRequest, resp)
+		return
+	}
+
+	v, exist := c.Get("userID")
+	if !exist || v == nil {
+		c.JSON(consts.StatusUnauthorized, "Unauthorized")
+		return
+	}
+	userID, err := strconv.Atoi(v.(string))
+	if err != nil {
+		c.JSON(consts.StatusUnauthorized, "Unauthorized,"+err.Error())
+		return
+	}
+
+	// only the sessions of the current user
+	sessions, total, err := logic.NewToken(data.Default()).List(ctx, &admin2.TokenListReq{
+		Page:     req.Page,
+		PageSize: req.PageSize,
+		UserID:   uint64(userID),
+	})
+	if err != nil {
+		resp.ErrCode = base.ErrCode_Fail
+		resp.ErrMsg = err.Error()
+		c.JSON(consts.StatusInternalServerError, resp)
+		return
+	}
+	for _, v := range sessions {
+		resp.Data = append(resp.Data, sessionInfo(v, c.GetString("jti")))
+	}
+	resp.Total = uint64(total)
+	resp.ErrCode = base.ErrCode_Success
+	resp.ErrMsg = "success"
+	c.JSON(consts.StatusOK, resp)
+}
+
+// RevokeMySession .
+// @router /api/admin/user/session/revoke [POST]
+func RevokeMySession(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req admin.SessionRevokeReq
+	resp := new(base.BaseResp)
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		resp.ErrCode = base.ErrCode_Fail
+		resp.ErrMsg = err.Error()
+		c.JSON(consts.StatusBadRequest, resp)
+		return
+	}
+
+	v, exist := c.Get("userID")
+	if !exist || v == nil {
+		c.JSON(consts.StatusUnauthorized, "Unauthorized")
+		return
+	}
+	userID, err := strconv.Atoi(v.(string))
+	if err != nil {
+		c.JSON(consts.StatusUnauthorized, "Unauthorized,"+err.Error())
+		return
+	}
+
+	err = logic.NewToken(data.Default()).RevokeByUser(ctx, uint64(userID), req.Jti)
+	if err != nil {
+		resp.ErrCode = base.ErrCode_Fail
+		resp.ErrMsg = err.Error()
+		c.JSON(consts.StatusBadRequest, resp)
+		return
+	}
+
+	resp.ErrCode = base.ErrCode_Success
+	resp.ErrMsg = "success"
+	c.JSON(consts.StatusOK, resp)
+}
+
+// RevokeMyOtherSessions .
+// @router /api/admin/user/session/revoke-others [POST]
+func RevokeMyOtherSessions(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req base.Empty
+	resp := new(base.BaseResp)
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		resp.ErrCode = base.ErrCode_Fail
+		resp.ErrMsg = err.Error()
+		c.JSON(consts.StatusBadRequest, resp)
+		return
+	}
+
+	v, exist := c.Get("userID")
+	if !exist || v == nil {
+		c.JSON(consts.StatusUnauthorized, "Unauthorized")
+		return
+	}
+	userID, err := strconv.Atoi(v.(string))
+	if err != nil {
+		c.JSON(consts.StatusUnauthorized, "Unauthorized,"+err.Error())
+		return
+	}
+
+	// the session of the request is kept, an api key request keeps none
+	err = logic.NewToken(data.Default()).RevokeAll(ctx, uint64(userID), c.GetString("jti"))
+	if err != nil {
+		resp.ErrCode = base.ErrCode_Fail
+		resp.ErrMsg = err.Error()
+		c.JSON(consts.StatusInternalServerError, resp)
+		return
+	}
+
+	resp.ErrCode = base.ErrCode_Success
+	resp.ErrMsg = "success"
+	c.JSON(consts.StatusOK, resp)
+}
+
+// MyLoginEventList .
+// @router /api/admin/user/login-event/list [POST]
+func MyLoginEventList(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req admin.LoginEventListReq
+	resp := new(admin.LoginEventListResp)
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		resp.ErrCode = base.ErrCode_Fail
+		resp.ErrMsg = err.Error()
+		c.JSON(consts.StatusBadRequest, resp)
+		return
+	}
+
+	v, exist := c.Get("userID")
+	if !exist || v == nil {
+		c.JSON(consts.StatusUnauthorized, "Unauthorized")
+		return
+	}
+	userID, err := strconv.Atoi(v.(string))
+	if err != nil {
+		c.JSON(consts.StatusUnauthorized, "Unauthorized,"+err.Error())
+		return
+	}
+
+	// only the logins of the current user, the newest first
+	events, total, err := logic.NewLoginEvent(data.Default(), configs.Data()).List(ctx, &admin2.LoginEventListReq{
+		Page:     req.Page,
+		PageSize: req.PageSize,
+		UserID:   uint64(userID),
+	})
+	if err != nil {
+		resp.ErrCode = base.ErrCode_Fail
+		resp.ErrMsg = err.Error()
+		c.JSON(consts.StatusInternalServerError, resp)
+		return
+	}
+	for _, v := range events {
+		resp.Data = append(resp.Data, loginEventInfo(v))
+	}
+	resp.Total = uint64(total)
+	resp.ErrCode = base.ErrCode_Success
+	resp.ErrMsg = "success"
+	c.JSON(consts.StatusOK, resp)
+}
+
+// SwitchRole .
+// @router /api/admin/user/role/switch [POST]
+func SwitchRole(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req admin.SwitchRoleReq
+	resp := new(base.BaseResp)
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		resp.ErrCode = base.ErrCode_Fail
+		resp.ErrMsg = err.Error()
+		c.JSON(consts.StatusBadRequest, resp)
+		return
+	}
+
+	v, exist := c.Get("userID")
+	if !exist || v == nil {
+		c.JSON(consts.StatusUnauthorized, "Unauthorized")
+		return
+	}
+	userID, err := strconv.Atoi(v.(string))
+	if err != nil {
+		c.JSON(consts.StatusUnauthorized, "Unauthorized,"+err.Error())
+		return
+	}
+	err = logic.NewUser(data.Default()).SwitchRole(ctx, uint64(userID), c.GetString("jti"), req.RoleID)
+	if err != nil {
+		resp.ErrCode = base.ErrCode_Fail
+		resp.ErrMsg = err.Error()
+		c.JSON(consts.StatusInternalServerError, resp)
+		return
+	}
+
+	resp.ErrCode = base.ErrCode_Success
+	resp.ErrMsg = "success"
+	c.JSON(consts.StatusOK, resp)
+}
@@ -0,0 +1,118 @@
+/*
+ * Copyright 2023 FormulaGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ */
+
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"formulago/biz/domain/admin"
+	logic "formulago/biz/logic/admin"
+	"formulago/configs"
+	Data "formulago/data"
+	"formulago/pkg/apikey"
+	"formulago/pkg/tenant"
+
+	"github.com/casbin/casbin/v3"
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"github.com/hertz-contrib/jwt"
+)
+
+// apiKeyForbiddenPrefixes an api key cannot manage api keys, its own or of the other users,
+// so it cannot outlive its expiry or widen its scopes
+var apiKeyForbiddenPrefixes = []string{"/api/admin/user/apikey", "/api/admin/apikey"}
+
+// GetAuthMiddleware returns the middleware accepting a bearer JWT or a bearer api key.
+// An api key passes if one of its scopes matches the request and one of the roles of its owner still has the policy.
+func GetAuthMiddleware(config configs.Config, db *Data.Data, enforcer *casbin.Enforcer) app.HandlerFunc {
+	jwtMiddleware := GetJWTMiddleware(config, db, enforcer)
+	jwtHandler := jwtMiddleware.MiddlewareFunc()
+	return func(ctx context.Context, c *app.RequestContext) {
+		token, ok := strings.CutPrefix(string(c.GetHeader("Authorization")), jwtMiddleware.TokenHeadName+" ")
+		if !ok || !apikey.IsKey(token) {
+			jwtHandler(ctx, c)
+			return
+		}
+
+		obj := string(c.URI().Path())
+		act := string(c.Method())
+		key, err := logic.NewAPIKey(db, enforcer).Authenticate(ctx, token, c.ClientIP())
+		if err != nil {
+			if !errors.Is(err, admin.ErrAPIKeyInvalid) {
+				hlog.Error(err, "authenticate api key error")
+			}
+			recordLogin(ctx, c, config, db, &admin.LoginEventInfo{Method: admin.LoginMethodAPIKey}, err)
+			unauthorized(ctx, c, jwtMiddleware, http.StatusUnauthorized, admin.ErrAPIKeyInvalid.Error())
+			return
+		}
+		// the use of a key is recorded once per interval and IP, not on every request
+		if key.NewUse {
+			recordLogin(ctx, c, config, db, &admin.LoginEventInfo{
+				UserID:   key.UserID,
+				Username: key.Username,
+				Method:   admin.LoginMethodAPIKey,
+			}, nil)
+		}
+		roleID := strconv.FormatUint(key.RoleID, 10)
+		if !authorizeAPIKey(ctx, config, db, enforcer, key, obj, act) {
+			unauthorized(ctx, c, jwtMiddleware, http.StatusForbidden, jwtMiddleware.HTTPStatusMessageFunc(jwt.ErrForbidden, ctx, c))
+			return
+		}
+
+		c.Set("roleID", roleID)
+		c.Set("userID", strconv.FormatUint(key.UserID, 10))
+		c.Set("apiKeyID", key.ID)
+		c.Set("tenantID", strconv.FormatUint(key.TenantID, 10))
+		c.Next(ctx)
+	}
+}
+
+// authorizeAPIKey checks the key like the Authorizator checks a token, the keys are revoked with the sessions
+// when the token version of the owner is bumped, e.g. a password change.
+func authorizeAPIKey(ctx context.Context, config configs.Config, db *Data.Data, enforcer *casbin.Enforcer, key *admin.APIKeyInfo, obj, act string) bool {
+	for _, prefix := range apiKeyForbiddenPrefixes {
+		if strings.HasPrefix(obj, prefix) {
+			hlog.Info("api key cannot manage api keys, key id: ", key.ID)
+			return false
+		}
+	}
+	// check the tenant and the role status
+	active, err := logic.NewTenant(db, enforcer).IsActive(ctx, key.TenantID)
+	if err != nil || !active {
+		hlog.Info("tenant of the api key owner is not active, key id: ", key.ID)
+		return false
+	}
+	roles, err := logic.NewUser(db).ActiveRoles(ctx, key.UserID)
+	if err != nil || len(roles) == 0 {
+		hlog.Info("the api key owner has no active role, key id: ", key.ID)
+		return false
+	}
+	// the owner must change the expired password or enroll the forced two-factor first, both need a login
+	passwordExpired, err := logic.NewUser(db).PasswordExpired(ctx, key.UserID)
+	if err != nil || passwordExpired {
+		hlog.Info("the password of the api key owner is expired, key id: ", key.ID, " err: ", err)
+		return false
+	}
+	if i := slices.IndexFunc(roles, func(r *admin.RoleInfo) bool { return r.ForceTwoFactor }); i >= 0 {
+		twoFactorStatus, err := logic.NewTwoFactor(db, config).Status(ctx, key.UserID, roles[i].ID)
+		if err != nil || !twoFactorStatus.Enabled {
+			hlog.Info("the api key owner has not enrolled the forced two-factor, key id: ", key.ID, " err: ", err)
+			return false
+		}
+	}
+	if !apikey.Allowed(key.Scopes, obj, act) {
+		hlog.Info("api key scope forbid key id: ", key.ID, " path: ", obj, " method: ", act)
+		return false
+	}
+	// the roles may have lost the policy since the key was created
+	return enforceRoles(enforcer, roles, tenant.Domain(key.TenantID), obj, act)
+}
@@ -0,0 +1,85 @@
+/*
+ * Copyright 2023 FormulaGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ */
+
+package middleware
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"formulago/biz/domain/admin"
+	logic "formulago/biz/logic/admin"
+	"formulago/configs"
+	"formulago/pkg/tenant"
+)
+
+func TestAuthorizeAPIKey(t *testing.T) {
+	ctx := context.Background()
+	d := newTestCluster(t, 1)[0]
+	enforcer := newTestEnforcer(t)
+	roleEnt, err := d.DBClient.Role.Create().SetName("admin").SetValue("admin").SetTenantID(1).Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = enforcer.AddPolicies([][]string{
+		{"1", tenant.Domain(1), "/api/admin/test", "GET"},
+		{"1", tenant.Domain(1), "/api/admin/apikey/list", "POST"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	userEnt := newTestUser(t, d, "alice", roleEnt.ID)
+
+	apiKeys := logic.NewAPIKey(d, enforcer)
+	token, err := apiKeys.Create(ctx, admin.CreateAPIKeyReq{
+		UserID:     userEnt.ID,
+		Name:       "test",
+		Scopes:     []string{"GET /api/admin/test", "POST /api/admin/apikey/list"},
+		ExpireDays: 1,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	key, err := apiKeys.Authenticate(ctx, token, "127.0.0.1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		// forceTwoFactor the role forces two-factor, the owner is not enrolled
+		forceTwoFactor bool
+		method         string
+		path           string
+		want           bool
+	}{
+		{name: "scope allowed", method: "GET", path: "/api/admin/test", want: true},
+		{name: "keys of the other users", method: "POST", path: "/api/admin/apikey/list", want: false},
+		{name: "own keys", method: "POST", path: "/api/admin/user/apikey/create", want: false},
+		{name: "forced two-factor not enrolled", forceTwoFactor: true, method: "GET", path: "/api/admin/test", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := d.DBClient.Role.UpdateOne(roleEnt).SetForceTwoFactor(tt.forceTwoFactor).Exec(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			d.Cache.Flush()
+			if got := authorizeAPIKey(ctx, configs.Config{}, d, enforcer, key, tt.path, tt.method); got != tt.want {
+				t.Errorf("authorizeAPIKey() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	// a password change revokes the keys with the tokens
+	if err = logic.NewUser(d).ResetPassword(ctx, userEnt.ID, "Xy7#kQ2!mZ9@vL4$"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = apiKeys.Authenticate(ctx, token, "127.0.0.1"); !errors.Is(err, admin.ErrAPIKeyInvalid) {
+		t.Errorf("Authenticate() after the password change error = %v, want %v", err, admin.ErrAPIKeyInvalid)
+	}
+}
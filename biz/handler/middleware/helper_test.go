@@ -0,0 +1,119 @@
+/*
+ * Copyright 2023 FormulaGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ */
+
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"formulago/configs"
+	Data "formulago/data"
+	"formulago/data/ent"
+	"formulago/data/ent/enttest"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/casbin/casbin/v3"
+	"github.com/casbin/casbin/v3/model"
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/hertz-contrib/jwt"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/patrickmn/go-cache"
+	"github.com/redis/go-redis/v9"
+)
+
+// testModelText the default casbin model of the service
+const testModelText = `
+[request_definition]
+r = sub, dom, obj, act
+[policy_definition]
+p = sub, dom, obj, act
+[role_definition]
+g = _, _, _
+[policy_effect]
+e = some(where (p.eft == allow))
+[matchers]
+m = g(r.sub, p.sub, r.dom) && r.dom == p.dom && keyMatch2(r.obj,p.obj) && r.act == p.act
+`
+
+// newTestCluster returns n Data instances sharing an in-memory sqlite database and a redis,
+// each with its own memory cache, like the instances of a cluster. The default tenant is created.
+func newTestCluster(t *testing.T, n int) []*Data.Data {
+	name := strings.NewReplacer("/", "_", " ", "_").Replace(t.Name())
+	client := enttest.Open(t, "sqlite3", fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", name))
+	t.Cleanup(func() { _ = client.Close() })
+	if err := client.Tenant.Create().SetName("default").Exec(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	server := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: server.Addr()})
+	t.Cleanup(func() { _ = rdb.Close() })
+
+	cluster := make([]*Data.Data, 0, n)
+	for i := 0; i < n; i++ {
+		cluster = append(cluster, &Data.Data{
+			DBClient: client,
+			Redis:    rdb,
+			Cache:    cache.New(time.Minute, time.Minute),
+		})
+	}
+	return cluster
+}
+
+func newTestEnforcer(t *testing.T) *casbin.Enforcer {
+	m, err := model.NewModelFromString(testModelText)
+	if err != nil {
+		t.Fatal(err)
+	}
+	enforcer, err := casbin.NewEnforcer(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return enforcer
+}
+
+func newTestConfig() configs.Config {
+	config := configs.Config{}
+	config.Auth.AccessSecret = "test-secret"
+	config.Auth.AccessExpire = 3600
+	return config
+}
+
+func newTestJWT(t *testing.T, d *Data.Data, enforcer *casbin.Enforcer) *jwt.HertzJWTMiddleware {
+	mw, err := newJWT(newTestConfig(), d, enforcer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return mw
+}
+
+// newTestUser creates an active user of the role in the default tenant
+func newTestUser(t *testing.T, d *Data.Data, username string, roleID uint64) *ent.User {
+	userEnt, err := d.DBClient.User.Create().
+		SetUsername(username).
+		SetPassword("-").
+		SetNickname(username).
+		SetMobile(username).
+		SetRoleID(roleID).
+		SetTenantID(1).
+		Save(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return userEnt
+}
+
+// newTestRequest returns a request context of the method and path
+func newTestRequest(method, path string) *app.RequestContext {
+	c := app.NewContext(0)
+	c.Request.SetRequestURI(path)
+	c.Request.Header.SetMethod(method)
+	return c
+}
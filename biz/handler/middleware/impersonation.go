@@ -0,0 +1,186 @@
+/*
+ * Copyright 2023 FormulaGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ */
+
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"formulago/biz/domain/admin"
+	logic "formulago/biz/logic/admin"
+	"formulago/configs"
+	Data "formulago/data"
+	"formulago/pkg/tenant"
+	"formulago/pkg/times"
+	"formulago/pkg/useragent"
+
+	"github.com/casbin/casbin/v3"
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"github.com/google/uuid"
+	"github.com/hertz-contrib/jwt"
+	"github.com/spf13/cast"
+)
+
+// endImpersonationPath is always allowed to an impersonation, so the admin can return to the own session
+const endImpersonationPath = "/api/admin/user/impersonate/end"
+
+// defaultImpersonationExpire the lifetime of an impersonation token if it is not configured
+const defaultImpersonationExpire = 15 * time.Minute
+
+// GetImpersonateHandler returns the handler issuing a short-lived token of the user set as "impersonateUserID".
+// The token carries the admin as impersonator, it is not refreshable and ends with the session of the admin.
+func GetImpersonateHandler(config configs.Config, db *Data.Data, e *casbin.Enforcer) app.HandlerFunc {
+	jwtMiddleware := GetJWTMiddleware(config, db, e)
+	return func(ctx context.Context, c *app.RequestContext) {
+		if _, ok := c.Get("impersonator"); ok {
+			jwtMiddleware.Unauthorized(ctx, c, http.StatusForbidden, "end the current impersonation first")
+			return
+		}
+		// api keys have no session to return to
+		impersonatorJTI := c.GetString("jti")
+		if impersonatorJTI == "" {
+			jwtMiddleware.Unauthorized(ctx, c, http.StatusForbidden, "impersonation requires a login session")
+			return
+		}
+		impersonatorID := cast.ToUint64(c.GetString("userID"))
+
+		res, err := logic.NewImpersonation(db, e).Start(ctx, impersonatorID, c.GetUint64("impersonateUserID"))
+		if err != nil {
+			hlog.Info("impersonation rejected, impersonator: ", impersonatorID, " err: ", err)
+			jwtMiddleware.Unauthorized(ctx, c, http.StatusBadRequest, err.Error())
+			return
+		}
+		payloadMap, err := issueImpersonationSession(ctx, c, config, db, res, impersonatorID, impersonatorJTI)
+		if err != nil {
+			jwtMiddleware.Unauthorized(ctx, c, http.StatusInternalServerError, err.Error())
+			return
+		}
+		tokenString, expire, err := generateToken(config, jwtMiddleware, payloadMap)
+		if err != nil {
+			hlog.Error(err, "impersonation error, generate token error")
+			jwtMiddleware.Unauthorized(ctx, c, http.StatusInternalServerError, jwt.ErrFailedTokenCreation.Error())
+			return
+		}
+		hlog.Info("impersonation started, impersonator: ", impersonatorID, " user: ", res.UserID)
+		jwtMiddleware.LoginResponse(ctx, c, http.StatusOK, tokenString, expire)
+	}
+}
+
+// GetEndImpersonationHandler returns the handler revoking the impersonation and issuing a new access token
+// of the session of the admin, the refresh token of that session kept by the client stays valid.
+func GetEndImpersonationHandler(config configs.Config, db *Data.Data, e *casbin.Enforcer) app.HandlerFunc {
+	jwtMiddleware := GetJWTMiddleware(config, db, e)
+	return func(ctx context.Context, c *app.RequestContext) {
+		impersonator, ok := c.Get("impersonator")
+		if !ok {
+			jwtMiddleware.Unauthorized(ctx, c, http.StatusBadRequest, "not impersonating")
+			return
+		}
+		impersonatorJTI := c.GetString("impersonatorJti")
+		res, err := logic.NewImpersonation(db, e).End(ctx, c.GetString("jti"), cast.ToUint64(impersonator), impersonatorJTI)
+		if err != nil {
+			hlog.Info("end impersonation error: ", err)
+			jwtMiddleware.Unauthorized(ctx, c, http.StatusUnauthorized, err.Error())
+			return
+		}
+
+		// the new access token keeps the session jti of the admin
+		payloadMap := make(map[string]any)
+		payloadMap["roleID"] = strconv.Itoa(int(res.RoleID))
+		payloadMap["userID"] = strconv.Itoa(int(res.UserID))
+		payloadMap["jti"] = impersonatorJTI
+		payloadMap["tokenVersion"] = strconv.FormatUint(res.TokenVersion, 10)
+		payloadMap["tenantID"] = strconv.FormatUint(res.TenantID, 10)
+		tokenString, expire, err := generateToken(config, jwtMiddleware, payloadMap)
+		if err != nil {
+			hlog.Error(err, "end impersonation error, generate token error")
+			jwtMiddleware.Unauthorized(ctx, c, http.StatusInternalServerError, jwt.ErrFailedTokenCreation.Error())
+			return
+		}
+		err = logic.NewToken(db).Renew(ctx, impersonatorJTI, expire.In(time.Local).Format(times.TimeFormat))
+		if err != nil {
+			hlog.Error(err, "end impersonation error, renew session error")
+			jwtMiddleware.Unauthorized(ctx, c, http.StatusUnauthorized, err.Error())
+			return
+		}
+		hlog.Info("impersonation ended, impersonator: ", res.UserID, " user: ", c.GetString("userID"))
+		jwtMiddleware.LoginResponse(ctx, c, http.StatusOK, tokenString, expire)
+	}
+}
+
+// issueImpersonationSession stores the session of the impersonation, and returns the jwt payload
+func issueImpersonationSession(ctx context.Context, c *app.RequestContext, config configs.Config, db *Data.Data, res *admin.LoginResp, impersonatorID uint64, impersonatorJTI string) (map[string]any, error) {
+	userAgent := string(c.UserAgent())
+	now := time.Now()
+	var tokenInfo admin.TokenInfo
+	tokenInfo.UserID = res.UserID
+	tokenInfo.UserName = res.Username
+	tokenInfo.JTI = uuid.NewString()
+	tokenInfo.Source = "impersonation"
+	tokenInfo.Device = useragent.Device(userAgent)
+	tokenInfo.IP = c.ClientIP()
+	tokenInfo.UserAgent = userAgent
+	tokenInfo.IssuedAt = now.Format(times.TimeFormat)
+	tokenInfo.ExpiredAt = now.Add(impersonationExpire(config)).Format(times.TimeFormat)
+	tokenInfo.ImpersonatorID = impersonatorID
+	tokenInfo.RoleID = res.RoleID
+	err := logic.NewToken(db).Create(ctx, &tokenInfo)
+	if err != nil {
+		hlog.Error(err, "impersonation error, store token error")
+		return nil, err
+	}
+
+	payloadMap := make(map[string]any)
+	payloadMap["roleID"] = strconv.Itoa(int(res.RoleID))
+	payloadMap["userID"] = strconv.Itoa(int(res.UserID))
+	payloadMap["jti"] = tokenInfo.JTI
+	payloadMap["tokenVersion"] = strconv.FormatUint(res.TokenVersion, 10)
+	payloadMap["tenantID"] = strconv.FormatUint(res.TenantID, 10)
+	payloadMap["impersonator"] = strconv.FormatUint(impersonatorID, 10)
+	payloadMap["impersonatorJti"] = impersonatorJTI
+	return payloadMap, nil
+}
+
+// authorizeImpersonation allows the request if both one of the roles of the user and the impersonation role have the policy,
+// while the admin is active and still logged in. The impersonation role is a role of the default tenant.
+func authorizeImpersonation(ctx context.Context, config configs.Config, db *Data.Data, enforcer *casbin.Enforcer, payloadMap map[string]any, roles []*admin.RoleInfo, obj, act string) bool {
+	if obj == endImpersonationPath {
+		return true
+	}
+	impersonator, _ := payloadMap["impersonator"].(string)
+	impersonatorJTI, _ := payloadMap["impersonatorJti"].(string)
+	if _, err := logic.NewUser(db).TokenVersion(ctx, cast.ToUint64(impersonator)); err != nil {
+		hlog.Info("impersonator is not active, impersonator: ", impersonator, " err: ", err)
+		return false
+	}
+	if !logic.NewToken(db).IsActive(ctx, impersonatorJTI) {
+		hlog.Info("session of the impersonator is revoked or expired, impersonator: ", impersonator)
+		return false
+	}
+	sub := strconv.FormatUint(config.Impersonation.RoleID, 10)
+	pass, err := enforcer.Enforce(sub, tenant.Domain(tenant.DefaultID), obj, act)
+	if err != nil {
+		hlog.Error("casbin err,  role id: ", sub, " path: ", obj, " method: ", act, " err: ", err.Error())
+		return false
+	}
+	if !pass {
+		hlog.Info("casbin forbid impersonation, impersonator: ", impersonator, " role id: ", sub, " path: ", obj, " method: ", act)
+		return false
+	}
+	return enforceRoles(enforcer, roles, tenant.Domain(tenantID(payloadMap["tenantID"])), obj, act)
+}
+
+// impersonationExpire is the lifetime of the impersonation token
+func impersonationExpire(config configs.Config) time.Duration {
+	if config.Impersonation.Expire <= 0 {
+		return defaultImpersonationExpire
+	}
+	return time.Duration(config.Impersonation.Expire) * time.Second
+}
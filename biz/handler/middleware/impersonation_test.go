@@ -0,0 +1,129 @@
+/*
+ * Copyright 2023 FormulaGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ */
+
+package middleware
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"formulago/biz/domain/admin"
+	logic "formulago/biz/logic/admin"
+	"formulago/data/ent/refreshtoken"
+	"formulago/data/ent/token"
+	"formulago/pkg/times"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/app/server"
+	"github.com/cloudwego/hertz/pkg/common/json"
+	"github.com/cloudwego/hertz/pkg/common/test/assert"
+	"github.com/cloudwego/hertz/pkg/common/ut"
+	gojwt "github.com/golang-jwt/jwt/v4"
+)
+
+func TestImpersonation(t *testing.T) {
+	ctx := context.Background()
+	d := newTestCluster(t, 1)[0]
+	enforcer := newTestEnforcer(t)
+	config := newTestConfig()
+	mw := newTestJWT(t, d, enforcer)
+	for _, value := range []string{"admin", "member"} {
+		if _, err := d.DBClient.Role.Create().SetName(value).SetValue(value).SetTenantID(1).Save(ctx); err != nil {
+			t.Fatal(err)
+		}
+	}
+	impersonator := newTestUser(t, d, "alice", 1)
+	member := newTestUser(t, d, "carol", 2)
+	err := logic.NewToken(d).Create(ctx, &admin.TokenInfo{
+		UserID:    impersonator.ID,
+		JTI:       "admin-session",
+		Source:    "test",
+		ExpiredAt: time.Now().Add(time.Hour).Format(times.TimeFormat),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type loginResponse struct {
+		Token        string `json:"token"`
+		RefreshToken string `json:"refresh_token"`
+	}
+	// claims returns the payload of the token
+	claims := func(tokenString string) map[string]any {
+		parsed, err := mw.ParseTokenString(tokenString)
+		if err != nil {
+			t.Fatal(err)
+		}
+		payloadMap, _ := parsed.Claims.(gojwt.MapClaims)[identityKey].(map[string]any)
+		return payloadMap
+	}
+
+	// start, the jwt middleware of the admin session ran before
+	var started loginResponse
+	h := server.Default()
+	h.POST("/impersonate", func(ctx context.Context, c *app.RequestContext) {
+		c.Set("userID", strconv.FormatUint(impersonator.ID, 10))
+		c.Set("jti", "admin-session")
+		c.Set("impersonateUserID", member.ID)
+		c.Next(ctx)
+	}, GetImpersonateHandler(config, d, enforcer))
+	resp := ut.PerformRequest(h.Engine, "POST", "/impersonate", nil).Result()
+	assert.DeepEqual(t, 200, resp.StatusCode())
+	if err = json.Unmarshal(resp.Body(), &started); err != nil {
+		t.Fatal(err)
+	}
+	// the impersonation is not refreshable
+	assert.DeepEqual(t, "", started.RefreshToken)
+	payloadMap := claims(started.Token)
+	assert.DeepEqual(t, strconv.FormatUint(member.ID, 10), payloadMap["userID"])
+	assert.DeepEqual(t, strconv.FormatUint(impersonator.ID, 10), payloadMap["impersonator"])
+	assert.DeepEqual(t, "admin-session", payloadMap["impersonatorJti"])
+	impersonationJTI, _ := payloadMap["jti"].(string)
+	session, err := d.DBClient.Token.Query().Where(token.Jti(impersonationJTI)).Only(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.DeepEqual(t, impersonator.ID, session.ImpersonatorID)
+	if session.ExpiredAt.After(time.Now().Add(defaultImpersonationExpire)) {
+		t.Errorf("the impersonation session expires at %s, later than the impersonation lifetime", session.ExpiredAt)
+	}
+	refreshable, err := d.DBClient.RefreshToken.Query().Where(refreshtoken.Family(impersonationJTI)).Exist(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if refreshable {
+		t.Error("a refresh token is issued for the impersonation")
+	}
+
+	// end, the admin gets a new token of the own session
+	var ended loginResponse
+	h.POST("/end", func(ctx context.Context, c *app.RequestContext) {
+		c.Set("userID", payloadMap["userID"])
+		c.Set("jti", impersonationJTI)
+		c.Set("impersonator", payloadMap["impersonator"])
+		c.Set("impersonatorJti", payloadMap["impersonatorJti"])
+		c.Next(ctx)
+	}, GetEndImpersonationHandler(config, d, enforcer))
+	resp = ut.PerformRequest(h.Engine, "POST", "/end", nil).Result()
+	assert.DeepEqual(t, 200, resp.StatusCode())
+	if err = json.Unmarshal(resp.Body(), &ended); err != nil {
+		t.Fatal(err)
+	}
+	payloadMap = claims(ended.Token)
+	assert.DeepEqual(t, strconv.FormatUint(impersonator.ID, 10), payloadMap["userID"])
+	assert.DeepEqual(t, "admin-session", payloadMap["jti"])
+	if _, ok := payloadMap["impersonator"]; ok {
+		t.Error("the token of the admin should not carry an impersonator")
+	}
+	if logic.NewToken(d).IsActive(ctx, impersonationJTI) {
+		t.Error("the impersonation session should be revoked")
+	}
+	if !logic.NewToken(d).IsActive(ctx, "admin-session") {
+		t.Error("the session of the admin should stay active")
+	}
+}
@@ -0,0 +1,86 @@
+/*
+ * Copyright 2023 FormulaGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ */
+
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"sync"
+
+	"formulago/configs"
+	"formulago/pkg/jwks"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+)
+
+var (
+	keySetOnce sync.Once
+	keySet     *jwks.KeySet
+	keySetErr  error
+)
+
+// getKeySet returns the asymmetric signing keys, nil if no signing key is configured
+func getKeySet(config configs.Config) (*jwks.KeySet, error) {
+	keySetOnce.Do(func() {
+		keySet, keySetErr = loadKeySet(config.Auth)
+	})
+	return keySet, keySetErr
+}
+
+func loadKeySet(auth configs.Auth) (*jwks.KeySet, error) {
+	if len(auth.SigningKeys) == 0 {
+		return nil, nil
+	}
+	var keys []*jwks.Key
+	for _, k := range auth.SigningKeys {
+		var privatePEM, publicPEM []byte
+		var err error
+		if k.PrivateKeyFile != "" {
+			privatePEM, err = os.ReadFile(k.PrivateKeyFile)
+			if err != nil {
+				return nil, fmt.Errorf("read private key of kid %s failed: %w", k.Kid, err)
+			}
+		}
+		if k.PublicKeyFile != "" {
+			publicPEM, err = os.ReadFile(k.PublicKeyFile)
+			if err != nil {
+				return nil, fmt.Errorf("read public key of kid %s failed: %w", k.Kid, err)
+			}
+		}
+		key, err := jwks.ParseKey(k.Kid, k.Algorithm, privatePEM, publicPEM)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	return jwks.NewKeySet(auth.ActiveKid, keys...)
+}
+
+// GetJWKSHandler returns the handler of /.well-known/jwks.json, publishing the public signing keys.
+// The key set is empty while tokens are signed with the HS256 AccessSecret.
+func GetJWKSHandler(config configs.Config) app.HandlerFunc {
+	return func(ctx context.Context, c *app.RequestContext) {
+		set, err := getKeySet(config)
+		if err != nil {
+			hlog.Error(err, "load jwt signing keys error")
+			c.JSON(http.StatusInternalServerError, map[string]any{
+				"code":    http.StatusInternalServerError,
+				"message": "signing keys unavailable",
+			})
+			return
+		}
+		res := jwks.JWKSet{Keys: []jwks.JWK{}}
+		if set != nil {
+			res = set.JWKS()
+		}
+		c.Header("Cache-Control", "public, max-age=3600")
+		c.JSON(http.StatusOK, res)
+	}
+}
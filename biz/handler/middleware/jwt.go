@@ -10,19 +10,26 @@ package middleware
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"formulago/biz/domain/admin"
+	"net/http"
+	"slices"
 	"strconv"
 	"time"
 
 	logic "formulago/biz/logic/admin"
 	"formulago/configs"
 	Data "formulago/data"
+	"formulago/pkg/tenant"
 	"formulago/pkg/times"
+	"formulago/pkg/useragent"
 
 	"github.com/casbin/casbin/v3"
 	"github.com/cloudwego/hertz/pkg/app"
 	"github.com/cloudwego/hertz/pkg/common/hlog"
+	gojwt "github.com/golang-jwt/jwt/v4"
+	"github.com/google/uuid"
 	"github.com/hertz-contrib/jwt"
 	"github.com/spf13/cast"
 )
@@ -32,6 +39,8 @@ type jwtLogin struct {
 	Password  string `form:"password,required" json:"password,required"`   //lint:ignore SA5008 ignoreCheck
 	Captcha   string `form:"captcha,required" json:"captcha,required"`     //lint:ignore SA5008 ignoreCheck
 	CaptchaID string `form:"captchaID,required" json:"captchaId,required"` //lint:ignore SA5008 ignoreCheck
+	// Device optional device label, taken from the User-Agent if empty
+	Device string `form:"device" json:"device"`
 }
 
 // jwt identityKey
@@ -40,6 +49,21 @@ var (
 	jwtMiddleware = new(jwt.HertzJWTMiddleware)
 )
 
+// errTwoFactorRequired is returned by the Authenticator when the login needs the second step
+var errTwoFactorRequired = errors.New("two-factor authentication required")
+
+// twoFactorEnrollPaths are the only paths allowed before enrolling, when the role forces two-factor
+var twoFactorEnrollPaths = map[string]struct{}{
+	"/api/admin/user/2fa/enroll":  {},
+	"/api/admin/user/2fa/confirm": {},
+}
+
+// passwordChangePaths are the only paths allowed while the password is expired
+var passwordChangePaths = map[string]struct{}{
+	"/api/admin/user/change-password": {},
+	"/api/admin/user/info":            {},
+}
+
 // GetJWTMiddleware returns a new JWT middleware.
 func GetJWTMiddleware(c configs.Config, d *Data.Data, e *casbin.Enforcer) *jwt.HertzJWTMiddleware {
 	jwtMiddleware, err := newJWT(c, d, e)
@@ -49,13 +73,118 @@ func GetJWTMiddleware(c configs.Config, d *Data.Data, e *casbin.Enforcer) *jwt.H
 	return jwtMiddleware
 }
 
+// GetLoginHandler returns the login handler, which signs the token by the active signing key if configured.
+func GetLoginHandler(config configs.Config, d *Data.Data, e *casbin.Enforcer) app.HandlerFunc {
+	jwtMiddleware := GetJWTMiddleware(config, d, e)
+	return func(ctx context.Context, c *app.RequestContext) {
+		data, err := jwtMiddleware.Authenticator(ctx, c)
+		// the second step records the login waiting for the two-factor code
+		if event, ok := c.Get(loginEventKey); ok && !errors.Is(err, errTwoFactorRequired) {
+			recordLogin(ctx, c, config, d, event.(*admin.LoginEventInfo), err)
+		}
+		if errors.Is(err, errTwoFactorRequired) {
+			// first step passed, the client posts the code with the mfa token to /api/login/2fa
+			c.JSON(http.StatusOK, map[string]any{
+				"code":         http.StatusOK,
+				"mfa_required": true,
+				"mfa_token":    c.GetString("mfaToken"),
+			})
+			return
+		}
+		if errors.Is(err, admin.ErrLoginLocked) {
+			unauthorized(ctx, c, jwtMiddleware, http.StatusTooManyRequests, err.Error())
+			return
+		}
+		if err != nil {
+			unauthorized(ctx, c, jwtMiddleware, http.StatusUnauthorized, jwtMiddleware.HTTPStatusMessageFunc(err, ctx, c))
+			return
+		}
+		tokenString, expire, err := generateToken(config, jwtMiddleware, data)
+		if err != nil {
+			hlog.Error(err, "jwtLogin error, generate token error")
+			unauthorized(ctx, c, jwtMiddleware, http.StatusUnauthorized, jwtMiddleware.HTTPStatusMessageFunc(jwt.ErrFailedTokenCreation, ctx, c))
+			return
+		}
+		jwtMiddleware.LoginResponse(ctx, c, http.StatusOK, tokenString, expire)
+	}
+}
+
+// GetOptionalAuthMiddleware returns the middleware of the public routes that also serve the signed-in users,
+// e.g. the oauth callback completing the link of an account. A valid bearer JWT sets the user,
+// an invalid one is rejected, and the requests without one pass anonymously.
+// Impersonation tokens never set the user, an account is only linked by the user in person.
+func GetOptionalAuthMiddleware(config configs.Config, db *Data.Data, enforcer *casbin.Enforcer) app.HandlerFunc {
+	jwtMiddleware := GetJWTMiddleware(config, db, enforcer)
+	return func(ctx context.Context, c *app.RequestContext) {
+		if len(c.GetHeader("Authorization")) == 0 {
+			c.Next(ctx)
+			return
+		}
+		claims, err := jwtMiddleware.GetClaimsFromJWT(ctx, c)
+		if err != nil {
+			unauthorized(ctx, c, jwtMiddleware, http.StatusUnauthorized, jwtMiddleware.HTTPStatusMessageFunc(err, ctx, c))
+			return
+		}
+		payloadMap, ok := claims[identityKey].(map[string]any)
+		if !ok || !sessionValid(ctx, db, enforcer, payloadMap) {
+			unauthorized(ctx, c, jwtMiddleware, http.StatusUnauthorized, jwtMiddleware.HTTPStatusMessageFunc(jwt.ErrForbidden, ctx, c))
+			return
+		}
+		if _, ok := payloadMap["impersonator"]; !ok {
+			c.Set("userID", payloadMap["userID"])
+			c.Set("jti", payloadMap["jti"])
+			c.Set("tenantID", payloadMap["tenantID"])
+		}
+		c.Next(ctx)
+	}
+}
+
+// sessionValid checks the user of the token is active with the current token version,
+// the session of the token is still active, and so is the tenant
+func sessionValid(ctx context.Context, db *Data.Data, enforcer *casbin.Enforcer, payloadMap map[string]any) bool {
+	userID, _ := payloadMap["userID"].(string)
+	tokenVersion, err := logic.NewUser(db).TokenVersion(ctx, cast.ToUint64(userID))
+	if err != nil {
+		hlog.Info("user is not active, userID: ", userID, " err: ", err)
+		return false
+	}
+	if payloadMap["tokenVersion"] != strconv.FormatUint(tokenVersion, 10) {
+		hlog.Info("token version is outdated, userID: ", userID)
+		return false
+	}
+
+	jti, _ := payloadMap["jti"].(string)
+	if !logic.NewToken(db).IsActive(ctx, jti) {
+		hlog.Info("session is revoked or expired, jti: ", jti)
+		return false
+	}
+
+	tenantID := tenantID(payloadMap["tenantID"])
+	active, err := logic.NewTenant(db, enforcer).IsActive(ctx, tenantID)
+	if err != nil || !active {
+		hlog.Info("tenant is not active, tenantID: ", tenantID, " err: ", err)
+		return false
+	}
+	return true
+}
+
 func newJWT(config configs.Config, db *Data.Data, enforcer *casbin.Enforcer) (jwtMiddleware *jwt.HertzJWTMiddleware, err error) {
+	// verify by kid if asymmetric signing keys are configured, otherwise HS256 with AccessSecret
+	set, err := getKeySet(config)
+	if err != nil {
+		return nil, err
+	}
+	var keyFunc func(token *gojwt.Token) (any, error)
+	if set != nil {
+		keyFunc = set.KeyFunc
+	}
+
 	// the jwt middleware
 	jwtMiddleware, err = jwt.New(&jwt.HertzJWTMiddleware{
 		Realm:       "formulago",
 		Key:         []byte(config.Auth.AccessSecret),
+		KeyFunc:     keyFunc,
 		Timeout:     time.Duration(config.Auth.AccessExpire) * time.Second,
-		MaxRefresh:  time.Hour,
 		IdentityKey: identityKey,
 		PayloadFunc: func(data any) jwt.MapClaims {
 			// take map which have roleID, userID as Payload
@@ -66,6 +195,15 @@ func newJWT(config configs.Config, db *Data.Data, enforcer *casbin.Enforcer) (jw
 			}
 			return jwt.MapClaims{}
 		},
+		// an impersonation token lives shorter than the tokens of the logins
+		TimeoutFunc: func(claims gojwt.MapClaims) time.Duration {
+			if payloadMap, ok := claims[identityKey].(map[string]any); ok {
+				if _, ok := payloadMap["impersonator"]; ok {
+					return impersonationExpire(config)
+				}
+			}
+			return time.Duration(config.Auth.AccessExpire) * time.Second
+		},
 		IdentityHandler: func(ctx context.Context, c *app.RequestContext) any {
 			claims := jwt.ExtractClaims(ctx, c)
 			payloadMap, ok := claims[identityKey].(map[string]any)
@@ -73,35 +211,92 @@ func newJWT(config configs.Config, db *Data.Data, enforcer *casbin.Enforcer) (jw
 				hlog.Error("get payloadMap error", "claims data:", claims[identityKey])
 				return nil
 			}
-			// take roleID, userID from PayloadMap
+			// take roleID, userID and tenantID from PayloadMap
 			c.Set("roleID", payloadMap["roleID"])
 			c.Set("userID", payloadMap["userID"])
+			c.Set("jti", payloadMap["jti"])
+			c.Set("tenantID", payloadMap["tenantID"])
+			// the admin logged in as the user
+			if impersonator, ok := payloadMap["impersonator"]; ok {
+				c.Set("impersonator", impersonator)
+				c.Set("impersonatorJti", payloadMap["impersonatorJti"])
+			}
 			return payloadMap
 		},
 		Authenticator: func(ctx context.Context, c *app.RequestContext) (any, error) {
 			oauthLogin := ctx.Value("OAuthKey") == config.Auth.OAuthKey
+			passkeyLogin := ctx.Value(webauthnLoginKey{}) != nil
+			var err error
 			res := new(admin.LoginResp)
-			if !oauthLogin {
+			source := "core"
+			var device string
+			event := new(admin.LoginEventInfo)
+			c.Set(loginEventKey, event)
+			if passkeyLogin {
+				event.Method = admin.LoginMethodWebauthn
+				// passkey jwtLogin, the assertion is verified against the stored credentials
+				var loginVal webauthnLogin
+				if err := json.Unmarshal(c.Request.Body(), &loginVal); err != nil {
+					return nil, err
+				}
+				if loginVal.SessionID == "" || len(loginVal.Credential) == 0 {
+					return nil, errors.New("session_id and credential are required")
+				}
+				device = loginVal.Device
+				event.Device = device
+				res, err = logic.NewWebauthn(db, config).FinishLogin(ctx, loginVal.SessionID, string(loginVal.Credential))
+				if err != nil {
+					hlog.Error(err, "passkey jwtLogin error")
+					return nil, err
+				}
+				source = "webauthn"
+			} else if !oauthLogin {
 				// normal jwtLogin
+				event.Method = admin.LoginMethodPassword
 				var loginVal jwtLogin
 				if err := c.BindAndValidate(&loginVal); err != nil {
 					return "", err
 				}
+				event.Username = loginVal.Username
+				event.Device = loginVal.Device
 				// verify captcha while IsProd is true
 				if config.IsProd {
-					valid := logic.CaptchaStore.Verify(loginVal.CaptchaID, loginVal.Captcha, true)
+					valid := logic.NewCaptcha().Verify(loginVal.CaptchaID, loginVal.Captcha)
 					if !valid {
 						return nil, errors.New("invalid captcha")
 					}
 				}
+				device = loginVal.Device
 				// Login
 				username := loginVal.Username
 				password := loginVal.Password
-				res, err = logic.NewLogin(db).Login(ctx, username, password)
+				// failures per username and IP slow down and then lock the password guessing
+				ip := c.ClientIP()
+				loginLimit := logic.NewLoginLimit(db, config)
+				var delay time.Duration
+				delay, err = loginLimit.Check(ctx, username, ip)
+				if err != nil {
+					hlog.Warn("jwtLogin rejected, username: ", username, " ip: ", ip, " err: ", err)
+					return nil, err
+				}
+				if delay > 0 {
+					select {
+					case <-time.After(delay):
+					case <-ctx.Done():
+						return nil, ctx.Err()
+					}
+				}
+				res, err = logic.NewLogin(db, config).Login(ctx, username, password)
 				if err != nil {
 					hlog.Error(err, "jwtLogin error")
+					if err := loginLimit.Fail(ctx, username, ip); err != nil {
+						hlog.Error(err, "jwtLogin error, count login failure error")
+					}
 					return nil, err
 				}
+				if err := loginLimit.Succeed(ctx, username); err != nil {
+					hlog.Error(err, "jwtLogin error, clear login failures error")
+				}
 			} else {
 				// oauth2.0 jwtLogin
 				providerAny, ok := c.Get("provider")
@@ -113,89 +308,140 @@ func newJWT(config configs.Config, db *Data.Data, enforcer *casbin.Enforcer) (jw
 					return nil, errors.New("invalid provider")
 				}
 
-				credentialAny, ok := c.Get("credential")
+				event.Method = provider
+
+				subjectAny, ok := c.Get("subject")
 				if !ok {
-					return nil, errors.New("invalid credential")
+					return nil, errors.New("invalid subject")
 				}
-				credential, ok := credentialAny.(string)
+				subject, ok := subjectAny.(string)
 				if !ok {
-					return nil, errors.New("invalid credential")
+					return nil, errors.New("invalid subject")
 				}
-				res, err = logic.NewLogin(db).LoginByOAuth(ctx, provider, credential)
+				res, err = logic.NewLogin(db, config).LoginByOAuth(ctx, provider, subject)
 				if err != nil {
 					hlog.Error(err, "oauth jwtLogin error")
 					return nil, err
 				}
+				source = provider
 			}
 
 			// jwtLogin success
-			// store token
-			var tokenInfo admin.TokenInfo
-			tokenInfo.UserID = res.UserID
-			tokenInfo.UserName = res.Username
-			tokenInfo.ExpiredAt = time.Now().Add(time.Duration(config.Auth.AccessExpire) * time.Second).Format(times.TimeFormat)
-			err = logic.NewToken(db).Create(ctx, &tokenInfo)
+			event.UserID = res.UserID
+			event.Username = res.Username
+			// users with two-factor enabled pass the second step before the session is issued,
+			// a passkey always verifies the user (PIN or biometrics) and counts as both factors
+			twoFactorStatus, err := logic.NewTwoFactor(db, config).Status(ctx, res.UserID, res.RoleID)
 			if err != nil {
-				hlog.Error(err, "jwtLogin error, store token error")
+				hlog.Error(err, "jwtLogin error, get two-factor status error")
 				return nil, err
 			}
+			if twoFactorStatus.Enabled && !passkeyLogin {
+				mfaToken, err := logic.NewTwoFactor(db, config).CreateChallenge(ctx, &admin.TwoFactorChallenge{
+					Login:  *res,
+					Source: source,
+					Device: device,
+				})
+				if err != nil {
+					hlog.Error(err, "jwtLogin error, create two-factor challenge error")
+					return nil, err
+				}
+				c.Set("mfaToken", mfaToken)
+				return nil, errTwoFactorRequired
+			}
 
-			// return the payload
-			// take str roleID, userID into PayloadMap
-			payloadMap := make(map[string]any)
-			payloadMap["roleID"] = strconv.Itoa(int(res.RoleID))
-			payloadMap["userID"] = strconv.Itoa(int(res.UserID))
-			return payloadMap, nil
+			return issueSession(ctx, c, config, db, res, source, device)
 		},
 		Authorizator: func(data any, ctx context.Context, c *app.RequestContext) bool {
 			// get the path
 			obj := string(c.URI().Path())
 			// get the method
 			act := string(c.Method())
-			// get the roleID
 			payloadMap, ok := data.(map[string]any)
 			if !ok {
 				hlog.Error("get payloadMap error", "claims data:", data)
 				return false
 			}
-			roleID := payloadMap["roleID"].(string)
-			userID := payloadMap["userID"].(string)
+			userID, _ := payloadMap["userID"].(string)
+			if !sessionValid(ctx, db, enforcer, payloadMap) {
+				return false
+			}
+			tenantID := tenantID(payloadMap["tenantID"])
 
-			// check token is valid
-			userIDInt, err := strconv.Atoi(userID)
+			// the roles of the user are checked on every request, not taken from the token,
+			// so assigning, removing or disabling a role takes effect at once
+			roles, err := logic.NewUser(db).ActiveRoles(ctx, cast.ToUint64(userID))
 			if err != nil {
-				hlog.Error("get payloadMap error", err)
+				hlog.Error(err, "get roles of the user error")
 				return false
 			}
-			existToken := logic.NewToken(db).IsExistByUserID(ctx, uint64(userIDInt))
-			if !existToken {
+			if len(roles) == 0 {
+				hlog.Info("the user has no active role, userID: ", userID)
 				return false
 			}
 
-			// check the role status
-			roleInfo, err := logic.NewRole(db).RoleInfoByID(ctx, cast.ToUint64(roleID))
-			// if the role is not exist or the role is not active, return false
+			// an impersonation is bounded by the impersonation role, the password and two-factor state belong to the user
+			if _, ok := payloadMap["impersonator"]; ok {
+				return authorizeImpersonation(ctx, config, db, enforcer, payloadMap, roles, obj, act)
+			}
+
+			// an expired password must be changed first
+			passwordExpired, err := logic.NewUser(db).PasswordExpired(ctx, cast.ToUint64(userID))
 			if err != nil {
-				hlog.Error(err, "role is not exist")
+				hlog.Error(err, "get password expiry error")
 				return false
 			}
-			if roleInfo.Status != 1 {
-				hlog.Error("role cache is not a valid *ent.Role or the role is not active")
-				return false
+			if passwordExpired {
+				_, allowed := passwordChangePaths[obj]
+				if !allowed {
+					hlog.Info("password change required, userID: ", userID, " path: ", obj)
+				}
+				return allowed
 			}
 
-			sub := roleID
-			// check the permission
-			pass, err := enforcer.Enforce(sub, obj, act)
-			if err != nil {
-				hlog.Error("casbin err,  role id: ", roleID, " path: ", obj, " method: ", act, " pass: ", pass, " err: ", err.Error())
-				return false
+			// one of the roles forces two-factor, users not enrolled yet may only enroll
+			if i := slices.IndexFunc(roles, func(r *admin.RoleInfo) bool { return r.ForceTwoFactor }); i >= 0 {
+				twoFactorStatus, err := logic.NewTwoFactor(db, config).Status(ctx, cast.ToUint64(userID), roles[i].ID)
+				if err != nil {
+					hlog.Error(err, "get two-factor status error")
+					return false
+				}
+				if !twoFactorStatus.Enabled {
+					_, allowed := twoFactorEnrollPaths[obj]
+					if !allowed {
+						hlog.Info("two-factor enrollment required, userID: ", userID, " path: ", obj)
+					}
+					return allowed
+				}
 			}
-			if !pass {
-				hlog.Info("casbin forbid role id: ", roleID, " path: ", obj, " method: ", act, " pass: ", pass)
+
+			// check the permission within the tenant, one of the roles allowing it is enough
+			return enforceRoles(enforcer, roles, tenant.Domain(tenantID), obj, act)
+		},
+		LoginResponse: func(ctx context.Context, c *app.RequestContext, code int, token string, expire time.Time) {
+			c.JSON(http.StatusOK, map[string]any{
+				"code":          http.StatusOK,
+				"token":         token,
+				"expire":        expire.Format(time.RFC3339),
+				"refresh_token": c.GetString("refreshToken"),
+				// the client shows the change password form, other requests are forbidden until then
+				"password_expired": c.GetBool("passwordExpired"),
+			})
+		},
+		LogoutResponse: func(ctx context.Context, c *app.RequestContext, code int) {
+			// revoke the session of the current token
+			if claims, err := jwtMiddleware.GetClaimsFromJWT(ctx, c); err == nil {
+				if payloadMap, ok := claims[identityKey].(map[string]any); ok {
+					if jti, ok := payloadMap["jti"].(string); ok {
+						if err := logic.NewToken(db).Revoke(ctx, jti); err != nil {
+							hlog.Error(err, "logout revoke session error")
+						}
+					}
+				}
 			}
-			hlog.Info("casbin allow role id: ", roleID, " path: ", obj, " method: ", act, " pass: ", pass)
-			return pass
+			c.JSON(code, map[string]any{
+				"code": code,
+			})
 		},
 		Unauthorized: func(ctx context.Context, c *app.RequestContext, code int, message string) {
 			c.JSON(code, map[string]any{
@@ -207,3 +453,100 @@ func newJWT(config configs.Config, db *Data.Data, enforcer *casbin.Enforcer) (jw
 
 	return
 }
+
+// generateToken signs the payload with the active signing key, or falls back to the HS256 token of the middleware
+func generateToken(config configs.Config, jwtMiddleware *jwt.HertzJWTMiddleware, data any) (string, time.Time, error) {
+	set, err := getKeySet(config)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	if set == nil {
+		return jwtMiddleware.TokenGenerator(data)
+	}
+
+	claims := gojwt.MapClaims{}
+	for key, value := range jwtMiddleware.PayloadFunc(data) {
+		claims[key] = value
+	}
+	expire := jwtMiddleware.TimeFunc().UTC().Add(jwtMiddleware.TimeoutFunc(claims))
+	claims["exp"] = expire.Unix()
+	claims["orig_iat"] = jwtMiddleware.TimeFunc().Unix()
+	tokenString, err := set.Sign(claims)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	return tokenString, expire, nil
+}
+
+// unauthorized aborts the request like the jwt middleware does
+func unauthorized(ctx context.Context, c *app.RequestContext, jwtMiddleware *jwt.HertzJWTMiddleware, code int, message string) {
+	c.Header("WWW-Authenticate", "JWT realm="+jwtMiddleware.Realm)
+	c.Abort()
+	jwtMiddleware.Unauthorized(ctx, c, code, message)
+}
+
+// issueSession stores a new session of the device and its refresh token, and returns the jwt payload
+func issueSession(ctx context.Context, c *app.RequestContext, config configs.Config, db *Data.Data, res *admin.LoginResp, source, device string) (map[string]any, error) {
+	userAgent := string(c.UserAgent())
+	if device == "" {
+		device = useragent.Device(userAgent)
+	}
+	now := time.Now()
+	var tokenInfo admin.TokenInfo
+	tokenInfo.UserID = res.UserID
+	tokenInfo.UserName = res.Username
+	tokenInfo.JTI = uuid.NewString()
+	tokenInfo.Source = source
+	tokenInfo.Device = device
+	tokenInfo.IP = c.ClientIP()
+	tokenInfo.UserAgent = userAgent
+	tokenInfo.IssuedAt = now.Format(times.TimeFormat)
+	tokenInfo.ExpiredAt = now.Add(time.Duration(config.Auth.AccessExpire) * time.Second).Format(times.TimeFormat)
+	tokenInfo.RoleID = res.RoleID
+	err := logic.NewToken(db).Create(ctx, &tokenInfo)
+	if err != nil {
+		hlog.Error(err, "jwtLogin error, store token error")
+		return nil, err
+	}
+	// issue the refresh token, the session jti is its family
+	refreshToken, err := logic.NewRefreshToken(db, config).Create(ctx, res.UserID, tokenInfo.JTI)
+	if err != nil {
+		hlog.Error(err, "jwtLogin error, store refresh token error")
+		return nil, err
+	}
+	c.Set("refreshToken", refreshToken)
+	passwordExpired, err := logic.NewUser(db).PasswordExpired(ctx, res.UserID)
+	if err != nil {
+		hlog.Error(err, "jwtLogin error, get password expiry error")
+		return nil, err
+	}
+	c.Set("passwordExpired", passwordExpired)
+
+	// return the payload
+	// take str roleID, userID, session jti, token version and tenantID into PayloadMap
+	payloadMap := make(map[string]any)
+	payloadMap["roleID"] = strconv.Itoa(int(res.RoleID))
+	payloadMap["userID"] = strconv.Itoa(int(res.UserID))
+	payloadMap["jti"] = tokenInfo.JTI
+	payloadMap["tokenVersion"] = strconv.FormatUint(res.TokenVersion, 10)
+	payloadMap["tenantID"] = strconv.FormatUint(res.TenantID, 10)
+	return payloadMap, nil
+}
+
+// enforceRoles checks the request against the roles within the domain, it passes if one of the roles allows it
+func enforceRoles(enforcer *casbin.Enforcer, roles []*admin.RoleInfo, dom, obj, act string) bool {
+	for _, v := range roles {
+		sub := strconv.FormatUint(v.ID, 10)
+		pass, err := enforcer.Enforce(sub, dom, obj, act)
+		if err != nil {
+			hlog.Error("casbin err,  role id: ", sub, " path: ", obj, " method: ", act, " pass: ", pass, " err: ", err.Error())
+			return false
+		}
+		if pass {
+			hlog.Info("casbin allow role id: ", sub, " path: ", obj, " method: ", act, " pass: ", pass)
+			return true
+		}
+	}
+	hlog.Info("casbin forbid roles: ", len(roles), " path: ", obj, " method: ", act)
+	return false
+}
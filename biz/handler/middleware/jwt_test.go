@@ -0,0 +1,151 @@
+/*
+ * Copyright 2023 FormulaGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ */
+
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"formulago/biz/domain/admin"
+	logic "formulago/biz/logic/admin"
+	"formulago/pkg/tenant"
+	"formulago/pkg/times"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/app/server"
+	"github.com/cloudwego/hertz/pkg/common/ut"
+)
+
+func TestAuthorizator_tokenVersion(t *testing.T) {
+	ctx := context.Background()
+	cluster := newTestCluster(t, 2)
+	enforcer := newTestEnforcer(t)
+	if _, err := cluster[0].DBClient.Role.Create().SetName("admin").SetValue("admin").SetTenantID(1).Save(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := enforcer.AddPolicy("1", tenant.Domain(1), "/api/admin/test", "GET"); err != nil {
+		t.Fatal(err)
+	}
+	userEnt := newTestUser(t, cluster[0], "alice", 1)
+	userID := strconv.FormatUint(userEnt.ID, 10)
+
+	login := func(jti string) {
+		err := logic.NewToken(cluster[0]).Create(ctx, &admin.TokenInfo{
+			UserID:    userEnt.ID,
+			JTI:       jti,
+			Source:    "test",
+			ExpiredAt: time.Now().Add(time.Hour).Format(times.TimeFormat),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	authorize := func(instance int, tokenVersion, jti string) bool {
+		payload := map[string]any{"userID": userID, "tokenVersion": tokenVersion, "jti": jti, "tenantID": "1"}
+		mw := newTestJWT(t, cluster[instance], enforcer)
+		return mw.Authorizator(payload, ctx, newTestRequest("GET", "/api/admin/test"))
+	}
+
+	login("session-1")
+	if !authorize(1, "0", "session-1") {
+		t.Fatal("the current token version should be allowed")
+	}
+	if authorize(1, "1", "session-1") {
+		t.Fatal("a token version other than the current one should be denied")
+	}
+
+	// the version is bumped on the first instance, the second one has the old version cached
+	if err := logic.NewUser(cluster[0]).UpdateUserStatus(ctx, userEnt.ID, 1); err != nil {
+		t.Fatal(err)
+	}
+	login("session-2")
+
+	tests := []struct {
+		name         string
+		instance     int
+		tokenVersion string
+		want         bool
+	}{
+		{name: "outdated version on the bumping instance", instance: 0, tokenVersion: "0", want: false},
+		{name: "outdated version on the other instance", instance: 1, tokenVersion: "0", want: false},
+		{name: "current version on the other instance", instance: 1, tokenVersion: "1", want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := authorize(tt.instance, tt.tokenVersion, "session-2"); got != tt.want {
+				t.Errorf("Authorizator() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOptionalAuthMiddleware(t *testing.T) {
+	ctx := context.Background()
+	d := newTestCluster(t, 1)[0]
+	enforcer := newTestEnforcer(t)
+	mw := newTestJWT(t, d, enforcer)
+	if _, err := d.DBClient.Role.Create().SetName("admin").SetValue("admin").SetTenantID(1).Save(ctx); err != nil {
+		t.Fatal(err)
+	}
+	userEnt := newTestUser(t, d, "alice", 1)
+	userID := strconv.FormatUint(userEnt.ID, 10)
+	err := logic.NewToken(d).Create(ctx, &admin.TokenInfo{
+		UserID:    userEnt.ID,
+		JTI:       "session-1",
+		Source:    "test",
+		ExpiredAt: time.Now().Add(time.Hour).Format(times.TimeFormat),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	token := func(payload map[string]any) string {
+		tokenString, _, err := mw.TokenGenerator(payload)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return "Bearer " + tokenString
+	}
+	session := map[string]any{"userID": userID, "tokenVersion": "0", "jti": "session-1", "tenantID": "1"}
+	impersonation := map[string]any{"userID": userID, "tokenVersion": "0", "jti": "session-1", "tenantID": "1",
+		"impersonator": "100", "impersonatorJti": "admin-session"}
+	revoked := map[string]any{"userID": userID, "tokenVersion": "0", "jti": "session-2", "tenantID": "1"}
+
+	h := server.Default()
+	h.GET("/callback", GetOptionalAuthMiddleware(newTestConfig(), d, enforcer), func(ctx context.Context, c *app.RequestContext) {
+		c.String(http.StatusOK, c.GetString("userID"))
+	})
+	tests := []struct {
+		name          string
+		authorization string
+		wantStatus    int
+		wantUserID    string
+	}{
+		{name: "anonymous", authorization: "", wantStatus: http.StatusOK, wantUserID: ""},
+		{name: "signed in", authorization: token(session), wantStatus: http.StatusOK, wantUserID: userID},
+		{name: "impersonation", authorization: token(impersonation), wantStatus: http.StatusOK, wantUserID: ""},
+		{name: "revoked session", authorization: token(revoked), wantStatus: http.StatusUnauthorized},
+		{name: "invalid token", authorization: "Bearer invalid", wantStatus: http.StatusUnauthorized},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var headers []ut.Header
+			if tt.authorization != "" {
+				headers = append(headers, ut.Header{Key: "Authorization", Value: tt.authorization})
+			}
+			resp := ut.PerformRequest(h.Engine, http.MethodGet, "/callback", nil, headers...).Result()
+			if resp.StatusCode() != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", resp.StatusCode(), tt.wantStatus)
+			}
+			if tt.wantStatus == http.StatusOK && string(resp.Body()) != tt.wantUserID {
+				t.Errorf("user = %q, want %q", resp.Body(), tt.wantUserID)
+			}
+		})
+	}
+}
@@ -0,0 +1,47 @@
+/*
+ * Copyright 2023 FormulaGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ */
+
+package middleware
+
+import (
+	"context"
+
+	"formulago/biz/domain/admin"
+	logic "formulago/biz/logic/admin"
+	"formulago/configs"
+	Data "formulago/data"
+	"formulago/pkg/useragent"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+)
+
+// loginEventKey the login event of the request, filled by the Authenticator
+const loginEventKey = "loginEvent"
+
+// loginMethod the login method of the session source, the providers are recorded by their name
+func loginMethod(source string) string {
+	if source == "core" {
+		return admin.LoginMethodPassword
+	}
+	return source
+}
+
+// recordLogin stores the login attempt of the request, the login goes on if it cannot be stored
+func recordLogin(ctx context.Context, c *app.RequestContext, config configs.Config, db *Data.Data, event *admin.LoginEventInfo, err error) {
+	event.IP = c.ClientIP()
+	event.UserAgent = string(c.UserAgent())
+	if event.Device == "" {
+		event.Device = useragent.Device(event.UserAgent)
+	}
+	event.Success = err == nil
+	if err != nil {
+		event.Reason = err.Error()
+	}
+	if err := logic.NewLoginEvent(db, config).Record(ctx, event); err != nil {
+		hlog.Error(err, "record login event error")
+	}
+}
@@ -65,8 +65,19 @@ func LogsMiddleware(d *data.Data) app.HandlerFunc {
 			username = userInfo.Username
 		}
 		logs.Operator = username
+		// an impersonation is logged as the admin acting as the user
+		if impersonator, ok := c.Get("impersonator"); ok {
+			impersonatorIDStr, _ := impersonator.(string)
+			impersonatorID, _ := strconv.Atoi(impersonatorIDStr)
+			logs.Operator = "anonymous"
+			logs.ImpersonatedUser = username
+			impersonatorInfo, _ := admin.NewUser(d).UserInfo(ctx, uint64(impersonatorID))
+			if impersonatorInfo != nil {
+				logs.Operator = impersonatorInfo.Username
+			}
+		}
 
-		err := admin.NewLogs(data.Default()).Create(ctx, &logs)
+		err := admin.NewLogs(d).Create(ctx, &logs)
 		if err != nil {
 			hlog.Error(err)
 		}
@@ -0,0 +1,295 @@
+/*
+ * Copyright 2023 FormulaGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ */
+
+package middleware
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"formulago/biz/domain/admin"
+	logic "formulago/biz/logic/admin"
+	"formulago/configs"
+	Data "formulago/data"
+	"formulago/pkg/jwks"
+	"formulago/pkg/oidc"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	gojwt "github.com/golang-jwt/jwt/v4"
+	"github.com/google/uuid"
+)
+
+// oidcKeySet returns the signing keys of the provider, the tokens must be verifiable by the clients with the JWKS,
+// so the provider is unavailable while tokens are signed with the HS256 AccessSecret
+func oidcKeySet(c *app.RequestContext, config configs.Config) (*jwks.KeySet, bool) {
+	if !config.OIDC.Enable {
+		c.AbortWithStatus(http.StatusNotFound)
+		return nil, false
+	}
+	set, err := getKeySet(config)
+	if err != nil || set == nil {
+		hlog.Error("oidc provider needs Auth.SigningKeys, load error: ", err)
+		oidcErrorJSON(c, http.StatusServiceUnavailable, &admin.OIDCError{Code: "temporarily_unavailable", Description: "signing keys unavailable"})
+		return nil, false
+	}
+	return set, true
+}
+
+// GetOIDCDiscoveryHandler returns the handler of /.well-known/openid-configuration
+func GetOIDCDiscoveryHandler(config configs.Config) app.HandlerFunc {
+	return func(ctx context.Context, c *app.RequestContext) {
+		set, ok := oidcKeySet(c, config)
+		if !ok {
+			return
+		}
+		c.Header("Cache-Control", "public, max-age=3600")
+		c.JSON(http.StatusOK, oidc.Discovery(config.OIDC.Issuer, set.ActiveAlg()))
+	}
+}
+
+// GetOIDCAuthorizeHandler returns the authorization endpoint, the valid request is sent on to the consent page
+// of the admin console, where the signed-in user answers it through /api/admin/oidc/consent
+func GetOIDCAuthorizeHandler(config configs.Config, db *Data.Data) app.HandlerFunc {
+	return func(ctx context.Context, c *app.RequestContext) {
+		if _, ok := oidcKeySet(c, config); !ok {
+			return
+		}
+		requestID, err := logic.NewOIDC(db, config).Authorize(ctx, &admin.OIDCAuthorizeReq{
+			ResponseType:        c.Query("response_type"),
+			ClientID:            c.Query("client_id"),
+			RedirectURI:         c.Query("redirect_uri"),
+			Scope:               c.Query("scope"),
+			State:               c.Query("state"),
+			Nonce:               c.Query("nonce"),
+			CodeChallenge:       c.Query("code_challenge"),
+			CodeChallengeMethod: c.Query("code_challenge_method"),
+		})
+		if err != nil {
+			var oidcErr *admin.OIDCError
+			if !errors.As(err, &oidcErr) {
+				hlog.Error(err, "oidc authorize error")
+				oidcErrorJSON(c, http.StatusInternalServerError, &admin.OIDCError{Code: "server_error", Description: "internal error"})
+				return
+			}
+			if oidcErr.RedirectURI == "" {
+				oidcErrorJSON(c, http.StatusBadRequest, oidcErr)
+				return
+			}
+			c.Redirect(http.StatusFound, []byte(oidc.AppendQuery(oidcErr.RedirectURI, map[string]string{
+				"error":             oidcErr.Code,
+				"error_description": oidcErr.Description,
+				"state":             oidcErr.State,
+			})))
+			return
+		}
+		consentURL := config.OIDC.ConsentURL
+		sep := "?"
+		if strings.Contains(consentURL, "?") {
+			sep = "&"
+		}
+		c.Redirect(http.StatusFound, []byte(consentURL+sep+"request_id="+url.QueryEscape(requestID)))
+	}
+}
+
+// GetOIDCTokenHandler returns the token endpoint of the authorization_code and client_credentials grants
+func GetOIDCTokenHandler(config configs.Config, db *Data.Data) app.HandlerFunc {
+	return func(ctx context.Context, c *app.RequestContext) {
+		set, ok := oidcKeySet(c, config)
+		if !ok {
+			return
+		}
+		c.Header("Cache-Control", "no-store")
+		c.Header("Pragma", "no-cache")
+
+		req := &admin.OIDCTokenReq{
+			GrantType:    string(c.PostForm("grant_type")),
+			Code:         string(c.PostForm("code")),
+			RedirectURI:  string(c.PostForm("redirect_uri")),
+			CodeVerifier: string(c.PostForm("code_verifier")),
+			ClientID:     string(c.PostForm("client_id")),
+			ClientSecret: string(c.PostForm("client_secret")),
+			Scope:        string(c.PostForm("scope")),
+		}
+		// client_secret_basic, the credentials are form encoded before joining (RFC 6749 2.3.1)
+		if id, secret, ok := basicAuth(string(c.GetHeader("Authorization"))); ok {
+			req.ClientID, req.ClientSecret = id, secret
+		}
+
+		oidcLogic := logic.NewOIDC(db, config)
+		grant, err := oidcLogic.Exchange(ctx, req)
+		if err != nil {
+			var oidcErr *admin.OIDCError
+			if !errors.As(err, &oidcErr) {
+				hlog.Error(err, "oidc token error")
+				oidcErrorJSON(c, http.StatusInternalServerError, &admin.OIDCError{Code: "server_error", Description: "internal error"})
+				return
+			}
+			status := http.StatusBadRequest
+			if oidcErr.Code == "invalid_client" {
+				status = http.StatusUnauthorized
+				c.Header("WWW-Authenticate", `Basic realm="formulago"`)
+			}
+			oidcErrorJSON(c, status, oidcErr)
+			return
+		}
+
+		issuer := strings.TrimSuffix(config.OIDC.Issuer, "/")
+		now := time.Now()
+		expiresIn := int64(config.OIDC.AccessExpire)
+		if expiresIn <= 0 {
+			expiresIn = 3600
+		}
+		subject := grant.ClientID
+		if grant.UserID != 0 {
+			subject = strconv.FormatUint(grant.UserID, 10)
+		}
+		accessClaims := gojwt.MapClaims{
+			"iss":       issuer,
+			"sub":       subject,
+			"aud":       grant.ClientID,
+			"client_id": grant.ClientID,
+			"scope":     strings.Join(grant.Scopes, " "),
+			"iat":       now.Unix(),
+			"exp":       now.Unix() + expiresIn,
+			"jti":       uuid.NewString(),
+		}
+		var userClaims map[string]any
+		if grant.UserID != 0 {
+			userClaims, err = oidcLogic.Claims(ctx, grant.UserID, grant.Scopes)
+			if err != nil {
+				hlog.Error(err, "oidc token error, get user claims error")
+				oidcErrorJSON(c, http.StatusBadRequest, &admin.OIDCError{Code: "invalid_grant", Description: "the user is not active"})
+				return
+			}
+			if roles, ok := userClaims["roles"]; ok {
+				accessClaims["roles"] = roles
+			}
+		}
+		accessToken, err := set.Sign(accessClaims)
+		if err != nil {
+			hlog.Error(err, "oidc token error, sign access token error")
+			oidcErrorJSON(c, http.StatusInternalServerError, &admin.OIDCError{Code: "server_error", Description: "internal error"})
+			return
+		}
+		res := map[string]any{
+			"access_token": accessToken,
+			"token_type":   "Bearer",
+			"expires_in":   expiresIn,
+			"scope":        strings.Join(grant.Scopes, " "),
+		}
+
+		if grant.UserID != 0 && slices.Contains(grant.Scopes, oidc.ScopeOpenID) {
+			idClaims := gojwt.MapClaims{}
+			for k, v := range userClaims {
+				idClaims[k] = v
+			}
+			idClaims["iss"] = issuer
+			idClaims["sub"] = subject
+			idClaims["aud"] = grant.ClientID
+			idClaims["iat"] = now.Unix()
+			idClaims["exp"] = now.Unix() + expiresIn
+			if grant.Nonce != "" {
+				idClaims["nonce"] = grant.Nonce
+			}
+			idToken, err := set.Sign(idClaims)
+			if err != nil {
+				hlog.Error(err, "oidc token error, sign id token error")
+				oidcErrorJSON(c, http.StatusInternalServerError, &admin.OIDCError{Code: "server_error", Description: "internal error"})
+				return
+			}
+			res["id_token"] = idToken
+		}
+		c.JSON(http.StatusOK, res)
+	}
+}
+
+// GetOIDCUserinfoHandler returns the userinfo endpoint, it accepts only access tokens issued by the token endpoint
+func GetOIDCUserinfoHandler(config configs.Config, db *Data.Data) app.HandlerFunc {
+	return func(ctx context.Context, c *app.RequestContext) {
+		set, ok := oidcKeySet(c, config)
+		if !ok {
+			return
+		}
+		invalidToken := func(description string) {
+			c.Header("WWW-Authenticate", `Bearer error="invalid_token", error_description="`+description+`"`)
+			oidcErrorJSON(c, http.StatusUnauthorized, &admin.OIDCError{Code: "invalid_token", Description: description})
+		}
+		tokenString, found := strings.CutPrefix(string(c.GetHeader("Authorization")), "Bearer ")
+		if !found {
+			invalidToken("bearer access token is required")
+			return
+		}
+		token, err := gojwt.Parse(tokenString, set.KeyFunc)
+		if err != nil || !token.Valid {
+			invalidToken("the access token is invalid or expired")
+			return
+		}
+		claims, _ := token.Claims.(gojwt.MapClaims)
+		// the session tokens of the admin console have neither the issuer nor the client_id
+		clientID, _ := claims["client_id"].(string)
+		if !claims.VerifyIssuer(strings.TrimSuffix(config.OIDC.Issuer, "/"), true) || clientID == "" {
+			invalidToken("the access token is not issued by the provider")
+			return
+		}
+		scope, _ := claims["scope"].(string)
+		scopes := oidc.ParseScopes(scope)
+		subject, _ := claims["sub"].(string)
+		userID, err := strconv.ParseUint(subject, 10, 64)
+		if err != nil || !slices.Contains(scopes, oidc.ScopeOpenID) {
+			c.Header("WWW-Authenticate", `Bearer error="insufficient_scope"`)
+			oidcErrorJSON(c, http.StatusForbidden, &admin.OIDCError{Code: "insufficient_scope", Description: "the openid scope is required"})
+			return
+		}
+		userClaims, err := logic.NewOIDC(db, config).Claims(ctx, userID, scopes)
+		if err != nil {
+			hlog.Info("oidc userinfo, get user claims error: ", err)
+			invalidToken("the user is not active")
+			return
+		}
+		userClaims["sub"] = subject
+		c.Header("Cache-Control", "no-store")
+		c.JSON(http.StatusOK, userClaims)
+	}
+}
+
+// basicAuth parses the client credentials of the HTTP Basic authorization header
+func basicAuth(header string) (clientID, secret string, ok bool) {
+	encoded, found := strings.CutPrefix(header, "Basic ")
+	if !found {
+		return "", "", false
+	}
+	decoded, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return "", "", false
+	}
+	id, pass, found := strings.Cut(string(decoded), ":")
+	if !found {
+		return "", "", false
+	}
+	if clientID, err = url.QueryUnescape(id); err != nil {
+		return "", "", false
+	}
+	if secret, err = url.QueryUnescape(pass); err != nil {
+		return "", "", false
+	}
+	return clientID, secret, true
+}
+
+// oidcErrorJSON writes the OAuth 2.0 error response
+func oidcErrorJSON(c *app.RequestContext, status int, err *admin.OIDCError) {
+	c.AbortWithStatusJSON(status, map[string]string{
+		"error":             err.Code,
+		"error_description": err.Description,
+	})
+}
@@ -0,0 +1,74 @@
+/*
+ * Copyright 2023 FormulaGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ */
+
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	logic "formulago/biz/logic/admin"
+	"formulago/configs"
+	Data "formulago/data"
+	"formulago/pkg/times"
+
+	"github.com/casbin/casbin/v3"
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+)
+
+type refreshLogin struct {
+	RefreshToken string `form:"refresh_token,required" json:"refresh_token,required"` //lint:ignore SA5008 ignoreCheck
+}
+
+// GetRefreshTokenHandler returns the handler which rotates the opaque refresh token and issues a new access token.
+// Replaying a refresh token which has been rotated revokes the whole token family and its session.
+func GetRefreshTokenHandler(config configs.Config, db *Data.Data, e *casbin.Enforcer) app.HandlerFunc {
+	jwtMiddleware := GetJWTMiddleware(config, db, e)
+	return func(ctx context.Context, c *app.RequestContext) {
+		var req refreshLogin
+		if err := c.BindAndValidate(&req); err != nil {
+			jwtMiddleware.Unauthorized(ctx, c, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, err := logic.NewRefreshToken(db, config).Rotate(ctx, req.RefreshToken)
+		if err != nil {
+			hlog.Error(err, "refresh token error")
+			jwtMiddleware.Unauthorized(ctx, c, http.StatusUnauthorized, err.Error())
+			return
+		}
+
+		// the new access token keeps the session jti
+		payloadMap := make(map[string]any)
+		payloadMap["roleID"] = strconv.Itoa(int(res.RoleID))
+		payloadMap["userID"] = strconv.Itoa(int(res.UserID))
+		payloadMap["jti"] = res.Family
+		payloadMap["tokenVersion"] = strconv.FormatUint(res.TokenVersion, 10)
+		payloadMap["tenantID"] = strconv.FormatUint(res.TenantID, 10)
+		tokenString, expire, err := generateToken(config, jwtMiddleware, payloadMap)
+		if err != nil {
+			hlog.Error(err, "refresh token error, generate token error")
+			jwtMiddleware.Unauthorized(ctx, c, http.StatusInternalServerError, err.Error())
+			return
+		}
+		err = logic.NewToken(db).Renew(ctx, res.Family, expire.In(time.Local).Format(times.TimeFormat))
+		if err != nil {
+			hlog.Error(err, "refresh token error, renew session error")
+			jwtMiddleware.Unauthorized(ctx, c, http.StatusUnauthorized, err.Error())
+			return
+		}
+
+		c.JSON(http.StatusOK, map[string]any{
+			"code":          http.StatusOK,
+			"token":         tokenString,
+			"expire":        expire.Format(time.RFC3339),
+			"refresh_token": res.RefreshToken,
+		})
+	}
+}
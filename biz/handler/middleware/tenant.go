@@ -0,0 +1,34 @@
+/*
+ * Copyright 2023 FormulaGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ */
+
+package middleware
+
+import (
+	"context"
+
+	"formulago/pkg/tenant"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/spf13/cast"
+)
+
+// TenantMiddleware scopes the context of the request to the tenant of the caller set by the auth middleware,
+// so the handlers only see and touch the data of that tenant.
+func TenantMiddleware() app.HandlerFunc {
+	return func(ctx context.Context, c *app.RequestContext) {
+		v, _ := c.Get("tenantID")
+		c.Next(tenant.NewContext(ctx, tenantID(v)))
+	}
+}
+
+// tenantID the tenant in the jwt payload, the tokens issued before the tenants belong to the default tenant
+func tenantID(v any) uint64 {
+	id := cast.ToUint64(v)
+	if id == 0 {
+		return tenant.DefaultID
+	}
+	return id
+}
@@ -0,0 +1,70 @@
+/*
+ * Copyright 2023 FormulaGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ */
+
+package middleware
+
+import (
+	"context"
+	"net/http"
+
+	"formulago/biz/domain/admin"
+	logic "formulago/biz/logic/admin"
+	"formulago/configs"
+	Data "formulago/data"
+
+	"github.com/casbin/casbin/v3"
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"github.com/hertz-contrib/jwt"
+)
+
+type twoFactorLogin struct {
+	MfaToken string `form:"mfa_token,required" json:"mfa_token,required"` //lint:ignore SA5008 ignoreCheck
+	// Code TOTP code or a recovery code
+	Code string `form:"code,required" json:"code,required"` //lint:ignore SA5008 ignoreCheck
+}
+
+// GetTwoFactorLoginHandler returns the handler of the second login step, it verifies the TOTP or recovery code
+// of the mfa token returned by the first step and issues the tokens.
+func GetTwoFactorLoginHandler(config configs.Config, db *Data.Data, e *casbin.Enforcer) app.HandlerFunc {
+	jwtMiddleware := GetJWTMiddleware(config, db, e)
+	return func(ctx context.Context, c *app.RequestContext) {
+		var req twoFactorLogin
+		if err := c.BindAndValidate(&req); err != nil {
+			unauthorized(ctx, c, jwtMiddleware, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		challenge, err := logic.NewTwoFactor(db, config).VerifyChallenge(ctx, req.MfaToken, req.Code)
+		// an unknown or expired mfa token is no attempt of any user
+		if challenge != nil {
+			recordLogin(ctx, c, config, db, &admin.LoginEventInfo{
+				UserID:   challenge.Login.UserID,
+				Username: challenge.Login.Username,
+				Method:   loginMethod(challenge.Source),
+				Device:   challenge.Device,
+			}, err)
+		}
+		if err != nil {
+			hlog.Info("two-factor login failed: ", err)
+			unauthorized(ctx, c, jwtMiddleware, http.StatusUnauthorized, err.Error())
+			return
+		}
+
+		payloadMap, err := issueSession(ctx, c, config, db, &challenge.Login, challenge.Source, challenge.Device)
+		if err != nil {
+			unauthorized(ctx, c, jwtMiddleware, http.StatusUnauthorized, err.Error())
+			return
+		}
+		tokenString, expire, err := generateToken(config, jwtMiddleware, payloadMap)
+		if err != nil {
+			hlog.Error(err, "two-factor login error, generate token error")
+			unauthorized(ctx, c, jwtMiddleware, http.StatusUnauthorized, jwt.ErrFailedTokenCreation.Error())
+			return
+		}
+		jwtMiddleware.LoginResponse(ctx, c, http.StatusOK, tokenString, expire)
+	}
+}
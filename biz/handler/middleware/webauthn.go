@@ -0,0 +1,69 @@
+/*
+ * Copyright 2023 FormulaGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ */
+
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	logic "formulago/biz/logic/admin"
+	"formulago/configs"
+	Data "formulago/data"
+
+	"github.com/casbin/casbin/v3"
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+)
+
+// webauthnLoginKey marks the context of a passkey login for the Authenticator
+type webauthnLoginKey struct{}
+
+type webauthnBeginLogin struct {
+	// Username optional, empty for a discoverable passkey chosen by the authenticator
+	Username string `form:"username" json:"username"`
+}
+
+// webauthnLogin the body of the passkey login, Credential is the PublicKeyCredential of navigator.credentials.get
+type webauthnLogin struct {
+	SessionID  string          `json:"session_id"`
+	Credential json.RawMessage `json:"credential"`
+	// Device optional device label, taken from the User-Agent if empty
+	Device string `json:"device"`
+}
+
+// GetWebauthnBeginLoginHandler returns the handler starting the passkey login, the options are passed to
+// navigator.credentials.get and the result is posted with the session id to /api/login/webauthn/finish.
+func GetWebauthnBeginLoginHandler(config configs.Config, db *Data.Data, e *casbin.Enforcer) app.HandlerFunc {
+	jwtMiddleware := GetJWTMiddleware(config, db, e)
+	return func(ctx context.Context, c *app.RequestContext) {
+		var req webauthnBeginLogin
+		if err := c.BindAndValidate(&req); err != nil {
+			unauthorized(ctx, c, jwtMiddleware, http.StatusBadRequest, err.Error())
+			return
+		}
+		res, err := logic.NewWebauthn(db, config).BeginLogin(ctx, req.Username)
+		if err != nil {
+			hlog.Info("passkey login failed: ", err)
+			unauthorized(ctx, c, jwtMiddleware, http.StatusUnauthorized, err.Error())
+			return
+		}
+		c.JSON(http.StatusOK, map[string]any{
+			"code":       http.StatusOK,
+			"session_id": res.SessionID,
+			"options":    json.RawMessage(res.Options),
+		})
+	}
+}
+
+// GetWebauthnLoginHandler returns the handler finishing the passkey login, it issues the tokens like /api/login.
+func GetWebauthnLoginHandler(config configs.Config, db *Data.Data, e *casbin.Enforcer) app.HandlerFunc {
+	loginHandler := GetLoginHandler(config, db, e)
+	return func(ctx context.Context, c *app.RequestContext) {
+		loginHandler(context.WithValue(ctx, webauthnLoginKey{}, true), c)
+	}
+}
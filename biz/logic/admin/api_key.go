@@ -0,0 +1,196 @@
+/*
+ * Copyright 2023 FormulaGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ */
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"formulago/biz/domain/admin"
+	"formulago/data"
+	"formulago/data/ent"
+	"formulago/data/ent/apikey"
+	"formulago/data/ent/predicate"
+	"formulago/data/ent/user"
+	apikeys "formulago/pkg/apikey"
+	"formulago/pkg/tenant"
+	"formulago/pkg/times"
+
+	"github.com/casbin/casbin/v3"
+)
+
+const (
+	apiKeyMaxExpireDays = 365
+	// apiKeyUsedInterval the last use is written at most once per interval and IP
+	apiKeyUsedInterval = time.Minute
+)
+
+type APIKey struct {
+	Data *data.Data
+	Cbs  *casbin.Enforcer
+}
+
+func NewAPIKey(data *data.Data, cbs *casbin.Enforcer) admin.APIKey {
+	return &APIKey{
+		Data: data,
+		Cbs:  cbs,
+	}
+}
+
+func (a *APIKey) Create(ctx context.Context, req admin.CreateAPIKeyReq) (key string, err error) {
+	if req.Name == "" {
+		return "", errors.New("name is required")
+	}
+	if req.ExpireDays == 0 || req.ExpireDays > apiKeyMaxExpireDays {
+		return "", fmt.Errorf("expire days must be between 1 and %d", apiKeyMaxExpireDays)
+	}
+	if len(req.Scopes) == 0 {
+		return "", errors.New("at least one scope is required")
+	}
+	userEnt, err := a.Data.DBClient.User.Query().Where(user.IDEQ(req.UserID), user.Status(1)).Only(ctx)
+	if err != nil {
+		return "", fmt.Errorf("get user failed: %w", err)
+	}
+	// a key is limited to the policies of the active roles
+	roles, err := NewUser(a.Data).ActiveRoles(ctx, userEnt.ID)
+	if err != nil {
+		return "", err
+	}
+	roleIDs := make([]uint64, 0, len(roles))
+	for _, v := range roles {
+		roleIDs = append(roleIDs, v.ID)
+	}
+	scopes := make([]string, 0, len(req.Scopes))
+	for _, s := range req.Scopes {
+		scope, err := apikeys.ParseScope(s)
+		if err != nil {
+			return "", err
+		}
+		pass, err := rolesAllow(a.Cbs, roleIDs, tenant.Domain(userEnt.TenantID), scope.Path, scope.Method)
+		if err != nil {
+			return "", fmt.Errorf("check scope failed: %w", err)
+		}
+		if !pass {
+			return "", fmt.Errorf("scope %s is not allowed to the roles of the user", scope)
+		}
+		scopes = append(scopes, scope.String())
+	}
+
+	key, prefix, hash, err := apikeys.Generate()
+	if err != nil {
+		return "", fmt.Errorf("generate api key failed: %w", err)
+	}
+	_, err = a.Data.DBClient.APIKey.Create().
+		SetUserID(req.UserID).
+		SetName(req.Name).
+		SetPrefix(prefix).
+		SetKeyHash(hash).
+		SetScopes(scopes).
+		SetExpiredAt(time.Now().AddDate(0, 0, int(req.ExpireDays))).
+		Save(ctx)
+	if err != nil {
+		return "", fmt.Errorf("create api key failed: %w", err)
+	}
+	return key, nil
+}
+
+func (a *APIKey) List(ctx context.Context, req admin.APIKeyListReq) (list []*admin.APIKeyInfo, total int, err error) {
+	var predicates []predicate.APIKey
+	if req.UserID != 0 {
+		predicates = append(predicates, apikey.UserID(req.UserID))
+	}
+	// the keys are not scoped themselves, but by the tenant of their owners
+	if id, ok := tenant.FromContext(ctx); ok {
+		predicates = append(predicates, apikey.HasOwnerWith(user.TenantID(id)))
+	}
+	keys, err := a.Data.DBClient.APIKey.Query().Where(predicates...).
+		Order(ent.Desc(apikey.FieldCreatedAt)).
+		Offset(int(req.Page-1) * int(req.PageSize)).
+		Limit(int(req.PageSize)).All(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("get api key list failed: %w", err)
+	}
+	for _, k := range keys {
+		list = append(list, apiKeyInfo(k))
+	}
+	total, err = a.Data.DBClient.APIKey.Query().Where(predicates...).Count(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("count api key failed: %w", err)
+	}
+	return list, total, nil
+}
+
+func (a *APIKey) Revoke(ctx context.Context, userID, id uint64) error {
+	predicates := []predicate.APIKey{apikey.IDEQ(id), apikey.RevokedAtIsNil()}
+	if userID != 0 {
+		predicates = append(predicates, apikey.UserID(userID))
+	}
+	if id, ok := tenant.FromContext(ctx); ok {
+		predicates = append(predicates, apikey.HasOwnerWith(user.TenantID(id)))
+	}
+	n, err := a.Data.DBClient.APIKey.Update().Where(predicates...).SetRevokedAt(time.Now()).Save(ctx)
+	if err != nil {
+		return fmt.Errorf("revoke api key failed: %w", err)
+	}
+	if n == 0 {
+		return errors.New("api key not found or already revoked")
+	}
+	return nil
+}
+
+func (a *APIKey) Authenticate(ctx context.Context, key, ip string) (*admin.APIKeyInfo, error) {
+	k, err := a.Data.DBClient.APIKey.Query().
+		Where(apikey.KeyHash(apikeys.Hash(key)), apikey.RevokedAtIsNil(), apikey.ExpiredAtGT(time.Now()),
+			apikey.HasOwnerWith(user.Status(1))).
+		WithOwner().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, admin.ErrAPIKeyInvalid
+		}
+		return nil, fmt.Errorf("get api key failed: %w", err)
+	}
+	// record the last use, not on every request
+	newUse := k.LastUsedAt == nil || time.Since(*k.LastUsedAt) > apiKeyUsedInterval || k.LastUsedIP != ip
+	if newUse {
+		now := time.Now()
+		err = a.Data.DBClient.APIKey.UpdateOne(k).SetLastUsedAt(now).SetLastUsedIP(ip).Exec(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("update api key last use failed: %w", err)
+		}
+		k.LastUsedAt = &now
+		k.LastUsedIP = ip
+	}
+	info := apiKeyInfo(k)
+	info.RoleID = k.Edges.Owner.RoleID
+	info.Username = k.Edges.Owner.Username
+	info.TenantID = k.Edges.Owner.TenantID
+	info.NewUse = newUse
+	return info, nil
+}
+
+func apiKeyInfo(k *ent.APIKey) *admin.APIKeyInfo {
+	info := &admin.APIKeyInfo{
+		ID:         k.ID,
+		CreatedAt:  k.CreatedAt.Format(times.TimeFormat),
+		UserID:     k.UserID,
+		Name:       k.Name,
+		Prefix:     k.Prefix,
+		Scopes:     k.Scopes,
+		ExpiredAt:  k.ExpiredAt.Format(times.TimeFormat),
+		LastUsedIP: k.LastUsedIP,
+	}
+	if k.LastUsedAt != nil {
+		info.LastUsedAt = k.LastUsedAt.Format(times.TimeFormat)
+	}
+	if k.RevokedAt != nil {
+		info.RevokedAt = k.RevokedAt.Format(times.TimeFormat)
+	}
+	return info
+}
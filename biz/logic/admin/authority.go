@@ -12,10 +12,15 @@ import (
 	"fmt"
 	"formulago/biz/domain/admin"
 	"formulago/data"
+	"formulago/data/ent/api"
+	"formulago/data/ent/menu"
 	"formulago/data/ent/role"
+	"formulago/pkg/tenant"
+	"slices"
 
 	"github.com/casbin/casbin/v3"
 	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"github.com/spf13/cast"
 )
 
 type Authority struct {
@@ -31,6 +36,35 @@ func NewAuthority(data *data.Data, cbs *casbin.Enforcer) admin.Authority {
 }
 
 func (a *Authority) UpdateApiAuthority(ctx context.Context, roleIDStr string, infos []*admin.ApiAuthorityInfo) error {
+	// the policies are in the domain of the tenant of the role
+	roleEnt, err := a.Data.DBClient.Role.Get(ctx, cast.ToUint64(roleIDStr))
+	if err != nil {
+		return fmt.Errorf("get role failed: %w", err)
+	}
+	domain := tenant.Domain(roleEnt.TenantID)
+	if roleEnt.TenantID != tenant.DefaultID {
+		for _, v := range infos {
+			exist, err := a.Data.DBClient.API.Query().
+				Where(api.Path(v.Path), api.Method(v.Method), api.APIGroupIn(platformAPIGroups...)).Exist(ctx)
+			if err != nil {
+				return fmt.Errorf("query api failed: %w", err)
+			}
+			if exist {
+				return fmt.Errorf("%s %s is only allowed to the roles of the default tenant", v.Method, v.Path)
+			}
+		}
+	}
+	// the policies inherited from the ancestors are not stored on the role,
+	// so the merged list of ApiAuthority can be saved back unchanged
+	inherited, err := a.inheritedPolicies(roleIDStr, domain)
+	if err != nil {
+		return err
+	}
+	infos = slices.DeleteFunc(slices.Clone(infos), func(v *admin.ApiAuthorityInfo) bool {
+		return slices.ContainsFunc(inherited, func(p *admin.ApiAuthorityInfo) bool {
+			return p.Path == v.Path && p.Method == v.Method
+		})
+	})
 	// clear old policies
 	oldPolicies, err := a.Cbs.GetFilteredPolicy(0, roleIDStr)
 	if err != nil {
@@ -48,7 +82,10 @@ func (a *Authority) UpdateApiAuthority(ctx context.Context, roleIDStr string, in
 	// add new policies
 	var policies [][]string
 	for _, v := range infos {
-		policies = append(policies, []string{roleIDStr, v.Path, v.Method})
+		policies = append(policies, []string{roleIDStr, domain, v.Path, v.Method})
+	}
+	if len(policies) == 0 {
+		return nil
 	}
 	addResult, err := a.Cbs.AddPolicies(policies)
 	if err != nil {
@@ -60,22 +97,65 @@ func (a *Authority) UpdateApiAuthority(ctx context.Context, roleIDStr string, in
 	return nil
 }
 
-// ApiAuthority CompanyInfo Api authority policy by role id
+// ApiAuthority returns the api policies of the role merged with those inherited from its ancestors,
+// the inherited ones are marked
 func (a *Authority) ApiAuthority(ctx context.Context, roleIDStr string) (infos []*admin.ApiAuthorityInfo, err error) {
+	roleEnt, err := a.Data.DBClient.Role.Get(ctx, cast.ToUint64(roleIDStr))
+	if err != nil {
+		return nil, fmt.Errorf("get role failed: %w", err)
+	}
 	policies, err := a.Cbs.GetFilteredPolicy(0, roleIDStr)
 	if err != nil {
 		return
 	}
 	for _, v := range policies {
 		infos = append(infos, &admin.ApiAuthorityInfo{
-			Path:   v[1],
-			Method: v[2],
+			Path:   v[2],
+			Method: v[3],
 		})
 	}
+	inherited, err := a.inheritedPolicies(roleIDStr, tenant.Domain(roleEnt.TenantID))
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range inherited {
+		if !slices.ContainsFunc(infos, func(p *admin.ApiAuthorityInfo) bool { return p.Path == v.Path && p.Method == v.Method }) {
+			infos = append(infos, v)
+		}
+	}
 	return
 }
 
+// inheritedPolicies returns the api policies of the ancestors of the role within the domain.
+// The grouping policies of casbin only link a role to an active parent,
+// so the policies of a disabled ancestor and of its own ancestors are not inherited.
+func (a *Authority) inheritedPolicies(roleIDStr, domain string) (infos []*admin.ApiAuthorityInfo, err error) {
+	policies, err := a.Cbs.GetImplicitPermissionsForUser(roleIDStr, domain)
+	if err != nil {
+		return nil, fmt.Errorf("get inherited policies failed: %w", err)
+	}
+	for _, v := range policies {
+		if v[0] == roleIDStr || slices.ContainsFunc(infos, func(p *admin.ApiAuthorityInfo) bool { return p.Path == v[2] && p.Method == v[3] }) {
+			continue
+		}
+		infos = append(infos, &admin.ApiAuthorityInfo{
+			Path:      v[2],
+			Method:    v[3],
+			Inherited: true,
+		})
+	}
+	return infos, nil
+}
+
 func (a *Authority) UpdateMenuAuthority(ctx context.Context, roleID uint64, menuIDs []uint64) error {
+	// the menus inherited from the ancestors are not stored on the role,
+	// so the merged list of MenuAuthority can be saved back unchanged
+	inherited, err := a.inheritedMenuIDs(ctx, roleID)
+	if err != nil {
+		return err
+	}
+	menuIDs = slices.DeleteFunc(slices.Clone(menuIDs), func(id uint64) bool { return slices.Contains(inherited, id) })
+
 	tx, err := a.Data.DBClient.Tx(ctx)
 	if err != nil {
 		return fmt.Errorf("starting a transaction err: %w", err)
@@ -89,6 +169,22 @@ func (a *Authority) UpdateMenuAuthority(ctx context.Context, roleID uint64, menu
 		}
 	}()
 
+	// the menus must belong to the tenant, the root menu is shared
+	ids := make(map[uint64]struct{})
+	for _, id := range menuIDs {
+		if id != 1 {
+			ids[id] = struct{}{}
+		}
+	}
+	count, err := tx.Menu.Query().Where(menu.IDIn(menuIDs...), menu.IDNEQ(1)).Count(ctx)
+	if err != nil {
+		return fmt.Errorf("query menus failed: %w", err)
+	}
+	if count != len(ids) {
+		err = errors.New("menu not exist")
+		return err
+	}
+
 	err = tx.Role.UpdateOneID(roleID).ClearMenus().Exec(ctx)
 	if err != nil {
 		return fmt.Errorf("delete role's menu failed, error: %w", err)
@@ -102,12 +198,40 @@ func (a *Authority) UpdateMenuAuthority(ctx context.Context, roleID uint64, menu
 	return tx.Commit()
 }
 
-func (a *Authority) MenuAuthority(ctx context.Context, roleID uint64) (menuIDs []uint64, err error) {
+func (a *Authority) MenuAuthority(ctx context.Context, roleID uint64) (menuIDs, inheritedMenuIDs []uint64, err error) {
 	menus, err := a.Data.DBClient.Role.Query().Where(role.IDEQ(roleID)).QueryMenus().All(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("query role's menu failed: %w", err)
+	}
 	for _, v := range menus {
 		if v.ID != 1 {
 			menuIDs = append(menuIDs, v.ID)
 		}
 	}
+	inherited, err := a.inheritedMenuIDs(ctx, roleID)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, id := range inherited {
+		if !slices.Contains(menuIDs, id) {
+			menuIDs = append(menuIDs, id)
+			inheritedMenuIDs = append(inheritedMenuIDs, id)
+		}
+	}
 	return
 }
+
+// inheritedMenuIDs returns the menus of the active ancestors of the role, without the root menu
+func (a *Authority) inheritedMenuIDs(ctx context.Context, roleID uint64) (menuIDs []uint64, err error) {
+	ancestors, err := inheritedRoles(ctx, a.Data, roleID)
+	if err != nil {
+		return nil, err
+	}
+	menuIDs, err = a.Data.DBClient.Menu.Query().
+		Where(menu.HasRolesWith(role.IDIn(ancestors[1:]...)), menu.IDNEQ(1)).
+		IDs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("query inherited menus failed: %w", err)
+	}
+	return menuIDs, nil
+}
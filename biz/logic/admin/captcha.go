@@ -7,37 +7,71 @@
 package admin
 
 import (
+	"sync"
+
 	"formulago/biz/domain/admin"
 	"formulago/configs"
 	"formulago/data"
 	"formulago/pkg/captcha"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
 	"github.com/mojocn/base64Captcha"
 )
 
 var (
-	captchaDriver *base64Captcha.DriverDigit
-	CaptchaStore  *captcha.CacheStore
+	captchaDriver base64Captcha.Driver
+	captchaStore  base64Captcha.Store
+	captchaOnce   sync.Once
 )
 
 func initCaptcha() {
 	c := configs.Data()
-	captchaDriver = base64Captcha.NewDriverDigit(c.Captcha.ImgHeight, c.Captcha.ImgWidth,
-		c.Captcha.KeyLong, 0.7, 80)
-	CaptchaStore = captcha.NewCacheStore(data.CacheDB("captcha"))
+	captchaDriver = newCaptchaDriver(c.Captcha)
+	// the answers are shared by every instance if redis is enabled,
+	// the login may be verified by another instance than the one generating the captcha
+	if d := data.Default(); d != nil && d.Redis != nil {
+		captchaStore = captcha.NewRedisStore(d.Redis)
+	} else {
+		captchaStore = captcha.NewCacheStore(data.CacheDB("captcha"))
+	}
+}
+
+// newCaptchaDriver returns the driver of the configured type, the digit driver if the type is empty or unknown
+func newCaptchaDriver(c configs.Captcha) base64Captcha.Driver {
+	switch c.Type {
+	case "", "digit":
+	case "string":
+		return base64Captcha.NewDriverString(c.ImgHeight, c.ImgWidth, 0, base64Captcha.OptionShowSlimeLine,
+			c.KeyLong, base64Captcha.TxtSimpleCharaters, nil, nil, nil)
+	case "math":
+		return base64Captcha.NewDriverMath(c.ImgHeight, c.ImgWidth, 0, base64Captcha.OptionShowSlimeLine,
+			nil, nil, nil)
+	case "chinese":
+		// only the embedded wqy-microhei font has the Chinese characters
+		return base64Captcha.NewDriverChinese(c.ImgHeight, c.ImgWidth, 0, base64Captcha.OptionShowSlimeLine,
+			c.KeyLong, base64Captcha.TxtChineseCharaters, nil, nil, []string{"wqy-microhei.ttc"})
+	case "audio":
+		language := c.Language
+		if language == "" {
+			language = "en"
+		}
+		return base64Captcha.NewDriverAudio(c.KeyLong, language)
+	default:
+		hlog.Error("unknown captcha type ", c.Type, ", the digit captcha is used")
+	}
+	return base64Captcha.NewDriverDigit(c.ImgHeight, c.ImgWidth,
+		c.KeyLong, 0.7, 80)
 }
 
 type Captcha struct {
-	CaptchaDriver *base64Captcha.DriverDigit
-	CaptchaStore  *captcha.CacheStore
+	CaptchaDriver base64Captcha.Driver
+	CaptchaStore  base64Captcha.Store
 }
 
 func NewCaptcha() admin.Captcha {
-	if captchaDriver == nil || CaptchaStore == nil {
-		initCaptcha()
-	}
+	captchaOnce.Do(initCaptcha)
 	return &Captcha{
 		CaptchaDriver: captchaDriver,
-		CaptchaStore:  CaptchaStore,
+		CaptchaStore:  captchaStore,
 	}
 }
 
@@ -46,3 +80,8 @@ func (c *Captcha) GetCaptcha() (id, b64s string, err error) {
 	id, b64s, _, err = captchaGen.Generate()
 	return
 }
+
+// Verify checks the answer of the captcha, the captcha is cleared whatever the result
+func (c *Captcha) Verify(id, answer string) bool {
+	return c.CaptchaStore.Verify(id, answer, true)
+}
@@ -0,0 +1,95 @@
+/*
+ * Copyright 2023 FormulaGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ */
+
+package admin
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"formulago/data"
+	"formulago/data/ent"
+	"formulago/data/ent/enttest"
+
+	"github.com/casbin/casbin/v3"
+	"github.com/casbin/casbin/v3/model"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/patrickmn/go-cache"
+)
+
+// testModelText the default casbin model of the service
+const testModelText = `
+[request_definition]
+r = sub, dom, obj, act
+[policy_definition]
+p = sub, dom, obj, act
+[role_definition]
+g = _, _, _
+[policy_effect]
+e = some(where (p.eft == allow))
+[matchers]
+m = g(r.sub, p.sub, r.dom) && r.dom == p.dom && keyMatch2(r.obj,p.obj) && r.act == p.act
+`
+
+// newTestData returns a Data on a private in-memory sqlite database, with the default tenant created
+func newTestData(t *testing.T) *data.Data {
+	name := strings.NewReplacer("/", "_", " ", "_").Replace(t.Name())
+	client := enttest.Open(t, "sqlite3", fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", name))
+	t.Cleanup(func() { _ = client.Close() })
+
+	if err := client.Tenant.Create().SetName("default").Exec(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	return &data.Data{
+		DBClient: client,
+		Cache:    cache.New(time.Minute, time.Minute),
+	}
+}
+
+// newTestUser creates an active user of the role in the default tenant
+func newTestUser(t *testing.T, d *data.Data, username string, roleID uint64) *ent.User {
+	userEnt, err := d.DBClient.User.Create().
+		SetUsername(username).
+		SetPassword("-").
+		SetNickname(username).
+		SetMobile(username).
+		SetRoleID(roleID).
+		SetTenantID(1).
+		Save(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return userEnt
+}
+
+// newTestRole creates an active role in the default tenant
+func newTestRole(t *testing.T, d *data.Data, value string) *ent.Role {
+	roleEnt, err := d.DBClient.Role.Create().
+		SetName(value).
+		SetValue(value).
+		SetTenantID(1).
+		Save(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return roleEnt
+}
+
+// newTestEnforcer returns an enforcer of the default model without adapter, the policies live in memory
+func newTestEnforcer(t *testing.T) *casbin.Enforcer {
+	m, err := model.NewModelFromString(testModelText)
+	if err != nil {
+		t.Fatal(err)
+	}
+	enforcer, err := casbin.NewEnforcer(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return enforcer
+}
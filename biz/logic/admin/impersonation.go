@@ -0,0 +1,112 @@
+/*
+ * Copyright 2023 FormulaGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ */
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"formulago/biz/domain/admin"
+	"formulago/data"
+	"formulago/data/ent"
+	"formulago/data/ent/token"
+	"formulago/data/ent/user"
+	"formulago/pkg/tenant"
+
+	"github.com/casbin/casbin/v3"
+)
+
+// impersonatePath the API starting an impersonation, the roles having its policy cannot be impersonated
+const impersonatePath = "/api/admin/user/impersonate"
+
+type Impersonation struct {
+	Data *data.Data
+	Cbs  *casbin.Enforcer
+}
+
+func NewImpersonation(data *data.Data, cbs *casbin.Enforcer) admin.Impersonation {
+	return &Impersonation{
+		Data: data,
+		Cbs:  cbs,
+	}
+}
+
+func (i *Impersonation) Start(ctx context.Context, impersonatorID, userID uint64) (*admin.LoginResp, error) {
+	if impersonatorID == userID {
+		return nil, errors.New("cannot impersonate yourself")
+	}
+	userEnt, err := i.Data.DBClient.User.Query().Where(user.IDEQ(userID)).Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.New("user not exist")
+		}
+		return nil, fmt.Errorf("get user failed: %w", err)
+	}
+	if userEnt.PendingApproval || userEnt.Status != 1 {
+		return nil, errors.New("cannot impersonate an inactive user")
+	}
+	// an admin may not act as another admin, or chain impersonations through one,
+	// disabled roles count too, as they may be enabled during the impersonation
+	roleIDs, err := NewUser(i.Data).RoleIDs(ctx, userEnt.ID)
+	if err != nil {
+		return nil, err
+	}
+	pass, err := rolesAllow(i.Cbs, roleIDs, tenant.Domain(userEnt.TenantID), impersonatePath, http.MethodPost)
+	if err != nil {
+		return nil, fmt.Errorf("check the roles of the user failed: %w", err)
+	}
+	if pass {
+		return nil, errors.New("cannot impersonate a user who may impersonate")
+	}
+	return i.loginResp(ctx, userEnt)
+}
+
+func (i *Impersonation) End(ctx context.Context, jti string, impersonatorID uint64, impersonatorJTI string) (*admin.LoginResp, error) {
+	tokens := NewToken(i.Data)
+	if err := tokens.Revoke(ctx, jti); err != nil {
+		return nil, err
+	}
+	if !tokens.IsActive(ctx, impersonatorJTI) {
+		return nil, errors.New("the session of the impersonator has ended, log in again")
+	}
+	userEnt, err := i.Data.DBClient.User.Query().Where(user.IDEQ(impersonatorID), user.Status(1)).Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get active impersonator failed: %w", err)
+	}
+	// the session of the admin keeps the role switched to
+	session, err := i.Data.DBClient.Token.Query().Where(token.Jti(impersonatorJTI)).Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get session of the impersonator failed: %w", err)
+	}
+	roles, err := NewUser(i.Data).ActiveRoles(ctx, userEnt.ID)
+	if err != nil {
+		return nil, err
+	}
+	roleID, ok := sessionRole(roles, session.RoleID, userEnt.RoleID)
+	if !ok {
+		return nil, errors.New("the impersonator has no active role")
+	}
+	userEnt.RoleID = roleID
+	return i.loginResp(ctx, userEnt)
+}
+
+func (i *Impersonation) loginResp(ctx context.Context, userEnt *ent.User) (res *admin.LoginResp, err error) {
+	res = new(admin.LoginResp)
+	res.Username = userEnt.Username
+	res.UserID = userEnt.ID
+	res.RoleID = userEnt.RoleID
+	res.TokenVersion = userEnt.TokenVersion
+	res.TenantID = userEnt.TenantID
+	login := &Login{Data: i.Data}
+	res.RoleName, res.RoleValue, err = login.getRoleInfo(ctx, userEnt.RoleID)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
@@ -0,0 +1,121 @@
+/*
+ * Copyright 2023 FormulaGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ */
+
+package admin
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"formulago/biz/domain/admin"
+	"formulago/data"
+	"formulago/pkg/tenant"
+	"formulago/pkg/times"
+)
+
+// newTestLogin creates an active session of the user
+func newTestLogin(t *testing.T, d *data.Data, userID uint64, jti string) {
+	err := NewToken(d).Create(context.Background(), &admin.TokenInfo{
+		UserID:    userID,
+		JTI:       jti,
+		Source:    "test",
+		ExpiredAt: time.Now().Add(time.Hour).Format(times.TimeFormat),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestImpersonation_Start(t *testing.T) {
+	ctx := context.Background()
+	d := newTestData(t)
+	enforcer := newTestEnforcer(t)
+	adminRole := newTestRole(t, d, "admin")
+	memberRole := newTestRole(t, d, "member")
+	// only the admin role may impersonate
+	if _, err := enforcer.AddPolicy("1", tenant.Domain(1), impersonatePath, http.MethodPost); err != nil {
+		t.Fatal(err)
+	}
+	impersonator := newTestUser(t, d, "alice", adminRole.ID)
+	otherAdmin := newTestUser(t, d, "bobby", adminRole.ID)
+	member := newTestUser(t, d, "carol", memberRole.ID)
+	inactive := newTestUser(t, d, "david", memberRole.ID)
+	if err := d.DBClient.User.UpdateOne(inactive).SetStatus(0).Exec(ctx); err != nil {
+		t.Fatal(err)
+	}
+	// the admin role as a second role is enough to refuse the impersonation
+	memberAdmin := newTestUser(t, d, "erika", memberRole.ID)
+	if err := d.DBClient.User.UpdateOne(memberAdmin).AddRoleIDs(memberRole.ID, adminRole.ID).Exec(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		userID  uint64
+		wantErr bool
+	}{
+		{name: "yourself", userID: impersonator.ID, wantErr: true},
+		{name: "not exist", userID: 100, wantErr: true},
+		{name: "inactive user", userID: inactive.ID, wantErr: true},
+		{name: "user who may impersonate", userID: otherAdmin.ID, wantErr: true},
+		{name: "second role may impersonate", userID: memberAdmin.ID, wantErr: true},
+		{name: "member", userID: member.ID, wantErr: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := NewImpersonation(d, enforcer).Start(ctx, impersonator.ID, tt.userID)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Start() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && (res.UserID != tt.userID || res.RoleID != memberRole.ID || res.RoleValue != "member") {
+				t.Errorf("Start() got %+v, want the login of user %d", res, tt.userID)
+			}
+		})
+	}
+}
+
+func TestImpersonation_End(t *testing.T) {
+	ctx := context.Background()
+	d := newTestData(t)
+	enforcer := newTestEnforcer(t)
+	adminRole := newTestRole(t, d, "admin")
+	memberRole := newTestRole(t, d, "member")
+	impersonator := newTestUser(t, d, "alice", adminRole.ID)
+	member := newTestUser(t, d, "carol", memberRole.ID)
+	tokens := NewToken(d)
+	impersonation := NewImpersonation(d, enforcer)
+
+	newTestLogin(t, d, impersonator.ID, "admin-session")
+	newTestLogin(t, d, member.ID, "impersonation-1")
+	res, err := impersonation.End(ctx, "impersonation-1", impersonator.ID, "admin-session")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the login of the admin is issued again for the session of the admin
+	if res.UserID != impersonator.ID || res.RoleID != adminRole.ID || res.Username != "alice" {
+		t.Errorf("End() got %+v, want the login of the impersonator", res)
+	}
+	if tokens.IsActive(ctx, "impersonation-1") {
+		t.Error("the impersonation session should be revoked")
+	}
+	if !tokens.IsActive(ctx, "admin-session") {
+		t.Error("the session of the impersonator should stay active")
+	}
+
+	// the admin logged out during the impersonation
+	newTestLogin(t, d, member.ID, "impersonation-2")
+	if err = tokens.Revoke(ctx, "admin-session"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = impersonation.End(ctx, "impersonation-2", impersonator.ID, "admin-session"); err == nil {
+		t.Error("End() should fail after the session of the impersonator ended")
+	}
+	if tokens.IsActive(ctx, "impersonation-2") {
+		t.Error("the impersonation session should be revoked even if the impersonator logged out")
+	}
+}
@@ -9,8 +9,10 @@ package admin
 import (
 	"context"
 	"formulago/pkg/encrypt"
+	"formulago/pkg/tenant"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/casbin/casbin/v3"
 
@@ -52,6 +54,13 @@ func (I *InitDatabase) InitDatabase(ctx context.Context) error {
 	}
 
 	// insert init data
+	err = I.insertTenantData(ctx)
+	if err != nil {
+		hlog.Error("insert tenant data failed", err)
+		err = fmt.Errorf("insert tenant data failed: %w", err)
+		return err
+	}
+
 	err = I.insertUserData(ctx)
 	if err != nil {
 		hlog.Error("insert user data failed", err)
@@ -104,6 +113,26 @@ func (I *InitDatabase) InitDatabase(ctx context.Context) error {
 	return nil
 }
 
+// insert init tenant data, the default tenant owns all init data and manages the other tenants
+func (I *InitDatabase) insertTenantData(ctx context.Context) error {
+	// the default tenant is created at startup already, see data.NewData
+	exist, err := I.DB.Tenant.Query().Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("db failed: %w", err)
+	}
+	if exist {
+		return nil
+	}
+	err = I.DB.Tenant.Create().
+		SetName("default").
+		SetRemark("默认租户").
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("db failed: %w", err)
+	}
+	return nil
+}
+
 // insert init user data
 func (I *InitDatabase) insertUserData(ctx context.Context) error {
 	var users []*ent.UserCreate
@@ -112,13 +141,25 @@ func (I *InitDatabase) insertUserData(ctx context.Context) error {
 		SetUsername("admin").
 		SetNickname("admin").
 		SetPassword(password).
+		// the default password is public, it expires at once when passwords have a max age
+		SetPasswordChangedAt(time.Unix(0, 0)).
 		SetEmail("admin@gmail.com").
 		SetMobile("12345678901").
 		SetRoleID(1).
+		AddRoleIDs(1).
 		SetWecom("admin"),
 	)
 
-	err := I.DB.User.CreateBulk(users...).Exec(ctx)
+	admins, err := I.DB.User.CreateBulk(users...).Save(ctx)
+	if err != nil {
+		return fmt.Errorf("db failed: %w", err)
+	}
+	// the oauth login finds the users by the linked accounts only, link the wecom account of the admin
+	err = I.DB.UserIdentity.Create().
+		SetUserID(admins[0].ID).
+		SetProvider("wecom").
+		SetSubject(admins[0].Wecom).
+		Exec(ctx)
 	if err != nil {
 		return fmt.Errorf("db failed: %w", err)
 	}
@@ -128,7 +169,7 @@ func (I *InitDatabase) insertUserData(ctx context.Context) error {
 // insert init apis data
 func (I *InitDatabase) insertRoleData(ctx context.Context) error {
 	var roles []*ent.RoleCreate
-	roles = make([]*ent.RoleCreate, 3)
+	roles = make([]*ent.RoleCreate, 4)
 	roles[0] = I.DB.Role.Create().
 		SetName("role.admin").
 		SetValue("admin").
@@ -147,6 +188,13 @@ func (I *InitDatabase) insertRoleData(ctx context.Context) error {
 		SetRemark("注册会员").
 		SetOrderNo(3)
 
+	// the policies of the impersonation role bound what an admin may do as another user
+	roles[3] = I.DB.Role.Create().
+		SetName("role.impersonation").
+		SetValue("impersonation").
+		SetRemark("模拟登录权限").
+		SetOrderNo(4)
+
 	err := I.DB.Role.CreateBulk(roles...).Exec(ctx)
 	if err != nil {
 		return fmt.Errorf("db failed: %w", err)
@@ -157,7 +205,7 @@ func (I *InitDatabase) insertRoleData(ctx context.Context) error {
 // insert init API data
 func (I *InitDatabase) insertApiData(ctx context.Context) error {
 	var apis []*ent.APICreate
-	apis = make([]*ent.APICreate, 55)
+	apis = make([]*ent.APICreate, 101)
 	// USER
 	apis[0] = I.DB.API.Create().
 		SetPath("/api/admin/user/login").
@@ -497,6 +545,288 @@ func (I *InitDatabase) insertApiData(ctx context.Context) error {
 		SetAPIGroup("logs").
 		SetMethod("DELETE")
 
+	// TWO-FACTOR
+	apis[55] = I.DB.API.Create().
+		SetPath("/api/admin/user/2fa/enroll").
+		SetDescription("apiDesc.enrollTwoFactor").
+		SetAPIGroup("user").
+		SetMethod("POST")
+
+	apis[56] = I.DB.API.Create().
+		SetPath("/api/admin/user/2fa/confirm").
+		SetDescription("apiDesc.confirmTwoFactor").
+		SetAPIGroup("user").
+		SetMethod("POST")
+
+	apis[57] = I.DB.API.Create().
+		SetPath("/api/admin/user/2fa/reset").
+		SetDescription("apiDesc.resetTwoFactor").
+		SetAPIGroup("user").
+		SetMethod("POST")
+
+	// PASSKEY
+	apis[58] = I.DB.API.Create().
+		SetPath("/api/admin/user/webauthn/register/begin").
+		SetDescription("apiDesc.beginWebauthnRegistration").
+		SetAPIGroup("user").
+		SetMethod("POST")
+
+	apis[59] = I.DB.API.Create().
+		SetPath("/api/admin/user/webauthn/register/finish").
+		SetDescription("apiDesc.finishWebauthnRegistration").
+		SetAPIGroup("user").
+		SetMethod("POST")
+
+	apis[60] = I.DB.API.Create().
+		SetPath("/api/admin/user/webauthn/list").
+		SetDescription("apiDesc.webauthnCredentialList").
+		SetAPIGroup("user").
+		SetMethod("GET")
+
+	apis[61] = I.DB.API.Create().
+		SetPath("/api/admin/user/webauthn/update").
+		SetDescription("apiDesc.updateWebauthnCredential").
+		SetAPIGroup("user").
+		SetMethod("POST")
+
+	apis[62] = I.DB.API.Create().
+		SetPath("/api/admin/user/webauthn").
+		SetDescription("apiDesc.deleteWebauthnCredential").
+		SetAPIGroup("user").
+		SetMethod("DELETE")
+
+	// LOGIN LOCKOUT
+	apis[63] = I.DB.API.Create().
+		SetPath("/api/admin/user/unlock").
+		SetDescription("apiDesc.unlockLogin").
+		SetAPIGroup("user").
+		SetMethod("POST")
+
+	// SELF-REGISTRATION
+	apis[64] = I.DB.API.Create().
+		SetPath("/api/admin/user/approve").
+		SetDescription("apiDesc.approveUser").
+		SetAPIGroup("user").
+		SetMethod("POST")
+
+	// API KEYS
+	apis[65] = I.DB.API.Create().
+		SetPath("/api/admin/user/apikey/create").
+		SetDescription("apiDesc.createAPIKey").
+		SetAPIGroup("user").
+		SetMethod("POST")
+
+	apis[66] = I.DB.API.Create().
+		SetPath("/api/admin/user/apikey/list").
+		SetDescription("apiDesc.myAPIKeyList").
+		SetAPIGroup("user").
+		SetMethod("POST")
+
+	apis[67] = I.DB.API.Create().
+		SetPath("/api/admin/user/apikey").
+		SetDescription("apiDesc.revokeMyAPIKey").
+		SetAPIGroup("user").
+		SetMethod("DELETE")
+
+	apis[68] = I.DB.API.Create().
+		SetPath("/api/admin/apikey/list").
+		SetDescription("apiDesc.apiKeyList").
+		SetAPIGroup("apikey").
+		SetMethod("POST")
+
+	apis[69] = I.DB.API.Create().
+		SetPath("/api/admin/apikey").
+		SetDescription("apiDesc.revokeAPIKey").
+		SetAPIGroup("apikey").
+		SetMethod("DELETE")
+
+	apis[70] = I.DB.API.Create().
+		SetPath("/api/admin/oidc/consent").
+		SetDescription("apiDesc.getOIDCConsent").
+		SetAPIGroup("oidc").
+		SetMethod("GET")
+
+	apis[71] = I.DB.API.Create().
+		SetPath("/api/admin/oidc/consent").
+		SetDescription("apiDesc.answerOIDCConsent").
+		SetAPIGroup("oidc").
+		SetMethod("POST")
+
+	apis[72] = I.DB.API.Create().
+		SetPath("/api/admin/oidc/client/create").
+		SetDescription("apiDesc.createOIDCClient").
+		SetAPIGroup("oidc").
+		SetMethod("POST")
+
+	apis[73] = I.DB.API.Create().
+		SetPath("/api/admin/oidc/client/update").
+		SetDescription("apiDesc.updateOIDCClient").
+		SetAPIGroup("oidc").
+		SetMethod("POST")
+
+	apis[74] = I.DB.API.Create().
+		SetPath("/api/admin/oidc/client/list").
+		SetDescription("apiDesc.oidcClientList").
+		SetAPIGroup("oidc").
+		SetMethod("POST")
+
+	apis[75] = I.DB.API.Create().
+		SetPath("/api/admin/oidc/client").
+		SetDescription("apiDesc.deleteOIDCClient").
+		SetAPIGroup("oidc").
+		SetMethod("DELETE")
+
+	apis[76] = I.DB.API.Create().
+		SetPath("/api/admin/oauth/provider/templates").
+		SetDescription("apiDesc.getProviderTemplates").
+		SetAPIGroup("oauth").
+		SetMethod("GET")
+
+	apis[77] = I.DB.API.Create().
+		SetPath("/api/admin/user/identity/list").
+		SetDescription("apiDesc.userIdentityList").
+		SetAPIGroup("user").
+		SetMethod("GET")
+
+	apis[78] = I.DB.API.Create().
+		SetPath("/api/admin/user/identity/link").
+		SetDescription("apiDesc.linkUserIdentity").
+		SetAPIGroup("user").
+		SetMethod("POST")
+
+	apis[79] = I.DB.API.Create().
+		SetPath("/api/admin/user/identity").
+		SetDescription("apiDesc.deleteUserIdentity").
+		SetAPIGroup("user").
+		SetMethod("DELETE")
+
+	apis[80] = I.DB.API.Create().
+		SetPath("/api/admin/saml/provider/create").
+		SetDescription("apiDesc.createSamlProvider").
+		SetAPIGroup("saml").
+		SetMethod("POST")
+
+	apis[81] = I.DB.API.Create().
+		SetPath("/api/admin/saml/provider/update").
+		SetDescription("apiDesc.updateSamlProvider").
+		SetAPIGroup("saml").
+		SetMethod("POST")
+
+	apis[82] = I.DB.API.Create().
+		SetPath("/api/admin/saml/provider").
+		SetDescription("apiDesc.deleteSamlProvider").
+		SetAPIGroup("saml").
+		SetMethod("DELETE")
+
+	apis[83] = I.DB.API.Create().
+		SetPath("/api/admin/saml/provider/list").
+		SetDescription("apiDesc.samlProviderList").
+		SetAPIGroup("saml").
+		SetMethod("POST")
+
+	apis[84] = I.DB.API.Create().
+		SetPath("/api/admin/saml/link").
+		SetDescription("apiDesc.linkSamlIdentity").
+		SetAPIGroup("saml").
+		SetMethod("POST")
+
+	apis[85] = I.DB.API.Create().
+		SetPath("/api/admin/user/impersonate").
+		SetDescription("apiDesc.impersonate").
+		SetAPIGroup("user").
+		SetMethod("POST")
+
+	apis[86] = I.DB.API.Create().
+		SetPath("/api/admin/user/impersonate/end").
+		SetDescription("apiDesc.endImpersonation").
+		SetAPIGroup("user").
+		SetMethod("POST")
+
+	apis[87] = I.DB.API.Create().
+		SetPath("/api/admin/session/list").
+		SetDescription("apiDesc.sessionList").
+		SetAPIGroup("session").
+		SetMethod("POST")
+
+	apis[88] = I.DB.API.Create().
+		SetPath("/api/admin/session/revoke").
+		SetDescription("apiDesc.revokeSession").
+		SetAPIGroup("session").
+		SetMethod("POST")
+
+	apis[89] = I.DB.API.Create().
+		SetPath("/api/admin/session/revoke-user").
+		SetDescription("apiDesc.revokeUserSessions").
+		SetAPIGroup("session").
+		SetMethod("POST")
+
+	apis[90] = I.DB.API.Create().
+		SetPath("/api/admin/user/session/list").
+		SetDescription("apiDesc.mySessionList").
+		SetAPIGroup("user").
+		SetMethod("POST")
+
+	apis[91] = I.DB.API.Create().
+		SetPath("/api/admin/user/session/revoke").
+		SetDescription("apiDesc.revokeMySession").
+		SetAPIGroup("user").
+		SetMethod("POST")
+
+	apis[92] = I.DB.API.Create().
+		SetPath("/api/admin/user/session/revoke-others").
+		SetDescription("apiDesc.revokeMyOtherSessions").
+		SetAPIGroup("user").
+		SetMethod("POST")
+
+	apis[93] = I.DB.API.Create().
+		SetPath("/api/admin/login-event/list").
+		SetDescription("apiDesc.loginEventList").
+		SetAPIGroup("loginEvent").
+		SetMethod("POST")
+
+	apis[94] = I.DB.API.Create().
+		SetPath("/api/admin/user/login-event/list").
+		SetDescription("apiDesc.myLoginEventList").
+		SetAPIGroup("user").
+		SetMethod("POST")
+
+	// TENANT
+	apis[95] = I.DB.API.Create().
+		SetPath("/api/admin/tenant/create").
+		SetDescription("apiDesc.createTenant").
+		SetAPIGroup("tenant").
+		SetMethod("POST")
+
+	apis[96] = I.DB.API.Create().
+		SetPath("/api/admin/tenant/update").
+		SetDescription("apiDesc.updateTenant").
+		SetAPIGroup("tenant").
+		SetMethod("POST")
+
+	apis[97] = I.DB.API.Create().
+		SetPath("/api/admin/tenant/list").
+		SetDescription("apiDesc.tenantList").
+		SetAPIGroup("tenant").
+		SetMethod("POST")
+
+	apis[98] = I.DB.API.Create().
+		SetPath("/api/admin/tenant/status").
+		SetDescription("apiDesc.updateTenantStatus").
+		SetAPIGroup("tenant").
+		SetMethod("POST")
+
+	apis[99] = I.DB.API.Create().
+		SetPath("/api/admin/user/role/switch").
+		SetDescription("apiDesc.switchRole").
+		SetAPIGroup("user").
+		SetMethod("POST")
+
+	apis[100] = I.DB.API.Create().
+		SetPath("/api/admin/role/tree").
+		SetDescription("apiDesc.roleTree").
+		SetAPIGroup("role").
+		SetMethod("GET")
+
 	err := I.DB.API.CreateBulk(apis...).Exec(ctx)
 	if err != nil {
 		return fmt.Errorf("db failed: %w", err)
@@ -763,8 +1093,13 @@ func (I *InitDatabase) insertCasbinPoliciesData(ctx context.Context) error {
 	}
 
 	var policies [][]string
+	domain := tenant.Domain(tenant.DefaultID)
 	for _, v := range apis {
-		policies = append(policies, []string{"1", v.Path, v.Method})
+		policies = append(policies, []string{"1", domain, v.Path, v.Method})
+		// an impersonation may only read by default
+		if v.Method == "GET" {
+			policies = append(policies, []string{"4", domain, v.Path, v.Method})
+		}
 	}
 
 	addResult, err := I.Csb.AddPolicies(policies)
@@ -11,6 +11,7 @@ import (
 	"errors"
 	"fmt"
 	"formulago/biz/domain/admin"
+	"formulago/configs"
 	"formulago/data"
 	"formulago/pkg/encrypt"
 	"strconv"
@@ -18,20 +19,31 @@ import (
 
 	"formulago/data/ent"
 	"formulago/data/ent/user"
+	"formulago/data/ent/useridentity"
 	"github.com/cloudwego/hertz/pkg/common/hlog"
 )
 
 type Login struct {
-	Data *data.Data
+	Data   *data.Data
+	Config configs.Config
 }
 
-func NewLogin(data *data.Data) admin.Login {
+func NewLogin(data *data.Data, config configs.Config) admin.Login {
 	return &Login{
-		Data: data,
+		Data:   data,
+		Config: config,
 	}
 }
 
 func (l *Login) Login(ctx context.Context, username, password string) (res *admin.LoginResp, err error) {
+	if l.Config.LDAP.Enable {
+		res, err = l.loginByLDAP(ctx, username, password)
+		// the users not in the directory or rejected by it are tried as local accounts, so is everyone while it is unreachable
+		if err == nil || !errors.Is(err, errLDAPFallback) {
+			return res, err
+		}
+		hlog.Info("ldap login falls back to the local accounts, username: ", username, " err: ", err)
+	}
 	// check username
 	result, err := l.Data.DBClient.User.Query().Where(user.UsernameEQ(username), user.Status(1)).Only(ctx)
 	if err != nil {
@@ -51,38 +63,37 @@ func (l *Login) Login(ctx context.Context, username, password string) (res *admi
 	res.UserID = result.ID
 	// get role info
 	res.RoleID = result.RoleID
+	res.TokenVersion = result.TokenVersion
+	res.TenantID = result.TenantID
 	res.RoleName, res.RoleValue, err = l.getRoleInfo(ctx, result.RoleID)
 
 	return
 }
 
-// LoginByOAuth only use for oauth2.0 login, should have been Authenticated by oauth
-func (l *Login) LoginByOAuth(ctx context.Context, provider, credential string) (res *admin.LoginResp, err error) {
-	// check credential
-	var userInfo *ent.User
-	switch provider {
-	case "wecom":
-		// check wecom
-		var err error
-		userInfo, err = l.Data.DBClient.User.Query().Where(user.Wecom(credential), user.Status(1)).Only(ctx)
-		if err != nil {
-			return nil, err
-		}
-		if userInfo == nil {
-			err = errors.New("login user not exist, find by wecom")
-			return nil, err
-		}
-	default:
-		// check username
-		var err error
-		userInfo, err = l.Data.DBClient.User.Query().Where(user.Username(credential), user.Status(1)).Only(ctx)
-		if err != nil {
-			return nil, err
-		}
-		if userInfo == nil {
-			err = errors.New("login user not exist, find by username")
-			return nil, err
+// LoginByOAuth only use for oauth2.0 login, should have been Authenticated by oauth,
+// the user is found by the account linked to it, never by the username or other fields
+func (l *Login) LoginByOAuth(ctx context.Context, provider, subject string) (res *admin.LoginResp, err error) {
+	identity, err := l.Data.DBClient.UserIdentity.Query().
+		Where(useridentity.Provider(provider), useridentity.Subject(subject)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.New("the account is not linked to any user, sign in and link it in the profile first")
 		}
+		return nil, err
+	}
+	userInfo, err := l.Data.DBClient.User.Get(ctx, identity.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if userInfo.PendingApproval {
+		return nil, errors.New("the user is waiting for the approval of an admin")
+	}
+	if userInfo.Status != 1 {
+		return nil, errors.New("login user is inactive")
+	}
+	if err = identity.Update().SetLastLoginAt(time.Now()).Exec(ctx); err != nil {
+		hlog.Error(err, "update the last login time of the user identity error")
 	}
 
 	res = new(admin.LoginResp)
@@ -90,6 +101,8 @@ func (l *Login) LoginByOAuth(ctx context.Context, provider, credential string) (
 	res.UserID = userInfo.ID
 	// get role info
 	res.RoleID = userInfo.RoleID
+	res.TokenVersion = userInfo.TokenVersion
+	res.TenantID = userInfo.TenantID
 	res.RoleName, res.RoleValue, err = l.getRoleInfo(ctx, userInfo.RoleID)
 
 	return
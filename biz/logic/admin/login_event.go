@@ -0,0 +1,191 @@
+/*
+ * Copyright 2023 FormulaGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ */
+
+package admin
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"formulago/biz/domain/admin"
+	"formulago/configs"
+	"formulago/data"
+	"formulago/data/ent"
+	"formulago/data/ent/loginevent"
+	"formulago/data/ent/predicate"
+	"formulago/data/ent/user"
+	"formulago/pkg/tenant"
+	"formulago/pkg/times"
+	"formulago/pkg/types"
+)
+
+type LoginEvent struct {
+	Data   *data.Data
+	Config configs.Config
+}
+
+func NewLoginEvent(data *data.Data, config configs.Config) admin.LoginEvent {
+	return &LoginEvent{
+		Data:   data,
+		Config: config,
+	}
+}
+
+func (l *LoginEvent) Record(ctx context.Context, req *admin.LoginEventInfo) error {
+	req.Username = types.SubStrByLen(req.Username, 255)
+	req.UserAgent = types.SubStrByLen(req.UserAgent, 512)
+	req.Reason = types.SubStrByLen(req.Reason, 255)
+	// the failures of an existing username belong to the user, who sees them in the own history
+	var predicates []predicate.User
+	switch {
+	case req.UserID != 0:
+		predicates = append(predicates, user.IDEQ(req.UserID))
+	case req.Username != "":
+		predicates = append(predicates, user.Username(req.Username))
+	}
+	// the event belongs to the tenant of the user, the attempts of unknown users to the default tenant
+	tenantID := tenant.DefaultID
+	if len(predicates) > 0 {
+		userEnt, err := l.Data.DBClient.User.Query().Where(predicates...).Only(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return fmt.Errorf("get login user failed: %w", err)
+		}
+		if userEnt != nil {
+			req.UserID = userEnt.ID
+			tenantID = userEnt.TenantID
+		}
+	}
+	flags, err := l.flags(ctx, req)
+	if err != nil {
+		return err
+	}
+	req.Flags = flags
+	err = l.Data.DBClient.LoginEvent.Create().
+		SetTenantID(tenantID).
+		SetUserID(req.UserID).
+		SetUsername(req.Username).
+		SetMethod(req.Method).
+		SetIP(req.IP).
+		SetUserAgent(req.UserAgent).
+		SetDevice(req.Device).
+		SetSuccess(req.Success).
+		SetReason(req.Reason).
+		SetFlags(flags).
+		SetAnomalous(len(flags) > 0).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("create login event failed: %w", err)
+	}
+	return nil
+}
+
+// flags compares the attempt with the history, the first successful login of a user is not flagged
+// as new since there is nothing to compare with
+func (l *LoginEvent) flags(ctx context.Context, req *admin.LoginEventInfo) (flags []string, err error) {
+	if req.Success && req.UserID != 0 {
+		succeeded := loginevent.And(loginevent.UserID(req.UserID), loginevent.Success(true))
+		known, err := l.Data.DBClient.LoginEvent.Query().Where(succeeded).Exist(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("query login history failed: %w", err)
+		}
+		if known {
+			exist, err := l.Data.DBClient.LoginEvent.Query().Where(succeeded, loginevent.IP(req.IP)).Exist(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("query login history failed: %w", err)
+			}
+			if !exist {
+				flags = append(flags, admin.LoginFlagNewIP)
+			}
+			exist, err = l.Data.DBClient.LoginEvent.Query().Where(succeeded, loginevent.Device(req.Device)).Exist(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("query login history failed: %w", err)
+			}
+			if !exist {
+				flags = append(flags, admin.LoginFlagNewDevice)
+			}
+		}
+	}
+
+	// the failures of the username or the IP within the window, this attempt included
+	var subjects []predicate.LoginEvent
+	if req.Username != "" {
+		subjects = append(subjects, loginevent.Username(req.Username))
+	}
+	if req.IP != "" {
+		subjects = append(subjects, loginevent.IP(req.IP))
+	}
+	if len(subjects) == 0 {
+		return flags, nil
+	}
+	window := configSeconds(l.Config.LoginEvent.FailureWindow, time.Hour)
+	threshold := l.Config.LoginEvent.FailureThreshold
+	if threshold <= 0 {
+		threshold = 5
+	}
+	failures, err := l.Data.DBClient.LoginEvent.Query().
+		Where(loginevent.Or(subjects...), loginevent.Success(false), loginevent.CreatedAtGT(time.Now().Add(-window))).
+		Count(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("count login failures failed: %w", err)
+	}
+	if !req.Success {
+		failures++
+	}
+	if failures >= threshold {
+		flags = append(flags, admin.LoginFlagManyFailures)
+	}
+	return flags, nil
+}
+
+func (l *LoginEvent) List(ctx context.Context, req *admin.LoginEventListReq) (list []*admin.LoginEventInfo, total int, err error) {
+	var predicates []predicate.LoginEvent
+	if req.UserID != 0 {
+		predicates = append(predicates, loginevent.UserID(req.UserID))
+	}
+	if req.Username != "" {
+		predicates = append(predicates, loginevent.UsernameContainsFold(req.Username))
+	}
+	if req.Method != "" {
+		predicates = append(predicates, loginevent.Method(req.Method))
+	}
+	if req.IP != "" {
+		predicates = append(predicates, loginevent.IP(req.IP))
+	}
+	if req.Success != nil {
+		predicates = append(predicates, loginevent.Success(*req.Success))
+	}
+	if req.Anomalous {
+		predicates = append(predicates, loginevent.Anomalous(true))
+	}
+	events, err := l.Data.DBClient.LoginEvent.Query().Where(predicates...).
+		Offset(int((req.Page-1)*req.PageSize)).
+		Limit(int(req.PageSize)).
+		Order(ent.Desc(loginevent.FieldCreatedAt), ent.Desc(loginevent.FieldID)).All(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("query login event list failed: %w", err)
+	}
+	for _, v := range events {
+		list = append(list, &admin.LoginEventInfo{
+			ID:        v.ID,
+			UserID:    v.UserID,
+			Username:  v.Username,
+			Method:    v.Method,
+			IP:        v.IP,
+			UserAgent: v.UserAgent,
+			Device:    v.Device,
+			Success:   v.Success,
+			Reason:    v.Reason,
+			Flags:     v.Flags,
+			CreatedAt: v.CreatedAt.Format(times.TimeFormat),
+		})
+	}
+	total, err = l.Data.DBClient.LoginEvent.Query().Where(predicates...).Count(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("query login event count failed: %w", err)
+	}
+	return
+}
@@ -0,0 +1,77 @@
+/*
+ * Copyright 2023 FormulaGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ */
+
+package admin
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"formulago/biz/domain/admin"
+	"formulago/configs"
+	"formulago/data/ent/loginevent"
+)
+
+func TestLoginEvent_Record(t *testing.T) {
+	ctx := context.Background()
+	d := newTestData(t)
+	userEnt := newTestUser(t, d, "alice", 1)
+	config := configs.Config{}
+	config.LoginEvent.FailureThreshold = 3
+	events := NewLoginEvent(d, config)
+
+	// the attempts are recorded in order, each one is compared with those before
+	tests := []struct {
+		name      string
+		event     admin.LoginEventInfo
+		wantUser  uint64
+		wantFlags []string
+	}{
+		{name: "first login", event: admin.LoginEventInfo{UserID: userEnt.ID, IP: "10.0.0.1", Device: "Chrome on macOS", Success: true},
+			wantUser: userEnt.ID},
+		{name: "known ip and device", event: admin.LoginEventInfo{UserID: userEnt.ID, IP: "10.0.0.1", Device: "Chrome on macOS", Success: true},
+			wantUser: userEnt.ID},
+		{name: "new ip", event: admin.LoginEventInfo{UserID: userEnt.ID, IP: "10.0.0.2", Device: "Chrome on macOS", Success: true},
+			wantUser: userEnt.ID, wantFlags: []string{admin.LoginFlagNewIP}},
+		{name: "new ip and device", event: admin.LoginEventInfo{UserID: userEnt.ID, IP: "10.0.0.3", Device: "Safari on iOS", Success: true},
+			wantUser: userEnt.ID, wantFlags: []string{admin.LoginFlagNewIP, admin.LoginFlagNewDevice}},
+		{name: "failure of a known username", event: admin.LoginEventInfo{Username: "alice", IP: "10.0.0.9", Reason: "wrong password"},
+			wantUser: userEnt.ID},
+		{name: "failure of an unknown username", event: admin.LoginEventInfo{Username: "mallory", IP: "10.0.0.9", Reason: "login user not exist"}},
+		{name: "failures of the ip reach the threshold", event: admin.LoginEventInfo{Username: "trudy", IP: "10.0.0.9", Reason: "login user not exist"},
+			wantFlags: []string{admin.LoginFlagManyFailures}},
+		{name: "second failure of the username", event: admin.LoginEventInfo{Username: "alice", IP: "10.0.0.7", Reason: "wrong password"},
+			wantUser: userEnt.ID},
+		{name: "failures of the username reach the threshold", event: admin.LoginEventInfo{Username: "alice", IP: "10.0.0.8", Reason: "wrong password"},
+			wantUser: userEnt.ID, wantFlags: []string{admin.LoginFlagManyFailures}},
+		{name: "success after the failures", event: admin.LoginEventInfo{UserID: userEnt.ID, Username: "alice", IP: "10.0.0.1", Device: "Chrome on macOS", Success: true},
+			wantUser: userEnt.ID, wantFlags: []string{admin.LoginFlagManyFailures}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := tt.event
+			event.Method = "password"
+			if err := events.Record(ctx, &event); err != nil {
+				t.Fatal(err)
+			}
+			recorded, err := d.DBClient.LoginEvent.Query().Order(loginevent.ByID()).All(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			last := recorded[len(recorded)-1]
+			if last.UserID != tt.wantUser {
+				t.Errorf("recorded user %d, want %d", last.UserID, tt.wantUser)
+			}
+			if !slices.Equal(last.Flags, tt.wantFlags) || last.Anomalous != (len(tt.wantFlags) > 0) {
+				t.Errorf("recorded flags %v anomalous %v, want %v", last.Flags, last.Anomalous, tt.wantFlags)
+			}
+			if last.Success != tt.event.Success || last.Method != "password" || last.TenantID != 1 {
+				t.Errorf("unexpected recorded event %+v", last)
+			}
+		})
+	}
+}
@@ -0,0 +1,152 @@
+/*
+ * Copyright 2023 FormulaGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ */
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"formulago/biz/domain/admin"
+	"formulago/data/ent"
+	"formulago/data/ent/user"
+	"formulago/data/ent/useridentity"
+	"formulago/pkg/ldap"
+	"formulago/pkg/rolerule"
+)
+
+// ldapProvider the provider of the accounts of the directory in user_identities
+const ldapProvider = "ldap"
+
+// errLDAPFallback the directory does not know the user, rejects the password or is unreachable, the local accounts are tried
+var errLDAPFallback = errors.New("ldap login not possible")
+
+// loginByLDAP verifies the password by the directory, and finds the user by the linked account of the directory,
+// a new user is created for the account at the first login, the local user of the same username is never taken over
+func (l *Login) loginByLDAP(ctx context.Context, username, password string) (*admin.LoginResp, error) {
+	c := l.Config.LDAP
+	entry, err := ldap.New(ldap.Config{
+		URL:                  c.URL,
+		StartTLS:             c.StartTLS,
+		InsecureSkipVerify:   c.InsecureSkipVerify,
+		BindDN:               c.BindDN,
+		BindPassword:         c.BindPassword,
+		BaseDN:               c.BaseDN,
+		UserFilter:           c.UserFilter,
+		GroupBaseDN:          c.GroupBaseDN,
+		GroupFilter:          c.GroupFilter,
+		UsernameAttribute:    c.Attributes.Username,
+		MailAttribute:        c.Attributes.Mail,
+		MobileAttribute:      c.Attributes.Mobile,
+		DisplayNameAttribute: c.Attributes.DisplayName,
+		GroupNameAttribute:   c.Attributes.GroupName,
+		Timeout:              time.Duration(c.Timeout) * time.Second,
+	}).Authenticate(ctx, username, password)
+	if err != nil {
+		// a local account of the same username as a directory account keeps its own password
+		return nil, fmt.Errorf("%w: %w", errLDAPFallback, err)
+	}
+
+	userInfo := &admin.OauthUserInfo{
+		Provider: ldapProvider,
+		Subject:  strings.ToLower(entry.Username),
+		Username: entry.Username,
+		Email:    entry.Mail,
+		// the mails of the directory are set by its admins
+		EmailVerified: entry.Mail != "",
+		Mobile:        entry.Mobile,
+		NickName:      entry.DisplayName,
+		Groups:        entry.Groups,
+	}
+	roleID, err := rolerule.Match(c.RoleRules, rolerule.Profile{Email: entry.Mail, EmailVerified: userInfo.EmailVerified, Groups: entry.Groups})
+	if err != nil {
+		roleID = 0
+	}
+	u, err := l.ldapUser(ctx, userInfo, roleID)
+	if err != nil {
+		return nil, err
+	}
+	if u.Status != 1 {
+		return nil, errors.New("login user is inactive")
+	}
+
+	res := new(admin.LoginResp)
+	res.Username = u.Username
+	res.UserID = u.ID
+	res.RoleID = u.RoleID
+	res.TokenVersion = u.TokenVersion
+	res.TenantID = u.TenantID
+	res.RoleName, res.RoleValue, err = l.getRoleInfo(ctx, u.RoleID)
+	return res, err
+}
+
+// ldapUser returns the user of the account with the attributes and the role of the directory synced,
+// the role is kept if no rule matches
+func (l *Login) ldapUser(ctx context.Context, userInfo *admin.OauthUserInfo, roleID uint64) (*ent.User, error) {
+	identity, err := l.Data.DBClient.UserIdentity.Query().
+		Where(useridentity.Provider(ldapProvider), useridentity.Subject(userInfo.Subject)).
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, fmt.Errorf("get user identity failed: %w", err)
+	}
+	if identity == nil {
+		// the local user of the same username may have a password or roles of its own, it is not linked
+		if roleID == 0 {
+			roleID = l.Config.LDAP.DefaultRoleID
+		}
+		if roleID == 0 {
+			return nil, errors.New("the ldap account matches no role rule and no default role is configured")
+		}
+		return createLinkedUser(ctx, l.Data, ldapProvider, userInfo, roleID, false)
+	}
+	u, err := l.Data.DBClient.User.Get(ctx, identity.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("get user failed: %w", err)
+	}
+
+	update := u.Update()
+	if userInfo.Email != "" {
+		update.SetEmail(userInfo.Email)
+	}
+	if userInfo.Mobile != "" && userInfo.Mobile != u.Mobile {
+		if mobile, err := unusedOr(ctx, l.Data, userInfo.Mobile, u.Mobile, user.Mobile); err == nil {
+			update.SetMobile(mobile)
+		}
+	}
+	if userInfo.NickName != "" && userInfo.NickName != u.Nickname {
+		if nickname, err := unusedOr(ctx, l.Data, userInfo.NickName, u.Nickname, user.Nickname); err == nil {
+			update.SetNickname(nickname)
+		}
+	}
+	if roleID != 0 {
+		roleEnt, err := l.Data.DBClient.Role.Get(ctx, roleID)
+		if err != nil {
+			return nil, fmt.Errorf("get role failed: %w", err)
+		}
+		// the tenant of a user never changes
+		if roleEnt.TenantID != u.TenantID {
+			return nil, errors.New("the role of the ldap rules belongs to another tenant")
+		}
+		// the directory decides the roles of the user, those assigned in the admin are replaced
+		update.SetRoleID(roleID).ClearRoles().AddRoleIDs(roleID)
+	}
+	if u, err = update.Save(ctx); err != nil {
+		return nil, fmt.Errorf("sync ldap user failed: %w", err)
+	}
+	l.Data.Cache.Delete("userInfo" + strconv.Itoa(int(u.ID)))
+	l.Data.Cache.Delete("userRoles" + strconv.Itoa(int(u.ID)))
+	if _, err = l.Data.DBClient.UserIdentity.Update().
+		Where(useridentity.Provider(ldapProvider), useridentity.Subject(userInfo.Subject)).
+		SetLastLoginAt(time.Now()).
+		Save(ctx); err != nil {
+		return nil, fmt.Errorf("update user identity failed: %w", err)
+	}
+	return u, nil
+}
@@ -0,0 +1,61 @@
+/*
+ * Copyright 2023 FormulaGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ */
+
+package admin
+
+import (
+	"context"
+	"testing"
+
+	"formulago/biz/domain/admin"
+	"formulago/configs"
+)
+
+func TestLogin_ldapUser(t *testing.T) {
+	ctx := context.Background()
+	d := newTestData(t)
+	newTestRole(t, d, "admin")
+	member := newTestRole(t, d, "member")
+	// a local admin of the same username as the account of the directory
+	local := newTestUser(t, d, "alice", 1)
+	l := &Login{Data: d, Config: configs.Config{}}
+	l.Config.LDAP.DefaultRoleID = member.ID
+
+	userInfo := &admin.OauthUserInfo{Provider: ldapProvider, Subject: "alice", Username: "alice", Email: "alice@example.com"}
+	first, err := l.ldapUser(ctx, userInfo, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first.ID == local.ID || first.Username == local.Username {
+		t.Fatalf("the local user %s is taken over by the directory account", local.Username)
+	}
+	if first.RoleID != member.ID {
+		t.Errorf("got role %d of the new user, want the default role %d", first.RoleID, member.ID)
+	}
+
+	// the next login finds the user by the linked account
+	second, err := l.ldapUser(ctx, userInfo, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if second.ID != first.ID {
+		t.Errorf("got user %d at the next login, want the linked user %d", second.ID, first.ID)
+	}
+	unchanged, err := d.DBClient.User.Get(ctx, local.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if unchanged.Email != "" || unchanged.RoleID != 1 {
+		t.Errorf("the local user is changed by the directory: %+v", unchanged)
+	}
+
+	// without a matching rule nor a default role, the new account is rejected
+	l.Config.LDAP.DefaultRoleID = 0
+	userInfo = &admin.OauthUserInfo{Provider: ldapProvider, Subject: "bobby", Username: "bobby"}
+	if _, err = l.ldapUser(ctx, userInfo, 0); err == nil {
+		t.Error("ldapUser() without a role should fail")
+	}
+}
@@ -0,0 +1,128 @@
+/*
+ * Copyright 2023 FormulaGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ */
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"formulago/biz/domain/admin"
+	"formulago/configs"
+	"formulago/data"
+	"formulago/pkg/lockout"
+
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+)
+
+type LoginLimit struct {
+	Data   *data.Data
+	Config configs.Config
+}
+
+func NewLoginLimit(data *data.Data, config configs.Config) admin.LoginLimit {
+	return &LoginLimit{
+		Data:   data,
+		Config: config,
+	}
+}
+
+// loginLimitKey the failures and the lock of a username or an IP
+type loginLimitKey struct {
+	fail   string
+	lock   string
+	policy lockout.Policy
+}
+
+func (l *LoginLimit) Check(ctx context.Context, username, ip string) (delay time.Duration, err error) {
+	for _, key := range l.keys(username, ip) {
+		locked, ttl, err := l.Data.CacheCounter(ctx, key.lock)
+		if err != nil {
+			return 0, fmt.Errorf("get login lock failed: %w", err)
+		}
+		if locked > 0 {
+			return 0, fmt.Errorf("%w, please try again in %s", admin.ErrLoginLocked, ttl.Round(time.Second))
+		}
+		failures, _, err := l.Data.CacheCounter(ctx, key.fail)
+		if err != nil {
+			return 0, fmt.Errorf("get login failures failed: %w", err)
+		}
+		if d := key.policy.Delay(failures); d > delay {
+			delay = d
+		}
+	}
+	return delay, nil
+}
+
+func (l *LoginLimit) Fail(ctx context.Context, username, ip string) error {
+	window := configSeconds(l.Config.LoginLimit.Window, 15*time.Minute)
+	lockDuration := configSeconds(l.Config.LoginLimit.LockDuration, 15*time.Minute)
+	var errs []error
+	for _, key := range l.keys(username, ip) {
+		failures, err := l.Data.CacheIncr(ctx, key.fail, window)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("count login failure failed: %w", err))
+			continue
+		}
+		if !key.policy.Locked(failures) {
+			continue
+		}
+		hlog.Warn("login locked after ", failures, " failures: ", key.lock)
+		if _, err = l.Data.CacheIncr(ctx, key.lock, lockDuration); err != nil {
+			errs = append(errs, fmt.Errorf("lock login failed: %w", err))
+			continue
+		}
+		// counting starts again when the lock expires
+		if err = l.Data.CacheDelete(ctx, key.fail); err != nil {
+			errs = append(errs, fmt.Errorf("clear login failures failed: %w", err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (l *LoginLimit) Succeed(ctx context.Context, username string) error {
+	// failures of the IP are kept, one valid account must not hide the guessing of others
+	return l.Data.CacheDelete(ctx, "loginFailUser"+username)
+}
+
+func (l *LoginLimit) Unlock(ctx context.Context, username, ip string) error {
+	if username == "" && ip == "" {
+		return errors.New("username or ip is required")
+	}
+	var errs []error
+	for _, key := range l.keys(username, ip) {
+		errs = append(errs, l.Data.CacheDelete(ctx, key.lock), l.Data.CacheDelete(ctx, key.fail))
+	}
+	return errors.Join(errs...)
+}
+
+func (l *LoginLimit) keys(username, ip string) (keys []loginLimitKey) {
+	c := l.Config.LoginLimit
+	policy := lockout.Policy{
+		DelayAfter: c.DelayAfter,
+		BaseDelay:  time.Duration(c.BaseDelay) * time.Millisecond,
+		MaxDelay:   time.Duration(c.MaxDelay) * time.Millisecond,
+	}
+	if username != "" {
+		policy.MaxFailures = c.UsernameMaxFailures
+		keys = append(keys, loginLimitKey{fail: "loginFailUser" + username, lock: "loginLockUser" + username, policy: policy})
+	}
+	if ip != "" {
+		policy.MaxFailures = c.IPMaxFailures
+		keys = append(keys, loginLimitKey{fail: "loginFailIP" + ip, lock: "loginLockIP" + ip, policy: policy})
+	}
+	return keys
+}
+
+// configSeconds returns the configured seconds, or the default if not configured
+func configSeconds(n int, def time.Duration) time.Duration {
+	if n <= 0 {
+		return def
+	}
+	return time.Duration(n) * time.Second
+}
@@ -43,6 +43,7 @@ func (l *Logs) Create(ctx context.Context, logsReq *admin.LogsInfo) error {
 		SetIP(logsReq.Ip).
 		SetUserAgent(logsReq.UserAgent).
 		SetOperator(logsReq.Operator).
+		SetImpersonatedUser(logsReq.ImpersonatedUser).
 		SetTime(int(logsReq.Time)).
 		Exec(ctx)
 	if err != nil {
@@ -78,18 +79,19 @@ func (l *Logs) List(ctx context.Context, req *admin.LogsListReq) (list []*admin.
 	}
 	for _, v := range logsData {
 		list = append(list, &admin.LogsInfo{
-			Type:        v.Type,
-			Method:      v.Method,
-			Api:         v.API,
-			Success:     v.Success,
-			ReqContent:  v.ReqContent,
-			RespContent: v.RespContent,
-			Ip:          v.IP,
-			UserAgent:   v.UserAgent,
-			Operator:    v.Operator,
-			Time:        uint32(v.Time),
-			CreatedAt:   v.CreatedAt.Format(times.TimeFormat),
-			UpdatedAt:   v.UpdatedAt.Format(times.TimeFormat),
+			Type:             v.Type,
+			Method:           v.Method,
+			Api:              v.API,
+			Success:          v.Success,
+			ReqContent:       v.ReqContent,
+			RespContent:      v.RespContent,
+			Ip:               v.IP,
+			UserAgent:        v.UserAgent,
+			Operator:         v.Operator,
+			Time:             uint32(v.Time),
+			CreatedAt:        v.CreatedAt.Format(times.TimeFormat),
+			UpdatedAt:        v.UpdatedAt.Format(times.TimeFormat),
+			ImpersonatedUser: v.ImpersonatedUser,
 		})
 	}
 	total, err = l.Data.DBClient.Logs.Query().Where(predicates...).Count(ctx)
@@ -148,9 +148,18 @@ func (m *Menu) Delete(ctx context.Context, id uint64) error {
 	return nil
 }
 
-func (m *Menu) ListByRole(ctx context.Context, roleID uint64) (list []*admin.MenuInfoTree, total uint64, err error) {
-	menus, err := m.Data.DBClient.Role.Query().Where(role.IDEQ(roleID)).
-		QueryMenus().Order(ent.Asc(menu.FieldOrderNo)).All(ctx)
+func (m *Menu) ListByRoles(ctx context.Context, roleIDs []uint64) (list []*admin.MenuInfoTree, total uint64, err error) {
+	// the roles inherit the menus of their active ancestors
+	var ids []uint64
+	for _, id := range roleIDs {
+		ancestors, err := inheritedRoles(ctx, m.Data, id)
+		if err != nil {
+			return nil, 0, err
+		}
+		ids = append(ids, ancestors...)
+	}
+	menus, err := m.Data.DBClient.Menu.Query().Where(menu.HasRolesWith(role.IDIn(ids...))).
+		Order(ent.Asc(menu.FieldOrderNo)).All(ctx)
 	if err != nil {
 		return nil, 0, fmt.Errorf("query m by role failed: %w", err)
 	}
@@ -7,25 +7,48 @@
 package admin
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
-"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
 	"formulago/biz/domain/admin"
-	"formulago/pkg/times"
 	"formulago/configs"
 	"formulago/data"
+	"formulago/data/ent"
 	"formulago/data/ent/oauthprovider"
 	"formulago/data/ent/predicate"
+	"formulago/data/ent/useridentity"
+	"formulago/pkg/encrypt"
+	"formulago/pkg/jsonpath"
+	"formulago/pkg/rolerule"
+	"formulago/pkg/times"
 	"formulago/pkg/wecom"
-	"github.com/cloudwego/hertz/pkg/common/json"
+
+	"github.com/coreos/go-oidc/v3/oidc"
 	"golang.org/x/oauth2"
-	"io"
-	"net/http"
-	"net/url"
-	"strings"
-	"time"
 )
 
+const (
+	// oauthStateExpire the time the user has to sign in at the provider
+	oauthStateExpire = 10 * time.Minute
+	// oauthClientExpire the provider configuration is reloaded after it, so that updates reach every instance
+	oauthClientExpire = 10 * time.Minute
+	// oauthResponseLimit the largest user info response read
+	oauthResponseLimit = 1 << 20
+)
+
+// oauthHTTPClient every request to the providers is bound to the request context and this timeout
+var oauthHTTPClient = &http.Client{Timeout: 10 * time.Second}
+
 type Oauth struct {
 	Data   *data.Data
 	Config configs.Config
@@ -38,7 +61,29 @@ func NewOauth(data *data.Data, config configs.Config) admin.Oauth {
 	}
 }
 
+// oauthClient is the cached configuration of a provider
+type oauthClient struct {
+	provider *ent.OauthProvider
+	config   oauth2.Config
+	infoURL  string
+	// verifier verifies the id_token, only set for OIDC providers
+	verifier *oidc.IDTokenVerifier
+	mapping  claimMapping
+}
+
+// oauthState is kept server-side under the state parameter until the callback
+type oauthState struct {
+	Provider string `json:"provider"`
+	Verifier string `json:"verifier"`
+	Nonce    string `json:"nonce"`
+	// UserID the user linking the account, zero for the logins
+	UserID uint64 `json:"userID,omitempty"`
+}
+
 func (o *Oauth) Create(ctx context.Context, providerReq *admin.ProviderInfo) error {
+	if err := validateProvider(providerReq); err != nil {
+		return err
+	}
 	_, err := o.Data.DBClient.OauthProvider.Create().
 		SetName(providerReq.Name).
 		SetClientID(providerReq.ClientID).
@@ -49,6 +94,12 @@ func (o *Oauth) Create(ctx context.Context, providerReq *admin.ProviderInfo) err
 		SetTokenURL(providerReq.TokenUrl).
 		SetAuthStyle(providerReq.AuthStyle).
 		SetInfoURL(providerReq.InfoUrl).
+		SetDiscoveryURL(providerReq.DiscoveryUrl).
+		SetClaimMapping(providerReq.ClaimMapping).
+		SetAutoProvision(providerReq.AutoProvision).
+		SetProvisionApproval(providerReq.ProvisionApproval).
+		SetDefaultRoleID(providerReq.DefaultRoleID).
+		SetRoleRules(providerReq.RoleRules).
 		Save(ctx)
 	if err != nil {
 		return fmt.Errorf("create oauth failed: %w", err)
@@ -57,7 +108,14 @@ func (o *Oauth) Create(ctx context.Context, providerReq *admin.ProviderInfo) err
 }
 
 func (o *Oauth) Update(ctx context.Context, providerReq *admin.ProviderInfo) error {
-	_, err := o.Data.DBClient.OauthProvider.UpdateOneID(providerReq.ID).
+	if err := validateProvider(providerReq); err != nil {
+		return err
+	}
+	old, err := o.Data.DBClient.OauthProvider.Get(ctx, providerReq.ID)
+	if err != nil {
+		return fmt.Errorf("get oauth provider failed: %w", err)
+	}
+	_, err = o.Data.DBClient.OauthProvider.UpdateOneID(providerReq.ID).
 		SetName(providerReq.Name).
 		SetClientID(providerReq.ClientID).
 		SetClientSecret(providerReq.ClientSecret).
@@ -67,18 +125,30 @@ func (o *Oauth) Update(ctx context.Context, providerReq *admin.ProviderInfo) err
 		SetTokenURL(providerReq.TokenUrl).
 		SetAuthStyle(providerReq.AuthStyle).
 		SetInfoURL(providerReq.InfoUrl).
+		SetDiscoveryURL(providerReq.DiscoveryUrl).
+		SetClaimMapping(providerReq.ClaimMapping).
+		SetAutoProvision(providerReq.AutoProvision).
+		SetProvisionApproval(providerReq.ProvisionApproval).
+		SetDefaultRoleID(providerReq.DefaultRoleID).
+		SetRoleRules(providerReq.RoleRules).
 		Save(ctx)
 	if err != nil {
 		return fmt.Errorf("update oauth failed: %w", err)
 	}
+	o.Data.Cache.Delete("oauthProviderClient" + old.Name)
 	return nil
 }
 
 func (o *Oauth) Delete(ctx context.Context, providerID uint64) error {
-	err := o.Data.DBClient.OauthProvider.DeleteOneID(providerID).Exec(ctx)
+	old, err := o.Data.DBClient.OauthProvider.Get(ctx, providerID)
+	if err != nil {
+		return fmt.Errorf("get oauth provider failed: %w", err)
+	}
+	err = o.Data.DBClient.OauthProvider.DeleteOneID(providerID).Exec(ctx)
 	if err != nil {
 		return fmt.Errorf("delete oauth failed: %w", err)
 	}
+	o.Data.Cache.Delete("oauthProviderClient" + old.Name)
 	return nil
 }
 
@@ -98,18 +168,24 @@ func (o *Oauth) List(ctx context.Context, req *admin.OauthListReq) (list []*admi
 
 	for _, provider := range providers {
 		list = append(list, &admin.ProviderInfo{
-			ID:           provider.ID,
-			Name:         provider.Name,
-			ClientID:     provider.ClientID,
-			ClientSecret: provider.ClientSecret,
-			RedirectUrl:  provider.RedirectURL,
-			Scopes:       provider.Scopes,
-			AuthUrl:      provider.AuthURL,
-			TokenUrl:     provider.TokenURL,
-			AuthStyle:    provider.AuthStyle,
-			InfoUrl:      provider.InfoURL,
-			CreatedAt:    provider.CreatedAt.Format(times.TimeFormat),
-			UpdatedAt:    provider.UpdatedAt.Format(times.TimeFormat),
+			ID:                provider.ID,
+			Name:              provider.Name,
+			ClientID:          provider.ClientID,
+			ClientSecret:      provider.ClientSecret,
+			RedirectUrl:       provider.RedirectURL,
+			Scopes:            provider.Scopes,
+			AuthUrl:           provider.AuthURL,
+			TokenUrl:          provider.TokenURL,
+			AuthStyle:         provider.AuthStyle,
+			InfoUrl:           provider.InfoURL,
+			DiscoveryUrl:      provider.DiscoveryURL,
+			ClaimMapping:      provider.ClaimMapping,
+			AutoProvision:     provider.AutoProvision,
+			ProvisionApproval: provider.ProvisionApproval,
+			DefaultRoleID:     provider.DefaultRoleID,
+			RoleRules:         provider.RoleRules,
+			CreatedAt:         provider.CreatedAt.Format(times.TimeFormat),
+			UpdatedAt:         provider.UpdatedAt.Format(times.TimeFormat),
 		})
 	}
 	total, err = o.Data.DBClient.OauthProvider.Query().Where(predicates...).Count(ctx)
@@ -120,36 +196,48 @@ func (o *Oauth) List(ctx context.Context, req *admin.OauthListReq) (list []*admi
 	return list, total, nil
 }
 
+func (o *Oauth) Templates(ctx context.Context) []*admin.ProviderInfo {
+	return copyTemplates()
+}
+
 func (o *Oauth) Login(ctx context.Context, req *admin.OauthLoginReq) (string, error) {
-	provider, err := o.Data.DBClient.OauthProvider.Query().Where(oauthprovider.Name(req.Provider)).First(ctx)
+	return o.authURL(ctx, req, 0)
+}
+
+func (o *Oauth) LinkURL(ctx context.Context, userID uint64, req *admin.OauthLoginReq) (string, error) {
+	return o.authURL(ctx, req, userID)
+}
+
+// authURL returns the authorization URL, the state of a link request carries the user to link the account to
+func (o *Oauth) authURL(ctx context.Context, req *admin.OauthLoginReq, userID uint64) (string, error) {
+	client, err := o.client(ctx, req.Provider)
 	if err != nil {
-		return "", fmt.Errorf("get oauth provider failed: %w", err)
+		return "", err
 	}
+	provider := client.provider
 
-	var config oauth2.Config
-	if v, found := o.Data.Cache.Get("oauthProviderConfig" + provider.Name); found {
-		var ok bool
-		config, ok = v.(oauth2.Config)
-		if !ok {
-			return "", errors.New("get cache provider config failed")
-		}
-	} else {
-		config = oauth2.Config{
-			ClientID:     provider.ClientID,
-			ClientSecret: provider.ClientSecret,
-			Endpoint: oauth2.Endpoint{
-				AuthURL:   provider.AuthURL,
-				TokenURL:  provider.TokenURL,
-				AuthStyle: oauth2.AuthStyle(provider.AuthStyle),
-			},
-			RedirectURL: provider.RedirectURL,
-			Scopes:      strings.Split(provider.Scopes, " "),
+	// the state keeps the {random string}-{provider} format, the provider is read from the stored state though
+	random, err := encrypt.RandomToken(24)
+	if err != nil {
+		return "", fmt.Errorf("generate oauth state failed: %w", err)
+	}
+	state := random + "-" + provider.Name
+	stored := oauthState{Provider: provider.Name, UserID: userID}
+	// wecom and dingtalk exchange the code by their own APIs without PKCE
+	if provider.Name != "wecom" && provider.Name != "dingtalk" {
+		stored.Verifier = oauth2.GenerateVerifier()
+		if client.verifier != nil {
+			if stored.Nonce, err = encrypt.RandomToken(24); err != nil {
+				return "", fmt.Errorf("generate oauth nonce failed: %w", err)
+			}
 		}
-		o.Data.Cache.Set("oauthProviderConfig"+provider.Name, config, 24*time.Hour)
 	}
-
-	if _, ok := o.Data.Cache.Get("oauthProviderUserInfoURL" + provider.Name); !ok {
-		o.Data.Cache.Set("oauthProviderUserInfoURL"+provider.Name, provider.InfoURL, 24*time.Hour)
+	b, err := json.Marshal(stored)
+	if err != nil {
+		return "", err
+	}
+	if err = o.Data.CacheSet(ctx, "oauthState"+state, string(b), oauthStateExpire); err != nil {
+		return "", fmt.Errorf("store oauth state failed: %w", err)
 	}
 
 	var oauthURL string
@@ -157,46 +245,42 @@ func (o *Oauth) Login(ctx context.Context, req *admin.OauthLoginReq) (string, er
 	case "wecom":
 		if req.LoginType == "Inside" {
 			oauthURL = fmt.Sprintf("https://open.weixin.qq.com/connect/oauth2/authorize?appid=%s&redirect_uri=%s&response_type=code&scope=snsapi_base&state=%s&agentid=%s#wechat_redirect",
-				provider.AppID, url.QueryEscape(provider.RedirectURL), req.State, provider.ClientID)
+				provider.AppID, url.QueryEscape(provider.RedirectURL), url.QueryEscape(state), provider.ClientID)
 		} else {
-			oauthURL = config.AuthCodeURL(req.State, oauth2.SetAuthURLParam("login_type", "CorpApp"),
+			oauthURL = client.config.AuthCodeURL(state, oauth2.SetAuthURLParam("login_type", "CorpApp"),
 				oauth2.SetAuthURLParam("appid", provider.AppID),
 				oauth2.SetAuthURLParam("agentid", provider.ClientID))
 		}
+	case "dingtalk":
+		oauthURL = client.config.AuthCodeURL(state, oauth2.SetAuthURLParam("prompt", "consent"))
 	default:
-		oauthURL = config.AuthCodeURL(req.State)
+		opts := []oauth2.AuthCodeOption{oauth2.S256ChallengeOption(stored.Verifier)}
+		if stored.Nonce != "" {
+			opts = append(opts, oidc.Nonce(stored.Nonce))
+		}
+		oauthURL = client.config.AuthCodeURL(state, opts...)
 	}
 
 	return oauthURL, nil
 }
 
 func (o *Oauth) Callback(ctx context.Context, req *admin.OauthCallbackReq) (*admin.OauthUserInfo, error) {
-	if _, found := o.Data.Cache.Get("oauthProviderConfig" + req.ProviderName); !found {
-		provider, err := o.Data.DBClient.OauthProvider.Query().Where(oauthprovider.Name(req.ProviderName)).First(ctx)
-		if err != nil {
-			return nil, fmt.Errorf("get oauth provider failed: %w", err)
-		}
-		config := oauth2.Config{
-			ClientID:     provider.ClientID,
-			ClientSecret: provider.ClientSecret,
-			Endpoint: oauth2.Endpoint{
-				AuthURL:   provider.AuthURL,
-				TokenURL:  provider.TokenURL,
-				AuthStyle: oauth2.AuthStyle(provider.AuthStyle),
-			},
-			RedirectURL: provider.RedirectURL,
-			Scopes:      strings.Split(provider.Scopes, " "),
-		}
-		o.Data.Cache.Set("oauthProviderConfig"+provider.Name, config, 24*time.Hour)
-
-		if _, ok := o.Data.Cache.Get("oauthProviderUserInfoURL" + provider.Name); !ok {
-			o.Data.Cache.Set("oauthProviderUserInfoURL"+provider.Name, provider.InfoURL, 24*time.Hour)
-		}
+	stored, err := o.redeemState(ctx, req.State)
+	if err != nil {
+		return nil, err
+	}
+	// a link started by a user is completed by the same user only, or anyone could be lured into finishing it
+	if stored.UserID != 0 && stored.UserID != req.UserID {
+		return nil, errors.New("the account link was started by another user, sign in and link the account again")
+	}
+	client, err := o.client(ctx, stored.Provider)
+	if err != nil {
+		return nil, err
 	}
 
 	// get user information
 	userInfo := new(admin.OauthUserInfo)
-	switch req.ProviderName {
+	switch stored.Provider {
 	case "wecom":
 		wecom := wecom.New(o.Config, o.Data)
 		u, err := wecom.GetOAuthUser(ctx, req.Code)
@@ -207,70 +291,354 @@ func (o *Oauth) Callback(ctx context.Context, req *admin.OauthCallbackReq) (*adm
 		if err != nil {
 			return nil, fmt.Errorf("get wecom user info failed: %w", err)
 		}
+		userInfo.Subject = wecomUser.UserID
 		userInfo.Credential = wecomUser.UserID
+		userInfo.Username = wecomUser.UserID
+		userInfo.NickName = wecomUser.Name
 		userInfo.Mobile = wecomUser.Mobile
 		userInfo.Email = wecomUser.Email
-	default:
-		c, ok := o.Data.Cache.Get("oauthProviderConfig" + req.ProviderName)
-		if !ok {
-			return nil, errors.New("get cache provider config failed")
-		}
-		userInfoURL, ok := o.Data.Cache.Get("oauthProviderUserInfoURL" + req.ProviderName)
-		if !ok {
-			return nil, errors.New("get cache provider user info url failed")
+		// the emails of WeCom are set by the admins of the company
+		userInfo.EmailVerified = wecomUser.Email != ""
+		userInfo.Picture = wecomUser.Avatar
+		for _, d := range wecomUser.Department {
+			userInfo.Departments = append(userInfo.Departments, strconv.Itoa(d))
 		}
-		var err error
-		userInfo, err = getUserInfo(c.(oauth2.Config), userInfoURL.(string), req.Code)
+	default:
+		userInfo, err = client.userInfo(ctx, req.Code, stored)
 		if err != nil {
 			return nil, fmt.Errorf("get user info failed: %w", err)
 		}
-		if userInfo == nil {
+		if userInfo.Credential == "" {
 			userInfo.Credential = userInfo.Username
 		}
+		if userInfo.Subject == "" {
+			userInfo.Subject = userInfo.Credential
+		}
+	}
+	if userInfo.Subject == "" {
+		return nil, errors.New("no subject in the user info, check the claim mapping of the provider")
+	}
+	userInfo.Provider = stored.Provider
+
+	if stored.UserID != 0 {
+		if err = linkIdentity(ctx, o.Data, stored.UserID, userInfo); err != nil {
+			return nil, err
+		}
+		userInfo.LinkedUserID = stored.UserID
+	} else if client.provider.AutoProvision {
+		err = provision(ctx, o.Data, userInfo, provisionPolicy{
+			approval:      client.provider.ProvisionApproval,
+			defaultRoleID: client.provider.DefaultRoleID,
+			roleRules:     client.provider.RoleRules,
+		})
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	return userInfo, nil
 }
 
-func getUserInfo(c oauth2.Config, infoURL string, code string) (*admin.OauthUserInfo, error) {
-	token, err := c.Exchange(context.Background(), code)
+// linkIdentity links the account to the user, an account is linked to one user only
+func linkIdentity(ctx context.Context, db *data.Data, userID uint64, userInfo *admin.OauthUserInfo) error {
+	identity, err := db.DBClient.UserIdentity.Query().
+		Where(useridentity.Provider(userInfo.Provider), useridentity.Subject(userInfo.Subject)).
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return fmt.Errorf("get user identity failed: %w", err)
+	}
+	if identity != nil {
+		if identity.UserID != userID {
+			return errors.New("the account is linked to another user")
+		}
+		return nil
+	}
+	_, err = db.DBClient.UserIdentity.Create().
+		SetUserID(userID).
+		SetProvider(userInfo.Provider).
+		SetSubject(userInfo.Subject).
+		SetUsername(userInfo.Username).
+		SetEmail(userInfo.Email).
+		Save(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("code exchange failed: %w", err)
+		if ent.IsConstraintError(err) {
+			return fmt.Errorf("an account of %s is linked already, unlink it first", userInfo.Provider)
+		}
+		return fmt.Errorf("create user identity failed: %w", err)
 	}
+	return nil
+}
 
-	var response *http.Response
-	if c.Endpoint.AuthStyle == 1 {
-		response, err = http.Get(infoURL + token.AccessToken)
+func (o *Oauth) Identities(ctx context.Context, userID uint64) ([]*admin.UserIdentityInfo, error) {
+	identities, err := o.Data.DBClient.UserIdentity.Query().
+		Where(useridentity.UserID(userID)).
+		Order(ent.Asc(useridentity.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list user identities failed: %w", err)
+	}
+	list := make([]*admin.UserIdentityInfo, 0, len(identities))
+	for _, v := range identities {
+		info := &admin.UserIdentityInfo{
+			ID:        v.ID,
+			CreatedAt: v.CreatedAt.Format(times.TimeFormat),
+			Provider:  v.Provider,
+			Subject:   v.Subject,
+			Username:  v.Username,
+			Email:     v.Email,
+		}
+		if v.LastLoginAt != nil {
+			info.LastLoginAt = v.LastLoginAt.Format(times.TimeFormat)
+		}
+		list = append(list, info)
+	}
+	return list, nil
+}
+
+func (o *Oauth) Unlink(ctx context.Context, userID, identityID uint64) error {
+	n, err := o.Data.DBClient.UserIdentity.Delete().
+		Where(useridentity.IDEQ(identityID), useridentity.UserID(userID)).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("delete user identity failed: %w", err)
+	}
+	if n == 0 {
+		return errors.New("linked account not found")
+	}
+	return nil
+}
+
+// redeemState returns the stored state and deletes it, a state is accepted once, even across instances
+func (o *Oauth) redeemState(ctx context.Context, state string) (*oauthState, error) {
+	errInvalidState := errors.New("invalid or expired oauth state, please sign in again")
+	if state == "" {
+		return nil, errInvalidState
+	}
+	v, ok, err := redeem(ctx, o.Data, "oauthState"+state, oauthStateExpire)
+	if err != nil {
+		return nil, fmt.Errorf("redeem oauth state failed: %w", err)
+	}
+	if !ok {
+		return nil, errInvalidState
+	}
+	stored := new(oauthState)
+	if err = json.Unmarshal([]byte(v), stored); err != nil {
+		return nil, fmt.Errorf("parse oauth state failed: %w", err)
+	}
+	return stored, nil
+}
+
+// redeem returns the cached value and deletes it, ok is false if it is missing or redeemed already by any instance
+func redeem(ctx context.Context, db *data.Data, key string, expire time.Duration) (v string, ok bool, err error) {
+	v, exist, err := db.CacheGet(ctx, key)
+	if err != nil || !exist {
+		return "", false, err
+	}
+	used, err := db.CacheIncr(ctx, key+"Used", expire)
+	if err != nil {
+		return "", false, err
+	}
+	if err = db.CacheDelete(ctx, key); err != nil {
+		return "", false, err
+	}
+	return v, used == 1, nil
+}
+
+// client returns the cached configuration of the provider, OIDC providers are configured by their discovery document
+func (o *Oauth) client(ctx context.Context, name string) (*oauthClient, error) {
+	if v, found := o.Data.Cache.Get("oauthProviderClient" + name); found {
+		if c, ok := v.(*oauthClient); ok {
+			return c, nil
+		}
+	}
+	provider, err := o.Data.DBClient.OauthProvider.Query().Where(oauthprovider.Name(name)).Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get oauth provider failed: %w", err)
+	}
+	c := &oauthClient{
+		provider: provider,
+		config: oauth2.Config{
+			ClientID:     provider.ClientID,
+			ClientSecret: provider.ClientSecret,
+			Endpoint: oauth2.Endpoint{
+				AuthURL:   provider.AuthURL,
+				TokenURL:  provider.TokenURL,
+				AuthStyle: oauth2.AuthStyle(provider.AuthStyle),
+			},
+			RedirectURL: provider.RedirectURL,
+			Scopes:      strings.Fields(provider.Scopes),
+		},
+		infoURL: provider.InfoURL,
+	}
+	c.mapping, err = providerClaimMapping(provider.Name, provider.ClaimMapping, provider.DiscoveryURL != "")
+	if err != nil {
+		return nil, err
+	}
+	if provider.DiscoveryURL != "" {
+		// the keys of the provider are fetched later with this context, it must outlive the request
+		discoveryCtx := oidc.ClientContext(context.WithoutCancel(ctx), oauthHTTPClient)
+		p, err := oidc.NewProvider(discoveryCtx, provider.DiscoveryURL)
 		if err != nil {
-			return nil, fmt.Errorf("failed getting user info: %s", err.Error())
+			return nil, fmt.Errorf("discover oidc provider failed: %w", err)
+		}
+		c.config.Endpoint = p.Endpoint()
+		c.verifier = p.Verifier(&oidc.Config{ClientID: provider.ClientID})
+		var metadata struct {
+			UserinfoEndpoint string `json:"userinfo_endpoint"`
+		}
+		if err = p.Claims(&metadata); err == nil && c.infoURL == "" {
+			c.infoURL = metadata.UserinfoEndpoint
 		}
-	} else if c.Endpoint.AuthStyle == 2 {
-		client := &http.Client{}
-		request, err := http.NewRequest("GET", infoURL, nil)
+		if !slices.Contains(c.config.Scopes, oidc.ScopeOpenID) {
+			c.config.Scopes = append([]string{oidc.ScopeOpenID}, c.config.Scopes...)
+		}
+	}
+	o.Data.Cache.Set("oauthProviderClient"+name, c, oauthClientExpire)
+	return c, nil
+}
+
+// userInfo exchanges the code with the PKCE verifier, and reads the user from the verified id_token of OIDC providers,
+// or from the user info URL of plain OAuth 2.0 providers, by the claim mapping of the provider
+func (c *oauthClient) userInfo(ctx context.Context, code string, stored *oauthState) (*admin.OauthUserInfo, error) {
+	ctx = context.WithValue(ctx, oauth2.HTTPClient, oauthHTTPClient)
+	if c.provider.Name == "dingtalk" {
+		doc, err := c.dingtalkUser(ctx, code)
 		if err != nil {
-			return nil, fmt.Errorf("Endpoint Request failed: %w", err)
+			return nil, err
 		}
+		return c.mapping.userInfo(doc), nil
+	}
 
-		request.Header.Set("Accept", "application/json")
-		request.Header.Set("Authorization", "Bearer "+token.AccessToken)
+	token, err := c.config.Exchange(ctx, code, oauth2.VerifierOption(stored.Verifier))
+	if err != nil {
+		return nil, fmt.Errorf("code exchange failed: %w", err)
+	}
 
-		response, err = client.Do(request)
+	if c.verifier != nil {
+		rawIDToken, ok := token.Extra("id_token").(string)
+		if !ok {
+			return nil, errors.New("no id_token in the token response")
+		}
+		idToken, err := c.verifier.Verify(ctx, rawIDToken)
+		if err != nil {
+			return nil, fmt.Errorf("verify id_token failed: %w", err)
+		}
+		if idToken.Nonce != stored.Nonce {
+			return nil, errors.New("id_token nonce mismatch")
+		}
+		var claims json.RawMessage
+		if err = idToken.Claims(&claims); err != nil {
+			return nil, fmt.Errorf("parse id_token claims failed: %w", err)
+		}
+		doc, err := jsonpath.Decode(claims)
 		if err != nil {
-			return nil, fmt.Errorf("failed getting user info: %w", err)
+			return nil, fmt.Errorf("parse id_token claims failed: %w", err)
 		}
+		return c.mapping.userInfo(doc), nil
+	}
+
+	var request *http.Request
+	if c.config.Endpoint.AuthStyle == 1 {
+		request, err = http.NewRequestWithContext(ctx, http.MethodGet, c.infoURL+url.QueryEscape(token.AccessToken), nil)
+	} else {
+		request, err = http.NewRequestWithContext(ctx, http.MethodGet, c.infoURL, nil)
+		if err == nil {
+			request.Header.Set("Authorization", "Bearer "+token.AccessToken)
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("endpoint request failed: %w", err)
+	}
+	doc, err := requestJSON(request)
+	if err != nil {
+		return nil, fmt.Errorf("failed getting user info: %w", err)
+	}
+	return c.mapping.userInfo(doc), nil
+}
+
+// dingtalkUser exchanges the code by the JSON API of dingtalk, and reads the user with the dingtalk token header
+func (c *oauthClient) dingtalkUser(ctx context.Context, code string) (any, error) {
+	body, err := json.Marshal(map[string]string{
+		"clientId":     c.config.ClientID,
+		"clientSecret": c.config.ClientSecret,
+		"code":         code,
+		"grantType":    "authorization_code",
+	})
+	if err != nil {
+		return nil, err
+	}
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, c.config.Endpoint.TokenURL, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("endpoint request failed: %w", err)
 	}
+	request.Header.Set("Content-Type", "application/json")
+	doc, err := requestJSON(request)
+	if err != nil {
+		return nil, fmt.Errorf("code exchange failed: %w", err)
+	}
+	res, _ := doc.(map[string]any)
+	accessToken, _ := res["accessToken"].(string)
+	if accessToken == "" {
+		return nil, errors.New("code exchange failed: no accessToken in the response")
+	}
+
+	request, err = http.NewRequestWithContext(ctx, http.MethodGet, c.infoURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("endpoint request failed: %w", err)
+	}
+	request.Header.Set("x-acs-dingtalk-access-token", accessToken)
+	doc, err = requestJSON(request)
+	if err != nil {
+		return nil, fmt.Errorf("failed getting user info: %w", err)
+	}
+	return doc, nil
+}
 
+// requestJSON sends the request to the provider and decodes the JSON response
+func requestJSON(request *http.Request) (any, error) {
+	request.Header.Set("Accept", "application/json")
+	response, err := oauthHTTPClient.Do(request)
+	if err != nil {
+		return nil, err
+	}
 	defer response.Body.Close()
-	contents, err := io.ReadAll(response.Body)
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("status %d", response.StatusCode)
+	}
+	contents, err := io.ReadAll(io.LimitReader(response.Body, oauthResponseLimit))
 	if err != nil {
 		return nil, fmt.Errorf("failed reading response body: %w", err)
 	}
-
-	var u *admin.OauthUserInfo
-	err = json.Unmarshal(contents, &u)
+	doc, err := jsonpath.Decode(contents)
 	if err != nil {
 		return nil, fmt.Errorf("failed unmarshaling response body: %w", err)
 	}
+	return doc, nil
+}
 
-	return u, nil
+// validateProvider a provider is configured either by its discovery URL or by the typed endpoints
+func validateProvider(p *admin.ProviderInfo) error {
+	if p.Name == "" || p.ClientID == "" || p.RedirectUrl == "" {
+		return errors.New("name, client id and redirect url are required")
+	}
+	if p.Name == ldapProvider {
+		return errors.New("the provider name ldap is reserved for the accounts of the directory")
+	}
+	if strings.HasPrefix(p.Name, samlIdentityPrefix) {
+		return errors.New("the provider names starting with saml: are reserved for the SAML providers")
+	}
+	if _, err := parseClaimMapping(p.ClaimMapping); err != nil {
+		return err
+	}
+	if err := rolerule.Validate(p.RoleRules); err != nil {
+		return err
+	}
+	if p.DiscoveryUrl != "" {
+		return nil
+	}
+	// wecom reads the user by its own API
+	if p.AuthUrl == "" || (p.Name != "wecom" && (p.TokenUrl == "" || p.InfoUrl == "")) {
+		return errors.New("discovery url, or auth url, token url and info url are required")
+	}
+	return nil
 }
@@ -0,0 +1,239 @@
+/*
+ * Copyright 2023 FormulaGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ */
+
+package admin
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+
+	"formulago/biz/domain/admin"
+	"formulago/pkg/jsonpath"
+)
+
+// the keys of the claim mapping
+const (
+	claimSubject    = "subject"
+	claimCredential = "credential"
+	claimUsername   = "username"
+	claimEmail      = "email"
+	// claimEmailVerified true if the provider verified the email, the email domain rules ignore the other emails
+	claimEmailVerified = "email_verified"
+	claimMobile        = "mobile"
+	claimNickname      = "nickname"
+	claimAvatar        = "avatar"
+	claimGroups        = "groups"
+)
+
+var claimKeys = []string{claimSubject, claimCredential, claimUsername, claimEmail, claimEmailVerified, claimMobile, claimNickname, claimAvatar, claimGroups}
+
+var (
+	// defaultClaimMapping the user info response of the providers without mapping or template, the subject falls back to the credential
+	defaultClaimMapping = map[string]string{
+		claimCredential: "credential",
+		claimUsername:   "username",
+		claimEmail:      "email",
+		claimMobile:     "mobile",
+		claimNickname:   "nickName",
+		claimAvatar:     "picture",
+	}
+	// defaultOIDCClaimMapping the standard claims of the id_token
+	defaultOIDCClaimMapping = map[string]string{
+		claimSubject:       "sub",
+		claimCredential:    "preferred_username",
+		claimUsername:      "preferred_username",
+		claimEmail:         "email",
+		claimEmailVerified: "email_verified",
+		claimMobile:        "phone_number",
+		claimNickname:      "name",
+		claimAvatar:        "picture",
+	}
+)
+
+// oauthTemplates the built-in providers, a provider named as a template uses its claim mapping unless it has its own
+var oauthTemplates = []*admin.ProviderInfo{
+	{
+		Name:      "github",
+		Scopes:    "read:user user:email",
+		AuthUrl:   "https://github.com/login/oauth/authorize",
+		TokenUrl:  "https://github.com/login/oauth/access_token",
+		AuthStyle: 0,
+		InfoUrl:   "https://api.github.com/user",
+		ClaimMapping: map[string]string{
+			claimSubject:    "id",
+			claimCredential: "login",
+			claimUsername:   "login",
+			claimEmail:      "email",
+			claimNickname:   "name",
+			claimAvatar:     "avatar_url",
+		},
+	},
+	{
+		Name:      "gitlab",
+		Scopes:    "read_user",
+		AuthUrl:   "https://gitlab.com/oauth/authorize",
+		TokenUrl:  "https://gitlab.com/oauth/token",
+		AuthStyle: 0,
+		InfoUrl:   "https://gitlab.com/api/v4/user",
+		ClaimMapping: map[string]string{
+			claimSubject:    "id",
+			claimCredential: "username",
+			claimUsername:   "username",
+			claimEmail:      "email",
+			claimNickname:   "name",
+			claimAvatar:     "avatar_url",
+		},
+	},
+	{
+		// gitee accepts the client secret and the access token only as parameters
+		Name:      "gitee",
+		Scopes:    "user_info emails",
+		AuthUrl:   "https://gitee.com/oauth/authorize",
+		TokenUrl:  "https://gitee.com/oauth/token",
+		AuthStyle: 1,
+		InfoUrl:   "https://gitee.com/api/v5/user?access_token=",
+		ClaimMapping: map[string]string{
+			claimSubject:    "id",
+			claimCredential: "login",
+			claimUsername:   "login",
+			claimEmail:      "email",
+			claimNickname:   "name",
+			claimAvatar:     "avatar_url",
+		},
+	},
+	{
+		// google has no username claim
+		Name:         "google",
+		Scopes:       "openid profile email",
+		DiscoveryUrl: "https://accounts.google.com",
+		ClaimMapping: map[string]string{
+			claimSubject:       "sub",
+			claimCredential:    "email",
+			claimUsername:      "email",
+			claimEmail:         "email",
+			claimEmailVerified: "email_verified",
+			claimNickname:      "name",
+			claimAvatar:        "picture",
+		},
+	},
+	{
+		Name:      "feishu",
+		AuthUrl:   "https://passport.feishu.cn/suite/passport/oauth/authorize",
+		TokenUrl:  "https://passport.feishu.cn/suite/passport/oauth/token",
+		AuthStyle: 0,
+		InfoUrl:   "https://passport.feishu.cn/suite/passport/oauth/userinfo",
+		ClaimMapping: map[string]string{
+			claimSubject:    "union_id",
+			claimCredential: "user_id",
+			claimUsername:   "user_id",
+			claimEmail:      "email",
+			claimMobile:     "mobile",
+			claimNickname:   "name",
+			claimAvatar:     "avatar_url",
+		},
+	},
+	{
+		// dingtalk exchanges the code by JSON and reads the user with its own token header
+		Name:     "dingtalk",
+		Scopes:   "openid",
+		AuthUrl:  "https://login.dingtalk.com/oauth2/auth",
+		TokenUrl: "https://api.dingtalk.com/v1.0/oauth2/userAccessToken",
+		InfoUrl:  "https://api.dingtalk.com/v1.0/contact/users/me",
+		ClaimMapping: map[string]string{
+			claimSubject:    "unionId",
+			claimCredential: "unionId",
+			claimUsername:   "unionId",
+			claimEmail:      "email",
+			claimMobile:     "mobile",
+			claimNickname:   "nick",
+			claimAvatar:     "avatarUrl",
+		},
+	},
+}
+
+// claimMapping the parsed JSON paths of the user info fields
+type claimMapping map[string]jsonpath.Path
+
+// parseClaimMapping parses the mapping, unknown keys and invalid paths are errors
+func parseClaimMapping(mapping map[string]string) (claimMapping, error) {
+	m := make(claimMapping, len(mapping))
+	for key, path := range mapping {
+		if !slices.Contains(claimKeys, key) {
+			return nil, fmt.Errorf("unknown claim mapping key %q, the keys are %v", key, claimKeys)
+		}
+		if path == "" {
+			continue
+		}
+		p, err := jsonpath.Parse(path)
+		if err != nil {
+			return nil, fmt.Errorf("claim mapping of %s: %w", key, err)
+		}
+		m[key] = p
+	}
+	return m, nil
+}
+
+// providerClaimMapping the mapping of the provider, else of the template of the same name, else the default one
+func providerClaimMapping(name string, mapping map[string]string, oidc bool) (claimMapping, error) {
+	if len(mapping) == 0 {
+		if t := oauthTemplate(name); t != nil {
+			mapping = t.ClaimMapping
+		} else if oidc {
+			mapping = defaultOIDCClaimMapping
+		} else {
+			mapping = defaultClaimMapping
+		}
+	}
+	return parseClaimMapping(mapping)
+}
+
+// userInfo reads the user info fields from the decoded user info response or id_token claims
+func (m claimMapping) userInfo(doc any) *admin.OauthUserInfo {
+	value := func(key string) string {
+		if p, ok := m[key]; ok {
+			return p.String(doc)
+		}
+		return ""
+	}
+	var groups []string
+	if p, ok := m[claimGroups]; ok {
+		groups = p.Strings(doc)
+	}
+	verified, _ := strconv.ParseBool(value(claimEmailVerified))
+	return &admin.OauthUserInfo{
+		EmailVerified: verified,
+		Subject:       value(claimSubject),
+		Credential:    value(claimCredential),
+		Username:      value(claimUsername),
+		Email:         value(claimEmail),
+		Mobile:        value(claimMobile),
+		NickName:      value(claimNickname),
+		Picture:       value(claimAvatar),
+		Groups:        groups,
+	}
+}
+
+func oauthTemplate(name string) *admin.ProviderInfo {
+	for _, t := range oauthTemplates {
+		if t.Name == name {
+			return t
+		}
+	}
+	return nil
+}
+
+// copyTemplates copies the templates, so the callers cannot change the built-in ones
+func copyTemplates() []*admin.ProviderInfo {
+	list := make([]*admin.ProviderInfo, 0, len(oauthTemplates))
+	for _, t := range oauthTemplates {
+		c := *t
+		c.ClaimMapping = maps.Clone(t.ClaimMapping)
+		list = append(list, &c)
+	}
+	return list
+}
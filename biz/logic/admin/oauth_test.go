@@ -0,0 +1,58 @@
+/*
+ * Copyright 2023 FormulaGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ */
+
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"formulago/biz/domain/admin"
+	"formulago/configs"
+)
+
+func TestOauth_Callback_link(t *testing.T) {
+	ctx := context.Background()
+	d := newTestData(t)
+	o := &Oauth{Data: d, Config: configs.Config{}}
+	// a link started by the user 1
+	link := func(state string) {
+		b, err := json.Marshal(oauthState{Provider: "github", UserID: 1})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = d.CacheSet(ctx, "oauthState"+state, string(b), oauthStateExpire); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		name   string
+		userID uint64
+		// wantMismatch the callback is rejected before the code is exchanged
+		wantMismatch bool
+	}{
+		{name: "anonymous caller", userID: 0, wantMismatch: true},
+		{name: "another user", userID: 2, wantMismatch: true},
+		{name: "the user who started it", userID: 1, wantMismatch: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// a state is redeemed once
+			state := "state-" + strings.ReplaceAll(tt.name, " ", "-")
+			link(state)
+			_, err := o.Callback(ctx, &admin.OauthCallbackReq{State: state, Code: "code", UserID: tt.userID})
+			if err == nil {
+				t.Fatal("Callback() of an unknown provider should fail")
+			}
+			if mismatch := strings.Contains(err.Error(), "started by another user"); mismatch != tt.wantMismatch {
+				t.Errorf("Callback() error = %v, want mismatch %v", err, tt.wantMismatch)
+			}
+		})
+	}
+}
@@ -0,0 +1,536 @@
+/*
+ * Copyright 2023 FormulaGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ */
+
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"formulago/biz/domain/admin"
+	"formulago/configs"
+	"formulago/data"
+	"formulago/data/ent"
+	"formulago/data/ent/oidcclient"
+	"formulago/data/ent/oidcconsent"
+	"formulago/data/ent/predicate"
+	"formulago/data/ent/user"
+	"formulago/pkg/encrypt"
+	"formulago/pkg/oidc"
+	"formulago/pkg/times"
+
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+)
+
+const (
+	// oidcRequestExpire the time the user has to sign in and answer the consent page
+	oidcRequestExpire = 10 * time.Minute
+	oidcSecretBytes   = 32
+)
+
+type OIDC struct {
+	Data   *data.Data
+	Config configs.Config
+}
+
+func NewOIDC(data *data.Data, config configs.Config) admin.OIDC {
+	return &OIDC{
+		Data:   data,
+		Config: config,
+	}
+}
+
+// oidcRequest is the pending authorization request, and the grant of its code once approved
+type oidcRequest struct {
+	ClientID            string   `json:"client_id"`
+	RedirectURI         string   `json:"redirect_uri"`
+	Scopes              []string `json:"scopes"`
+	State               string   `json:"state"`
+	Nonce               string   `json:"nonce"`
+	CodeChallenge       string   `json:"code_challenge"`
+	CodeChallengeMethod string   `json:"code_challenge_method"`
+	UserID              uint64   `json:"user_id"`
+}
+
+func (o *OIDC) Authorize(ctx context.Context, req *admin.OIDCAuthorizeReq) (requestID string, err error) {
+	client, err := o.activeClient(ctx, req.ClientID)
+	if err != nil {
+		return "", err
+	}
+	// never redirect to an unregistered URI, the error is shown to the user agent instead
+	if !oidc.ValidRedirectURI(client.RedirectUris, req.RedirectURI) {
+		return "", &admin.OIDCError{Code: "invalid_request", Description: "redirect_uri is not registered"}
+	}
+	redirectErr := func(code, description string) error {
+		return &admin.OIDCError{Code: code, Description: description, RedirectURI: req.RedirectURI, State: req.State}
+	}
+	if req.ResponseType != "code" {
+		return "", redirectErr("unsupported_response_type", "only the code response type is supported")
+	}
+	if !slices.Contains(client.GrantTypes, oidc.GrantAuthorizationCode) {
+		return "", redirectErr("unauthorized_client", "the client may not use the authorization code grant")
+	}
+	scopes := oidc.ParseScopes(req.Scope)
+	if len(scopes) == 0 || !oidc.SubsetOf(scopes, client.Scopes) {
+		return "", redirectErr("invalid_scope", "the scope is empty or not allowed to the client")
+	}
+	// PKCE is required for every client
+	if req.CodeChallenge == "" || req.CodeChallengeMethod != oidc.CodeChallengeS256 {
+		return "", redirectErr("invalid_request", "code_challenge with the S256 method is required")
+	}
+
+	requestID, err = encrypt.RandomToken(24)
+	if err != nil {
+		return "", fmt.Errorf("generate oidc request id failed: %w", err)
+	}
+	b, err := json.Marshal(oidcRequest{
+		ClientID:            client.ClientID,
+		RedirectURI:         req.RedirectURI,
+		Scopes:              scopes,
+		State:               req.State,
+		Nonce:               req.Nonce,
+		CodeChallenge:       req.CodeChallenge,
+		CodeChallengeMethod: req.CodeChallengeMethod,
+	})
+	if err != nil {
+		return "", err
+	}
+	if err = o.Data.CacheSet(ctx, "oidcRequest"+requestID, string(b), oidcRequestExpire); err != nil {
+		return "", fmt.Errorf("store oidc request failed: %w", err)
+	}
+	return requestID, nil
+}
+
+func (o *OIDC) ConsentInfo(ctx context.Context, userID uint64, requestID string) (*admin.OIDCConsentInfo, error) {
+	req, err := o.request(ctx, requestID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := o.activeClient(ctx, req.ClientID)
+	if err != nil {
+		return nil, err
+	}
+	consented, err := o.consented(ctx, userID, client, req.Scopes)
+	if err != nil {
+		return nil, err
+	}
+	return &admin.OIDCConsentInfo{
+		ClientID:   client.ClientID,
+		ClientName: client.Name,
+		Scopes:     req.Scopes,
+		Consented:  consented,
+	}, nil
+}
+
+func (o *OIDC) Consent(ctx context.Context, userID uint64, requestID string, approve bool) (redirectURL string, err error) {
+	req, err := o.request(ctx, requestID)
+	if err != nil {
+		return "", err
+	}
+	// the request is answered only once
+	if err = o.Data.CacheDelete(ctx, "oidcRequest"+requestID); err != nil {
+		return "", fmt.Errorf("delete oidc request failed: %w", err)
+	}
+	if !approve {
+		return oidc.AppendQuery(req.RedirectURI, map[string]string{
+			"error":             "access_denied",
+			"error_description": "the user denied the request",
+			"state":             req.State,
+		}), nil
+	}
+	client, err := o.activeClient(ctx, req.ClientID)
+	if err != nil {
+		return "", err
+	}
+	consented, err := o.consented(ctx, userID, client, req.Scopes)
+	if err != nil {
+		return "", err
+	}
+	if !consented {
+		if err = o.saveConsent(ctx, userID, client.ClientID, req.Scopes); err != nil {
+			return "", err
+		}
+	}
+
+	code, err := encrypt.RandomToken(32)
+	if err != nil {
+		return "", fmt.Errorf("generate authorization code failed: %w", err)
+	}
+	req.UserID = userID
+	b, err := json.Marshal(req)
+	if err != nil {
+		return "", err
+	}
+	err = o.Data.CacheSet(ctx, "oidcCode"+encrypt.SHA256Hex(code), string(b), configSeconds(o.Config.OIDC.CodeExpire, time.Minute))
+	if err != nil {
+		return "", fmt.Errorf("store authorization code failed: %w", err)
+	}
+	return oidc.AppendQuery(req.RedirectURI, map[string]string{
+		"code":  code,
+		"state": req.State,
+	}), nil
+}
+
+func (o *OIDC) Exchange(ctx context.Context, req *admin.OIDCTokenReq) (*admin.OIDCGrant, error) {
+	client, err := o.authenticateClient(ctx, req.ClientID, req.ClientSecret)
+	if err != nil {
+		return nil, err
+	}
+	if req.GrantType != oidc.GrantAuthorizationCode && req.GrantType != oidc.GrantClientCredentials {
+		return nil, &admin.OIDCError{Code: "unsupported_grant_type", Description: "the grant type is not supported"}
+	}
+	if !slices.Contains(client.GrantTypes, req.GrantType) {
+		return nil, &admin.OIDCError{Code: "unauthorized_client", Description: "the grant type is not allowed to the client"}
+	}
+
+	if req.GrantType == oidc.GrantClientCredentials {
+		// the client acts on its own behalf, the user scopes make no sense
+		scopes := oidc.ParseScopes(req.Scope)
+		if len(scopes) == 0 {
+			scopes = client.Scopes
+		}
+		if !oidc.SubsetOf(scopes, client.Scopes) || slices.Contains(scopes, oidc.ScopeOpenID) {
+			return nil, &admin.OIDCError{Code: "invalid_scope", Description: "the scope is not allowed to the client"}
+		}
+		return &admin.OIDCGrant{ClientID: client.ClientID, Scopes: scopes}, nil
+	}
+
+	invalidGrant := &admin.OIDCError{Code: "invalid_grant", Description: "the code is invalid, expired or used"}
+	key := "oidcCode" + encrypt.SHA256Hex(req.Code)
+	v, exist, err := o.Data.CacheGet(ctx, key)
+	if err != nil {
+		return nil, fmt.Errorf("get authorization code failed: %w", err)
+	}
+	if req.Code == "" || !exist {
+		return nil, invalidGrant
+	}
+	// redeem the code once, even if two instances receive it at the same time
+	used, err := o.Data.CacheIncr(ctx, key+"Used", oidcRequestExpire)
+	if err != nil {
+		return nil, fmt.Errorf("redeem authorization code failed: %w", err)
+	}
+	if err = o.Data.CacheDelete(ctx, key); err != nil {
+		return nil, fmt.Errorf("delete authorization code failed: %w", err)
+	}
+	if used > 1 {
+		return nil, invalidGrant
+	}
+	var grant oidcRequest
+	if err = json.Unmarshal([]byte(v), &grant); err != nil {
+		return nil, fmt.Errorf("parse authorization code failed: %w", err)
+	}
+	if grant.ClientID != client.ClientID || grant.RedirectURI != req.RedirectURI {
+		return nil, invalidGrant
+	}
+	if !oidc.VerifyPKCE(req.CodeVerifier, grant.CodeChallenge, grant.CodeChallengeMethod) {
+		return nil, &admin.OIDCError{Code: "invalid_grant", Description: "code_verifier does not match the code_challenge"}
+	}
+	return &admin.OIDCGrant{
+		ClientID: client.ClientID,
+		UserID:   grant.UserID,
+		Scopes:   grant.Scopes,
+		Nonce:    grant.Nonce,
+	}, nil
+}
+
+func (o *OIDC) Claims(ctx context.Context, userID uint64, scopes []string) (map[string]any, error) {
+	u, err := o.Data.DBClient.User.Query().Where(user.IDEQ(userID), user.Status(1)).Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get user failed: %w", err)
+	}
+	claims := make(map[string]any)
+	if slices.Contains(scopes, oidc.ScopeProfile) {
+		claims["name"] = u.Nickname
+		claims["preferred_username"] = u.Username
+		if u.Avatar != "" {
+			claims["picture"] = u.Avatar
+		}
+	}
+	if slices.Contains(scopes, oidc.ScopeEmail) && u.Email != "" {
+		claims["email"] = u.Email
+	}
+	if slices.Contains(scopes, oidc.ScopePhone) {
+		claims["phone_number"] = u.Mobile
+	}
+	if slices.Contains(scopes, oidc.ScopeRoles) {
+		roles := []string{}
+		activeRoles, err := NewUser(o.Data).ActiveRoles(ctx, u.ID)
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range activeRoles {
+			roles = append(roles, r.Value)
+		}
+		claims["roles"] = roles
+	}
+	return claims, nil
+}
+
+func (o *OIDC) CreateClient(ctx context.Context, req *admin.OIDCClientInfo) (clientID, secret string, err error) {
+	if err = validateOIDCClient(req); err != nil {
+		return "", "", err
+	}
+	clientID = req.ClientID
+	if clientID == "" {
+		clientID, err = encrypt.RandomToken(16)
+		if err != nil {
+			return "", "", fmt.Errorf("generate client id failed: %w", err)
+		}
+	}
+	create := o.Data.DBClient.OidcClient.Create().
+		SetClientID(clientID).
+		SetName(req.Name).
+		SetRedirectUris(req.RedirectURIs).
+		SetGrantTypes(req.GrantTypes).
+		SetScopes(req.Scopes).
+		SetPublic(req.Public).
+		SetSkipConsent(req.SkipConsent).
+		SetStatus(1)
+	var secretHash string
+	if !req.Public {
+		secret, secretHash, err = newClientSecret()
+		if err != nil {
+			return "", "", err
+		}
+		create.SetClientSecret(secretHash)
+	}
+	if _, err = create.Save(ctx); err != nil {
+		return "", "", fmt.Errorf("create oidc client failed: %w", err)
+	}
+	return clientID, secret, nil
+}
+
+func (o *OIDC) UpdateClient(ctx context.Context, req *admin.OIDCClientInfo) (secret string, err error) {
+	if err = validateOIDCClient(req); err != nil {
+		return "", err
+	}
+	client, err := o.Data.DBClient.OidcClient.Get(ctx, req.ID)
+	if err != nil {
+		return "", fmt.Errorf("get oidc client failed: %w", err)
+	}
+	update := o.Data.DBClient.OidcClient.UpdateOne(client).
+		SetName(req.Name).
+		SetRedirectUris(req.RedirectURIs).
+		SetGrantTypes(req.GrantTypes).
+		SetScopes(req.Scopes).
+		SetPublic(req.Public).
+		SetSkipConsent(req.SkipConsent).
+		SetStatus(uint8(req.Status))
+	switch {
+	case req.Public:
+		update.SetClientSecret("")
+	case req.ResetSecret || client.ClientSecret == "":
+		var secretHash string
+		secret, secretHash, err = newClientSecret()
+		if err != nil {
+			return "", err
+		}
+		update.SetClientSecret(secretHash)
+	}
+	if err = update.Exec(ctx); err != nil {
+		return "", fmt.Errorf("update oidc client failed: %w", err)
+	}
+	return secret, nil
+}
+
+func (o *OIDC) DeleteClient(ctx context.Context, id uint64) (err error) {
+	client, err := o.Data.DBClient.OidcClient.Get(ctx, id)
+	if err != nil {
+		return fmt.Errorf("get oidc client failed: %w", err)
+	}
+	tx, err := o.Data.DBClient.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("starting a transaction err: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			rollbackErr := tx.Rollback()
+			if rollbackErr != nil {
+				hlog.Error("DeleteClient err:", err, "rollback err:", rollbackErr)
+			}
+		}
+	}()
+	if _, err = tx.OidcConsent.Delete().Where(oidcconsent.ClientID(client.ClientID)).Exec(ctx); err != nil {
+		return fmt.Errorf("delete oidc consents failed: %w", err)
+	}
+	if err = tx.OidcClient.DeleteOneID(id).Exec(ctx); err != nil {
+		return fmt.Errorf("delete oidc client failed: %w", err)
+	}
+	return tx.Commit()
+}
+
+func (o *OIDC) ClientList(ctx context.Context, req *admin.OIDCClientListReq) (list []*admin.OIDCClientInfo, total int, err error) {
+	var predicates []predicate.OidcClient
+	if req.Name != "" {
+		predicates = append(predicates, oidcclient.NameContains(req.Name))
+	}
+	clients, err := o.Data.DBClient.OidcClient.Query().Where(predicates...).
+		Order(ent.Desc(oidcclient.FieldCreatedAt)).
+		Offset(int(req.Page-1) * int(req.PageSize)).
+		Limit(int(req.PageSize)).All(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("get oidc client list failed: %w", err)
+	}
+	for _, c := range clients {
+		list = append(list, &admin.OIDCClientInfo{
+			ID:           c.ID,
+			CreatedAt:    c.CreatedAt.Format(times.TimeFormat),
+			UpdatedAt:    c.UpdatedAt.Format(times.TimeFormat),
+			Status:       uint64(c.Status),
+			ClientID:     c.ClientID,
+			Name:         c.Name,
+			RedirectURIs: c.RedirectUris,
+			GrantTypes:   c.GrantTypes,
+			Scopes:       c.Scopes,
+			Public:       c.Public,
+			SkipConsent:  c.SkipConsent,
+		})
+	}
+	total, err = o.Data.DBClient.OidcClient.Query().Where(predicates...).Count(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("count oidc client failed: %w", err)
+	}
+	return list, total, nil
+}
+
+func (o *OIDC) request(ctx context.Context, requestID string) (*oidcRequest, error) {
+	v, exist, err := o.Data.CacheGet(ctx, "oidcRequest"+requestID)
+	if err != nil {
+		return nil, fmt.Errorf("get oidc request failed: %w", err)
+	}
+	if requestID == "" || !exist {
+		return nil, &admin.OIDCError{Code: "invalid_request", Description: "the authorization request is expired, please sign in again from the app"}
+	}
+	req := new(oidcRequest)
+	if err = json.Unmarshal([]byte(v), req); err != nil {
+		return nil, fmt.Errorf("parse oidc request failed: %w", err)
+	}
+	return req, nil
+}
+
+func (o *OIDC) activeClient(ctx context.Context, clientID string) (*ent.OidcClient, error) {
+	client, err := o.Data.DBClient.OidcClient.Query().
+		Where(oidcclient.ClientID(clientID), oidcclient.Status(1)).Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, &admin.OIDCError{Code: "invalid_client", Description: "unknown or disabled client"}
+		}
+		return nil, fmt.Errorf("get oidc client failed: %w", err)
+	}
+	return client, nil
+}
+
+// authenticateClient checks the secret of a confidential client, public clients have no secret and rely on PKCE
+func (o *OIDC) authenticateClient(ctx context.Context, clientID, secret string) (*ent.OidcClient, error) {
+	client, err := o.activeClient(ctx, clientID)
+	if err != nil {
+		return nil, err
+	}
+	if client.Public {
+		if secret != "" {
+			return nil, &admin.OIDCError{Code: "invalid_client", Description: "public clients have no secret"}
+		}
+		return client, nil
+	}
+	if secret == "" || !encrypt.BcryptCheck(secret, client.ClientSecret) {
+		return nil, &admin.OIDCError{Code: "invalid_client", Description: "client authentication failed"}
+	}
+	return client, nil
+}
+
+// consented reports whether the scopes need not be asked, because the client skips consent or the user granted them before
+func (o *OIDC) consented(ctx context.Context, userID uint64, client *ent.OidcClient, scopes []string) (bool, error) {
+	if client.SkipConsent {
+		return true, nil
+	}
+	consent, err := o.Data.DBClient.OidcConsent.Query().
+		Where(oidcconsent.UserID(userID), oidcconsent.ClientID(client.ClientID)).Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("get oidc consent failed: %w", err)
+	}
+	return oidc.SubsetOf(scopes, consent.Scopes), nil
+}
+
+// saveConsent adds the scopes to the consent of the user
+func (o *OIDC) saveConsent(ctx context.Context, userID uint64, clientID string, scopes []string) error {
+	consent, err := o.Data.DBClient.OidcConsent.Query().
+		Where(oidcconsent.UserID(userID), oidcconsent.ClientID(clientID)).Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return fmt.Errorf("get oidc consent failed: %w", err)
+	}
+	if consent == nil {
+		err = o.Data.DBClient.OidcConsent.Create().
+			SetUserID(userID).
+			SetClientID(clientID).
+			SetScopes(scopes).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("create oidc consent failed: %w", err)
+		}
+		return nil
+	}
+	granted := consent.Scopes
+	for _, s := range scopes {
+		if !slices.Contains(granted, s) {
+			granted = append(granted, s)
+		}
+	}
+	if err = o.Data.DBClient.OidcConsent.UpdateOne(consent).SetScopes(granted).Exec(ctx); err != nil {
+		return fmt.Errorf("update oidc consent failed: %w", err)
+	}
+	return nil
+}
+
+func validateOIDCClient(req *admin.OIDCClientInfo) error {
+	if req.Name == "" {
+		return errors.New("name is required")
+	}
+	if len(req.GrantTypes) == 0 {
+		return errors.New("at least one grant type is required")
+	}
+	for _, g := range req.GrantTypes {
+		if g != oidc.GrantAuthorizationCode && g != oidc.GrantClientCredentials {
+			return fmt.Errorf("unsupported grant type %s", g)
+		}
+	}
+	if req.Public && slices.Contains(req.GrantTypes, oidc.GrantClientCredentials) {
+		return errors.New("public clients cannot use the client credentials grant")
+	}
+	if slices.Contains(req.GrantTypes, oidc.GrantAuthorizationCode) {
+		if len(req.RedirectURIs) == 0 {
+			return errors.New("at least one redirect uri is required by the authorization code grant")
+		}
+		for _, uri := range req.RedirectURIs {
+			if !oidc.ValidRedirectURI([]string{uri}, uri) {
+				return fmt.Errorf("invalid redirect uri %s", uri)
+			}
+		}
+	}
+	if len(req.Scopes) == 0 {
+		return errors.New("at least one scope is required")
+	}
+	return nil
+}
+
+// newClientSecret returns a new client secret and its bcrypt hash
+func newClientSecret() (secret, hash string, err error) {
+	secret, err = encrypt.RandomToken(oidcSecretBytes)
+	if err != nil {
+		return "", "", fmt.Errorf("generate client secret failed: %w", err)
+	}
+	hash, err = encrypt.BcryptEncrypt(secret)
+	if err != nil {
+		return "", "", fmt.Errorf("encrypt client secret failed: %w", err)
+	}
+	return secret, hash, nil
+}
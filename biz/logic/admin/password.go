@@ -0,0 +1,105 @@
+/*
+ * Copyright 2023 FormulaGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ */
+
+package admin
+
+import (
+	"errors"
+	"fmt"
+	"formulago/configs"
+	"formulago/data/ent"
+	"formulago/pkg/encrypt"
+	"formulago/pkg/password"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+)
+
+var (
+	blocklistOnce sync.Once
+	blocklist     *password.Blocklist
+)
+
+// passwordPolicy returns the configured policy, the blocklist file is loaded once
+func passwordPolicy() password.Policy {
+	c := configs.Data().PasswordPolicy
+	blocklistOnce.Do(func() {
+		blocklist = password.DefaultBlocklist()
+		if c.BlocklistFile == "" {
+			return
+		}
+		f, err := os.Open(c.BlocklistFile)
+		if err != nil {
+			hlog.Error("open password blocklist failed: ", err)
+			return
+		}
+		defer f.Close()
+		if err = blocklist.Load(f); err != nil {
+			hlog.Error("load password blocklist failed: ", err)
+		}
+	})
+
+	minLength := c.MinLength
+	if minLength <= 0 {
+		minLength = 8
+	}
+	return password.Policy{
+		MinLength:     minLength,
+		RequireUpper:  c.RequireUpper,
+		RequireLower:  c.RequireLower,
+		RequireDigit:  c.RequireDigit,
+		RequireSymbol: c.RequireSymbol,
+		Blocklist:     blocklist,
+	}
+}
+
+// validateNewPassword checks the password against the policy and the current and previous passwords of the user,
+// userEnt is nil for a new user
+func validateNewPassword(userEnt *ent.User, username, newPassword string) error {
+	if err := passwordPolicy().Validate(newPassword, username); err != nil {
+		return err
+	}
+	if userEnt == nil {
+		return nil
+	}
+	if encrypt.BcryptCheck(newPassword, userEnt.Password) {
+		return errors.New("new password must be different from the current password")
+	}
+	for _, hash := range userEnt.PasswordHistory {
+		if encrypt.BcryptCheck(newPassword, hash) {
+			return fmt.Errorf("password must not be one of the last %d passwords", len(userEnt.PasswordHistory)+1)
+		}
+	}
+	return nil
+}
+
+// passwordHistory returns the history after the current password of the user is replaced
+func passwordHistory(userEnt *ent.User) []string {
+	size := configs.Data().PasswordPolicy.History
+	if size <= 0 {
+		return []string{}
+	}
+	history := append([]string{userEnt.Password}, userEnt.PasswordHistory...)
+	if len(history) > size {
+		history = history[:size]
+	}
+	return history
+}
+
+// passwordDeadline returns when the password of the user expires, zero if passwords never expire
+func passwordDeadline(userEnt *ent.User) time.Time {
+	maxAge := configs.Data().PasswordPolicy.MaxAge
+	if maxAge <= 0 {
+		return time.Time{}
+	}
+	changedAt := userEnt.CreatedAt
+	if userEnt.PasswordChangedAt != nil {
+		changedAt = *userEnt.PasswordChangedAt
+	}
+	return changedAt.AddDate(0, 0, maxAge)
+}
@@ -0,0 +1,127 @@
+/*
+ * Copyright 2023 FormulaGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ */
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"formulago/biz/domain/admin"
+	"formulago/configs"
+	"formulago/data"
+	"formulago/data/ent/user"
+	"formulago/pkg/mail"
+	"formulago/pkg/password"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+)
+
+type PasswordReset struct {
+	Data   *data.Data
+	Config configs.Config
+	// Sender delivers the reset mails, SMTP of the Mail config by default
+	Sender mail.Sender
+}
+
+func NewPasswordReset(data *data.Data, config configs.Config) admin.PasswordReset {
+	return &PasswordReset{
+		Data:   data,
+		Config: config,
+		Sender: newMailSender(config),
+	}
+}
+
+// newMailSender returns the SMTP sender of the Mail config
+func newMailSender(config configs.Config) mail.Sender {
+	return mail.NewSMTP(mail.SMTPConfig{
+		Host:     config.Mail.Host,
+		Port:     config.Mail.Port,
+		Username: config.Mail.Username,
+		Password: config.Mail.Password,
+		From:     config.Mail.From,
+		TLS:      config.Mail.TLS,
+	})
+}
+
+func (p *PasswordReset) SendResetMail(ctx context.Context, email string) error {
+	if p.Config.Mail.Host == "" || p.Config.PasswordReset.URL == "" {
+		return errors.New("password reset by mail is not enabled")
+	}
+	if email == "" {
+		return errors.New("email is required")
+	}
+	users, err := p.Data.DBClient.User.Query().Where(user.EmailEQ(email), user.Status(1)).All(ctx)
+	if err != nil {
+		return fmt.Errorf("get users failed: %w", err)
+	}
+
+	expire := configSeconds(p.Config.PasswordReset.Expire, 30*time.Minute)
+	for _, u := range users {
+		// one mail per user and minute
+		n, err := p.Data.CacheIncr(ctx, "passwordResetMail"+strconv.Itoa(int(u.ID)), time.Minute)
+		if err != nil {
+			return err
+		}
+		if n > 1 {
+			continue
+		}
+		token := password.ResetToken([]byte(p.Config.Auth.AccessSecret), u.ID, u.Password, time.Now().Add(expire))
+		msg := mail.Message{
+			To:      []string{u.Email},
+			Subject: p.Config.Name + " password reset | 重置密码",
+			Body: fmt.Sprintf("Hello %s,\n\n"+
+				"a password reset was requested for your account %s. "+
+				"Open the link below within %d minutes to set a new password, it can be used once:\n\n"+
+				"%s\n\n"+
+				"If you did not request it, ignore this mail and your password stays unchanged.\n",
+				u.Nickname, u.Username, int(expire.Minutes()), p.resetURL(token)),
+		}
+		// send in the background, the response must not reveal whether the email is registered
+		go func(username string) {
+			sendCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), time.Minute)
+			defer cancel()
+			if err := p.Sender.Send(sendCtx, msg); err != nil {
+				hlog.Error("send password reset mail failed, username: ", username, " err: ", err)
+			}
+		}(u.Username)
+	}
+	return nil
+}
+
+func (p *PasswordReset) Reset(ctx context.Context, token, newPassword string) error {
+	var username string
+	userID, err := password.VerifyResetToken([]byte(p.Config.Auth.AccessSecret), token, func(userID uint64) (string, error) {
+		u, err := p.Data.DBClient.User.Query().Where(user.IDEQ(userID), user.Status(1)).Only(ctx)
+		if err != nil {
+			return "", err
+		}
+		username = u.Username
+		return u.Password, nil
+	})
+	if err != nil {
+		return err
+	}
+	if err = NewUser(p.Data).ResetPassword(ctx, userID, newPassword); err != nil {
+		return err
+	}
+	// the user proved to own the mailbox, lift a lockout of the username
+	return NewLoginLimit(p.Data, p.Config).Unlock(ctx, username, "")
+}
+
+// resetURL appends the token to the reset page of the admin console
+func (p *PasswordReset) resetURL(token string) string {
+	link := p.Config.PasswordReset.URL
+	sep := "?"
+	if strings.Contains(link, "?") {
+		sep = "&"
+	}
+	return link + sep + "token=" + url.QueryEscape(token)
+}
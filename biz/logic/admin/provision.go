@@ -0,0 +1,197 @@
+/*
+ * Copyright 2023 FormulaGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ */
+
+package admin
+
+import (
+	"cmp"
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"formulago/biz/domain/admin"
+	"formulago/data"
+	"formulago/data/ent"
+	"formulago/data/ent/predicate"
+	"formulago/data/ent/user"
+	"formulago/data/ent/useridentity"
+	"formulago/pkg/encrypt"
+	"formulago/pkg/rolerule"
+
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+)
+
+// provisionPolicy is how a provider creates the users of the accounts not linked yet
+type provisionPolicy struct {
+	approval      bool
+	defaultRoleID uint64
+	roleRules     []rolerule.Rule
+}
+
+// provision creates the user of an account not linked yet and links the account to it,
+// the role rules of the provider decide its role, the default role is given if no rule matches
+func provision(ctx context.Context, db *data.Data, userInfo *admin.OauthUserInfo, policy provisionPolicy) (err error) {
+	linked, err := db.DBClient.UserIdentity.Query().
+		Where(useridentity.Provider(userInfo.Provider), useridentity.Subject(userInfo.Subject)).
+		Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("get user identity failed: %w", err)
+	}
+	if linked {
+		return nil
+	}
+
+	roleID, err := rolerule.Match(policy.roleRules, rolerule.Profile{
+		Email:         userInfo.Email,
+		EmailVerified: userInfo.EmailVerified,
+		Departments:   userInfo.Departments,
+		Groups:        userInfo.Groups,
+	})
+	if err != nil {
+		if policy.defaultRoleID == 0 {
+			return errors.New("the account is not linked to any user and no role rule matches it")
+		}
+		roleID = policy.defaultRoleID
+	}
+
+	_, err = createLinkedUser(ctx, db, userInfo.Provider, userInfo, roleID, policy.approval)
+	return err
+}
+
+// createLinkedUser creates the user of an external account from its profile and links the account to it
+func createLinkedUser(ctx context.Context, db *data.Data, provider string, userInfo *admin.OauthUserInfo, roleID uint64, pending bool) (u *ent.User, err error) {
+	username, err := provisionUsername(ctx, db, userInfo)
+	if err != nil {
+		return nil, err
+	}
+	nickname, err := unusedOr(ctx, db, userInfo.NickName, username, user.Nickname)
+	if err != nil {
+		return nil, err
+	}
+	// the mobile is required and unique, the users without one keep the username until they set it
+	mobile, err := unusedOr(ctx, db, userInfo.Mobile, username, user.Mobile)
+	if err != nil {
+		return nil, err
+	}
+	// the user signs in with the provider, the random password is never told
+	random, err := encrypt.RandomToken(32)
+	if err != nil {
+		return nil, err
+	}
+	password, err := encrypt.BcryptEncrypt(random)
+	if err != nil {
+		return nil, err
+	}
+	status := uint8(1)
+	if pending {
+		status = 0
+	}
+
+	tx, err := db.DBClient.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("starting a transaction err: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			rollbackErr := tx.Rollback()
+			if rollbackErr != nil {
+				hlog.Error("create linked user err:", err, "rollback err:", rollbackErr)
+			}
+		}
+	}()
+	// the user belongs to the tenant of the role
+	roleEnt, err := tx.Role.Get(ctx, roleID)
+	if err != nil {
+		return nil, fmt.Errorf("get role failed: %w", err)
+	}
+	u, err = tx.User.Create().
+		SetTenantID(roleEnt.TenantID).
+		SetUsername(username).
+		SetNickname(nickname).
+		SetPassword(password).
+		SetPasswordChangedAt(time.Now()).
+		SetMobile(mobile).
+		SetEmail(userInfo.Email).
+		SetAvatar(userInfo.Picture).
+		SetRoleID(roleID).
+		AddRoleIDs(roleID).
+		SetStatus(status).
+		SetPendingApproval(pending).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("create user failed: %w", err)
+	}
+	err = tx.UserIdentity.Create().
+		SetUserID(u.ID).
+		SetProvider(provider).
+		SetSubject(userInfo.Subject).
+		SetUsername(userInfo.Username).
+		SetEmail(userInfo.Email).
+		Exec(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("create user identity failed: %w", err)
+	}
+	return u, tx.Commit()
+}
+
+// provisionUsername the lowercase letters of the username at the provider, or of the email, with a random suffix
+// if they are too short or taken
+func provisionUsername(ctx context.Context, db *data.Data, userInfo *admin.OauthUserInfo) (string, error) {
+	local, _, _ := strings.Cut(userInfo.Email, "@")
+	base := strings.Map(func(r rune) rune {
+		if r >= 'a' && r <= 'z' {
+			return r
+		}
+		return -1
+	}, strings.ToLower(cmp.Or(userInfo.Username, local, userInfo.Credential)))
+	for i := 0; i < 5; i++ {
+		username := base
+		if i > 0 || validateUsername(username) != nil {
+			suffix, err := randomLetters(6)
+			if err != nil {
+				return "", err
+			}
+			username += suffix
+		}
+		exist, err := db.DBClient.User.Query().Where(user.Username(username)).Exist(ctx)
+		if err != nil {
+			return "", fmt.Errorf("get user failed: %w", err)
+		}
+		if !exist {
+			return username, nil
+		}
+	}
+	return "", errors.New("generate the username of the user failed")
+}
+
+// unusedOr returns the value unless it is empty or another user has it already
+func unusedOr(ctx context.Context, db *data.Data, value, fallback string, field func(string) predicate.User) (string, error) {
+	if value == "" {
+		return fallback, nil
+	}
+	exist, err := db.DBClient.User.Query().Where(field(value)).Exist(ctx)
+	if err != nil {
+		return "", fmt.Errorf("get user failed: %w", err)
+	}
+	if exist {
+		return fallback, nil
+	}
+	return value, nil
+}
+
+func randomLetters(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	for i := range b {
+		b[i] = 'a' + b[i]%26
+	}
+	return string(b), nil
+}
@@ -0,0 +1,61 @@
+/*
+ * Copyright 2023 FormulaGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ */
+
+package admin
+
+import (
+	"context"
+	"testing"
+
+	"formulago/biz/domain/admin"
+	"formulago/data/ent/user"
+	"formulago/pkg/rolerule"
+)
+
+func TestProvision_emailDomain(t *testing.T) {
+	ctx := context.Background()
+	d := newTestData(t)
+	admins := newTestRole(t, d, "admin")
+	members := newTestRole(t, d, "member")
+	rules := []rolerule.Rule{{Type: rolerule.TypeEmailDomain, Value: "example.com", RoleID: admins.ID}}
+
+	tests := []struct {
+		name          string
+		subject       string
+		emailVerified bool
+		defaultRoleID uint64
+		wantRoleID    uint64
+		wantErr       bool
+	}{
+		{name: "verified email", subject: "alice", emailVerified: true, defaultRoleID: members.ID, wantRoleID: admins.ID},
+		{name: "email not verified", subject: "bobby", emailVerified: false, defaultRoleID: members.ID, wantRoleID: members.ID},
+		{name: "email not verified without default role", subject: "carol", emailVerified: false, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := provision(ctx, d, &admin.OauthUserInfo{
+				Provider:      "oidc",
+				Subject:       tt.subject,
+				Username:      tt.subject,
+				Email:         tt.subject + "@example.com",
+				EmailVerified: tt.emailVerified,
+			}, provisionPolicy{defaultRoleID: tt.defaultRoleID, roleRules: rules})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("provision() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			userEnt, err := d.DBClient.User.Query().Where(user.Username(tt.subject)).Only(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if userEnt.RoleID != tt.wantRoleID {
+				t.Errorf("provisioned role = %d, want %d", userEnt.RoleID, tt.wantRoleID)
+			}
+		})
+	}
+}
@@ -0,0 +1,184 @@
+/*
+ * Copyright 2023 FormulaGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ */
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"formulago/biz/domain/admin"
+	"formulago/configs"
+	"formulago/data"
+	"formulago/data/ent"
+	"formulago/data/ent/refreshtoken"
+	"formulago/data/ent/token"
+	"formulago/data/ent/user"
+	"formulago/pkg/encrypt"
+
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+)
+
+// defaultRefreshExpire is used when Auth.RefreshExpire is not configured, 30 days
+const defaultRefreshExpire = 30 * 24 * time.Hour
+
+type RefreshToken struct {
+	Data   *data.Data
+	Config configs.Config
+}
+
+func NewRefreshToken(data *data.Data, config configs.Config) admin.RefreshToken {
+	return &RefreshToken{
+		Data:   data,
+		Config: config,
+	}
+}
+
+func (r *RefreshToken) expire() time.Duration {
+	if r.Config.Auth.RefreshExpire > 0 {
+		return time.Duration(r.Config.Auth.RefreshExpire) * time.Second
+	}
+	return defaultRefreshExpire
+}
+
+func (r *RefreshToken) Create(ctx context.Context, userID uint64, family string) (refreshToken string, err error) {
+	refreshToken, err = encrypt.RandomToken(32)
+	if err != nil {
+		return "", fmt.Errorf("generate refresh token failed: %w", err)
+	}
+	// only the hash is stored, the token itself is returned to the client once
+	_, err = r.Data.DBClient.RefreshToken.Create().
+		SetUserID(userID).
+		SetTokenHash(encrypt.SHA256Hex(refreshToken)).
+		SetFamily(family).
+		SetExpiredAt(time.Now().Add(r.expire())).
+		Save(ctx)
+	if err != nil {
+		return "", fmt.Errorf("create refresh token failed: %w", err)
+	}
+	return refreshToken, nil
+}
+
+func (r *RefreshToken) Rotate(ctx context.Context, refreshToken string) (res *admin.RefreshTokenInfo, err error) {
+	tokenEnt, err := r.Data.DBClient.RefreshToken.Query().
+		Where(refreshtoken.TokenHash(encrypt.SHA256Hex(refreshToken))).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.New("invalid refresh token")
+		}
+		return nil, fmt.Errorf("get refresh token failed: %w", err)
+	}
+
+	// replay of a used or revoked token, the family may be stolen, revoke all of it
+	if tokenEnt.UsedAt != nil || tokenEnt.RevokedAt != nil {
+		r.revokeSession(ctx, tokenEnt.Family)
+		return nil, admin.ErrRefreshTokenReused
+	}
+	if time.Now().After(tokenEnt.ExpiredAt) {
+		return nil, errors.New("refresh token expired")
+	}
+
+	// mark as used, the condition guards against concurrent rotation of the same token
+	n, err := r.Data.DBClient.RefreshToken.Update().
+		Where(refreshtoken.IDEQ(tokenEnt.ID), refreshtoken.UsedAtIsNil()).
+		SetUsedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("rotate refresh token failed: %w", err)
+	}
+	if n == 0 {
+		r.revokeSession(ctx, tokenEnt.Family)
+		return nil, admin.ErrRefreshTokenReused
+	}
+
+	// the session must not be revoked, e.g. logout or forced offline
+	session, err := r.Data.DBClient.Token.Query().
+		Where(token.Jti(tokenEnt.Family), token.RevokedAtIsNil()).
+		Only(ctx)
+	if err != nil {
+		if !ent.IsNotFound(err) {
+			return nil, fmt.Errorf("get session failed: %w", err)
+		}
+		_ = r.RevokeFamily(ctx, tokenEnt.Family)
+		return nil, errors.New("session is revoked")
+	}
+
+	// the user must still be active
+	userEnt, err := r.Data.DBClient.User.Query().Where(user.IDEQ(tokenEnt.UserID), user.Status(1)).Only(ctx)
+	if err != nil {
+		r.revokeSession(ctx, tokenEnt.Family)
+		return nil, fmt.Errorf("get active user failed: %w", err)
+	}
+
+	// the session keeps the role switched to, unless it is no longer an active role of the user
+	roles, err := NewUser(r.Data).ActiveRoles(ctx, userEnt.ID)
+	if err != nil {
+		return nil, err
+	}
+	roleID, ok := sessionRole(roles, session.RoleID, userEnt.RoleID)
+	if !ok {
+		r.revokeSession(ctx, tokenEnt.Family)
+		return nil, errors.New("the user has no active role")
+	}
+	if roleID != session.RoleID {
+		if err = r.Data.DBClient.Token.UpdateOne(session).SetRoleID(roleID).Exec(ctx); err != nil {
+			return nil, fmt.Errorf("update role of the session failed: %w", err)
+		}
+	}
+
+	newToken, err := r.Create(ctx, tokenEnt.UserID, tokenEnt.Family)
+	if err != nil {
+		return nil, err
+	}
+
+	res = new(admin.RefreshTokenInfo)
+	res.UserID = userEnt.ID
+	res.RoleID = roleID
+	res.TokenVersion = userEnt.TokenVersion
+	res.TenantID = userEnt.TenantID
+	res.Family = tokenEnt.Family
+	res.RefreshToken = newToken
+	return res, nil
+}
+
+func (r *RefreshToken) RevokeFamily(ctx context.Context, family string) error {
+	_, err := r.Data.DBClient.RefreshToken.Update().
+		Where(refreshtoken.Family(family), refreshtoken.RevokedAtIsNil()).
+		SetRevokedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("revoke refresh token family failed: %w", err)
+	}
+	return nil
+}
+
+// sessionRole returns the role of the session if it is still an active role, otherwise the default role of the user,
+// or the first active role. The sessions created before the roles of the sessions have none.
+func sessionRole(roles []*admin.RoleInfo, sessionRoleID, userRoleID uint64) (uint64, bool) {
+	if len(roles) == 0 {
+		return 0, false
+	}
+	for _, id := range []uint64{sessionRoleID, userRoleID} {
+		if id != 0 && slices.ContainsFunc(roles, func(r *admin.RoleInfo) bool { return r.ID == id }) {
+			return id, true
+		}
+	}
+	return roles[0].ID, true
+}
+
+// revokeSession revokes the refresh token family and the session of the family
+func (r *RefreshToken) revokeSession(ctx context.Context, family string) {
+	if err := r.RevokeFamily(ctx, family); err != nil {
+		hlog.Error(err)
+	}
+	if err := NewToken(r.Data).Revoke(ctx, family); err != nil {
+		hlog.Error(err)
+	}
+}
@@ -0,0 +1,125 @@
+/*
+ * Copyright 2023 FormulaGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ */
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"formulago/biz/domain/admin"
+	"formulago/configs"
+	"formulago/data"
+	"formulago/data/ent/refreshtoken"
+	"formulago/data/ent/token"
+	"formulago/pkg/times"
+)
+
+// newTestSession creates a session of the user whose access token is already expired, and its refresh token
+func newTestSession(t *testing.T, d *data.Data, userID uint64, jti string) (refreshToken string) {
+	ctx := context.Background()
+	err := NewToken(d).Create(ctx, &admin.TokenInfo{
+		UserID:    userID,
+		JTI:       jti,
+		Token:     "access-" + jti,
+		Source:    "test",
+		ExpiredAt: time.Now().Add(time.Hour).Format(times.TimeFormat),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the access token expires, the session stays renewable by the refresh token
+	err = d.DBClient.Token.Update().Where(token.Jti(jti)).SetExpiredAt(time.Now().Add(-time.Minute)).Exec(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d.Cache.Flush()
+
+	refreshToken, err = NewRefreshToken(d, configs.Config{}).Create(ctx, userID, jti)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return refreshToken
+}
+
+func TestRefreshToken_Rotate(t *testing.T) {
+	ctx := context.Background()
+	d := newTestData(t)
+	newTestRole(t, d, "admin")
+	userEnt := newTestUser(t, d, "alice", 1)
+	tokens := NewToken(d)
+	refreshTokens := NewRefreshToken(d, configs.Config{})
+
+	first := newTestSession(t, d, userEnt.ID, "session-1")
+
+	// listing the sessions must not drop the session of an expired access token
+	if _, _, err := tokens.List(ctx, &admin.TokenListReq{Page: 1, PageSize: 10}); err != nil {
+		t.Fatal(err)
+	}
+
+	// rotate after the access token expired
+	res, err := refreshTokens.Rotate(ctx, first)
+	if err != nil {
+		t.Fatalf("rotate after access expiry: %v", err)
+	}
+	if res.Family != "session-1" || res.UserID != userEnt.ID || res.RefreshToken == "" || res.RefreshToken == first {
+		t.Fatalf("unexpected rotate result %+v", res)
+	}
+	err = tokens.Renew(ctx, res.Family, time.Now().Add(time.Hour).Format(times.TimeFormat))
+	if err != nil {
+		t.Fatalf("renew the session: %v", err)
+	}
+	if !tokens.IsActive(ctx, "session-1") {
+		t.Fatal("renewed session should be active")
+	}
+
+	// replay of the used token revokes the whole family and the session
+	_, err = refreshTokens.Rotate(ctx, first)
+	if !errors.Is(err, admin.ErrRefreshTokenReused) {
+		t.Fatalf("replay got error %v, want %v", err, admin.ErrRefreshTokenReused)
+	}
+	if tokens.IsActive(ctx, "session-1") {
+		t.Fatal("session should be revoked after the replay")
+	}
+	alive, err := d.DBClient.RefreshToken.Query().
+		Where(refreshtoken.Family("session-1"), refreshtoken.RevokedAtIsNil()).
+		Exist(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if alive {
+		t.Fatal("refresh token family should be revoked after the replay")
+	}
+	// the newest token of the family is dead as well
+	if _, err = refreshTokens.Rotate(ctx, res.RefreshToken); !errors.Is(err, admin.ErrRefreshTokenReused) {
+		t.Fatalf("rotate the revoked family got error %v, want %v", err, admin.ErrRefreshTokenReused)
+	}
+}
+
+func TestToken_List_purgeExpired(t *testing.T) {
+	ctx := context.Background()
+	d := newTestData(t)
+	userEnt := newTestUser(t, d, "alice", 1)
+
+	newTestSession(t, d, userEnt.ID, "renewable")
+	newTestSession(t, d, userEnt.ID, "dead")
+	// the refresh family of the dead session expired as well
+	err := d.DBClient.RefreshToken.Update().Where(refreshtoken.Family("dead")).
+		SetExpiredAt(time.Now().Add(-time.Minute)).Exec(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res, total, err := NewToken(d).List(ctx, &admin.TokenListReq{Page: 1, PageSize: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if total != 1 || len(res) != 1 || res[0].JTI != "renewable" {
+		t.Fatalf("List got %d sessions %+v, want the renewable one only", total, res)
+	}
+}
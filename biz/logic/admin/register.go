@@ -0,0 +1,154 @@
+/*
+ * Copyright 2023 FormulaGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ */
+
+package admin
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"formulago/biz/domain/admin"
+	"formulago/configs"
+	"formulago/data"
+	"formulago/pkg/mail"
+	"math/big"
+	netmail "net/mail"
+	"strings"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+)
+
+const (
+	registerCodeExpire      = 10 * time.Minute
+	registerCodeMaxAttempts = 5
+)
+
+type Register struct {
+	Data   *data.Data
+	Config configs.Config
+	// Sender delivers the verification codes, SMTP of the Mail config by default
+	Sender mail.Sender
+}
+
+func NewRegister(data *data.Data, config configs.Config) admin.Register {
+	return &Register{
+		Data:   data,
+		Config: config,
+		Sender: newMailSender(config),
+	}
+}
+
+func (r *Register) SendCode(ctx context.Context, email string) error {
+	if !r.Config.Register.Enable || r.Config.Register.Verify != "email" {
+		return errors.New("email verification of the registration is not enabled")
+	}
+	if _, err := netmail.ParseAddress(email); err != nil {
+		return errors.New("invalid email")
+	}
+	key := registerCodeKey(email)
+	// one code per email and minute
+	n, err := r.Data.CacheIncr(ctx, "registerCodeSent"+key, time.Minute)
+	if err != nil {
+		return err
+	}
+	if n > 1 {
+		return errors.New("a code has been sent, please retry in a minute")
+	}
+
+	code, err := rand.Int(rand.Reader, big.NewInt(1000000))
+	if err != nil {
+		return err
+	}
+	codeStr := fmt.Sprintf("%06d", code.Int64())
+	if err = r.Data.CacheSet(ctx, "registerCode"+key, codeStr, registerCodeExpire); err != nil {
+		return err
+	}
+	_ = r.Data.CacheDelete(ctx, "registerCodeFail"+key)
+
+	msg := mail.Message{
+		To:      []string{email},
+		Subject: r.Config.Name + " registration code | 注册验证码",
+		Body: fmt.Sprintf("Your registration code is %s, it expires in %d minutes.\n\n"+
+			"If you did not register, ignore this mail.\n", codeStr, int(registerCodeExpire.Minutes())),
+	}
+	if err = r.Sender.Send(ctx, msg); err != nil {
+		hlog.Error("send registration code failed, err: ", err)
+		return errors.New("send registration code failed")
+	}
+	return nil
+}
+
+func (r *Register) Register(ctx context.Context, req admin.RegisterReq) error {
+	c := r.Config.Register
+	if !c.Enable {
+		return errors.New("register is not enabled")
+	}
+	if req.Mobile == "" {
+		return errors.New("mobile is required")
+	}
+
+	switch c.Verify {
+	case "email":
+		if err := r.verifyCode(ctx, req.Email, req.EmailCode); err != nil {
+			return err
+		}
+	default:
+		if !NewCaptcha().Verify(req.CaptchaID, req.Captcha) {
+			return errors.New("invalid captcha")
+		}
+	}
+
+	roleID := c.DefaultRoleID
+	if roleID == 0 {
+		roleID = 2
+	}
+	status := uint64(1)
+	if c.Approval {
+		status = 0
+	}
+	return NewUser(r.Data).Create(ctx, admin.CreateOrUpdateUserReq{
+		RoleID:          roleID,
+		Mobile:          req.Mobile,
+		Email:           req.Email,
+		Status:          status,
+		Username:        req.Username,
+		Password:        req.Password,
+		Nickname:        req.Username,
+		PendingApproval: c.Approval,
+	})
+}
+
+// verifyCode checks the mailed code, it is deleted when used or after too many wrong attempts
+func (r *Register) verifyCode(ctx context.Context, email, code string) error {
+	if email == "" || code == "" {
+		return errors.New("email and email code are required")
+	}
+	key := registerCodeKey(email)
+	want, exist, err := r.Data.CacheGet(ctx, "registerCode"+key)
+	if err != nil || !exist {
+		return errors.New("invalid or expired email code")
+	}
+	if subtle.ConstantTimeCompare([]byte(want), []byte(code)) != 1 {
+		n, err := r.Data.CacheIncr(ctx, "registerCodeFail"+key, registerCodeExpire)
+		if err == nil && n >= registerCodeMaxAttempts {
+			_ = r.Data.CacheDelete(ctx, "registerCode"+key)
+		}
+		return errors.New("invalid or expired email code")
+	}
+	_ = r.Data.CacheDelete(ctx, "registerCode"+key)
+	_ = r.Data.CacheDelete(ctx, "registerCodeFail"+key)
+	return nil
+}
+
+func registerCodeKey(email string) string {
+	sum := sha256.Sum256([]byte(strings.ToLower(strings.TrimSpace(email))))
+	return hex.EncodeToString(sum[:])
+}
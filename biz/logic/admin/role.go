@@ -16,30 +16,43 @@ import (
 	"formulago/data/ent/predicate"
 	"formulago/data/ent/role"
 	"formulago/data/ent/user"
+	"formulago/pkg/tenant"
 	"formulago/pkg/times"
+	"slices"
 	"strconv"
 	"time"
+
+	"github.com/casbin/casbin/v3"
 )
 
 type Role struct {
 	Data *data.Data
+	Cbs  *casbin.Enforcer
 }
 
-func NewRole(data *data.Data) admin.Role {
+func NewRole(data *data.Data, cbs *casbin.Enforcer) admin.Role {
 	return &Role{
 		Data: data,
+		Cbs:  cbs,
 	}
 }
 
 func (r *Role) Create(ctx context.Context, req admin.RoleInfo) error {
-	roleEnt, err := r.Data.DBClient.Role.Create().
+	if err := r.checkParent(ctx, 0, req.ParentID); err != nil {
+		return err
+	}
+	create := r.Data.DBClient.Role.Create().
 		SetName(req.Name).
 		SetValue(req.Value).
 		SetDefaultRouter(req.DefaultRouter).
 		SetStatus(uint8(req.Status)).
 		SetRemark(req.Remark).
 		SetOrderNo(req.OrderNo).
-		Save(ctx)
+		SetForceTwoFactor(req.ForceTwoFactor)
+	if req.ParentID != 0 {
+		create.SetParentID(req.ParentID)
+	}
+	roleEnt, err := create.Save(ctx)
 	if err != nil {
 		err = fmt.Errorf("create Role failed: %w", err)
 		return err
@@ -47,19 +60,28 @@ func (r *Role) Create(ctx context.Context, req admin.RoleInfo) error {
 
 	// set roleEnt to cache
 	r.Data.Cache.Set("roleData"+strconv.Itoa(int(roleEnt.ID)), roleEnt, 24*time.Hour)
-	return nil
+	return r.setParent(ctx, roleEnt)
 }
 
 func (r *Role) Update(ctx context.Context, req admin.RoleInfo) error {
-	roleEnt, err := r.Data.DBClient.Role.UpdateOneID(req.ID).
+	if err := r.checkParent(ctx, req.ID, req.ParentID); err != nil {
+		return err
+	}
+	update := r.Data.DBClient.Role.UpdateOneID(req.ID).
 		SetName(req.Name).
 		SetValue(req.Value).
 		SetDefaultRouter(req.DefaultRouter).
 		SetStatus(uint8(req.Status)).
 		SetRemark(req.Remark).
 		SetOrderNo(req.OrderNo).
-		SetUpdatedAt(time.Now()).
-		Save(ctx)
+		SetForceTwoFactor(req.ForceTwoFactor).
+		SetUpdatedAt(time.Now())
+	if req.ParentID != 0 {
+		update.SetParentID(req.ParentID)
+	} else {
+		update.ClearParentID()
+	}
+	roleEnt, err := update.Save(ctx)
 	if err != nil {
 		err = fmt.Errorf("update Role failed: %w", err)
 		return err
@@ -67,12 +89,22 @@ func (r *Role) Update(ctx context.Context, req admin.RoleInfo) error {
 
 	// set roleEnt to cache
 	r.Data.Cache.Set("roleData"+strconv.Itoa(int(roleEnt.ID)), roleEnt, 24*time.Hour)
+	if err = r.setParent(ctx, roleEnt); err != nil {
+		return err
+	}
+	if err = r.setChildren(ctx, roleEnt); err != nil {
+		return err
+	}
+	// the users of a disabled role are logged out
+	if roleEnt.Status != 1 {
+		return NewToken(r.Data).RevokeRole(ctx, roleEnt.ID)
+	}
 	return nil
 }
 
 func (r *Role) Delete(ctx context.Context, id uint64) error {
 	// whether role is used by user
-	exist, err := r.Data.DBClient.User.Query().Where(user.RoleIDEQ(id)).Exist(ctx)
+	exist, err := r.Data.DBClient.User.Query().Where(user.Or(user.RoleIDEQ(id), user.HasRolesWith(role.IDEQ(id)))).Exist(ctx)
 	if err != nil {
 		err = fmt.Errorf("query user - role failed: %w", err)
 		return err
@@ -80,6 +112,13 @@ func (r *Role) Delete(ctx context.Context, id uint64) error {
 	if exist {
 		return errors.New("role is used by user")
 	}
+	exist, err = r.Data.DBClient.Role.Query().Where(role.ParentIDEQ(id)).Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("query child roles failed: %w", err)
+	}
+	if exist {
+		return errors.New("role has child roles")
+	}
 	// delete role from db
 	err = r.Data.DBClient.Role.DeleteOneID(id).Exec(ctx)
 	if err != nil {
@@ -88,6 +127,10 @@ func (r *Role) Delete(ctx context.Context, id uint64) error {
 	}
 	// delete role from cache
 	r.Data.Cache.Delete("roleData" + strconv.Itoa(int(id)))
+	// the role no longer inherits from its parent
+	if _, err = r.Cbs.RemoveFilteredGroupingPolicy(0, strconv.FormatUint(id, 10)); err != nil {
+		return fmt.Errorf("remove role inheritance failed: %w", err)
+	}
 	return nil
 }
 
@@ -96,16 +139,23 @@ func (r *Role) RoleInfoByID(ctx context.Context, ID uint64) (roleInfo *admin.Rol
 	roleInterface, ok := r.Data.Cache.Get("roleData" + strconv.Itoa(int(ID)))
 	if ok {
 		if r, ok := roleInterface.(*ent.Role); ok {
+			// the cache is shared by the tenants
+			if id, ok := tenant.FromContext(ctx); ok && r.TenantID != id {
+				return nil, errors.New("get Role failed: role not found")
+			}
 			return &admin.RoleInfo{
-				ID:            r.ID,
-				Name:          r.Name,
-				Value:         r.Value,
-				DefaultRouter: r.DefaultRouter,
-				Status:        uint64(r.Status),
-				Remark:        r.Remark,
-				OrderNo:       r.OrderNo,
-				CreatedAt:     r.CreatedAt.Format(times.TimeFormat),
-				UpdatedAt:     r.UpdatedAt.Format(times.TimeFormat),
+				ID:             r.ID,
+				Name:           r.Name,
+				Value:          r.Value,
+				DefaultRouter:  r.DefaultRouter,
+				Status:         uint64(r.Status),
+				Remark:         r.Remark,
+				OrderNo:        r.OrderNo,
+				CreatedAt:      r.CreatedAt.Format(times.TimeFormat),
+				UpdatedAt:      r.UpdatedAt.Format(times.TimeFormat),
+				ForceTwoFactor: r.ForceTwoFactor,
+				TenantID:       r.TenantID,
+				ParentID:       r.ParentID,
 			}, nil
 		}
 	}
@@ -119,15 +169,18 @@ func (r *Role) RoleInfoByID(ctx context.Context, ID uint64) (roleInfo *admin.Rol
 	r.Data.Cache.Set("roleData"+strconv.Itoa(int(ID)), roleEnt, 24*time.Hour)
 	// convert to RoleInfo
 	roleInfo = &admin.RoleInfo{
-		ID:            roleEnt.ID,
-		Name:          roleEnt.Name,
-		Value:         roleEnt.Value,
-		DefaultRouter: roleEnt.DefaultRouter,
-		Status:        uint64(roleEnt.Status),
-		Remark:        roleEnt.Remark,
-		OrderNo:       roleEnt.OrderNo,
-		CreatedAt:     roleEnt.CreatedAt.Format(times.TimeFormat),
-		UpdatedAt:     roleEnt.UpdatedAt.Format(times.TimeFormat),
+		ID:             roleEnt.ID,
+		Name:           roleEnt.Name,
+		Value:          roleEnt.Value,
+		DefaultRouter:  roleEnt.DefaultRouter,
+		Status:         uint64(roleEnt.Status),
+		Remark:         roleEnt.Remark,
+		OrderNo:        roleEnt.OrderNo,
+		CreatedAt:      roleEnt.CreatedAt.Format(times.TimeFormat),
+		UpdatedAt:      roleEnt.UpdatedAt.Format(times.TimeFormat),
+		ForceTwoFactor: roleEnt.ForceTwoFactor,
+		TenantID:       roleEnt.TenantID,
+		ParentID:       roleEnt.ParentID,
 	}
 	return
 }
@@ -151,15 +204,18 @@ func (r *Role) List(ctx context.Context, req *admin.RoleListReq) (roleInfoList [
 	// convert to List
 	for _, roleEnt := range roleEntList {
 		roleInfoList = append(roleInfoList, &admin.RoleInfo{
-			ID:            roleEnt.ID,
-			Name:          roleEnt.Name,
-			Value:         roleEnt.Value,
-			DefaultRouter: roleEnt.DefaultRouter,
-			Status:        uint64(roleEnt.Status),
-			Remark:        roleEnt.Remark,
-			OrderNo:       roleEnt.OrderNo,
-			CreatedAt:     roleEnt.CreatedAt.Format(times.TimeFormat),
-			UpdatedAt:     roleEnt.UpdatedAt.Format(times.TimeFormat),
+			ID:             roleEnt.ID,
+			Name:           roleEnt.Name,
+			Value:          roleEnt.Value,
+			DefaultRouter:  roleEnt.DefaultRouter,
+			Status:         uint64(roleEnt.Status),
+			Remark:         roleEnt.Remark,
+			OrderNo:        roleEnt.OrderNo,
+			CreatedAt:      roleEnt.CreatedAt.Format(times.TimeFormat),
+			UpdatedAt:      roleEnt.UpdatedAt.Format(times.TimeFormat),
+			ForceTwoFactor: roleEnt.ForceTwoFactor,
+			TenantID:       roleEnt.TenantID,
+			ParentID:       roleEnt.ParentID,
 		})
 	}
 	total, err = r.Data.DBClient.Role.Query().Count(ctx)
@@ -179,6 +235,153 @@ func (r *Role) UpdateStatus(ctx context.Context, ID uint64, status uint8) error
 	}
 	// set role to cache
 	r.Data.Cache.Set("roleData"+strconv.Itoa(int(ID)), roleEnt, 24*time.Hour)
+	if err = r.setChildren(ctx, roleEnt); err != nil {
+		return err
+	}
+	// the users of a disabled role are logged out
+	if status != 1 {
+		return NewToken(r.Data).RevokeRole(ctx, ID)
+	}
+	return nil
+}
+
+func (r *Role) Tree(ctx context.Context) (tree []*admin.RoleInfoTree, err error) {
+	roles, err := r.Data.DBClient.Role.Query().Order(ent.Asc(role.FieldOrderNo)).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get role tree failed: %w", err)
+	}
+	return findRoleChildren(roles, 0), nil
+}
+
+func findRoleChildren(roles []*ent.Role, parentID uint64) []*admin.RoleInfoTree {
+	var result []*admin.RoleInfoTree
+	for _, v := range roles {
+		if v.ParentID == parentID {
+			result = append(result, &admin.RoleInfoTree{
+				RoleInfo: admin.RoleInfo{
+					ID:             v.ID,
+					Name:           v.Name,
+					Value:          v.Value,
+					DefaultRouter:  v.DefaultRouter,
+					Status:         uint64(v.Status),
+					Remark:         v.Remark,
+					OrderNo:        v.OrderNo,
+					CreatedAt:      v.CreatedAt.Format(times.TimeFormat),
+					UpdatedAt:      v.UpdatedAt.Format(times.TimeFormat),
+					ForceTwoFactor: v.ForceTwoFactor,
+					TenantID:       v.TenantID,
+					ParentID:       v.ParentID,
+				},
+				Children: findRoleChildren(roles, v.ID),
+			})
+		}
+	}
+	return result
+}
+
+// checkParent checks the parent exists in the tenant, and is neither the role itself nor one of its descendants
+func (r *Role) checkParent(ctx context.Context, id, parentID uint64) error {
+	if parentID == 0 {
+		return nil
+	}
+	if parentID == id {
+		return errors.New("a role cannot be its own parent")
+	}
+	ancestors, err := roleAncestors(ctx, r.Data, parentID)
+	if err != nil {
+		return err
+	}
+	if id != 0 && slices.Contains(ancestors, id) {
+		return errors.New("the parent role is a descendant of the role")
+	}
+	return nil
+}
+
+// setParent replaces the casbin grouping policy of the role,
+// through which the role inherits the api policies of the parent within the tenant.
+// A disabled parent grants nothing, so the role is only linked to an active parent.
+func (r *Role) setParent(ctx context.Context, roleEnt *ent.Role) error {
+	id := strconv.FormatUint(roleEnt.ID, 10)
+	if _, err := r.Cbs.RemoveFilteredGroupingPolicy(0, id); err != nil {
+		return fmt.Errorf("remove role inheritance failed: %w", err)
+	}
+	if roleEnt.ParentID == 0 {
+		return nil
+	}
+	parent, err := r.Data.DBClient.Role.Get(ctx, roleEnt.ParentID)
+	if err != nil {
+		return fmt.Errorf("get parent role failed: %w", err)
+	}
+	if parent.Status != 1 {
+		return nil
+	}
+	_, err = r.Cbs.AddGroupingPolicy(id, strconv.FormatUint(roleEnt.ParentID, 10), tenant.Domain(roleEnt.TenantID))
+	if err != nil {
+		return fmt.Errorf("add role inheritance failed: %w", err)
+	}
+	return nil
+}
 
+// setChildren links the children to the role when it is enabled, and unlinks them when it is disabled
+func (r *Role) setChildren(ctx context.Context, roleEnt *ent.Role) error {
+	children, err := r.Data.DBClient.Role.Query().Where(role.ParentIDEQ(roleEnt.ID)).IDs(ctx)
+	if err != nil {
+		return fmt.Errorf("query child roles failed: %w", err)
+	}
+	id := strconv.FormatUint(roleEnt.ID, 10)
+	domain := tenant.Domain(roleEnt.TenantID)
+	for _, child := range children {
+		childID := strconv.FormatUint(child, 10)
+		if roleEnt.Status == 1 {
+			_, err = r.Cbs.AddGroupingPolicy(childID, id, domain)
+		} else {
+			_, err = r.Cbs.RemoveGroupingPolicy(childID, id, domain)
+		}
+		if err != nil {
+			return fmt.Errorf("update role inheritance failed: %w", err)
+		}
+	}
 	return nil
 }
+
+// roleAncestors returns the role and its ancestors, the role first.
+// It stops at a role met before, so a cycle made by concurrent updates cannot loop forever.
+func roleAncestors(ctx context.Context, d *data.Data, id uint64) (ids []uint64, err error) {
+	roles, err := roleChain(ctx, d, id)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range roles {
+		ids = append(ids, v.ID)
+	}
+	return ids, nil
+}
+
+// inheritedRoles returns the role and the ancestors it inherits from, the role first.
+// The inheritance stops at a disabled ancestor, like the grouping policies set by setParent.
+func inheritedRoles(ctx context.Context, d *data.Data, id uint64) (ids []uint64, err error) {
+	roles, err := roleChain(ctx, d, id)
+	if err != nil {
+		return nil, err
+	}
+	for i, v := range roles {
+		if i > 0 && v.Status != 1 {
+			break
+		}
+		ids = append(ids, v.ID)
+	}
+	return ids, nil
+}
+
+// roleChain returns the role and its ancestors, the role first, stopping at a role met before
+func roleChain(ctx context.Context, d *data.Data, id uint64) (roles []*ent.Role, err error) {
+	for id != 0 && !slices.ContainsFunc(roles, func(v *ent.Role) bool { return v.ID == id }) {
+		roleEnt, err := d.DBClient.Role.Get(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("get role failed: %w", err)
+		}
+		roles = append(roles, roleEnt)
+		id = roleEnt.ParentID
+	}
+	return roles, nil
+}
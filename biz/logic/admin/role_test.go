@@ -0,0 +1,203 @@
+/*
+ * Copyright 2023 FormulaGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ */
+
+package admin
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"strconv"
+	"testing"
+
+	"formulago/biz/domain/admin"
+	"formulago/data"
+	"formulago/data/ent/role"
+	"formulago/pkg/tenant"
+
+	"github.com/casbin/casbin/v3"
+)
+
+// newTestRoleChain creates the active roles, each one the parent of the next
+func newTestRoleChain(t *testing.T, d *data.Data, e *casbin.Enforcer, values ...string) (ids []uint64) {
+	ctx := tenant.NewContext(context.Background(), tenant.DefaultID)
+	var parentID uint64
+	for _, value := range values {
+		err := NewRole(d, e).Create(ctx, admin.RoleInfo{Name: value, Value: value, Status: 1, ParentID: parentID})
+		if err != nil {
+			t.Fatal(err)
+		}
+		parentID, err = d.DBClient.Role.Query().Where(role.Value(value)).OnlyID(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, parentID)
+	}
+	return ids
+}
+
+func TestRole_checkParent(t *testing.T) {
+	ctx := tenant.NewContext(context.Background(), tenant.DefaultID)
+	d := newTestData(t)
+	roles := &Role{Data: d, Cbs: newTestEnforcer(t)}
+	ids := newTestRoleChain(t, d, roles.Cbs, "root", "child", "grandchild")
+	root, grandchild := ids[0], ids[2]
+
+	tests := []struct {
+		name     string
+		id       uint64
+		parentID uint64
+		wantErr  bool
+	}{
+		{name: "no parent", id: root, parentID: 0, wantErr: false},
+		{name: "own parent", id: root, parentID: root, wantErr: true},
+		{name: "descendant as parent", id: root, parentID: grandchild, wantErr: true},
+		{name: "ancestor as parent", id: grandchild, parentID: root, wantErr: false},
+		{name: "new role", id: 0, parentID: grandchild, wantErr: false},
+		{name: "parent not found", id: root, parentID: 100, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := roles.checkParent(ctx, tt.id, tt.parentID)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("checkParent() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRoleAncestors_cycle(t *testing.T) {
+	ctx := tenant.NewContext(context.Background(), tenant.DefaultID)
+	d := newTestData(t)
+	ids := newTestRoleChain(t, d, newTestEnforcer(t), "first", "second")
+	// a cycle made behind the checks of the updates
+	if err := d.DBClient.Role.UpdateOneID(ids[0]).SetParentID(ids[1]).Exec(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := roleAncestors(ctx, d, ids[1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []uint64{ids[1], ids[0]}; !slices.Equal(got, want) {
+		t.Errorf("roleAncestors() = %v, want %v", got, want)
+	}
+}
+
+func TestRole_Tree(t *testing.T) {
+	ctx := tenant.NewContext(context.Background(), tenant.DefaultID)
+	d := newTestData(t)
+	e := newTestEnforcer(t)
+	ids := newTestRoleChain(t, d, e, "root", "child")
+	other := newTestRoleChain(t, d, e, "other")
+
+	tree, err := NewRole(d, e).Tree(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tree) != 2 || tree[0].ID != ids[0] || tree[1].ID != other[0] {
+		t.Fatalf("Tree() got %d top roles, want root and other", len(tree))
+	}
+	if len(tree[0].Children) != 1 || tree[0].Children[0].ID != ids[1] || len(tree[0].Children[0].Children) != 0 {
+		t.Errorf("Tree() children of root = %+v, want child only", tree[0].Children)
+	}
+	if len(tree[1].Children) != 0 {
+		t.Errorf("Tree() children of other = %+v, want none", tree[1].Children)
+	}
+}
+
+func TestRole_disabledParent(t *testing.T) {
+	ctx := tenant.NewContext(context.Background(), tenant.DefaultID)
+	d := newTestData(t)
+	e := newTestEnforcer(t)
+	roles := NewRole(d, e)
+	authority := NewAuthority(d, e)
+	ids := newTestRoleChain(t, d, e, "parent", "child", "grandchild")
+	parent, child, grandchild := ids[0], ids[1], ids[2]
+	parentStr, childStr := strconv.FormatUint(parent, 10), strconv.FormatUint(child, 10)
+	if err := authority.UpdateApiAuthority(ctx, parentStr, []*admin.ApiAuthorityInfo{
+		{Path: "/api/admin/user/list", Method: http.MethodPost},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if err := authority.UpdateApiAuthority(ctx, childStr, []*admin.ApiAuthorityInfo{
+		{Path: "/api/admin/role/list", Method: http.MethodPost},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	check := func(t *testing.T, wantInherited bool) {
+		t.Helper()
+		for _, id := range []uint64{child, grandchild} {
+			pass, err := e.Enforce(strconv.FormatUint(id, 10), tenant.Domain(1), "/api/admin/user/list", http.MethodPost)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if pass != wantInherited {
+				t.Errorf("role %d allowed the api of the parent = %v, want %v", id, pass, wantInherited)
+			}
+		}
+		infos, err := authority.ApiAuthority(ctx, childStr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := []admin.ApiAuthorityInfo{{Path: "/api/admin/role/list", Method: http.MethodPost}}
+		if wantInherited {
+			want = append(want, admin.ApiAuthorityInfo{Path: "/api/admin/user/list", Method: http.MethodPost, Inherited: true})
+		}
+		got := make([]admin.ApiAuthorityInfo, 0, len(infos))
+		for _, v := range infos {
+			got = append(got, *v)
+		}
+		if !slices.Equal(got, want) {
+			t.Errorf("ApiAuthority() = %+v, want %+v", got, want)
+		}
+		inherited, err := inheritedRoles(ctx, d, grandchild)
+		if err != nil {
+			t.Fatal(err)
+		}
+		wantRoles := []uint64{grandchild, child}
+		if wantInherited {
+			wantRoles = append(wantRoles, parent)
+		}
+		if !slices.Equal(inherited, wantRoles) {
+			t.Errorf("inheritedRoles() = %v, want %v", inherited, wantRoles)
+		}
+	}
+	check(t, true)
+
+	// saving back the merged list keeps the inherited policy off the role
+	infos, err := authority.ApiAuthority(ctx, childStr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = authority.UpdateApiAuthority(ctx, childStr, infos); err != nil {
+		t.Fatal(err)
+	}
+	own, err := e.GetFilteredPolicy(0, childStr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(own) != 1 {
+		t.Errorf("policies stored on the child = %v, want its own one only", own)
+	}
+
+	if err = roles.UpdateStatus(ctx, parent, 0); err != nil {
+		t.Fatal(err)
+	}
+	check(t, false)
+
+	// a child updated while the parent is disabled is not linked again
+	if err = roles.Update(ctx, admin.RoleInfo{ID: child, Name: "child", Value: "child", Status: 1, ParentID: parent}); err != nil {
+		t.Fatal(err)
+	}
+	check(t, false)
+
+	if err = roles.Update(ctx, admin.RoleInfo{ID: parent, Name: "parent", Value: "parent", Status: 1}); err != nil {
+		t.Fatal(err)
+	}
+	check(t, true)
+}
@@ -0,0 +1,392 @@
+/*
+ * Copyright 2023 FormulaGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ */
+
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"formulago/biz/domain/admin"
+	"formulago/configs"
+	"formulago/data"
+	"formulago/data/ent"
+	"formulago/data/ent/predicate"
+	"formulago/data/ent/samlprovider"
+	"formulago/pkg/encrypt"
+	"formulago/pkg/rolerule"
+	"formulago/pkg/samlsp"
+	"formulago/pkg/times"
+
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+)
+
+// samlIdentityPrefix the accounts of a SAML provider are linked as saml:{name}, apart from the OAuth providers of the same name
+const samlIdentityPrefix = "saml:"
+
+var (
+	samlAttributeKeys = []string{claimSubject, claimUsername, claimEmail, claimEmailVerified, claimMobile, claimNickname, claimAvatar, claimGroups}
+	// defaultSamlAttributeMapping the LDAP attribute names most IdPs release, the subject is the NameID
+	defaultSamlAttributeMapping = map[string]string{
+		claimUsername: "uid",
+		claimEmail:    "mail",
+		claimMobile:   "mobile",
+		claimNickname: "displayName",
+		claimGroups:   "memberOf",
+	}
+)
+
+type Saml struct {
+	Data   *data.Data
+	Config configs.Config
+}
+
+func NewSaml(data *data.Data, config configs.Config) admin.Saml {
+	return &Saml{
+		Data:   data,
+		Config: config,
+	}
+}
+
+// samlClient is the cached service provider of an IdP
+type samlClient struct {
+	provider *ent.SamlProvider
+	sp       *samlsp.ServiceProvider
+	mapping  map[string]string
+}
+
+// samlState is kept server-side under the relay state until the response is posted
+type samlState struct {
+	Provider  string `json:"provider"`
+	RequestID string `json:"requestID"`
+	// UserID the user linking the account, zero for the logins
+	UserID uint64 `json:"userID,omitempty"`
+}
+
+func (s *Saml) Create(ctx context.Context, req *admin.SamlProviderInfo) error {
+	if err := validateSamlProvider(req); err != nil {
+		return err
+	}
+	_, err := s.Data.DBClient.SamlProvider.Create().
+		SetName(req.Name).
+		SetEntityID(req.EntityID).
+		SetIdpMetadataURL(req.IdpMetadataUrl).
+		SetIdpMetadataXML(req.IdpMetadataXml).
+		SetAttributeMapping(req.AttributeMapping).
+		SetAllowIdpInitiated(req.AllowIdpInitiated).
+		SetAutoProvision(req.AutoProvision).
+		SetProvisionApproval(req.ProvisionApproval).
+		SetDefaultRoleID(req.DefaultRoleID).
+		SetRoleRules(req.RoleRules).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("create saml provider failed: %w", err)
+	}
+	return nil
+}
+
+func (s *Saml) Update(ctx context.Context, req *admin.SamlProviderInfo) error {
+	if err := validateSamlProvider(req); err != nil {
+		return err
+	}
+	old, err := s.Data.DBClient.SamlProvider.Get(ctx, req.ID)
+	if err != nil {
+		return fmt.Errorf("get saml provider failed: %w", err)
+	}
+	_, err = s.Data.DBClient.SamlProvider.UpdateOneID(req.ID).
+		SetName(req.Name).
+		SetEntityID(req.EntityID).
+		SetIdpMetadataURL(req.IdpMetadataUrl).
+		SetIdpMetadataXML(req.IdpMetadataXml).
+		SetAttributeMapping(req.AttributeMapping).
+		SetAllowIdpInitiated(req.AllowIdpInitiated).
+		SetAutoProvision(req.AutoProvision).
+		SetProvisionApproval(req.ProvisionApproval).
+		SetDefaultRoleID(req.DefaultRoleID).
+		SetRoleRules(req.RoleRules).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("update saml provider failed: %w", err)
+	}
+	s.Data.Cache.Delete("samlProviderClient" + old.Name)
+	return nil
+}
+
+func (s *Saml) Delete(ctx context.Context, id uint64) error {
+	old, err := s.Data.DBClient.SamlProvider.Get(ctx, id)
+	if err != nil {
+		return fmt.Errorf("get saml provider failed: %w", err)
+	}
+	err = s.Data.DBClient.SamlProvider.DeleteOneID(id).Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("delete saml provider failed: %w", err)
+	}
+	s.Data.Cache.Delete("samlProviderClient" + old.Name)
+	return nil
+}
+
+func (s *Saml) List(ctx context.Context, req *admin.SamlListReq) (list []*admin.SamlProviderInfo, total int, err error) {
+	var predicates []predicate.SamlProvider
+	if req.Name != "" {
+		predicates = append(predicates, samlprovider.NameContains(req.Name))
+	}
+	providers, err := s.Data.DBClient.SamlProvider.Query().
+		Where(predicates...).
+		Offset(int((req.Page - 1) * req.PageSize)).
+		Limit(int(req.PageSize)).
+		All(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("list saml provider failed: %w", err)
+	}
+
+	for _, provider := range providers {
+		list = append(list, &admin.SamlProviderInfo{
+			ID:                provider.ID,
+			CreatedAt:         provider.CreatedAt.Format(times.TimeFormat),
+			UpdatedAt:         provider.UpdatedAt.Format(times.TimeFormat),
+			Name:              provider.Name,
+			EntityID:          provider.EntityID,
+			IdpMetadataUrl:    provider.IdpMetadataURL,
+			IdpMetadataXml:    provider.IdpMetadataXML,
+			AttributeMapping:  provider.AttributeMapping,
+			AllowIdpInitiated: provider.AllowIdpInitiated,
+			AutoProvision:     provider.AutoProvision,
+			ProvisionApproval: provider.ProvisionApproval,
+			DefaultRoleID:     provider.DefaultRoleID,
+			RoleRules:         provider.RoleRules,
+			MetadataUrl:       s.url("metadata", provider.Name),
+			AcsUrl:            s.url("acs", provider.Name),
+		})
+	}
+	total, err = s.Data.DBClient.SamlProvider.Query().Where(predicates...).Count(ctx)
+	if err != nil {
+		err = fmt.Errorf("count saml provider failed: %w", err)
+		return list, total, err
+	}
+	return list, total, nil
+}
+
+func (s *Saml) Metadata(ctx context.Context, provider string) ([]byte, error) {
+	client, err := s.client(ctx, provider)
+	if err != nil {
+		return nil, err
+	}
+	return client.sp.Metadata()
+}
+
+func (s *Saml) Login(ctx context.Context, provider string) (string, error) {
+	return s.authURL(ctx, provider, 0)
+}
+
+func (s *Saml) LinkURL(ctx context.Context, userID uint64, provider string) (string, error) {
+	return s.authURL(ctx, provider, userID)
+}
+
+// authURL returns the redirect of the authentication request, the relay state of a link request carries the user
+func (s *Saml) authURL(ctx context.Context, provider string, userID uint64) (string, error) {
+	client, err := s.client(ctx, provider)
+	if err != nil {
+		return "", err
+	}
+	relayState, err := encrypt.RandomToken(24)
+	if err != nil {
+		return "", fmt.Errorf("generate saml relay state failed: %w", err)
+	}
+	redirectURL, requestID, err := client.sp.AuthnRequestURL(relayState)
+	if err != nil {
+		return "", err
+	}
+	b, err := json.Marshal(samlState{Provider: client.provider.Name, RequestID: requestID, UserID: userID})
+	if err != nil {
+		return "", err
+	}
+	if err = s.Data.CacheSet(ctx, "samlRelayState"+relayState, string(b), oauthStateExpire); err != nil {
+		return "", fmt.Errorf("store saml relay state failed: %w", err)
+	}
+	return redirectURL, nil
+}
+
+func (s *Saml) Acs(ctx context.Context, req *admin.SamlAcsReq) (*admin.OauthUserInfo, error) {
+	client, err := s.client(ctx, req.Provider)
+	if err != nil {
+		return nil, err
+	}
+
+	// the response answers the request stored under the relay state, which is accepted once,
+	// the logins started at the IdP have no such request
+	var stored *samlState
+	if req.RelayState != "" {
+		v, ok, err := redeem(ctx, s.Data, "samlRelayState"+req.RelayState, oauthStateExpire)
+		if err != nil {
+			return nil, fmt.Errorf("redeem saml relay state failed: %w", err)
+		}
+		if ok {
+			stored = new(samlState)
+			if err = json.Unmarshal([]byte(v), stored); err != nil {
+				return nil, fmt.Errorf("parse saml relay state failed: %w", err)
+			}
+			if stored.Provider != client.provider.Name {
+				return nil, errors.New("the saml response is not posted to the provider the login was started at")
+			}
+		}
+	}
+	var requestIDs []string
+	if stored != nil {
+		requestIDs = []string{stored.RequestID}
+	} else if !client.provider.AllowIdpInitiated {
+		return nil, errors.New("invalid or expired saml request, please sign in again")
+	}
+
+	assertion, err := client.sp.ParseResponse(req.SAMLResponse, requestIDs)
+	if err != nil {
+		// the reason is logged only, it tells about the checks of the signature
+		hlog.Warn("saml provider ", client.provider.Name, " response rejected: ", err)
+		return nil, errors.New("invalid saml response")
+	}
+	// an assertion is accepted once until it expires
+	used, err := s.Data.CacheIncr(ctx, "samlAssertion"+assertion.ID, time.Until(assertion.Expire)+oauthStateExpire)
+	if err != nil {
+		return nil, fmt.Errorf("redeem saml assertion failed: %w", err)
+	}
+	if used > 1 {
+		return nil, errors.New("the saml assertion is used already, please sign in again")
+	}
+
+	value := func(key string) string {
+		if name := client.mapping[key]; name != "" {
+			return assertion.Value(name)
+		}
+		return ""
+	}
+	userInfo := &admin.OauthUserInfo{
+		Provider: samlIdentityPrefix + client.provider.Name,
+		Subject:  value(claimSubject),
+		Username: value(claimUsername),
+		Email:    value(claimEmail),
+		Mobile:   value(claimMobile),
+		NickName: value(claimNickname),
+		Picture:  value(claimAvatar),
+	}
+	userInfo.EmailVerified, _ = strconv.ParseBool(value(claimEmailVerified))
+	if name := client.mapping[claimGroups]; name != "" {
+		userInfo.Groups = assertion.Attributes[name]
+	}
+	if client.mapping[claimSubject] == "" {
+		userInfo.Subject = assertion.NameID
+	}
+	if userInfo.Subject == "" {
+		return nil, errors.New("no subject in the saml assertion, check the attribute mapping of the provider")
+	}
+	userInfo.Credential = userInfo.Subject
+
+	if stored != nil && stored.UserID != 0 {
+		if err = linkIdentity(ctx, s.Data, stored.UserID, userInfo); err != nil {
+			return nil, err
+		}
+		userInfo.LinkedUserID = stored.UserID
+	} else if client.provider.AutoProvision {
+		err = provision(ctx, s.Data, userInfo, provisionPolicy{
+			approval:      client.provider.ProvisionApproval,
+			defaultRoleID: client.provider.DefaultRoleID,
+			roleRules:     client.provider.RoleRules,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return userInfo, nil
+}
+
+// client returns the cached service provider of the IdP, the metadata URL is fetched again when it expires
+func (s *Saml) client(ctx context.Context, name string) (*samlClient, error) {
+	if v, found := s.Data.Cache.Get("samlProviderClient" + name); found {
+		if c, ok := v.(*samlClient); ok {
+			return c, nil
+		}
+	}
+	provider, err := s.Data.DBClient.SamlProvider.Query().Where(samlprovider.Name(name)).Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get saml provider failed: %w", err)
+	}
+
+	idpMetadata := []byte(provider.IdpMetadataXML)
+	if provider.IdpMetadataURL != "" {
+		idpMetadata, err = samlsp.FetchIDPMetadata(ctx, oauthHTTPClient, provider.IdpMetadataURL)
+		if err != nil {
+			return nil, err
+		}
+	}
+	config := samlsp.Config{
+		EntityID:          provider.EntityID,
+		MetadataURL:       s.url("metadata", provider.Name),
+		AcsURL:            s.url("acs", provider.Name),
+		IDPMetadata:       idpMetadata,
+		AllowIDPInitiated: provider.AllowIdpInitiated,
+	}
+	if s.Config.SAML.CertificateFile != "" || s.Config.SAML.PrivateKeyFile != "" {
+		certPEM, err := os.ReadFile(s.Config.SAML.CertificateFile)
+		if err != nil {
+			return nil, fmt.Errorf("read saml certificate failed: %w", err)
+		}
+		keyPEM, err := os.ReadFile(s.Config.SAML.PrivateKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("read saml private key failed: %w", err)
+		}
+		if config.Key, config.Certificate, err = samlsp.ParseKeyPair(certPEM, keyPEM); err != nil {
+			return nil, err
+		}
+	}
+	sp, err := samlsp.New(config)
+	if err != nil {
+		return nil, fmt.Errorf("saml provider %s: %w", provider.Name, err)
+	}
+
+	c := &samlClient{
+		provider: provider,
+		sp:       sp,
+		mapping:  provider.AttributeMapping,
+	}
+	if len(c.mapping) == 0 {
+		c.mapping = defaultSamlAttributeMapping
+	}
+	s.Data.Cache.Set("samlProviderClient"+name, c, oauthClientExpire)
+	return c, nil
+}
+
+// url the metadata or ACS URL of the provider below the public base URL
+func (s *Saml) url(endpoint, name string) string {
+	return strings.TrimRight(s.Config.SAML.BaseURL, "/") + "/api/saml/" + endpoint + "?provider=" + url.QueryEscape(name)
+}
+
+// validateSamlProvider the IdP metadata is given by its URL or its XML, the XML is checked at once
+func validateSamlProvider(p *admin.SamlProviderInfo) error {
+	if p.Name == "" {
+		return errors.New("name is required")
+	}
+	if p.IdpMetadataUrl == "" && p.IdpMetadataXml == "" {
+		return errors.New("idp metadata url or idp metadata xml is required")
+	}
+	if p.IdpMetadataUrl == "" {
+		if _, err := samlsp.ParseIDPMetadata([]byte(p.IdpMetadataXml)); err != nil {
+			return err
+		}
+	}
+	for key := range p.AttributeMapping {
+		if !slices.Contains(samlAttributeKeys, key) {
+			return fmt.Errorf("unknown attribute mapping key %q, the keys are %v", key, samlAttributeKeys)
+		}
+	}
+	return rolerule.Validate(p.RoleRules)
+}
@@ -0,0 +1,293 @@
+/*
+ * Copyright 2023 FormulaGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ */
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"formulago/biz/domain/admin"
+	"formulago/data"
+	"formulago/data/ent"
+	"formulago/data/ent/api"
+	"formulago/data/ent/apikey"
+	"formulago/data/ent/menu"
+	"formulago/data/ent/predicate"
+	enttenant "formulago/data/ent/tenant"
+	"formulago/data/ent/user"
+	"formulago/pkg/encrypt"
+	"formulago/pkg/tenant"
+	"formulago/pkg/times"
+
+	"github.com/casbin/casbin/v3"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+)
+
+// tenantStatusExpire the status of a tenant is cached shortly, a disabled tenant also loses its sessions at once
+const tenantStatusExpire = time.Minute
+
+// platformAPIGroups the apis of the settings shared by all tenants, only the roles of the default tenant may have them
+var platformAPIGroups = []string{"tenant", "api", "oauth", "saml", "oidc"}
+
+type Tenant struct {
+	Data *data.Data
+	Cbs  *casbin.Enforcer
+}
+
+func NewTenant(data *data.Data, cbs *casbin.Enforcer) admin.Tenant {
+	return &Tenant{
+		Data: data,
+		Cbs:  cbs,
+	}
+}
+
+// manageable the tenants are managed within the default tenant, or by the system without a tenant
+func manageable(ctx context.Context) error {
+	if id, ok := tenant.FromContext(ctx); ok && id != tenant.DefaultID {
+		return admin.ErrTenantForbidden
+	}
+	return nil
+}
+
+func (t *Tenant) Create(ctx context.Context, req *admin.CreateTenantReq) (id uint64, err error) {
+	if err = manageable(ctx); err != nil {
+		return 0, err
+	}
+	if err = validateUsername(req.AdminUsername); err != nil {
+		return 0, err
+	}
+	if err = validateNewPassword(nil, req.AdminUsername, req.AdminPassword); err != nil {
+		return 0, err
+	}
+	password, err := encrypt.BcryptEncrypt(req.AdminPassword)
+	if err != nil {
+		return 0, fmt.Errorf("encrypt password failed: %w", err)
+	}
+
+	tx, err := t.Data.DBClient.Tx(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("starting a transaction err: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			rollbackErr := tx.Rollback()
+			if rollbackErr != nil {
+				hlog.Error("create tenant err:", err, "rollback err:", rollbackErr)
+			}
+		}
+	}()
+
+	tenantEnt, err := tx.Tenant.Create().
+		SetName(req.Name).
+		SetRemark(req.Remark).
+		Save(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("create tenant failed: %w", err)
+	}
+	// the data of the new tenant is created in its scope
+	tenantCtx := tenant.NewContext(ctx, tenantEnt.ID)
+	menuIDs, err := copyMenus(tenant.NewContext(ctx, tenant.DefaultID), tenantCtx, tx)
+	if err != nil {
+		return 0, err
+	}
+	roleEnt, err := tx.Role.Create().
+		SetName("role.admin").
+		SetValue("admin").
+		SetRemark("租户管理员").
+		SetOrderNo(1).
+		AddMenuIDs(menuIDs...).
+		Save(tenantCtx)
+	if err != nil {
+		return 0, fmt.Errorf("create tenant role failed: %w", err)
+	}
+	err = tx.User.Create().
+		SetUsername(req.AdminUsername).
+		SetNickname(req.AdminUsername).
+		SetPassword(password).
+		SetPasswordChangedAt(time.Now()).
+		SetEmail(req.AdminEmail).
+		SetMobile(req.AdminMobile).
+		SetRoleID(roleEnt.ID).
+		AddRoleIDs(roleEnt.ID).
+		Exec(tenantCtx)
+	if err != nil {
+		return 0, fmt.Errorf("create tenant admin failed: %w", err)
+	}
+	// the admin role has all apis but the platform ones
+	apis, err := tx.API.Query().Where(api.APIGroupNotIn(platformAPIGroups...)).All(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("query apis failed: %w", err)
+	}
+	if err = tx.Commit(); err != nil {
+		return 0, fmt.Errorf("commit tenant failed: %w", err)
+	}
+
+	roleIDStr := strconv.FormatUint(roleEnt.ID, 10)
+	domain := tenant.Domain(tenantEnt.ID)
+	var policies [][]string
+	for _, v := range apis {
+		policies = append(policies, []string{roleIDStr, domain, v.Path, v.Method})
+	}
+	if _, err := t.Cbs.AddPolicies(policies); err != nil {
+		return tenantEnt.ID, fmt.Errorf("add tenant policies failed: %w", err)
+	}
+	return tenantEnt.ID, nil
+}
+
+// copyMenus copies the menus of the tenant of from to the tenant of to, and returns the new menu IDs.
+// The root menu 1 is shared by the menu trees of all tenants.
+func copyMenus(from, to context.Context, tx *ent.Tx) (menuIDs []uint64, err error) {
+	menus, err := tx.Menu.Query().Where(menu.IDNEQ(1)).
+		Order(ent.Asc(menu.FieldMenuLevel), ent.Asc(menu.FieldID)).All(from)
+	if err != nil {
+		return nil, fmt.Errorf("query menus failed: %w", err)
+	}
+	ids := map[uint64]uint64{1: 1}
+	for _, v := range menus {
+		// the parents come first by their level
+		parentID, ok := ids[v.ParentID]
+		if !ok {
+			parentID = 1
+		}
+		m, err := tx.Menu.Create().
+			SetMenuLevel(v.MenuLevel).
+			SetMenuType(v.MenuType).
+			SetParentID(parentID).
+			SetPath(v.Path).
+			SetName(v.Name).
+			SetRedirect(v.Redirect).
+			SetComponent(v.Component).
+			SetOrderNo(v.OrderNo).
+			SetDisabled(v.Disabled).
+			// meta
+			SetTitle(v.Title).
+			SetIcon(v.Icon).
+			SetHideMenu(v.HideMenu).
+			SetHideBreadcrumb(v.HideBreadcrumb).
+			SetCurrentActiveMenu(v.CurrentActiveMenu).
+			SetIgnoreKeepAlive(v.IgnoreKeepAlive).
+			SetHideTab(v.HideTab).
+			SetFrameSrc(v.FrameSrc).
+			SetCarryParam(v.CarryParam).
+			SetHideChildrenInMenu(v.HideChildrenInMenu).
+			SetAffix(v.Affix).
+			SetDynamicLevel(v.DynamicLevel).
+			SetRealPath(v.RealPath).
+			Save(to)
+		if err != nil {
+			return nil, fmt.Errorf("copy menu failed: %w", err)
+		}
+		ids[v.ID] = m.ID
+		menuIDs = append(menuIDs, m.ID)
+	}
+	return menuIDs, nil
+}
+
+func (t *Tenant) Update(ctx context.Context, req *admin.TenantInfo) error {
+	if err := manageable(ctx); err != nil {
+		return err
+	}
+	_, err := t.Data.DBClient.Tenant.UpdateOneID(req.ID).
+		SetName(req.Name).
+		SetRemark(req.Remark).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("update tenant failed: %w", err)
+	}
+	return nil
+}
+
+func (t *Tenant) UpdateStatus(ctx context.Context, id uint64, status uint8) error {
+	if err := manageable(ctx); err != nil {
+		return err
+	}
+	if id == tenant.DefaultID && status != 1 {
+		return errors.New("the default tenant cannot be disabled")
+	}
+	_, err := t.Data.DBClient.Tenant.UpdateOneID(id).SetStatus(status).Save(ctx)
+	if err != nil {
+		return fmt.Errorf("update tenant status failed: %w", err)
+	}
+	err = t.Data.CacheSet(ctx, "tenantStatus"+strconv.FormatUint(id, 10), strconv.Itoa(int(status)), tenantStatusExpire)
+	if err != nil {
+		return fmt.Errorf("set tenant status cache failed: %w", err)
+	}
+	if status == 1 {
+		return nil
+	}
+
+	// the users of a disabled tenant are signed out, and their api keys revoked
+	if err = NewToken(t.Data).RevokeTenant(ctx, id); err != nil {
+		return err
+	}
+	_, err = t.Data.DBClient.APIKey.Update().
+		Where(apikey.HasOwnerWith(user.TenantID(id)), apikey.RevokedAtIsNil()).
+		SetRevokedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("revoke api keys of the tenant failed: %w", err)
+	}
+	return nil
+}
+
+func (t *Tenant) List(ctx context.Context, req *admin.TenantListReq) (list []*admin.TenantInfo, total int, err error) {
+	if err = manageable(ctx); err != nil {
+		return nil, 0, err
+	}
+	var predicates []predicate.Tenant
+	if req.Name != "" {
+		predicates = append(predicates, enttenant.NameContains(req.Name))
+	}
+	tenants, err := t.Data.DBClient.Tenant.Query().Where(predicates...).
+		Order(ent.Asc(enttenant.FieldID)).
+		Offset(int(req.Page-1) * int(req.PageSize)).
+		Limit(int(req.PageSize)).All(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("query tenant list failed: %w", err)
+	}
+	for _, v := range tenants {
+		list = append(list, &admin.TenantInfo{
+			ID:        v.ID,
+			Name:      v.Name,
+			Remark:    v.Remark,
+			Status:    v.Status,
+			CreatedAt: v.CreatedAt.Format(times.TimeFormat),
+			UpdatedAt: v.UpdatedAt.Format(times.TimeFormat),
+		})
+	}
+	total, err = t.Data.DBClient.Tenant.Query().Where(predicates...).Count(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("count tenant failed: %w", err)
+	}
+	return
+}
+
+func (t *Tenant) IsActive(ctx context.Context, id uint64) (bool, error) {
+	key := "tenantStatus" + strconv.FormatUint(id, 10)
+	status, exist, err := t.Data.CacheGet(ctx, key)
+	if err != nil {
+		hlog.Error(err, "get tenant status from cache error")
+	}
+	if exist {
+		return status == "1", nil
+	}
+	tenantEnt, err := t.Data.DBClient.Tenant.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("get tenant failed: %w", err)
+	}
+	err = t.Data.CacheSet(ctx, key, strconv.Itoa(int(tenantEnt.Status)), tenantStatusExpire)
+	if err != nil {
+		hlog.Error(err, "set tenant status to cache error")
+	}
+	return tenantEnt.Status == 1, nil
+}
@@ -0,0 +1,84 @@
+/*
+ * Copyright 2023 FormulaGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ */
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"formulago/biz/domain/admin"
+	"formulago/pkg/tenant"
+)
+
+func TestTenant_UpdateStatus(t *testing.T) {
+	ctx := tenant.NewContext(context.Background(), tenant.DefaultID)
+	d := newTestData(t)
+	enforcer := newTestEnforcer(t)
+	tenants := NewTenant(d, enforcer)
+	tokens := NewToken(d)
+
+	other, err := d.DBClient.Tenant.Create().SetName("other").Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	local := newTestUser(t, d, "alice", 1)
+	remote, err := d.DBClient.User.Create().SetUsername("bobby").SetPassword("-").SetNickname("bobby").
+		SetMobile("bobby").SetTenantID(other.ID).Save(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	newTestLogin(t, d, local.ID, "local-session")
+	newTestLogin(t, d, remote.ID, "remote-session")
+	remoteKey, err := d.DBClient.APIKey.Create().SetUserID(remote.ID).SetName("key").SetPrefix("fgo_x").
+		SetKeyHash("hash").SetScopes([]string{"GET /api/admin/test"}).SetExpiredAt(time.Now().AddDate(0, 0, 1)).
+		Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the status is cached by the first check
+	if active, err := tenants.IsActive(ctx, other.ID); err != nil || !active {
+		t.Fatalf("IsActive() = %v, %v, want true", active, err)
+	}
+
+	if err = tenants.UpdateStatus(ctx, other.ID, 0); err != nil {
+		t.Fatal(err)
+	}
+	if active, err := tenants.IsActive(ctx, other.ID); err != nil || active {
+		t.Errorf("IsActive() of the disabled tenant = %v, %v, want false", active, err)
+	}
+	if tokens.IsActive(ctx, "remote-session") {
+		t.Error("the sessions of the disabled tenant should be revoked")
+	}
+	if !tokens.IsActive(ctx, "local-session") {
+		t.Error("the sessions of the other tenants should stay active")
+	}
+	keyEnt, err := d.DBClient.APIKey.Get(ctx, remoteKey.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if keyEnt.RevokedAt == nil {
+		t.Error("the api keys of the disabled tenant should be revoked")
+	}
+
+	if err = tenants.UpdateStatus(ctx, other.ID, 1); err != nil {
+		t.Fatal(err)
+	}
+	if active, err := tenants.IsActive(ctx, other.ID); err != nil || !active {
+		t.Errorf("IsActive() of the enabled tenant = %v, %v, want true", active, err)
+	}
+
+	// the default tenant cannot be disabled, the other tenants cannot manage the tenants
+	if err = tenants.UpdateStatus(ctx, tenant.DefaultID, 0); err == nil {
+		t.Error("UpdateStatus() of the default tenant should fail")
+	}
+	err = tenants.UpdateStatus(tenant.NewContext(ctx, other.ID), other.ID, 0)
+	if !errors.Is(err, admin.ErrTenantForbidden) {
+		t.Errorf("UpdateStatus() within another tenant error = %v, want %v", err, admin.ErrTenantForbidden)
+	}
+}
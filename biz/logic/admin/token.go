@@ -15,11 +15,20 @@ import (
 	"formulago/data"
 	"formulago/data/ent"
 	"formulago/data/ent/predicate"
+	"formulago/data/ent/refreshtoken"
+	"formulago/data/ent/role"
 	"formulago/data/ent/token"
 	"formulago/data/ent/user"
+	"formulago/pkg/tenant"
 	"time"
+
+	"github.com/cloudwego/hertz/pkg/common/hlog"
 )
 
+// tokenRevokedKey marks a revoked session in the shared cache until it expires, so every instance rejects it
+// at once instead of trusting its local cache of the active sessions
+const tokenRevokedKey = "tokenRevoked"
+
 type Token struct {
 	Data *data.Data
 }
@@ -35,38 +44,34 @@ func (t *Token) Create(ctx context.Context, req *admin.TokenInfo) error {
 	if time.Until(expiredAt).Seconds() < 5 {
 		return errors.New("expired time must be greater than now, more than 5s")
 	}
-	// whether user token exists
-	tokenExist, err := t.Data.DBClient.Token.Query().Where(token.UserID(req.UserID)).Only(ctx)
-	if err != nil {
-		if !ent.IsNotFound(err) {
-			return fmt.Errorf("create Token failed: %w", err)
-		}
-	}
-	// if exists, update token
-	if tokenExist != nil {
-		req.ID = tokenExist.ID
-		return t.Update(ctx, req)
+	if req.JTI == "" {
+		return errors.New("jti is required")
 	}
-	// userinfo
-	userInfo, err := t.Data.DBClient.User.Query().Where(user.IDEQ(req.UserID)).Only(ctx)
-	if err != nil {
-		return fmt.Errorf("get userinfo failed: %w", err)
+	issuedAt := time.Now()
+	if req.IssuedAt != "" {
+		issuedAt, _ = time.ParseInLocation(times.TimeFormat, req.IssuedAt, time.Local)
 	}
 
-	// create token
-	_, err = t.Data.DBClient.Token.Create().
-		SetOwner(userInfo).
+	// every login creates a new session, so a user can sign in on several devices
+	_, err := t.Data.DBClient.Token.Create().
 		SetUserID(req.UserID).
+		SetJti(req.JTI).
 		SetToken(req.Token).
 		SetSource(req.Source).
+		SetDevice(req.Device).
+		SetIP(req.IP).
+		SetUserAgent(req.UserAgent).
+		SetIssuedAt(issuedAt).
 		SetExpiredAt(expiredAt).
+		SetImpersonatorID(req.ImpersonatorID).
+		SetRoleID(req.RoleID).
 		Save(ctx)
 	if err != nil {
 		return fmt.Errorf("create Token failed: %w", err)
 	}
 
 	// add to cache
-	t.Data.Cache.Set(fmt.Sprintf("token_%d", req.UserID), req.UserID, time.Until(expiredAt))
+	t.Data.Cache.Set(fmt.Sprintf("token_%s", req.JTI), req.UserID, time.Until(expiredAt))
 
 	return nil
 }
@@ -76,8 +81,7 @@ func (t *Token) Update(ctx context.Context, req *admin.TokenInfo) error {
 	if time.Until(expiredAt).Seconds() < 5 {
 		return errors.New("expired time must be greater than now, more than 5s")
 	}
-	_, err := t.Data.DBClient.Token.UpdateOneID(req.ID).
-		SetUserID(req.UserID).
+	tokenEnt, err := t.Data.DBClient.Token.UpdateOneID(req.ID).
 		SetToken(req.Token).
 		SetSource(req.Source).
 		SetUpdatedAt(time.Now()).
@@ -88,72 +92,270 @@ func (t *Token) Update(ctx context.Context, req *admin.TokenInfo) error {
 	}
 
 	// add to cache
-	t.Data.Cache.Set(fmt.Sprintf("token_%d", req.UserID), req.UserID, time.Until(expiredAt))
+	if tokenEnt.RevokedAt == nil {
+		t.Data.Cache.Set(fmt.Sprintf("token_%s", tokenEnt.Jti), tokenEnt.UserID, time.Until(expiredAt))
+	}
 	return nil
 }
 
-func (t *Token) IsExistByUserID(ctx context.Context, userID uint64) bool {
+// IsActive reports whether the session with the given jti exists, is not revoked and not expired.
+func (t *Token) IsActive(ctx context.Context, jti string) bool {
+	if jti == "" {
+		return false
+	}
+	// the session may have been revoked by another instance
+	_, revoked, err := t.Data.CacheGet(ctx, tokenRevokedKey+jti)
+	if err != nil {
+		hlog.Error(err, "get revoked session from cache error")
+	}
+	if revoked {
+		return false
+	}
 	// get token from cache
-	_, exist := t.Data.Cache.Get(fmt.Sprintf("token_%d", userID))
+	_, exist := t.Data.Cache.Get(fmt.Sprintf("token_%s", jti))
 	if exist {
 		return true
 	}
 
 	// get token from db
-	exist, _ = t.Data.DBClient.Token.Query().Where(token.UserID(userID)).Exist(ctx)
-	return exist
+	tokenEnt, err := t.Data.DBClient.Token.Query().
+		Where(token.Jti(jti), token.RevokedAtIsNil(), token.ExpiredAtGT(time.Now())).
+		Only(ctx)
+	if err != nil {
+		return false
+	}
+	t.Data.Cache.Set(fmt.Sprintf("token_%s", jti), tokenEnt.UserID, time.Until(tokenEnt.ExpiredAt))
+	return true
 }
 
+// Renew extends the expiry of an active session, used when the access token is refreshed.
+func (t *Token) Renew(ctx context.Context, jti string, expiredAt string) error {
+	expiredTime, _ := time.ParseInLocation(times.TimeFormat, expiredAt, time.Local)
+	n, err := t.Data.DBClient.Token.Update().
+		Where(token.Jti(jti), token.RevokedAtIsNil()).
+		SetExpiredAt(expiredTime).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("renew Token failed: %w", err)
+	}
+	if n == 0 {
+		return errors.New("session is revoked or not exist")
+	}
+
+	// drop the cache, it is reloaded with the new expiry on the next check
+	t.Data.Cache.Delete(fmt.Sprintf("token_%s", jti))
+	return nil
+}
+
+// Revoke revokes a single session by its jti.
+func (t *Token) Revoke(ctx context.Context, jti string) error {
+	_, err := t.revoke(ctx, token.Jti(jti))
+	return err
+}
+
+// RevokeByUser revokes a session of the user, it fails if the session is not an active one of the user.
+func (t *Token) RevokeByUser(ctx context.Context, userID uint64, jti string) error {
+	n, err := t.revoke(ctx, token.UserID(userID), token.Jti(jti))
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return errors.New("session is revoked or not exist")
+	}
+	return nil
+}
+
+// RevokeAll revokes all sessions of the user but the one of exceptJTI, if set.
+func (t *Token) RevokeAll(ctx context.Context, userID uint64, exceptJTI string) error {
+	predicates := []predicate.Token{token.UserID(userID)}
+	if exceptJTI != "" {
+		predicates = append(predicates, token.JtiNEQ(exceptJTI))
+	}
+	_, err := t.revoke(ctx, predicates...)
+	return err
+}
+
+// RevokeRole revokes all sessions of the users of the role.
+func (t *Token) RevokeRole(ctx context.Context, roleID uint64) error {
+	_, err := t.revoke(ctx, token.HasOwnerWith(user.Or(user.RoleID(roleID), user.HasRolesWith(role.ID(roleID)))))
+	return err
+}
+
+// RevokeTenant revokes all sessions of the users of the tenant.
+func (t *Token) RevokeTenant(ctx context.Context, tenantID uint64) error {
+	_, err := t.revoke(tenant.NewContext(ctx, tenantID))
+	return err
+}
+
+// Delete deletes all sessions of the user.
 func (t *Token) Delete(ctx context.Context, userID uint64) error {
+	if err := userInTenant(ctx, t.Data, userID); err != nil {
+		return err
+	}
+	// mark the active sessions revoked for the other instances first
+	if _, err := t.revoke(ctx, token.UserID(userID)); err != nil {
+		return err
+	}
 	_, err := t.Data.DBClient.Token.Delete().Where(token.UserID(userID)).Exec(ctx)
 	if err != nil {
 		return fmt.Errorf("delete Token failed: %w", err)
 	}
+	_, err = t.Data.DBClient.RefreshToken.Delete().Where(refreshtoken.UserID(userID)).Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("delete refresh Token failed: %w", err)
+	}
+	return nil
+}
 
-	// delete from cache
-	t.Data.Cache.Delete(fmt.Sprintf("token_%d", userID))
+// revoke revokes the sessions matching the predicates and their refresh tokens,
+// and marks them revoked in the shared cache until they expire.
+// The expired sessions are revoked as well, they may still be renewed by their refresh tokens.
+func (t *Token) revoke(ctx context.Context, predicates ...predicate.Token) (n int, err error) {
+	predicates = append(predicates, token.RevokedAtIsNil())
+	predicates = append(predicates, tokenTenant(ctx)...)
+	sessions, err := t.Data.DBClient.Token.Query().Where(predicates...).
+		Select(token.FieldJti, token.FieldExpiredAt).All(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("get Tokens failed: %w", err)
+	}
+	if len(sessions) == 0 {
+		return 0, nil
+	}
+	jtis := make([]string, 0, len(sessions))
+	for _, session := range sessions {
+		jtis = append(jtis, session.Jti)
+	}
+
+	now := time.Now()
+	_, err = t.Data.DBClient.Token.Update().
+		Where(token.JtiIn(jtis...), token.RevokedAtIsNil()).
+		SetRevokedAt(now).
+		Save(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("revoke Token failed: %w", err)
+	}
+	// the refresh tokens of the session die with it
+	_, err = t.Data.DBClient.RefreshToken.Update().
+		Where(refreshtoken.FamilyIn(jtis...), refreshtoken.RevokedAtIsNil()).
+		SetRevokedAt(now).
+		Save(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("revoke refresh Token failed: %w", err)
+	}
+
+	for _, session := range sessions {
+		t.Data.Cache.Delete(fmt.Sprintf("token_%s", session.Jti))
+		if !session.ExpiredAt.After(now) {
+			continue
+		}
+		err = t.Data.CacheSet(ctx, tokenRevokedKey+session.Jti, "1", time.Until(session.ExpiredAt))
+		if err != nil {
+			return 0, fmt.Errorf("mark Token revoked failed: %w", err)
+		}
+	}
+	return len(sessions), nil
+}
+
+// tokenTenant the sessions are not scoped themselves, but by the tenant of their owners
+func tokenTenant(ctx context.Context) []predicate.Token {
+	if id, ok := tenant.FromContext(ctx); ok {
+		return []predicate.Token{token.HasOwnerWith(user.TenantID(id))}
+	}
 	return nil
 }
 
 func (t *Token) List(ctx context.Context, req *admin.TokenListReq) (res []*admin.TokenInfo, total int, err error) {
-	// list token with user info
-	var userPredicates = []predicate.User{user.HasToken()}
+	if err = t.purgeExpired(ctx); err != nil {
+		hlog.Error(err)
+	}
+	// list active sessions with user info
+	var predicates = []predicate.Token{token.RevokedAtIsNil()}
 	if req.Username != "" {
-		userPredicates = append(userPredicates, user.UsernameContainsFold(req.Username))
+		predicates = append(predicates, token.HasOwnerWith(user.UsernameContainsFold(req.Username)))
 	}
 	if req.UserID != 0 {
-		userPredicates = append(userPredicates, user.IDEQ(req.UserID))
+		predicates = append(predicates, token.UserID(req.UserID))
 	}
-	UserTokens, err := t.Data.DBClient.User.Query().Where(userPredicates...).
-		WithToken(func(q *ent.TokenQuery) {
-			// get token all fields default, or use q.Select() to get some fields
-		}).Offset(int(req.Page-1) * int(req.PageSize)).
+	predicates = append(predicates, tokenTenant(ctx)...)
+	tokens, err := t.Data.DBClient.Token.Query().Where(predicates...).
+		WithOwner().
+		Order(ent.Desc(token.FieldIssuedAt)).
+		Offset(int(req.Page-1) * int(req.PageSize)).
 		Limit(int(req.PageSize)).All(ctx)
 	if err != nil {
 		return res, total, fmt.Errorf("get User - Token list failed: %w", err)
 	}
 
-	for _, userEnt := range UserTokens {
+	for _, tokenEnt := range tokens {
+		var username string
+		if tokenEnt.Edges.Owner != nil {
+			username = tokenEnt.Edges.Owner.Username
+		}
 		res = append(res, &admin.TokenInfo{
-			ID:        userEnt.Edges.Token.ID,
-			UserID:    userEnt.ID,
-			UserName:  userEnt.Username,
-			Token:     userEnt.Edges.Token.Token,
-			Source:    userEnt.Edges.Token.Source,
-			CreatedAt: userEnt.CreatedAt.Format(times.TimeFormat),
-			UpdatedAt: userEnt.UpdatedAt.Format(times.TimeFormat),
-			ExpiredAt: userEnt.Edges.Token.ExpiredAt.Format(times.TimeFormat),
+			ID:             tokenEnt.ID,
+			UserID:         tokenEnt.UserID,
+			UserName:       username,
+			Token:          tokenEnt.Token,
+			Source:         tokenEnt.Source,
+			JTI:            tokenEnt.Jti,
+			Device:         tokenEnt.Device,
+			IP:             tokenEnt.IP,
+			UserAgent:      tokenEnt.UserAgent,
+			CreatedAt:      tokenEnt.CreatedAt.Format(times.TimeFormat),
+			UpdatedAt:      tokenEnt.UpdatedAt.Format(times.TimeFormat),
+			IssuedAt:       tokenEnt.IssuedAt.Format(times.TimeFormat),
+			ExpiredAt:      tokenEnt.ExpiredAt.Format(times.TimeFormat),
+			ImpersonatorID: tokenEnt.ImpersonatorID,
 		})
-
-		// delete expired token from db
-		if time.Until(userEnt.Edges.Token.ExpiredAt).Seconds() < 0 {
-			_ = t.Delete(ctx, userEnt.ID)
-		}
 	}
-	total, err = t.Data.DBClient.User.Query().Where(userPredicates...).Count(ctx)
+	total, err = t.Data.DBClient.Token.Query().Where(predicates...).Count(ctx)
 	if err != nil {
 		err = fmt.Errorf("count token failed: %w", err)
 		return
 	}
 	return
 }
+
+// purgeExpired deletes the expired sessions which can not be renewed any more.
+// A session whose access token expired is kept while its refresh token family is alive, Rotate renews it.
+func (t *Token) purgeExpired(ctx context.Context) error {
+	now := time.Now()
+	predicates := append([]predicate.Token{token.ExpiredAtLT(now)}, tokenTenant(ctx)...)
+	jtis, err := t.Data.DBClient.Token.Query().Where(predicates...).Select(token.FieldJti).Strings(ctx)
+	if err != nil {
+		return fmt.Errorf("get expired Tokens failed: %w", err)
+	}
+	if len(jtis) == 0 {
+		return nil
+	}
+	liveFamilies, err := t.Data.DBClient.RefreshToken.Query().
+		Where(refreshtoken.FamilyIn(jtis...), refreshtoken.ExpiredAtGT(now),
+			refreshtoken.UsedAtIsNil(), refreshtoken.RevokedAtIsNil()).
+		Select(refreshtoken.FieldFamily).Strings(ctx)
+	if err != nil {
+		return fmt.Errorf("get refresh Token families failed: %w", err)
+	}
+	live := make(map[string]struct{}, len(liveFamilies))
+	for _, family := range liveFamilies {
+		live[family] = struct{}{}
+	}
+	dead := make([]string, 0, len(jtis))
+	for _, jti := range jtis {
+		if _, ok := live[jti]; !ok {
+			dead = append(dead, jti)
+		}
+	}
+	if len(dead) == 0 {
+		return nil
+	}
+
+	_, err = t.Data.DBClient.RefreshToken.Delete().Where(refreshtoken.FamilyIn(dead...)).Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("delete expired refresh Tokens failed: %w", err)
+	}
+	_, err = t.Data.DBClient.Token.Delete().Where(token.JtiIn(dead...)).Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("delete expired Tokens failed: %w", err)
+	}
+	return nil
+}
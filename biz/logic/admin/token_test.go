@@ -0,0 +1,69 @@
+/*
+ * Copyright 2023 FormulaGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ */
+
+package admin
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"formulago/data"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/patrickmn/go-cache"
+	"github.com/redis/go-redis/v9"
+)
+
+func TestToken_revokeClusterWide(t *testing.T) {
+	ctx := context.Background()
+	server := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: server.Addr()})
+	defer client.Close()
+	// two instances sharing the database and the redis, each with its own memory cache
+	d := newTestData(t)
+	d.Redis = client
+	other := &data.Data{DBClient: d.DBClient, Redis: client, Cache: cache.New(time.Minute, time.Minute)}
+	userEnt := newTestUser(t, d, "alice", 1)
+
+	tests := []struct {
+		name string
+		// revoke revokes the sessions on the first instance
+		revoke func(tokens *Token) error
+		// want whether the sessions are active on the other instance afterwards
+		want map[string]bool
+	}{
+		{
+			name:   "revoke a session",
+			revoke: func(tokens *Token) error { return tokens.Revoke(ctx, "session-1") },
+			want:   map[string]bool{"session-1": false, "session-2": true, "session-3": true},
+		},
+		{
+			name:   "revoke the other sessions of the user",
+			revoke: func(tokens *Token) error { return tokens.RevokeAll(ctx, userEnt.ID, "session-3") },
+			want:   map[string]bool{"session-1": false, "session-2": false, "session-3": true},
+		},
+	}
+	for _, jti := range []string{"session-1", "session-2", "session-3"} {
+		newTestLogin(t, d, userEnt.ID, jti)
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// the other instance has the sessions in its memory cache
+			for jti := range tt.want {
+				NewToken(other).IsActive(ctx, jti)
+			}
+			if err := tt.revoke(&Token{Data: d}); err != nil {
+				t.Fatal(err)
+			}
+			for jti, want := range tt.want {
+				if got := NewToken(other).IsActive(ctx, jti); got != want {
+					t.Errorf("IsActive(%s) on the other instance = %v, want %v", jti, got, want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,295 @@
+/*
+ * Copyright 2023 FormulaGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ */
+
+package admin
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"formulago/biz/domain/admin"
+	"formulago/configs"
+	"formulago/data"
+	"formulago/data/ent/user"
+	"formulago/pkg/encrypt"
+	"formulago/pkg/totp"
+
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+)
+
+const (
+	// recoveryCodeCount number of recovery codes generated at enrollment
+	recoveryCodeCount = 10
+	// challengeExpire lifetime of the second login step
+	challengeExpire = 5 * time.Minute
+	// challengeMaxAttempts wrong codes allowed before the challenge is dropped
+	challengeMaxAttempts = 5
+)
+
+type TwoFactor struct {
+	Data   *data.Data
+	Config configs.Config
+}
+
+func NewTwoFactor(data *data.Data, config configs.Config) admin.TwoFactor {
+	return &TwoFactor{
+		Data:   data,
+		Config: config,
+	}
+}
+
+func (t *TwoFactor) Enroll(ctx context.Context, userID uint64) (res *admin.TwoFactorEnrollInfo, err error) {
+	userEnt, err := t.Data.DBClient.User.Query().Where(user.IDEQ(userID)).Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get user failed: %w", err)
+	}
+	if userEnt.TotpEnabled {
+		return nil, errors.New("two-factor authentication is already enabled, reset it first")
+	}
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return nil, fmt.Errorf("generate totp secret failed: %w", err)
+	}
+	// the secret is pending until the first code is confirmed, on whatever instance
+	err = t.Data.CacheSet(ctx, "totpEnroll"+strconv.Itoa(int(userID)), secret, 10*time.Minute)
+	if err != nil {
+		return nil, fmt.Errorf("store totp secret failed: %w", err)
+	}
+
+	res = new(admin.TwoFactorEnrollInfo)
+	res.Secret = secret
+	res.URI = totp.ProvisioningURI(t.Config.Name, userEnt.Username, secret)
+	return res, nil
+}
+
+func (t *TwoFactor) Confirm(ctx context.Context, userID uint64, code string) (recoveryCodes []string, err error) {
+	secret, exist, err := t.Data.CacheGetShared(ctx, "totpEnroll"+strconv.Itoa(int(userID)))
+	if err != nil {
+		return nil, fmt.Errorf("get totp secret failed: %w", err)
+	}
+	if !exist {
+		return nil, errors.New("two-factor enrollment expired, please enroll again")
+	}
+	step, valid := totp.Validate(code, secret, time.Now())
+	if !valid {
+		return nil, errors.New("invalid two-factor code")
+	}
+
+	var hashes []string
+	for i := 0; i < recoveryCodeCount; i++ {
+		recoveryCode, err := newRecoveryCode()
+		if err != nil {
+			return nil, fmt.Errorf("generate recovery code failed: %w", err)
+		}
+		recoveryCodes = append(recoveryCodes, recoveryCode)
+		hashes = append(hashes, encrypt.SHA256Hex(recoveryCode))
+	}
+	_, err = t.Data.DBClient.User.Update().Where(user.IDEQ(userID)).
+		SetTotpSecret(secret).
+		SetTotpEnabled(true).
+		SetRecoveryCodes(hashes).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("enable two-factor failed: %w", err)
+	}
+
+	if err = t.Data.CacheDelete(ctx, "totpEnroll"+strconv.Itoa(int(userID))); err != nil {
+		return nil, fmt.Errorf("delete totp secret cache failed: %w", err)
+	}
+	if err = t.Data.CacheDelete(ctx, "twoFactor"+strconv.Itoa(int(userID))); err != nil {
+		return nil, fmt.Errorf("delete two-factor cache failed: %w", err)
+	}
+	// two-factor is enabled already, the code may be replayed only if the cache is down
+	if err = t.setUsedStep(ctx, userID, step); err != nil {
+		hlog.Error(err)
+	}
+	return recoveryCodes, nil
+}
+
+func (t *TwoFactor) Reset(ctx context.Context, userID uint64) error {
+	_, err := t.Data.DBClient.User.Update().Where(user.IDEQ(userID)).
+		SetTotpSecret("").
+		SetTotpEnabled(false).
+		ClearRecoveryCodes().
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("reset two-factor failed: %w", err)
+	}
+	// the other instances must not keep asking for a code
+	if err = t.Data.CacheDelete(ctx, "twoFactor"+strconv.Itoa(int(userID))); err != nil {
+		return fmt.Errorf("delete two-factor cache failed: %w", err)
+	}
+	return nil
+}
+
+func (t *TwoFactor) Status(ctx context.Context, userID, roleID uint64) (res *admin.TwoFactorStatus, err error) {
+	res = new(admin.TwoFactorStatus)
+	roleInfo, err := (&Role{Data: t.Data}).RoleInfoByID(ctx, roleID)
+	if err != nil {
+		return nil, err
+	}
+	res.Enforced = roleInfo.ForceTwoFactor
+
+	// get enabled from the shared cache, an enrollment or a reset on any instance takes effect at once
+	v, exist, err := t.Data.CacheGetShared(ctx, "twoFactor"+strconv.Itoa(int(userID)))
+	if err != nil {
+		hlog.Error(err, "get two-factor status from cache error")
+	}
+	if exist {
+		if enabled, err := strconv.ParseBool(v); err == nil {
+			res.Enabled = enabled
+			return res, nil
+		}
+	}
+	userEnt, err := t.Data.DBClient.User.Query().Where(user.IDEQ(userID)).Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get user failed: %w", err)
+	}
+	res.Enabled = userEnt.TotpEnabled
+	err = t.Data.CacheSet(ctx, "twoFactor"+strconv.Itoa(int(userID)), strconv.FormatBool(res.Enabled), 24*time.Hour)
+	if err != nil {
+		hlog.Error(err, "set two-factor status to cache error")
+	}
+	return res, nil
+}
+
+func (t *TwoFactor) Verify(ctx context.Context, userID uint64, code string) error {
+	userEnt, err := t.Data.DBClient.User.Query().Where(user.IDEQ(userID)).Only(ctx)
+	if err != nil {
+		return fmt.Errorf("get user failed: %w", err)
+	}
+	if !userEnt.TotpEnabled {
+		return errors.New("two-factor authentication is not enabled")
+	}
+
+	code = strings.TrimSpace(code)
+	if len(code) == totp.Digits {
+		step, valid := totp.Validate(code, userEnt.TotpSecret, time.Now())
+		if !valid {
+			return errors.New("invalid two-factor code")
+		}
+		// a code can only be used once, on any instance
+		last, exist, err := t.Data.CacheGetShared(ctx, "totpStep"+strconv.Itoa(int(userID)))
+		if err != nil {
+			return fmt.Errorf("get used two-factor code failed: %w", err)
+		}
+		if lastStep, err := strconv.ParseUint(last, 10, 64); exist && err == nil && step <= lastStep {
+			return errors.New("two-factor code has already been used")
+		}
+		return t.setUsedStep(ctx, userID, step)
+	}
+
+	// recovery code, consumed on use
+	hash := encrypt.SHA256Hex(strings.ToLower(code))
+	var remaining []string
+	var matched bool
+	for _, h := range userEnt.RecoveryCodes {
+		if h == hash && !matched {
+			matched = true
+			continue
+		}
+		remaining = append(remaining, h)
+	}
+	if !matched {
+		return errors.New("invalid two-factor code")
+	}
+	n, err := t.Data.DBClient.User.Update().
+		Where(user.IDEQ(userID), user.UpdatedAtEQ(userEnt.UpdatedAt)).
+		SetRecoveryCodes(remaining).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("consume recovery code failed: %w", err)
+	}
+	if n == 0 {
+		return errors.New("recovery code is being used, please retry")
+	}
+	return nil
+}
+
+func (t *TwoFactor) CreateChallenge(ctx context.Context, req *admin.TwoFactorChallenge) (mfaToken string, err error) {
+	mfaToken, err = encrypt.RandomToken(32)
+	if err != nil {
+		return "", fmt.Errorf("generate mfa token failed: %w", err)
+	}
+	req.Attempts = 0
+	req.ExpiredAt = time.Now().Add(challengeExpire).Unix()
+	if err = t.setChallenge(ctx, mfaToken, req); err != nil {
+		return "", err
+	}
+	return mfaToken, nil
+}
+
+func (t *TwoFactor) VerifyChallenge(ctx context.Context, mfaToken, code string) (res *admin.TwoFactorChallenge, err error) {
+	key := "mfaChallenge" + encrypt.SHA256Hex(mfaToken)
+	v, exist, err := t.Data.CacheGet(ctx, key)
+	if err != nil {
+		return nil, fmt.Errorf("get mfa challenge failed: %w", err)
+	}
+	if !exist {
+		return nil, errors.New("two-factor login expired, please login again")
+	}
+	res = new(admin.TwoFactorChallenge)
+	if err = json.Unmarshal([]byte(v), res); err != nil {
+		return nil, fmt.Errorf("unmarshal mfa challenge failed: %w", err)
+	}
+
+	if err = t.Verify(ctx, res.Login.UserID, code); err != nil {
+		res.Attempts++
+		if res.Attempts >= challengeMaxAttempts {
+			_ = t.Data.CacheDelete(ctx, key)
+			return res, errors.New("too many wrong two-factor codes, please login again")
+		}
+		_ = t.setChallenge(ctx, mfaToken, res)
+		return res, err
+	}
+
+	// the challenge can only be passed once
+	_ = t.Data.CacheDelete(ctx, key)
+	return res, nil
+}
+
+func (t *TwoFactor) setChallenge(ctx context.Context, mfaToken string, req *admin.TwoFactorChallenge) error {
+	b, err := json.Marshal(req)
+	if err != nil {
+		return fmt.Errorf("marshal mfa challenge failed: %w", err)
+	}
+	exp := time.Until(time.Unix(req.ExpiredAt, 0))
+	if exp <= 0 {
+		return errors.New("two-factor login expired, please login again")
+	}
+	err = t.Data.CacheSet(ctx, "mfaChallenge"+encrypt.SHA256Hex(mfaToken), string(b), exp)
+	if err != nil {
+		return fmt.Errorf("store mfa challenge failed: %w", err)
+	}
+	return nil
+}
+
+// setUsedStep records the time step of the last code used by the user, for as long as the code is valid
+func (t *TwoFactor) setUsedStep(ctx context.Context, userID, step uint64) error {
+	err := t.Data.CacheSet(ctx, "totpStep"+strconv.Itoa(int(userID)), strconv.FormatUint(step, 10),
+		2*totp.Period*time.Second*(totp.Skew+1))
+	if err != nil {
+		return fmt.Errorf("store used two-factor code failed: %w", err)
+	}
+	return nil
+}
+
+// newRecoveryCode returns a code like 3f9a1-c07b2
+func newRecoveryCode() (string, error) {
+	b := make([]byte, 5)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	h := hex.EncodeToString(b)
+	return h[:5] + "-" + h[5:], nil
+}
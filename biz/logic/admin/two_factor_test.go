@@ -0,0 +1,75 @@
+/*
+ * Copyright 2023 FormulaGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ */
+
+package admin
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"formulago/biz/domain/admin"
+	"formulago/configs"
+	"formulago/data"
+	"formulago/pkg/totp"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/patrickmn/go-cache"
+	"github.com/redis/go-redis/v9"
+)
+
+func TestTwoFactor_clusterWide(t *testing.T) {
+	ctx := context.Background()
+	server := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: server.Addr()})
+	defer client.Close()
+	// two instances sharing the database and the redis, each with its own memory cache
+	d := newTestData(t)
+	d.Redis = client
+	other := &data.Data{DBClient: d.DBClient, Redis: client, Cache: cache.New(time.Minute, time.Minute)}
+	first, second := NewTwoFactor(d, configs.Config{}), NewTwoFactor(other, configs.Config{})
+	roleEnt := newTestRole(t, d, "admin")
+	userEnt := newTestUser(t, d, "alice", roleEnt.ID)
+
+	enabled := func(t *testing.T, want bool) {
+		t.Helper()
+		status, err := second.Status(ctx, userEnt.ID, roleEnt.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if status.Enabled != want {
+			t.Errorf("Status() on the other instance enabled = %v, want %v", status.Enabled, want)
+		}
+	}
+	// the other instance caches the status before the enrollment
+	enabled(t, false)
+
+	// enrolled on one instance, confirmed on the other
+	enroll, err := first.Enroll(ctx, userEnt.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	code, err := totp.GenerateCode(enroll.Secret, totp.Step(time.Now()), totp.Digits)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = second.Confirm(ctx, userEnt.ID, code); err != nil {
+		t.Fatalf("Confirm() on the other instance: %v", err)
+	}
+	enabled(t, true)
+
+	// the code of the confirmation cannot be replayed on any instance
+	for i, twoFactor := range []admin.TwoFactor{first, second} {
+		if err = twoFactor.Verify(ctx, userEnt.ID, code); err == nil {
+			t.Errorf("Verify() of a used code on instance %d should fail", i)
+		}
+	}
+
+	if err = first.Reset(ctx, userEnt.ID); err != nil {
+		t.Fatal(err)
+	}
+	enabled(t, false)
+}
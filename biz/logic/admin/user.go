@@ -13,25 +13,32 @@ import (
 	"formulago/biz/domain/admin"
 	"formulago/data"
 	"formulago/data/ent"
+	"formulago/data/ent/apikey"
+	"formulago/data/ent/oidcconsent"
 	"formulago/data/ent/predicate"
+	"formulago/data/ent/role"
+	"formulago/data/ent/token"
 	"formulago/data/ent/user"
+	"formulago/data/ent/useridentity"
+	"formulago/data/ent/webauthncredential"
 	"formulago/pkg/encrypt"
+	"formulago/pkg/tenant"
 	"regexp"
+	"slices"
 	"strconv"
 	"time"
 
+	"github.com/casbin/casbin/v3"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
 	"github.com/jinzhu/copier"
 )
 
 var usernameRegex = regexp.MustCompile(`^[a-z]{5,}$`)
 
-func validateUserInput(username, password string) error {
+func validateUsername(username string) error {
 	if !usernameRegex.MatchString(username) {
 		return errors.New("username must be at least 5 lowercase letters, no spaces or special characters")
 	}
-	if len(password) < 8 {
-		return errors.New("password must be at least 8 characters")
-	}
 	return nil
 }
 
@@ -46,19 +53,35 @@ func NewUser(data *data.Data) admin.User {
 }
 
 func (u *User) Create(ctx context.Context, req admin.CreateOrUpdateUserReq) error {
-	if err := validateUserInput(req.Username, req.Password); err != nil {
+	if err := validateUsername(req.Username); err != nil {
+		return err
+	}
+	if err := validateNewPassword(nil, req.Username, req.Password); err != nil {
 		return err
 	}
 	password, _ := encrypt.BcryptEncrypt(req.Password)
-	_, err := u.Data.DBClient.User.Create().
+	roleID, roleIDs := userRoleIDs(req.RoleID, req.RoleIDs)
+	// the user belongs to the tenant of the default role
+	roleEnt, err := u.Data.DBClient.Role.Get(ctx, roleID)
+	if err != nil {
+		return fmt.Errorf("get role failed: %w", err)
+	}
+	if err = u.checkRoles(ctx, roleEnt.TenantID, roleIDs); err != nil {
+		return err
+	}
+	_, err = u.Data.DBClient.User.Create().
+		SetTenantID(roleEnt.TenantID).
 		SetAvatar(req.Avatar).
-		SetRoleID(req.RoleID).
+		SetRoleID(roleID).
+		AddRoleIDs(roleIDs...).
 		SetMobile(req.Mobile).
 		SetEmail(req.Email).
 		SetStatus(uint8(req.Status)).
 		SetUsername(req.Username).
 		SetPassword(password).
+		SetPasswordChangedAt(time.Now()).
 		SetNickname(req.Nickname).
+		SetPendingApproval(req.PendingApproval).
 		Save(ctx)
 	if err != nil {
 		return fmt.Errorf("create user failed: %w", err)
@@ -68,32 +91,87 @@ func (u *User) Create(ctx context.Context, req admin.CreateOrUpdateUserReq) erro
 }
 
 func (u *User) Update(ctx context.Context, req admin.CreateOrUpdateUserReq) error {
-	if err := validateUserInput(req.Username, req.Password); err != nil {
+	if err := validateUsername(req.Username); err != nil {
 		return err
 	}
-	password, _ := encrypt.BcryptEncrypt(req.Password)
-	_, err := u.Data.DBClient.User.Update().
-		Where(user.IDEQ(req.ID)).
+	targetUser, err := u.Data.DBClient.User.Query().Where(user.IDEQ(req.ID)).First(ctx)
+	if err != nil {
+		return fmt.Errorf("targetUser not found: %w", err)
+	}
+	// clients not aware of multiple roles keep the other roles of the user
+	if len(req.RoleIDs) == 0 {
+		if req.RoleIDs, err = u.RoleIDs(ctx, req.ID); err != nil {
+			return err
+		}
+	}
+	roleID, roleIDs := userRoleIDs(req.RoleID, req.RoleIDs)
+	if err = u.checkRoles(ctx, targetUser.TenantID, roleIDs); err != nil {
+		return err
+	}
+	update := u.Data.DBClient.User.UpdateOne(targetUser).
 		SetAvatar(req.Avatar).
-		SetRoleID(req.RoleID).
+		SetRoleID(roleID).
+		ClearRoles().
+		AddRoleIDs(roleIDs...).
 		SetMobile(req.Mobile).
 		SetEmail(req.Email).
 		SetStatus(uint8(req.Status)).
 		SetUsername(req.Username).
-		SetPassword(password).
-		SetNickname(req.Nickname).
-		Save(ctx)
-	if err != nil {
+		SetNickname(req.Nickname)
+	// an admin enabling a self-registered user approves it
+	if req.Status == 1 {
+		update.SetPendingApproval(false)
+	}
+	// an empty or unchanged password keeps the current one
+	passwordChanged := req.Password != "" && !encrypt.BcryptCheck(req.Password, targetUser.Password)
+	if passwordChanged {
+		if err = validateNewPassword(targetUser, req.Username, req.Password); err != nil {
+			return err
+		}
+		password, _ := encrypt.BcryptEncrypt(req.Password)
+		update.SetPassword(password).
+			SetPasswordHistory(passwordHistory(targetUser)).
+			SetPasswordChangedAt(time.Now())
+	}
+	if _, err = update.Save(ctx); err != nil {
 		return fmt.Errorf("update user failed: %w", err)
 	}
+	u.Data.Cache.Delete("passwordDeadline" + strconv.Itoa(int(req.ID)))
+	u.Data.Cache.Delete("userRoles" + strconv.Itoa(int(req.ID)))
+	// a disabled user or a reset password loses all tokens at once
+	if req.Status != 1 || passwordChanged {
+		return u.bumpTokenVersion(ctx, req.ID)
+	}
+	return u.clearTokenVersion(ctx, req.ID)
+}
 
+// userRoleIDs returns the default role and all roles of the user, the default one first and without duplicates
+func userRoleIDs(roleID uint64, roleIDs []uint64) (uint64, []uint64) {
+	if roleID == 0 && len(roleIDs) > 0 {
+		roleID = roleIDs[0]
+	}
+	ids := []uint64{roleID}
+	for _, id := range roleIDs {
+		if !slices.Contains(ids, id) {
+			ids = append(ids, id)
+		}
+	}
+	return roleID, ids
+}
+
+// checkRoles checks the roles exist and belong to the tenant of the user
+func (u *User) checkRoles(ctx context.Context, tenantID uint64, roleIDs []uint64) error {
+	n, err := u.Data.DBClient.Role.Query().Where(role.IDIn(roleIDs...), role.TenantID(tenantID)).Count(ctx)
+	if err != nil {
+		return fmt.Errorf("query roles failed: %w", err)
+	}
+	if n != len(roleIDs) {
+		return errors.New("role not exist")
+	}
 	return nil
 }
 
 func (u *User) ChangePassword(ctx context.Context, userID uint64, oldPassword, newPassword string) error {
-	if len(newPassword) < 8 {
-		return errors.New("password must be at least 8 characters")
-	}
 	// get user info
 	targetUser, err := u.Data.DBClient.User.Query().Where(user.IDEQ(userID)).First(ctx)
 	if err != nil {
@@ -103,11 +181,35 @@ func (u *User) ChangePassword(ctx context.Context, userID uint64, oldPassword, n
 	if ok := encrypt.BcryptCheck(oldPassword, targetUser.Password); !ok {
 		return errors.New("wrong old password")
 	}
+	return u.setPassword(ctx, targetUser, newPassword)
+}
+
+func (u *User) ResetPassword(ctx context.Context, userID uint64, newPassword string) error {
+	targetUser, err := u.Data.DBClient.User.Query().Where(user.IDEQ(userID)).First(ctx)
+	if err != nil {
+		return fmt.Errorf("targetUser not found: %w", err)
+	}
+	return u.setPassword(ctx, targetUser, newPassword)
+}
+
+// setPassword checks the new password against the policy, keeps the history and revokes all tokens
+func (u *User) setPassword(ctx context.Context, targetUser *ent.User, newPassword string) error {
+	if err := validateNewPassword(targetUser, targetUser.Username, newPassword); err != nil {
+		return err
+	}
 	// update password
 	password, _ := encrypt.BcryptEncrypt(newPassword)
-	_, err = u.Data.DBClient.User.Update().Where(user.IDEQ(userID)).SetPassword(password).Save(ctx)
+	_, err := u.Data.DBClient.User.UpdateOne(targetUser).
+		SetPassword(password).
+		SetPasswordHistory(passwordHistory(targetUser)).
+		SetPasswordChangedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("update password failed: %w", err)
+	}
+	u.Data.Cache.Delete("passwordDeadline" + strconv.Itoa(int(targetUser.ID)))
 
-	return err
+	return u.bumpTokenVersion(ctx, targetUser.ID)
 }
 
 func (u *User) UserInfo(ctx context.Context, id uint64) (userInfo *admin.UserInfo, err error) {
@@ -116,6 +218,10 @@ func (u *User) UserInfo(ctx context.Context, id uint64) (userInfo *admin.UserInf
 	userInterface, exist := u.Data.Cache.Get("userInfo" + strconv.Itoa(int(id)))
 	if exist {
 		if u, ok := userInterface.(*admin.UserInfo); ok {
+			// the cache is shared by the tenants
+			if id, ok := tenant.FromContext(ctx); ok && u.TenantID != id {
+				return userInfo, errors.New("get user failed: user not found")
+			}
 			return u, nil
 		}
 	}
@@ -131,6 +237,10 @@ func (u *User) UserInfo(ctx context.Context, id uint64) (userInfo *admin.UserInf
 		err = fmt.Errorf("copy user info failed: %w", err)
 		return userInfo, err
 	}
+	userInfo.RoleIDs, err = u.RoleIDs(ctx, id)
+	if err != nil {
+		return userInfo, err
+	}
 	// role info from cache
 	roleInterface, exist := u.Data.Cache.Get("roleData" + strconv.Itoa(int(userInfo.RoleID)))
 	if exist {
@@ -164,10 +274,14 @@ func (u *User) List(ctx context.Context, req admin.UserListReq) (userList []*adm
 	}
 
 	if req.RoleID != 0 {
-		predicates = append(predicates, user.RoleIDEQ(req.RoleID))
+		predicates = append(predicates, user.Or(user.RoleIDEQ(req.RoleID), user.HasRolesWith(role.IDEQ(req.RoleID))))
 	}
 
-	users, err := u.Data.DBClient.User.Query().Where(predicates...).
+	if req.PendingApproval {
+		predicates = append(predicates, user.PendingApproval(true))
+	}
+
+	users, err := u.Data.DBClient.User.Query().Where(predicates...).WithRoles().
 		Offset(int(req.Page-1) * int(req.PageSize)).
 		Limit(int(req.PageSize)).All(ctx)
 	if err != nil {
@@ -180,6 +294,9 @@ func (u *User) List(ctx context.Context, req admin.UserListReq) (userList []*adm
 		err = fmt.Errorf("copy user info failed: %w", err)
 		return userList, 0, err
 	}
+	for i, v := range users {
+		userList[i].RoleIDs = entRoleIDs(v)
+	}
 	total, err = u.Data.DBClient.User.Query().Where(predicates...).Count(ctx)
 	if err != nil {
 		err = fmt.Errorf("count user failed: %w", err)
@@ -189,13 +306,67 @@ func (u *User) List(ctx context.Context, req admin.UserListReq) (userList []*adm
 }
 
 func (u *User) UpdateUserStatus(ctx context.Context, id uint64, status uint64) error {
-	_, err := u.Data.DBClient.User.Update().Where(user.IDEQ(id)).SetStatus(uint8(status)).Save(ctx)
-	return err
+	n, err := u.Data.DBClient.User.Update().Where(user.IDEQ(id)).SetStatus(uint8(status)).Save(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return errors.New("user not exist")
+	}
+	return u.bumpTokenVersion(ctx, id)
+}
+
+func (u *User) ApproveUser(ctx context.Context, id uint64, approve bool) error {
+	exist, err := u.Data.DBClient.User.Query().Where(user.IDEQ(id), user.PendingApproval(true)).Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("get user failed: %w", err)
+	}
+	if !exist {
+		return errors.New("the user is not waiting for approval")
+	}
+	if !approve {
+		return u.DeleteUser(ctx, id)
+	}
+	_, err = u.Data.DBClient.User.UpdateOneID(id).SetStatus(1).SetPendingApproval(false).Save(ctx)
+	if err != nil {
+		return fmt.Errorf("approve user failed: %w", err)
+	}
+	return u.clearTokenVersion(ctx, id)
 }
 
 func (u *User) DeleteUser(ctx context.Context, id uint64) error {
-	_, err := u.Data.DBClient.User.Delete().Where(user.IDEQ(id)).Exec(ctx)
-	return err
+	if err := userInTenant(ctx, u.Data, id); err != nil {
+		return err
+	}
+	// sessions, passkeys, api keys and linked accounts reference the user, remove them first
+	if err := NewToken(u.Data).Delete(ctx, id); err != nil {
+		return err
+	}
+	_, err := u.Data.DBClient.WebauthnCredential.Delete().Where(webauthncredential.UserIDEQ(id)).Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("delete passkeys failed: %w", err)
+	}
+	_, err = u.Data.DBClient.APIKey.Delete().Where(apikey.UserIDEQ(id)).Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("delete api keys failed: %w", err)
+	}
+	_, err = u.Data.DBClient.OidcConsent.Delete().Where(oidcconsent.UserID(id)).Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("delete oidc consents failed: %w", err)
+	}
+	_, err = u.Data.DBClient.UserIdentity.Delete().Where(useridentity.UserID(id)).Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("delete user identities failed: %w", err)
+	}
+	_, err = u.Data.DBClient.User.Delete().Where(user.IDEQ(id)).Exec(ctx)
+	if err != nil {
+		return err
+	}
+	// the user is gone, TokenVersion fails from now on, clear the cache
+	u.Data.Cache.Delete("userInfo" + strconv.Itoa(int(id)))
+	u.Data.Cache.Delete("passwordDeadline" + strconv.Itoa(int(id)))
+	u.Data.Cache.Delete("userRoles" + strconv.Itoa(int(id)))
+	return u.clearTokenVersion(ctx, id)
 }
 
 func (u *User) UpdateProfile(ctx context.Context, req admin.UpdateUserProfileReq) error {
@@ -208,3 +379,197 @@ func (u *User) UpdateProfile(ctx context.Context, req admin.UpdateUserProfileReq
 		Save(ctx)
 	return err
 }
+
+func (u *User) TokenVersion(ctx context.Context, id uint64) (version uint64, err error) {
+	// get token version from the shared cache, a bump on any instance takes effect at once
+	v, exist, err := u.Data.CacheGetShared(ctx, "tokenVersion"+strconv.Itoa(int(id)))
+	if err != nil {
+		hlog.Error(err, "get token version from cache error")
+	}
+	if exist {
+		if version, err := strconv.ParseUint(v, 10, 64); err == nil {
+			return version, nil
+		}
+	}
+	// only active users have a valid token version
+	userEnt, err := u.Data.DBClient.User.Query().Where(user.IDEQ(id), user.Status(1)).Only(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("get active user failed: %w", err)
+	}
+	err = u.Data.CacheSet(ctx, "tokenVersion"+strconv.Itoa(int(id)), strconv.FormatUint(userEnt.TokenVersion, 10), 72*time.Hour)
+	if err != nil {
+		hlog.Error(err, "set token version to cache error")
+	}
+	return userEnt.TokenVersion, nil
+}
+
+func (u *User) PasswordExpired(ctx context.Context, id uint64) (bool, error) {
+	// get password deadline from cache
+	var deadline time.Time
+	d, exist := u.Data.Cache.Get("passwordDeadline" + strconv.Itoa(int(id)))
+	if t, ok := d.(time.Time); exist && ok {
+		deadline = t
+	} else {
+		userEnt, err := u.Data.DBClient.User.Query().Where(user.IDEQ(id)).Only(ctx)
+		if err != nil {
+			return false, fmt.Errorf("get user failed: %w", err)
+		}
+		deadline = passwordDeadline(userEnt)
+		u.Data.Cache.Set("passwordDeadline"+strconv.Itoa(int(id)), deadline, time.Hour)
+	}
+	return !deadline.IsZero() && time.Now().After(deadline), nil
+}
+
+func (u *User) RoleIDs(ctx context.Context, id uint64) (roleIDs []uint64, err error) {
+	// get role ids from cache
+	v, exist := u.Data.Cache.Get("userRoles" + strconv.Itoa(int(id)))
+	if exist {
+		if roleIDs, ok := v.([]uint64); ok {
+			return roleIDs, nil
+		}
+	}
+	userEnt, err := u.Data.DBClient.User.Query().Where(user.IDEQ(id)).WithRoles().Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get user failed: %w", err)
+	}
+	roleIDs = entRoleIDs(userEnt)
+	u.Data.Cache.Set("userRoles"+strconv.Itoa(int(id)), roleIDs, 24*time.Hour)
+	return roleIDs, nil
+}
+
+func (u *User) ActiveRoles(ctx context.Context, id uint64) (roles []*admin.RoleInfo, err error) {
+	roleIDs, err := u.RoleIDs(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	for _, roleID := range roleIDs {
+		roleInfo, err := (&Role{Data: u.Data}).RoleInfoByID(ctx, roleID)
+		if err != nil {
+			return nil, err
+		}
+		if roleInfo.Status == 1 {
+			roles = append(roles, roleInfo)
+		}
+	}
+	return roles, nil
+}
+
+func (u *User) SwitchRole(ctx context.Context, id uint64, jti string, roleID uint64) error {
+	roles, err := u.ActiveRoles(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !slices.ContainsFunc(roles, func(r *admin.RoleInfo) bool { return r.ID == roleID }) {
+		return errors.New("the role is not an active role of the user")
+	}
+	// api keys have no session to switch
+	if jti == "" {
+		return errors.New("switching the role requires a login session")
+	}
+	// only the session switches, the role of the user stays the one of the next logins
+	n, err := u.Data.DBClient.Token.Update().Where(token.Jti(jti), token.UserID(id)).SetRoleID(roleID).Save(ctx)
+	if err != nil {
+		return fmt.Errorf("switch role of the session failed: %w", err)
+	}
+	if n == 0 {
+		return errors.New("session not exist")
+	}
+	return nil
+}
+
+func (u *User) CurrentRole(ctx context.Context, id uint64, jti string) (roleInfo *admin.RoleInfo, err error) {
+	userEnt, err := u.Data.DBClient.User.Query().Where(user.IDEQ(id)).First(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get user failed: %w", err)
+	}
+	var sessionRoleID uint64
+	if jti != "" {
+		session, err := u.Data.DBClient.Token.Query().Where(token.Jti(jti), token.UserID(id)).Only(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return nil, fmt.Errorf("get session failed: %w", err)
+		}
+		if session != nil {
+			sessionRoleID = session.RoleID
+		}
+	}
+	roles, err := u.ActiveRoles(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	roleID, ok := sessionRole(roles, sessionRoleID, userEnt.RoleID)
+	if !ok {
+		return nil, errors.New("the user has no active role")
+	}
+	return roles[slices.IndexFunc(roles, func(r *admin.RoleInfo) bool { return r.ID == roleID })], nil
+}
+
+// rolesAllow checks whether one of the roles has the policy within the domain
+func rolesAllow(cbs *casbin.Enforcer, roleIDs []uint64, dom, obj, act string) (bool, error) {
+	for _, id := range roleIDs {
+		pass, err := cbs.Enforce(strconv.FormatUint(id, 10), dom, obj, act)
+		if err != nil || pass {
+			return pass, err
+		}
+	}
+	return false, nil
+}
+
+// entRoleIDs returns the role ids of the user loaded with its roles, the default role first.
+// The default role counts even without the edge, as the users created before multiple roles only have role_id.
+func entRoleIDs(userEnt *ent.User) []uint64 {
+	roleIDs := []uint64{userEnt.RoleID}
+	for _, r := range userEnt.Edges.Roles {
+		if !slices.Contains(roleIDs, r.ID) {
+			roleIDs = append(roleIDs, r.ID)
+		}
+	}
+	return roleIDs
+}
+
+// userInTenant checks the user belongs to the tenant of the context,
+// before the data of the user which is not scoped itself is touched
+func userInTenant(ctx context.Context, d *data.Data, id uint64) error {
+	if _, ok := tenant.FromContext(ctx); !ok {
+		return nil
+	}
+	exist, err := d.DBClient.User.Query().Where(user.IDEQ(id)).Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("query user failed: %w", err)
+	}
+	if !exist {
+		return errors.New("user not exist")
+	}
+	return nil
+}
+
+// bumpTokenVersion invalidates every token issued to the user, revokes the api keys and removes the sessions.
+// The api keys and the sessions are not scoped, so nothing is touched unless the user is in the tenant of the context.
+func (u *User) bumpTokenVersion(ctx context.Context, id uint64) error {
+	n, err := u.Data.DBClient.User.Update().Where(user.IDEQ(id)).AddTokenVersion(1).Save(ctx)
+	if err != nil {
+		return fmt.Errorf("update token version failed: %w", err)
+	}
+	if n == 0 {
+		return errors.New("user not exist")
+	}
+	// the api keys do not carry the token version, they die with the tokens
+	_, err = u.Data.DBClient.APIKey.Update().
+		Where(apikey.UserIDEQ(id), apikey.RevokedAtIsNil()).
+		SetRevokedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("revoke api keys failed: %w", err)
+	}
+	if err = u.clearTokenVersion(ctx, id); err != nil {
+		return err
+	}
+	return NewToken(u.Data).Delete(ctx, id)
+}
+
+// clearTokenVersion drops the cached token version of the user on every instance
+func (u *User) clearTokenVersion(ctx context.Context, id uint64) error {
+	if err := u.Data.CacheDelete(ctx, "tokenVersion"+strconv.Itoa(int(id))); err != nil {
+		return fmt.Errorf("delete token version cache failed: %w", err)
+	}
+	return nil
+}
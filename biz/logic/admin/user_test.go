@@ -0,0 +1,264 @@
+/*
+ * Copyright 2023 FormulaGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ */
+
+package admin
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"testing"
+	"time"
+
+	"formulago/biz/domain/admin"
+	"formulago/configs"
+	"formulago/data/ent/token"
+	"formulago/pkg/tenant"
+)
+
+func TestUser_ActiveRoles(t *testing.T) {
+	ctx := context.Background()
+	d := newTestData(t)
+	enforcer := newTestEnforcer(t)
+	admins := newTestRole(t, d, "admin")
+	editors := newTestRole(t, d, "editor")
+	disabled := newTestRole(t, d, "disabled")
+	if err := d.DBClient.Role.UpdateOne(disabled).SetStatus(0).Exec(ctx); err != nil {
+		t.Fatal(err)
+	}
+	for _, policy := range [][]string{
+		{"1", tenant.Domain(1), "/api/admin/user/list", http.MethodPost},
+		{"2", tenant.Domain(1), "/api/admin/article/list", http.MethodPost},
+		{"3", tenant.Domain(1), "/api/admin/role/list", http.MethodPost},
+	} {
+		if _, err := enforcer.AddPolicy(policy); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// the users created before multiple roles only have the default role
+	legacy := newTestUser(t, d, "alice", editors.ID)
+	multiple := newTestUser(t, d, "bobby", admins.ID)
+	err := d.DBClient.User.UpdateOne(multiple).AddRoleIDs(admins.ID, editors.ID, disabled.ID).Exec(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name      string
+		userID    uint64
+		wantRoles []uint64
+		// allowed the paths allowed to the union of the active roles
+		allowed   []string
+		forbidden []string
+	}{
+		{name: "legacy user", userID: legacy.ID, wantRoles: []uint64{editors.ID},
+			allowed: []string{"/api/admin/article/list"}, forbidden: []string{"/api/admin/user/list"}},
+		{name: "multiple roles", userID: multiple.ID, wantRoles: []uint64{admins.ID, editors.ID},
+			allowed: []string{"/api/admin/user/list", "/api/admin/article/list"}, forbidden: []string{"/api/admin/role/list"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			roles, err := NewUser(d).ActiveRoles(ctx, tt.userID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			roleIDs := make([]uint64, 0, len(roles))
+			for _, v := range roles {
+				roleIDs = append(roleIDs, v.ID)
+			}
+			if !slices.Equal(roleIDs, tt.wantRoles) {
+				t.Fatalf("ActiveRoles() = %v, want %v", roleIDs, tt.wantRoles)
+			}
+			for _, path := range append(tt.allowed, tt.forbidden...) {
+				pass, err := rolesAllow(enforcer, roleIDs, tenant.Domain(1), path, http.MethodPost)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if want := slices.Contains(tt.allowed, path); pass != want {
+					t.Errorf("rolesAllow(%s) = %v, want %v", path, pass, want)
+				}
+			}
+		})
+	}
+}
+
+func TestUser_SwitchRole(t *testing.T) {
+	ctx := context.Background()
+	d := newTestData(t)
+	admins := newTestRole(t, d, "admin")
+	editors := newTestRole(t, d, "editor")
+	disabled := newTestRole(t, d, "disabled")
+	other := newTestRole(t, d, "other")
+	if err := d.DBClient.Role.UpdateOne(disabled).SetStatus(0).Exec(ctx); err != nil {
+		t.Fatal(err)
+	}
+	userEnt := newTestUser(t, d, "alice", admins.ID)
+	err := d.DBClient.User.UpdateOne(userEnt).AddRoleIDs(admins.ID, editors.ID, disabled.ID).Exec(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	users := NewUser(d)
+	refreshTokens := NewRefreshToken(d, configs.Config{})
+
+	refreshToken := newTestSession(t, d, userEnt.ID, "session-1")
+	newTestSession(t, d, userEnt.ID, "session-2")
+
+	tests := []struct {
+		name    string
+		roleID  uint64
+		wantErr bool
+	}{
+		{name: "role not assigned", roleID: other.ID, wantErr: true},
+		{name: "disabled role", roleID: disabled.ID, wantErr: true},
+		{name: "assigned role", roleID: editors.ID, wantErr: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := users.SwitchRole(ctx, userEnt.ID, "session-1", tt.roleID)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("SwitchRole() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+	// api keys have no session to switch
+	if err = users.SwitchRole(ctx, userEnt.ID, "", editors.ID); err == nil {
+		t.Error("SwitchRole() without a session should fail")
+	}
+
+	// the switched role is the current role of the session only, the default role of the next logins is kept
+	info, err := users.UserInfo(ctx, userEnt.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.RoleID != admins.ID || !slices.Equal(info.RoleIDs, []uint64{admins.ID, editors.ID, disabled.ID}) {
+		t.Errorf("roles of the user = %d %v, want the default %d kept", info.RoleID, info.RoleIDs, admins.ID)
+	}
+	for jti, want := range map[string]uint64{"session-1": editors.ID, "session-2": admins.ID, "": admins.ID} {
+		current, err := users.CurrentRole(ctx, userEnt.ID, jti)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if current.ID != want {
+			t.Errorf("CurrentRole(%q) = %d, want %d", jti, current.ID, want)
+		}
+	}
+	for jti, want := range map[string]uint64{"session-1": editors.ID, "session-2": 0} {
+		session, err := d.DBClient.Token.Query().Where(token.Jti(jti)).Only(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if session.RoleID != want {
+			t.Errorf("role of %s = %d, want %d", jti, session.RoleID, want)
+		}
+	}
+
+	// the refresh keeps the switched role
+	res, err := refreshTokens.Rotate(ctx, refreshToken)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.RoleID != editors.ID {
+		t.Errorf("Rotate() role = %d, want the switched role %d", res.RoleID, editors.ID)
+	}
+
+	// the switched role is disabled, the refresh falls back to an active role
+	if err = d.DBClient.Role.UpdateOne(editors).SetStatus(0).Exec(ctx); err != nil {
+		t.Fatal(err)
+	}
+	d.Cache.Flush()
+	res, err = refreshTokens.Rotate(ctx, res.RefreshToken)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.RoleID != admins.ID {
+		t.Errorf("Rotate() role = %d after the switched role is disabled, want %d", res.RoleID, admins.ID)
+	}
+
+	// no active role is left, the session ends
+	if err = d.DBClient.Role.UpdateOne(admins).SetStatus(0).Exec(ctx); err != nil {
+		t.Fatal(err)
+	}
+	d.Cache.Flush()
+	if _, err = refreshTokens.Rotate(ctx, res.RefreshToken); err == nil {
+		t.Error("Rotate() without an active role should fail")
+	}
+	if NewToken(d).IsActive(ctx, "session-1") {
+		t.Error("the session without an active role should be revoked")
+	}
+}
+
+func TestSessionRole(t *testing.T) {
+	roles := []*admin.RoleInfo{{ID: 1}, {ID: 2}}
+	tests := []struct {
+		name          string
+		roles         []*admin.RoleInfo
+		sessionRoleID uint64
+		userRoleID    uint64
+		want          uint64
+		wantOk        bool
+	}{
+		{name: "role of the session", roles: roles, sessionRoleID: 2, userRoleID: 1, want: 2, wantOk: true},
+		{name: "session without role", roles: roles, sessionRoleID: 0, userRoleID: 2, want: 2, wantOk: true},
+		{name: "role of the session not active", roles: roles, sessionRoleID: 3, userRoleID: 2, want: 2, wantOk: true},
+		{name: "neither role active", roles: roles, sessionRoleID: 3, userRoleID: 4, want: 1, wantOk: true},
+		{name: "no active role", roles: nil, sessionRoleID: 1, userRoleID: 1, want: 0, wantOk: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := sessionRole(tt.roles, tt.sessionRoleID, tt.userRoleID)
+			if got != tt.want || ok != tt.wantOk {
+				t.Errorf("sessionRole() = %v, %v, want %v, %v", got, ok, tt.want, tt.wantOk)
+			}
+		})
+	}
+}
+
+func TestUser_UpdateUserStatus_otherTenant(t *testing.T) {
+	ctx := tenant.NewContext(context.Background(), tenant.DefaultID)
+	d := newTestData(t)
+	users := NewUser(d)
+	other, err := d.DBClient.Tenant.Create().SetName("other").Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	remote, err := d.DBClient.User.Create().SetUsername("bobby").SetPassword("-").SetNickname("bobby").
+		SetMobile("bobby").SetTenantID(other.ID).Save(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	newTestLogin(t, d, remote.ID, "remote-session")
+	remoteKey, err := d.DBClient.APIKey.Create().SetUserID(remote.ID).SetName("key").SetPrefix("fgo_x").
+		SetKeyHash("hash").SetScopes([]string{"GET /api/admin/test"}).SetExpiredAt(time.Now().AddDate(0, 0, 1)).
+		Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the user of another tenant is not found, its api keys and sessions are left alone
+	if err = users.UpdateUserStatus(ctx, remote.ID, 0); err == nil {
+		t.Error("UpdateUserStatus() of a user of another tenant should fail")
+	}
+	if err = users.ResetPassword(ctx, remote.ID, "Another-Passw0rd"); err == nil {
+		t.Error("ResetPassword() of a user of another tenant should fail")
+	}
+	keyEnt, err := d.DBClient.APIKey.Get(ctx, remoteKey.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if keyEnt.RevokedAt != nil {
+		t.Error("the api keys of a user of another tenant should not be revoked")
+	}
+	if !NewToken(d).IsActive(ctx, "remote-session") {
+		t.Error("the sessions of a user of another tenant should stay active")
+	}
+	remoteEnt, err := d.DBClient.User.Get(context.Background(), remote.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if remoteEnt.Status != 1 || remoteEnt.TokenVersion != remote.TokenVersion {
+		t.Errorf("the user of another tenant is changed, status %d token version %d", remoteEnt.Status, remoteEnt.TokenVersion)
+	}
+}
@@ -0,0 +1,313 @@
+/*
+ * Copyright 2023 FormulaGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ */
+
+package admin
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"formulago/biz/domain/admin"
+	"formulago/configs"
+	"formulago/data"
+	"formulago/data/ent"
+	"formulago/data/ent/user"
+	"formulago/data/ent/webauthncredential"
+	"formulago/pkg/encrypt"
+	"formulago/pkg/passkey"
+	"formulago/pkg/times"
+
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/webauthn"
+)
+
+// webauthnSessionExpire lifetime of a registration or login ceremony
+const webauthnSessionExpire = 5 * time.Minute
+
+type Webauthn struct {
+	Data   *data.Data
+	Config configs.Config
+}
+
+func NewWebauthn(data *data.Data, config configs.Config) admin.Webauthn {
+	return &Webauthn{
+		Data:   data,
+		Config: config,
+	}
+}
+
+func (w *Webauthn) BeginRegistration(ctx context.Context, userID uint64) (options string, err error) {
+	p, err := w.passkey()
+	if err != nil {
+		return "", err
+	}
+	userEnt, err := w.Data.DBClient.User.Query().Where(user.IDEQ(userID)).Only(ctx)
+	if err != nil {
+		return "", fmt.Errorf("get user failed: %w", err)
+	}
+	passkeyUser, err := w.passkeyUser(ctx, userEnt)
+	if err != nil {
+		return "", err
+	}
+	optionsBytes, session, err := p.BeginRegistration(passkeyUser)
+	if err != nil {
+		return "", err
+	}
+	err = w.Data.CacheSet(ctx, "webauthnRegister"+strconv.Itoa(int(userID)), string(session), webauthnSessionExpire)
+	if err != nil {
+		return "", fmt.Errorf("store registration session failed: %w", err)
+	}
+	return string(optionsBytes), nil
+}
+
+func (w *Webauthn) FinishRegistration(ctx context.Context, userID uint64, name, credential string) error {
+	p, err := w.passkey()
+	if err != nil {
+		return err
+	}
+	// the session can only be used once
+	key := "webauthnRegister" + strconv.Itoa(int(userID))
+	session, exist, err := w.Data.CacheGet(ctx, key)
+	if err != nil {
+		return fmt.Errorf("get registration session failed: %w", err)
+	}
+	if !exist {
+		return errors.New("passkey registration expired, please try again")
+	}
+	_ = w.Data.CacheDelete(ctx, key)
+
+	userEnt, err := w.Data.DBClient.User.Query().Where(user.IDEQ(userID)).Only(ctx)
+	if err != nil {
+		return fmt.Errorf("get user failed: %w", err)
+	}
+	passkeyUser, err := w.passkeyUser(ctx, userEnt)
+	if err != nil {
+		return err
+	}
+	cred, err := p.FinishRegistration(passkeyUser, []byte(session), []byte(credential))
+	if err != nil {
+		return err
+	}
+
+	if name == "" {
+		name = "Passkey " + strconv.Itoa(len(passkeyUser.Credentials)+1)
+	}
+	var transports []string
+	for _, t := range cred.Transport {
+		transports = append(transports, string(t))
+	}
+	_, err = w.Data.DBClient.WebauthnCredential.Create().
+		SetUserID(userID).
+		SetName(name).
+		SetCredentialID(base64.RawURLEncoding.EncodeToString(cred.ID)).
+		SetPublicKey(cred.PublicKey).
+		SetAttestationType(cred.AttestationType).
+		SetAaguid(cred.Authenticator.AAGUID).
+		SetSignCount(cred.Authenticator.SignCount).
+		SetTransports(transports).
+		SetFlags(uint8(cred.Flags.ProtocolValue())).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("create passkey failed: %w", err)
+	}
+	return nil
+}
+
+func (w *Webauthn) BeginLogin(ctx context.Context, username string) (res *admin.WebauthnLoginOptions, err error) {
+	p, err := w.passkey()
+	if err != nil {
+		return nil, err
+	}
+	// without username the authenticator chooses the account of a discoverable credential
+	var passkeyUser *passkey.User
+	if username != "" {
+		userEnt, err := w.Data.DBClient.User.Query().Where(user.UsernameEQ(username), user.Status(1)).Only(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return nil, fmt.Errorf("get user failed: %w", err)
+		}
+		if userEnt != nil {
+			passkeyUser, err = w.passkeyUser(ctx, userEnt)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if passkeyUser == nil || len(passkeyUser.Credentials) == 0 {
+			return nil, errors.New("no passkey registered for the user")
+		}
+	}
+	optionsBytes, session, err := p.BeginLogin(passkeyUser)
+	if err != nil {
+		return nil, err
+	}
+
+	sessionID, err := encrypt.RandomToken(32)
+	if err != nil {
+		return nil, fmt.Errorf("generate session id failed: %w", err)
+	}
+	err = w.Data.CacheSet(ctx, "webauthnLogin"+encrypt.SHA256Hex(sessionID), string(session), webauthnSessionExpire)
+	if err != nil {
+		return nil, fmt.Errorf("store login session failed: %w", err)
+	}
+	res = new(admin.WebauthnLoginOptions)
+	res.SessionID = sessionID
+	res.Options = string(optionsBytes)
+	return res, nil
+}
+
+func (w *Webauthn) FinishLogin(ctx context.Context, sessionID, credential string) (res *admin.LoginResp, err error) {
+	p, err := w.passkey()
+	if err != nil {
+		return nil, err
+	}
+	// the session can only be used once
+	key := "webauthnLogin" + encrypt.SHA256Hex(sessionID)
+	session, exist, err := w.Data.CacheGet(ctx, key)
+	if err != nil {
+		return nil, fmt.Errorf("get login session failed: %w", err)
+	}
+	if !exist {
+		return nil, errors.New("passkey login expired, please try again")
+	}
+	_ = w.Data.CacheDelete(ctx, key)
+
+	var userEnt *ent.User
+	passkeyUser, cred, err := p.FinishLogin([]byte(session), []byte(credential), func(userID uint64) (*passkey.User, error) {
+		var err error
+		userEnt, err = w.Data.DBClient.User.Query().Where(user.IDEQ(userID), user.Status(1)).Only(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("get active user failed: %w", err)
+		}
+		return w.passkeyUser(ctx, userEnt)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// keep the sign count to detect cloned authenticators
+	_, err = w.Data.DBClient.WebauthnCredential.Update().
+		Where(webauthncredential.CredentialIDEQ(base64.RawURLEncoding.EncodeToString(cred.ID)),
+			webauthncredential.UserIDEQ(passkeyUser.ID)).
+		SetSignCount(cred.Authenticator.SignCount).
+		SetLastUsedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("update passkey failed: %w", err)
+	}
+
+	res = new(admin.LoginResp)
+	res.Username = userEnt.Username
+	res.UserID = userEnt.ID
+	// get role info
+	res.RoleID = userEnt.RoleID
+	res.TokenVersion = userEnt.TokenVersion
+	res.TenantID = userEnt.TenantID
+	res.RoleName, res.RoleValue, err = (&Login{Data: w.Data}).getRoleInfo(ctx, userEnt.RoleID)
+	return res, err
+}
+
+func (w *Webauthn) List(ctx context.Context, userID uint64) (res []*admin.WebauthnCredentialInfo, err error) {
+	credentials, err := w.Data.DBClient.WebauthnCredential.Query().
+		Where(webauthncredential.UserIDEQ(userID)).
+		Order(ent.Asc(webauthncredential.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get passkey list failed: %w", err)
+	}
+	for _, v := range credentials {
+		info := &admin.WebauthnCredentialInfo{
+			ID:           v.ID,
+			CreatedAt:    v.CreatedAt.Format(times.TimeFormat),
+			Name:         v.Name,
+			CredentialID: v.CredentialID,
+			Transports:   v.Transports,
+			SignCount:    v.SignCount,
+		}
+		if v.LastUsedAt != nil {
+			info.LastUsedAt = v.LastUsedAt.Format(times.TimeFormat)
+		}
+		res = append(res, info)
+	}
+	return res, nil
+}
+
+func (w *Webauthn) Rename(ctx context.Context, userID, id uint64, name string) error {
+	n, err := w.Data.DBClient.WebauthnCredential.Update().
+		Where(webauthncredential.IDEQ(id), webauthncredential.UserIDEQ(userID)).
+		SetName(name).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("update passkey failed: %w", err)
+	}
+	if n == 0 {
+		return errors.New("passkey not found")
+	}
+	return nil
+}
+
+func (w *Webauthn) Delete(ctx context.Context, userID, id uint64) error {
+	n, err := w.Data.DBClient.WebauthnCredential.Delete().
+		Where(webauthncredential.IDEQ(id), webauthncredential.UserIDEQ(userID)).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("delete passkey failed: %w", err)
+	}
+	if n == 0 {
+		return errors.New("passkey not found")
+	}
+	return nil
+}
+
+func (w *Webauthn) passkey() (*passkey.Passkey, error) {
+	if len(w.Config.Webauthn.RPOrigins) == 0 {
+		return nil, errors.New("passkey login is not configured")
+	}
+	return passkey.New(passkey.Config{
+		RPID:          w.Config.Webauthn.RPID,
+		RPDisplayName: w.Config.Webauthn.RPDisplayName,
+		RPOrigins:     w.Config.Webauthn.RPOrigins,
+	})
+}
+
+// passkeyUser loads the stored credentials of the user
+func (w *Webauthn) passkeyUser(ctx context.Context, userEnt *ent.User) (*passkey.User, error) {
+	credentials, err := w.Data.DBClient.WebauthnCredential.Query().
+		Where(webauthncredential.UserIDEQ(userEnt.ID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get passkeys failed: %w", err)
+	}
+	res := &passkey.User{
+		ID:          userEnt.ID,
+		Name:        userEnt.Username,
+		DisplayName: userEnt.Nickname,
+	}
+	for _, v := range credentials {
+		id, err := base64.RawURLEncoding.DecodeString(v.CredentialID)
+		if err != nil {
+			return nil, fmt.Errorf("decode credential id failed: %w", err)
+		}
+		var transports []protocol.AuthenticatorTransport
+		for _, t := range v.Transports {
+			transports = append(transports, protocol.AuthenticatorTransport(t))
+		}
+		res.Credentials = append(res.Credentials, webauthn.Credential{
+			ID:              id,
+			PublicKey:       v.PublicKey,
+			AttestationType: v.AttestationType,
+			Transport:       transports,
+			Flags:           webauthn.NewCredentialFlags(protocol.AuthenticatorFlags(v.Flags)),
+			Authenticator: webauthn.Authenticator{
+				AAGUID:    v.Aaguid,
+				SignCount: v.SignCount,
+			},
+		})
+	}
+	return res, nil
+}
@@ -23,13 +23,16 @@ func Register(r *server.Hertz) {
 		_api.POST("/deleteStructTag", append(_deletestructtagMw(), admin.DeleteStructTag)...)
 		_api.GET("/health", append(_healthcheckMw(), admin.HealthCheck)...)
 		_api.GET("/initDatabase", append(_initdatabaseMw(), admin.InitDatabase)...)
-		_api.POST("/register", append(_registerMw(), admin.Register)...)
+		_api.POST("/register", append(_register0Mw(), admin.Register)...)
+		_register := _api.Group("/register", _registerMw()...)
+		_register.POST("/code", append(_sendregistercodeMw(), admin.SendRegisterCode)...)
 		_api.POST("/structToProto", append(_structtoprotoMw(), admin.StructToProto)...)
 		{
 			_admin := _api.Group("/admin", _adminMw()...)
 			_admin.DELETE("/api", append(_deleteapiMw(), admin.DeleteApi)...)
 			_api0 := _admin.Group("/api", _api0Mw()...)
 			_api0.POST("/list", append(_apilistMw(), admin.ApiList)...)
+			_admin.DELETE("/apikey", append(_revokeapikeyMw(), admin.RevokeAPIKey)...)
 			_admin.DELETE("/dict", append(_deletedictionaryMw(), admin.DeleteDictionary)...)
 			_dict := _admin.Group("/dict", _dictMw()...)
 			_dict.DELETE("/detail", append(_deletedictionarydetailMw(), admin.DeleteDictionaryDetail)...)
@@ -49,20 +52,73 @@ func Register(r *server.Hertz) {
 			_role := _admin.Group("/role", _roleMw()...)
 			_role.POST("/list", append(_rolelistMw(), admin.RoleList)...)
 			_role.POST("/status", append(_updaterolestatusMw(), admin.UpdateRoleStatus)...)
+			_role.GET("/tree", append(_roletreeMw(), admin.RoleTree)...)
 			_admin.GET("/role", append(_rolebyidMw(), admin.RoleByID)...)
 			_admin.DELETE("/token", append(_deletetokenMw(), admin.DeleteToken)...)
 			_token := _admin.Group("/token", _tokenMw()...)
 			_token.POST("/list", append(_tokenlistMw(), admin.TokenList)...)
 			_admin.DELETE("/user", append(_deleteuserMw(), admin.DeleteUser)...)
 			_user := _admin.Group("/user", _userMw()...)
+			_user.DELETE("/apikey", append(_revokemyapikeyMw(), admin.RevokeMyAPIKey)...)
+			_user.POST("/approve", append(_approveuserMw(), admin.ApproveUser)...)
+			_user.DELETE("/identity", append(_deleteuseridentityMw(), admin.DeleteUserIdentity)...)
+			_user.POST("/impersonate", append(_impersonate0Mw(), admin.Impersonate)...)
+			_impersonate := _user.Group("/impersonate", _impersonateMw()...)
+			_impersonate.POST("/end", append(_endimpersonationMw(), admin.EndImpersonation)...)
 			_user.GET("/profile", append(_userprofileMw(), admin.UserProfile)...)
 			_user.POST("/profile", append(_updateprofileMw(), admin.UpdateProfile)...)
 			_user.POST("/status", append(_updateuserstatusMw(), admin.UpdateUserStatus)...)
+			_user.POST("/unlock", append(_unlockloginMw(), admin.UnlockLogin)...)
+			_user.DELETE("/webauthn", append(_deletewebauthncredentialMw(), admin.DeleteWebauthnCredential)...)
+			{
+				__fa := _user.Group("/2fa", __faMw()...)
+				__fa.POST("/confirm", append(_confirmtwofactorMw(), admin.ConfirmTwoFactor)...)
+				__fa.POST("/enroll", append(_enrolltwofactorMw(), admin.EnrollTwoFactor)...)
+				__fa.POST("/reset", append(_resettwofactorMw(), admin.ResetTwoFactor)...)
+			}
+			{
+				_apikey := _user.Group("/apikey", _apikeyMw()...)
+				_apikey.POST("/create", append(_createapikeyMw(), admin.CreateAPIKey)...)
+				_apikey.POST("/list", append(_myapikeylistMw(), admin.MyAPIKeyList)...)
+			}
+			{
+				_identity := _user.Group("/identity", _identityMw()...)
+				_identity.POST("/link", append(_linkuseridentityMw(), admin.LinkUserIdentity)...)
+				_identity.GET("/list", append(_useridentitylistMw(), admin.UserIdentityList)...)
+			}
+			{
+				_login_event := _user.Group("/login-event", _login_eventMw()...)
+				_login_event.POST("/list", append(_mylogineventlistMw(), admin.MyLoginEventList)...)
+			}
+			{
+				_role0 := _user.Group("/role", _role0Mw()...)
+				_role0.POST("/switch", append(_switchroleMw(), admin.SwitchRole)...)
+			}
+			{
+				_session := _user.Group("/session", _sessionMw()...)
+				_session.POST("/list", append(_mysessionlistMw(), admin.MySessionList)...)
+				_session.POST("/revoke", append(_revokemysessionMw(), admin.RevokeMySession)...)
+				_session.POST("/revoke-others", append(_revokemyothersessionsMw(), admin.RevokeMyOtherSessions)...)
+			}
+			{
+				_webauthn := _user.Group("/webauthn", _webauthnMw()...)
+				_webauthn.GET("/list", append(_webauthncredentiallistMw(), admin.WebauthnCredentialList)...)
+				_webauthn.POST("/update", append(_updatewebauthncredentialMw(), admin.UpdateWebauthnCredential)...)
+				{
+					_register1 := _webauthn.Group("/register", _register1Mw()...)
+					_register1.POST("/begin", append(_beginwebauthnregistrationMw(), admin.BeginWebauthnRegistration)...)
+					_register1.POST("/finish", append(_finishwebauthnregistrationMw(), admin.FinishWebauthnRegistration)...)
+				}
+			}
 			{
 				_api1 := _admin.Group("/api", _api1Mw()...)
 				_api1.POST("/create", append(_createapiMw(), admin.CreateApi)...)
 				_api1.POST("/update", append(_updateapiMw(), admin.UpdateApi)...)
 			}
+			{
+				_apikey0 := _admin.Group("/apikey", _apikey0Mw()...)
+				_apikey0.POST("/list", append(_apikeylistMw(), admin.APIKeyList)...)
+			}
 			{
 				_authority := _admin.Group("/authority", _authorityMw()...)
 				{
@@ -83,6 +139,10 @@ func Register(r *server.Hertz) {
 				_dict0.POST("/create", append(_createdictionaryMw(), admin.CreateDictionary)...)
 				_dict0.POST("/update", append(_updatedictionaryMw(), admin.UpdateDictionary)...)
 			}
+			{
+				_login_event0 := _admin.Group("/login-event", _login_event0Mw()...)
+				_login_event0.POST("/list", append(_logineventlistMw(), admin.LoginEventList)...)
+			}
 			{
 				_logs := _admin.Group("/logs", _logsMw()...)
 				_logs.DELETE("/deleteAll", append(_deletelogsMw(), admin.DeleteLogs)...)
@@ -98,6 +158,7 @@ func Register(r *server.Hertz) {
 				_oauth.DELETE("/provider", append(_deleteproviderMw(), admin.DeleteProvider)...)
 				_provider := _oauth.Group("/provider", _providerMw()...)
 				_provider.POST("/list", append(_getproviderlistMw(), admin.GetProviderList)...)
+				_provider.GET("/templates", append(_getprovidertemplatesMw(), admin.GetProviderTemplates)...)
 				{
 					_provider0 := _oauth.Group("/provider", _provider0Mw()...)
 					_provider0.POST("/create", append(_createproviderMw(), admin.CreateProvider)...)
@@ -105,9 +166,46 @@ func Register(r *server.Hertz) {
 				}
 			}
 			{
-				_role0 := _admin.Group("/role", _role0Mw()...)
-				_role0.POST("/create", append(_createroleMw(), admin.CreateRole)...)
-				_role0.POST("/update", append(_updateroleMw(), admin.UpdateRole)...)
+				_oidc := _admin.Group("/oidc", _oidcMw()...)
+				_oidc.DELETE("/client", append(_deleteoidcclientMw(), admin.DeleteOIDCClient)...)
+				_oidc.GET("/consent", append(_oidcconsentinfoMw(), admin.OIDCConsentInfo)...)
+				_oidc.POST("/consent", append(_oidcconsentMw(), admin.OIDCConsent)...)
+				{
+					_client := _oidc.Group("/client", _clientMw()...)
+					_client.POST("/create", append(_createoidcclientMw(), admin.CreateOIDCClient)...)
+					_client.POST("/list", append(_oidcclientlistMw(), admin.OIDCClientList)...)
+					_client.POST("/update", append(_updateoidcclientMw(), admin.UpdateOIDCClient)...)
+				}
+			}
+			{
+				_role1 := _admin.Group("/role", _role1Mw()...)
+				_role1.POST("/create", append(_createroleMw(), admin.CreateRole)...)
+				_role1.POST("/update", append(_updateroleMw(), admin.UpdateRole)...)
+			}
+			{
+				_saml := _admin.Group("/saml", _samlMw()...)
+				_saml.POST("/link", append(_linksamlidentityMw(), admin.LinkSamlIdentity)...)
+				_saml.DELETE("/provider", append(_deletesamlproviderMw(), admin.DeleteSamlProvider)...)
+				_provider1 := _saml.Group("/provider", _provider1Mw()...)
+				_provider1.POST("/list", append(_samlproviderlistMw(), admin.SamlProviderList)...)
+				{
+					_provider2 := _saml.Group("/provider", _provider2Mw()...)
+					_provider2.POST("/create", append(_createsamlproviderMw(), admin.CreateSamlProvider)...)
+					_provider2.POST("/update", append(_updatesamlproviderMw(), admin.UpdateSamlProvider)...)
+				}
+			}
+			{
+				_session0 := _admin.Group("/session", _session0Mw()...)
+				_session0.POST("/list", append(_sessionlistMw(), admin.SessionList)...)
+				_session0.POST("/revoke", append(_revokesessionMw(), admin.RevokeSession)...)
+				_session0.POST("/revoke-user", append(_revokeusersessionsMw(), admin.RevokeUserSessions)...)
+			}
+			{
+				_tenant := _admin.Group("/tenant", _tenantMw()...)
+				_tenant.POST("/create", append(_createtenantMw(), admin.CreateTenant)...)
+				_tenant.POST("/list", append(_tenantlistMw(), admin.TenantList)...)
+				_tenant.POST("/status", append(_updatetenantstatusMw(), admin.UpdateTenantStatus)...)
+				_tenant.POST("/update", append(_updatetenantMw(), admin.UpdateTenant)...)
 			}
 			{
 				_token0 := _admin.Group("/token", _token0Mw()...)
@@ -128,5 +226,16 @@ func Register(r *server.Hertz) {
 			_oauth0.GET("/callback", append(_oauthcallbackMw(), admin.OauthCallback)...)
 			_oauth0.POST("/login", append(_oauthloginMw(), admin.OauthLogin)...)
 		}
+		{
+			_password := _api.Group("/password", _passwordMw()...)
+			_password.POST("/forgot", append(_forgotpasswordMw(), admin.ForgotPassword)...)
+			_password.POST("/reset", append(_resetpasswordMw(), admin.ResetPassword)...)
+		}
+		{
+			_saml0 := _api.Group("/saml", _saml0Mw()...)
+			_saml0.POST("/acs", append(_samlacsMw(), admin.SamlAcs)...)
+			_saml0.POST("/login", append(_samlloginMw(), admin.SamlLogin)...)
+			_saml0.GET("/metadata", append(_samlmetadataMw(), admin.SamlMetadata)...)
+		}
 	}
 }
@@ -41,8 +41,9 @@ func _registerMw() []app.HandlerFunc {
 
 func _adminMw() []app.HandlerFunc {
 	// your code...
-	// add jwt Auth middleware
-	return []app.HandlerFunc{middleware.GetJWTMiddleware(configs.Data(), data.Default(), data.CasbinEnforcer()).MiddlewareFunc(),
+	// add jwt Auth middleware, api keys are accepted as bearer tokens too
+	return []app.HandlerFunc{middleware.GetAuthMiddleware(configs.Data(), data.Default(), data.CasbinEnforcer()),
+		middleware.TenantMiddleware(),
 		middleware.LogsMiddleware(data.Default()),
 		middleware.ForbidOperation(configs.Data())}
 }
@@ -314,7 +315,8 @@ func _oauth0Mw() []app.HandlerFunc {
 
 func _oauthcallbackMw() []app.HandlerFunc {
 	// your code...
-	return nil
+	// the signed-in users complete the link of an account by the callback
+	return []app.HandlerFunc{middleware.GetOptionalAuthMiddleware(configs.Data(), data.Default(), data.CasbinEnforcer())}
 }
 
 func _oauthloginMw() []app.HandlerFunc {
@@ -416,3 +418,368 @@ func _deleteuserMw() []app.HandlerFunc {
 	// your code...
 	return nil
 }
+
+func __faMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _confirmtwofactorMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _enrolltwofactorMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _resettwofactorMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _deletewebauthncredentialMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _webauthnMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _webauthncredentiallistMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _updatewebauthncredentialMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _register0Mw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _beginwebauthnregistrationMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _finishwebauthnregistrationMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _unlockloginMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _passwordMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _forgotpasswordMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _resetpasswordMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _sendregistercodeMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _approveuserMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _register1Mw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _revokeapikeyMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _revokemyapikeyMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _apikeyMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _createapikeyMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _myapikeylistMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _apikey0Mw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _apikeylistMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _oidcMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _deleteoidcclientMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _oidcconsentinfoMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _oidcconsentMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _clientMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _createoidcclientMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _oidcclientlistMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _updateoidcclientMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getprovidertemplatesMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _deleteuseridentityMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _identityMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _linkuseridentityMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _useridentitylistMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _samlMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _linksamlidentityMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _provider1Mw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _deletesamlproviderMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _samlproviderlistMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _provider2Mw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _createsamlproviderMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _updatesamlproviderMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _saml0Mw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _samlacsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _samlloginMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _samlmetadataMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _impersonateMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _impersonate0Mw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _endimpersonationMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _sessionMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _mysessionlistMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _revokemysessionMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _revokemyothersessionsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _session0Mw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _sessionlistMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _revokesessionMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _revokeusersessionsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _login_eventMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _mylogineventlistMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _login_event0Mw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _logineventlistMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _tenantMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _createtenantMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _tenantlistMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _updatetenantstatusMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _updatetenantMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _switchroleMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _role1Mw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _roletreeMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
@@ -15,6 +15,8 @@ import (
 	"sync"
 	"sync/atomic"
 
+	"formulago/pkg/rolerule"
+
 	"github.com/cloudwego/hertz/pkg/common/hlog"
 	"github.com/knadh/koanf/parsers/yaml"
 	"github.com/knadh/koanf/providers/rawbytes"
@@ -125,6 +127,12 @@ func overrideSecretFromEnv(config *Config) {
 	if v := os.Getenv("WECOM_AES_KEY"); v != "" {
 		config.Wecom.EncodingAESKey = v
 	}
+	if v := os.Getenv("MAIL_PASSWORD"); v != "" {
+		config.Mail.Password = v
+	}
+	if v := os.Getenv("LDAP_BIND_PASSWORD"); v != "" {
+		config.LDAP.BindPassword = v
+	}
 	if v := os.Getenv("REDIS_PASSWORD"); v != "" {
 		config.Redis.Password = v
 	}
@@ -154,33 +162,186 @@ func FSConfigFileToBytes(fs embed.FS) ([]byte, error) {
 
 // Config is the configuration of the project.
 type Config struct {
-	Name     string     `yaml:"Name"`
-	IsDemo   bool       `yaml:"IsDemo"`
-	IsProd   bool       `yaml:"IsProd"`
-	Host     string     `yaml:"Host"`
-	Port     int        `yaml:"Port"`
-	Timeout  int        `yaml:"Timeout"`
-	Captcha  Captcha    `yaml:"Captcha"`
-	Auth     Auth       `yaml:"Auth"`
-	Redis    Redis      `yaml:"Redis"`
-	Database Database   `yaml:"Database"`
-	Casbin   CasbinConf `yaml:"Casbin"`
-	S3       S3         `yaml:"S3"`
-	Wecom    Wecom      `yaml:"Wecom"`
+	Name           string         `yaml:"Name"`
+	IsDemo         bool           `yaml:"IsDemo"`
+	IsProd         bool           `yaml:"IsProd"`
+	Host           string         `yaml:"Host"`
+	Port           int            `yaml:"Port"`
+	Timeout        int            `yaml:"Timeout"`
+	Captcha        Captcha        `yaml:"Captcha"`
+	Auth           Auth           `yaml:"Auth"`
+	Webauthn       Webauthn       `yaml:"Webauthn"`
+	LoginLimit     LoginLimit     `yaml:"LoginLimit"`
+	LoginEvent     LoginEvent     `yaml:"LoginEvent"`
+	PasswordPolicy PasswordPolicy `yaml:"PasswordPolicy"`
+	PasswordReset  PasswordReset  `yaml:"PasswordReset"`
+	Mail           Mail           `yaml:"Mail"`
+	Register       Register       `yaml:"Register"`
+	OIDC           OIDC           `yaml:"OIDC"`
+	LDAP           LDAP           `yaml:"LDAP"`
+	SAML           SAML           `yaml:"SAML"`
+	Impersonation  Impersonation  `yaml:"Impersonation"`
+	Redis          Redis          `yaml:"Redis"`
+	Database       Database       `yaml:"Database"`
+	Casbin         CasbinConf     `yaml:"Casbin"`
+	S3             S3             `yaml:"S3"`
+	Wecom          Wecom          `yaml:"Wecom"`
 }
 
 // Captcha is the configuration of the captcha.
 type Captcha struct {
-	KeyLong   int `yaml:"KeyLong"`
-	ImgWidth  int `yaml:"ImgWidth"`
-	ImgHeight int `yaml:"ImgHeight"`
+	// Type digit, string, math, chinese or audio, digit if empty
+	Type      string `yaml:"Type"`
+	KeyLong   int    `yaml:"KeyLong"`
+	ImgWidth  int    `yaml:"ImgWidth"`
+	ImgHeight int    `yaml:"ImgHeight"`
+	// Language of the audio captcha, en, ja, ru or zh
+	Language string `yaml:"Language"`
 }
 
 // Auth is the configuration of the auth.
 type Auth struct {
-	OAuthKey     string `yaml:"OAuthKey"`
-	AccessSecret string `yaml:"AccessSecret"`
-	AccessExpire int    `yaml:"AccessExpire"`
+	OAuthKey      string `yaml:"OAuthKey"`
+	AccessSecret  string `yaml:"AccessSecret"`
+	AccessExpire  int    `yaml:"AccessExpire"`
+	RefreshExpire int    `yaml:"RefreshExpire"`
+	// ActiveKid the kid of the signing key, tokens are signed by HS256 with AccessSecret if SigningKeys is empty
+	ActiveKid   string       `yaml:"ActiveKid"`
+	SigningKeys []SigningKey `yaml:"SigningKeys"`
+}
+
+// SigningKey is an asymmetric jwt signing key, a key without private key only verifies tokens.
+type SigningKey struct {
+	Kid            string `yaml:"Kid"`
+	Algorithm      string `yaml:"Algorithm"` // RS256, ES256 or EdDSA
+	PrivateKeyFile string `yaml:"PrivateKeyFile"`
+	PublicKeyFile  string `yaml:"PublicKeyFile"`
+}
+
+// Webauthn is the relying party of the passkey login.
+type Webauthn struct {
+	RPID          string   `yaml:"RPID"`
+	RPDisplayName string   `yaml:"RPDisplayName"`
+	RPOrigins     []string `yaml:"RPOrigins"`
+}
+
+// LoginLimit counts the failed logins per username and per IP, each attempt is delayed after DelayAfter failures
+// and the username or IP is locked for LockDuration when its max failures are reached.
+type LoginLimit struct {
+	Window              int   `yaml:"Window"` // seconds the failures are counted
+	UsernameMaxFailures int64 `yaml:"UsernameMaxFailures"`
+	IPMaxFailures       int64 `yaml:"IPMaxFailures"`
+	LockDuration        int   `yaml:"LockDuration"` // seconds
+	DelayAfter          int64 `yaml:"DelayAfter"`
+	BaseDelay           int   `yaml:"BaseDelay"` // milliseconds, doubled by every further failure
+	MaxDelay            int   `yaml:"MaxDelay"`  // milliseconds
+}
+
+// LoginEvent is the anomaly detection of the login history, an attempt is flagged
+// after FailureThreshold failures of its username or IP within FailureWindow.
+type LoginEvent struct {
+	FailureWindow    int `yaml:"FailureWindow"` // seconds
+	FailureThreshold int `yaml:"FailureThreshold"`
+}
+
+// PasswordPolicy is the strength, reuse and expiry rules of the user passwords.
+type PasswordPolicy struct {
+	MinLength     int  `yaml:"MinLength"`
+	RequireUpper  bool `yaml:"RequireUpper"`
+	RequireLower  bool `yaml:"RequireLower"`
+	RequireDigit  bool `yaml:"RequireDigit"`
+	RequireSymbol bool `yaml:"RequireSymbol"`
+	// BlocklistFile one password or SHA-1 per line, checked together with the built-in common passwords
+	BlocklistFile string `yaml:"BlocklistFile"`
+	History       int    `yaml:"History"` // previous passwords that cannot be reused
+	MaxAge        int    `yaml:"MaxAge"`  // days, 0 never expires
+}
+
+// PasswordReset is the self-service password reset, the link is sent by Mail.
+type PasswordReset struct {
+	// URL the reset page of the admin console, the token is appended as the token query parameter
+	URL    string `yaml:"URL"`
+	Expire int    `yaml:"Expire"` // seconds
+}
+
+// Mail is the SMTP server of the notification mails.
+type Mail struct {
+	Host     string `yaml:"Host"`
+	Port     int    `yaml:"Port"`
+	Username string `yaml:"Username"`
+	Password string `yaml:"Password"`
+	From     string `yaml:"From"`
+	TLS      string `yaml:"TLS"` // starttls, tls or none
+}
+
+// Register is the self-registration of users.
+type Register struct {
+	Enable        bool   `yaml:"Enable"`
+	DefaultRoleID uint64 `yaml:"DefaultRoleID"`
+	// Verify captcha, or email to verify the address by a mailed code
+	Verify string `yaml:"Verify"`
+	// Approval new users stay disabled until an admin approves them
+	Approval bool `yaml:"Approval"`
+}
+
+// OIDC is the OpenID Connect provider, clients are registered in the admin console.
+type OIDC struct {
+	Enable bool   `yaml:"Enable"`
+	Issuer string `yaml:"Issuer"`
+	// ConsentURL the consent page of the admin console, the request_id query parameter is appended
+	ConsentURL   string `yaml:"ConsentURL"`
+	CodeExpire   int    `yaml:"CodeExpire"`   // seconds
+	AccessExpire int    `yaml:"AccessExpire"` // seconds
+}
+
+// LDAP authenticates the password logins against the directory, the local accounts are the fallback.
+type LDAP struct {
+	Enable bool `yaml:"Enable"`
+	// URL ldap://host:389 or ldaps://host:636
+	URL                string `yaml:"URL"`
+	StartTLS           bool   `yaml:"StartTLS"`
+	InsecureSkipVerify bool   `yaml:"InsecureSkipVerify"`
+	// BindDN the service account searching the users and groups, empty binds anonymously
+	BindDN       string `yaml:"BindDN"`
+	BindPassword string `yaml:"BindPassword"`
+	BaseDN       string `yaml:"BaseDN"`
+	// UserFilter %s is the username, e.g. (uid=%s), or (sAMAccountName=%s) for Active Directory
+	UserFilter  string `yaml:"UserFilter"`
+	GroupBaseDN string `yaml:"GroupBaseDN"`
+	// GroupFilter %s is the DN of the user, e.g. (member=%s), the memberOf attribute is read if empty
+	GroupFilter string         `yaml:"GroupFilter"`
+	Attributes  LDAPAttributes `yaml:"Attributes"`
+	Timeout     int            `yaml:"Timeout"`
+	// DefaultRoleID the role of the new users no rule matches, their first login is rejected if 0
+	DefaultRoleID uint64 `yaml:"DefaultRoleID"`
+	// RoleRules the group and email domain rules deciding the role, synced at each login
+	RoleRules []rolerule.Rule `yaml:"RoleRules"`
+}
+
+// LDAPAttributes are the attributes synced into the users.
+type LDAPAttributes struct {
+	Username    string `yaml:"Username"`
+	Mail        string `yaml:"Mail"`
+	Mobile      string `yaml:"Mobile"`
+	DisplayName string `yaml:"DisplayName"`
+	GroupName   string `yaml:"GroupName"`
+}
+
+// SAML is the service provider of the SAML logins, the identity providers are managed in the admin console.
+type SAML struct {
+	// BaseURL the public base URL of this server, the metadata and ACS URLs of the providers are below it
+	BaseURL string `yaml:"BaseURL"`
+	// CertificateFile and PrivateKeyFile the RSA key pair signing the requests and decrypting the assertions, optional
+	CertificateFile string `yaml:"CertificateFile"`
+	PrivateKeyFile  string `yaml:"PrivateKeyFile"`
+}
+
+// Impersonation is the configuration of the admin logging in as another user.
+type Impersonation struct {
+	// Expire the lifetime of the impersonation token in seconds, it is not refreshable
+	Expire int `yaml:"Expire"`
+	// RoleID the role whose policies bound the actions of every impersonation, besides the role of the user
+	RoleID uint64 `yaml:"RoleID"`
 }
 
 // Redis is the configuration of the redis.
@@ -8,10 +8,13 @@ package data
 
 import (
 	"fmt"
+	"strings"
 
 	"formulago/configs"
+	"formulago/pkg/tenant"
 	"github.com/casbin/casbin/v3"
 	"github.com/casbin/casbin/v3/model"
+	"github.com/casbin/casbin/v3/persist"
 	entAdapter "github.com/casbin/ent-adapter"
 	"github.com/cloudwego/hertz/pkg/common/hlog"
 )
@@ -43,19 +46,19 @@ func newCasbin(config configs.Config) (enforcer *casbin.Enforcer, err error) {
 	if config.Casbin.ModelText == "" {
 		text = `
 		[request_definition]
-		r = sub, obj, act
+		r = sub, dom, obj, act
 		
 		[policy_definition]
-		p = sub, obj, act
+		p = sub, dom, obj, act
 		
 		[role_definition]
-		g = _, _
+		g = _, _, _
 		
 		[policy_effect]
 		e = some(where (p.eft == allow))
 		
 		[matchers]
-		m = r.sub == p.sub && keyMatch2(r.obj,p.obj) && r.act == p.act
+		m = g(r.sub, p.sub, r.dom) && r.dom == p.dom && keyMatch2(r.obj,p.obj) && r.act == p.act
 		`
 	} else {
 		text = config.Casbin.ModelText
@@ -66,6 +69,9 @@ func newCasbin(config configs.Config) (enforcer *casbin.Enforcer, err error) {
 		hlog.Error(err)
 		return
 	}
+	if !strings.Contains(m["m"]["m"].Value, "g(") {
+		hlog.Warn("the casbin matcher does not call g, child roles do not inherit the policies of their parents")
+	}
 
 	enforcer, err = casbin.NewEnforcer(m, adapter)
 	if err != nil {
@@ -79,5 +85,54 @@ func newCasbin(config configs.Config) (enforcer *casbin.Enforcer, err error) {
 		return
 	}
 
+	err = migrateDomainPolicies(enforcer, adapter)
+	if err != nil {
+		hlog.Error(err)
+		return
+	}
+
 	return
 }
+
+// legacyModelText the model before the tenant domains, its policies are "role, path, method"
+const legacyModelText = `
+[request_definition]
+r = sub, obj, act
+[policy_definition]
+p = sub, obj, act
+[policy_effect]
+e = some(where (p.eft == allow))
+[matchers]
+m = r.sub == p.sub && keyMatch2(r.obj,p.obj) && r.act == p.act
+`
+
+// migrateDomainPolicies moves the policies stored without domain to the domain of the default tenant,
+// which owns the roles created before the tenants. Each model only loads the policies of its size.
+func migrateDomainPolicies(enforcer *casbin.Enforcer, adapter persist.Adapter) error {
+	m, err := model.NewModelFromString(legacyModelText)
+	if err != nil {
+		return err
+	}
+	legacy, err := casbin.NewEnforcer(m, adapter)
+	if err != nil {
+		return err
+	}
+	policies, err := legacy.GetPolicy()
+	if err != nil || len(policies) == 0 {
+		return err
+	}
+
+	domain := tenant.Domain(tenant.DefaultID)
+	migrated := make([][]string, 0, len(policies))
+	for _, v := range policies {
+		migrated = append(migrated, []string{v[0], domain, v[1], v[2]})
+	}
+	if _, err = enforcer.AddPolicies(migrated); err != nil {
+		return fmt.Errorf("add domain policies failed: %w", err)
+	}
+	if _, err = legacy.RemovePolicies(policies); err != nil {
+		return fmt.Errorf("remove legacy policies failed: %w", err)
+	}
+	hlog.Info("casbin policies moved to the default tenant: ", len(migrated))
+	return nil
+}
@@ -11,10 +11,15 @@ package data
 import (
 	"context"
 	"errors"
+	"fmt"
 	"time"
 
 	"formulago/configs"
 	"formulago/data/ent"
+	// the hooks and interceptors of the schemas
+	_ "formulago/data/ent/runtime"
+	"formulago/data/ent/user"
+	"formulago/data/ent/useridentity"
 
 	"github.com/cloudwego/hertz/pkg/common/hlog"
 	"github.com/patrickmn/go-cache"
@@ -52,6 +57,14 @@ func NewData(config configs.Config) (data *Data, err error) {
 		return
 	}
 	data.DBClient = client
+	err = defaultTenant(client)
+	if err != nil {
+		return
+	}
+	err = wecomIdentities(client)
+	if err != nil {
+		return
+	}
 
 	if config.Redis.Enable {
 		rdb := newRedisDB(config)
@@ -64,6 +77,55 @@ func NewData(config configs.Config) (data *Data, err error) {
 	return data, nil
 }
 
+// defaultTenant creates the default tenant, which owns the data created before the tenants.
+// It is the first row of the table, the ID is not set so the sequences of all databases start after it.
+func defaultTenant(client *ent.Client) error {
+	ctx := context.Background()
+	exist, err := client.Tenant.Query().Exist(ctx)
+	if err != nil || exist {
+		return err
+	}
+	return client.Tenant.Create().
+		SetName("default").
+		SetRemark("默认租户").
+		Exec(ctx)
+}
+
+// wecomIdentities links the WeCom accounts saved on the users before the linked accounts to the users,
+// the oauth login only finds the users by the linked accounts. The users already linked are skipped, so it runs on every start.
+func wecomIdentities(client *ent.Client) error {
+	ctx := context.Background()
+	users, err := client.User.Query().
+		Where(user.WecomNEQ(""), user.Not(user.HasIdentitiesWith(useridentity.Provider("wecom")))).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("get users of wecom accounts failed: %w", err)
+	}
+	for _, v := range users {
+		// the account may be linked to another user by now, it is not moved
+		taken, err := client.UserIdentity.Query().
+			Where(useridentity.Provider("wecom"), useridentity.Subject(v.Wecom)).
+			Exist(ctx)
+		if err != nil {
+			return fmt.Errorf("get wecom identity failed: %w", err)
+		}
+		if taken {
+			hlog.Warn("the wecom account is linked to another user, skipped, userID: ", v.ID, " wecom: ", v.Wecom)
+			continue
+		}
+		err = client.UserIdentity.Create().
+			SetUserID(v.ID).
+			SetProvider("wecom").
+			SetSubject(v.Wecom).
+			SetUsername(v.Wecom).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("create wecom identity failed: %w", err)
+		}
+	}
+	return nil
+}
+
 // CacheSet . Set cache data to memory cache and redis(if enable)
 func (d *Data) CacheSet(ctx context.Context, k, v string, exp time.Duration) (err error) {
 	d.Cache.Set(k, v, exp)
@@ -106,6 +168,29 @@ func (d *Data) CacheGet(ctx context.Context, k string) (v string, exist bool, er
 	return "", false, nil
 }
 
+// CacheGetShared . Get cache data from redis if enable, otherwise from memory cache.
+// The memory cache is skipped with redis, so that a value changed or deleted by another instance is seen at once.
+func (d *Data) CacheGetShared(ctx context.Context, k string) (v string, exist bool, err error) {
+	if d.Redis == nil {
+		value, ok := d.Cache.Get(k)
+		if !ok {
+			return "", false, nil
+		}
+		v, ok = value.(string)
+		return v, ok, nil
+	}
+
+	v, err = d.Redis.Get(ctx, k).Result()
+	// redis.Nil is returned when the key does not exist
+	if errors.Is(err, redis.Nil) {
+		return "", false, nil
+	}
+	if err != nil {
+		return "", false, err
+	}
+	return v, true, nil
+}
+
 // CacheDelete . Delete cache data from memory cache and redis(if enable)
 func (d *Data) CacheDelete(ctx context.Context, k string) (err error) {
 	d.Cache.Delete(k)
@@ -118,3 +203,48 @@ func (d *Data) CacheDelete(ctx context.Context, k string) (err error) {
 	}
 	return nil
 }
+
+// CacheIncr . Increase the counter by 1 and return the new value, the expiration is set when the counter is created.
+// The counter only lives in redis if enabled, so that every instance shares the same value.
+func (d *Data) CacheIncr(ctx context.Context, k string, exp time.Duration) (n int64, err error) {
+	if d.Redis != nil {
+		n, err = d.Redis.Incr(ctx, k).Result()
+		if err != nil {
+			return 0, err
+		}
+		if n == 1 {
+			err = d.Redis.Expire(ctx, k, exp).Err()
+		}
+		return n, err
+	}
+
+	if err = d.Cache.Add(k, int64(1), exp); err == nil {
+		return 1, nil
+	}
+	return d.Cache.IncrementInt64(k, 1)
+}
+
+// CacheCounter . Get the counter set by CacheIncr and its remaining lifetime, 0 if not exist
+func (d *Data) CacheCounter(ctx context.Context, k string) (n int64, ttl time.Duration, err error) {
+	if d.Redis != nil {
+		n, err = d.Redis.Get(ctx, k).Int64()
+		if errors.Is(err, redis.Nil) {
+			return 0, 0, nil
+		}
+		if err != nil {
+			return 0, 0, err
+		}
+		ttl, err = d.Redis.TTL(ctx, k).Result()
+		return n, ttl, err
+	}
+
+	value, expiration, ok := d.Cache.GetWithExpiration(k)
+	if !ok {
+		return 0, 0, nil
+	}
+	n, _ = value.(int64)
+	if !expiration.IsZero() {
+		ttl = time.Until(expiration)
+	}
+	return n, ttl, nil
+}
@@ -0,0 +1,57 @@
+/*
+ * Copyright 2023 FormulaGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ */
+
+package data
+
+import (
+	"context"
+	"testing"
+
+	"formulago/data/ent/enttest"
+	"formulago/data/ent/useridentity"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestWecomIdentities(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, "sqlite3", "file:wecom?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	if err := defaultTenant(client); err != nil {
+		t.Fatal(err)
+	}
+	for _, v := range []struct{ username, wecom string }{{"alice", "alice.w"}, {"bob", "bob.w"}, {"carol", ""}} {
+		err := client.User.Create().SetUsername(v.username).SetPassword("-").SetNickname(v.username).
+			SetMobile(v.username).SetWecom(v.wecom).Exec(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	// the account of bob is linked already
+	err := client.UserIdentity.Create().SetUserID(2).SetProvider("wecom").SetSubject("bob.w").Exec(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// it runs on every start
+	for i := 0; i < 2; i++ {
+		if err = wecomIdentities(client); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	identities, err := client.UserIdentity.Query().Where(useridentity.Provider("wecom")).
+		Order(useridentity.ByUserID()).All(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(identities) != 2 {
+		t.Fatalf("got %d wecom identities, want 2", len(identities))
+	}
+	if identities[0].UserID != 1 || identities[0].Subject != "alice.w" || identities[1].UserID != 2 || identities[1].Subject != "bob.w" {
+		t.Errorf("unexpected wecom identities %+v %+v", identities[0], identities[1])
+	}
+}
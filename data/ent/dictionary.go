@@ -24,9 +24,11 @@ type Dictionary struct {
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
 	// status 1 normal 0 ban | 状态 1 正常 0 禁用
 	Status uint8 `json:"status,omitempty"`
+	// tenant ID | 租户ID
+	TenantID uint64 `json:"tenant_id,omitempty"`
 	// the title shown in the ui | 展示名称 （建议配合i18n）
 	Title string `json:"title,omitempty"`
-	// the name of dictionary for search | 字典搜索名称
+	// the name of dictionary for search, unique in the tenant | 字典搜索名称, 租户内唯一
 	Name string `json:"name,omitempty"`
 	// the description of dictionary | 字典描述
 	Description string `json:"description,omitempty"`
@@ -59,7 +61,7 @@ func (*Dictionary) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case dictionary.FieldID, dictionary.FieldStatus:
+		case dictionary.FieldID, dictionary.FieldStatus, dictionary.FieldTenantID:
 			values[i] = new(sql.NullInt64)
 		case dictionary.FieldTitle, dictionary.FieldName, dictionary.FieldDescription:
 			values[i] = new(sql.NullString)
@@ -104,6 +106,12 @@ func (_m *Dictionary) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Status = uint8(value.Int64)
 			}
+		case dictionary.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				_m.TenantID = uint64(value.Int64)
+			}
 		case dictionary.FieldTitle:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field title", values[i])
@@ -172,6 +180,9 @@ func (_m *Dictionary) String() string {
 	builder.WriteString("status=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Status))
 	builder.WriteString(", ")
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TenantID))
+	builder.WriteString(", ")
 	builder.WriteString("title=")
 	builder.WriteString(_m.Title)
 	builder.WriteString(", ")
@@ -63,6 +63,20 @@ func (_c *DictionaryCreate) SetNillableStatus(v *uint8) *DictionaryCreate {
 	return _c
 }
 
+// SetTenantID sets the "tenant_id" field.
+func (_c *DictionaryCreate) SetTenantID(v uint64) *DictionaryCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_c *DictionaryCreate) SetNillableTenantID(v *uint64) *DictionaryCreate {
+	if v != nil {
+		_c.SetTenantID(*v)
+	}
+	return _c
+}
+
 // SetTitle sets the "title" field.
 func (_c *DictionaryCreate) SetTitle(v string) *DictionaryCreate {
 	_c.mutation.SetTitle(v)
@@ -109,7 +123,9 @@ func (_c *DictionaryCreate) Mutation() *DictionaryMutation {
 
 // Save creates the Dictionary in the database.
 func (_c *DictionaryCreate) Save(ctx context.Context) (*Dictionary, error) {
-	_c.defaults()
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
@@ -136,12 +152,18 @@ func (_c *DictionaryCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_c *DictionaryCreate) defaults() {
+func (_c *DictionaryCreate) defaults() error {
 	if _, ok := _c.mutation.CreatedAt(); !ok {
+		if dictionary.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized dictionary.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
 		v := dictionary.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
 	}
 	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		if dictionary.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized dictionary.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := dictionary.DefaultUpdatedAt()
 		_c.mutation.SetUpdatedAt(v)
 	}
@@ -149,6 +171,11 @@ func (_c *DictionaryCreate) defaults() {
 		v := dictionary.DefaultStatus
 		_c.mutation.SetStatus(v)
 	}
+	if _, ok := _c.mutation.TenantID(); !ok {
+		v := dictionary.DefaultTenantID
+		_c.mutation.SetTenantID(v)
+	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -159,6 +186,9 @@ func (_c *DictionaryCreate) check() error {
 	if _, ok := _c.mutation.UpdatedAt(); !ok {
 		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Dictionary.updated_at"`)}
 	}
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "Dictionary.tenant_id"`)}
+	}
 	if _, ok := _c.mutation.Title(); !ok {
 		return &ValidationError{Name: "title", err: errors.New(`ent: missing required field "Dictionary.title"`)}
 	}
@@ -212,6 +242,10 @@ func (_c *DictionaryCreate) createSpec() (*Dictionary, *sqlgraph.CreateSpec) {
 		_spec.SetField(dictionary.FieldStatus, field.TypeUint8, value)
 		_node.Status = value
 	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(dictionary.FieldTenantID, field.TypeUint64, value)
+		_node.TenantID = value
+	}
 	if value, ok := _c.mutation.Title(); ok {
 		_spec.SetField(dictionary.FieldTitle, field.TypeString, value)
 		_node.Title = value
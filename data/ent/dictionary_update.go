@@ -147,7 +147,9 @@ func (_u *DictionaryUpdate) RemoveDictionaryDetails(v ...*DictionaryDetail) *Dic
 
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *DictionaryUpdate) Save(ctx context.Context) (int, error) {
-	_u.defaults()
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
@@ -174,11 +176,15 @@ func (_u *DictionaryUpdate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_u *DictionaryUpdate) defaults() {
+func (_u *DictionaryUpdate) defaults() error {
 	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if dictionary.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized dictionary.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := dictionary.UpdateDefaultUpdatedAt()
 		_u.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 func (_u *DictionaryUpdate) sqlSave(ctx context.Context) (_node int, err error) {
@@ -407,7 +413,9 @@ func (_u *DictionaryUpdateOne) Select(field string, fields ...string) *Dictionar
 
 // Save executes the query and returns the updated Dictionary entity.
 func (_u *DictionaryUpdateOne) Save(ctx context.Context) (*Dictionary, error) {
-	_u.defaults()
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
@@ -434,11 +442,15 @@ func (_u *DictionaryUpdateOne) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_u *DictionaryUpdateOne) defaults() {
+func (_u *DictionaryUpdateOne) defaults() error {
 	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if dictionary.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized dictionary.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := dictionary.UpdateDefaultUpdatedAt()
 		_u.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 func (_u *DictionaryUpdateOne) sqlSave(ctx context.Context) (_node *Dictionary, err error) {
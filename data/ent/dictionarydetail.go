@@ -25,6 +25,8 @@ type DictionaryDetail struct {
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
 	// status 1 normal 0 ban | 状态 1 正常 0 禁用
 	Status uint8 `json:"status,omitempty"`
+	// tenant ID | 租户ID
+	TenantID uint64 `json:"tenant_id,omitempty"`
 	// the title shown in the ui | 展示名称 （建议配合i18n）
 	Title string `json:"title,omitempty"`
 	// key | 键
@@ -64,7 +66,7 @@ func (*DictionaryDetail) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case dictionarydetail.FieldID, dictionarydetail.FieldStatus, dictionarydetail.FieldDictionaryID:
+		case dictionarydetail.FieldID, dictionarydetail.FieldStatus, dictionarydetail.FieldTenantID, dictionarydetail.FieldDictionaryID:
 			values[i] = new(sql.NullInt64)
 		case dictionarydetail.FieldTitle, dictionarydetail.FieldKey, dictionarydetail.FieldValue:
 			values[i] = new(sql.NullString)
@@ -109,6 +111,12 @@ func (_m *DictionaryDetail) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Status = uint8(value.Int64)
 			}
+		case dictionarydetail.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				_m.TenantID = uint64(value.Int64)
+			}
 		case dictionarydetail.FieldTitle:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field title", values[i])
@@ -183,6 +191,9 @@ func (_m *DictionaryDetail) String() string {
 	builder.WriteString("status=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Status))
 	builder.WriteString(", ")
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TenantID))
+	builder.WriteString(", ")
 	builder.WriteString("title=")
 	builder.WriteString(_m.Title)
 	builder.WriteString(", ")
@@ -70,6 +70,11 @@ func Status(v uint8) predicate.DictionaryDetail {
 	return predicate.DictionaryDetail(sql.FieldEQ(FieldStatus, v))
 }
 
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uint64) predicate.DictionaryDetail {
+	return predicate.DictionaryDetail(sql.FieldEQ(FieldTenantID, v))
+}
+
 // Title applies equality check predicate on the "title" field. It's identical to TitleEQ.
 func Title(v string) predicate.DictionaryDetail {
 	return predicate.DictionaryDetail(sql.FieldEQ(FieldTitle, v))
@@ -220,6 +225,46 @@ func StatusNotNil() predicate.DictionaryDetail {
 	return predicate.DictionaryDetail(sql.FieldNotNull(FieldStatus))
 }
 
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uint64) predicate.DictionaryDetail {
+	return predicate.DictionaryDetail(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uint64) predicate.DictionaryDetail {
+	return predicate.DictionaryDetail(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uint64) predicate.DictionaryDetail {
+	return predicate.DictionaryDetail(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uint64) predicate.DictionaryDetail {
+	return predicate.DictionaryDetail(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uint64) predicate.DictionaryDetail {
+	return predicate.DictionaryDetail(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uint64) predicate.DictionaryDetail {
+	return predicate.DictionaryDetail(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uint64) predicate.DictionaryDetail {
+	return predicate.DictionaryDetail(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uint64) predicate.DictionaryDetail {
+	return predicate.DictionaryDetail(sql.FieldLTE(FieldTenantID, v))
+}
+
 // TitleEQ applies the EQ predicate on the "title" field.
 func TitleEQ(v string) predicate.DictionaryDetail {
 	return predicate.DictionaryDetail(sql.FieldEQ(FieldTitle, v))
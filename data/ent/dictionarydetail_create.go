@@ -63,6 +63,20 @@ func (_c *DictionaryDetailCreate) SetNillableStatus(v *uint8) *DictionaryDetailC
 	return _c
 }
 
+// SetTenantID sets the "tenant_id" field.
+func (_c *DictionaryDetailCreate) SetTenantID(v uint64) *DictionaryDetailCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_c *DictionaryDetailCreate) SetNillableTenantID(v *uint64) *DictionaryDetailCreate {
+	if v != nil {
+		_c.SetTenantID(*v)
+	}
+	return _c
+}
+
 // SetTitle sets the "title" field.
 func (_c *DictionaryDetailCreate) SetTitle(v string) *DictionaryDetailCreate {
 	_c.mutation.SetTitle(v)
@@ -113,7 +127,9 @@ func (_c *DictionaryDetailCreate) Mutation() *DictionaryDetailMutation {
 
 // Save creates the DictionaryDetail in the database.
 func (_c *DictionaryDetailCreate) Save(ctx context.Context) (*DictionaryDetail, error) {
-	_c.defaults()
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
@@ -140,12 +156,18 @@ func (_c *DictionaryDetailCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_c *DictionaryDetailCreate) defaults() {
+func (_c *DictionaryDetailCreate) defaults() error {
 	if _, ok := _c.mutation.CreatedAt(); !ok {
+		if dictionarydetail.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized dictionarydetail.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
 		v := dictionarydetail.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
 	}
 	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		if dictionarydetail.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized dictionarydetail.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := dictionarydetail.DefaultUpdatedAt()
 		_c.mutation.SetUpdatedAt(v)
 	}
@@ -153,6 +175,11 @@ func (_c *DictionaryDetailCreate) defaults() {
 		v := dictionarydetail.DefaultStatus
 		_c.mutation.SetStatus(v)
 	}
+	if _, ok := _c.mutation.TenantID(); !ok {
+		v := dictionarydetail.DefaultTenantID
+		_c.mutation.SetTenantID(v)
+	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -163,6 +190,9 @@ func (_c *DictionaryDetailCreate) check() error {
 	if _, ok := _c.mutation.UpdatedAt(); !ok {
 		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "DictionaryDetail.updated_at"`)}
 	}
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "DictionaryDetail.tenant_id"`)}
+	}
 	if _, ok := _c.mutation.Title(); !ok {
 		return &ValidationError{Name: "title", err: errors.New(`ent: missing required field "DictionaryDetail.title"`)}
 	}
@@ -216,6 +246,10 @@ func (_c *DictionaryDetailCreate) createSpec() (*DictionaryDetail, *sqlgraph.Cre
 		_spec.SetField(dictionarydetail.FieldStatus, field.TypeUint8, value)
 		_node.Status = value
 	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(dictionarydetail.FieldTenantID, field.TypeUint64, value)
+		_node.TenantID = value
+	}
 	if value, ok := _c.mutation.Title(); ok {
 		_spec.SetField(dictionarydetail.FieldTitle, field.TypeString, value)
 		_node.Title = value
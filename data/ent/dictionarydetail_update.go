@@ -142,7 +142,9 @@ func (_u *DictionaryDetailUpdate) ClearDictionary() *DictionaryDetailUpdate {
 
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *DictionaryDetailUpdate) Save(ctx context.Context) (int, error) {
-	_u.defaults()
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
@@ -169,11 +171,15 @@ func (_u *DictionaryDetailUpdate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_u *DictionaryDetailUpdate) defaults() {
+func (_u *DictionaryDetailUpdate) defaults() error {
 	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if dictionarydetail.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized dictionarydetail.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := dictionarydetail.UpdateDefaultUpdatedAt()
 		_u.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 func (_u *DictionaryDetailUpdate) sqlSave(ctx context.Context) (_node int, err error) {
@@ -381,7 +387,9 @@ func (_u *DictionaryDetailUpdateOne) Select(field string, fields ...string) *Dic
 
 // Save executes the query and returns the updated DictionaryDetail entity.
 func (_u *DictionaryDetailUpdateOne) Save(ctx context.Context) (*DictionaryDetail, error) {
-	_u.defaults()
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
@@ -408,11 +416,15 @@ func (_u *DictionaryDetailUpdateOne) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_u *DictionaryDetailUpdateOne) defaults() {
+func (_u *DictionaryDetailUpdateOne) defaults() error {
 	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if dictionarydetail.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized dictionarydetail.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := dictionarydetail.UpdateDefaultUpdatedAt()
 		_u.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 func (_u *DictionaryDetailUpdateOne) sqlSave(ctx context.Context) (_node *DictionaryDetail, err error) {
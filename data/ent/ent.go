@@ -7,14 +7,23 @@ import (
 	"errors"
 	"fmt"
 	"formulago/data/ent/api"
+	"formulago/data/ent/apikey"
 	"formulago/data/ent/dictionary"
 	"formulago/data/ent/dictionarydetail"
+	"formulago/data/ent/loginevent"
 	"formulago/data/ent/logs"
 	"formulago/data/ent/menu"
 	"formulago/data/ent/oauthprovider"
+	"formulago/data/ent/oidcclient"
+	"formulago/data/ent/oidcconsent"
+	"formulago/data/ent/refreshtoken"
 	"formulago/data/ent/role"
+	"formulago/data/ent/samlprovider"
+	"formulago/data/ent/tenant"
 	"formulago/data/ent/token"
 	"formulago/data/ent/user"
+	"formulago/data/ent/useridentity"
+	"formulago/data/ent/webauthncredential"
 	"reflect"
 	"sync"
 
@@ -81,15 +90,24 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			api.Table:              api.ValidColumn,
-			dictionary.Table:       dictionary.ValidColumn,
-			dictionarydetail.Table: dictionarydetail.ValidColumn,
-			logs.Table:             logs.ValidColumn,
-			menu.Table:             menu.ValidColumn,
-			oauthprovider.Table:    oauthprovider.ValidColumn,
-			role.Table:             role.ValidColumn,
-			token.Table:            token.ValidColumn,
-			user.Table:             user.ValidColumn,
+			api.Table:                api.ValidColumn,
+			apikey.Table:             apikey.ValidColumn,
+			dictionary.Table:         dictionary.ValidColumn,
+			dictionarydetail.Table:   dictionarydetail.ValidColumn,
+			loginevent.Table:         loginevent.ValidColumn,
+			logs.Table:               logs.ValidColumn,
+			menu.Table:               menu.ValidColumn,
+			oauthprovider.Table:      oauthprovider.ValidColumn,
+			oidcclient.Table:         oidcclient.ValidColumn,
+			oidcconsent.Table:        oidcconsent.ValidColumn,
+			refreshtoken.Table:       refreshtoken.ValidColumn,
+			role.Table:               role.ValidColumn,
+			samlprovider.Table:       samlprovider.ValidColumn,
+			tenant.Table:             tenant.ValidColumn,
+			token.Table:              token.ValidColumn,
+			user.Table:               user.ValidColumn,
+			useridentity.Table:       useridentity.ValidColumn,
+			webauthncredential.Table: webauthncredential.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
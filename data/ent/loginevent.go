@@ -0,0 +1,246 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"formulago/data/ent/loginevent"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// LoginEvent is the model entity for the LoginEvent schema.
+type LoginEvent struct {
+	config `json:"-"`
+	// ID of the ent.
+	// primary key
+	ID uint64 `json:"id,omitempty"`
+	// created time
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// last update time
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// tenant ID | 租户ID
+	TenantID uint64 `json:"tenant_id,omitempty"`
+	// ID of the user, 0 if the user is unknown | 用户ID, 未知用户为0
+	UserID uint64 `json:"user_id,omitempty"`
+	// username of the attempt | 尝试登录的用户名
+	Username string `json:"username,omitempty"`
+	// login method such as password, webauthn, api_key or the OAuth provider | 登录方式, 如password, webauthn, api_key或第三方平台
+	Method string `json:"method,omitempty"`
+	// client IP | 登录IP
+	IP string `json:"ip,omitempty"`
+	// client user agent | 客户端UA
+	UserAgent string `json:"user_agent,omitempty"`
+	// device label | 登录设备
+	Device string `json:"device,omitempty"`
+	// whether the login succeeded | 是否登录成功
+	Success bool `json:"success,omitempty"`
+	// failure reason | 失败原因
+	Reason string `json:"reason,omitempty"`
+	// anomalies: new_ip, new_device or many_failures | 异常标记: 新IP, 新设备或多次失败
+	Flags []string `json:"flags,omitempty"`
+	// whether any anomaly is flagged | 是否存在异常
+	Anomalous    bool `json:"anomalous,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*LoginEvent) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case loginevent.FieldFlags:
+			values[i] = new([]byte)
+		case loginevent.FieldSuccess, loginevent.FieldAnomalous:
+			values[i] = new(sql.NullBool)
+		case loginevent.FieldID, loginevent.FieldTenantID, loginevent.FieldUserID:
+			values[i] = new(sql.NullInt64)
+		case loginevent.FieldUsername, loginevent.FieldMethod, loginevent.FieldIP, loginevent.FieldUserAgent, loginevent.FieldDevice, loginevent.FieldReason:
+			values[i] = new(sql.NullString)
+		case loginevent.FieldCreatedAt, loginevent.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the LoginEvent fields.
+func (_m *LoginEvent) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case loginevent.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = uint64(value.Int64)
+		case loginevent.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case loginevent.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case loginevent.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				_m.TenantID = uint64(value.Int64)
+			}
+		case loginevent.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = uint64(value.Int64)
+			}
+		case loginevent.FieldUsername:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field username", values[i])
+			} else if value.Valid {
+				_m.Username = value.String
+			}
+		case loginevent.FieldMethod:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field method", values[i])
+			} else if value.Valid {
+				_m.Method = value.String
+			}
+		case loginevent.FieldIP:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ip", values[i])
+			} else if value.Valid {
+				_m.IP = value.String
+			}
+		case loginevent.FieldUserAgent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_agent", values[i])
+			} else if value.Valid {
+				_m.UserAgent = value.String
+			}
+		case loginevent.FieldDevice:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field device", values[i])
+			} else if value.Valid {
+				_m.Device = value.String
+			}
+		case loginevent.FieldSuccess:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field success", values[i])
+			} else if value.Valid {
+				_m.Success = value.Bool
+			}
+		case loginevent.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				_m.Reason = value.String
+			}
+		case loginevent.FieldFlags:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field flags", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Flags); err != nil {
+					return fmt.Errorf("unmarshal field flags: %w", err)
+				}
+			}
+		case loginevent.FieldAnomalous:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field anomalous", values[i])
+			} else if value.Valid {
+				_m.Anomalous = value.Bool
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the LoginEvent.
+// This includes values selected through modifiers, order, etc.
+func (_m *LoginEvent) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this LoginEvent.
+// Note that you need to call LoginEvent.Unwrap() before calling this method if this LoginEvent
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *LoginEvent) Update() *LoginEventUpdateOne {
+	return NewLoginEventClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the LoginEvent entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *LoginEvent) Unwrap() *LoginEvent {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: LoginEvent is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *LoginEvent) String() string {
+	var builder strings.Builder
+	builder.WriteString("LoginEvent(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("username=")
+	builder.WriteString(_m.Username)
+	builder.WriteString(", ")
+	builder.WriteString("method=")
+	builder.WriteString(_m.Method)
+	builder.WriteString(", ")
+	builder.WriteString("ip=")
+	builder.WriteString(_m.IP)
+	builder.WriteString(", ")
+	builder.WriteString("user_agent=")
+	builder.WriteString(_m.UserAgent)
+	builder.WriteString(", ")
+	builder.WriteString("device=")
+	builder.WriteString(_m.Device)
+	builder.WriteString(", ")
+	builder.WriteString("success=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Success))
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(_m.Reason)
+	builder.WriteString(", ")
+	builder.WriteString("flags=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Flags))
+	builder.WriteString(", ")
+	builder.WriteString("anomalous=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Anomalous))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// LoginEvents is a parsable slice of LoginEvent.
+type LoginEvents []*LoginEvent
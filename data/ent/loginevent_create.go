@@ -0,0 +1,466 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"formulago/data/ent/loginevent"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// LoginEventCreate is the builder for creating a LoginEvent entity.
+type LoginEventCreate struct {
+	config
+	mutation *LoginEventMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *LoginEventCreate) SetCreatedAt(v time.Time) *LoginEventCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *LoginEventCreate) SetNillableCreatedAt(v *time.Time) *LoginEventCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *LoginEventCreate) SetUpdatedAt(v time.Time) *LoginEventCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *LoginEventCreate) SetNillableUpdatedAt(v *time.Time) *LoginEventCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *LoginEventCreate) SetTenantID(v uint64) *LoginEventCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_c *LoginEventCreate) SetNillableTenantID(v *uint64) *LoginEventCreate {
+	if v != nil {
+		_c.SetTenantID(*v)
+	}
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *LoginEventCreate) SetUserID(v uint64) *LoginEventCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_c *LoginEventCreate) SetNillableUserID(v *uint64) *LoginEventCreate {
+	if v != nil {
+		_c.SetUserID(*v)
+	}
+	return _c
+}
+
+// SetUsername sets the "username" field.
+func (_c *LoginEventCreate) SetUsername(v string) *LoginEventCreate {
+	_c.mutation.SetUsername(v)
+	return _c
+}
+
+// SetNillableUsername sets the "username" field if the given value is not nil.
+func (_c *LoginEventCreate) SetNillableUsername(v *string) *LoginEventCreate {
+	if v != nil {
+		_c.SetUsername(*v)
+	}
+	return _c
+}
+
+// SetMethod sets the "method" field.
+func (_c *LoginEventCreate) SetMethod(v string) *LoginEventCreate {
+	_c.mutation.SetMethod(v)
+	return _c
+}
+
+// SetIP sets the "ip" field.
+func (_c *LoginEventCreate) SetIP(v string) *LoginEventCreate {
+	_c.mutation.SetIP(v)
+	return _c
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (_c *LoginEventCreate) SetNillableIP(v *string) *LoginEventCreate {
+	if v != nil {
+		_c.SetIP(*v)
+	}
+	return _c
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (_c *LoginEventCreate) SetUserAgent(v string) *LoginEventCreate {
+	_c.mutation.SetUserAgent(v)
+	return _c
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (_c *LoginEventCreate) SetNillableUserAgent(v *string) *LoginEventCreate {
+	if v != nil {
+		_c.SetUserAgent(*v)
+	}
+	return _c
+}
+
+// SetDevice sets the "device" field.
+func (_c *LoginEventCreate) SetDevice(v string) *LoginEventCreate {
+	_c.mutation.SetDevice(v)
+	return _c
+}
+
+// SetNillableDevice sets the "device" field if the given value is not nil.
+func (_c *LoginEventCreate) SetNillableDevice(v *string) *LoginEventCreate {
+	if v != nil {
+		_c.SetDevice(*v)
+	}
+	return _c
+}
+
+// SetSuccess sets the "success" field.
+func (_c *LoginEventCreate) SetSuccess(v bool) *LoginEventCreate {
+	_c.mutation.SetSuccess(v)
+	return _c
+}
+
+// SetReason sets the "reason" field.
+func (_c *LoginEventCreate) SetReason(v string) *LoginEventCreate {
+	_c.mutation.SetReason(v)
+	return _c
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_c *LoginEventCreate) SetNillableReason(v *string) *LoginEventCreate {
+	if v != nil {
+		_c.SetReason(*v)
+	}
+	return _c
+}
+
+// SetFlags sets the "flags" field.
+func (_c *LoginEventCreate) SetFlags(v []string) *LoginEventCreate {
+	_c.mutation.SetFlags(v)
+	return _c
+}
+
+// SetAnomalous sets the "anomalous" field.
+func (_c *LoginEventCreate) SetAnomalous(v bool) *LoginEventCreate {
+	_c.mutation.SetAnomalous(v)
+	return _c
+}
+
+// SetNillableAnomalous sets the "anomalous" field if the given value is not nil.
+func (_c *LoginEventCreate) SetNillableAnomalous(v *bool) *LoginEventCreate {
+	if v != nil {
+		_c.SetAnomalous(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *LoginEventCreate) SetID(v uint64) *LoginEventCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the LoginEventMutation object of the builder.
+func (_c *LoginEventCreate) Mutation() *LoginEventMutation {
+	return _c.mutation
+}
+
+// Save creates the LoginEvent in the database.
+func (_c *LoginEventCreate) Save(ctx context.Context) (*LoginEvent, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *LoginEventCreate) SaveX(ctx context.Context) *LoginEvent {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LoginEventCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LoginEventCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *LoginEventCreate) defaults() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		if loginevent.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized loginevent.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := loginevent.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		if loginevent.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized loginevent.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := loginevent.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.TenantID(); !ok {
+		v := loginevent.DefaultTenantID
+		_c.mutation.SetTenantID(v)
+	}
+	if _, ok := _c.mutation.UserID(); !ok {
+		v := loginevent.DefaultUserID
+		_c.mutation.SetUserID(v)
+	}
+	if _, ok := _c.mutation.Username(); !ok {
+		v := loginevent.DefaultUsername
+		_c.mutation.SetUsername(v)
+	}
+	if _, ok := _c.mutation.IP(); !ok {
+		v := loginevent.DefaultIP
+		_c.mutation.SetIP(v)
+	}
+	if _, ok := _c.mutation.UserAgent(); !ok {
+		v := loginevent.DefaultUserAgent
+		_c.mutation.SetUserAgent(v)
+	}
+	if _, ok := _c.mutation.Device(); !ok {
+		v := loginevent.DefaultDevice
+		_c.mutation.SetDevice(v)
+	}
+	if _, ok := _c.mutation.Reason(); !ok {
+		v := loginevent.DefaultReason
+		_c.mutation.SetReason(v)
+	}
+	if _, ok := _c.mutation.Anomalous(); !ok {
+		v := loginevent.DefaultAnomalous
+		_c.mutation.SetAnomalous(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *LoginEventCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "LoginEvent.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "LoginEvent.updated_at"`)}
+	}
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "LoginEvent.tenant_id"`)}
+	}
+	if _, ok := _c.mutation.Method(); !ok {
+		return &ValidationError{Name: "method", err: errors.New(`ent: missing required field "LoginEvent.method"`)}
+	}
+	if _, ok := _c.mutation.Success(); !ok {
+		return &ValidationError{Name: "success", err: errors.New(`ent: missing required field "LoginEvent.success"`)}
+	}
+	if _, ok := _c.mutation.Anomalous(); !ok {
+		return &ValidationError{Name: "anomalous", err: errors.New(`ent: missing required field "LoginEvent.anomalous"`)}
+	}
+	return nil
+}
+
+func (_c *LoginEventCreate) sqlSave(ctx context.Context) (*LoginEvent, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint64(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *LoginEventCreate) createSpec() (*LoginEvent, *sqlgraph.CreateSpec) {
+	var (
+		_node = &LoginEvent{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(loginevent.Table, sqlgraph.NewFieldSpec(loginevent.FieldID, field.TypeUint64))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(loginevent.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(loginevent.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(loginevent.FieldTenantID, field.TypeUint64, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(loginevent.FieldUserID, field.TypeUint64, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.Username(); ok {
+		_spec.SetField(loginevent.FieldUsername, field.TypeString, value)
+		_node.Username = value
+	}
+	if value, ok := _c.mutation.Method(); ok {
+		_spec.SetField(loginevent.FieldMethod, field.TypeString, value)
+		_node.Method = value
+	}
+	if value, ok := _c.mutation.IP(); ok {
+		_spec.SetField(loginevent.FieldIP, field.TypeString, value)
+		_node.IP = value
+	}
+	if value, ok := _c.mutation.UserAgent(); ok {
+		_spec.SetField(loginevent.FieldUserAgent, field.TypeString, value)
+		_node.UserAgent = value
+	}
+	if value, ok := _c.mutation.Device(); ok {
+		_spec.SetField(loginevent.FieldDevice, field.TypeString, value)
+		_node.Device = value
+	}
+	if value, ok := _c.mutation.Success(); ok {
+		_spec.SetField(loginevent.FieldSuccess, field.TypeBool, value)
+		_node.Success = value
+	}
+	if value, ok := _c.mutation.Reason(); ok {
+		_spec.SetField(loginevent.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if value, ok := _c.mutation.Flags(); ok {
+		_spec.SetField(loginevent.FieldFlags, field.TypeJSON, value)
+		_node.Flags = value
+	}
+	if value, ok := _c.mutation.Anomalous(); ok {
+		_spec.SetField(loginevent.FieldAnomalous, field.TypeBool, value)
+		_node.Anomalous = value
+	}
+	return _node, _spec
+}
+
+// LoginEventCreateBulk is the builder for creating many LoginEvent entities in bulk.
+type LoginEventCreateBulk struct {
+	config
+	err      error
+	builders []*LoginEventCreate
+}
+
+// Save creates the LoginEvent entities in the database.
+func (_c *LoginEventCreateBulk) Save(ctx context.Context) ([]*LoginEvent, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*LoginEvent, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LoginEventMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *LoginEventCreateBulk) SaveX(ctx context.Context) []*LoginEvent {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LoginEventCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LoginEventCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,717 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"formulago/data/ent/loginevent"
+	"formulago/data/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// LoginEventUpdate is the builder for updating LoginEvent entities.
+type LoginEventUpdate struct {
+	config
+	hooks    []Hook
+	mutation *LoginEventMutation
+}
+
+// Where appends a list predicates to the LoginEventUpdate builder.
+func (_u *LoginEventUpdate) Where(ps ...predicate.LoginEvent) *LoginEventUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *LoginEventUpdate) SetUpdatedAt(v time.Time) *LoginEventUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *LoginEventUpdate) SetUserID(v uint64) *LoginEventUpdate {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *LoginEventUpdate) SetNillableUserID(v *uint64) *LoginEventUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *LoginEventUpdate) AddUserID(v int64) *LoginEventUpdate {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (_u *LoginEventUpdate) ClearUserID() *LoginEventUpdate {
+	_u.mutation.ClearUserID()
+	return _u
+}
+
+// SetUsername sets the "username" field.
+func (_u *LoginEventUpdate) SetUsername(v string) *LoginEventUpdate {
+	_u.mutation.SetUsername(v)
+	return _u
+}
+
+// SetNillableUsername sets the "username" field if the given value is not nil.
+func (_u *LoginEventUpdate) SetNillableUsername(v *string) *LoginEventUpdate {
+	if v != nil {
+		_u.SetUsername(*v)
+	}
+	return _u
+}
+
+// ClearUsername clears the value of the "username" field.
+func (_u *LoginEventUpdate) ClearUsername() *LoginEventUpdate {
+	_u.mutation.ClearUsername()
+	return _u
+}
+
+// SetMethod sets the "method" field.
+func (_u *LoginEventUpdate) SetMethod(v string) *LoginEventUpdate {
+	_u.mutation.SetMethod(v)
+	return _u
+}
+
+// SetNillableMethod sets the "method" field if the given value is not nil.
+func (_u *LoginEventUpdate) SetNillableMethod(v *string) *LoginEventUpdate {
+	if v != nil {
+		_u.SetMethod(*v)
+	}
+	return _u
+}
+
+// SetIP sets the "ip" field.
+func (_u *LoginEventUpdate) SetIP(v string) *LoginEventUpdate {
+	_u.mutation.SetIP(v)
+	return _u
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (_u *LoginEventUpdate) SetNillableIP(v *string) *LoginEventUpdate {
+	if v != nil {
+		_u.SetIP(*v)
+	}
+	return _u
+}
+
+// ClearIP clears the value of the "ip" field.
+func (_u *LoginEventUpdate) ClearIP() *LoginEventUpdate {
+	_u.mutation.ClearIP()
+	return _u
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (_u *LoginEventUpdate) SetUserAgent(v string) *LoginEventUpdate {
+	_u.mutation.SetUserAgent(v)
+	return _u
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (_u *LoginEventUpdate) SetNillableUserAgent(v *string) *LoginEventUpdate {
+	if v != nil {
+		_u.SetUserAgent(*v)
+	}
+	return _u
+}
+
+// ClearUserAgent clears the value of the "user_agent" field.
+func (_u *LoginEventUpdate) ClearUserAgent() *LoginEventUpdate {
+	_u.mutation.ClearUserAgent()
+	return _u
+}
+
+// SetDevice sets the "device" field.
+func (_u *LoginEventUpdate) SetDevice(v string) *LoginEventUpdate {
+	_u.mutation.SetDevice(v)
+	return _u
+}
+
+// SetNillableDevice sets the "device" field if the given value is not nil.
+func (_u *LoginEventUpdate) SetNillableDevice(v *string) *LoginEventUpdate {
+	if v != nil {
+		_u.SetDevice(*v)
+	}
+	return _u
+}
+
+// ClearDevice clears the value of the "device" field.
+func (_u *LoginEventUpdate) ClearDevice() *LoginEventUpdate {
+	_u.mutation.ClearDevice()
+	return _u
+}
+
+// SetSuccess sets the "success" field.
+func (_u *LoginEventUpdate) SetSuccess(v bool) *LoginEventUpdate {
+	_u.mutation.SetSuccess(v)
+	return _u
+}
+
+// SetNillableSuccess sets the "success" field if the given value is not nil.
+func (_u *LoginEventUpdate) SetNillableSuccess(v *bool) *LoginEventUpdate {
+	if v != nil {
+		_u.SetSuccess(*v)
+	}
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *LoginEventUpdate) SetReason(v string) *LoginEventUpdate {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *LoginEventUpdate) SetNillableReason(v *string) *LoginEventUpdate {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// ClearReason clears the value of the "reason" field.
+func (_u *LoginEventUpdate) ClearReason() *LoginEventUpdate {
+	_u.mutation.ClearReason()
+	return _u
+}
+
+// SetFlags sets the "flags" field.
+func (_u *LoginEventUpdate) SetFlags(v []string) *LoginEventUpdate {
+	_u.mutation.SetFlags(v)
+	return _u
+}
+
+// AppendFlags appends value to the "flags" field.
+func (_u *LoginEventUpdate) AppendFlags(v []string) *LoginEventUpdate {
+	_u.mutation.AppendFlags(v)
+	return _u
+}
+
+// ClearFlags clears the value of the "flags" field.
+func (_u *LoginEventUpdate) ClearFlags() *LoginEventUpdate {
+	_u.mutation.ClearFlags()
+	return _u
+}
+
+// SetAnomalous sets the "anomalous" field.
+func (_u *LoginEventUpdate) SetAnomalous(v bool) *LoginEventUpdate {
+	_u.mutation.SetAnomalous(v)
+	return _u
+}
+
+// SetNillableAnomalous sets the "anomalous" field if the given value is not nil.
+func (_u *LoginEventUpdate) SetNillableAnomalous(v *bool) *LoginEventUpdate {
+	if v != nil {
+		_u.SetAnomalous(*v)
+	}
+	return _u
+}
+
+// Mutation returns the LoginEventMutation object of the builder.
+func (_u *LoginEventUpdate) Mutation() *LoginEventMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *LoginEventUpdate) Save(ctx context.Context) (int, error) {
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LoginEventUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *LoginEventUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LoginEventUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *LoginEventUpdate) defaults() error {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if loginevent.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized loginevent.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := loginevent.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+func (_u *LoginEventUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(loginevent.Table, loginevent.Columns, sqlgraph.NewFieldSpec(loginevent.FieldID, field.TypeUint64))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(loginevent.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(loginevent.FieldUserID, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(loginevent.FieldUserID, field.TypeUint64, value)
+	}
+	if _u.mutation.UserIDCleared() {
+		_spec.ClearField(loginevent.FieldUserID, field.TypeUint64)
+	}
+	if value, ok := _u.mutation.Username(); ok {
+		_spec.SetField(loginevent.FieldUsername, field.TypeString, value)
+	}
+	if _u.mutation.UsernameCleared() {
+		_spec.ClearField(loginevent.FieldUsername, field.TypeString)
+	}
+	if value, ok := _u.mutation.Method(); ok {
+		_spec.SetField(loginevent.FieldMethod, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.IP(); ok {
+		_spec.SetField(loginevent.FieldIP, field.TypeString, value)
+	}
+	if _u.mutation.IPCleared() {
+		_spec.ClearField(loginevent.FieldIP, field.TypeString)
+	}
+	if value, ok := _u.mutation.UserAgent(); ok {
+		_spec.SetField(loginevent.FieldUserAgent, field.TypeString, value)
+	}
+	if _u.mutation.UserAgentCleared() {
+		_spec.ClearField(loginevent.FieldUserAgent, field.TypeString)
+	}
+	if value, ok := _u.mutation.Device(); ok {
+		_spec.SetField(loginevent.FieldDevice, field.TypeString, value)
+	}
+	if _u.mutation.DeviceCleared() {
+		_spec.ClearField(loginevent.FieldDevice, field.TypeString)
+	}
+	if value, ok := _u.mutation.Success(); ok {
+		_spec.SetField(loginevent.FieldSuccess, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(loginevent.FieldReason, field.TypeString, value)
+	}
+	if _u.mutation.ReasonCleared() {
+		_spec.ClearField(loginevent.FieldReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.Flags(); ok {
+		_spec.SetField(loginevent.FieldFlags, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedFlags(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, loginevent.FieldFlags, value)
+		})
+	}
+	if _u.mutation.FlagsCleared() {
+		_spec.ClearField(loginevent.FieldFlags, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Anomalous(); ok {
+		_spec.SetField(loginevent.FieldAnomalous, field.TypeBool, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{loginevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// LoginEventUpdateOne is the builder for updating a single LoginEvent entity.
+type LoginEventUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *LoginEventMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *LoginEventUpdateOne) SetUpdatedAt(v time.Time) *LoginEventUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *LoginEventUpdateOne) SetUserID(v uint64) *LoginEventUpdateOne {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *LoginEventUpdateOne) SetNillableUserID(v *uint64) *LoginEventUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *LoginEventUpdateOne) AddUserID(v int64) *LoginEventUpdateOne {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (_u *LoginEventUpdateOne) ClearUserID() *LoginEventUpdateOne {
+	_u.mutation.ClearUserID()
+	return _u
+}
+
+// SetUsername sets the "username" field.
+func (_u *LoginEventUpdateOne) SetUsername(v string) *LoginEventUpdateOne {
+	_u.mutation.SetUsername(v)
+	return _u
+}
+
+// SetNillableUsername sets the "username" field if the given value is not nil.
+func (_u *LoginEventUpdateOne) SetNillableUsername(v *string) *LoginEventUpdateOne {
+	if v != nil {
+		_u.SetUsername(*v)
+	}
+	return _u
+}
+
+// ClearUsername clears the value of the "username" field.
+func (_u *LoginEventUpdateOne) ClearUsername() *LoginEventUpdateOne {
+	_u.mutation.ClearUsername()
+	return _u
+}
+
+// SetMethod sets the "method" field.
+func (_u *LoginEventUpdateOne) SetMethod(v string) *LoginEventUpdateOne {
+	_u.mutation.SetMethod(v)
+	return _u
+}
+
+// SetNillableMethod sets the "method" field if the given value is not nil.
+func (_u *LoginEventUpdateOne) SetNillableMethod(v *string) *LoginEventUpdateOne {
+	if v != nil {
+		_u.SetMethod(*v)
+	}
+	return _u
+}
+
+// SetIP sets the "ip" field.
+func (_u *LoginEventUpdateOne) SetIP(v string) *LoginEventUpdateOne {
+	_u.mutation.SetIP(v)
+	return _u
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (_u *LoginEventUpdateOne) SetNillableIP(v *string) *LoginEventUpdateOne {
+	if v != nil {
+		_u.SetIP(*v)
+	}
+	return _u
+}
+
+// ClearIP clears the value of the "ip" field.
+func (_u *LoginEventUpdateOne) ClearIP() *LoginEventUpdateOne {
+	_u.mutation.ClearIP()
+	return _u
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (_u *LoginEventUpdateOne) SetUserAgent(v string) *LoginEventUpdateOne {
+	_u.mutation.SetUserAgent(v)
+	return _u
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (_u *LoginEventUpdateOne) SetNillableUserAgent(v *string) *LoginEventUpdateOne {
+	if v != nil {
+		_u.SetUserAgent(*v)
+	}
+	return _u
+}
+
+// ClearUserAgent clears the value of the "user_agent" field.
+func (_u *LoginEventUpdateOne) ClearUserAgent() *LoginEventUpdateOne {
+	_u.mutation.ClearUserAgent()
+	return _u
+}
+
+// SetDevice sets the "device" field.
+func (_u *LoginEventUpdateOne) SetDevice(v string) *LoginEventUpdateOne {
+	_u.mutation.SetDevice(v)
+	return _u
+}
+
+// SetNillableDevice sets the "device" field if the given value is not nil.
+func (_u *LoginEventUpdateOne) SetNillableDevice(v *string) *LoginEventUpdateOne {
+	if v != nil {
+		_u.SetDevice(*v)
+	}
+	return _u
+}
+
+// ClearDevice clears the value of the "device" field.
+func (_u *LoginEventUpdateOne) ClearDevice() *LoginEventUpdateOne {
+	_u.mutation.ClearDevice()
+	return _u
+}
+
+// SetSuccess sets the "success" field.
+func (_u *LoginEventUpdateOne) SetSuccess(v bool) *LoginEventUpdateOne {
+	_u.mutation.SetSuccess(v)
+	return _u
+}
+
+// SetNillableSuccess sets the "success" field if the given value is not nil.
+func (_u *LoginEventUpdateOne) SetNillableSuccess(v *bool) *LoginEventUpdateOne {
+	if v != nil {
+		_u.SetSuccess(*v)
+	}
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *LoginEventUpdateOne) SetReason(v string) *LoginEventUpdateOne {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *LoginEventUpdateOne) SetNillableReason(v *string) *LoginEventUpdateOne {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// ClearReason clears the value of the "reason" field.
+func (_u *LoginEventUpdateOne) ClearReason() *LoginEventUpdateOne {
+	_u.mutation.ClearReason()
+	return _u
+}
+
+// SetFlags sets the "flags" field.
+func (_u *LoginEventUpdateOne) SetFlags(v []string) *LoginEventUpdateOne {
+	_u.mutation.SetFlags(v)
+	return _u
+}
+
+// AppendFlags appends value to the "flags" field.
+func (_u *LoginEventUpdateOne) AppendFlags(v []string) *LoginEventUpdateOne {
+	_u.mutation.AppendFlags(v)
+	return _u
+}
+
+// ClearFlags clears the value of the "flags" field.
+func (_u *LoginEventUpdateOne) ClearFlags() *LoginEventUpdateOne {
+	_u.mutation.ClearFlags()
+	return _u
+}
+
+// SetAnomalous sets the "anomalous" field.
+func (_u *LoginEventUpdateOne) SetAnomalous(v bool) *LoginEventUpdateOne {
+	_u.mutation.SetAnomalous(v)
+	return _u
+}
+
+// SetNillableAnomalous sets the "anomalous" field if the given value is not nil.
+func (_u *LoginEventUpdateOne) SetNillableAnomalous(v *bool) *LoginEventUpdateOne {
+	if v != nil {
+		_u.SetAnomalous(*v)
+	}
+	return _u
+}
+
+// Mutation returns the LoginEventMutation object of the builder.
+func (_u *LoginEventUpdateOne) Mutation() *LoginEventMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the LoginEventUpdate builder.
+func (_u *LoginEventUpdateOne) Where(ps ...predicate.LoginEvent) *LoginEventUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *LoginEventUpdateOne) Select(field string, fields ...string) *LoginEventUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated LoginEvent entity.
+func (_u *LoginEventUpdateOne) Save(ctx context.Context) (*LoginEvent, error) {
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LoginEventUpdateOne) SaveX(ctx context.Context) *LoginEvent {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *LoginEventUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LoginEventUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *LoginEventUpdateOne) defaults() error {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if loginevent.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized loginevent.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := loginevent.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+func (_u *LoginEventUpdateOne) sqlSave(ctx context.Context) (_node *LoginEvent, err error) {
+	_spec := sqlgraph.NewUpdateSpec(loginevent.Table, loginevent.Columns, sqlgraph.NewFieldSpec(loginevent.FieldID, field.TypeUint64))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "LoginEvent.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, loginevent.FieldID)
+		for _, f := range fields {
+			if !loginevent.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != loginevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(loginevent.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(loginevent.FieldUserID, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(loginevent.FieldUserID, field.TypeUint64, value)
+	}
+	if _u.mutation.UserIDCleared() {
+		_spec.ClearField(loginevent.FieldUserID, field.TypeUint64)
+	}
+	if value, ok := _u.mutation.Username(); ok {
+		_spec.SetField(loginevent.FieldUsername, field.TypeString, value)
+	}
+	if _u.mutation.UsernameCleared() {
+		_spec.ClearField(loginevent.FieldUsername, field.TypeString)
+	}
+	if value, ok := _u.mutation.Method(); ok {
+		_spec.SetField(loginevent.FieldMethod, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.IP(); ok {
+		_spec.SetField(loginevent.FieldIP, field.TypeString, value)
+	}
+	if _u.mutation.IPCleared() {
+		_spec.ClearField(loginevent.FieldIP, field.TypeString)
+	}
+	if value, ok := _u.mutation.UserAgent(); ok {
+		_spec.SetField(loginevent.FieldUserAgent, field.TypeString, value)
+	}
+	if _u.mutation.UserAgentCleared() {
+		_spec.ClearField(loginevent.FieldUserAgent, field.TypeString)
+	}
+	if value, ok := _u.mutation.Device(); ok {
+		_spec.SetField(loginevent.FieldDevice, field.TypeString, value)
+	}
+	if _u.mutation.DeviceCleared() {
+		_spec.ClearField(loginevent.FieldDevice, field.TypeString)
+	}
+	if value, ok := _u.mutation.Success(); ok {
+		_spec.SetField(loginevent.FieldSuccess, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(loginevent.FieldReason, field.TypeString, value)
+	}
+	if _u.mutation.ReasonCleared() {
+		_spec.ClearField(loginevent.FieldReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.Flags(); ok {
+		_spec.SetField(loginevent.FieldFlags, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedFlags(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, loginevent.FieldFlags, value)
+		})
+	}
+	if _u.mutation.FlagsCleared() {
+		_spec.ClearField(loginevent.FieldFlags, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Anomalous(); ok {
+		_spec.SetField(loginevent.FieldAnomalous, field.TypeBool, value)
+	}
+	_node = &LoginEvent{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{loginevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -22,6 +22,8 @@ type Logs struct {
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// last update time
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// tenant ID | 租户ID
+	TenantID uint64 `json:"tenant_id,omitempty"`
 	// type of log | 日志类型
 	Type string `json:"type,omitempty"`
 	// method of log | 日志请求方法
@@ -40,6 +42,8 @@ type Logs struct {
 	UserAgent string `json:"user_agent,omitempty"`
 	// operator of log | 日志操作者
 	Operator string `json:"operator,omitempty"`
+	// user impersonated by the operator | 操作者模拟登录的用户
+	ImpersonatedUser string `json:"impersonated_user,omitempty"`
 	// time of log(millisecond) | 日志时间(毫秒)
 	Time         int `json:"time,omitempty"`
 	selectValues sql.SelectValues
@@ -52,9 +56,9 @@ func (*Logs) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case logs.FieldSuccess:
 			values[i] = new(sql.NullBool)
-		case logs.FieldID, logs.FieldTime:
+		case logs.FieldID, logs.FieldTenantID, logs.FieldTime:
 			values[i] = new(sql.NullInt64)
-		case logs.FieldType, logs.FieldMethod, logs.FieldAPI, logs.FieldReqContent, logs.FieldRespContent, logs.FieldIP, logs.FieldUserAgent, logs.FieldOperator:
+		case logs.FieldType, logs.FieldMethod, logs.FieldAPI, logs.FieldReqContent, logs.FieldRespContent, logs.FieldIP, logs.FieldUserAgent, logs.FieldOperator, logs.FieldImpersonatedUser:
 			values[i] = new(sql.NullString)
 		case logs.FieldCreatedAt, logs.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -91,6 +95,12 @@ func (_m *Logs) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.UpdatedAt = value.Time
 			}
+		case logs.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				_m.TenantID = uint64(value.Int64)
+			}
 		case logs.FieldType:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field type", values[i])
@@ -145,6 +155,12 @@ func (_m *Logs) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Operator = value.String
 			}
+		case logs.FieldImpersonatedUser:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field impersonated_user", values[i])
+			} else if value.Valid {
+				_m.ImpersonatedUser = value.String
+			}
 		case logs.FieldTime:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field time", values[i])
@@ -193,6 +209,9 @@ func (_m *Logs) String() string {
 	builder.WriteString("updated_at=")
 	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TenantID))
+	builder.WriteString(", ")
 	builder.WriteString("type=")
 	builder.WriteString(_m.Type)
 	builder.WriteString(", ")
@@ -220,6 +239,9 @@ func (_m *Logs) String() string {
 	builder.WriteString("operator=")
 	builder.WriteString(_m.Operator)
 	builder.WriteString(", ")
+	builder.WriteString("impersonated_user=")
+	builder.WriteString(_m.ImpersonatedUser)
+	builder.WriteString(", ")
 	builder.WriteString("time=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Time))
 	builder.WriteByte(')')
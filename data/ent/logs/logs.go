@@ -5,6 +5,7 @@ package logs
 import (
 	"time"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 )
 
@@ -17,6 +18,8 @@ const (
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
 	FieldUpdatedAt = "updated_at"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
 	// FieldType holds the string denoting the type field in the database.
 	FieldType = "type"
 	// FieldMethod holds the string denoting the method field in the database.
@@ -35,6 +38,8 @@ const (
 	FieldUserAgent = "user_agent"
 	// FieldOperator holds the string denoting the operator field in the database.
 	FieldOperator = "operator"
+	// FieldImpersonatedUser holds the string denoting the impersonated_user field in the database.
+	FieldImpersonatedUser = "impersonated_user"
 	// FieldTime holds the string denoting the time field in the database.
 	FieldTime = "time"
 	// Table holds the table name of the logs in the database.
@@ -46,6 +51,7 @@ var Columns = []string{
 	FieldID,
 	FieldCreatedAt,
 	FieldUpdatedAt,
+	FieldTenantID,
 	FieldType,
 	FieldMethod,
 	FieldAPI,
@@ -55,6 +61,7 @@ var Columns = []string{
 	FieldIP,
 	FieldUserAgent,
 	FieldOperator,
+	FieldImpersonatedUser,
 	FieldTime,
 }
 
@@ -68,13 +75,22 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "formulago/data/ent/runtime"
 var (
+	Hooks        [1]ent.Hook
+	Interceptors [1]ent.Interceptor
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
 	DefaultUpdatedAt func() time.Time
 	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
 	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultTenantID holds the default value on creation for the "tenant_id" field.
+	DefaultTenantID uint64
 )
 
 // OrderOption defines the ordering options for the Logs queries.
@@ -95,6 +111,11 @@ func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
 }
 
+// ByTenantID orders the results by the tenant_id field.
+func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
+}
+
 // ByType orders the results by the type field.
 func ByType(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldType, opts...).ToFunc()
@@ -140,6 +161,11 @@ func ByOperator(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldOperator, opts...).ToFunc()
 }
 
+// ByImpersonatedUser orders the results by the impersonated_user field.
+func ByImpersonatedUser(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldImpersonatedUser, opts...).ToFunc()
+}
+
 // ByTime orders the results by the time field.
 func ByTime(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldTime, opts...).ToFunc()
@@ -64,6 +64,11 @@ func UpdatedAt(v time.Time) predicate.Logs {
 	return predicate.Logs(sql.FieldEQ(FieldUpdatedAt, v))
 }
 
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uint64) predicate.Logs {
+	return predicate.Logs(sql.FieldEQ(FieldTenantID, v))
+}
+
 // Type applies equality check predicate on the "type" field. It's identical to TypeEQ.
 func Type(v string) predicate.Logs {
 	return predicate.Logs(sql.FieldEQ(FieldType, v))
@@ -109,6 +114,11 @@ func Operator(v string) predicate.Logs {
 	return predicate.Logs(sql.FieldEQ(FieldOperator, v))
 }
 
+// ImpersonatedUser applies equality check predicate on the "impersonated_user" field. It's identical to ImpersonatedUserEQ.
+func ImpersonatedUser(v string) predicate.Logs {
+	return predicate.Logs(sql.FieldEQ(FieldImpersonatedUser, v))
+}
+
 // Time applies equality check predicate on the "time" field. It's identical to TimeEQ.
 func Time(v int) predicate.Logs {
 	return predicate.Logs(sql.FieldEQ(FieldTime, v))
@@ -194,6 +204,46 @@ func UpdatedAtLTE(v time.Time) predicate.Logs {
 	return predicate.Logs(sql.FieldLTE(FieldUpdatedAt, v))
 }
 
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uint64) predicate.Logs {
+	return predicate.Logs(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uint64) predicate.Logs {
+	return predicate.Logs(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uint64) predicate.Logs {
+	return predicate.Logs(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uint64) predicate.Logs {
+	return predicate.Logs(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uint64) predicate.Logs {
+	return predicate.Logs(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uint64) predicate.Logs {
+	return predicate.Logs(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uint64) predicate.Logs {
+	return predicate.Logs(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uint64) predicate.Logs {
+	return predicate.Logs(sql.FieldLTE(FieldTenantID, v))
+}
+
 // TypeEQ applies the EQ predicate on the "type" field.
 func TypeEQ(v string) predicate.Logs {
 	return predicate.Logs(sql.FieldEQ(FieldType, v))
@@ -774,6 +824,81 @@ func OperatorContainsFold(v string) predicate.Logs {
 	return predicate.Logs(sql.FieldContainsFold(FieldOperator, v))
 }
 
+// ImpersonatedUserEQ applies the EQ predicate on the "impersonated_user" field.
+func ImpersonatedUserEQ(v string) predicate.Logs {
+	return predicate.Logs(sql.FieldEQ(FieldImpersonatedUser, v))
+}
+
+// ImpersonatedUserNEQ applies the NEQ predicate on the "impersonated_user" field.
+func ImpersonatedUserNEQ(v string) predicate.Logs {
+	return predicate.Logs(sql.FieldNEQ(FieldImpersonatedUser, v))
+}
+
+// ImpersonatedUserIn applies the In predicate on the "impersonated_user" field.
+func ImpersonatedUserIn(vs ...string) predicate.Logs {
+	return predicate.Logs(sql.FieldIn(FieldImpersonatedUser, vs...))
+}
+
+// ImpersonatedUserNotIn applies the NotIn predicate on the "impersonated_user" field.
+func ImpersonatedUserNotIn(vs ...string) predicate.Logs {
+	return predicate.Logs(sql.FieldNotIn(FieldImpersonatedUser, vs...))
+}
+
+// ImpersonatedUserGT applies the GT predicate on the "impersonated_user" field.
+func ImpersonatedUserGT(v string) predicate.Logs {
+	return predicate.Logs(sql.FieldGT(FieldImpersonatedUser, v))
+}
+
+// ImpersonatedUserGTE applies the GTE predicate on the "impersonated_user" field.
+func ImpersonatedUserGTE(v string) predicate.Logs {
+	return predicate.Logs(sql.FieldGTE(FieldImpersonatedUser, v))
+}
+
+// ImpersonatedUserLT applies the LT predicate on the "impersonated_user" field.
+func ImpersonatedUserLT(v string) predicate.Logs {
+	return predicate.Logs(sql.FieldLT(FieldImpersonatedUser, v))
+}
+
+// ImpersonatedUserLTE applies the LTE predicate on the "impersonated_user" field.
+func ImpersonatedUserLTE(v string) predicate.Logs {
+	return predicate.Logs(sql.FieldLTE(FieldImpersonatedUser, v))
+}
+
+// ImpersonatedUserContains applies the Contains predicate on the "impersonated_user" field.
+func ImpersonatedUserContains(v string) predicate.Logs {
+	return predicate.Logs(sql.FieldContains(FieldImpersonatedUser, v))
+}
+
+// ImpersonatedUserHasPrefix applies the HasPrefix predicate on the "impersonated_user" field.
+func ImpersonatedUserHasPrefix(v string) predicate.Logs {
+	return predicate.Logs(sql.FieldHasPrefix(FieldImpersonatedUser, v))
+}
+
+// ImpersonatedUserHasSuffix applies the HasSuffix predicate on the "impersonated_user" field.
+func ImpersonatedUserHasSuffix(v string) predicate.Logs {
+	return predicate.Logs(sql.FieldHasSuffix(FieldImpersonatedUser, v))
+}
+
+// ImpersonatedUserIsNil applies the IsNil predicate on the "impersonated_user" field.
+func ImpersonatedUserIsNil() predicate.Logs {
+	return predicate.Logs(sql.FieldIsNull(FieldImpersonatedUser))
+}
+
+// ImpersonatedUserNotNil applies the NotNil predicate on the "impersonated_user" field.
+func ImpersonatedUserNotNil() predicate.Logs {
+	return predicate.Logs(sql.FieldNotNull(FieldImpersonatedUser))
+}
+
+// ImpersonatedUserEqualFold applies the EqualFold predicate on the "impersonated_user" field.
+func ImpersonatedUserEqualFold(v string) predicate.Logs {
+	return predicate.Logs(sql.FieldEqualFold(FieldImpersonatedUser, v))
+}
+
+// ImpersonatedUserContainsFold applies the ContainsFold predicate on the "impersonated_user" field.
+func ImpersonatedUserContainsFold(v string) predicate.Logs {
+	return predicate.Logs(sql.FieldContainsFold(FieldImpersonatedUser, v))
+}
+
 // TimeEQ applies the EQ predicate on the "time" field.
 func TimeEQ(v int) predicate.Logs {
 	return predicate.Logs(sql.FieldEQ(FieldTime, v))
@@ -48,6 +48,20 @@ func (_c *LogsCreate) SetNillableUpdatedAt(v *time.Time) *LogsCreate {
 	return _c
 }
 
+// SetTenantID sets the "tenant_id" field.
+func (_c *LogsCreate) SetTenantID(v uint64) *LogsCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_c *LogsCreate) SetNillableTenantID(v *uint64) *LogsCreate {
+	if v != nil {
+		_c.SetTenantID(*v)
+	}
+	return _c
+}
+
 // SetType sets the "type" field.
 func (_c *LogsCreate) SetType(v string) *LogsCreate {
 	_c.mutation.SetType(v)
@@ -142,6 +156,20 @@ func (_c *LogsCreate) SetNillableOperator(v *string) *LogsCreate {
 	return _c
 }
 
+// SetImpersonatedUser sets the "impersonated_user" field.
+func (_c *LogsCreate) SetImpersonatedUser(v string) *LogsCreate {
+	_c.mutation.SetImpersonatedUser(v)
+	return _c
+}
+
+// SetNillableImpersonatedUser sets the "impersonated_user" field if the given value is not nil.
+func (_c *LogsCreate) SetNillableImpersonatedUser(v *string) *LogsCreate {
+	if v != nil {
+		_c.SetImpersonatedUser(*v)
+	}
+	return _c
+}
+
 // SetTime sets the "time" field.
 func (_c *LogsCreate) SetTime(v int) *LogsCreate {
 	_c.mutation.SetTime(v)
@@ -169,7 +197,9 @@ func (_c *LogsCreate) Mutation() *LogsMutation {
 
 // Save creates the Logs in the database.
 func (_c *LogsCreate) Save(ctx context.Context) (*Logs, error) {
-	_c.defaults()
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
@@ -196,15 +226,26 @@ func (_c *LogsCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_c *LogsCreate) defaults() {
+func (_c *LogsCreate) defaults() error {
 	if _, ok := _c.mutation.CreatedAt(); !ok {
+		if logs.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized logs.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
 		v := logs.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
 	}
 	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		if logs.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized logs.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := logs.DefaultUpdatedAt()
 		_c.mutation.SetUpdatedAt(v)
 	}
+	if _, ok := _c.mutation.TenantID(); !ok {
+		v := logs.DefaultTenantID
+		_c.mutation.SetTenantID(v)
+	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -215,6 +256,9 @@ func (_c *LogsCreate) check() error {
 	if _, ok := _c.mutation.UpdatedAt(); !ok {
 		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Logs.updated_at"`)}
 	}
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "Logs.tenant_id"`)}
+	}
 	if _, ok := _c.mutation.GetType(); !ok {
 		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "Logs.type"`)}
 	}
@@ -267,6 +311,10 @@ func (_c *LogsCreate) createSpec() (*Logs, *sqlgraph.CreateSpec) {
 		_spec.SetField(logs.FieldUpdatedAt, field.TypeTime, value)
 		_node.UpdatedAt = value
 	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(logs.FieldTenantID, field.TypeUint64, value)
+		_node.TenantID = value
+	}
 	if value, ok := _c.mutation.GetType(); ok {
 		_spec.SetField(logs.FieldType, field.TypeString, value)
 		_node.Type = value
@@ -303,6 +351,10 @@ func (_c *LogsCreate) createSpec() (*Logs, *sqlgraph.CreateSpec) {
 		_spec.SetField(logs.FieldOperator, field.TypeString, value)
 		_node.Operator = value
 	}
+	if value, ok := _c.mutation.ImpersonatedUser(); ok {
+		_spec.SetField(logs.FieldImpersonatedUser, field.TypeString, value)
+		_node.ImpersonatedUser = value
+	}
 	if value, ok := _c.mutation.Time(); ok {
 		_spec.SetField(logs.FieldTime, field.TypeInt, value)
 		_node.Time = value
@@ -190,6 +190,26 @@ func (_u *LogsUpdate) ClearOperator() *LogsUpdate {
 	return _u
 }
 
+// SetImpersonatedUser sets the "impersonated_user" field.
+func (_u *LogsUpdate) SetImpersonatedUser(v string) *LogsUpdate {
+	_u.mutation.SetImpersonatedUser(v)
+	return _u
+}
+
+// SetNillableImpersonatedUser sets the "impersonated_user" field if the given value is not nil.
+func (_u *LogsUpdate) SetNillableImpersonatedUser(v *string) *LogsUpdate {
+	if v != nil {
+		_u.SetImpersonatedUser(*v)
+	}
+	return _u
+}
+
+// ClearImpersonatedUser clears the value of the "impersonated_user" field.
+func (_u *LogsUpdate) ClearImpersonatedUser() *LogsUpdate {
+	_u.mutation.ClearImpersonatedUser()
+	return _u
+}
+
 // SetTime sets the "time" field.
 func (_u *LogsUpdate) SetTime(v int) *LogsUpdate {
 	_u.mutation.ResetTime()
@@ -224,7 +244,9 @@ func (_u *LogsUpdate) Mutation() *LogsMutation {
 
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *LogsUpdate) Save(ctx context.Context) (int, error) {
-	_u.defaults()
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
@@ -251,11 +273,15 @@ func (_u *LogsUpdate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_u *LogsUpdate) defaults() {
+func (_u *LogsUpdate) defaults() error {
 	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if logs.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized logs.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := logs.UpdateDefaultUpdatedAt()
 		_u.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 func (_u *LogsUpdate) sqlSave(ctx context.Context) (_node int, err error) {
@@ -312,6 +338,12 @@ func (_u *LogsUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.OperatorCleared() {
 		_spec.ClearField(logs.FieldOperator, field.TypeString)
 	}
+	if value, ok := _u.mutation.ImpersonatedUser(); ok {
+		_spec.SetField(logs.FieldImpersonatedUser, field.TypeString, value)
+	}
+	if _u.mutation.ImpersonatedUserCleared() {
+		_spec.ClearField(logs.FieldImpersonatedUser, field.TypeString)
+	}
 	if value, ok := _u.mutation.Time(); ok {
 		_spec.SetField(logs.FieldTime, field.TypeInt, value)
 	}
@@ -503,6 +535,26 @@ func (_u *LogsUpdateOne) ClearOperator() *LogsUpdateOne {
 	return _u
 }
 
+// SetImpersonatedUser sets the "impersonated_user" field.
+func (_u *LogsUpdateOne) SetImpersonatedUser(v string) *LogsUpdateOne {
+	_u.mutation.SetImpersonatedUser(v)
+	return _u
+}
+
+// SetNillableImpersonatedUser sets the "impersonated_user" field if the given value is not nil.
+func (_u *LogsUpdateOne) SetNillableImpersonatedUser(v *string) *LogsUpdateOne {
+	if v != nil {
+		_u.SetImpersonatedUser(*v)
+	}
+	return _u
+}
+
+// ClearImpersonatedUser clears the value of the "impersonated_user" field.
+func (_u *LogsUpdateOne) ClearImpersonatedUser() *LogsUpdateOne {
+	_u.mutation.ClearImpersonatedUser()
+	return _u
+}
+
 // SetTime sets the "time" field.
 func (_u *LogsUpdateOne) SetTime(v int) *LogsUpdateOne {
 	_u.mutation.ResetTime()
@@ -550,7 +602,9 @@ func (_u *LogsUpdateOne) Select(field string, fields ...string) *LogsUpdateOne {
 
 // Save executes the query and returns the updated Logs entity.
 func (_u *LogsUpdateOne) Save(ctx context.Context) (*Logs, error) {
-	_u.defaults()
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
@@ -577,11 +631,15 @@ func (_u *LogsUpdateOne) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_u *LogsUpdateOne) defaults() {
+func (_u *LogsUpdateOne) defaults() error {
 	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if logs.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized logs.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := logs.UpdateDefaultUpdatedAt()
 		_u.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 func (_u *LogsUpdateOne) sqlSave(ctx context.Context) (_node *Logs, err error) {
@@ -655,6 +713,12 @@ func (_u *LogsUpdateOne) sqlSave(ctx context.Context) (_node *Logs, err error) {
 	if _u.mutation.OperatorCleared() {
 		_spec.ClearField(logs.FieldOperator, field.TypeString)
 	}
+	if value, ok := _u.mutation.ImpersonatedUser(); ok {
+		_spec.SetField(logs.FieldImpersonatedUser, field.TypeString, value)
+	}
+	if _u.mutation.ImpersonatedUserCleared() {
+		_spec.ClearField(logs.FieldImpersonatedUser, field.TypeString)
+	}
 	if value, ok := _u.mutation.Time(); ok {
 		_spec.SetField(logs.FieldTime, field.TypeInt, value)
 	}
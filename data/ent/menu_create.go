@@ -49,6 +49,20 @@ func (_c *MenuCreate) SetNillableUpdatedAt(v *time.Time) *MenuCreate {
 	return _c
 }
 
+// SetTenantID sets the "tenant_id" field.
+func (_c *MenuCreate) SetTenantID(v uint64) *MenuCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_c *MenuCreate) SetNillableTenantID(v *uint64) *MenuCreate {
+	if v != nil {
+		_c.SetTenantID(*v)
+	}
+	return _c
+}
+
 // SetParentID sets the "parent_id" field.
 func (_c *MenuCreate) SetParentID(v uint64) *MenuCreate {
 	_c.mutation.SetParentID(v)
@@ -365,7 +379,9 @@ func (_c *MenuCreate) Mutation() *MenuMutation {
 
 // Save creates the Menu in the database.
 func (_c *MenuCreate) Save(ctx context.Context) (*Menu, error) {
-	_c.defaults()
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
@@ -392,15 +408,25 @@ func (_c *MenuCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_c *MenuCreate) defaults() {
+func (_c *MenuCreate) defaults() error {
 	if _, ok := _c.mutation.CreatedAt(); !ok {
+		if menu.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized menu.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
 		v := menu.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
 	}
 	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		if menu.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized menu.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := menu.DefaultUpdatedAt()
 		_c.mutation.SetUpdatedAt(v)
 	}
+	if _, ok := _c.mutation.TenantID(); !ok {
+		v := menu.DefaultTenantID
+		_c.mutation.SetTenantID(v)
+	}
 	if _, ok := _c.mutation.Path(); !ok {
 		v := menu.DefaultPath
 		_c.mutation.SetPath(v)
@@ -465,6 +491,7 @@ func (_c *MenuCreate) defaults() {
 		v := menu.DefaultRealPath
 		_c.mutation.SetRealPath(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -475,6 +502,9 @@ func (_c *MenuCreate) check() error {
 	if _, ok := _c.mutation.UpdatedAt(); !ok {
 		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Menu.updated_at"`)}
 	}
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "Menu.tenant_id"`)}
+	}
 	if _, ok := _c.mutation.MenuLevel(); !ok {
 		return &ValidationError{Name: "menu_level", err: errors.New(`ent: missing required field "Menu.menu_level"`)}
 	}
@@ -533,6 +563,10 @@ func (_c *MenuCreate) createSpec() (*Menu, *sqlgraph.CreateSpec) {
 		_spec.SetField(menu.FieldUpdatedAt, field.TypeTime, value)
 		_node.UpdatedAt = value
 	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(menu.FieldTenantID, field.TypeUint64, value)
+		_node.TenantID = value
+	}
 	if value, ok := _c.mutation.MenuLevel(); ok {
 		_spec.SetField(menu.FieldMenuLevel, field.TypeUint32, value)
 		_node.MenuLevel = value
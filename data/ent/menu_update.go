@@ -557,7 +557,9 @@ func (_u *MenuUpdate) RemoveChildren(v ...*Menu) *MenuUpdate {
 
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *MenuUpdate) Save(ctx context.Context) (int, error) {
-	_u.defaults()
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
@@ -584,11 +586,15 @@ func (_u *MenuUpdate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_u *MenuUpdate) defaults() {
+func (_u *MenuUpdate) defaults() error {
 	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if menu.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized menu.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := menu.UpdateDefaultUpdatedAt()
 		_u.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 func (_u *MenuUpdate) sqlSave(ctx context.Context) (_node int, err error) {
@@ -1403,7 +1409,9 @@ func (_u *MenuUpdateOne) Select(field string, fields ...string) *MenuUpdateOne {
 
 // Save executes the query and returns the updated Menu entity.
 func (_u *MenuUpdateOne) Save(ctx context.Context) (*Menu, error) {
-	_u.defaults()
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
@@ -1430,11 +1438,15 @@ func (_u *MenuUpdateOne) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_u *MenuUpdateOne) defaults() {
+func (_u *MenuUpdateOne) defaults() error {
 	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if menu.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized menu.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := menu.UpdateDefaultUpdatedAt()
 		_u.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 func (_u *MenuUpdateOne) sqlSave(ctx context.Context) (_node *Menu, err error) {
@@ -32,14 +32,51 @@ var (
 			},
 		},
 	}
+	// SysAPIKeysColumns holds the columns for the "sys_api_keys" table.
+	SysAPIKeysColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint64, Increment: true, Comment: "primary key"},
+		{Name: "created_at", Type: field.TypeTime, Comment: "created time"},
+		{Name: "updated_at", Type: field.TypeTime, Comment: "last update time"},
+		{Name: "name", Type: field.TypeString, Comment: "key name | 密钥名称"},
+		{Name: "prefix", Type: field.TypeString, Comment: "first characters of the key to recognize it | 密钥前缀, 用于识别"},
+		{Name: "key_hash", Type: field.TypeString, Unique: true, Comment: "sha256 of the key | 密钥的sha256摘要"},
+		{Name: "scopes", Type: field.TypeJSON, Comment: "allowed casbin policies, each is METHOD and path separated by a space | 允许的casbin策略, 每项为以空格分隔的METHOD与path"},
+		{Name: "expired_at", Type: field.TypeTime, Comment: "expire time | 过期时间"},
+		{Name: "last_used_at", Type: field.TypeTime, Nullable: true, Comment: "last used time | 最近使用时间"},
+		{Name: "last_used_ip", Type: field.TypeString, Nullable: true, Comment: "last used IP | 最近使用IP", Default: ""},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true, Comment: "revoked time | 吊销时间"},
+		{Name: "user_id", Type: field.TypeUint64, Comment: "owner's ID | 所属用户ID"},
+	}
+	// SysAPIKeysTable holds the schema information for the "sys_api_keys" table.
+	SysAPIKeysTable = &schema.Table{
+		Name:       "sys_api_keys",
+		Columns:    SysAPIKeysColumns,
+		PrimaryKey: []*schema.Column{SysAPIKeysColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "sys_api_keys_sys_users_api_keys",
+				Columns:    []*schema.Column{SysAPIKeysColumns[11]},
+				RefColumns: []*schema.Column{SysUsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "apikey_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{SysAPIKeysColumns[11]},
+			},
+		},
+	}
 	// SysDictionariesColumns holds the columns for the "sys_dictionaries" table.
 	SysDictionariesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUint64, Increment: true, Comment: "primary key"},
 		{Name: "created_at", Type: field.TypeTime, Comment: "created time"},
 		{Name: "updated_at", Type: field.TypeTime, Comment: "last update time"},
 		{Name: "status", Type: field.TypeUint8, Nullable: true, Comment: "status 1 normal 0 ban | 状态 1 正常 0 禁用", Default: 1},
+		{Name: "tenant_id", Type: field.TypeUint64, Comment: "tenant ID | 租户ID", Default: 1},
 		{Name: "title", Type: field.TypeString, Comment: "the title shown in the ui | 展示名称 （建议配合i18n）"},
-		{Name: "name", Type: field.TypeString, Unique: true, Comment: "the name of dictionary for search | 字典搜索名称"},
+		{Name: "name", Type: field.TypeString, Comment: "the name of dictionary for search, unique in the tenant | 字典搜索名称, 租户内唯一"},
 		{Name: "description", Type: field.TypeString, Comment: "the description of dictionary | 字典描述"},
 	}
 	// SysDictionariesTable holds the schema information for the "sys_dictionaries" table.
@@ -47,6 +84,18 @@ var (
 		Name:       "sys_dictionaries",
 		Columns:    SysDictionariesColumns,
 		PrimaryKey: []*schema.Column{SysDictionariesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "dictionary_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{SysDictionariesColumns[4]},
+			},
+			{
+				Name:    "dictionary_tenant_id_name",
+				Unique:  true,
+				Columns: []*schema.Column{SysDictionariesColumns[4], SysDictionariesColumns[6]},
+			},
+		},
 	}
 	// SysDictionaryDetailsColumns holds the columns for the "sys_dictionary_details" table.
 	SysDictionaryDetailsColumns = []*schema.Column{
@@ -54,6 +103,7 @@ var (
 		{Name: "created_at", Type: field.TypeTime, Comment: "created time"},
 		{Name: "updated_at", Type: field.TypeTime, Comment: "last update time"},
 		{Name: "status", Type: field.TypeUint8, Nullable: true, Comment: "status 1 normal 0 ban | 状态 1 正常 0 禁用", Default: 1},
+		{Name: "tenant_id", Type: field.TypeUint64, Comment: "tenant ID | 租户ID", Default: 1},
 		{Name: "title", Type: field.TypeString, Comment: "the title shown in the ui | 展示名称 （建议配合i18n）"},
 		{Name: "key", Type: field.TypeString, Comment: "key | 键"},
 		{Name: "value", Type: field.TypeString, Comment: "value | 值"},
@@ -67,16 +117,66 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "sys_dictionary_details_sys_dictionaries_dictionary_details",
-				Columns:    []*schema.Column{SysDictionaryDetailsColumns[7]},
+				Columns:    []*schema.Column{SysDictionaryDetailsColumns[8]},
 				RefColumns: []*schema.Column{SysDictionariesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 		},
 		Indexes: []*schema.Index{
+			{
+				Name:    "dictionarydetail_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{SysDictionaryDetailsColumns[4]},
+			},
 			{
 				Name:    "dictionarydetail_key_dictionary_id",
 				Unique:  true,
-				Columns: []*schema.Column{SysDictionaryDetailsColumns[5], SysDictionaryDetailsColumns[7]},
+				Columns: []*schema.Column{SysDictionaryDetailsColumns[6], SysDictionaryDetailsColumns[8]},
+			},
+		},
+	}
+	// SysLoginEventsColumns holds the columns for the "sys_login_events" table.
+	SysLoginEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint64, Increment: true, Comment: "primary key"},
+		{Name: "created_at", Type: field.TypeTime, Comment: "created time"},
+		{Name: "updated_at", Type: field.TypeTime, Comment: "last update time"},
+		{Name: "tenant_id", Type: field.TypeUint64, Comment: "tenant ID | 租户ID", Default: 1},
+		{Name: "user_id", Type: field.TypeUint64, Nullable: true, Comment: "ID of the user, 0 if the user is unknown | 用户ID, 未知用户为0", Default: 0},
+		{Name: "username", Type: field.TypeString, Nullable: true, Comment: "username of the attempt | 尝试登录的用户名", Default: ""},
+		{Name: "method", Type: field.TypeString, Comment: "login method such as password, webauthn, api_key or the OAuth provider | 登录方式, 如password, webauthn, api_key或第三方平台"},
+		{Name: "ip", Type: field.TypeString, Nullable: true, Comment: "client IP | 登录IP", Default: ""},
+		{Name: "user_agent", Type: field.TypeString, Nullable: true, Comment: "client user agent | 客户端UA", Default: "", SchemaType: map[string]string{"mysql": "varchar(512)"}},
+		{Name: "device", Type: field.TypeString, Nullable: true, Comment: "device label | 登录设备", Default: ""},
+		{Name: "success", Type: field.TypeBool, Comment: "whether the login succeeded | 是否登录成功"},
+		{Name: "reason", Type: field.TypeString, Nullable: true, Comment: "failure reason | 失败原因", Default: ""},
+		{Name: "flags", Type: field.TypeJSON, Nullable: true, Comment: "anomalies: new_ip, new_device or many_failures | 异常标记: 新IP, 新设备或多次失败"},
+		{Name: "anomalous", Type: field.TypeBool, Comment: "whether any anomaly is flagged | 是否存在异常", Default: false},
+	}
+	// SysLoginEventsTable holds the schema information for the "sys_login_events" table.
+	SysLoginEventsTable = &schema.Table{
+		Name:       "sys_login_events",
+		Columns:    SysLoginEventsColumns,
+		PrimaryKey: []*schema.Column{SysLoginEventsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "loginevent_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{SysLoginEventsColumns[3]},
+			},
+			{
+				Name:    "loginevent_user_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{SysLoginEventsColumns[4], SysLoginEventsColumns[1]},
+			},
+			{
+				Name:    "loginevent_username_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{SysLoginEventsColumns[5], SysLoginEventsColumns[1]},
+			},
+			{
+				Name:    "loginevent_ip_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{SysLoginEventsColumns[7], SysLoginEventsColumns[1]},
 			},
 		},
 	}
@@ -85,6 +185,7 @@ var (
 		{Name: "id", Type: field.TypeUint64, Increment: true, Comment: "primary key"},
 		{Name: "created_at", Type: field.TypeTime, Comment: "created time"},
 		{Name: "updated_at", Type: field.TypeTime, Comment: "last update time"},
+		{Name: "tenant_id", Type: field.TypeUint64, Comment: "tenant ID | 租户ID", Default: 1},
 		{Name: "type", Type: field.TypeString, Comment: "type of log | 日志类型"},
 		{Name: "method", Type: field.TypeString, Comment: "method of log | 日志请求方法"},
 		{Name: "api", Type: field.TypeString, Comment: "api of log | 日志请求api"},
@@ -94,6 +195,7 @@ var (
 		{Name: "ip", Type: field.TypeString, Nullable: true, Comment: "ip of log | 日志IP"},
 		{Name: "user_agent", Type: field.TypeString, Nullable: true, Comment: "user_agent of log | 日志用户客户端"},
 		{Name: "operator", Type: field.TypeString, Nullable: true, Comment: "operator of log | 日志操作者"},
+		{Name: "impersonated_user", Type: field.TypeString, Nullable: true, Comment: "user impersonated by the operator | 操作者模拟登录的用户"},
 		{Name: "time", Type: field.TypeInt, Nullable: true, Comment: "time of log(millisecond) | 日志时间(毫秒)"},
 	}
 	// SysLogsTable holds the schema information for the "sys_logs" table.
@@ -102,10 +204,15 @@ var (
 		Columns:    SysLogsColumns,
 		PrimaryKey: []*schema.Column{SysLogsColumns[0]},
 		Indexes: []*schema.Index{
+			{
+				Name:    "logs_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{SysLogsColumns[3]},
+			},
 			{
 				Name:    "logs_api",
 				Unique:  false,
-				Columns: []*schema.Column{SysLogsColumns[5]},
+				Columns: []*schema.Column{SysLogsColumns[6]},
 			},
 		},
 	}
@@ -114,6 +221,7 @@ var (
 		{Name: "id", Type: field.TypeUint64, Increment: true, Comment: "primary key"},
 		{Name: "created_at", Type: field.TypeTime, Comment: "created time"},
 		{Name: "updated_at", Type: field.TypeTime, Comment: "last update time"},
+		{Name: "tenant_id", Type: field.TypeUint64, Comment: "tenant ID | 租户ID", Default: 1},
 		{Name: "menu_level", Type: field.TypeUint32, Comment: "menu level | 菜单层级"},
 		{Name: "menu_type", Type: field.TypeUint32, Comment: "menu type | 菜单类型 0 目录 1 菜单 2 按钮"},
 		{Name: "path", Type: field.TypeString, Nullable: true, Comment: "index path | 菜单路由路径", Default: ""},
@@ -145,11 +253,18 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "sys_menus_sys_menus_children",
-				Columns:    []*schema.Column{SysMenusColumns[24]},
+				Columns:    []*schema.Column{SysMenusColumns[25]},
 				RefColumns: []*schema.Column{SysMenusColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "menu_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{SysMenusColumns[3]},
+			},
+		},
 	}
 	// SysOauthProvidersColumns holds the columns for the "sys_oauth_providers" table.
 	SysOauthProvidersColumns = []*schema.Column{
@@ -162,10 +277,16 @@ var (
 		{Name: "client_secret", Type: field.TypeString, Nullable: true, Comment: "the client secret | 客户端密钥"},
 		{Name: "redirect_url", Type: field.TypeString, Comment: "the redirect url | 跳转地址"},
 		{Name: "scopes", Type: field.TypeString, Nullable: true, Comment: "the scopes | 权限范围"},
-		{Name: "auth_url", Type: field.TypeString, Comment: "the auth url of the provider | 认证地址"},
+		{Name: "auth_url", Type: field.TypeString, Nullable: true, Comment: "the auth url of the provider, discovered if discovery_url is set | 认证地址, 设置discovery_url时自动发现"},
 		{Name: "token_url", Type: field.TypeString, Nullable: true, Comment: "the token url of the provider | 获取 token地址"},
 		{Name: "auth_style", Type: field.TypeUint64, Nullable: true, Comment: "the auth style, 0: auto detect; 1: third party login; 2: login with username and password"},
 		{Name: "info_url", Type: field.TypeString, Nullable: true, Comment: "the URL to request user information by token | 用户信息请求地址"},
+		{Name: "discovery_url", Type: field.TypeString, Nullable: true, Comment: "the OIDC issuer, endpoints are read from its openid-configuration and the id_token is verified | OIDC签发者地址, 从openid-configuration读取端点并校验id_token", Default: ""},
+		{Name: "claim_mapping", Type: field.TypeJSON, Nullable: true, Comment: "JSON paths of the user info fields in the user info response or id_token, keyed by subject, credential, username, email, email_verified, mobile, nickname, avatar and groups | 用户信息字段在用户信息响应或id_token中的JSON路径"},
+		{Name: "auto_provision", Type: field.TypeBool, Comment: "create the user on the first login of an account not linked yet | 未关联账号首次登录时自动创建用户", Default: false},
+		{Name: "provision_approval", Type: field.TypeBool, Comment: "the created users wait for the approval of an admin | 自动创建的用户需管理员审批", Default: false},
+		{Name: "default_role_id", Type: field.TypeUint64, Nullable: true, Comment: "the role of the created users no rule matches, 0 rejects them | 未匹配规则的自动创建用户角色, 0为拒绝", Default: 0},
+		{Name: "role_rules", Type: field.TypeJSON, Nullable: true, Comment: "the rules deciding the role of the created users, the first match wins | 决定自动创建用户角色的规则, 按顺序首个匹配生效"},
 	}
 	// SysOauthProvidersTable holds the schema information for the "sys_oauth_providers" table.
 	SysOauthProvidersTable = &schema.Table{
@@ -173,34 +294,174 @@ var (
 		Columns:    SysOauthProvidersColumns,
 		PrimaryKey: []*schema.Column{SysOauthProvidersColumns[0]},
 	}
+	// SysOidcClientsColumns holds the columns for the "sys_oidc_clients" table.
+	SysOidcClientsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint64, Increment: true, Comment: "primary key"},
+		{Name: "created_at", Type: field.TypeTime, Comment: "created time"},
+		{Name: "updated_at", Type: field.TypeTime, Comment: "last update time"},
+		{Name: "status", Type: field.TypeUint8, Nullable: true, Comment: "status 1 normal 0 ban | 状态 1 正常 0 禁用", Default: 1},
+		{Name: "client_id", Type: field.TypeString, Unique: true, Comment: "the client id | 客户端 id"},
+		{Name: "client_secret", Type: field.TypeString, Nullable: true, Comment: "bcrypt of the client secret, empty for public clients | 客户端密钥的bcrypt摘要, 公共客户端为空", Default: ""},
+		{Name: "name", Type: field.TypeString, Comment: "the client name shown on the consent page | 客户端名称, 显示在授权页面"},
+		{Name: "redirect_uris", Type: field.TypeJSON, Nullable: true, Comment: "the registered redirect URIs | 已登记的回调地址"},
+		{Name: "grant_types", Type: field.TypeJSON, Comment: "allowed grant types | 允许的授权类型"},
+		{Name: "scopes", Type: field.TypeJSON, Comment: "allowed scopes | 允许的权限范围"},
+		{Name: "public", Type: field.TypeBool, Comment: "public client without secret, PKCE only | 无密钥的公共客户端, 仅支持PKCE", Default: false},
+		{Name: "skip_consent", Type: field.TypeBool, Comment: "trusted first party client, consent is not asked | 受信任的内部客户端, 不询问用户授权", Default: false},
+	}
+	// SysOidcClientsTable holds the schema information for the "sys_oidc_clients" table.
+	SysOidcClientsTable = &schema.Table{
+		Name:       "sys_oidc_clients",
+		Columns:    SysOidcClientsColumns,
+		PrimaryKey: []*schema.Column{SysOidcClientsColumns[0]},
+	}
+	// SysOidcConsentsColumns holds the columns for the "sys_oidc_consents" table.
+	SysOidcConsentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint64, Increment: true, Comment: "primary key"},
+		{Name: "created_at", Type: field.TypeTime, Comment: "created time"},
+		{Name: "updated_at", Type: field.TypeTime, Comment: "last update time"},
+		{Name: "user_id", Type: field.TypeUint64, Comment: "user's ID | 用户ID"},
+		{Name: "client_id", Type: field.TypeString, Comment: "the client id | 客户端 id"},
+		{Name: "scopes", Type: field.TypeJSON, Comment: "granted scopes | 已授权的权限范围"},
+	}
+	// SysOidcConsentsTable holds the schema information for the "sys_oidc_consents" table.
+	SysOidcConsentsTable = &schema.Table{
+		Name:       "sys_oidc_consents",
+		Columns:    SysOidcConsentsColumns,
+		PrimaryKey: []*schema.Column{SysOidcConsentsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "oidcconsent_user_id_client_id",
+				Unique:  true,
+				Columns: []*schema.Column{SysOidcConsentsColumns[3], SysOidcConsentsColumns[4]},
+			},
+		},
+	}
+	// SysRefreshTokensColumns holds the columns for the "sys_refresh_tokens" table.
+	SysRefreshTokensColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint64, Increment: true, Comment: "primary key"},
+		{Name: "created_at", Type: field.TypeTime, Comment: "created time"},
+		{Name: "updated_at", Type: field.TypeTime, Comment: "last update time"},
+		{Name: "user_id", Type: field.TypeUint64, Comment: "User's ID | 用户的ID"},
+		{Name: "token_hash", Type: field.TypeString, Unique: true, Comment: "sha256 of the refresh token | 刷新令牌的sha256摘要"},
+		{Name: "family", Type: field.TypeString, Comment: "token family, the jti of the session | 令牌族, 即会话的jti"},
+		{Name: "expired_at", Type: field.TypeTime, Comment: "Expire time | 过期时间"},
+		{Name: "used_at", Type: field.TypeTime, Nullable: true, Comment: "rotated time | 已使用(轮换)时间"},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true, Comment: "revoked time | 吊销时间"},
+	}
+	// SysRefreshTokensTable holds the schema information for the "sys_refresh_tokens" table.
+	SysRefreshTokensTable = &schema.Table{
+		Name:       "sys_refresh_tokens",
+		Columns:    SysRefreshTokensColumns,
+		PrimaryKey: []*schema.Column{SysRefreshTokensColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "refreshtoken_family",
+				Unique:  false,
+				Columns: []*schema.Column{SysRefreshTokensColumns[5]},
+			},
+			{
+				Name:    "refreshtoken_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{SysRefreshTokensColumns[3]},
+			},
+		},
+	}
 	// SysRolesColumns holds the columns for the "sys_roles" table.
 	SysRolesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUint64, Increment: true, Comment: "primary key"},
 		{Name: "created_at", Type: field.TypeTime, Comment: "created time"},
 		{Name: "updated_at", Type: field.TypeTime, Comment: "last update time"},
 		{Name: "status", Type: field.TypeUint8, Nullable: true, Comment: "status 1 normal 0 ban | 状态 1 正常 0 禁用", Default: 1},
+		{Name: "tenant_id", Type: field.TypeUint64, Comment: "tenant ID | 租户ID", Default: 1},
 		{Name: "name", Type: field.TypeString, Comment: "role name | 角色名"},
-		{Name: "value", Type: field.TypeString, Unique: true, Comment: "role value for permission control in front end | 角色值，用于前端权限控制"},
+		{Name: "value", Type: field.TypeString, Comment: "role value for permission control in front end, unique in the tenant | 角色值，用于前端权限控制, 租户内唯一"},
 		{Name: "default_router", Type: field.TypeString, Comment: "default menu : dashboard | 默认登录页面", Default: "/dashboard"},
 		{Name: "remark", Type: field.TypeString, Comment: "remark | 备注", Default: ""},
 		{Name: "order_no", Type: field.TypeUint32, Comment: "order number | 排序编号", Default: 0},
+		{Name: "force_two_factor", Type: field.TypeBool, Comment: "force two-factor authentication for the role | 强制该角色启用双因素认证", Default: false},
+		{Name: "parent_id", Type: field.TypeUint64, Nullable: true, Comment: "parent role ID, the role inherits the api and menu permissions of the parent | 父角色ID, 继承父角色的接口与菜单权限"},
 	}
 	// SysRolesTable holds the schema information for the "sys_roles" table.
 	SysRolesTable = &schema.Table{
 		Name:       "sys_roles",
 		Columns:    SysRolesColumns,
 		PrimaryKey: []*schema.Column{SysRolesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "sys_roles_sys_roles_children",
+				Columns:    []*schema.Column{SysRolesColumns[11]},
+				RefColumns: []*schema.Column{SysRolesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "role_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{SysRolesColumns[4]},
+			},
+			{
+				Name:    "role_tenant_id_value",
+				Unique:  true,
+				Columns: []*schema.Column{SysRolesColumns[4], SysRolesColumns[6]},
+			},
+		},
+	}
+	// SysSamlProvidersColumns holds the columns for the "sys_saml_providers" table.
+	SysSamlProvidersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint64, Increment: true, Comment: "primary key"},
+		{Name: "created_at", Type: field.TypeTime, Comment: "created time"},
+		{Name: "updated_at", Type: field.TypeTime, Comment: "last update time"},
+		{Name: "name", Type: field.TypeString, Unique: true, Comment: "the IdP's name, part of the metadata and ACS URLs | 身份提供商名称"},
+		{Name: "entity_id", Type: field.TypeString, Nullable: true, Comment: "the entity id of the service provider registered at the IdP, the metadata URL if empty | 服务提供商实体ID, 为空时使用元数据地址", Default: ""},
+		{Name: "idp_metadata_url", Type: field.TypeString, Nullable: true, Comment: "the URL of the IdP metadata, reloaded with the provider | 身份提供商元数据地址", Default: ""},
+		{Name: "idp_metadata_xml", Type: field.TypeString, Nullable: true, Size: 2147483647, Comment: "the IdP metadata, used if idp_metadata_url is empty | 身份提供商元数据XML, 未设置元数据地址时使用", Default: ""},
+		{Name: "attribute_mapping", Type: field.TypeJSON, Nullable: true, Comment: "the attribute names of the user info fields, keyed by subject, username, email, email_verified, mobile, nickname, avatar and groups, the subject is the NameID if empty | 用户信息字段对应的属性名"},
+		{Name: "allow_idp_initiated", Type: field.TypeBool, Comment: "accept the logins started at the IdP | 允许身份提供商发起的登录", Default: false},
+		{Name: "auto_provision", Type: field.TypeBool, Comment: "create the user on the first login of an account not linked yet | 未关联账号首次登录时自动创建用户", Default: false},
+		{Name: "provision_approval", Type: field.TypeBool, Comment: "the created users wait for the approval of an admin | 自动创建的用户需管理员审批", Default: false},
+		{Name: "default_role_id", Type: field.TypeUint64, Nullable: true, Comment: "the role of the created users no rule matches, 0 rejects them | 未匹配规则的自动创建用户角色, 0为拒绝", Default: 0},
+		{Name: "role_rules", Type: field.TypeJSON, Nullable: true, Comment: "the rules deciding the role of the created users, the first match wins | 决定自动创建用户角色的规则, 按顺序首个匹配生效"},
+	}
+	// SysSamlProvidersTable holds the schema information for the "sys_saml_providers" table.
+	SysSamlProvidersTable = &schema.Table{
+		Name:       "sys_saml_providers",
+		Columns:    SysSamlProvidersColumns,
+		PrimaryKey: []*schema.Column{SysSamlProvidersColumns[0]},
+	}
+	// SysTenantsColumns holds the columns for the "sys_tenants" table.
+	SysTenantsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint64, Increment: true, Comment: "primary key"},
+		{Name: "created_at", Type: field.TypeTime, Comment: "created time"},
+		{Name: "updated_at", Type: field.TypeTime, Comment: "last update time"},
+		{Name: "status", Type: field.TypeUint8, Nullable: true, Comment: "status 1 normal 0 ban | 状态 1 正常 0 禁用", Default: 1},
+		{Name: "name", Type: field.TypeString, Unique: true, Comment: "tenant name | 租户名称"},
+		{Name: "remark", Type: field.TypeString, Nullable: true, Comment: "remark | 备注", Default: ""},
+	}
+	// SysTenantsTable holds the schema information for the "sys_tenants" table.
+	SysTenantsTable = &schema.Table{
+		Name:       "sys_tenants",
+		Columns:    SysTenantsColumns,
+		PrimaryKey: []*schema.Column{SysTenantsColumns[0]},
 	}
 	// SysTokensColumns holds the columns for the "sys_tokens" table.
 	SysTokensColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUint64, Increment: true},
 		{Name: "created_at", Type: field.TypeTime, Comment: "created time"},
 		{Name: "updated_at", Type: field.TypeTime, Comment: "last update time"},
-		{Name: "user_id", Type: field.TypeUint64, Unique: true, Comment: " User's ID | 用户的ID"},
+		{Name: "jti", Type: field.TypeString, Unique: true, Comment: "JWT ID of the session | 会话对应的JWT ID"},
 		{Name: "token", Type: field.TypeString, Comment: "Token string | Token 字符串"},
 		{Name: "source", Type: field.TypeString, Comment: "Log in source such as GitHub | Token 来源 （本地为core, 第三方如github等）"},
+		{Name: "device", Type: field.TypeString, Nullable: true, Comment: "device label | 登录设备", Default: ""},
+		{Name: "ip", Type: field.TypeString, Nullable: true, Comment: "client IP | 登录IP", Default: ""},
+		{Name: "user_agent", Type: field.TypeString, Nullable: true, Comment: "client user agent | 客户端UA", Default: "", SchemaType: map[string]string{"mysql": "varchar(512)"}},
+		{Name: "issued_at", Type: field.TypeTime, Comment: "issued time | 签发时间"},
 		{Name: "expired_at", Type: field.TypeTime, Comment: " Expire time | 过期时间"},
-		{Name: "user_token", Type: field.TypeUint64, Unique: true, Nullable: true},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true, Comment: "revoked time | 吊销时间"},
+		{Name: "impersonator_id", Type: field.TypeUint64, Nullable: true, Comment: "ID of the admin impersonating the user, 0 if not impersonated | 模拟登录的管理员ID, 非模拟登录为0", Default: 0},
+		{Name: "role_id", Type: field.TypeUint64, Nullable: true, Comment: "current role of the session, kept by the refresh | 会话的当前角色, 刷新令牌时保持不变", Default: 0},
+		{Name: "user_id", Type: field.TypeUint64, Comment: " User's ID | 用户的ID"},
 	}
 	// SysTokensTable holds the schema information for the "sys_tokens" table.
 	SysTokensTable = &schema.Table{
@@ -209,22 +470,22 @@ var (
 		PrimaryKey: []*schema.Column{SysTokensColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{
 			{
-				Symbol:     "sys_tokens_sys_users_token",
-				Columns:    []*schema.Column{SysTokensColumns[7]},
+				Symbol:     "sys_tokens_sys_users_tokens",
+				Columns:    []*schema.Column{SysTokensColumns[14]},
 				RefColumns: []*schema.Column{SysUsersColumns[0]},
-				OnDelete:   schema.SetNull,
+				OnDelete:   schema.NoAction,
 			},
 		},
 		Indexes: []*schema.Index{
 			{
 				Name:    "token_user_id",
 				Unique:  false,
-				Columns: []*schema.Column{SysTokensColumns[3]},
+				Columns: []*schema.Column{SysTokensColumns[14]},
 			},
 			{
 				Name:    "token_expired_at",
 				Unique:  false,
-				Columns: []*schema.Column{SysTokensColumns[6]},
+				Columns: []*schema.Column{SysTokensColumns[10]},
 			},
 		},
 	}
@@ -234,17 +495,25 @@ var (
 		{Name: "created_at", Type: field.TypeTime, Comment: "created time"},
 		{Name: "updated_at", Type: field.TypeTime, Comment: "last update time"},
 		{Name: "status", Type: field.TypeUint8, Nullable: true, Comment: "status 1 normal 0 ban | 状态 1 正常 0 禁用", Default: 1},
+		{Name: "tenant_id", Type: field.TypeUint64, Comment: "tenant ID | 租户ID", Default: 1},
 		{Name: "username", Type: field.TypeString, Unique: true, Comment: "user's login name | 登录名"},
 		{Name: "password", Type: field.TypeString, Comment: "password | 密码"},
 		{Name: "nickname", Type: field.TypeString, Unique: true, Comment: "nickname | 昵称"},
 		{Name: "side_mode", Type: field.TypeString, Nullable: true, Comment: "template mode | 布局方式", Default: "dark"},
 		{Name: "base_color", Type: field.TypeString, Nullable: true, Comment: "base color of template | 后台页面色调", Default: "#fff"},
 		{Name: "active_color", Type: field.TypeString, Nullable: true, Comment: "active color of template | 当前激活的颜色设定", Default: "#1890ff"},
-		{Name: "role_id", Type: field.TypeUint64, Nullable: true, Comment: "role id | 角色ID", Default: 2},
+		{Name: "role_id", Type: field.TypeUint64, Nullable: true, Comment: "default role id used by the logins | 登录使用的默认角色ID", Default: 2},
 		{Name: "mobile", Type: field.TypeString, Unique: true, Comment: "mobile number | 手机号"},
 		{Name: "email", Type: field.TypeString, Nullable: true, Comment: "email | 邮箱号"},
 		{Name: "wecom", Type: field.TypeString, Nullable: true, Comment: "wecom | 企业微信号"},
 		{Name: "avatar", Type: field.TypeString, Nullable: true, Comment: "avatar | 头像路径", Default: "", SchemaType: map[string]string{"mysql": "varchar(512)"}},
+		{Name: "token_version", Type: field.TypeUint64, Comment: "token version, bumped to revoke all issued tokens | 令牌版本, 递增后已签发的令牌全部失效", Default: 0},
+		{Name: "totp_secret", Type: field.TypeString, Nullable: true, Comment: "TOTP secret | TOTP密钥", Default: ""},
+		{Name: "totp_enabled", Type: field.TypeBool, Comment: "whether TOTP two-factor authentication is enabled | 是否启用TOTP双因素认证", Default: false},
+		{Name: "recovery_codes", Type: field.TypeJSON, Nullable: true, Comment: "sha256 of the unused recovery codes | 未使用恢复码的sha256摘要"},
+		{Name: "password_history", Type: field.TypeJSON, Nullable: true, Comment: "bcrypt hashes of the previous passwords, newest first | 历史密码的bcrypt哈希, 最新的在前"},
+		{Name: "pending_approval", Type: field.TypeBool, Comment: "self-registered and waiting for approval, the status stays 0 until then | 自助注册待审批, 审批前状态为0", Default: false},
+		{Name: "password_changed_at", Type: field.TypeTime, Nullable: true, Comment: "time of the last password change, the create time if never changed | 最近修改密码的时间, 未修改过则为创建时间"},
 	}
 	// SysUsersTable holds the schema information for the "sys_users" table.
 	SysUsersTable = &schema.Table{
@@ -252,10 +521,90 @@ var (
 		Columns:    SysUsersColumns,
 		PrimaryKey: []*schema.Column{SysUsersColumns[0]},
 		Indexes: []*schema.Index{
+			{
+				Name:    "user_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{SysUsersColumns[4]},
+			},
 			{
 				Name:    "user_username_email",
 				Unique:  true,
-				Columns: []*schema.Column{SysUsersColumns[4], SysUsersColumns[12]},
+				Columns: []*schema.Column{SysUsersColumns[5], SysUsersColumns[13]},
+			},
+		},
+	}
+	// SysUserIdentitiesColumns holds the columns for the "sys_user_identities" table.
+	SysUserIdentitiesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint64, Increment: true, Comment: "primary key"},
+		{Name: "created_at", Type: field.TypeTime, Comment: "created time"},
+		{Name: "updated_at", Type: field.TypeTime, Comment: "last update time"},
+		{Name: "provider", Type: field.TypeString, Comment: "the provider's name | 提供商名称"},
+		{Name: "subject", Type: field.TypeString, Comment: "the stable ID of the account at the provider | 提供商账号的唯一ID"},
+		{Name: "username", Type: field.TypeString, Nullable: true, Comment: "the username at the provider when linked | 关联时提供商的用户名", Default: ""},
+		{Name: "email", Type: field.TypeString, Nullable: true, Comment: "the email at the provider when linked | 关联时提供商的邮箱", Default: ""},
+		{Name: "last_login_at", Type: field.TypeTime, Nullable: true, Comment: "last login time | 最后登录时间"},
+		{Name: "user_id", Type: field.TypeUint64, Comment: "User's ID | 用户的ID"},
+	}
+	// SysUserIdentitiesTable holds the schema information for the "sys_user_identities" table.
+	SysUserIdentitiesTable = &schema.Table{
+		Name:       "sys_user_identities",
+		Columns:    SysUserIdentitiesColumns,
+		PrimaryKey: []*schema.Column{SysUserIdentitiesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "sys_user_identities_sys_users_identities",
+				Columns:    []*schema.Column{SysUserIdentitiesColumns[8]},
+				RefColumns: []*schema.Column{SysUsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "useridentity_provider_subject",
+				Unique:  true,
+				Columns: []*schema.Column{SysUserIdentitiesColumns[3], SysUserIdentitiesColumns[4]},
+			},
+			{
+				Name:    "useridentity_user_id_provider",
+				Unique:  true,
+				Columns: []*schema.Column{SysUserIdentitiesColumns[8], SysUserIdentitiesColumns[3]},
+			},
+		},
+	}
+	// SysWebauthnCredentialsColumns holds the columns for the "sys_webauthn_credentials" table.
+	SysWebauthnCredentialsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint64, Increment: true, Comment: "primary key"},
+		{Name: "created_at", Type: field.TypeTime, Comment: "created time"},
+		{Name: "updated_at", Type: field.TypeTime, Comment: "last update time"},
+		{Name: "name", Type: field.TypeString, Comment: "credential name given by the user | 凭证名称", Default: ""},
+		{Name: "credential_id", Type: field.TypeString, Unique: true, Comment: "base64url credential ID | base64url编码的凭证ID", SchemaType: map[string]string{"mysql": "varchar(512)"}},
+		{Name: "public_key", Type: field.TypeBytes, Comment: "COSE public key | COSE格式公钥"},
+		{Name: "attestation_type", Type: field.TypeString, Nullable: true, Comment: "attestation format | 证明格式", Default: ""},
+		{Name: "aaguid", Type: field.TypeBytes, Nullable: true, Comment: "authenticator model AAGUID | 认证器型号AAGUID"},
+		{Name: "sign_count", Type: field.TypeUint32, Comment: "signature counter | 签名计数器", Default: 0},
+		{Name: "transports", Type: field.TypeJSON, Nullable: true, Comment: "authenticator transports | 认证器传输方式"},
+		{Name: "flags", Type: field.TypeUint8, Comment: "authenticator flags at registration | 注册时的认证器标志", Default: 0},
+		{Name: "last_used_at", Type: field.TypeTime, Nullable: true, Comment: "last login time | 最后登录时间"},
+		{Name: "user_id", Type: field.TypeUint64, Comment: "User's ID | 用户的ID"},
+	}
+	// SysWebauthnCredentialsTable holds the schema information for the "sys_webauthn_credentials" table.
+	SysWebauthnCredentialsTable = &schema.Table{
+		Name:       "sys_webauthn_credentials",
+		Columns:    SysWebauthnCredentialsColumns,
+		PrimaryKey: []*schema.Column{SysWebauthnCredentialsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "sys_webauthn_credentials_sys_users_webauthn_credentials",
+				Columns:    []*schema.Column{SysWebauthnCredentialsColumns[12]},
+				RefColumns: []*schema.Column{SysUsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "webauthncredential_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{SysWebauthnCredentialsColumns[12]},
 			},
 		},
 	}
@@ -284,18 +633,53 @@ var (
 			},
 		},
 	}
+	// UserRolesColumns holds the columns for the "user_roles" table.
+	UserRolesColumns = []*schema.Column{
+		{Name: "user_id", Type: field.TypeUint64},
+		{Name: "role_id", Type: field.TypeUint64},
+	}
+	// UserRolesTable holds the schema information for the "user_roles" table.
+	UserRolesTable = &schema.Table{
+		Name:       "user_roles",
+		Columns:    UserRolesColumns,
+		PrimaryKey: []*schema.Column{UserRolesColumns[0], UserRolesColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "user_roles_user_id",
+				Columns:    []*schema.Column{UserRolesColumns[0]},
+				RefColumns: []*schema.Column{SysUsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "user_roles_role_id",
+				Columns:    []*schema.Column{UserRolesColumns[1]},
+				RefColumns: []*schema.Column{SysRolesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		SysApisTable,
+		SysAPIKeysTable,
 		SysDictionariesTable,
 		SysDictionaryDetailsTable,
+		SysLoginEventsTable,
 		SysLogsTable,
 		SysMenusTable,
 		SysOauthProvidersTable,
+		SysOidcClientsTable,
+		SysOidcConsentsTable,
+		SysRefreshTokensTable,
 		SysRolesTable,
+		SysSamlProvidersTable,
+		SysTenantsTable,
 		SysTokensTable,
 		SysUsersTable,
+		SysUserIdentitiesTable,
+		SysWebauthnCredentialsTable,
 		RoleMenusTable,
+		UserRolesTable,
 	}
 )
 
@@ -303,6 +687,10 @@ func init() {
 	SysApisTable.Annotation = &entsql.Annotation{
 		Table: "sys_apis",
 	}
+	SysAPIKeysTable.ForeignKeys[0].RefTable = SysUsersTable
+	SysAPIKeysTable.Annotation = &entsql.Annotation{
+		Table: "sys_api_keys",
+	}
 	SysDictionariesTable.Annotation = &entsql.Annotation{
 		Table: "sys_dictionaries",
 	}
@@ -310,6 +698,9 @@ func init() {
 	SysDictionaryDetailsTable.Annotation = &entsql.Annotation{
 		Table: "sys_dictionary_details",
 	}
+	SysLoginEventsTable.Annotation = &entsql.Annotation{
+		Table: "sys_login_events",
+	}
 	SysLogsTable.Annotation = &entsql.Annotation{
 		Table: "sys_logs",
 	}
@@ -320,9 +711,25 @@ func init() {
 	SysOauthProvidersTable.Annotation = &entsql.Annotation{
 		Table: "sys_oauth_providers",
 	}
+	SysOidcClientsTable.Annotation = &entsql.Annotation{
+		Table: "sys_oidc_clients",
+	}
+	SysOidcConsentsTable.Annotation = &entsql.Annotation{
+		Table: "sys_oidc_consents",
+	}
+	SysRefreshTokensTable.Annotation = &entsql.Annotation{
+		Table: "sys_refresh_tokens",
+	}
+	SysRolesTable.ForeignKeys[0].RefTable = SysRolesTable
 	SysRolesTable.Annotation = &entsql.Annotation{
 		Table: "sys_roles",
 	}
+	SysSamlProvidersTable.Annotation = &entsql.Annotation{
+		Table: "sys_saml_providers",
+	}
+	SysTenantsTable.Annotation = &entsql.Annotation{
+		Table: "sys_tenants",
+	}
 	SysTokensTable.ForeignKeys[0].RefTable = SysUsersTable
 	SysTokensTable.Annotation = &entsql.Annotation{
 		Table: "sys_tokens",
@@ -330,6 +737,16 @@ func init() {
 	SysUsersTable.Annotation = &entsql.Annotation{
 		Table: "sys_users",
 	}
+	SysUserIdentitiesTable.ForeignKeys[0].RefTable = SysUsersTable
+	SysUserIdentitiesTable.Annotation = &entsql.Annotation{
+		Table: "sys_user_identities",
+	}
+	SysWebauthnCredentialsTable.ForeignKeys[0].RefTable = SysUsersTable
+	SysWebauthnCredentialsTable.Annotation = &entsql.Annotation{
+		Table: "sys_webauthn_credentials",
+	}
 	RoleMenusTable.ForeignKeys[0].RefTable = SysRolesTable
 	RoleMenusTable.ForeignKeys[1].RefTable = SysMenusTable
+	UserRolesTable.ForeignKeys[0].RefTable = SysUsersTable
+	UserRolesTable.ForeignKeys[1].RefTable = SysRolesTable
 }
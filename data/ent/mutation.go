@@ -7,15 +7,25 @@ import (
 	"errors"
 	"fmt"
 	"formulago/data/ent/api"
+	"formulago/data/ent/apikey"
 	"formulago/data/ent/dictionary"
 	"formulago/data/ent/dictionarydetail"
+	"formulago/data/ent/loginevent"
 	"formulago/data/ent/logs"
 	"formulago/data/ent/menu"
 	"formulago/data/ent/oauthprovider"
+	"formulago/data/ent/oidcclient"
+	"formulago/data/ent/oidcconsent"
 	"formulago/data/ent/predicate"
+	"formulago/data/ent/refreshtoken"
 	"formulago/data/ent/role"
+	"formulago/data/ent/samlprovider"
+	"formulago/data/ent/tenant"
 	"formulago/data/ent/token"
 	"formulago/data/ent/user"
+	"formulago/data/ent/useridentity"
+	"formulago/data/ent/webauthncredential"
+	"formulago/pkg/rolerule"
 	"sync"
 	"time"
 
@@ -32,15 +42,24 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeAPI              = "API"
-	TypeDictionary       = "Dictionary"
-	TypeDictionaryDetail = "DictionaryDetail"
-	TypeLogs             = "Logs"
-	TypeMenu             = "Menu"
-	TypeOauthProvider    = "OauthProvider"
-	TypeRole             = "Role"
-	TypeToken            = "Token"
-	TypeUser             = "User"
+	TypeAPI                = "API"
+	TypeAPIKey             = "APIKey"
+	TypeDictionary         = "Dictionary"
+	TypeDictionaryDetail   = "DictionaryDetail"
+	TypeLoginEvent         = "LoginEvent"
+	TypeLogs               = "Logs"
+	TypeMenu               = "Menu"
+	TypeOauthProvider      = "OauthProvider"
+	TypeOidcClient         = "OidcClient"
+	TypeOidcConsent        = "OidcConsent"
+	TypeRefreshToken       = "RefreshToken"
+	TypeRole               = "Role"
+	TypeSamlProvider       = "SamlProvider"
+	TypeTenant             = "Tenant"
+	TypeToken              = "Token"
+	TypeUser               = "User"
+	TypeUserIdentity       = "UserIdentity"
+	TypeWebauthnCredential = "WebauthnCredential"
 )
 
 // APIMutation represents an operation that mutates the API nodes in the graph.
@@ -645,39 +664,42 @@ func (m *APIMutation) ResetEdge(name string) error {
 	return fmt.Errorf("unknown API edge %s", name)
 }
 
-// DictionaryMutation represents an operation that mutates the Dictionary nodes in the graph.
-type DictionaryMutation struct {
+// APIKeyMutation represents an operation that mutates the APIKey nodes in the graph.
+type APIKeyMutation struct {
 	config
-	op                        Op
-	typ                       string
-	id                        *uint64
-	created_at                *time.Time
-	updated_at                *time.Time
-	status                    *uint8
-	addstatus                 *int8
-	title                     *string
-	name                      *string
-	description               *string
-	clearedFields             map[string]struct{}
-	dictionary_details        map[uint64]struct{}
-	removeddictionary_details map[uint64]struct{}
-	cleareddictionary_details bool
-	done                      bool
-	oldValue                  func(context.Context) (*Dictionary, error)
-	predicates                []predicate.Dictionary
+	op            Op
+	typ           string
+	id            *uint64
+	created_at    *time.Time
+	updated_at    *time.Time
+	name          *string
+	prefix        *string
+	key_hash      *string
+	scopes        *[]string
+	appendscopes  []string
+	expired_at    *time.Time
+	last_used_at  *time.Time
+	last_used_ip  *string
+	revoked_at    *time.Time
+	clearedFields map[string]struct{}
+	owner         *uint64
+	clearedowner  bool
+	done          bool
+	oldValue      func(context.Context) (*APIKey, error)
+	predicates    []predicate.APIKey
 }
 
-var _ ent.Mutation = (*DictionaryMutation)(nil)
+var _ ent.Mutation = (*APIKeyMutation)(nil)
 
-// dictionaryOption allows management of the mutation configuration using functional options.
-type dictionaryOption func(*DictionaryMutation)
+// apikeyOption allows management of the mutation configuration using functional options.
+type apikeyOption func(*APIKeyMutation)
 
-// newDictionaryMutation creates new mutation for the Dictionary entity.
-func newDictionaryMutation(c config, op Op, opts ...dictionaryOption) *DictionaryMutation {
-	m := &DictionaryMutation{
+// newAPIKeyMutation creates new mutation for the APIKey entity.
+func newAPIKeyMutation(c config, op Op, opts ...apikeyOption) *APIKeyMutation {
+	m := &APIKeyMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeDictionary,
+		typ:           TypeAPIKey,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -686,20 +708,20 @@ func newDictionaryMutation(c config, op Op, opts ...dictionaryOption) *Dictionar
 	return m
 }
 
-// withDictionaryID sets the ID field of the mutation.
-func withDictionaryID(id uint64) dictionaryOption {
-	return func(m *DictionaryMutation) {
+// withAPIKeyID sets the ID field of the mutation.
+func withAPIKeyID(id uint64) apikeyOption {
+	return func(m *APIKeyMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Dictionary
+			value *APIKey
 		)
-		m.oldValue = func(ctx context.Context) (*Dictionary, error) {
+		m.oldValue = func(ctx context.Context) (*APIKey, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Dictionary.Get(ctx, id)
+					value, err = m.Client().APIKey.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -708,10 +730,10 @@ func withDictionaryID(id uint64) dictionaryOption {
 	}
 }
 
-// withDictionary sets the old Dictionary of the mutation.
-func withDictionary(node *Dictionary) dictionaryOption {
-	return func(m *DictionaryMutation) {
-		m.oldValue = func(context.Context) (*Dictionary, error) {
+// withAPIKey sets the old APIKey of the mutation.
+func withAPIKey(node *APIKey) apikeyOption {
+	return func(m *APIKeyMutation) {
+		m.oldValue = func(context.Context) (*APIKey, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -720,7 +742,7 @@ func withDictionary(node *Dictionary) dictionaryOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m DictionaryMutation) Client() *Client {
+func (m APIKeyMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -728,7 +750,7 @@ func (m DictionaryMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m DictionaryMutation) Tx() (*Tx, error) {
+func (m APIKeyMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -738,14 +760,14 @@ func (m DictionaryMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Dictionary entities.
-func (m *DictionaryMutation) SetID(id uint64) {
+// operation is only accepted on creation of APIKey entities.
+func (m *APIKeyMutation) SetID(id uint64) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *DictionaryMutation) ID() (id uint64, exists bool) {
+func (m *APIKeyMutation) ID() (id uint64, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -756,7 +778,7 @@ func (m *DictionaryMutation) ID() (id uint64, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *DictionaryMutation) IDs(ctx context.Context) ([]uint64, error) {
+func (m *APIKeyMutation) IDs(ctx context.Context) ([]uint64, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -765,19 +787,19 @@ func (m *DictionaryMutation) IDs(ctx context.Context) ([]uint64, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Dictionary.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().APIKey.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *DictionaryMutation) SetCreatedAt(t time.Time) {
+func (m *APIKeyMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *DictionaryMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *APIKeyMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -785,10 +807,10 @@ func (m *DictionaryMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Dictionary entity.
-// If the Dictionary object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DictionaryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *APIKeyMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -803,17 +825,17 @@ func (m *DictionaryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *DictionaryMutation) ResetCreatedAt() {
+func (m *APIKeyMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *DictionaryMutation) SetUpdatedAt(t time.Time) {
+func (m *APIKeyMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *DictionaryMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *APIKeyMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -821,10 +843,10 @@ func (m *DictionaryMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Dictionary entity.
-// If the Dictionary object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DictionaryMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *APIKeyMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -839,251 +861,437 @@ func (m *DictionaryMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *DictionaryMutation) ResetUpdatedAt() {
+func (m *APIKeyMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
-// SetStatus sets the "status" field.
-func (m *DictionaryMutation) SetStatus(u uint8) {
-	m.status = &u
-	m.addstatus = nil
+// SetUserID sets the "user_id" field.
+func (m *APIKeyMutation) SetUserID(u uint64) {
+	m.owner = &u
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *DictionaryMutation) Status() (r uint8, exists bool) {
-	v := m.status
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *APIKeyMutation) UserID() (r uint64, exists bool) {
+	v := m.owner
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStatus returns the old "status" field's value of the Dictionary entity.
-// If the Dictionary object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DictionaryMutation) OldStatus(ctx context.Context) (v uint8, err error) {
+func (m *APIKeyMutation) OldUserID(ctx context.Context) (v uint64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
+		return v, errors.New("OldUserID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
 	}
-	return oldValue.Status, nil
+	return oldValue.UserID, nil
 }
 
-// AddStatus adds u to the "status" field.
-func (m *DictionaryMutation) AddStatus(u int8) {
-	if m.addstatus != nil {
-		*m.addstatus += u
-	} else {
-		m.addstatus = &u
-	}
+// ResetUserID resets all changes to the "user_id" field.
+func (m *APIKeyMutation) ResetUserID() {
+	m.owner = nil
 }
 
-// AddedStatus returns the value that was added to the "status" field in this mutation.
-func (m *DictionaryMutation) AddedStatus() (r int8, exists bool) {
-	v := m.addstatus
+// SetName sets the "name" field.
+func (m *APIKeyMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *APIKeyMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ClearStatus clears the value of the "status" field.
-func (m *DictionaryMutation) ClearStatus() {
-	m.status = nil
-	m.addstatus = nil
-	m.clearedFields[dictionary.FieldStatus] = struct{}{}
-}
-
-// StatusCleared returns if the "status" field was cleared in this mutation.
-func (m *DictionaryMutation) StatusCleared() bool {
-	_, ok := m.clearedFields[dictionary.FieldStatus]
-	return ok
+// OldName returns the old "name" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *APIKeyMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *DictionaryMutation) ResetStatus() {
-	m.status = nil
-	m.addstatus = nil
-	delete(m.clearedFields, dictionary.FieldStatus)
+// ResetName resets all changes to the "name" field.
+func (m *APIKeyMutation) ResetName() {
+	m.name = nil
 }
 
-// SetTitle sets the "title" field.
-func (m *DictionaryMutation) SetTitle(s string) {
-	m.title = &s
+// SetPrefix sets the "prefix" field.
+func (m *APIKeyMutation) SetPrefix(s string) {
+	m.prefix = &s
 }
 
-// Title returns the value of the "title" field in the mutation.
-func (m *DictionaryMutation) Title() (r string, exists bool) {
-	v := m.title
+// Prefix returns the value of the "prefix" field in the mutation.
+func (m *APIKeyMutation) Prefix() (r string, exists bool) {
+	v := m.prefix
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTitle returns the old "title" field's value of the Dictionary entity.
-// If the Dictionary object wasn't provided to the builder, the object is fetched from the database.
+// OldPrefix returns the old "prefix" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DictionaryMutation) OldTitle(ctx context.Context) (v string, err error) {
+func (m *APIKeyMutation) OldPrefix(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+		return v, errors.New("OldPrefix is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTitle requires an ID field in the mutation")
+		return v, errors.New("OldPrefix requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+		return v, fmt.Errorf("querying old value for OldPrefix: %w", err)
 	}
-	return oldValue.Title, nil
+	return oldValue.Prefix, nil
 }
 
-// ResetTitle resets all changes to the "title" field.
-func (m *DictionaryMutation) ResetTitle() {
-	m.title = nil
+// ResetPrefix resets all changes to the "prefix" field.
+func (m *APIKeyMutation) ResetPrefix() {
+	m.prefix = nil
 }
 
-// SetName sets the "name" field.
-func (m *DictionaryMutation) SetName(s string) {
-	m.name = &s
+// SetKeyHash sets the "key_hash" field.
+func (m *APIKeyMutation) SetKeyHash(s string) {
+	m.key_hash = &s
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *DictionaryMutation) Name() (r string, exists bool) {
-	v := m.name
+// KeyHash returns the value of the "key_hash" field in the mutation.
+func (m *APIKeyMutation) KeyHash() (r string, exists bool) {
+	v := m.key_hash
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Dictionary entity.
-// If the Dictionary object wasn't provided to the builder, the object is fetched from the database.
+// OldKeyHash returns the old "key_hash" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DictionaryMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *APIKeyMutation) OldKeyHash(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldKeyHash is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldKeyHash requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldKeyHash: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.KeyHash, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *DictionaryMutation) ResetName() {
-	m.name = nil
+// ResetKeyHash resets all changes to the "key_hash" field.
+func (m *APIKeyMutation) ResetKeyHash() {
+	m.key_hash = nil
 }
 
-// SetDescription sets the "description" field.
-func (m *DictionaryMutation) SetDescription(s string) {
-	m.description = &s
+// SetScopes sets the "scopes" field.
+func (m *APIKeyMutation) SetScopes(s []string) {
+	m.scopes = &s
+	m.appendscopes = nil
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *DictionaryMutation) Description() (r string, exists bool) {
-	v := m.description
+// Scopes returns the value of the "scopes" field in the mutation.
+func (m *APIKeyMutation) Scopes() (r []string, exists bool) {
+	v := m.scopes
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the Dictionary entity.
-// If the Dictionary object wasn't provided to the builder, the object is fetched from the database.
+// OldScopes returns the old "scopes" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DictionaryMutation) OldDescription(ctx context.Context) (v string, err error) {
+func (m *APIKeyMutation) OldScopes(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+		return v, errors.New("OldScopes is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+		return v, errors.New("OldScopes requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+		return v, fmt.Errorf("querying old value for OldScopes: %w", err)
 	}
-	return oldValue.Description, nil
+	return oldValue.Scopes, nil
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *DictionaryMutation) ResetDescription() {
-	m.description = nil
+// AppendScopes adds s to the "scopes" field.
+func (m *APIKeyMutation) AppendScopes(s []string) {
+	m.appendscopes = append(m.appendscopes, s...)
 }
 
-// AddDictionaryDetailIDs adds the "dictionary_details" edge to the DictionaryDetail entity by ids.
-func (m *DictionaryMutation) AddDictionaryDetailIDs(ids ...uint64) {
-	if m.dictionary_details == nil {
-		m.dictionary_details = make(map[uint64]struct{})
-	}
-	for i := range ids {
-		m.dictionary_details[ids[i]] = struct{}{}
+// AppendedScopes returns the list of values that were appended to the "scopes" field in this mutation.
+func (m *APIKeyMutation) AppendedScopes() ([]string, bool) {
+	if len(m.appendscopes) == 0 {
+		return nil, false
 	}
+	return m.appendscopes, true
 }
 
-// ClearDictionaryDetails clears the "dictionary_details" edge to the DictionaryDetail entity.
-func (m *DictionaryMutation) ClearDictionaryDetails() {
-	m.cleareddictionary_details = true
+// ResetScopes resets all changes to the "scopes" field.
+func (m *APIKeyMutation) ResetScopes() {
+	m.scopes = nil
+	m.appendscopes = nil
 }
 
-// DictionaryDetailsCleared reports if the "dictionary_details" edge to the DictionaryDetail entity was cleared.
-func (m *DictionaryMutation) DictionaryDetailsCleared() bool {
-	return m.cleareddictionary_details
+// SetExpiredAt sets the "expired_at" field.
+func (m *APIKeyMutation) SetExpiredAt(t time.Time) {
+	m.expired_at = &t
 }
 
-// RemoveDictionaryDetailIDs removes the "dictionary_details" edge to the DictionaryDetail entity by IDs.
-func (m *DictionaryMutation) RemoveDictionaryDetailIDs(ids ...uint64) {
-	if m.removeddictionary_details == nil {
-		m.removeddictionary_details = make(map[uint64]struct{})
-	}
-	for i := range ids {
-		delete(m.dictionary_details, ids[i])
-		m.removeddictionary_details[ids[i]] = struct{}{}
+// ExpiredAt returns the value of the "expired_at" field in the mutation.
+func (m *APIKeyMutation) ExpiredAt() (r time.Time, exists bool) {
+	v := m.expired_at
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedDictionaryDetails returns the removed IDs of the "dictionary_details" edge to the DictionaryDetail entity.
-func (m *DictionaryMutation) RemovedDictionaryDetailsIDs() (ids []uint64) {
-	for id := range m.removeddictionary_details {
-		ids = append(ids, id)
+// OldExpiredAt returns the old "expired_at" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *APIKeyMutation) OldExpiredAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiredAt is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiredAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiredAt: %w", err)
+	}
+	return oldValue.ExpiredAt, nil
 }
 
-// DictionaryDetailsIDs returns the "dictionary_details" edge IDs in the mutation.
-func (m *DictionaryMutation) DictionaryDetailsIDs() (ids []uint64) {
-	for id := range m.dictionary_details {
-		ids = append(ids, id)
-	}
-	return
+// ResetExpiredAt resets all changes to the "expired_at" field.
+func (m *APIKeyMutation) ResetExpiredAt() {
+	m.expired_at = nil
 }
 
-// ResetDictionaryDetails resets all changes to the "dictionary_details" edge.
-func (m *DictionaryMutation) ResetDictionaryDetails() {
-	m.dictionary_details = nil
-	m.cleareddictionary_details = false
-	m.removeddictionary_details = nil
+// SetLastUsedAt sets the "last_used_at" field.
+func (m *APIKeyMutation) SetLastUsedAt(t time.Time) {
+	m.last_used_at = &t
 }
 
-// Where appends a list predicates to the DictionaryMutation builder.
-func (m *DictionaryMutation) Where(ps ...predicate.Dictionary) {
-	m.predicates = append(m.predicates, ps...)
+// LastUsedAt returns the value of the "last_used_at" field in the mutation.
+func (m *APIKeyMutation) LastUsedAt() (r time.Time, exists bool) {
+	v := m.last_used_at
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// WhereP appends storage-level predicates to the DictionaryMutation builder. Using this method,
+// OldLastUsedAt returns the old "last_used_at" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *APIKeyMutation) OldLastUsedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastUsedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastUsedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastUsedAt: %w", err)
+	}
+	return oldValue.LastUsedAt, nil
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (m *APIKeyMutation) ClearLastUsedAt() {
+	m.last_used_at = nil
+	m.clearedFields[apikey.FieldLastUsedAt] = struct{}{}
+}
+
+// LastUsedAtCleared returns if the "last_used_at" field was cleared in this mutation.
+func (m *APIKeyMutation) LastUsedAtCleared() bool {
+	_, ok := m.clearedFields[apikey.FieldLastUsedAt]
+	return ok
+}
+
+// ResetLastUsedAt resets all changes to the "last_used_at" field.
+func (m *APIKeyMutation) ResetLastUsedAt() {
+	m.last_used_at = nil
+	delete(m.clearedFields, apikey.FieldLastUsedAt)
+}
+
+// SetLastUsedIP sets the "last_used_ip" field.
+func (m *APIKeyMutation) SetLastUsedIP(s string) {
+	m.last_used_ip = &s
+}
+
+// LastUsedIP returns the value of the "last_used_ip" field in the mutation.
+func (m *APIKeyMutation) LastUsedIP() (r string, exists bool) {
+	v := m.last_used_ip
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastUsedIP returns the old "last_used_ip" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *APIKeyMutation) OldLastUsedIP(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastUsedIP is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastUsedIP requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastUsedIP: %w", err)
+	}
+	return oldValue.LastUsedIP, nil
+}
+
+// ClearLastUsedIP clears the value of the "last_used_ip" field.
+func (m *APIKeyMutation) ClearLastUsedIP() {
+	m.last_used_ip = nil
+	m.clearedFields[apikey.FieldLastUsedIP] = struct{}{}
+}
+
+// LastUsedIPCleared returns if the "last_used_ip" field was cleared in this mutation.
+func (m *APIKeyMutation) LastUsedIPCleared() bool {
+	_, ok := m.clearedFields[apikey.FieldLastUsedIP]
+	return ok
+}
+
+// ResetLastUsedIP resets all changes to the "last_used_ip" field.
+func (m *APIKeyMutation) ResetLastUsedIP() {
+	m.last_used_ip = nil
+	delete(m.clearedFields, apikey.FieldLastUsedIP)
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (m *APIKeyMutation) SetRevokedAt(t time.Time) {
+	m.revoked_at = &t
+}
+
+// RevokedAt returns the value of the "revoked_at" field in the mutation.
+func (m *APIKeyMutation) RevokedAt() (r time.Time, exists bool) {
+	v := m.revoked_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRevokedAt returns the old "revoked_at" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *APIKeyMutation) OldRevokedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRevokedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRevokedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRevokedAt: %w", err)
+	}
+	return oldValue.RevokedAt, nil
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (m *APIKeyMutation) ClearRevokedAt() {
+	m.revoked_at = nil
+	m.clearedFields[apikey.FieldRevokedAt] = struct{}{}
+}
+
+// RevokedAtCleared returns if the "revoked_at" field was cleared in this mutation.
+func (m *APIKeyMutation) RevokedAtCleared() bool {
+	_, ok := m.clearedFields[apikey.FieldRevokedAt]
+	return ok
+}
+
+// ResetRevokedAt resets all changes to the "revoked_at" field.
+func (m *APIKeyMutation) ResetRevokedAt() {
+	m.revoked_at = nil
+	delete(m.clearedFields, apikey.FieldRevokedAt)
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by id.
+func (m *APIKeyMutation) SetOwnerID(id uint64) {
+	m.owner = &id
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (m *APIKeyMutation) ClearOwner() {
+	m.clearedowner = true
+	m.clearedFields[apikey.FieldUserID] = struct{}{}
+}
+
+// OwnerCleared reports if the "owner" edge to the User entity was cleared.
+func (m *APIKeyMutation) OwnerCleared() bool {
+	return m.clearedowner
+}
+
+// OwnerID returns the "owner" edge ID in the mutation.
+func (m *APIKeyMutation) OwnerID() (id uint64, exists bool) {
+	if m.owner != nil {
+		return *m.owner, true
+	}
+	return
+}
+
+// OwnerIDs returns the "owner" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OwnerID instead. It exists only for internal usage by the builders.
+func (m *APIKeyMutation) OwnerIDs() (ids []uint64) {
+	if id := m.owner; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetOwner resets all changes to the "owner" edge.
+func (m *APIKeyMutation) ResetOwner() {
+	m.owner = nil
+	m.clearedowner = false
+}
+
+// Where appends a list predicates to the APIKeyMutation builder.
+func (m *APIKeyMutation) Where(ps ...predicate.APIKey) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the APIKeyMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *DictionaryMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Dictionary, len(ps))
+func (m *APIKeyMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.APIKey, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1091,42 +1299,57 @@ func (m *DictionaryMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *DictionaryMutation) Op() Op {
+func (m *APIKeyMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *DictionaryMutation) SetOp(op Op) {
+func (m *APIKeyMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Dictionary).
-func (m *DictionaryMutation) Type() string {
+// Type returns the node type of this mutation (APIKey).
+func (m *APIKeyMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *DictionaryMutation) Fields() []string {
-	fields := make([]string, 0, 6)
+func (m *APIKeyMutation) Fields() []string {
+	fields := make([]string, 0, 11)
 	if m.created_at != nil {
-		fields = append(fields, dictionary.FieldCreatedAt)
+		fields = append(fields, apikey.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, dictionary.FieldUpdatedAt)
-	}
-	if m.status != nil {
-		fields = append(fields, dictionary.FieldStatus)
+		fields = append(fields, apikey.FieldUpdatedAt)
 	}
-	if m.title != nil {
-		fields = append(fields, dictionary.FieldTitle)
+	if m.owner != nil {
+		fields = append(fields, apikey.FieldUserID)
 	}
 	if m.name != nil {
-		fields = append(fields, dictionary.FieldName)
+		fields = append(fields, apikey.FieldName)
 	}
-	if m.description != nil {
-		fields = append(fields, dictionary.FieldDescription)
+	if m.prefix != nil {
+		fields = append(fields, apikey.FieldPrefix)
+	}
+	if m.key_hash != nil {
+		fields = append(fields, apikey.FieldKeyHash)
+	}
+	if m.scopes != nil {
+		fields = append(fields, apikey.FieldScopes)
+	}
+	if m.expired_at != nil {
+		fields = append(fields, apikey.FieldExpiredAt)
+	}
+	if m.last_used_at != nil {
+		fields = append(fields, apikey.FieldLastUsedAt)
+	}
+	if m.last_used_ip != nil {
+		fields = append(fields, apikey.FieldLastUsedIP)
+	}
+	if m.revoked_at != nil {
+		fields = append(fields, apikey.FieldRevokedAt)
 	}
 	return fields
 }
@@ -1134,20 +1357,30 @@ func (m *DictionaryMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *DictionaryMutation) Field(name string) (ent.Value, bool) {
+func (m *APIKeyMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case dictionary.FieldCreatedAt:
+	case apikey.FieldCreatedAt:
 		return m.CreatedAt()
-	case dictionary.FieldUpdatedAt:
+	case apikey.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case dictionary.FieldStatus:
-		return m.Status()
-	case dictionary.FieldTitle:
-		return m.Title()
-	case dictionary.FieldName:
+	case apikey.FieldUserID:
+		return m.UserID()
+	case apikey.FieldName:
 		return m.Name()
-	case dictionary.FieldDescription:
-		return m.Description()
+	case apikey.FieldPrefix:
+		return m.Prefix()
+	case apikey.FieldKeyHash:
+		return m.KeyHash()
+	case apikey.FieldScopes:
+		return m.Scopes()
+	case apikey.FieldExpiredAt:
+		return m.ExpiredAt()
+	case apikey.FieldLastUsedAt:
+		return m.LastUsedAt()
+	case apikey.FieldLastUsedIP:
+		return m.LastUsedIP()
+	case apikey.FieldRevokedAt:
+		return m.RevokedAt()
 	}
 	return nil, false
 }
@@ -1155,92 +1388,132 @@ func (m *DictionaryMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *DictionaryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *APIKeyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case dictionary.FieldCreatedAt:
+	case apikey.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case dictionary.FieldUpdatedAt:
+	case apikey.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case dictionary.FieldStatus:
-		return m.OldStatus(ctx)
-	case dictionary.FieldTitle:
-		return m.OldTitle(ctx)
-	case dictionary.FieldName:
+	case apikey.FieldUserID:
+		return m.OldUserID(ctx)
+	case apikey.FieldName:
 		return m.OldName(ctx)
-	case dictionary.FieldDescription:
-		return m.OldDescription(ctx)
+	case apikey.FieldPrefix:
+		return m.OldPrefix(ctx)
+	case apikey.FieldKeyHash:
+		return m.OldKeyHash(ctx)
+	case apikey.FieldScopes:
+		return m.OldScopes(ctx)
+	case apikey.FieldExpiredAt:
+		return m.OldExpiredAt(ctx)
+	case apikey.FieldLastUsedAt:
+		return m.OldLastUsedAt(ctx)
+	case apikey.FieldLastUsedIP:
+		return m.OldLastUsedIP(ctx)
+	case apikey.FieldRevokedAt:
+		return m.OldRevokedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Dictionary field %s", name)
+	return nil, fmt.Errorf("unknown APIKey field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DictionaryMutation) SetField(name string, value ent.Value) error {
+func (m *APIKeyMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case dictionary.FieldCreatedAt:
+	case apikey.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case dictionary.FieldUpdatedAt:
+	case apikey.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case dictionary.FieldStatus:
-		v, ok := value.(uint8)
+	case apikey.FieldUserID:
+		v, ok := value.(uint64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStatus(v)
+		m.SetUserID(v)
 		return nil
-	case dictionary.FieldTitle:
+	case apikey.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTitle(v)
+		m.SetName(v)
 		return nil
-	case dictionary.FieldName:
+	case apikey.FieldPrefix:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetPrefix(v)
 		return nil
-	case dictionary.FieldDescription:
+	case apikey.FieldKeyHash:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDescription(v)
+		m.SetKeyHash(v)
+		return nil
+	case apikey.FieldScopes:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScopes(v)
+		return nil
+	case apikey.FieldExpiredAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiredAt(v)
+		return nil
+	case apikey.FieldLastUsedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastUsedAt(v)
+		return nil
+	case apikey.FieldLastUsedIP:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastUsedIP(v)
+		return nil
+	case apikey.FieldRevokedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRevokedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Dictionary field %s", name)
+	return fmt.Errorf("unknown APIKey field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *DictionaryMutation) AddedFields() []string {
+func (m *APIKeyMutation) AddedFields() []string {
 	var fields []string
-	if m.addstatus != nil {
-		fields = append(fields, dictionary.FieldStatus)
-	}
 	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *DictionaryMutation) AddedField(name string) (ent.Value, bool) {
+func (m *APIKeyMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case dictionary.FieldStatus:
-		return m.AddedStatus()
 	}
 	return nil, false
 }
@@ -1248,189 +1521,202 @@ func (m *DictionaryMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DictionaryMutation) AddField(name string, value ent.Value) error {
+func (m *APIKeyMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case dictionary.FieldStatus:
-		v, ok := value.(int8)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddStatus(v)
-		return nil
 	}
-	return fmt.Errorf("unknown Dictionary numeric field %s", name)
+	return fmt.Errorf("unknown APIKey numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *DictionaryMutation) ClearedFields() []string {
+func (m *APIKeyMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(dictionary.FieldStatus) {
-		fields = append(fields, dictionary.FieldStatus)
+	if m.FieldCleared(apikey.FieldLastUsedAt) {
+		fields = append(fields, apikey.FieldLastUsedAt)
+	}
+	if m.FieldCleared(apikey.FieldLastUsedIP) {
+		fields = append(fields, apikey.FieldLastUsedIP)
+	}
+	if m.FieldCleared(apikey.FieldRevokedAt) {
+		fields = append(fields, apikey.FieldRevokedAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *DictionaryMutation) FieldCleared(name string) bool {
+func (m *APIKeyMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *DictionaryMutation) ClearField(name string) error {
+func (m *APIKeyMutation) ClearField(name string) error {
 	switch name {
-	case dictionary.FieldStatus:
-		m.ClearStatus()
+	case apikey.FieldLastUsedAt:
+		m.ClearLastUsedAt()
+		return nil
+	case apikey.FieldLastUsedIP:
+		m.ClearLastUsedIP()
+		return nil
+	case apikey.FieldRevokedAt:
+		m.ClearRevokedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Dictionary nullable field %s", name)
+	return fmt.Errorf("unknown APIKey nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *DictionaryMutation) ResetField(name string) error {
+func (m *APIKeyMutation) ResetField(name string) error {
 	switch name {
-	case dictionary.FieldCreatedAt:
+	case apikey.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case dictionary.FieldUpdatedAt:
+	case apikey.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case dictionary.FieldStatus:
-		m.ResetStatus()
-		return nil
-	case dictionary.FieldTitle:
-		m.ResetTitle()
+	case apikey.FieldUserID:
+		m.ResetUserID()
 		return nil
-	case dictionary.FieldName:
+	case apikey.FieldName:
 		m.ResetName()
 		return nil
-	case dictionary.FieldDescription:
-		m.ResetDescription()
+	case apikey.FieldPrefix:
+		m.ResetPrefix()
+		return nil
+	case apikey.FieldKeyHash:
+		m.ResetKeyHash()
+		return nil
+	case apikey.FieldScopes:
+		m.ResetScopes()
+		return nil
+	case apikey.FieldExpiredAt:
+		m.ResetExpiredAt()
+		return nil
+	case apikey.FieldLastUsedAt:
+		m.ResetLastUsedAt()
+		return nil
+	case apikey.FieldLastUsedIP:
+		m.ResetLastUsedIP()
+		return nil
+	case apikey.FieldRevokedAt:
+		m.ResetRevokedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Dictionary field %s", name)
+	return fmt.Errorf("unknown APIKey field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *DictionaryMutation) AddedEdges() []string {
+func (m *APIKeyMutation) AddedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.dictionary_details != nil {
-		edges = append(edges, dictionary.EdgeDictionaryDetails)
+	if m.owner != nil {
+		edges = append(edges, apikey.EdgeOwner)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *DictionaryMutation) AddedIDs(name string) []ent.Value {
+func (m *APIKeyMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case dictionary.EdgeDictionaryDetails:
-		ids := make([]ent.Value, 0, len(m.dictionary_details))
-		for id := range m.dictionary_details {
-			ids = append(ids, id)
+	case apikey.EdgeOwner:
+		if id := m.owner; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *DictionaryMutation) RemovedEdges() []string {
+func (m *APIKeyMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.removeddictionary_details != nil {
-		edges = append(edges, dictionary.EdgeDictionaryDetails)
-	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *DictionaryMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case dictionary.EdgeDictionaryDetails:
-		ids := make([]ent.Value, 0, len(m.removeddictionary_details))
-		for id := range m.removeddictionary_details {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *APIKeyMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *DictionaryMutation) ClearedEdges() []string {
+func (m *APIKeyMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.cleareddictionary_details {
-		edges = append(edges, dictionary.EdgeDictionaryDetails)
+	if m.clearedowner {
+		edges = append(edges, apikey.EdgeOwner)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *DictionaryMutation) EdgeCleared(name string) bool {
+func (m *APIKeyMutation) EdgeCleared(name string) bool {
 	switch name {
-	case dictionary.EdgeDictionaryDetails:
-		return m.cleareddictionary_details
+	case apikey.EdgeOwner:
+		return m.clearedowner
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *DictionaryMutation) ClearEdge(name string) error {
+func (m *APIKeyMutation) ClearEdge(name string) error {
 	switch name {
+	case apikey.EdgeOwner:
+		m.ClearOwner()
+		return nil
 	}
-	return fmt.Errorf("unknown Dictionary unique edge %s", name)
+	return fmt.Errorf("unknown APIKey unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *DictionaryMutation) ResetEdge(name string) error {
+func (m *APIKeyMutation) ResetEdge(name string) error {
 	switch name {
-	case dictionary.EdgeDictionaryDetails:
-		m.ResetDictionaryDetails()
+	case apikey.EdgeOwner:
+		m.ResetOwner()
 		return nil
 	}
-	return fmt.Errorf("unknown Dictionary edge %s", name)
+	return fmt.Errorf("unknown APIKey edge %s", name)
 }
 
-// DictionaryDetailMutation represents an operation that mutates the DictionaryDetail nodes in the graph.
-type DictionaryDetailMutation struct {
+// DictionaryMutation represents an operation that mutates the Dictionary nodes in the graph.
+type DictionaryMutation struct {
 	config
-	op                Op
-	typ               string
-	id                *uint64
-	created_at        *time.Time
-	updated_at        *time.Time
-	status            *uint8
-	addstatus         *int8
-	title             *string
-	key               *string
-	value             *string
-	clearedFields     map[string]struct{}
-	dictionary        *uint64
-	cleareddictionary bool
-	done              bool
-	oldValue          func(context.Context) (*DictionaryDetail, error)
-	predicates        []predicate.DictionaryDetail
+	op                        Op
+	typ                       string
+	id                        *uint64
+	created_at                *time.Time
+	updated_at                *time.Time
+	status                    *uint8
+	addstatus                 *int8
+	tenant_id                 *uint64
+	addtenant_id              *int64
+	title                     *string
+	name                      *string
+	description               *string
+	clearedFields             map[string]struct{}
+	dictionary_details        map[uint64]struct{}
+	removeddictionary_details map[uint64]struct{}
+	cleareddictionary_details bool
+	done                      bool
+	oldValue                  func(context.Context) (*Dictionary, error)
+	predicates                []predicate.Dictionary
 }
 
-var _ ent.Mutation = (*DictionaryDetailMutation)(nil)
+var _ ent.Mutation = (*DictionaryMutation)(nil)
 
-// dictionarydetailOption allows management of the mutation configuration using functional options.
-type dictionarydetailOption func(*DictionaryDetailMutation)
+// dictionaryOption allows management of the mutation configuration using functional options.
+type dictionaryOption func(*DictionaryMutation)
 
-// newDictionaryDetailMutation creates new mutation for the DictionaryDetail entity.
-func newDictionaryDetailMutation(c config, op Op, opts ...dictionarydetailOption) *DictionaryDetailMutation {
-	m := &DictionaryDetailMutation{
+// newDictionaryMutation creates new mutation for the Dictionary entity.
+func newDictionaryMutation(c config, op Op, opts ...dictionaryOption) *DictionaryMutation {
+	m := &DictionaryMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeDictionaryDetail,
+		typ:           TypeDictionary,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1439,20 +1725,20 @@ func newDictionaryDetailMutation(c config, op Op, opts ...dictionarydetailOption
 	return m
 }
 
-// withDictionaryDetailID sets the ID field of the mutation.
-func withDictionaryDetailID(id uint64) dictionarydetailOption {
-	return func(m *DictionaryDetailMutation) {
+// withDictionaryID sets the ID field of the mutation.
+func withDictionaryID(id uint64) dictionaryOption {
+	return func(m *DictionaryMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *DictionaryDetail
+			value *Dictionary
 		)
-		m.oldValue = func(ctx context.Context) (*DictionaryDetail, error) {
+		m.oldValue = func(ctx context.Context) (*Dictionary, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().DictionaryDetail.Get(ctx, id)
+					value, err = m.Client().Dictionary.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1461,10 +1747,10 @@ func withDictionaryDetailID(id uint64) dictionarydetailOption {
 	}
 }
 
-// withDictionaryDetail sets the old DictionaryDetail of the mutation.
-func withDictionaryDetail(node *DictionaryDetail) dictionarydetailOption {
-	return func(m *DictionaryDetailMutation) {
-		m.oldValue = func(context.Context) (*DictionaryDetail, error) {
+// withDictionary sets the old Dictionary of the mutation.
+func withDictionary(node *Dictionary) dictionaryOption {
+	return func(m *DictionaryMutation) {
+		m.oldValue = func(context.Context) (*Dictionary, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1473,7 +1759,7 @@ func withDictionaryDetail(node *DictionaryDetail) dictionarydetailOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m DictionaryDetailMutation) Client() *Client {
+func (m DictionaryMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1481,7 +1767,7 @@ func (m DictionaryDetailMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m DictionaryDetailMutation) Tx() (*Tx, error) {
+func (m DictionaryMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1491,14 +1777,14 @@ func (m DictionaryDetailMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of DictionaryDetail entities.
-func (m *DictionaryDetailMutation) SetID(id uint64) {
+// operation is only accepted on creation of Dictionary entities.
+func (m *DictionaryMutation) SetID(id uint64) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *DictionaryDetailMutation) ID() (id uint64, exists bool) {
+func (m *DictionaryMutation) ID() (id uint64, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1509,7 +1795,7 @@ func (m *DictionaryDetailMutation) ID() (id uint64, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *DictionaryDetailMutation) IDs(ctx context.Context) ([]uint64, error) {
+func (m *DictionaryMutation) IDs(ctx context.Context) ([]uint64, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1518,19 +1804,19 @@ func (m *DictionaryDetailMutation) IDs(ctx context.Context) ([]uint64, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().DictionaryDetail.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Dictionary.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *DictionaryDetailMutation) SetCreatedAt(t time.Time) {
+func (m *DictionaryMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *DictionaryDetailMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *DictionaryMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -1538,10 +1824,10 @@ func (m *DictionaryDetailMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the DictionaryDetail entity.
-// If the DictionaryDetail object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Dictionary entity.
+// If the Dictionary object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DictionaryDetailMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *DictionaryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -1556,17 +1842,17 @@ func (m *DictionaryDetailMutation) OldCreatedAt(ctx context.Context) (v time.Tim
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *DictionaryDetailMutation) ResetCreatedAt() {
+func (m *DictionaryMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *DictionaryDetailMutation) SetUpdatedAt(t time.Time) {
+func (m *DictionaryMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *DictionaryDetailMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *DictionaryMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -1574,10 +1860,10 @@ func (m *DictionaryDetailMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the DictionaryDetail entity.
-// If the DictionaryDetail object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the Dictionary entity.
+// If the Dictionary object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DictionaryDetailMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *DictionaryMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -1592,18 +1878,18 @@ func (m *DictionaryDetailMutation) OldUpdatedAt(ctx context.Context) (v time.Tim
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *DictionaryDetailMutation) ResetUpdatedAt() {
+func (m *DictionaryMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
 // SetStatus sets the "status" field.
-func (m *DictionaryDetailMutation) SetStatus(u uint8) {
+func (m *DictionaryMutation) SetStatus(u uint8) {
 	m.status = &u
 	m.addstatus = nil
 }
 
 // Status returns the value of the "status" field in the mutation.
-func (m *DictionaryDetailMutation) Status() (r uint8, exists bool) {
+func (m *DictionaryMutation) Status() (r uint8, exists bool) {
 	v := m.status
 	if v == nil {
 		return
@@ -1611,10 +1897,10 @@ func (m *DictionaryDetailMutation) Status() (r uint8, exists bool) {
 	return *v, true
 }
 
-// OldStatus returns the old "status" field's value of the DictionaryDetail entity.
-// If the DictionaryDetail object wasn't provided to the builder, the object is fetched from the database.
+// OldStatus returns the old "status" field's value of the Dictionary entity.
+// If the Dictionary object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DictionaryDetailMutation) OldStatus(ctx context.Context) (v uint8, err error) {
+func (m *DictionaryMutation) OldStatus(ctx context.Context) (v uint8, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
@@ -1629,7 +1915,7 @@ func (m *DictionaryDetailMutation) OldStatus(ctx context.Context) (v uint8, err
 }
 
 // AddStatus adds u to the "status" field.
-func (m *DictionaryDetailMutation) AddStatus(u int8) {
+func (m *DictionaryMutation) AddStatus(u int8) {
 	if m.addstatus != nil {
 		*m.addstatus += u
 	} else {
@@ -1638,7 +1924,7 @@ func (m *DictionaryDetailMutation) AddStatus(u int8) {
 }
 
 // AddedStatus returns the value that was added to the "status" field in this mutation.
-func (m *DictionaryDetailMutation) AddedStatus() (r int8, exists bool) {
+func (m *DictionaryMutation) AddedStatus() (r int8, exists bool) {
 	v := m.addstatus
 	if v == nil {
 		return
@@ -1647,218 +1933,252 @@ func (m *DictionaryDetailMutation) AddedStatus() (r int8, exists bool) {
 }
 
 // ClearStatus clears the value of the "status" field.
-func (m *DictionaryDetailMutation) ClearStatus() {
+func (m *DictionaryMutation) ClearStatus() {
 	m.status = nil
 	m.addstatus = nil
-	m.clearedFields[dictionarydetail.FieldStatus] = struct{}{}
+	m.clearedFields[dictionary.FieldStatus] = struct{}{}
 }
 
 // StatusCleared returns if the "status" field was cleared in this mutation.
-func (m *DictionaryDetailMutation) StatusCleared() bool {
-	_, ok := m.clearedFields[dictionarydetail.FieldStatus]
+func (m *DictionaryMutation) StatusCleared() bool {
+	_, ok := m.clearedFields[dictionary.FieldStatus]
 	return ok
 }
 
 // ResetStatus resets all changes to the "status" field.
-func (m *DictionaryDetailMutation) ResetStatus() {
+func (m *DictionaryMutation) ResetStatus() {
 	m.status = nil
 	m.addstatus = nil
-	delete(m.clearedFields, dictionarydetail.FieldStatus)
+	delete(m.clearedFields, dictionary.FieldStatus)
 }
 
-// SetTitle sets the "title" field.
-func (m *DictionaryDetailMutation) SetTitle(s string) {
-	m.title = &s
+// SetTenantID sets the "tenant_id" field.
+func (m *DictionaryMutation) SetTenantID(u uint64) {
+	m.tenant_id = &u
+	m.addtenant_id = nil
 }
 
-// Title returns the value of the "title" field in the mutation.
-func (m *DictionaryDetailMutation) Title() (r string, exists bool) {
-	v := m.title
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *DictionaryMutation) TenantID() (r uint64, exists bool) {
+	v := m.tenant_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTitle returns the old "title" field's value of the DictionaryDetail entity.
-// If the DictionaryDetail object wasn't provided to the builder, the object is fetched from the database.
+// OldTenantID returns the old "tenant_id" field's value of the Dictionary entity.
+// If the Dictionary object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DictionaryDetailMutation) OldTitle(ctx context.Context) (v string, err error) {
+func (m *DictionaryMutation) OldTenantID(ctx context.Context) (v uint64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTitle requires an ID field in the mutation")
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
 	}
-	return oldValue.Title, nil
+	return oldValue.TenantID, nil
 }
 
-// ResetTitle resets all changes to the "title" field.
-func (m *DictionaryDetailMutation) ResetTitle() {
-	m.title = nil
+// AddTenantID adds u to the "tenant_id" field.
+func (m *DictionaryMutation) AddTenantID(u int64) {
+	if m.addtenant_id != nil {
+		*m.addtenant_id += u
+	} else {
+		m.addtenant_id = &u
+	}
 }
 
-// SetKey sets the "key" field.
-func (m *DictionaryDetailMutation) SetKey(s string) {
-	m.key = &s
+// AddedTenantID returns the value that was added to the "tenant_id" field in this mutation.
+func (m *DictionaryMutation) AddedTenantID() (r int64, exists bool) {
+	v := m.addtenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Key returns the value of the "key" field in the mutation.
-func (m *DictionaryDetailMutation) Key() (r string, exists bool) {
-	v := m.key
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *DictionaryMutation) ResetTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+}
+
+// SetTitle sets the "title" field.
+func (m *DictionaryMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *DictionaryMutation) Title() (r string, exists bool) {
+	v := m.title
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldKey returns the old "key" field's value of the DictionaryDetail entity.
-// If the DictionaryDetail object wasn't provided to the builder, the object is fetched from the database.
+// OldTitle returns the old "title" field's value of the Dictionary entity.
+// If the Dictionary object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DictionaryDetailMutation) OldKey(ctx context.Context) (v string, err error) {
+func (m *DictionaryMutation) OldTitle(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldKey is only allowed on UpdateOne operations")
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldKey requires an ID field in the mutation")
+		return v, errors.New("OldTitle requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldKey: %w", err)
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
 	}
-	return oldValue.Key, nil
+	return oldValue.Title, nil
 }
 
-// ResetKey resets all changes to the "key" field.
-func (m *DictionaryDetailMutation) ResetKey() {
-	m.key = nil
+// ResetTitle resets all changes to the "title" field.
+func (m *DictionaryMutation) ResetTitle() {
+	m.title = nil
 }
 
-// SetValue sets the "value" field.
-func (m *DictionaryDetailMutation) SetValue(s string) {
-	m.value = &s
+// SetName sets the "name" field.
+func (m *DictionaryMutation) SetName(s string) {
+	m.name = &s
 }
 
-// Value returns the value of the "value" field in the mutation.
-func (m *DictionaryDetailMutation) Value() (r string, exists bool) {
-	v := m.value
+// Name returns the value of the "name" field in the mutation.
+func (m *DictionaryMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldValue returns the old "value" field's value of the DictionaryDetail entity.
-// If the DictionaryDetail object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the Dictionary entity.
+// If the Dictionary object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DictionaryDetailMutation) OldValue(ctx context.Context) (v string, err error) {
+func (m *DictionaryMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldValue is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldValue requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldValue: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.Value, nil
+	return oldValue.Name, nil
 }
 
-// ResetValue resets all changes to the "value" field.
-func (m *DictionaryDetailMutation) ResetValue() {
-	m.value = nil
+// ResetName resets all changes to the "name" field.
+func (m *DictionaryMutation) ResetName() {
+	m.name = nil
 }
 
-// SetDictionaryID sets the "dictionary_id" field.
-func (m *DictionaryDetailMutation) SetDictionaryID(u uint64) {
-	m.dictionary = &u
+// SetDescription sets the "description" field.
+func (m *DictionaryMutation) SetDescription(s string) {
+	m.description = &s
 }
 
-// DictionaryID returns the value of the "dictionary_id" field in the mutation.
-func (m *DictionaryDetailMutation) DictionaryID() (r uint64, exists bool) {
-	v := m.dictionary
+// Description returns the value of the "description" field in the mutation.
+func (m *DictionaryMutation) Description() (r string, exists bool) {
+	v := m.description
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDictionaryID returns the old "dictionary_id" field's value of the DictionaryDetail entity.
-// If the DictionaryDetail object wasn't provided to the builder, the object is fetched from the database.
+// OldDescription returns the old "description" field's value of the Dictionary entity.
+// If the Dictionary object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DictionaryDetailMutation) OldDictionaryID(ctx context.Context) (v uint64, err error) {
+func (m *DictionaryMutation) OldDescription(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDictionaryID is only allowed on UpdateOne operations")
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDictionaryID requires an ID field in the mutation")
+		return v, errors.New("OldDescription requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDictionaryID: %w", err)
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
 	}
-	return oldValue.DictionaryID, nil
+	return oldValue.Description, nil
 }
 
-// ClearDictionaryID clears the value of the "dictionary_id" field.
-func (m *DictionaryDetailMutation) ClearDictionaryID() {
-	m.dictionary = nil
-	m.clearedFields[dictionarydetail.FieldDictionaryID] = struct{}{}
+// ResetDescription resets all changes to the "description" field.
+func (m *DictionaryMutation) ResetDescription() {
+	m.description = nil
 }
 
-// DictionaryIDCleared returns if the "dictionary_id" field was cleared in this mutation.
-func (m *DictionaryDetailMutation) DictionaryIDCleared() bool {
-	_, ok := m.clearedFields[dictionarydetail.FieldDictionaryID]
-	return ok
+// AddDictionaryDetailIDs adds the "dictionary_details" edge to the DictionaryDetail entity by ids.
+func (m *DictionaryMutation) AddDictionaryDetailIDs(ids ...uint64) {
+	if m.dictionary_details == nil {
+		m.dictionary_details = make(map[uint64]struct{})
+	}
+	for i := range ids {
+		m.dictionary_details[ids[i]] = struct{}{}
+	}
 }
 
-// ResetDictionaryID resets all changes to the "dictionary_id" field.
-func (m *DictionaryDetailMutation) ResetDictionaryID() {
-	m.dictionary = nil
-	delete(m.clearedFields, dictionarydetail.FieldDictionaryID)
+// ClearDictionaryDetails clears the "dictionary_details" edge to the DictionaryDetail entity.
+func (m *DictionaryMutation) ClearDictionaryDetails() {
+	m.cleareddictionary_details = true
 }
 
-// ClearDictionary clears the "dictionary" edge to the Dictionary entity.
-func (m *DictionaryDetailMutation) ClearDictionary() {
-	m.cleareddictionary = true
-	m.clearedFields[dictionarydetail.FieldDictionaryID] = struct{}{}
+// DictionaryDetailsCleared reports if the "dictionary_details" edge to the DictionaryDetail entity was cleared.
+func (m *DictionaryMutation) DictionaryDetailsCleared() bool {
+	return m.cleareddictionary_details
 }
 
-// DictionaryCleared reports if the "dictionary" edge to the Dictionary entity was cleared.
-func (m *DictionaryDetailMutation) DictionaryCleared() bool {
-	return m.DictionaryIDCleared() || m.cleareddictionary
+// RemoveDictionaryDetailIDs removes the "dictionary_details" edge to the DictionaryDetail entity by IDs.
+func (m *DictionaryMutation) RemoveDictionaryDetailIDs(ids ...uint64) {
+	if m.removeddictionary_details == nil {
+		m.removeddictionary_details = make(map[uint64]struct{})
+	}
+	for i := range ids {
+		delete(m.dictionary_details, ids[i])
+		m.removeddictionary_details[ids[i]] = struct{}{}
+	}
 }
 
-// DictionaryIDs returns the "dictionary" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// DictionaryID instead. It exists only for internal usage by the builders.
-func (m *DictionaryDetailMutation) DictionaryIDs() (ids []uint64) {
-	if id := m.dictionary; id != nil {
-		ids = append(ids, *id)
+// RemovedDictionaryDetails returns the removed IDs of the "dictionary_details" edge to the DictionaryDetail entity.
+func (m *DictionaryMutation) RemovedDictionaryDetailsIDs() (ids []uint64) {
+	for id := range m.removeddictionary_details {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetDictionary resets all changes to the "dictionary" edge.
-func (m *DictionaryDetailMutation) ResetDictionary() {
-	m.dictionary = nil
-	m.cleareddictionary = false
+// DictionaryDetailsIDs returns the "dictionary_details" edge IDs in the mutation.
+func (m *DictionaryMutation) DictionaryDetailsIDs() (ids []uint64) {
+	for id := range m.dictionary_details {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// Where appends a list predicates to the DictionaryDetailMutation builder.
-func (m *DictionaryDetailMutation) Where(ps ...predicate.DictionaryDetail) {
+// ResetDictionaryDetails resets all changes to the "dictionary_details" edge.
+func (m *DictionaryMutation) ResetDictionaryDetails() {
+	m.dictionary_details = nil
+	m.cleareddictionary_details = false
+	m.removeddictionary_details = nil
+}
+
+// Where appends a list predicates to the DictionaryMutation builder.
+func (m *DictionaryMutation) Where(ps ...predicate.Dictionary) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the DictionaryDetailMutation builder. Using this method,
+// WhereP appends storage-level predicates to the DictionaryMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *DictionaryDetailMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.DictionaryDetail, len(ps))
+func (m *DictionaryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Dictionary, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1866,45 +2186,45 @@ func (m *DictionaryDetailMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *DictionaryDetailMutation) Op() Op {
+func (m *DictionaryMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *DictionaryDetailMutation) SetOp(op Op) {
+func (m *DictionaryMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (DictionaryDetail).
-func (m *DictionaryDetailMutation) Type() string {
+// Type returns the node type of this mutation (Dictionary).
+func (m *DictionaryMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *DictionaryDetailMutation) Fields() []string {
+func (m *DictionaryMutation) Fields() []string {
 	fields := make([]string, 0, 7)
 	if m.created_at != nil {
-		fields = append(fields, dictionarydetail.FieldCreatedAt)
+		fields = append(fields, dictionary.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, dictionarydetail.FieldUpdatedAt)
+		fields = append(fields, dictionary.FieldUpdatedAt)
 	}
 	if m.status != nil {
-		fields = append(fields, dictionarydetail.FieldStatus)
+		fields = append(fields, dictionary.FieldStatus)
 	}
-	if m.title != nil {
-		fields = append(fields, dictionarydetail.FieldTitle)
+	if m.tenant_id != nil {
+		fields = append(fields, dictionary.FieldTenantID)
 	}
-	if m.key != nil {
-		fields = append(fields, dictionarydetail.FieldKey)
+	if m.title != nil {
+		fields = append(fields, dictionary.FieldTitle)
 	}
-	if m.value != nil {
-		fields = append(fields, dictionarydetail.FieldValue)
+	if m.name != nil {
+		fields = append(fields, dictionary.FieldName)
 	}
-	if m.dictionary != nil {
-		fields = append(fields, dictionarydetail.FieldDictionaryID)
+	if m.description != nil {
+		fields = append(fields, dictionary.FieldDescription)
 	}
 	return fields
 }
@@ -1912,22 +2232,22 @@ func (m *DictionaryDetailMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *DictionaryDetailMutation) Field(name string) (ent.Value, bool) {
+func (m *DictionaryMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case dictionarydetail.FieldCreatedAt:
+	case dictionary.FieldCreatedAt:
 		return m.CreatedAt()
-	case dictionarydetail.FieldUpdatedAt:
+	case dictionary.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case dictionarydetail.FieldStatus:
+	case dictionary.FieldStatus:
 		return m.Status()
-	case dictionarydetail.FieldTitle:
+	case dictionary.FieldTenantID:
+		return m.TenantID()
+	case dictionary.FieldTitle:
 		return m.Title()
-	case dictionarydetail.FieldKey:
-		return m.Key()
-	case dictionarydetail.FieldValue:
-		return m.Value()
-	case dictionarydetail.FieldDictionaryID:
-		return m.DictionaryID()
+	case dictionary.FieldName:
+		return m.Name()
+	case dictionary.FieldDescription:
+		return m.Description()
 	}
 	return nil, false
 }
@@ -1935,90 +2255,93 @@ func (m *DictionaryDetailMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *DictionaryDetailMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *DictionaryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case dictionarydetail.FieldCreatedAt:
+	case dictionary.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case dictionarydetail.FieldUpdatedAt:
+	case dictionary.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case dictionarydetail.FieldStatus:
+	case dictionary.FieldStatus:
 		return m.OldStatus(ctx)
-	case dictionarydetail.FieldTitle:
+	case dictionary.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case dictionary.FieldTitle:
 		return m.OldTitle(ctx)
-	case dictionarydetail.FieldKey:
-		return m.OldKey(ctx)
-	case dictionarydetail.FieldValue:
-		return m.OldValue(ctx)
-	case dictionarydetail.FieldDictionaryID:
-		return m.OldDictionaryID(ctx)
+	case dictionary.FieldName:
+		return m.OldName(ctx)
+	case dictionary.FieldDescription:
+		return m.OldDescription(ctx)
 	}
-	return nil, fmt.Errorf("unknown DictionaryDetail field %s", name)
+	return nil, fmt.Errorf("unknown Dictionary field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DictionaryDetailMutation) SetField(name string, value ent.Value) error {
+func (m *DictionaryMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case dictionarydetail.FieldCreatedAt:
+	case dictionary.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case dictionarydetail.FieldUpdatedAt:
+	case dictionary.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case dictionarydetail.FieldStatus:
+	case dictionary.FieldStatus:
 		v, ok := value.(uint8)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetStatus(v)
 		return nil
-	case dictionarydetail.FieldTitle:
-		v, ok := value.(string)
+	case dictionary.FieldTenantID:
+		v, ok := value.(uint64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTitle(v)
+		m.SetTenantID(v)
 		return nil
-	case dictionarydetail.FieldKey:
+	case dictionary.FieldTitle:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetKey(v)
+		m.SetTitle(v)
 		return nil
-	case dictionarydetail.FieldValue:
+	case dictionary.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetValue(v)
+		m.SetName(v)
 		return nil
-	case dictionarydetail.FieldDictionaryID:
-		v, ok := value.(uint64)
+	case dictionary.FieldDescription:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDictionaryID(v)
+		m.SetDescription(v)
 		return nil
 	}
-	return fmt.Errorf("unknown DictionaryDetail field %s", name)
+	return fmt.Errorf("unknown Dictionary field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *DictionaryDetailMutation) AddedFields() []string {
+func (m *DictionaryMutation) AddedFields() []string {
 	var fields []string
 	if m.addstatus != nil {
-		fields = append(fields, dictionarydetail.FieldStatus)
+		fields = append(fields, dictionary.FieldStatus)
+	}
+	if m.addtenant_id != nil {
+		fields = append(fields, dictionary.FieldTenantID)
 	}
 	return fields
 }
@@ -2026,10 +2349,12 @@ func (m *DictionaryDetailMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *DictionaryDetailMutation) AddedField(name string) (ent.Value, bool) {
+func (m *DictionaryMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case dictionarydetail.FieldStatus:
+	case dictionary.FieldStatus:
 		return m.AddedStatus()
+	case dictionary.FieldTenantID:
+		return m.AddedTenantID()
 	}
 	return nil, false
 }
@@ -2037,192 +2362,201 @@ func (m *DictionaryDetailMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DictionaryDetailMutation) AddField(name string, value ent.Value) error {
+func (m *DictionaryMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case dictionarydetail.FieldStatus:
+	case dictionary.FieldStatus:
 		v, ok := value.(int8)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.AddStatus(v)
 		return nil
+	case dictionary.FieldTenantID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTenantID(v)
+		return nil
 	}
-	return fmt.Errorf("unknown DictionaryDetail numeric field %s", name)
+	return fmt.Errorf("unknown Dictionary numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *DictionaryDetailMutation) ClearedFields() []string {
+func (m *DictionaryMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(dictionarydetail.FieldStatus) {
-		fields = append(fields, dictionarydetail.FieldStatus)
-	}
-	if m.FieldCleared(dictionarydetail.FieldDictionaryID) {
-		fields = append(fields, dictionarydetail.FieldDictionaryID)
+	if m.FieldCleared(dictionary.FieldStatus) {
+		fields = append(fields, dictionary.FieldStatus)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *DictionaryDetailMutation) FieldCleared(name string) bool {
+func (m *DictionaryMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *DictionaryDetailMutation) ClearField(name string) error {
+func (m *DictionaryMutation) ClearField(name string) error {
 	switch name {
-	case dictionarydetail.FieldStatus:
+	case dictionary.FieldStatus:
 		m.ClearStatus()
 		return nil
-	case dictionarydetail.FieldDictionaryID:
-		m.ClearDictionaryID()
-		return nil
 	}
-	return fmt.Errorf("unknown DictionaryDetail nullable field %s", name)
+	return fmt.Errorf("unknown Dictionary nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *DictionaryDetailMutation) ResetField(name string) error {
+func (m *DictionaryMutation) ResetField(name string) error {
 	switch name {
-	case dictionarydetail.FieldCreatedAt:
+	case dictionary.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case dictionarydetail.FieldUpdatedAt:
+	case dictionary.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case dictionarydetail.FieldStatus:
+	case dictionary.FieldStatus:
 		m.ResetStatus()
 		return nil
-	case dictionarydetail.FieldTitle:
-		m.ResetTitle()
+	case dictionary.FieldTenantID:
+		m.ResetTenantID()
 		return nil
-	case dictionarydetail.FieldKey:
-		m.ResetKey()
+	case dictionary.FieldTitle:
+		m.ResetTitle()
 		return nil
-	case dictionarydetail.FieldValue:
-		m.ResetValue()
+	case dictionary.FieldName:
+		m.ResetName()
 		return nil
-	case dictionarydetail.FieldDictionaryID:
-		m.ResetDictionaryID()
+	case dictionary.FieldDescription:
+		m.ResetDescription()
 		return nil
 	}
-	return fmt.Errorf("unknown DictionaryDetail field %s", name)
+	return fmt.Errorf("unknown Dictionary field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *DictionaryDetailMutation) AddedEdges() []string {
+func (m *DictionaryMutation) AddedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.dictionary != nil {
-		edges = append(edges, dictionarydetail.EdgeDictionary)
+	if m.dictionary_details != nil {
+		edges = append(edges, dictionary.EdgeDictionaryDetails)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *DictionaryDetailMutation) AddedIDs(name string) []ent.Value {
+func (m *DictionaryMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case dictionarydetail.EdgeDictionary:
-		if id := m.dictionary; id != nil {
-			return []ent.Value{*id}
+	case dictionary.EdgeDictionaryDetails:
+		ids := make([]ent.Value, 0, len(m.dictionary_details))
+		for id := range m.dictionary_details {
+			ids = append(ids, id)
 		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *DictionaryDetailMutation) RemovedEdges() []string {
+func (m *DictionaryMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 1)
+	if m.removeddictionary_details != nil {
+		edges = append(edges, dictionary.EdgeDictionaryDetails)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *DictionaryDetailMutation) RemovedIDs(name string) []ent.Value {
+func (m *DictionaryMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case dictionary.EdgeDictionaryDetails:
+		ids := make([]ent.Value, 0, len(m.removeddictionary_details))
+		for id := range m.removeddictionary_details {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *DictionaryDetailMutation) ClearedEdges() []string {
+func (m *DictionaryMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.cleareddictionary {
-		edges = append(edges, dictionarydetail.EdgeDictionary)
+	if m.cleareddictionary_details {
+		edges = append(edges, dictionary.EdgeDictionaryDetails)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *DictionaryDetailMutation) EdgeCleared(name string) bool {
+func (m *DictionaryMutation) EdgeCleared(name string) bool {
 	switch name {
-	case dictionarydetail.EdgeDictionary:
-		return m.cleareddictionary
+	case dictionary.EdgeDictionaryDetails:
+		return m.cleareddictionary_details
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *DictionaryDetailMutation) ClearEdge(name string) error {
+func (m *DictionaryMutation) ClearEdge(name string) error {
 	switch name {
-	case dictionarydetail.EdgeDictionary:
-		m.ClearDictionary()
-		return nil
 	}
-	return fmt.Errorf("unknown DictionaryDetail unique edge %s", name)
+	return fmt.Errorf("unknown Dictionary unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *DictionaryDetailMutation) ResetEdge(name string) error {
+func (m *DictionaryMutation) ResetEdge(name string) error {
 	switch name {
-	case dictionarydetail.EdgeDictionary:
-		m.ResetDictionary()
+	case dictionary.EdgeDictionaryDetails:
+		m.ResetDictionaryDetails()
 		return nil
 	}
-	return fmt.Errorf("unknown DictionaryDetail edge %s", name)
+	return fmt.Errorf("unknown Dictionary edge %s", name)
 }
 
-// LogsMutation represents an operation that mutates the Logs nodes in the graph.
-type LogsMutation struct {
+// DictionaryDetailMutation represents an operation that mutates the DictionaryDetail nodes in the graph.
+type DictionaryDetailMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *uint64
-	created_at    *time.Time
-	updated_at    *time.Time
-	_type         *string
-	method        *string
-	api           *string
-	success       *bool
-	req_content   *string
-	resp_content  *string
-	ip            *string
-	user_agent    *string
-	operator      *string
-	time          *int
-	addtime       *int
-	clearedFields map[string]struct{}
-	done          bool
-	oldValue      func(context.Context) (*Logs, error)
-	predicates    []predicate.Logs
+	op                Op
+	typ               string
+	id                *uint64
+	created_at        *time.Time
+	updated_at        *time.Time
+	status            *uint8
+	addstatus         *int8
+	tenant_id         *uint64
+	addtenant_id      *int64
+	title             *string
+	key               *string
+	value             *string
+	clearedFields     map[string]struct{}
+	dictionary        *uint64
+	cleareddictionary bool
+	done              bool
+	oldValue          func(context.Context) (*DictionaryDetail, error)
+	predicates        []predicate.DictionaryDetail
 }
 
-var _ ent.Mutation = (*LogsMutation)(nil)
+var _ ent.Mutation = (*DictionaryDetailMutation)(nil)
 
-// logsOption allows management of the mutation configuration using functional options.
-type logsOption func(*LogsMutation)
+// dictionarydetailOption allows management of the mutation configuration using functional options.
+type dictionarydetailOption func(*DictionaryDetailMutation)
 
-// newLogsMutation creates new mutation for the Logs entity.
-func newLogsMutation(c config, op Op, opts ...logsOption) *LogsMutation {
-	m := &LogsMutation{
+// newDictionaryDetailMutation creates new mutation for the DictionaryDetail entity.
+func newDictionaryDetailMutation(c config, op Op, opts ...dictionarydetailOption) *DictionaryDetailMutation {
+	m := &DictionaryDetailMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeLogs,
+		typ:           TypeDictionaryDetail,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2231,20 +2565,20 @@ func newLogsMutation(c config, op Op, opts ...logsOption) *LogsMutation {
 	return m
 }
 
-// withLogsID sets the ID field of the mutation.
-func withLogsID(id uint64) logsOption {
-	return func(m *LogsMutation) {
+// withDictionaryDetailID sets the ID field of the mutation.
+func withDictionaryDetailID(id uint64) dictionarydetailOption {
+	return func(m *DictionaryDetailMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Logs
+			value *DictionaryDetail
 		)
-		m.oldValue = func(ctx context.Context) (*Logs, error) {
+		m.oldValue = func(ctx context.Context) (*DictionaryDetail, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Logs.Get(ctx, id)
+					value, err = m.Client().DictionaryDetail.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2253,10 +2587,10 @@ func withLogsID(id uint64) logsOption {
 	}
 }
 
-// withLogs sets the old Logs of the mutation.
-func withLogs(node *Logs) logsOption {
-	return func(m *LogsMutation) {
-		m.oldValue = func(context.Context) (*Logs, error) {
+// withDictionaryDetail sets the old DictionaryDetail of the mutation.
+func withDictionaryDetail(node *DictionaryDetail) dictionarydetailOption {
+	return func(m *DictionaryDetailMutation) {
+		m.oldValue = func(context.Context) (*DictionaryDetail, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2265,7 +2599,7 @@ func withLogs(node *Logs) logsOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m LogsMutation) Client() *Client {
+func (m DictionaryDetailMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2273,7 +2607,7 @@ func (m LogsMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m LogsMutation) Tx() (*Tx, error) {
+func (m DictionaryDetailMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2283,14 +2617,14 @@ func (m LogsMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Logs entities.
-func (m *LogsMutation) SetID(id uint64) {
+// operation is only accepted on creation of DictionaryDetail entities.
+func (m *DictionaryDetailMutation) SetID(id uint64) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *LogsMutation) ID() (id uint64, exists bool) {
+func (m *DictionaryDetailMutation) ID() (id uint64, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2301,7 +2635,7 @@ func (m *LogsMutation) ID() (id uint64, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *LogsMutation) IDs(ctx context.Context) ([]uint64, error) {
+func (m *DictionaryDetailMutation) IDs(ctx context.Context) ([]uint64, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2310,19 +2644,19 @@ func (m *LogsMutation) IDs(ctx context.Context) ([]uint64, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Logs.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().DictionaryDetail.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *LogsMutation) SetCreatedAt(t time.Time) {
+func (m *DictionaryDetailMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *LogsMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *DictionaryDetailMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -2330,10 +2664,10 @@ func (m *LogsMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Logs entity.
-// If the Logs object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the DictionaryDetail entity.
+// If the DictionaryDetail object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LogsMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *DictionaryDetailMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -2348,17 +2682,17 @@ func (m *LogsMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *LogsMutation) ResetCreatedAt() {
+func (m *DictionaryDetailMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *LogsMutation) SetUpdatedAt(t time.Time) {
+func (m *DictionaryDetailMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *LogsMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *DictionaryDetailMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -2366,10 +2700,10 @@ func (m *LogsMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Logs entity.
-// If the Logs object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the DictionaryDetail entity.
+// If the DictionaryDetail object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LogsMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *DictionaryDetailMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -2384,478 +2718,329 @@ func (m *LogsMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *LogsMutation) ResetUpdatedAt() {
+func (m *DictionaryDetailMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
-// SetType sets the "type" field.
-func (m *LogsMutation) SetType(s string) {
-	m._type = &s
+// SetStatus sets the "status" field.
+func (m *DictionaryDetailMutation) SetStatus(u uint8) {
+	m.status = &u
+	m.addstatus = nil
 }
 
-// GetType returns the value of the "type" field in the mutation.
-func (m *LogsMutation) GetType() (r string, exists bool) {
-	v := m._type
+// Status returns the value of the "status" field in the mutation.
+func (m *DictionaryDetailMutation) Status() (r uint8, exists bool) {
+	v := m.status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldType returns the old "type" field's value of the Logs entity.
-// If the Logs object wasn't provided to the builder, the object is fetched from the database.
+// OldStatus returns the old "status" field's value of the DictionaryDetail entity.
+// If the DictionaryDetail object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LogsMutation) OldType(ctx context.Context) (v string, err error) {
+func (m *DictionaryDetailMutation) OldStatus(ctx context.Context) (v uint8, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldType is only allowed on UpdateOne operations")
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldType requires an ID field in the mutation")
+		return v, errors.New("OldStatus requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldType: %w", err)
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
 	}
-	return oldValue.Type, nil
-}
-
-// ResetType resets all changes to the "type" field.
-func (m *LogsMutation) ResetType() {
-	m._type = nil
+	return oldValue.Status, nil
 }
 
-// SetMethod sets the "method" field.
-func (m *LogsMutation) SetMethod(s string) {
-	m.method = &s
+// AddStatus adds u to the "status" field.
+func (m *DictionaryDetailMutation) AddStatus(u int8) {
+	if m.addstatus != nil {
+		*m.addstatus += u
+	} else {
+		m.addstatus = &u
+	}
 }
 
-// Method returns the value of the "method" field in the mutation.
-func (m *LogsMutation) Method() (r string, exists bool) {
-	v := m.method
+// AddedStatus returns the value that was added to the "status" field in this mutation.
+func (m *DictionaryDetailMutation) AddedStatus() (r int8, exists bool) {
+	v := m.addstatus
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMethod returns the old "method" field's value of the Logs entity.
-// If the Logs object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LogsMutation) OldMethod(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMethod is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMethod requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMethod: %w", err)
-	}
-	return oldValue.Method, nil
-}
-
-// ResetMethod resets all changes to the "method" field.
-func (m *LogsMutation) ResetMethod() {
-	m.method = nil
+// ClearStatus clears the value of the "status" field.
+func (m *DictionaryDetailMutation) ClearStatus() {
+	m.status = nil
+	m.addstatus = nil
+	m.clearedFields[dictionarydetail.FieldStatus] = struct{}{}
 }
 
-// SetAPI sets the "api" field.
-func (m *LogsMutation) SetAPI(s string) {
-	m.api = &s
+// StatusCleared returns if the "status" field was cleared in this mutation.
+func (m *DictionaryDetailMutation) StatusCleared() bool {
+	_, ok := m.clearedFields[dictionarydetail.FieldStatus]
+	return ok
 }
 
-// API returns the value of the "api" field in the mutation.
-func (m *LogsMutation) API() (r string, exists bool) {
-	v := m.api
+// ResetStatus resets all changes to the "status" field.
+func (m *DictionaryDetailMutation) ResetStatus() {
+	m.status = nil
+	m.addstatus = nil
+	delete(m.clearedFields, dictionarydetail.FieldStatus)
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (m *DictionaryDetailMutation) SetTenantID(u uint64) {
+	m.tenant_id = &u
+	m.addtenant_id = nil
+}
+
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *DictionaryDetailMutation) TenantID() (r uint64, exists bool) {
+	v := m.tenant_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAPI returns the old "api" field's value of the Logs entity.
-// If the Logs object wasn't provided to the builder, the object is fetched from the database.
+// OldTenantID returns the old "tenant_id" field's value of the DictionaryDetail entity.
+// If the DictionaryDetail object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LogsMutation) OldAPI(ctx context.Context) (v string, err error) {
+func (m *DictionaryDetailMutation) OldTenantID(ctx context.Context) (v uint64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAPI is only allowed on UpdateOne operations")
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAPI requires an ID field in the mutation")
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAPI: %w", err)
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
 	}
-	return oldValue.API, nil
-}
-
-// ResetAPI resets all changes to the "api" field.
-func (m *LogsMutation) ResetAPI() {
-	m.api = nil
+	return oldValue.TenantID, nil
 }
 
-// SetSuccess sets the "success" field.
-func (m *LogsMutation) SetSuccess(b bool) {
-	m.success = &b
+// AddTenantID adds u to the "tenant_id" field.
+func (m *DictionaryDetailMutation) AddTenantID(u int64) {
+	if m.addtenant_id != nil {
+		*m.addtenant_id += u
+	} else {
+		m.addtenant_id = &u
+	}
 }
 
-// Success returns the value of the "success" field in the mutation.
-func (m *LogsMutation) Success() (r bool, exists bool) {
-	v := m.success
+// AddedTenantID returns the value that was added to the "tenant_id" field in this mutation.
+func (m *DictionaryDetailMutation) AddedTenantID() (r int64, exists bool) {
+	v := m.addtenant_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSuccess returns the old "success" field's value of the Logs entity.
-// If the Logs object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LogsMutation) OldSuccess(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSuccess is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSuccess requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSuccess: %w", err)
-	}
-	return oldValue.Success, nil
-}
-
-// ResetSuccess resets all changes to the "success" field.
-func (m *LogsMutation) ResetSuccess() {
-	m.success = nil
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *DictionaryDetailMutation) ResetTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
 }
 
-// SetReqContent sets the "req_content" field.
-func (m *LogsMutation) SetReqContent(s string) {
-	m.req_content = &s
+// SetTitle sets the "title" field.
+func (m *DictionaryDetailMutation) SetTitle(s string) {
+	m.title = &s
 }
 
-// ReqContent returns the value of the "req_content" field in the mutation.
-func (m *LogsMutation) ReqContent() (r string, exists bool) {
-	v := m.req_content
+// Title returns the value of the "title" field in the mutation.
+func (m *DictionaryDetailMutation) Title() (r string, exists bool) {
+	v := m.title
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldReqContent returns the old "req_content" field's value of the Logs entity.
-// If the Logs object wasn't provided to the builder, the object is fetched from the database.
+// OldTitle returns the old "title" field's value of the DictionaryDetail entity.
+// If the DictionaryDetail object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LogsMutation) OldReqContent(ctx context.Context) (v string, err error) {
+func (m *DictionaryDetailMutation) OldTitle(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldReqContent is only allowed on UpdateOne operations")
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldReqContent requires an ID field in the mutation")
+		return v, errors.New("OldTitle requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldReqContent: %w", err)
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
 	}
-	return oldValue.ReqContent, nil
-}
-
-// ClearReqContent clears the value of the "req_content" field.
-func (m *LogsMutation) ClearReqContent() {
-	m.req_content = nil
-	m.clearedFields[logs.FieldReqContent] = struct{}{}
-}
-
-// ReqContentCleared returns if the "req_content" field was cleared in this mutation.
-func (m *LogsMutation) ReqContentCleared() bool {
-	_, ok := m.clearedFields[logs.FieldReqContent]
-	return ok
+	return oldValue.Title, nil
 }
 
-// ResetReqContent resets all changes to the "req_content" field.
-func (m *LogsMutation) ResetReqContent() {
-	m.req_content = nil
-	delete(m.clearedFields, logs.FieldReqContent)
+// ResetTitle resets all changes to the "title" field.
+func (m *DictionaryDetailMutation) ResetTitle() {
+	m.title = nil
 }
 
-// SetRespContent sets the "resp_content" field.
-func (m *LogsMutation) SetRespContent(s string) {
-	m.resp_content = &s
+// SetKey sets the "key" field.
+func (m *DictionaryDetailMutation) SetKey(s string) {
+	m.key = &s
 }
 
-// RespContent returns the value of the "resp_content" field in the mutation.
-func (m *LogsMutation) RespContent() (r string, exists bool) {
-	v := m.resp_content
+// Key returns the value of the "key" field in the mutation.
+func (m *DictionaryDetailMutation) Key() (r string, exists bool) {
+	v := m.key
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRespContent returns the old "resp_content" field's value of the Logs entity.
-// If the Logs object wasn't provided to the builder, the object is fetched from the database.
+// OldKey returns the old "key" field's value of the DictionaryDetail entity.
+// If the DictionaryDetail object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LogsMutation) OldRespContent(ctx context.Context) (v string, err error) {
+func (m *DictionaryDetailMutation) OldKey(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRespContent is only allowed on UpdateOne operations")
+		return v, errors.New("OldKey is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRespContent requires an ID field in the mutation")
+		return v, errors.New("OldKey requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRespContent: %w", err)
+		return v, fmt.Errorf("querying old value for OldKey: %w", err)
 	}
-	return oldValue.RespContent, nil
-}
-
-// ClearRespContent clears the value of the "resp_content" field.
-func (m *LogsMutation) ClearRespContent() {
-	m.resp_content = nil
-	m.clearedFields[logs.FieldRespContent] = struct{}{}
-}
-
-// RespContentCleared returns if the "resp_content" field was cleared in this mutation.
-func (m *LogsMutation) RespContentCleared() bool {
-	_, ok := m.clearedFields[logs.FieldRespContent]
-	return ok
+	return oldValue.Key, nil
 }
 
-// ResetRespContent resets all changes to the "resp_content" field.
-func (m *LogsMutation) ResetRespContent() {
-	m.resp_content = nil
-	delete(m.clearedFields, logs.FieldRespContent)
+// ResetKey resets all changes to the "key" field.
+func (m *DictionaryDetailMutation) ResetKey() {
+	m.key = nil
 }
 
-// SetIP sets the "ip" field.
-func (m *LogsMutation) SetIP(s string) {
-	m.ip = &s
+// SetValue sets the "value" field.
+func (m *DictionaryDetailMutation) SetValue(s string) {
+	m.value = &s
 }
 
-// IP returns the value of the "ip" field in the mutation.
-func (m *LogsMutation) IP() (r string, exists bool) {
-	v := m.ip
+// Value returns the value of the "value" field in the mutation.
+func (m *DictionaryDetailMutation) Value() (r string, exists bool) {
+	v := m.value
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIP returns the old "ip" field's value of the Logs entity.
-// If the Logs object wasn't provided to the builder, the object is fetched from the database.
+// OldValue returns the old "value" field's value of the DictionaryDetail entity.
+// If the DictionaryDetail object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LogsMutation) OldIP(ctx context.Context) (v string, err error) {
+func (m *DictionaryDetailMutation) OldValue(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIP is only allowed on UpdateOne operations")
+		return v, errors.New("OldValue is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIP requires an ID field in the mutation")
+		return v, errors.New("OldValue requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIP: %w", err)
+		return v, fmt.Errorf("querying old value for OldValue: %w", err)
 	}
-	return oldValue.IP, nil
-}
-
-// ClearIP clears the value of the "ip" field.
-func (m *LogsMutation) ClearIP() {
-	m.ip = nil
-	m.clearedFields[logs.FieldIP] = struct{}{}
-}
-
-// IPCleared returns if the "ip" field was cleared in this mutation.
-func (m *LogsMutation) IPCleared() bool {
-	_, ok := m.clearedFields[logs.FieldIP]
-	return ok
+	return oldValue.Value, nil
 }
 
-// ResetIP resets all changes to the "ip" field.
-func (m *LogsMutation) ResetIP() {
-	m.ip = nil
-	delete(m.clearedFields, logs.FieldIP)
+// ResetValue resets all changes to the "value" field.
+func (m *DictionaryDetailMutation) ResetValue() {
+	m.value = nil
 }
 
-// SetUserAgent sets the "user_agent" field.
-func (m *LogsMutation) SetUserAgent(s string) {
-	m.user_agent = &s
+// SetDictionaryID sets the "dictionary_id" field.
+func (m *DictionaryDetailMutation) SetDictionaryID(u uint64) {
+	m.dictionary = &u
 }
 
-// UserAgent returns the value of the "user_agent" field in the mutation.
-func (m *LogsMutation) UserAgent() (r string, exists bool) {
-	v := m.user_agent
+// DictionaryID returns the value of the "dictionary_id" field in the mutation.
+func (m *DictionaryDetailMutation) DictionaryID() (r uint64, exists bool) {
+	v := m.dictionary
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUserAgent returns the old "user_agent" field's value of the Logs entity.
-// If the Logs object wasn't provided to the builder, the object is fetched from the database.
+// OldDictionaryID returns the old "dictionary_id" field's value of the DictionaryDetail entity.
+// If the DictionaryDetail object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LogsMutation) OldUserAgent(ctx context.Context) (v string, err error) {
+func (m *DictionaryDetailMutation) OldDictionaryID(ctx context.Context) (v uint64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUserAgent is only allowed on UpdateOne operations")
+		return v, errors.New("OldDictionaryID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUserAgent requires an ID field in the mutation")
+		return v, errors.New("OldDictionaryID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUserAgent: %w", err)
+		return v, fmt.Errorf("querying old value for OldDictionaryID: %w", err)
 	}
-	return oldValue.UserAgent, nil
+	return oldValue.DictionaryID, nil
 }
 
-// ClearUserAgent clears the value of the "user_agent" field.
-func (m *LogsMutation) ClearUserAgent() {
-	m.user_agent = nil
-	m.clearedFields[logs.FieldUserAgent] = struct{}{}
+// ClearDictionaryID clears the value of the "dictionary_id" field.
+func (m *DictionaryDetailMutation) ClearDictionaryID() {
+	m.dictionary = nil
+	m.clearedFields[dictionarydetail.FieldDictionaryID] = struct{}{}
 }
 
-// UserAgentCleared returns if the "user_agent" field was cleared in this mutation.
-func (m *LogsMutation) UserAgentCleared() bool {
-	_, ok := m.clearedFields[logs.FieldUserAgent]
+// DictionaryIDCleared returns if the "dictionary_id" field was cleared in this mutation.
+func (m *DictionaryDetailMutation) DictionaryIDCleared() bool {
+	_, ok := m.clearedFields[dictionarydetail.FieldDictionaryID]
 	return ok
 }
 
-// ResetUserAgent resets all changes to the "user_agent" field.
-func (m *LogsMutation) ResetUserAgent() {
-	m.user_agent = nil
-	delete(m.clearedFields, logs.FieldUserAgent)
+// ResetDictionaryID resets all changes to the "dictionary_id" field.
+func (m *DictionaryDetailMutation) ResetDictionaryID() {
+	m.dictionary = nil
+	delete(m.clearedFields, dictionarydetail.FieldDictionaryID)
 }
 
-// SetOperator sets the "operator" field.
-func (m *LogsMutation) SetOperator(s string) {
-	m.operator = &s
+// ClearDictionary clears the "dictionary" edge to the Dictionary entity.
+func (m *DictionaryDetailMutation) ClearDictionary() {
+	m.cleareddictionary = true
+	m.clearedFields[dictionarydetail.FieldDictionaryID] = struct{}{}
 }
 
-// Operator returns the value of the "operator" field in the mutation.
-func (m *LogsMutation) Operator() (r string, exists bool) {
-	v := m.operator
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldOperator returns the old "operator" field's value of the Logs entity.
-// If the Logs object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LogsMutation) OldOperator(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOperator is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOperator requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOperator: %w", err)
-	}
-	return oldValue.Operator, nil
-}
-
-// ClearOperator clears the value of the "operator" field.
-func (m *LogsMutation) ClearOperator() {
-	m.operator = nil
-	m.clearedFields[logs.FieldOperator] = struct{}{}
-}
-
-// OperatorCleared returns if the "operator" field was cleared in this mutation.
-func (m *LogsMutation) OperatorCleared() bool {
-	_, ok := m.clearedFields[logs.FieldOperator]
-	return ok
-}
-
-// ResetOperator resets all changes to the "operator" field.
-func (m *LogsMutation) ResetOperator() {
-	m.operator = nil
-	delete(m.clearedFields, logs.FieldOperator)
-}
-
-// SetTime sets the "time" field.
-func (m *LogsMutation) SetTime(i int) {
-	m.time = &i
-	m.addtime = nil
-}
-
-// Time returns the value of the "time" field in the mutation.
-func (m *LogsMutation) Time() (r int, exists bool) {
-	v := m.time
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldTime returns the old "time" field's value of the Logs entity.
-// If the Logs object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LogsMutation) OldTime(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTime is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTime requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTime: %w", err)
-	}
-	return oldValue.Time, nil
-}
-
-// AddTime adds i to the "time" field.
-func (m *LogsMutation) AddTime(i int) {
-	if m.addtime != nil {
-		*m.addtime += i
-	} else {
-		m.addtime = &i
-	}
+// DictionaryCleared reports if the "dictionary" edge to the Dictionary entity was cleared.
+func (m *DictionaryDetailMutation) DictionaryCleared() bool {
+	return m.DictionaryIDCleared() || m.cleareddictionary
 }
 
-// AddedTime returns the value that was added to the "time" field in this mutation.
-func (m *LogsMutation) AddedTime() (r int, exists bool) {
-	v := m.addtime
-	if v == nil {
-		return
+// DictionaryIDs returns the "dictionary" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// DictionaryID instead. It exists only for internal usage by the builders.
+func (m *DictionaryDetailMutation) DictionaryIDs() (ids []uint64) {
+	if id := m.dictionary; id != nil {
+		ids = append(ids, *id)
 	}
-	return *v, true
-}
-
-// ClearTime clears the value of the "time" field.
-func (m *LogsMutation) ClearTime() {
-	m.time = nil
-	m.addtime = nil
-	m.clearedFields[logs.FieldTime] = struct{}{}
-}
-
-// TimeCleared returns if the "time" field was cleared in this mutation.
-func (m *LogsMutation) TimeCleared() bool {
-	_, ok := m.clearedFields[logs.FieldTime]
-	return ok
+	return
 }
 
-// ResetTime resets all changes to the "time" field.
-func (m *LogsMutation) ResetTime() {
-	m.time = nil
-	m.addtime = nil
-	delete(m.clearedFields, logs.FieldTime)
+// ResetDictionary resets all changes to the "dictionary" edge.
+func (m *DictionaryDetailMutation) ResetDictionary() {
+	m.dictionary = nil
+	m.cleareddictionary = false
 }
 
-// Where appends a list predicates to the LogsMutation builder.
-func (m *LogsMutation) Where(ps ...predicate.Logs) {
+// Where appends a list predicates to the DictionaryDetailMutation builder.
+func (m *DictionaryDetailMutation) Where(ps ...predicate.DictionaryDetail) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the LogsMutation builder. Using this method,
+// WhereP appends storage-level predicates to the DictionaryDetailMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *LogsMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Logs, len(ps))
+func (m *DictionaryDetailMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.DictionaryDetail, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2863,60 +3048,48 @@ func (m *LogsMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *LogsMutation) Op() Op {
+func (m *DictionaryDetailMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *LogsMutation) SetOp(op Op) {
+func (m *DictionaryDetailMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Logs).
-func (m *LogsMutation) Type() string {
+// Type returns the node type of this mutation (DictionaryDetail).
+func (m *DictionaryDetailMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *LogsMutation) Fields() []string {
-	fields := make([]string, 0, 12)
+func (m *DictionaryDetailMutation) Fields() []string {
+	fields := make([]string, 0, 8)
 	if m.created_at != nil {
-		fields = append(fields, logs.FieldCreatedAt)
+		fields = append(fields, dictionarydetail.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, logs.FieldUpdatedAt)
-	}
-	if m._type != nil {
-		fields = append(fields, logs.FieldType)
-	}
-	if m.method != nil {
-		fields = append(fields, logs.FieldMethod)
-	}
-	if m.api != nil {
-		fields = append(fields, logs.FieldAPI)
-	}
-	if m.success != nil {
-		fields = append(fields, logs.FieldSuccess)
+		fields = append(fields, dictionarydetail.FieldUpdatedAt)
 	}
-	if m.req_content != nil {
-		fields = append(fields, logs.FieldReqContent)
+	if m.status != nil {
+		fields = append(fields, dictionarydetail.FieldStatus)
 	}
-	if m.resp_content != nil {
-		fields = append(fields, logs.FieldRespContent)
+	if m.tenant_id != nil {
+		fields = append(fields, dictionarydetail.FieldTenantID)
 	}
-	if m.ip != nil {
-		fields = append(fields, logs.FieldIP)
+	if m.title != nil {
+		fields = append(fields, dictionarydetail.FieldTitle)
 	}
-	if m.user_agent != nil {
-		fields = append(fields, logs.FieldUserAgent)
+	if m.key != nil {
+		fields = append(fields, dictionarydetail.FieldKey)
 	}
-	if m.operator != nil {
-		fields = append(fields, logs.FieldOperator)
+	if m.value != nil {
+		fields = append(fields, dictionarydetail.FieldValue)
 	}
-	if m.time != nil {
-		fields = append(fields, logs.FieldTime)
+	if m.dictionary != nil {
+		fields = append(fields, dictionarydetail.FieldDictionaryID)
 	}
 	return fields
 }
@@ -2924,32 +3097,24 @@ func (m *LogsMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *LogsMutation) Field(name string) (ent.Value, bool) {
+func (m *DictionaryDetailMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case logs.FieldCreatedAt:
+	case dictionarydetail.FieldCreatedAt:
 		return m.CreatedAt()
-	case logs.FieldUpdatedAt:
+	case dictionarydetail.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case logs.FieldType:
-		return m.GetType()
-	case logs.FieldMethod:
-		return m.Method()
-	case logs.FieldAPI:
-		return m.API()
-	case logs.FieldSuccess:
-		return m.Success()
-	case logs.FieldReqContent:
-		return m.ReqContent()
-	case logs.FieldRespContent:
-		return m.RespContent()
-	case logs.FieldIP:
-		return m.IP()
-	case logs.FieldUserAgent:
-		return m.UserAgent()
-	case logs.FieldOperator:
-		return m.Operator()
-	case logs.FieldTime:
-		return m.Time()
+	case dictionarydetail.FieldStatus:
+		return m.Status()
+	case dictionarydetail.FieldTenantID:
+		return m.TenantID()
+	case dictionarydetail.FieldTitle:
+		return m.Title()
+	case dictionarydetail.FieldKey:
+		return m.Key()
+	case dictionarydetail.FieldValue:
+		return m.Value()
+	case dictionarydetail.FieldDictionaryID:
+		return m.DictionaryID()
 	}
 	return nil, false
 }
@@ -2957,135 +3122,102 @@ func (m *LogsMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *LogsMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *DictionaryDetailMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case logs.FieldCreatedAt:
+	case dictionarydetail.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case logs.FieldUpdatedAt:
+	case dictionarydetail.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case logs.FieldType:
-		return m.OldType(ctx)
-	case logs.FieldMethod:
-		return m.OldMethod(ctx)
-	case logs.FieldAPI:
-		return m.OldAPI(ctx)
-	case logs.FieldSuccess:
-		return m.OldSuccess(ctx)
-	case logs.FieldReqContent:
-		return m.OldReqContent(ctx)
-	case logs.FieldRespContent:
-		return m.OldRespContent(ctx)
-	case logs.FieldIP:
-		return m.OldIP(ctx)
-	case logs.FieldUserAgent:
-		return m.OldUserAgent(ctx)
-	case logs.FieldOperator:
-		return m.OldOperator(ctx)
-	case logs.FieldTime:
-		return m.OldTime(ctx)
+	case dictionarydetail.FieldStatus:
+		return m.OldStatus(ctx)
+	case dictionarydetail.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case dictionarydetail.FieldTitle:
+		return m.OldTitle(ctx)
+	case dictionarydetail.FieldKey:
+		return m.OldKey(ctx)
+	case dictionarydetail.FieldValue:
+		return m.OldValue(ctx)
+	case dictionarydetail.FieldDictionaryID:
+		return m.OldDictionaryID(ctx)
 	}
-	return nil, fmt.Errorf("unknown Logs field %s", name)
+	return nil, fmt.Errorf("unknown DictionaryDetail field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *LogsMutation) SetField(name string, value ent.Value) error {
+func (m *DictionaryDetailMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case logs.FieldCreatedAt:
+	case dictionarydetail.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case logs.FieldUpdatedAt:
+	case dictionarydetail.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case logs.FieldType:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetType(v)
-		return nil
-	case logs.FieldMethod:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetMethod(v)
-		return nil
-	case logs.FieldAPI:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetAPI(v)
-		return nil
-	case logs.FieldSuccess:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSuccess(v)
-		return nil
-	case logs.FieldReqContent:
-		v, ok := value.(string)
+	case dictionarydetail.FieldStatus:
+		v, ok := value.(uint8)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetReqContent(v)
+		m.SetStatus(v)
 		return nil
-	case logs.FieldRespContent:
-		v, ok := value.(string)
+	case dictionarydetail.FieldTenantID:
+		v, ok := value.(uint64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRespContent(v)
+		m.SetTenantID(v)
 		return nil
-	case logs.FieldIP:
+	case dictionarydetail.FieldTitle:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIP(v)
+		m.SetTitle(v)
 		return nil
-	case logs.FieldUserAgent:
+	case dictionarydetail.FieldKey:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUserAgent(v)
+		m.SetKey(v)
 		return nil
-	case logs.FieldOperator:
+	case dictionarydetail.FieldValue:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetOperator(v)
+		m.SetValue(v)
 		return nil
-	case logs.FieldTime:
-		v, ok := value.(int)
+	case dictionarydetail.FieldDictionaryID:
+		v, ok := value.(uint64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTime(v)
+		m.SetDictionaryID(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Logs field %s", name)
+	return fmt.Errorf("unknown DictionaryDetail field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *LogsMutation) AddedFields() []string {
+func (m *DictionaryDetailMutation) AddedFields() []string {
 	var fields []string
-	if m.addtime != nil {
-		fields = append(fields, logs.FieldTime)
+	if m.addstatus != nil {
+		fields = append(fields, dictionarydetail.FieldStatus)
+	}
+	if m.addtenant_id != nil {
+		fields = append(fields, dictionarydetail.FieldTenantID)
 	}
 	return fields
 }
@@ -3093,10 +3225,12 @@ func (m *LogsMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *LogsMutation) AddedField(name string) (ent.Value, bool) {
+func (m *DictionaryDetailMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case logs.FieldTime:
-		return m.AddedTime()
+	case dictionarydetail.FieldStatus:
+		return m.AddedStatus()
+	case dictionarydetail.FieldTenantID:
+		return m.AddedTenantID()
 	}
 	return nil, false
 }
@@ -3104,227 +3238,205 @@ func (m *LogsMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *LogsMutation) AddField(name string, value ent.Value) error {
+func (m *DictionaryDetailMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case logs.FieldTime:
-		v, ok := value.(int)
+	case dictionarydetail.FieldStatus:
+		v, ok := value.(int8)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddTime(v)
+		m.AddStatus(v)
+		return nil
+	case dictionarydetail.FieldTenantID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTenantID(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Logs numeric field %s", name)
+	return fmt.Errorf("unknown DictionaryDetail numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *LogsMutation) ClearedFields() []string {
+func (m *DictionaryDetailMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(logs.FieldReqContent) {
-		fields = append(fields, logs.FieldReqContent)
-	}
-	if m.FieldCleared(logs.FieldRespContent) {
-		fields = append(fields, logs.FieldRespContent)
-	}
-	if m.FieldCleared(logs.FieldIP) {
-		fields = append(fields, logs.FieldIP)
-	}
-	if m.FieldCleared(logs.FieldUserAgent) {
-		fields = append(fields, logs.FieldUserAgent)
-	}
-	if m.FieldCleared(logs.FieldOperator) {
-		fields = append(fields, logs.FieldOperator)
+	if m.FieldCleared(dictionarydetail.FieldStatus) {
+		fields = append(fields, dictionarydetail.FieldStatus)
 	}
-	if m.FieldCleared(logs.FieldTime) {
-		fields = append(fields, logs.FieldTime)
+	if m.FieldCleared(dictionarydetail.FieldDictionaryID) {
+		fields = append(fields, dictionarydetail.FieldDictionaryID)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *LogsMutation) FieldCleared(name string) bool {
+func (m *DictionaryDetailMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *LogsMutation) ClearField(name string) error {
+func (m *DictionaryDetailMutation) ClearField(name string) error {
 	switch name {
-	case logs.FieldReqContent:
-		m.ClearReqContent()
-		return nil
-	case logs.FieldRespContent:
-		m.ClearRespContent()
-		return nil
-	case logs.FieldIP:
-		m.ClearIP()
-		return nil
-	case logs.FieldUserAgent:
-		m.ClearUserAgent()
-		return nil
-	case logs.FieldOperator:
-		m.ClearOperator()
+	case dictionarydetail.FieldStatus:
+		m.ClearStatus()
 		return nil
-	case logs.FieldTime:
-		m.ClearTime()
+	case dictionarydetail.FieldDictionaryID:
+		m.ClearDictionaryID()
 		return nil
 	}
-	return fmt.Errorf("unknown Logs nullable field %s", name)
+	return fmt.Errorf("unknown DictionaryDetail nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *LogsMutation) ResetField(name string) error {
+func (m *DictionaryDetailMutation) ResetField(name string) error {
 	switch name {
-	case logs.FieldCreatedAt:
+	case dictionarydetail.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case logs.FieldUpdatedAt:
+	case dictionarydetail.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case logs.FieldType:
-		m.ResetType()
-		return nil
-	case logs.FieldMethod:
-		m.ResetMethod()
-		return nil
-	case logs.FieldAPI:
-		m.ResetAPI()
-		return nil
-	case logs.FieldSuccess:
-		m.ResetSuccess()
-		return nil
-	case logs.FieldReqContent:
-		m.ResetReqContent()
+	case dictionarydetail.FieldStatus:
+		m.ResetStatus()
 		return nil
-	case logs.FieldRespContent:
-		m.ResetRespContent()
+	case dictionarydetail.FieldTenantID:
+		m.ResetTenantID()
 		return nil
-	case logs.FieldIP:
-		m.ResetIP()
+	case dictionarydetail.FieldTitle:
+		m.ResetTitle()
 		return nil
-	case logs.FieldUserAgent:
-		m.ResetUserAgent()
+	case dictionarydetail.FieldKey:
+		m.ResetKey()
 		return nil
-	case logs.FieldOperator:
-		m.ResetOperator()
+	case dictionarydetail.FieldValue:
+		m.ResetValue()
 		return nil
-	case logs.FieldTime:
-		m.ResetTime()
+	case dictionarydetail.FieldDictionaryID:
+		m.ResetDictionaryID()
 		return nil
 	}
-	return fmt.Errorf("unknown Logs field %s", name)
+	return fmt.Errorf("unknown DictionaryDetail field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *LogsMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *DictionaryDetailMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.dictionary != nil {
+		edges = append(edges, dictionarydetail.EdgeDictionary)
+	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *LogsMutation) AddedIDs(name string) []ent.Value {
+func (m *DictionaryDetailMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case dictionarydetail.EdgeDictionary:
+		if id := m.dictionary; id != nil {
+			return []ent.Value{*id}
+		}
+	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *LogsMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *DictionaryDetailMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *LogsMutation) RemovedIDs(name string) []ent.Value {
+func (m *DictionaryDetailMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *LogsMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *DictionaryDetailMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareddictionary {
+		edges = append(edges, dictionarydetail.EdgeDictionary)
+	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *LogsMutation) EdgeCleared(name string) bool {
+func (m *DictionaryDetailMutation) EdgeCleared(name string) bool {
+	switch name {
+	case dictionarydetail.EdgeDictionary:
+		return m.cleareddictionary
+	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *LogsMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown Logs unique edge %s", name)
+func (m *DictionaryDetailMutation) ClearEdge(name string) error {
+	switch name {
+	case dictionarydetail.EdgeDictionary:
+		m.ClearDictionary()
+		return nil
+	}
+	return fmt.Errorf("unknown DictionaryDetail unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *LogsMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown Logs edge %s", name)
+func (m *DictionaryDetailMutation) ResetEdge(name string) error {
+	switch name {
+	case dictionarydetail.EdgeDictionary:
+		m.ResetDictionary()
+		return nil
+	}
+	return fmt.Errorf("unknown DictionaryDetail edge %s", name)
 }
 
-// MenuMutation represents an operation that mutates the Menu nodes in the graph.
-type MenuMutation struct {
+// LoginEventMutation represents an operation that mutates the LoginEvent nodes in the graph.
+type LoginEventMutation struct {
 	config
-	op                    Op
-	typ                   string
-	id                    *uint64
-	created_at            *time.Time
-	updated_at            *time.Time
-	menu_level            *uint32
-	addmenu_level         *int32
-	menu_type             *uint32
-	addmenu_type          *int32
-	_path                 *string
-	name                  *string
-	redirect              *string
-	component             *string
-	order_no              *uint32
-	addorder_no           *int32
-	disabled              *bool
-	title                 *string
-	icon                  *string
-	hide_menu             *bool
-	hide_breadcrumb       *bool
-	current_active_menu   *string
-	ignore_keep_alive     *bool
-	hide_tab              *bool
-	frame_src             *string
-	carry_param           *bool
-	hide_children_in_menu *bool
-	affix                 *bool
-	dynamic_level         *uint32
-	adddynamic_level      *int32
-	real_path             *string
-	clearedFields         map[string]struct{}
-	roles                 map[uint64]struct{}
-	removedroles          map[uint64]struct{}
-	clearedroles          bool
-	parent                *uint64
-	clearedparent         bool
-	children              map[uint64]struct{}
-	removedchildren       map[uint64]struct{}
-	clearedchildren       bool
-	done                  bool
-	oldValue              func(context.Context) (*Menu, error)
-	predicates            []predicate.Menu
+	op            Op
+	typ           string
+	id            *uint64
+	created_at    *time.Time
+	updated_at    *time.Time
+	tenant_id     *uint64
+	addtenant_id  *int64
+	user_id       *uint64
+	adduser_id    *int64
+	username      *string
+	method        *string
+	ip            *string
+	user_agent    *string
+	device        *string
+	success       *bool
+	reason        *string
+	flags         *[]string
+	appendflags   []string
+	anomalous     *bool
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*LoginEvent, error)
+	predicates    []predicate.LoginEvent
 }
 
-var _ ent.Mutation = (*MenuMutation)(nil)
+var _ ent.Mutation = (*LoginEventMutation)(nil)
 
-// menuOption allows management of the mutation configuration using functional options.
-type menuOption func(*MenuMutation)
+// logineventOption allows management of the mutation configuration using functional options.
+type logineventOption func(*LoginEventMutation)
 
-// newMenuMutation creates new mutation for the Menu entity.
-func newMenuMutation(c config, op Op, opts ...menuOption) *MenuMutation {
-	m := &MenuMutation{
+// newLoginEventMutation creates new mutation for the LoginEvent entity.
+func newLoginEventMutation(c config, op Op, opts ...logineventOption) *LoginEventMutation {
+	m := &LoginEventMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeMenu,
+		typ:           TypeLoginEvent,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -3333,20 +3445,20 @@ func newMenuMutation(c config, op Op, opts ...menuOption) *MenuMutation {
 	return m
 }
 
-// withMenuID sets the ID field of the mutation.
-func withMenuID(id uint64) menuOption {
-	return func(m *MenuMutation) {
+// withLoginEventID sets the ID field of the mutation.
+func withLoginEventID(id uint64) logineventOption {
+	return func(m *LoginEventMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Menu
+			value *LoginEvent
 		)
-		m.oldValue = func(ctx context.Context) (*Menu, error) {
+		m.oldValue = func(ctx context.Context) (*LoginEvent, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Menu.Get(ctx, id)
+					value, err = m.Client().LoginEvent.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -3355,10 +3467,10 @@ func withMenuID(id uint64) menuOption {
 	}
 }
 
-// withMenu sets the old Menu of the mutation.
-func withMenu(node *Menu) menuOption {
-	return func(m *MenuMutation) {
-		m.oldValue = func(context.Context) (*Menu, error) {
+// withLoginEvent sets the old LoginEvent of the mutation.
+func withLoginEvent(node *LoginEvent) logineventOption {
+	return func(m *LoginEventMutation) {
+		m.oldValue = func(context.Context) (*LoginEvent, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -3367,7 +3479,7 @@ func withMenu(node *Menu) menuOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m MenuMutation) Client() *Client {
+func (m LoginEventMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -3375,7 +3487,7 @@ func (m MenuMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m MenuMutation) Tx() (*Tx, error) {
+func (m LoginEventMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -3385,14 +3497,14 @@ func (m MenuMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Menu entities.
-func (m *MenuMutation) SetID(id uint64) {
+// operation is only accepted on creation of LoginEvent entities.
+func (m *LoginEventMutation) SetID(id uint64) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *MenuMutation) ID() (id uint64, exists bool) {
+func (m *LoginEventMutation) ID() (id uint64, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -3403,7 +3515,7 @@ func (m *MenuMutation) ID() (id uint64, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *MenuMutation) IDs(ctx context.Context) ([]uint64, error) {
+func (m *LoginEventMutation) IDs(ctx context.Context) ([]uint64, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -3412,19 +3524,19 @@ func (m *MenuMutation) IDs(ctx context.Context) ([]uint64, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Menu.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().LoginEvent.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *MenuMutation) SetCreatedAt(t time.Time) {
+func (m *LoginEventMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *MenuMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *LoginEventMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -3432,10 +3544,10 @@ func (m *MenuMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Menu entity.
-// If the Menu object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the LoginEvent entity.
+// If the LoginEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MenuMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *LoginEventMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -3450,17 +3562,17 @@ func (m *MenuMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *MenuMutation) ResetCreatedAt() {
+func (m *LoginEventMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *MenuMutation) SetUpdatedAt(t time.Time) {
+func (m *LoginEventMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *MenuMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *LoginEventMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -3468,10 +3580,10 @@ func (m *MenuMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Menu entity.
-// If the Menu object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the LoginEvent entity.
+// If the LoginEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MenuMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *LoginEventMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -3486,1332 +3598,1853 @@ func (m *MenuMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *MenuMutation) ResetUpdatedAt() {
+func (m *LoginEventMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
-// SetParentID sets the "parent_id" field.
-func (m *MenuMutation) SetParentID(u uint64) {
-	m.parent = &u
+// SetTenantID sets the "tenant_id" field.
+func (m *LoginEventMutation) SetTenantID(u uint64) {
+	m.tenant_id = &u
+	m.addtenant_id = nil
 }
 
-// ParentID returns the value of the "parent_id" field in the mutation.
-func (m *MenuMutation) ParentID() (r uint64, exists bool) {
-	v := m.parent
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *LoginEventMutation) TenantID() (r uint64, exists bool) {
+	v := m.tenant_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldParentID returns the old "parent_id" field's value of the Menu entity.
-// If the Menu object wasn't provided to the builder, the object is fetched from the database.
+// OldTenantID returns the old "tenant_id" field's value of the LoginEvent entity.
+// If the LoginEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MenuMutation) OldParentID(ctx context.Context) (v uint64, err error) {
+func (m *LoginEventMutation) OldTenantID(ctx context.Context) (v uint64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldParentID is only allowed on UpdateOne operations")
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldParentID requires an ID field in the mutation")
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldParentID: %w", err)
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
 	}
-	return oldValue.ParentID, nil
+	return oldValue.TenantID, nil
 }
 
-// ClearParentID clears the value of the "parent_id" field.
-func (m *MenuMutation) ClearParentID() {
-	m.parent = nil
-	m.clearedFields[menu.FieldParentID] = struct{}{}
+// AddTenantID adds u to the "tenant_id" field.
+func (m *LoginEventMutation) AddTenantID(u int64) {
+	if m.addtenant_id != nil {
+		*m.addtenant_id += u
+	} else {
+		m.addtenant_id = &u
+	}
 }
 
-// ParentIDCleared returns if the "parent_id" field was cleared in this mutation.
-func (m *MenuMutation) ParentIDCleared() bool {
-	_, ok := m.clearedFields[menu.FieldParentID]
-	return ok
+// AddedTenantID returns the value that was added to the "tenant_id" field in this mutation.
+func (m *LoginEventMutation) AddedTenantID() (r int64, exists bool) {
+	v := m.addtenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetParentID resets all changes to the "parent_id" field.
-func (m *MenuMutation) ResetParentID() {
-	m.parent = nil
-	delete(m.clearedFields, menu.FieldParentID)
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *LoginEventMutation) ResetTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
 }
 
-// SetMenuLevel sets the "menu_level" field.
-func (m *MenuMutation) SetMenuLevel(u uint32) {
-	m.menu_level = &u
-	m.addmenu_level = nil
+// SetUserID sets the "user_id" field.
+func (m *LoginEventMutation) SetUserID(u uint64) {
+	m.user_id = &u
+	m.adduser_id = nil
 }
 
-// MenuLevel returns the value of the "menu_level" field in the mutation.
-func (m *MenuMutation) MenuLevel() (r uint32, exists bool) {
-	v := m.menu_level
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *LoginEventMutation) UserID() (r uint64, exists bool) {
+	v := m.user_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMenuLevel returns the old "menu_level" field's value of the Menu entity.
-// If the Menu object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the LoginEvent entity.
+// If the LoginEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MenuMutation) OldMenuLevel(ctx context.Context) (v uint32, err error) {
+func (m *LoginEventMutation) OldUserID(ctx context.Context) (v uint64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMenuLevel is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMenuLevel requires an ID field in the mutation")
+		return v, errors.New("OldUserID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMenuLevel: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
 	}
-	return oldValue.MenuLevel, nil
+	return oldValue.UserID, nil
 }
 
-// AddMenuLevel adds u to the "menu_level" field.
-func (m *MenuMutation) AddMenuLevel(u int32) {
-	if m.addmenu_level != nil {
-		*m.addmenu_level += u
+// AddUserID adds u to the "user_id" field.
+func (m *LoginEventMutation) AddUserID(u int64) {
+	if m.adduser_id != nil {
+		*m.adduser_id += u
 	} else {
-		m.addmenu_level = &u
+		m.adduser_id = &u
 	}
 }
 
-// AddedMenuLevel returns the value that was added to the "menu_level" field in this mutation.
-func (m *MenuMutation) AddedMenuLevel() (r int32, exists bool) {
-	v := m.addmenu_level
+// AddedUserID returns the value that was added to the "user_id" field in this mutation.
+func (m *LoginEventMutation) AddedUserID() (r int64, exists bool) {
+	v := m.adduser_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetMenuLevel resets all changes to the "menu_level" field.
-func (m *MenuMutation) ResetMenuLevel() {
-	m.menu_level = nil
-	m.addmenu_level = nil
+// ClearUserID clears the value of the "user_id" field.
+func (m *LoginEventMutation) ClearUserID() {
+	m.user_id = nil
+	m.adduser_id = nil
+	m.clearedFields[loginevent.FieldUserID] = struct{}{}
 }
 
-// SetMenuType sets the "menu_type" field.
-func (m *MenuMutation) SetMenuType(u uint32) {
-	m.menu_type = &u
-	m.addmenu_type = nil
+// UserIDCleared returns if the "user_id" field was cleared in this mutation.
+func (m *LoginEventMutation) UserIDCleared() bool {
+	_, ok := m.clearedFields[loginevent.FieldUserID]
+	return ok
 }
 
-// MenuType returns the value of the "menu_type" field in the mutation.
-func (m *MenuMutation) MenuType() (r uint32, exists bool) {
-	v := m.menu_type
+// ResetUserID resets all changes to the "user_id" field.
+func (m *LoginEventMutation) ResetUserID() {
+	m.user_id = nil
+	m.adduser_id = nil
+	delete(m.clearedFields, loginevent.FieldUserID)
+}
+
+// SetUsername sets the "username" field.
+func (m *LoginEventMutation) SetUsername(s string) {
+	m.username = &s
+}
+
+// Username returns the value of the "username" field in the mutation.
+func (m *LoginEventMutation) Username() (r string, exists bool) {
+	v := m.username
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMenuType returns the old "menu_type" field's value of the Menu entity.
-// If the Menu object wasn't provided to the builder, the object is fetched from the database.
+// OldUsername returns the old "username" field's value of the LoginEvent entity.
+// If the LoginEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MenuMutation) OldMenuType(ctx context.Context) (v uint32, err error) {
+func (m *LoginEventMutation) OldUsername(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMenuType is only allowed on UpdateOne operations")
+		return v, errors.New("OldUsername is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMenuType requires an ID field in the mutation")
+		return v, errors.New("OldUsername requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMenuType: %w", err)
+		return v, fmt.Errorf("querying old value for OldUsername: %w", err)
 	}
-	return oldValue.MenuType, nil
+	return oldValue.Username, nil
 }
 
-// AddMenuType adds u to the "menu_type" field.
-func (m *MenuMutation) AddMenuType(u int32) {
-	if m.addmenu_type != nil {
-		*m.addmenu_type += u
-	} else {
-		m.addmenu_type = &u
-	}
+// ClearUsername clears the value of the "username" field.
+func (m *LoginEventMutation) ClearUsername() {
+	m.username = nil
+	m.clearedFields[loginevent.FieldUsername] = struct{}{}
 }
 
-// AddedMenuType returns the value that was added to the "menu_type" field in this mutation.
-func (m *MenuMutation) AddedMenuType() (r int32, exists bool) {
-	v := m.addmenu_type
-	if v == nil {
-		return
-	}
-	return *v, true
+// UsernameCleared returns if the "username" field was cleared in this mutation.
+func (m *LoginEventMutation) UsernameCleared() bool {
+	_, ok := m.clearedFields[loginevent.FieldUsername]
+	return ok
 }
 
-// ResetMenuType resets all changes to the "menu_type" field.
-func (m *MenuMutation) ResetMenuType() {
-	m.menu_type = nil
-	m.addmenu_type = nil
+// ResetUsername resets all changes to the "username" field.
+func (m *LoginEventMutation) ResetUsername() {
+	m.username = nil
+	delete(m.clearedFields, loginevent.FieldUsername)
 }
 
-// SetPath sets the "path" field.
-func (m *MenuMutation) SetPath(s string) {
-	m._path = &s
+// SetMethod sets the "method" field.
+func (m *LoginEventMutation) SetMethod(s string) {
+	m.method = &s
 }
 
-// Path returns the value of the "path" field in the mutation.
-func (m *MenuMutation) Path() (r string, exists bool) {
-	v := m._path
+// Method returns the value of the "method" field in the mutation.
+func (m *LoginEventMutation) Method() (r string, exists bool) {
+	v := m.method
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPath returns the old "path" field's value of the Menu entity.
-// If the Menu object wasn't provided to the builder, the object is fetched from the database.
+// OldMethod returns the old "method" field's value of the LoginEvent entity.
+// If the LoginEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MenuMutation) OldPath(ctx context.Context) (v string, err error) {
+func (m *LoginEventMutation) OldMethod(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPath is only allowed on UpdateOne operations")
+		return v, errors.New("OldMethod is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPath requires an ID field in the mutation")
+		return v, errors.New("OldMethod requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPath: %w", err)
+		return v, fmt.Errorf("querying old value for OldMethod: %w", err)
 	}
-	return oldValue.Path, nil
-}
-
-// ClearPath clears the value of the "path" field.
-func (m *MenuMutation) ClearPath() {
-	m._path = nil
-	m.clearedFields[menu.FieldPath] = struct{}{}
-}
-
-// PathCleared returns if the "path" field was cleared in this mutation.
-func (m *MenuMutation) PathCleared() bool {
-	_, ok := m.clearedFields[menu.FieldPath]
-	return ok
+	return oldValue.Method, nil
 }
 
-// ResetPath resets all changes to the "path" field.
-func (m *MenuMutation) ResetPath() {
-	m._path = nil
-	delete(m.clearedFields, menu.FieldPath)
+// ResetMethod resets all changes to the "method" field.
+func (m *LoginEventMutation) ResetMethod() {
+	m.method = nil
 }
 
-// SetName sets the "name" field.
-func (m *MenuMutation) SetName(s string) {
-	m.name = &s
+// SetIP sets the "ip" field.
+func (m *LoginEventMutation) SetIP(s string) {
+	m.ip = &s
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *MenuMutation) Name() (r string, exists bool) {
-	v := m.name
+// IP returns the value of the "ip" field in the mutation.
+func (m *LoginEventMutation) IP() (r string, exists bool) {
+	v := m.ip
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Menu entity.
-// If the Menu object wasn't provided to the builder, the object is fetched from the database.
+// OldIP returns the old "ip" field's value of the LoginEvent entity.
+// If the LoginEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MenuMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *LoginEventMutation) OldIP(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldIP is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldIP requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldIP: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.IP, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *MenuMutation) ResetName() {
-	m.name = nil
+// ClearIP clears the value of the "ip" field.
+func (m *LoginEventMutation) ClearIP() {
+	m.ip = nil
+	m.clearedFields[loginevent.FieldIP] = struct{}{}
 }
 
-// SetRedirect sets the "redirect" field.
-func (m *MenuMutation) SetRedirect(s string) {
-	m.redirect = &s
+// IPCleared returns if the "ip" field was cleared in this mutation.
+func (m *LoginEventMutation) IPCleared() bool {
+	_, ok := m.clearedFields[loginevent.FieldIP]
+	return ok
 }
 
-// Redirect returns the value of the "redirect" field in the mutation.
-func (m *MenuMutation) Redirect() (r string, exists bool) {
-	v := m.redirect
+// ResetIP resets all changes to the "ip" field.
+func (m *LoginEventMutation) ResetIP() {
+	m.ip = nil
+	delete(m.clearedFields, loginevent.FieldIP)
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (m *LoginEventMutation) SetUserAgent(s string) {
+	m.user_agent = &s
+}
+
+// UserAgent returns the value of the "user_agent" field in the mutation.
+func (m *LoginEventMutation) UserAgent() (r string, exists bool) {
+	v := m.user_agent
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRedirect returns the old "redirect" field's value of the Menu entity.
-// If the Menu object wasn't provided to the builder, the object is fetched from the database.
+// OldUserAgent returns the old "user_agent" field's value of the LoginEvent entity.
+// If the LoginEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MenuMutation) OldRedirect(ctx context.Context) (v string, err error) {
+func (m *LoginEventMutation) OldUserAgent(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRedirect is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserAgent is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRedirect requires an ID field in the mutation")
+		return v, errors.New("OldUserAgent requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRedirect: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserAgent: %w", err)
 	}
-	return oldValue.Redirect, nil
+	return oldValue.UserAgent, nil
 }
 
-// ClearRedirect clears the value of the "redirect" field.
-func (m *MenuMutation) ClearRedirect() {
-	m.redirect = nil
-	m.clearedFields[menu.FieldRedirect] = struct{}{}
+// ClearUserAgent clears the value of the "user_agent" field.
+func (m *LoginEventMutation) ClearUserAgent() {
+	m.user_agent = nil
+	m.clearedFields[loginevent.FieldUserAgent] = struct{}{}
 }
 
-// RedirectCleared returns if the "redirect" field was cleared in this mutation.
-func (m *MenuMutation) RedirectCleared() bool {
-	_, ok := m.clearedFields[menu.FieldRedirect]
+// UserAgentCleared returns if the "user_agent" field was cleared in this mutation.
+func (m *LoginEventMutation) UserAgentCleared() bool {
+	_, ok := m.clearedFields[loginevent.FieldUserAgent]
 	return ok
 }
 
-// ResetRedirect resets all changes to the "redirect" field.
-func (m *MenuMutation) ResetRedirect() {
-	m.redirect = nil
-	delete(m.clearedFields, menu.FieldRedirect)
+// ResetUserAgent resets all changes to the "user_agent" field.
+func (m *LoginEventMutation) ResetUserAgent() {
+	m.user_agent = nil
+	delete(m.clearedFields, loginevent.FieldUserAgent)
 }
 
-// SetComponent sets the "component" field.
-func (m *MenuMutation) SetComponent(s string) {
-	m.component = &s
+// SetDevice sets the "device" field.
+func (m *LoginEventMutation) SetDevice(s string) {
+	m.device = &s
 }
 
-// Component returns the value of the "component" field in the mutation.
-func (m *MenuMutation) Component() (r string, exists bool) {
-	v := m.component
+// Device returns the value of the "device" field in the mutation.
+func (m *LoginEventMutation) Device() (r string, exists bool) {
+	v := m.device
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldComponent returns the old "component" field's value of the Menu entity.
-// If the Menu object wasn't provided to the builder, the object is fetched from the database.
+// OldDevice returns the old "device" field's value of the LoginEvent entity.
+// If the LoginEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MenuMutation) OldComponent(ctx context.Context) (v string, err error) {
+func (m *LoginEventMutation) OldDevice(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldComponent is only allowed on UpdateOne operations")
+		return v, errors.New("OldDevice is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldComponent requires an ID field in the mutation")
+		return v, errors.New("OldDevice requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldComponent: %w", err)
+		return v, fmt.Errorf("querying old value for OldDevice: %w", err)
 	}
-	return oldValue.Component, nil
+	return oldValue.Device, nil
 }
 
-// ClearComponent clears the value of the "component" field.
-func (m *MenuMutation) ClearComponent() {
-	m.component = nil
-	m.clearedFields[menu.FieldComponent] = struct{}{}
+// ClearDevice clears the value of the "device" field.
+func (m *LoginEventMutation) ClearDevice() {
+	m.device = nil
+	m.clearedFields[loginevent.FieldDevice] = struct{}{}
 }
 
-// ComponentCleared returns if the "component" field was cleared in this mutation.
-func (m *MenuMutation) ComponentCleared() bool {
-	_, ok := m.clearedFields[menu.FieldComponent]
+// DeviceCleared returns if the "device" field was cleared in this mutation.
+func (m *LoginEventMutation) DeviceCleared() bool {
+	_, ok := m.clearedFields[loginevent.FieldDevice]
 	return ok
 }
 
-// ResetComponent resets all changes to the "component" field.
-func (m *MenuMutation) ResetComponent() {
-	m.component = nil
-	delete(m.clearedFields, menu.FieldComponent)
+// ResetDevice resets all changes to the "device" field.
+func (m *LoginEventMutation) ResetDevice() {
+	m.device = nil
+	delete(m.clearedFields, loginevent.FieldDevice)
 }
 
-// SetOrderNo sets the "order_no" field.
-func (m *MenuMutation) SetOrderNo(u uint32) {
-	m.order_no = &u
-	m.addorder_no = nil
+// SetSuccess sets the "success" field.
+func (m *LoginEventMutation) SetSuccess(b bool) {
+	m.success = &b
 }
 
-// OrderNo returns the value of the "order_no" field in the mutation.
-func (m *MenuMutation) OrderNo() (r uint32, exists bool) {
-	v := m.order_no
+// Success returns the value of the "success" field in the mutation.
+func (m *LoginEventMutation) Success() (r bool, exists bool) {
+	v := m.success
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldOrderNo returns the old "order_no" field's value of the Menu entity.
-// If the Menu object wasn't provided to the builder, the object is fetched from the database.
+// OldSuccess returns the old "success" field's value of the LoginEvent entity.
+// If the LoginEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MenuMutation) OldOrderNo(ctx context.Context) (v uint32, err error) {
+func (m *LoginEventMutation) OldSuccess(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOrderNo is only allowed on UpdateOne operations")
+		return v, errors.New("OldSuccess is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOrderNo requires an ID field in the mutation")
+		return v, errors.New("OldSuccess requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOrderNo: %w", err)
-	}
-	return oldValue.OrderNo, nil
-}
-
-// AddOrderNo adds u to the "order_no" field.
-func (m *MenuMutation) AddOrderNo(u int32) {
-	if m.addorder_no != nil {
-		*m.addorder_no += u
-	} else {
-		m.addorder_no = &u
-	}
-}
-
-// AddedOrderNo returns the value that was added to the "order_no" field in this mutation.
-func (m *MenuMutation) AddedOrderNo() (r int32, exists bool) {
-	v := m.addorder_no
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldSuccess: %w", err)
 	}
-	return *v, true
+	return oldValue.Success, nil
 }
 
-// ResetOrderNo resets all changes to the "order_no" field.
-func (m *MenuMutation) ResetOrderNo() {
-	m.order_no = nil
-	m.addorder_no = nil
+// ResetSuccess resets all changes to the "success" field.
+func (m *LoginEventMutation) ResetSuccess() {
+	m.success = nil
 }
 
-// SetDisabled sets the "disabled" field.
-func (m *MenuMutation) SetDisabled(b bool) {
-	m.disabled = &b
+// SetReason sets the "reason" field.
+func (m *LoginEventMutation) SetReason(s string) {
+	m.reason = &s
 }
 
-// Disabled returns the value of the "disabled" field in the mutation.
-func (m *MenuMutation) Disabled() (r bool, exists bool) {
-	v := m.disabled
+// Reason returns the value of the "reason" field in the mutation.
+func (m *LoginEventMutation) Reason() (r string, exists bool) {
+	v := m.reason
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDisabled returns the old "disabled" field's value of the Menu entity.
-// If the Menu object wasn't provided to the builder, the object is fetched from the database.
+// OldReason returns the old "reason" field's value of the LoginEvent entity.
+// If the LoginEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MenuMutation) OldDisabled(ctx context.Context) (v bool, err error) {
+func (m *LoginEventMutation) OldReason(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDisabled is only allowed on UpdateOne operations")
+		return v, errors.New("OldReason is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDisabled requires an ID field in the mutation")
+		return v, errors.New("OldReason requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDisabled: %w", err)
+		return v, fmt.Errorf("querying old value for OldReason: %w", err)
 	}
-	return oldValue.Disabled, nil
+	return oldValue.Reason, nil
 }
 
-// ClearDisabled clears the value of the "disabled" field.
-func (m *MenuMutation) ClearDisabled() {
-	m.disabled = nil
-	m.clearedFields[menu.FieldDisabled] = struct{}{}
+// ClearReason clears the value of the "reason" field.
+func (m *LoginEventMutation) ClearReason() {
+	m.reason = nil
+	m.clearedFields[loginevent.FieldReason] = struct{}{}
 }
 
-// DisabledCleared returns if the "disabled" field was cleared in this mutation.
-func (m *MenuMutation) DisabledCleared() bool {
-	_, ok := m.clearedFields[menu.FieldDisabled]
+// ReasonCleared returns if the "reason" field was cleared in this mutation.
+func (m *LoginEventMutation) ReasonCleared() bool {
+	_, ok := m.clearedFields[loginevent.FieldReason]
 	return ok
 }
 
-// ResetDisabled resets all changes to the "disabled" field.
-func (m *MenuMutation) ResetDisabled() {
-	m.disabled = nil
-	delete(m.clearedFields, menu.FieldDisabled)
+// ResetReason resets all changes to the "reason" field.
+func (m *LoginEventMutation) ResetReason() {
+	m.reason = nil
+	delete(m.clearedFields, loginevent.FieldReason)
 }
 
-// SetTitle sets the "title" field.
-func (m *MenuMutation) SetTitle(s string) {
-	m.title = &s
+// SetFlags sets the "flags" field.
+func (m *LoginEventMutation) SetFlags(s []string) {
+	m.flags = &s
+	m.appendflags = nil
 }
 
-// Title returns the value of the "title" field in the mutation.
-func (m *MenuMutation) Title() (r string, exists bool) {
-	v := m.title
+// Flags returns the value of the "flags" field in the mutation.
+func (m *LoginEventMutation) Flags() (r []string, exists bool) {
+	v := m.flags
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTitle returns the old "title" field's value of the Menu entity.
-// If the Menu object wasn't provided to the builder, the object is fetched from the database.
+// OldFlags returns the old "flags" field's value of the LoginEvent entity.
+// If the LoginEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MenuMutation) OldTitle(ctx context.Context) (v string, err error) {
+func (m *LoginEventMutation) OldFlags(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+		return v, errors.New("OldFlags is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTitle requires an ID field in the mutation")
+		return v, errors.New("OldFlags requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+		return v, fmt.Errorf("querying old value for OldFlags: %w", err)
 	}
-	return oldValue.Title, nil
+	return oldValue.Flags, nil
 }
 
-// ResetTitle resets all changes to the "title" field.
-func (m *MenuMutation) ResetTitle() {
-	m.title = nil
+// AppendFlags adds s to the "flags" field.
+func (m *LoginEventMutation) AppendFlags(s []string) {
+	m.appendflags = append(m.appendflags, s...)
 }
 
-// SetIcon sets the "icon" field.
-func (m *MenuMutation) SetIcon(s string) {
-	m.icon = &s
+// AppendedFlags returns the list of values that were appended to the "flags" field in this mutation.
+func (m *LoginEventMutation) AppendedFlags() ([]string, bool) {
+	if len(m.appendflags) == 0 {
+		return nil, false
+	}
+	return m.appendflags, true
 }
 
-// Icon returns the value of the "icon" field in the mutation.
-func (m *MenuMutation) Icon() (r string, exists bool) {
-	v := m.icon
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearFlags clears the value of the "flags" field.
+func (m *LoginEventMutation) ClearFlags() {
+	m.flags = nil
+	m.appendflags = nil
+	m.clearedFields[loginevent.FieldFlags] = struct{}{}
 }
 
-// OldIcon returns the old "icon" field's value of the Menu entity.
-// If the Menu object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MenuMutation) OldIcon(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIcon is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIcon requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIcon: %w", err)
-	}
-	return oldValue.Icon, nil
+// FlagsCleared returns if the "flags" field was cleared in this mutation.
+func (m *LoginEventMutation) FlagsCleared() bool {
+	_, ok := m.clearedFields[loginevent.FieldFlags]
+	return ok
 }
 
-// ResetIcon resets all changes to the "icon" field.
-func (m *MenuMutation) ResetIcon() {
-	m.icon = nil
+// ResetFlags resets all changes to the "flags" field.
+func (m *LoginEventMutation) ResetFlags() {
+	m.flags = nil
+	m.appendflags = nil
+	delete(m.clearedFields, loginevent.FieldFlags)
 }
 
-// SetHideMenu sets the "hide_menu" field.
-func (m *MenuMutation) SetHideMenu(b bool) {
-	m.hide_menu = &b
+// SetAnomalous sets the "anomalous" field.
+func (m *LoginEventMutation) SetAnomalous(b bool) {
+	m.anomalous = &b
 }
 
-// HideMenu returns the value of the "hide_menu" field in the mutation.
-func (m *MenuMutation) HideMenu() (r bool, exists bool) {
-	v := m.hide_menu
+// Anomalous returns the value of the "anomalous" field in the mutation.
+func (m *LoginEventMutation) Anomalous() (r bool, exists bool) {
+	v := m.anomalous
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldHideMenu returns the old "hide_menu" field's value of the Menu entity.
-// If the Menu object wasn't provided to the builder, the object is fetched from the database.
+// OldAnomalous returns the old "anomalous" field's value of the LoginEvent entity.
+// If the LoginEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MenuMutation) OldHideMenu(ctx context.Context) (v bool, err error) {
+func (m *LoginEventMutation) OldAnomalous(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldHideMenu is only allowed on UpdateOne operations")
+		return v, errors.New("OldAnomalous is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldHideMenu requires an ID field in the mutation")
+		return v, errors.New("OldAnomalous requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldHideMenu: %w", err)
+		return v, fmt.Errorf("querying old value for OldAnomalous: %w", err)
 	}
-	return oldValue.HideMenu, nil
-}
-
-// ClearHideMenu clears the value of the "hide_menu" field.
-func (m *MenuMutation) ClearHideMenu() {
-	m.hide_menu = nil
-	m.clearedFields[menu.FieldHideMenu] = struct{}{}
-}
-
-// HideMenuCleared returns if the "hide_menu" field was cleared in this mutation.
-func (m *MenuMutation) HideMenuCleared() bool {
-	_, ok := m.clearedFields[menu.FieldHideMenu]
-	return ok
+	return oldValue.Anomalous, nil
 }
 
-// ResetHideMenu resets all changes to the "hide_menu" field.
-func (m *MenuMutation) ResetHideMenu() {
-	m.hide_menu = nil
-	delete(m.clearedFields, menu.FieldHideMenu)
+// ResetAnomalous resets all changes to the "anomalous" field.
+func (m *LoginEventMutation) ResetAnomalous() {
+	m.anomalous = nil
 }
 
-// SetHideBreadcrumb sets the "hide_breadcrumb" field.
-func (m *MenuMutation) SetHideBreadcrumb(b bool) {
-	m.hide_breadcrumb = &b
+// Where appends a list predicates to the LoginEventMutation builder.
+func (m *LoginEventMutation) Where(ps ...predicate.LoginEvent) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// HideBreadcrumb returns the value of the "hide_breadcrumb" field in the mutation.
-func (m *MenuMutation) HideBreadcrumb() (r bool, exists bool) {
-	v := m.hide_breadcrumb
-	if v == nil {
-		return
+// WhereP appends storage-level predicates to the LoginEventMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *LoginEventMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.LoginEvent, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return *v, true
+	m.Where(p...)
 }
 
-// OldHideBreadcrumb returns the old "hide_breadcrumb" field's value of the Menu entity.
-// If the Menu object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MenuMutation) OldHideBreadcrumb(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldHideBreadcrumb is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldHideBreadcrumb requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldHideBreadcrumb: %w", err)
-	}
-	return oldValue.HideBreadcrumb, nil
+// Op returns the operation name.
+func (m *LoginEventMutation) Op() Op {
+	return m.op
 }
 
-// ClearHideBreadcrumb clears the value of the "hide_breadcrumb" field.
-func (m *MenuMutation) ClearHideBreadcrumb() {
-	m.hide_breadcrumb = nil
-	m.clearedFields[menu.FieldHideBreadcrumb] = struct{}{}
+// SetOp allows setting the mutation operation.
+func (m *LoginEventMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// HideBreadcrumbCleared returns if the "hide_breadcrumb" field was cleared in this mutation.
-func (m *MenuMutation) HideBreadcrumbCleared() bool {
-	_, ok := m.clearedFields[menu.FieldHideBreadcrumb]
-	return ok
+// Type returns the node type of this mutation (LoginEvent).
+func (m *LoginEventMutation) Type() string {
+	return m.typ
 }
 
-// ResetHideBreadcrumb resets all changes to the "hide_breadcrumb" field.
-func (m *MenuMutation) ResetHideBreadcrumb() {
-	m.hide_breadcrumb = nil
-	delete(m.clearedFields, menu.FieldHideBreadcrumb)
-}
-
-// SetCurrentActiveMenu sets the "current_active_menu" field.
-func (m *MenuMutation) SetCurrentActiveMenu(s string) {
-	m.current_active_menu = &s
-}
-
-// CurrentActiveMenu returns the value of the "current_active_menu" field in the mutation.
-func (m *MenuMutation) CurrentActiveMenu() (r string, exists bool) {
-	v := m.current_active_menu
-	if v == nil {
-		return
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *LoginEventMutation) Fields() []string {
+	fields := make([]string, 0, 13)
+	if m.created_at != nil {
+		fields = append(fields, loginevent.FieldCreatedAt)
 	}
-	return *v, true
-}
-
-// OldCurrentActiveMenu returns the old "current_active_menu" field's value of the Menu entity.
-// If the Menu object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MenuMutation) OldCurrentActiveMenu(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCurrentActiveMenu is only allowed on UpdateOne operations")
+	if m.updated_at != nil {
+		fields = append(fields, loginevent.FieldUpdatedAt)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCurrentActiveMenu requires an ID field in the mutation")
+	if m.tenant_id != nil {
+		fields = append(fields, loginevent.FieldTenantID)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCurrentActiveMenu: %w", err)
+	if m.user_id != nil {
+		fields = append(fields, loginevent.FieldUserID)
 	}
-	return oldValue.CurrentActiveMenu, nil
+	if m.username != nil {
+		fields = append(fields, loginevent.FieldUsername)
+	}
+	if m.method != nil {
+		fields = append(fields, loginevent.FieldMethod)
+	}
+	if m.ip != nil {
+		fields = append(fields, loginevent.FieldIP)
+	}
+	if m.user_agent != nil {
+		fields = append(fields, loginevent.FieldUserAgent)
+	}
+	if m.device != nil {
+		fields = append(fields, loginevent.FieldDevice)
+	}
+	if m.success != nil {
+		fields = append(fields, loginevent.FieldSuccess)
+	}
+	if m.reason != nil {
+		fields = append(fields, loginevent.FieldReason)
+	}
+	if m.flags != nil {
+		fields = append(fields, loginevent.FieldFlags)
+	}
+	if m.anomalous != nil {
+		fields = append(fields, loginevent.FieldAnomalous)
+	}
+	return fields
 }
 
-// ClearCurrentActiveMenu clears the value of the "current_active_menu" field.
-func (m *MenuMutation) ClearCurrentActiveMenu() {
-	m.current_active_menu = nil
-	m.clearedFields[menu.FieldCurrentActiveMenu] = struct{}{}
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *LoginEventMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case loginevent.FieldCreatedAt:
+		return m.CreatedAt()
+	case loginevent.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case loginevent.FieldTenantID:
+		return m.TenantID()
+	case loginevent.FieldUserID:
+		return m.UserID()
+	case loginevent.FieldUsername:
+		return m.Username()
+	case loginevent.FieldMethod:
+		return m.Method()
+	case loginevent.FieldIP:
+		return m.IP()
+	case loginevent.FieldUserAgent:
+		return m.UserAgent()
+	case loginevent.FieldDevice:
+		return m.Device()
+	case loginevent.FieldSuccess:
+		return m.Success()
+	case loginevent.FieldReason:
+		return m.Reason()
+	case loginevent.FieldFlags:
+		return m.Flags()
+	case loginevent.FieldAnomalous:
+		return m.Anomalous()
+	}
+	return nil, false
 }
 
-// CurrentActiveMenuCleared returns if the "current_active_menu" field was cleared in this mutation.
-func (m *MenuMutation) CurrentActiveMenuCleared() bool {
-	_, ok := m.clearedFields[menu.FieldCurrentActiveMenu]
-	return ok
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *LoginEventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case loginevent.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case loginevent.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case loginevent.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case loginevent.FieldUserID:
+		return m.OldUserID(ctx)
+	case loginevent.FieldUsername:
+		return m.OldUsername(ctx)
+	case loginevent.FieldMethod:
+		return m.OldMethod(ctx)
+	case loginevent.FieldIP:
+		return m.OldIP(ctx)
+	case loginevent.FieldUserAgent:
+		return m.OldUserAgent(ctx)
+	case loginevent.FieldDevice:
+		return m.OldDevice(ctx)
+	case loginevent.FieldSuccess:
+		return m.OldSuccess(ctx)
+	case loginevent.FieldReason:
+		return m.OldReason(ctx)
+	case loginevent.FieldFlags:
+		return m.OldFlags(ctx)
+	case loginevent.FieldAnomalous:
+		return m.OldAnomalous(ctx)
+	}
+	return nil, fmt.Errorf("unknown LoginEvent field %s", name)
 }
 
-// ResetCurrentActiveMenu resets all changes to the "current_active_menu" field.
-func (m *MenuMutation) ResetCurrentActiveMenu() {
-	m.current_active_menu = nil
-	delete(m.clearedFields, menu.FieldCurrentActiveMenu)
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LoginEventMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case loginevent.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case loginevent.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case loginevent.FieldTenantID:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case loginevent.FieldUserID:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case loginevent.FieldUsername:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUsername(v)
+		return nil
+	case loginevent.FieldMethod:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMethod(v)
+		return nil
+	case loginevent.FieldIP:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIP(v)
+		return nil
+	case loginevent.FieldUserAgent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserAgent(v)
+		return nil
+	case loginevent.FieldDevice:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDevice(v)
+		return nil
+	case loginevent.FieldSuccess:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSuccess(v)
+		return nil
+	case loginevent.FieldReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReason(v)
+		return nil
+	case loginevent.FieldFlags:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFlags(v)
+		return nil
+	case loginevent.FieldAnomalous:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAnomalous(v)
+		return nil
+	}
+	return fmt.Errorf("unknown LoginEvent field %s", name)
 }
 
-// SetIgnoreKeepAlive sets the "ignore_keep_alive" field.
-func (m *MenuMutation) SetIgnoreKeepAlive(b bool) {
-	m.ignore_keep_alive = &b
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *LoginEventMutation) AddedFields() []string {
+	var fields []string
+	if m.addtenant_id != nil {
+		fields = append(fields, loginevent.FieldTenantID)
+	}
+	if m.adduser_id != nil {
+		fields = append(fields, loginevent.FieldUserID)
+	}
+	return fields
 }
 
-// IgnoreKeepAlive returns the value of the "ignore_keep_alive" field in the mutation.
-func (m *MenuMutation) IgnoreKeepAlive() (r bool, exists bool) {
-	v := m.ignore_keep_alive
-	if v == nil {
-		return
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *LoginEventMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case loginevent.FieldTenantID:
+		return m.AddedTenantID()
+	case loginevent.FieldUserID:
+		return m.AddedUserID()
 	}
-	return *v, true
+	return nil, false
 }
 
-// OldIgnoreKeepAlive returns the old "ignore_keep_alive" field's value of the Menu entity.
-// If the Menu object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MenuMutation) OldIgnoreKeepAlive(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIgnoreKeepAlive is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIgnoreKeepAlive requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIgnoreKeepAlive: %w", err)
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LoginEventMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case loginevent.FieldTenantID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTenantID(v)
+		return nil
+	case loginevent.FieldUserID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUserID(v)
+		return nil
 	}
-	return oldValue.IgnoreKeepAlive, nil
+	return fmt.Errorf("unknown LoginEvent numeric field %s", name)
 }
 
-// ClearIgnoreKeepAlive clears the value of the "ignore_keep_alive" field.
-func (m *MenuMutation) ClearIgnoreKeepAlive() {
-	m.ignore_keep_alive = nil
-	m.clearedFields[menu.FieldIgnoreKeepAlive] = struct{}{}
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *LoginEventMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(loginevent.FieldUserID) {
+		fields = append(fields, loginevent.FieldUserID)
+	}
+	if m.FieldCleared(loginevent.FieldUsername) {
+		fields = append(fields, loginevent.FieldUsername)
+	}
+	if m.FieldCleared(loginevent.FieldIP) {
+		fields = append(fields, loginevent.FieldIP)
+	}
+	if m.FieldCleared(loginevent.FieldUserAgent) {
+		fields = append(fields, loginevent.FieldUserAgent)
+	}
+	if m.FieldCleared(loginevent.FieldDevice) {
+		fields = append(fields, loginevent.FieldDevice)
+	}
+	if m.FieldCleared(loginevent.FieldReason) {
+		fields = append(fields, loginevent.FieldReason)
+	}
+	if m.FieldCleared(loginevent.FieldFlags) {
+		fields = append(fields, loginevent.FieldFlags)
+	}
+	return fields
 }
 
-// IgnoreKeepAliveCleared returns if the "ignore_keep_alive" field was cleared in this mutation.
-func (m *MenuMutation) IgnoreKeepAliveCleared() bool {
-	_, ok := m.clearedFields[menu.FieldIgnoreKeepAlive]
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *LoginEventMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
 	return ok
 }
 
-// ResetIgnoreKeepAlive resets all changes to the "ignore_keep_alive" field.
-func (m *MenuMutation) ResetIgnoreKeepAlive() {
-	m.ignore_keep_alive = nil
-	delete(m.clearedFields, menu.FieldIgnoreKeepAlive)
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *LoginEventMutation) ClearField(name string) error {
+	switch name {
+	case loginevent.FieldUserID:
+		m.ClearUserID()
+		return nil
+	case loginevent.FieldUsername:
+		m.ClearUsername()
+		return nil
+	case loginevent.FieldIP:
+		m.ClearIP()
+		return nil
+	case loginevent.FieldUserAgent:
+		m.ClearUserAgent()
+		return nil
+	case loginevent.FieldDevice:
+		m.ClearDevice()
+		return nil
+	case loginevent.FieldReason:
+		m.ClearReason()
+		return nil
+	case loginevent.FieldFlags:
+		m.ClearFlags()
+		return nil
+	}
+	return fmt.Errorf("unknown LoginEvent nullable field %s", name)
 }
 
-// SetHideTab sets the "hide_tab" field.
-func (m *MenuMutation) SetHideTab(b bool) {
-	m.hide_tab = &b
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *LoginEventMutation) ResetField(name string) error {
+	switch name {
+	case loginevent.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case loginevent.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case loginevent.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case loginevent.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case loginevent.FieldUsername:
+		m.ResetUsername()
+		return nil
+	case loginevent.FieldMethod:
+		m.ResetMethod()
+		return nil
+	case loginevent.FieldIP:
+		m.ResetIP()
+		return nil
+	case loginevent.FieldUserAgent:
+		m.ResetUserAgent()
+		return nil
+	case loginevent.FieldDevice:
+		m.ResetDevice()
+		return nil
+	case loginevent.FieldSuccess:
+		m.ResetSuccess()
+		return nil
+	case loginevent.FieldReason:
+		m.ResetReason()
+		return nil
+	case loginevent.FieldFlags:
+		m.ResetFlags()
+		return nil
+	case loginevent.FieldAnomalous:
+		m.ResetAnomalous()
+		return nil
+	}
+	return fmt.Errorf("unknown LoginEvent field %s", name)
 }
 
-// HideTab returns the value of the "hide_tab" field in the mutation.
-func (m *MenuMutation) HideTab() (r bool, exists bool) {
-	v := m.hide_tab
-	if v == nil {
-		return
-	}
-	return *v, true
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *LoginEventMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// OldHideTab returns the old "hide_tab" field's value of the Menu entity.
-// If the Menu object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MenuMutation) OldHideTab(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldHideTab is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldHideTab requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldHideTab: %w", err)
-	}
-	return oldValue.HideTab, nil
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *LoginEventMutation) AddedIDs(name string) []ent.Value {
+	return nil
 }
 
-// ClearHideTab clears the value of the "hide_tab" field.
-func (m *MenuMutation) ClearHideTab() {
-	m.hide_tab = nil
-	m.clearedFields[menu.FieldHideTab] = struct{}{}
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *LoginEventMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// HideTabCleared returns if the "hide_tab" field was cleared in this mutation.
-func (m *MenuMutation) HideTabCleared() bool {
-	_, ok := m.clearedFields[menu.FieldHideTab]
-	return ok
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *LoginEventMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// ResetHideTab resets all changes to the "hide_tab" field.
-func (m *MenuMutation) ResetHideTab() {
-	m.hide_tab = nil
-	delete(m.clearedFields, menu.FieldHideTab)
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *LoginEventMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// SetFrameSrc sets the "frame_src" field.
-func (m *MenuMutation) SetFrameSrc(s string) {
-	m.frame_src = &s
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *LoginEventMutation) EdgeCleared(name string) bool {
+	return false
 }
 
-// FrameSrc returns the value of the "frame_src" field in the mutation.
-func (m *MenuMutation) FrameSrc() (r string, exists bool) {
-	v := m.frame_src
-	if v == nil {
-		return
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *LoginEventMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown LoginEvent unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *LoginEventMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown LoginEvent edge %s", name)
+}
+
+// LogsMutation represents an operation that mutates the Logs nodes in the graph.
+type LogsMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *uint64
+	created_at        *time.Time
+	updated_at        *time.Time
+	tenant_id         *uint64
+	addtenant_id      *int64
+	_type             *string
+	method            *string
+	api               *string
+	success           *bool
+	req_content       *string
+	resp_content      *string
+	ip                *string
+	user_agent        *string
+	operator          *string
+	impersonated_user *string
+	time              *int
+	addtime           *int
+	clearedFields     map[string]struct{}
+	done              bool
+	oldValue          func(context.Context) (*Logs, error)
+	predicates        []predicate.Logs
+}
+
+var _ ent.Mutation = (*LogsMutation)(nil)
+
+// logsOption allows management of the mutation configuration using functional options.
+type logsOption func(*LogsMutation)
+
+// newLogsMutation creates new mutation for the Logs entity.
+func newLogsMutation(c config, op Op, opts ...logsOption) *LogsMutation {
+	m := &LogsMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeLogs,
+		clearedFields: make(map[string]struct{}),
 	}
-	return *v, true
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
 }
 
-// OldFrameSrc returns the old "frame_src" field's value of the Menu entity.
-// If the Menu object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MenuMutation) OldFrameSrc(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFrameSrc is only allowed on UpdateOne operations")
+// withLogsID sets the ID field of the mutation.
+func withLogsID(id uint64) logsOption {
+	return func(m *LogsMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Logs
+		)
+		m.oldValue = func(ctx context.Context) (*Logs, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Logs.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFrameSrc requires an ID field in the mutation")
+}
+
+// withLogs sets the old Logs of the mutation.
+func withLogs(node *Logs) logsOption {
+	return func(m *LogsMutation) {
+		m.oldValue = func(context.Context) (*Logs, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFrameSrc: %w", err)
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m LogsMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m LogsMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
-	return oldValue.FrameSrc, nil
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// ClearFrameSrc clears the value of the "frame_src" field.
-func (m *MenuMutation) ClearFrameSrc() {
-	m.frame_src = nil
-	m.clearedFields[menu.FieldFrameSrc] = struct{}{}
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Logs entities.
+func (m *LogsMutation) SetID(id uint64) {
+	m.id = &id
 }
 
-// FrameSrcCleared returns if the "frame_src" field was cleared in this mutation.
-func (m *MenuMutation) FrameSrcCleared() bool {
-	_, ok := m.clearedFields[menu.FieldFrameSrc]
-	return ok
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *LogsMutation) ID() (id uint64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
 }
 
-// ResetFrameSrc resets all changes to the "frame_src" field.
-func (m *MenuMutation) ResetFrameSrc() {
-	m.frame_src = nil
-	delete(m.clearedFields, menu.FieldFrameSrc)
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *LogsMutation) IDs(ctx context.Context) ([]uint64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Logs.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// SetCarryParam sets the "carry_param" field.
-func (m *MenuMutation) SetCarryParam(b bool) {
-	m.carry_param = &b
+// SetCreatedAt sets the "created_at" field.
+func (m *LogsMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// CarryParam returns the value of the "carry_param" field in the mutation.
-func (m *MenuMutation) CarryParam() (r bool, exists bool) {
-	v := m.carry_param
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *LogsMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCarryParam returns the old "carry_param" field's value of the Menu entity.
-// If the Menu object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Logs entity.
+// If the Logs object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MenuMutation) OldCarryParam(ctx context.Context) (v bool, err error) {
+func (m *LogsMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCarryParam is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCarryParam requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCarryParam: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.CarryParam, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ClearCarryParam clears the value of the "carry_param" field.
-func (m *MenuMutation) ClearCarryParam() {
-	m.carry_param = nil
-	m.clearedFields[menu.FieldCarryParam] = struct{}{}
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *LogsMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// CarryParamCleared returns if the "carry_param" field was cleared in this mutation.
-func (m *MenuMutation) CarryParamCleared() bool {
-	_, ok := m.clearedFields[menu.FieldCarryParam]
-	return ok
-}
-
-// ResetCarryParam resets all changes to the "carry_param" field.
-func (m *MenuMutation) ResetCarryParam() {
-	m.carry_param = nil
-	delete(m.clearedFields, menu.FieldCarryParam)
-}
-
-// SetHideChildrenInMenu sets the "hide_children_in_menu" field.
-func (m *MenuMutation) SetHideChildrenInMenu(b bool) {
-	m.hide_children_in_menu = &b
+// SetUpdatedAt sets the "updated_at" field.
+func (m *LogsMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// HideChildrenInMenu returns the value of the "hide_children_in_menu" field in the mutation.
-func (m *MenuMutation) HideChildrenInMenu() (r bool, exists bool) {
-	v := m.hide_children_in_menu
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *LogsMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldHideChildrenInMenu returns the old "hide_children_in_menu" field's value of the Menu entity.
-// If the Menu object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the Logs entity.
+// If the Logs object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MenuMutation) OldHideChildrenInMenu(ctx context.Context) (v bool, err error) {
+func (m *LogsMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldHideChildrenInMenu is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldHideChildrenInMenu requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldHideChildrenInMenu: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return oldValue.HideChildrenInMenu, nil
-}
-
-// ClearHideChildrenInMenu clears the value of the "hide_children_in_menu" field.
-func (m *MenuMutation) ClearHideChildrenInMenu() {
-	m.hide_children_in_menu = nil
-	m.clearedFields[menu.FieldHideChildrenInMenu] = struct{}{}
-}
-
-// HideChildrenInMenuCleared returns if the "hide_children_in_menu" field was cleared in this mutation.
-func (m *MenuMutation) HideChildrenInMenuCleared() bool {
-	_, ok := m.clearedFields[menu.FieldHideChildrenInMenu]
-	return ok
+	return oldValue.UpdatedAt, nil
 }
 
-// ResetHideChildrenInMenu resets all changes to the "hide_children_in_menu" field.
-func (m *MenuMutation) ResetHideChildrenInMenu() {
-	m.hide_children_in_menu = nil
-	delete(m.clearedFields, menu.FieldHideChildrenInMenu)
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *LogsMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// SetAffix sets the "affix" field.
-func (m *MenuMutation) SetAffix(b bool) {
-	m.affix = &b
+// SetTenantID sets the "tenant_id" field.
+func (m *LogsMutation) SetTenantID(u uint64) {
+	m.tenant_id = &u
+	m.addtenant_id = nil
 }
 
-// Affix returns the value of the "affix" field in the mutation.
-func (m *MenuMutation) Affix() (r bool, exists bool) {
-	v := m.affix
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *LogsMutation) TenantID() (r uint64, exists bool) {
+	v := m.tenant_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAffix returns the old "affix" field's value of the Menu entity.
-// If the Menu object wasn't provided to the builder, the object is fetched from the database.
+// OldTenantID returns the old "tenant_id" field's value of the Logs entity.
+// If the Logs object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MenuMutation) OldAffix(ctx context.Context) (v bool, err error) {
+func (m *LogsMutation) OldTenantID(ctx context.Context) (v uint64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAffix is only allowed on UpdateOne operations")
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAffix requires an ID field in the mutation")
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAffix: %w", err)
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
 	}
-	return oldValue.Affix, nil
+	return oldValue.TenantID, nil
 }
 
-// ClearAffix clears the value of the "affix" field.
-func (m *MenuMutation) ClearAffix() {
-	m.affix = nil
-	m.clearedFields[menu.FieldAffix] = struct{}{}
+// AddTenantID adds u to the "tenant_id" field.
+func (m *LogsMutation) AddTenantID(u int64) {
+	if m.addtenant_id != nil {
+		*m.addtenant_id += u
+	} else {
+		m.addtenant_id = &u
+	}
 }
 
-// AffixCleared returns if the "affix" field was cleared in this mutation.
-func (m *MenuMutation) AffixCleared() bool {
-	_, ok := m.clearedFields[menu.FieldAffix]
-	return ok
+// AddedTenantID returns the value that was added to the "tenant_id" field in this mutation.
+func (m *LogsMutation) AddedTenantID() (r int64, exists bool) {
+	v := m.addtenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetAffix resets all changes to the "affix" field.
-func (m *MenuMutation) ResetAffix() {
-	m.affix = nil
-	delete(m.clearedFields, menu.FieldAffix)
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *LogsMutation) ResetTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
 }
 
-// SetDynamicLevel sets the "dynamic_level" field.
-func (m *MenuMutation) SetDynamicLevel(u uint32) {
-	m.dynamic_level = &u
-	m.adddynamic_level = nil
+// SetType sets the "type" field.
+func (m *LogsMutation) SetType(s string) {
+	m._type = &s
 }
 
-// DynamicLevel returns the value of the "dynamic_level" field in the mutation.
-func (m *MenuMutation) DynamicLevel() (r uint32, exists bool) {
-	v := m.dynamic_level
+// GetType returns the value of the "type" field in the mutation.
+func (m *LogsMutation) GetType() (r string, exists bool) {
+	v := m._type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDynamicLevel returns the old "dynamic_level" field's value of the Menu entity.
-// If the Menu object wasn't provided to the builder, the object is fetched from the database.
+// OldType returns the old "type" field's value of the Logs entity.
+// If the Logs object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MenuMutation) OldDynamicLevel(ctx context.Context) (v uint32, err error) {
+func (m *LogsMutation) OldType(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDynamicLevel is only allowed on UpdateOne operations")
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDynamicLevel requires an ID field in the mutation")
+		return v, errors.New("OldType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDynamicLevel: %w", err)
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
 	}
-	return oldValue.DynamicLevel, nil
+	return oldValue.Type, nil
 }
 
-// AddDynamicLevel adds u to the "dynamic_level" field.
-func (m *MenuMutation) AddDynamicLevel(u int32) {
-	if m.adddynamic_level != nil {
-		*m.adddynamic_level += u
-	} else {
-		m.adddynamic_level = &u
-	}
+// ResetType resets all changes to the "type" field.
+func (m *LogsMutation) ResetType() {
+	m._type = nil
 }
 
-// AddedDynamicLevel returns the value that was added to the "dynamic_level" field in this mutation.
-func (m *MenuMutation) AddedDynamicLevel() (r int32, exists bool) {
-	v := m.adddynamic_level
+// SetMethod sets the "method" field.
+func (m *LogsMutation) SetMethod(s string) {
+	m.method = &s
+}
+
+// Method returns the value of the "method" field in the mutation.
+func (m *LogsMutation) Method() (r string, exists bool) {
+	v := m.method
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ClearDynamicLevel clears the value of the "dynamic_level" field.
-func (m *MenuMutation) ClearDynamicLevel() {
-	m.dynamic_level = nil
-	m.adddynamic_level = nil
-	m.clearedFields[menu.FieldDynamicLevel] = struct{}{}
-}
-
-// DynamicLevelCleared returns if the "dynamic_level" field was cleared in this mutation.
-func (m *MenuMutation) DynamicLevelCleared() bool {
-	_, ok := m.clearedFields[menu.FieldDynamicLevel]
-	return ok
+// OldMethod returns the old "method" field's value of the Logs entity.
+// If the Logs object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LogsMutation) OldMethod(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMethod is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMethod requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMethod: %w", err)
+	}
+	return oldValue.Method, nil
 }
 
-// ResetDynamicLevel resets all changes to the "dynamic_level" field.
-func (m *MenuMutation) ResetDynamicLevel() {
-	m.dynamic_level = nil
-	m.adddynamic_level = nil
-	delete(m.clearedFields, menu.FieldDynamicLevel)
+// ResetMethod resets all changes to the "method" field.
+func (m *LogsMutation) ResetMethod() {
+	m.method = nil
 }
 
-// SetRealPath sets the "real_path" field.
-func (m *MenuMutation) SetRealPath(s string) {
-	m.real_path = &s
+// SetAPI sets the "api" field.
+func (m *LogsMutation) SetAPI(s string) {
+	m.api = &s
 }
 
-// RealPath returns the value of the "real_path" field in the mutation.
-func (m *MenuMutation) RealPath() (r string, exists bool) {
-	v := m.real_path
+// API returns the value of the "api" field in the mutation.
+func (m *LogsMutation) API() (r string, exists bool) {
+	v := m.api
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRealPath returns the old "real_path" field's value of the Menu entity.
-// If the Menu object wasn't provided to the builder, the object is fetched from the database.
+// OldAPI returns the old "api" field's value of the Logs entity.
+// If the Logs object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MenuMutation) OldRealPath(ctx context.Context) (v string, err error) {
+func (m *LogsMutation) OldAPI(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRealPath is only allowed on UpdateOne operations")
+		return v, errors.New("OldAPI is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRealPath requires an ID field in the mutation")
+		return v, errors.New("OldAPI requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRealPath: %w", err)
+		return v, fmt.Errorf("querying old value for OldAPI: %w", err)
 	}
-	return oldValue.RealPath, nil
+	return oldValue.API, nil
 }
 
-// ClearRealPath clears the value of the "real_path" field.
-func (m *MenuMutation) ClearRealPath() {
-	m.real_path = nil
-	m.clearedFields[menu.FieldRealPath] = struct{}{}
+// ResetAPI resets all changes to the "api" field.
+func (m *LogsMutation) ResetAPI() {
+	m.api = nil
 }
 
-// RealPathCleared returns if the "real_path" field was cleared in this mutation.
-func (m *MenuMutation) RealPathCleared() bool {
-	_, ok := m.clearedFields[menu.FieldRealPath]
-	return ok
+// SetSuccess sets the "success" field.
+func (m *LogsMutation) SetSuccess(b bool) {
+	m.success = &b
 }
 
-// ResetRealPath resets all changes to the "real_path" field.
-func (m *MenuMutation) ResetRealPath() {
-	m.real_path = nil
-	delete(m.clearedFields, menu.FieldRealPath)
+// Success returns the value of the "success" field in the mutation.
+func (m *LogsMutation) Success() (r bool, exists bool) {
+	v := m.success
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// AddRoleIDs adds the "roles" edge to the Role entity by ids.
-func (m *MenuMutation) AddRoleIDs(ids ...uint64) {
-	if m.roles == nil {
-		m.roles = make(map[uint64]struct{})
+// OldSuccess returns the old "success" field's value of the Logs entity.
+// If the Logs object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LogsMutation) OldSuccess(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSuccess is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		m.roles[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSuccess requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSuccess: %w", err)
 	}
+	return oldValue.Success, nil
 }
 
-// ClearRoles clears the "roles" edge to the Role entity.
-func (m *MenuMutation) ClearRoles() {
-	m.clearedroles = true
+// ResetSuccess resets all changes to the "success" field.
+func (m *LogsMutation) ResetSuccess() {
+	m.success = nil
 }
 
-// RolesCleared reports if the "roles" edge to the Role entity was cleared.
-func (m *MenuMutation) RolesCleared() bool {
-	return m.clearedroles
+// SetReqContent sets the "req_content" field.
+func (m *LogsMutation) SetReqContent(s string) {
+	m.req_content = &s
 }
 
-// RemoveRoleIDs removes the "roles" edge to the Role entity by IDs.
-func (m *MenuMutation) RemoveRoleIDs(ids ...uint64) {
-	if m.removedroles == nil {
-		m.removedroles = make(map[uint64]struct{})
-	}
-	for i := range ids {
-		delete(m.roles, ids[i])
-		m.removedroles[ids[i]] = struct{}{}
+// ReqContent returns the value of the "req_content" field in the mutation.
+func (m *LogsMutation) ReqContent() (r string, exists bool) {
+	v := m.req_content
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedRoles returns the removed IDs of the "roles" edge to the Role entity.
-func (m *MenuMutation) RemovedRolesIDs() (ids []uint64) {
-	for id := range m.removedroles {
-		ids = append(ids, id)
+// OldReqContent returns the old "req_content" field's value of the Logs entity.
+// If the Logs object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LogsMutation) OldReqContent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReqContent is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// RolesIDs returns the "roles" edge IDs in the mutation.
-func (m *MenuMutation) RolesIDs() (ids []uint64) {
-	for id := range m.roles {
-		ids = append(ids, id)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReqContent requires an ID field in the mutation")
 	}
-	return
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReqContent: %w", err)
+	}
+	return oldValue.ReqContent, nil
 }
 
-// ResetRoles resets all changes to the "roles" edge.
-func (m *MenuMutation) ResetRoles() {
-	m.roles = nil
-	m.clearedroles = false
-	m.removedroles = nil
+// ClearReqContent clears the value of the "req_content" field.
+func (m *LogsMutation) ClearReqContent() {
+	m.req_content = nil
+	m.clearedFields[logs.FieldReqContent] = struct{}{}
 }
 
-// ClearParent clears the "parent" edge to the Menu entity.
-func (m *MenuMutation) ClearParent() {
-	m.clearedparent = true
-	m.clearedFields[menu.FieldParentID] = struct{}{}
+// ReqContentCleared returns if the "req_content" field was cleared in this mutation.
+func (m *LogsMutation) ReqContentCleared() bool {
+	_, ok := m.clearedFields[logs.FieldReqContent]
+	return ok
 }
 
-// ParentCleared reports if the "parent" edge to the Menu entity was cleared.
-func (m *MenuMutation) ParentCleared() bool {
-	return m.ParentIDCleared() || m.clearedparent
+// ResetReqContent resets all changes to the "req_content" field.
+func (m *LogsMutation) ResetReqContent() {
+	m.req_content = nil
+	delete(m.clearedFields, logs.FieldReqContent)
 }
 
-// ParentIDs returns the "parent" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ParentID instead. It exists only for internal usage by the builders.
-func (m *MenuMutation) ParentIDs() (ids []uint64) {
-	if id := m.parent; id != nil {
-		ids = append(ids, *id)
-	}
-	return
+// SetRespContent sets the "resp_content" field.
+func (m *LogsMutation) SetRespContent(s string) {
+	m.resp_content = &s
 }
 
-// ResetParent resets all changes to the "parent" edge.
-func (m *MenuMutation) ResetParent() {
-	m.parent = nil
-	m.clearedparent = false
+// RespContent returns the value of the "resp_content" field in the mutation.
+func (m *LogsMutation) RespContent() (r string, exists bool) {
+	v := m.resp_content
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// AddChildIDs adds the "children" edge to the Menu entity by ids.
-func (m *MenuMutation) AddChildIDs(ids ...uint64) {
-	if m.children == nil {
-		m.children = make(map[uint64]struct{})
+// OldRespContent returns the old "resp_content" field's value of the Logs entity.
+// If the Logs object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LogsMutation) OldRespContent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRespContent is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		m.children[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRespContent requires an ID field in the mutation")
 	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRespContent: %w", err)
+	}
+	return oldValue.RespContent, nil
 }
 
-// ClearChildren clears the "children" edge to the Menu entity.
-func (m *MenuMutation) ClearChildren() {
-	m.clearedchildren = true
+// ClearRespContent clears the value of the "resp_content" field.
+func (m *LogsMutation) ClearRespContent() {
+	m.resp_content = nil
+	m.clearedFields[logs.FieldRespContent] = struct{}{}
 }
 
-// ChildrenCleared reports if the "children" edge to the Menu entity was cleared.
-func (m *MenuMutation) ChildrenCleared() bool {
-	return m.clearedchildren
+// RespContentCleared returns if the "resp_content" field was cleared in this mutation.
+func (m *LogsMutation) RespContentCleared() bool {
+	_, ok := m.clearedFields[logs.FieldRespContent]
+	return ok
 }
 
-// RemoveChildIDs removes the "children" edge to the Menu entity by IDs.
-func (m *MenuMutation) RemoveChildIDs(ids ...uint64) {
-	if m.removedchildren == nil {
-		m.removedchildren = make(map[uint64]struct{})
-	}
-	for i := range ids {
-		delete(m.children, ids[i])
-		m.removedchildren[ids[i]] = struct{}{}
-	}
+// ResetRespContent resets all changes to the "resp_content" field.
+func (m *LogsMutation) ResetRespContent() {
+	m.resp_content = nil
+	delete(m.clearedFields, logs.FieldRespContent)
 }
 
-// RemovedChildren returns the removed IDs of the "children" edge to the Menu entity.
-func (m *MenuMutation) RemovedChildrenIDs() (ids []uint64) {
-	for id := range m.removedchildren {
-		ids = append(ids, id)
-	}
-	return
+// SetIP sets the "ip" field.
+func (m *LogsMutation) SetIP(s string) {
+	m.ip = &s
 }
 
-// ChildrenIDs returns the "children" edge IDs in the mutation.
-func (m *MenuMutation) ChildrenIDs() (ids []uint64) {
-	for id := range m.children {
-		ids = append(ids, id)
+// IP returns the value of the "ip" field in the mutation.
+func (m *LogsMutation) IP() (r string, exists bool) {
+	v := m.ip
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetChildren resets all changes to the "children" edge.
-func (m *MenuMutation) ResetChildren() {
-	m.children = nil
-	m.clearedchildren = false
-	m.removedchildren = nil
+// OldIP returns the old "ip" field's value of the Logs entity.
+// If the Logs object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LogsMutation) OldIP(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIP is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIP requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIP: %w", err)
+	}
+	return oldValue.IP, nil
 }
 
-// Where appends a list predicates to the MenuMutation builder.
-func (m *MenuMutation) Where(ps ...predicate.Menu) {
-	m.predicates = append(m.predicates, ps...)
+// ClearIP clears the value of the "ip" field.
+func (m *LogsMutation) ClearIP() {
+	m.ip = nil
+	m.clearedFields[logs.FieldIP] = struct{}{}
 }
 
-// WhereP appends storage-level predicates to the MenuMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *MenuMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Menu, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
-	}
-	m.Where(p...)
+// IPCleared returns if the "ip" field was cleared in this mutation.
+func (m *LogsMutation) IPCleared() bool {
+	_, ok := m.clearedFields[logs.FieldIP]
+	return ok
 }
 
-// Op returns the operation name.
-func (m *MenuMutation) Op() Op {
-	return m.op
+// ResetIP resets all changes to the "ip" field.
+func (m *LogsMutation) ResetIP() {
+	m.ip = nil
+	delete(m.clearedFields, logs.FieldIP)
 }
 
-// SetOp allows setting the mutation operation.
-func (m *MenuMutation) SetOp(op Op) {
-	m.op = op
+// SetUserAgent sets the "user_agent" field.
+func (m *LogsMutation) SetUserAgent(s string) {
+	m.user_agent = &s
 }
 
-// Type returns the node type of this mutation (Menu).
-func (m *MenuMutation) Type() string {
-	return m.typ
+// UserAgent returns the value of the "user_agent" field in the mutation.
+func (m *LogsMutation) UserAgent() (r string, exists bool) {
+	v := m.user_agent
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *MenuMutation) Fields() []string {
-	fields := make([]string, 0, 24)
-	if m.created_at != nil {
-		fields = append(fields, menu.FieldCreatedAt)
-	}
-	if m.updated_at != nil {
-		fields = append(fields, menu.FieldUpdatedAt)
-	}
-	if m.parent != nil {
-		fields = append(fields, menu.FieldParentID)
-	}
-	if m.menu_level != nil {
-		fields = append(fields, menu.FieldMenuLevel)
-	}
-	if m.menu_type != nil {
-		fields = append(fields, menu.FieldMenuType)
-	}
-	if m._path != nil {
-		fields = append(fields, menu.FieldPath)
-	}
-	if m.name != nil {
-		fields = append(fields, menu.FieldName)
-	}
-	if m.redirect != nil {
-		fields = append(fields, menu.FieldRedirect)
-	}
-	if m.component != nil {
-		fields = append(fields, menu.FieldComponent)
+// OldUserAgent returns the old "user_agent" field's value of the Logs entity.
+// If the Logs object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LogsMutation) OldUserAgent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserAgent is only allowed on UpdateOne operations")
 	}
-	if m.order_no != nil {
-		fields = append(fields, menu.FieldOrderNo)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserAgent requires an ID field in the mutation")
 	}
-	if m.disabled != nil {
-		fields = append(fields, menu.FieldDisabled)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserAgent: %w", err)
 	}
-	if m.title != nil {
-		fields = append(fields, menu.FieldTitle)
+	return oldValue.UserAgent, nil
+}
+
+// ClearUserAgent clears the value of the "user_agent" field.
+func (m *LogsMutation) ClearUserAgent() {
+	m.user_agent = nil
+	m.clearedFields[logs.FieldUserAgent] = struct{}{}
+}
+
+// UserAgentCleared returns if the "user_agent" field was cleared in this mutation.
+func (m *LogsMutation) UserAgentCleared() bool {
+	_, ok := m.clearedFields[logs.FieldUserAgent]
+	return ok
+}
+
+// ResetUserAgent resets all changes to the "user_agent" field.
+func (m *LogsMutation) ResetUserAgent() {
+	m.user_agent = nil
+	delete(m.clearedFields, logs.FieldUserAgent)
+}
+
+// SetOperator sets the "operator" field.
+func (m *LogsMutation) SetOperator(s string) {
+	m.operator = &s
+}
+
+// Operator returns the value of the "operator" field in the mutation.
+func (m *LogsMutation) Operator() (r string, exists bool) {
+	v := m.operator
+	if v == nil {
+		return
 	}
-	if m.icon != nil {
-		fields = append(fields, menu.FieldIcon)
+	return *v, true
+}
+
+// OldOperator returns the old "operator" field's value of the Logs entity.
+// If the Logs object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LogsMutation) OldOperator(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOperator is only allowed on UpdateOne operations")
 	}
-	if m.hide_menu != nil {
-		fields = append(fields, menu.FieldHideMenu)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOperator requires an ID field in the mutation")
 	}
-	if m.hide_breadcrumb != nil {
-		fields = append(fields, menu.FieldHideBreadcrumb)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOperator: %w", err)
 	}
-	if m.current_active_menu != nil {
-		fields = append(fields, menu.FieldCurrentActiveMenu)
+	return oldValue.Operator, nil
+}
+
+// ClearOperator clears the value of the "operator" field.
+func (m *LogsMutation) ClearOperator() {
+	m.operator = nil
+	m.clearedFields[logs.FieldOperator] = struct{}{}
+}
+
+// OperatorCleared returns if the "operator" field was cleared in this mutation.
+func (m *LogsMutation) OperatorCleared() bool {
+	_, ok := m.clearedFields[logs.FieldOperator]
+	return ok
+}
+
+// ResetOperator resets all changes to the "operator" field.
+func (m *LogsMutation) ResetOperator() {
+	m.operator = nil
+	delete(m.clearedFields, logs.FieldOperator)
+}
+
+// SetImpersonatedUser sets the "impersonated_user" field.
+func (m *LogsMutation) SetImpersonatedUser(s string) {
+	m.impersonated_user = &s
+}
+
+// ImpersonatedUser returns the value of the "impersonated_user" field in the mutation.
+func (m *LogsMutation) ImpersonatedUser() (r string, exists bool) {
+	v := m.impersonated_user
+	if v == nil {
+		return
 	}
-	if m.ignore_keep_alive != nil {
-		fields = append(fields, menu.FieldIgnoreKeepAlive)
+	return *v, true
+}
+
+// OldImpersonatedUser returns the old "impersonated_user" field's value of the Logs entity.
+// If the Logs object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LogsMutation) OldImpersonatedUser(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldImpersonatedUser is only allowed on UpdateOne operations")
 	}
-	if m.hide_tab != nil {
-		fields = append(fields, menu.FieldHideTab)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldImpersonatedUser requires an ID field in the mutation")
 	}
-	if m.frame_src != nil {
-		fields = append(fields, menu.FieldFrameSrc)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldImpersonatedUser: %w", err)
 	}
-	if m.carry_param != nil {
-		fields = append(fields, menu.FieldCarryParam)
+	return oldValue.ImpersonatedUser, nil
+}
+
+// ClearImpersonatedUser clears the value of the "impersonated_user" field.
+func (m *LogsMutation) ClearImpersonatedUser() {
+	m.impersonated_user = nil
+	m.clearedFields[logs.FieldImpersonatedUser] = struct{}{}
+}
+
+// ImpersonatedUserCleared returns if the "impersonated_user" field was cleared in this mutation.
+func (m *LogsMutation) ImpersonatedUserCleared() bool {
+	_, ok := m.clearedFields[logs.FieldImpersonatedUser]
+	return ok
+}
+
+// ResetImpersonatedUser resets all changes to the "impersonated_user" field.
+func (m *LogsMutation) ResetImpersonatedUser() {
+	m.impersonated_user = nil
+	delete(m.clearedFields, logs.FieldImpersonatedUser)
+}
+
+// SetTime sets the "time" field.
+func (m *LogsMutation) SetTime(i int) {
+	m.time = &i
+	m.addtime = nil
+}
+
+// Time returns the value of the "time" field in the mutation.
+func (m *LogsMutation) Time() (r int, exists bool) {
+	v := m.time
+	if v == nil {
+		return
 	}
-	if m.hide_children_in_menu != nil {
-		fields = append(fields, menu.FieldHideChildrenInMenu)
+	return *v, true
+}
+
+// OldTime returns the old "time" field's value of the Logs entity.
+// If the Logs object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LogsMutation) OldTime(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTime is only allowed on UpdateOne operations")
 	}
-	if m.affix != nil {
-		fields = append(fields, menu.FieldAffix)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTime requires an ID field in the mutation")
 	}
-	if m.dynamic_level != nil {
-		fields = append(fields, menu.FieldDynamicLevel)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTime: %w", err)
 	}
-	if m.real_path != nil {
-		fields = append(fields, menu.FieldRealPath)
+	return oldValue.Time, nil
+}
+
+// AddTime adds i to the "time" field.
+func (m *LogsMutation) AddTime(i int) {
+	if m.addtime != nil {
+		*m.addtime += i
+	} else {
+		m.addtime = &i
+	}
+}
+
+// AddedTime returns the value that was added to the "time" field in this mutation.
+func (m *LogsMutation) AddedTime() (r int, exists bool) {
+	v := m.addtime
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearTime clears the value of the "time" field.
+func (m *LogsMutation) ClearTime() {
+	m.time = nil
+	m.addtime = nil
+	m.clearedFields[logs.FieldTime] = struct{}{}
+}
+
+// TimeCleared returns if the "time" field was cleared in this mutation.
+func (m *LogsMutation) TimeCleared() bool {
+	_, ok := m.clearedFields[logs.FieldTime]
+	return ok
+}
+
+// ResetTime resets all changes to the "time" field.
+func (m *LogsMutation) ResetTime() {
+	m.time = nil
+	m.addtime = nil
+	delete(m.clearedFields, logs.FieldTime)
+}
+
+// Where appends a list predicates to the LogsMutation builder.
+func (m *LogsMutation) Where(ps ...predicate.Logs) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the LogsMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *LogsMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Logs, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *LogsMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *LogsMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Logs).
+func (m *LogsMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *LogsMutation) Fields() []string {
+	fields := make([]string, 0, 14)
+	if m.created_at != nil {
+		fields = append(fields, logs.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, logs.FieldUpdatedAt)
+	}
+	if m.tenant_id != nil {
+		fields = append(fields, logs.FieldTenantID)
+	}
+	if m._type != nil {
+		fields = append(fields, logs.FieldType)
+	}
+	if m.method != nil {
+		fields = append(fields, logs.FieldMethod)
+	}
+	if m.api != nil {
+		fields = append(fields, logs.FieldAPI)
+	}
+	if m.success != nil {
+		fields = append(fields, logs.FieldSuccess)
+	}
+	if m.req_content != nil {
+		fields = append(fields, logs.FieldReqContent)
+	}
+	if m.resp_content != nil {
+		fields = append(fields, logs.FieldRespContent)
+	}
+	if m.ip != nil {
+		fields = append(fields, logs.FieldIP)
+	}
+	if m.user_agent != nil {
+		fields = append(fields, logs.FieldUserAgent)
+	}
+	if m.operator != nil {
+		fields = append(fields, logs.FieldOperator)
+	}
+	if m.impersonated_user != nil {
+		fields = append(fields, logs.FieldImpersonatedUser)
+	}
+	if m.time != nil {
+		fields = append(fields, logs.FieldTime)
 	}
 	return fields
 }
@@ -4819,56 +5452,36 @@ func (m *MenuMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *MenuMutation) Field(name string) (ent.Value, bool) {
+func (m *LogsMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case menu.FieldCreatedAt:
+	case logs.FieldCreatedAt:
 		return m.CreatedAt()
-	case menu.FieldUpdatedAt:
+	case logs.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case menu.FieldParentID:
-		return m.ParentID()
-	case menu.FieldMenuLevel:
-		return m.MenuLevel()
-	case menu.FieldMenuType:
-		return m.MenuType()
-	case menu.FieldPath:
-		return m.Path()
-	case menu.FieldName:
-		return m.Name()
-	case menu.FieldRedirect:
-		return m.Redirect()
-	case menu.FieldComponent:
-		return m.Component()
-	case menu.FieldOrderNo:
-		return m.OrderNo()
-	case menu.FieldDisabled:
-		return m.Disabled()
-	case menu.FieldTitle:
-		return m.Title()
-	case menu.FieldIcon:
-		return m.Icon()
-	case menu.FieldHideMenu:
-		return m.HideMenu()
-	case menu.FieldHideBreadcrumb:
-		return m.HideBreadcrumb()
-	case menu.FieldCurrentActiveMenu:
-		return m.CurrentActiveMenu()
-	case menu.FieldIgnoreKeepAlive:
-		return m.IgnoreKeepAlive()
-	case menu.FieldHideTab:
-		return m.HideTab()
-	case menu.FieldFrameSrc:
-		return m.FrameSrc()
-	case menu.FieldCarryParam:
-		return m.CarryParam()
-	case menu.FieldHideChildrenInMenu:
-		return m.HideChildrenInMenu()
-	case menu.FieldAffix:
-		return m.Affix()
-	case menu.FieldDynamicLevel:
-		return m.DynamicLevel()
-	case menu.FieldRealPath:
-		return m.RealPath()
+	case logs.FieldTenantID:
+		return m.TenantID()
+	case logs.FieldType:
+		return m.GetType()
+	case logs.FieldMethod:
+		return m.Method()
+	case logs.FieldAPI:
+		return m.API()
+	case logs.FieldSuccess:
+		return m.Success()
+	case logs.FieldReqContent:
+		return m.ReqContent()
+	case logs.FieldRespContent:
+		return m.RespContent()
+	case logs.FieldIP:
+		return m.IP()
+	case logs.FieldUserAgent:
+		return m.UserAgent()
+	case logs.FieldOperator:
+		return m.Operator()
+	case logs.FieldImpersonatedUser:
+		return m.ImpersonatedUser()
+	case logs.FieldTime:
+		return m.Time()
 	}
 	return nil, false
 }
@@ -4876,672 +5489,418 @@ func (m *MenuMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *MenuMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *LogsMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case menu.FieldCreatedAt:
+	case logs.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case menu.FieldUpdatedAt:
+	case logs.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case menu.FieldParentID:
-		return m.OldParentID(ctx)
-	case menu.FieldMenuLevel:
-		return m.OldMenuLevel(ctx)
-	case menu.FieldMenuType:
-		return m.OldMenuType(ctx)
-	case menu.FieldPath:
-		return m.OldPath(ctx)
-	case menu.FieldName:
-		return m.OldName(ctx)
-	case menu.FieldRedirect:
-		return m.OldRedirect(ctx)
-	case menu.FieldComponent:
-		return m.OldComponent(ctx)
-	case menu.FieldOrderNo:
-		return m.OldOrderNo(ctx)
-	case menu.FieldDisabled:
-		return m.OldDisabled(ctx)
-	case menu.FieldTitle:
-		return m.OldTitle(ctx)
-	case menu.FieldIcon:
-		return m.OldIcon(ctx)
-	case menu.FieldHideMenu:
-		return m.OldHideMenu(ctx)
-	case menu.FieldHideBreadcrumb:
-		return m.OldHideBreadcrumb(ctx)
-	case menu.FieldCurrentActiveMenu:
-		return m.OldCurrentActiveMenu(ctx)
-	case menu.FieldIgnoreKeepAlive:
-		return m.OldIgnoreKeepAlive(ctx)
-	case menu.FieldHideTab:
-		return m.OldHideTab(ctx)
-	case menu.FieldFrameSrc:
-		return m.OldFrameSrc(ctx)
-	case menu.FieldCarryParam:
-		return m.OldCarryParam(ctx)
-	case menu.FieldHideChildrenInMenu:
-		return m.OldHideChildrenInMenu(ctx)
-	case menu.FieldAffix:
-		return m.OldAffix(ctx)
-	case menu.FieldDynamicLevel:
-		return m.OldDynamicLevel(ctx)
-	case menu.FieldRealPath:
-		return m.OldRealPath(ctx)
+	case logs.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case logs.FieldType:
+		return m.OldType(ctx)
+	case logs.FieldMethod:
+		return m.OldMethod(ctx)
+	case logs.FieldAPI:
+		return m.OldAPI(ctx)
+	case logs.FieldSuccess:
+		return m.OldSuccess(ctx)
+	case logs.FieldReqContent:
+		return m.OldReqContent(ctx)
+	case logs.FieldRespContent:
+		return m.OldRespContent(ctx)
+	case logs.FieldIP:
+		return m.OldIP(ctx)
+	case logs.FieldUserAgent:
+		return m.OldUserAgent(ctx)
+	case logs.FieldOperator:
+		return m.OldOperator(ctx)
+	case logs.FieldImpersonatedUser:
+		return m.OldImpersonatedUser(ctx)
+	case logs.FieldTime:
+		return m.OldTime(ctx)
 	}
-	return nil, fmt.Errorf("unknown Menu field %s", name)
+	return nil, fmt.Errorf("unknown Logs field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MenuMutation) SetField(name string, value ent.Value) error {
+func (m *LogsMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case menu.FieldCreatedAt:
+	case logs.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case menu.FieldUpdatedAt:
+	case logs.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case menu.FieldParentID:
+	case logs.FieldTenantID:
 		v, ok := value.(uint64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetParentID(v)
-		return nil
-	case menu.FieldMenuLevel:
-		v, ok := value.(uint32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetMenuLevel(v)
+		m.SetTenantID(v)
 		return nil
-	case menu.FieldMenuType:
-		v, ok := value.(uint32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetMenuType(v)
-		return nil
-	case menu.FieldPath:
+	case logs.FieldType:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPath(v)
+		m.SetType(v)
 		return nil
-	case menu.FieldName:
+	case logs.FieldMethod:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetMethod(v)
 		return nil
-	case menu.FieldRedirect:
+	case logs.FieldAPI:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRedirect(v)
+		m.SetAPI(v)
 		return nil
-	case menu.FieldComponent:
-		v, ok := value.(string)
+	case logs.FieldSuccess:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetComponent(v)
+		m.SetSuccess(v)
 		return nil
-	case menu.FieldOrderNo:
-		v, ok := value.(uint32)
+	case logs.FieldReqContent:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetOrderNo(v)
+		m.SetReqContent(v)
 		return nil
-	case menu.FieldDisabled:
-		v, ok := value.(bool)
+	case logs.FieldRespContent:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDisabled(v)
+		m.SetRespContent(v)
 		return nil
-	case menu.FieldTitle:
+	case logs.FieldIP:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTitle(v)
+		m.SetIP(v)
 		return nil
-	case menu.FieldIcon:
+	case logs.FieldUserAgent:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIcon(v)
+		m.SetUserAgent(v)
 		return nil
-	case menu.FieldHideMenu:
-		v, ok := value.(bool)
+	case logs.FieldOperator:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetHideMenu(v)
+		m.SetOperator(v)
 		return nil
-	case menu.FieldHideBreadcrumb:
-		v, ok := value.(bool)
+	case logs.FieldImpersonatedUser:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetHideBreadcrumb(v)
+		m.SetImpersonatedUser(v)
 		return nil
-	case menu.FieldCurrentActiveMenu:
-		v, ok := value.(string)
+	case logs.FieldTime:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCurrentActiveMenu(v)
+		m.SetTime(v)
 		return nil
-	case menu.FieldIgnoreKeepAlive:
-		v, ok := value.(bool)
+	}
+	return fmt.Errorf("unknown Logs field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *LogsMutation) AddedFields() []string {
+	var fields []string
+	if m.addtenant_id != nil {
+		fields = append(fields, logs.FieldTenantID)
+	}
+	if m.addtime != nil {
+		fields = append(fields, logs.FieldTime)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *LogsMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case logs.FieldTenantID:
+		return m.AddedTenantID()
+	case logs.FieldTime:
+		return m.AddedTime()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LogsMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case logs.FieldTenantID:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIgnoreKeepAlive(v)
+		m.AddTenantID(v)
 		return nil
-	case menu.FieldHideTab:
-		v, ok := value.(bool)
+	case logs.FieldTime:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetHideTab(v)
-		return nil
-	case menu.FieldFrameSrc:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetFrameSrc(v)
-		return nil
-	case menu.FieldCarryParam:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCarryParam(v)
-		return nil
-	case menu.FieldHideChildrenInMenu:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetHideChildrenInMenu(v)
-		return nil
-	case menu.FieldAffix:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetAffix(v)
-		return nil
-	case menu.FieldDynamicLevel:
-		v, ok := value.(uint32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDynamicLevel(v)
-		return nil
-	case menu.FieldRealPath:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetRealPath(v)
-		return nil
-	}
-	return fmt.Errorf("unknown Menu field %s", name)
-}
-
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *MenuMutation) AddedFields() []string {
-	var fields []string
-	if m.addmenu_level != nil {
-		fields = append(fields, menu.FieldMenuLevel)
-	}
-	if m.addmenu_type != nil {
-		fields = append(fields, menu.FieldMenuType)
-	}
-	if m.addorder_no != nil {
-		fields = append(fields, menu.FieldOrderNo)
-	}
-	if m.adddynamic_level != nil {
-		fields = append(fields, menu.FieldDynamicLevel)
-	}
-	return fields
-}
-
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *MenuMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case menu.FieldMenuLevel:
-		return m.AddedMenuLevel()
-	case menu.FieldMenuType:
-		return m.AddedMenuType()
-	case menu.FieldOrderNo:
-		return m.AddedOrderNo()
-	case menu.FieldDynamicLevel:
-		return m.AddedDynamicLevel()
-	}
-	return nil, false
-}
-
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *MenuMutation) AddField(name string, value ent.Value) error {
-	switch name {
-	case menu.FieldMenuLevel:
-		v, ok := value.(int32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddMenuLevel(v)
-		return nil
-	case menu.FieldMenuType:
-		v, ok := value.(int32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddMenuType(v)
-		return nil
-	case menu.FieldOrderNo:
-		v, ok := value.(int32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddOrderNo(v)
-		return nil
-	case menu.FieldDynamicLevel:
-		v, ok := value.(int32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddDynamicLevel(v)
+		m.AddTime(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Menu numeric field %s", name)
+	return fmt.Errorf("unknown Logs numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *MenuMutation) ClearedFields() []string {
+func (m *LogsMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(menu.FieldParentID) {
-		fields = append(fields, menu.FieldParentID)
-	}
-	if m.FieldCleared(menu.FieldPath) {
-		fields = append(fields, menu.FieldPath)
-	}
-	if m.FieldCleared(menu.FieldRedirect) {
-		fields = append(fields, menu.FieldRedirect)
-	}
-	if m.FieldCleared(menu.FieldComponent) {
-		fields = append(fields, menu.FieldComponent)
-	}
-	if m.FieldCleared(menu.FieldDisabled) {
-		fields = append(fields, menu.FieldDisabled)
-	}
-	if m.FieldCleared(menu.FieldHideMenu) {
-		fields = append(fields, menu.FieldHideMenu)
-	}
-	if m.FieldCleared(menu.FieldHideBreadcrumb) {
-		fields = append(fields, menu.FieldHideBreadcrumb)
-	}
-	if m.FieldCleared(menu.FieldCurrentActiveMenu) {
-		fields = append(fields, menu.FieldCurrentActiveMenu)
-	}
-	if m.FieldCleared(menu.FieldIgnoreKeepAlive) {
-		fields = append(fields, menu.FieldIgnoreKeepAlive)
-	}
-	if m.FieldCleared(menu.FieldHideTab) {
-		fields = append(fields, menu.FieldHideTab)
+	if m.FieldCleared(logs.FieldReqContent) {
+		fields = append(fields, logs.FieldReqContent)
 	}
-	if m.FieldCleared(menu.FieldFrameSrc) {
-		fields = append(fields, menu.FieldFrameSrc)
+	if m.FieldCleared(logs.FieldRespContent) {
+		fields = append(fields, logs.FieldRespContent)
 	}
-	if m.FieldCleared(menu.FieldCarryParam) {
-		fields = append(fields, menu.FieldCarryParam)
+	if m.FieldCleared(logs.FieldIP) {
+		fields = append(fields, logs.FieldIP)
 	}
-	if m.FieldCleared(menu.FieldHideChildrenInMenu) {
-		fields = append(fields, menu.FieldHideChildrenInMenu)
+	if m.FieldCleared(logs.FieldUserAgent) {
+		fields = append(fields, logs.FieldUserAgent)
 	}
-	if m.FieldCleared(menu.FieldAffix) {
-		fields = append(fields, menu.FieldAffix)
+	if m.FieldCleared(logs.FieldOperator) {
+		fields = append(fields, logs.FieldOperator)
 	}
-	if m.FieldCleared(menu.FieldDynamicLevel) {
-		fields = append(fields, menu.FieldDynamicLevel)
+	if m.FieldCleared(logs.FieldImpersonatedUser) {
+		fields = append(fields, logs.FieldImpersonatedUser)
 	}
-	if m.FieldCleared(menu.FieldRealPath) {
-		fields = append(fields, menu.FieldRealPath)
+	if m.FieldCleared(logs.FieldTime) {
+		fields = append(fields, logs.FieldTime)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *MenuMutation) FieldCleared(name string) bool {
+func (m *LogsMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *MenuMutation) ClearField(name string) error {
+func (m *LogsMutation) ClearField(name string) error {
 	switch name {
-	case menu.FieldParentID:
-		m.ClearParentID()
-		return nil
-	case menu.FieldPath:
-		m.ClearPath()
-		return nil
-	case menu.FieldRedirect:
-		m.ClearRedirect()
-		return nil
-	case menu.FieldComponent:
-		m.ClearComponent()
-		return nil
-	case menu.FieldDisabled:
-		m.ClearDisabled()
-		return nil
-	case menu.FieldHideMenu:
-		m.ClearHideMenu()
-		return nil
-	case menu.FieldHideBreadcrumb:
-		m.ClearHideBreadcrumb()
-		return nil
-	case menu.FieldCurrentActiveMenu:
-		m.ClearCurrentActiveMenu()
-		return nil
-	case menu.FieldIgnoreKeepAlive:
-		m.ClearIgnoreKeepAlive()
-		return nil
-	case menu.FieldHideTab:
-		m.ClearHideTab()
+	case logs.FieldReqContent:
+		m.ClearReqContent()
 		return nil
-	case menu.FieldFrameSrc:
-		m.ClearFrameSrc()
+	case logs.FieldRespContent:
+		m.ClearRespContent()
 		return nil
-	case menu.FieldCarryParam:
-		m.ClearCarryParam()
+	case logs.FieldIP:
+		m.ClearIP()
 		return nil
-	case menu.FieldHideChildrenInMenu:
-		m.ClearHideChildrenInMenu()
+	case logs.FieldUserAgent:
+		m.ClearUserAgent()
 		return nil
-	case menu.FieldAffix:
-		m.ClearAffix()
+	case logs.FieldOperator:
+		m.ClearOperator()
 		return nil
-	case menu.FieldDynamicLevel:
-		m.ClearDynamicLevel()
+	case logs.FieldImpersonatedUser:
+		m.ClearImpersonatedUser()
 		return nil
-	case menu.FieldRealPath:
-		m.ClearRealPath()
+	case logs.FieldTime:
+		m.ClearTime()
 		return nil
 	}
-	return fmt.Errorf("unknown Menu nullable field %s", name)
+	return fmt.Errorf("unknown Logs nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *MenuMutation) ResetField(name string) error {
+func (m *LogsMutation) ResetField(name string) error {
 	switch name {
-	case menu.FieldCreatedAt:
+	case logs.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case menu.FieldUpdatedAt:
+	case logs.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case menu.FieldParentID:
-		m.ResetParentID()
-		return nil
-	case menu.FieldMenuLevel:
-		m.ResetMenuLevel()
+	case logs.FieldTenantID:
+		m.ResetTenantID()
 		return nil
-	case menu.FieldMenuType:
-		m.ResetMenuType()
+	case logs.FieldType:
+		m.ResetType()
 		return nil
-	case menu.FieldPath:
-		m.ResetPath()
+	case logs.FieldMethod:
+		m.ResetMethod()
 		return nil
-	case menu.FieldName:
-		m.ResetName()
+	case logs.FieldAPI:
+		m.ResetAPI()
 		return nil
-	case menu.FieldRedirect:
-		m.ResetRedirect()
+	case logs.FieldSuccess:
+		m.ResetSuccess()
 		return nil
-	case menu.FieldComponent:
-		m.ResetComponent()
-		return nil
-	case menu.FieldOrderNo:
-		m.ResetOrderNo()
-		return nil
-	case menu.FieldDisabled:
-		m.ResetDisabled()
-		return nil
-	case menu.FieldTitle:
-		m.ResetTitle()
-		return nil
-	case menu.FieldIcon:
-		m.ResetIcon()
-		return nil
-	case menu.FieldHideMenu:
-		m.ResetHideMenu()
-		return nil
-	case menu.FieldHideBreadcrumb:
-		m.ResetHideBreadcrumb()
-		return nil
-	case menu.FieldCurrentActiveMenu:
-		m.ResetCurrentActiveMenu()
-		return nil
-	case menu.FieldIgnoreKeepAlive:
-		m.ResetIgnoreKeepAlive()
-		return nil
-	case menu.FieldHideTab:
-		m.ResetHideTab()
+	case logs.FieldReqContent:
+		m.ResetReqContent()
 		return nil
-	case menu.FieldFrameSrc:
-		m.ResetFrameSrc()
+	case logs.FieldRespContent:
+		m.ResetRespContent()
 		return nil
-	case menu.FieldCarryParam:
-		m.ResetCarryParam()
+	case logs.FieldIP:
+		m.ResetIP()
 		return nil
-	case menu.FieldHideChildrenInMenu:
-		m.ResetHideChildrenInMenu()
+	case logs.FieldUserAgent:
+		m.ResetUserAgent()
 		return nil
-	case menu.FieldAffix:
-		m.ResetAffix()
+	case logs.FieldOperator:
+		m.ResetOperator()
 		return nil
-	case menu.FieldDynamicLevel:
-		m.ResetDynamicLevel()
+	case logs.FieldImpersonatedUser:
+		m.ResetImpersonatedUser()
 		return nil
-	case menu.FieldRealPath:
-		m.ResetRealPath()
+	case logs.FieldTime:
+		m.ResetTime()
 		return nil
 	}
-	return fmt.Errorf("unknown Menu field %s", name)
+	return fmt.Errorf("unknown Logs field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *MenuMutation) AddedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.roles != nil {
-		edges = append(edges, menu.EdgeRoles)
-	}
-	if m.parent != nil {
-		edges = append(edges, menu.EdgeParent)
-	}
-	if m.children != nil {
-		edges = append(edges, menu.EdgeChildren)
-	}
+func (m *LogsMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *MenuMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case menu.EdgeRoles:
-		ids := make([]ent.Value, 0, len(m.roles))
-		for id := range m.roles {
-			ids = append(ids, id)
-		}
-		return ids
-	case menu.EdgeParent:
-		if id := m.parent; id != nil {
-			return []ent.Value{*id}
-		}
-	case menu.EdgeChildren:
-		ids := make([]ent.Value, 0, len(m.children))
-		for id := range m.children {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *LogsMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *MenuMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.removedroles != nil {
-		edges = append(edges, menu.EdgeRoles)
-	}
-	if m.removedchildren != nil {
-		edges = append(edges, menu.EdgeChildren)
-	}
+func (m *LogsMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *MenuMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case menu.EdgeRoles:
-		ids := make([]ent.Value, 0, len(m.removedroles))
-		for id := range m.removedroles {
-			ids = append(ids, id)
-		}
-		return ids
-	case menu.EdgeChildren:
-		ids := make([]ent.Value, 0, len(m.removedchildren))
-		for id := range m.removedchildren {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *LogsMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *MenuMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.clearedroles {
-		edges = append(edges, menu.EdgeRoles)
-	}
-	if m.clearedparent {
-		edges = append(edges, menu.EdgeParent)
-	}
-	if m.clearedchildren {
-		edges = append(edges, menu.EdgeChildren)
-	}
+func (m *LogsMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *MenuMutation) EdgeCleared(name string) bool {
-	switch name {
-	case menu.EdgeRoles:
-		return m.clearedroles
-	case menu.EdgeParent:
-		return m.clearedparent
-	case menu.EdgeChildren:
-		return m.clearedchildren
-	}
+func (m *LogsMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *MenuMutation) ClearEdge(name string) error {
-	switch name {
-	case menu.EdgeParent:
-		m.ClearParent()
-		return nil
-	}
-	return fmt.Errorf("unknown Menu unique edge %s", name)
+func (m *LogsMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Logs unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *MenuMutation) ResetEdge(name string) error {
-	switch name {
-	case menu.EdgeRoles:
-		m.ResetRoles()
-		return nil
-	case menu.EdgeParent:
-		m.ResetParent()
-		return nil
-	case menu.EdgeChildren:
-		m.ResetChildren()
-		return nil
-	}
-	return fmt.Errorf("unknown Menu edge %s", name)
+func (m *LogsMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Logs edge %s", name)
 }
 
-// OauthProviderMutation represents an operation that mutates the OauthProvider nodes in the graph.
-type OauthProviderMutation struct {
+// MenuMutation represents an operation that mutates the Menu nodes in the graph.
+type MenuMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *uint64
-	created_at    *time.Time
-	updated_at    *time.Time
-	name          *string
-	app_id        *string
-	client_id     *string
-	client_secret *string
-	redirect_url  *string
-	scopes        *string
-	auth_url      *string
-	token_url     *string
-	auth_style    *uint64
-	addauth_style *int64
-	info_url      *string
-	clearedFields map[string]struct{}
-	done          bool
-	oldValue      func(context.Context) (*OauthProvider, error)
-	predicates    []predicate.OauthProvider
+	op                    Op
+	typ                   string
+	id                    *uint64
+	created_at            *time.Time
+	updated_at            *time.Time
+	tenant_id             *uint64
+	addtenant_id          *int64
+	menu_level            *uint32
+	addmenu_level         *int32
+	menu_type             *uint32
+	addmenu_type          *int32
+	_path                 *string
+	name                  *string
+	redirect              *string
+	component             *string
+	order_no              *uint32
+	addorder_no           *int32
+	disabled              *bool
+	title                 *string
+	icon                  *string
+	hide_menu             *bool
+	hide_breadcrumb       *bool
+	current_active_menu   *string
+	ignore_keep_alive     *bool
+	hide_tab              *bool
+	frame_src             *string
+	carry_param           *bool
+	hide_children_in_menu *bool
+	affix                 *bool
+	dynamic_level         *uint32
+	adddynamic_level      *int32
+	real_path             *string
+	clearedFields         map[string]struct{}
+	roles                 map[uint64]struct{}
+	removedroles          map[uint64]struct{}
+	clearedroles          bool
+	parent                *uint64
+	clearedparent         bool
+	children              map[uint64]struct{}
+	removedchildren       map[uint64]struct{}
+	clearedchildren       bool
+	done                  bool
+	oldValue              func(context.Context) (*Menu, error)
+	predicates            []predicate.Menu
 }
 
-var _ ent.Mutation = (*OauthProviderMutation)(nil)
+var _ ent.Mutation = (*MenuMutation)(nil)
 
-// oauthproviderOption allows management of the mutation configuration using functional options.
-type oauthproviderOption func(*OauthProviderMutation)
+// menuOption allows management of the mutation configuration using functional options.
+type menuOption func(*MenuMutation)
 
-// newOauthProviderMutation creates new mutation for the OauthProvider entity.
-func newOauthProviderMutation(c config, op Op, opts ...oauthproviderOption) *OauthProviderMutation {
-	m := &OauthProviderMutation{
+// newMenuMutation creates new mutation for the Menu entity.
+func newMenuMutation(c config, op Op, opts ...menuOption) *MenuMutation {
+	m := &MenuMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeOauthProvider,
+		typ:           TypeMenu,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -5550,20 +5909,20 @@ func newOauthProviderMutation(c config, op Op, opts ...oauthproviderOption) *Oau
 	return m
 }
 
-// withOauthProviderID sets the ID field of the mutation.
-func withOauthProviderID(id uint64) oauthproviderOption {
-	return func(m *OauthProviderMutation) {
+// withMenuID sets the ID field of the mutation.
+func withMenuID(id uint64) menuOption {
+	return func(m *MenuMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *OauthProvider
+			value *Menu
 		)
-		m.oldValue = func(ctx context.Context) (*OauthProvider, error) {
+		m.oldValue = func(ctx context.Context) (*Menu, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().OauthProvider.Get(ctx, id)
+					value, err = m.Client().Menu.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -5572,10 +5931,10 @@ func withOauthProviderID(id uint64) oauthproviderOption {
 	}
 }
 
-// withOauthProvider sets the old OauthProvider of the mutation.
-func withOauthProvider(node *OauthProvider) oauthproviderOption {
-	return func(m *OauthProviderMutation) {
-		m.oldValue = func(context.Context) (*OauthProvider, error) {
+// withMenu sets the old Menu of the mutation.
+func withMenu(node *Menu) menuOption {
+	return func(m *MenuMutation) {
+		m.oldValue = func(context.Context) (*Menu, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -5584,7 +5943,7 @@ func withOauthProvider(node *OauthProvider) oauthproviderOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m OauthProviderMutation) Client() *Client {
+func (m MenuMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -5592,7 +5951,7 @@ func (m OauthProviderMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m OauthProviderMutation) Tx() (*Tx, error) {
+func (m MenuMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -5602,14 +5961,14 @@ func (m OauthProviderMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of OauthProvider entities.
-func (m *OauthProviderMutation) SetID(id uint64) {
+// operation is only accepted on creation of Menu entities.
+func (m *MenuMutation) SetID(id uint64) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *OauthProviderMutation) ID() (id uint64, exists bool) {
+func (m *MenuMutation) ID() (id uint64, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -5620,7 +5979,7 @@ func (m *OauthProviderMutation) ID() (id uint64, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *OauthProviderMutation) IDs(ctx context.Context) ([]uint64, error) {
+func (m *MenuMutation) IDs(ctx context.Context) ([]uint64, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -5629,19 +5988,19 @@ func (m *OauthProviderMutation) IDs(ctx context.Context) ([]uint64, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().OauthProvider.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Menu.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *OauthProviderMutation) SetCreatedAt(t time.Time) {
+func (m *MenuMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *OauthProviderMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *MenuMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -5649,10 +6008,10 @@ func (m *OauthProviderMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the OauthProvider entity.
-// If the OauthProvider object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Menu entity.
+// If the Menu object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OauthProviderMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *MenuMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -5667,17 +6026,17 @@ func (m *OauthProviderMutation) OldCreatedAt(ctx context.Context) (v time.Time,
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *OauthProviderMutation) ResetCreatedAt() {
+func (m *MenuMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *OauthProviderMutation) SetUpdatedAt(t time.Time) {
+func (m *MenuMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *OauthProviderMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *MenuMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -5685,10 +6044,10 @@ func (m *OauthProviderMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the OauthProvider entity.
-// If the OauthProvider object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the Menu entity.
+// If the Menu object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OauthProviderMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *MenuMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -5703,1423 +6062,11899 @@ func (m *OauthProviderMutation) OldUpdatedAt(ctx context.Context) (v time.Time,
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *OauthProviderMutation) ResetUpdatedAt() {
+func (m *MenuMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
-// SetName sets the "name" field.
-func (m *OauthProviderMutation) SetName(s string) {
-	m.name = &s
+// SetTenantID sets the "tenant_id" field.
+func (m *MenuMutation) SetTenantID(u uint64) {
+	m.tenant_id = &u
+	m.addtenant_id = nil
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *OauthProviderMutation) Name() (r string, exists bool) {
-	v := m.name
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *MenuMutation) TenantID() (r uint64, exists bool) {
+	v := m.tenant_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the OauthProvider entity.
-// If the OauthProvider object wasn't provided to the builder, the object is fetched from the database.
+// OldTenantID returns the old "tenant_id" field's value of the Menu entity.
+// If the Menu object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OauthProviderMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *MenuMutation) OldTenantID(ctx context.Context) (v uint64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.TenantID, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *OauthProviderMutation) ResetName() {
-	m.name = nil
+// AddTenantID adds u to the "tenant_id" field.
+func (m *MenuMutation) AddTenantID(u int64) {
+	if m.addtenant_id != nil {
+		*m.addtenant_id += u
+	} else {
+		m.addtenant_id = &u
+	}
 }
 
-// SetAppID sets the "app_id" field.
-func (m *OauthProviderMutation) SetAppID(s string) {
-	m.app_id = &s
+// AddedTenantID returns the value that was added to the "tenant_id" field in this mutation.
+func (m *MenuMutation) AddedTenantID() (r int64, exists bool) {
+	v := m.addtenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// AppID returns the value of the "app_id" field in the mutation.
-func (m *OauthProviderMutation) AppID() (r string, exists bool) {
-	v := m.app_id
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *MenuMutation) ResetTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+}
+
+// SetParentID sets the "parent_id" field.
+func (m *MenuMutation) SetParentID(u uint64) {
+	m.parent = &u
+}
+
+// ParentID returns the value of the "parent_id" field in the mutation.
+func (m *MenuMutation) ParentID() (r uint64, exists bool) {
+	v := m.parent
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAppID returns the old "app_id" field's value of the OauthProvider entity.
-// If the OauthProvider object wasn't provided to the builder, the object is fetched from the database.
+// OldParentID returns the old "parent_id" field's value of the Menu entity.
+// If the Menu object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OauthProviderMutation) OldAppID(ctx context.Context) (v string, err error) {
+func (m *MenuMutation) OldParentID(ctx context.Context) (v uint64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAppID is only allowed on UpdateOne operations")
+		return v, errors.New("OldParentID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAppID requires an ID field in the mutation")
+		return v, errors.New("OldParentID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAppID: %w", err)
+		return v, fmt.Errorf("querying old value for OldParentID: %w", err)
 	}
-	return oldValue.AppID, nil
+	return oldValue.ParentID, nil
 }
 
-// ClearAppID clears the value of the "app_id" field.
-func (m *OauthProviderMutation) ClearAppID() {
-	m.app_id = nil
-	m.clearedFields[oauthprovider.FieldAppID] = struct{}{}
+// ClearParentID clears the value of the "parent_id" field.
+func (m *MenuMutation) ClearParentID() {
+	m.parent = nil
+	m.clearedFields[menu.FieldParentID] = struct{}{}
 }
 
-// AppIDCleared returns if the "app_id" field was cleared in this mutation.
-func (m *OauthProviderMutation) AppIDCleared() bool {
-	_, ok := m.clearedFields[oauthprovider.FieldAppID]
+// ParentIDCleared returns if the "parent_id" field was cleared in this mutation.
+func (m *MenuMutation) ParentIDCleared() bool {
+	_, ok := m.clearedFields[menu.FieldParentID]
 	return ok
 }
 
-// ResetAppID resets all changes to the "app_id" field.
-func (m *OauthProviderMutation) ResetAppID() {
-	m.app_id = nil
-	delete(m.clearedFields, oauthprovider.FieldAppID)
+// ResetParentID resets all changes to the "parent_id" field.
+func (m *MenuMutation) ResetParentID() {
+	m.parent = nil
+	delete(m.clearedFields, menu.FieldParentID)
 }
 
-// SetClientID sets the "client_id" field.
-func (m *OauthProviderMutation) SetClientID(s string) {
-	m.client_id = &s
+// SetMenuLevel sets the "menu_level" field.
+func (m *MenuMutation) SetMenuLevel(u uint32) {
+	m.menu_level = &u
+	m.addmenu_level = nil
 }
 
-// ClientID returns the value of the "client_id" field in the mutation.
-func (m *OauthProviderMutation) ClientID() (r string, exists bool) {
-	v := m.client_id
+// MenuLevel returns the value of the "menu_level" field in the mutation.
+func (m *MenuMutation) MenuLevel() (r uint32, exists bool) {
+	v := m.menu_level
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldClientID returns the old "client_id" field's value of the OauthProvider entity.
-// If the OauthProvider object wasn't provided to the builder, the object is fetched from the database.
+// OldMenuLevel returns the old "menu_level" field's value of the Menu entity.
+// If the Menu object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OauthProviderMutation) OldClientID(ctx context.Context) (v string, err error) {
+func (m *MenuMutation) OldMenuLevel(ctx context.Context) (v uint32, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldClientID is only allowed on UpdateOne operations")
+		return v, errors.New("OldMenuLevel is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldClientID requires an ID field in the mutation")
+		return v, errors.New("OldMenuLevel requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldClientID: %w", err)
+		return v, fmt.Errorf("querying old value for OldMenuLevel: %w", err)
 	}
-	return oldValue.ClientID, nil
+	return oldValue.MenuLevel, nil
 }
 
-// ResetClientID resets all changes to the "client_id" field.
-func (m *OauthProviderMutation) ResetClientID() {
-	m.client_id = nil
+// AddMenuLevel adds u to the "menu_level" field.
+func (m *MenuMutation) AddMenuLevel(u int32) {
+	if m.addmenu_level != nil {
+		*m.addmenu_level += u
+	} else {
+		m.addmenu_level = &u
+	}
 }
 
-// SetClientSecret sets the "client_secret" field.
-func (m *OauthProviderMutation) SetClientSecret(s string) {
-	m.client_secret = &s
+// AddedMenuLevel returns the value that was added to the "menu_level" field in this mutation.
+func (m *MenuMutation) AddedMenuLevel() (r int32, exists bool) {
+	v := m.addmenu_level
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ClientSecret returns the value of the "client_secret" field in the mutation.
-func (m *OauthProviderMutation) ClientSecret() (r string, exists bool) {
-	v := m.client_secret
+// ResetMenuLevel resets all changes to the "menu_level" field.
+func (m *MenuMutation) ResetMenuLevel() {
+	m.menu_level = nil
+	m.addmenu_level = nil
+}
+
+// SetMenuType sets the "menu_type" field.
+func (m *MenuMutation) SetMenuType(u uint32) {
+	m.menu_type = &u
+	m.addmenu_type = nil
+}
+
+// MenuType returns the value of the "menu_type" field in the mutation.
+func (m *MenuMutation) MenuType() (r uint32, exists bool) {
+	v := m.menu_type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldClientSecret returns the old "client_secret" field's value of the OauthProvider entity.
-// If the OauthProvider object wasn't provided to the builder, the object is fetched from the database.
+// OldMenuType returns the old "menu_type" field's value of the Menu entity.
+// If the Menu object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OauthProviderMutation) OldClientSecret(ctx context.Context) (v string, err error) {
+func (m *MenuMutation) OldMenuType(ctx context.Context) (v uint32, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldClientSecret is only allowed on UpdateOne operations")
+		return v, errors.New("OldMenuType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldClientSecret requires an ID field in the mutation")
+		return v, errors.New("OldMenuType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldClientSecret: %w", err)
+		return v, fmt.Errorf("querying old value for OldMenuType: %w", err)
 	}
-	return oldValue.ClientSecret, nil
+	return oldValue.MenuType, nil
 }
 
-// ClearClientSecret clears the value of the "client_secret" field.
-func (m *OauthProviderMutation) ClearClientSecret() {
-	m.client_secret = nil
-	m.clearedFields[oauthprovider.FieldClientSecret] = struct{}{}
+// AddMenuType adds u to the "menu_type" field.
+func (m *MenuMutation) AddMenuType(u int32) {
+	if m.addmenu_type != nil {
+		*m.addmenu_type += u
+	} else {
+		m.addmenu_type = &u
+	}
 }
 
-// ClientSecretCleared returns if the "client_secret" field was cleared in this mutation.
-func (m *OauthProviderMutation) ClientSecretCleared() bool {
-	_, ok := m.clearedFields[oauthprovider.FieldClientSecret]
-	return ok
+// AddedMenuType returns the value that was added to the "menu_type" field in this mutation.
+func (m *MenuMutation) AddedMenuType() (r int32, exists bool) {
+	v := m.addmenu_type
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetClientSecret resets all changes to the "client_secret" field.
-func (m *OauthProviderMutation) ResetClientSecret() {
-	m.client_secret = nil
-	delete(m.clearedFields, oauthprovider.FieldClientSecret)
+// ResetMenuType resets all changes to the "menu_type" field.
+func (m *MenuMutation) ResetMenuType() {
+	m.menu_type = nil
+	m.addmenu_type = nil
 }
 
-// SetRedirectURL sets the "redirect_url" field.
-func (m *OauthProviderMutation) SetRedirectURL(s string) {
-	m.redirect_url = &s
+// SetPath sets the "path" field.
+func (m *MenuMutation) SetPath(s string) {
+	m._path = &s
 }
 
-// RedirectURL returns the value of the "redirect_url" field in the mutation.
-func (m *OauthProviderMutation) RedirectURL() (r string, exists bool) {
-	v := m.redirect_url
+// Path returns the value of the "path" field in the mutation.
+func (m *MenuMutation) Path() (r string, exists bool) {
+	v := m._path
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRedirectURL returns the old "redirect_url" field's value of the OauthProvider entity.
-// If the OauthProvider object wasn't provided to the builder, the object is fetched from the database.
+// OldPath returns the old "path" field's value of the Menu entity.
+// If the Menu object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OauthProviderMutation) OldRedirectURL(ctx context.Context) (v string, err error) {
+func (m *MenuMutation) OldPath(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRedirectURL is only allowed on UpdateOne operations")
+		return v, errors.New("OldPath is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRedirectURL requires an ID field in the mutation")
+		return v, errors.New("OldPath requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRedirectURL: %w", err)
+		return v, fmt.Errorf("querying old value for OldPath: %w", err)
 	}
-	return oldValue.RedirectURL, nil
+	return oldValue.Path, nil
 }
 
-// ResetRedirectURL resets all changes to the "redirect_url" field.
-func (m *OauthProviderMutation) ResetRedirectURL() {
-	m.redirect_url = nil
+// ClearPath clears the value of the "path" field.
+func (m *MenuMutation) ClearPath() {
+	m._path = nil
+	m.clearedFields[menu.FieldPath] = struct{}{}
 }
 
-// SetScopes sets the "scopes" field.
-func (m *OauthProviderMutation) SetScopes(s string) {
-	m.scopes = &s
+// PathCleared returns if the "path" field was cleared in this mutation.
+func (m *MenuMutation) PathCleared() bool {
+	_, ok := m.clearedFields[menu.FieldPath]
+	return ok
 }
 
-// Scopes returns the value of the "scopes" field in the mutation.
-func (m *OauthProviderMutation) Scopes() (r string, exists bool) {
-	v := m.scopes
+// ResetPath resets all changes to the "path" field.
+func (m *MenuMutation) ResetPath() {
+	m._path = nil
+	delete(m.clearedFields, menu.FieldPath)
+}
+
+// SetName sets the "name" field.
+func (m *MenuMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *MenuMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldScopes returns the old "scopes" field's value of the OauthProvider entity.
-// If the OauthProvider object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the Menu entity.
+// If the Menu object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OauthProviderMutation) OldScopes(ctx context.Context) (v string, err error) {
+func (m *MenuMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldScopes is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldScopes requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldScopes: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.Scopes, nil
-}
-
-// ClearScopes clears the value of the "scopes" field.
-func (m *OauthProviderMutation) ClearScopes() {
-	m.scopes = nil
-	m.clearedFields[oauthprovider.FieldScopes] = struct{}{}
-}
-
-// ScopesCleared returns if the "scopes" field was cleared in this mutation.
-func (m *OauthProviderMutation) ScopesCleared() bool {
-	_, ok := m.clearedFields[oauthprovider.FieldScopes]
-	return ok
+	return oldValue.Name, nil
 }
 
-// ResetScopes resets all changes to the "scopes" field.
-func (m *OauthProviderMutation) ResetScopes() {
-	m.scopes = nil
-	delete(m.clearedFields, oauthprovider.FieldScopes)
+// ResetName resets all changes to the "name" field.
+func (m *MenuMutation) ResetName() {
+	m.name = nil
 }
 
-// SetAuthURL sets the "auth_url" field.
-func (m *OauthProviderMutation) SetAuthURL(s string) {
-	m.auth_url = &s
+// SetRedirect sets the "redirect" field.
+func (m *MenuMutation) SetRedirect(s string) {
+	m.redirect = &s
 }
 
-// AuthURL returns the value of the "auth_url" field in the mutation.
-func (m *OauthProviderMutation) AuthURL() (r string, exists bool) {
-	v := m.auth_url
+// Redirect returns the value of the "redirect" field in the mutation.
+func (m *MenuMutation) Redirect() (r string, exists bool) {
+	v := m.redirect
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAuthURL returns the old "auth_url" field's value of the OauthProvider entity.
-// If the OauthProvider object wasn't provided to the builder, the object is fetched from the database.
+// OldRedirect returns the old "redirect" field's value of the Menu entity.
+// If the Menu object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OauthProviderMutation) OldAuthURL(ctx context.Context) (v string, err error) {
+func (m *MenuMutation) OldRedirect(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAuthURL is only allowed on UpdateOne operations")
+		return v, errors.New("OldRedirect is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAuthURL requires an ID field in the mutation")
+		return v, errors.New("OldRedirect requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAuthURL: %w", err)
+		return v, fmt.Errorf("querying old value for OldRedirect: %w", err)
 	}
-	return oldValue.AuthURL, nil
+	return oldValue.Redirect, nil
 }
 
-// ResetAuthURL resets all changes to the "auth_url" field.
-func (m *OauthProviderMutation) ResetAuthURL() {
-	m.auth_url = nil
+// ClearRedirect clears the value of the "redirect" field.
+func (m *MenuMutation) ClearRedirect() {
+	m.redirect = nil
+	m.clearedFields[menu.FieldRedirect] = struct{}{}
 }
 
-// SetTokenURL sets the "token_url" field.
-func (m *OauthProviderMutation) SetTokenURL(s string) {
-	m.token_url = &s
+// RedirectCleared returns if the "redirect" field was cleared in this mutation.
+func (m *MenuMutation) RedirectCleared() bool {
+	_, ok := m.clearedFields[menu.FieldRedirect]
+	return ok
 }
 
-// TokenURL returns the value of the "token_url" field in the mutation.
-func (m *OauthProviderMutation) TokenURL() (r string, exists bool) {
-	v := m.token_url
+// ResetRedirect resets all changes to the "redirect" field.
+func (m *MenuMutation) ResetRedirect() {
+	m.redirect = nil
+	delete(m.clearedFields, menu.FieldRedirect)
+}
+
+// SetComponent sets the "component" field.
+func (m *MenuMutation) SetComponent(s string) {
+	m.component = &s
+}
+
+// Component returns the value of the "component" field in the mutation.
+func (m *MenuMutation) Component() (r string, exists bool) {
+	v := m.component
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTokenURL returns the old "token_url" field's value of the OauthProvider entity.
-// If the OauthProvider object wasn't provided to the builder, the object is fetched from the database.
+// OldComponent returns the old "component" field's value of the Menu entity.
+// If the Menu object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OauthProviderMutation) OldTokenURL(ctx context.Context) (v string, err error) {
+func (m *MenuMutation) OldComponent(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTokenURL is only allowed on UpdateOne operations")
+		return v, errors.New("OldComponent is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTokenURL requires an ID field in the mutation")
+		return v, errors.New("OldComponent requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTokenURL: %w", err)
+		return v, fmt.Errorf("querying old value for OldComponent: %w", err)
 	}
-	return oldValue.TokenURL, nil
+	return oldValue.Component, nil
 }
 
-// ClearTokenURL clears the value of the "token_url" field.
-func (m *OauthProviderMutation) ClearTokenURL() {
-	m.token_url = nil
-	m.clearedFields[oauthprovider.FieldTokenURL] = struct{}{}
+// ClearComponent clears the value of the "component" field.
+func (m *MenuMutation) ClearComponent() {
+	m.component = nil
+	m.clearedFields[menu.FieldComponent] = struct{}{}
 }
 
-// TokenURLCleared returns if the "token_url" field was cleared in this mutation.
-func (m *OauthProviderMutation) TokenURLCleared() bool {
-	_, ok := m.clearedFields[oauthprovider.FieldTokenURL]
+// ComponentCleared returns if the "component" field was cleared in this mutation.
+func (m *MenuMutation) ComponentCleared() bool {
+	_, ok := m.clearedFields[menu.FieldComponent]
 	return ok
 }
 
-// ResetTokenURL resets all changes to the "token_url" field.
-func (m *OauthProviderMutation) ResetTokenURL() {
-	m.token_url = nil
-	delete(m.clearedFields, oauthprovider.FieldTokenURL)
+// ResetComponent resets all changes to the "component" field.
+func (m *MenuMutation) ResetComponent() {
+	m.component = nil
+	delete(m.clearedFields, menu.FieldComponent)
 }
 
-// SetAuthStyle sets the "auth_style" field.
-func (m *OauthProviderMutation) SetAuthStyle(u uint64) {
-	m.auth_style = &u
-	m.addauth_style = nil
+// SetOrderNo sets the "order_no" field.
+func (m *MenuMutation) SetOrderNo(u uint32) {
+	m.order_no = &u
+	m.addorder_no = nil
 }
 
-// AuthStyle returns the value of the "auth_style" field in the mutation.
-func (m *OauthProviderMutation) AuthStyle() (r uint64, exists bool) {
-	v := m.auth_style
+// OrderNo returns the value of the "order_no" field in the mutation.
+func (m *MenuMutation) OrderNo() (r uint32, exists bool) {
+	v := m.order_no
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAuthStyle returns the old "auth_style" field's value of the OauthProvider entity.
-// If the OauthProvider object wasn't provided to the builder, the object is fetched from the database.
+// OldOrderNo returns the old "order_no" field's value of the Menu entity.
+// If the Menu object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OauthProviderMutation) OldAuthStyle(ctx context.Context) (v uint64, err error) {
+func (m *MenuMutation) OldOrderNo(ctx context.Context) (v uint32, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAuthStyle is only allowed on UpdateOne operations")
+		return v, errors.New("OldOrderNo is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAuthStyle requires an ID field in the mutation")
+		return v, errors.New("OldOrderNo requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAuthStyle: %w", err)
+		return v, fmt.Errorf("querying old value for OldOrderNo: %w", err)
 	}
-	return oldValue.AuthStyle, nil
+	return oldValue.OrderNo, nil
 }
 
-// AddAuthStyle adds u to the "auth_style" field.
-func (m *OauthProviderMutation) AddAuthStyle(u int64) {
-	if m.addauth_style != nil {
-		*m.addauth_style += u
+// AddOrderNo adds u to the "order_no" field.
+func (m *MenuMutation) AddOrderNo(u int32) {
+	if m.addorder_no != nil {
+		*m.addorder_no += u
 	} else {
-		m.addauth_style = &u
+		m.addorder_no = &u
 	}
 }
 
-// AddedAuthStyle returns the value that was added to the "auth_style" field in this mutation.
-func (m *OauthProviderMutation) AddedAuthStyle() (r int64, exists bool) {
-	v := m.addauth_style
+// AddedOrderNo returns the value that was added to the "order_no" field in this mutation.
+func (m *MenuMutation) AddedOrderNo() (r int32, exists bool) {
+	v := m.addorder_no
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ClearAuthStyle clears the value of the "auth_style" field.
-func (m *OauthProviderMutation) ClearAuthStyle() {
-	m.auth_style = nil
-	m.addauth_style = nil
-	m.clearedFields[oauthprovider.FieldAuthStyle] = struct{}{}
-}
-
-// AuthStyleCleared returns if the "auth_style" field was cleared in this mutation.
-func (m *OauthProviderMutation) AuthStyleCleared() bool {
-	_, ok := m.clearedFields[oauthprovider.FieldAuthStyle]
-	return ok
-}
-
-// ResetAuthStyle resets all changes to the "auth_style" field.
-func (m *OauthProviderMutation) ResetAuthStyle() {
-	m.auth_style = nil
-	m.addauth_style = nil
-	delete(m.clearedFields, oauthprovider.FieldAuthStyle)
+// ResetOrderNo resets all changes to the "order_no" field.
+func (m *MenuMutation) ResetOrderNo() {
+	m.order_no = nil
+	m.addorder_no = nil
 }
 
-// SetInfoURL sets the "info_url" field.
-func (m *OauthProviderMutation) SetInfoURL(s string) {
-	m.info_url = &s
+// SetDisabled sets the "disabled" field.
+func (m *MenuMutation) SetDisabled(b bool) {
+	m.disabled = &b
 }
 
-// InfoURL returns the value of the "info_url" field in the mutation.
-func (m *OauthProviderMutation) InfoURL() (r string, exists bool) {
-	v := m.info_url
+// Disabled returns the value of the "disabled" field in the mutation.
+func (m *MenuMutation) Disabled() (r bool, exists bool) {
+	v := m.disabled
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldInfoURL returns the old "info_url" field's value of the OauthProvider entity.
-// If the OauthProvider object wasn't provided to the builder, the object is fetched from the database.
+// OldDisabled returns the old "disabled" field's value of the Menu entity.
+// If the Menu object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OauthProviderMutation) OldInfoURL(ctx context.Context) (v string, err error) {
+func (m *MenuMutation) OldDisabled(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldInfoURL is only allowed on UpdateOne operations")
+		return v, errors.New("OldDisabled is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldInfoURL requires an ID field in the mutation")
+		return v, errors.New("OldDisabled requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldInfoURL: %w", err)
+		return v, fmt.Errorf("querying old value for OldDisabled: %w", err)
 	}
-	return oldValue.InfoURL, nil
+	return oldValue.Disabled, nil
 }
 
-// ClearInfoURL clears the value of the "info_url" field.
-func (m *OauthProviderMutation) ClearInfoURL() {
-	m.info_url = nil
-	m.clearedFields[oauthprovider.FieldInfoURL] = struct{}{}
+// ClearDisabled clears the value of the "disabled" field.
+func (m *MenuMutation) ClearDisabled() {
+	m.disabled = nil
+	m.clearedFields[menu.FieldDisabled] = struct{}{}
 }
 
-// InfoURLCleared returns if the "info_url" field was cleared in this mutation.
-func (m *OauthProviderMutation) InfoURLCleared() bool {
-	_, ok := m.clearedFields[oauthprovider.FieldInfoURL]
+// DisabledCleared returns if the "disabled" field was cleared in this mutation.
+func (m *MenuMutation) DisabledCleared() bool {
+	_, ok := m.clearedFields[menu.FieldDisabled]
 	return ok
 }
 
-// ResetInfoURL resets all changes to the "info_url" field.
-func (m *OauthProviderMutation) ResetInfoURL() {
-	m.info_url = nil
-	delete(m.clearedFields, oauthprovider.FieldInfoURL)
-}
-
-// Where appends a list predicates to the OauthProviderMutation builder.
-func (m *OauthProviderMutation) Where(ps ...predicate.OauthProvider) {
-	m.predicates = append(m.predicates, ps...)
+// ResetDisabled resets all changes to the "disabled" field.
+func (m *MenuMutation) ResetDisabled() {
+	m.disabled = nil
+	delete(m.clearedFields, menu.FieldDisabled)
 }
 
-// WhereP appends storage-level predicates to the OauthProviderMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *OauthProviderMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.OauthProvider, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// SetTitle sets the "title" field.
+func (m *MenuMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *MenuMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
 	}
-	m.Where(p...)
+	return *v, true
 }
 
-// Op returns the operation name.
-func (m *OauthProviderMutation) Op() Op {
-	return m.op
+// OldTitle returns the old "title" field's value of the Menu entity.
+// If the Menu object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MenuMutation) OldTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
 }
 
-// SetOp allows setting the mutation operation.
-func (m *OauthProviderMutation) SetOp(op Op) {
-	m.op = op
+// ResetTitle resets all changes to the "title" field.
+func (m *MenuMutation) ResetTitle() {
+	m.title = nil
 }
 
-// Type returns the node type of this mutation (OauthProvider).
-func (m *OauthProviderMutation) Type() string {
-	return m.typ
+// SetIcon sets the "icon" field.
+func (m *MenuMutation) SetIcon(s string) {
+	m.icon = &s
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *OauthProviderMutation) Fields() []string {
-	fields := make([]string, 0, 12)
-	if m.created_at != nil {
-		fields = append(fields, oauthprovider.FieldCreatedAt)
+// Icon returns the value of the "icon" field in the mutation.
+func (m *MenuMutation) Icon() (r string, exists bool) {
+	v := m.icon
+	if v == nil {
+		return
 	}
-	if m.updated_at != nil {
-		fields = append(fields, oauthprovider.FieldUpdatedAt)
+	return *v, true
+}
+
+// OldIcon returns the old "icon" field's value of the Menu entity.
+// If the Menu object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MenuMutation) OldIcon(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIcon is only allowed on UpdateOne operations")
 	}
-	if m.name != nil {
-		fields = append(fields, oauthprovider.FieldName)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIcon requires an ID field in the mutation")
 	}
-	if m.app_id != nil {
-		fields = append(fields, oauthprovider.FieldAppID)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIcon: %w", err)
 	}
-	if m.client_id != nil {
-		fields = append(fields, oauthprovider.FieldClientID)
+	return oldValue.Icon, nil
+}
+
+// ResetIcon resets all changes to the "icon" field.
+func (m *MenuMutation) ResetIcon() {
+	m.icon = nil
+}
+
+// SetHideMenu sets the "hide_menu" field.
+func (m *MenuMutation) SetHideMenu(b bool) {
+	m.hide_menu = &b
+}
+
+// HideMenu returns the value of the "hide_menu" field in the mutation.
+func (m *MenuMutation) HideMenu() (r bool, exists bool) {
+	v := m.hide_menu
+	if v == nil {
+		return
 	}
-	if m.client_secret != nil {
-		fields = append(fields, oauthprovider.FieldClientSecret)
+	return *v, true
+}
+
+// OldHideMenu returns the old "hide_menu" field's value of the Menu entity.
+// If the Menu object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MenuMutation) OldHideMenu(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHideMenu is only allowed on UpdateOne operations")
 	}
-	if m.redirect_url != nil {
-		fields = append(fields, oauthprovider.FieldRedirectURL)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHideMenu requires an ID field in the mutation")
 	}
-	if m.scopes != nil {
-		fields = append(fields, oauthprovider.FieldScopes)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHideMenu: %w", err)
 	}
-	if m.auth_url != nil {
-		fields = append(fields, oauthprovider.FieldAuthURL)
+	return oldValue.HideMenu, nil
+}
+
+// ClearHideMenu clears the value of the "hide_menu" field.
+func (m *MenuMutation) ClearHideMenu() {
+	m.hide_menu = nil
+	m.clearedFields[menu.FieldHideMenu] = struct{}{}
+}
+
+// HideMenuCleared returns if the "hide_menu" field was cleared in this mutation.
+func (m *MenuMutation) HideMenuCleared() bool {
+	_, ok := m.clearedFields[menu.FieldHideMenu]
+	return ok
+}
+
+// ResetHideMenu resets all changes to the "hide_menu" field.
+func (m *MenuMutation) ResetHideMenu() {
+	m.hide_menu = nil
+	delete(m.clearedFields, menu.FieldHideMenu)
+}
+
+// SetHideBreadcrumb sets the "hide_breadcrumb" field.
+func (m *MenuMutation) SetHideBreadcrumb(b bool) {
+	m.hide_breadcrumb = &b
+}
+
+// HideBreadcrumb returns the value of the "hide_breadcrumb" field in the mutation.
+func (m *MenuMutation) HideBreadcrumb() (r bool, exists bool) {
+	v := m.hide_breadcrumb
+	if v == nil {
+		return
 	}
-	if m.token_url != nil {
-		fields = append(fields, oauthprovider.FieldTokenURL)
+	return *v, true
+}
+
+// OldHideBreadcrumb returns the old "hide_breadcrumb" field's value of the Menu entity.
+// If the Menu object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MenuMutation) OldHideBreadcrumb(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHideBreadcrumb is only allowed on UpdateOne operations")
 	}
-	if m.auth_style != nil {
-		fields = append(fields, oauthprovider.FieldAuthStyle)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHideBreadcrumb requires an ID field in the mutation")
 	}
-	if m.info_url != nil {
-		fields = append(fields, oauthprovider.FieldInfoURL)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHideBreadcrumb: %w", err)
 	}
-	return fields
+	return oldValue.HideBreadcrumb, nil
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *OauthProviderMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case oauthprovider.FieldCreatedAt:
-		return m.CreatedAt()
-	case oauthprovider.FieldUpdatedAt:
-		return m.UpdatedAt()
-	case oauthprovider.FieldName:
-		return m.Name()
-	case oauthprovider.FieldAppID:
-		return m.AppID()
-	case oauthprovider.FieldClientID:
-		return m.ClientID()
-	case oauthprovider.FieldClientSecret:
-		return m.ClientSecret()
-	case oauthprovider.FieldRedirectURL:
-		return m.RedirectURL()
-	case oauthprovider.FieldScopes:
-		return m.Scopes()
-	case oauthprovider.FieldAuthURL:
-		return m.AuthURL()
-	case oauthprovider.FieldTokenURL:
-		return m.TokenURL()
-	case oauthprovider.FieldAuthStyle:
-		return m.AuthStyle()
-	case oauthprovider.FieldInfoURL:
-		return m.InfoURL()
-	}
-	return nil, false
+// ClearHideBreadcrumb clears the value of the "hide_breadcrumb" field.
+func (m *MenuMutation) ClearHideBreadcrumb() {
+	m.hide_breadcrumb = nil
+	m.clearedFields[menu.FieldHideBreadcrumb] = struct{}{}
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *OauthProviderMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case oauthprovider.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case oauthprovider.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	case oauthprovider.FieldName:
-		return m.OldName(ctx)
-	case oauthprovider.FieldAppID:
-		return m.OldAppID(ctx)
-	case oauthprovider.FieldClientID:
-		return m.OldClientID(ctx)
-	case oauthprovider.FieldClientSecret:
-		return m.OldClientSecret(ctx)
-	case oauthprovider.FieldRedirectURL:
-		return m.OldRedirectURL(ctx)
-	case oauthprovider.FieldScopes:
-		return m.OldScopes(ctx)
-	case oauthprovider.FieldAuthURL:
-		return m.OldAuthURL(ctx)
-	case oauthprovider.FieldTokenURL:
-		return m.OldTokenURL(ctx)
-	case oauthprovider.FieldAuthStyle:
-		return m.OldAuthStyle(ctx)
-	case oauthprovider.FieldInfoURL:
-		return m.OldInfoURL(ctx)
-	}
-	return nil, fmt.Errorf("unknown OauthProvider field %s", name)
+// HideBreadcrumbCleared returns if the "hide_breadcrumb" field was cleared in this mutation.
+func (m *MenuMutation) HideBreadcrumbCleared() bool {
+	_, ok := m.clearedFields[menu.FieldHideBreadcrumb]
+	return ok
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *OauthProviderMutation) SetField(name string, value ent.Value) error {
-	switch name {
+// ResetHideBreadcrumb resets all changes to the "hide_breadcrumb" field.
+func (m *MenuMutation) ResetHideBreadcrumb() {
+	m.hide_breadcrumb = nil
+	delete(m.clearedFields, menu.FieldHideBreadcrumb)
+}
+
+// SetCurrentActiveMenu sets the "current_active_menu" field.
+func (m *MenuMutation) SetCurrentActiveMenu(s string) {
+	m.current_active_menu = &s
+}
+
+// CurrentActiveMenu returns the value of the "current_active_menu" field in the mutation.
+func (m *MenuMutation) CurrentActiveMenu() (r string, exists bool) {
+	v := m.current_active_menu
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCurrentActiveMenu returns the old "current_active_menu" field's value of the Menu entity.
+// If the Menu object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MenuMutation) OldCurrentActiveMenu(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCurrentActiveMenu is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCurrentActiveMenu requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCurrentActiveMenu: %w", err)
+	}
+	return oldValue.CurrentActiveMenu, nil
+}
+
+// ClearCurrentActiveMenu clears the value of the "current_active_menu" field.
+func (m *MenuMutation) ClearCurrentActiveMenu() {
+	m.current_active_menu = nil
+	m.clearedFields[menu.FieldCurrentActiveMenu] = struct{}{}
+}
+
+// CurrentActiveMenuCleared returns if the "current_active_menu" field was cleared in this mutation.
+func (m *MenuMutation) CurrentActiveMenuCleared() bool {
+	_, ok := m.clearedFields[menu.FieldCurrentActiveMenu]
+	return ok
+}
+
+// ResetCurrentActiveMenu resets all changes to the "current_active_menu" field.
+func (m *MenuMutation) ResetCurrentActiveMenu() {
+	m.current_active_menu = nil
+	delete(m.clearedFields, menu.FieldCurrentActiveMenu)
+}
+
+// SetIgnoreKeepAlive sets the "ignore_keep_alive" field.
+func (m *MenuMutation) SetIgnoreKeepAlive(b bool) {
+	m.ignore_keep_alive = &b
+}
+
+// IgnoreKeepAlive returns the value of the "ignore_keep_alive" field in the mutation.
+func (m *MenuMutation) IgnoreKeepAlive() (r bool, exists bool) {
+	v := m.ignore_keep_alive
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIgnoreKeepAlive returns the old "ignore_keep_alive" field's value of the Menu entity.
+// If the Menu object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MenuMutation) OldIgnoreKeepAlive(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIgnoreKeepAlive is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIgnoreKeepAlive requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIgnoreKeepAlive: %w", err)
+	}
+	return oldValue.IgnoreKeepAlive, nil
+}
+
+// ClearIgnoreKeepAlive clears the value of the "ignore_keep_alive" field.
+func (m *MenuMutation) ClearIgnoreKeepAlive() {
+	m.ignore_keep_alive = nil
+	m.clearedFields[menu.FieldIgnoreKeepAlive] = struct{}{}
+}
+
+// IgnoreKeepAliveCleared returns if the "ignore_keep_alive" field was cleared in this mutation.
+func (m *MenuMutation) IgnoreKeepAliveCleared() bool {
+	_, ok := m.clearedFields[menu.FieldIgnoreKeepAlive]
+	return ok
+}
+
+// ResetIgnoreKeepAlive resets all changes to the "ignore_keep_alive" field.
+func (m *MenuMutation) ResetIgnoreKeepAlive() {
+	m.ignore_keep_alive = nil
+	delete(m.clearedFields, menu.FieldIgnoreKeepAlive)
+}
+
+// SetHideTab sets the "hide_tab" field.
+func (m *MenuMutation) SetHideTab(b bool) {
+	m.hide_tab = &b
+}
+
+// HideTab returns the value of the "hide_tab" field in the mutation.
+func (m *MenuMutation) HideTab() (r bool, exists bool) {
+	v := m.hide_tab
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHideTab returns the old "hide_tab" field's value of the Menu entity.
+// If the Menu object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MenuMutation) OldHideTab(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHideTab is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHideTab requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHideTab: %w", err)
+	}
+	return oldValue.HideTab, nil
+}
+
+// ClearHideTab clears the value of the "hide_tab" field.
+func (m *MenuMutation) ClearHideTab() {
+	m.hide_tab = nil
+	m.clearedFields[menu.FieldHideTab] = struct{}{}
+}
+
+// HideTabCleared returns if the "hide_tab" field was cleared in this mutation.
+func (m *MenuMutation) HideTabCleared() bool {
+	_, ok := m.clearedFields[menu.FieldHideTab]
+	return ok
+}
+
+// ResetHideTab resets all changes to the "hide_tab" field.
+func (m *MenuMutation) ResetHideTab() {
+	m.hide_tab = nil
+	delete(m.clearedFields, menu.FieldHideTab)
+}
+
+// SetFrameSrc sets the "frame_src" field.
+func (m *MenuMutation) SetFrameSrc(s string) {
+	m.frame_src = &s
+}
+
+// FrameSrc returns the value of the "frame_src" field in the mutation.
+func (m *MenuMutation) FrameSrc() (r string, exists bool) {
+	v := m.frame_src
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFrameSrc returns the old "frame_src" field's value of the Menu entity.
+// If the Menu object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MenuMutation) OldFrameSrc(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFrameSrc is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFrameSrc requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFrameSrc: %w", err)
+	}
+	return oldValue.FrameSrc, nil
+}
+
+// ClearFrameSrc clears the value of the "frame_src" field.
+func (m *MenuMutation) ClearFrameSrc() {
+	m.frame_src = nil
+	m.clearedFields[menu.FieldFrameSrc] = struct{}{}
+}
+
+// FrameSrcCleared returns if the "frame_src" field was cleared in this mutation.
+func (m *MenuMutation) FrameSrcCleared() bool {
+	_, ok := m.clearedFields[menu.FieldFrameSrc]
+	return ok
+}
+
+// ResetFrameSrc resets all changes to the "frame_src" field.
+func (m *MenuMutation) ResetFrameSrc() {
+	m.frame_src = nil
+	delete(m.clearedFields, menu.FieldFrameSrc)
+}
+
+// SetCarryParam sets the "carry_param" field.
+func (m *MenuMutation) SetCarryParam(b bool) {
+	m.carry_param = &b
+}
+
+// CarryParam returns the value of the "carry_param" field in the mutation.
+func (m *MenuMutation) CarryParam() (r bool, exists bool) {
+	v := m.carry_param
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCarryParam returns the old "carry_param" field's value of the Menu entity.
+// If the Menu object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MenuMutation) OldCarryParam(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCarryParam is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCarryParam requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCarryParam: %w", err)
+	}
+	return oldValue.CarryParam, nil
+}
+
+// ClearCarryParam clears the value of the "carry_param" field.
+func (m *MenuMutation) ClearCarryParam() {
+	m.carry_param = nil
+	m.clearedFields[menu.FieldCarryParam] = struct{}{}
+}
+
+// CarryParamCleared returns if the "carry_param" field was cleared in this mutation.
+func (m *MenuMutation) CarryParamCleared() bool {
+	_, ok := m.clearedFields[menu.FieldCarryParam]
+	return ok
+}
+
+// ResetCarryParam resets all changes to the "carry_param" field.
+func (m *MenuMutation) ResetCarryParam() {
+	m.carry_param = nil
+	delete(m.clearedFields, menu.FieldCarryParam)
+}
+
+// SetHideChildrenInMenu sets the "hide_children_in_menu" field.
+func (m *MenuMutation) SetHideChildrenInMenu(b bool) {
+	m.hide_children_in_menu = &b
+}
+
+// HideChildrenInMenu returns the value of the "hide_children_in_menu" field in the mutation.
+func (m *MenuMutation) HideChildrenInMenu() (r bool, exists bool) {
+	v := m.hide_children_in_menu
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHideChildrenInMenu returns the old "hide_children_in_menu" field's value of the Menu entity.
+// If the Menu object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MenuMutation) OldHideChildrenInMenu(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHideChildrenInMenu is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHideChildrenInMenu requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHideChildrenInMenu: %w", err)
+	}
+	return oldValue.HideChildrenInMenu, nil
+}
+
+// ClearHideChildrenInMenu clears the value of the "hide_children_in_menu" field.
+func (m *MenuMutation) ClearHideChildrenInMenu() {
+	m.hide_children_in_menu = nil
+	m.clearedFields[menu.FieldHideChildrenInMenu] = struct{}{}
+}
+
+// HideChildrenInMenuCleared returns if the "hide_children_in_menu" field was cleared in this mutation.
+func (m *MenuMutation) HideChildrenInMenuCleared() bool {
+	_, ok := m.clearedFields[menu.FieldHideChildrenInMenu]
+	return ok
+}
+
+// ResetHideChildrenInMenu resets all changes to the "hide_children_in_menu" field.
+func (m *MenuMutation) ResetHideChildrenInMenu() {
+	m.hide_children_in_menu = nil
+	delete(m.clearedFields, menu.FieldHideChildrenInMenu)
+}
+
+// SetAffix sets the "affix" field.
+func (m *MenuMutation) SetAffix(b bool) {
+	m.affix = &b
+}
+
+// Affix returns the value of the "affix" field in the mutation.
+func (m *MenuMutation) Affix() (r bool, exists bool) {
+	v := m.affix
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAffix returns the old "affix" field's value of the Menu entity.
+// If the Menu object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MenuMutation) OldAffix(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAffix is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAffix requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAffix: %w", err)
+	}
+	return oldValue.Affix, nil
+}
+
+// ClearAffix clears the value of the "affix" field.
+func (m *MenuMutation) ClearAffix() {
+	m.affix = nil
+	m.clearedFields[menu.FieldAffix] = struct{}{}
+}
+
+// AffixCleared returns if the "affix" field was cleared in this mutation.
+func (m *MenuMutation) AffixCleared() bool {
+	_, ok := m.clearedFields[menu.FieldAffix]
+	return ok
+}
+
+// ResetAffix resets all changes to the "affix" field.
+func (m *MenuMutation) ResetAffix() {
+	m.affix = nil
+	delete(m.clearedFields, menu.FieldAffix)
+}
+
+// SetDynamicLevel sets the "dynamic_level" field.
+func (m *MenuMutation) SetDynamicLevel(u uint32) {
+	m.dynamic_level = &u
+	m.adddynamic_level = nil
+}
+
+// DynamicLevel returns the value of the "dynamic_level" field in the mutation.
+func (m *MenuMutation) DynamicLevel() (r uint32, exists bool) {
+	v := m.dynamic_level
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDynamicLevel returns the old "dynamic_level" field's value of the Menu entity.
+// If the Menu object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MenuMutation) OldDynamicLevel(ctx context.Context) (v uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDynamicLevel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDynamicLevel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDynamicLevel: %w", err)
+	}
+	return oldValue.DynamicLevel, nil
+}
+
+// AddDynamicLevel adds u to the "dynamic_level" field.
+func (m *MenuMutation) AddDynamicLevel(u int32) {
+	if m.adddynamic_level != nil {
+		*m.adddynamic_level += u
+	} else {
+		m.adddynamic_level = &u
+	}
+}
+
+// AddedDynamicLevel returns the value that was added to the "dynamic_level" field in this mutation.
+func (m *MenuMutation) AddedDynamicLevel() (r int32, exists bool) {
+	v := m.adddynamic_level
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearDynamicLevel clears the value of the "dynamic_level" field.
+func (m *MenuMutation) ClearDynamicLevel() {
+	m.dynamic_level = nil
+	m.adddynamic_level = nil
+	m.clearedFields[menu.FieldDynamicLevel] = struct{}{}
+}
+
+// DynamicLevelCleared returns if the "dynamic_level" field was cleared in this mutation.
+func (m *MenuMutation) DynamicLevelCleared() bool {
+	_, ok := m.clearedFields[menu.FieldDynamicLevel]
+	return ok
+}
+
+// ResetDynamicLevel resets all changes to the "dynamic_level" field.
+func (m *MenuMutation) ResetDynamicLevel() {
+	m.dynamic_level = nil
+	m.adddynamic_level = nil
+	delete(m.clearedFields, menu.FieldDynamicLevel)
+}
+
+// SetRealPath sets the "real_path" field.
+func (m *MenuMutation) SetRealPath(s string) {
+	m.real_path = &s
+}
+
+// RealPath returns the value of the "real_path" field in the mutation.
+func (m *MenuMutation) RealPath() (r string, exists bool) {
+	v := m.real_path
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRealPath returns the old "real_path" field's value of the Menu entity.
+// If the Menu object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MenuMutation) OldRealPath(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRealPath is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRealPath requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRealPath: %w", err)
+	}
+	return oldValue.RealPath, nil
+}
+
+// ClearRealPath clears the value of the "real_path" field.
+func (m *MenuMutation) ClearRealPath() {
+	m.real_path = nil
+	m.clearedFields[menu.FieldRealPath] = struct{}{}
+}
+
+// RealPathCleared returns if the "real_path" field was cleared in this mutation.
+func (m *MenuMutation) RealPathCleared() bool {
+	_, ok := m.clearedFields[menu.FieldRealPath]
+	return ok
+}
+
+// ResetRealPath resets all changes to the "real_path" field.
+func (m *MenuMutation) ResetRealPath() {
+	m.real_path = nil
+	delete(m.clearedFields, menu.FieldRealPath)
+}
+
+// AddRoleIDs adds the "roles" edge to the Role entity by ids.
+func (m *MenuMutation) AddRoleIDs(ids ...uint64) {
+	if m.roles == nil {
+		m.roles = make(map[uint64]struct{})
+	}
+	for i := range ids {
+		m.roles[ids[i]] = struct{}{}
+	}
+}
+
+// ClearRoles clears the "roles" edge to the Role entity.
+func (m *MenuMutation) ClearRoles() {
+	m.clearedroles = true
+}
+
+// RolesCleared reports if the "roles" edge to the Role entity was cleared.
+func (m *MenuMutation) RolesCleared() bool {
+	return m.clearedroles
+}
+
+// RemoveRoleIDs removes the "roles" edge to the Role entity by IDs.
+func (m *MenuMutation) RemoveRoleIDs(ids ...uint64) {
+	if m.removedroles == nil {
+		m.removedroles = make(map[uint64]struct{})
+	}
+	for i := range ids {
+		delete(m.roles, ids[i])
+		m.removedroles[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedRoles returns the removed IDs of the "roles" edge to the Role entity.
+func (m *MenuMutation) RemovedRolesIDs() (ids []uint64) {
+	for id := range m.removedroles {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RolesIDs returns the "roles" edge IDs in the mutation.
+func (m *MenuMutation) RolesIDs() (ids []uint64) {
+	for id := range m.roles {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetRoles resets all changes to the "roles" edge.
+func (m *MenuMutation) ResetRoles() {
+	m.roles = nil
+	m.clearedroles = false
+	m.removedroles = nil
+}
+
+// ClearParent clears the "parent" edge to the Menu entity.
+func (m *MenuMutation) ClearParent() {
+	m.clearedparent = true
+	m.clearedFields[menu.FieldParentID] = struct{}{}
+}
+
+// ParentCleared reports if the "parent" edge to the Menu entity was cleared.
+func (m *MenuMutation) ParentCleared() bool {
+	return m.ParentIDCleared() || m.clearedparent
+}
+
+// ParentIDs returns the "parent" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ParentID instead. It exists only for internal usage by the builders.
+func (m *MenuMutation) ParentIDs() (ids []uint64) {
+	if id := m.parent; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetParent resets all changes to the "parent" edge.
+func (m *MenuMutation) ResetParent() {
+	m.parent = nil
+	m.clearedparent = false
+}
+
+// AddChildIDs adds the "children" edge to the Menu entity by ids.
+func (m *MenuMutation) AddChildIDs(ids ...uint64) {
+	if m.children == nil {
+		m.children = make(map[uint64]struct{})
+	}
+	for i := range ids {
+		m.children[ids[i]] = struct{}{}
+	}
+}
+
+// ClearChildren clears the "children" edge to the Menu entity.
+func (m *MenuMutation) ClearChildren() {
+	m.clearedchildren = true
+}
+
+// ChildrenCleared reports if the "children" edge to the Menu entity was cleared.
+func (m *MenuMutation) ChildrenCleared() bool {
+	return m.clearedchildren
+}
+
+// RemoveChildIDs removes the "children" edge to the Menu entity by IDs.
+func (m *MenuMutation) RemoveChildIDs(ids ...uint64) {
+	if m.removedchildren == nil {
+		m.removedchildren = make(map[uint64]struct{})
+	}
+	for i := range ids {
+		delete(m.children, ids[i])
+		m.removedchildren[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedChildren returns the removed IDs of the "children" edge to the Menu entity.
+func (m *MenuMutation) RemovedChildrenIDs() (ids []uint64) {
+	for id := range m.removedchildren {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ChildrenIDs returns the "children" edge IDs in the mutation.
+func (m *MenuMutation) ChildrenIDs() (ids []uint64) {
+	for id := range m.children {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetChildren resets all changes to the "children" edge.
+func (m *MenuMutation) ResetChildren() {
+	m.children = nil
+	m.clearedchildren = false
+	m.removedchildren = nil
+}
+
+// Where appends a list predicates to the MenuMutation builder.
+func (m *MenuMutation) Where(ps ...predicate.Menu) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MenuMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MenuMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Menu, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MenuMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MenuMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Menu).
+func (m *MenuMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MenuMutation) Fields() []string {
+	fields := make([]string, 0, 25)
+	if m.created_at != nil {
+		fields = append(fields, menu.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, menu.FieldUpdatedAt)
+	}
+	if m.tenant_id != nil {
+		fields = append(fields, menu.FieldTenantID)
+	}
+	if m.parent != nil {
+		fields = append(fields, menu.FieldParentID)
+	}
+	if m.menu_level != nil {
+		fields = append(fields, menu.FieldMenuLevel)
+	}
+	if m.menu_type != nil {
+		fields = append(fields, menu.FieldMenuType)
+	}
+	if m._path != nil {
+		fields = append(fields, menu.FieldPath)
+	}
+	if m.name != nil {
+		fields = append(fields, menu.FieldName)
+	}
+	if m.redirect != nil {
+		fields = append(fields, menu.FieldRedirect)
+	}
+	if m.component != nil {
+		fields = append(fields, menu.FieldComponent)
+	}
+	if m.order_no != nil {
+		fields = append(fields, menu.FieldOrderNo)
+	}
+	if m.disabled != nil {
+		fields = append(fields, menu.FieldDisabled)
+	}
+	if m.title != nil {
+		fields = append(fields, menu.FieldTitle)
+	}
+	if m.icon != nil {
+		fields = append(fields, menu.FieldIcon)
+	}
+	if m.hide_menu != nil {
+		fields = append(fields, menu.FieldHideMenu)
+	}
+	if m.hide_breadcrumb != nil {
+		fields = append(fields, menu.FieldHideBreadcrumb)
+	}
+	if m.current_active_menu != nil {
+		fields = append(fields, menu.FieldCurrentActiveMenu)
+	}
+	if m.ignore_keep_alive != nil {
+		fields = append(fields, menu.FieldIgnoreKeepAlive)
+	}
+	if m.hide_tab != nil {
+		fields = append(fields, menu.FieldHideTab)
+	}
+	if m.frame_src != nil {
+		fields = append(fields, menu.FieldFrameSrc)
+	}
+	if m.carry_param != nil {
+		fields = append(fields, menu.FieldCarryParam)
+	}
+	if m.hide_children_in_menu != nil {
+		fields = append(fields, menu.FieldHideChildrenInMenu)
+	}
+	if m.affix != nil {
+		fields = append(fields, menu.FieldAffix)
+	}
+	if m.dynamic_level != nil {
+		fields = append(fields, menu.FieldDynamicLevel)
+	}
+	if m.real_path != nil {
+		fields = append(fields, menu.FieldRealPath)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MenuMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case menu.FieldCreatedAt:
+		return m.CreatedAt()
+	case menu.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case menu.FieldTenantID:
+		return m.TenantID()
+	case menu.FieldParentID:
+		return m.ParentID()
+	case menu.FieldMenuLevel:
+		return m.MenuLevel()
+	case menu.FieldMenuType:
+		return m.MenuType()
+	case menu.FieldPath:
+		return m.Path()
+	case menu.FieldName:
+		return m.Name()
+	case menu.FieldRedirect:
+		return m.Redirect()
+	case menu.FieldComponent:
+		return m.Component()
+	case menu.FieldOrderNo:
+		return m.OrderNo()
+	case menu.FieldDisabled:
+		return m.Disabled()
+	case menu.FieldTitle:
+		return m.Title()
+	case menu.FieldIcon:
+		return m.Icon()
+	case menu.FieldHideMenu:
+		return m.HideMenu()
+	case menu.FieldHideBreadcrumb:
+		return m.HideBreadcrumb()
+	case menu.FieldCurrentActiveMenu:
+		return m.CurrentActiveMenu()
+	case menu.FieldIgnoreKeepAlive:
+		return m.IgnoreKeepAlive()
+	case menu.FieldHideTab:
+		return m.HideTab()
+	case menu.FieldFrameSrc:
+		return m.FrameSrc()
+	case menu.FieldCarryParam:
+		return m.CarryParam()
+	case menu.FieldHideChildrenInMenu:
+		return m.HideChildrenInMenu()
+	case menu.FieldAffix:
+		return m.Affix()
+	case menu.FieldDynamicLevel:
+		return m.DynamicLevel()
+	case menu.FieldRealPath:
+		return m.RealPath()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MenuMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case menu.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case menu.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case menu.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case menu.FieldParentID:
+		return m.OldParentID(ctx)
+	case menu.FieldMenuLevel:
+		return m.OldMenuLevel(ctx)
+	case menu.FieldMenuType:
+		return m.OldMenuType(ctx)
+	case menu.FieldPath:
+		return m.OldPath(ctx)
+	case menu.FieldName:
+		return m.OldName(ctx)
+	case menu.FieldRedirect:
+		return m.OldRedirect(ctx)
+	case menu.FieldComponent:
+		return m.OldComponent(ctx)
+	case menu.FieldOrderNo:
+		return m.OldOrderNo(ctx)
+	case menu.FieldDisabled:
+		return m.OldDisabled(ctx)
+	case menu.FieldTitle:
+		return m.OldTitle(ctx)
+	case menu.FieldIcon:
+		return m.OldIcon(ctx)
+	case menu.FieldHideMenu:
+		return m.OldHideMenu(ctx)
+	case menu.FieldHideBreadcrumb:
+		return m.OldHideBreadcrumb(ctx)
+	case menu.FieldCurrentActiveMenu:
+		return m.OldCurrentActiveMenu(ctx)
+	case menu.FieldIgnoreKeepAlive:
+		return m.OldIgnoreKeepAlive(ctx)
+	case menu.FieldHideTab:
+		return m.OldHideTab(ctx)
+	case menu.FieldFrameSrc:
+		return m.OldFrameSrc(ctx)
+	case menu.FieldCarryParam:
+		return m.OldCarryParam(ctx)
+	case menu.FieldHideChildrenInMenu:
+		return m.OldHideChildrenInMenu(ctx)
+	case menu.FieldAffix:
+		return m.OldAffix(ctx)
+	case menu.FieldDynamicLevel:
+		return m.OldDynamicLevel(ctx)
+	case menu.FieldRealPath:
+		return m.OldRealPath(ctx)
+	}
+	return nil, fmt.Errorf("unknown Menu field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MenuMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case menu.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case menu.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case menu.FieldTenantID:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case menu.FieldParentID:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetParentID(v)
+		return nil
+	case menu.FieldMenuLevel:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMenuLevel(v)
+		return nil
+	case menu.FieldMenuType:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMenuType(v)
+		return nil
+	case menu.FieldPath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPath(v)
+		return nil
+	case menu.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case menu.FieldRedirect:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRedirect(v)
+		return nil
+	case menu.FieldComponent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetComponent(v)
+		return nil
+	case menu.FieldOrderNo:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOrderNo(v)
+		return nil
+	case menu.FieldDisabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDisabled(v)
+		return nil
+	case menu.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case menu.FieldIcon:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIcon(v)
+		return nil
+	case menu.FieldHideMenu:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHideMenu(v)
+		return nil
+	case menu.FieldHideBreadcrumb:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHideBreadcrumb(v)
+		return nil
+	case menu.FieldCurrentActiveMenu:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCurrentActiveMenu(v)
+		return nil
+	case menu.FieldIgnoreKeepAlive:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIgnoreKeepAlive(v)
+		return nil
+	case menu.FieldHideTab:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHideTab(v)
+		return nil
+	case menu.FieldFrameSrc:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFrameSrc(v)
+		return nil
+	case menu.FieldCarryParam:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCarryParam(v)
+		return nil
+	case menu.FieldHideChildrenInMenu:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHideChildrenInMenu(v)
+		return nil
+	case menu.FieldAffix:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAffix(v)
+		return nil
+	case menu.FieldDynamicLevel:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDynamicLevel(v)
+		return nil
+	case menu.FieldRealPath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRealPath(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Menu field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MenuMutation) AddedFields() []string {
+	var fields []string
+	if m.addtenant_id != nil {
+		fields = append(fields, menu.FieldTenantID)
+	}
+	if m.addmenu_level != nil {
+		fields = append(fields, menu.FieldMenuLevel)
+	}
+	if m.addmenu_type != nil {
+		fields = append(fields, menu.FieldMenuType)
+	}
+	if m.addorder_no != nil {
+		fields = append(fields, menu.FieldOrderNo)
+	}
+	if m.adddynamic_level != nil {
+		fields = append(fields, menu.FieldDynamicLevel)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MenuMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case menu.FieldTenantID:
+		return m.AddedTenantID()
+	case menu.FieldMenuLevel:
+		return m.AddedMenuLevel()
+	case menu.FieldMenuType:
+		return m.AddedMenuType()
+	case menu.FieldOrderNo:
+		return m.AddedOrderNo()
+	case menu.FieldDynamicLevel:
+		return m.AddedDynamicLevel()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MenuMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case menu.FieldTenantID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTenantID(v)
+		return nil
+	case menu.FieldMenuLevel:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMenuLevel(v)
+		return nil
+	case menu.FieldMenuType:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMenuType(v)
+		return nil
+	case menu.FieldOrderNo:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddOrderNo(v)
+		return nil
+	case menu.FieldDynamicLevel:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDynamicLevel(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Menu numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MenuMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(menu.FieldParentID) {
+		fields = append(fields, menu.FieldParentID)
+	}
+	if m.FieldCleared(menu.FieldPath) {
+		fields = append(fields, menu.FieldPath)
+	}
+	if m.FieldCleared(menu.FieldRedirect) {
+		fields = append(fields, menu.FieldRedirect)
+	}
+	if m.FieldCleared(menu.FieldComponent) {
+		fields = append(fields, menu.FieldComponent)
+	}
+	if m.FieldCleared(menu.FieldDisabled) {
+		fields = append(fields, menu.FieldDisabled)
+	}
+	if m.FieldCleared(menu.FieldHideMenu) {
+		fields = append(fields, menu.FieldHideMenu)
+	}
+	if m.FieldCleared(menu.FieldHideBreadcrumb) {
+		fields = append(fields, menu.FieldHideBreadcrumb)
+	}
+	if m.FieldCleared(menu.FieldCurrentActiveMenu) {
+		fields = append(fields, menu.FieldCurrentActiveMenu)
+	}
+	if m.FieldCleared(menu.FieldIgnoreKeepAlive) {
+		fields = append(fields, menu.FieldIgnoreKeepAlive)
+	}
+	if m.FieldCleared(menu.FieldHideTab) {
+		fields = append(fields, menu.FieldHideTab)
+	}
+	if m.FieldCleared(menu.FieldFrameSrc) {
+		fields = append(fields, menu.FieldFrameSrc)
+	}
+	if m.FieldCleared(menu.FieldCarryParam) {
+		fields = append(fields, menu.FieldCarryParam)
+	}
+	if m.FieldCleared(menu.FieldHideChildrenInMenu) {
+		fields = append(fields, menu.FieldHideChildrenInMenu)
+	}
+	if m.FieldCleared(menu.FieldAffix) {
+		fields = append(fields, menu.FieldAffix)
+	}
+	if m.FieldCleared(menu.FieldDynamicLevel) {
+		fields = append(fields, menu.FieldDynamicLevel)
+	}
+	if m.FieldCleared(menu.FieldRealPath) {
+		fields = append(fields, menu.FieldRealPath)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MenuMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MenuMutation) ClearField(name string) error {
+	switch name {
+	case menu.FieldParentID:
+		m.ClearParentID()
+		return nil
+	case menu.FieldPath:
+		m.ClearPath()
+		return nil
+	case menu.FieldRedirect:
+		m.ClearRedirect()
+		return nil
+	case menu.FieldComponent:
+		m.ClearComponent()
+		return nil
+	case menu.FieldDisabled:
+		m.ClearDisabled()
+		return nil
+	case menu.FieldHideMenu:
+		m.ClearHideMenu()
+		return nil
+	case menu.FieldHideBreadcrumb:
+		m.ClearHideBreadcrumb()
+		return nil
+	case menu.FieldCurrentActiveMenu:
+		m.ClearCurrentActiveMenu()
+		return nil
+	case menu.FieldIgnoreKeepAlive:
+		m.ClearIgnoreKeepAlive()
+		return nil
+	case menu.FieldHideTab:
+		m.ClearHideTab()
+		return nil
+	case menu.FieldFrameSrc:
+		m.ClearFrameSrc()
+		return nil
+	case menu.FieldCarryParam:
+		m.ClearCarryParam()
+		return nil
+	case menu.FieldHideChildrenInMenu:
+		m.ClearHideChildrenInMenu()
+		return nil
+	case menu.FieldAffix:
+		m.ClearAffix()
+		return nil
+	case menu.FieldDynamicLevel:
+		m.ClearDynamicLevel()
+		return nil
+	case menu.FieldRealPath:
+		m.ClearRealPath()
+		return nil
+	}
+	return fmt.Errorf("unknown Menu nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MenuMutation) ResetField(name string) error {
+	switch name {
+	case menu.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case menu.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case menu.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case menu.FieldParentID:
+		m.ResetParentID()
+		return nil
+	case menu.FieldMenuLevel:
+		m.ResetMenuLevel()
+		return nil
+	case menu.FieldMenuType:
+		m.ResetMenuType()
+		return nil
+	case menu.FieldPath:
+		m.ResetPath()
+		return nil
+	case menu.FieldName:
+		m.ResetName()
+		return nil
+	case menu.FieldRedirect:
+		m.ResetRedirect()
+		return nil
+	case menu.FieldComponent:
+		m.ResetComponent()
+		return nil
+	case menu.FieldOrderNo:
+		m.ResetOrderNo()
+		return nil
+	case menu.FieldDisabled:
+		m.ResetDisabled()
+		return nil
+	case menu.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case menu.FieldIcon:
+		m.ResetIcon()
+		return nil
+	case menu.FieldHideMenu:
+		m.ResetHideMenu()
+		return nil
+	case menu.FieldHideBreadcrumb:
+		m.ResetHideBreadcrumb()
+		return nil
+	case menu.FieldCurrentActiveMenu:
+		m.ResetCurrentActiveMenu()
+		return nil
+	case menu.FieldIgnoreKeepAlive:
+		m.ResetIgnoreKeepAlive()
+		return nil
+	case menu.FieldHideTab:
+		m.ResetHideTab()
+		return nil
+	case menu.FieldFrameSrc:
+		m.ResetFrameSrc()
+		return nil
+	case menu.FieldCarryParam:
+		m.ResetCarryParam()
+		return nil
+	case menu.FieldHideChildrenInMenu:
+		m.ResetHideChildrenInMenu()
+		return nil
+	case menu.FieldAffix:
+		m.ResetAffix()
+		return nil
+	case menu.FieldDynamicLevel:
+		m.ResetDynamicLevel()
+		return nil
+	case menu.FieldRealPath:
+		m.ResetRealPath()
+		return nil
+	}
+	return fmt.Errorf("unknown Menu field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MenuMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.roles != nil {
+		edges = append(edges, menu.EdgeRoles)
+	}
+	if m.parent != nil {
+		edges = append(edges, menu.EdgeParent)
+	}
+	if m.children != nil {
+		edges = append(edges, menu.EdgeChildren)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MenuMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case menu.EdgeRoles:
+		ids := make([]ent.Value, 0, len(m.roles))
+		for id := range m.roles {
+			ids = append(ids, id)
+		}
+		return ids
+	case menu.EdgeParent:
+		if id := m.parent; id != nil {
+			return []ent.Value{*id}
+		}
+	case menu.EdgeChildren:
+		ids := make([]ent.Value, 0, len(m.children))
+		for id := range m.children {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MenuMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removedroles != nil {
+		edges = append(edges, menu.EdgeRoles)
+	}
+	if m.removedchildren != nil {
+		edges = append(edges, menu.EdgeChildren)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MenuMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case menu.EdgeRoles:
+		ids := make([]ent.Value, 0, len(m.removedroles))
+		for id := range m.removedroles {
+			ids = append(ids, id)
+		}
+		return ids
+	case menu.EdgeChildren:
+		ids := make([]ent.Value, 0, len(m.removedchildren))
+		for id := range m.removedchildren {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MenuMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedroles {
+		edges = append(edges, menu.EdgeRoles)
+	}
+	if m.clearedparent {
+		edges = append(edges, menu.EdgeParent)
+	}
+	if m.clearedchildren {
+		edges = append(edges, menu.EdgeChildren)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MenuMutation) EdgeCleared(name string) bool {
+	switch name {
+	case menu.EdgeRoles:
+		return m.clearedroles
+	case menu.EdgeParent:
+		return m.clearedparent
+	case menu.EdgeChildren:
+		return m.clearedchildren
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MenuMutation) ClearEdge(name string) error {
+	switch name {
+	case menu.EdgeParent:
+		m.ClearParent()
+		return nil
+	}
+	return fmt.Errorf("unknown Menu unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MenuMutation) ResetEdge(name string) error {
+	switch name {
+	case menu.EdgeRoles:
+		m.ResetRoles()
+		return nil
+	case menu.EdgeParent:
+		m.ResetParent()
+		return nil
+	case menu.EdgeChildren:
+		m.ResetChildren()
+		return nil
+	}
+	return fmt.Errorf("unknown Menu edge %s", name)
+}
+
+// OauthProviderMutation represents an operation that mutates the OauthProvider nodes in the graph.
+type OauthProviderMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *uint64
+	created_at         *time.Time
+	updated_at         *time.Time
+	name               *string
+	app_id             *string
+	client_id          *string
+	client_secret      *string
+	redirect_url       *string
+	scopes             *string
+	auth_url           *string
+	token_url          *string
+	auth_style         *uint64
+	addauth_style      *int64
+	info_url           *string
+	discovery_url      *string
+	claim_mapping      *map[string]string
+	auto_provision     *bool
+	provision_approval *bool
+	default_role_id    *uint64
+	adddefault_role_id *int64
+	role_rules         *[]rolerule.Rule
+	appendrole_rules   []rolerule.Rule
+	clearedFields      map[string]struct{}
+	done               bool
+	oldValue           func(context.Context) (*OauthProvider, error)
+	predicates         []predicate.OauthProvider
+}
+
+var _ ent.Mutation = (*OauthProviderMutation)(nil)
+
+// oauthproviderOption allows management of the mutation configuration using functional options.
+type oauthproviderOption func(*OauthProviderMutation)
+
+// newOauthProviderMutation creates new mutation for the OauthProvider entity.
+func newOauthProviderMutation(c config, op Op, opts ...oauthproviderOption) *OauthProviderMutation {
+	m := &OauthProviderMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeOauthProvider,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withOauthProviderID sets the ID field of the mutation.
+func withOauthProviderID(id uint64) oauthproviderOption {
+	return func(m *OauthProviderMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *OauthProvider
+		)
+		m.oldValue = func(ctx context.Context) (*OauthProvider, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().OauthProvider.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withOauthProvider sets the old OauthProvider of the mutation.
+func withOauthProvider(node *OauthProvider) oauthproviderOption {
+	return func(m *OauthProviderMutation) {
+		m.oldValue = func(context.Context) (*OauthProvider, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m OauthProviderMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m OauthProviderMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of OauthProvider entities.
+func (m *OauthProviderMutation) SetID(id uint64) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *OauthProviderMutation) ID() (id uint64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *OauthProviderMutation) IDs(ctx context.Context) ([]uint64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().OauthProvider.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *OauthProviderMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *OauthProviderMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the OauthProvider entity.
+// If the OauthProvider object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OauthProviderMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *OauthProviderMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *OauthProviderMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *OauthProviderMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the OauthProvider entity.
+// If the OauthProvider object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OauthProviderMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *OauthProviderMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetName sets the "name" field.
+func (m *OauthProviderMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *OauthProviderMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the OauthProvider entity.
+// If the OauthProvider object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OauthProviderMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *OauthProviderMutation) ResetName() {
+	m.name = nil
+}
+
+// SetAppID sets the "app_id" field.
+func (m *OauthProviderMutation) SetAppID(s string) {
+	m.app_id = &s
+}
+
+// AppID returns the value of the "app_id" field in the mutation.
+func (m *OauthProviderMutation) AppID() (r string, exists bool) {
+	v := m.app_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAppID returns the old "app_id" field's value of the OauthProvider entity.
+// If the OauthProvider object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OauthProviderMutation) OldAppID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAppID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAppID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAppID: %w", err)
+	}
+	return oldValue.AppID, nil
+}
+
+// ClearAppID clears the value of the "app_id" field.
+func (m *OauthProviderMutation) ClearAppID() {
+	m.app_id = nil
+	m.clearedFields[oauthprovider.FieldAppID] = struct{}{}
+}
+
+// AppIDCleared returns if the "app_id" field was cleared in this mutation.
+func (m *OauthProviderMutation) AppIDCleared() bool {
+	_, ok := m.clearedFields[oauthprovider.FieldAppID]
+	return ok
+}
+
+// ResetAppID resets all changes to the "app_id" field.
+func (m *OauthProviderMutation) ResetAppID() {
+	m.app_id = nil
+	delete(m.clearedFields, oauthprovider.FieldAppID)
+}
+
+// SetClientID sets the "client_id" field.
+func (m *OauthProviderMutation) SetClientID(s string) {
+	m.client_id = &s
+}
+
+// ClientID returns the value of the "client_id" field in the mutation.
+func (m *OauthProviderMutation) ClientID() (r string, exists bool) {
+	v := m.client_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClientID returns the old "client_id" field's value of the OauthProvider entity.
+// If the OauthProvider object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OauthProviderMutation) OldClientID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClientID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClientID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClientID: %w", err)
+	}
+	return oldValue.ClientID, nil
+}
+
+// ResetClientID resets all changes to the "client_id" field.
+func (m *OauthProviderMutation) ResetClientID() {
+	m.client_id = nil
+}
+
+// SetClientSecret sets the "client_secret" field.
+func (m *OauthProviderMutation) SetClientSecret(s string) {
+	m.client_secret = &s
+}
+
+// ClientSecret returns the value of the "client_secret" field in the mutation.
+func (m *OauthProviderMutation) ClientSecret() (r string, exists bool) {
+	v := m.client_secret
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClientSecret returns the old "client_secret" field's value of the OauthProvider entity.
+// If the OauthProvider object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OauthProviderMutation) OldClientSecret(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClientSecret is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClientSecret requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClientSecret: %w", err)
+	}
+	return oldValue.ClientSecret, nil
+}
+
+// ClearClientSecret clears the value of the "client_secret" field.
+func (m *OauthProviderMutation) ClearClientSecret() {
+	m.client_secret = nil
+	m.clearedFields[oauthprovider.FieldClientSecret] = struct{}{}
+}
+
+// ClientSecretCleared returns if the "client_secret" field was cleared in this mutation.
+func (m *OauthProviderMutation) ClientSecretCleared() bool {
+	_, ok := m.clearedFields[oauthprovider.FieldClientSecret]
+	return ok
+}
+
+// ResetClientSecret resets all changes to the "client_secret" field.
+func (m *OauthProviderMutation) ResetClientSecret() {
+	m.client_secret = nil
+	delete(m.clearedFields, oauthprovider.FieldClientSecret)
+}
+
+// SetRedirectURL sets the "redirect_url" field.
+func (m *OauthProviderMutation) SetRedirectURL(s string) {
+	m.redirect_url = &s
+}
+
+// RedirectURL returns the value of the "redirect_url" field in the mutation.
+func (m *OauthProviderMutation) RedirectURL() (r string, exists bool) {
+	v := m.redirect_url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRedirectURL returns the old "redirect_url" field's value of the OauthProvider entity.
+// If the OauthProvider object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OauthProviderMutation) OldRedirectURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRedirectURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRedirectURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRedirectURL: %w", err)
+	}
+	return oldValue.RedirectURL, nil
+}
+
+// ResetRedirectURL resets all changes to the "redirect_url" field.
+func (m *OauthProviderMutation) ResetRedirectURL() {
+	m.redirect_url = nil
+}
+
+// SetScopes sets the "scopes" field.
+func (m *OauthProviderMutation) SetScopes(s string) {
+	m.scopes = &s
+}
+
+// Scopes returns the value of the "scopes" field in the mutation.
+func (m *OauthProviderMutation) Scopes() (r string, exists bool) {
+	v := m.scopes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScopes returns the old "scopes" field's value of the OauthProvider entity.
+// If the OauthProvider object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OauthProviderMutation) OldScopes(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScopes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScopes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScopes: %w", err)
+	}
+	return oldValue.Scopes, nil
+}
+
+// ClearScopes clears the value of the "scopes" field.
+func (m *OauthProviderMutation) ClearScopes() {
+	m.scopes = nil
+	m.clearedFields[oauthprovider.FieldScopes] = struct{}{}
+}
+
+// ScopesCleared returns if the "scopes" field was cleared in this mutation.
+func (m *OauthProviderMutation) ScopesCleared() bool {
+	_, ok := m.clearedFields[oauthprovider.FieldScopes]
+	return ok
+}
+
+// ResetScopes resets all changes to the "scopes" field.
+func (m *OauthProviderMutation) ResetScopes() {
+	m.scopes = nil
+	delete(m.clearedFields, oauthprovider.FieldScopes)
+}
+
+// SetAuthURL sets the "auth_url" field.
+func (m *OauthProviderMutation) SetAuthURL(s string) {
+	m.auth_url = &s
+}
+
+// AuthURL returns the value of the "auth_url" field in the mutation.
+func (m *OauthProviderMutation) AuthURL() (r string, exists bool) {
+	v := m.auth_url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAuthURL returns the old "auth_url" field's value of the OauthProvider entity.
+// If the OauthProvider object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OauthProviderMutation) OldAuthURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAuthURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAuthURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAuthURL: %w", err)
+	}
+	return oldValue.AuthURL, nil
+}
+
+// ClearAuthURL clears the value of the "auth_url" field.
+func (m *OauthProviderMutation) ClearAuthURL() {
+	m.auth_url = nil
+	m.clearedFields[oauthprovider.FieldAuthURL] = struct{}{}
+}
+
+// AuthURLCleared returns if the "auth_url" field was cleared in this mutation.
+func (m *OauthProviderMutation) AuthURLCleared() bool {
+	_, ok := m.clearedFields[oauthprovider.FieldAuthURL]
+	return ok
+}
+
+// ResetAuthURL resets all changes to the "auth_url" field.
+func (m *OauthProviderMutation) ResetAuthURL() {
+	m.auth_url = nil
+	delete(m.clearedFields, oauthprovider.FieldAuthURL)
+}
+
+// SetTokenURL sets the "token_url" field.
+func (m *OauthProviderMutation) SetTokenURL(s string) {
+	m.token_url = &s
+}
+
+// TokenURL returns the value of the "token_url" field in the mutation.
+func (m *OauthProviderMutation) TokenURL() (r string, exists bool) {
+	v := m.token_url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTokenURL returns the old "token_url" field's value of the OauthProvider entity.
+// If the OauthProvider object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OauthProviderMutation) OldTokenURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTokenURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTokenURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTokenURL: %w", err)
+	}
+	return oldValue.TokenURL, nil
+}
+
+// ClearTokenURL clears the value of the "token_url" field.
+func (m *OauthProviderMutation) ClearTokenURL() {
+	m.token_url = nil
+	m.clearedFields[oauthprovider.FieldTokenURL] = struct{}{}
+}
+
+// TokenURLCleared returns if the "token_url" field was cleared in this mutation.
+func (m *OauthProviderMutation) TokenURLCleared() bool {
+	_, ok := m.clearedFields[oauthprovider.FieldTokenURL]
+	return ok
+}
+
+// ResetTokenURL resets all changes to the "token_url" field.
+func (m *OauthProviderMutation) ResetTokenURL() {
+	m.token_url = nil
+	delete(m.clearedFields, oauthprovider.FieldTokenURL)
+}
+
+// SetAuthStyle sets the "auth_style" field.
+func (m *OauthProviderMutation) SetAuthStyle(u uint64) {
+	m.auth_style = &u
+	m.addauth_style = nil
+}
+
+// AuthStyle returns the value of the "auth_style" field in the mutation.
+func (m *OauthProviderMutation) AuthStyle() (r uint64, exists bool) {
+	v := m.auth_style
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAuthStyle returns the old "auth_style" field's value of the OauthProvider entity.
+// If the OauthProvider object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OauthProviderMutation) OldAuthStyle(ctx context.Context) (v uint64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAuthStyle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAuthStyle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAuthStyle: %w", err)
+	}
+	return oldValue.AuthStyle, nil
+}
+
+// AddAuthStyle adds u to the "auth_style" field.
+func (m *OauthProviderMutation) AddAuthStyle(u int64) {
+	if m.addauth_style != nil {
+		*m.addauth_style += u
+	} else {
+		m.addauth_style = &u
+	}
+}
+
+// AddedAuthStyle returns the value that was added to the "auth_style" field in this mutation.
+func (m *OauthProviderMutation) AddedAuthStyle() (r int64, exists bool) {
+	v := m.addauth_style
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearAuthStyle clears the value of the "auth_style" field.
+func (m *OauthProviderMutation) ClearAuthStyle() {
+	m.auth_style = nil
+	m.addauth_style = nil
+	m.clearedFields[oauthprovider.FieldAuthStyle] = struct{}{}
+}
+
+// AuthStyleCleared returns if the "auth_style" field was cleared in this mutation.
+func (m *OauthProviderMutation) AuthStyleCleared() bool {
+	_, ok := m.clearedFields[oauthprovider.FieldAuthStyle]
+	return ok
+}
+
+// ResetAuthStyle resets all changes to the "auth_style" field.
+func (m *OauthProviderMutation) ResetAuthStyle() {
+	m.auth_style = nil
+	m.addauth_style = nil
+	delete(m.clearedFields, oauthprovider.FieldAuthStyle)
+}
+
+// SetInfoURL sets the "info_url" field.
+func (m *OauthProviderMutation) SetInfoURL(s string) {
+	m.info_url = &s
+}
+
+// InfoURL returns the value of the "info_url" field in the mutation.
+func (m *OauthProviderMutation) InfoURL() (r string, exists bool) {
+	v := m.info_url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInfoURL returns the old "info_url" field's value of the OauthProvider entity.
+// If the OauthProvider object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OauthProviderMutation) OldInfoURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInfoURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInfoURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInfoURL: %w", err)
+	}
+	return oldValue.InfoURL, nil
+}
+
+// ClearInfoURL clears the value of the "info_url" field.
+func (m *OauthProviderMutation) ClearInfoURL() {
+	m.info_url = nil
+	m.clearedFields[oauthprovider.FieldInfoURL] = struct{}{}
+}
+
+// InfoURLCleared returns if the "info_url" field was cleared in this mutation.
+func (m *OauthProviderMutation) InfoURLCleared() bool {
+	_, ok := m.clearedFields[oauthprovider.FieldInfoURL]
+	return ok
+}
+
+// ResetInfoURL resets all changes to the "info_url" field.
+func (m *OauthProviderMutation) ResetInfoURL() {
+	m.info_url = nil
+	delete(m.clearedFields, oauthprovider.FieldInfoURL)
+}
+
+// SetDiscoveryURL sets the "discovery_url" field.
+func (m *OauthProviderMutation) SetDiscoveryURL(s string) {
+	m.discovery_url = &s
+}
+
+// DiscoveryURL returns the value of the "discovery_url" field in the mutation.
+func (m *OauthProviderMutation) DiscoveryURL() (r string, exists bool) {
+	v := m.discovery_url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDiscoveryURL returns the old "discovery_url" field's value of the OauthProvider entity.
+// If the OauthProvider object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OauthProviderMutation) OldDiscoveryURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDiscoveryURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDiscoveryURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDiscoveryURL: %w", err)
+	}
+	return oldValue.DiscoveryURL, nil
+}
+
+// ClearDiscoveryURL clears the value of the "discovery_url" field.
+func (m *OauthProviderMutation) ClearDiscoveryURL() {
+	m.discovery_url = nil
+	m.clearedFields[oauthprovider.FieldDiscoveryURL] = struct{}{}
+}
+
+// DiscoveryURLCleared returns if the "discovery_url" field was cleared in this mutation.
+func (m *OauthProviderMutation) DiscoveryURLCleared() bool {
+	_, ok := m.clearedFields[oauthprovider.FieldDiscoveryURL]
+	return ok
+}
+
+// ResetDiscoveryURL resets all changes to the "discovery_url" field.
+func (m *OauthProviderMutation) ResetDiscoveryURL() {
+	m.discovery_url = nil
+	delete(m.clearedFields, oauthprovider.FieldDiscoveryURL)
+}
+
+// SetClaimMapping sets the "claim_mapping" field.
+func (m *OauthProviderMutation) SetClaimMapping(value map[string]string) {
+	m.claim_mapping = &value
+}
+
+// ClaimMapping returns the value of the "claim_mapping" field in the mutation.
+func (m *OauthProviderMutation) ClaimMapping() (r map[string]string, exists bool) {
+	v := m.claim_mapping
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClaimMapping returns the old "claim_mapping" field's value of the OauthProvider entity.
+// If the OauthProvider object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OauthProviderMutation) OldClaimMapping(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClaimMapping is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClaimMapping requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClaimMapping: %w", err)
+	}
+	return oldValue.ClaimMapping, nil
+}
+
+// ClearClaimMapping clears the value of the "claim_mapping" field.
+func (m *OauthProviderMutation) ClearClaimMapping() {
+	m.claim_mapping = nil
+	m.clearedFields[oauthprovider.FieldClaimMapping] = struct{}{}
+}
+
+// ClaimMappingCleared returns if the "claim_mapping" field was cleared in this mutation.
+func (m *OauthProviderMutation) ClaimMappingCleared() bool {
+	_, ok := m.clearedFields[oauthprovider.FieldClaimMapping]
+	return ok
+}
+
+// ResetClaimMapping resets all changes to the "claim_mapping" field.
+func (m *OauthProviderMutation) ResetClaimMapping() {
+	m.claim_mapping = nil
+	delete(m.clearedFields, oauthprovider.FieldClaimMapping)
+}
+
+// SetAutoProvision sets the "auto_provision" field.
+func (m *OauthProviderMutation) SetAutoProvision(b bool) {
+	m.auto_provision = &b
+}
+
+// AutoProvision returns the value of the "auto_provision" field in the mutation.
+func (m *OauthProviderMutation) AutoProvision() (r bool, exists bool) {
+	v := m.auto_provision
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAutoProvision returns the old "auto_provision" field's value of the OauthProvider entity.
+// If the OauthProvider object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OauthProviderMutation) OldAutoProvision(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAutoProvision is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAutoProvision requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAutoProvision: %w", err)
+	}
+	return oldValue.AutoProvision, nil
+}
+
+// ResetAutoProvision resets all changes to the "auto_provision" field.
+func (m *OauthProviderMutation) ResetAutoProvision() {
+	m.auto_provision = nil
+}
+
+// SetProvisionApproval sets the "provision_approval" field.
+func (m *OauthProviderMutation) SetProvisionApproval(b bool) {
+	m.provision_approval = &b
+}
+
+// ProvisionApproval returns the value of the "provision_approval" field in the mutation.
+func (m *OauthProviderMutation) ProvisionApproval() (r bool, exists bool) {
+	v := m.provision_approval
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProvisionApproval returns the old "provision_approval" field's value of the OauthProvider entity.
+// If the OauthProvider object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OauthProviderMutation) OldProvisionApproval(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProvisionApproval is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProvisionApproval requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProvisionApproval: %w", err)
+	}
+	return oldValue.ProvisionApproval, nil
+}
+
+// ResetProvisionApproval resets all changes to the "provision_approval" field.
+func (m *OauthProviderMutation) ResetProvisionApproval() {
+	m.provision_approval = nil
+}
+
+// SetDefaultRoleID sets the "default_role_id" field.
+func (m *OauthProviderMutation) SetDefaultRoleID(u uint64) {
+	m.default_role_id = &u
+	m.adddefault_role_id = nil
+}
+
+// DefaultRoleID returns the value of the "default_role_id" field in the mutation.
+func (m *OauthProviderMutation) DefaultRoleID() (r uint64, exists bool) {
+	v := m.default_role_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDefaultRoleID returns the old "default_role_id" field's value of the OauthProvider entity.
+// If the OauthProvider object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OauthProviderMutation) OldDefaultRoleID(ctx context.Context) (v uint64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDefaultRoleID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDefaultRoleID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDefaultRoleID: %w", err)
+	}
+	return oldValue.DefaultRoleID, nil
+}
+
+// AddDefaultRoleID adds u to the "default_role_id" field.
+func (m *OauthProviderMutation) AddDefaultRoleID(u int64) {
+	if m.adddefault_role_id != nil {
+		*m.adddefault_role_id += u
+	} else {
+		m.adddefault_role_id = &u
+	}
+}
+
+// AddedDefaultRoleID returns the value that was added to the "default_role_id" field in this mutation.
+func (m *OauthProviderMutation) AddedDefaultRoleID() (r int64, exists bool) {
+	v := m.adddefault_role_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearDefaultRoleID clears the value of the "default_role_id" field.
+func (m *OauthProviderMutation) ClearDefaultRoleID() {
+	m.default_role_id = nil
+	m.adddefault_role_id = nil
+	m.clearedFields[oauthprovider.FieldDefaultRoleID] = struct{}{}
+}
+
+// DefaultRoleIDCleared returns if the "default_role_id" field was cleared in this mutation.
+func (m *OauthProviderMutation) DefaultRoleIDCleared() bool {
+	_, ok := m.clearedFields[oauthprovider.FieldDefaultRoleID]
+	return ok
+}
+
+// ResetDefaultRoleID resets all changes to the "default_role_id" field.
+func (m *OauthProviderMutation) ResetDefaultRoleID() {
+	m.default_role_id = nil
+	m.adddefault_role_id = nil
+	delete(m.clearedFields, oauthprovider.FieldDefaultRoleID)
+}
+
+// SetRoleRules sets the "role_rules" field.
+func (m *OauthProviderMutation) SetRoleRules(r []rolerule.Rule) {
+	m.role_rules = &r
+	m.appendrole_rules = nil
+}
+
+// RoleRules returns the value of the "role_rules" field in the mutation.
+func (m *OauthProviderMutation) RoleRules() (r []rolerule.Rule, exists bool) {
+	v := m.role_rules
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRoleRules returns the old "role_rules" field's value of the OauthProvider entity.
+// If the OauthProvider object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OauthProviderMutation) OldRoleRules(ctx context.Context) (v []rolerule.Rule, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRoleRules is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRoleRules requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRoleRules: %w", err)
+	}
+	return oldValue.RoleRules, nil
+}
+
+// AppendRoleRules adds r to the "role_rules" field.
+func (m *OauthProviderMutation) AppendRoleRules(r []rolerule.Rule) {
+	m.appendrole_rules = append(m.appendrole_rules, r...)
+}
+
+// AppendedRoleRules returns the list of values that were appended to the "role_rules" field in this mutation.
+func (m *OauthProviderMutation) AppendedRoleRules() ([]rolerule.Rule, bool) {
+	if len(m.appendrole_rules) == 0 {
+		return nil, false
+	}
+	return m.appendrole_rules, true
+}
+
+// ClearRoleRules clears the value of the "role_rules" field.
+func (m *OauthProviderMutation) ClearRoleRules() {
+	m.role_rules = nil
+	m.appendrole_rules = nil
+	m.clearedFields[oauthprovider.FieldRoleRules] = struct{}{}
+}
+
+// RoleRulesCleared returns if the "role_rules" field was cleared in this mutation.
+func (m *OauthProviderMutation) RoleRulesCleared() bool {
+	_, ok := m.clearedFields[oauthprovider.FieldRoleRules]
+	return ok
+}
+
+// ResetRoleRules resets all changes to the "role_rules" field.
+func (m *OauthProviderMutation) ResetRoleRules() {
+	m.role_rules = nil
+	m.appendrole_rules = nil
+	delete(m.clearedFields, oauthprovider.FieldRoleRules)
+}
+
+// Where appends a list predicates to the OauthProviderMutation builder.
+func (m *OauthProviderMutation) Where(ps ...predicate.OauthProvider) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the OauthProviderMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *OauthProviderMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.OauthProvider, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *OauthProviderMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *OauthProviderMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (OauthProvider).
+func (m *OauthProviderMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *OauthProviderMutation) Fields() []string {
+	fields := make([]string, 0, 18)
+	if m.created_at != nil {
+		fields = append(fields, oauthprovider.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, oauthprovider.FieldUpdatedAt)
+	}
+	if m.name != nil {
+		fields = append(fields, oauthprovider.FieldName)
+	}
+	if m.app_id != nil {
+		fields = append(fields, oauthprovider.FieldAppID)
+	}
+	if m.client_id != nil {
+		fields = append(fields, oauthprovider.FieldClientID)
+	}
+	if m.client_secret != nil {
+		fields = append(fields, oauthprovider.FieldClientSecret)
+	}
+	if m.redirect_url != nil {
+		fields = append(fields, oauthprovider.FieldRedirectURL)
+	}
+	if m.scopes != nil {
+		fields = append(fields, oauthprovider.FieldScopes)
+	}
+	if m.auth_url != nil {
+		fields = append(fields, oauthprovider.FieldAuthURL)
+	}
+	if m.token_url != nil {
+		fields = append(fields, oauthprovider.FieldTokenURL)
+	}
+	if m.auth_style != nil {
+		fields = append(fields, oauthprovider.FieldAuthStyle)
+	}
+	if m.info_url != nil {
+		fields = append(fields, oauthprovider.FieldInfoURL)
+	}
+	if m.discovery_url != nil {
+		fields = append(fields, oauthprovider.FieldDiscoveryURL)
+	}
+	if m.claim_mapping != nil {
+		fields = append(fields, oauthprovider.FieldClaimMapping)
+	}
+	if m.auto_provision != nil {
+		fields = append(fields, oauthprovider.FieldAutoProvision)
+	}
+	if m.provision_approval != nil {
+		fields = append(fields, oauthprovider.FieldProvisionApproval)
+	}
+	if m.default_role_id != nil {
+		fields = append(fields, oauthprovider.FieldDefaultRoleID)
+	}
+	if m.role_rules != nil {
+		fields = append(fields, oauthprovider.FieldRoleRules)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *OauthProviderMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case oauthprovider.FieldCreatedAt:
+		return m.CreatedAt()
+	case oauthprovider.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case oauthprovider.FieldName:
+		return m.Name()
+	case oauthprovider.FieldAppID:
+		return m.AppID()
+	case oauthprovider.FieldClientID:
+		return m.ClientID()
+	case oauthprovider.FieldClientSecret:
+		return m.ClientSecret()
+	case oauthprovider.FieldRedirectURL:
+		return m.RedirectURL()
+	case oauthprovider.FieldScopes:
+		return m.Scopes()
+	case oauthprovider.FieldAuthURL:
+		return m.AuthURL()
+	case oauthprovider.FieldTokenURL:
+		return m.TokenURL()
+	case oauthprovider.FieldAuthStyle:
+		return m.AuthStyle()
+	case oauthprovider.FieldInfoURL:
+		return m.InfoURL()
+	case oauthprovider.FieldDiscoveryURL:
+		return m.DiscoveryURL()
+	case oauthprovider.FieldClaimMapping:
+		return m.ClaimMapping()
+	case oauthprovider.FieldAutoProvision:
+		return m.AutoProvision()
+	case oauthprovider.FieldProvisionApproval:
+		return m.ProvisionApproval()
+	case oauthprovider.FieldDefaultRoleID:
+		return m.DefaultRoleID()
+	case oauthprovider.FieldRoleRules:
+		return m.RoleRules()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *OauthProviderMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case oauthprovider.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case oauthprovider.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case oauthprovider.FieldName:
+		return m.OldName(ctx)
+	case oauthprovider.FieldAppID:
+		return m.OldAppID(ctx)
+	case oauthprovider.FieldClientID:
+		return m.OldClientID(ctx)
+	case oauthprovider.FieldClientSecret:
+		return m.OldClientSecret(ctx)
+	case oauthprovider.FieldRedirectURL:
+		return m.OldRedirectURL(ctx)
+	case oauthprovider.FieldScopes:
+		return m.OldScopes(ctx)
+	case oauthprovider.FieldAuthURL:
+		return m.OldAuthURL(ctx)
+	case oauthprovider.FieldTokenURL:
+		return m.OldTokenURL(ctx)
+	case oauthprovider.FieldAuthStyle:
+		return m.OldAuthStyle(ctx)
+	case oauthprovider.FieldInfoURL:
+		return m.OldInfoURL(ctx)
+	case oauthprovider.FieldDiscoveryURL:
+		return m.OldDiscoveryURL(ctx)
+	case oauthprovider.FieldClaimMapping:
+		return m.OldClaimMapping(ctx)
+	case oauthprovider.FieldAutoProvision:
+		return m.OldAutoProvision(ctx)
+	case oauthprovider.FieldProvisionApproval:
+		return m.OldProvisionApproval(ctx)
+	case oauthprovider.FieldDefaultRoleID:
+		return m.OldDefaultRoleID(ctx)
+	case oauthprovider.FieldRoleRules:
+		return m.OldRoleRules(ctx)
+	}
+	return nil, fmt.Errorf("unknown OauthProvider field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OauthProviderMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case oauthprovider.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case oauthprovider.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case oauthprovider.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case oauthprovider.FieldAppID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAppID(v)
+		return nil
+	case oauthprovider.FieldClientID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClientID(v)
+		return nil
+	case oauthprovider.FieldClientSecret:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClientSecret(v)
+		return nil
+	case oauthprovider.FieldRedirectURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRedirectURL(v)
+		return nil
+	case oauthprovider.FieldScopes:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScopes(v)
+		return nil
+	case oauthprovider.FieldAuthURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAuthURL(v)
+		return nil
+	case oauthprovider.FieldTokenURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTokenURL(v)
+		return nil
+	case oauthprovider.FieldAuthStyle:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAuthStyle(v)
+		return nil
+	case oauthprovider.FieldInfoURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInfoURL(v)
+		return nil
+	case oauthprovider.FieldDiscoveryURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDiscoveryURL(v)
+		return nil
+	case oauthprovider.FieldClaimMapping:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClaimMapping(v)
+		return nil
+	case oauthprovider.FieldAutoProvision:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAutoProvision(v)
+		return nil
+	case oauthprovider.FieldProvisionApproval:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProvisionApproval(v)
+		return nil
+	case oauthprovider.FieldDefaultRoleID:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDefaultRoleID(v)
+		return nil
+	case oauthprovider.FieldRoleRules:
+		v, ok := value.([]rolerule.Rule)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRoleRules(v)
+		return nil
+	}
+	return fmt.Errorf("unknown OauthProvider field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *OauthProviderMutation) AddedFields() []string {
+	var fields []string
+	if m.addauth_style != nil {
+		fields = append(fields, oauthprovider.FieldAuthStyle)
+	}
+	if m.adddefault_role_id != nil {
+		fields = append(fields, oauthprovider.FieldDefaultRoleID)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *OauthProviderMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case oauthprovider.FieldAuthStyle:
+		return m.AddedAuthStyle()
+	case oauthprovider.FieldDefaultRoleID:
+		return m.AddedDefaultRoleID()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OauthProviderMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case oauthprovider.FieldAuthStyle:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAuthStyle(v)
+		return nil
+	case oauthprovider.FieldDefaultRoleID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDefaultRoleID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown OauthProvider numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *OauthProviderMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(oauthprovider.FieldAppID) {
+		fields = append(fields, oauthprovider.FieldAppID)
+	}
+	if m.FieldCleared(oauthprovider.FieldClientSecret) {
+		fields = append(fields, oauthprovider.FieldClientSecret)
+	}
+	if m.FieldCleared(oauthprovider.FieldScopes) {
+		fields = append(fields, oauthprovider.FieldScopes)
+	}
+	if m.FieldCleared(oauthprovider.FieldAuthURL) {
+		fields = append(fields, oauthprovider.FieldAuthURL)
+	}
+	if m.FieldCleared(oauthprovider.FieldTokenURL) {
+		fields = append(fields, oauthprovider.FieldTokenURL)
+	}
+	if m.FieldCleared(oauthprovider.FieldAuthStyle) {
+		fields = append(fields, oauthprovider.FieldAuthStyle)
+	}
+	if m.FieldCleared(oauthprovider.FieldInfoURL) {
+		fields = append(fields, oauthprovider.FieldInfoURL)
+	}
+	if m.FieldCleared(oauthprovider.FieldDiscoveryURL) {
+		fields = append(fields, oauthprovider.FieldDiscoveryURL)
+	}
+	if m.FieldCleared(oauthprovider.FieldClaimMapping) {
+		fields = append(fields, oauthprovider.FieldClaimMapping)
+	}
+	if m.FieldCleared(oauthprovider.FieldDefaultRoleID) {
+		fields = append(fields, oauthprovider.FieldDefaultRoleID)
+	}
+	if m.FieldCleared(oauthprovider.FieldRoleRules) {
+		fields = append(fields, oauthprovider.FieldRoleRules)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *OauthProviderMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *OauthProviderMutation) ClearField(name string) error {
+	switch name {
+	case oauthprovider.FieldAppID:
+		m.ClearAppID()
+		return nil
+	case oauthprovider.FieldClientSecret:
+		m.ClearClientSecret()
+		return nil
+	case oauthprovider.FieldScopes:
+		m.ClearScopes()
+		return nil
+	case oauthprovider.FieldAuthURL:
+		m.ClearAuthURL()
+		return nil
+	case oauthprovider.FieldTokenURL:
+		m.ClearTokenURL()
+		return nil
+	case oauthprovider.FieldAuthStyle:
+		m.ClearAuthStyle()
+		return nil
+	case oauthprovider.FieldInfoURL:
+		m.ClearInfoURL()
+		return nil
+	case oauthprovider.FieldDiscoveryURL:
+		m.ClearDiscoveryURL()
+		return nil
+	case oauthprovider.FieldClaimMapping:
+		m.ClearClaimMapping()
+		return nil
+	case oauthprovider.FieldDefaultRoleID:
+		m.ClearDefaultRoleID()
+		return nil
+	case oauthprovider.FieldRoleRules:
+		m.ClearRoleRules()
+		return nil
+	}
+	return fmt.Errorf("unknown OauthProvider nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *OauthProviderMutation) ResetField(name string) error {
+	switch name {
 	case oauthprovider.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case oauthprovider.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case oauthprovider.FieldName:
+		m.ResetName()
+		return nil
+	case oauthprovider.FieldAppID:
+		m.ResetAppID()
+		return nil
+	case oauthprovider.FieldClientID:
+		m.ResetClientID()
+		return nil
+	case oauthprovider.FieldClientSecret:
+		m.ResetClientSecret()
+		return nil
+	case oauthprovider.FieldRedirectURL:
+		m.ResetRedirectURL()
+		return nil
+	case oauthprovider.FieldScopes:
+		m.ResetScopes()
+		return nil
+	case oauthprovider.FieldAuthURL:
+		m.ResetAuthURL()
+		return nil
+	case oauthprovider.FieldTokenURL:
+		m.ResetTokenURL()
+		return nil
+	case oauthprovider.FieldAuthStyle:
+		m.ResetAuthStyle()
+		return nil
+	case oauthprovider.FieldInfoURL:
+		m.ResetInfoURL()
+		return nil
+	case oauthprovider.FieldDiscoveryURL:
+		m.ResetDiscoveryURL()
+		return nil
+	case oauthprovider.FieldClaimMapping:
+		m.ResetClaimMapping()
+		return nil
+	case oauthprovider.FieldAutoProvision:
+		m.ResetAutoProvision()
+		return nil
+	case oauthprovider.FieldProvisionApproval:
+		m.ResetProvisionApproval()
+		return nil
+	case oauthprovider.FieldDefaultRoleID:
+		m.ResetDefaultRoleID()
+		return nil
+	case oauthprovider.FieldRoleRules:
+		m.ResetRoleRules()
+		return nil
+	}
+	return fmt.Errorf("unknown OauthProvider field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *OauthProviderMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *OauthProviderMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *OauthProviderMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *OauthProviderMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *OauthProviderMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *OauthProviderMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *OauthProviderMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown OauthProvider unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *OauthProviderMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown OauthProvider edge %s", name)
+}
+
+// OidcClientMutation represents an operation that mutates the OidcClient nodes in the graph.
+type OidcClientMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *uint64
+	created_at          *time.Time
+	updated_at          *time.Time
+	status              *uint8
+	addstatus           *int8
+	client_id           *string
+	client_secret       *string
+	name                *string
+	redirect_uris       *[]string
+	appendredirect_uris []string
+	grant_types         *[]string
+	appendgrant_types   []string
+	scopes              *[]string
+	appendscopes        []string
+	public              *bool
+	skip_consent        *bool
+	clearedFields       map[string]struct{}
+	done                bool
+	oldValue            func(context.Context) (*OidcClient, error)
+	predicates          []predicate.OidcClient
+}
+
+var _ ent.Mutation = (*OidcClientMutation)(nil)
+
+// oidcclientOption allows management of the mutation configuration using functional options.
+type oidcclientOption func(*OidcClientMutation)
+
+// newOidcClientMutation creates new mutation for the OidcClient entity.
+func newOidcClientMutation(c config, op Op, opts ...oidcclientOption) *OidcClientMutation {
+	m := &OidcClientMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeOidcClient,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withOidcClientID sets the ID field of the mutation.
+func withOidcClientID(id uint64) oidcclientOption {
+	return func(m *OidcClientMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *OidcClient
+		)
+		m.oldValue = func(ctx context.Context) (*OidcClient, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().OidcClient.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withOidcClient sets the old OidcClient of the mutation.
+func withOidcClient(node *OidcClient) oidcclientOption {
+	return func(m *OidcClientMutation) {
+		m.oldValue = func(context.Context) (*OidcClient, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m OidcClientMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m OidcClientMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of OidcClient entities.
+func (m *OidcClientMutation) SetID(id uint64) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *OidcClientMutation) ID() (id uint64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *OidcClientMutation) IDs(ctx context.Context) ([]uint64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().OidcClient.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *OidcClientMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *OidcClientMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the OidcClient entity.
+// If the OidcClient object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OidcClientMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *OidcClientMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *OidcClientMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *OidcClientMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the OidcClient entity.
+// If the OidcClient object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OidcClientMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *OidcClientMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *OidcClientMutation) SetStatus(u uint8) {
+	m.status = &u
+	m.addstatus = nil
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *OidcClientMutation) Status() (r uint8, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the OidcClient entity.
+// If the OidcClient object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OidcClientMutation) OldStatus(ctx context.Context) (v uint8, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// AddStatus adds u to the "status" field.
+func (m *OidcClientMutation) AddStatus(u int8) {
+	if m.addstatus != nil {
+		*m.addstatus += u
+	} else {
+		m.addstatus = &u
+	}
+}
+
+// AddedStatus returns the value that was added to the "status" field in this mutation.
+func (m *OidcClientMutation) AddedStatus() (r int8, exists bool) {
+	v := m.addstatus
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearStatus clears the value of the "status" field.
+func (m *OidcClientMutation) ClearStatus() {
+	m.status = nil
+	m.addstatus = nil
+	m.clearedFields[oidcclient.FieldStatus] = struct{}{}
+}
+
+// StatusCleared returns if the "status" field was cleared in this mutation.
+func (m *OidcClientMutation) StatusCleared() bool {
+	_, ok := m.clearedFields[oidcclient.FieldStatus]
+	return ok
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *OidcClientMutation) ResetStatus() {
+	m.status = nil
+	m.addstatus = nil
+	delete(m.clearedFields, oidcclient.FieldStatus)
+}
+
+// SetClientID sets the "client_id" field.
+func (m *OidcClientMutation) SetClientID(s string) {
+	m.client_id = &s
+}
+
+// ClientID returns the value of the "client_id" field in the mutation.
+func (m *OidcClientMutation) ClientID() (r string, exists bool) {
+	v := m.client_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClientID returns the old "client_id" field's value of the OidcClient entity.
+// If the OidcClient object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OidcClientMutation) OldClientID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClientID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClientID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClientID: %w", err)
+	}
+	return oldValue.ClientID, nil
+}
+
+// ResetClientID resets all changes to the "client_id" field.
+func (m *OidcClientMutation) ResetClientID() {
+	m.client_id = nil
+}
+
+// SetClientSecret sets the "client_secret" field.
+func (m *OidcClientMutation) SetClientSecret(s string) {
+	m.client_secret = &s
+}
+
+// ClientSecret returns the value of the "client_secret" field in the mutation.
+func (m *OidcClientMutation) ClientSecret() (r string, exists bool) {
+	v := m.client_secret
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClientSecret returns the old "client_secret" field's value of the OidcClient entity.
+// If the OidcClient object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OidcClientMutation) OldClientSecret(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClientSecret is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClientSecret requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClientSecret: %w", err)
+	}
+	return oldValue.ClientSecret, nil
+}
+
+// ClearClientSecret clears the value of the "client_secret" field.
+func (m *OidcClientMutation) ClearClientSecret() {
+	m.client_secret = nil
+	m.clearedFields[oidcclient.FieldClientSecret] = struct{}{}
+}
+
+// ClientSecretCleared returns if the "client_secret" field was cleared in this mutation.
+func (m *OidcClientMutation) ClientSecretCleared() bool {
+	_, ok := m.clearedFields[oidcclient.FieldClientSecret]
+	return ok
+}
+
+// ResetClientSecret resets all changes to the "client_secret" field.
+func (m *OidcClientMutation) ResetClientSecret() {
+	m.client_secret = nil
+	delete(m.clearedFields, oidcclient.FieldClientSecret)
+}
+
+// SetName sets the "name" field.
+func (m *OidcClientMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *OidcClientMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the OidcClient entity.
+// If the OidcClient object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OidcClientMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *OidcClientMutation) ResetName() {
+	m.name = nil
+}
+
+// SetRedirectUris sets the "redirect_uris" field.
+func (m *OidcClientMutation) SetRedirectUris(s []string) {
+	m.redirect_uris = &s
+	m.appendredirect_uris = nil
+}
+
+// RedirectUris returns the value of the "redirect_uris" field in the mutation.
+func (m *OidcClientMutation) RedirectUris() (r []string, exists bool) {
+	v := m.redirect_uris
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRedirectUris returns the old "redirect_uris" field's value of the OidcClient entity.
+// If the OidcClient object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OidcClientMutation) OldRedirectUris(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRedirectUris is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRedirectUris requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRedirectUris: %w", err)
+	}
+	return oldValue.RedirectUris, nil
+}
+
+// AppendRedirectUris adds s to the "redirect_uris" field.
+func (m *OidcClientMutation) AppendRedirectUris(s []string) {
+	m.appendredirect_uris = append(m.appendredirect_uris, s...)
+}
+
+// AppendedRedirectUris returns the list of values that were appended to the "redirect_uris" field in this mutation.
+func (m *OidcClientMutation) AppendedRedirectUris() ([]string, bool) {
+	if len(m.appendredirect_uris) == 0 {
+		return nil, false
+	}
+	return m.appendredirect_uris, true
+}
+
+// ClearRedirectUris clears the value of the "redirect_uris" field.
+func (m *OidcClientMutation) ClearRedirectUris() {
+	m.redirect_uris = nil
+	m.appendredirect_uris = nil
+	m.clearedFields[oidcclient.FieldRedirectUris] = struct{}{}
+}
+
+// RedirectUrisCleared returns if the "redirect_uris" field was cleared in this mutation.
+func (m *OidcClientMutation) RedirectUrisCleared() bool {
+	_, ok := m.clearedFields[oidcclient.FieldRedirectUris]
+	return ok
+}
+
+// ResetRedirectUris resets all changes to the "redirect_uris" field.
+func (m *OidcClientMutation) ResetRedirectUris() {
+	m.redirect_uris = nil
+	m.appendredirect_uris = nil
+	delete(m.clearedFields, oidcclient.FieldRedirectUris)
+}
+
+// SetGrantTypes sets the "grant_types" field.
+func (m *OidcClientMutation) SetGrantTypes(s []string) {
+	m.grant_types = &s
+	m.appendgrant_types = nil
+}
+
+// GrantTypes returns the value of the "grant_types" field in the mutation.
+func (m *OidcClientMutation) GrantTypes() (r []string, exists bool) {
+	v := m.grant_types
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGrantTypes returns the old "grant_types" field's value of the OidcClient entity.
+// If the OidcClient object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OidcClientMutation) OldGrantTypes(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGrantTypes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGrantTypes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGrantTypes: %w", err)
+	}
+	return oldValue.GrantTypes, nil
+}
+
+// AppendGrantTypes adds s to the "grant_types" field.
+func (m *OidcClientMutation) AppendGrantTypes(s []string) {
+	m.appendgrant_types = append(m.appendgrant_types, s...)
+}
+
+// AppendedGrantTypes returns the list of values that were appended to the "grant_types" field in this mutation.
+func (m *OidcClientMutation) AppendedGrantTypes() ([]string, bool) {
+	if len(m.appendgrant_types) == 0 {
+		return nil, false
+	}
+	return m.appendgrant_types, true
+}
+
+// ResetGrantTypes resets all changes to the "grant_types" field.
+func (m *OidcClientMutation) ResetGrantTypes() {
+	m.grant_types = nil
+	m.appendgrant_types = nil
+}
+
+// SetScopes sets the "scopes" field.
+func (m *OidcClientMutation) SetScopes(s []string) {
+	m.scopes = &s
+	m.appendscopes = nil
+}
+
+// Scopes returns the value of the "scopes" field in the mutation.
+func (m *OidcClientMutation) Scopes() (r []string, exists bool) {
+	v := m.scopes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScopes returns the old "scopes" field's value of the OidcClient entity.
+// If the OidcClient object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OidcClientMutation) OldScopes(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScopes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScopes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScopes: %w", err)
+	}
+	return oldValue.Scopes, nil
+}
+
+// AppendScopes adds s to the "scopes" field.
+func (m *OidcClientMutation) AppendScopes(s []string) {
+	m.appendscopes = append(m.appendscopes, s...)
+}
+
+// AppendedScopes returns the list of values that were appended to the "scopes" field in this mutation.
+func (m *OidcClientMutation) AppendedScopes() ([]string, bool) {
+	if len(m.appendscopes) == 0 {
+		return nil, false
+	}
+	return m.appendscopes, true
+}
+
+// ResetScopes resets all changes to the "scopes" field.
+func (m *OidcClientMutation) ResetScopes() {
+	m.scopes = nil
+	m.appendscopes = nil
+}
+
+// SetPublic sets the "public" field.
+func (m *OidcClientMutation) SetPublic(b bool) {
+	m.public = &b
+}
+
+// Public returns the value of the "public" field in the mutation.
+func (m *OidcClientMutation) Public() (r bool, exists bool) {
+	v := m.public
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPublic returns the old "public" field's value of the OidcClient entity.
+// If the OidcClient object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OidcClientMutation) OldPublic(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPublic is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPublic requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPublic: %w", err)
+	}
+	return oldValue.Public, nil
+}
+
+// ResetPublic resets all changes to the "public" field.
+func (m *OidcClientMutation) ResetPublic() {
+	m.public = nil
+}
+
+// SetSkipConsent sets the "skip_consent" field.
+func (m *OidcClientMutation) SetSkipConsent(b bool) {
+	m.skip_consent = &b
+}
+
+// SkipConsent returns the value of the "skip_consent" field in the mutation.
+func (m *OidcClientMutation) SkipConsent() (r bool, exists bool) {
+	v := m.skip_consent
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSkipConsent returns the old "skip_consent" field's value of the OidcClient entity.
+// If the OidcClient object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OidcClientMutation) OldSkipConsent(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSkipConsent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSkipConsent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSkipConsent: %w", err)
+	}
+	return oldValue.SkipConsent, nil
+}
+
+// ResetSkipConsent resets all changes to the "skip_consent" field.
+func (m *OidcClientMutation) ResetSkipConsent() {
+	m.skip_consent = nil
+}
+
+// Where appends a list predicates to the OidcClientMutation builder.
+func (m *OidcClientMutation) Where(ps ...predicate.OidcClient) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the OidcClientMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *OidcClientMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.OidcClient, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *OidcClientMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *OidcClientMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (OidcClient).
+func (m *OidcClientMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *OidcClientMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.created_at != nil {
+		fields = append(fields, oidcclient.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, oidcclient.FieldUpdatedAt)
+	}
+	if m.status != nil {
+		fields = append(fields, oidcclient.FieldStatus)
+	}
+	if m.client_id != nil {
+		fields = append(fields, oidcclient.FieldClientID)
+	}
+	if m.client_secret != nil {
+		fields = append(fields, oidcclient.FieldClientSecret)
+	}
+	if m.name != nil {
+		fields = append(fields, oidcclient.FieldName)
+	}
+	if m.redirect_uris != nil {
+		fields = append(fields, oidcclient.FieldRedirectUris)
+	}
+	if m.grant_types != nil {
+		fields = append(fields, oidcclient.FieldGrantTypes)
+	}
+	if m.scopes != nil {
+		fields = append(fields, oidcclient.FieldScopes)
+	}
+	if m.public != nil {
+		fields = append(fields, oidcclient.FieldPublic)
+	}
+	if m.skip_consent != nil {
+		fields = append(fields, oidcclient.FieldSkipConsent)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *OidcClientMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case oidcclient.FieldCreatedAt:
+		return m.CreatedAt()
+	case oidcclient.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case oidcclient.FieldStatus:
+		return m.Status()
+	case oidcclient.FieldClientID:
+		return m.ClientID()
+	case oidcclient.FieldClientSecret:
+		return m.ClientSecret()
+	case oidcclient.FieldName:
+		return m.Name()
+	case oidcclient.FieldRedirectUris:
+		return m.RedirectUris()
+	case oidcclient.FieldGrantTypes:
+		return m.GrantTypes()
+	case oidcclient.FieldScopes:
+		return m.Scopes()
+	case oidcclient.FieldPublic:
+		return m.Public()
+	case oidcclient.FieldSkipConsent:
+		return m.SkipConsent()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *OidcClientMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case oidcclient.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case oidcclient.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case oidcclient.FieldStatus:
+		return m.OldStatus(ctx)
+	case oidcclient.FieldClientID:
+		return m.OldClientID(ctx)
+	case oidcclient.FieldClientSecret:
+		return m.OldClientSecret(ctx)
+	case oidcclient.FieldName:
+		return m.OldName(ctx)
+	case oidcclient.FieldRedirectUris:
+		return m.OldRedirectUris(ctx)
+	case oidcclient.FieldGrantTypes:
+		return m.OldGrantTypes(ctx)
+	case oidcclient.FieldScopes:
+		return m.OldScopes(ctx)
+	case oidcclient.FieldPublic:
+		return m.OldPublic(ctx)
+	case oidcclient.FieldSkipConsent:
+		return m.OldSkipConsent(ctx)
+	}
+	return nil, fmt.Errorf("unknown OidcClient field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OidcClientMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case oidcclient.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case oidcclient.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case oidcclient.FieldStatus:
+		v, ok := value.(uint8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case oidcclient.FieldClientID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClientID(v)
+		return nil
+	case oidcclient.FieldClientSecret:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClientSecret(v)
+		return nil
+	case oidcclient.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case oidcclient.FieldRedirectUris:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRedirectUris(v)
+		return nil
+	case oidcclient.FieldGrantTypes:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGrantTypes(v)
+		return nil
+	case oidcclient.FieldScopes:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScopes(v)
+		return nil
+	case oidcclient.FieldPublic:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPublic(v)
+		return nil
+	case oidcclient.FieldSkipConsent:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSkipConsent(v)
+		return nil
+	}
+	return fmt.Errorf("unknown OidcClient field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *OidcClientMutation) AddedFields() []string {
+	var fields []string
+	if m.addstatus != nil {
+		fields = append(fields, oidcclient.FieldStatus)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *OidcClientMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case oidcclient.FieldStatus:
+		return m.AddedStatus()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OidcClientMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case oidcclient.FieldStatus:
+		v, ok := value.(int8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStatus(v)
+		return nil
+	}
+	return fmt.Errorf("unknown OidcClient numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *OidcClientMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(oidcclient.FieldStatus) {
+		fields = append(fields, oidcclient.FieldStatus)
+	}
+	if m.FieldCleared(oidcclient.FieldClientSecret) {
+		fields = append(fields, oidcclient.FieldClientSecret)
+	}
+	if m.FieldCleared(oidcclient.FieldRedirectUris) {
+		fields = append(fields, oidcclient.FieldRedirectUris)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *OidcClientMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *OidcClientMutation) ClearField(name string) error {
+	switch name {
+	case oidcclient.FieldStatus:
+		m.ClearStatus()
+		return nil
+	case oidcclient.FieldClientSecret:
+		m.ClearClientSecret()
+		return nil
+	case oidcclient.FieldRedirectUris:
+		m.ClearRedirectUris()
+		return nil
+	}
+	return fmt.Errorf("unknown OidcClient nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *OidcClientMutation) ResetField(name string) error {
+	switch name {
+	case oidcclient.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case oidcclient.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case oidcclient.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case oidcclient.FieldClientID:
+		m.ResetClientID()
+		return nil
+	case oidcclient.FieldClientSecret:
+		m.ResetClientSecret()
+		return nil
+	case oidcclient.FieldName:
+		m.ResetName()
+		return nil
+	case oidcclient.FieldRedirectUris:
+		m.ResetRedirectUris()
+		return nil
+	case oidcclient.FieldGrantTypes:
+		m.ResetGrantTypes()
+		return nil
+	case oidcclient.FieldScopes:
+		m.ResetScopes()
+		return nil
+	case oidcclient.FieldPublic:
+		m.ResetPublic()
+		return nil
+	case oidcclient.FieldSkipConsent:
+		m.ResetSkipConsent()
+		return nil
+	}
+	return fmt.Errorf("unknown OidcClient field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *OidcClientMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *OidcClientMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *OidcClientMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *OidcClientMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *OidcClientMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *OidcClientMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *OidcClientMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown OidcClient unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *OidcClientMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown OidcClient edge %s", name)
+}
+
+// OidcConsentMutation represents an operation that mutates the OidcConsent nodes in the graph.
+type OidcConsentMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uint64
+	created_at    *time.Time
+	updated_at    *time.Time
+	user_id       *uint64
+	adduser_id    *int64
+	client_id     *string
+	scopes        *[]string
+	appendscopes  []string
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*OidcConsent, error)
+	predicates    []predicate.OidcConsent
+}
+
+var _ ent.Mutation = (*OidcConsentMutation)(nil)
+
+// oidcconsentOption allows management of the mutation configuration using functional options.
+type oidcconsentOption func(*OidcConsentMutation)
+
+// newOidcConsentMutation creates new mutation for the OidcConsent entity.
+func newOidcConsentMutation(c config, op Op, opts ...oidcconsentOption) *OidcConsentMutation {
+	m := &OidcConsentMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeOidcConsent,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withOidcConsentID sets the ID field of the mutation.
+func withOidcConsentID(id uint64) oidcconsentOption {
+	return func(m *OidcConsentMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *OidcConsent
+		)
+		m.oldValue = func(ctx context.Context) (*OidcConsent, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().OidcConsent.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withOidcConsent sets the old OidcConsent of the mutation.
+func withOidcConsent(node *OidcConsent) oidcconsentOption {
+	return func(m *OidcConsentMutation) {
+		m.oldValue = func(context.Context) (*OidcConsent, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m OidcConsentMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m OidcConsentMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of OidcConsent entities.
+func (m *OidcConsentMutation) SetID(id uint64) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *OidcConsentMutation) ID() (id uint64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *OidcConsentMutation) IDs(ctx context.Context) ([]uint64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().OidcConsent.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *OidcConsentMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *OidcConsentMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the OidcConsent entity.
+// If the OidcConsent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OidcConsentMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *OidcConsentMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *OidcConsentMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *OidcConsentMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the OidcConsent entity.
+// If the OidcConsent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OidcConsentMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *OidcConsentMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *OidcConsentMutation) SetUserID(u uint64) {
+	m.user_id = &u
+	m.adduser_id = nil
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *OidcConsentMutation) UserID() (r uint64, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the OidcConsent entity.
+// If the OidcConsent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OidcConsentMutation) OldUserID(ctx context.Context) (v uint64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// AddUserID adds u to the "user_id" field.
+func (m *OidcConsentMutation) AddUserID(u int64) {
+	if m.adduser_id != nil {
+		*m.adduser_id += u
+	} else {
+		m.adduser_id = &u
+	}
+}
+
+// AddedUserID returns the value that was added to the "user_id" field in this mutation.
+func (m *OidcConsentMutation) AddedUserID() (r int64, exists bool) {
+	v := m.adduser_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *OidcConsentMutation) ResetUserID() {
+	m.user_id = nil
+	m.adduser_id = nil
+}
+
+// SetClientID sets the "client_id" field.
+func (m *OidcConsentMutation) SetClientID(s string) {
+	m.client_id = &s
+}
+
+// ClientID returns the value of the "client_id" field in the mutation.
+func (m *OidcConsentMutation) ClientID() (r string, exists bool) {
+	v := m.client_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClientID returns the old "client_id" field's value of the OidcConsent entity.
+// If the OidcConsent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OidcConsentMutation) OldClientID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClientID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClientID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClientID: %w", err)
+	}
+	return oldValue.ClientID, nil
+}
+
+// ResetClientID resets all changes to the "client_id" field.
+func (m *OidcConsentMutation) ResetClientID() {
+	m.client_id = nil
+}
+
+// SetScopes sets the "scopes" field.
+func (m *OidcConsentMutation) SetScopes(s []string) {
+	m.scopes = &s
+	m.appendscopes = nil
+}
+
+// Scopes returns the value of the "scopes" field in the mutation.
+func (m *OidcConsentMutation) Scopes() (r []string, exists bool) {
+	v := m.scopes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScopes returns the old "scopes" field's value of the OidcConsent entity.
+// If the OidcConsent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OidcConsentMutation) OldScopes(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScopes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScopes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScopes: %w", err)
+	}
+	return oldValue.Scopes, nil
+}
+
+// AppendScopes adds s to the "scopes" field.
+func (m *OidcConsentMutation) AppendScopes(s []string) {
+	m.appendscopes = append(m.appendscopes, s...)
+}
+
+// AppendedScopes returns the list of values that were appended to the "scopes" field in this mutation.
+func (m *OidcConsentMutation) AppendedScopes() ([]string, bool) {
+	if len(m.appendscopes) == 0 {
+		return nil, false
+	}
+	return m.appendscopes, true
+}
+
+// ResetScopes resets all changes to the "scopes" field.
+func (m *OidcConsentMutation) ResetScopes() {
+	m.scopes = nil
+	m.appendscopes = nil
+}
+
+// Where appends a list predicates to the OidcConsentMutation builder.
+func (m *OidcConsentMutation) Where(ps ...predicate.OidcConsent) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the OidcConsentMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *OidcConsentMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.OidcConsent, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *OidcConsentMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *OidcConsentMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (OidcConsent).
+func (m *OidcConsentMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *OidcConsentMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.created_at != nil {
+		fields = append(fields, oidcconsent.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, oidcconsent.FieldUpdatedAt)
+	}
+	if m.user_id != nil {
+		fields = append(fields, oidcconsent.FieldUserID)
+	}
+	if m.client_id != nil {
+		fields = append(fields, oidcconsent.FieldClientID)
+	}
+	if m.scopes != nil {
+		fields = append(fields, oidcconsent.FieldScopes)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *OidcConsentMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case oidcconsent.FieldCreatedAt:
+		return m.CreatedAt()
+	case oidcconsent.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case oidcconsent.FieldUserID:
+		return m.UserID()
+	case oidcconsent.FieldClientID:
+		return m.ClientID()
+	case oidcconsent.FieldScopes:
+		return m.Scopes()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *OidcConsentMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case oidcconsent.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case oidcconsent.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case oidcconsent.FieldUserID:
+		return m.OldUserID(ctx)
+	case oidcconsent.FieldClientID:
+		return m.OldClientID(ctx)
+	case oidcconsent.FieldScopes:
+		return m.OldScopes(ctx)
+	}
+	return nil, fmt.Errorf("unknown OidcConsent field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OidcConsentMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case oidcconsent.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case oidcconsent.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case oidcconsent.FieldUserID:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case oidcconsent.FieldClientID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClientID(v)
+		return nil
+	case oidcconsent.FieldScopes:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScopes(v)
+		return nil
+	}
+	return fmt.Errorf("unknown OidcConsent field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *OidcConsentMutation) AddedFields() []string {
+	var fields []string
+	if m.adduser_id != nil {
+		fields = append(fields, oidcconsent.FieldUserID)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *OidcConsentMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case oidcconsent.FieldUserID:
+		return m.AddedUserID()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OidcConsentMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case oidcconsent.FieldUserID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUserID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown OidcConsent numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *OidcConsentMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *OidcConsentMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *OidcConsentMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown OidcConsent nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *OidcConsentMutation) ResetField(name string) error {
+	switch name {
+	case oidcconsent.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case oidcconsent.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case oidcconsent.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case oidcconsent.FieldClientID:
+		m.ResetClientID()
+		return nil
+	case oidcconsent.FieldScopes:
+		m.ResetScopes()
+		return nil
+	}
+	return fmt.Errorf("unknown OidcConsent field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *OidcConsentMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *OidcConsentMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *OidcConsentMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *OidcConsentMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *OidcConsentMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *OidcConsentMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *OidcConsentMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown OidcConsent unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *OidcConsentMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown OidcConsent edge %s", name)
+}
+
+// RefreshTokenMutation represents an operation that mutates the RefreshToken nodes in the graph.
+type RefreshTokenMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uint64
+	created_at    *time.Time
+	updated_at    *time.Time
+	user_id       *uint64
+	adduser_id    *int64
+	token_hash    *string
+	family        *string
+	expired_at    *time.Time
+	used_at       *time.Time
+	revoked_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*RefreshToken, error)
+	predicates    []predicate.RefreshToken
+}
+
+var _ ent.Mutation = (*RefreshTokenMutation)(nil)
+
+// refreshtokenOption allows management of the mutation configuration using functional options.
+type refreshtokenOption func(*RefreshTokenMutation)
+
+// newRefreshTokenMutation creates new mutation for the RefreshToken entity.
+func newRefreshTokenMutation(c config, op Op, opts ...refreshtokenOption) *RefreshTokenMutation {
+	m := &RefreshTokenMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRefreshToken,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRefreshTokenID sets the ID field of the mutation.
+func withRefreshTokenID(id uint64) refreshtokenOption {
+	return func(m *RefreshTokenMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *RefreshToken
+		)
+		m.oldValue = func(ctx context.Context) (*RefreshToken, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().RefreshToken.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRefreshToken sets the old RefreshToken of the mutation.
+func withRefreshToken(node *RefreshToken) refreshtokenOption {
+	return func(m *RefreshTokenMutation) {
+		m.oldValue = func(context.Context) (*RefreshToken, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RefreshTokenMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RefreshTokenMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of RefreshToken entities.
+func (m *RefreshTokenMutation) SetID(id uint64) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RefreshTokenMutation) ID() (id uint64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RefreshTokenMutation) IDs(ctx context.Context) ([]uint64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().RefreshToken.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *RefreshTokenMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *RefreshTokenMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefreshTokenMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *RefreshTokenMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *RefreshTokenMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *RefreshTokenMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefreshTokenMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *RefreshTokenMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *RefreshTokenMutation) SetUserID(u uint64) {
+	m.user_id = &u
+	m.adduser_id = nil
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *RefreshTokenMutation) UserID() (r uint64, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefreshTokenMutation) OldUserID(ctx context.Context) (v uint64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// AddUserID adds u to the "user_id" field.
+func (m *RefreshTokenMutation) AddUserID(u int64) {
+	if m.adduser_id != nil {
+		*m.adduser_id += u
+	} else {
+		m.adduser_id = &u
+	}
+}
+
+// AddedUserID returns the value that was added to the "user_id" field in this mutation.
+func (m *RefreshTokenMutation) AddedUserID() (r int64, exists bool) {
+	v := m.adduser_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *RefreshTokenMutation) ResetUserID() {
+	m.user_id = nil
+	m.adduser_id = nil
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (m *RefreshTokenMutation) SetTokenHash(s string) {
+	m.token_hash = &s
+}
+
+// TokenHash returns the value of the "token_hash" field in the mutation.
+func (m *RefreshTokenMutation) TokenHash() (r string, exists bool) {
+	v := m.token_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTokenHash returns the old "token_hash" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefreshTokenMutation) OldTokenHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTokenHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTokenHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTokenHash: %w", err)
+	}
+	return oldValue.TokenHash, nil
+}
+
+// ResetTokenHash resets all changes to the "token_hash" field.
+func (m *RefreshTokenMutation) ResetTokenHash() {
+	m.token_hash = nil
+}
+
+// SetFamily sets the "family" field.
+func (m *RefreshTokenMutation) SetFamily(s string) {
+	m.family = &s
+}
+
+// Family returns the value of the "family" field in the mutation.
+func (m *RefreshTokenMutation) Family() (r string, exists bool) {
+	v := m.family
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFamily returns the old "family" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefreshTokenMutation) OldFamily(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFamily is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFamily requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFamily: %w", err)
+	}
+	return oldValue.Family, nil
+}
+
+// ResetFamily resets all changes to the "family" field.
+func (m *RefreshTokenMutation) ResetFamily() {
+	m.family = nil
+}
+
+// SetExpiredAt sets the "expired_at" field.
+func (m *RefreshTokenMutation) SetExpiredAt(t time.Time) {
+	m.expired_at = &t
+}
+
+// ExpiredAt returns the value of the "expired_at" field in the mutation.
+func (m *RefreshTokenMutation) ExpiredAt() (r time.Time, exists bool) {
+	v := m.expired_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiredAt returns the old "expired_at" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefreshTokenMutation) OldExpiredAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiredAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiredAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiredAt: %w", err)
+	}
+	return oldValue.ExpiredAt, nil
+}
+
+// ResetExpiredAt resets all changes to the "expired_at" field.
+func (m *RefreshTokenMutation) ResetExpiredAt() {
+	m.expired_at = nil
+}
+
+// SetUsedAt sets the "used_at" field.
+func (m *RefreshTokenMutation) SetUsedAt(t time.Time) {
+	m.used_at = &t
+}
+
+// UsedAt returns the value of the "used_at" field in the mutation.
+func (m *RefreshTokenMutation) UsedAt() (r time.Time, exists bool) {
+	v := m.used_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUsedAt returns the old "used_at" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefreshTokenMutation) OldUsedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUsedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUsedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUsedAt: %w", err)
+	}
+	return oldValue.UsedAt, nil
+}
+
+// ClearUsedAt clears the value of the "used_at" field.
+func (m *RefreshTokenMutation) ClearUsedAt() {
+	m.used_at = nil
+	m.clearedFields[refreshtoken.FieldUsedAt] = struct{}{}
+}
+
+// UsedAtCleared returns if the "used_at" field was cleared in this mutation.
+func (m *RefreshTokenMutation) UsedAtCleared() bool {
+	_, ok := m.clearedFields[refreshtoken.FieldUsedAt]
+	return ok
+}
+
+// ResetUsedAt resets all changes to the "used_at" field.
+func (m *RefreshTokenMutation) ResetUsedAt() {
+	m.used_at = nil
+	delete(m.clearedFields, refreshtoken.FieldUsedAt)
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (m *RefreshTokenMutation) SetRevokedAt(t time.Time) {
+	m.revoked_at = &t
+}
+
+// RevokedAt returns the value of the "revoked_at" field in the mutation.
+func (m *RefreshTokenMutation) RevokedAt() (r time.Time, exists bool) {
+	v := m.revoked_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRevokedAt returns the old "revoked_at" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefreshTokenMutation) OldRevokedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRevokedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRevokedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRevokedAt: %w", err)
+	}
+	return oldValue.RevokedAt, nil
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (m *RefreshTokenMutation) ClearRevokedAt() {
+	m.revoked_at = nil
+	m.clearedFields[refreshtoken.FieldRevokedAt] = struct{}{}
+}
+
+// RevokedAtCleared returns if the "revoked_at" field was cleared in this mutation.
+func (m *RefreshTokenMutation) RevokedAtCleared() bool {
+	_, ok := m.clearedFields[refreshtoken.FieldRevokedAt]
+	return ok
+}
+
+// ResetRevokedAt resets all changes to the "revoked_at" field.
+func (m *RefreshTokenMutation) ResetRevokedAt() {
+	m.revoked_at = nil
+	delete(m.clearedFields, refreshtoken.FieldRevokedAt)
+}
+
+// Where appends a list predicates to the RefreshTokenMutation builder.
+func (m *RefreshTokenMutation) Where(ps ...predicate.RefreshToken) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RefreshTokenMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RefreshTokenMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.RefreshToken, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RefreshTokenMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RefreshTokenMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (RefreshToken).
+func (m *RefreshTokenMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RefreshTokenMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.created_at != nil {
+		fields = append(fields, refreshtoken.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, refreshtoken.FieldUpdatedAt)
+	}
+	if m.user_id != nil {
+		fields = append(fields, refreshtoken.FieldUserID)
+	}
+	if m.token_hash != nil {
+		fields = append(fields, refreshtoken.FieldTokenHash)
+	}
+	if m.family != nil {
+		fields = append(fields, refreshtoken.FieldFamily)
+	}
+	if m.expired_at != nil {
+		fields = append(fields, refreshtoken.FieldExpiredAt)
+	}
+	if m.used_at != nil {
+		fields = append(fields, refreshtoken.FieldUsedAt)
+	}
+	if m.revoked_at != nil {
+		fields = append(fields, refreshtoken.FieldRevokedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RefreshTokenMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case refreshtoken.FieldCreatedAt:
+		return m.CreatedAt()
+	case refreshtoken.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case refreshtoken.FieldUserID:
+		return m.UserID()
+	case refreshtoken.FieldTokenHash:
+		return m.TokenHash()
+	case refreshtoken.FieldFamily:
+		return m.Family()
+	case refreshtoken.FieldExpiredAt:
+		return m.ExpiredAt()
+	case refreshtoken.FieldUsedAt:
+		return m.UsedAt()
+	case refreshtoken.FieldRevokedAt:
+		return m.RevokedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RefreshTokenMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case refreshtoken.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case refreshtoken.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case refreshtoken.FieldUserID:
+		return m.OldUserID(ctx)
+	case refreshtoken.FieldTokenHash:
+		return m.OldTokenHash(ctx)
+	case refreshtoken.FieldFamily:
+		return m.OldFamily(ctx)
+	case refreshtoken.FieldExpiredAt:
+		return m.OldExpiredAt(ctx)
+	case refreshtoken.FieldUsedAt:
+		return m.OldUsedAt(ctx)
+	case refreshtoken.FieldRevokedAt:
+		return m.OldRevokedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown RefreshToken field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RefreshTokenMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case refreshtoken.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case refreshtoken.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case refreshtoken.FieldUserID:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case refreshtoken.FieldTokenHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTokenHash(v)
+		return nil
+	case refreshtoken.FieldFamily:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFamily(v)
+		return nil
+	case refreshtoken.FieldExpiredAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiredAt(v)
+		return nil
+	case refreshtoken.FieldUsedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUsedAt(v)
+		return nil
+	case refreshtoken.FieldRevokedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRevokedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RefreshToken field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RefreshTokenMutation) AddedFields() []string {
+	var fields []string
+	if m.adduser_id != nil {
+		fields = append(fields, refreshtoken.FieldUserID)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RefreshTokenMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case refreshtoken.FieldUserID:
+		return m.AddedUserID()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RefreshTokenMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case refreshtoken.FieldUserID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUserID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RefreshToken numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RefreshTokenMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(refreshtoken.FieldUsedAt) {
+		fields = append(fields, refreshtoken.FieldUsedAt)
+	}
+	if m.FieldCleared(refreshtoken.FieldRevokedAt) {
+		fields = append(fields, refreshtoken.FieldRevokedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RefreshTokenMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RefreshTokenMutation) ClearField(name string) error {
+	switch name {
+	case refreshtoken.FieldUsedAt:
+		m.ClearUsedAt()
+		return nil
+	case refreshtoken.FieldRevokedAt:
+		m.ClearRevokedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown RefreshToken nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RefreshTokenMutation) ResetField(name string) error {
+	switch name {
+	case refreshtoken.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case refreshtoken.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case refreshtoken.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case refreshtoken.FieldTokenHash:
+		m.ResetTokenHash()
+		return nil
+	case refreshtoken.FieldFamily:
+		m.ResetFamily()
+		return nil
+	case refreshtoken.FieldExpiredAt:
+		m.ResetExpiredAt()
+		return nil
+	case refreshtoken.FieldUsedAt:
+		m.ResetUsedAt()
+		return nil
+	case refreshtoken.FieldRevokedAt:
+		m.ResetRevokedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown RefreshToken field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RefreshTokenMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RefreshTokenMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RefreshTokenMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RefreshTokenMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RefreshTokenMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RefreshTokenMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RefreshTokenMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown RefreshToken unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RefreshTokenMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown RefreshToken edge %s", name)
+}
+
+// RoleMutation represents an operation that mutates the Role nodes in the graph.
+type RoleMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *uint64
+	created_at       *time.Time
+	updated_at       *time.Time
+	status           *uint8
+	addstatus        *int8
+	tenant_id        *uint64
+	addtenant_id     *int64
+	name             *string
+	value            *string
+	default_router   *string
+	remark           *string
+	order_no         *uint32
+	addorder_no      *int32
+	force_two_factor *bool
+	clearedFields    map[string]struct{}
+	menus            map[uint64]struct{}
+	removedmenus     map[uint64]struct{}
+	clearedmenus     bool
+	users            map[uint64]struct{}
+	removedusers     map[uint64]struct{}
+	clearedusers     bool
+	parent           *uint64
+	clearedparent    bool
+	children         map[uint64]struct{}
+	removedchildren  map[uint64]struct{}
+	clearedchildren  bool
+	done             bool
+	oldValue         func(context.Context) (*Role, error)
+	predicates       []predicate.Role
+}
+
+var _ ent.Mutation = (*RoleMutation)(nil)
+
+// roleOption allows management of the mutation configuration using functional options.
+type roleOption func(*RoleMutation)
+
+// newRoleMutation creates new mutation for the Role entity.
+func newRoleMutation(c config, op Op, opts ...roleOption) *RoleMutation {
+	m := &RoleMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRole,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRoleID sets the ID field of the mutation.
+func withRoleID(id uint64) roleOption {
+	return func(m *RoleMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Role
+		)
+		m.oldValue = func(ctx context.Context) (*Role, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Role.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRole sets the old Role of the mutation.
+func withRole(node *Role) roleOption {
+	return func(m *RoleMutation) {
+		m.oldValue = func(context.Context) (*Role, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RoleMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RoleMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Role entities.
+func (m *RoleMutation) SetID(id uint64) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RoleMutation) ID() (id uint64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RoleMutation) IDs(ctx context.Context) ([]uint64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Role.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *RoleMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *RoleMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Role entity.
+// If the Role object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *RoleMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *RoleMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *RoleMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Role entity.
+// If the Role object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *RoleMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *RoleMutation) SetStatus(u uint8) {
+	m.status = &u
+	m.addstatus = nil
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *RoleMutation) Status() (r uint8, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Role entity.
+// If the Role object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleMutation) OldStatus(ctx context.Context) (v uint8, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// AddStatus adds u to the "status" field.
+func (m *RoleMutation) AddStatus(u int8) {
+	if m.addstatus != nil {
+		*m.addstatus += u
+	} else {
+		m.addstatus = &u
+	}
+}
+
+// AddedStatus returns the value that was added to the "status" field in this mutation.
+func (m *RoleMutation) AddedStatus() (r int8, exists bool) {
+	v := m.addstatus
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearStatus clears the value of the "status" field.
+func (m *RoleMutation) ClearStatus() {
+	m.status = nil
+	m.addstatus = nil
+	m.clearedFields[role.FieldStatus] = struct{}{}
+}
+
+// StatusCleared returns if the "status" field was cleared in this mutation.
+func (m *RoleMutation) StatusCleared() bool {
+	_, ok := m.clearedFields[role.FieldStatus]
+	return ok
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *RoleMutation) ResetStatus() {
+	m.status = nil
+	m.addstatus = nil
+	delete(m.clearedFields, role.FieldStatus)
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (m *RoleMutation) SetTenantID(u uint64) {
+	m.tenant_id = &u
+	m.addtenant_id = nil
+}
+
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *RoleMutation) TenantID() (r uint64, exists bool) {
+	v := m.tenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenantID returns the old "tenant_id" field's value of the Role entity.
+// If the Role object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleMutation) OldTenantID(ctx context.Context) (v uint64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+	}
+	return oldValue.TenantID, nil
+}
+
+// AddTenantID adds u to the "tenant_id" field.
+func (m *RoleMutation) AddTenantID(u int64) {
+	if m.addtenant_id != nil {
+		*m.addtenant_id += u
+	} else {
+		m.addtenant_id = &u
+	}
+}
+
+// AddedTenantID returns the value that was added to the "tenant_id" field in this mutation.
+func (m *RoleMutation) AddedTenantID() (r int64, exists bool) {
+	v := m.addtenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *RoleMutation) ResetTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+}
+
+// SetName sets the "name" field.
+func (m *RoleMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *RoleMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Role entity.
+// If the Role object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *RoleMutation) ResetName() {
+	m.name = nil
+}
+
+// SetValue sets the "value" field.
+func (m *RoleMutation) SetValue(s string) {
+	m.value = &s
+}
+
+// Value returns the value of the "value" field in the mutation.
+func (m *RoleMutation) Value() (r string, exists bool) {
+	v := m.value
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldValue returns the old "value" field's value of the Role entity.
+// If the Role object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleMutation) OldValue(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValue is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValue requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValue: %w", err)
+	}
+	return oldValue.Value, nil
+}
+
+// ResetValue resets all changes to the "value" field.
+func (m *RoleMutation) ResetValue() {
+	m.value = nil
+}
+
+// SetDefaultRouter sets the "default_router" field.
+func (m *RoleMutation) SetDefaultRouter(s string) {
+	m.default_router = &s
+}
+
+// DefaultRouter returns the value of the "default_router" field in the mutation.
+func (m *RoleMutation) DefaultRouter() (r string, exists bool) {
+	v := m.default_router
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDefaultRouter returns the old "default_router" field's value of the Role entity.
+// If the Role object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleMutation) OldDefaultRouter(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDefaultRouter is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDefaultRouter requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDefaultRouter: %w", err)
+	}
+	return oldValue.DefaultRouter, nil
+}
+
+// ResetDefaultRouter resets all changes to the "default_router" field.
+func (m *RoleMutation) ResetDefaultRouter() {
+	m.default_router = nil
+}
+
+// SetRemark sets the "remark" field.
+func (m *RoleMutation) SetRemark(s string) {
+	m.remark = &s
+}
+
+// Remark returns the value of the "remark" field in the mutation.
+func (m *RoleMutation) Remark() (r string, exists bool) {
+	v := m.remark
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRemark returns the old "remark" field's value of the Role entity.
+// If the Role object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleMutation) OldRemark(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRemark is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRemark requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRemark: %w", err)
+	}
+	return oldValue.Remark, nil
+}
+
+// ResetRemark resets all changes to the "remark" field.
+func (m *RoleMutation) ResetRemark() {
+	m.remark = nil
+}
+
+// SetOrderNo sets the "order_no" field.
+func (m *RoleMutation) SetOrderNo(u uint32) {
+	m.order_no = &u
+	m.addorder_no = nil
+}
+
+// OrderNo returns the value of the "order_no" field in the mutation.
+func (m *RoleMutation) OrderNo() (r uint32, exists bool) {
+	v := m.order_no
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOrderNo returns the old "order_no" field's value of the Role entity.
+// If the Role object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleMutation) OldOrderNo(ctx context.Context) (v uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOrderNo is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOrderNo requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOrderNo: %w", err)
+	}
+	return oldValue.OrderNo, nil
+}
+
+// AddOrderNo adds u to the "order_no" field.
+func (m *RoleMutation) AddOrderNo(u int32) {
+	if m.addorder_no != nil {
+		*m.addorder_no += u
+	} else {
+		m.addorder_no = &u
+	}
+}
+
+// AddedOrderNo returns the value that was added to the "order_no" field in this mutation.
+func (m *RoleMutation) AddedOrderNo() (r int32, exists bool) {
+	v := m.addorder_no
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetOrderNo resets all changes to the "order_no" field.
+func (m *RoleMutation) ResetOrderNo() {
+	m.order_no = nil
+	m.addorder_no = nil
+}
+
+// SetForceTwoFactor sets the "force_two_factor" field.
+func (m *RoleMutation) SetForceTwoFactor(b bool) {
+	m.force_two_factor = &b
+}
+
+// ForceTwoFactor returns the value of the "force_two_factor" field in the mutation.
+func (m *RoleMutation) ForceTwoFactor() (r bool, exists bool) {
+	v := m.force_two_factor
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldForceTwoFactor returns the old "force_two_factor" field's value of the Role entity.
+// If the Role object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleMutation) OldForceTwoFactor(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldForceTwoFactor is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldForceTwoFactor requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldForceTwoFactor: %w", err)
+	}
+	return oldValue.ForceTwoFactor, nil
+}
+
+// ResetForceTwoFactor resets all changes to the "force_two_factor" field.
+func (m *RoleMutation) ResetForceTwoFactor() {
+	m.force_two_factor = nil
+}
+
+// SetParentID sets the "parent_id" field.
+func (m *RoleMutation) SetParentID(u uint64) {
+	m.parent = &u
+}
+
+// ParentID returns the value of the "parent_id" field in the mutation.
+func (m *RoleMutation) ParentID() (r uint64, exists bool) {
+	v := m.parent
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldParentID returns the old "parent_id" field's value of the Role entity.
+// If the Role object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleMutation) OldParentID(ctx context.Context) (v uint64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldParentID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldParentID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldParentID: %w", err)
+	}
+	return oldValue.ParentID, nil
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (m *RoleMutation) ClearParentID() {
+	m.parent = nil
+	m.clearedFields[role.FieldParentID] = struct{}{}
+}
+
+// ParentIDCleared returns if the "parent_id" field was cleared in this mutation.
+func (m *RoleMutation) ParentIDCleared() bool {
+	_, ok := m.clearedFields[role.FieldParentID]
+	return ok
+}
+
+// ResetParentID resets all changes to the "parent_id" field.
+func (m *RoleMutation) ResetParentID() {
+	m.parent = nil
+	delete(m.clearedFields, role.FieldParentID)
+}
+
+// AddMenuIDs adds the "menus" edge to the Menu entity by ids.
+func (m *RoleMutation) AddMenuIDs(ids ...uint64) {
+	if m.menus == nil {
+		m.menus = make(map[uint64]struct{})
+	}
+	for i := range ids {
+		m.menus[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMenus clears the "menus" edge to the Menu entity.
+func (m *RoleMutation) ClearMenus() {
+	m.clearedmenus = true
+}
+
+// MenusCleared reports if the "menus" edge to the Menu entity was cleared.
+func (m *RoleMutation) MenusCleared() bool {
+	return m.clearedmenus
+}
+
+// RemoveMenuIDs removes the "menus" edge to the Menu entity by IDs.
+func (m *RoleMutation) RemoveMenuIDs(ids ...uint64) {
+	if m.removedmenus == nil {
+		m.removedmenus = make(map[uint64]struct{})
+	}
+	for i := range ids {
+		delete(m.menus, ids[i])
+		m.removedmenus[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMenus returns the removed IDs of the "menus" edge to the Menu entity.
+func (m *RoleMutation) RemovedMenusIDs() (ids []uint64) {
+	for id := range m.removedmenus {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MenusIDs returns the "menus" edge IDs in the mutation.
+func (m *RoleMutation) MenusIDs() (ids []uint64) {
+	for id := range m.menus {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMenus resets all changes to the "menus" edge.
+func (m *RoleMutation) ResetMenus() {
+	m.menus = nil
+	m.clearedmenus = false
+	m.removedmenus = nil
+}
+
+// AddUserIDs adds the "users" edge to the User entity by ids.
+func (m *RoleMutation) AddUserIDs(ids ...uint64) {
+	if m.users == nil {
+		m.users = make(map[uint64]struct{})
+	}
+	for i := range ids {
+		m.users[ids[i]] = struct{}{}
+	}
+}
+
+// ClearUsers clears the "users" edge to the User entity.
+func (m *RoleMutation) ClearUsers() {
+	m.clearedusers = true
+}
+
+// UsersCleared reports if the "users" edge to the User entity was cleared.
+func (m *RoleMutation) UsersCleared() bool {
+	return m.clearedusers
+}
+
+// RemoveUserIDs removes the "users" edge to the User entity by IDs.
+func (m *RoleMutation) RemoveUserIDs(ids ...uint64) {
+	if m.removedusers == nil {
+		m.removedusers = make(map[uint64]struct{})
+	}
+	for i := range ids {
+		delete(m.users, ids[i])
+		m.removedusers[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedUsers returns the removed IDs of the "users" edge to the User entity.
+func (m *RoleMutation) RemovedUsersIDs() (ids []uint64) {
+	for id := range m.removedusers {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// UsersIDs returns the "users" edge IDs in the mutation.
+func (m *RoleMutation) UsersIDs() (ids []uint64) {
+	for id := range m.users {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetUsers resets all changes to the "users" edge.
+func (m *RoleMutation) ResetUsers() {
+	m.users = nil
+	m.clearedusers = false
+	m.removedusers = nil
+}
+
+// ClearParent clears the "parent" edge to the Role entity.
+func (m *RoleMutation) ClearParent() {
+	m.clearedparent = true
+	m.clearedFields[role.FieldParentID] = struct{}{}
+}
+
+// ParentCleared reports if the "parent" edge to the Role entity was cleared.
+func (m *RoleMutation) ParentCleared() bool {
+	return m.ParentIDCleared() || m.clearedparent
+}
+
+// ParentIDs returns the "parent" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ParentID instead. It exists only for internal usage by the builders.
+func (m *RoleMutation) ParentIDs() (ids []uint64) {
+	if id := m.parent; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetParent resets all changes to the "parent" edge.
+func (m *RoleMutation) ResetParent() {
+	m.parent = nil
+	m.clearedparent = false
+}
+
+// AddChildIDs adds the "children" edge to the Role entity by ids.
+func (m *RoleMutation) AddChildIDs(ids ...uint64) {
+	if m.children == nil {
+		m.children = make(map[uint64]struct{})
+	}
+	for i := range ids {
+		m.children[ids[i]] = struct{}{}
+	}
+}
+
+// ClearChildren clears the "children" edge to the Role entity.
+func (m *RoleMutation) ClearChildren() {
+	m.clearedchildren = true
+}
+
+// ChildrenCleared reports if the "children" edge to the Role entity was cleared.
+func (m *RoleMutation) ChildrenCleared() bool {
+	return m.clearedchildren
+}
+
+// RemoveChildIDs removes the "children" edge to the Role entity by IDs.
+func (m *RoleMutation) RemoveChildIDs(ids ...uint64) {
+	if m.removedchildren == nil {
+		m.removedchildren = make(map[uint64]struct{})
+	}
+	for i := range ids {
+		delete(m.children, ids[i])
+		m.removedchildren[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedChildren returns the removed IDs of the "children" edge to the Role entity.
+func (m *RoleMutation) RemovedChildrenIDs() (ids []uint64) {
+	for id := range m.removedchildren {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ChildrenIDs returns the "children" edge IDs in the mutation.
+func (m *RoleMutation) ChildrenIDs() (ids []uint64) {
+	for id := range m.children {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetChildren resets all changes to the "children" edge.
+func (m *RoleMutation) ResetChildren() {
+	m.children = nil
+	m.clearedchildren = false
+	m.removedchildren = nil
+}
+
+// Where appends a list predicates to the RoleMutation builder.
+func (m *RoleMutation) Where(ps ...predicate.Role) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RoleMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RoleMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Role, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RoleMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RoleMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Role).
+func (m *RoleMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RoleMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.created_at != nil {
+		fields = append(fields, role.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, role.FieldUpdatedAt)
+	}
+	if m.status != nil {
+		fields = append(fields, role.FieldStatus)
+	}
+	if m.tenant_id != nil {
+		fields = append(fields, role.FieldTenantID)
+	}
+	if m.name != nil {
+		fields = append(fields, role.FieldName)
+	}
+	if m.value != nil {
+		fields = append(fields, role.FieldValue)
+	}
+	if m.default_router != nil {
+		fields = append(fields, role.FieldDefaultRouter)
+	}
+	if m.remark != nil {
+		fields = append(fields, role.FieldRemark)
+	}
+	if m.order_no != nil {
+		fields = append(fields, role.FieldOrderNo)
+	}
+	if m.force_two_factor != nil {
+		fields = append(fields, role.FieldForceTwoFactor)
+	}
+	if m.parent != nil {
+		fields = append(fields, role.FieldParentID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RoleMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case role.FieldCreatedAt:
+		return m.CreatedAt()
+	case role.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case role.FieldStatus:
+		return m.Status()
+	case role.FieldTenantID:
+		return m.TenantID()
+	case role.FieldName:
+		return m.Name()
+	case role.FieldValue:
+		return m.Value()
+	case role.FieldDefaultRouter:
+		return m.DefaultRouter()
+	case role.FieldRemark:
+		return m.Remark()
+	case role.FieldOrderNo:
+		return m.OrderNo()
+	case role.FieldForceTwoFactor:
+		return m.ForceTwoFactor()
+	case role.FieldParentID:
+		return m.ParentID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RoleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case role.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case role.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case role.FieldStatus:
+		return m.OldStatus(ctx)
+	case role.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case role.FieldName:
+		return m.OldName(ctx)
+	case role.FieldValue:
+		return m.OldValue(ctx)
+	case role.FieldDefaultRouter:
+		return m.OldDefaultRouter(ctx)
+	case role.FieldRemark:
+		return m.OldRemark(ctx)
+	case role.FieldOrderNo:
+		return m.OldOrderNo(ctx)
+	case role.FieldForceTwoFactor:
+		return m.OldForceTwoFactor(ctx)
+	case role.FieldParentID:
+		return m.OldParentID(ctx)
+	}
+	return nil, fmt.Errorf("unknown Role field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RoleMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case role.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case role.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case role.FieldStatus:
+		v, ok := value.(uint8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case role.FieldTenantID:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case role.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case role.FieldValue:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValue(v)
+		return nil
+	case role.FieldDefaultRouter:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDefaultRouter(v)
+		return nil
+	case role.FieldRemark:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRemark(v)
+		return nil
+	case role.FieldOrderNo:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOrderNo(v)
+		return nil
+	case role.FieldForceTwoFactor:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetForceTwoFactor(v)
+		return nil
+	case role.FieldParentID:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetParentID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Role field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RoleMutation) AddedFields() []string {
+	var fields []string
+	if m.addstatus != nil {
+		fields = append(fields, role.FieldStatus)
+	}
+	if m.addtenant_id != nil {
+		fields = append(fields, role.FieldTenantID)
+	}
+	if m.addorder_no != nil {
+		fields = append(fields, role.FieldOrderNo)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RoleMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case role.FieldStatus:
+		return m.AddedStatus()
+	case role.FieldTenantID:
+		return m.AddedTenantID()
+	case role.FieldOrderNo:
+		return m.AddedOrderNo()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RoleMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case role.FieldStatus:
+		v, ok := value.(int8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStatus(v)
+		return nil
+	case role.FieldTenantID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTenantID(v)
+		return nil
+	case role.FieldOrderNo:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddOrderNo(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Role numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RoleMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(role.FieldStatus) {
+		fields = append(fields, role.FieldStatus)
+	}
+	if m.FieldCleared(role.FieldParentID) {
+		fields = append(fields, role.FieldParentID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RoleMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RoleMutation) ClearField(name string) error {
+	switch name {
+	case role.FieldStatus:
+		m.ClearStatus()
+		return nil
+	case role.FieldParentID:
+		m.ClearParentID()
+		return nil
+	}
+	return fmt.Errorf("unknown Role nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RoleMutation) ResetField(name string) error {
+	switch name {
+	case role.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case role.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case role.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case role.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case role.FieldName:
+		m.ResetName()
+		return nil
+	case role.FieldValue:
+		m.ResetValue()
+		return nil
+	case role.FieldDefaultRouter:
+		m.ResetDefaultRouter()
+		return nil
+	case role.FieldRemark:
+		m.ResetRemark()
+		return nil
+	case role.FieldOrderNo:
+		m.ResetOrderNo()
+		return nil
+	case role.FieldForceTwoFactor:
+		m.ResetForceTwoFactor()
+		return nil
+	case role.FieldParentID:
+		m.ResetParentID()
+		return nil
+	}
+	return fmt.Errorf("unknown Role field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RoleMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.menus != nil {
+		edges = append(edges, role.EdgeMenus)
+	}
+	if m.users != nil {
+		edges = append(edges, role.EdgeUsers)
+	}
+	if m.parent != nil {
+		edges = append(edges, role.EdgeParent)
+	}
+	if m.children != nil {
+		edges = append(edges, role.EdgeChildren)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RoleMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case role.EdgeMenus:
+		ids := make([]ent.Value, 0, len(m.menus))
+		for id := range m.menus {
+			ids = append(ids, id)
+		}
+		return ids
+	case role.EdgeUsers:
+		ids := make([]ent.Value, 0, len(m.users))
+		for id := range m.users {
+			ids = append(ids, id)
+		}
+		return ids
+	case role.EdgeParent:
+		if id := m.parent; id != nil {
+			return []ent.Value{*id}
+		}
+	case role.EdgeChildren:
+		ids := make([]ent.Value, 0, len(m.children))
+		for id := range m.children {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RoleMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.removedmenus != nil {
+		edges = append(edges, role.EdgeMenus)
+	}
+	if m.removedusers != nil {
+		edges = append(edges, role.EdgeUsers)
+	}
+	if m.removedchildren != nil {
+		edges = append(edges, role.EdgeChildren)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RoleMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case role.EdgeMenus:
+		ids := make([]ent.Value, 0, len(m.removedmenus))
+		for id := range m.removedmenus {
+			ids = append(ids, id)
+		}
+		return ids
+	case role.EdgeUsers:
+		ids := make([]ent.Value, 0, len(m.removedusers))
+		for id := range m.removedusers {
+			ids = append(ids, id)
+		}
+		return ids
+	case role.EdgeChildren:
+		ids := make([]ent.Value, 0, len(m.removedchildren))
+		for id := range m.removedchildren {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RoleMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.clearedmenus {
+		edges = append(edges, role.EdgeMenus)
+	}
+	if m.clearedusers {
+		edges = append(edges, role.EdgeUsers)
+	}
+	if m.clearedparent {
+		edges = append(edges, role.EdgeParent)
+	}
+	if m.clearedchildren {
+		edges = append(edges, role.EdgeChildren)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RoleMutation) EdgeCleared(name string) bool {
+	switch name {
+	case role.EdgeMenus:
+		return m.clearedmenus
+	case role.EdgeUsers:
+		return m.clearedusers
+	case role.EdgeParent:
+		return m.clearedparent
+	case role.EdgeChildren:
+		return m.clearedchildren
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RoleMutation) ClearEdge(name string) error {
+	switch name {
+	case role.EdgeParent:
+		m.ClearParent()
+		return nil
+	}
+	return fmt.Errorf("unknown Role unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RoleMutation) ResetEdge(name string) error {
+	switch name {
+	case role.EdgeMenus:
+		m.ResetMenus()
+		return nil
+	case role.EdgeUsers:
+		m.ResetUsers()
+		return nil
+	case role.EdgeParent:
+		m.ResetParent()
+		return nil
+	case role.EdgeChildren:
+		m.ResetChildren()
+		return nil
+	}
+	return fmt.Errorf("unknown Role edge %s", name)
+}
+
+// SamlProviderMutation represents an operation that mutates the SamlProvider nodes in the graph.
+type SamlProviderMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *uint64
+	created_at          *time.Time
+	updated_at          *time.Time
+	name                *string
+	entity_id           *string
+	idp_metadata_url    *string
+	idp_metadata_xml    *string
+	attribute_mapping   *map[string]string
+	allow_idp_initiated *bool
+	auto_provision      *bool
+	provision_approval  *bool
+	default_role_id     *uint64
+	adddefault_role_id  *int64
+	role_rules          *[]rolerule.Rule
+	appendrole_rules    []rolerule.Rule
+	clearedFields       map[string]struct{}
+	done                bool
+	oldValue            func(context.Context) (*SamlProvider, error)
+	predicates          []predicate.SamlProvider
+}
+
+var _ ent.Mutation = (*SamlProviderMutation)(nil)
+
+// samlproviderOption allows management of the mutation configuration using functional options.
+type samlproviderOption func(*SamlProviderMutation)
+
+// newSamlProviderMutation creates new mutation for the SamlProvider entity.
+func newSamlProviderMutation(c config, op Op, opts ...samlproviderOption) *SamlProviderMutation {
+	m := &SamlProviderMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSamlProvider,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSamlProviderID sets the ID field of the mutation.
+func withSamlProviderID(id uint64) samlproviderOption {
+	return func(m *SamlProviderMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *SamlProvider
+		)
+		m.oldValue = func(ctx context.Context) (*SamlProvider, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().SamlProvider.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSamlProvider sets the old SamlProvider of the mutation.
+func withSamlProvider(node *SamlProvider) samlproviderOption {
+	return func(m *SamlProviderMutation) {
+		m.oldValue = func(context.Context) (*SamlProvider, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SamlProviderMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SamlProviderMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of SamlProvider entities.
+func (m *SamlProviderMutation) SetID(id uint64) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SamlProviderMutation) ID() (id uint64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SamlProviderMutation) IDs(ctx context.Context) ([]uint64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().SamlProvider.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *SamlProviderMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *SamlProviderMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the SamlProvider entity.
+// If the SamlProvider object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SamlProviderMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *SamlProviderMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *SamlProviderMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *SamlProviderMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the SamlProvider entity.
+// If the SamlProvider object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SamlProviderMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *SamlProviderMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetName sets the "name" field.
+func (m *SamlProviderMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *SamlProviderMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the SamlProvider entity.
+// If the SamlProvider object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SamlProviderMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *SamlProviderMutation) ResetName() {
+	m.name = nil
+}
+
+// SetEntityID sets the "entity_id" field.
+func (m *SamlProviderMutation) SetEntityID(s string) {
+	m.entity_id = &s
+}
+
+// EntityID returns the value of the "entity_id" field in the mutation.
+func (m *SamlProviderMutation) EntityID() (r string, exists bool) {
+	v := m.entity_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEntityID returns the old "entity_id" field's value of the SamlProvider entity.
+// If the SamlProvider object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SamlProviderMutation) OldEntityID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEntityID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEntityID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEntityID: %w", err)
+	}
+	return oldValue.EntityID, nil
+}
+
+// ClearEntityID clears the value of the "entity_id" field.
+func (m *SamlProviderMutation) ClearEntityID() {
+	m.entity_id = nil
+	m.clearedFields[samlprovider.FieldEntityID] = struct{}{}
+}
+
+// EntityIDCleared returns if the "entity_id" field was cleared in this mutation.
+func (m *SamlProviderMutation) EntityIDCleared() bool {
+	_, ok := m.clearedFields[samlprovider.FieldEntityID]
+	return ok
+}
+
+// ResetEntityID resets all changes to the "entity_id" field.
+func (m *SamlProviderMutation) ResetEntityID() {
+	m.entity_id = nil
+	delete(m.clearedFields, samlprovider.FieldEntityID)
+}
+
+// SetIdpMetadataURL sets the "idp_metadata_url" field.
+func (m *SamlProviderMutation) SetIdpMetadataURL(s string) {
+	m.idp_metadata_url = &s
+}
+
+// IdpMetadataURL returns the value of the "idp_metadata_url" field in the mutation.
+func (m *SamlProviderMutation) IdpMetadataURL() (r string, exists bool) {
+	v := m.idp_metadata_url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIdpMetadataURL returns the old "idp_metadata_url" field's value of the SamlProvider entity.
+// If the SamlProvider object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SamlProviderMutation) OldIdpMetadataURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIdpMetadataURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIdpMetadataURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIdpMetadataURL: %w", err)
+	}
+	return oldValue.IdpMetadataURL, nil
+}
+
+// ClearIdpMetadataURL clears the value of the "idp_metadata_url" field.
+func (m *SamlProviderMutation) ClearIdpMetadataURL() {
+	m.idp_metadata_url = nil
+	m.clearedFields[samlprovider.FieldIdpMetadataURL] = struct{}{}
+}
+
+// IdpMetadataURLCleared returns if the "idp_metadata_url" field was cleared in this mutation.
+func (m *SamlProviderMutation) IdpMetadataURLCleared() bool {
+	_, ok := m.clearedFields[samlprovider.FieldIdpMetadataURL]
+	return ok
+}
+
+// ResetIdpMetadataURL resets all changes to the "idp_metadata_url" field.
+func (m *SamlProviderMutation) ResetIdpMetadataURL() {
+	m.idp_metadata_url = nil
+	delete(m.clearedFields, samlprovider.FieldIdpMetadataURL)
+}
+
+// SetIdpMetadataXML sets the "idp_metadata_xml" field.
+func (m *SamlProviderMutation) SetIdpMetadataXML(s string) {
+	m.idp_metadata_xml = &s
+}
+
+// IdpMetadataXML returns the value of the "idp_metadata_xml" field in the mutation.
+func (m *SamlProviderMutation) IdpMetadataXML() (r string, exists bool) {
+	v := m.idp_metadata_xml
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIdpMetadataXML returns the old "idp_metadata_xml" field's value of the SamlProvider entity.
+// If the SamlProvider object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SamlProviderMutation) OldIdpMetadataXML(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIdpMetadataXML is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIdpMetadataXML requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIdpMetadataXML: %w", err)
+	}
+	return oldValue.IdpMetadataXML, nil
+}
+
+// ClearIdpMetadataXML clears the value of the "idp_metadata_xml" field.
+func (m *SamlProviderMutation) ClearIdpMetadataXML() {
+	m.idp_metadata_xml = nil
+	m.clearedFields[samlprovider.FieldIdpMetadataXML] = struct{}{}
+}
+
+// IdpMetadataXMLCleared returns if the "idp_metadata_xml" field was cleared in this mutation.
+func (m *SamlProviderMutation) IdpMetadataXMLCleared() bool {
+	_, ok := m.clearedFields[samlprovider.FieldIdpMetadataXML]
+	return ok
+}
+
+// ResetIdpMetadataXML resets all changes to the "idp_metadata_xml" field.
+func (m *SamlProviderMutation) ResetIdpMetadataXML() {
+	m.idp_metadata_xml = nil
+	delete(m.clearedFields, samlprovider.FieldIdpMetadataXML)
+}
+
+// SetAttributeMapping sets the "attribute_mapping" field.
+func (m *SamlProviderMutation) SetAttributeMapping(value map[string]string) {
+	m.attribute_mapping = &value
+}
+
+// AttributeMapping returns the value of the "attribute_mapping" field in the mutation.
+func (m *SamlProviderMutation) AttributeMapping() (r map[string]string, exists bool) {
+	v := m.attribute_mapping
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAttributeMapping returns the old "attribute_mapping" field's value of the SamlProvider entity.
+// If the SamlProvider object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SamlProviderMutation) OldAttributeMapping(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttributeMapping is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttributeMapping requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttributeMapping: %w", err)
+	}
+	return oldValue.AttributeMapping, nil
+}
+
+// ClearAttributeMapping clears the value of the "attribute_mapping" field.
+func (m *SamlProviderMutation) ClearAttributeMapping() {
+	m.attribute_mapping = nil
+	m.clearedFields[samlprovider.FieldAttributeMapping] = struct{}{}
+}
+
+// AttributeMappingCleared returns if the "attribute_mapping" field was cleared in this mutation.
+func (m *SamlProviderMutation) AttributeMappingCleared() bool {
+	_, ok := m.clearedFields[samlprovider.FieldAttributeMapping]
+	return ok
+}
+
+// ResetAttributeMapping resets all changes to the "attribute_mapping" field.
+func (m *SamlProviderMutation) ResetAttributeMapping() {
+	m.attribute_mapping = nil
+	delete(m.clearedFields, samlprovider.FieldAttributeMapping)
+}
+
+// SetAllowIdpInitiated sets the "allow_idp_initiated" field.
+func (m *SamlProviderMutation) SetAllowIdpInitiated(b bool) {
+	m.allow_idp_initiated = &b
+}
+
+// AllowIdpInitiated returns the value of the "allow_idp_initiated" field in the mutation.
+func (m *SamlProviderMutation) AllowIdpInitiated() (r bool, exists bool) {
+	v := m.allow_idp_initiated
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAllowIdpInitiated returns the old "allow_idp_initiated" field's value of the SamlProvider entity.
+// If the SamlProvider object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SamlProviderMutation) OldAllowIdpInitiated(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAllowIdpInitiated is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAllowIdpInitiated requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAllowIdpInitiated: %w", err)
+	}
+	return oldValue.AllowIdpInitiated, nil
+}
+
+// ResetAllowIdpInitiated resets all changes to the "allow_idp_initiated" field.
+func (m *SamlProviderMutation) ResetAllowIdpInitiated() {
+	m.allow_idp_initiated = nil
+}
+
+// SetAutoProvision sets the "auto_provision" field.
+func (m *SamlProviderMutation) SetAutoProvision(b bool) {
+	m.auto_provision = &b
+}
+
+// AutoProvision returns the value of the "auto_provision" field in the mutation.
+func (m *SamlProviderMutation) AutoProvision() (r bool, exists bool) {
+	v := m.auto_provision
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAutoProvision returns the old "auto_provision" field's value of the SamlProvider entity.
+// If the SamlProvider object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SamlProviderMutation) OldAutoProvision(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAutoProvision is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAutoProvision requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAutoProvision: %w", err)
+	}
+	return oldValue.AutoProvision, nil
+}
+
+// ResetAutoProvision resets all changes to the "auto_provision" field.
+func (m *SamlProviderMutation) ResetAutoProvision() {
+	m.auto_provision = nil
+}
+
+// SetProvisionApproval sets the "provision_approval" field.
+func (m *SamlProviderMutation) SetProvisionApproval(b bool) {
+	m.provision_approval = &b
+}
+
+// ProvisionApproval returns the value of the "provision_approval" field in the mutation.
+func (m *SamlProviderMutation) ProvisionApproval() (r bool, exists bool) {
+	v := m.provision_approval
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProvisionApproval returns the old "provision_approval" field's value of the SamlProvider entity.
+// If the SamlProvider object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SamlProviderMutation) OldProvisionApproval(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProvisionApproval is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProvisionApproval requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProvisionApproval: %w", err)
+	}
+	return oldValue.ProvisionApproval, nil
+}
+
+// ResetProvisionApproval resets all changes to the "provision_approval" field.
+func (m *SamlProviderMutation) ResetProvisionApproval() {
+	m.provision_approval = nil
+}
+
+// SetDefaultRoleID sets the "default_role_id" field.
+func (m *SamlProviderMutation) SetDefaultRoleID(u uint64) {
+	m.default_role_id = &u
+	m.adddefault_role_id = nil
+}
+
+// DefaultRoleID returns the value of the "default_role_id" field in the mutation.
+func (m *SamlProviderMutation) DefaultRoleID() (r uint64, exists bool) {
+	v := m.default_role_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDefaultRoleID returns the old "default_role_id" field's value of the SamlProvider entity.
+// If the SamlProvider object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SamlProviderMutation) OldDefaultRoleID(ctx context.Context) (v uint64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDefaultRoleID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDefaultRoleID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDefaultRoleID: %w", err)
+	}
+	return oldValue.DefaultRoleID, nil
+}
+
+// AddDefaultRoleID adds u to the "default_role_id" field.
+func (m *SamlProviderMutation) AddDefaultRoleID(u int64) {
+	if m.adddefault_role_id != nil {
+		*m.adddefault_role_id += u
+	} else {
+		m.adddefault_role_id = &u
+	}
+}
+
+// AddedDefaultRoleID returns the value that was added to the "default_role_id" field in this mutation.
+func (m *SamlProviderMutation) AddedDefaultRoleID() (r int64, exists bool) {
+	v := m.adddefault_role_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearDefaultRoleID clears the value of the "default_role_id" field.
+func (m *SamlProviderMutation) ClearDefaultRoleID() {
+	m.default_role_id = nil
+	m.adddefault_role_id = nil
+	m.clearedFields[samlprovider.FieldDefaultRoleID] = struct{}{}
+}
+
+// DefaultRoleIDCleared returns if the "default_role_id" field was cleared in this mutation.
+func (m *SamlProviderMutation) DefaultRoleIDCleared() bool {
+	_, ok := m.clearedFields[samlprovider.FieldDefaultRoleID]
+	return ok
+}
+
+// ResetDefaultRoleID resets all changes to the "default_role_id" field.
+func (m *SamlProviderMutation) ResetDefaultRoleID() {
+	m.default_role_id = nil
+	m.adddefault_role_id = nil
+	delete(m.clearedFields, samlprovider.FieldDefaultRoleID)
+}
+
+// SetRoleRules sets the "role_rules" field.
+func (m *SamlProviderMutation) SetRoleRules(r []rolerule.Rule) {
+	m.role_rules = &r
+	m.appendrole_rules = nil
+}
+
+// RoleRules returns the value of the "role_rules" field in the mutation.
+func (m *SamlProviderMutation) RoleRules() (r []rolerule.Rule, exists bool) {
+	v := m.role_rules
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRoleRules returns the old "role_rules" field's value of the SamlProvider entity.
+// If the SamlProvider object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SamlProviderMutation) OldRoleRules(ctx context.Context) (v []rolerule.Rule, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRoleRules is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRoleRules requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRoleRules: %w", err)
+	}
+	return oldValue.RoleRules, nil
+}
+
+// AppendRoleRules adds r to the "role_rules" field.
+func (m *SamlProviderMutation) AppendRoleRules(r []rolerule.Rule) {
+	m.appendrole_rules = append(m.appendrole_rules, r...)
+}
+
+// AppendedRoleRules returns the list of values that were appended to the "role_rules" field in this mutation.
+func (m *SamlProviderMutation) AppendedRoleRules() ([]rolerule.Rule, bool) {
+	if len(m.appendrole_rules) == 0 {
+		return nil, false
+	}
+	return m.appendrole_rules, true
+}
+
+// ClearRoleRules clears the value of the "role_rules" field.
+func (m *SamlProviderMutation) ClearRoleRules() {
+	m.role_rules = nil
+	m.appendrole_rules = nil
+	m.clearedFields[samlprovider.FieldRoleRules] = struct{}{}
+}
+
+// RoleRulesCleared returns if the "role_rules" field was cleared in this mutation.
+func (m *SamlProviderMutation) RoleRulesCleared() bool {
+	_, ok := m.clearedFields[samlprovider.FieldRoleRules]
+	return ok
+}
+
+// ResetRoleRules resets all changes to the "role_rules" field.
+func (m *SamlProviderMutation) ResetRoleRules() {
+	m.role_rules = nil
+	m.appendrole_rules = nil
+	delete(m.clearedFields, samlprovider.FieldRoleRules)
+}
+
+// Where appends a list predicates to the SamlProviderMutation builder.
+func (m *SamlProviderMutation) Where(ps ...predicate.SamlProvider) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SamlProviderMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SamlProviderMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SamlProvider, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SamlProviderMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SamlProviderMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (SamlProvider).
+func (m *SamlProviderMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SamlProviderMutation) Fields() []string {
+	fields := make([]string, 0, 12)
+	if m.created_at != nil {
+		fields = append(fields, samlprovider.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, samlprovider.FieldUpdatedAt)
+	}
+	if m.name != nil {
+		fields = append(fields, samlprovider.FieldName)
+	}
+	if m.entity_id != nil {
+		fields = append(fields, samlprovider.FieldEntityID)
+	}
+	if m.idp_metadata_url != nil {
+		fields = append(fields, samlprovider.FieldIdpMetadataURL)
+	}
+	if m.idp_metadata_xml != nil {
+		fields = append(fields, samlprovider.FieldIdpMetadataXML)
+	}
+	if m.attribute_mapping != nil {
+		fields = append(fields, samlprovider.FieldAttributeMapping)
+	}
+	if m.allow_idp_initiated != nil {
+		fields = append(fields, samlprovider.FieldAllowIdpInitiated)
+	}
+	if m.auto_provision != nil {
+		fields = append(fields, samlprovider.FieldAutoProvision)
+	}
+	if m.provision_approval != nil {
+		fields = append(fields, samlprovider.FieldProvisionApproval)
+	}
+	if m.default_role_id != nil {
+		fields = append(fields, samlprovider.FieldDefaultRoleID)
+	}
+	if m.role_rules != nil {
+		fields = append(fields, samlprovider.FieldRoleRules)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SamlProviderMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case samlprovider.FieldCreatedAt:
+		return m.CreatedAt()
+	case samlprovider.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case samlprovider.FieldName:
+		return m.Name()
+	case samlprovider.FieldEntityID:
+		return m.EntityID()
+	case samlprovider.FieldIdpMetadataURL:
+		return m.IdpMetadataURL()
+	case samlprovider.FieldIdpMetadataXML:
+		return m.IdpMetadataXML()
+	case samlprovider.FieldAttributeMapping:
+		return m.AttributeMapping()
+	case samlprovider.FieldAllowIdpInitiated:
+		return m.AllowIdpInitiated()
+	case samlprovider.FieldAutoProvision:
+		return m.AutoProvision()
+	case samlprovider.FieldProvisionApproval:
+		return m.ProvisionApproval()
+	case samlprovider.FieldDefaultRoleID:
+		return m.DefaultRoleID()
+	case samlprovider.FieldRoleRules:
+		return m.RoleRules()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SamlProviderMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case samlprovider.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case samlprovider.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case samlprovider.FieldName:
+		return m.OldName(ctx)
+	case samlprovider.FieldEntityID:
+		return m.OldEntityID(ctx)
+	case samlprovider.FieldIdpMetadataURL:
+		return m.OldIdpMetadataURL(ctx)
+	case samlprovider.FieldIdpMetadataXML:
+		return m.OldIdpMetadataXML(ctx)
+	case samlprovider.FieldAttributeMapping:
+		return m.OldAttributeMapping(ctx)
+	case samlprovider.FieldAllowIdpInitiated:
+		return m.OldAllowIdpInitiated(ctx)
+	case samlprovider.FieldAutoProvision:
+		return m.OldAutoProvision(ctx)
+	case samlprovider.FieldProvisionApproval:
+		return m.OldProvisionApproval(ctx)
+	case samlprovider.FieldDefaultRoleID:
+		return m.OldDefaultRoleID(ctx)
+	case samlprovider.FieldRoleRules:
+		return m.OldRoleRules(ctx)
+	}
+	return nil, fmt.Errorf("unknown SamlProvider field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SamlProviderMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case samlprovider.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case samlprovider.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case samlprovider.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case samlprovider.FieldEntityID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEntityID(v)
+		return nil
+	case samlprovider.FieldIdpMetadataURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIdpMetadataURL(v)
+		return nil
+	case samlprovider.FieldIdpMetadataXML:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIdpMetadataXML(v)
+		return nil
+	case samlprovider.FieldAttributeMapping:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttributeMapping(v)
+		return nil
+	case samlprovider.FieldAllowIdpInitiated:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAllowIdpInitiated(v)
+		return nil
+	case samlprovider.FieldAutoProvision:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAutoProvision(v)
+		return nil
+	case samlprovider.FieldProvisionApproval:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProvisionApproval(v)
+		return nil
+	case samlprovider.FieldDefaultRoleID:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDefaultRoleID(v)
+		return nil
+	case samlprovider.FieldRoleRules:
+		v, ok := value.([]rolerule.Rule)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRoleRules(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SamlProvider field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SamlProviderMutation) AddedFields() []string {
+	var fields []string
+	if m.adddefault_role_id != nil {
+		fields = append(fields, samlprovider.FieldDefaultRoleID)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SamlProviderMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case samlprovider.FieldDefaultRoleID:
+		return m.AddedDefaultRoleID()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SamlProviderMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case samlprovider.FieldDefaultRoleID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDefaultRoleID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SamlProvider numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SamlProviderMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(samlprovider.FieldEntityID) {
+		fields = append(fields, samlprovider.FieldEntityID)
+	}
+	if m.FieldCleared(samlprovider.FieldIdpMetadataURL) {
+		fields = append(fields, samlprovider.FieldIdpMetadataURL)
+	}
+	if m.FieldCleared(samlprovider.FieldIdpMetadataXML) {
+		fields = append(fields, samlprovider.FieldIdpMetadataXML)
+	}
+	if m.FieldCleared(samlprovider.FieldAttributeMapping) {
+		fields = append(fields, samlprovider.FieldAttributeMapping)
+	}
+	if m.FieldCleared(samlprovider.FieldDefaultRoleID) {
+		fields = append(fields, samlprovider.FieldDefaultRoleID)
+	}
+	if m.FieldCleared(samlprovider.FieldRoleRules) {
+		fields = append(fields, samlprovider.FieldRoleRules)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SamlProviderMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SamlProviderMutation) ClearField(name string) error {
+	switch name {
+	case samlprovider.FieldEntityID:
+		m.ClearEntityID()
+		return nil
+	case samlprovider.FieldIdpMetadataURL:
+		m.ClearIdpMetadataURL()
+		return nil
+	case samlprovider.FieldIdpMetadataXML:
+		m.ClearIdpMetadataXML()
+		return nil
+	case samlprovider.FieldAttributeMapping:
+		m.ClearAttributeMapping()
+		return nil
+	case samlprovider.FieldDefaultRoleID:
+		m.ClearDefaultRoleID()
+		return nil
+	case samlprovider.FieldRoleRules:
+		m.ClearRoleRules()
+		return nil
+	}
+	return fmt.Errorf("unknown SamlProvider nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SamlProviderMutation) ResetField(name string) error {
+	switch name {
+	case samlprovider.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case samlprovider.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case samlprovider.FieldName:
+		m.ResetName()
+		return nil
+	case samlprovider.FieldEntityID:
+		m.ResetEntityID()
+		return nil
+	case samlprovider.FieldIdpMetadataURL:
+		m.ResetIdpMetadataURL()
+		return nil
+	case samlprovider.FieldIdpMetadataXML:
+		m.ResetIdpMetadataXML()
+		return nil
+	case samlprovider.FieldAttributeMapping:
+		m.ResetAttributeMapping()
+		return nil
+	case samlprovider.FieldAllowIdpInitiated:
+		m.ResetAllowIdpInitiated()
+		return nil
+	case samlprovider.FieldAutoProvision:
+		m.ResetAutoProvision()
+		return nil
+	case samlprovider.FieldProvisionApproval:
+		m.ResetProvisionApproval()
+		return nil
+	case samlprovider.FieldDefaultRoleID:
+		m.ResetDefaultRoleID()
+		return nil
+	case samlprovider.FieldRoleRules:
+		m.ResetRoleRules()
+		return nil
+	}
+	return fmt.Errorf("unknown SamlProvider field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SamlProviderMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SamlProviderMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SamlProviderMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SamlProviderMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SamlProviderMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SamlProviderMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SamlProviderMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown SamlProvider unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SamlProviderMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown SamlProvider edge %s", name)
+}
+
+// TenantMutation represents an operation that mutates the Tenant nodes in the graph.
+type TenantMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uint64
+	created_at    *time.Time
+	updated_at    *time.Time
+	status        *uint8
+	addstatus     *int8
+	name          *string
+	remark        *string
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*Tenant, error)
+	predicates    []predicate.Tenant
+}
+
+var _ ent.Mutation = (*TenantMutation)(nil)
+
+// tenantOption allows management of the mutation configuration using functional options.
+type tenantOption func(*TenantMutation)
+
+// newTenantMutation creates new mutation for the Tenant entity.
+func newTenantMutation(c config, op Op, opts ...tenantOption) *TenantMutation {
+	m := &TenantMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTenant,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTenantID sets the ID field of the mutation.
+func withTenantID(id uint64) tenantOption {
+	return func(m *TenantMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Tenant
+		)
+		m.oldValue = func(ctx context.Context) (*Tenant, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Tenant.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTenant sets the old Tenant of the mutation.
+func withTenant(node *Tenant) tenantOption {
+	return func(m *TenantMutation) {
+		m.oldValue = func(context.Context) (*Tenant, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TenantMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TenantMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Tenant entities.
+func (m *TenantMutation) SetID(id uint64) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TenantMutation) ID() (id uint64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TenantMutation) IDs(ctx context.Context) ([]uint64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Tenant.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *TenantMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *TenantMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Tenant entity.
+// If the Tenant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TenantMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *TenantMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *TenantMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *TenantMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Tenant entity.
+// If the Tenant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TenantMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *TenantMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *TenantMutation) SetStatus(u uint8) {
+	m.status = &u
+	m.addstatus = nil
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *TenantMutation) Status() (r uint8, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Tenant entity.
+// If the Tenant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TenantMutation) OldStatus(ctx context.Context) (v uint8, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// AddStatus adds u to the "status" field.
+func (m *TenantMutation) AddStatus(u int8) {
+	if m.addstatus != nil {
+		*m.addstatus += u
+	} else {
+		m.addstatus = &u
+	}
+}
+
+// AddedStatus returns the value that was added to the "status" field in this mutation.
+func (m *TenantMutation) AddedStatus() (r int8, exists bool) {
+	v := m.addstatus
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearStatus clears the value of the "status" field.
+func (m *TenantMutation) ClearStatus() {
+	m.status = nil
+	m.addstatus = nil
+	m.clearedFields[tenant.FieldStatus] = struct{}{}
+}
+
+// StatusCleared returns if the "status" field was cleared in this mutation.
+func (m *TenantMutation) StatusCleared() bool {
+	_, ok := m.clearedFields[tenant.FieldStatus]
+	return ok
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *TenantMutation) ResetStatus() {
+	m.status = nil
+	m.addstatus = nil
+	delete(m.clearedFields, tenant.FieldStatus)
+}
+
+// SetName sets the "name" field.
+func (m *TenantMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *TenantMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Tenant entity.
+// If the Tenant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TenantMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *TenantMutation) ResetName() {
+	m.name = nil
+}
+
+// SetRemark sets the "remark" field.
+func (m *TenantMutation) SetRemark(s string) {
+	m.remark = &s
+}
+
+// Remark returns the value of the "remark" field in the mutation.
+func (m *TenantMutation) Remark() (r string, exists bool) {
+	v := m.remark
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRemark returns the old "remark" field's value of the Tenant entity.
+// If the Tenant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TenantMutation) OldRemark(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRemark is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRemark requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRemark: %w", err)
+	}
+	return oldValue.Remark, nil
+}
+
+// ClearRemark clears the value of the "remark" field.
+func (m *TenantMutation) ClearRemark() {
+	m.remark = nil
+	m.clearedFields[tenant.FieldRemark] = struct{}{}
+}
+
+// RemarkCleared returns if the "remark" field was cleared in this mutation.
+func (m *TenantMutation) RemarkCleared() bool {
+	_, ok := m.clearedFields[tenant.FieldRemark]
+	return ok
+}
+
+// ResetRemark resets all changes to the "remark" field.
+func (m *TenantMutation) ResetRemark() {
+	m.remark = nil
+	delete(m.clearedFields, tenant.FieldRemark)
+}
+
+// Where appends a list predicates to the TenantMutation builder.
+func (m *TenantMutation) Where(ps ...predicate.Tenant) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the TenantMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TenantMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Tenant, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *TenantMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *TenantMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Tenant).
+func (m *TenantMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TenantMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.created_at != nil {
+		fields = append(fields, tenant.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, tenant.FieldUpdatedAt)
+	}
+	if m.status != nil {
+		fields = append(fields, tenant.FieldStatus)
+	}
+	if m.name != nil {
+		fields = append(fields, tenant.FieldName)
+	}
+	if m.remark != nil {
+		fields = append(fields, tenant.FieldRemark)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TenantMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case tenant.FieldCreatedAt:
+		return m.CreatedAt()
+	case tenant.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case tenant.FieldStatus:
+		return m.Status()
+	case tenant.FieldName:
+		return m.Name()
+	case tenant.FieldRemark:
+		return m.Remark()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TenantMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case tenant.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case tenant.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case tenant.FieldStatus:
+		return m.OldStatus(ctx)
+	case tenant.FieldName:
+		return m.OldName(ctx)
+	case tenant.FieldRemark:
+		return m.OldRemark(ctx)
+	}
+	return nil, fmt.Errorf("unknown Tenant field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TenantMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case tenant.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case tenant.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case tenant.FieldStatus:
+		v, ok := value.(uint8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case tenant.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case tenant.FieldRemark:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRemark(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Tenant field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TenantMutation) AddedFields() []string {
+	var fields []string
+	if m.addstatus != nil {
+		fields = append(fields, tenant.FieldStatus)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TenantMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case tenant.FieldStatus:
+		return m.AddedStatus()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TenantMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case tenant.FieldStatus:
+		v, ok := value.(int8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStatus(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Tenant numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TenantMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(tenant.FieldStatus) {
+		fields = append(fields, tenant.FieldStatus)
+	}
+	if m.FieldCleared(tenant.FieldRemark) {
+		fields = append(fields, tenant.FieldRemark)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TenantMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TenantMutation) ClearField(name string) error {
+	switch name {
+	case tenant.FieldStatus:
+		m.ClearStatus()
+		return nil
+	case tenant.FieldRemark:
+		m.ClearRemark()
+		return nil
+	}
+	return fmt.Errorf("unknown Tenant nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TenantMutation) ResetField(name string) error {
+	switch name {
+	case tenant.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case tenant.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case tenant.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case tenant.FieldName:
+		m.ResetName()
+		return nil
+	case tenant.FieldRemark:
+		m.ResetRemark()
+		return nil
+	}
+	return fmt.Errorf("unknown Tenant field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TenantMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TenantMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TenantMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TenantMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TenantMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TenantMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TenantMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Tenant unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TenantMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Tenant edge %s", name)
+}
+
+// TokenMutation represents an operation that mutates the Token nodes in the graph.
+type TokenMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *uint64
+	created_at         *time.Time
+	updated_at         *time.Time
+	jti                *string
+	token              *string
+	source             *string
+	device             *string
+	ip                 *string
+	user_agent         *string
+	issued_at          *time.Time
+	expired_at         *time.Time
+	revoked_at         *time.Time
+	impersonator_id    *uint64
+	addimpersonator_id *int64
+	role_id            *uint64
+	addrole_id         *int64
+	clearedFields      map[string]struct{}
+	owner              *uint64
+	clearedowner       bool
+	done               bool
+	oldValue           func(context.Context) (*Token, error)
+	predicates         []predicate.Token
+}
+
+var _ ent.Mutation = (*TokenMutation)(nil)
+
+// tokenOption allows management of the mutation configuration using functional options.
+type tokenOption func(*TokenMutation)
+
+// newTokenMutation creates new mutation for the Token entity.
+func newTokenMutation(c config, op Op, opts ...tokenOption) *TokenMutation {
+	m := &TokenMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeToken,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTokenID sets the ID field of the mutation.
+func withTokenID(id uint64) tokenOption {
+	return func(m *TokenMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Token
+		)
+		m.oldValue = func(ctx context.Context) (*Token, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Token.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withToken sets the old Token of the mutation.
+func withToken(node *Token) tokenOption {
+	return func(m *TokenMutation) {
+		m.oldValue = func(context.Context) (*Token, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TokenMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TokenMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Token entities.
+func (m *TokenMutation) SetID(id uint64) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TokenMutation) ID() (id uint64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TokenMutation) IDs(ctx context.Context) ([]uint64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Token.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *TokenMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *TokenMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Token entity.
+// If the Token object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *TokenMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *TokenMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *TokenMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Token entity.
+// If the Token object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *TokenMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *TokenMutation) SetUserID(u uint64) {
+	m.owner = &u
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *TokenMutation) UserID() (r uint64, exists bool) {
+	v := m.owner
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the Token entity.
+// If the Token object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenMutation) OldUserID(ctx context.Context) (v uint64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *TokenMutation) ResetUserID() {
+	m.owner = nil
+}
+
+// SetJti sets the "jti" field.
+func (m *TokenMutation) SetJti(s string) {
+	m.jti = &s
+}
+
+// Jti returns the value of the "jti" field in the mutation.
+func (m *TokenMutation) Jti() (r string, exists bool) {
+	v := m.jti
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldJti returns the old "jti" field's value of the Token entity.
+// If the Token object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenMutation) OldJti(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldJti is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldJti requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldJti: %w", err)
+	}
+	return oldValue.Jti, nil
+}
+
+// ResetJti resets all changes to the "jti" field.
+func (m *TokenMutation) ResetJti() {
+	m.jti = nil
+}
+
+// SetToken sets the "token" field.
+func (m *TokenMutation) SetToken(s string) {
+	m.token = &s
+}
+
+// Token returns the value of the "token" field in the mutation.
+func (m *TokenMutation) Token() (r string, exists bool) {
+	v := m.token
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldToken returns the old "token" field's value of the Token entity.
+// If the Token object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenMutation) OldToken(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldToken is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldToken requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldToken: %w", err)
+	}
+	return oldValue.Token, nil
+}
+
+// ResetToken resets all changes to the "token" field.
+func (m *TokenMutation) ResetToken() {
+	m.token = nil
+}
+
+// SetSource sets the "source" field.
+func (m *TokenMutation) SetSource(s string) {
+	m.source = &s
+}
+
+// Source returns the value of the "source" field in the mutation.
+func (m *TokenMutation) Source() (r string, exists bool) {
+	v := m.source
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSource returns the old "source" field's value of the Token entity.
+// If the Token object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenMutation) OldSource(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSource is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSource requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSource: %w", err)
+	}
+	return oldValue.Source, nil
+}
+
+// ResetSource resets all changes to the "source" field.
+func (m *TokenMutation) ResetSource() {
+	m.source = nil
+}
+
+// SetDevice sets the "device" field.
+func (m *TokenMutation) SetDevice(s string) {
+	m.device = &s
+}
+
+// Device returns the value of the "device" field in the mutation.
+func (m *TokenMutation) Device() (r string, exists bool) {
+	v := m.device
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDevice returns the old "device" field's value of the Token entity.
+// If the Token object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenMutation) OldDevice(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDevice is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDevice requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDevice: %w", err)
+	}
+	return oldValue.Device, nil
+}
+
+// ClearDevice clears the value of the "device" field.
+func (m *TokenMutation) ClearDevice() {
+	m.device = nil
+	m.clearedFields[token.FieldDevice] = struct{}{}
+}
+
+// DeviceCleared returns if the "device" field was cleared in this mutation.
+func (m *TokenMutation) DeviceCleared() bool {
+	_, ok := m.clearedFields[token.FieldDevice]
+	return ok
+}
+
+// ResetDevice resets all changes to the "device" field.
+func (m *TokenMutation) ResetDevice() {
+	m.device = nil
+	delete(m.clearedFields, token.FieldDevice)
+}
+
+// SetIP sets the "ip" field.
+func (m *TokenMutation) SetIP(s string) {
+	m.ip = &s
+}
+
+// IP returns the value of the "ip" field in the mutation.
+func (m *TokenMutation) IP() (r string, exists bool) {
+	v := m.ip
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIP returns the old "ip" field's value of the Token entity.
+// If the Token object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenMutation) OldIP(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIP is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIP requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIP: %w", err)
+	}
+	return oldValue.IP, nil
+}
+
+// ClearIP clears the value of the "ip" field.
+func (m *TokenMutation) ClearIP() {
+	m.ip = nil
+	m.clearedFields[token.FieldIP] = struct{}{}
+}
+
+// IPCleared returns if the "ip" field was cleared in this mutation.
+func (m *TokenMutation) IPCleared() bool {
+	_, ok := m.clearedFields[token.FieldIP]
+	return ok
+}
+
+// ResetIP resets all changes to the "ip" field.
+func (m *TokenMutation) ResetIP() {
+	m.ip = nil
+	delete(m.clearedFields, token.FieldIP)
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (m *TokenMutation) SetUserAgent(s string) {
+	m.user_agent = &s
+}
+
+// UserAgent returns the value of the "user_agent" field in the mutation.
+func (m *TokenMutation) UserAgent() (r string, exists bool) {
+	v := m.user_agent
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserAgent returns the old "user_agent" field's value of the Token entity.
+// If the Token object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenMutation) OldUserAgent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserAgent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserAgent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserAgent: %w", err)
+	}
+	return oldValue.UserAgent, nil
+}
+
+// ClearUserAgent clears the value of the "user_agent" field.
+func (m *TokenMutation) ClearUserAgent() {
+	m.user_agent = nil
+	m.clearedFields[token.FieldUserAgent] = struct{}{}
+}
+
+// UserAgentCleared returns if the "user_agent" field was cleared in this mutation.
+func (m *TokenMutation) UserAgentCleared() bool {
+	_, ok := m.clearedFields[token.FieldUserAgent]
+	return ok
+}
+
+// ResetUserAgent resets all changes to the "user_agent" field.
+func (m *TokenMutation) ResetUserAgent() {
+	m.user_agent = nil
+	delete(m.clearedFields, token.FieldUserAgent)
+}
+
+// SetIssuedAt sets the "issued_at" field.
+func (m *TokenMutation) SetIssuedAt(t time.Time) {
+	m.issued_at = &t
+}
+
+// IssuedAt returns the value of the "issued_at" field in the mutation.
+func (m *TokenMutation) IssuedAt() (r time.Time, exists bool) {
+	v := m.issued_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIssuedAt returns the old "issued_at" field's value of the Token entity.
+// If the Token object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenMutation) OldIssuedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIssuedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIssuedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIssuedAt: %w", err)
+	}
+	return oldValue.IssuedAt, nil
+}
+
+// ResetIssuedAt resets all changes to the "issued_at" field.
+func (m *TokenMutation) ResetIssuedAt() {
+	m.issued_at = nil
+}
+
+// SetExpiredAt sets the "expired_at" field.
+func (m *TokenMutation) SetExpiredAt(t time.Time) {
+	m.expired_at = &t
+}
+
+// ExpiredAt returns the value of the "expired_at" field in the mutation.
+func (m *TokenMutation) ExpiredAt() (r time.Time, exists bool) {
+	v := m.expired_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiredAt returns the old "expired_at" field's value of the Token entity.
+// If the Token object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenMutation) OldExpiredAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiredAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiredAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiredAt: %w", err)
+	}
+	return oldValue.ExpiredAt, nil
+}
+
+// ResetExpiredAt resets all changes to the "expired_at" field.
+func (m *TokenMutation) ResetExpiredAt() {
+	m.expired_at = nil
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (m *TokenMutation) SetRevokedAt(t time.Time) {
+	m.revoked_at = &t
+}
+
+// RevokedAt returns the value of the "revoked_at" field in the mutation.
+func (m *TokenMutation) RevokedAt() (r time.Time, exists bool) {
+	v := m.revoked_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRevokedAt returns the old "revoked_at" field's value of the Token entity.
+// If the Token object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenMutation) OldRevokedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRevokedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRevokedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRevokedAt: %w", err)
+	}
+	return oldValue.RevokedAt, nil
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (m *TokenMutation) ClearRevokedAt() {
+	m.revoked_at = nil
+	m.clearedFields[token.FieldRevokedAt] = struct{}{}
+}
+
+// RevokedAtCleared returns if the "revoked_at" field was cleared in this mutation.
+func (m *TokenMutation) RevokedAtCleared() bool {
+	_, ok := m.clearedFields[token.FieldRevokedAt]
+	return ok
+}
+
+// ResetRevokedAt resets all changes to the "revoked_at" field.
+func (m *TokenMutation) ResetRevokedAt() {
+	m.revoked_at = nil
+	delete(m.clearedFields, token.FieldRevokedAt)
+}
+
+// SetImpersonatorID sets the "impersonator_id" field.
+func (m *TokenMutation) SetImpersonatorID(u uint64) {
+	m.impersonator_id = &u
+	m.addimpersonator_id = nil
+}
+
+// ImpersonatorID returns the value of the "impersonator_id" field in the mutation.
+func (m *TokenMutation) ImpersonatorID() (r uint64, exists bool) {
+	v := m.impersonator_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldImpersonatorID returns the old "impersonator_id" field's value of the Token entity.
+// If the Token object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenMutation) OldImpersonatorID(ctx context.Context) (v uint64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldImpersonatorID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldImpersonatorID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldImpersonatorID: %w", err)
+	}
+	return oldValue.ImpersonatorID, nil
+}
+
+// AddImpersonatorID adds u to the "impersonator_id" field.
+func (m *TokenMutation) AddImpersonatorID(u int64) {
+	if m.addimpersonator_id != nil {
+		*m.addimpersonator_id += u
+	} else {
+		m.addimpersonator_id = &u
+	}
+}
+
+// AddedImpersonatorID returns the value that was added to the "impersonator_id" field in this mutation.
+func (m *TokenMutation) AddedImpersonatorID() (r int64, exists bool) {
+	v := m.addimpersonator_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearImpersonatorID clears the value of the "impersonator_id" field.
+func (m *TokenMutation) ClearImpersonatorID() {
+	m.impersonator_id = nil
+	m.addimpersonator_id = nil
+	m.clearedFields[token.FieldImpersonatorID] = struct{}{}
+}
+
+// ImpersonatorIDCleared returns if the "impersonator_id" field was cleared in this mutation.
+func (m *TokenMutation) ImpersonatorIDCleared() bool {
+	_, ok := m.clearedFields[token.FieldImpersonatorID]
+	return ok
+}
+
+// ResetImpersonatorID resets all changes to the "impersonator_id" field.
+func (m *TokenMutation) ResetImpersonatorID() {
+	m.impersonator_id = nil
+	m.addimpersonator_id = nil
+	delete(m.clearedFields, token.FieldImpersonatorID)
+}
+
+// SetRoleID sets the "role_id" field.
+func (m *TokenMutation) SetRoleID(u uint64) {
+	m.role_id = &u
+	m.addrole_id = nil
+}
+
+// RoleID returns the value of the "role_id" field in the mutation.
+func (m *TokenMutation) RoleID() (r uint64, exists bool) {
+	v := m.role_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRoleID returns the old "role_id" field's value of the Token entity.
+// If the Token object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenMutation) OldRoleID(ctx context.Context) (v uint64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRoleID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRoleID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRoleID: %w", err)
+	}
+	return oldValue.RoleID, nil
+}
+
+// AddRoleID adds u to the "role_id" field.
+func (m *TokenMutation) AddRoleID(u int64) {
+	if m.addrole_id != nil {
+		*m.addrole_id += u
+	} else {
+		m.addrole_id = &u
+	}
+}
+
+// AddedRoleID returns the value that was added to the "role_id" field in this mutation.
+func (m *TokenMutation) AddedRoleID() (r int64, exists bool) {
+	v := m.addrole_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearRoleID clears the value of the "role_id" field.
+func (m *TokenMutation) ClearRoleID() {
+	m.role_id = nil
+	m.addrole_id = nil
+	m.clearedFields[token.FieldRoleID] = struct{}{}
+}
+
+// RoleIDCleared returns if the "role_id" field was cleared in this mutation.
+func (m *TokenMutation) RoleIDCleared() bool {
+	_, ok := m.clearedFields[token.FieldRoleID]
+	return ok
+}
+
+// ResetRoleID resets all changes to the "role_id" field.
+func (m *TokenMutation) ResetRoleID() {
+	m.role_id = nil
+	m.addrole_id = nil
+	delete(m.clearedFields, token.FieldRoleID)
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by id.
+func (m *TokenMutation) SetOwnerID(id uint64) {
+	m.owner = &id
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (m *TokenMutation) ClearOwner() {
+	m.clearedowner = true
+	m.clearedFields[token.FieldUserID] = struct{}{}
+}
+
+// OwnerCleared reports if the "owner" edge to the User entity was cleared.
+func (m *TokenMutation) OwnerCleared() bool {
+	return m.clearedowner
+}
+
+// OwnerID returns the "owner" edge ID in the mutation.
+func (m *TokenMutation) OwnerID() (id uint64, exists bool) {
+	if m.owner != nil {
+		return *m.owner, true
+	}
+	return
+}
+
+// OwnerIDs returns the "owner" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OwnerID instead. It exists only for internal usage by the builders.
+func (m *TokenMutation) OwnerIDs() (ids []uint64) {
+	if id := m.owner; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetOwner resets all changes to the "owner" edge.
+func (m *TokenMutation) ResetOwner() {
+	m.owner = nil
+	m.clearedowner = false
+}
+
+// Where appends a list predicates to the TokenMutation builder.
+func (m *TokenMutation) Where(ps ...predicate.Token) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the TokenMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TokenMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Token, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *TokenMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *TokenMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Token).
+func (m *TokenMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TokenMutation) Fields() []string {
+	fields := make([]string, 0, 14)
+	if m.created_at != nil {
+		fields = append(fields, token.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, token.FieldUpdatedAt)
+	}
+	if m.owner != nil {
+		fields = append(fields, token.FieldUserID)
+	}
+	if m.jti != nil {
+		fields = append(fields, token.FieldJti)
+	}
+	if m.token != nil {
+		fields = append(fields, token.FieldToken)
+	}
+	if m.source != nil {
+		fields = append(fields, token.FieldSource)
+	}
+	if m.device != nil {
+		fields = append(fields, token.FieldDevice)
+	}
+	if m.ip != nil {
+		fields = append(fields, token.FieldIP)
+	}
+	if m.user_agent != nil {
+		fields = append(fields, token.FieldUserAgent)
+	}
+	if m.issued_at != nil {
+		fields = append(fields, token.FieldIssuedAt)
+	}
+	if m.expired_at != nil {
+		fields = append(fields, token.FieldExpiredAt)
+	}
+	if m.revoked_at != nil {
+		fields = append(fields, token.FieldRevokedAt)
+	}
+	if m.impersonator_id != nil {
+		fields = append(fields, token.FieldImpersonatorID)
+	}
+	if m.role_id != nil {
+		fields = append(fields, token.FieldRoleID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TokenMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case token.FieldCreatedAt:
+		return m.CreatedAt()
+	case token.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case token.FieldUserID:
+		return m.UserID()
+	case token.FieldJti:
+		return m.Jti()
+	case token.FieldToken:
+		return m.Token()
+	case token.FieldSource:
+		return m.Source()
+	case token.FieldDevice:
+		return m.Device()
+	case token.FieldIP:
+		return m.IP()
+	case token.FieldUserAgent:
+		return m.UserAgent()
+	case token.FieldIssuedAt:
+		return m.IssuedAt()
+	case token.FieldExpiredAt:
+		return m.ExpiredAt()
+	case token.FieldRevokedAt:
+		return m.RevokedAt()
+	case token.FieldImpersonatorID:
+		return m.ImpersonatorID()
+	case token.FieldRoleID:
+		return m.RoleID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TokenMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case token.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case token.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case token.FieldUserID:
+		return m.OldUserID(ctx)
+	case token.FieldJti:
+		return m.OldJti(ctx)
+	case token.FieldToken:
+		return m.OldToken(ctx)
+	case token.FieldSource:
+		return m.OldSource(ctx)
+	case token.FieldDevice:
+		return m.OldDevice(ctx)
+	case token.FieldIP:
+		return m.OldIP(ctx)
+	case token.FieldUserAgent:
+		return m.OldUserAgent(ctx)
+	case token.FieldIssuedAt:
+		return m.OldIssuedAt(ctx)
+	case token.FieldExpiredAt:
+		return m.OldExpiredAt(ctx)
+	case token.FieldRevokedAt:
+		return m.OldRevokedAt(ctx)
+	case token.FieldImpersonatorID:
+		return m.OldImpersonatorID(ctx)
+	case token.FieldRoleID:
+		return m.OldRoleID(ctx)
+	}
+	return nil, fmt.Errorf("unknown Token field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TokenMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case token.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case oauthprovider.FieldUpdatedAt:
+	case token.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case oauthprovider.FieldName:
+	case token.FieldUserID:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case token.FieldJti:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetJti(v)
+		return nil
+	case token.FieldToken:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetToken(v)
+		return nil
+	case token.FieldSource:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSource(v)
+		return nil
+	case token.FieldDevice:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDevice(v)
+		return nil
+	case token.FieldIP:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIP(v)
+		return nil
+	case token.FieldUserAgent:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetUserAgent(v)
+		return nil
+	case token.FieldIssuedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIssuedAt(v)
+		return nil
+	case token.FieldExpiredAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiredAt(v)
+		return nil
+	case token.FieldRevokedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRevokedAt(v)
+		return nil
+	case token.FieldImpersonatorID:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetImpersonatorID(v)
+		return nil
+	case token.FieldRoleID:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRoleID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Token field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TokenMutation) AddedFields() []string {
+	var fields []string
+	if m.addimpersonator_id != nil {
+		fields = append(fields, token.FieldImpersonatorID)
+	}
+	if m.addrole_id != nil {
+		fields = append(fields, token.FieldRoleID)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TokenMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case token.FieldImpersonatorID:
+		return m.AddedImpersonatorID()
+	case token.FieldRoleID:
+		return m.AddedRoleID()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TokenMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case token.FieldImpersonatorID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddImpersonatorID(v)
 		return nil
-	case oauthprovider.FieldAppID:
-		v, ok := value.(string)
+	case token.FieldRoleID:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAppID(v)
+		m.AddRoleID(v)
 		return nil
-	case oauthprovider.FieldClientID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetClientID(v)
+	}
+	return fmt.Errorf("unknown Token numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TokenMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(token.FieldDevice) {
+		fields = append(fields, token.FieldDevice)
+	}
+	if m.FieldCleared(token.FieldIP) {
+		fields = append(fields, token.FieldIP)
+	}
+	if m.FieldCleared(token.FieldUserAgent) {
+		fields = append(fields, token.FieldUserAgent)
+	}
+	if m.FieldCleared(token.FieldRevokedAt) {
+		fields = append(fields, token.FieldRevokedAt)
+	}
+	if m.FieldCleared(token.FieldImpersonatorID) {
+		fields = append(fields, token.FieldImpersonatorID)
+	}
+	if m.FieldCleared(token.FieldRoleID) {
+		fields = append(fields, token.FieldRoleID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TokenMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TokenMutation) ClearField(name string) error {
+	switch name {
+	case token.FieldDevice:
+		m.ClearDevice()
 		return nil
-	case oauthprovider.FieldClientSecret:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetClientSecret(v)
+	case token.FieldIP:
+		m.ClearIP()
 		return nil
-	case oauthprovider.FieldRedirectURL:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetRedirectURL(v)
+	case token.FieldUserAgent:
+		m.ClearUserAgent()
 		return nil
-	case oauthprovider.FieldScopes:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetScopes(v)
+	case token.FieldRevokedAt:
+		m.ClearRevokedAt()
 		return nil
-	case oauthprovider.FieldAuthURL:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetAuthURL(v)
+	case token.FieldImpersonatorID:
+		m.ClearImpersonatorID()
 		return nil
-	case oauthprovider.FieldTokenURL:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTokenURL(v)
+	case token.FieldRoleID:
+		m.ClearRoleID()
 		return nil
-	case oauthprovider.FieldAuthStyle:
-		v, ok := value.(uint64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
+	}
+	return fmt.Errorf("unknown Token nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TokenMutation) ResetField(name string) error {
+	switch name {
+	case token.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case token.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case token.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case token.FieldJti:
+		m.ResetJti()
+		return nil
+	case token.FieldToken:
+		m.ResetToken()
+		return nil
+	case token.FieldSource:
+		m.ResetSource()
+		return nil
+	case token.FieldDevice:
+		m.ResetDevice()
+		return nil
+	case token.FieldIP:
+		m.ResetIP()
+		return nil
+	case token.FieldUserAgent:
+		m.ResetUserAgent()
+		return nil
+	case token.FieldIssuedAt:
+		m.ResetIssuedAt()
+		return nil
+	case token.FieldExpiredAt:
+		m.ResetExpiredAt()
+		return nil
+	case token.FieldRevokedAt:
+		m.ResetRevokedAt()
+		return nil
+	case token.FieldImpersonatorID:
+		m.ResetImpersonatorID()
+		return nil
+	case token.FieldRoleID:
+		m.ResetRoleID()
+		return nil
+	}
+	return fmt.Errorf("unknown Token field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TokenMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.owner != nil {
+		edges = append(edges, token.EdgeOwner)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TokenMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case token.EdgeOwner:
+		if id := m.owner; id != nil {
+			return []ent.Value{*id}
 		}
-		m.SetAuthStyle(v)
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TokenMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TokenMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TokenMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedowner {
+		edges = append(edges, token.EdgeOwner)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TokenMutation) EdgeCleared(name string) bool {
+	switch name {
+	case token.EdgeOwner:
+		return m.clearedowner
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TokenMutation) ClearEdge(name string) error {
+	switch name {
+	case token.EdgeOwner:
+		m.ClearOwner()
 		return nil
-	case oauthprovider.FieldInfoURL:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
+	}
+	return fmt.Errorf("unknown Token unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TokenMutation) ResetEdge(name string) error {
+	switch name {
+	case token.EdgeOwner:
+		m.ResetOwner()
+		return nil
+	}
+	return fmt.Errorf("unknown Token edge %s", name)
+}
+
+// UserMutation represents an operation that mutates the User nodes in the graph.
+type UserMutation struct {
+	config
+	op                          Op
+	typ                         string
+	id                          *uint64
+	created_at                  *time.Time
+	updated_at                  *time.Time
+	status                      *uint8
+	addstatus                   *int8
+	tenant_id                   *uint64
+	addtenant_id                *int64
+	username                    *string
+	password                    *string
+	nickname                    *string
+	side_mode                   *string
+	base_color                  *string
+	active_color                *string
+	role_id                     *uint64
+	addrole_id                  *int64
+	mobile                      *string
+	email                       *string
+	wecom                       *string
+	avatar                      *string
+	token_version               *uint64
+	addtoken_version            *int64
+	totp_secret                 *string
+	totp_enabled                *bool
+	recovery_codes              *[]string
+	appendrecovery_codes        []string
+	password_history            *[]string
+	appendpassword_history      []string
+	pending_approval            *bool
+	password_changed_at         *time.Time
+	clearedFields               map[string]struct{}
+	tokens                      map[uint64]struct{}
+	removedtokens               map[uint64]struct{}
+	clearedtokens               bool
+	webauthn_credentials        map[uint64]struct{}
+	removedwebauthn_credentials map[uint64]struct{}
+	clearedwebauthn_credentials bool
+	api_keys                    map[uint64]struct{}
+	removedapi_keys             map[uint64]struct{}
+	clearedapi_keys             bool
+	identities                  map[uint64]struct{}
+	removedidentities           map[uint64]struct{}
+	clearedidentities           bool
+	roles                       map[uint64]struct{}
+	removedroles                map[uint64]struct{}
+	clearedroles                bool
+	done                        bool
+	oldValue                    func(context.Context) (*User, error)
+	predicates                  []predicate.User
+}
+
+var _ ent.Mutation = (*UserMutation)(nil)
+
+// userOption allows management of the mutation configuration using functional options.
+type userOption func(*UserMutation)
+
+// newUserMutation creates new mutation for the User entity.
+func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
+	m := &UserMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeUser,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withUserID sets the ID field of the mutation.
+func withUserID(id uint64) userOption {
+	return func(m *UserMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *User
+		)
+		m.oldValue = func(ctx context.Context) (*User, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().User.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withUser sets the old User of the mutation.
+func withUser(node *User) userOption {
+	return func(m *UserMutation) {
+		m.oldValue = func(context.Context) (*User, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m UserMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m UserMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of User entities.
+func (m *UserMutation) SetID(id uint64) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *UserMutation) ID() (id uint64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *UserMutation) IDs(ctx context.Context) ([]uint64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint64{id}, nil
 		}
-		m.SetInfoURL(v)
-		return nil
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().User.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *UserMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *UserMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *UserMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *UserMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *UserMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *UserMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *UserMutation) SetStatus(u uint8) {
+	m.status = &u
+	m.addstatus = nil
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *UserMutation) Status() (r uint8, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldStatus(ctx context.Context) (v uint8, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// AddStatus adds u to the "status" field.
+func (m *UserMutation) AddStatus(u int8) {
+	if m.addstatus != nil {
+		*m.addstatus += u
+	} else {
+		m.addstatus = &u
+	}
+}
+
+// AddedStatus returns the value that was added to the "status" field in this mutation.
+func (m *UserMutation) AddedStatus() (r int8, exists bool) {
+	v := m.addstatus
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearStatus clears the value of the "status" field.
+func (m *UserMutation) ClearStatus() {
+	m.status = nil
+	m.addstatus = nil
+	m.clearedFields[user.FieldStatus] = struct{}{}
+}
+
+// StatusCleared returns if the "status" field was cleared in this mutation.
+func (m *UserMutation) StatusCleared() bool {
+	_, ok := m.clearedFields[user.FieldStatus]
+	return ok
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *UserMutation) ResetStatus() {
+	m.status = nil
+	m.addstatus = nil
+	delete(m.clearedFields, user.FieldStatus)
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (m *UserMutation) SetTenantID(u uint64) {
+	m.tenant_id = &u
+	m.addtenant_id = nil
+}
+
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *UserMutation) TenantID() (r uint64, exists bool) {
+	v := m.tenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenantID returns the old "tenant_id" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldTenantID(ctx context.Context) (v uint64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+	}
+	return oldValue.TenantID, nil
+}
+
+// AddTenantID adds u to the "tenant_id" field.
+func (m *UserMutation) AddTenantID(u int64) {
+	if m.addtenant_id != nil {
+		*m.addtenant_id += u
+	} else {
+		m.addtenant_id = &u
+	}
+}
+
+// AddedTenantID returns the value that was added to the "tenant_id" field in this mutation.
+func (m *UserMutation) AddedTenantID() (r int64, exists bool) {
+	v := m.addtenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *UserMutation) ResetTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+}
+
+// SetUsername sets the "username" field.
+func (m *UserMutation) SetUsername(s string) {
+	m.username = &s
+}
+
+// Username returns the value of the "username" field in the mutation.
+func (m *UserMutation) Username() (r string, exists bool) {
+	v := m.username
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUsername returns the old "username" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldUsername(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUsername is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUsername requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUsername: %w", err)
+	}
+	return oldValue.Username, nil
+}
+
+// ResetUsername resets all changes to the "username" field.
+func (m *UserMutation) ResetUsername() {
+	m.username = nil
+}
+
+// SetPassword sets the "password" field.
+func (m *UserMutation) SetPassword(s string) {
+	m.password = &s
+}
+
+// Password returns the value of the "password" field in the mutation.
+func (m *UserMutation) Password() (r string, exists bool) {
+	v := m.password
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPassword returns the old "password" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldPassword(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPassword is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPassword requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPassword: %w", err)
+	}
+	return oldValue.Password, nil
+}
+
+// ResetPassword resets all changes to the "password" field.
+func (m *UserMutation) ResetPassword() {
+	m.password = nil
+}
+
+// SetNickname sets the "nickname" field.
+func (m *UserMutation) SetNickname(s string) {
+	m.nickname = &s
+}
+
+// Nickname returns the value of the "nickname" field in the mutation.
+func (m *UserMutation) Nickname() (r string, exists bool) {
+	v := m.nickname
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNickname returns the old "nickname" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldNickname(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNickname is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNickname requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNickname: %w", err)
+	}
+	return oldValue.Nickname, nil
+}
+
+// ResetNickname resets all changes to the "nickname" field.
+func (m *UserMutation) ResetNickname() {
+	m.nickname = nil
+}
+
+// SetSideMode sets the "side_mode" field.
+func (m *UserMutation) SetSideMode(s string) {
+	m.side_mode = &s
+}
+
+// SideMode returns the value of the "side_mode" field in the mutation.
+func (m *UserMutation) SideMode() (r string, exists bool) {
+	v := m.side_mode
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSideMode returns the old "side_mode" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldSideMode(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSideMode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSideMode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSideMode: %w", err)
+	}
+	return oldValue.SideMode, nil
+}
+
+// ClearSideMode clears the value of the "side_mode" field.
+func (m *UserMutation) ClearSideMode() {
+	m.side_mode = nil
+	m.clearedFields[user.FieldSideMode] = struct{}{}
+}
+
+// SideModeCleared returns if the "side_mode" field was cleared in this mutation.
+func (m *UserMutation) SideModeCleared() bool {
+	_, ok := m.clearedFields[user.FieldSideMode]
+	return ok
+}
+
+// ResetSideMode resets all changes to the "side_mode" field.
+func (m *UserMutation) ResetSideMode() {
+	m.side_mode = nil
+	delete(m.clearedFields, user.FieldSideMode)
+}
+
+// SetBaseColor sets the "base_color" field.
+func (m *UserMutation) SetBaseColor(s string) {
+	m.base_color = &s
+}
+
+// BaseColor returns the value of the "base_color" field in the mutation.
+func (m *UserMutation) BaseColor() (r string, exists bool) {
+	v := m.base_color
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBaseColor returns the old "base_color" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldBaseColor(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBaseColor is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBaseColor requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBaseColor: %w", err)
+	}
+	return oldValue.BaseColor, nil
+}
+
+// ClearBaseColor clears the value of the "base_color" field.
+func (m *UserMutation) ClearBaseColor() {
+	m.base_color = nil
+	m.clearedFields[user.FieldBaseColor] = struct{}{}
+}
+
+// BaseColorCleared returns if the "base_color" field was cleared in this mutation.
+func (m *UserMutation) BaseColorCleared() bool {
+	_, ok := m.clearedFields[user.FieldBaseColor]
+	return ok
+}
+
+// ResetBaseColor resets all changes to the "base_color" field.
+func (m *UserMutation) ResetBaseColor() {
+	m.base_color = nil
+	delete(m.clearedFields, user.FieldBaseColor)
+}
+
+// SetActiveColor sets the "active_color" field.
+func (m *UserMutation) SetActiveColor(s string) {
+	m.active_color = &s
+}
+
+// ActiveColor returns the value of the "active_color" field in the mutation.
+func (m *UserMutation) ActiveColor() (r string, exists bool) {
+	v := m.active_color
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldActiveColor returns the old "active_color" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldActiveColor(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActiveColor is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActiveColor requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActiveColor: %w", err)
+	}
+	return oldValue.ActiveColor, nil
+}
+
+// ClearActiveColor clears the value of the "active_color" field.
+func (m *UserMutation) ClearActiveColor() {
+	m.active_color = nil
+	m.clearedFields[user.FieldActiveColor] = struct{}{}
+}
+
+// ActiveColorCleared returns if the "active_color" field was cleared in this mutation.
+func (m *UserMutation) ActiveColorCleared() bool {
+	_, ok := m.clearedFields[user.FieldActiveColor]
+	return ok
+}
+
+// ResetActiveColor resets all changes to the "active_color" field.
+func (m *UserMutation) ResetActiveColor() {
+	m.active_color = nil
+	delete(m.clearedFields, user.FieldActiveColor)
+}
+
+// SetRoleID sets the "role_id" field.
+func (m *UserMutation) SetRoleID(u uint64) {
+	m.role_id = &u
+	m.addrole_id = nil
+}
+
+// RoleID returns the value of the "role_id" field in the mutation.
+func (m *UserMutation) RoleID() (r uint64, exists bool) {
+	v := m.role_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRoleID returns the old "role_id" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldRoleID(ctx context.Context) (v uint64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRoleID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRoleID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRoleID: %w", err)
+	}
+	return oldValue.RoleID, nil
+}
+
+// AddRoleID adds u to the "role_id" field.
+func (m *UserMutation) AddRoleID(u int64) {
+	if m.addrole_id != nil {
+		*m.addrole_id += u
+	} else {
+		m.addrole_id = &u
 	}
-	return fmt.Errorf("unknown OauthProvider field %s", name)
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *OauthProviderMutation) AddedFields() []string {
-	var fields []string
-	if m.addauth_style != nil {
-		fields = append(fields, oauthprovider.FieldAuthStyle)
+// AddedRoleID returns the value that was added to the "role_id" field in this mutation.
+func (m *UserMutation) AddedRoleID() (r int64, exists bool) {
+	v := m.addrole_id
+	if v == nil {
+		return
 	}
-	return fields
+	return *v, true
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *OauthProviderMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case oauthprovider.FieldAuthStyle:
-		return m.AddedAuthStyle()
-	}
-	return nil, false
+// ClearRoleID clears the value of the "role_id" field.
+func (m *UserMutation) ClearRoleID() {
+	m.role_id = nil
+	m.addrole_id = nil
+	m.clearedFields[user.FieldRoleID] = struct{}{}
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *OauthProviderMutation) AddField(name string, value ent.Value) error {
-	switch name {
-	case oauthprovider.FieldAuthStyle:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddAuthStyle(v)
-		return nil
-	}
-	return fmt.Errorf("unknown OauthProvider numeric field %s", name)
+// RoleIDCleared returns if the "role_id" field was cleared in this mutation.
+func (m *UserMutation) RoleIDCleared() bool {
+	_, ok := m.clearedFields[user.FieldRoleID]
+	return ok
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *OauthProviderMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(oauthprovider.FieldAppID) {
-		fields = append(fields, oauthprovider.FieldAppID)
-	}
-	if m.FieldCleared(oauthprovider.FieldClientSecret) {
-		fields = append(fields, oauthprovider.FieldClientSecret)
-	}
-	if m.FieldCleared(oauthprovider.FieldScopes) {
-		fields = append(fields, oauthprovider.FieldScopes)
+// ResetRoleID resets all changes to the "role_id" field.
+func (m *UserMutation) ResetRoleID() {
+	m.role_id = nil
+	m.addrole_id = nil
+	delete(m.clearedFields, user.FieldRoleID)
+}
+
+// SetMobile sets the "mobile" field.
+func (m *UserMutation) SetMobile(s string) {
+	m.mobile = &s
+}
+
+// Mobile returns the value of the "mobile" field in the mutation.
+func (m *UserMutation) Mobile() (r string, exists bool) {
+	v := m.mobile
+	if v == nil {
+		return
 	}
-	if m.FieldCleared(oauthprovider.FieldTokenURL) {
-		fields = append(fields, oauthprovider.FieldTokenURL)
+	return *v, true
+}
+
+// OldMobile returns the old "mobile" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldMobile(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMobile is only allowed on UpdateOne operations")
 	}
-	if m.FieldCleared(oauthprovider.FieldAuthStyle) {
-		fields = append(fields, oauthprovider.FieldAuthStyle)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMobile requires an ID field in the mutation")
 	}
-	if m.FieldCleared(oauthprovider.FieldInfoURL) {
-		fields = append(fields, oauthprovider.FieldInfoURL)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMobile: %w", err)
 	}
-	return fields
+	return oldValue.Mobile, nil
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *OauthProviderMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
-	return ok
+// ResetMobile resets all changes to the "mobile" field.
+func (m *UserMutation) ResetMobile() {
+	m.mobile = nil
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *OauthProviderMutation) ClearField(name string) error {
-	switch name {
-	case oauthprovider.FieldAppID:
-		m.ClearAppID()
-		return nil
-	case oauthprovider.FieldClientSecret:
-		m.ClearClientSecret()
-		return nil
-	case oauthprovider.FieldScopes:
-		m.ClearScopes()
-		return nil
-	case oauthprovider.FieldTokenURL:
-		m.ClearTokenURL()
-		return nil
-	case oauthprovider.FieldAuthStyle:
-		m.ClearAuthStyle()
-		return nil
-	case oauthprovider.FieldInfoURL:
-		m.ClearInfoURL()
-		return nil
+// SetEmail sets the "email" field.
+func (m *UserMutation) SetEmail(s string) {
+	m.email = &s
+}
+
+// Email returns the value of the "email" field in the mutation.
+func (m *UserMutation) Email() (r string, exists bool) {
+	v := m.email
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown OauthProvider nullable field %s", name)
+	return *v, true
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *OauthProviderMutation) ResetField(name string) error {
-	switch name {
-	case oauthprovider.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case oauthprovider.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
-	case oauthprovider.FieldName:
-		m.ResetName()
-		return nil
-	case oauthprovider.FieldAppID:
-		m.ResetAppID()
-		return nil
-	case oauthprovider.FieldClientID:
-		m.ResetClientID()
-		return nil
-	case oauthprovider.FieldClientSecret:
-		m.ResetClientSecret()
-		return nil
-	case oauthprovider.FieldRedirectURL:
-		m.ResetRedirectURL()
-		return nil
-	case oauthprovider.FieldScopes:
-		m.ResetScopes()
-		return nil
-	case oauthprovider.FieldAuthURL:
-		m.ResetAuthURL()
-		return nil
-	case oauthprovider.FieldTokenURL:
-		m.ResetTokenURL()
-		return nil
-	case oauthprovider.FieldAuthStyle:
-		m.ResetAuthStyle()
-		return nil
-	case oauthprovider.FieldInfoURL:
-		m.ResetInfoURL()
-		return nil
+// OldEmail returns the old "email" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldEmail(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown OauthProvider field %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmail requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+	}
+	return oldValue.Email, nil
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *OauthProviderMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
+// ClearEmail clears the value of the "email" field.
+func (m *UserMutation) ClearEmail() {
+	m.email = nil
+	m.clearedFields[user.FieldEmail] = struct{}{}
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *OauthProviderMutation) AddedIDs(name string) []ent.Value {
-	return nil
+// EmailCleared returns if the "email" field was cleared in this mutation.
+func (m *UserMutation) EmailCleared() bool {
+	_, ok := m.clearedFields[user.FieldEmail]
+	return ok
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *OauthProviderMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
+// ResetEmail resets all changes to the "email" field.
+func (m *UserMutation) ResetEmail() {
+	m.email = nil
+	delete(m.clearedFields, user.FieldEmail)
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *OauthProviderMutation) RemovedIDs(name string) []ent.Value {
-	return nil
+// SetWecom sets the "wecom" field.
+func (m *UserMutation) SetWecom(s string) {
+	m.wecom = &s
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *OauthProviderMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
+// Wecom returns the value of the "wecom" field in the mutation.
+func (m *UserMutation) Wecom() (r string, exists bool) {
+	v := m.wecom
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *OauthProviderMutation) EdgeCleared(name string) bool {
-	return false
+// OldWecom returns the old "wecom" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldWecom(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWecom is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWecom requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWecom: %w", err)
+	}
+	return oldValue.Wecom, nil
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *OauthProviderMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown OauthProvider unique edge %s", name)
+// ClearWecom clears the value of the "wecom" field.
+func (m *UserMutation) ClearWecom() {
+	m.wecom = nil
+	m.clearedFields[user.FieldWecom] = struct{}{}
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *OauthProviderMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown OauthProvider edge %s", name)
+// WecomCleared returns if the "wecom" field was cleared in this mutation.
+func (m *UserMutation) WecomCleared() bool {
+	_, ok := m.clearedFields[user.FieldWecom]
+	return ok
 }
 
-// RoleMutation represents an operation that mutates the Role nodes in the graph.
-type RoleMutation struct {
-	config
-	op             Op
-	typ            string
-	id             *uint64
-	created_at     *time.Time
-	updated_at     *time.Time
-	status         *uint8
-	addstatus      *int8
-	name           *string
-	value          *string
-	default_router *string
-	remark         *string
-	order_no       *uint32
-	addorder_no    *int32
-	clearedFields  map[string]struct{}
-	menus          map[uint64]struct{}
-	removedmenus   map[uint64]struct{}
-	clearedmenus   bool
-	done           bool
-	oldValue       func(context.Context) (*Role, error)
-	predicates     []predicate.Role
+// ResetWecom resets all changes to the "wecom" field.
+func (m *UserMutation) ResetWecom() {
+	m.wecom = nil
+	delete(m.clearedFields, user.FieldWecom)
 }
 
-var _ ent.Mutation = (*RoleMutation)(nil)
+// SetAvatar sets the "avatar" field.
+func (m *UserMutation) SetAvatar(s string) {
+	m.avatar = &s
+}
 
-// roleOption allows management of the mutation configuration using functional options.
-type roleOption func(*RoleMutation)
+// Avatar returns the value of the "avatar" field in the mutation.
+func (m *UserMutation) Avatar() (r string, exists bool) {
+	v := m.avatar
+	if v == nil {
+		return
+	}
+	return *v, true
+}
 
-// newRoleMutation creates new mutation for the Role entity.
-func newRoleMutation(c config, op Op, opts ...roleOption) *RoleMutation {
-	m := &RoleMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeRole,
-		clearedFields: make(map[string]struct{}),
+// OldAvatar returns the old "avatar" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldAvatar(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAvatar is only allowed on UpdateOne operations")
 	}
-	for _, opt := range opts {
-		opt(m)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAvatar requires an ID field in the mutation")
 	}
-	return m
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAvatar: %w", err)
+	}
+	return oldValue.Avatar, nil
 }
 
-// withRoleID sets the ID field of the mutation.
-func withRoleID(id uint64) roleOption {
-	return func(m *RoleMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *Role
-		)
-		m.oldValue = func(ctx context.Context) (*Role, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().Role.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
-	}
+// ClearAvatar clears the value of the "avatar" field.
+func (m *UserMutation) ClearAvatar() {
+	m.avatar = nil
+	m.clearedFields[user.FieldAvatar] = struct{}{}
 }
 
-// withRole sets the old Role of the mutation.
-func withRole(node *Role) roleOption {
-	return func(m *RoleMutation) {
-		m.oldValue = func(context.Context) (*Role, error) {
-			return node, nil
-		}
-		m.id = &node.ID
-	}
+// AvatarCleared returns if the "avatar" field was cleared in this mutation.
+func (m *UserMutation) AvatarCleared() bool {
+	_, ok := m.clearedFields[user.FieldAvatar]
+	return ok
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m RoleMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// ResetAvatar resets all changes to the "avatar" field.
+func (m *UserMutation) ResetAvatar() {
+	m.avatar = nil
+	delete(m.clearedFields, user.FieldAvatar)
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m RoleMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
+// SetTokenVersion sets the "token_version" field.
+func (m *UserMutation) SetTokenVersion(u uint64) {
+	m.token_version = &u
+	m.addtoken_version = nil
+}
+
+// TokenVersion returns the value of the "token_version" field in the mutation.
+func (m *UserMutation) TokenVersion() (r uint64, exists bool) {
+	v := m.token_version
+	if v == nil {
+		return
 	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+	return *v, true
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Role entities.
-func (m *RoleMutation) SetID(id uint64) {
-	m.id = &id
+// OldTokenVersion returns the old "token_version" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldTokenVersion(ctx context.Context) (v uint64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTokenVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTokenVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTokenVersion: %w", err)
+	}
+	return oldValue.TokenVersion, nil
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *RoleMutation) ID() (id uint64, exists bool) {
-	if m.id == nil {
-		return
+// AddTokenVersion adds u to the "token_version" field.
+func (m *UserMutation) AddTokenVersion(u int64) {
+	if m.addtoken_version != nil {
+		*m.addtoken_version += u
+	} else {
+		m.addtoken_version = &u
 	}
-	return *m.id, true
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *RoleMutation) IDs(ctx context.Context) ([]uint64, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []uint64{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Role.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+// AddedTokenVersion returns the value that was added to the "token_version" field in this mutation.
+func (m *UserMutation) AddedTokenVersion() (r int64, exists bool) {
+	v := m.addtoken_version
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *RoleMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// ResetTokenVersion resets all changes to the "token_version" field.
+func (m *UserMutation) ResetTokenVersion() {
+	m.token_version = nil
+	m.addtoken_version = nil
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *RoleMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// SetTotpSecret sets the "totp_secret" field.
+func (m *UserMutation) SetTotpSecret(s string) {
+	m.totp_secret = &s
+}
+
+// TotpSecret returns the value of the "totp_secret" field in the mutation.
+func (m *UserMutation) TotpSecret() (r string, exists bool) {
+	v := m.totp_secret
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Role entity.
-// If the Role object wasn't provided to the builder, the object is fetched from the database.
+// OldTotpSecret returns the old "totp_secret" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RoleMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *UserMutation) OldTotpSecret(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldTotpSecret is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldTotpSecret requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldTotpSecret: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.TotpSecret, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *RoleMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ClearTotpSecret clears the value of the "totp_secret" field.
+func (m *UserMutation) ClearTotpSecret() {
+	m.totp_secret = nil
+	m.clearedFields[user.FieldTotpSecret] = struct{}{}
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *RoleMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// TotpSecretCleared returns if the "totp_secret" field was cleared in this mutation.
+func (m *UserMutation) TotpSecretCleared() bool {
+	_, ok := m.clearedFields[user.FieldTotpSecret]
+	return ok
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *RoleMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// ResetTotpSecret resets all changes to the "totp_secret" field.
+func (m *UserMutation) ResetTotpSecret() {
+	m.totp_secret = nil
+	delete(m.clearedFields, user.FieldTotpSecret)
+}
+
+// SetTotpEnabled sets the "totp_enabled" field.
+func (m *UserMutation) SetTotpEnabled(b bool) {
+	m.totp_enabled = &b
+}
+
+// TotpEnabled returns the value of the "totp_enabled" field in the mutation.
+func (m *UserMutation) TotpEnabled() (r bool, exists bool) {
+	v := m.totp_enabled
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Role entity.
-// If the Role object wasn't provided to the builder, the object is fetched from the database.
+// OldTotpEnabled returns the old "totp_enabled" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RoleMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *UserMutation) OldTotpEnabled(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldTotpEnabled is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldTotpEnabled requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldTotpEnabled: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.TotpEnabled, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *RoleMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ResetTotpEnabled resets all changes to the "totp_enabled" field.
+func (m *UserMutation) ResetTotpEnabled() {
+	m.totp_enabled = nil
 }
 
-// SetStatus sets the "status" field.
-func (m *RoleMutation) SetStatus(u uint8) {
-	m.status = &u
-	m.addstatus = nil
+// SetRecoveryCodes sets the "recovery_codes" field.
+func (m *UserMutation) SetRecoveryCodes(s []string) {
+	m.recovery_codes = &s
+	m.appendrecovery_codes = nil
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *RoleMutation) Status() (r uint8, exists bool) {
-	v := m.status
+// RecoveryCodes returns the value of the "recovery_codes" field in the mutation.
+func (m *UserMutation) RecoveryCodes() (r []string, exists bool) {
+	v := m.recovery_codes
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStatus returns the old "status" field's value of the Role entity.
-// If the Role object wasn't provided to the builder, the object is fetched from the database.
+// OldRecoveryCodes returns the old "recovery_codes" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RoleMutation) OldStatus(ctx context.Context) (v uint8, err error) {
+func (m *UserMutation) OldRecoveryCodes(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+		return v, errors.New("OldRecoveryCodes is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
+		return v, errors.New("OldRecoveryCodes requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+		return v, fmt.Errorf("querying old value for OldRecoveryCodes: %w", err)
 	}
-	return oldValue.Status, nil
+	return oldValue.RecoveryCodes, nil
 }
 
-// AddStatus adds u to the "status" field.
-func (m *RoleMutation) AddStatus(u int8) {
-	if m.addstatus != nil {
-		*m.addstatus += u
-	} else {
-		m.addstatus = &u
+// AppendRecoveryCodes adds s to the "recovery_codes" field.
+func (m *UserMutation) AppendRecoveryCodes(s []string) {
+	m.appendrecovery_codes = append(m.appendrecovery_codes, s...)
+}
+
+// AppendedRecoveryCodes returns the list of values that were appended to the "recovery_codes" field in this mutation.
+func (m *UserMutation) AppendedRecoveryCodes() ([]string, bool) {
+	if len(m.appendrecovery_codes) == 0 {
+		return nil, false
 	}
+	return m.appendrecovery_codes, true
 }
 
-// AddedStatus returns the value that was added to the "status" field in this mutation.
-func (m *RoleMutation) AddedStatus() (r int8, exists bool) {
-	v := m.addstatus
+// ClearRecoveryCodes clears the value of the "recovery_codes" field.
+func (m *UserMutation) ClearRecoveryCodes() {
+	m.recovery_codes = nil
+	m.appendrecovery_codes = nil
+	m.clearedFields[user.FieldRecoveryCodes] = struct{}{}
+}
+
+// RecoveryCodesCleared returns if the "recovery_codes" field was cleared in this mutation.
+func (m *UserMutation) RecoveryCodesCleared() bool {
+	_, ok := m.clearedFields[user.FieldRecoveryCodes]
+	return ok
+}
+
+// ResetRecoveryCodes resets all changes to the "recovery_codes" field.
+func (m *UserMutation) ResetRecoveryCodes() {
+	m.recovery_codes = nil
+	m.appendrecovery_codes = nil
+	delete(m.clearedFields, user.FieldRecoveryCodes)
+}
+
+// SetPasswordHistory sets the "password_history" field.
+func (m *UserMutation) SetPasswordHistory(s []string) {
+	m.password_history = &s
+	m.appendpassword_history = nil
+}
+
+// PasswordHistory returns the value of the "password_history" field in the mutation.
+func (m *UserMutation) PasswordHistory() (r []string, exists bool) {
+	v := m.password_history
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ClearStatus clears the value of the "status" field.
-func (m *RoleMutation) ClearStatus() {
-	m.status = nil
-	m.addstatus = nil
-	m.clearedFields[role.FieldStatus] = struct{}{}
+// OldPasswordHistory returns the old "password_history" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldPasswordHistory(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPasswordHistory is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPasswordHistory requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPasswordHistory: %w", err)
+	}
+	return oldValue.PasswordHistory, nil
+}
+
+// AppendPasswordHistory adds s to the "password_history" field.
+func (m *UserMutation) AppendPasswordHistory(s []string) {
+	m.appendpassword_history = append(m.appendpassword_history, s...)
+}
+
+// AppendedPasswordHistory returns the list of values that were appended to the "password_history" field in this mutation.
+func (m *UserMutation) AppendedPasswordHistory() ([]string, bool) {
+	if len(m.appendpassword_history) == 0 {
+		return nil, false
+	}
+	return m.appendpassword_history, true
 }
 
-// StatusCleared returns if the "status" field was cleared in this mutation.
-func (m *RoleMutation) StatusCleared() bool {
-	_, ok := m.clearedFields[role.FieldStatus]
+// ClearPasswordHistory clears the value of the "password_history" field.
+func (m *UserMutation) ClearPasswordHistory() {
+	m.password_history = nil
+	m.appendpassword_history = nil
+	m.clearedFields[user.FieldPasswordHistory] = struct{}{}
+}
+
+// PasswordHistoryCleared returns if the "password_history" field was cleared in this mutation.
+func (m *UserMutation) PasswordHistoryCleared() bool {
+	_, ok := m.clearedFields[user.FieldPasswordHistory]
 	return ok
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *RoleMutation) ResetStatus() {
-	m.status = nil
-	m.addstatus = nil
-	delete(m.clearedFields, role.FieldStatus)
+// ResetPasswordHistory resets all changes to the "password_history" field.
+func (m *UserMutation) ResetPasswordHistory() {
+	m.password_history = nil
+	m.appendpassword_history = nil
+	delete(m.clearedFields, user.FieldPasswordHistory)
 }
 
-// SetName sets the "name" field.
-func (m *RoleMutation) SetName(s string) {
-	m.name = &s
+// SetPendingApproval sets the "pending_approval" field.
+func (m *UserMutation) SetPendingApproval(b bool) {
+	m.pending_approval = &b
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *RoleMutation) Name() (r string, exists bool) {
-	v := m.name
+// PendingApproval returns the value of the "pending_approval" field in the mutation.
+func (m *UserMutation) PendingApproval() (r bool, exists bool) {
+	v := m.pending_approval
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Role entity.
-// If the Role object wasn't provided to the builder, the object is fetched from the database.
+// OldPendingApproval returns the old "pending_approval" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RoleMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *UserMutation) OldPendingApproval(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldPendingApproval is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldPendingApproval requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldPendingApproval: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.PendingApproval, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *RoleMutation) ResetName() {
-	m.name = nil
+// ResetPendingApproval resets all changes to the "pending_approval" field.
+func (m *UserMutation) ResetPendingApproval() {
+	m.pending_approval = nil
 }
 
-// SetValue sets the "value" field.
-func (m *RoleMutation) SetValue(s string) {
-	m.value = &s
+// SetPasswordChangedAt sets the "password_changed_at" field.
+func (m *UserMutation) SetPasswordChangedAt(t time.Time) {
+	m.password_changed_at = &t
 }
 
-// Value returns the value of the "value" field in the mutation.
-func (m *RoleMutation) Value() (r string, exists bool) {
-	v := m.value
+// PasswordChangedAt returns the value of the "password_changed_at" field in the mutation.
+func (m *UserMutation) PasswordChangedAt() (r time.Time, exists bool) {
+	v := m.password_changed_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldValue returns the old "value" field's value of the Role entity.
-// If the Role object wasn't provided to the builder, the object is fetched from the database.
+// OldPasswordChangedAt returns the old "password_changed_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RoleMutation) OldValue(ctx context.Context) (v string, err error) {
+func (m *UserMutation) OldPasswordChangedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldValue is only allowed on UpdateOne operations")
+		return v, errors.New("OldPasswordChangedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldValue requires an ID field in the mutation")
+		return v, errors.New("OldPasswordChangedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldValue: %w", err)
+		return v, fmt.Errorf("querying old value for OldPasswordChangedAt: %w", err)
 	}
-	return oldValue.Value, nil
+	return oldValue.PasswordChangedAt, nil
 }
 
-// ResetValue resets all changes to the "value" field.
-func (m *RoleMutation) ResetValue() {
-	m.value = nil
+// ClearPasswordChangedAt clears the value of the "password_changed_at" field.
+func (m *UserMutation) ClearPasswordChangedAt() {
+	m.password_changed_at = nil
+	m.clearedFields[user.FieldPasswordChangedAt] = struct{}{}
 }
 
-// SetDefaultRouter sets the "default_router" field.
-func (m *RoleMutation) SetDefaultRouter(s string) {
-	m.default_router = &s
+// PasswordChangedAtCleared returns if the "password_changed_at" field was cleared in this mutation.
+func (m *UserMutation) PasswordChangedAtCleared() bool {
+	_, ok := m.clearedFields[user.FieldPasswordChangedAt]
+	return ok
 }
 
-// DefaultRouter returns the value of the "default_router" field in the mutation.
-func (m *RoleMutation) DefaultRouter() (r string, exists bool) {
-	v := m.default_router
-	if v == nil {
-		return
+// ResetPasswordChangedAt resets all changes to the "password_changed_at" field.
+func (m *UserMutation) ResetPasswordChangedAt() {
+	m.password_changed_at = nil
+	delete(m.clearedFields, user.FieldPasswordChangedAt)
+}
+
+// AddTokenIDs adds the "tokens" edge to the Token entity by ids.
+func (m *UserMutation) AddTokenIDs(ids ...uint64) {
+	if m.tokens == nil {
+		m.tokens = make(map[uint64]struct{})
+	}
+	for i := range ids {
+		m.tokens[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// OldDefaultRouter returns the old "default_router" field's value of the Role entity.
-// If the Role object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RoleMutation) OldDefaultRouter(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDefaultRouter is only allowed on UpdateOne operations")
+// ClearTokens clears the "tokens" edge to the Token entity.
+func (m *UserMutation) ClearTokens() {
+	m.clearedtokens = true
+}
+
+// TokensCleared reports if the "tokens" edge to the Token entity was cleared.
+func (m *UserMutation) TokensCleared() bool {
+	return m.clearedtokens
+}
+
+// RemoveTokenIDs removes the "tokens" edge to the Token entity by IDs.
+func (m *UserMutation) RemoveTokenIDs(ids ...uint64) {
+	if m.removedtokens == nil {
+		m.removedtokens = make(map[uint64]struct{})
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDefaultRouter requires an ID field in the mutation")
+	for i := range ids {
+		delete(m.tokens, ids[i])
+		m.removedtokens[ids[i]] = struct{}{}
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDefaultRouter: %w", err)
+}
+
+// RemovedTokens returns the removed IDs of the "tokens" edge to the Token entity.
+func (m *UserMutation) RemovedTokensIDs() (ids []uint64) {
+	for id := range m.removedtokens {
+		ids = append(ids, id)
 	}
-	return oldValue.DefaultRouter, nil
+	return
 }
 
-// ResetDefaultRouter resets all changes to the "default_router" field.
-func (m *RoleMutation) ResetDefaultRouter() {
-	m.default_router = nil
+// TokensIDs returns the "tokens" edge IDs in the mutation.
+func (m *UserMutation) TokensIDs() (ids []uint64) {
+	for id := range m.tokens {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// SetRemark sets the "remark" field.
-func (m *RoleMutation) SetRemark(s string) {
-	m.remark = &s
+// ResetTokens resets all changes to the "tokens" edge.
+func (m *UserMutation) ResetTokens() {
+	m.tokens = nil
+	m.clearedtokens = false
+	m.removedtokens = nil
 }
 
-// Remark returns the value of the "remark" field in the mutation.
-func (m *RoleMutation) Remark() (r string, exists bool) {
-	v := m.remark
-	if v == nil {
-		return
+// AddWebauthnCredentialIDs adds the "webauthn_credentials" edge to the WebauthnCredential entity by ids.
+func (m *UserMutation) AddWebauthnCredentialIDs(ids ...uint64) {
+	if m.webauthn_credentials == nil {
+		m.webauthn_credentials = make(map[uint64]struct{})
+	}
+	for i := range ids {
+		m.webauthn_credentials[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// OldRemark returns the old "remark" field's value of the Role entity.
-// If the Role object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RoleMutation) OldRemark(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRemark is only allowed on UpdateOne operations")
+// ClearWebauthnCredentials clears the "webauthn_credentials" edge to the WebauthnCredential entity.
+func (m *UserMutation) ClearWebauthnCredentials() {
+	m.clearedwebauthn_credentials = true
+}
+
+// WebauthnCredentialsCleared reports if the "webauthn_credentials" edge to the WebauthnCredential entity was cleared.
+func (m *UserMutation) WebauthnCredentialsCleared() bool {
+	return m.clearedwebauthn_credentials
+}
+
+// RemoveWebauthnCredentialIDs removes the "webauthn_credentials" edge to the WebauthnCredential entity by IDs.
+func (m *UserMutation) RemoveWebauthnCredentialIDs(ids ...uint64) {
+	if m.removedwebauthn_credentials == nil {
+		m.removedwebauthn_credentials = make(map[uint64]struct{})
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRemark requires an ID field in the mutation")
+	for i := range ids {
+		delete(m.webauthn_credentials, ids[i])
+		m.removedwebauthn_credentials[ids[i]] = struct{}{}
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRemark: %w", err)
+}
+
+// RemovedWebauthnCredentials returns the removed IDs of the "webauthn_credentials" edge to the WebauthnCredential entity.
+func (m *UserMutation) RemovedWebauthnCredentialsIDs() (ids []uint64) {
+	for id := range m.removedwebauthn_credentials {
+		ids = append(ids, id)
 	}
-	return oldValue.Remark, nil
+	return
 }
 
-// ResetRemark resets all changes to the "remark" field.
-func (m *RoleMutation) ResetRemark() {
-	m.remark = nil
+// WebauthnCredentialsIDs returns the "webauthn_credentials" edge IDs in the mutation.
+func (m *UserMutation) WebauthnCredentialsIDs() (ids []uint64) {
+	for id := range m.webauthn_credentials {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// SetOrderNo sets the "order_no" field.
-func (m *RoleMutation) SetOrderNo(u uint32) {
-	m.order_no = &u
-	m.addorder_no = nil
+// ResetWebauthnCredentials resets all changes to the "webauthn_credentials" edge.
+func (m *UserMutation) ResetWebauthnCredentials() {
+	m.webauthn_credentials = nil
+	m.clearedwebauthn_credentials = false
+	m.removedwebauthn_credentials = nil
 }
 
-// OrderNo returns the value of the "order_no" field in the mutation.
-func (m *RoleMutation) OrderNo() (r uint32, exists bool) {
-	v := m.order_no
-	if v == nil {
-		return
+// AddAPIKeyIDs adds the "api_keys" edge to the APIKey entity by ids.
+func (m *UserMutation) AddAPIKeyIDs(ids ...uint64) {
+	if m.api_keys == nil {
+		m.api_keys = make(map[uint64]struct{})
+	}
+	for i := range ids {
+		m.api_keys[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// OldOrderNo returns the old "order_no" field's value of the Role entity.
-// If the Role object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RoleMutation) OldOrderNo(ctx context.Context) (v uint32, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOrderNo is only allowed on UpdateOne operations")
+// ClearAPIKeys clears the "api_keys" edge to the APIKey entity.
+func (m *UserMutation) ClearAPIKeys() {
+	m.clearedapi_keys = true
+}
+
+// APIKeysCleared reports if the "api_keys" edge to the APIKey entity was cleared.
+func (m *UserMutation) APIKeysCleared() bool {
+	return m.clearedapi_keys
+}
+
+// RemoveAPIKeyIDs removes the "api_keys" edge to the APIKey entity by IDs.
+func (m *UserMutation) RemoveAPIKeyIDs(ids ...uint64) {
+	if m.removedapi_keys == nil {
+		m.removedapi_keys = make(map[uint64]struct{})
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOrderNo requires an ID field in the mutation")
+	for i := range ids {
+		delete(m.api_keys, ids[i])
+		m.removedapi_keys[ids[i]] = struct{}{}
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOrderNo: %w", err)
+}
+
+// RemovedAPIKeys returns the removed IDs of the "api_keys" edge to the APIKey entity.
+func (m *UserMutation) RemovedAPIKeysIDs() (ids []uint64) {
+	for id := range m.removedapi_keys {
+		ids = append(ids, id)
 	}
-	return oldValue.OrderNo, nil
+	return
 }
 
-// AddOrderNo adds u to the "order_no" field.
-func (m *RoleMutation) AddOrderNo(u int32) {
-	if m.addorder_no != nil {
-		*m.addorder_no += u
-	} else {
-		m.addorder_no = &u
+// APIKeysIDs returns the "api_keys" edge IDs in the mutation.
+func (m *UserMutation) APIKeysIDs() (ids []uint64) {
+	for id := range m.api_keys {
+		ids = append(ids, id)
 	}
+	return
 }
 
-// AddedOrderNo returns the value that was added to the "order_no" field in this mutation.
-func (m *RoleMutation) AddedOrderNo() (r int32, exists bool) {
-	v := m.addorder_no
-	if v == nil {
-		return
+// ResetAPIKeys resets all changes to the "api_keys" edge.
+func (m *UserMutation) ResetAPIKeys() {
+	m.api_keys = nil
+	m.clearedapi_keys = false
+	m.removedapi_keys = nil
+}
+
+// AddIdentityIDs adds the "identities" edge to the UserIdentity entity by ids.
+func (m *UserMutation) AddIdentityIDs(ids ...uint64) {
+	if m.identities == nil {
+		m.identities = make(map[uint64]struct{})
+	}
+	for i := range ids {
+		m.identities[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// ResetOrderNo resets all changes to the "order_no" field.
-func (m *RoleMutation) ResetOrderNo() {
-	m.order_no = nil
-	m.addorder_no = nil
+// ClearIdentities clears the "identities" edge to the UserIdentity entity.
+func (m *UserMutation) ClearIdentities() {
+	m.clearedidentities = true
 }
 
-// AddMenuIDs adds the "menus" edge to the Menu entity by ids.
-func (m *RoleMutation) AddMenuIDs(ids ...uint64) {
-	if m.menus == nil {
-		m.menus = make(map[uint64]struct{})
+// IdentitiesCleared reports if the "identities" edge to the UserIdentity entity was cleared.
+func (m *UserMutation) IdentitiesCleared() bool {
+	return m.clearedidentities
+}
+
+// RemoveIdentityIDs removes the "identities" edge to the UserIdentity entity by IDs.
+func (m *UserMutation) RemoveIdentityIDs(ids ...uint64) {
+	if m.removedidentities == nil {
+		m.removedidentities = make(map[uint64]struct{})
 	}
 	for i := range ids {
-		m.menus[ids[i]] = struct{}{}
+		delete(m.identities, ids[i])
+		m.removedidentities[ids[i]] = struct{}{}
 	}
 }
 
-// ClearMenus clears the "menus" edge to the Menu entity.
-func (m *RoleMutation) ClearMenus() {
-	m.clearedmenus = true
+// RemovedIdentities returns the removed IDs of the "identities" edge to the UserIdentity entity.
+func (m *UserMutation) RemovedIdentitiesIDs() (ids []uint64) {
+	for id := range m.removedidentities {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// MenusCleared reports if the "menus" edge to the Menu entity was cleared.
-func (m *RoleMutation) MenusCleared() bool {
-	return m.clearedmenus
+// IdentitiesIDs returns the "identities" edge IDs in the mutation.
+func (m *UserMutation) IdentitiesIDs() (ids []uint64) {
+	for id := range m.identities {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// RemoveMenuIDs removes the "menus" edge to the Menu entity by IDs.
-func (m *RoleMutation) RemoveMenuIDs(ids ...uint64) {
-	if m.removedmenus == nil {
-		m.removedmenus = make(map[uint64]struct{})
+// ResetIdentities resets all changes to the "identities" edge.
+func (m *UserMutation) ResetIdentities() {
+	m.identities = nil
+	m.clearedidentities = false
+	m.removedidentities = nil
+}
+
+// AddRoleIDs adds the "roles" edge to the Role entity by ids.
+func (m *UserMutation) AddRoleIDs(ids ...uint64) {
+	if m.roles == nil {
+		m.roles = make(map[uint64]struct{})
 	}
 	for i := range ids {
-		delete(m.menus, ids[i])
-		m.removedmenus[ids[i]] = struct{}{}
+		m.roles[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedMenus returns the removed IDs of the "menus" edge to the Menu entity.
-func (m *RoleMutation) RemovedMenusIDs() (ids []uint64) {
-	for id := range m.removedmenus {
+// ClearRoles clears the "roles" edge to the Role entity.
+func (m *UserMutation) ClearRoles() {
+	m.clearedroles = true
+}
+
+// RolesCleared reports if the "roles" edge to the Role entity was cleared.
+func (m *UserMutation) RolesCleared() bool {
+	return m.clearedroles
+}
+
+// RemoveRoleIDs removes the "roles" edge to the Role entity by IDs.
+func (m *UserMutation) RemoveRoleIDs(ids ...uint64) {
+	if m.removedroles == nil {
+		m.removedroles = make(map[uint64]struct{})
+	}
+	for i := range ids {
+		delete(m.roles, ids[i])
+		m.removedroles[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedRoles returns the removed IDs of the "roles" edge to the Role entity.
+func (m *UserMutation) RemovedRolesIDs() (ids []uint64) {
+	for id := range m.removedroles {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// MenusIDs returns the "menus" edge IDs in the mutation.
-func (m *RoleMutation) MenusIDs() (ids []uint64) {
-	for id := range m.menus {
+// RolesIDs returns the "roles" edge IDs in the mutation.
+func (m *UserMutation) RolesIDs() (ids []uint64) {
+	for id := range m.roles {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetMenus resets all changes to the "menus" edge.
-func (m *RoleMutation) ResetMenus() {
-	m.menus = nil
-	m.clearedmenus = false
-	m.removedmenus = nil
+// ResetRoles resets all changes to the "roles" edge.
+func (m *UserMutation) ResetRoles() {
+	m.roles = nil
+	m.clearedroles = false
+	m.removedroles = nil
 }
 
-// Where appends a list predicates to the RoleMutation builder.
-func (m *RoleMutation) Where(ps ...predicate.Role) {
+// Where appends a list predicates to the UserMutation builder.
+func (m *UserMutation) Where(ps ...predicate.User) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the RoleMutation builder. Using this method,
+// WhereP appends storage-level predicates to the UserMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *RoleMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Role, len(ps))
+func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.User, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -7127,48 +17962,90 @@ func (m *RoleMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *RoleMutation) Op() Op {
+func (m *UserMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *RoleMutation) SetOp(op Op) {
+func (m *UserMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Role).
-func (m *RoleMutation) Type() string {
+// Type returns the node type of this mutation (User).
+func (m *UserMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *RoleMutation) Fields() []string {
-	fields := make([]string, 0, 8)
+func (m *UserMutation) Fields() []string {
+	fields := make([]string, 0, 22)
 	if m.created_at != nil {
-		fields = append(fields, role.FieldCreatedAt)
+		fields = append(fields, user.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, role.FieldUpdatedAt)
+		fields = append(fields, user.FieldUpdatedAt)
 	}
 	if m.status != nil {
-		fields = append(fields, role.FieldStatus)
+		fields = append(fields, user.FieldStatus)
 	}
-	if m.name != nil {
-		fields = append(fields, role.FieldName)
+	if m.tenant_id != nil {
+		fields = append(fields, user.FieldTenantID)
 	}
-	if m.value != nil {
-		fields = append(fields, role.FieldValue)
+	if m.username != nil {
+		fields = append(fields, user.FieldUsername)
 	}
-	if m.default_router != nil {
-		fields = append(fields, role.FieldDefaultRouter)
+	if m.password != nil {
+		fields = append(fields, user.FieldPassword)
 	}
-	if m.remark != nil {
-		fields = append(fields, role.FieldRemark)
+	if m.nickname != nil {
+		fields = append(fields, user.FieldNickname)
 	}
-	if m.order_no != nil {
-		fields = append(fields, role.FieldOrderNo)
+	if m.side_mode != nil {
+		fields = append(fields, user.FieldSideMode)
+	}
+	if m.base_color != nil {
+		fields = append(fields, user.FieldBaseColor)
+	}
+	if m.active_color != nil {
+		fields = append(fields, user.FieldActiveColor)
+	}
+	if m.role_id != nil {
+		fields = append(fields, user.FieldRoleID)
+	}
+	if m.mobile != nil {
+		fields = append(fields, user.FieldMobile)
+	}
+	if m.email != nil {
+		fields = append(fields, user.FieldEmail)
+	}
+	if m.wecom != nil {
+		fields = append(fields, user.FieldWecom)
+	}
+	if m.avatar != nil {
+		fields = append(fields, user.FieldAvatar)
+	}
+	if m.token_version != nil {
+		fields = append(fields, user.FieldTokenVersion)
+	}
+	if m.totp_secret != nil {
+		fields = append(fields, user.FieldTotpSecret)
+	}
+	if m.totp_enabled != nil {
+		fields = append(fields, user.FieldTotpEnabled)
+	}
+	if m.recovery_codes != nil {
+		fields = append(fields, user.FieldRecoveryCodes)
+	}
+	if m.password_history != nil {
+		fields = append(fields, user.FieldPasswordHistory)
+	}
+	if m.pending_approval != nil {
+		fields = append(fields, user.FieldPendingApproval)
+	}
+	if m.password_changed_at != nil {
+		fields = append(fields, user.FieldPasswordChangedAt)
 	}
 	return fields
 }
@@ -7176,24 +18053,52 @@ func (m *RoleMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *RoleMutation) Field(name string) (ent.Value, bool) {
+func (m *UserMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case role.FieldCreatedAt:
+	case user.FieldCreatedAt:
 		return m.CreatedAt()
-	case role.FieldUpdatedAt:
+	case user.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case role.FieldStatus:
+	case user.FieldStatus:
 		return m.Status()
-	case role.FieldName:
-		return m.Name()
-	case role.FieldValue:
-		return m.Value()
-	case role.FieldDefaultRouter:
-		return m.DefaultRouter()
-	case role.FieldRemark:
-		return m.Remark()
-	case role.FieldOrderNo:
-		return m.OrderNo()
+	case user.FieldTenantID:
+		return m.TenantID()
+	case user.FieldUsername:
+		return m.Username()
+	case user.FieldPassword:
+		return m.Password()
+	case user.FieldNickname:
+		return m.Nickname()
+	case user.FieldSideMode:
+		return m.SideMode()
+	case user.FieldBaseColor:
+		return m.BaseColor()
+	case user.FieldActiveColor:
+		return m.ActiveColor()
+	case user.FieldRoleID:
+		return m.RoleID()
+	case user.FieldMobile:
+		return m.Mobile()
+	case user.FieldEmail:
+		return m.Email()
+	case user.FieldWecom:
+		return m.Wecom()
+	case user.FieldAvatar:
+		return m.Avatar()
+	case user.FieldTokenVersion:
+		return m.TokenVersion()
+	case user.FieldTotpSecret:
+		return m.TotpSecret()
+	case user.FieldTotpEnabled:
+		return m.TotpEnabled()
+	case user.FieldRecoveryCodes:
+		return m.RecoveryCodes()
+	case user.FieldPasswordHistory:
+		return m.PasswordHistory()
+	case user.FieldPendingApproval:
+		return m.PendingApproval()
+	case user.FieldPasswordChangedAt:
+		return m.PasswordChangedAt()
 	}
 	return nil, false
 }
@@ -7201,102 +18106,234 @@ func (m *RoleMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *RoleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case role.FieldCreatedAt:
+	case user.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case role.FieldUpdatedAt:
+	case user.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case role.FieldStatus:
+	case user.FieldStatus:
 		return m.OldStatus(ctx)
-	case role.FieldName:
-		return m.OldName(ctx)
-	case role.FieldValue:
-		return m.OldValue(ctx)
-	case role.FieldDefaultRouter:
-		return m.OldDefaultRouter(ctx)
-	case role.FieldRemark:
-		return m.OldRemark(ctx)
-	case role.FieldOrderNo:
-		return m.OldOrderNo(ctx)
+	case user.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case user.FieldUsername:
+		return m.OldUsername(ctx)
+	case user.FieldPassword:
+		return m.OldPassword(ctx)
+	case user.FieldNickname:
+		return m.OldNickname(ctx)
+	case user.FieldSideMode:
+		return m.OldSideMode(ctx)
+	case user.FieldBaseColor:
+		return m.OldBaseColor(ctx)
+	case user.FieldActiveColor:
+		return m.OldActiveColor(ctx)
+	case user.FieldRoleID:
+		return m.OldRoleID(ctx)
+	case user.FieldMobile:
+		return m.OldMobile(ctx)
+	case user.FieldEmail:
+		return m.OldEmail(ctx)
+	case user.FieldWecom:
+		return m.OldWecom(ctx)
+	case user.FieldAvatar:
+		return m.OldAvatar(ctx)
+	case user.FieldTokenVersion:
+		return m.OldTokenVersion(ctx)
+	case user.FieldTotpSecret:
+		return m.OldTotpSecret(ctx)
+	case user.FieldTotpEnabled:
+		return m.OldTotpEnabled(ctx)
+	case user.FieldRecoveryCodes:
+		return m.OldRecoveryCodes(ctx)
+	case user.FieldPasswordHistory:
+		return m.OldPasswordHistory(ctx)
+	case user.FieldPendingApproval:
+		return m.OldPendingApproval(ctx)
+	case user.FieldPasswordChangedAt:
+		return m.OldPasswordChangedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Role field %s", name)
+	return nil, fmt.Errorf("unknown User field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *RoleMutation) SetField(name string, value ent.Value) error {
+func (m *UserMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case role.FieldCreatedAt:
+	case user.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case role.FieldUpdatedAt:
+	case user.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case role.FieldStatus:
+	case user.FieldStatus:
 		v, ok := value.(uint8)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetStatus(v)
 		return nil
-	case role.FieldName:
+	case user.FieldTenantID:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case user.FieldUsername:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetUsername(v)
 		return nil
-	case role.FieldValue:
+	case user.FieldPassword:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetValue(v)
+		m.SetPassword(v)
 		return nil
-	case role.FieldDefaultRouter:
+	case user.FieldNickname:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDefaultRouter(v)
+		m.SetNickname(v)
 		return nil
-	case role.FieldRemark:
+	case user.FieldSideMode:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRemark(v)
+		m.SetSideMode(v)
 		return nil
-	case role.FieldOrderNo:
-		v, ok := value.(uint32)
+	case user.FieldBaseColor:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetOrderNo(v)
+		m.SetBaseColor(v)
+		return nil
+	case user.FieldActiveColor:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActiveColor(v)
+		return nil
+	case user.FieldRoleID:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRoleID(v)
+		return nil
+	case user.FieldMobile:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMobile(v)
+		return nil
+	case user.FieldEmail:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmail(v)
+		return nil
+	case user.FieldWecom:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWecom(v)
+		return nil
+	case user.FieldAvatar:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAvatar(v)
+		return nil
+	case user.FieldTokenVersion:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTokenVersion(v)
+		return nil
+	case user.FieldTotpSecret:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTotpSecret(v)
+		return nil
+	case user.FieldTotpEnabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTotpEnabled(v)
+		return nil
+	case user.FieldRecoveryCodes:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRecoveryCodes(v)
+		return nil
+	case user.FieldPasswordHistory:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPasswordHistory(v)
+		return nil
+	case user.FieldPendingApproval:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPendingApproval(v)
+		return nil
+	case user.FieldPasswordChangedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPasswordChangedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Role field %s", name)
+	return fmt.Errorf("unknown User field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *RoleMutation) AddedFields() []string {
+func (m *UserMutation) AddedFields() []string {
 	var fields []string
 	if m.addstatus != nil {
-		fields = append(fields, role.FieldStatus)
+		fields = append(fields, user.FieldStatus)
 	}
-	if m.addorder_no != nil {
-		fields = append(fields, role.FieldOrderNo)
+	if m.addtenant_id != nil {
+		fields = append(fields, user.FieldTenantID)
+	}
+	if m.addrole_id != nil {
+		fields = append(fields, user.FieldRoleID)
+	}
+	if m.addtoken_version != nil {
+		fields = append(fields, user.FieldTokenVersion)
 	}
 	return fields
 }
@@ -7304,12 +18341,16 @@ func (m *RoleMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *RoleMutation) AddedField(name string) (ent.Value, bool) {
+func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case role.FieldStatus:
+	case user.FieldStatus:
 		return m.AddedStatus()
-	case role.FieldOrderNo:
-		return m.AddedOrderNo()
+	case user.FieldTenantID:
+		return m.AddedTenantID()
+	case user.FieldRoleID:
+		return m.AddedRoleID()
+	case user.FieldTokenVersion:
+		return m.AddedTokenVersion()
 	}
 	return nil, false
 }
@@ -7317,102 +18358,260 @@ func (m *RoleMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *RoleMutation) AddField(name string, value ent.Value) error {
+func (m *UserMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case role.FieldStatus:
+	case user.FieldStatus:
 		v, ok := value.(int8)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.AddStatus(v)
 		return nil
-	case role.FieldOrderNo:
-		v, ok := value.(int32)
+	case user.FieldTenantID:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddOrderNo(v)
+		m.AddTenantID(v)
+		return nil
+	case user.FieldRoleID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRoleID(v)
+		return nil
+	case user.FieldTokenVersion:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTokenVersion(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Role numeric field %s", name)
+	return fmt.Errorf("unknown User numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *RoleMutation) ClearedFields() []string {
+func (m *UserMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(role.FieldStatus) {
-		fields = append(fields, role.FieldStatus)
+	if m.FieldCleared(user.FieldStatus) {
+		fields = append(fields, user.FieldStatus)
+	}
+	if m.FieldCleared(user.FieldSideMode) {
+		fields = append(fields, user.FieldSideMode)
+	}
+	if m.FieldCleared(user.FieldBaseColor) {
+		fields = append(fields, user.FieldBaseColor)
+	}
+	if m.FieldCleared(user.FieldActiveColor) {
+		fields = append(fields, user.FieldActiveColor)
+	}
+	if m.FieldCleared(user.FieldRoleID) {
+		fields = append(fields, user.FieldRoleID)
+	}
+	if m.FieldCleared(user.FieldEmail) {
+		fields = append(fields, user.FieldEmail)
+	}
+	if m.FieldCleared(user.FieldWecom) {
+		fields = append(fields, user.FieldWecom)
+	}
+	if m.FieldCleared(user.FieldAvatar) {
+		fields = append(fields, user.FieldAvatar)
+	}
+	if m.FieldCleared(user.FieldTotpSecret) {
+		fields = append(fields, user.FieldTotpSecret)
+	}
+	if m.FieldCleared(user.FieldRecoveryCodes) {
+		fields = append(fields, user.FieldRecoveryCodes)
+	}
+	if m.FieldCleared(user.FieldPasswordHistory) {
+		fields = append(fields, user.FieldPasswordHistory)
+	}
+	if m.FieldCleared(user.FieldPasswordChangedAt) {
+		fields = append(fields, user.FieldPasswordChangedAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *RoleMutation) FieldCleared(name string) bool {
+func (m *UserMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *RoleMutation) ClearField(name string) error {
+func (m *UserMutation) ClearField(name string) error {
 	switch name {
-	case role.FieldStatus:
+	case user.FieldStatus:
 		m.ClearStatus()
 		return nil
+	case user.FieldSideMode:
+		m.ClearSideMode()
+		return nil
+	case user.FieldBaseColor:
+		m.ClearBaseColor()
+		return nil
+	case user.FieldActiveColor:
+		m.ClearActiveColor()
+		return nil
+	case user.FieldRoleID:
+		m.ClearRoleID()
+		return nil
+	case user.FieldEmail:
+		m.ClearEmail()
+		return nil
+	case user.FieldWecom:
+		m.ClearWecom()
+		return nil
+	case user.FieldAvatar:
+		m.ClearAvatar()
+		return nil
+	case user.FieldTotpSecret:
+		m.ClearTotpSecret()
+		return nil
+	case user.FieldRecoveryCodes:
+		m.ClearRecoveryCodes()
+		return nil
+	case user.FieldPasswordHistory:
+		m.ClearPasswordHistory()
+		return nil
+	case user.FieldPasswordChangedAt:
+		m.ClearPasswordChangedAt()
+		return nil
 	}
-	return fmt.Errorf("unknown Role nullable field %s", name)
+	return fmt.Errorf("unknown User nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *RoleMutation) ResetField(name string) error {
+func (m *UserMutation) ResetField(name string) error {
 	switch name {
-	case role.FieldCreatedAt:
+	case user.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case role.FieldUpdatedAt:
+	case user.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case role.FieldStatus:
+	case user.FieldStatus:
 		m.ResetStatus()
 		return nil
-	case role.FieldName:
-		m.ResetName()
+	case user.FieldTenantID:
+		m.ResetTenantID()
 		return nil
-	case role.FieldValue:
-		m.ResetValue()
+	case user.FieldUsername:
+		m.ResetUsername()
 		return nil
-	case role.FieldDefaultRouter:
-		m.ResetDefaultRouter()
+	case user.FieldPassword:
+		m.ResetPassword()
 		return nil
-	case role.FieldRemark:
-		m.ResetRemark()
+	case user.FieldNickname:
+		m.ResetNickname()
 		return nil
-	case role.FieldOrderNo:
-		m.ResetOrderNo()
+	case user.FieldSideMode:
+		m.ResetSideMode()
+		return nil
+	case user.FieldBaseColor:
+		m.ResetBaseColor()
+		return nil
+	case user.FieldActiveColor:
+		m.ResetActiveColor()
+		return nil
+	case user.FieldRoleID:
+		m.ResetRoleID()
+		return nil
+	case user.FieldMobile:
+		m.ResetMobile()
+		return nil
+	case user.FieldEmail:
+		m.ResetEmail()
+		return nil
+	case user.FieldWecom:
+		m.ResetWecom()
+		return nil
+	case user.FieldAvatar:
+		m.ResetAvatar()
+		return nil
+	case user.FieldTokenVersion:
+		m.ResetTokenVersion()
+		return nil
+	case user.FieldTotpSecret:
+		m.ResetTotpSecret()
+		return nil
+	case user.FieldTotpEnabled:
+		m.ResetTotpEnabled()
+		return nil
+	case user.FieldRecoveryCodes:
+		m.ResetRecoveryCodes()
+		return nil
+	case user.FieldPasswordHistory:
+		m.ResetPasswordHistory()
+		return nil
+	case user.FieldPendingApproval:
+		m.ResetPendingApproval()
+		return nil
+	case user.FieldPasswordChangedAt:
+		m.ResetPasswordChangedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Role field %s", name)
+	return fmt.Errorf("unknown User field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *RoleMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.menus != nil {
-		edges = append(edges, role.EdgeMenus)
+func (m *UserMutation) AddedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.tokens != nil {
+		edges = append(edges, user.EdgeTokens)
+	}
+	if m.webauthn_credentials != nil {
+		edges = append(edges, user.EdgeWebauthnCredentials)
+	}
+	if m.api_keys != nil {
+		edges = append(edges, user.EdgeAPIKeys)
+	}
+	if m.identities != nil {
+		edges = append(edges, user.EdgeIdentities)
+	}
+	if m.roles != nil {
+		edges = append(edges, user.EdgeRoles)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *RoleMutation) AddedIDs(name string) []ent.Value {
+func (m *UserMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case role.EdgeMenus:
-		ids := make([]ent.Value, 0, len(m.menus))
-		for id := range m.menus {
+	case user.EdgeTokens:
+		ids := make([]ent.Value, 0, len(m.tokens))
+		for id := range m.tokens {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeWebauthnCredentials:
+		ids := make([]ent.Value, 0, len(m.webauthn_credentials))
+		for id := range m.webauthn_credentials {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeAPIKeys:
+		ids := make([]ent.Value, 0, len(m.api_keys))
+		for id := range m.api_keys {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeIdentities:
+		ids := make([]ent.Value, 0, len(m.identities))
+		for id := range m.identities {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeRoles:
+		ids := make([]ent.Value, 0, len(m.roles))
+		for id := range m.roles {
 			ids = append(ids, id)
 		}
 		return ids
@@ -7421,21 +18620,57 @@ func (m *RoleMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *RoleMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.removedmenus != nil {
-		edges = append(edges, role.EdgeMenus)
+func (m *UserMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.removedtokens != nil {
+		edges = append(edges, user.EdgeTokens)
+	}
+	if m.removedwebauthn_credentials != nil {
+		edges = append(edges, user.EdgeWebauthnCredentials)
+	}
+	if m.removedapi_keys != nil {
+		edges = append(edges, user.EdgeAPIKeys)
+	}
+	if m.removedidentities != nil {
+		edges = append(edges, user.EdgeIdentities)
+	}
+	if m.removedroles != nil {
+		edges = append(edges, user.EdgeRoles)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *RoleMutation) RemovedIDs(name string) []ent.Value {
+func (m *UserMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case role.EdgeMenus:
-		ids := make([]ent.Value, 0, len(m.removedmenus))
-		for id := range m.removedmenus {
+	case user.EdgeTokens:
+		ids := make([]ent.Value, 0, len(m.removedtokens))
+		for id := range m.removedtokens {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeWebauthnCredentials:
+		ids := make([]ent.Value, 0, len(m.removedwebauthn_credentials))
+		for id := range m.removedwebauthn_credentials {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeAPIKeys:
+		ids := make([]ent.Value, 0, len(m.removedapi_keys))
+		for id := range m.removedapi_keys {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeIdentities:
+		ids := make([]ent.Value, 0, len(m.removedidentities))
+		for id := range m.removedidentities {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeRoles:
+		ids := make([]ent.Value, 0, len(m.removedroles))
+		for id := range m.removedroles {
 			ids = append(ids, id)
 		}
 		return ids
@@ -7444,75 +18679,107 @@ func (m *RoleMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *RoleMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedmenus {
-		edges = append(edges, role.EdgeMenus)
+func (m *UserMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.clearedtokens {
+		edges = append(edges, user.EdgeTokens)
+	}
+	if m.clearedwebauthn_credentials {
+		edges = append(edges, user.EdgeWebauthnCredentials)
+	}
+	if m.clearedapi_keys {
+		edges = append(edges, user.EdgeAPIKeys)
+	}
+	if m.clearedidentities {
+		edges = append(edges, user.EdgeIdentities)
+	}
+	if m.clearedroles {
+		edges = append(edges, user.EdgeRoles)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *RoleMutation) EdgeCleared(name string) bool {
+func (m *UserMutation) EdgeCleared(name string) bool {
 	switch name {
-	case role.EdgeMenus:
-		return m.clearedmenus
+	case user.EdgeTokens:
+		return m.clearedtokens
+	case user.EdgeWebauthnCredentials:
+		return m.clearedwebauthn_credentials
+	case user.EdgeAPIKeys:
+		return m.clearedapi_keys
+	case user.EdgeIdentities:
+		return m.clearedidentities
+	case user.EdgeRoles:
+		return m.clearedroles
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *RoleMutation) ClearEdge(name string) error {
+func (m *UserMutation) ClearEdge(name string) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Role unique edge %s", name)
+	return fmt.Errorf("unknown User unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *RoleMutation) ResetEdge(name string) error {
+func (m *UserMutation) ResetEdge(name string) error {
 	switch name {
-	case role.EdgeMenus:
-		m.ResetMenus()
+	case user.EdgeTokens:
+		m.ResetTokens()
+		return nil
+	case user.EdgeWebauthnCredentials:
+		m.ResetWebauthnCredentials()
+		return nil
+	case user.EdgeAPIKeys:
+		m.ResetAPIKeys()
+		return nil
+	case user.EdgeIdentities:
+		m.ResetIdentities()
+		return nil
+	case user.EdgeRoles:
+		m.ResetRoles()
 		return nil
 	}
-	return fmt.Errorf("unknown Role edge %s", name)
+	return fmt.Errorf("unknown User edge %s", name)
 }
 
-// TokenMutation represents an operation that mutates the Token nodes in the graph.
-type TokenMutation struct {
+// UserIdentityMutation represents an operation that mutates the UserIdentity nodes in the graph.
+type UserIdentityMutation struct {
 	config
 	op            Op
 	typ           string
 	id            *uint64
 	created_at    *time.Time
-	updated_at    *time.Time
-	user_id       *uint64
-	adduser_id    *int64
-	token         *string
-	source        *string
-	expired_at    *time.Time
+	updated_at    *time.Time
+	provider      *string
+	subject       *string
+	username      *string
+	email         *string
+	last_login_at *time.Time
 	clearedFields map[string]struct{}
 	owner         *uint64
 	clearedowner  bool
 	done          bool
-	oldValue      func(context.Context) (*Token, error)
-	predicates    []predicate.Token
+	oldValue      func(context.Context) (*UserIdentity, error)
+	predicates    []predicate.UserIdentity
 }
 
-var _ ent.Mutation = (*TokenMutation)(nil)
+var _ ent.Mutation = (*UserIdentityMutation)(nil)
 
-// tokenOption allows management of the mutation configuration using functional options.
-type tokenOption func(*TokenMutation)
+// useridentityOption allows management of the mutation configuration using functional options.
+type useridentityOption func(*UserIdentityMutation)
 
-// newTokenMutation creates new mutation for the Token entity.
-func newTokenMutation(c config, op Op, opts ...tokenOption) *TokenMutation {
-	m := &TokenMutation{
+// newUserIdentityMutation creates new mutation for the UserIdentity entity.
+func newUserIdentityMutation(c config, op Op, opts ...useridentityOption) *UserIdentityMutation {
+	m := &UserIdentityMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeToken,
+		typ:           TypeUserIdentity,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -7521,20 +18788,20 @@ func newTokenMutation(c config, op Op, opts ...tokenOption) *TokenMutation {
 	return m
 }
 
-// withTokenID sets the ID field of the mutation.
-func withTokenID(id uint64) tokenOption {
-	return func(m *TokenMutation) {
+// withUserIdentityID sets the ID field of the mutation.
+func withUserIdentityID(id uint64) useridentityOption {
+	return func(m *UserIdentityMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Token
+			value *UserIdentity
 		)
-		m.oldValue = func(ctx context.Context) (*Token, error) {
+		m.oldValue = func(ctx context.Context) (*UserIdentity, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Token.Get(ctx, id)
+					value, err = m.Client().UserIdentity.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -7543,10 +18810,10 @@ func withTokenID(id uint64) tokenOption {
 	}
 }
 
-// withToken sets the old Token of the mutation.
-func withToken(node *Token) tokenOption {
-	return func(m *TokenMutation) {
-		m.oldValue = func(context.Context) (*Token, error) {
+// withUserIdentity sets the old UserIdentity of the mutation.
+func withUserIdentity(node *UserIdentity) useridentityOption {
+	return func(m *UserIdentityMutation) {
+		m.oldValue = func(context.Context) (*UserIdentity, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -7555,7 +18822,7 @@ func withToken(node *Token) tokenOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m TokenMutation) Client() *Client {
+func (m UserIdentityMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -7563,7 +18830,7 @@ func (m TokenMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m TokenMutation) Tx() (*Tx, error) {
+func (m UserIdentityMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -7573,14 +18840,14 @@ func (m TokenMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Token entities.
-func (m *TokenMutation) SetID(id uint64) {
+// operation is only accepted on creation of UserIdentity entities.
+func (m *UserIdentityMutation) SetID(id uint64) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *TokenMutation) ID() (id uint64, exists bool) {
+func (m *UserIdentityMutation) ID() (id uint64, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -7591,7 +18858,7 @@ func (m *TokenMutation) ID() (id uint64, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *TokenMutation) IDs(ctx context.Context) ([]uint64, error) {
+func (m *UserIdentityMutation) IDs(ctx context.Context) ([]uint64, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -7600,19 +18867,19 @@ func (m *TokenMutation) IDs(ctx context.Context) ([]uint64, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Token.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().UserIdentity.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *TokenMutation) SetCreatedAt(t time.Time) {
+func (m *UserIdentityMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *TokenMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *UserIdentityMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -7620,10 +18887,10 @@ func (m *TokenMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Token entity.
-// If the Token object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the UserIdentity entity.
+// If the UserIdentity object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TokenMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *UserIdentityMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -7638,17 +18905,17 @@ func (m *TokenMutation) OldCreatedAt(ctx context.Context) (v time.Time, err erro
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *TokenMutation) ResetCreatedAt() {
+func (m *UserIdentityMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *TokenMutation) SetUpdatedAt(t time.Time) {
+func (m *UserIdentityMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *TokenMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *UserIdentityMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -7656,10 +18923,10 @@ func (m *TokenMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Token entity.
-// If the Token object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the UserIdentity entity.
+// If the UserIdentity object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TokenMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *UserIdentityMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -7674,29 +18941,28 @@ func (m *TokenMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err erro
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *TokenMutation) ResetUpdatedAt() {
+func (m *UserIdentityMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
 // SetUserID sets the "user_id" field.
-func (m *TokenMutation) SetUserID(u uint64) {
-	m.user_id = &u
-	m.adduser_id = nil
+func (m *UserIdentityMutation) SetUserID(u uint64) {
+	m.owner = &u
 }
 
 // UserID returns the value of the "user_id" field in the mutation.
-func (m *TokenMutation) UserID() (r uint64, exists bool) {
-	v := m.user_id
+func (m *UserIdentityMutation) UserID() (r uint64, exists bool) {
+	v := m.owner
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUserID returns the old "user_id" field's value of the Token entity.
-// If the Token object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the UserIdentity entity.
+// If the UserIdentity object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TokenMutation) OldUserID(ctx context.Context) (v uint64, err error) {
+func (m *UserIdentityMutation) OldUserID(ctx context.Context) (v uint64, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
@@ -7710,155 +18976,248 @@ func (m *TokenMutation) OldUserID(ctx context.Context) (v uint64, err error) {
 	return oldValue.UserID, nil
 }
 
-// AddUserID adds u to the "user_id" field.
-func (m *TokenMutation) AddUserID(u int64) {
-	if m.adduser_id != nil {
-		*m.adduser_id += u
-	} else {
-		m.adduser_id = &u
+// ResetUserID resets all changes to the "user_id" field.
+func (m *UserIdentityMutation) ResetUserID() {
+	m.owner = nil
+}
+
+// SetProvider sets the "provider" field.
+func (m *UserIdentityMutation) SetProvider(s string) {
+	m.provider = &s
+}
+
+// Provider returns the value of the "provider" field in the mutation.
+func (m *UserIdentityMutation) Provider() (r string, exists bool) {
+	v := m.provider
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// AddedUserID returns the value that was added to the "user_id" field in this mutation.
-func (m *TokenMutation) AddedUserID() (r int64, exists bool) {
-	v := m.adduser_id
+// OldProvider returns the old "provider" field's value of the UserIdentity entity.
+// If the UserIdentity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserIdentityMutation) OldProvider(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProvider is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProvider requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProvider: %w", err)
+	}
+	return oldValue.Provider, nil
+}
+
+// ResetProvider resets all changes to the "provider" field.
+func (m *UserIdentityMutation) ResetProvider() {
+	m.provider = nil
+}
+
+// SetSubject sets the "subject" field.
+func (m *UserIdentityMutation) SetSubject(s string) {
+	m.subject = &s
+}
+
+// Subject returns the value of the "subject" field in the mutation.
+func (m *UserIdentityMutation) Subject() (r string, exists bool) {
+	v := m.subject
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetUserID resets all changes to the "user_id" field.
-func (m *TokenMutation) ResetUserID() {
-	m.user_id = nil
-	m.adduser_id = nil
+// OldSubject returns the old "subject" field's value of the UserIdentity entity.
+// If the UserIdentity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserIdentityMutation) OldSubject(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSubject is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSubject requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSubject: %w", err)
+	}
+	return oldValue.Subject, nil
 }
 
-// SetToken sets the "token" field.
-func (m *TokenMutation) SetToken(s string) {
-	m.token = &s
+// ResetSubject resets all changes to the "subject" field.
+func (m *UserIdentityMutation) ResetSubject() {
+	m.subject = nil
 }
 
-// Token returns the value of the "token" field in the mutation.
-func (m *TokenMutation) Token() (r string, exists bool) {
-	v := m.token
+// SetUsername sets the "username" field.
+func (m *UserIdentityMutation) SetUsername(s string) {
+	m.username = &s
+}
+
+// Username returns the value of the "username" field in the mutation.
+func (m *UserIdentityMutation) Username() (r string, exists bool) {
+	v := m.username
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldToken returns the old "token" field's value of the Token entity.
-// If the Token object wasn't provided to the builder, the object is fetched from the database.
+// OldUsername returns the old "username" field's value of the UserIdentity entity.
+// If the UserIdentity object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TokenMutation) OldToken(ctx context.Context) (v string, err error) {
+func (m *UserIdentityMutation) OldUsername(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldToken is only allowed on UpdateOne operations")
+		return v, errors.New("OldUsername is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldToken requires an ID field in the mutation")
+		return v, errors.New("OldUsername requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldToken: %w", err)
+		return v, fmt.Errorf("querying old value for OldUsername: %w", err)
 	}
-	return oldValue.Token, nil
+	return oldValue.Username, nil
 }
 
-// ResetToken resets all changes to the "token" field.
-func (m *TokenMutation) ResetToken() {
-	m.token = nil
+// ClearUsername clears the value of the "username" field.
+func (m *UserIdentityMutation) ClearUsername() {
+	m.username = nil
+	m.clearedFields[useridentity.FieldUsername] = struct{}{}
 }
 
-// SetSource sets the "source" field.
-func (m *TokenMutation) SetSource(s string) {
-	m.source = &s
+// UsernameCleared returns if the "username" field was cleared in this mutation.
+func (m *UserIdentityMutation) UsernameCleared() bool {
+	_, ok := m.clearedFields[useridentity.FieldUsername]
+	return ok
 }
 
-// Source returns the value of the "source" field in the mutation.
-func (m *TokenMutation) Source() (r string, exists bool) {
-	v := m.source
+// ResetUsername resets all changes to the "username" field.
+func (m *UserIdentityMutation) ResetUsername() {
+	m.username = nil
+	delete(m.clearedFields, useridentity.FieldUsername)
+}
+
+// SetEmail sets the "email" field.
+func (m *UserIdentityMutation) SetEmail(s string) {
+	m.email = &s
+}
+
+// Email returns the value of the "email" field in the mutation.
+func (m *UserIdentityMutation) Email() (r string, exists bool) {
+	v := m.email
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSource returns the old "source" field's value of the Token entity.
-// If the Token object wasn't provided to the builder, the object is fetched from the database.
+// OldEmail returns the old "email" field's value of the UserIdentity entity.
+// If the UserIdentity object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TokenMutation) OldSource(ctx context.Context) (v string, err error) {
+func (m *UserIdentityMutation) OldEmail(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSource is only allowed on UpdateOne operations")
+		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSource requires an ID field in the mutation")
+		return v, errors.New("OldEmail requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSource: %w", err)
+		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
 	}
-	return oldValue.Source, nil
+	return oldValue.Email, nil
 }
 
-// ResetSource resets all changes to the "source" field.
-func (m *TokenMutation) ResetSource() {
-	m.source = nil
+// ClearEmail clears the value of the "email" field.
+func (m *UserIdentityMutation) ClearEmail() {
+	m.email = nil
+	m.clearedFields[useridentity.FieldEmail] = struct{}{}
 }
 
-// SetExpiredAt sets the "expired_at" field.
-func (m *TokenMutation) SetExpiredAt(t time.Time) {
-	m.expired_at = &t
+// EmailCleared returns if the "email" field was cleared in this mutation.
+func (m *UserIdentityMutation) EmailCleared() bool {
+	_, ok := m.clearedFields[useridentity.FieldEmail]
+	return ok
 }
 
-// ExpiredAt returns the value of the "expired_at" field in the mutation.
-func (m *TokenMutation) ExpiredAt() (r time.Time, exists bool) {
-	v := m.expired_at
+// ResetEmail resets all changes to the "email" field.
+func (m *UserIdentityMutation) ResetEmail() {
+	m.email = nil
+	delete(m.clearedFields, useridentity.FieldEmail)
+}
+
+// SetLastLoginAt sets the "last_login_at" field.
+func (m *UserIdentityMutation) SetLastLoginAt(t time.Time) {
+	m.last_login_at = &t
+}
+
+// LastLoginAt returns the value of the "last_login_at" field in the mutation.
+func (m *UserIdentityMutation) LastLoginAt() (r time.Time, exists bool) {
+	v := m.last_login_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldExpiredAt returns the old "expired_at" field's value of the Token entity.
-// If the Token object wasn't provided to the builder, the object is fetched from the database.
+// OldLastLoginAt returns the old "last_login_at" field's value of the UserIdentity entity.
+// If the UserIdentity object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TokenMutation) OldExpiredAt(ctx context.Context) (v time.Time, err error) {
+func (m *UserIdentityMutation) OldLastLoginAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldExpiredAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldLastLoginAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldExpiredAt requires an ID field in the mutation")
+		return v, errors.New("OldLastLoginAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldExpiredAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldLastLoginAt: %w", err)
 	}
-	return oldValue.ExpiredAt, nil
+	return oldValue.LastLoginAt, nil
 }
 
-// ResetExpiredAt resets all changes to the "expired_at" field.
-func (m *TokenMutation) ResetExpiredAt() {
-	m.expired_at = nil
+// ClearLastLoginAt clears the value of the "last_login_at" field.
+func (m *UserIdentityMutation) ClearLastLoginAt() {
+	m.last_login_at = nil
+	m.clearedFields[useridentity.FieldLastLoginAt] = struct{}{}
+}
+
+// LastLoginAtCleared returns if the "last_login_at" field was cleared in this mutation.
+func (m *UserIdentityMutation) LastLoginAtCleared() bool {
+	_, ok := m.clearedFields[useridentity.FieldLastLoginAt]
+	return ok
+}
+
+// ResetLastLoginAt resets all changes to the "last_login_at" field.
+func (m *UserIdentityMutation) ResetLastLoginAt() {
+	m.last_login_at = nil
+	delete(m.clearedFields, useridentity.FieldLastLoginAt)
 }
 
 // SetOwnerID sets the "owner" edge to the User entity by id.
-func (m *TokenMutation) SetOwnerID(id uint64) {
+func (m *UserIdentityMutation) SetOwnerID(id uint64) {
 	m.owner = &id
 }
 
 // ClearOwner clears the "owner" edge to the User entity.
-func (m *TokenMutation) ClearOwner() {
+func (m *UserIdentityMutation) ClearOwner() {
 	m.clearedowner = true
+	m.clearedFields[useridentity.FieldUserID] = struct{}{}
 }
 
 // OwnerCleared reports if the "owner" edge to the User entity was cleared.
-func (m *TokenMutation) OwnerCleared() bool {
+func (m *UserIdentityMutation) OwnerCleared() bool {
 	return m.clearedowner
 }
 
 // OwnerID returns the "owner" edge ID in the mutation.
-func (m *TokenMutation) OwnerID() (id uint64, exists bool) {
+func (m *UserIdentityMutation) OwnerID() (id uint64, exists bool) {
 	if m.owner != nil {
 		return *m.owner, true
 	}
@@ -7868,7 +19227,7 @@ func (m *TokenMutation) OwnerID() (id uint64, exists bool) {
 // OwnerIDs returns the "owner" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
 // OwnerID instead. It exists only for internal usage by the builders.
-func (m *TokenMutation) OwnerIDs() (ids []uint64) {
+func (m *UserIdentityMutation) OwnerIDs() (ids []uint64) {
 	if id := m.owner; id != nil {
 		ids = append(ids, *id)
 	}
@@ -7876,20 +19235,20 @@ func (m *TokenMutation) OwnerIDs() (ids []uint64) {
 }
 
 // ResetOwner resets all changes to the "owner" edge.
-func (m *TokenMutation) ResetOwner() {
+func (m *UserIdentityMutation) ResetOwner() {
 	m.owner = nil
 	m.clearedowner = false
 }
 
-// Where appends a list predicates to the TokenMutation builder.
-func (m *TokenMutation) Where(ps ...predicate.Token) {
+// Where appends a list predicates to the UserIdentityMutation builder.
+func (m *UserIdentityMutation) Where(ps ...predicate.UserIdentity) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the TokenMutation builder. Using this method,
+// WhereP appends storage-level predicates to the UserIdentityMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *TokenMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Token, len(ps))
+func (m *UserIdentityMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.UserIdentity, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -7897,42 +19256,48 @@ func (m *TokenMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *TokenMutation) Op() Op {
+func (m *UserIdentityMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *TokenMutation) SetOp(op Op) {
+func (m *UserIdentityMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Token).
-func (m *TokenMutation) Type() string {
+// Type returns the node type of this mutation (UserIdentity).
+func (m *UserIdentityMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *TokenMutation) Fields() []string {
-	fields := make([]string, 0, 6)
+func (m *UserIdentityMutation) Fields() []string {
+	fields := make([]string, 0, 8)
 	if m.created_at != nil {
-		fields = append(fields, token.FieldCreatedAt)
+		fields = append(fields, useridentity.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, token.FieldUpdatedAt)
+		fields = append(fields, useridentity.FieldUpdatedAt)
 	}
-	if m.user_id != nil {
-		fields = append(fields, token.FieldUserID)
+	if m.owner != nil {
+		fields = append(fields, useridentity.FieldUserID)
 	}
-	if m.token != nil {
-		fields = append(fields, token.FieldToken)
+	if m.provider != nil {
+		fields = append(fields, useridentity.FieldProvider)
 	}
-	if m.source != nil {
-		fields = append(fields, token.FieldSource)
+	if m.subject != nil {
+		fields = append(fields, useridentity.FieldSubject)
 	}
-	if m.expired_at != nil {
-		fields = append(fields, token.FieldExpiredAt)
+	if m.username != nil {
+		fields = append(fields, useridentity.FieldUsername)
+	}
+	if m.email != nil {
+		fields = append(fields, useridentity.FieldEmail)
+	}
+	if m.last_login_at != nil {
+		fields = append(fields, useridentity.FieldLastLoginAt)
 	}
 	return fields
 }
@@ -7940,20 +19305,24 @@ func (m *TokenMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *TokenMutation) Field(name string) (ent.Value, bool) {
+func (m *UserIdentityMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case token.FieldCreatedAt:
+	case useridentity.FieldCreatedAt:
 		return m.CreatedAt()
-	case token.FieldUpdatedAt:
+	case useridentity.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case token.FieldUserID:
+	case useridentity.FieldUserID:
 		return m.UserID()
-	case token.FieldToken:
-		return m.Token()
-	case token.FieldSource:
-		return m.Source()
-	case token.FieldExpiredAt:
-		return m.ExpiredAt()
+	case useridentity.FieldProvider:
+		return m.Provider()
+	case useridentity.FieldSubject:
+		return m.Subject()
+	case useridentity.FieldUsername:
+		return m.Username()
+	case useridentity.FieldEmail:
+		return m.Email()
+	case useridentity.FieldLastLoginAt:
+		return m.LastLoginAt()
 	}
 	return nil, false
 }
@@ -7961,92 +19330,105 @@ func (m *TokenMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *TokenMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *UserIdentityMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case token.FieldCreatedAt:
+	case useridentity.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case token.FieldUpdatedAt:
+	case useridentity.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case token.FieldUserID:
+	case useridentity.FieldUserID:
 		return m.OldUserID(ctx)
-	case token.FieldToken:
-		return m.OldToken(ctx)
-	case token.FieldSource:
-		return m.OldSource(ctx)
-	case token.FieldExpiredAt:
-		return m.OldExpiredAt(ctx)
+	case useridentity.FieldProvider:
+		return m.OldProvider(ctx)
+	case useridentity.FieldSubject:
+		return m.OldSubject(ctx)
+	case useridentity.FieldUsername:
+		return m.OldUsername(ctx)
+	case useridentity.FieldEmail:
+		return m.OldEmail(ctx)
+	case useridentity.FieldLastLoginAt:
+		return m.OldLastLoginAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Token field %s", name)
+	return nil, fmt.Errorf("unknown UserIdentity field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TokenMutation) SetField(name string, value ent.Value) error {
+func (m *UserIdentityMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case token.FieldCreatedAt:
+	case useridentity.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case token.FieldUpdatedAt:
+	case useridentity.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case token.FieldUserID:
+	case useridentity.FieldUserID:
 		v, ok := value.(uint64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUserID(v)
 		return nil
-	case token.FieldToken:
+	case useridentity.FieldProvider:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetToken(v)
+		m.SetProvider(v)
 		return nil
-	case token.FieldSource:
+	case useridentity.FieldSubject:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSource(v)
+		m.SetSubject(v)
+		return nil
+	case useridentity.FieldUsername:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUsername(v)
+		return nil
+	case useridentity.FieldEmail:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmail(v)
 		return nil
-	case token.FieldExpiredAt:
+	case useridentity.FieldLastLoginAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetExpiredAt(v)
+		m.SetLastLoginAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Token field %s", name)
+	return fmt.Errorf("unknown UserIdentity field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *TokenMutation) AddedFields() []string {
+func (m *UserIdentityMutation) AddedFields() []string {
 	var fields []string
-	if m.adduser_id != nil {
-		fields = append(fields, token.FieldUserID)
-	}
 	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *TokenMutation) AddedField(name string) (ent.Value, bool) {
+func (m *UserIdentityMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case token.FieldUserID:
-		return m.AddedUserID()
 	}
 	return nil, false
 }
@@ -8054,78 +19436,98 @@ func (m *TokenMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TokenMutation) AddField(name string, value ent.Value) error {
+func (m *UserIdentityMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case token.FieldUserID:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddUserID(v)
-		return nil
 	}
-	return fmt.Errorf("unknown Token numeric field %s", name)
+	return fmt.Errorf("unknown UserIdentity numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *TokenMutation) ClearedFields() []string {
-	return nil
+func (m *UserIdentityMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(useridentity.FieldUsername) {
+		fields = append(fields, useridentity.FieldUsername)
+	}
+	if m.FieldCleared(useridentity.FieldEmail) {
+		fields = append(fields, useridentity.FieldEmail)
+	}
+	if m.FieldCleared(useridentity.FieldLastLoginAt) {
+		fields = append(fields, useridentity.FieldLastLoginAt)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *TokenMutation) FieldCleared(name string) bool {
+func (m *UserIdentityMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *TokenMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Token nullable field %s", name)
+func (m *UserIdentityMutation) ClearField(name string) error {
+	switch name {
+	case useridentity.FieldUsername:
+		m.ClearUsername()
+		return nil
+	case useridentity.FieldEmail:
+		m.ClearEmail()
+		return nil
+	case useridentity.FieldLastLoginAt:
+		m.ClearLastLoginAt()
+		return nil
+	}
+	return fmt.Errorf("unknown UserIdentity nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *TokenMutation) ResetField(name string) error {
+func (m *UserIdentityMutation) ResetField(name string) error {
 	switch name {
-	case token.FieldCreatedAt:
+	case useridentity.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case token.FieldUpdatedAt:
+	case useridentity.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case token.FieldUserID:
+	case useridentity.FieldUserID:
 		m.ResetUserID()
 		return nil
-	case token.FieldToken:
-		m.ResetToken()
+	case useridentity.FieldProvider:
+		m.ResetProvider()
 		return nil
-	case token.FieldSource:
-		m.ResetSource()
+	case useridentity.FieldSubject:
+		m.ResetSubject()
 		return nil
-	case token.FieldExpiredAt:
-		m.ResetExpiredAt()
+	case useridentity.FieldUsername:
+		m.ResetUsername()
+		return nil
+	case useridentity.FieldEmail:
+		m.ResetEmail()
+		return nil
+	case useridentity.FieldLastLoginAt:
+		m.ResetLastLoginAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Token field %s", name)
+	return fmt.Errorf("unknown UserIdentity field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *TokenMutation) AddedEdges() []string {
+func (m *UserIdentityMutation) AddedEdges() []string {
 	edges := make([]string, 0, 1)
 	if m.owner != nil {
-		edges = append(edges, token.EdgeOwner)
+		edges = append(edges, useridentity.EdgeOwner)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *TokenMutation) AddedIDs(name string) []ent.Value {
+func (m *UserIdentityMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case token.EdgeOwner:
+	case useridentity.EdgeOwner:
 		if id := m.owner; id != nil {
 			return []ent.Value{*id}
 		}
@@ -8134,31 +19536,31 @@ func (m *TokenMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *TokenMutation) RemovedEdges() []string {
+func (m *UserIdentityMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *TokenMutation) RemovedIDs(name string) []ent.Value {
+func (m *UserIdentityMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *TokenMutation) ClearedEdges() []string {
+func (m *UserIdentityMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 1)
 	if m.clearedowner {
-		edges = append(edges, token.EdgeOwner)
+		edges = append(edges, useridentity.EdgeOwner)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *TokenMutation) EdgeCleared(name string) bool {
+func (m *UserIdentityMutation) EdgeCleared(name string) bool {
 	switch name {
-	case token.EdgeOwner:
+	case useridentity.EdgeOwner:
 		return m.clearedowner
 	}
 	return false
@@ -8166,67 +19568,65 @@ func (m *TokenMutation) EdgeCleared(name string) bool {
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *TokenMutation) ClearEdge(name string) error {
+func (m *UserIdentityMutation) ClearEdge(name string) error {
 	switch name {
-	case token.EdgeOwner:
+	case useridentity.EdgeOwner:
 		m.ClearOwner()
 		return nil
 	}
-	return fmt.Errorf("unknown Token unique edge %s", name)
+	return fmt.Errorf("unknown UserIdentity unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *TokenMutation) ResetEdge(name string) error {
+func (m *UserIdentityMutation) ResetEdge(name string) error {
 	switch name {
-	case token.EdgeOwner:
+	case useridentity.EdgeOwner:
 		m.ResetOwner()
 		return nil
 	}
-	return fmt.Errorf("unknown Token edge %s", name)
+	return fmt.Errorf("unknown UserIdentity edge %s", name)
 }
 
-// UserMutation represents an operation that mutates the User nodes in the graph.
-type UserMutation struct {
+// WebauthnCredentialMutation represents an operation that mutates the WebauthnCredential nodes in the graph.
+type WebauthnCredentialMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *uint64
-	created_at    *time.Time
-	updated_at    *time.Time
-	status        *uint8
-	addstatus     *int8
-	username      *string
-	password      *string
-	nickname      *string
-	side_mode     *string
-	base_color    *string
-	active_color  *string
-	role_id       *uint64
-	addrole_id    *int64
-	mobile        *string
-	email         *string
-	wecom         *string
-	avatar        *string
-	clearedFields map[string]struct{}
-	token         *uint64
-	clearedtoken  bool
-	done          bool
-	oldValue      func(context.Context) (*User, error)
-	predicates    []predicate.User
-}
-
-var _ ent.Mutation = (*UserMutation)(nil)
-
-// userOption allows management of the mutation configuration using functional options.
-type userOption func(*UserMutation)
-
-// newUserMutation creates new mutation for the User entity.
-func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
-	m := &UserMutation{
+	op               Op
+	typ              string
+	id               *uint64
+	created_at       *time.Time
+	updated_at       *time.Time
+	name             *string
+	credential_id    *string
+	public_key       *[]byte
+	attestation_type *string
+	aaguid           *[]byte
+	sign_count       *uint32
+	addsign_count    *int32
+	transports       *[]string
+	appendtransports []string
+	flags            *uint8
+	addflags         *int8
+	last_used_at     *time.Time
+	clearedFields    map[string]struct{}
+	owner            *uint64
+	clearedowner     bool
+	done             bool
+	oldValue         func(context.Context) (*WebauthnCredential, error)
+	predicates       []predicate.WebauthnCredential
+}
+
+var _ ent.Mutation = (*WebauthnCredentialMutation)(nil)
+
+// webauthncredentialOption allows management of the mutation configuration using functional options.
+type webauthncredentialOption func(*WebauthnCredentialMutation)
+
+// newWebauthnCredentialMutation creates new mutation for the WebauthnCredential entity.
+func newWebauthnCredentialMutation(c config, op Op, opts ...webauthncredentialOption) *WebauthnCredentialMutation {
+	m := &WebauthnCredentialMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeUser,
+		typ:           TypeWebauthnCredential,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -8235,20 +19635,20 @@ func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
 	return m
 }
 
-// withUserID sets the ID field of the mutation.
-func withUserID(id uint64) userOption {
-	return func(m *UserMutation) {
+// withWebauthnCredentialID sets the ID field of the mutation.
+func withWebauthnCredentialID(id uint64) webauthncredentialOption {
+	return func(m *WebauthnCredentialMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *User
+			value *WebauthnCredential
 		)
-		m.oldValue = func(ctx context.Context) (*User, error) {
+		m.oldValue = func(ctx context.Context) (*WebauthnCredential, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().User.Get(ctx, id)
+					value, err = m.Client().WebauthnCredential.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -8257,10 +19657,10 @@ func withUserID(id uint64) userOption {
 	}
 }
 
-// withUser sets the old User of the mutation.
-func withUser(node *User) userOption {
-	return func(m *UserMutation) {
-		m.oldValue = func(context.Context) (*User, error) {
+// withWebauthnCredential sets the old WebauthnCredential of the mutation.
+func withWebauthnCredential(node *WebauthnCredential) webauthncredentialOption {
+	return func(m *WebauthnCredentialMutation) {
+		m.oldValue = func(context.Context) (*WebauthnCredential, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -8269,7 +19669,7 @@ func withUser(node *User) userOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m UserMutation) Client() *Client {
+func (m WebauthnCredentialMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -8277,7 +19677,7 @@ func (m UserMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m UserMutation) Tx() (*Tx, error) {
+func (m WebauthnCredentialMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -8287,14 +19687,14 @@ func (m UserMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of User entities.
-func (m *UserMutation) SetID(id uint64) {
+// operation is only accepted on creation of WebauthnCredential entities.
+func (m *WebauthnCredentialMutation) SetID(id uint64) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *UserMutation) ID() (id uint64, exists bool) {
+func (m *WebauthnCredentialMutation) ID() (id uint64, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -8305,7 +19705,7 @@ func (m *UserMutation) ID() (id uint64, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *UserMutation) IDs(ctx context.Context) ([]uint64, error) {
+func (m *WebauthnCredentialMutation) IDs(ctx context.Context) ([]uint64, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -8314,19 +19714,19 @@ func (m *UserMutation) IDs(ctx context.Context) ([]uint64, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().User.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().WebauthnCredential.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *UserMutation) SetCreatedAt(t time.Time) {
+func (m *WebauthnCredentialMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *UserMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *WebauthnCredentialMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -8334,10 +19734,10 @@ func (m *UserMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the WebauthnCredential entity.
+// If the WebauthnCredential object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *WebauthnCredentialMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -8352,17 +19752,17 @@ func (m *UserMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *UserMutation) ResetCreatedAt() {
+func (m *WebauthnCredentialMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *UserMutation) SetUpdatedAt(t time.Time) {
+func (m *WebauthnCredentialMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *UserMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *WebauthnCredentialMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -8370,10 +19770,10 @@ func (m *UserMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the WebauthnCredential entity.
+// If the WebauthnCredential object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *WebauthnCredentialMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -8388,636 +19788,527 @@ func (m *UserMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *UserMutation) ResetUpdatedAt() {
+func (m *WebauthnCredentialMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
-// SetStatus sets the "status" field.
-func (m *UserMutation) SetStatus(u uint8) {
-	m.status = &u
-	m.addstatus = nil
-}
-
-// Status returns the value of the "status" field in the mutation.
-func (m *UserMutation) Status() (r uint8, exists bool) {
-	v := m.status
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldStatus returns the old "status" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldStatus(ctx context.Context) (v uint8, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
-	}
-	return oldValue.Status, nil
-}
-
-// AddStatus adds u to the "status" field.
-func (m *UserMutation) AddStatus(u int8) {
-	if m.addstatus != nil {
-		*m.addstatus += u
-	} else {
-		m.addstatus = &u
-	}
-}
-
-// AddedStatus returns the value that was added to the "status" field in this mutation.
-func (m *UserMutation) AddedStatus() (r int8, exists bool) {
-	v := m.addstatus
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// ClearStatus clears the value of the "status" field.
-func (m *UserMutation) ClearStatus() {
-	m.status = nil
-	m.addstatus = nil
-	m.clearedFields[user.FieldStatus] = struct{}{}
-}
-
-// StatusCleared returns if the "status" field was cleared in this mutation.
-func (m *UserMutation) StatusCleared() bool {
-	_, ok := m.clearedFields[user.FieldStatus]
-	return ok
-}
-
-// ResetStatus resets all changes to the "status" field.
-func (m *UserMutation) ResetStatus() {
-	m.status = nil
-	m.addstatus = nil
-	delete(m.clearedFields, user.FieldStatus)
-}
-
-// SetUsername sets the "username" field.
-func (m *UserMutation) SetUsername(s string) {
-	m.username = &s
+// SetUserID sets the "user_id" field.
+func (m *WebauthnCredentialMutation) SetUserID(u uint64) {
+	m.owner = &u
 }
 
-// Username returns the value of the "username" field in the mutation.
-func (m *UserMutation) Username() (r string, exists bool) {
-	v := m.username
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *WebauthnCredentialMutation) UserID() (r uint64, exists bool) {
+	v := m.owner
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUsername returns the old "username" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the WebauthnCredential entity.
+// If the WebauthnCredential object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldUsername(ctx context.Context) (v string, err error) {
+func (m *WebauthnCredentialMutation) OldUserID(ctx context.Context) (v uint64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUsername is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUsername requires an ID field in the mutation")
+		return v, errors.New("OldUserID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUsername: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
 	}
-	return oldValue.Username, nil
+	return oldValue.UserID, nil
 }
 
-// ResetUsername resets all changes to the "username" field.
-func (m *UserMutation) ResetUsername() {
-	m.username = nil
+// ResetUserID resets all changes to the "user_id" field.
+func (m *WebauthnCredentialMutation) ResetUserID() {
+	m.owner = nil
 }
 
-// SetPassword sets the "password" field.
-func (m *UserMutation) SetPassword(s string) {
-	m.password = &s
+// SetName sets the "name" field.
+func (m *WebauthnCredentialMutation) SetName(s string) {
+	m.name = &s
 }
 
-// Password returns the value of the "password" field in the mutation.
-func (m *UserMutation) Password() (r string, exists bool) {
-	v := m.password
+// Name returns the value of the "name" field in the mutation.
+func (m *WebauthnCredentialMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPassword returns the old "password" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the WebauthnCredential entity.
+// If the WebauthnCredential object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldPassword(ctx context.Context) (v string, err error) {
+func (m *WebauthnCredentialMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPassword is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPassword requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPassword: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.Password, nil
+	return oldValue.Name, nil
 }
 
-// ResetPassword resets all changes to the "password" field.
-func (m *UserMutation) ResetPassword() {
-	m.password = nil
+// ResetName resets all changes to the "name" field.
+func (m *WebauthnCredentialMutation) ResetName() {
+	m.name = nil
 }
 
-// SetNickname sets the "nickname" field.
-func (m *UserMutation) SetNickname(s string) {
-	m.nickname = &s
+// SetCredentialID sets the "credential_id" field.
+func (m *WebauthnCredentialMutation) SetCredentialID(s string) {
+	m.credential_id = &s
 }
 
-// Nickname returns the value of the "nickname" field in the mutation.
-func (m *UserMutation) Nickname() (r string, exists bool) {
-	v := m.nickname
+// CredentialID returns the value of the "credential_id" field in the mutation.
+func (m *WebauthnCredentialMutation) CredentialID() (r string, exists bool) {
+	v := m.credential_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldNickname returns the old "nickname" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldCredentialID returns the old "credential_id" field's value of the WebauthnCredential entity.
+// If the WebauthnCredential object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldNickname(ctx context.Context) (v string, err error) {
+func (m *WebauthnCredentialMutation) OldCredentialID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldNickname is only allowed on UpdateOne operations")
+		return v, errors.New("OldCredentialID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldNickname requires an ID field in the mutation")
+		return v, errors.New("OldCredentialID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldNickname: %w", err)
+		return v, fmt.Errorf("querying old value for OldCredentialID: %w", err)
 	}
-	return oldValue.Nickname, nil
+	return oldValue.CredentialID, nil
 }
-
-// ResetNickname resets all changes to the "nickname" field.
-func (m *UserMutation) ResetNickname() {
-	m.nickname = nil
+
+// ResetCredentialID resets all changes to the "credential_id" field.
+func (m *WebauthnCredentialMutation) ResetCredentialID() {
+	m.credential_id = nil
 }
 
-// SetSideMode sets the "side_mode" field.
-func (m *UserMutation) SetSideMode(s string) {
-	m.side_mode = &s
+// SetPublicKey sets the "public_key" field.
+func (m *WebauthnCredentialMutation) SetPublicKey(b []byte) {
+	m.public_key = &b
 }
 
-// SideMode returns the value of the "side_mode" field in the mutation.
-func (m *UserMutation) SideMode() (r string, exists bool) {
-	v := m.side_mode
+// PublicKey returns the value of the "public_key" field in the mutation.
+func (m *WebauthnCredentialMutation) PublicKey() (r []byte, exists bool) {
+	v := m.public_key
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSideMode returns the old "side_mode" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldPublicKey returns the old "public_key" field's value of the WebauthnCredential entity.
+// If the WebauthnCredential object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldSideMode(ctx context.Context) (v string, err error) {
+func (m *WebauthnCredentialMutation) OldPublicKey(ctx context.Context) (v []byte, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSideMode is only allowed on UpdateOne operations")
+		return v, errors.New("OldPublicKey is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSideMode requires an ID field in the mutation")
+		return v, errors.New("OldPublicKey requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSideMode: %w", err)
+		return v, fmt.Errorf("querying old value for OldPublicKey: %w", err)
 	}
-	return oldValue.SideMode, nil
-}
-
-// ClearSideMode clears the value of the "side_mode" field.
-func (m *UserMutation) ClearSideMode() {
-	m.side_mode = nil
-	m.clearedFields[user.FieldSideMode] = struct{}{}
-}
-
-// SideModeCleared returns if the "side_mode" field was cleared in this mutation.
-func (m *UserMutation) SideModeCleared() bool {
-	_, ok := m.clearedFields[user.FieldSideMode]
-	return ok
+	return oldValue.PublicKey, nil
 }
 
-// ResetSideMode resets all changes to the "side_mode" field.
-func (m *UserMutation) ResetSideMode() {
-	m.side_mode = nil
-	delete(m.clearedFields, user.FieldSideMode)
+// ResetPublicKey resets all changes to the "public_key" field.
+func (m *WebauthnCredentialMutation) ResetPublicKey() {
+	m.public_key = nil
 }
 
-// SetBaseColor sets the "base_color" field.
-func (m *UserMutation) SetBaseColor(s string) {
-	m.base_color = &s
+// SetAttestationType sets the "attestation_type" field.
+func (m *WebauthnCredentialMutation) SetAttestationType(s string) {
+	m.attestation_type = &s
 }
 
-// BaseColor returns the value of the "base_color" field in the mutation.
-func (m *UserMutation) BaseColor() (r string, exists bool) {
-	v := m.base_color
+// AttestationType returns the value of the "attestation_type" field in the mutation.
+func (m *WebauthnCredentialMutation) AttestationType() (r string, exists bool) {
+	v := m.attestation_type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldBaseColor returns the old "base_color" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldAttestationType returns the old "attestation_type" field's value of the WebauthnCredential entity.
+// If the WebauthnCredential object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldBaseColor(ctx context.Context) (v string, err error) {
+func (m *WebauthnCredentialMutation) OldAttestationType(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldBaseColor is only allowed on UpdateOne operations")
+		return v, errors.New("OldAttestationType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldBaseColor requires an ID field in the mutation")
+		return v, errors.New("OldAttestationType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBaseColor: %w", err)
+		return v, fmt.Errorf("querying old value for OldAttestationType: %w", err)
 	}
-	return oldValue.BaseColor, nil
+	return oldValue.AttestationType, nil
 }
 
-// ClearBaseColor clears the value of the "base_color" field.
-func (m *UserMutation) ClearBaseColor() {
-	m.base_color = nil
-	m.clearedFields[user.FieldBaseColor] = struct{}{}
+// ClearAttestationType clears the value of the "attestation_type" field.
+func (m *WebauthnCredentialMutation) ClearAttestationType() {
+	m.attestation_type = nil
+	m.clearedFields[webauthncredential.FieldAttestationType] = struct{}{}
 }
 
-// BaseColorCleared returns if the "base_color" field was cleared in this mutation.
-func (m *UserMutation) BaseColorCleared() bool {
-	_, ok := m.clearedFields[user.FieldBaseColor]
+// AttestationTypeCleared returns if the "attestation_type" field was cleared in this mutation.
+func (m *WebauthnCredentialMutation) AttestationTypeCleared() bool {
+	_, ok := m.clearedFields[webauthncredential.FieldAttestationType]
 	return ok
 }
 
-// ResetBaseColor resets all changes to the "base_color" field.
-func (m *UserMutation) ResetBaseColor() {
-	m.base_color = nil
-	delete(m.clearedFields, user.FieldBaseColor)
+// ResetAttestationType resets all changes to the "attestation_type" field.
+func (m *WebauthnCredentialMutation) ResetAttestationType() {
+	m.attestation_type = nil
+	delete(m.clearedFields, webauthncredential.FieldAttestationType)
 }
 
-// SetActiveColor sets the "active_color" field.
-func (m *UserMutation) SetActiveColor(s string) {
-	m.active_color = &s
+// SetAaguid sets the "aaguid" field.
+func (m *WebauthnCredentialMutation) SetAaguid(b []byte) {
+	m.aaguid = &b
 }
 
-// ActiveColor returns the value of the "active_color" field in the mutation.
-func (m *UserMutation) ActiveColor() (r string, exists bool) {
-	v := m.active_color
+// Aaguid returns the value of the "aaguid" field in the mutation.
+func (m *WebauthnCredentialMutation) Aaguid() (r []byte, exists bool) {
+	v := m.aaguid
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldActiveColor returns the old "active_color" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldAaguid returns the old "aaguid" field's value of the WebauthnCredential entity.
+// If the WebauthnCredential object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldActiveColor(ctx context.Context) (v string, err error) {
+func (m *WebauthnCredentialMutation) OldAaguid(ctx context.Context) (v []byte, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldActiveColor is only allowed on UpdateOne operations")
+		return v, errors.New("OldAaguid is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldActiveColor requires an ID field in the mutation")
+		return v, errors.New("OldAaguid requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldActiveColor: %w", err)
+		return v, fmt.Errorf("querying old value for OldAaguid: %w", err)
 	}
-	return oldValue.ActiveColor, nil
+	return oldValue.Aaguid, nil
 }
 
-// ClearActiveColor clears the value of the "active_color" field.
-func (m *UserMutation) ClearActiveColor() {
-	m.active_color = nil
-	m.clearedFields[user.FieldActiveColor] = struct{}{}
+// ClearAaguid clears the value of the "aaguid" field.
+func (m *WebauthnCredentialMutation) ClearAaguid() {
+	m.aaguid = nil
+	m.clearedFields[webauthncredential.FieldAaguid] = struct{}{}
 }
 
-// ActiveColorCleared returns if the "active_color" field was cleared in this mutation.
-func (m *UserMutation) ActiveColorCleared() bool {
-	_, ok := m.clearedFields[user.FieldActiveColor]
+// AaguidCleared returns if the "aaguid" field was cleared in this mutation.
+func (m *WebauthnCredentialMutation) AaguidCleared() bool {
+	_, ok := m.clearedFields[webauthncredential.FieldAaguid]
 	return ok
 }
 
-// ResetActiveColor resets all changes to the "active_color" field.
-func (m *UserMutation) ResetActiveColor() {
-	m.active_color = nil
-	delete(m.clearedFields, user.FieldActiveColor)
+// ResetAaguid resets all changes to the "aaguid" field.
+func (m *WebauthnCredentialMutation) ResetAaguid() {
+	m.aaguid = nil
+	delete(m.clearedFields, webauthncredential.FieldAaguid)
 }
 
-// SetRoleID sets the "role_id" field.
-func (m *UserMutation) SetRoleID(u uint64) {
-	m.role_id = &u
-	m.addrole_id = nil
+// SetSignCount sets the "sign_count" field.
+func (m *WebauthnCredentialMutation) SetSignCount(u uint32) {
+	m.sign_count = &u
+	m.addsign_count = nil
 }
 
-// RoleID returns the value of the "role_id" field in the mutation.
-func (m *UserMutation) RoleID() (r uint64, exists bool) {
-	v := m.role_id
+// SignCount returns the value of the "sign_count" field in the mutation.
+func (m *WebauthnCredentialMutation) SignCount() (r uint32, exists bool) {
+	v := m.sign_count
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRoleID returns the old "role_id" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldSignCount returns the old "sign_count" field's value of the WebauthnCredential entity.
+// If the WebauthnCredential object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldRoleID(ctx context.Context) (v uint64, err error) {
+func (m *WebauthnCredentialMutation) OldSignCount(ctx context.Context) (v uint32, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRoleID is only allowed on UpdateOne operations")
+		return v, errors.New("OldSignCount is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRoleID requires an ID field in the mutation")
+		return v, errors.New("OldSignCount requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRoleID: %w", err)
+		return v, fmt.Errorf("querying old value for OldSignCount: %w", err)
 	}
-	return oldValue.RoleID, nil
+	return oldValue.SignCount, nil
 }
 
-// AddRoleID adds u to the "role_id" field.
-func (m *UserMutation) AddRoleID(u int64) {
-	if m.addrole_id != nil {
-		*m.addrole_id += u
+// AddSignCount adds u to the "sign_count" field.
+func (m *WebauthnCredentialMutation) AddSignCount(u int32) {
+	if m.addsign_count != nil {
+		*m.addsign_count += u
 	} else {
-		m.addrole_id = &u
+		m.addsign_count = &u
 	}
 }
 
-// AddedRoleID returns the value that was added to the "role_id" field in this mutation.
-func (m *UserMutation) AddedRoleID() (r int64, exists bool) {
-	v := m.addrole_id
+// AddedSignCount returns the value that was added to the "sign_count" field in this mutation.
+func (m *WebauthnCredentialMutation) AddedSignCount() (r int32, exists bool) {
+	v := m.addsign_count
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ClearRoleID clears the value of the "role_id" field.
-func (m *UserMutation) ClearRoleID() {
-	m.role_id = nil
-	m.addrole_id = nil
-	m.clearedFields[user.FieldRoleID] = struct{}{}
-}
-
-// RoleIDCleared returns if the "role_id" field was cleared in this mutation.
-func (m *UserMutation) RoleIDCleared() bool {
-	_, ok := m.clearedFields[user.FieldRoleID]
-	return ok
-}
-
-// ResetRoleID resets all changes to the "role_id" field.
-func (m *UserMutation) ResetRoleID() {
-	m.role_id = nil
-	m.addrole_id = nil
-	delete(m.clearedFields, user.FieldRoleID)
+// ResetSignCount resets all changes to the "sign_count" field.
+func (m *WebauthnCredentialMutation) ResetSignCount() {
+	m.sign_count = nil
+	m.addsign_count = nil
 }
 
-// SetMobile sets the "mobile" field.
-func (m *UserMutation) SetMobile(s string) {
-	m.mobile = &s
+// SetTransports sets the "transports" field.
+func (m *WebauthnCredentialMutation) SetTransports(s []string) {
+	m.transports = &s
+	m.appendtransports = nil
 }
 
-// Mobile returns the value of the "mobile" field in the mutation.
-func (m *UserMutation) Mobile() (r string, exists bool) {
-	v := m.mobile
+// Transports returns the value of the "transports" field in the mutation.
+func (m *WebauthnCredentialMutation) Transports() (r []string, exists bool) {
+	v := m.transports
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMobile returns the old "mobile" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldTransports returns the old "transports" field's value of the WebauthnCredential entity.
+// If the WebauthnCredential object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldMobile(ctx context.Context) (v string, err error) {
+func (m *WebauthnCredentialMutation) OldTransports(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMobile is only allowed on UpdateOne operations")
+		return v, errors.New("OldTransports is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMobile requires an ID field in the mutation")
+		return v, errors.New("OldTransports requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMobile: %w", err)
+		return v, fmt.Errorf("querying old value for OldTransports: %w", err)
 	}
-	return oldValue.Mobile, nil
-}
-
-// ResetMobile resets all changes to the "mobile" field.
-func (m *UserMutation) ResetMobile() {
-	m.mobile = nil
+	return oldValue.Transports, nil
 }
 
-// SetEmail sets the "email" field.
-func (m *UserMutation) SetEmail(s string) {
-	m.email = &s
-}
-
-// Email returns the value of the "email" field in the mutation.
-func (m *UserMutation) Email() (r string, exists bool) {
-	v := m.email
-	if v == nil {
-		return
-	}
-	return *v, true
+// AppendTransports adds s to the "transports" field.
+func (m *WebauthnCredentialMutation) AppendTransports(s []string) {
+	m.appendtransports = append(m.appendtransports, s...)
 }
 
-// OldEmail returns the old "email" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldEmail(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEmail requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+// AppendedTransports returns the list of values that were appended to the "transports" field in this mutation.
+func (m *WebauthnCredentialMutation) AppendedTransports() ([]string, bool) {
+	if len(m.appendtransports) == 0 {
+		return nil, false
 	}
-	return oldValue.Email, nil
+	return m.appendtransports, true
 }
 
-// ClearEmail clears the value of the "email" field.
-func (m *UserMutation) ClearEmail() {
-	m.email = nil
-	m.clearedFields[user.FieldEmail] = struct{}{}
+// ClearTransports clears the value of the "transports" field.
+func (m *WebauthnCredentialMutation) ClearTransports() {
+	m.transports = nil
+	m.appendtransports = nil
+	m.clearedFields[webauthncredential.FieldTransports] = struct{}{}
 }
 
-// EmailCleared returns if the "email" field was cleared in this mutation.
-func (m *UserMutation) EmailCleared() bool {
-	_, ok := m.clearedFields[user.FieldEmail]
+// TransportsCleared returns if the "transports" field was cleared in this mutation.
+func (m *WebauthnCredentialMutation) TransportsCleared() bool {
+	_, ok := m.clearedFields[webauthncredential.FieldTransports]
 	return ok
 }
 
-// ResetEmail resets all changes to the "email" field.
-func (m *UserMutation) ResetEmail() {
-	m.email = nil
-	delete(m.clearedFields, user.FieldEmail)
+// ResetTransports resets all changes to the "transports" field.
+func (m *WebauthnCredentialMutation) ResetTransports() {
+	m.transports = nil
+	m.appendtransports = nil
+	delete(m.clearedFields, webauthncredential.FieldTransports)
 }
 
-// SetWecom sets the "wecom" field.
-func (m *UserMutation) SetWecom(s string) {
-	m.wecom = &s
+// SetFlags sets the "flags" field.
+func (m *WebauthnCredentialMutation) SetFlags(u uint8) {
+	m.flags = &u
+	m.addflags = nil
 }
 
-// Wecom returns the value of the "wecom" field in the mutation.
-func (m *UserMutation) Wecom() (r string, exists bool) {
-	v := m.wecom
+// Flags returns the value of the "flags" field in the mutation.
+func (m *WebauthnCredentialMutation) Flags() (r uint8, exists bool) {
+	v := m.flags
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldWecom returns the old "wecom" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldFlags returns the old "flags" field's value of the WebauthnCredential entity.
+// If the WebauthnCredential object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldWecom(ctx context.Context) (v string, err error) {
+func (m *WebauthnCredentialMutation) OldFlags(ctx context.Context) (v uint8, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldWecom is only allowed on UpdateOne operations")
+		return v, errors.New("OldFlags is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldWecom requires an ID field in the mutation")
+		return v, errors.New("OldFlags requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldWecom: %w", err)
+		return v, fmt.Errorf("querying old value for OldFlags: %w", err)
 	}
-	return oldValue.Wecom, nil
+	return oldValue.Flags, nil
 }
 
-// ClearWecom clears the value of the "wecom" field.
-func (m *UserMutation) ClearWecom() {
-	m.wecom = nil
-	m.clearedFields[user.FieldWecom] = struct{}{}
+// AddFlags adds u to the "flags" field.
+func (m *WebauthnCredentialMutation) AddFlags(u int8) {
+	if m.addflags != nil {
+		*m.addflags += u
+	} else {
+		m.addflags = &u
+	}
 }
 
-// WecomCleared returns if the "wecom" field was cleared in this mutation.
-func (m *UserMutation) WecomCleared() bool {
-	_, ok := m.clearedFields[user.FieldWecom]
-	return ok
+// AddedFlags returns the value that was added to the "flags" field in this mutation.
+func (m *WebauthnCredentialMutation) AddedFlags() (r int8, exists bool) {
+	v := m.addflags
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetWecom resets all changes to the "wecom" field.
-func (m *UserMutation) ResetWecom() {
-	m.wecom = nil
-	delete(m.clearedFields, user.FieldWecom)
+// ResetFlags resets all changes to the "flags" field.
+func (m *WebauthnCredentialMutation) ResetFlags() {
+	m.flags = nil
+	m.addflags = nil
 }
 
-// SetAvatar sets the "avatar" field.
-func (m *UserMutation) SetAvatar(s string) {
-	m.avatar = &s
+// SetLastUsedAt sets the "last_used_at" field.
+func (m *WebauthnCredentialMutation) SetLastUsedAt(t time.Time) {
+	m.last_used_at = &t
 }
 
-// Avatar returns the value of the "avatar" field in the mutation.
-func (m *UserMutation) Avatar() (r string, exists bool) {
-	v := m.avatar
+// LastUsedAt returns the value of the "last_used_at" field in the mutation.
+func (m *WebauthnCredentialMutation) LastUsedAt() (r time.Time, exists bool) {
+	v := m.last_used_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAvatar returns the old "avatar" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldLastUsedAt returns the old "last_used_at" field's value of the WebauthnCredential entity.
+// If the WebauthnCredential object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldAvatar(ctx context.Context) (v string, err error) {
+func (m *WebauthnCredentialMutation) OldLastUsedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAvatar is only allowed on UpdateOne operations")
+		return v, errors.New("OldLastUsedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAvatar requires an ID field in the mutation")
+		return v, errors.New("OldLastUsedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAvatar: %w", err)
+		return v, fmt.Errorf("querying old value for OldLastUsedAt: %w", err)
 	}
-	return oldValue.Avatar, nil
+	return oldValue.LastUsedAt, nil
 }
 
-// ClearAvatar clears the value of the "avatar" field.
-func (m *UserMutation) ClearAvatar() {
-	m.avatar = nil
-	m.clearedFields[user.FieldAvatar] = struct{}{}
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (m *WebauthnCredentialMutation) ClearLastUsedAt() {
+	m.last_used_at = nil
+	m.clearedFields[webauthncredential.FieldLastUsedAt] = struct{}{}
 }
 
-// AvatarCleared returns if the "avatar" field was cleared in this mutation.
-func (m *UserMutation) AvatarCleared() bool {
-	_, ok := m.clearedFields[user.FieldAvatar]
+// LastUsedAtCleared returns if the "last_used_at" field was cleared in this mutation.
+func (m *WebauthnCredentialMutation) LastUsedAtCleared() bool {
+	_, ok := m.clearedFields[webauthncredential.FieldLastUsedAt]
 	return ok
 }
 
-// ResetAvatar resets all changes to the "avatar" field.
-func (m *UserMutation) ResetAvatar() {
-	m.avatar = nil
-	delete(m.clearedFields, user.FieldAvatar)
+// ResetLastUsedAt resets all changes to the "last_used_at" field.
+func (m *WebauthnCredentialMutation) ResetLastUsedAt() {
+	m.last_used_at = nil
+	delete(m.clearedFields, webauthncredential.FieldLastUsedAt)
 }
 
-// SetTokenID sets the "token" edge to the Token entity by id.
-func (m *UserMutation) SetTokenID(id uint64) {
-	m.token = &id
+// SetOwnerID sets the "owner" edge to the User entity by id.
+func (m *WebauthnCredentialMutation) SetOwnerID(id uint64) {
+	m.owner = &id
 }
 
-// ClearToken clears the "token" edge to the Token entity.
-func (m *UserMutation) ClearToken() {
-	m.clearedtoken = true
+// ClearOwner clears the "owner" edge to the User entity.
+func (m *WebauthnCredentialMutation) ClearOwner() {
+	m.clearedowner = true
+	m.clearedFields[webauthncredential.FieldUserID] = struct{}{}
 }
 
-// TokenCleared reports if the "token" edge to the Token entity was cleared.
-func (m *UserMutation) TokenCleared() bool {
-	return m.clearedtoken
+// OwnerCleared reports if the "owner" edge to the User entity was cleared.
+func (m *WebauthnCredentialMutation) OwnerCleared() bool {
+	return m.clearedowner
 }
 
-// TokenID returns the "token" edge ID in the mutation.
-func (m *UserMutation) TokenID() (id uint64, exists bool) {
-	if m.token != nil {
-		return *m.token, true
+// OwnerID returns the "owner" edge ID in the mutation.
+func (m *WebauthnCredentialMutation) OwnerID() (id uint64, exists bool) {
+	if m.owner != nil {
+		return *m.owner, true
 	}
 	return
 }
 
-// TokenIDs returns the "token" edge IDs in the mutation.
+// OwnerIDs returns the "owner" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// TokenID instead. It exists only for internal usage by the builders.
-func (m *UserMutation) TokenIDs() (ids []uint64) {
-	if id := m.token; id != nil {
+// OwnerID instead. It exists only for internal usage by the builders.
+func (m *WebauthnCredentialMutation) OwnerIDs() (ids []uint64) {
+	if id := m.owner; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetToken resets all changes to the "token" edge.
-func (m *UserMutation) ResetToken() {
-	m.token = nil
-	m.clearedtoken = false
+// ResetOwner resets all changes to the "owner" edge.
+func (m *WebauthnCredentialMutation) ResetOwner() {
+	m.owner = nil
+	m.clearedowner = false
 }
 
-// Where appends a list predicates to the UserMutation builder.
-func (m *UserMutation) Where(ps ...predicate.User) {
+// Where appends a list predicates to the WebauthnCredentialMutation builder.
+func (m *WebauthnCredentialMutation) Where(ps ...predicate.WebauthnCredential) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the UserMutation builder. Using this method,
+// WhereP appends storage-level predicates to the WebauthnCredentialMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.User, len(ps))
+func (m *WebauthnCredentialMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.WebauthnCredential, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -9025,66 +20316,60 @@ func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *UserMutation) Op() Op {
+func (m *WebauthnCredentialMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *UserMutation) SetOp(op Op) {
+func (m *WebauthnCredentialMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (User).
-func (m *UserMutation) Type() string {
+// Type returns the node type of this mutation (WebauthnCredential).
+func (m *WebauthnCredentialMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *UserMutation) Fields() []string {
-	fields := make([]string, 0, 14)
+func (m *WebauthnCredentialMutation) Fields() []string {
+	fields := make([]string, 0, 12)
 	if m.created_at != nil {
-		fields = append(fields, user.FieldCreatedAt)
+		fields = append(fields, webauthncredential.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, user.FieldUpdatedAt)
-	}
-	if m.status != nil {
-		fields = append(fields, user.FieldStatus)
-	}
-	if m.username != nil {
-		fields = append(fields, user.FieldUsername)
+		fields = append(fields, webauthncredential.FieldUpdatedAt)
 	}
-	if m.password != nil {
-		fields = append(fields, user.FieldPassword)
+	if m.owner != nil {
+		fields = append(fields, webauthncredential.FieldUserID)
 	}
-	if m.nickname != nil {
-		fields = append(fields, user.FieldNickname)
+	if m.name != nil {
+		fields = append(fields, webauthncredential.FieldName)
 	}
-	if m.side_mode != nil {
-		fields = append(fields, user.FieldSideMode)
+	if m.credential_id != nil {
+		fields = append(fields, webauthncredential.FieldCredentialID)
 	}
-	if m.base_color != nil {
-		fields = append(fields, user.FieldBaseColor)
+	if m.public_key != nil {
+		fields = append(fields, webauthncredential.FieldPublicKey)
 	}
-	if m.active_color != nil {
-		fields = append(fields, user.FieldActiveColor)
+	if m.attestation_type != nil {
+		fields = append(fields, webauthncredential.FieldAttestationType)
 	}
-	if m.role_id != nil {
-		fields = append(fields, user.FieldRoleID)
+	if m.aaguid != nil {
+		fields = append(fields, webauthncredential.FieldAaguid)
 	}
-	if m.mobile != nil {
-		fields = append(fields, user.FieldMobile)
+	if m.sign_count != nil {
+		fields = append(fields, webauthncredential.FieldSignCount)
 	}
-	if m.email != nil {
-		fields = append(fields, user.FieldEmail)
+	if m.transports != nil {
+		fields = append(fields, webauthncredential.FieldTransports)
 	}
-	if m.wecom != nil {
-		fields = append(fields, user.FieldWecom)
+	if m.flags != nil {
+		fields = append(fields, webauthncredential.FieldFlags)
 	}
-	if m.avatar != nil {
-		fields = append(fields, user.FieldAvatar)
+	if m.last_used_at != nil {
+		fields = append(fields, webauthncredential.FieldLastUsedAt)
 	}
 	return fields
 }
@@ -9092,36 +20377,32 @@ func (m *UserMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *UserMutation) Field(name string) (ent.Value, bool) {
+func (m *WebauthnCredentialMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case user.FieldCreatedAt:
+	case webauthncredential.FieldCreatedAt:
 		return m.CreatedAt()
-	case user.FieldUpdatedAt:
+	case webauthncredential.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case user.FieldStatus:
-		return m.Status()
-	case user.FieldUsername:
-		return m.Username()
-	case user.FieldPassword:
-		return m.Password()
-	case user.FieldNickname:
-		return m.Nickname()
-	case user.FieldSideMode:
-		return m.SideMode()
-	case user.FieldBaseColor:
-		return m.BaseColor()
-	case user.FieldActiveColor:
-		return m.ActiveColor()
-	case user.FieldRoleID:
-		return m.RoleID()
-	case user.FieldMobile:
-		return m.Mobile()
-	case user.FieldEmail:
-		return m.Email()
-	case user.FieldWecom:
-		return m.Wecom()
-	case user.FieldAvatar:
-		return m.Avatar()
+	case webauthncredential.FieldUserID:
+		return m.UserID()
+	case webauthncredential.FieldName:
+		return m.Name()
+	case webauthncredential.FieldCredentialID:
+		return m.CredentialID()
+	case webauthncredential.FieldPublicKey:
+		return m.PublicKey()
+	case webauthncredential.FieldAttestationType:
+		return m.AttestationType()
+	case webauthncredential.FieldAaguid:
+		return m.Aaguid()
+	case webauthncredential.FieldSignCount:
+		return m.SignCount()
+	case webauthncredential.FieldTransports:
+		return m.Transports()
+	case webauthncredential.FieldFlags:
+		return m.Flags()
+	case webauthncredential.FieldLastUsedAt:
+		return m.LastUsedAt()
 	}
 	return nil, false
 }
@@ -9129,156 +20410,138 @@ func (m *UserMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *WebauthnCredentialMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case user.FieldCreatedAt:
+	case webauthncredential.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case user.FieldUpdatedAt:
+	case webauthncredential.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case user.FieldStatus:
-		return m.OldStatus(ctx)
-	case user.FieldUsername:
-		return m.OldUsername(ctx)
-	case user.FieldPassword:
-		return m.OldPassword(ctx)
-	case user.FieldNickname:
-		return m.OldNickname(ctx)
-	case user.FieldSideMode:
-		return m.OldSideMode(ctx)
-	case user.FieldBaseColor:
-		return m.OldBaseColor(ctx)
-	case user.FieldActiveColor:
-		return m.OldActiveColor(ctx)
-	case user.FieldRoleID:
-		return m.OldRoleID(ctx)
-	case user.FieldMobile:
-		return m.OldMobile(ctx)
-	case user.FieldEmail:
-		return m.OldEmail(ctx)
-	case user.FieldWecom:
-		return m.OldWecom(ctx)
-	case user.FieldAvatar:
-		return m.OldAvatar(ctx)
-	}
-	return nil, fmt.Errorf("unknown User field %s", name)
+	case webauthncredential.FieldUserID:
+		return m.OldUserID(ctx)
+	case webauthncredential.FieldName:
+		return m.OldName(ctx)
+	case webauthncredential.FieldCredentialID:
+		return m.OldCredentialID(ctx)
+	case webauthncredential.FieldPublicKey:
+		return m.OldPublicKey(ctx)
+	case webauthncredential.FieldAttestationType:
+		return m.OldAttestationType(ctx)
+	case webauthncredential.FieldAaguid:
+		return m.OldAaguid(ctx)
+	case webauthncredential.FieldSignCount:
+		return m.OldSignCount(ctx)
+	case webauthncredential.FieldTransports:
+		return m.OldTransports(ctx)
+	case webauthncredential.FieldFlags:
+		return m.OldFlags(ctx)
+	case webauthncredential.FieldLastUsedAt:
+		return m.OldLastUsedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown WebauthnCredential field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UserMutation) SetField(name string, value ent.Value) error {
+func (m *WebauthnCredentialMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case user.FieldCreatedAt:
+	case webauthncredential.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case user.FieldUpdatedAt:
+	case webauthncredential.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case user.FieldStatus:
-		v, ok := value.(uint8)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStatus(v)
-		return nil
-	case user.FieldUsername:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUsername(v)
-		return nil
-	case user.FieldPassword:
-		v, ok := value.(string)
+	case webauthncredential.FieldUserID:
+		v, ok := value.(uint64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPassword(v)
+		m.SetUserID(v)
 		return nil
-	case user.FieldNickname:
+	case webauthncredential.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetNickname(v)
+		m.SetName(v)
 		return nil
-	case user.FieldSideMode:
+	case webauthncredential.FieldCredentialID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSideMode(v)
+		m.SetCredentialID(v)
 		return nil
-	case user.FieldBaseColor:
-		v, ok := value.(string)
+	case webauthncredential.FieldPublicKey:
+		v, ok := value.([]byte)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetBaseColor(v)
+		m.SetPublicKey(v)
 		return nil
-	case user.FieldActiveColor:
+	case webauthncredential.FieldAttestationType:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetActiveColor(v)
+		m.SetAttestationType(v)
 		return nil
-	case user.FieldRoleID:
-		v, ok := value.(uint64)
+	case webauthncredential.FieldAaguid:
+		v, ok := value.([]byte)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRoleID(v)
+		m.SetAaguid(v)
 		return nil
-	case user.FieldMobile:
-		v, ok := value.(string)
+	case webauthncredential.FieldSignCount:
+		v, ok := value.(uint32)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMobile(v)
+		m.SetSignCount(v)
 		return nil
-	case user.FieldEmail:
-		v, ok := value.(string)
+	case webauthncredential.FieldTransports:
+		v, ok := value.([]string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEmail(v)
+		m.SetTransports(v)
 		return nil
-	case user.FieldWecom:
-		v, ok := value.(string)
+	case webauthncredential.FieldFlags:
+		v, ok := value.(uint8)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetWecom(v)
+		m.SetFlags(v)
 		return nil
-	case user.FieldAvatar:
-		v, ok := value.(string)
+	case webauthncredential.FieldLastUsedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAvatar(v)
+		m.SetLastUsedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown User field %s", name)
+	return fmt.Errorf("unknown WebauthnCredential field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *UserMutation) AddedFields() []string {
+func (m *WebauthnCredentialMutation) AddedFields() []string {
 	var fields []string
-	if m.addstatus != nil {
-		fields = append(fields, user.FieldStatus)
+	if m.addsign_count != nil {
+		fields = append(fields, webauthncredential.FieldSignCount)
 	}
-	if m.addrole_id != nil {
-		fields = append(fields, user.FieldRoleID)
+	if m.addflags != nil {
+		fields = append(fields, webauthncredential.FieldFlags)
 	}
 	return fields
 }
@@ -9286,12 +20549,12 @@ func (m *UserMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
+func (m *WebauthnCredentialMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case user.FieldStatus:
-		return m.AddedStatus()
-	case user.FieldRoleID:
-		return m.AddedRoleID()
+	case webauthncredential.FieldSignCount:
+		return m.AddedSignCount()
+	case webauthncredential.FieldFlags:
+		return m.AddedFlags()
 	}
 	return nil, false
 }
@@ -9299,161 +20562,131 @@ func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UserMutation) AddField(name string, value ent.Value) error {
+func (m *WebauthnCredentialMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case user.FieldStatus:
-		v, ok := value.(int8)
+	case webauthncredential.FieldSignCount:
+		v, ok := value.(int32)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddStatus(v)
+		m.AddSignCount(v)
 		return nil
-	case user.FieldRoleID:
-		v, ok := value.(int64)
+	case webauthncredential.FieldFlags:
+		v, ok := value.(int8)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddRoleID(v)
+		m.AddFlags(v)
 		return nil
 	}
-	return fmt.Errorf("unknown User numeric field %s", name)
+	return fmt.Errorf("unknown WebauthnCredential numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *UserMutation) ClearedFields() []string {
+func (m *WebauthnCredentialMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(user.FieldStatus) {
-		fields = append(fields, user.FieldStatus)
-	}
-	if m.FieldCleared(user.FieldSideMode) {
-		fields = append(fields, user.FieldSideMode)
-	}
-	if m.FieldCleared(user.FieldBaseColor) {
-		fields = append(fields, user.FieldBaseColor)
-	}
-	if m.FieldCleared(user.FieldActiveColor) {
-		fields = append(fields, user.FieldActiveColor)
-	}
-	if m.FieldCleared(user.FieldRoleID) {
-		fields = append(fields, user.FieldRoleID)
+	if m.FieldCleared(webauthncredential.FieldAttestationType) {
+		fields = append(fields, webauthncredential.FieldAttestationType)
 	}
-	if m.FieldCleared(user.FieldEmail) {
-		fields = append(fields, user.FieldEmail)
+	if m.FieldCleared(webauthncredential.FieldAaguid) {
+		fields = append(fields, webauthncredential.FieldAaguid)
 	}
-	if m.FieldCleared(user.FieldWecom) {
-		fields = append(fields, user.FieldWecom)
+	if m.FieldCleared(webauthncredential.FieldTransports) {
+		fields = append(fields, webauthncredential.FieldTransports)
 	}
-	if m.FieldCleared(user.FieldAvatar) {
-		fields = append(fields, user.FieldAvatar)
+	if m.FieldCleared(webauthncredential.FieldLastUsedAt) {
+		fields = append(fields, webauthncredential.FieldLastUsedAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *UserMutation) FieldCleared(name string) bool {
+func (m *WebauthnCredentialMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *UserMutation) ClearField(name string) error {
+func (m *WebauthnCredentialMutation) ClearField(name string) error {
 	switch name {
-	case user.FieldStatus:
-		m.ClearStatus()
-		return nil
-	case user.FieldSideMode:
-		m.ClearSideMode()
-		return nil
-	case user.FieldBaseColor:
-		m.ClearBaseColor()
-		return nil
-	case user.FieldActiveColor:
-		m.ClearActiveColor()
+	case webauthncredential.FieldAttestationType:
+		m.ClearAttestationType()
 		return nil
-	case user.FieldRoleID:
-		m.ClearRoleID()
-		return nil
-	case user.FieldEmail:
-		m.ClearEmail()
+	case webauthncredential.FieldAaguid:
+		m.ClearAaguid()
 		return nil
-	case user.FieldWecom:
-		m.ClearWecom()
+	case webauthncredential.FieldTransports:
+		m.ClearTransports()
 		return nil
-	case user.FieldAvatar:
-		m.ClearAvatar()
+	case webauthncredential.FieldLastUsedAt:
+		m.ClearLastUsedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown User nullable field %s", name)
+	return fmt.Errorf("unknown WebauthnCredential nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *UserMutation) ResetField(name string) error {
+func (m *WebauthnCredentialMutation) ResetField(name string) error {
 	switch name {
-	case user.FieldCreatedAt:
+	case webauthncredential.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case user.FieldUpdatedAt:
+	case webauthncredential.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case user.FieldStatus:
-		m.ResetStatus()
-		return nil
-	case user.FieldUsername:
-		m.ResetUsername()
-		return nil
-	case user.FieldPassword:
-		m.ResetPassword()
+	case webauthncredential.FieldUserID:
+		m.ResetUserID()
 		return nil
-	case user.FieldNickname:
-		m.ResetNickname()
+	case webauthncredential.FieldName:
+		m.ResetName()
 		return nil
-	case user.FieldSideMode:
-		m.ResetSideMode()
+	case webauthncredential.FieldCredentialID:
+		m.ResetCredentialID()
 		return nil
-	case user.FieldBaseColor:
-		m.ResetBaseColor()
+	case webauthncredential.FieldPublicKey:
+		m.ResetPublicKey()
 		return nil
-	case user.FieldActiveColor:
-		m.ResetActiveColor()
+	case webauthncredential.FieldAttestationType:
+		m.ResetAttestationType()
 		return nil
-	case user.FieldRoleID:
-		m.ResetRoleID()
+	case webauthncredential.FieldAaguid:
+		m.ResetAaguid()
 		return nil
-	case user.FieldMobile:
-		m.ResetMobile()
+	case webauthncredential.FieldSignCount:
+		m.ResetSignCount()
 		return nil
-	case user.FieldEmail:
-		m.ResetEmail()
+	case webauthncredential.FieldTransports:
+		m.ResetTransports()
 		return nil
-	case user.FieldWecom:
-		m.ResetWecom()
+	case webauthncredential.FieldFlags:
+		m.ResetFlags()
 		return nil
-	case user.FieldAvatar:
-		m.ResetAvatar()
+	case webauthncredential.FieldLastUsedAt:
+		m.ResetLastUsedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown User field %s", name)
+	return fmt.Errorf("unknown WebauthnCredential field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *UserMutation) AddedEdges() []string {
+func (m *WebauthnCredentialMutation) AddedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.token != nil {
-		edges = append(edges, user.EdgeToken)
+	if m.owner != nil {
+		edges = append(edges, webauthncredential.EdgeOwner)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *UserMutation) AddedIDs(name string) []ent.Value {
+func (m *WebauthnCredentialMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case user.EdgeToken:
-		if id := m.token; id != nil {
+	case webauthncredential.EdgeOwner:
+		if id := m.owner; id != nil {
 			return []ent.Value{*id}
 		}
 	}
@@ -9461,54 +20694,54 @@ func (m *UserMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *UserMutation) RemovedEdges() []string {
+func (m *WebauthnCredentialMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *UserMutation) RemovedIDs(name string) []ent.Value {
+func (m *WebauthnCredentialMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *UserMutation) ClearedEdges() []string {
+func (m *WebauthnCredentialMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.clearedtoken {
-		edges = append(edges, user.EdgeToken)
+	if m.clearedowner {
+		edges = append(edges, webauthncredential.EdgeOwner)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *UserMutation) EdgeCleared(name string) bool {
+func (m *WebauthnCredentialMutation) EdgeCleared(name string) bool {
 	switch name {
-	case user.EdgeToken:
-		return m.clearedtoken
+	case webauthncredential.EdgeOwner:
+		return m.clearedowner
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *UserMutation) ClearEdge(name string) error {
+func (m *WebauthnCredentialMutation) ClearEdge(name string) error {
 	switch name {
-	case user.EdgeToken:
-		m.ClearToken()
+	case webauthncredential.EdgeOwner:
+		m.ClearOwner()
 		return nil
 	}
-	return fmt.Errorf("unknown User unique edge %s", name)
+	return fmt.Errorf("unknown WebauthnCredential unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *UserMutation) ResetEdge(name string) error {
+func (m *WebauthnCredentialMutation) ResetEdge(name string) error {
 	switch name {
-	case user.EdgeToken:
-		m.ResetToken()
+	case webauthncredential.EdgeOwner:
+		m.ResetOwner()
 		return nil
 	}
-	return fmt.Errorf("unknown User edge %s", name)
+	return fmt.Errorf("unknown WebauthnCredential edge %s", name)
 }
@@ -3,8 +3,10 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"formulago/data/ent/oauthprovider"
+	"formulago/pkg/rolerule"
 	"strings"
 	"time"
 
@@ -34,14 +36,26 @@ type OauthProvider struct {
 	RedirectURL string `json:"redirect_url,omitempty"`
 	// the scopes | 权限范围
 	Scopes string `json:"scopes,omitempty"`
-	// the auth url of the provider | 认证地址
+	// the auth url of the provider, discovered if discovery_url is set | 认证地址, 设置discovery_url时自动发现
 	AuthURL string `json:"auth_url,omitempty"`
 	// the token url of the provider | 获取 token地址
 	TokenURL string `json:"token_url,omitempty"`
 	// the auth style, 0: auto detect; 1: third party login; 2: login with username and password
 	AuthStyle uint64 `json:"auth_style,omitempty"`
 	// the URL to request user information by token | 用户信息请求地址
-	InfoURL      string `json:"info_url,omitempty"`
+	InfoURL string `json:"info_url,omitempty"`
+	// the OIDC issuer, endpoints are read from its openid-configuration and the id_token is verified | OIDC签发者地址, 从openid-configuration读取端点并校验id_token
+	DiscoveryURL string `json:"discovery_url,omitempty"`
+	// JSON paths of the user info fields in the user info response or id_token, keyed by subject, credential, username, email, email_verified, mobile, nickname, avatar and groups | 用户信息字段在用户信息响应或id_token中的JSON路径
+	ClaimMapping map[string]string `json:"claim_mapping,omitempty"`
+	// create the user on the first login of an account not linked yet | 未关联账号首次登录时自动创建用户
+	AutoProvision bool `json:"auto_provision,omitempty"`
+	// the created users wait for the approval of an admin | 自动创建的用户需管理员审批
+	ProvisionApproval bool `json:"provision_approval,omitempty"`
+	// the role of the created users no rule matches, 0 rejects them | 未匹配规则的自动创建用户角色, 0为拒绝
+	DefaultRoleID uint64 `json:"default_role_id,omitempty"`
+	// the rules deciding the role of the created users, the first match wins | 决定自动创建用户角色的规则, 按顺序首个匹配生效
+	RoleRules    []rolerule.Rule `json:"role_rules,omitempty"`
 	selectValues sql.SelectValues
 }
 
@@ -50,9 +64,13 @@ func (*OauthProvider) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case oauthprovider.FieldID, oauthprovider.FieldAuthStyle:
+		case oauthprovider.FieldClaimMapping, oauthprovider.FieldRoleRules:
+			values[i] = new([]byte)
+		case oauthprovider.FieldAutoProvision, oauthprovider.FieldProvisionApproval:
+			values[i] = new(sql.NullBool)
+		case oauthprovider.FieldID, oauthprovider.FieldAuthStyle, oauthprovider.FieldDefaultRoleID:
 			values[i] = new(sql.NullInt64)
-		case oauthprovider.FieldName, oauthprovider.FieldAppID, oauthprovider.FieldClientID, oauthprovider.FieldClientSecret, oauthprovider.FieldRedirectURL, oauthprovider.FieldScopes, oauthprovider.FieldAuthURL, oauthprovider.FieldTokenURL, oauthprovider.FieldInfoURL:
+		case oauthprovider.FieldName, oauthprovider.FieldAppID, oauthprovider.FieldClientID, oauthprovider.FieldClientSecret, oauthprovider.FieldRedirectURL, oauthprovider.FieldScopes, oauthprovider.FieldAuthURL, oauthprovider.FieldTokenURL, oauthprovider.FieldInfoURL, oauthprovider.FieldDiscoveryURL:
 			values[i] = new(sql.NullString)
 		case oauthprovider.FieldCreatedAt, oauthprovider.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -149,6 +167,46 @@ func (_m *OauthProvider) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.InfoURL = value.String
 			}
+		case oauthprovider.FieldDiscoveryURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field discovery_url", values[i])
+			} else if value.Valid {
+				_m.DiscoveryURL = value.String
+			}
+		case oauthprovider.FieldClaimMapping:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field claim_mapping", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.ClaimMapping); err != nil {
+					return fmt.Errorf("unmarshal field claim_mapping: %w", err)
+				}
+			}
+		case oauthprovider.FieldAutoProvision:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field auto_provision", values[i])
+			} else if value.Valid {
+				_m.AutoProvision = value.Bool
+			}
+		case oauthprovider.FieldProvisionApproval:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field provision_approval", values[i])
+			} else if value.Valid {
+				_m.ProvisionApproval = value.Bool
+			}
+		case oauthprovider.FieldDefaultRoleID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field default_role_id", values[i])
+			} else if value.Valid {
+				_m.DefaultRoleID = uint64(value.Int64)
+			}
+		case oauthprovider.FieldRoleRules:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field role_rules", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.RoleRules); err != nil {
+					return fmt.Errorf("unmarshal field role_rules: %w", err)
+				}
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -220,6 +278,24 @@ func (_m *OauthProvider) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("info_url=")
 	builder.WriteString(_m.InfoURL)
+	builder.WriteString(", ")
+	builder.WriteString("discovery_url=")
+	builder.WriteString(_m.DiscoveryURL)
+	builder.WriteString(", ")
+	builder.WriteString("claim_mapping=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ClaimMapping))
+	builder.WriteString(", ")
+	builder.WriteString("auto_provision=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AutoProvision))
+	builder.WriteString(", ")
+	builder.WriteString("provision_approval=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ProvisionApproval))
+	builder.WriteString(", ")
+	builder.WriteString("default_role_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DefaultRoleID))
+	builder.WriteString(", ")
+	builder.WriteString("role_rules=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RoleRules))
 	builder.WriteByte(')')
 	return builder.String()
 }
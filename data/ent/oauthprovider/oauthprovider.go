@@ -37,6 +37,18 @@ const (
 	FieldAuthStyle = "auth_style"
 	// FieldInfoURL holds the string denoting the info_url field in the database.
 	FieldInfoURL = "info_url"
+	// FieldDiscoveryURL holds the string denoting the discovery_url field in the database.
+	FieldDiscoveryURL = "discovery_url"
+	// FieldClaimMapping holds the string denoting the claim_mapping field in the database.
+	FieldClaimMapping = "claim_mapping"
+	// FieldAutoProvision holds the string denoting the auto_provision field in the database.
+	FieldAutoProvision = "auto_provision"
+	// FieldProvisionApproval holds the string denoting the provision_approval field in the database.
+	FieldProvisionApproval = "provision_approval"
+	// FieldDefaultRoleID holds the string denoting the default_role_id field in the database.
+	FieldDefaultRoleID = "default_role_id"
+	// FieldRoleRules holds the string denoting the role_rules field in the database.
+	FieldRoleRules = "role_rules"
 	// Table holds the table name of the oauthprovider in the database.
 	Table = "sys_oauth_providers"
 )
@@ -56,6 +68,12 @@ var Columns = []string{
 	FieldTokenURL,
 	FieldAuthStyle,
 	FieldInfoURL,
+	FieldDiscoveryURL,
+	FieldClaimMapping,
+	FieldAutoProvision,
+	FieldProvisionApproval,
+	FieldDefaultRoleID,
+	FieldRoleRules,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -75,6 +93,14 @@ var (
 	DefaultUpdatedAt func() time.Time
 	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
 	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultDiscoveryURL holds the default value on creation for the "discovery_url" field.
+	DefaultDiscoveryURL string
+	// DefaultAutoProvision holds the default value on creation for the "auto_provision" field.
+	DefaultAutoProvision bool
+	// DefaultProvisionApproval holds the default value on creation for the "provision_approval" field.
+	DefaultProvisionApproval bool
+	// DefaultDefaultRoleID holds the default value on creation for the "default_role_id" field.
+	DefaultDefaultRoleID uint64
 )
 
 // OrderOption defines the ordering options for the OauthProvider queries.
@@ -144,3 +170,23 @@ func ByAuthStyle(opts ...sql.OrderTermOption) OrderOption {
 func ByInfoURL(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldInfoURL, opts...).ToFunc()
 }
+
+// ByDiscoveryURL orders the results by the discovery_url field.
+func ByDiscoveryURL(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDiscoveryURL, opts...).ToFunc()
+}
+
+// ByAutoProvision orders the results by the auto_provision field.
+func ByAutoProvision(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAutoProvision, opts...).ToFunc()
+}
+
+// ByProvisionApproval orders the results by the provision_approval field.
+func ByProvisionApproval(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProvisionApproval, opts...).ToFunc()
+}
+
+// ByDefaultRoleID orders the results by the default_role_id field.
+func ByDefaultRoleID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDefaultRoleID, opts...).ToFunc()
+}
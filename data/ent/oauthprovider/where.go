@@ -114,6 +114,26 @@ func InfoURL(v string) predicate.OauthProvider {
 	return predicate.OauthProvider(sql.FieldEQ(FieldInfoURL, v))
 }
 
+// DiscoveryURL applies equality check predicate on the "discovery_url" field. It's identical to DiscoveryURLEQ.
+func DiscoveryURL(v string) predicate.OauthProvider {
+	return predicate.OauthProvider(sql.FieldEQ(FieldDiscoveryURL, v))
+}
+
+// AutoProvision applies equality check predicate on the "auto_provision" field. It's identical to AutoProvisionEQ.
+func AutoProvision(v bool) predicate.OauthProvider {
+	return predicate.OauthProvider(sql.FieldEQ(FieldAutoProvision, v))
+}
+
+// ProvisionApproval applies equality check predicate on the "provision_approval" field. It's identical to ProvisionApprovalEQ.
+func ProvisionApproval(v bool) predicate.OauthProvider {
+	return predicate.OauthProvider(sql.FieldEQ(FieldProvisionApproval, v))
+}
+
+// DefaultRoleID applies equality check predicate on the "default_role_id" field. It's identical to DefaultRoleIDEQ.
+func DefaultRoleID(v uint64) predicate.OauthProvider {
+	return predicate.OauthProvider(sql.FieldEQ(FieldDefaultRoleID, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.OauthProvider {
 	return predicate.OauthProvider(sql.FieldEQ(FieldCreatedAt, v))
@@ -669,6 +689,16 @@ func AuthURLHasSuffix(v string) predicate.OauthProvider {
 	return predicate.OauthProvider(sql.FieldHasSuffix(FieldAuthURL, v))
 }
 
+// AuthURLIsNil applies the IsNil predicate on the "auth_url" field.
+func AuthURLIsNil() predicate.OauthProvider {
+	return predicate.OauthProvider(sql.FieldIsNull(FieldAuthURL))
+}
+
+// AuthURLNotNil applies the NotNil predicate on the "auth_url" field.
+func AuthURLNotNil() predicate.OauthProvider {
+	return predicate.OauthProvider(sql.FieldNotNull(FieldAuthURL))
+}
+
 // AuthURLEqualFold applies the EqualFold predicate on the "auth_url" field.
 func AuthURLEqualFold(v string) predicate.OauthProvider {
 	return predicate.OauthProvider(sql.FieldEqualFold(FieldAuthURL, v))
@@ -879,6 +909,171 @@ func InfoURLContainsFold(v string) predicate.OauthProvider {
 	return predicate.OauthProvider(sql.FieldContainsFold(FieldInfoURL, v))
 }
 
+// DiscoveryURLEQ applies the EQ predicate on the "discovery_url" field.
+func DiscoveryURLEQ(v string) predicate.OauthProvider {
+	return predicate.OauthProvider(sql.FieldEQ(FieldDiscoveryURL, v))
+}
+
+// DiscoveryURLNEQ applies the NEQ predicate on the "discovery_url" field.
+func DiscoveryURLNEQ(v string) predicate.OauthProvider {
+	return predicate.OauthProvider(sql.FieldNEQ(FieldDiscoveryURL, v))
+}
+
+// DiscoveryURLIn applies the In predicate on the "discovery_url" field.
+func DiscoveryURLIn(vs ...string) predicate.OauthProvider {
+	return predicate.OauthProvider(sql.FieldIn(FieldDiscoveryURL, vs...))
+}
+
+// DiscoveryURLNotIn applies the NotIn predicate on the "discovery_url" field.
+func DiscoveryURLNotIn(vs ...string) predicate.OauthProvider {
+	return predicate.OauthProvider(sql.FieldNotIn(FieldDiscoveryURL, vs...))
+}
+
+// DiscoveryURLGT applies the GT predicate on the "discovery_url" field.
+func DiscoveryURLGT(v string) predicate.OauthProvider {
+	return predicate.OauthProvider(sql.FieldGT(FieldDiscoveryURL, v))
+}
+
+// DiscoveryURLGTE applies the GTE predicate on the "discovery_url" field.
+func DiscoveryURLGTE(v string) predicate.OauthProvider {
+	return predicate.OauthProvider(sql.FieldGTE(FieldDiscoveryURL, v))
+}
+
+// DiscoveryURLLT applies the LT predicate on the "discovery_url" field.
+func DiscoveryURLLT(v string) predicate.OauthProvider {
+	return predicate.OauthProvider(sql.FieldLT(FieldDiscoveryURL, v))
+}
+
+// DiscoveryURLLTE applies the LTE predicate on the "discovery_url" field.
+func DiscoveryURLLTE(v string) predicate.OauthProvider {
+	return predicate.OauthProvider(sql.FieldLTE(FieldDiscoveryURL, v))
+}
+
+// DiscoveryURLContains applies the Contains predicate on the "discovery_url" field.
+func DiscoveryURLContains(v string) predicate.OauthProvider {
+	return predicate.OauthProvider(sql.FieldContains(FieldDiscoveryURL, v))
+}
+
+// DiscoveryURLHasPrefix applies the HasPrefix predicate on the "discovery_url" field.
+func DiscoveryURLHasPrefix(v string) predicate.OauthProvider {
+	return predicate.OauthProvider(sql.FieldHasPrefix(FieldDiscoveryURL, v))
+}
+
+// DiscoveryURLHasSuffix applies the HasSuffix predicate on the "discovery_url" field.
+func DiscoveryURLHasSuffix(v string) predicate.OauthProvider {
+	return predicate.OauthProvider(sql.FieldHasSuffix(FieldDiscoveryURL, v))
+}
+
+// DiscoveryURLIsNil applies the IsNil predicate on the "discovery_url" field.
+func DiscoveryURLIsNil() predicate.OauthProvider {
+	return predicate.OauthProvider(sql.FieldIsNull(FieldDiscoveryURL))
+}
+
+// DiscoveryURLNotNil applies the NotNil predicate on the "discovery_url" field.
+func DiscoveryURLNotNil() predicate.OauthProvider {
+	return predicate.OauthProvider(sql.FieldNotNull(FieldDiscoveryURL))
+}
+
+// DiscoveryURLEqualFold applies the EqualFold predicate on the "discovery_url" field.
+func DiscoveryURLEqualFold(v string) predicate.OauthProvider {
+	return predicate.OauthProvider(sql.FieldEqualFold(FieldDiscoveryURL, v))
+}
+
+// DiscoveryURLContainsFold applies the ContainsFold predicate on the "discovery_url" field.
+func DiscoveryURLContainsFold(v string) predicate.OauthProvider {
+	return predicate.OauthProvider(sql.FieldContainsFold(FieldDiscoveryURL, v))
+}
+
+// ClaimMappingIsNil applies the IsNil predicate on the "claim_mapping" field.
+func ClaimMappingIsNil() predicate.OauthProvider {
+	return predicate.OauthProvider(sql.FieldIsNull(FieldClaimMapping))
+}
+
+// ClaimMappingNotNil applies the NotNil predicate on the "claim_mapping" field.
+func ClaimMappingNotNil() predicate.OauthProvider {
+	return predicate.OauthProvider(sql.FieldNotNull(FieldClaimMapping))
+}
+
+// AutoProvisionEQ applies the EQ predicate on the "auto_provision" field.
+func AutoProvisionEQ(v bool) predicate.OauthProvider {
+	return predicate.OauthProvider(sql.FieldEQ(FieldAutoProvision, v))
+}
+
+// AutoProvisionNEQ applies the NEQ predicate on the "auto_provision" field.
+func AutoProvisionNEQ(v bool) predicate.OauthProvider {
+	return predicate.OauthProvider(sql.FieldNEQ(FieldAutoProvision, v))
+}
+
+// ProvisionApprovalEQ applies the EQ predicate on the "provision_approval" field.
+func ProvisionApprovalEQ(v bool) predicate.OauthProvider {
+	return predicate.OauthProvider(sql.FieldEQ(FieldProvisionApproval, v))
+}
+
+// ProvisionApprovalNEQ applies the NEQ predicate on the "provision_approval" field.
+func ProvisionApprovalNEQ(v bool) predicate.OauthProvider {
+	return predicate.OauthProvider(sql.FieldNEQ(FieldProvisionApproval, v))
+}
+
+// DefaultRoleIDEQ applies the EQ predicate on the "default_role_id" field.
+func DefaultRoleIDEQ(v uint64) predicate.OauthProvider {
+	return predicate.OauthProvider(sql.FieldEQ(FieldDefaultRoleID, v))
+}
+
+// DefaultRoleIDNEQ applies the NEQ predicate on the "default_role_id" field.
+func DefaultRoleIDNEQ(v uint64) predicate.OauthProvider {
+	return predicate.OauthProvider(sql.FieldNEQ(FieldDefaultRoleID, v))
+}
+
+// DefaultRoleIDIn applies the In predicate on the "default_role_id" field.
+func DefaultRoleIDIn(vs ...uint64) predicate.OauthProvider {
+	return predicate.OauthProvider(sql.FieldIn(FieldDefaultRoleID, vs...))
+}
+
+// DefaultRoleIDNotIn applies the NotIn predicate on the "default_role_id" field.
+func DefaultRoleIDNotIn(vs ...uint64) predicate.OauthProvider {
+	return predicate.OauthProvider(sql.FieldNotIn(FieldDefaultRoleID, vs...))
+}
+
+// DefaultRoleIDGT applies the GT predicate on the "default_role_id" field.
+func DefaultRoleIDGT(v uint64) predicate.OauthProvider {
+	return predicate.OauthProvider(sql.FieldGT(FieldDefaultRoleID, v))
+}
+
+// DefaultRoleIDGTE applies the GTE predicate on the "default_role_id" field.
+func DefaultRoleIDGTE(v uint64) predicate.OauthProvider {
+	return predicate.OauthProvider(sql.FieldGTE(FieldDefaultRoleID, v))
+}
+
+// DefaultRoleIDLT applies the LT predicate on the "default_role_id" field.
+func DefaultRoleIDLT(v uint64) predicate.OauthProvider {
+	return predicate.OauthProvider(sql.FieldLT(FieldDefaultRoleID, v))
+}
+
+// DefaultRoleIDLTE applies the LTE predicate on the "default_role_id" field.
+func DefaultRoleIDLTE(v uint64) predicate.OauthProvider {
+	return predicate.OauthProvider(sql.FieldLTE(FieldDefaultRoleID, v))
+}
+
+// DefaultRoleIDIsNil applies the IsNil predicate on the "default_role_id" field.
+func DefaultRoleIDIsNil() predicate.OauthProvider {
+	return predicate.OauthProvider(sql.FieldIsNull(FieldDefaultRoleID))
+}
+
+// DefaultRoleIDNotNil applies the NotNil predicate on the "default_role_id" field.
+func DefaultRoleIDNotNil() predicate.OauthProvider {
+	return predicate.OauthProvider(sql.FieldNotNull(FieldDefaultRoleID))
+}
+
+// RoleRulesIsNil applies the IsNil predicate on the "role_rules" field.
+func RoleRulesIsNil() predicate.OauthProvider {
+	return predicate.OauthProvider(sql.FieldIsNull(FieldRoleRules))
+}
+
+// RoleRulesNotNil applies the NotNil predicate on the "role_rules" field.
+func RoleRulesNotNil() predicate.OauthProvider {
+	return predicate.OauthProvider(sql.FieldNotNull(FieldRoleRules))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.OauthProvider) predicate.OauthProvider {
 	return predicate.OauthProvider(sql.AndPredicates(predicates...))
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"formulago/data/ent/oauthprovider"
+	"formulago/pkg/rolerule"
 	"time"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -114,6 +115,14 @@ func (_c *OauthProviderCreate) SetAuthURL(v string) *OauthProviderCreate {
 	return _c
 }
 
+// SetNillableAuthURL sets the "auth_url" field if the given value is not nil.
+func (_c *OauthProviderCreate) SetNillableAuthURL(v *string) *OauthProviderCreate {
+	if v != nil {
+		_c.SetAuthURL(*v)
+	}
+	return _c
+}
+
 // SetTokenURL sets the "token_url" field.
 func (_c *OauthProviderCreate) SetTokenURL(v string) *OauthProviderCreate {
 	_c.mutation.SetTokenURL(v)
@@ -156,6 +165,74 @@ func (_c *OauthProviderCreate) SetNillableInfoURL(v *string) *OauthProviderCreat
 	return _c
 }
 
+// SetDiscoveryURL sets the "discovery_url" field.
+func (_c *OauthProviderCreate) SetDiscoveryURL(v string) *OauthProviderCreate {
+	_c.mutation.SetDiscoveryURL(v)
+	return _c
+}
+
+// SetNillableDiscoveryURL sets the "discovery_url" field if the given value is not nil.
+func (_c *OauthProviderCreate) SetNillableDiscoveryURL(v *string) *OauthProviderCreate {
+	if v != nil {
+		_c.SetDiscoveryURL(*v)
+	}
+	return _c
+}
+
+// SetClaimMapping sets the "claim_mapping" field.
+func (_c *OauthProviderCreate) SetClaimMapping(v map[string]string) *OauthProviderCreate {
+	_c.mutation.SetClaimMapping(v)
+	return _c
+}
+
+// SetAutoProvision sets the "auto_provision" field.
+func (_c *OauthProviderCreate) SetAutoProvision(v bool) *OauthProviderCreate {
+	_c.mutation.SetAutoProvision(v)
+	return _c
+}
+
+// SetNillableAutoProvision sets the "auto_provision" field if the given value is not nil.
+func (_c *OauthProviderCreate) SetNillableAutoProvision(v *bool) *OauthProviderCreate {
+	if v != nil {
+		_c.SetAutoProvision(*v)
+	}
+	return _c
+}
+
+// SetProvisionApproval sets the "provision_approval" field.
+func (_c *OauthProviderCreate) SetProvisionApproval(v bool) *OauthProviderCreate {
+	_c.mutation.SetProvisionApproval(v)
+	return _c
+}
+
+// SetNillableProvisionApproval sets the "provision_approval" field if the given value is not nil.
+func (_c *OauthProviderCreate) SetNillableProvisionApproval(v *bool) *OauthProviderCreate {
+	if v != nil {
+		_c.SetProvisionApproval(*v)
+	}
+	return _c
+}
+
+// SetDefaultRoleID sets the "default_role_id" field.
+func (_c *OauthProviderCreate) SetDefaultRoleID(v uint64) *OauthProviderCreate {
+	_c.mutation.SetDefaultRoleID(v)
+	return _c
+}
+
+// SetNillableDefaultRoleID sets the "default_role_id" field if the given value is not nil.
+func (_c *OauthProviderCreate) SetNillableDefaultRoleID(v *uint64) *OauthProviderCreate {
+	if v != nil {
+		_c.SetDefaultRoleID(*v)
+	}
+	return _c
+}
+
+// SetRoleRules sets the "role_rules" field.
+func (_c *OauthProviderCreate) SetRoleRules(v []rolerule.Rule) *OauthProviderCreate {
+	_c.mutation.SetRoleRules(v)
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *OauthProviderCreate) SetID(v uint64) *OauthProviderCreate {
 	_c.mutation.SetID(v)
@@ -205,6 +282,22 @@ func (_c *OauthProviderCreate) defaults() {
 		v := oauthprovider.DefaultUpdatedAt()
 		_c.mutation.SetUpdatedAt(v)
 	}
+	if _, ok := _c.mutation.DiscoveryURL(); !ok {
+		v := oauthprovider.DefaultDiscoveryURL
+		_c.mutation.SetDiscoveryURL(v)
+	}
+	if _, ok := _c.mutation.AutoProvision(); !ok {
+		v := oauthprovider.DefaultAutoProvision
+		_c.mutation.SetAutoProvision(v)
+	}
+	if _, ok := _c.mutation.ProvisionApproval(); !ok {
+		v := oauthprovider.DefaultProvisionApproval
+		_c.mutation.SetProvisionApproval(v)
+	}
+	if _, ok := _c.mutation.DefaultRoleID(); !ok {
+		v := oauthprovider.DefaultDefaultRoleID
+		_c.mutation.SetDefaultRoleID(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -224,8 +317,11 @@ func (_c *OauthProviderCreate) check() error {
 	if _, ok := _c.mutation.RedirectURL(); !ok {
 		return &ValidationError{Name: "redirect_url", err: errors.New(`ent: missing required field "OauthProvider.redirect_url"`)}
 	}
-	if _, ok := _c.mutation.AuthURL(); !ok {
-		return &ValidationError{Name: "auth_url", err: errors.New(`ent: missing required field "OauthProvider.auth_url"`)}
+	if _, ok := _c.mutation.AutoProvision(); !ok {
+		return &ValidationError{Name: "auto_provision", err: errors.New(`ent: missing required field "OauthProvider.auto_provision"`)}
+	}
+	if _, ok := _c.mutation.ProvisionApproval(); !ok {
+		return &ValidationError{Name: "provision_approval", err: errors.New(`ent: missing required field "OauthProvider.provision_approval"`)}
 	}
 	return nil
 }
@@ -307,6 +403,30 @@ func (_c *OauthProviderCreate) createSpec() (*OauthProvider, *sqlgraph.CreateSpe
 		_spec.SetField(oauthprovider.FieldInfoURL, field.TypeString, value)
 		_node.InfoURL = value
 	}
+	if value, ok := _c.mutation.DiscoveryURL(); ok {
+		_spec.SetField(oauthprovider.FieldDiscoveryURL, field.TypeString, value)
+		_node.DiscoveryURL = value
+	}
+	if value, ok := _c.mutation.ClaimMapping(); ok {
+		_spec.SetField(oauthprovider.FieldClaimMapping, field.TypeJSON, value)
+		_node.ClaimMapping = value
+	}
+	if value, ok := _c.mutation.AutoProvision(); ok {
+		_spec.SetField(oauthprovider.FieldAutoProvision, field.TypeBool, value)
+		_node.AutoProvision = value
+	}
+	if value, ok := _c.mutation.ProvisionApproval(); ok {
+		_spec.SetField(oauthprovider.FieldProvisionApproval, field.TypeBool, value)
+		_node.ProvisionApproval = value
+	}
+	if value, ok := _c.mutation.DefaultRoleID(); ok {
+		_spec.SetField(oauthprovider.FieldDefaultRoleID, field.TypeUint64, value)
+		_node.DefaultRoleID = value
+	}
+	if value, ok := _c.mutation.RoleRules(); ok {
+		_spec.SetField(oauthprovider.FieldRoleRules, field.TypeJSON, value)
+		_node.RoleRules = value
+	}
 	return _node, _spec
 }
 
@@ -8,10 +8,12 @@ import (
 	"fmt"
 	"formulago/data/ent/oauthprovider"
 	"formulago/data/ent/predicate"
+	"formulago/pkg/rolerule"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 )
 
@@ -150,6 +152,12 @@ func (_u *OauthProviderUpdate) SetNillableAuthURL(v *string) *OauthProviderUpdat
 	return _u
 }
 
+// ClearAuthURL clears the value of the "auth_url" field.
+func (_u *OauthProviderUpdate) ClearAuthURL() *OauthProviderUpdate {
+	_u.mutation.ClearAuthURL()
+	return _u
+}
+
 // SetTokenURL sets the "token_url" field.
 func (_u *OauthProviderUpdate) SetTokenURL(v string) *OauthProviderUpdate {
 	_u.mutation.SetTokenURL(v)
@@ -217,6 +225,111 @@ func (_u *OauthProviderUpdate) ClearInfoURL() *OauthProviderUpdate {
 	return _u
 }
 
+// SetDiscoveryURL sets the "discovery_url" field.
+func (_u *OauthProviderUpdate) SetDiscoveryURL(v string) *OauthProviderUpdate {
+	_u.mutation.SetDiscoveryURL(v)
+	return _u
+}
+
+// SetNillableDiscoveryURL sets the "discovery_url" field if the given value is not nil.
+func (_u *OauthProviderUpdate) SetNillableDiscoveryURL(v *string) *OauthProviderUpdate {
+	if v != nil {
+		_u.SetDiscoveryURL(*v)
+	}
+	return _u
+}
+
+// ClearDiscoveryURL clears the value of the "discovery_url" field.
+func (_u *OauthProviderUpdate) ClearDiscoveryURL() *OauthProviderUpdate {
+	_u.mutation.ClearDiscoveryURL()
+	return _u
+}
+
+// SetClaimMapping sets the "claim_mapping" field.
+func (_u *OauthProviderUpdate) SetClaimMapping(v map[string]string) *OauthProviderUpdate {
+	_u.mutation.SetClaimMapping(v)
+	return _u
+}
+
+// ClearClaimMapping clears the value of the "claim_mapping" field.
+func (_u *OauthProviderUpdate) ClearClaimMapping() *OauthProviderUpdate {
+	_u.mutation.ClearClaimMapping()
+	return _u
+}
+
+// SetAutoProvision sets the "auto_provision" field.
+func (_u *OauthProviderUpdate) SetAutoProvision(v bool) *OauthProviderUpdate {
+	_u.mutation.SetAutoProvision(v)
+	return _u
+}
+
+// SetNillableAutoProvision sets the "auto_provision" field if the given value is not nil.
+func (_u *OauthProviderUpdate) SetNillableAutoProvision(v *bool) *OauthProviderUpdate {
+	if v != nil {
+		_u.SetAutoProvision(*v)
+	}
+	return _u
+}
+
+// SetProvisionApproval sets the "provision_approval" field.
+func (_u *OauthProviderUpdate) SetProvisionApproval(v bool) *OauthProviderUpdate {
+	_u.mutation.SetProvisionApproval(v)
+	return _u
+}
+
+// SetNillableProvisionApproval sets the "provision_approval" field if the given value is not nil.
+func (_u *OauthProviderUpdate) SetNillableProvisionApproval(v *bool) *OauthProviderUpdate {
+	if v != nil {
+		_u.SetProvisionApproval(*v)
+	}
+	return _u
+}
+
+// SetDefaultRoleID sets the "default_role_id" field.
+func (_u *OauthProviderUpdate) SetDefaultRoleID(v uint64) *OauthProviderUpdate {
+	_u.mutation.ResetDefaultRoleID()
+	_u.mutation.SetDefaultRoleID(v)
+	return _u
+}
+
+// SetNillableDefaultRoleID sets the "default_role_id" field if the given value is not nil.
+func (_u *OauthProviderUpdate) SetNillableDefaultRoleID(v *uint64) *OauthProviderUpdate {
+	if v != nil {
+		_u.SetDefaultRoleID(*v)
+	}
+	return _u
+}
+
+// AddDefaultRoleID adds value to the "default_role_id" field.
+func (_u *OauthProviderUpdate) AddDefaultRoleID(v int64) *OauthProviderUpdate {
+	_u.mutation.AddDefaultRoleID(v)
+	return _u
+}
+
+// ClearDefaultRoleID clears the value of the "default_role_id" field.
+func (_u *OauthProviderUpdate) ClearDefaultRoleID() *OauthProviderUpdate {
+	_u.mutation.ClearDefaultRoleID()
+	return _u
+}
+
+// SetRoleRules sets the "role_rules" field.
+func (_u *OauthProviderUpdate) SetRoleRules(v []rolerule.Rule) *OauthProviderUpdate {
+	_u.mutation.SetRoleRules(v)
+	return _u
+}
+
+// AppendRoleRules appends value to the "role_rules" field.
+func (_u *OauthProviderUpdate) AppendRoleRules(v []rolerule.Rule) *OauthProviderUpdate {
+	_u.mutation.AppendRoleRules(v)
+	return _u
+}
+
+// ClearRoleRules clears the value of the "role_rules" field.
+func (_u *OauthProviderUpdate) ClearRoleRules() *OauthProviderUpdate {
+	_u.mutation.ClearRoleRules()
+	return _u
+}
+
 // Mutation returns the OauthProviderMutation object of the builder.
 func (_u *OauthProviderUpdate) Mutation() *OauthProviderMutation {
 	return _u.mutation
@@ -300,6 +413,9 @@ func (_u *OauthProviderUpdate) sqlSave(ctx context.Context) (_node int, err erro
 	if value, ok := _u.mutation.AuthURL(); ok {
 		_spec.SetField(oauthprovider.FieldAuthURL, field.TypeString, value)
 	}
+	if _u.mutation.AuthURLCleared() {
+		_spec.ClearField(oauthprovider.FieldAuthURL, field.TypeString)
+	}
 	if value, ok := _u.mutation.TokenURL(); ok {
 		_spec.SetField(oauthprovider.FieldTokenURL, field.TypeString, value)
 	}
@@ -321,6 +437,44 @@ func (_u *OauthProviderUpdate) sqlSave(ctx context.Context) (_node int, err erro
 	if _u.mutation.InfoURLCleared() {
 		_spec.ClearField(oauthprovider.FieldInfoURL, field.TypeString)
 	}
+	if value, ok := _u.mutation.DiscoveryURL(); ok {
+		_spec.SetField(oauthprovider.FieldDiscoveryURL, field.TypeString, value)
+	}
+	if _u.mutation.DiscoveryURLCleared() {
+		_spec.ClearField(oauthprovider.FieldDiscoveryURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.ClaimMapping(); ok {
+		_spec.SetField(oauthprovider.FieldClaimMapping, field.TypeJSON, value)
+	}
+	if _u.mutation.ClaimMappingCleared() {
+		_spec.ClearField(oauthprovider.FieldClaimMapping, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AutoProvision(); ok {
+		_spec.SetField(oauthprovider.FieldAutoProvision, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ProvisionApproval(); ok {
+		_spec.SetField(oauthprovider.FieldProvisionApproval, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.DefaultRoleID(); ok {
+		_spec.SetField(oauthprovider.FieldDefaultRoleID, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedDefaultRoleID(); ok {
+		_spec.AddField(oauthprovider.FieldDefaultRoleID, field.TypeUint64, value)
+	}
+	if _u.mutation.DefaultRoleIDCleared() {
+		_spec.ClearField(oauthprovider.FieldDefaultRoleID, field.TypeUint64)
+	}
+	if value, ok := _u.mutation.RoleRules(); ok {
+		_spec.SetField(oauthprovider.FieldRoleRules, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedRoleRules(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, oauthprovider.FieldRoleRules, value)
+		})
+	}
+	if _u.mutation.RoleRulesCleared() {
+		_spec.ClearField(oauthprovider.FieldRoleRules, field.TypeJSON)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{oauthprovider.Label}
@@ -463,6 +617,12 @@ func (_u *OauthProviderUpdateOne) SetNillableAuthURL(v *string) *OauthProviderUp
 	return _u
 }
 
+// ClearAuthURL clears the value of the "auth_url" field.
+func (_u *OauthProviderUpdateOne) ClearAuthURL() *OauthProviderUpdateOne {
+	_u.mutation.ClearAuthURL()
+	return _u
+}
+
 // SetTokenURL sets the "token_url" field.
 func (_u *OauthProviderUpdateOne) SetTokenURL(v string) *OauthProviderUpdateOne {
 	_u.mutation.SetTokenURL(v)
@@ -530,6 +690,111 @@ func (_u *OauthProviderUpdateOne) ClearInfoURL() *OauthProviderUpdateOne {
 	return _u
 }
 
+// SetDiscoveryURL sets the "discovery_url" field.
+func (_u *OauthProviderUpdateOne) SetDiscoveryURL(v string) *OauthProviderUpdateOne {
+	_u.mutation.SetDiscoveryURL(v)
+	return _u
+}
+
+// SetNillableDiscoveryURL sets the "discovery_url" field if the given value is not nil.
+func (_u *OauthProviderUpdateOne) SetNillableDiscoveryURL(v *string) *OauthProviderUpdateOne {
+	if v != nil {
+		_u.SetDiscoveryURL(*v)
+	}
+	return _u
+}
+
+// ClearDiscoveryURL clears the value of the "discovery_url" field.
+func (_u *OauthProviderUpdateOne) ClearDiscoveryURL() *OauthProviderUpdateOne {
+	_u.mutation.ClearDiscoveryURL()
+	return _u
+}
+
+// SetClaimMapping sets the "claim_mapping" field.
+func (_u *OauthProviderUpdateOne) SetClaimMapping(v map[string]string) *OauthProviderUpdateOne {
+	_u.mutation.SetClaimMapping(v)
+	return _u
+}
+
+// ClearClaimMapping clears the value of the "claim_mapping" field.
+func (_u *OauthProviderUpdateOne) ClearClaimMapping() *OauthProviderUpdateOne {
+	_u.mutation.ClearClaimMapping()
+	return _u
+}
+
+// SetAutoProvision sets the "auto_provision" field.
+func (_u *OauthProviderUpdateOne) SetAutoProvision(v bool) *OauthProviderUpdateOne {
+	_u.mutation.SetAutoProvision(v)
+	return _u
+}
+
+// SetNillableAutoProvision sets the "auto_provision" field if the given value is not nil.
+func (_u *OauthProviderUpdateOne) SetNillableAutoProvision(v *bool) *OauthProviderUpdateOne {
+	if v != nil {
+		_u.SetAutoProvision(*v)
+	}
+	return _u
+}
+
+// SetProvisionApproval sets the "provision_approval" field.
+func (_u *OauthProviderUpdateOne) SetProvisionApproval(v bool) *OauthProviderUpdateOne {
+	_u.mutation.SetProvisionApproval(v)
+	return _u
+}
+
+// SetNillableProvisionApproval sets the "provision_approval" field if the given value is not nil.
+func (_u *OauthProviderUpdateOne) SetNillableProvisionApproval(v *bool) *OauthProviderUpdateOne {
+	if v != nil {
+		_u.SetProvisionApproval(*v)
+	}
+	return _u
+}
+
+// SetDefaultRoleID sets the "default_role_id" field.
+func (_u *OauthProviderUpdateOne) SetDefaultRoleID(v uint64) *OauthProviderUpdateOne {
+	_u.mutation.ResetDefaultRoleID()
+	_u.mutation.SetDefaultRoleID(v)
+	return _u
+}
+
+// SetNillableDefaultRoleID sets the "default_role_id" field if the given value is not nil.
+func (_u *OauthProviderUpdateOne) SetNillableDefaultRoleID(v *uint64) *OauthProviderUpdateOne {
+	if v != nil {
+		_u.SetDefaultRoleID(*v)
+	}
+	return _u
+}
+
+// AddDefaultRoleID adds value to the "default_role_id" field.
+func (_u *OauthProviderUpdateOne) AddDefaultRoleID(v int64) *OauthProviderUpdateOne {
+	_u.mutation.AddDefaultRoleID(v)
+	return _u
+}
+
+// ClearDefaultRoleID clears the value of the "default_role_id" field.
+func (_u *OauthProviderUpdateOne) ClearDefaultRoleID() *OauthProviderUpdateOne {
+	_u.mutation.ClearDefaultRoleID()
+	return _u
+}
+
+// SetRoleRules sets the "role_rules" field.
+func (_u *OauthProviderUpdateOne) SetRoleRules(v []rolerule.Rule) *OauthProviderUpdateOne {
+	_u.mutation.SetRoleRules(v)
+	return _u
+}
+
+// AppendRoleRules appends value to the "role_rules" field.
+func (_u *OauthProviderUpdateOne) AppendRoleRules(v []rolerule.Rule) *OauthProviderUpdateOne {
+	_u.mutation.AppendRoleRules(v)
+	return _u
+}
+
+// ClearRoleRules clears the value of the "role_rules" field.
+func (_u *OauthProviderUpdateOne) ClearRoleRules() *OauthProviderUpdateOne {
+	_u.mutation.ClearRoleRules()
+	return _u
+}
+
 // Mutation returns the OauthProviderMutation object of the builder.
 func (_u *OauthProviderUpdateOne) Mutation() *OauthProviderMutation {
 	return _u.mutation
@@ -643,6 +908,9 @@ func (_u *OauthProviderUpdateOne) sqlSave(ctx context.Context) (_node *OauthProv
 	if value, ok := _u.mutation.AuthURL(); ok {
 		_spec.SetField(oauthprovider.FieldAuthURL, field.TypeString, value)
 	}
+	if _u.mutation.AuthURLCleared() {
+		_spec.ClearField(oauthprovider.FieldAuthURL, field.TypeString)
+	}
 	if value, ok := _u.mutation.TokenURL(); ok {
 		_spec.SetField(oauthprovider.FieldTokenURL, field.TypeString, value)
 	}
@@ -664,6 +932,44 @@ func (_u *OauthProviderUpdateOne) sqlSave(ctx context.Context) (_node *OauthProv
 	if _u.mutation.InfoURLCleared() {
 		_spec.ClearField(oauthprovider.FieldInfoURL, field.TypeString)
 	}
+	if value, ok := _u.mutation.DiscoveryURL(); ok {
+		_spec.SetField(oauthprovider.FieldDiscoveryURL, field.TypeString, value)
+	}
+	if _u.mutation.DiscoveryURLCleared() {
+		_spec.ClearField(oauthprovider.FieldDiscoveryURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.ClaimMapping(); ok {
+		_spec.SetField(oauthprovider.FieldClaimMapping, field.TypeJSON, value)
+	}
+	if _u.mutation.ClaimMappingCleared() {
+		_spec.ClearField(oauthprovider.FieldClaimMapping, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AutoProvision(); ok {
+		_spec.SetField(oauthprovider.FieldAutoProvision, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ProvisionApproval(); ok {
+		_spec.SetField(oauthprovider.FieldProvisionApproval, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.DefaultRoleID(); ok {
+		_spec.SetField(oauthprovider.FieldDefaultRoleID, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedDefaultRoleID(); ok {
+		_spec.AddField(oauthprovider.FieldDefaultRoleID, field.TypeUint64, value)
+	}
+	if _u.mutation.DefaultRoleIDCleared() {
+		_spec.ClearField(oauthprovider.FieldDefaultRoleID, field.TypeUint64)
+	}
+	if value, ok := _u.mutation.RoleRules(); ok {
+		_spec.SetField(oauthprovider.FieldRoleRules, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedRoleRules(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, oauthprovider.FieldRoleRules, value)
+		})
+	}
+	if _u.mutation.RoleRulesCleared() {
+		_spec.ClearField(oauthprovider.FieldRoleRules, field.TypeJSON)
+	}
 	_node = &OauthProvider{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,227 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"formulago/data/ent/oidcclient"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// OidcClient is the model entity for the OidcClient schema.
+type OidcClient struct {
+	config `json:"-"`
+	// ID of the ent.
+	// primary key
+	ID uint64 `json:"id,omitempty"`
+	// created time
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// last update time
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// status 1 normal 0 ban | 状态 1 正常 0 禁用
+	Status uint8 `json:"status,omitempty"`
+	// the client id | 客户端 id
+	ClientID string `json:"client_id,omitempty"`
+	// bcrypt of the client secret, empty for public clients | 客户端密钥的bcrypt摘要, 公共客户端为空
+	ClientSecret string `json:"-"`
+	// the client name shown on the consent page | 客户端名称, 显示在授权页面
+	Name string `json:"name,omitempty"`
+	// the registered redirect URIs | 已登记的回调地址
+	RedirectUris []string `json:"redirect_uris,omitempty"`
+	// allowed grant types | 允许的授权类型
+	GrantTypes []string `json:"grant_types,omitempty"`
+	// allowed scopes | 允许的权限范围
+	Scopes []string `json:"scopes,omitempty"`
+	// public client without secret, PKCE only | 无密钥的公共客户端, 仅支持PKCE
+	Public bool `json:"public,omitempty"`
+	// trusted first party client, consent is not asked | 受信任的内部客户端, 不询问用户授权
+	SkipConsent  bool `json:"skip_consent,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*OidcClient) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case oidcclient.FieldRedirectUris, oidcclient.FieldGrantTypes, oidcclient.FieldScopes:
+			values[i] = new([]byte)
+		case oidcclient.FieldPublic, oidcclient.FieldSkipConsent:
+			values[i] = new(sql.NullBool)
+		case oidcclient.FieldID, oidcclient.FieldStatus:
+			values[i] = new(sql.NullInt64)
+		case oidcclient.FieldClientID, oidcclient.FieldClientSecret, oidcclient.FieldName:
+			values[i] = new(sql.NullString)
+		case oidcclient.FieldCreatedAt, oidcclient.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the OidcClient fields.
+func (_m *OidcClient) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case oidcclient.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = uint64(value.Int64)
+		case oidcclient.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case oidcclient.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case oidcclient.FieldStatus:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = uint8(value.Int64)
+			}
+		case oidcclient.FieldClientID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field client_id", values[i])
+			} else if value.Valid {
+				_m.ClientID = value.String
+			}
+		case oidcclient.FieldClientSecret:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field client_secret", values[i])
+			} else if value.Valid {
+				_m.ClientSecret = value.String
+			}
+		case oidcclient.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case oidcclient.FieldRedirectUris:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field redirect_uris", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.RedirectUris); err != nil {
+					return fmt.Errorf("unmarshal field redirect_uris: %w", err)
+				}
+			}
+		case oidcclient.FieldGrantTypes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field grant_types", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.GrantTypes); err != nil {
+					return fmt.Errorf("unmarshal field grant_types: %w", err)
+				}
+			}
+		case oidcclient.FieldScopes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field scopes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Scopes); err != nil {
+					return fmt.Errorf("unmarshal field scopes: %w", err)
+				}
+			}
+		case oidcclient.FieldPublic:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field public", values[i])
+			} else if value.Valid {
+				_m.Public = value.Bool
+			}
+		case oidcclient.FieldSkipConsent:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field skip_consent", values[i])
+			} else if value.Valid {
+				_m.SkipConsent = value.Bool
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the OidcClient.
+// This includes values selected through modifiers, order, etc.
+func (_m *OidcClient) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this OidcClient.
+// Note that you need to call OidcClient.Unwrap() before calling this method if this OidcClient
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *OidcClient) Update() *OidcClientUpdateOne {
+	return NewOidcClientClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the OidcClient entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *OidcClient) Unwrap() *OidcClient {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: OidcClient is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *OidcClient) String() string {
+	var builder strings.Builder
+	builder.WriteString("OidcClient(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	builder.WriteString("client_id=")
+	builder.WriteString(_m.ClientID)
+	builder.WriteString(", ")
+	builder.WriteString("client_secret=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("redirect_uris=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RedirectUris))
+	builder.WriteString(", ")
+	builder.WriteString("grant_types=")
+	builder.WriteString(fmt.Sprintf("%v", _m.GrantTypes))
+	builder.WriteString(", ")
+	builder.WriteString("scopes=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Scopes))
+	builder.WriteString(", ")
+	builder.WriteString("public=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Public))
+	builder.WriteString(", ")
+	builder.WriteString("skip_consent=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SkipConsent))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// OidcClients is a parsable slice of OidcClient.
+type OidcClients []*OidcClient
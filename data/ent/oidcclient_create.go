@@ -0,0 +1,395 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"formulago/data/ent/oidcclient"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// OidcClientCreate is the builder for creating a OidcClient entity.
+type OidcClientCreate struct {
+	config
+	mutation *OidcClientMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *OidcClientCreate) SetCreatedAt(v time.Time) *OidcClientCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *OidcClientCreate) SetNillableCreatedAt(v *time.Time) *OidcClientCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *OidcClientCreate) SetUpdatedAt(v time.Time) *OidcClientCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *OidcClientCreate) SetNillableUpdatedAt(v *time.Time) *OidcClientCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *OidcClientCreate) SetStatus(v uint8) *OidcClientCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *OidcClientCreate) SetNillableStatus(v *uint8) *OidcClientCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetClientID sets the "client_id" field.
+func (_c *OidcClientCreate) SetClientID(v string) *OidcClientCreate {
+	_c.mutation.SetClientID(v)
+	return _c
+}
+
+// SetClientSecret sets the "client_secret" field.
+func (_c *OidcClientCreate) SetClientSecret(v string) *OidcClientCreate {
+	_c.mutation.SetClientSecret(v)
+	return _c
+}
+
+// SetNillableClientSecret sets the "client_secret" field if the given value is not nil.
+func (_c *OidcClientCreate) SetNillableClientSecret(v *string) *OidcClientCreate {
+	if v != nil {
+		_c.SetClientSecret(*v)
+	}
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *OidcClientCreate) SetName(v string) *OidcClientCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetRedirectUris sets the "redirect_uris" field.
+func (_c *OidcClientCreate) SetRedirectUris(v []string) *OidcClientCreate {
+	_c.mutation.SetRedirectUris(v)
+	return _c
+}
+
+// SetGrantTypes sets the "grant_types" field.
+func (_c *OidcClientCreate) SetGrantTypes(v []string) *OidcClientCreate {
+	_c.mutation.SetGrantTypes(v)
+	return _c
+}
+
+// SetScopes sets the "scopes" field.
+func (_c *OidcClientCreate) SetScopes(v []string) *OidcClientCreate {
+	_c.mutation.SetScopes(v)
+	return _c
+}
+
+// SetPublic sets the "public" field.
+func (_c *OidcClientCreate) SetPublic(v bool) *OidcClientCreate {
+	_c.mutation.SetPublic(v)
+	return _c
+}
+
+// SetNillablePublic sets the "public" field if the given value is not nil.
+func (_c *OidcClientCreate) SetNillablePublic(v *bool) *OidcClientCreate {
+	if v != nil {
+		_c.SetPublic(*v)
+	}
+	return _c
+}
+
+// SetSkipConsent sets the "skip_consent" field.
+func (_c *OidcClientCreate) SetSkipConsent(v bool) *OidcClientCreate {
+	_c.mutation.SetSkipConsent(v)
+	return _c
+}
+
+// SetNillableSkipConsent sets the "skip_consent" field if the given value is not nil.
+func (_c *OidcClientCreate) SetNillableSkipConsent(v *bool) *OidcClientCreate {
+	if v != nil {
+		_c.SetSkipConsent(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *OidcClientCreate) SetID(v uint64) *OidcClientCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the OidcClientMutation object of the builder.
+func (_c *OidcClientCreate) Mutation() *OidcClientMutation {
+	return _c.mutation
+}
+
+// Save creates the OidcClient in the database.
+func (_c *OidcClientCreate) Save(ctx context.Context) (*OidcClient, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *OidcClientCreate) SaveX(ctx context.Context) *OidcClient {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *OidcClientCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *OidcClientCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *OidcClientCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := oidcclient.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := oidcclient.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := oidcclient.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.ClientSecret(); !ok {
+		v := oidcclient.DefaultClientSecret
+		_c.mutation.SetClientSecret(v)
+	}
+	if _, ok := _c.mutation.Public(); !ok {
+		v := oidcclient.DefaultPublic
+		_c.mutation.SetPublic(v)
+	}
+	if _, ok := _c.mutation.SkipConsent(); !ok {
+		v := oidcclient.DefaultSkipConsent
+		_c.mutation.SetSkipConsent(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *OidcClientCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "OidcClient.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "OidcClient.updated_at"`)}
+	}
+	if _, ok := _c.mutation.ClientID(); !ok {
+		return &ValidationError{Name: "client_id", err: errors.New(`ent: missing required field "OidcClient.client_id"`)}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "OidcClient.name"`)}
+	}
+	if _, ok := _c.mutation.GrantTypes(); !ok {
+		return &ValidationError{Name: "grant_types", err: errors.New(`ent: missing required field "OidcClient.grant_types"`)}
+	}
+	if _, ok := _c.mutation.Scopes(); !ok {
+		return &ValidationError{Name: "scopes", err: errors.New(`ent: missing required field "OidcClient.scopes"`)}
+	}
+	if _, ok := _c.mutation.Public(); !ok {
+		return &ValidationError{Name: "public", err: errors.New(`ent: missing required field "OidcClient.public"`)}
+	}
+	if _, ok := _c.mutation.SkipConsent(); !ok {
+		return &ValidationError{Name: "skip_consent", err: errors.New(`ent: missing required field "OidcClient.skip_consent"`)}
+	}
+	return nil
+}
+
+func (_c *OidcClientCreate) sqlSave(ctx context.Context) (*OidcClient, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint64(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *OidcClientCreate) createSpec() (*OidcClient, *sqlgraph.CreateSpec) {
+	var (
+		_node = &OidcClient{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(oidcclient.Table, sqlgraph.NewFieldSpec(oidcclient.FieldID, field.TypeUint64))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(oidcclient.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(oidcclient.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(oidcclient.FieldStatus, field.TypeUint8, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.ClientID(); ok {
+		_spec.SetField(oidcclient.FieldClientID, field.TypeString, value)
+		_node.ClientID = value
+	}
+	if value, ok := _c.mutation.ClientSecret(); ok {
+		_spec.SetField(oidcclient.FieldClientSecret, field.TypeString, value)
+		_node.ClientSecret = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(oidcclient.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.RedirectUris(); ok {
+		_spec.SetField(oidcclient.FieldRedirectUris, field.TypeJSON, value)
+		_node.RedirectUris = value
+	}
+	if value, ok := _c.mutation.GrantTypes(); ok {
+		_spec.SetField(oidcclient.FieldGrantTypes, field.TypeJSON, value)
+		_node.GrantTypes = value
+	}
+	if value, ok := _c.mutation.Scopes(); ok {
+		_spec.SetField(oidcclient.FieldScopes, field.TypeJSON, value)
+		_node.Scopes = value
+	}
+	if value, ok := _c.mutation.Public(); ok {
+		_spec.SetField(oidcclient.FieldPublic, field.TypeBool, value)
+		_node.Public = value
+	}
+	if value, ok := _c.mutation.SkipConsent(); ok {
+		_spec.SetField(oidcclient.FieldSkipConsent, field.TypeBool, value)
+		_node.SkipConsent = value
+	}
+	return _node, _spec
+}
+
+// OidcClientCreateBulk is the builder for creating many OidcClient entities in bulk.
+type OidcClientCreateBulk struct {
+	config
+	err      error
+	builders []*OidcClientCreate
+}
+
+// Save creates the OidcClient entities in the database.
+func (_c *OidcClientCreateBulk) Save(ctx context.Context) ([]*OidcClient, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*OidcClient, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*OidcClientMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *OidcClientCreateBulk) SaveX(ctx context.Context) []*OidcClient {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *OidcClientCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *OidcClientCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,611 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"formulago/data/ent/oidcclient"
+	"formulago/data/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// OidcClientUpdate is the builder for updating OidcClient entities.
+type OidcClientUpdate struct {
+	config
+	hooks    []Hook
+	mutation *OidcClientMutation
+}
+
+// Where appends a list predicates to the OidcClientUpdate builder.
+func (_u *OidcClientUpdate) Where(ps ...predicate.OidcClient) *OidcClientUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *OidcClientUpdate) SetUpdatedAt(v time.Time) *OidcClientUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *OidcClientUpdate) SetStatus(v uint8) *OidcClientUpdate {
+	_u.mutation.ResetStatus()
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *OidcClientUpdate) SetNillableStatus(v *uint8) *OidcClientUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// AddStatus adds value to the "status" field.
+func (_u *OidcClientUpdate) AddStatus(v int8) *OidcClientUpdate {
+	_u.mutation.AddStatus(v)
+	return _u
+}
+
+// ClearStatus clears the value of the "status" field.
+func (_u *OidcClientUpdate) ClearStatus() *OidcClientUpdate {
+	_u.mutation.ClearStatus()
+	return _u
+}
+
+// SetClientID sets the "client_id" field.
+func (_u *OidcClientUpdate) SetClientID(v string) *OidcClientUpdate {
+	_u.mutation.SetClientID(v)
+	return _u
+}
+
+// SetNillableClientID sets the "client_id" field if the given value is not nil.
+func (_u *OidcClientUpdate) SetNillableClientID(v *string) *OidcClientUpdate {
+	if v != nil {
+		_u.SetClientID(*v)
+	}
+	return _u
+}
+
+// SetClientSecret sets the "client_secret" field.
+func (_u *OidcClientUpdate) SetClientSecret(v string) *OidcClientUpdate {
+	_u.mutation.SetClientSecret(v)
+	return _u
+}
+
+// SetNillableClientSecret sets the "client_secret" field if the given value is not nil.
+func (_u *OidcClientUpdate) SetNillableClientSecret(v *string) *OidcClientUpdate {
+	if v != nil {
+		_u.SetClientSecret(*v)
+	}
+	return _u
+}
+
+// ClearClientSecret clears the value of the "client_secret" field.
+func (_u *OidcClientUpdate) ClearClientSecret() *OidcClientUpdate {
+	_u.mutation.ClearClientSecret()
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *OidcClientUpdate) SetName(v string) *OidcClientUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *OidcClientUpdate) SetNillableName(v *string) *OidcClientUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetRedirectUris sets the "redirect_uris" field.
+func (_u *OidcClientUpdate) SetRedirectUris(v []string) *OidcClientUpdate {
+	_u.mutation.SetRedirectUris(v)
+	return _u
+}
+
+// AppendRedirectUris appends value to the "redirect_uris" field.
+func (_u *OidcClientUpdate) AppendRedirectUris(v []string) *OidcClientUpdate {
+	_u.mutation.AppendRedirectUris(v)
+	return _u
+}
+
+// ClearRedirectUris clears the value of the "redirect_uris" field.
+func (_u *OidcClientUpdate) ClearRedirectUris() *OidcClientUpdate {
+	_u.mutation.ClearRedirectUris()
+	return _u
+}
+
+// SetGrantTypes sets the "grant_types" field.
+func (_u *OidcClientUpdate) SetGrantTypes(v []string) *OidcClientUpdate {
+	_u.mutation.SetGrantTypes(v)
+	return _u
+}
+
+// AppendGrantTypes appends value to the "grant_types" field.
+func (_u *OidcClientUpdate) AppendGrantTypes(v []string) *OidcClientUpdate {
+	_u.mutation.AppendGrantTypes(v)
+	return _u
+}
+
+// SetScopes sets the "scopes" field.
+func (_u *OidcClientUpdate) SetScopes(v []string) *OidcClientUpdate {
+	_u.mutation.SetScopes(v)
+	return _u
+}
+
+// AppendScopes appends value to the "scopes" field.
+func (_u *OidcClientUpdate) AppendScopes(v []string) *OidcClientUpdate {
+	_u.mutation.AppendScopes(v)
+	return _u
+}
+
+// SetPublic sets the "public" field.
+func (_u *OidcClientUpdate) SetPublic(v bool) *OidcClientUpdate {
+	_u.mutation.SetPublic(v)
+	return _u
+}
+
+// SetNillablePublic sets the "public" field if the given value is not nil.
+func (_u *OidcClientUpdate) SetNillablePublic(v *bool) *OidcClientUpdate {
+	if v != nil {
+		_u.SetPublic(*v)
+	}
+	return _u
+}
+
+// SetSkipConsent sets the "skip_consent" field.
+func (_u *OidcClientUpdate) SetSkipConsent(v bool) *OidcClientUpdate {
+	_u.mutation.SetSkipConsent(v)
+	return _u
+}
+
+// SetNillableSkipConsent sets the "skip_consent" field if the given value is not nil.
+func (_u *OidcClientUpdate) SetNillableSkipConsent(v *bool) *OidcClientUpdate {
+	if v != nil {
+		_u.SetSkipConsent(*v)
+	}
+	return _u
+}
+
+// Mutation returns the OidcClientMutation object of the builder.
+func (_u *OidcClientUpdate) Mutation() *OidcClientMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *OidcClientUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *OidcClientUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *OidcClientUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *OidcClientUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *OidcClientUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := oidcclient.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *OidcClientUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(oidcclient.Table, oidcclient.Columns, sqlgraph.NewFieldSpec(oidcclient.FieldID, field.TypeUint64))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(oidcclient.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(oidcclient.FieldStatus, field.TypeUint8, value)
+	}
+	if value, ok := _u.mutation.AddedStatus(); ok {
+		_spec.AddField(oidcclient.FieldStatus, field.TypeUint8, value)
+	}
+	if _u.mutation.StatusCleared() {
+		_spec.ClearField(oidcclient.FieldStatus, field.TypeUint8)
+	}
+	if value, ok := _u.mutation.ClientID(); ok {
+		_spec.SetField(oidcclient.FieldClientID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ClientSecret(); ok {
+		_spec.SetField(oidcclient.FieldClientSecret, field.TypeString, value)
+	}
+	if _u.mutation.ClientSecretCleared() {
+		_spec.ClearField(oidcclient.FieldClientSecret, field.TypeString)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(oidcclient.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RedirectUris(); ok {
+		_spec.SetField(oidcclient.FieldRedirectUris, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedRedirectUris(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, oidcclient.FieldRedirectUris, value)
+		})
+	}
+	if _u.mutation.RedirectUrisCleared() {
+		_spec.ClearField(oidcclient.FieldRedirectUris, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.GrantTypes(); ok {
+		_spec.SetField(oidcclient.FieldGrantTypes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedGrantTypes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, oidcclient.FieldGrantTypes, value)
+		})
+	}
+	if value, ok := _u.mutation.Scopes(); ok {
+		_spec.SetField(oidcclient.FieldScopes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedScopes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, oidcclient.FieldScopes, value)
+		})
+	}
+	if value, ok := _u.mutation.Public(); ok {
+		_spec.SetField(oidcclient.FieldPublic, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.SkipConsent(); ok {
+		_spec.SetField(oidcclient.FieldSkipConsent, field.TypeBool, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{oidcclient.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// OidcClientUpdateOne is the builder for updating a single OidcClient entity.
+type OidcClientUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *OidcClientMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *OidcClientUpdateOne) SetUpdatedAt(v time.Time) *OidcClientUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *OidcClientUpdateOne) SetStatus(v uint8) *OidcClientUpdateOne {
+	_u.mutation.ResetStatus()
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *OidcClientUpdateOne) SetNillableStatus(v *uint8) *OidcClientUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// AddStatus adds value to the "status" field.
+func (_u *OidcClientUpdateOne) AddStatus(v int8) *OidcClientUpdateOne {
+	_u.mutation.AddStatus(v)
+	return _u
+}
+
+// ClearStatus clears the value of the "status" field.
+func (_u *OidcClientUpdateOne) ClearStatus() *OidcClientUpdateOne {
+	_u.mutation.ClearStatus()
+	return _u
+}
+
+// SetClientID sets the "client_id" field.
+func (_u *OidcClientUpdateOne) SetClientID(v string) *OidcClientUpdateOne {
+	_u.mutation.SetClientID(v)
+	return _u
+}
+
+// SetNillableClientID sets the "client_id" field if the given value is not nil.
+func (_u *OidcClientUpdateOne) SetNillableClientID(v *string) *OidcClientUpdateOne {
+	if v != nil {
+		_u.SetClientID(*v)
+	}
+	return _u
+}
+
+// SetClientSecret sets the "client_secret" field.
+func (_u *OidcClientUpdateOne) SetClientSecret(v string) *OidcClientUpdateOne {
+	_u.mutation.SetClientSecret(v)
+	return _u
+}
+
+// SetNillableClientSecret sets the "client_secret" field if the given value is not nil.
+func (_u *OidcClientUpdateOne) SetNillableClientSecret(v *string) *OidcClientUpdateOne {
+	if v != nil {
+		_u.SetClientSecret(*v)
+	}
+	return _u
+}
+
+// ClearClientSecret clears the value of the "client_secret" field.
+func (_u *OidcClientUpdateOne) ClearClientSecret() *OidcClientUpdateOne {
+	_u.mutation.ClearClientSecret()
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *OidcClientUpdateOne) SetName(v string) *OidcClientUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *OidcClientUpdateOne) SetNillableName(v *string) *OidcClientUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetRedirectUris sets the "redirect_uris" field.
+func (_u *OidcClientUpdateOne) SetRedirectUris(v []string) *OidcClientUpdateOne {
+	_u.mutation.SetRedirectUris(v)
+	return _u
+}
+
+// AppendRedirectUris appends value to the "redirect_uris" field.
+func (_u *OidcClientUpdateOne) AppendRedirectUris(v []string) *OidcClientUpdateOne {
+	_u.mutation.AppendRedirectUris(v)
+	return _u
+}
+
+// ClearRedirectUris clears the value of the "redirect_uris" field.
+func (_u *OidcClientUpdateOne) ClearRedirectUris() *OidcClientUpdateOne {
+	_u.mutation.ClearRedirectUris()
+	return _u
+}
+
+// SetGrantTypes sets the "grant_types" field.
+func (_u *OidcClientUpdateOne) SetGrantTypes(v []string) *OidcClientUpdateOne {
+	_u.mutation.SetGrantTypes(v)
+	return _u
+}
+
+// AppendGrantTypes appends value to the "grant_types" field.
+func (_u *OidcClientUpdateOne) AppendGrantTypes(v []string) *OidcClientUpdateOne {
+	_u.mutation.AppendGrantTypes(v)
+	return _u
+}
+
+// SetScopes sets the "scopes" field.
+func (_u *OidcClientUpdateOne) SetScopes(v []string) *OidcClientUpdateOne {
+	_u.mutation.SetScopes(v)
+	return _u
+}
+
+// AppendScopes appends value to the "scopes" field.
+func (_u *OidcClientUpdateOne) AppendScopes(v []string) *OidcClientUpdateOne {
+	_u.mutation.AppendScopes(v)
+	return _u
+}
+
+// SetPublic sets the "public" field.
+func (_u *OidcClientUpdateOne) SetPublic(v bool) *OidcClientUpdateOne {
+	_u.mutation.SetPublic(v)
+	return _u
+}
+
+// SetNillablePublic sets the "public" field if the given value is not nil.
+func (_u *OidcClientUpdateOne) SetNillablePublic(v *bool) *OidcClientUpdateOne {
+	if v != nil {
+		_u.SetPublic(*v)
+	}
+	return _u
+}
+
+// SetSkipConsent sets the "skip_consent" field.
+func (_u *OidcClientUpdateOne) SetSkipConsent(v bool) *OidcClientUpdateOne {
+	_u.mutation.SetSkipConsent(v)
+	return _u
+}
+
+// SetNillableSkipConsent sets the "skip_consent" field if the given value is not nil.
+func (_u *OidcClientUpdateOne) SetNillableSkipConsent(v *bool) *OidcClientUpdateOne {
+	if v != nil {
+		_u.SetSkipConsent(*v)
+	}
+	return _u
+}
+
+// Mutation returns the OidcClientMutation object of the builder.
+func (_u *OidcClientUpdateOne) Mutation() *OidcClientMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the OidcClientUpdate builder.
+func (_u *OidcClientUpdateOne) Where(ps ...predicate.OidcClient) *OidcClientUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *OidcClientUpdateOne) Select(field string, fields ...string) *OidcClientUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated OidcClient entity.
+func (_u *OidcClientUpdateOne) Save(ctx context.Context) (*OidcClient, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *OidcClientUpdateOne) SaveX(ctx context.Context) *OidcClient {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *OidcClientUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *OidcClientUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *OidcClientUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := oidcclient.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *OidcClientUpdateOne) sqlSave(ctx context.Context) (_node *OidcClient, err error) {
+	_spec := sqlgraph.NewUpdateSpec(oidcclient.Table, oidcclient.Columns, sqlgraph.NewFieldSpec(oidcclient.FieldID, field.TypeUint64))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "OidcClient.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, oidcclient.FieldID)
+		for _, f := range fields {
+			if !oidcclient.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != oidcclient.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(oidcclient.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(oidcclient.FieldStatus, field.TypeUint8, value)
+	}
+	if value, ok := _u.mutation.AddedStatus(); ok {
+		_spec.AddField(oidcclient.FieldStatus, field.TypeUint8, value)
+	}
+	if _u.mutation.StatusCleared() {
+		_spec.ClearField(oidcclient.FieldStatus, field.TypeUint8)
+	}
+	if value, ok := _u.mutation.ClientID(); ok {
+		_spec.SetField(oidcclient.FieldClientID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ClientSecret(); ok {
+		_spec.SetField(oidcclient.FieldClientSecret, field.TypeString, value)
+	}
+	if _u.mutation.ClientSecretCleared() {
+		_spec.ClearField(oidcclient.FieldClientSecret, field.TypeString)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(oidcclient.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RedirectUris(); ok {
+		_spec.SetField(oidcclient.FieldRedirectUris, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedRedirectUris(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, oidcclient.FieldRedirectUris, value)
+		})
+	}
+	if _u.mutation.RedirectUrisCleared() {
+		_spec.ClearField(oidcclient.FieldRedirectUris, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.GrantTypes(); ok {
+		_spec.SetField(oidcclient.FieldGrantTypes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedGrantTypes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, oidcclient.FieldGrantTypes, value)
+		})
+	}
+	if value, ok := _u.mutation.Scopes(); ok {
+		_spec.SetField(oidcclient.FieldScopes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedScopes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, oidcclient.FieldScopes, value)
+		})
+	}
+	if value, ok := _u.mutation.Public(); ok {
+		_spec.SetField(oidcclient.FieldPublic, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.SkipConsent(); ok {
+		_spec.SetField(oidcclient.FieldSkipConsent, field.TypeBool, value)
+	}
+	_node = &OidcClient{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{oidcclient.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
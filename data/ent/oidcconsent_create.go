@@ -0,0 +1,278 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"formulago/data/ent/oidcconsent"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// OidcConsentCreate is the builder for creating a OidcConsent entity.
+type OidcConsentCreate struct {
+	config
+	mutation *OidcConsentMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *OidcConsentCreate) SetCreatedAt(v time.Time) *OidcConsentCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *OidcConsentCreate) SetNillableCreatedAt(v *time.Time) *OidcConsentCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *OidcConsentCreate) SetUpdatedAt(v time.Time) *OidcConsentCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *OidcConsentCreate) SetNillableUpdatedAt(v *time.Time) *OidcConsentCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *OidcConsentCreate) SetUserID(v uint64) *OidcConsentCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetClientID sets the "client_id" field.
+func (_c *OidcConsentCreate) SetClientID(v string) *OidcConsentCreate {
+	_c.mutation.SetClientID(v)
+	return _c
+}
+
+// SetScopes sets the "scopes" field.
+func (_c *OidcConsentCreate) SetScopes(v []string) *OidcConsentCreate {
+	_c.mutation.SetScopes(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *OidcConsentCreate) SetID(v uint64) *OidcConsentCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the OidcConsentMutation object of the builder.
+func (_c *OidcConsentCreate) Mutation() *OidcConsentMutation {
+	return _c.mutation
+}
+
+// Save creates the OidcConsent in the database.
+func (_c *OidcConsentCreate) Save(ctx context.Context) (*OidcConsent, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *OidcConsentCreate) SaveX(ctx context.Context) *OidcConsent {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *OidcConsentCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *OidcConsentCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *OidcConsentCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := oidcconsent.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := oidcconsent.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *OidcConsentCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "OidcConsent.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "OidcConsent.updated_at"`)}
+	}
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "OidcConsent.user_id"`)}
+	}
+	if _, ok := _c.mutation.ClientID(); !ok {
+		return &ValidationError{Name: "client_id", err: errors.New(`ent: missing required field "OidcConsent.client_id"`)}
+	}
+	if _, ok := _c.mutation.Scopes(); !ok {
+		return &ValidationError{Name: "scopes", err: errors.New(`ent: missing required field "OidcConsent.scopes"`)}
+	}
+	return nil
+}
+
+func (_c *OidcConsentCreate) sqlSave(ctx context.Context) (*OidcConsent, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint64(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *OidcConsentCreate) createSpec() (*OidcConsent, *sqlgraph.CreateSpec) {
+	var (
+		_node = &OidcConsent{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(oidcconsent.Table, sqlgraph.NewFieldSpec(oidcconsent.FieldID, field.TypeUint64))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(oidcconsent.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(oidcconsent.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(oidcconsent.FieldUserID, field.TypeUint64, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.ClientID(); ok {
+		_spec.SetField(oidcconsent.FieldClientID, field.TypeString, value)
+		_node.ClientID = value
+	}
+	if value, ok := _c.mutation.Scopes(); ok {
+		_spec.SetField(oidcconsent.FieldScopes, field.TypeJSON, value)
+		_node.Scopes = value
+	}
+	return _node, _spec
+}
+
+// OidcConsentCreateBulk is the builder for creating many OidcConsent entities in bulk.
+type OidcConsentCreateBulk struct {
+	config
+	err      error
+	builders []*OidcConsentCreate
+}
+
+// Save creates the OidcConsent entities in the database.
+func (_c *OidcConsentCreateBulk) Save(ctx context.Context) ([]*OidcConsent, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*OidcConsent, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*OidcConsentMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *OidcConsentCreateBulk) SaveX(ctx context.Context) []*OidcConsent {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *OidcConsentCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *OidcConsentCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,341 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"formulago/data/ent/oidcconsent"
+	"formulago/data/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// OidcConsentUpdate is the builder for updating OidcConsent entities.
+type OidcConsentUpdate struct {
+	config
+	hooks    []Hook
+	mutation *OidcConsentMutation
+}
+
+// Where appends a list predicates to the OidcConsentUpdate builder.
+func (_u *OidcConsentUpdate) Where(ps ...predicate.OidcConsent) *OidcConsentUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *OidcConsentUpdate) SetUpdatedAt(v time.Time) *OidcConsentUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *OidcConsentUpdate) SetUserID(v uint64) *OidcConsentUpdate {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *OidcConsentUpdate) SetNillableUserID(v *uint64) *OidcConsentUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *OidcConsentUpdate) AddUserID(v int64) *OidcConsentUpdate {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// SetClientID sets the "client_id" field.
+func (_u *OidcConsentUpdate) SetClientID(v string) *OidcConsentUpdate {
+	_u.mutation.SetClientID(v)
+	return _u
+}
+
+// SetNillableClientID sets the "client_id" field if the given value is not nil.
+func (_u *OidcConsentUpdate) SetNillableClientID(v *string) *OidcConsentUpdate {
+	if v != nil {
+		_u.SetClientID(*v)
+	}
+	return _u
+}
+
+// SetScopes sets the "scopes" field.
+func (_u *OidcConsentUpdate) SetScopes(v []string) *OidcConsentUpdate {
+	_u.mutation.SetScopes(v)
+	return _u
+}
+
+// AppendScopes appends value to the "scopes" field.
+func (_u *OidcConsentUpdate) AppendScopes(v []string) *OidcConsentUpdate {
+	_u.mutation.AppendScopes(v)
+	return _u
+}
+
+// Mutation returns the OidcConsentMutation object of the builder.
+func (_u *OidcConsentUpdate) Mutation() *OidcConsentMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *OidcConsentUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *OidcConsentUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *OidcConsentUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *OidcConsentUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *OidcConsentUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := oidcconsent.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *OidcConsentUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(oidcconsent.Table, oidcconsent.Columns, sqlgraph.NewFieldSpec(oidcconsent.FieldID, field.TypeUint64))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(oidcconsent.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(oidcconsent.FieldUserID, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(oidcconsent.FieldUserID, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.ClientID(); ok {
+		_spec.SetField(oidcconsent.FieldClientID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Scopes(); ok {
+		_spec.SetField(oidcconsent.FieldScopes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedScopes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, oidcconsent.FieldScopes, value)
+		})
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{oidcconsent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// OidcConsentUpdateOne is the builder for updating a single OidcConsent entity.
+type OidcConsentUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *OidcConsentMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *OidcConsentUpdateOne) SetUpdatedAt(v time.Time) *OidcConsentUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *OidcConsentUpdateOne) SetUserID(v uint64) *OidcConsentUpdateOne {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *OidcConsentUpdateOne) SetNillableUserID(v *uint64) *OidcConsentUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *OidcConsentUpdateOne) AddUserID(v int64) *OidcConsentUpdateOne {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// SetClientID sets the "client_id" field.
+func (_u *OidcConsentUpdateOne) SetClientID(v string) *OidcConsentUpdateOne {
+	_u.mutation.SetClientID(v)
+	return _u
+}
+
+// SetNillableClientID sets the "client_id" field if the given value is not nil.
+func (_u *OidcConsentUpdateOne) SetNillableClientID(v *string) *OidcConsentUpdateOne {
+	if v != nil {
+		_u.SetClientID(*v)
+	}
+	return _u
+}
+
+// SetScopes sets the "scopes" field.
+func (_u *OidcConsentUpdateOne) SetScopes(v []string) *OidcConsentUpdateOne {
+	_u.mutation.SetScopes(v)
+	return _u
+}
+
+// AppendScopes appends value to the "scopes" field.
+func (_u *OidcConsentUpdateOne) AppendScopes(v []string) *OidcConsentUpdateOne {
+	_u.mutation.AppendScopes(v)
+	return _u
+}
+
+// Mutation returns the OidcConsentMutation object of the builder.
+func (_u *OidcConsentUpdateOne) Mutation() *OidcConsentMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the OidcConsentUpdate builder.
+func (_u *OidcConsentUpdateOne) Where(ps ...predicate.OidcConsent) *OidcConsentUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *OidcConsentUpdateOne) Select(field string, fields ...string) *OidcConsentUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated OidcConsent entity.
+func (_u *OidcConsentUpdateOne) Save(ctx context.Context) (*OidcConsent, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *OidcConsentUpdateOne) SaveX(ctx context.Context) *OidcConsent {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *OidcConsentUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *OidcConsentUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *OidcConsentUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := oidcconsent.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *OidcConsentUpdateOne) sqlSave(ctx context.Context) (_node *OidcConsent, err error) {
+	_spec := sqlgraph.NewUpdateSpec(oidcconsent.Table, oidcconsent.Columns, sqlgraph.NewFieldSpec(oidcconsent.FieldID, field.TypeUint64))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "OidcConsent.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, oidcconsent.FieldID)
+		for _, f := range fields {
+			if !oidcconsent.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != oidcconsent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(oidcconsent.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(oidcconsent.FieldUserID, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(oidcconsent.FieldUserID, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.ClientID(); ok {
+		_spec.SetField(oidcconsent.FieldClientID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Scopes(); ok {
+		_spec.SetField(oidcconsent.FieldScopes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedScopes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, oidcconsent.FieldScopes, value)
+		})
+	}
+	_node = &OidcConsent{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{oidcconsent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
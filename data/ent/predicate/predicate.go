@@ -9,12 +9,18 @@ import (
 // API is the predicate function for api builders.
 type API func(*sql.Selector)
 
+// APIKey is the predicate function for apikey builders.
+type APIKey func(*sql.Selector)
+
 // Dictionary is the predicate function for dictionary builders.
 type Dictionary func(*sql.Selector)
 
 // DictionaryDetail is the predicate function for dictionarydetail builders.
 type DictionaryDetail func(*sql.Selector)
 
+// LoginEvent is the predicate function for loginevent builders.
+type LoginEvent func(*sql.Selector)
+
 // Logs is the predicate function for logs builders.
 type Logs func(*sql.Selector)
 
@@ -24,11 +30,32 @@ type Menu func(*sql.Selector)
 // OauthProvider is the predicate function for oauthprovider builders.
 type OauthProvider func(*sql.Selector)
 
+// OidcClient is the predicate function for oidcclient builders.
+type OidcClient func(*sql.Selector)
+
+// OidcConsent is the predicate function for oidcconsent builders.
+type OidcConsent func(*sql.Selector)
+
+// RefreshToken is the predicate function for refreshtoken builders.
+type RefreshToken func(*sql.Selector)
+
 // Role is the predicate function for role builders.
 type Role func(*sql.Selector)
 
+// SamlProvider is the predicate function for samlprovider builders.
+type SamlProvider func(*sql.Selector)
+
+// Tenant is the predicate function for tenant builders.
+type Tenant func(*sql.Selector)
+
 // Token is the predicate function for token builders.
 type Token func(*sql.Selector)
 
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
+
+// UserIdentity is the predicate function for useridentity builders.
+type UserIdentity func(*sql.Selector)
+
+// WebauthnCredential is the predicate function for webauthncredential builders.
+type WebauthnCredential func(*sql.Selector)
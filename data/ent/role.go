@@ -24,9 +24,11 @@ type Role struct {
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
 	// status 1 normal 0 ban | 状态 1 正常 0 禁用
 	Status uint8 `json:"status,omitempty"`
+	// tenant ID | 租户ID
+	TenantID uint64 `json:"tenant_id,omitempty"`
 	// role name | 角色名
 	Name string `json:"name,omitempty"`
-	// role value for permission control in front end | 角色值，用于前端权限控制
+	// role value for permission control in front end, unique in the tenant | 角色值，用于前端权限控制, 租户内唯一
 	Value string `json:"value,omitempty"`
 	// default menu : dashboard | 默认登录页面
 	DefaultRouter string `json:"default_router,omitempty"`
@@ -34,6 +36,10 @@ type Role struct {
 	Remark string `json:"remark,omitempty"`
 	// order number | 排序编号
 	OrderNo uint32 `json:"order_no,omitempty"`
+	// force two-factor authentication for the role | 强制该角色启用双因素认证
+	ForceTwoFactor bool `json:"force_two_factor,omitempty"`
+	// parent role ID, the role inherits the api and menu permissions of the parent | 父角色ID, 继承父角色的接口与菜单权限
+	ParentID uint64 `json:"parent_id,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the RoleQuery when eager-loading is set.
 	Edges        RoleEdges `json:"edges"`
@@ -44,9 +50,15 @@ type Role struct {
 type RoleEdges struct {
 	// Menus holds the value of the menus edge.
 	Menus []*Menu `json:"menus,omitempty"`
+	// Users holds the value of the users edge.
+	Users []*User `json:"users,omitempty"`
+	// Parent holds the value of the parent edge.
+	Parent *Role `json:"parent,omitempty"`
+	// Children holds the value of the children edge.
+	Children []*Role `json:"children,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [4]bool
 }
 
 // MenusOrErr returns the Menus value or an error if the edge
@@ -58,12 +70,43 @@ func (e RoleEdges) MenusOrErr() ([]*Menu, error) {
 	return nil, &NotLoadedError{edge: "menus"}
 }
 
+// UsersOrErr returns the Users value or an error if the edge
+// was not loaded in eager-loading.
+func (e RoleEdges) UsersOrErr() ([]*User, error) {
+	if e.loadedTypes[1] {
+		return e.Users, nil
+	}
+	return nil, &NotLoadedError{edge: "users"}
+}
+
+// ParentOrErr returns the Parent value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RoleEdges) ParentOrErr() (*Role, error) {
+	if e.Parent != nil {
+		return e.Parent, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: role.Label}
+	}
+	return nil, &NotLoadedError{edge: "parent"}
+}
+
+// ChildrenOrErr returns the Children value or an error if the edge
+// was not loaded in eager-loading.
+func (e RoleEdges) ChildrenOrErr() ([]*Role, error) {
+	if e.loadedTypes[3] {
+		return e.Children, nil
+	}
+	return nil, &NotLoadedError{edge: "children"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Role) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case role.FieldID, role.FieldStatus, role.FieldOrderNo:
+		case role.FieldForceTwoFactor:
+			values[i] = new(sql.NullBool)
+		case role.FieldID, role.FieldStatus, role.FieldTenantID, role.FieldOrderNo, role.FieldParentID:
 			values[i] = new(sql.NullInt64)
 		case role.FieldName, role.FieldValue, role.FieldDefaultRouter, role.FieldRemark:
 			values[i] = new(sql.NullString)
@@ -108,6 +151,12 @@ func (_m *Role) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Status = uint8(value.Int64)
 			}
+		case role.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				_m.TenantID = uint64(value.Int64)
+			}
 		case role.FieldName:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field name", values[i])
@@ -138,6 +187,18 @@ func (_m *Role) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.OrderNo = uint32(value.Int64)
 			}
+		case role.FieldForceTwoFactor:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field force_two_factor", values[i])
+			} else if value.Valid {
+				_m.ForceTwoFactor = value.Bool
+			}
+		case role.FieldParentID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field parent_id", values[i])
+			} else if value.Valid {
+				_m.ParentID = uint64(value.Int64)
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -156,6 +217,21 @@ func (_m *Role) QueryMenus() *MenuQuery {
 	return NewRoleClient(_m.config).QueryMenus(_m)
 }
 
+// QueryUsers queries the "users" edge of the Role entity.
+func (_m *Role) QueryUsers() *UserQuery {
+	return NewRoleClient(_m.config).QueryUsers(_m)
+}
+
+// QueryParent queries the "parent" edge of the Role entity.
+func (_m *Role) QueryParent() *RoleQuery {
+	return NewRoleClient(_m.config).QueryParent(_m)
+}
+
+// QueryChildren queries the "children" edge of the Role entity.
+func (_m *Role) QueryChildren() *RoleQuery {
+	return NewRoleClient(_m.config).QueryChildren(_m)
+}
+
 // Update returns a builder for updating this Role.
 // Note that you need to call Role.Unwrap() before calling this method if this Role
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -188,6 +264,9 @@ func (_m *Role) String() string {
 	builder.WriteString("status=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Status))
 	builder.WriteString(", ")
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TenantID))
+	builder.WriteString(", ")
 	builder.WriteString("name=")
 	builder.WriteString(_m.Name)
 	builder.WriteString(", ")
@@ -202,6 +281,12 @@ func (_m *Role) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("order_no=")
 	builder.WriteString(fmt.Sprintf("%v", _m.OrderNo))
+	builder.WriteString(", ")
+	builder.WriteString("force_two_factor=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ForceTwoFactor))
+	builder.WriteString(", ")
+	builder.WriteString("parent_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ParentID))
 	builder.WriteByte(')')
 	return builder.String()
 }
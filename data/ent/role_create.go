@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"formulago/data/ent/menu"
 	"formulago/data/ent/role"
+	"formulago/data/ent/user"
 	"time"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -63,6 +64,20 @@ func (_c *RoleCreate) SetNillableStatus(v *uint8) *RoleCreate {
 	return _c
 }
 
+// SetTenantID sets the "tenant_id" field.
+func (_c *RoleCreate) SetTenantID(v uint64) *RoleCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_c *RoleCreate) SetNillableTenantID(v *uint64) *RoleCreate {
+	if v != nil {
+		_c.SetTenantID(*v)
+	}
+	return _c
+}
+
 // SetName sets the "name" field.
 func (_c *RoleCreate) SetName(v string) *RoleCreate {
 	_c.mutation.SetName(v)
@@ -117,6 +132,34 @@ func (_c *RoleCreate) SetNillableOrderNo(v *uint32) *RoleCreate {
 	return _c
 }
 
+// SetForceTwoFactor sets the "force_two_factor" field.
+func (_c *RoleCreate) SetForceTwoFactor(v bool) *RoleCreate {
+	_c.mutation.SetForceTwoFactor(v)
+	return _c
+}
+
+// SetNillableForceTwoFactor sets the "force_two_factor" field if the given value is not nil.
+func (_c *RoleCreate) SetNillableForceTwoFactor(v *bool) *RoleCreate {
+	if v != nil {
+		_c.SetForceTwoFactor(*v)
+	}
+	return _c
+}
+
+// SetParentID sets the "parent_id" field.
+func (_c *RoleCreate) SetParentID(v uint64) *RoleCreate {
+	_c.mutation.SetParentID(v)
+	return _c
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (_c *RoleCreate) SetNillableParentID(v *uint64) *RoleCreate {
+	if v != nil {
+		_c.SetParentID(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *RoleCreate) SetID(v uint64) *RoleCreate {
 	_c.mutation.SetID(v)
@@ -138,6 +181,41 @@ func (_c *RoleCreate) AddMenus(v ...*Menu) *RoleCreate {
 	return _c.AddMenuIDs(ids...)
 }
 
+// AddUserIDs adds the "users" edge to the User entity by IDs.
+func (_c *RoleCreate) AddUserIDs(ids ...uint64) *RoleCreate {
+	_c.mutation.AddUserIDs(ids...)
+	return _c
+}
+
+// AddUsers adds the "users" edges to the User entity.
+func (_c *RoleCreate) AddUsers(v ...*User) *RoleCreate {
+	ids := make([]uint64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddUserIDs(ids...)
+}
+
+// SetParent sets the "parent" edge to the Role entity.
+func (_c *RoleCreate) SetParent(v *Role) *RoleCreate {
+	return _c.SetParentID(v.ID)
+}
+
+// AddChildIDs adds the "children" edge to the Role entity by IDs.
+func (_c *RoleCreate) AddChildIDs(ids ...uint64) *RoleCreate {
+	_c.mutation.AddChildIDs(ids...)
+	return _c
+}
+
+// AddChildren adds the "children" edges to the Role entity.
+func (_c *RoleCreate) AddChildren(v ...*Role) *RoleCreate {
+	ids := make([]uint64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddChildIDs(ids...)
+}
+
 // Mutation returns the RoleMutation object of the builder.
 func (_c *RoleCreate) Mutation() *RoleMutation {
 	return _c.mutation
@@ -145,7 +223,9 @@ func (_c *RoleCreate) Mutation() *RoleMutation {
 
 // Save creates the Role in the database.
 func (_c *RoleCreate) Save(ctx context.Context) (*Role, error) {
-	_c.defaults()
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
@@ -172,12 +252,18 @@ func (_c *RoleCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_c *RoleCreate) defaults() {
+func (_c *RoleCreate) defaults() error {
 	if _, ok := _c.mutation.CreatedAt(); !ok {
+		if role.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized role.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
 		v := role.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
 	}
 	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		if role.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized role.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := role.DefaultUpdatedAt()
 		_c.mutation.SetUpdatedAt(v)
 	}
@@ -185,6 +271,10 @@ func (_c *RoleCreate) defaults() {
 		v := role.DefaultStatus
 		_c.mutation.SetStatus(v)
 	}
+	if _, ok := _c.mutation.TenantID(); !ok {
+		v := role.DefaultTenantID
+		_c.mutation.SetTenantID(v)
+	}
 	if _, ok := _c.mutation.DefaultRouter(); !ok {
 		v := role.DefaultDefaultRouter
 		_c.mutation.SetDefaultRouter(v)
@@ -197,6 +287,11 @@ func (_c *RoleCreate) defaults() {
 		v := role.DefaultOrderNo
 		_c.mutation.SetOrderNo(v)
 	}
+	if _, ok := _c.mutation.ForceTwoFactor(); !ok {
+		v := role.DefaultForceTwoFactor
+		_c.mutation.SetForceTwoFactor(v)
+	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -207,6 +302,9 @@ func (_c *RoleCreate) check() error {
 	if _, ok := _c.mutation.UpdatedAt(); !ok {
 		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Role.updated_at"`)}
 	}
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "Role.tenant_id"`)}
+	}
 	if _, ok := _c.mutation.Name(); !ok {
 		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Role.name"`)}
 	}
@@ -222,6 +320,9 @@ func (_c *RoleCreate) check() error {
 	if _, ok := _c.mutation.OrderNo(); !ok {
 		return &ValidationError{Name: "order_no", err: errors.New(`ent: missing required field "Role.order_no"`)}
 	}
+	if _, ok := _c.mutation.ForceTwoFactor(); !ok {
+		return &ValidationError{Name: "force_two_factor", err: errors.New(`ent: missing required field "Role.force_two_factor"`)}
+	}
 	return nil
 }
 
@@ -266,6 +367,10 @@ func (_c *RoleCreate) createSpec() (*Role, *sqlgraph.CreateSpec) {
 		_spec.SetField(role.FieldStatus, field.TypeUint8, value)
 		_node.Status = value
 	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(role.FieldTenantID, field.TypeUint64, value)
+		_node.TenantID = value
+	}
 	if value, ok := _c.mutation.Name(); ok {
 		_spec.SetField(role.FieldName, field.TypeString, value)
 		_node.Name = value
@@ -286,6 +391,10 @@ func (_c *RoleCreate) createSpec() (*Role, *sqlgraph.CreateSpec) {
 		_spec.SetField(role.FieldOrderNo, field.TypeUint32, value)
 		_node.OrderNo = value
 	}
+	if value, ok := _c.mutation.ForceTwoFactor(); ok {
+		_spec.SetField(role.FieldForceTwoFactor, field.TypeBool, value)
+		_node.ForceTwoFactor = value
+	}
 	if nodes := _c.mutation.MenusIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -302,6 +411,55 @@ func (_c *RoleCreate) createSpec() (*Role, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.UsersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   role.UsersTable,
+			Columns: role.UsersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   role.ParentTable,
+			Columns: []string{role.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ParentID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ChildrenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   role.ChildrenTable,
+			Columns: []string{role.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
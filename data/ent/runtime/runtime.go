@@ -2,7 +2,666 @@
 
 package runtime
 
-// The schema-stitching logic is generated in formulago/data/ent/runtime.go
+import (
+	"formulago/data/ent/api"
+	"formulago/data/ent/apikey"
+	"formulago/data/ent/dictionary"
+	"formulago/data/ent/dictionarydetail"
+	"formulago/data/ent/loginevent"
+	"formulago/data/ent/logs"
+	"formulago/data/ent/menu"
+	"formulago/data/ent/oauthprovider"
+	"formulago/data/ent/oidcclient"
+	"formulago/data/ent/oidcconsent"
+	"formulago/data/ent/refreshtoken"
+	"formulago/data/ent/role"
+	"formulago/data/ent/samlprovider"
+	"formulago/data/ent/schema"
+	"formulago/data/ent/tenant"
+	"formulago/data/ent/token"
+	"formulago/data/ent/user"
+	"formulago/data/ent/useridentity"
+	"formulago/data/ent/webauthncredential"
+	"time"
+)
+
+// The init function reads all schema descriptors with runtime code
+// (default values, validators, hooks and policies) and stitches it
+// to their package variables.
+func init() {
+	apiMixin := schema.API{}.Mixin()
+	apiMixinFields0 := apiMixin[0].Fields()
+	_ = apiMixinFields0
+	apiFields := schema.API{}.Fields()
+	_ = apiFields
+	// apiDescCreatedAt is the schema descriptor for created_at field.
+	apiDescCreatedAt := apiMixinFields0[1].Descriptor()
+	// api.DefaultCreatedAt holds the default value on creation for the created_at field.
+	api.DefaultCreatedAt = apiDescCreatedAt.Default.(func() time.Time)
+	// apiDescUpdatedAt is the schema descriptor for updated_at field.
+	apiDescUpdatedAt := apiMixinFields0[2].Descriptor()
+	// api.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	api.DefaultUpdatedAt = apiDescUpdatedAt.Default.(func() time.Time)
+	// api.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	api.UpdateDefaultUpdatedAt = apiDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// apiDescMethod is the schema descriptor for method field.
+	apiDescMethod := apiFields[3].Descriptor()
+	// api.DefaultMethod holds the default value on creation for the method field.
+	api.DefaultMethod = apiDescMethod.Default.(string)
+	apikeyMixin := schema.APIKey{}.Mixin()
+	apikeyMixinFields0 := apikeyMixin[0].Fields()
+	_ = apikeyMixinFields0
+	apikeyFields := schema.APIKey{}.Fields()
+	_ = apikeyFields
+	// apikeyDescCreatedAt is the schema descriptor for created_at field.
+	apikeyDescCreatedAt := apikeyMixinFields0[1].Descriptor()
+	// apikey.DefaultCreatedAt holds the default value on creation for the created_at field.
+	apikey.DefaultCreatedAt = apikeyDescCreatedAt.Default.(func() time.Time)
+	// apikeyDescUpdatedAt is the schema descriptor for updated_at field.
+	apikeyDescUpdatedAt := apikeyMixinFields0[2].Descriptor()
+	// apikey.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	apikey.DefaultUpdatedAt = apikeyDescUpdatedAt.Default.(func() time.Time)
+	// apikey.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	apikey.UpdateDefaultUpdatedAt = apikeyDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// apikeyDescLastUsedIP is the schema descriptor for last_used_ip field.
+	apikeyDescLastUsedIP := apikeyFields[7].Descriptor()
+	// apikey.DefaultLastUsedIP holds the default value on creation for the last_used_ip field.
+	apikey.DefaultLastUsedIP = apikeyDescLastUsedIP.Default.(string)
+	dictionaryMixin := schema.Dictionary{}.Mixin()
+	dictionaryMixinHooks2 := dictionaryMixin[2].Hooks()
+	dictionary.Hooks[0] = dictionaryMixinHooks2[0]
+	dictionaryMixinInters2 := dictionaryMixin[2].Interceptors()
+	dictionary.Interceptors[0] = dictionaryMixinInters2[0]
+	dictionaryMixinFields0 := dictionaryMixin[0].Fields()
+	_ = dictionaryMixinFields0
+	dictionaryMixinFields1 := dictionaryMixin[1].Fields()
+	_ = dictionaryMixinFields1
+	dictionaryMixinFields2 := dictionaryMixin[2].Fields()
+	_ = dictionaryMixinFields2
+	dictionaryFields := schema.Dictionary{}.Fields()
+	_ = dictionaryFields
+	// dictionaryDescCreatedAt is the schema descriptor for created_at field.
+	dictionaryDescCreatedAt := dictionaryMixinFields0[1].Descriptor()
+	// dictionary.DefaultCreatedAt holds the default value on creation for the created_at field.
+	dictionary.DefaultCreatedAt = dictionaryDescCreatedAt.Default.(func() time.Time)
+	// dictionaryDescUpdatedAt is the schema descriptor for updated_at field.
+	dictionaryDescUpdatedAt := dictionaryMixinFields0[2].Descriptor()
+	// dictionary.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	dictionary.DefaultUpdatedAt = dictionaryDescUpdatedAt.Default.(func() time.Time)
+	// dictionary.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	dictionary.UpdateDefaultUpdatedAt = dictionaryDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// dictionaryDescStatus is the schema descriptor for status field.
+	dictionaryDescStatus := dictionaryMixinFields1[0].Descriptor()
+	// dictionary.DefaultStatus holds the default value on creation for the status field.
+	dictionary.DefaultStatus = dictionaryDescStatus.Default.(uint8)
+	// dictionaryDescTenantID is the schema descriptor for tenant_id field.
+	dictionaryDescTenantID := dictionaryMixinFields2[0].Descriptor()
+	// dictionary.DefaultTenantID holds the default value on creation for the tenant_id field.
+	dictionary.DefaultTenantID = dictionaryDescTenantID.Default.(uint64)
+	dictionarydetailMixin := schema.DictionaryDetail{}.Mixin()
+	dictionarydetailMixinHooks2 := dictionarydetailMixin[2].Hooks()
+	dictionarydetail.Hooks[0] = dictionarydetailMixinHooks2[0]
+	dictionarydetailMixinInters2 := dictionarydetailMixin[2].Interceptors()
+	dictionarydetail.Interceptors[0] = dictionarydetailMixinInters2[0]
+	dictionarydetailMixinFields0 := dictionarydetailMixin[0].Fields()
+	_ = dictionarydetailMixinFields0
+	dictionarydetailMixinFields1 := dictionarydetailMixin[1].Fields()
+	_ = dictionarydetailMixinFields1
+	dictionarydetailMixinFields2 := dictionarydetailMixin[2].Fields()
+	_ = dictionarydetailMixinFields2
+	dictionarydetailFields := schema.DictionaryDetail{}.Fields()
+	_ = dictionarydetailFields
+	// dictionarydetailDescCreatedAt is the schema descriptor for created_at field.
+	dictionarydetailDescCreatedAt := dictionarydetailMixinFields0[1].Descriptor()
+	// dictionarydetail.DefaultCreatedAt holds the default value on creation for the created_at field.
+	dictionarydetail.DefaultCreatedAt = dictionarydetailDescCreatedAt.Default.(func() time.Time)
+	// dictionarydetailDescUpdatedAt is the schema descriptor for updated_at field.
+	dictionarydetailDescUpdatedAt := dictionarydetailMixinFields0[2].Descriptor()
+	// dictionarydetail.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	dictionarydetail.DefaultUpdatedAt = dictionarydetailDescUpdatedAt.Default.(func() time.Time)
+	// dictionarydetail.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	dictionarydetail.UpdateDefaultUpdatedAt = dictionarydetailDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// dictionarydetailDescStatus is the schema descriptor for status field.
+	dictionarydetailDescStatus := dictionarydetailMixinFields1[0].Descriptor()
+	// dictionarydetail.DefaultStatus holds the default value on creation for the status field.
+	dictionarydetail.DefaultStatus = dictionarydetailDescStatus.Default.(uint8)
+	// dictionarydetailDescTenantID is the schema descriptor for tenant_id field.
+	dictionarydetailDescTenantID := dictionarydetailMixinFields2[0].Descriptor()
+	// dictionarydetail.DefaultTenantID holds the default value on creation for the tenant_id field.
+	dictionarydetail.DefaultTenantID = dictionarydetailDescTenantID.Default.(uint64)
+	logineventMixin := schema.LoginEvent{}.Mixin()
+	logineventMixinHooks1 := logineventMixin[1].Hooks()
+	loginevent.Hooks[0] = logineventMixinHooks1[0]
+	logineventMixinInters1 := logineventMixin[1].Interceptors()
+	loginevent.Interceptors[0] = logineventMixinInters1[0]
+	logineventMixinFields0 := logineventMixin[0].Fields()
+	_ = logineventMixinFields0
+	logineventMixinFields1 := logineventMixin[1].Fields()
+	_ = logineventMixinFields1
+	logineventFields := schema.LoginEvent{}.Fields()
+	_ = logineventFields
+	// logineventDescCreatedAt is the schema descriptor for created_at field.
+	logineventDescCreatedAt := logineventMixinFields0[1].Descriptor()
+	// loginevent.DefaultCreatedAt holds the default value on creation for the created_at field.
+	loginevent.DefaultCreatedAt = logineventDescCreatedAt.Default.(func() time.Time)
+	// logineventDescUpdatedAt is the schema descriptor for updated_at field.
+	logineventDescUpdatedAt := logineventMixinFields0[2].Descriptor()
+	// loginevent.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	loginevent.DefaultUpdatedAt = logineventDescUpdatedAt.Default.(func() time.Time)
+	// loginevent.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	loginevent.UpdateDefaultUpdatedAt = logineventDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// logineventDescTenantID is the schema descriptor for tenant_id field.
+	logineventDescTenantID := logineventMixinFields1[0].Descriptor()
+	// loginevent.DefaultTenantID holds the default value on creation for the tenant_id field.
+	loginevent.DefaultTenantID = logineventDescTenantID.Default.(uint64)
+	// logineventDescUserID is the schema descriptor for user_id field.
+	logineventDescUserID := logineventFields[0].Descriptor()
+	// loginevent.DefaultUserID holds the default value on creation for the user_id field.
+	loginevent.DefaultUserID = logineventDescUserID.Default.(uint64)
+	// logineventDescUsername is the schema descriptor for username field.
+	logineventDescUsername := logineventFields[1].Descriptor()
+	// loginevent.DefaultUsername holds the default value on creation for the username field.
+	loginevent.DefaultUsername = logineventDescUsername.Default.(string)
+	// logineventDescIP is the schema descriptor for ip field.
+	logineventDescIP := logineventFields[3].Descriptor()
+	// loginevent.DefaultIP holds the default value on creation for the ip field.
+	loginevent.DefaultIP = logineventDescIP.Default.(string)
+	// logineventDescUserAgent is the schema descriptor for user_agent field.
+	logineventDescUserAgent := logineventFields[4].Descriptor()
+	// loginevent.DefaultUserAgent holds the default value on creation for the user_agent field.
+	loginevent.DefaultUserAgent = logineventDescUserAgent.Default.(string)
+	// logineventDescDevice is the schema descriptor for device field.
+	logineventDescDevice := logineventFields[5].Descriptor()
+	// loginevent.DefaultDevice holds the default value on creation for the device field.
+	loginevent.DefaultDevice = logineventDescDevice.Default.(string)
+	// logineventDescReason is the schema descriptor for reason field.
+	logineventDescReason := logineventFields[7].Descriptor()
+	// loginevent.DefaultReason holds the default value on creation for the reason field.
+	loginevent.DefaultReason = logineventDescReason.Default.(string)
+	// logineventDescAnomalous is the schema descriptor for anomalous field.
+	logineventDescAnomalous := logineventFields[9].Descriptor()
+	// loginevent.DefaultAnomalous holds the default value on creation for the anomalous field.
+	loginevent.DefaultAnomalous = logineventDescAnomalous.Default.(bool)
+	logsMixin := schema.Logs{}.Mixin()
+	logsMixinHooks1 := logsMixin[1].Hooks()
+	logs.Hooks[0] = logsMixinHooks1[0]
+	logsMixinInters1 := logsMixin[1].Interceptors()
+	logs.Interceptors[0] = logsMixinInters1[0]
+	logsMixinFields0 := logsMixin[0].Fields()
+	_ = logsMixinFields0
+	logsMixinFields1 := logsMixin[1].Fields()
+	_ = logsMixinFields1
+	logsFields := schema.Logs{}.Fields()
+	_ = logsFields
+	// logsDescCreatedAt is the schema descriptor for created_at field.
+	logsDescCreatedAt := logsMixinFields0[1].Descriptor()
+	// logs.DefaultCreatedAt holds the default value on creation for the created_at field.
+	logs.DefaultCreatedAt = logsDescCreatedAt.Default.(func() time.Time)
+	// logsDescUpdatedAt is the schema descriptor for updated_at field.
+	logsDescUpdatedAt := logsMixinFields0[2].Descriptor()
+	// logs.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	logs.DefaultUpdatedAt = logsDescUpdatedAt.Default.(func() time.Time)
+	// logs.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	logs.UpdateDefaultUpdatedAt = logsDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// logsDescTenantID is the schema descriptor for tenant_id field.
+	logsDescTenantID := logsMixinFields1[0].Descriptor()
+	// logs.DefaultTenantID holds the default value on creation for the tenant_id field.
+	logs.DefaultTenantID = logsDescTenantID.Default.(uint64)
+	menuMixin := schema.Menu{}.Mixin()
+	menuMixinHooks1 := menuMixin[1].Hooks()
+	menu.Hooks[0] = menuMixinHooks1[0]
+	menuMixinInters1 := menuMixin[1].Interceptors()
+	menu.Interceptors[0] = menuMixinInters1[0]
+	menuMixinFields0 := menuMixin[0].Fields()
+	_ = menuMixinFields0
+	menuMixinFields1 := menuMixin[1].Fields()
+	_ = menuMixinFields1
+	menuFields := schema.Menu{}.Fields()
+	_ = menuFields
+	// menuDescCreatedAt is the schema descriptor for created_at field.
+	menuDescCreatedAt := menuMixinFields0[1].Descriptor()
+	// menu.DefaultCreatedAt holds the default value on creation for the created_at field.
+	menu.DefaultCreatedAt = menuDescCreatedAt.Default.(func() time.Time)
+	// menuDescUpdatedAt is the schema descriptor for updated_at field.
+	menuDescUpdatedAt := menuMixinFields0[2].Descriptor()
+	// menu.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	menu.DefaultUpdatedAt = menuDescUpdatedAt.Default.(func() time.Time)
+	// menu.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	menu.UpdateDefaultUpdatedAt = menuDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// menuDescTenantID is the schema descriptor for tenant_id field.
+	menuDescTenantID := menuMixinFields1[0].Descriptor()
+	// menu.DefaultTenantID holds the default value on creation for the tenant_id field.
+	menu.DefaultTenantID = menuDescTenantID.Default.(uint64)
+	// menuDescPath is the schema descriptor for path field.
+	menuDescPath := menuFields[3].Descriptor()
+	// menu.DefaultPath holds the default value on creation for the path field.
+	menu.DefaultPath = menuDescPath.Default.(string)
+	// menuDescRedirect is the schema descriptor for redirect field.
+	menuDescRedirect := menuFields[5].Descriptor()
+	// menu.DefaultRedirect holds the default value on creation for the redirect field.
+	menu.DefaultRedirect = menuDescRedirect.Default.(string)
+	// menuDescComponent is the schema descriptor for component field.
+	menuDescComponent := menuFields[6].Descriptor()
+	// menu.DefaultComponent holds the default value on creation for the component field.
+	menu.DefaultComponent = menuDescComponent.Default.(string)
+	// menuDescOrderNo is the schema descriptor for order_no field.
+	menuDescOrderNo := menuFields[7].Descriptor()
+	// menu.DefaultOrderNo holds the default value on creation for the order_no field.
+	menu.DefaultOrderNo = menuDescOrderNo.Default.(uint32)
+	// menuDescDisabled is the schema descriptor for disabled field.
+	menuDescDisabled := menuFields[8].Descriptor()
+	// menu.DefaultDisabled holds the default value on creation for the disabled field.
+	menu.DefaultDisabled = menuDescDisabled.Default.(bool)
+	// menuDescHideMenu is the schema descriptor for hide_menu field.
+	menuDescHideMenu := menuFields[11].Descriptor()
+	// menu.DefaultHideMenu holds the default value on creation for the hide_menu field.
+	menu.DefaultHideMenu = menuDescHideMenu.Default.(bool)
+	// menuDescHideBreadcrumb is the schema descriptor for hide_breadcrumb field.
+	menuDescHideBreadcrumb := menuFields[12].Descriptor()
+	// menu.DefaultHideBreadcrumb holds the default value on creation for the hide_breadcrumb field.
+	menu.DefaultHideBreadcrumb = menuDescHideBreadcrumb.Default.(bool)
+	// menuDescCurrentActiveMenu is the schema descriptor for current_active_menu field.
+	menuDescCurrentActiveMenu := menuFields[13].Descriptor()
+	// menu.DefaultCurrentActiveMenu holds the default value on creation for the current_active_menu field.
+	menu.DefaultCurrentActiveMenu = menuDescCurrentActiveMenu.Default.(string)
+	// menuDescIgnoreKeepAlive is the schema descriptor for ignore_keep_alive field.
+	menuDescIgnoreKeepAlive := menuFields[14].Descriptor()
+	// menu.DefaultIgnoreKeepAlive holds the default value on creation for the ignore_keep_alive field.
+	menu.DefaultIgnoreKeepAlive = menuDescIgnoreKeepAlive.Default.(bool)
+	// menuDescHideTab is the schema descriptor for hide_tab field.
+	menuDescHideTab := menuFields[15].Descriptor()
+	// menu.DefaultHideTab holds the default value on creation for the hide_tab field.
+	menu.DefaultHideTab = menuDescHideTab.Default.(bool)
+	// menuDescFrameSrc is the schema descriptor for frame_src field.
+	menuDescFrameSrc := menuFields[16].Descriptor()
+	// menu.DefaultFrameSrc holds the default value on creation for the frame_src field.
+	menu.DefaultFrameSrc = menuDescFrameSrc.Default.(string)
+	// menuDescCarryParam is the schema descriptor for carry_param field.
+	menuDescCarryParam := menuFields[17].Descriptor()
+	// menu.DefaultCarryParam holds the default value on creation for the carry_param field.
+	menu.DefaultCarryParam = menuDescCarryParam.Default.(bool)
+	// menuDescHideChildrenInMenu is the schema descriptor for hide_children_in_menu field.
+	menuDescHideChildrenInMenu := menuFields[18].Descriptor()
+	// menu.DefaultHideChildrenInMenu holds the default value on creation for the hide_children_in_menu field.
+	menu.DefaultHideChildrenInMenu = menuDescHideChildrenInMenu.Default.(bool)
+	// menuDescAffix is the schema descriptor for affix field.
+	menuDescAffix := menuFields[19].Descriptor()
+	// menu.DefaultAffix holds the default value on creation for the affix field.
+	menu.DefaultAffix = menuDescAffix.Default.(bool)
+	// menuDescDynamicLevel is the schema descriptor for dynamic_level field.
+	menuDescDynamicLevel := menuFields[20].Descriptor()
+	// menu.DefaultDynamicLevel holds the default value on creation for the dynamic_level field.
+	menu.DefaultDynamicLevel = menuDescDynamicLevel.Default.(uint32)
+	// menuDescRealPath is the schema descriptor for real_path field.
+	menuDescRealPath := menuFields[21].Descriptor()
+	// menu.DefaultRealPath holds the default value on creation for the real_path field.
+	menu.DefaultRealPath = menuDescRealPath.Default.(string)
+	oauthproviderMixin := schema.OauthProvider{}.Mixin()
+	oauthproviderMixinFields0 := oauthproviderMixin[0].Fields()
+	_ = oauthproviderMixinFields0
+	oauthproviderFields := schema.OauthProvider{}.Fields()
+	_ = oauthproviderFields
+	// oauthproviderDescCreatedAt is the schema descriptor for created_at field.
+	oauthproviderDescCreatedAt := oauthproviderMixinFields0[1].Descriptor()
+	// oauthprovider.DefaultCreatedAt holds the default value on creation for the created_at field.
+	oauthprovider.DefaultCreatedAt = oauthproviderDescCreatedAt.Default.(func() time.Time)
+	// oauthproviderDescUpdatedAt is the schema descriptor for updated_at field.
+	oauthproviderDescUpdatedAt := oauthproviderMixinFields0[2].Descriptor()
+	// oauthprovider.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	oauthprovider.DefaultUpdatedAt = oauthproviderDescUpdatedAt.Default.(func() time.Time)
+	// oauthprovider.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	oauthprovider.UpdateDefaultUpdatedAt = oauthproviderDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// oauthproviderDescDiscoveryURL is the schema descriptor for discovery_url field.
+	oauthproviderDescDiscoveryURL := oauthproviderFields[10].Descriptor()
+	// oauthprovider.DefaultDiscoveryURL holds the default value on creation for the discovery_url field.
+	oauthprovider.DefaultDiscoveryURL = oauthproviderDescDiscoveryURL.Default.(string)
+	// oauthproviderDescAutoProvision is the schema descriptor for auto_provision field.
+	oauthproviderDescAutoProvision := oauthproviderFields[12].Descriptor()
+	// oauthprovider.DefaultAutoProvision holds the default value on creation for the auto_provision field.
+	oauthprovider.DefaultAutoProvision = oauthproviderDescAutoProvision.Default.(bool)
+	// oauthproviderDescProvisionApproval is the schema descriptor for provision_approval field.
+	oauthproviderDescProvisionApproval := oauthproviderFields[13].Descriptor()
+	// oauthprovider.DefaultProvisionApproval holds the default value on creation for the provision_approval field.
+	oauthprovider.DefaultProvisionApproval = oauthproviderDescProvisionApproval.Default.(bool)
+	// oauthproviderDescDefaultRoleID is the schema descriptor for default_role_id field.
+	oauthproviderDescDefaultRoleID := oauthproviderFields[14].Descriptor()
+	// oauthprovider.DefaultDefaultRoleID holds the default value on creation for the default_role_id field.
+	oauthprovider.DefaultDefaultRoleID = oauthproviderDescDefaultRoleID.Default.(uint64)
+	oidcclientMixin := schema.OidcClient{}.Mixin()
+	oidcclientMixinFields0 := oidcclientMixin[0].Fields()
+	_ = oidcclientMixinFields0
+	oidcclientMixinFields1 := oidcclientMixin[1].Fields()
+	_ = oidcclientMixinFields1
+	oidcclientFields := schema.OidcClient{}.Fields()
+	_ = oidcclientFields
+	// oidcclientDescCreatedAt is the schema descriptor for created_at field.
+	oidcclientDescCreatedAt := oidcclientMixinFields0[1].Descriptor()
+	// oidcclient.DefaultCreatedAt holds the default value on creation for the created_at field.
+	oidcclient.DefaultCreatedAt = oidcclientDescCreatedAt.Default.(func() time.Time)
+	// oidcclientDescUpdatedAt is the schema descriptor for updated_at field.
+	oidcclientDescUpdatedAt := oidcclientMixinFields0[2].Descriptor()
+	// oidcclient.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	oidcclient.DefaultUpdatedAt = oidcclientDescUpdatedAt.Default.(func() time.Time)
+	// oidcclient.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	oidcclient.UpdateDefaultUpdatedAt = oidcclientDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// oidcclientDescStatus is the schema descriptor for status field.
+	oidcclientDescStatus := oidcclientMixinFields1[0].Descriptor()
+	// oidcclient.DefaultStatus holds the default value on creation for the status field.
+	oidcclient.DefaultStatus = oidcclientDescStatus.Default.(uint8)
+	// oidcclientDescClientSecret is the schema descriptor for client_secret field.
+	oidcclientDescClientSecret := oidcclientFields[1].Descriptor()
+	// oidcclient.DefaultClientSecret holds the default value on creation for the client_secret field.
+	oidcclient.DefaultClientSecret = oidcclientDescClientSecret.Default.(string)
+	// oidcclientDescPublic is the schema descriptor for public field.
+	oidcclientDescPublic := oidcclientFields[6].Descriptor()
+	// oidcclient.DefaultPublic holds the default value on creation for the public field.
+	oidcclient.DefaultPublic = oidcclientDescPublic.Default.(bool)
+	// oidcclientDescSkipConsent is the schema descriptor for skip_consent field.
+	oidcclientDescSkipConsent := oidcclientFields[7].Descriptor()
+	// oidcclient.DefaultSkipConsent holds the default value on creation for the skip_consent field.
+	oidcclient.DefaultSkipConsent = oidcclientDescSkipConsent.Default.(bool)
+	oidcconsentMixin := schema.OidcConsent{}.Mixin()
+	oidcconsentMixinFields0 := oidcconsentMixin[0].Fields()
+	_ = oidcconsentMixinFields0
+	oidcconsentFields := schema.OidcConsent{}.Fields()
+	_ = oidcconsentFields
+	// oidcconsentDescCreatedAt is the schema descriptor for created_at field.
+	oidcconsentDescCreatedAt := oidcconsentMixinFields0[1].Descriptor()
+	// oidcconsent.DefaultCreatedAt holds the default value on creation for the created_at field.
+	oidcconsent.DefaultCreatedAt = oidcconsentDescCreatedAt.Default.(func() time.Time)
+	// oidcconsentDescUpdatedAt is the schema descriptor for updated_at field.
+	oidcconsentDescUpdatedAt := oidcconsentMixinFields0[2].Descriptor()
+	// oidcconsent.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	oidcconsent.DefaultUpdatedAt = oidcconsentDescUpdatedAt.Default.(func() time.Time)
+	// oidcconsent.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	oidcconsent.UpdateDefaultUpdatedAt = oidcconsentDescUpdatedAt.UpdateDefault.(func() time.Time)
+	refreshtokenMixin := schema.RefreshToken{}.Mixin()
+	refreshtokenMixinFields0 := refreshtokenMixin[0].Fields()
+	_ = refreshtokenMixinFields0
+	refreshtokenFields := schema.RefreshToken{}.Fields()
+	_ = refreshtokenFields
+	// refreshtokenDescCreatedAt is the schema descriptor for created_at field.
+	refreshtokenDescCreatedAt := refreshtokenMixinFields0[1].Descriptor()
+	// refreshtoken.DefaultCreatedAt holds the default value on creation for the created_at field.
+	refreshtoken.DefaultCreatedAt = refreshtokenDescCreatedAt.Default.(func() time.Time)
+	// refreshtokenDescUpdatedAt is the schema descriptor for updated_at field.
+	refreshtokenDescUpdatedAt := refreshtokenMixinFields0[2].Descriptor()
+	// refreshtoken.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	refreshtoken.DefaultUpdatedAt = refreshtokenDescUpdatedAt.Default.(func() time.Time)
+	// refreshtoken.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	refreshtoken.UpdateDefaultUpdatedAt = refreshtokenDescUpdatedAt.UpdateDefault.(func() time.Time)
+	roleMixin := schema.Role{}.Mixin()
+	roleMixinHooks2 := roleMixin[2].Hooks()
+	role.Hooks[0] = roleMixinHooks2[0]
+	roleMixinInters2 := roleMixin[2].Interceptors()
+	role.Interceptors[0] = roleMixinInters2[0]
+	roleMixinFields0 := roleMixin[0].Fields()
+	_ = roleMixinFields0
+	roleMixinFields1 := roleMixin[1].Fields()
+	_ = roleMixinFields1
+	roleMixinFields2 := roleMixin[2].Fields()
+	_ = roleMixinFields2
+	roleFields := schema.Role{}.Fields()
+	_ = roleFields
+	// roleDescCreatedAt is the schema descriptor for created_at field.
+	roleDescCreatedAt := roleMixinFields0[1].Descriptor()
+	// role.DefaultCreatedAt holds the default value on creation for the created_at field.
+	role.DefaultCreatedAt = roleDescCreatedAt.Default.(func() time.Time)
+	// roleDescUpdatedAt is the schema descriptor for updated_at field.
+	roleDescUpdatedAt := roleMixinFields0[2].Descriptor()
+	// role.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	role.DefaultUpdatedAt = roleDescUpdatedAt.Default.(func() time.Time)
+	// role.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	role.UpdateDefaultUpdatedAt = roleDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// roleDescStatus is the schema descriptor for status field.
+	roleDescStatus := roleMixinFields1[0].Descriptor()
+	// role.DefaultStatus holds the default value on creation for the status field.
+	role.DefaultStatus = roleDescStatus.Default.(uint8)
+	// roleDescTenantID is the schema descriptor for tenant_id field.
+	roleDescTenantID := roleMixinFields2[0].Descriptor()
+	// role.DefaultTenantID holds the default value on creation for the tenant_id field.
+	role.DefaultTenantID = roleDescTenantID.Default.(uint64)
+	// roleDescDefaultRouter is the schema descriptor for default_router field.
+	roleDescDefaultRouter := roleFields[2].Descriptor()
+	// role.DefaultDefaultRouter holds the default value on creation for the default_router field.
+	role.DefaultDefaultRouter = roleDescDefaultRouter.Default.(string)
+	// roleDescRemark is the schema descriptor for remark field.
+	roleDescRemark := roleFields[3].Descriptor()
+	// role.DefaultRemark holds the default value on creation for the remark field.
+	role.DefaultRemark = roleDescRemark.Default.(string)
+	// roleDescOrderNo is the schema descriptor for order_no field.
+	roleDescOrderNo := roleFields[4].Descriptor()
+	// role.DefaultOrderNo holds the default value on creation for the order_no field.
+	role.DefaultOrderNo = roleDescOrderNo.Default.(uint32)
+	// roleDescForceTwoFactor is the schema descriptor for force_two_factor field.
+	roleDescForceTwoFactor := roleFields[5].Descriptor()
+	// role.DefaultForceTwoFactor holds the default value on creation for the force_two_factor field.
+	role.DefaultForceTwoFactor = roleDescForceTwoFactor.Default.(bool)
+	samlproviderMixin := schema.SamlProvider{}.Mixin()
+	samlproviderMixinFields0 := samlproviderMixin[0].Fields()
+	_ = samlproviderMixinFields0
+	samlproviderFields := schema.SamlProvider{}.Fields()
+	_ = samlproviderFields
+	// samlproviderDescCreatedAt is the schema descriptor for created_at field.
+	samlproviderDescCreatedAt := samlproviderMixinFields0[1].Descriptor()
+	// samlprovider.DefaultCreatedAt holds the default value on creation for the created_at field.
+	samlprovider.DefaultCreatedAt = samlproviderDescCreatedAt.Default.(func() time.Time)
+	// samlproviderDescUpdatedAt is the schema descriptor for updated_at field.
+	samlproviderDescUpdatedAt := samlproviderMixinFields0[2].Descriptor()
+	// samlprovider.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	samlprovider.DefaultUpdatedAt = samlproviderDescUpdatedAt.Default.(func() time.Time)
+	// samlprovider.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	samlprovider.UpdateDefaultUpdatedAt = samlproviderDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// samlproviderDescEntityID is the schema descriptor for entity_id field.
+	samlproviderDescEntityID := samlproviderFields[1].Descriptor()
+	// samlprovider.DefaultEntityID holds the default value on creation for the entity_id field.
+	samlprovider.DefaultEntityID = samlproviderDescEntityID.Default.(string)
+	// samlproviderDescIdpMetadataURL is the schema descriptor for idp_metadata_url field.
+	samlproviderDescIdpMetadataURL := samlproviderFields[2].Descriptor()
+	// samlprovider.DefaultIdpMetadataURL holds the default value on creation for the idp_metadata_url field.
+	samlprovider.DefaultIdpMetadataURL = samlproviderDescIdpMetadataURL.Default.(string)
+	// samlproviderDescIdpMetadataXML is the schema descriptor for idp_metadata_xml field.
+	samlproviderDescIdpMetadataXML := samlproviderFields[3].Descriptor()
+	// samlprovider.DefaultIdpMetadataXML holds the default value on creation for the idp_metadata_xml field.
+	samlprovider.DefaultIdpMetadataXML = samlproviderDescIdpMetadataXML.Default.(string)
+	// samlproviderDescAllowIdpInitiated is the schema descriptor for allow_idp_initiated field.
+	samlproviderDescAllowIdpInitiated := samlproviderFields[5].Descriptor()
+	// samlprovider.DefaultAllowIdpInitiated holds the default value on creation for the allow_idp_initiated field.
+	samlprovider.DefaultAllowIdpInitiated = samlproviderDescAllowIdpInitiated.Default.(bool)
+	// samlproviderDescAutoProvision is the schema descriptor for auto_provision field.
+	samlproviderDescAutoProvision := samlproviderFields[6].Descriptor()
+	// samlprovider.DefaultAutoProvision holds the default value on creation for the auto_provision field.
+	samlprovider.DefaultAutoProvision = samlproviderDescAutoProvision.Default.(bool)
+	// samlproviderDescProvisionApproval is the schema descriptor for provision_approval field.
+	samlproviderDescProvisionApproval := samlproviderFields[7].Descriptor()
+	// samlprovider.DefaultProvisionApproval holds the default value on creation for the provision_approval field.
+	samlprovider.DefaultProvisionApproval = samlproviderDescProvisionApproval.Default.(bool)
+	// samlproviderDescDefaultRoleID is the schema descriptor for default_role_id field.
+	samlproviderDescDefaultRoleID := samlproviderFields[8].Descriptor()
+	// samlprovider.DefaultDefaultRoleID holds the default value on creation for the default_role_id field.
+	samlprovider.DefaultDefaultRoleID = samlproviderDescDefaultRoleID.Default.(uint64)
+	tenantMixin := schema.Tenant{}.Mixin()
+	tenantMixinFields0 := tenantMixin[0].Fields()
+	_ = tenantMixinFields0
+	tenantMixinFields1 := tenantMixin[1].Fields()
+	_ = tenantMixinFields1
+	tenantFields := schema.Tenant{}.Fields()
+	_ = tenantFields
+	// tenantDescCreatedAt is the schema descriptor for created_at field.
+	tenantDescCreatedAt := tenantMixinFields0[1].Descriptor()
+	// tenant.DefaultCreatedAt holds the default value on creation for the created_at field.
+	tenant.DefaultCreatedAt = tenantDescCreatedAt.Default.(func() time.Time)
+	// tenantDescUpdatedAt is the schema descriptor for updated_at field.
+	tenantDescUpdatedAt := tenantMixinFields0[2].Descriptor()
+	// tenant.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	tenant.DefaultUpdatedAt = tenantDescUpdatedAt.Default.(func() time.Time)
+	// tenant.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	tenant.UpdateDefaultUpdatedAt = tenantDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// tenantDescStatus is the schema descriptor for status field.
+	tenantDescStatus := tenantMixinFields1[0].Descriptor()
+	// tenant.DefaultStatus holds the default value on creation for the status field.
+	tenant.DefaultStatus = tenantDescStatus.Default.(uint8)
+	// tenantDescRemark is the schema descriptor for remark field.
+	tenantDescRemark := tenantFields[1].Descriptor()
+	// tenant.DefaultRemark holds the default value on creation for the remark field.
+	tenant.DefaultRemark = tenantDescRemark.Default.(string)
+	tokenMixin := schema.Token{}.Mixin()
+	tokenMixinFields0 := tokenMixin[0].Fields()
+	_ = tokenMixinFields0
+	tokenFields := schema.Token{}.Fields()
+	_ = tokenFields
+	// tokenDescCreatedAt is the schema descriptor for created_at field.
+	tokenDescCreatedAt := tokenMixinFields0[1].Descriptor()
+	// token.DefaultCreatedAt holds the default value on creation for the created_at field.
+	token.DefaultCreatedAt = tokenDescCreatedAt.Default.(func() time.Time)
+	// tokenDescUpdatedAt is the schema descriptor for updated_at field.
+	tokenDescUpdatedAt := tokenMixinFields0[2].Descriptor()
+	// token.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	token.DefaultUpdatedAt = tokenDescUpdatedAt.Default.(func() time.Time)
+	// token.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	token.UpdateDefaultUpdatedAt = tokenDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// tokenDescDevice is the schema descriptor for device field.
+	tokenDescDevice := tokenFields[5].Descriptor()
+	// token.DefaultDevice holds the default value on creation for the device field.
+	token.DefaultDevice = tokenDescDevice.Default.(string)
+	// tokenDescIP is the schema descriptor for ip field.
+	tokenDescIP := tokenFields[6].Descriptor()
+	// token.DefaultIP holds the default value on creation for the ip field.
+	token.DefaultIP = tokenDescIP.Default.(string)
+	// tokenDescUserAgent is the schema descriptor for user_agent field.
+	tokenDescUserAgent := tokenFields[7].Descriptor()
+	// token.DefaultUserAgent holds the default value on creation for the user_agent field.
+	token.DefaultUserAgent = tokenDescUserAgent.Default.(string)
+	// tokenDescImpersonatorID is the schema descriptor for impersonator_id field.
+	tokenDescImpersonatorID := tokenFields[11].Descriptor()
+	// token.DefaultImpersonatorID holds the default value on creation for the impersonator_id field.
+	token.DefaultImpersonatorID = tokenDescImpersonatorID.Default.(uint64)
+	// tokenDescRoleID is the schema descriptor for role_id field.
+	tokenDescRoleID := tokenFields[12].Descriptor()
+	// token.DefaultRoleID holds the default value on creation for the role_id field.
+	token.DefaultRoleID = tokenDescRoleID.Default.(uint64)
+	userMixin := schema.User{}.Mixin()
+	userMixinHooks2 := userMixin[2].Hooks()
+	user.Hooks[0] = userMixinHooks2[0]
+	userMixinInters2 := userMixin[2].Interceptors()
+	user.Interceptors[0] = userMixinInters2[0]
+	userMixinFields0 := userMixin[0].Fields()
+	_ = userMixinFields0
+	userMixinFields1 := userMixin[1].Fields()
+	_ = userMixinFields1
+	userMixinFields2 := userMixin[2].Fields()
+	_ = userMixinFields2
+	userFields := schema.User{}.Fields()
+	_ = userFields
+	// userDescCreatedAt is the schema descriptor for created_at field.
+	userDescCreatedAt := userMixinFields0[1].Descriptor()
+	// user.DefaultCreatedAt holds the default value on creation for the created_at field.
+	user.DefaultCreatedAt = userDescCreatedAt.Default.(func() time.Time)
+	// userDescUpdatedAt is the schema descriptor for updated_at field.
+	userDescUpdatedAt := userMixinFields0[2].Descriptor()
+	// user.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	user.DefaultUpdatedAt = userDescUpdatedAt.Default.(func() time.Time)
+	// user.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	user.UpdateDefaultUpdatedAt = userDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// userDescStatus is the schema descriptor for status field.
+	userDescStatus := userMixinFields1[0].Descriptor()
+	// user.DefaultStatus holds the default value on creation for the status field.
+	user.DefaultStatus = userDescStatus.Default.(uint8)
+	// userDescTenantID is the schema descriptor for tenant_id field.
+	userDescTenantID := userMixinFields2[0].Descriptor()
+	// user.DefaultTenantID holds the default value on creation for the tenant_id field.
+	user.DefaultTenantID = userDescTenantID.Default.(uint64)
+	// userDescSideMode is the schema descriptor for side_mode field.
+	userDescSideMode := userFields[3].Descriptor()
+	// user.DefaultSideMode holds the default value on creation for the side_mode field.
+	user.DefaultSideMode = userDescSideMode.Default.(string)
+	// userDescBaseColor is the schema descriptor for base_color field.
+	userDescBaseColor := userFields[4].Descriptor()
+	// user.DefaultBaseColor holds the default value on creation for the base_color field.
+	user.DefaultBaseColor = userDescBaseColor.Default.(string)
+	// userDescActiveColor is the schema descriptor for active_color field.
+	userDescActiveColor := userFields[5].Descriptor()
+	// user.DefaultActiveColor holds the default value on creation for the active_color field.
+	user.DefaultActiveColor = userDescActiveColor.Default.(string)
+	// userDescRoleID is the schema descriptor for role_id field.
+	userDescRoleID := userFields[6].Descriptor()
+	// user.DefaultRoleID holds the default value on creation for the role_id field.
+	user.DefaultRoleID = userDescRoleID.Default.(uint64)
+	// userDescAvatar is the schema descriptor for avatar field.
+	userDescAvatar := userFields[10].Descriptor()
+	// user.DefaultAvatar holds the default value on creation for the avatar field.
+	user.DefaultAvatar = userDescAvatar.Default.(string)
+	// userDescTokenVersion is the schema descriptor for token_version field.
+	userDescTokenVersion := userFields[11].Descriptor()
+	// user.DefaultTokenVersion holds the default value on creation for the token_version field.
+	user.DefaultTokenVersion = userDescTokenVersion.Default.(uint64)
+	// userDescTotpSecret is the schema descriptor for totp_secret field.
+	userDescTotpSecret := userFields[12].Descriptor()
+	// user.DefaultTotpSecret holds the default value on creation for the totp_secret field.
+	user.DefaultTotpSecret = userDescTotpSecret.Default.(string)
+	// userDescTotpEnabled is the schema descriptor for totp_enabled field.
+	userDescTotpEnabled := userFields[13].Descriptor()
+	// user.DefaultTotpEnabled holds the default value on creation for the totp_enabled field.
+	user.DefaultTotpEnabled = userDescTotpEnabled.Default.(bool)
+	// userDescPendingApproval is the schema descriptor for pending_approval field.
+	userDescPendingApproval := userFields[16].Descriptor()
+	// user.DefaultPendingApproval holds the default value on creation for the pending_approval field.
+	user.DefaultPendingApproval = userDescPendingApproval.Default.(bool)
+	useridentityMixin := schema.UserIdentity{}.Mixin()
+	useridentityMixinFields0 := useridentityMixin[0].Fields()
+	_ = useridentityMixinFields0
+	useridentityFields := schema.UserIdentity{}.Fields()
+	_ = useridentityFields
+	// useridentityDescCreatedAt is the schema descriptor for created_at field.
+	useridentityDescCreatedAt := useridentityMixinFields0[1].Descriptor()
+	// useridentity.DefaultCreatedAt holds the default value on creation for the created_at field.
+	useridentity.DefaultCreatedAt = useridentityDescCreatedAt.Default.(func() time.Time)
+	// useridentityDescUpdatedAt is the schema descriptor for updated_at field.
+	useridentityDescUpdatedAt := useridentityMixinFields0[2].Descriptor()
+	// useridentity.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	useridentity.DefaultUpdatedAt = useridentityDescUpdatedAt.Default.(func() time.Time)
+	// useridentity.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	useridentity.UpdateDefaultUpdatedAt = useridentityDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// useridentityDescUsername is the schema descriptor for username field.
+	useridentityDescUsername := useridentityFields[3].Descriptor()
+	// useridentity.DefaultUsername holds the default value on creation for the username field.
+	useridentity.DefaultUsername = useridentityDescUsername.Default.(string)
+	// useridentityDescEmail is the schema descriptor for email field.
+	useridentityDescEmail := useridentityFields[4].Descriptor()
+	// useridentity.DefaultEmail holds the default value on creation for the email field.
+	useridentity.DefaultEmail = useridentityDescEmail.Default.(string)
+	webauthncredentialMixin := schema.WebauthnCredential{}.Mixin()
+	webauthncredentialMixinFields0 := webauthncredentialMixin[0].Fields()
+	_ = webauthncredentialMixinFields0
+	webauthncredentialFields := schema.WebauthnCredential{}.Fields()
+	_ = webauthncredentialFields
+	// webauthncredentialDescCreatedAt is the schema descriptor for created_at field.
+	webauthncredentialDescCreatedAt := webauthncredentialMixinFields0[1].Descriptor()
+	// webauthncredential.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webauthncredential.DefaultCreatedAt = webauthncredentialDescCreatedAt.Default.(func() time.Time)
+	// webauthncredentialDescUpdatedAt is the schema descriptor for updated_at field.
+	webauthncredentialDescUpdatedAt := webauthncredentialMixinFields0[2].Descriptor()
+	// webauthncredential.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	webauthncredential.DefaultUpdatedAt = webauthncredentialDescUpdatedAt.Default.(func() time.Time)
+	// webauthncredential.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	webauthncredential.UpdateDefaultUpdatedAt = webauthncredentialDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// webauthncredentialDescName is the schema descriptor for name field.
+	webauthncredentialDescName := webauthncredentialFields[1].Descriptor()
+	// webauthncredential.DefaultName holds the default value on creation for the name field.
+	webauthncredential.DefaultName = webauthncredentialDescName.Default.(string)
+	// webauthncredentialDescAttestationType is the schema descriptor for attestation_type field.
+	webauthncredentialDescAttestationType := webauthncredentialFields[4].Descriptor()
+	// webauthncredential.DefaultAttestationType holds the default value on creation for the attestation_type field.
+	webauthncredential.DefaultAttestationType = webauthncredentialDescAttestationType.Default.(string)
+	// webauthncredentialDescSignCount is the schema descriptor for sign_count field.
+	webauthncredentialDescSignCount := webauthncredentialFields[6].Descriptor()
+	// webauthncredential.DefaultSignCount holds the default value on creation for the sign_count field.
+	webauthncredential.DefaultSignCount = webauthncredentialDescSignCount.Default.(uint32)
+	// webauthncredentialDescFlags is the schema descriptor for flags field.
+	webauthncredentialDescFlags := webauthncredentialFields[8].Descriptor()
+	// webauthncredential.DefaultFlags holds the default value on creation for the flags field.
+	webauthncredential.DefaultFlags = webauthncredentialDescFlags.Default.(uint8)
+}
 
 const (
 	Version = "v0.14.6"                                         // Version of ent codegen.
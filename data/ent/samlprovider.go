@@ -0,0 +1,238 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"formulago/data/ent/samlprovider"
+	"formulago/pkg/rolerule"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// SamlProvider is the model entity for the SamlProvider schema.
+type SamlProvider struct {
+	config `json:"-"`
+	// ID of the ent.
+	// primary key
+	ID uint64 `json:"id,omitempty"`
+	// created time
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// last update time
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// the IdP's name, part of the metadata and ACS URLs | 身份提供商名称
+	Name string `json:"name,omitempty"`
+	// the entity id of the service provider registered at the IdP, the metadata URL if empty | 服务提供商实体ID, 为空时使用元数据地址
+	EntityID string `json:"entity_id,omitempty"`
+	// the URL of the IdP metadata, reloaded with the provider | 身份提供商元数据地址
+	IdpMetadataURL string `json:"idp_metadata_url,omitempty"`
+	// the IdP metadata, used if idp_metadata_url is empty | 身份提供商元数据XML, 未设置元数据地址时使用
+	IdpMetadataXML string `json:"idp_metadata_xml,omitempty"`
+	// the attribute names of the user info fields, keyed by subject, username, email, email_verified, mobile, nickname, avatar and groups, the subject is the NameID if empty | 用户信息字段对应的属性名
+	AttributeMapping map[string]string `json:"attribute_mapping,omitempty"`
+	// accept the logins started at the IdP | 允许身份提供商发起的登录
+	AllowIdpInitiated bool `json:"allow_idp_initiated,omitempty"`
+	// create the user on the first login of an account not linked yet | 未关联账号首次登录时自动创建用户
+	AutoProvision bool `json:"auto_provision,omitempty"`
+	// the created users wait for the approval of an admin | 自动创建的用户需管理员审批
+	ProvisionApproval bool `json:"provision_approval,omitempty"`
+	// the role of the created users no rule matches, 0 rejects them | 未匹配规则的自动创建用户角色, 0为拒绝
+	DefaultRoleID uint64 `json:"default_role_id,omitempty"`
+	// the rules deciding the role of the created users, the first match wins | 决定自动创建用户角色的规则, 按顺序首个匹配生效
+	RoleRules    []rolerule.Rule `json:"role_rules,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SamlProvider) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case samlprovider.FieldAttributeMapping, samlprovider.FieldRoleRules:
+			values[i] = new([]byte)
+		case samlprovider.FieldAllowIdpInitiated, samlprovider.FieldAutoProvision, samlprovider.FieldProvisionApproval:
+			values[i] = new(sql.NullBool)
+		case samlprovider.FieldID, samlprovider.FieldDefaultRoleID:
+			values[i] = new(sql.NullInt64)
+		case samlprovider.FieldName, samlprovider.FieldEntityID, samlprovider.FieldIdpMetadataURL, samlprovider.FieldIdpMetadataXML:
+			values[i] = new(sql.NullString)
+		case samlprovider.FieldCreatedAt, samlprovider.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SamlProvider fields.
+func (_m *SamlProvider) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case samlprovider.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = uint64(value.Int64)
+		case samlprovider.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case samlprovider.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case samlprovider.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case samlprovider.FieldEntityID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field entity_id", values[i])
+			} else if value.Valid {
+				_m.EntityID = value.String
+			}
+		case samlprovider.FieldIdpMetadataURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field idp_metadata_url", values[i])
+			} else if value.Valid {
+				_m.IdpMetadataURL = value.String
+			}
+		case samlprovider.FieldIdpMetadataXML:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field idp_metadata_xml", values[i])
+			} else if value.Valid {
+				_m.IdpMetadataXML = value.String
+			}
+		case samlprovider.FieldAttributeMapping:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field attribute_mapping", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.AttributeMapping); err != nil {
+					return fmt.Errorf("unmarshal field attribute_mapping: %w", err)
+				}
+			}
+		case samlprovider.FieldAllowIdpInitiated:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field allow_idp_initiated", values[i])
+			} else if value.Valid {
+				_m.AllowIdpInitiated = value.Bool
+			}
+		case samlprovider.FieldAutoProvision:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field auto_provision", values[i])
+			} else if value.Valid {
+				_m.AutoProvision = value.Bool
+			}
+		case samlprovider.FieldProvisionApproval:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field provision_approval", values[i])
+			} else if value.Valid {
+				_m.ProvisionApproval = value.Bool
+			}
+		case samlprovider.FieldDefaultRoleID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field default_role_id", values[i])
+			} else if value.Valid {
+				_m.DefaultRoleID = uint64(value.Int64)
+			}
+		case samlprovider.FieldRoleRules:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field role_rules", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.RoleRules); err != nil {
+					return fmt.Errorf("unmarshal field role_rules: %w", err)
+				}
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SamlProvider.
+// This includes values selected through modifiers, order, etc.
+func (_m *SamlProvider) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this SamlProvider.
+// Note that you need to call SamlProvider.Unwrap() before calling this method if this SamlProvider
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *SamlProvider) Update() *SamlProviderUpdateOne {
+	return NewSamlProviderClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the SamlProvider entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *SamlProvider) Unwrap() *SamlProvider {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SamlProvider is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *SamlProvider) String() string {
+	var builder strings.Builder
+	builder.WriteString("SamlProvider(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("entity_id=")
+	builder.WriteString(_m.EntityID)
+	builder.WriteString(", ")
+	builder.WriteString("idp_metadata_url=")
+	builder.WriteString(_m.IdpMetadataURL)
+	builder.WriteString(", ")
+	builder.WriteString("idp_metadata_xml=")
+	builder.WriteString(_m.IdpMetadataXML)
+	builder.WriteString(", ")
+	builder.WriteString("attribute_mapping=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AttributeMapping))
+	builder.WriteString(", ")
+	builder.WriteString("allow_idp_initiated=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AllowIdpInitiated))
+	builder.WriteString(", ")
+	builder.WriteString("auto_provision=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AutoProvision))
+	builder.WriteString(", ")
+	builder.WriteString("provision_approval=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ProvisionApproval))
+	builder.WriteString(", ")
+	builder.WriteString("default_role_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DefaultRoleID))
+	builder.WriteString(", ")
+	builder.WriteString("role_rules=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RoleRules))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SamlProviders is a parsable slice of SamlProvider.
+type SamlProviders []*SamlProvider
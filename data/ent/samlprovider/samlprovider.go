@@ -0,0 +1,150 @@
+// Code generated by ent, DO NOT EDIT.
+
+package samlprovider
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the samlprovider type in the database.
+	Label = "saml_provider"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldEntityID holds the string denoting the entity_id field in the database.
+	FieldEntityID = "entity_id"
+	// FieldIdpMetadataURL holds the string denoting the idp_metadata_url field in the database.
+	FieldIdpMetadataURL = "idp_metadata_url"
+	// FieldIdpMetadataXML holds the string denoting the idp_metadata_xml field in the database.
+	FieldIdpMetadataXML = "idp_metadata_xml"
+	// FieldAttributeMapping holds the string denoting the attribute_mapping field in the database.
+	FieldAttributeMapping = "attribute_mapping"
+	// FieldAllowIdpInitiated holds the string denoting the allow_idp_initiated field in the database.
+	FieldAllowIdpInitiated = "allow_idp_initiated"
+	// FieldAutoProvision holds the string denoting the auto_provision field in the database.
+	FieldAutoProvision = "auto_provision"
+	// FieldProvisionApproval holds the string denoting the provision_approval field in the database.
+	FieldProvisionApproval = "provision_approval"
+	// FieldDefaultRoleID holds the string denoting the default_role_id field in the database.
+	FieldDefaultRoleID = "default_role_id"
+	// FieldRoleRules holds the string denoting the role_rules field in the database.
+	FieldRoleRules = "role_rules"
+	// Table holds the table name of the samlprovider in the database.
+	Table = "sys_saml_providers"
+)
+
+// Columns holds all SQL columns for samlprovider fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldName,
+	FieldEntityID,
+	FieldIdpMetadataURL,
+	FieldIdpMetadataXML,
+	FieldAttributeMapping,
+	FieldAllowIdpInitiated,
+	FieldAutoProvision,
+	FieldProvisionApproval,
+	FieldDefaultRoleID,
+	FieldRoleRules,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultEntityID holds the default value on creation for the "entity_id" field.
+	DefaultEntityID string
+	// DefaultIdpMetadataURL holds the default value on creation for the "idp_metadata_url" field.
+	DefaultIdpMetadataURL string
+	// DefaultIdpMetadataXML holds the default value on creation for the "idp_metadata_xml" field.
+	DefaultIdpMetadataXML string
+	// DefaultAllowIdpInitiated holds the default value on creation for the "allow_idp_initiated" field.
+	DefaultAllowIdpInitiated bool
+	// DefaultAutoProvision holds the default value on creation for the "auto_provision" field.
+	DefaultAutoProvision bool
+	// DefaultProvisionApproval holds the default value on creation for the "provision_approval" field.
+	DefaultProvisionApproval bool
+	// DefaultDefaultRoleID holds the default value on creation for the "default_role_id" field.
+	DefaultDefaultRoleID uint64
+)
+
+// OrderOption defines the ordering options for the SamlProvider queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByEntityID orders the results by the entity_id field.
+func ByEntityID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEntityID, opts...).ToFunc()
+}
+
+// ByIdpMetadataURL orders the results by the idp_metadata_url field.
+func ByIdpMetadataURL(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIdpMetadataURL, opts...).ToFunc()
+}
+
+// ByIdpMetadataXML orders the results by the idp_metadata_xml field.
+func ByIdpMetadataXML(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIdpMetadataXML, opts...).ToFunc()
+}
+
+// ByAllowIdpInitiated orders the results by the allow_idp_initiated field.
+func ByAllowIdpInitiated(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAllowIdpInitiated, opts...).ToFunc()
+}
+
+// ByAutoProvision orders the results by the auto_provision field.
+func ByAutoProvision(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAutoProvision, opts...).ToFunc()
+}
+
+// ByProvisionApproval orders the results by the provision_approval field.
+func ByProvisionApproval(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProvisionApproval, opts...).ToFunc()
+}
+
+// ByDefaultRoleID orders the results by the default_role_id field.
+func ByDefaultRoleID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDefaultRoleID, opts...).ToFunc()
+}
@@ -0,0 +1,590 @@
+// Code generated by ent, DO NOT EDIT.
+
+package samlprovider
+
+import (
+	"formulago/data/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint64) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint64) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint64) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint64) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint64) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint64) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint64) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint64) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint64) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldEQ(FieldName, v))
+}
+
+// EntityID applies equality check predicate on the "entity_id" field. It's identical to EntityIDEQ.
+func EntityID(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldEQ(FieldEntityID, v))
+}
+
+// IdpMetadataURL applies equality check predicate on the "idp_metadata_url" field. It's identical to IdpMetadataURLEQ.
+func IdpMetadataURL(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldEQ(FieldIdpMetadataURL, v))
+}
+
+// IdpMetadataXML applies equality check predicate on the "idp_metadata_xml" field. It's identical to IdpMetadataXMLEQ.
+func IdpMetadataXML(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldEQ(FieldIdpMetadataXML, v))
+}
+
+// AllowIdpInitiated applies equality check predicate on the "allow_idp_initiated" field. It's identical to AllowIdpInitiatedEQ.
+func AllowIdpInitiated(v bool) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldEQ(FieldAllowIdpInitiated, v))
+}
+
+// AutoProvision applies equality check predicate on the "auto_provision" field. It's identical to AutoProvisionEQ.
+func AutoProvision(v bool) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldEQ(FieldAutoProvision, v))
+}
+
+// ProvisionApproval applies equality check predicate on the "provision_approval" field. It's identical to ProvisionApprovalEQ.
+func ProvisionApproval(v bool) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldEQ(FieldProvisionApproval, v))
+}
+
+// DefaultRoleID applies equality check predicate on the "default_role_id" field. It's identical to DefaultRoleIDEQ.
+func DefaultRoleID(v uint64) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldEQ(FieldDefaultRoleID, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldContainsFold(FieldName, v))
+}
+
+// EntityIDEQ applies the EQ predicate on the "entity_id" field.
+func EntityIDEQ(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldEQ(FieldEntityID, v))
+}
+
+// EntityIDNEQ applies the NEQ predicate on the "entity_id" field.
+func EntityIDNEQ(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldNEQ(FieldEntityID, v))
+}
+
+// EntityIDIn applies the In predicate on the "entity_id" field.
+func EntityIDIn(vs ...string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldIn(FieldEntityID, vs...))
+}
+
+// EntityIDNotIn applies the NotIn predicate on the "entity_id" field.
+func EntityIDNotIn(vs ...string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldNotIn(FieldEntityID, vs...))
+}
+
+// EntityIDGT applies the GT predicate on the "entity_id" field.
+func EntityIDGT(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldGT(FieldEntityID, v))
+}
+
+// EntityIDGTE applies the GTE predicate on the "entity_id" field.
+func EntityIDGTE(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldGTE(FieldEntityID, v))
+}
+
+// EntityIDLT applies the LT predicate on the "entity_id" field.
+func EntityIDLT(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldLT(FieldEntityID, v))
+}
+
+// EntityIDLTE applies the LTE predicate on the "entity_id" field.
+func EntityIDLTE(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldLTE(FieldEntityID, v))
+}
+
+// EntityIDContains applies the Contains predicate on the "entity_id" field.
+func EntityIDContains(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldContains(FieldEntityID, v))
+}
+
+// EntityIDHasPrefix applies the HasPrefix predicate on the "entity_id" field.
+func EntityIDHasPrefix(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldHasPrefix(FieldEntityID, v))
+}
+
+// EntityIDHasSuffix applies the HasSuffix predicate on the "entity_id" field.
+func EntityIDHasSuffix(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldHasSuffix(FieldEntityID, v))
+}
+
+// EntityIDIsNil applies the IsNil predicate on the "entity_id" field.
+func EntityIDIsNil() predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldIsNull(FieldEntityID))
+}
+
+// EntityIDNotNil applies the NotNil predicate on the "entity_id" field.
+func EntityIDNotNil() predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldNotNull(FieldEntityID))
+}
+
+// EntityIDEqualFold applies the EqualFold predicate on the "entity_id" field.
+func EntityIDEqualFold(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldEqualFold(FieldEntityID, v))
+}
+
+// EntityIDContainsFold applies the ContainsFold predicate on the "entity_id" field.
+func EntityIDContainsFold(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldContainsFold(FieldEntityID, v))
+}
+
+// IdpMetadataURLEQ applies the EQ predicate on the "idp_metadata_url" field.
+func IdpMetadataURLEQ(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldEQ(FieldIdpMetadataURL, v))
+}
+
+// IdpMetadataURLNEQ applies the NEQ predicate on the "idp_metadata_url" field.
+func IdpMetadataURLNEQ(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldNEQ(FieldIdpMetadataURL, v))
+}
+
+// IdpMetadataURLIn applies the In predicate on the "idp_metadata_url" field.
+func IdpMetadataURLIn(vs ...string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldIn(FieldIdpMetadataURL, vs...))
+}
+
+// IdpMetadataURLNotIn applies the NotIn predicate on the "idp_metadata_url" field.
+func IdpMetadataURLNotIn(vs ...string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldNotIn(FieldIdpMetadataURL, vs...))
+}
+
+// IdpMetadataURLGT applies the GT predicate on the "idp_metadata_url" field.
+func IdpMetadataURLGT(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldGT(FieldIdpMetadataURL, v))
+}
+
+// IdpMetadataURLGTE applies the GTE predicate on the "idp_metadata_url" field.
+func IdpMetadataURLGTE(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldGTE(FieldIdpMetadataURL, v))
+}
+
+// IdpMetadataURLLT applies the LT predicate on the "idp_metadata_url" field.
+func IdpMetadataURLLT(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldLT(FieldIdpMetadataURL, v))
+}
+
+// IdpMetadataURLLTE applies the LTE predicate on the "idp_metadata_url" field.
+func IdpMetadataURLLTE(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldLTE(FieldIdpMetadataURL, v))
+}
+
+// IdpMetadataURLContains applies the Contains predicate on the "idp_metadata_url" field.
+func IdpMetadataURLContains(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldContains(FieldIdpMetadataURL, v))
+}
+
+// IdpMetadataURLHasPrefix applies the HasPrefix predicate on the "idp_metadata_url" field.
+func IdpMetadataURLHasPrefix(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldHasPrefix(FieldIdpMetadataURL, v))
+}
+
+// IdpMetadataURLHasSuffix applies the HasSuffix predicate on the "idp_metadata_url" field.
+func IdpMetadataURLHasSuffix(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldHasSuffix(FieldIdpMetadataURL, v))
+}
+
+// IdpMetadataURLIsNil applies the IsNil predicate on the "idp_metadata_url" field.
+func IdpMetadataURLIsNil() predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldIsNull(FieldIdpMetadataURL))
+}
+
+// IdpMetadataURLNotNil applies the NotNil predicate on the "idp_metadata_url" field.
+func IdpMetadataURLNotNil() predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldNotNull(FieldIdpMetadataURL))
+}
+
+// IdpMetadataURLEqualFold applies the EqualFold predicate on the "idp_metadata_url" field.
+func IdpMetadataURLEqualFold(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldEqualFold(FieldIdpMetadataURL, v))
+}
+
+// IdpMetadataURLContainsFold applies the ContainsFold predicate on the "idp_metadata_url" field.
+func IdpMetadataURLContainsFold(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldContainsFold(FieldIdpMetadataURL, v))
+}
+
+// IdpMetadataXMLEQ applies the EQ predicate on the "idp_metadata_xml" field.
+func IdpMetadataXMLEQ(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldEQ(FieldIdpMetadataXML, v))
+}
+
+// IdpMetadataXMLNEQ applies the NEQ predicate on the "idp_metadata_xml" field.
+func IdpMetadataXMLNEQ(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldNEQ(FieldIdpMetadataXML, v))
+}
+
+// IdpMetadataXMLIn applies the In predicate on the "idp_metadata_xml" field.
+func IdpMetadataXMLIn(vs ...string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldIn(FieldIdpMetadataXML, vs...))
+}
+
+// IdpMetadataXMLNotIn applies the NotIn predicate on the "idp_metadata_xml" field.
+func IdpMetadataXMLNotIn(vs ...string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldNotIn(FieldIdpMetadataXML, vs...))
+}
+
+// IdpMetadataXMLGT applies the GT predicate on the "idp_metadata_xml" field.
+func IdpMetadataXMLGT(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldGT(FieldIdpMetadataXML, v))
+}
+
+// IdpMetadataXMLGTE applies the GTE predicate on the "idp_metadata_xml" field.
+func IdpMetadataXMLGTE(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldGTE(FieldIdpMetadataXML, v))
+}
+
+// IdpMetadataXMLLT applies the LT predicate on the "idp_metadata_xml" field.
+func IdpMetadataXMLLT(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldLT(FieldIdpMetadataXML, v))
+}
+
+// IdpMetadataXMLLTE applies the LTE predicate on the "idp_metadata_xml" field.
+func IdpMetadataXMLLTE(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldLTE(FieldIdpMetadataXML, v))
+}
+
+// IdpMetadataXMLContains applies the Contains predicate on the "idp_metadata_xml" field.
+func IdpMetadataXMLContains(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldContains(FieldIdpMetadataXML, v))
+}
+
+// IdpMetadataXMLHasPrefix applies the HasPrefix predicate on the "idp_metadata_xml" field.
+func IdpMetadataXMLHasPrefix(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldHasPrefix(FieldIdpMetadataXML, v))
+}
+
+// IdpMetadataXMLHasSuffix applies the HasSuffix predicate on the "idp_metadata_xml" field.
+func IdpMetadataXMLHasSuffix(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldHasSuffix(FieldIdpMetadataXML, v))
+}
+
+// IdpMetadataXMLIsNil applies the IsNil predicate on the "idp_metadata_xml" field.
+func IdpMetadataXMLIsNil() predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldIsNull(FieldIdpMetadataXML))
+}
+
+// IdpMetadataXMLNotNil applies the NotNil predicate on the "idp_metadata_xml" field.
+func IdpMetadataXMLNotNil() predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldNotNull(FieldIdpMetadataXML))
+}
+
+// IdpMetadataXMLEqualFold applies the EqualFold predicate on the "idp_metadata_xml" field.
+func IdpMetadataXMLEqualFold(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldEqualFold(FieldIdpMetadataXML, v))
+}
+
+// IdpMetadataXMLContainsFold applies the ContainsFold predicate on the "idp_metadata_xml" field.
+func IdpMetadataXMLContainsFold(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldContainsFold(FieldIdpMetadataXML, v))
+}
+
+// AttributeMappingIsNil applies the IsNil predicate on the "attribute_mapping" field.
+func AttributeMappingIsNil() predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldIsNull(FieldAttributeMapping))
+}
+
+// AttributeMappingNotNil applies the NotNil predicate on the "attribute_mapping" field.
+func AttributeMappingNotNil() predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldNotNull(FieldAttributeMapping))
+}
+
+// AllowIdpInitiatedEQ applies the EQ predicate on the "allow_idp_initiated" field.
+func AllowIdpInitiatedEQ(v bool) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldEQ(FieldAllowIdpInitiated, v))
+}
+
+// AllowIdpInitiatedNEQ applies the NEQ predicate on the "allow_idp_initiated" field.
+func AllowIdpInitiatedNEQ(v bool) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldNEQ(FieldAllowIdpInitiated, v))
+}
+
+// AutoProvisionEQ applies the EQ predicate on the "auto_provision" field.
+func AutoProvisionEQ(v bool) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldEQ(FieldAutoProvision, v))
+}
+
+// AutoProvisionNEQ applies the NEQ predicate on the "auto_provision" field.
+func AutoProvisionNEQ(v bool) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldNEQ(FieldAutoProvision, v))
+}
+
+// ProvisionApprovalEQ applies the EQ predicate on the "provision_approval" field.
+func ProvisionApprovalEQ(v bool) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldEQ(FieldProvisionApproval, v))
+}
+
+// ProvisionApprovalNEQ applies the NEQ predicate on the "provision_approval" field.
+func ProvisionApprovalNEQ(v bool) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldNEQ(FieldProvisionApproval, v))
+}
+
+// DefaultRoleIDEQ applies the EQ predicate on the "default_role_id" field.
+func DefaultRoleIDEQ(v uint64) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldEQ(FieldDefaultRoleID, v))
+}
+
+// DefaultRoleIDNEQ applies the NEQ predicate on the "default_role_id" field.
+func DefaultRoleIDNEQ(v uint64) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldNEQ(FieldDefaultRoleID, v))
+}
+
+// DefaultRoleIDIn applies the In predicate on the "default_role_id" field.
+func DefaultRoleIDIn(vs ...uint64) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldIn(FieldDefaultRoleID, vs...))
+}
+
+// DefaultRoleIDNotIn applies the NotIn predicate on the "default_role_id" field.
+func DefaultRoleIDNotIn(vs ...uint64) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldNotIn(FieldDefaultRoleID, vs...))
+}
+
+// DefaultRoleIDGT applies the GT predicate on the "default_role_id" field.
+func DefaultRoleIDGT(v uint64) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldGT(FieldDefaultRoleID, v))
+}
+
+// DefaultRoleIDGTE applies the GTE predicate on the "default_role_id" field.
+func DefaultRoleIDGTE(v uint64) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldGTE(FieldDefaultRoleID, v))
+}
+
+// DefaultRoleIDLT applies the LT predicate on the "default_role_id" field.
+func DefaultRoleIDLT(v uint64) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldLT(FieldDefaultRoleID, v))
+}
+
+// DefaultRoleIDLTE applies the LTE predicate on the "default_role_id" field.
+func DefaultRoleIDLTE(v uint64) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldLTE(FieldDefaultRoleID, v))
+}
+
+// DefaultRoleIDIsNil applies the IsNil predicate on the "default_role_id" field.
+func DefaultRoleIDIsNil() predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldIsNull(FieldDefaultRoleID))
+}
+
+// DefaultRoleIDNotNil applies the NotNil predicate on the "default_role_id" field.
+func DefaultRoleIDNotNil() predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldNotNull(FieldDefaultRoleID))
+}
+
+// RoleRulesIsNil applies the IsNil predicate on the "role_rules" field.
+func RoleRulesIsNil() predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldIsNull(FieldRoleRules))
+}
+
+// RoleRulesNotNil applies the NotNil predicate on the "role_rules" field.
+func RoleRulesNotNil() predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldNotNull(FieldRoleRules))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.SamlProvider) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.SamlProvider) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.SamlProvider) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.NotPredicates(p))
+}
@@ -0,0 +1,436 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"formulago/data/ent/samlprovider"
+	"formulago/pkg/rolerule"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SamlProviderCreate is the builder for creating a SamlProvider entity.
+type SamlProviderCreate struct {
+	config
+	mutation *SamlProviderMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *SamlProviderCreate) SetCreatedAt(v time.Time) *SamlProviderCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *SamlProviderCreate) SetNillableCreatedAt(v *time.Time) *SamlProviderCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *SamlProviderCreate) SetUpdatedAt(v time.Time) *SamlProviderCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *SamlProviderCreate) SetNillableUpdatedAt(v *time.Time) *SamlProviderCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *SamlProviderCreate) SetName(v string) *SamlProviderCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetEntityID sets the "entity_id" field.
+func (_c *SamlProviderCreate) SetEntityID(v string) *SamlProviderCreate {
+	_c.mutation.SetEntityID(v)
+	return _c
+}
+
+// SetNillableEntityID sets the "entity_id" field if the given value is not nil.
+func (_c *SamlProviderCreate) SetNillableEntityID(v *string) *SamlProviderCreate {
+	if v != nil {
+		_c.SetEntityID(*v)
+	}
+	return _c
+}
+
+// SetIdpMetadataURL sets the "idp_metadata_url" field.
+func (_c *SamlProviderCreate) SetIdpMetadataURL(v string) *SamlProviderCreate {
+	_c.mutation.SetIdpMetadataURL(v)
+	return _c
+}
+
+// SetNillableIdpMetadataURL sets the "idp_metadata_url" field if the given value is not nil.
+func (_c *SamlProviderCreate) SetNillableIdpMetadataURL(v *string) *SamlProviderCreate {
+	if v != nil {
+		_c.SetIdpMetadataURL(*v)
+	}
+	return _c
+}
+
+// SetIdpMetadataXML sets the "idp_metadata_xml" field.
+func (_c *SamlProviderCreate) SetIdpMetadataXML(v string) *SamlProviderCreate {
+	_c.mutation.SetIdpMetadataXML(v)
+	return _c
+}
+
+// SetNillableIdpMetadataXML sets the "idp_metadata_xml" field if the given value is not nil.
+func (_c *SamlProviderCreate) SetNillableIdpMetadataXML(v *string) *SamlProviderCreate {
+	if v != nil {
+		_c.SetIdpMetadataXML(*v)
+	}
+	return _c
+}
+
+// SetAttributeMapping sets the "attribute_mapping" field.
+func (_c *SamlProviderCreate) SetAttributeMapping(v map[string]string) *SamlProviderCreate {
+	_c.mutation.SetAttributeMapping(v)
+	return _c
+}
+
+// SetAllowIdpInitiated sets the "allow_idp_initiated" field.
+func (_c *SamlProviderCreate) SetAllowIdpInitiated(v bool) *SamlProviderCreate {
+	_c.mutation.SetAllowIdpInitiated(v)
+	return _c
+}
+
+// SetNillableAllowIdpInitiated sets the "allow_idp_initiated" field if the given value is not nil.
+func (_c *SamlProviderCreate) SetNillableAllowIdpInitiated(v *bool) *SamlProviderCreate {
+	if v != nil {
+		_c.SetAllowIdpInitiated(*v)
+	}
+	return _c
+}
+
+// SetAutoProvision sets the "auto_provision" field.
+func (_c *SamlProviderCreate) SetAutoProvision(v bool) *SamlProviderCreate {
+	_c.mutation.SetAutoProvision(v)
+	return _c
+}
+
+// SetNillableAutoProvision sets the "auto_provision" field if the given value is not nil.
+func (_c *SamlProviderCreate) SetNillableAutoProvision(v *bool) *SamlProviderCreate {
+	if v != nil {
+		_c.SetAutoProvision(*v)
+	}
+	return _c
+}
+
+// SetProvisionApproval sets the "provision_approval" field.
+func (_c *SamlProviderCreate) SetProvisionApproval(v bool) *SamlProviderCreate {
+	_c.mutation.SetProvisionApproval(v)
+	return _c
+}
+
+// SetNillableProvisionApproval sets the "provision_approval" field if the given value is not nil.
+func (_c *SamlProviderCreate) SetNillableProvisionApproval(v *bool) *SamlProviderCreate {
+	if v != nil {
+		_c.SetProvisionApproval(*v)
+	}
+	return _c
+}
+
+// SetDefaultRoleID sets the "default_role_id" field.
+func (_c *SamlProviderCreate) SetDefaultRoleID(v uint64) *SamlProviderCreate {
+	_c.mutation.SetDefaultRoleID(v)
+	return _c
+}
+
+// SetNillableDefaultRoleID sets the "default_role_id" field if the given value is not nil.
+func (_c *SamlProviderCreate) SetNillableDefaultRoleID(v *uint64) *SamlProviderCreate {
+	if v != nil {
+		_c.SetDefaultRoleID(*v)
+	}
+	return _c
+}
+
+// SetRoleRules sets the "role_rules" field.
+func (_c *SamlProviderCreate) SetRoleRules(v []rolerule.Rule) *SamlProviderCreate {
+	_c.mutation.SetRoleRules(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *SamlProviderCreate) SetID(v uint64) *SamlProviderCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the SamlProviderMutation object of the builder.
+func (_c *SamlProviderCreate) Mutation() *SamlProviderMutation {
+	return _c.mutation
+}
+
+// Save creates the SamlProvider in the database.
+func (_c *SamlProviderCreate) Save(ctx context.Context) (*SamlProvider, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *SamlProviderCreate) SaveX(ctx context.Context) *SamlProvider {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SamlProviderCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SamlProviderCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *SamlProviderCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := samlprovider.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := samlprovider.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.EntityID(); !ok {
+		v := samlprovider.DefaultEntityID
+		_c.mutation.SetEntityID(v)
+	}
+	if _, ok := _c.mutation.IdpMetadataURL(); !ok {
+		v := samlprovider.DefaultIdpMetadataURL
+		_c.mutation.SetIdpMetadataURL(v)
+	}
+	if _, ok := _c.mutation.IdpMetadataXML(); !ok {
+		v := samlprovider.DefaultIdpMetadataXML
+		_c.mutation.SetIdpMetadataXML(v)
+	}
+	if _, ok := _c.mutation.AllowIdpInitiated(); !ok {
+		v := samlprovider.DefaultAllowIdpInitiated
+		_c.mutation.SetAllowIdpInitiated(v)
+	}
+	if _, ok := _c.mutation.AutoProvision(); !ok {
+		v := samlprovider.DefaultAutoProvision
+		_c.mutation.SetAutoProvision(v)
+	}
+	if _, ok := _c.mutation.ProvisionApproval(); !ok {
+		v := samlprovider.DefaultProvisionApproval
+		_c.mutation.SetProvisionApproval(v)
+	}
+	if _, ok := _c.mutation.DefaultRoleID(); !ok {
+		v := samlprovider.DefaultDefaultRoleID
+		_c.mutation.SetDefaultRoleID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *SamlProviderCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "SamlProvider.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "SamlProvider.updated_at"`)}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "SamlProvider.name"`)}
+	}
+	if _, ok := _c.mutation.AllowIdpInitiated(); !ok {
+		return &ValidationError{Name: "allow_idp_initiated", err: errors.New(`ent: missing required field "SamlProvider.allow_idp_initiated"`)}
+	}
+	if _, ok := _c.mutation.AutoProvision(); !ok {
+		return &ValidationError{Name: "auto_provision", err: errors.New(`ent: missing required field "SamlProvider.auto_provision"`)}
+	}
+	if _, ok := _c.mutation.ProvisionApproval(); !ok {
+		return &ValidationError{Name: "provision_approval", err: errors.New(`ent: missing required field "SamlProvider.provision_approval"`)}
+	}
+	return nil
+}
+
+func (_c *SamlProviderCreate) sqlSave(ctx context.Context) (*SamlProvider, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint64(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *SamlProviderCreate) createSpec() (*SamlProvider, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SamlProvider{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(samlprovider.Table, sqlgraph.NewFieldSpec(samlprovider.FieldID, field.TypeUint64))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(samlprovider.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(samlprovider.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(samlprovider.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.EntityID(); ok {
+		_spec.SetField(samlprovider.FieldEntityID, field.TypeString, value)
+		_node.EntityID = value
+	}
+	if value, ok := _c.mutation.IdpMetadataURL(); ok {
+		_spec.SetField(samlprovider.FieldIdpMetadataURL, field.TypeString, value)
+		_node.IdpMetadataURL = value
+	}
+	if value, ok := _c.mutation.IdpMetadataXML(); ok {
+		_spec.SetField(samlprovider.FieldIdpMetadataXML, field.TypeString, value)
+		_node.IdpMetadataXML = value
+	}
+	if value, ok := _c.mutation.AttributeMapping(); ok {
+		_spec.SetField(samlprovider.FieldAttributeMapping, field.TypeJSON, value)
+		_node.AttributeMapping = value
+	}
+	if value, ok := _c.mutation.AllowIdpInitiated(); ok {
+		_spec.SetField(samlprovider.FieldAllowIdpInitiated, field.TypeBool, value)
+		_node.AllowIdpInitiated = value
+	}
+	if value, ok := _c.mutation.AutoProvision(); ok {
+		_spec.SetField(samlprovider.FieldAutoProvision, field.TypeBool, value)
+		_node.AutoProvision = value
+	}
+	if value, ok := _c.mutation.ProvisionApproval(); ok {
+		_spec.SetField(samlprovider.FieldProvisionApproval, field.TypeBool, value)
+		_node.ProvisionApproval = value
+	}
+	if value, ok := _c.mutation.DefaultRoleID(); ok {
+		_spec.SetField(samlprovider.FieldDefaultRoleID, field.TypeUint64, value)
+		_node.DefaultRoleID = value
+	}
+	if value, ok := _c.mutation.RoleRules(); ok {
+		_spec.SetField(samlprovider.FieldRoleRules, field.TypeJSON, value)
+		_node.RoleRules = value
+	}
+	return _node, _spec
+}
+
+// SamlProviderCreateBulk is the builder for creating many SamlProvider entities in bulk.
+type SamlProviderCreateBulk struct {
+	config
+	err      error
+	builders []*SamlProviderCreate
+}
+
+// Save creates the SamlProvider entities in the database.
+func (_c *SamlProviderCreateBulk) Save(ctx context.Context) ([]*SamlProvider, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*SamlProvider, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SamlProviderMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *SamlProviderCreateBulk) SaveX(ctx context.Context) []*SamlProvider {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SamlProviderCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SamlProviderCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,672 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"formulago/data/ent/predicate"
+	"formulago/data/ent/samlprovider"
+	"formulago/pkg/rolerule"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// SamlProviderUpdate is the builder for updating SamlProvider entities.
+type SamlProviderUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SamlProviderMutation
+}
+
+// Where appends a list predicates to the SamlProviderUpdate builder.
+func (_u *SamlProviderUpdate) Where(ps ...predicate.SamlProvider) *SamlProviderUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *SamlProviderUpdate) SetUpdatedAt(v time.Time) *SamlProviderUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *SamlProviderUpdate) SetName(v string) *SamlProviderUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *SamlProviderUpdate) SetNillableName(v *string) *SamlProviderUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetEntityID sets the "entity_id" field.
+func (_u *SamlProviderUpdate) SetEntityID(v string) *SamlProviderUpdate {
+	_u.mutation.SetEntityID(v)
+	return _u
+}
+
+// SetNillableEntityID sets the "entity_id" field if the given value is not nil.
+func (_u *SamlProviderUpdate) SetNillableEntityID(v *string) *SamlProviderUpdate {
+	if v != nil {
+		_u.SetEntityID(*v)
+	}
+	return _u
+}
+
+// ClearEntityID clears the value of the "entity_id" field.
+func (_u *SamlProviderUpdate) ClearEntityID() *SamlProviderUpdate {
+	_u.mutation.ClearEntityID()
+	return _u
+}
+
+// SetIdpMetadataURL sets the "idp_metadata_url" field.
+func (_u *SamlProviderUpdate) SetIdpMetadataURL(v string) *SamlProviderUpdate {
+	_u.mutation.SetIdpMetadataURL(v)
+	return _u
+}
+
+// SetNillableIdpMetadataURL sets the "idp_metadata_url" field if the given value is not nil.
+func (_u *SamlProviderUpdate) SetNillableIdpMetadataURL(v *string) *SamlProviderUpdate {
+	if v != nil {
+		_u.SetIdpMetadataURL(*v)
+	}
+	return _u
+}
+
+// ClearIdpMetadataURL clears the value of the "idp_metadata_url" field.
+func (_u *SamlProviderUpdate) ClearIdpMetadataURL() *SamlProviderUpdate {
+	_u.mutation.ClearIdpMetadataURL()
+	return _u
+}
+
+// SetIdpMetadataXML sets the "idp_metadata_xml" field.
+func (_u *SamlProviderUpdate) SetIdpMetadataXML(v string) *SamlProviderUpdate {
+	_u.mutation.SetIdpMetadataXML(v)
+	return _u
+}
+
+// SetNillableIdpMetadataXML sets the "idp_metadata_xml" field if the given value is not nil.
+func (_u *SamlProviderUpdate) SetNillableIdpMetadataXML(v *string) *SamlProviderUpdate {
+	if v != nil {
+		_u.SetIdpMetadataXML(*v)
+	}
+	return _u
+}
+
+// ClearIdpMetadataXML clears the value of the "idp_metadata_xml" field.
+func (_u *SamlProviderUpdate) ClearIdpMetadataXML() *SamlProviderUpdate {
+	_u.mutation.ClearIdpMetadataXML()
+	return _u
+}
+
+// SetAttributeMapping sets the "attribute_mapping" field.
+func (_u *SamlProviderUpdate) SetAttributeMapping(v map[string]string) *SamlProviderUpdate {
+	_u.mutation.SetAttributeMapping(v)
+	return _u
+}
+
+// ClearAttributeMapping clears the value of the "attribute_mapping" field.
+func (_u *SamlProviderUpdate) ClearAttributeMapping() *SamlProviderUpdate {
+	_u.mutation.ClearAttributeMapping()
+	return _u
+}
+
+// SetAllowIdpInitiated sets the "allow_idp_initiated" field.
+func (_u *SamlProviderUpdate) SetAllowIdpInitiated(v bool) *SamlProviderUpdate {
+	_u.mutation.SetAllowIdpInitiated(v)
+	return _u
+}
+
+// SetNillableAllowIdpInitiated sets the "allow_idp_initiated" field if the given value is not nil.
+func (_u *SamlProviderUpdate) SetNillableAllowIdpInitiated(v *bool) *SamlProviderUpdate {
+	if v != nil {
+		_u.SetAllowIdpInitiated(*v)
+	}
+	return _u
+}
+
+// SetAutoProvision sets the "auto_provision" field.
+func (_u *SamlProviderUpdate) SetAutoProvision(v bool) *SamlProviderUpdate {
+	_u.mutation.SetAutoProvision(v)
+	return _u
+}
+
+// SetNillableAutoProvision sets the "auto_provision" field if the given value is not nil.
+func (_u *SamlProviderUpdate) SetNillableAutoProvision(v *bool) *SamlProviderUpdate {
+	if v != nil {
+		_u.SetAutoProvision(*v)
+	}
+	return _u
+}
+
+// SetProvisionApproval sets the "provision_approval" field.
+func (_u *SamlProviderUpdate) SetProvisionApproval(v bool) *SamlProviderUpdate {
+	_u.mutation.SetProvisionApproval(v)
+	return _u
+}
+
+// SetNillableProvisionApproval sets the "provision_approval" field if the given value is not nil.
+func (_u *SamlProviderUpdate) SetNillableProvisionApproval(v *bool) *SamlProviderUpdate {
+	if v != nil {
+		_u.SetProvisionApproval(*v)
+	}
+	return _u
+}
+
+// SetDefaultRoleID sets the "default_role_id" field.
+func (_u *SamlProviderUpdate) SetDefaultRoleID(v uint64) *SamlProviderUpdate {
+	_u.mutation.ResetDefaultRoleID()
+	_u.mutation.SetDefaultRoleID(v)
+	return _u
+}
+
+// SetNillableDefaultRoleID sets the "default_role_id" field if the given value is not nil.
+func (_u *SamlProviderUpdate) SetNillableDefaultRoleID(v *uint64) *SamlProviderUpdate {
+	if v != nil {
+		_u.SetDefaultRoleID(*v)
+	}
+	return _u
+}
+
+// AddDefaultRoleID adds value to the "default_role_id" field.
+func (_u *SamlProviderUpdate) AddDefaultRoleID(v int64) *SamlProviderUpdate {
+	_u.mutation.AddDefaultRoleID(v)
+	return _u
+}
+
+// ClearDefaultRoleID clears the value of the "default_role_id" field.
+func (_u *SamlProviderUpdate) ClearDefaultRoleID() *SamlProviderUpdate {
+	_u.mutation.ClearDefaultRoleID()
+	return _u
+}
+
+// SetRoleRules sets the "role_rules" field.
+func (_u *SamlProviderUpdate) SetRoleRules(v []rolerule.Rule) *SamlProviderUpdate {
+	_u.mutation.SetRoleRules(v)
+	return _u
+}
+
+// AppendRoleRules appends value to the "role_rules" field.
+func (_u *SamlProviderUpdate) AppendRoleRules(v []rolerule.Rule) *SamlProviderUpdate {
+	_u.mutation.AppendRoleRules(v)
+	return _u
+}
+
+// ClearRoleRules clears the value of the "role_rules" field.
+func (_u *SamlProviderUpdate) ClearRoleRules() *SamlProviderUpdate {
+	_u.mutation.ClearRoleRules()
+	return _u
+}
+
+// Mutation returns the SamlProviderMutation object of the builder.
+func (_u *SamlProviderUpdate) Mutation() *SamlProviderMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *SamlProviderUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SamlProviderUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *SamlProviderUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SamlProviderUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *SamlProviderUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := samlprovider.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *SamlProviderUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(samlprovider.Table, samlprovider.Columns, sqlgraph.NewFieldSpec(samlprovider.FieldID, field.TypeUint64))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(samlprovider.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(samlprovider.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.EntityID(); ok {
+		_spec.SetField(samlprovider.FieldEntityID, field.TypeString, value)
+	}
+	if _u.mutation.EntityIDCleared() {
+		_spec.ClearField(samlprovider.FieldEntityID, field.TypeString)
+	}
+	if value, ok := _u.mutation.IdpMetadataURL(); ok {
+		_spec.SetField(samlprovider.FieldIdpMetadataURL, field.TypeString, value)
+	}
+	if _u.mutation.IdpMetadataURLCleared() {
+		_spec.ClearField(samlprovider.FieldIdpMetadataURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.IdpMetadataXML(); ok {
+		_spec.SetField(samlprovider.FieldIdpMetadataXML, field.TypeString, value)
+	}
+	if _u.mutation.IdpMetadataXMLCleared() {
+		_spec.ClearField(samlprovider.FieldIdpMetadataXML, field.TypeString)
+	}
+	if value, ok := _u.mutation.AttributeMapping(); ok {
+		_spec.SetField(samlprovider.FieldAttributeMapping, field.TypeJSON, value)
+	}
+	if _u.mutation.AttributeMappingCleared() {
+		_spec.ClearField(samlprovider.FieldAttributeMapping, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AllowIdpInitiated(); ok {
+		_spec.SetField(samlprovider.FieldAllowIdpInitiated, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.AutoProvision(); ok {
+		_spec.SetField(samlprovider.FieldAutoProvision, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ProvisionApproval(); ok {
+		_spec.SetField(samlprovider.FieldProvisionApproval, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.DefaultRoleID(); ok {
+		_spec.SetField(samlprovider.FieldDefaultRoleID, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedDefaultRoleID(); ok {
+		_spec.AddField(samlprovider.FieldDefaultRoleID, field.TypeUint64, value)
+	}
+	if _u.mutation.DefaultRoleIDCleared() {
+		_spec.ClearField(samlprovider.FieldDefaultRoleID, field.TypeUint64)
+	}
+	if value, ok := _u.mutation.RoleRules(); ok {
+		_spec.SetField(samlprovider.FieldRoleRules, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedRoleRules(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, samlprovider.FieldRoleRules, value)
+		})
+	}
+	if _u.mutation.RoleRulesCleared() {
+		_spec.ClearField(samlprovider.FieldRoleRules, field.TypeJSON)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{samlprovider.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// SamlProviderUpdateOne is the builder for updating a single SamlProvider entity.
+type SamlProviderUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SamlProviderMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *SamlProviderUpdateOne) SetUpdatedAt(v time.Time) *SamlProviderUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *SamlProviderUpdateOne) SetName(v string) *SamlProviderUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *SamlProviderUpdateOne) SetNillableName(v *string) *SamlProviderUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetEntityID sets the "entity_id" field.
+func (_u *SamlProviderUpdateOne) SetEntityID(v string) *SamlProviderUpdateOne {
+	_u.mutation.SetEntityID(v)
+	return _u
+}
+
+// SetNillableEntityID sets the "entity_id" field if the given value is not nil.
+func (_u *SamlProviderUpdateOne) SetNillableEntityID(v *string) *SamlProviderUpdateOne {
+	if v != nil {
+		_u.SetEntityID(*v)
+	}
+	return _u
+}
+
+// ClearEntityID clears the value of the "entity_id" field.
+func (_u *SamlProviderUpdateOne) ClearEntityID() *SamlProviderUpdateOne {
+	_u.mutation.ClearEntityID()
+	return _u
+}
+
+// SetIdpMetadataURL sets the "idp_metadata_url" field.
+func (_u *SamlProviderUpdateOne) SetIdpMetadataURL(v string) *SamlProviderUpdateOne {
+	_u.mutation.SetIdpMetadataURL(v)
+	return _u
+}
+
+// SetNillableIdpMetadataURL sets the "idp_metadata_url" field if the given value is not nil.
+func (_u *SamlProviderUpdateOne) SetNillableIdpMetadataURL(v *string) *SamlProviderUpdateOne {
+	if v != nil {
+		_u.SetIdpMetadataURL(*v)
+	}
+	return _u
+}
+
+// ClearIdpMetadataURL clears the value of the "idp_metadata_url" field.
+func (_u *SamlProviderUpdateOne) ClearIdpMetadataURL() *SamlProviderUpdateOne {
+	_u.mutation.ClearIdpMetadataURL()
+	return _u
+}
+
+// SetIdpMetadataXML sets the "idp_metadata_xml" field.
+func (_u *SamlProviderUpdateOne) SetIdpMetadataXML(v string) *SamlProviderUpdateOne {
+	_u.mutation.SetIdpMetadataXML(v)
+	return _u
+}
+
+// SetNillableIdpMetadataXML sets the "idp_metadata_xml" field if the given value is not nil.
+func (_u *SamlProviderUpdateOne) SetNillableIdpMetadataXML(v *string) *SamlProviderUpdateOne {
+	if v != nil {
+		_u.SetIdpMetadataXML(*v)
+	}
+	return _u
+}
+
+// ClearIdpMetadataXML clears the value of the "idp_metadata_xml" field.
+func (_u *SamlProviderUpdateOne) ClearIdpMetadataXML() *SamlProviderUpdateOne {
+	_u.mutation.ClearIdpMetadataXML()
+	return _u
+}
+
+// SetAttributeMapping sets the "attribute_mapping" field.
+func (_u *SamlProviderUpdateOne) SetAttributeMapping(v map[string]string) *SamlProviderUpdateOne {
+	_u.mutation.SetAttributeMapping(v)
+	return _u
+}
+
+// ClearAttributeMapping clears the value of the "attribute_mapping" field.
+func (_u *SamlProviderUpdateOne) ClearAttributeMapping() *SamlProviderUpdateOne {
+	_u.mutation.ClearAttributeMapping()
+	return _u
+}
+
+// SetAllowIdpInitiated sets the "allow_idp_initiated" field.
+func (_u *SamlProviderUpdateOne) SetAllowIdpInitiated(v bool) *SamlProviderUpdateOne {
+	_u.mutation.SetAllowIdpInitiated(v)
+	return _u
+}
+
+// SetNillableAllowIdpInitiated sets the "allow_idp_initiated" field if the given value is not nil.
+func (_u *SamlProviderUpdateOne) SetNillableAllowIdpInitiated(v *bool) *SamlProviderUpdateOne {
+	if v != nil {
+		_u.SetAllowIdpInitiated(*v)
+	}
+	return _u
+}
+
+// SetAutoProvision sets the "auto_provision" field.
+func (_u *SamlProviderUpdateOne) SetAutoProvision(v bool) *SamlProviderUpdateOne {
+	_u.mutation.SetAutoProvision(v)
+	return _u
+}
+
+// SetNillableAutoProvision sets the "auto_provision" field if the given value is not nil.
+func (_u *SamlProviderUpdateOne) SetNillableAutoProvision(v *bool) *SamlProviderUpdateOne {
+	if v != nil {
+		_u.SetAutoProvision(*v)
+	}
+	return _u
+}
+
+// SetProvisionApproval sets the "provision_approval" field.
+func (_u *SamlProviderUpdateOne) SetProvisionApproval(v bool) *SamlProviderUpdateOne {
+	_u.mutation.SetProvisionApproval(v)
+	return _u
+}
+
+// SetNillableProvisionApproval sets the "provision_approval" field if the given value is not nil.
+func (_u *SamlProviderUpdateOne) SetNillableProvisionApproval(v *bool) *SamlProviderUpdateOne {
+	if v != nil {
+		_u.SetProvisionApproval(*v)
+	}
+	return _u
+}
+
+// SetDefaultRoleID sets the "default_role_id" field.
+func (_u *SamlProviderUpdateOne) SetDefaultRoleID(v uint64) *SamlProviderUpdateOne {
+	_u.mutation.ResetDefaultRoleID()
+	_u.mutation.SetDefaultRoleID(v)
+	return _u
+}
+
+// SetNillableDefaultRoleID sets the "default_role_id" field if the given value is not nil.
+func (_u *SamlProviderUpdateOne) SetNillableDefaultRoleID(v *uint64) *SamlProviderUpdateOne {
+	if v != nil {
+		_u.SetDefaultRoleID(*v)
+	}
+	return _u
+}
+
+// AddDefaultRoleID adds value to the "default_role_id" field.
+func (_u *SamlProviderUpdateOne) AddDefaultRoleID(v int64) *SamlProviderUpdateOne {
+	_u.mutation.AddDefaultRoleID(v)
+	return _u
+}
+
+// ClearDefaultRoleID clears the value of the "default_role_id" field.
+func (_u *SamlProviderUpdateOne) ClearDefaultRoleID() *SamlProviderUpdateOne {
+	_u.mutation.ClearDefaultRoleID()
+	return _u
+}
+
+// SetRoleRules sets the "role_rules" field.
+func (_u *SamlProviderUpdateOne) SetRoleRules(v []rolerule.Rule) *SamlProviderUpdateOne {
+	_u.mutation.SetRoleRules(v)
+	return _u
+}
+
+// AppendRoleRules appends value to the "role_rules" field.
+func (_u *SamlProviderUpdateOne) AppendRoleRules(v []rolerule.Rule) *SamlProviderUpdateOne {
+	_u.mutation.AppendRoleRules(v)
+	return _u
+}
+
+// ClearRoleRules clears the value of the "role_rules" field.
+func (_u *SamlProviderUpdateOne) ClearRoleRules() *SamlProviderUpdateOne {
+	_u.mutation.ClearRoleRules()
+	return _u
+}
+
+// Mutation returns the SamlProviderMutation object of the builder.
+func (_u *SamlProviderUpdateOne) Mutation() *SamlProviderMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the SamlProviderUpdate builder.
+func (_u *SamlProviderUpdateOne) Where(ps ...predicate.SamlProvider) *SamlProviderUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *SamlProviderUpdateOne) Select(field string, fields ...string) *SamlProviderUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated SamlProvider entity.
+func (_u *SamlProviderUpdateOne) Save(ctx context.Context) (*SamlProvider, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SamlProviderUpdateOne) SaveX(ctx context.Context) *SamlProvider {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *SamlProviderUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SamlProviderUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *SamlProviderUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := samlprovider.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *SamlProviderUpdateOne) sqlSave(ctx context.Context) (_node *SamlProvider, err error) {
+	_spec := sqlgraph.NewUpdateSpec(samlprovider.Table, samlprovider.Columns, sqlgraph.NewFieldSpec(samlprovider.FieldID, field.TypeUint64))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SamlProvider.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, samlprovider.FieldID)
+		for _, f := range fields {
+			if !samlprovider.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != samlprovider.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(samlprovider.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(samlprovider.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.EntityID(); ok {
+		_spec.SetField(samlprovider.FieldEntityID, field.TypeString, value)
+	}
+	if _u.mutation.EntityIDCleared() {
+		_spec.ClearField(samlprovider.FieldEntityID, field.TypeString)
+	}
+	if value, ok := _u.mutation.IdpMetadataURL(); ok {
+		_spec.SetField(samlprovider.FieldIdpMetadataURL, field.TypeString, value)
+	}
+	if _u.mutation.IdpMetadataURLCleared() {
+		_spec.ClearField(samlprovider.FieldIdpMetadataURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.IdpMetadataXML(); ok {
+		_spec.SetField(samlprovider.FieldIdpMetadataXML, field.TypeString, value)
+	}
+	if _u.mutation.IdpMetadataXMLCleared() {
+		_spec.ClearField(samlprovider.FieldIdpMetadataXML, field.TypeString)
+	}
+	if value, ok := _u.mutation.AttributeMapping(); ok {
+		_spec.SetField(samlprovider.FieldAttributeMapping, field.TypeJSON, value)
+	}
+	if _u.mutation.AttributeMappingCleared() {
+		_spec.ClearField(samlprovider.FieldAttributeMapping, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AllowIdpInitiated(); ok {
+		_spec.SetField(samlprovider.FieldAllowIdpInitiated, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.AutoProvision(); ok {
+		_spec.SetField(samlprovider.FieldAutoProvision, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ProvisionApproval(); ok {
+		_spec.SetField(samlprovider.FieldProvisionApproval, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.DefaultRoleID(); ok {
+		_spec.SetField(samlprovider.FieldDefaultRoleID, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedDefaultRoleID(); ok {
+		_spec.AddField(samlprovider.FieldDefaultRoleID, field.TypeUint64, value)
+	}
+	if _u.mutation.DefaultRoleIDCleared() {
+		_spec.ClearField(samlprovider.FieldDefaultRoleID, field.TypeUint64)
+	}
+	if value, ok := _u.mutation.RoleRules(); ok {
+		_spec.SetField(samlprovider.FieldRoleRules, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedRoleRules(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, samlprovider.FieldRoleRules, value)
+		})
+	}
+	if _u.mutation.RoleRulesCleared() {
+		_spec.ClearField(samlprovider.FieldRoleRules, field.TypeJSON)
+	}
+	_node = &SamlProvider{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{samlprovider.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,61 @@
+/*
+ * Copyright 2023 FormulaGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ */
+
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"formulago/data/ent/schema/mixins"
+)
+
+type APIKey struct {
+	ent.Schema
+}
+
+func (APIKey) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint64("user_id").Comment("owner's ID | 所属用户ID"),
+		field.String("name").Comment("key name | 密钥名称"),
+		field.String("prefix").Comment("first characters of the key to recognize it | 密钥前缀, 用于识别"),
+		field.String("key_hash").Unique().Sensitive().Comment("sha256 of the key | 密钥的sha256摘要"),
+		field.Strings("scopes").Comment("allowed casbin policies, each is METHOD and path separated by a space | 允许的casbin策略, 每项为以空格分隔的METHOD与path"),
+		field.Time("expired_at").Comment("expire time | 过期时间"),
+		field.Time("last_used_at").Optional().Nillable().Comment("last used time | 最近使用时间"),
+		field.String("last_used_ip").Optional().Default("").Comment("last used IP | 最近使用IP"),
+		field.Time("revoked_at").Optional().Nillable().Comment("revoked time | 吊销时间"),
+	}
+}
+
+func (APIKey) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.BaseMixin{},
+	}
+}
+
+func (APIKey) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("owner", User.Type).Ref("api_keys").Field("user_id").Unique().Required(),
+	}
+}
+
+func (APIKey) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id"),
+	}
+}
+
+func (APIKey) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "sys_api_keys"},
+		entsql.WithComments(true),
+	}
+}
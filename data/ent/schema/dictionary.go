@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 
 	"formulago/data/ent/schema/mixins"
 )
@@ -23,7 +24,7 @@ type Dictionary struct {
 func (Dictionary) Fields() []ent.Field {
 	return []ent.Field{
 		field.String("title").Comment("the title shown in the ui | 展示名称 （建议配合i18n）"),
-		field.String("name").Unique().Comment("the name of dictionary for search | 字典搜索名称"),
+		field.String("name").Comment("the name of dictionary for search, unique in the tenant | 字典搜索名称, 租户内唯一"),
 		field.String("description").Comment("the description of dictionary | 字典描述"),
 	}
 }
@@ -32,6 +33,7 @@ func (Dictionary) Mixin() []ent.Mixin {
 	return []ent.Mixin{
 		mixins.BaseMixin{},
 		mixins.StatusMixin{},
+		mixins.TenantMixin{},
 	}
 }
 
@@ -41,6 +43,12 @@ func (Dictionary) Edges() []ent.Edge {
 	}
 }
 
+func (Dictionary) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "name").Unique(),
+	}
+}
+
 func (Dictionary) Annotations() []schema.Annotation {
 	return []schema.Annotation{
 		entsql.Annotation{Table: "sys_dictionaries"},
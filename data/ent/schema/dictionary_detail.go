@@ -34,6 +34,7 @@ func (DictionaryDetail) Mixin() []ent.Mixin {
 	return []ent.Mixin{
 		mixins.BaseMixin{},
 		mixins.StatusMixin{},
+		mixins.TenantMixin{},
 	}
 }
 
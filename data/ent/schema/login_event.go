@@ -0,0 +1,70 @@
+/*
+ * Copyright 2023 FormulaGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ */
+
+package schema
+
+import (
+	"formulago/data/ent/schema/mixins"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// LoginEvent holds the schema definition for the LoginEvent entity, one login attempt.
+type LoginEvent struct {
+	ent.Schema
+}
+
+// Fields of the LoginEvent.
+func (LoginEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint64("user_id").Optional().Default(0).Comment("ID of the user, 0 if the user is unknown | 用户ID, 未知用户为0"),
+		field.String("username").Optional().Default("").Comment("username of the attempt | 尝试登录的用户名"),
+		field.String("method").Comment("login method such as password, webauthn, api_key or the OAuth provider | 登录方式, 如password, webauthn, api_key或第三方平台"),
+		field.String("ip").Optional().Default("").Comment("client IP | 登录IP"),
+		field.String("user_agent").
+			SchemaType(map[string]string{dialect.MySQL: "varchar(512)"}).
+			Optional().
+			Default("").
+			Comment("client user agent | 客户端UA"),
+		field.String("device").Optional().Default("").Comment("device label | 登录设备"),
+		field.Bool("success").Comment("whether the login succeeded | 是否登录成功"),
+		field.String("reason").Optional().Default("").Comment("failure reason | 失败原因"),
+		field.Strings("flags").Optional().Comment("anomalies: new_ip, new_device or many_failures | 异常标记: 新IP, 新设备或多次失败"),
+		field.Bool("anomalous").Default(false).Comment("whether any anomaly is flagged | 是否存在异常"),
+	}
+}
+
+func (LoginEvent) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.BaseMixin{},
+		mixins.TenantMixin{},
+	}
+}
+
+// Edges of the LoginEvent.
+func (LoginEvent) Edges() []ent.Edge {
+	return nil
+}
+
+func (LoginEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "created_at"),
+		index.Fields("username", "created_at"),
+		index.Fields("ip", "created_at"),
+	}
+}
+
+func (LoginEvent) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "sys_login_events"},
+		entsql.WithComments(true),
+	}
+}
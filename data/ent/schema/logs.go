@@ -36,6 +36,7 @@ func (Logs) Fields() []ent.Field {
 		field.String("ip").Optional().Comment("ip of log | 日志IP"),
 		field.String("user_agent").Optional().Comment("user_agent of log | 日志用户客户端"),
 		field.String("operator").Optional().Comment("operator of log | 日志操作者"),
+		field.String("impersonated_user").Optional().Comment("user impersonated by the operator | 操作者模拟登录的用户"),
 		field.Int("time").Optional().Comment("time of log(millisecond) | 日志时间(毫秒)"),
 	}
 }
@@ -43,6 +44,7 @@ func (Logs) Fields() []ent.Field {
 func (Logs) Mixin() []ent.Mixin {
 	return []ent.Mixin{
 		mixins.BaseMixin{},
+		mixins.TenantMixin{},
 	}
 }
 
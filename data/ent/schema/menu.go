@@ -51,6 +51,7 @@ func (Menu) Fields() []ent.Field {
 func (Menu) Mixin() []ent.Mixin {
 	return []ent.Mixin{
 		mixins.BaseMixin{},
+		mixins.TenantMixin{},
 	}
 }
 
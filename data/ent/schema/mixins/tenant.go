@@ -0,0 +1,84 @@
+/*
+ * Copyright 2023 FormulaGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ */
+
+package mixins
+
+import (
+	"context"
+	"fmt"
+
+	"formulago/data/ent/intercept"
+	"formulago/pkg/tenant"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+)
+
+// TenantMixin implements the ent.Mixin for the entities owned by a tenant.
+// Within a context scoped by tenant.NewContext the queries, updates and deletes only touch the rows
+// of the tenant and the created rows belong to it, other contexts are not scoped.
+type TenantMixin struct {
+	mixin.Schema
+}
+
+// whereP is implemented by the generated mutations
+type whereP interface {
+	WhereP(...func(*sql.Selector))
+}
+
+func (TenantMixin) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint64("tenant_id").
+			Default(tenant.DefaultID).
+			Immutable().
+			Comment("tenant ID | 租户ID"),
+	}
+}
+
+func (TenantMixin) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id"),
+	}
+}
+
+func (TenantMixin) Interceptors() []ent.Interceptor {
+	return []ent.Interceptor{
+		intercept.TraverseFunc(func(ctx context.Context, q intercept.Query) error {
+			if id, ok := tenant.FromContext(ctx); ok {
+				q.WhereP(sql.FieldEQ("tenant_id", id))
+			}
+			return nil
+		}),
+	}
+}
+
+func (TenantMixin) Hooks() []ent.Hook {
+	return []ent.Hook{
+		func(next ent.Mutator) ent.Mutator {
+			return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+				id, ok := tenant.FromContext(ctx)
+				if !ok {
+					return next.Mutate(ctx, m)
+				}
+				if m.Op().Is(ent.OpCreate) {
+					if err := m.SetField("tenant_id", id); err != nil {
+						return nil, err
+					}
+					return next.Mutate(ctx, m)
+				}
+				w, ok := m.(whereP)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				w.WhereP(sql.FieldEQ("tenant_id", id))
+				return next.Mutate(ctx, m)
+			})
+		},
+	}
+}
@@ -0,0 +1,94 @@
+/*
+ * Copyright 2023 FormulaGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ */
+
+package mixins_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"formulago/data/ent"
+	"formulago/data/ent/enttest"
+	"formulago/data/ent/role"
+	"formulago/pkg/tenant"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestTenantMixin(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:tenant_mixin?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	background := context.Background()
+	for _, name := range []string{"default", "other"} {
+		if err := client.Tenant.Create().SetName(name).Exec(background); err != nil {
+			t.Fatal(err)
+		}
+	}
+	defaultCtx := tenant.NewContext(background, 1)
+	otherCtx := tenant.NewContext(background, 2)
+
+	// the created rows belong to the tenant of the context, whatever tenant is set
+	own, err := client.Role.Create().SetName("admin").SetValue("admin").Save(defaultCtx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	foreign, err := client.Role.Create().SetName("admin").SetValue("admin").SetTenantID(1).Save(otherCtx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if own.TenantID != 1 || foreign.TenantID != 2 {
+		t.Fatalf("created roles of tenants %d and %d, want 1 and 2", own.TenantID, foreign.TenantID)
+	}
+
+	tests := []struct {
+		name string
+		ctx  context.Context
+		want []uint64
+	}{
+		{name: "default tenant", ctx: defaultCtx, want: []uint64{own.ID}},
+		{name: "other tenant", ctx: otherCtx, want: []uint64{foreign.ID}},
+		{name: "not scoped", ctx: background, want: []uint64{own.ID, foreign.ID}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ids, err := client.Role.Query().Order(role.ByID()).IDs(tt.ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !slices.Equal(ids, tt.want) {
+				t.Errorf("Query() got roles %v, want %v", ids, tt.want)
+			}
+		})
+	}
+
+	// the rows of another tenant are neither read nor touched
+	if _, err = client.Role.Get(otherCtx, own.ID); !ent.IsNotFound(err) {
+		t.Errorf("Get() of a role of another tenant error = %v, want not found", err)
+	}
+	exist, err := client.Role.Query().Where(role.ID(own.ID)).Exist(otherCtx)
+	if err != nil || exist {
+		t.Errorf("Exist() of a role of another tenant = %v, %v, want false", exist, err)
+	}
+	n, err := client.Role.Update().Where(role.ID(own.ID)).SetRemark("changed").Save(otherCtx)
+	if err != nil || n != 0 {
+		t.Errorf("Update() of a role of another tenant = %d, %v, want 0", n, err)
+	}
+	if err = client.Role.UpdateOneID(own.ID).SetRemark("changed").Exec(otherCtx); !ent.IsNotFound(err) {
+		t.Errorf("UpdateOneID() of a role of another tenant error = %v, want not found", err)
+	}
+	n, err = client.Role.Delete().Where(role.ID(own.ID)).Exec(otherCtx)
+	if err != nil || n != 0 {
+		t.Errorf("Delete() of a role of another tenant = %d, %v, want 0", n, err)
+	}
+	unchanged, err := client.Role.Get(defaultCtx, own.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if unchanged.Remark != "" {
+		t.Errorf("the role of another tenant is changed, remark %q", unchanged.Remark)
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"entgo.io/ent/schema/field"
 
 	"formulago/data/ent/schema/mixins"
+	"formulago/pkg/rolerule"
 )
 
 type OauthProvider struct {
@@ -27,10 +28,16 @@ func (OauthProvider) Fields() []ent.Field {
 		field.String("client_secret").Optional().Comment("the client secret | 客户端密钥"),
 		field.String("redirect_url").Comment("the redirect url | 跳转地址"),
 		field.String("scopes").Optional().Comment("the scopes | 权限范围"),
-		field.String("auth_url").Comment("the auth url of the provider | 认证地址"),
+		field.String("auth_url").Optional().Comment("the auth url of the provider, discovered if discovery_url is set | 认证地址, 设置discovery_url时自动发现"),
 		field.String("token_url").Optional().Comment("the token url of the provider | 获取 token地址"),
 		field.Uint64("auth_style").Optional().Comment("the auth style, 0: auto detect; 1: third party login; 2: login with username and password"),
 		field.String("info_url").Optional().Comment("the URL to request user information by token | 用户信息请求地址"),
+		field.String("discovery_url").Optional().Default("").Comment("the OIDC issuer, endpoints are read from its openid-configuration and the id_token is verified | OIDC签发者地址, 从openid-configuration读取端点并校验id_token"),
+		field.JSON("claim_mapping", map[string]string{}).Optional().Comment("JSON paths of the user info fields in the user info response or id_token, keyed by subject, credential, username, email, email_verified, mobile, nickname, avatar and groups | 用户信息字段在用户信息响应或id_token中的JSON路径"),
+		field.Bool("auto_provision").Default(false).Comment("create the user on the first login of an account not linked yet | 未关联账号首次登录时自动创建用户"),
+		field.Bool("provision_approval").Default(false).Comment("the created users wait for the approval of an admin | 自动创建的用户需管理员审批"),
+		field.Uint64("default_role_id").Optional().Default(0).Comment("the role of the created users no rule matches, 0 rejects them | 未匹配规则的自动创建用户角色, 0为拒绝"),
+		field.JSON("role_rules", []rolerule.Rule{}).Optional().Comment("the rules deciding the role of the created users, the first match wins | 决定自动创建用户角色的规则, 按顺序首个匹配生效"),
 	}
 }
 
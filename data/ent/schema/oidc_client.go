@@ -0,0 +1,51 @@
+/*
+ * Copyright 2023 FormulaGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ */
+
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+
+	"formulago/data/ent/schema/mixins"
+)
+
+type OidcClient struct {
+	ent.Schema
+}
+
+func (OidcClient) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("client_id").Unique().Comment("the client id | 客户端 id"),
+		field.String("client_secret").Optional().Default("").Sensitive().Comment("bcrypt of the client secret, empty for public clients | 客户端密钥的bcrypt摘要, 公共客户端为空"),
+		field.String("name").Comment("the client name shown on the consent page | 客户端名称, 显示在授权页面"),
+		field.Strings("redirect_uris").Optional().Comment("the registered redirect URIs | 已登记的回调地址"),
+		field.Strings("grant_types").Comment("allowed grant types | 允许的授权类型"),
+		field.Strings("scopes").Comment("allowed scopes | 允许的权限范围"),
+		field.Bool("public").Default(false).Comment("public client without secret, PKCE only | 无密钥的公共客户端, 仅支持PKCE"),
+		field.Bool("skip_consent").Default(false).Comment("trusted first party client, consent is not asked | 受信任的内部客户端, 不询问用户授权"),
+	}
+}
+
+func (OidcClient) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.BaseMixin{},
+		mixins.StatusMixin{},
+	}
+}
+
+func (OidcClient) Edges() []ent.Edge {
+	return nil
+}
+
+func (OidcClient) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "sys_oidc_clients"},
+		entsql.WithComments(true),
+	}
+}
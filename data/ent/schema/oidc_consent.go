@@ -0,0 +1,52 @@
+/*
+ * Copyright 2023 FormulaGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ */
+
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"formulago/data/ent/schema/mixins"
+)
+
+type OidcConsent struct {
+	ent.Schema
+}
+
+func (OidcConsent) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint64("user_id").Comment("user's ID | 用户ID"),
+		field.String("client_id").Comment("the client id | 客户端 id"),
+		field.Strings("scopes").Comment("granted scopes | 已授权的权限范围"),
+	}
+}
+
+func (OidcConsent) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.BaseMixin{},
+	}
+}
+
+func (OidcConsent) Edges() []ent.Edge {
+	return nil
+}
+
+func (OidcConsent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "client_id").Unique(),
+	}
+}
+
+func (OidcConsent) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "sys_oidc_consents"},
+		entsql.WithComments(true),
+	}
+}
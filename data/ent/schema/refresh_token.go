@@ -0,0 +1,57 @@
+/*
+ * Copyright 2023 FormulaGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ */
+
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"formulago/data/ent/schema/mixins"
+)
+
+// RefreshToken holds the opaque refresh tokens, all tokens rotated from one login share the same family (the session jti)
+type RefreshToken struct {
+	ent.Schema
+}
+
+func (RefreshToken) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint64("user_id").Comment("User's ID | 用户的ID"),
+		field.String("token_hash").Unique().Comment("sha256 of the refresh token | 刷新令牌的sha256摘要"),
+		field.String("family").Comment("token family, the jti of the session | 令牌族, 即会话的jti"),
+		field.Time("expired_at").Comment("Expire time | 过期时间"),
+		field.Time("used_at").Optional().Nillable().Comment("rotated time | 已使用(轮换)时间"),
+		field.Time("revoked_at").Optional().Nillable().Comment("revoked time | 吊销时间"),
+	}
+}
+
+func (RefreshToken) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.BaseMixin{},
+	}
+}
+
+func (RefreshToken) Edges() []ent.Edge {
+	return nil
+}
+
+func (RefreshToken) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("family"),
+		index.Fields("user_id"),
+	}
+}
+
+func (RefreshToken) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "sys_refresh_tokens"},
+		entsql.WithComments(true),
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 
 	"formulago/data/ent/schema/mixins"
 )
@@ -23,10 +24,12 @@ type Role struct {
 func (Role) Fields() []ent.Field {
 	return []ent.Field{
 		field.String("name").Comment("role name | 角色名"),
-		field.String("value").Unique().Comment("role value for permission control in front end | 角色值，用于前端权限控制"),
+		field.String("value").Comment("role value for permission control in front end, unique in the tenant | 角色值，用于前端权限控制, 租户内唯一"),
 		field.String("default_router").Default("/dashboard").Comment("default menu : dashboard | 默认登录页面"),
 		field.String("remark").Default("").Comment("remark | 备注"),
 		field.Uint32("order_no").Default(0).Comment("order number | 排序编号"),
+		field.Bool("force_two_factor").Default(false).Comment("force two-factor authentication for the role | 强制该角色启用双因素认证"),
+		field.Uint64("parent_id").Optional().Comment("parent role ID, the role inherits the api and menu permissions of the parent | 父角色ID, 继承父角色的接口与菜单权限"),
 	}
 }
 
@@ -34,12 +37,21 @@ func (Role) Mixin() []ent.Mixin {
 	return []ent.Mixin{
 		mixins.BaseMixin{},
 		mixins.StatusMixin{},
+		mixins.TenantMixin{},
 	}
 }
 
 func (Role) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("menus", Menu.Type),
+		edge.From("users", User.Type).Ref("roles"),
+		edge.To("children", Role.Type).From("parent").Unique().Field("parent_id"),
+	}
+}
+
+func (Role) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "value").Unique(),
 	}
 }
 
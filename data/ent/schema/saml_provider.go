@@ -0,0 +1,53 @@
+/*
+ * Copyright 2023 FormulaGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ */
+
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+
+	"formulago/data/ent/schema/mixins"
+	"formulago/pkg/rolerule"
+)
+
+type SamlProvider struct {
+	ent.Schema
+}
+
+func (SamlProvider) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").Unique().Comment("the IdP's name, part of the metadata and ACS URLs | 身份提供商名称"),
+		field.String("entity_id").Optional().Default("").Comment("the entity id of the service provider registered at the IdP, the metadata URL if empty | 服务提供商实体ID, 为空时使用元数据地址"),
+		field.String("idp_metadata_url").Optional().Default("").Comment("the URL of the IdP metadata, reloaded with the provider | 身份提供商元数据地址"),
+		field.Text("idp_metadata_xml").Optional().Default("").Comment("the IdP metadata, used if idp_metadata_url is empty | 身份提供商元数据XML, 未设置元数据地址时使用"),
+		field.JSON("attribute_mapping", map[string]string{}).Optional().Comment("the attribute names of the user info fields, keyed by subject, username, email, email_verified, mobile, nickname, avatar and groups, the subject is the NameID if empty | 用户信息字段对应的属性名"),
+		field.Bool("allow_idp_initiated").Default(false).Comment("accept the logins started at the IdP | 允许身份提供商发起的登录"),
+		field.Bool("auto_provision").Default(false).Comment("create the user on the first login of an account not linked yet | 未关联账号首次登录时自动创建用户"),
+		field.Bool("provision_approval").Default(false).Comment("the created users wait for the approval of an admin | 自动创建的用户需管理员审批"),
+		field.Uint64("default_role_id").Optional().Default(0).Comment("the role of the created users no rule matches, 0 rejects them | 未匹配规则的自动创建用户角色, 0为拒绝"),
+		field.JSON("role_rules", []rolerule.Rule{}).Optional().Comment("the rules deciding the role of the created users, the first match wins | 决定自动创建用户角色的规则, 按顺序首个匹配生效"),
+	}
+}
+
+func (SamlProvider) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.BaseMixin{},
+	}
+}
+
+func (SamlProvider) Edges() []ent.Edge {
+	return nil
+}
+
+func (SamlProvider) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "sys_saml_providers"},
+		entsql.WithComments(true),
+	}
+}
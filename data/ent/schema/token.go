@@ -8,6 +8,7 @@ package schema
 
 import (
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
@@ -24,10 +25,22 @@ type Token struct {
 func (Token) Fields() []ent.Field {
 	return []ent.Field{
 		field.Uint64("id"),
-		field.Uint64("user_id").Comment(" User's ID | 用户的ID").Unique(),
+		field.Uint64("user_id").Comment(" User's ID | 用户的ID"),
+		field.String("jti").Unique().Comment("JWT ID of the session | 会话对应的JWT ID"),
 		field.String("token").Comment("Token string | Token 字符串"),
 		field.String("source").Comment("Log in source such as GitHub | Token 来源 （本地为core, 第三方如github等）"),
+		field.String("device").Optional().Default("").Comment("device label | 登录设备"),
+		field.String("ip").Optional().Default("").Comment("client IP | 登录IP"),
+		field.String("user_agent").
+			SchemaType(map[string]string{dialect.MySQL: "varchar(512)"}).
+			Optional().
+			Default("").
+			Comment("client user agent | 客户端UA"),
+		field.Time("issued_at").Comment("issued time | 签发时间"),
 		field.Time("expired_at").Comment(" Expire time | 过期时间"),
+		field.Time("revoked_at").Optional().Nillable().Comment("revoked time | 吊销时间"),
+		field.Uint64("impersonator_id").Optional().Default(0).Comment("ID of the admin impersonating the user, 0 if not impersonated | 模拟登录的管理员ID, 非模拟登录为0"),
+		field.Uint64("role_id").Optional().Default(0).Comment("current role of the session, kept by the refresh | 会话的当前角色, 刷新令牌时保持不变"),
 	}
 }
 
@@ -39,7 +52,7 @@ func (Token) Mixin() []ent.Mixin {
 
 func (Token) Edges() []ent.Edge {
 	return []ent.Edge{
-		edge.From("owner", User.Type).Ref("token").Unique(),
+		edge.From("owner", User.Type).Ref("tokens").Field("user_id").Unique().Required(),
 	}
 }
 
@@ -30,7 +30,7 @@ func (User) Fields() []ent.Field {
 		field.String("side_mode").Optional().Default("dark").Comment("template mode | 布局方式"),
 		field.String("base_color").Optional().Default("#fff").Comment("base color of template | 后台页面色调"),
 		field.String("active_color").Optional().Default("#1890ff").Comment("active color of template | 当前激活的颜色设定"),
-		field.Uint64("role_id").Optional().Default(2).Comment("role id | 角色ID"),
+		field.Uint64("role_id").Optional().Default(2).Comment("default role id used by the logins | 登录使用的默认角色ID"),
 		field.String("mobile").Unique().Comment("mobile number | 手机号"),
 		field.String("email").Optional().Comment("email | 邮箱号"),
 		field.String("wecom").Optional().Comment("wecom | 企业微信号"),
@@ -39,6 +39,13 @@ func (User) Fields() []ent.Field {
 			Optional().
 			Default("").
 			Comment("avatar | 头像路径"),
+		field.Uint64("token_version").Default(0).Comment("token version, bumped to revoke all issued tokens | 令牌版本, 递增后已签发的令牌全部失效"),
+		field.String("totp_secret").Optional().Sensitive().Default("").Comment("TOTP secret | TOTP密钥"),
+		field.Bool("totp_enabled").Default(false).Comment("whether TOTP two-factor authentication is enabled | 是否启用TOTP双因素认证"),
+		field.Strings("recovery_codes").Optional().Sensitive().Comment("sha256 of the unused recovery codes | 未使用恢复码的sha256摘要"),
+		field.Strings("password_history").Optional().Sensitive().Comment("bcrypt hashes of the previous passwords, newest first | 历史密码的bcrypt哈希, 最新的在前"),
+		field.Bool("pending_approval").Default(false).Comment("self-registered and waiting for approval, the status stays 0 until then | 自助注册待审批, 审批前状态为0"),
+		field.Time("password_changed_at").Optional().Nillable().Comment("time of the last password change, the create time if never changed | 最近修改密码的时间, 未修改过则为创建时间"),
 	}
 }
 
@@ -46,12 +53,18 @@ func (User) Mixin() []ent.Mixin {
 	return []ent.Mixin{
 		mixins.BaseMixin{},
 		mixins.StatusMixin{},
+		mixins.TenantMixin{},
 	}
 }
 
 func (User) Edges() []ent.Edge {
 	return []ent.Edge{
-		edge.To("token", Token.Type).Unique(),
+		edge.To("tokens", Token.Type),
+		edge.To("webauthn_credentials", WebauthnCredential.Type),
+		edge.To("api_keys", APIKey.Type),
+		edge.To("identities", UserIdentity.Type),
+		// the roles of the user, the role_id is the default one among them
+		edge.To("roles", Role.Type),
 	}
 }
 
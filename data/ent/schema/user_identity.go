@@ -0,0 +1,61 @@
+/*
+ * Copyright 2023 FormulaGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ */
+
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"formulago/data/ent/schema/mixins"
+)
+
+// UserIdentity links the account of an OAuth provider to a user, the OAuth login finds the user only by it
+type UserIdentity struct {
+	ent.Schema
+}
+
+func (UserIdentity) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint64("user_id").Comment("User's ID | 用户的ID"),
+		field.String("provider").Comment("the provider's name | 提供商名称"),
+		field.String("subject").Comment("the stable ID of the account at the provider | 提供商账号的唯一ID"),
+		field.String("username").Optional().Default("").Comment("the username at the provider when linked | 关联时提供商的用户名"),
+		field.String("email").Optional().Default("").Comment("the email at the provider when linked | 关联时提供商的邮箱"),
+		field.Time("last_login_at").Optional().Nillable().Comment("last login time | 最后登录时间"),
+	}
+}
+
+func (UserIdentity) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.BaseMixin{},
+	}
+}
+
+func (UserIdentity) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("owner", User.Type).Ref("identities").Field("user_id").Unique().Required(),
+	}
+}
+
+func (UserIdentity) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("provider", "subject").Unique(),
+		// a user links one account of each provider
+		index.Fields("user_id", "provider").Unique(),
+	}
+}
+
+func (UserIdentity) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "sys_user_identities"},
+		entsql.WithComments(true),
+	}
+}
@@ -0,0 +1,67 @@
+/*
+ * Copyright 2023 FormulaGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ */
+
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"formulago/data/ent/schema/mixins"
+)
+
+// WebauthnCredential holds the passkeys registered by the users, a user may have several
+type WebauthnCredential struct {
+	ent.Schema
+}
+
+func (WebauthnCredential) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint64("user_id").Comment("User's ID | 用户的ID"),
+		field.String("name").Default("").Comment("credential name given by the user | 凭证名称"),
+		field.String("credential_id").
+			SchemaType(map[string]string{dialect.MySQL: "varchar(512)"}).
+			Unique().
+			Comment("base64url credential ID | base64url编码的凭证ID"),
+		field.Bytes("public_key").Comment("COSE public key | COSE格式公钥"),
+		field.String("attestation_type").Optional().Default("").Comment("attestation format | 证明格式"),
+		field.Bytes("aaguid").Optional().Comment("authenticator model AAGUID | 认证器型号AAGUID"),
+		field.Uint32("sign_count").Default(0).Comment("signature counter | 签名计数器"),
+		field.Strings("transports").Optional().Comment("authenticator transports | 认证器传输方式"),
+		field.Uint8("flags").Default(0).Comment("authenticator flags at registration | 注册时的认证器标志"),
+		field.Time("last_used_at").Optional().Nillable().Comment("last login time | 最后登录时间"),
+	}
+}
+
+func (WebauthnCredential) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.BaseMixin{},
+	}
+}
+
+func (WebauthnCredential) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("owner", User.Type).Ref("webauthn_credentials").Field("user_id").Unique().Required(),
+	}
+}
+
+func (WebauthnCredential) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id"),
+	}
+}
+
+func (WebauthnCredential) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "sys_webauthn_credentials"},
+		entsql.WithComments(true),
+	}
+}
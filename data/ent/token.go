@@ -24,16 +24,31 @@ type Token struct {
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
 	//  User's ID | 用户的ID
 	UserID uint64 `json:"user_id,omitempty"`
+	// JWT ID of the session | 会话对应的JWT ID
+	Jti string `json:"jti,omitempty"`
 	// Token string | Token 字符串
 	Token string `json:"token,omitempty"`
 	// Log in source such as GitHub | Token 来源 （本地为core, 第三方如github等）
 	Source string `json:"source,omitempty"`
+	// device label | 登录设备
+	Device string `json:"device,omitempty"`
+	// client IP | 登录IP
+	IP string `json:"ip,omitempty"`
+	// client user agent | 客户端UA
+	UserAgent string `json:"user_agent,omitempty"`
+	// issued time | 签发时间
+	IssuedAt time.Time `json:"issued_at,omitempty"`
 	//  Expire time | 过期时间
 	ExpiredAt time.Time `json:"expired_at,omitempty"`
+	// revoked time | 吊销时间
+	RevokedAt *time.Time `json:"revoked_at,omitempty"`
+	// ID of the admin impersonating the user, 0 if not impersonated | 模拟登录的管理员ID, 非模拟登录为0
+	ImpersonatorID uint64 `json:"impersonator_id,omitempty"`
+	// current role of the session, kept by the refresh | 会话的当前角色, 刷新令牌时保持不变
+	RoleID uint64 `json:"role_id,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the TokenQuery when eager-loading is set.
 	Edges        TokenEdges `json:"edges"`
-	user_token   *uint64
 	selectValues sql.SelectValues
 }
 
@@ -62,14 +77,12 @@ func (*Token) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case token.FieldID, token.FieldUserID:
+		case token.FieldID, token.FieldUserID, token.FieldImpersonatorID, token.FieldRoleID:
 			values[i] = new(sql.NullInt64)
-		case token.FieldToken, token.FieldSource:
+		case token.FieldJti, token.FieldToken, token.FieldSource, token.FieldDevice, token.FieldIP, token.FieldUserAgent:
 			values[i] = new(sql.NullString)
-		case token.FieldCreatedAt, token.FieldUpdatedAt, token.FieldExpiredAt:
+		case token.FieldCreatedAt, token.FieldUpdatedAt, token.FieldIssuedAt, token.FieldExpiredAt, token.FieldRevokedAt:
 			values[i] = new(sql.NullTime)
-		case token.ForeignKeys[0]: // user_token
-			values[i] = new(sql.NullInt64)
 		default:
 			values[i] = new(sql.UnknownType)
 		}
@@ -109,6 +122,12 @@ func (_m *Token) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.UserID = uint64(value.Int64)
 			}
+		case token.FieldJti:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field jti", values[i])
+			} else if value.Valid {
+				_m.Jti = value.String
+			}
 		case token.FieldToken:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field token", values[i])
@@ -121,18 +140,54 @@ func (_m *Token) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Source = value.String
 			}
+		case token.FieldDevice:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field device", values[i])
+			} else if value.Valid {
+				_m.Device = value.String
+			}
+		case token.FieldIP:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ip", values[i])
+			} else if value.Valid {
+				_m.IP = value.String
+			}
+		case token.FieldUserAgent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_agent", values[i])
+			} else if value.Valid {
+				_m.UserAgent = value.String
+			}
+		case token.FieldIssuedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field issued_at", values[i])
+			} else if value.Valid {
+				_m.IssuedAt = value.Time
+			}
 		case token.FieldExpiredAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field expired_at", values[i])
 			} else if value.Valid {
 				_m.ExpiredAt = value.Time
 			}
-		case token.ForeignKeys[0]:
+		case token.FieldRevokedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field revoked_at", values[i])
+			} else if value.Valid {
+				_m.RevokedAt = new(time.Time)
+				*_m.RevokedAt = value.Time
+			}
+		case token.FieldImpersonatorID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field impersonator_id", values[i])
+			} else if value.Valid {
+				_m.ImpersonatorID = uint64(value.Int64)
+			}
+		case token.FieldRoleID:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
-				return fmt.Errorf("unexpected type %T for edge-field user_token", value)
+				return fmt.Errorf("unexpected type %T for field role_id", values[i])
 			} else if value.Valid {
-				_m.user_token = new(uint64)
-				*_m.user_token = uint64(value.Int64)
+				_m.RoleID = uint64(value.Int64)
 			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
@@ -184,14 +239,40 @@ func (_m *Token) String() string {
 	builder.WriteString("user_id=")
 	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
 	builder.WriteString(", ")
+	builder.WriteString("jti=")
+	builder.WriteString(_m.Jti)
+	builder.WriteString(", ")
 	builder.WriteString("token=")
 	builder.WriteString(_m.Token)
 	builder.WriteString(", ")
 	builder.WriteString("source=")
 	builder.WriteString(_m.Source)
 	builder.WriteString(", ")
+	builder.WriteString("device=")
+	builder.WriteString(_m.Device)
+	builder.WriteString(", ")
+	builder.WriteString("ip=")
+	builder.WriteString(_m.IP)
+	builder.WriteString(", ")
+	builder.WriteString("user_agent=")
+	builder.WriteString(_m.UserAgent)
+	builder.WriteString(", ")
+	builder.WriteString("issued_at=")
+	builder.WriteString(_m.IssuedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
 	builder.WriteString("expired_at=")
 	builder.WriteString(_m.ExpiredAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.RevokedAt; v != nil {
+		builder.WriteString("revoked_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("impersonator_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ImpersonatorID))
+	builder.WriteString(", ")
+	builder.WriteString("role_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RoleID))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -55,6 +55,12 @@ func (_c *TokenCreate) SetUserID(v uint64) *TokenCreate {
 	return _c
 }
 
+// SetJti sets the "jti" field.
+func (_c *TokenCreate) SetJti(v string) *TokenCreate {
+	_c.mutation.SetJti(v)
+	return _c
+}
+
 // SetToken sets the "token" field.
 func (_c *TokenCreate) SetToken(v string) *TokenCreate {
 	_c.mutation.SetToken(v)
@@ -67,12 +73,102 @@ func (_c *TokenCreate) SetSource(v string) *TokenCreate {
 	return _c
 }
 
+// SetDevice sets the "device" field.
+func (_c *TokenCreate) SetDevice(v string) *TokenCreate {
+	_c.mutation.SetDevice(v)
+	return _c
+}
+
+// SetNillableDevice sets the "device" field if the given value is not nil.
+func (_c *TokenCreate) SetNillableDevice(v *string) *TokenCreate {
+	if v != nil {
+		_c.SetDevice(*v)
+	}
+	return _c
+}
+
+// SetIP sets the "ip" field.
+func (_c *TokenCreate) SetIP(v string) *TokenCreate {
+	_c.mutation.SetIP(v)
+	return _c
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (_c *TokenCreate) SetNillableIP(v *string) *TokenCreate {
+	if v != nil {
+		_c.SetIP(*v)
+	}
+	return _c
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (_c *TokenCreate) SetUserAgent(v string) *TokenCreate {
+	_c.mutation.SetUserAgent(v)
+	return _c
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (_c *TokenCreate) SetNillableUserAgent(v *string) *TokenCreate {
+	if v != nil {
+		_c.SetUserAgent(*v)
+	}
+	return _c
+}
+
+// SetIssuedAt sets the "issued_at" field.
+func (_c *TokenCreate) SetIssuedAt(v time.Time) *TokenCreate {
+	_c.mutation.SetIssuedAt(v)
+	return _c
+}
+
 // SetExpiredAt sets the "expired_at" field.
 func (_c *TokenCreate) SetExpiredAt(v time.Time) *TokenCreate {
 	_c.mutation.SetExpiredAt(v)
 	return _c
 }
 
+// SetRevokedAt sets the "revoked_at" field.
+func (_c *TokenCreate) SetRevokedAt(v time.Time) *TokenCreate {
+	_c.mutation.SetRevokedAt(v)
+	return _c
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (_c *TokenCreate) SetNillableRevokedAt(v *time.Time) *TokenCreate {
+	if v != nil {
+		_c.SetRevokedAt(*v)
+	}
+	return _c
+}
+
+// SetImpersonatorID sets the "impersonator_id" field.
+func (_c *TokenCreate) SetImpersonatorID(v uint64) *TokenCreate {
+	_c.mutation.SetImpersonatorID(v)
+	return _c
+}
+
+// SetNillableImpersonatorID sets the "impersonator_id" field if the given value is not nil.
+func (_c *TokenCreate) SetNillableImpersonatorID(v *uint64) *TokenCreate {
+	if v != nil {
+		_c.SetImpersonatorID(*v)
+	}
+	return _c
+}
+
+// SetRoleID sets the "role_id" field.
+func (_c *TokenCreate) SetRoleID(v uint64) *TokenCreate {
+	_c.mutation.SetRoleID(v)
+	return _c
+}
+
+// SetNillableRoleID sets the "role_id" field if the given value is not nil.
+func (_c *TokenCreate) SetNillableRoleID(v *uint64) *TokenCreate {
+	if v != nil {
+		_c.SetRoleID(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *TokenCreate) SetID(v uint64) *TokenCreate {
 	_c.mutation.SetID(v)
@@ -85,14 +181,6 @@ func (_c *TokenCreate) SetOwnerID(id uint64) *TokenCreate {
 	return _c
 }
 
-// SetNillableOwnerID sets the "owner" edge to the User entity by ID if the given value is not nil.
-func (_c *TokenCreate) SetNillableOwnerID(id *uint64) *TokenCreate {
-	if id != nil {
-		_c = _c.SetOwnerID(*id)
-	}
-	return _c
-}
-
 // SetOwner sets the "owner" edge to the User entity.
 func (_c *TokenCreate) SetOwner(v *User) *TokenCreate {
 	return _c.SetOwnerID(v.ID)
@@ -141,6 +229,26 @@ func (_c *TokenCreate) defaults() {
 		v := token.DefaultUpdatedAt()
 		_c.mutation.SetUpdatedAt(v)
 	}
+	if _, ok := _c.mutation.Device(); !ok {
+		v := token.DefaultDevice
+		_c.mutation.SetDevice(v)
+	}
+	if _, ok := _c.mutation.IP(); !ok {
+		v := token.DefaultIP
+		_c.mutation.SetIP(v)
+	}
+	if _, ok := _c.mutation.UserAgent(); !ok {
+		v := token.DefaultUserAgent
+		_c.mutation.SetUserAgent(v)
+	}
+	if _, ok := _c.mutation.ImpersonatorID(); !ok {
+		v := token.DefaultImpersonatorID
+		_c.mutation.SetImpersonatorID(v)
+	}
+	if _, ok := _c.mutation.RoleID(); !ok {
+		v := token.DefaultRoleID
+		_c.mutation.SetRoleID(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -154,15 +262,24 @@ func (_c *TokenCreate) check() error {
 	if _, ok := _c.mutation.UserID(); !ok {
 		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "Token.user_id"`)}
 	}
+	if _, ok := _c.mutation.Jti(); !ok {
+		return &ValidationError{Name: "jti", err: errors.New(`ent: missing required field "Token.jti"`)}
+	}
 	if _, ok := _c.mutation.Token(); !ok {
 		return &ValidationError{Name: "token", err: errors.New(`ent: missing required field "Token.token"`)}
 	}
 	if _, ok := _c.mutation.Source(); !ok {
 		return &ValidationError{Name: "source", err: errors.New(`ent: missing required field "Token.source"`)}
 	}
+	if _, ok := _c.mutation.IssuedAt(); !ok {
+		return &ValidationError{Name: "issued_at", err: errors.New(`ent: missing required field "Token.issued_at"`)}
+	}
 	if _, ok := _c.mutation.ExpiredAt(); !ok {
 		return &ValidationError{Name: "expired_at", err: errors.New(`ent: missing required field "Token.expired_at"`)}
 	}
+	if len(_c.mutation.OwnerIDs()) == 0 {
+		return &ValidationError{Name: "owner", err: errors.New(`ent: missing required edge "Token.owner"`)}
+	}
 	return nil
 }
 
@@ -203,9 +320,9 @@ func (_c *TokenCreate) createSpec() (*Token, *sqlgraph.CreateSpec) {
 		_spec.SetField(token.FieldUpdatedAt, field.TypeTime, value)
 		_node.UpdatedAt = value
 	}
-	if value, ok := _c.mutation.UserID(); ok {
-		_spec.SetField(token.FieldUserID, field.TypeUint64, value)
-		_node.UserID = value
+	if value, ok := _c.mutation.Jti(); ok {
+		_spec.SetField(token.FieldJti, field.TypeString, value)
+		_node.Jti = value
 	}
 	if value, ok := _c.mutation.Token(); ok {
 		_spec.SetField(token.FieldToken, field.TypeString, value)
@@ -215,13 +332,41 @@ func (_c *TokenCreate) createSpec() (*Token, *sqlgraph.CreateSpec) {
 		_spec.SetField(token.FieldSource, field.TypeString, value)
 		_node.Source = value
 	}
+	if value, ok := _c.mutation.Device(); ok {
+		_spec.SetField(token.FieldDevice, field.TypeString, value)
+		_node.Device = value
+	}
+	if value, ok := _c.mutation.IP(); ok {
+		_spec.SetField(token.FieldIP, field.TypeString, value)
+		_node.IP = value
+	}
+	if value, ok := _c.mutation.UserAgent(); ok {
+		_spec.SetField(token.FieldUserAgent, field.TypeString, value)
+		_node.UserAgent = value
+	}
+	if value, ok := _c.mutation.IssuedAt(); ok {
+		_spec.SetField(token.FieldIssuedAt, field.TypeTime, value)
+		_node.IssuedAt = value
+	}
 	if value, ok := _c.mutation.ExpiredAt(); ok {
 		_spec.SetField(token.FieldExpiredAt, field.TypeTime, value)
 		_node.ExpiredAt = value
 	}
+	if value, ok := _c.mutation.RevokedAt(); ok {
+		_spec.SetField(token.FieldRevokedAt, field.TypeTime, value)
+		_node.RevokedAt = &value
+	}
+	if value, ok := _c.mutation.ImpersonatorID(); ok {
+		_spec.SetField(token.FieldImpersonatorID, field.TypeUint64, value)
+		_node.ImpersonatorID = value
+	}
+	if value, ok := _c.mutation.RoleID(); ok {
+		_spec.SetField(token.FieldRoleID, field.TypeUint64, value)
+		_node.RoleID = value
+	}
 	if nodes := _c.mutation.OwnerIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2O,
+			Rel:     sqlgraph.M2O,
 			Inverse: true,
 			Table:   token.OwnerTable,
 			Columns: []string{token.OwnerColumn},
@@ -233,7 +378,7 @@ func (_c *TokenCreate) createSpec() (*Token, *sqlgraph.CreateSpec) {
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
-		_node.user_token = &nodes[0]
+		_node.UserID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
 	return _node, _spec
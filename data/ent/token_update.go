@@ -37,7 +37,6 @@ func (_u *TokenUpdate) SetUpdatedAt(v time.Time) *TokenUpdate {
 
 // SetUserID sets the "user_id" field.
 func (_u *TokenUpdate) SetUserID(v uint64) *TokenUpdate {
-	_u.mutation.ResetUserID()
 	_u.mutation.SetUserID(v)
 	return _u
 }
@@ -50,9 +49,17 @@ func (_u *TokenUpdate) SetNillableUserID(v *uint64) *TokenUpdate {
 	return _u
 }
 
-// AddUserID adds value to the "user_id" field.
-func (_u *TokenUpdate) AddUserID(v int64) *TokenUpdate {
-	_u.mutation.AddUserID(v)
+// SetJti sets the "jti" field.
+func (_u *TokenUpdate) SetJti(v string) *TokenUpdate {
+	_u.mutation.SetJti(v)
+	return _u
+}
+
+// SetNillableJti sets the "jti" field if the given value is not nil.
+func (_u *TokenUpdate) SetNillableJti(v *string) *TokenUpdate {
+	if v != nil {
+		_u.SetJti(*v)
+	}
 	return _u
 }
 
@@ -84,6 +91,80 @@ func (_u *TokenUpdate) SetNillableSource(v *string) *TokenUpdate {
 	return _u
 }
 
+// SetDevice sets the "device" field.
+func (_u *TokenUpdate) SetDevice(v string) *TokenUpdate {
+	_u.mutation.SetDevice(v)
+	return _u
+}
+
+// SetNillableDevice sets the "device" field if the given value is not nil.
+func (_u *TokenUpdate) SetNillableDevice(v *string) *TokenUpdate {
+	if v != nil {
+		_u.SetDevice(*v)
+	}
+	return _u
+}
+
+// ClearDevice clears the value of the "device" field.
+func (_u *TokenUpdate) ClearDevice() *TokenUpdate {
+	_u.mutation.ClearDevice()
+	return _u
+}
+
+// SetIP sets the "ip" field.
+func (_u *TokenUpdate) SetIP(v string) *TokenUpdate {
+	_u.mutation.SetIP(v)
+	return _u
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (_u *TokenUpdate) SetNillableIP(v *string) *TokenUpdate {
+	if v != nil {
+		_u.SetIP(*v)
+	}
+	return _u
+}
+
+// ClearIP clears the value of the "ip" field.
+func (_u *TokenUpdate) ClearIP() *TokenUpdate {
+	_u.mutation.ClearIP()
+	return _u
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (_u *TokenUpdate) SetUserAgent(v string) *TokenUpdate {
+	_u.mutation.SetUserAgent(v)
+	return _u
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (_u *TokenUpdate) SetNillableUserAgent(v *string) *TokenUpdate {
+	if v != nil {
+		_u.SetUserAgent(*v)
+	}
+	return _u
+}
+
+// ClearUserAgent clears the value of the "user_agent" field.
+func (_u *TokenUpdate) ClearUserAgent() *TokenUpdate {
+	_u.mutation.ClearUserAgent()
+	return _u
+}
+
+// SetIssuedAt sets the "issued_at" field.
+func (_u *TokenUpdate) SetIssuedAt(v time.Time) *TokenUpdate {
+	_u.mutation.SetIssuedAt(v)
+	return _u
+}
+
+// SetNillableIssuedAt sets the "issued_at" field if the given value is not nil.
+func (_u *TokenUpdate) SetNillableIssuedAt(v *time.Time) *TokenUpdate {
+	if v != nil {
+		_u.SetIssuedAt(*v)
+	}
+	return _u
+}
+
 // SetExpiredAt sets the "expired_at" field.
 func (_u *TokenUpdate) SetExpiredAt(v time.Time) *TokenUpdate {
 	_u.mutation.SetExpiredAt(v)
@@ -98,20 +179,86 @@ func (_u *TokenUpdate) SetNillableExpiredAt(v *time.Time) *TokenUpdate {
 	return _u
 }
 
-// SetOwnerID sets the "owner" edge to the User entity by ID.
-func (_u *TokenUpdate) SetOwnerID(id uint64) *TokenUpdate {
-	_u.mutation.SetOwnerID(id)
+// SetRevokedAt sets the "revoked_at" field.
+func (_u *TokenUpdate) SetRevokedAt(v time.Time) *TokenUpdate {
+	_u.mutation.SetRevokedAt(v)
 	return _u
 }
 
-// SetNillableOwnerID sets the "owner" edge to the User entity by ID if the given value is not nil.
-func (_u *TokenUpdate) SetNillableOwnerID(id *uint64) *TokenUpdate {
-	if id != nil {
-		_u = _u.SetOwnerID(*id)
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (_u *TokenUpdate) SetNillableRevokedAt(v *time.Time) *TokenUpdate {
+	if v != nil {
+		_u.SetRevokedAt(*v)
 	}
 	return _u
 }
 
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (_u *TokenUpdate) ClearRevokedAt() *TokenUpdate {
+	_u.mutation.ClearRevokedAt()
+	return _u
+}
+
+// SetImpersonatorID sets the "impersonator_id" field.
+func (_u *TokenUpdate) SetImpersonatorID(v uint64) *TokenUpdate {
+	_u.mutation.ResetImpersonatorID()
+	_u.mutation.SetImpersonatorID(v)
+	return _u
+}
+
+// SetNillableImpersonatorID sets the "impersonator_id" field if the given value is not nil.
+func (_u *TokenUpdate) SetNillableImpersonatorID(v *uint64) *TokenUpdate {
+	if v != nil {
+		_u.SetImpersonatorID(*v)
+	}
+	return _u
+}
+
+// AddImpersonatorID adds value to the "impersonator_id" field.
+func (_u *TokenUpdate) AddImpersonatorID(v int64) *TokenUpdate {
+	_u.mutation.AddImpersonatorID(v)
+	return _u
+}
+
+// ClearImpersonatorID clears the value of the "impersonator_id" field.
+func (_u *TokenUpdate) ClearImpersonatorID() *TokenUpdate {
+	_u.mutation.ClearImpersonatorID()
+	return _u
+}
+
+// SetRoleID sets the "role_id" field.
+func (_u *TokenUpdate) SetRoleID(v uint64) *TokenUpdate {
+	_u.mutation.ResetRoleID()
+	_u.mutation.SetRoleID(v)
+	return _u
+}
+
+// SetNillableRoleID sets the "role_id" field if the given value is not nil.
+func (_u *TokenUpdate) SetNillableRoleID(v *uint64) *TokenUpdate {
+	if v != nil {
+		_u.SetRoleID(*v)
+	}
+	return _u
+}
+
+// AddRoleID adds value to the "role_id" field.
+func (_u *TokenUpdate) AddRoleID(v int64) *TokenUpdate {
+	_u.mutation.AddRoleID(v)
+	return _u
+}
+
+// ClearRoleID clears the value of the "role_id" field.
+func (_u *TokenUpdate) ClearRoleID() *TokenUpdate {
+	_u.mutation.ClearRoleID()
+	return _u
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by ID.
+func (_u *TokenUpdate) SetOwnerID(id uint64) *TokenUpdate {
+	_u.mutation.SetOwnerID(id)
+	return _u
+}
+
 // SetOwner sets the "owner" edge to the User entity.
 func (_u *TokenUpdate) SetOwner(v *User) *TokenUpdate {
 	return _u.SetOwnerID(v.ID)
@@ -164,7 +311,18 @@ func (_u *TokenUpdate) defaults() {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (_u *TokenUpdate) check() error {
+	if _u.mutation.OwnerCleared() && len(_u.mutation.OwnerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Token.owner"`)
+	}
+	return nil
+}
+
 func (_u *TokenUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
 	_spec := sqlgraph.NewUpdateSpec(token.Table, token.Columns, sqlgraph.NewFieldSpec(token.FieldID, field.TypeUint64))
 	if ps := _u.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -176,11 +334,8 @@ func (_u *TokenUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(token.FieldUpdatedAt, field.TypeTime, value)
 	}
-	if value, ok := _u.mutation.UserID(); ok {
-		_spec.SetField(token.FieldUserID, field.TypeUint64, value)
-	}
-	if value, ok := _u.mutation.AddedUserID(); ok {
-		_spec.AddField(token.FieldUserID, field.TypeUint64, value)
+	if value, ok := _u.mutation.Jti(); ok {
+		_spec.SetField(token.FieldJti, field.TypeString, value)
 	}
 	if value, ok := _u.mutation.Token(); ok {
 		_spec.SetField(token.FieldToken, field.TypeString, value)
@@ -188,12 +343,57 @@ func (_u *TokenUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.Source(); ok {
 		_spec.SetField(token.FieldSource, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Device(); ok {
+		_spec.SetField(token.FieldDevice, field.TypeString, value)
+	}
+	if _u.mutation.DeviceCleared() {
+		_spec.ClearField(token.FieldDevice, field.TypeString)
+	}
+	if value, ok := _u.mutation.IP(); ok {
+		_spec.SetField(token.FieldIP, field.TypeString, value)
+	}
+	if _u.mutation.IPCleared() {
+		_spec.ClearField(token.FieldIP, field.TypeString)
+	}
+	if value, ok := _u.mutation.UserAgent(); ok {
+		_spec.SetField(token.FieldUserAgent, field.TypeString, value)
+	}
+	if _u.mutation.UserAgentCleared() {
+		_spec.ClearField(token.FieldUserAgent, field.TypeString)
+	}
+	if value, ok := _u.mutation.IssuedAt(); ok {
+		_spec.SetField(token.FieldIssuedAt, field.TypeTime, value)
+	}
 	if value, ok := _u.mutation.ExpiredAt(); ok {
 		_spec.SetField(token.FieldExpiredAt, field.TypeTime, value)
 	}
+	if value, ok := _u.mutation.RevokedAt(); ok {
+		_spec.SetField(token.FieldRevokedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RevokedAtCleared() {
+		_spec.ClearField(token.FieldRevokedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ImpersonatorID(); ok {
+		_spec.SetField(token.FieldImpersonatorID, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedImpersonatorID(); ok {
+		_spec.AddField(token.FieldImpersonatorID, field.TypeUint64, value)
+	}
+	if _u.mutation.ImpersonatorIDCleared() {
+		_spec.ClearField(token.FieldImpersonatorID, field.TypeUint64)
+	}
+	if value, ok := _u.mutation.RoleID(); ok {
+		_spec.SetField(token.FieldRoleID, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedRoleID(); ok {
+		_spec.AddField(token.FieldRoleID, field.TypeUint64, value)
+	}
+	if _u.mutation.RoleIDCleared() {
+		_spec.ClearField(token.FieldRoleID, field.TypeUint64)
+	}
 	if _u.mutation.OwnerCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2O,
+			Rel:     sqlgraph.M2O,
 			Inverse: true,
 			Table:   token.OwnerTable,
 			Columns: []string{token.OwnerColumn},
@@ -206,7 +406,7 @@ func (_u *TokenUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	}
 	if nodes := _u.mutation.OwnerIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2O,
+			Rel:     sqlgraph.M2O,
 			Inverse: true,
 			Table:   token.OwnerTable,
 			Columns: []string{token.OwnerColumn},
@@ -248,7 +448,6 @@ func (_u *TokenUpdateOne) SetUpdatedAt(v time.Time) *TokenUpdateOne {
 
 // SetUserID sets the "user_id" field.
 func (_u *TokenUpdateOne) SetUserID(v uint64) *TokenUpdateOne {
-	_u.mutation.ResetUserID()
 	_u.mutation.SetUserID(v)
 	return _u
 }
@@ -261,9 +460,17 @@ func (_u *TokenUpdateOne) SetNillableUserID(v *uint64) *TokenUpdateOne {
 	return _u
 }
 
-// AddUserID adds value to the "user_id" field.
-func (_u *TokenUpdateOne) AddUserID(v int64) *TokenUpdateOne {
-	_u.mutation.AddUserID(v)
+// SetJti sets the "jti" field.
+func (_u *TokenUpdateOne) SetJti(v string) *TokenUpdateOne {
+	_u.mutation.SetJti(v)
+	return _u
+}
+
+// SetNillableJti sets the "jti" field if the given value is not nil.
+func (_u *TokenUpdateOne) SetNillableJti(v *string) *TokenUpdateOne {
+	if v != nil {
+		_u.SetJti(*v)
+	}
 	return _u
 }
 
@@ -295,6 +502,80 @@ func (_u *TokenUpdateOne) SetNillableSource(v *string) *TokenUpdateOne {
 	return _u
 }
 
+// SetDevice sets the "device" field.
+func (_u *TokenUpdateOne) SetDevice(v string) *TokenUpdateOne {
+	_u.mutation.SetDevice(v)
+	return _u
+}
+
+// SetNillableDevice sets the "device" field if the given value is not nil.
+func (_u *TokenUpdateOne) SetNillableDevice(v *string) *TokenUpdateOne {
+	if v != nil {
+		_u.SetDevice(*v)
+	}
+	return _u
+}
+
+// ClearDevice clears the value of the "device" field.
+func (_u *TokenUpdateOne) ClearDevice() *TokenUpdateOne {
+	_u.mutation.ClearDevice()
+	return _u
+}
+
+// SetIP sets the "ip" field.
+func (_u *TokenUpdateOne) SetIP(v string) *TokenUpdateOne {
+	_u.mutation.SetIP(v)
+	return _u
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (_u *TokenUpdateOne) SetNillableIP(v *string) *TokenUpdateOne {
+	if v != nil {
+		_u.SetIP(*v)
+	}
+	return _u
+}
+
+// ClearIP clears the value of the "ip" field.
+func (_u *TokenUpdateOne) ClearIP() *TokenUpdateOne {
+	_u.mutation.ClearIP()
+	return _u
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (_u *TokenUpdateOne) SetUserAgent(v string) *TokenUpdateOne {
+	_u.mutation.SetUserAgent(v)
+	return _u
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (_u *TokenUpdateOne) SetNillableUserAgent(v *string) *TokenUpdateOne {
+	if v != nil {
+		_u.SetUserAgent(*v)
+	}
+	return _u
+}
+
+// ClearUserAgent clears the value of the "user_agent" field.
+func (_u *TokenUpdateOne) ClearUserAgent() *TokenUpdateOne {
+	_u.mutation.ClearUserAgent()
+	return _u
+}
+
+// SetIssuedAt sets the "issued_at" field.
+func (_u *TokenUpdateOne) SetIssuedAt(v time.Time) *TokenUpdateOne {
+	_u.mutation.SetIssuedAt(v)
+	return _u
+}
+
+// SetNillableIssuedAt sets the "issued_at" field if the given value is not nil.
+func (_u *TokenUpdateOne) SetNillableIssuedAt(v *time.Time) *TokenUpdateOne {
+	if v != nil {
+		_u.SetIssuedAt(*v)
+	}
+	return _u
+}
+
 // SetExpiredAt sets the "expired_at" field.
 func (_u *TokenUpdateOne) SetExpiredAt(v time.Time) *TokenUpdateOne {
 	_u.mutation.SetExpiredAt(v)
@@ -309,20 +590,86 @@ func (_u *TokenUpdateOne) SetNillableExpiredAt(v *time.Time) *TokenUpdateOne {
 	return _u
 }
 
-// SetOwnerID sets the "owner" edge to the User entity by ID.
-func (_u *TokenUpdateOne) SetOwnerID(id uint64) *TokenUpdateOne {
-	_u.mutation.SetOwnerID(id)
+// SetRevokedAt sets the "revoked_at" field.
+func (_u *TokenUpdateOne) SetRevokedAt(v time.Time) *TokenUpdateOne {
+	_u.mutation.SetRevokedAt(v)
 	return _u
 }
 
-// SetNillableOwnerID sets the "owner" edge to the User entity by ID if the given value is not nil.
-func (_u *TokenUpdateOne) SetNillableOwnerID(id *uint64) *TokenUpdateOne {
-	if id != nil {
-		_u = _u.SetOwnerID(*id)
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (_u *TokenUpdateOne) SetNillableRevokedAt(v *time.Time) *TokenUpdateOne {
+	if v != nil {
+		_u.SetRevokedAt(*v)
 	}
 	return _u
 }
 
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (_u *TokenUpdateOne) ClearRevokedAt() *TokenUpdateOne {
+	_u.mutation.ClearRevokedAt()
+	return _u
+}
+
+// SetImpersonatorID sets the "impersonator_id" field.
+func (_u *TokenUpdateOne) SetImpersonatorID(v uint64) *TokenUpdateOne {
+	_u.mutation.ResetImpersonatorID()
+	_u.mutation.SetImpersonatorID(v)
+	return _u
+}
+
+// SetNillableImpersonatorID sets the "impersonator_id" field if the given value is not nil.
+func (_u *TokenUpdateOne) SetNillableImpersonatorID(v *uint64) *TokenUpdateOne {
+	if v != nil {
+		_u.SetImpersonatorID(*v)
+	}
+	return _u
+}
+
+// AddImpersonatorID adds value to the "impersonator_id" field.
+func (_u *TokenUpdateOne) AddImpersonatorID(v int64) *TokenUpdateOne {
+	_u.mutation.AddImpersonatorID(v)
+	return _u
+}
+
+// ClearImpersonatorID clears the value of the "impersonator_id" field.
+func (_u *TokenUpdateOne) ClearImpersonatorID() *TokenUpdateOne {
+	_u.mutation.ClearImpersonatorID()
+	return _u
+}
+
+// SetRoleID sets the "role_id" field.
+func (_u *TokenUpdateOne) SetRoleID(v uint64) *TokenUpdateOne {
+	_u.mutation.ResetRoleID()
+	_u.mutation.SetRoleID(v)
+	return _u
+}
+
+// SetNillableRoleID sets the "role_id" field if the given value is not nil.
+func (_u *TokenUpdateOne) SetNillableRoleID(v *uint64) *TokenUpdateOne {
+	if v != nil {
+		_u.SetRoleID(*v)
+	}
+	return _u
+}
+
+// AddRoleID adds value to the "role_id" field.
+func (_u *TokenUpdateOne) AddRoleID(v int64) *TokenUpdateOne {
+	_u.mutation.AddRoleID(v)
+	return _u
+}
+
+// ClearRoleID clears the value of the "role_id" field.
+func (_u *TokenUpdateOne) ClearRoleID() *TokenUpdateOne {
+	_u.mutation.ClearRoleID()
+	return _u
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by ID.
+func (_u *TokenUpdateOne) SetOwnerID(id uint64) *TokenUpdateOne {
+	_u.mutation.SetOwnerID(id)
+	return _u
+}
+
 // SetOwner sets the "owner" edge to the User entity.
 func (_u *TokenUpdateOne) SetOwner(v *User) *TokenUpdateOne {
 	return _u.SetOwnerID(v.ID)
@@ -388,7 +735,18 @@ func (_u *TokenUpdateOne) defaults() {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (_u *TokenUpdateOne) check() error {
+	if _u.mutation.OwnerCleared() && len(_u.mutation.OwnerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Token.owner"`)
+	}
+	return nil
+}
+
 func (_u *TokenUpdateOne) sqlSave(ctx context.Context) (_node *Token, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
 	_spec := sqlgraph.NewUpdateSpec(token.Table, token.Columns, sqlgraph.NewFieldSpec(token.FieldID, field.TypeUint64))
 	id, ok := _u.mutation.ID()
 	if !ok {
@@ -417,11 +775,8 @@ func (_u *TokenUpdateOne) sqlSave(ctx context.Context) (_node *Token, err error)
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(token.FieldUpdatedAt, field.TypeTime, value)
 	}
-	if value, ok := _u.mutation.UserID(); ok {
-		_spec.SetField(token.FieldUserID, field.TypeUint64, value)
-	}
-	if value, ok := _u.mutation.AddedUserID(); ok {
-		_spec.AddField(token.FieldUserID, field.TypeUint64, value)
+	if value, ok := _u.mutation.Jti(); ok {
+		_spec.SetField(token.FieldJti, field.TypeString, value)
 	}
 	if value, ok := _u.mutation.Token(); ok {
 		_spec.SetField(token.FieldToken, field.TypeString, value)
@@ -429,12 +784,57 @@ func (_u *TokenUpdateOne) sqlSave(ctx context.Context) (_node *Token, err error)
 	if value, ok := _u.mutation.Source(); ok {
 		_spec.SetField(token.FieldSource, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Device(); ok {
+		_spec.SetField(token.FieldDevice, field.TypeString, value)
+	}
+	if _u.mutation.DeviceCleared() {
+		_spec.ClearField(token.FieldDevice, field.TypeString)
+	}
+	if value, ok := _u.mutation.IP(); ok {
+		_spec.SetField(token.FieldIP, field.TypeString, value)
+	}
+	if _u.mutation.IPCleared() {
+		_spec.ClearField(token.FieldIP, field.TypeString)
+	}
+	if value, ok := _u.mutation.UserAgent(); ok {
+		_spec.SetField(token.FieldUserAgent, field.TypeString, value)
+	}
+	if _u.mutation.UserAgentCleared() {
+		_spec.ClearField(token.FieldUserAgent, field.TypeString)
+	}
+	if value, ok := _u.mutation.IssuedAt(); ok {
+		_spec.SetField(token.FieldIssuedAt, field.TypeTime, value)
+	}
 	if value, ok := _u.mutation.ExpiredAt(); ok {
 		_spec.SetField(token.FieldExpiredAt, field.TypeTime, value)
 	}
+	if value, ok := _u.mutation.RevokedAt(); ok {
+		_spec.SetField(token.FieldRevokedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RevokedAtCleared() {
+		_spec.ClearField(token.FieldRevokedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ImpersonatorID(); ok {
+		_spec.SetField(token.FieldImpersonatorID, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedImpersonatorID(); ok {
+		_spec.AddField(token.FieldImpersonatorID, field.TypeUint64, value)
+	}
+	if _u.mutation.ImpersonatorIDCleared() {
+		_spec.ClearField(token.FieldImpersonatorID, field.TypeUint64)
+	}
+	if value, ok := _u.mutation.RoleID(); ok {
+		_spec.SetField(token.FieldRoleID, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedRoleID(); ok {
+		_spec.AddField(token.FieldRoleID, field.TypeUint64, value)
+	}
+	if _u.mutation.RoleIDCleared() {
+		_spec.ClearField(token.FieldRoleID, field.TypeUint64)
+	}
 	if _u.mutation.OwnerCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2O,
+			Rel:     sqlgraph.M2O,
 			Inverse: true,
 			Table:   token.OwnerTable,
 			Columns: []string{token.OwnerColumn},
@@ -447,7 +847,7 @@ func (_u *TokenUpdateOne) sqlSave(ctx context.Context) (_node *Token, err error)
 	}
 	if nodes := _u.mutation.OwnerIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2O,
+			Rel:     sqlgraph.M2O,
 			Inverse: true,
 			Table:   token.OwnerTable,
 			Columns: []string{token.OwnerColumn},
@@ -3,8 +3,8 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
-	"formulago/data/ent/token"
 	"formulago/data/ent/user"
 	"strings"
 	"time"
@@ -25,6 +25,8 @@ type User struct {
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
 	// status 1 normal 0 ban | 状态 1 正常 0 禁用
 	Status uint8 `json:"status,omitempty"`
+	// tenant ID | 租户ID
+	TenantID uint64 `json:"tenant_id,omitempty"`
 	// user's login name | 登录名
 	Username string `json:"username,omitempty"`
 	// password | 密码
@@ -37,7 +39,7 @@ type User struct {
 	BaseColor string `json:"base_color,omitempty"`
 	// active color of template | 当前激活的颜色设定
 	ActiveColor string `json:"active_color,omitempty"`
-	// role id | 角色ID
+	// default role id used by the logins | 登录使用的默认角色ID
 	RoleID uint64 `json:"role_id,omitempty"`
 	// mobile number | 手机号
 	Mobile string `json:"mobile,omitempty"`
@@ -47,6 +49,20 @@ type User struct {
 	Wecom string `json:"wecom,omitempty"`
 	// avatar | 头像路径
 	Avatar string `json:"avatar,omitempty"`
+	// token version, bumped to revoke all issued tokens | 令牌版本, 递增后已签发的令牌全部失效
+	TokenVersion uint64 `json:"token_version,omitempty"`
+	// TOTP secret | TOTP密钥
+	TotpSecret string `json:"-"`
+	// whether TOTP two-factor authentication is enabled | 是否启用TOTP双因素认证
+	TotpEnabled bool `json:"totp_enabled,omitempty"`
+	// sha256 of the unused recovery codes | 未使用恢复码的sha256摘要
+	RecoveryCodes []string `json:"-"`
+	// bcrypt hashes of the previous passwords, newest first | 历史密码的bcrypt哈希, 最新的在前
+	PasswordHistory []string `json:"-"`
+	// self-registered and waiting for approval, the status stays 0 until then | 自助注册待审批, 审批前状态为0
+	PendingApproval bool `json:"pending_approval,omitempty"`
+	// time of the last password change, the create time if never changed | 最近修改密码的时间, 未修改过则为创建时间
+	PasswordChangedAt *time.Time `json:"password_changed_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the UserQuery when eager-loading is set.
 	Edges        UserEdges `json:"edges"`
@@ -55,22 +71,64 @@ type User struct {
 
 // UserEdges holds the relations/edges for other nodes in the graph.
 type UserEdges struct {
-	// Token holds the value of the token edge.
-	Token *Token `json:"token,omitempty"`
+	// Tokens holds the value of the tokens edge.
+	Tokens []*Token `json:"tokens,omitempty"`
+	// WebauthnCredentials holds the value of the webauthn_credentials edge.
+	WebauthnCredentials []*WebauthnCredential `json:"webauthn_credentials,omitempty"`
+	// APIKeys holds the value of the api_keys edge.
+	APIKeys []*APIKey `json:"api_keys,omitempty"`
+	// Identities holds the value of the identities edge.
+	Identities []*UserIdentity `json:"identities,omitempty"`
+	// Roles holds the value of the roles edge.
+	Roles []*Role `json:"roles,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [5]bool
 }
 
-// TokenOrErr returns the Token value or an error if the edge
-// was not loaded in eager-loading, or loaded but was not found.
-func (e UserEdges) TokenOrErr() (*Token, error) {
-	if e.Token != nil {
-		return e.Token, nil
-	} else if e.loadedTypes[0] {
-		return nil, &NotFoundError{label: token.Label}
+// TokensOrErr returns the Tokens value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) TokensOrErr() ([]*Token, error) {
+	if e.loadedTypes[0] {
+		return e.Tokens, nil
 	}
-	return nil, &NotLoadedError{edge: "token"}
+	return nil, &NotLoadedError{edge: "tokens"}
+}
+
+// WebauthnCredentialsOrErr returns the WebauthnCredentials value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) WebauthnCredentialsOrErr() ([]*WebauthnCredential, error) {
+	if e.loadedTypes[1] {
+		return e.WebauthnCredentials, nil
+	}
+	return nil, &NotLoadedError{edge: "webauthn_credentials"}
+}
+
+// APIKeysOrErr returns the APIKeys value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) APIKeysOrErr() ([]*APIKey, error) {
+	if e.loadedTypes[2] {
+		return e.APIKeys, nil
+	}
+	return nil, &NotLoadedError{edge: "api_keys"}
+}
+
+// IdentitiesOrErr returns the Identities value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) IdentitiesOrErr() ([]*UserIdentity, error) {
+	if e.loadedTypes[3] {
+		return e.Identities, nil
+	}
+	return nil, &NotLoadedError{edge: "identities"}
+}
+
+// RolesOrErr returns the Roles value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) RolesOrErr() ([]*Role, error) {
+	if e.loadedTypes[4] {
+		return e.Roles, nil
+	}
+	return nil, &NotLoadedError{edge: "roles"}
 }
 
 // scanValues returns the types for scanning values from sql.Rows.
@@ -78,11 +136,15 @@ func (*User) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case user.FieldID, user.FieldStatus, user.FieldRoleID:
+		case user.FieldRecoveryCodes, user.FieldPasswordHistory:
+			values[i] = new([]byte)
+		case user.FieldTotpEnabled, user.FieldPendingApproval:
+			values[i] = new(sql.NullBool)
+		case user.FieldID, user.FieldStatus, user.FieldTenantID, user.FieldRoleID, user.FieldTokenVersion:
 			values[i] = new(sql.NullInt64)
-		case user.FieldUsername, user.FieldPassword, user.FieldNickname, user.FieldSideMode, user.FieldBaseColor, user.FieldActiveColor, user.FieldMobile, user.FieldEmail, user.FieldWecom, user.FieldAvatar:
+		case user.FieldUsername, user.FieldPassword, user.FieldNickname, user.FieldSideMode, user.FieldBaseColor, user.FieldActiveColor, user.FieldMobile, user.FieldEmail, user.FieldWecom, user.FieldAvatar, user.FieldTotpSecret:
 			values[i] = new(sql.NullString)
-		case user.FieldCreatedAt, user.FieldUpdatedAt:
+		case user.FieldCreatedAt, user.FieldUpdatedAt, user.FieldPasswordChangedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -123,6 +185,12 @@ func (_m *User) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Status = uint8(value.Int64)
 			}
+		case user.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				_m.TenantID = uint64(value.Int64)
+			}
 		case user.FieldUsername:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field username", values[i])
@@ -189,6 +257,53 @@ func (_m *User) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Avatar = value.String
 			}
+		case user.FieldTokenVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field token_version", values[i])
+			} else if value.Valid {
+				_m.TokenVersion = uint64(value.Int64)
+			}
+		case user.FieldTotpSecret:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field totp_secret", values[i])
+			} else if value.Valid {
+				_m.TotpSecret = value.String
+			}
+		case user.FieldTotpEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field totp_enabled", values[i])
+			} else if value.Valid {
+				_m.TotpEnabled = value.Bool
+			}
+		case user.FieldRecoveryCodes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field recovery_codes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.RecoveryCodes); err != nil {
+					return fmt.Errorf("unmarshal field recovery_codes: %w", err)
+				}
+			}
+		case user.FieldPasswordHistory:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field password_history", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.PasswordHistory); err != nil {
+					return fmt.Errorf("unmarshal field password_history: %w", err)
+				}
+			}
+		case user.FieldPendingApproval:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field pending_approval", values[i])
+			} else if value.Valid {
+				_m.PendingApproval = value.Bool
+			}
+		case user.FieldPasswordChangedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field password_changed_at", values[i])
+			} else if value.Valid {
+				_m.PasswordChangedAt = new(time.Time)
+				*_m.PasswordChangedAt = value.Time
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -202,9 +317,29 @@ func (_m *User) Value(name string) (ent.Value, error) {
 	return _m.selectValues.Get(name)
 }
 
-// QueryToken queries the "token" edge of the User entity.
-func (_m *User) QueryToken() *TokenQuery {
-	return NewUserClient(_m.config).QueryToken(_m)
+// QueryTokens queries the "tokens" edge of the User entity.
+func (_m *User) QueryTokens() *TokenQuery {
+	return NewUserClient(_m.config).QueryTokens(_m)
+}
+
+// QueryWebauthnCredentials queries the "webauthn_credentials" edge of the User entity.
+func (_m *User) QueryWebauthnCredentials() *WebauthnCredentialQuery {
+	return NewUserClient(_m.config).QueryWebauthnCredentials(_m)
+}
+
+// QueryAPIKeys queries the "api_keys" edge of the User entity.
+func (_m *User) QueryAPIKeys() *APIKeyQuery {
+	return NewUserClient(_m.config).QueryAPIKeys(_m)
+}
+
+// QueryIdentities queries the "identities" edge of the User entity.
+func (_m *User) QueryIdentities() *UserIdentityQuery {
+	return NewUserClient(_m.config).QueryIdentities(_m)
+}
+
+// QueryRoles queries the "roles" edge of the User entity.
+func (_m *User) QueryRoles() *RoleQuery {
+	return NewUserClient(_m.config).QueryRoles(_m)
 }
 
 // Update returns a builder for updating this User.
@@ -239,6 +374,9 @@ func (_m *User) String() string {
 	builder.WriteString("status=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Status))
 	builder.WriteString(", ")
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TenantID))
+	builder.WriteString(", ")
 	builder.WriteString("username=")
 	builder.WriteString(_m.Username)
 	builder.WriteString(", ")
@@ -271,6 +409,26 @@ func (_m *User) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("avatar=")
 	builder.WriteString(_m.Avatar)
+	builder.WriteString(", ")
+	builder.WriteString("token_version=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TokenVersion))
+	builder.WriteString(", ")
+	builder.WriteString("totp_secret=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("totp_enabled=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TotpEnabled))
+	builder.WriteString(", ")
+	builder.WriteString("recovery_codes=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("password_history=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("pending_approval=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PendingApproval))
+	builder.WriteString(", ")
+	if v := _m.PasswordChangedAt; v != nil {
+		builder.WriteString("password_changed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
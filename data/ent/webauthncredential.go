@@ -0,0 +1,265 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"formulago/data/ent/user"
+	"formulago/data/ent/webauthncredential"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// WebauthnCredential is the model entity for the WebauthnCredential schema.
+type WebauthnCredential struct {
+	config `json:"-"`
+	// ID of the ent.
+	// primary key
+	ID uint64 `json:"id,omitempty"`
+	// created time
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// last update time
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// User's ID | 用户的ID
+	UserID uint64 `json:"user_id,omitempty"`
+	// credential name given by the user | 凭证名称
+	Name string `json:"name,omitempty"`
+	// base64url credential ID | base64url编码的凭证ID
+	CredentialID string `json:"credential_id,omitempty"`
+	// COSE public key | COSE格式公钥
+	PublicKey []byte `json:"public_key,omitempty"`
+	// attestation format | 证明格式
+	AttestationType string `json:"attestation_type,omitempty"`
+	// authenticator model AAGUID | 认证器型号AAGUID
+	Aaguid []byte `json:"aaguid,omitempty"`
+	// signature counter | 签名计数器
+	SignCount uint32 `json:"sign_count,omitempty"`
+	// authenticator transports | 认证器传输方式
+	Transports []string `json:"transports,omitempty"`
+	// authenticator flags at registration | 注册时的认证器标志
+	Flags uint8 `json:"flags,omitempty"`
+	// last login time | 最后登录时间
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the WebauthnCredentialQuery when eager-loading is set.
+	Edges        WebauthnCredentialEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// WebauthnCredentialEdges holds the relations/edges for other nodes in the graph.
+type WebauthnCredentialEdges struct {
+	// Owner holds the value of the owner edge.
+	Owner *User `json:"owner,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// OwnerOrErr returns the Owner value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e WebauthnCredentialEdges) OwnerOrErr() (*User, error) {
+	if e.Owner != nil {
+		return e.Owner, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "owner"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*WebauthnCredential) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case webauthncredential.FieldPublicKey, webauthncredential.FieldAaguid, webauthncredential.FieldTransports:
+			values[i] = new([]byte)
+		case webauthncredential.FieldID, webauthncredential.FieldUserID, webauthncredential.FieldSignCount, webauthncredential.FieldFlags:
+			values[i] = new(sql.NullInt64)
+		case webauthncredential.FieldName, webauthncredential.FieldCredentialID, webauthncredential.FieldAttestationType:
+			values[i] = new(sql.NullString)
+		case webauthncredential.FieldCreatedAt, webauthncredential.FieldUpdatedAt, webauthncredential.FieldLastUsedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the WebauthnCredential fields.
+func (_m *WebauthnCredential) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case webauthncredential.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = uint64(value.Int64)
+		case webauthncredential.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case webauthncredential.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case webauthncredential.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = uint64(value.Int64)
+			}
+		case webauthncredential.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case webauthncredential.FieldCredentialID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field credential_id", values[i])
+			} else if value.Valid {
+				_m.CredentialID = value.String
+			}
+		case webauthncredential.FieldPublicKey:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field public_key", values[i])
+			} else if value != nil {
+				_m.PublicKey = *value
+			}
+		case webauthncredential.FieldAttestationType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field attestation_type", values[i])
+			} else if value.Valid {
+				_m.AttestationType = value.String
+			}
+		case webauthncredential.FieldAaguid:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field aaguid", values[i])
+			} else if value != nil {
+				_m.Aaguid = *value
+			}
+		case webauthncredential.FieldSignCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field sign_count", values[i])
+			} else if value.Valid {
+				_m.SignCount = uint32(value.Int64)
+			}
+		case webauthncredential.FieldTransports:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field transports", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Transports); err != nil {
+					return fmt.Errorf("unmarshal field transports: %w", err)
+				}
+			}
+		case webauthncredential.FieldFlags:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field flags", values[i])
+			} else if value.Valid {
+				_m.Flags = uint8(value.Int64)
+			}
+		case webauthncredential.FieldLastUsedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_used_at", values[i])
+			} else if value.Valid {
+				_m.LastUsedAt = new(time.Time)
+				*_m.LastUsedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the WebauthnCredential.
+// This includes values selected through modifiers, order, etc.
+func (_m *WebauthnCredential) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryOwner queries the "owner" edge of the WebauthnCredential entity.
+func (_m *WebauthnCredential) QueryOwner() *UserQuery {
+	return NewWebauthnCredentialClient(_m.config).QueryOwner(_m)
+}
+
+// Update returns a builder for updating this WebauthnCredential.
+// Note that you need to call WebauthnCredential.Unwrap() before calling this method if this WebauthnCredential
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *WebauthnCredential) Update() *WebauthnCredentialUpdateOne {
+	return NewWebauthnCredentialClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the WebauthnCredential entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *WebauthnCredential) Unwrap() *WebauthnCredential {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: WebauthnCredential is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *WebauthnCredential) String() string {
+	var builder strings.Builder
+	builder.WriteString("WebauthnCredential(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("credential_id=")
+	builder.WriteString(_m.CredentialID)
+	builder.WriteString(", ")
+	builder.WriteString("public_key=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PublicKey))
+	builder.WriteString(", ")
+	builder.WriteString("attestation_type=")
+	builder.WriteString(_m.AttestationType)
+	builder.WriteString(", ")
+	builder.WriteString("aaguid=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Aaguid))
+	builder.WriteString(", ")
+	builder.WriteString("sign_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SignCount))
+	builder.WriteString(", ")
+	builder.WriteString("transports=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Transports))
+	builder.WriteString(", ")
+	builder.WriteString("flags=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Flags))
+	builder.WriteString(", ")
+	if v := _m.LastUsedAt; v != nil {
+		builder.WriteString("last_used_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// WebauthnCredentials is a parsable slice of WebauthnCredential.
+type WebauthnCredentials []*WebauthnCredential
@@ -0,0 +1,684 @@
+// Code generated by ent, DO NOT EDIT.
+
+package webauthncredential
+
+import (
+	"formulago/data/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint64) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint64) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint64) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint64) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint64) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint64) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint64) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint64) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint64) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v uint64) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldEQ(FieldUserID, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldEQ(FieldName, v))
+}
+
+// CredentialID applies equality check predicate on the "credential_id" field. It's identical to CredentialIDEQ.
+func CredentialID(v string) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldEQ(FieldCredentialID, v))
+}
+
+// PublicKey applies equality check predicate on the "public_key" field. It's identical to PublicKeyEQ.
+func PublicKey(v []byte) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldEQ(FieldPublicKey, v))
+}
+
+// AttestationType applies equality check predicate on the "attestation_type" field. It's identical to AttestationTypeEQ.
+func AttestationType(v string) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldEQ(FieldAttestationType, v))
+}
+
+// Aaguid applies equality check predicate on the "aaguid" field. It's identical to AaguidEQ.
+func Aaguid(v []byte) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldEQ(FieldAaguid, v))
+}
+
+// SignCount applies equality check predicate on the "sign_count" field. It's identical to SignCountEQ.
+func SignCount(v uint32) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldEQ(FieldSignCount, v))
+}
+
+// Flags applies equality check predicate on the "flags" field. It's identical to FlagsEQ.
+func Flags(v uint8) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldEQ(FieldFlags, v))
+}
+
+// LastUsedAt applies equality check predicate on the "last_used_at" field. It's identical to LastUsedAtEQ.
+func LastUsedAt(v time.Time) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldEQ(FieldLastUsedAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v uint64) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v uint64) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...uint64) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...uint64) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldContainsFold(FieldName, v))
+}
+
+// CredentialIDEQ applies the EQ predicate on the "credential_id" field.
+func CredentialIDEQ(v string) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldEQ(FieldCredentialID, v))
+}
+
+// CredentialIDNEQ applies the NEQ predicate on the "credential_id" field.
+func CredentialIDNEQ(v string) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldNEQ(FieldCredentialID, v))
+}
+
+// CredentialIDIn applies the In predicate on the "credential_id" field.
+func CredentialIDIn(vs ...string) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldIn(FieldCredentialID, vs...))
+}
+
+// CredentialIDNotIn applies the NotIn predicate on the "credential_id" field.
+func CredentialIDNotIn(vs ...string) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldNotIn(FieldCredentialID, vs...))
+}
+
+// CredentialIDGT applies the GT predicate on the "credential_id" field.
+func CredentialIDGT(v string) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldGT(FieldCredentialID, v))
+}
+
+// CredentialIDGTE applies the GTE predicate on the "credential_id" field.
+func CredentialIDGTE(v string) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldGTE(FieldCredentialID, v))
+}
+
+// CredentialIDLT applies the LT predicate on the "credential_id" field.
+func CredentialIDLT(v string) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldLT(FieldCredentialID, v))
+}
+
+// CredentialIDLTE applies the LTE predicate on the "credential_id" field.
+func CredentialIDLTE(v string) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldLTE(FieldCredentialID, v))
+}
+
+// CredentialIDContains applies the Contains predicate on the "credential_id" field.
+func CredentialIDContains(v string) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldContains(FieldCredentialID, v))
+}
+
+// CredentialIDHasPrefix applies the HasPrefix predicate on the "credential_id" field.
+func CredentialIDHasPrefix(v string) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldHasPrefix(FieldCredentialID, v))
+}
+
+// CredentialIDHasSuffix applies the HasSuffix predicate on the "credential_id" field.
+func CredentialIDHasSuffix(v string) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldHasSuffix(FieldCredentialID, v))
+}
+
+// CredentialIDEqualFold applies the EqualFold predicate on the "credential_id" field.
+func CredentialIDEqualFold(v string) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldEqualFold(FieldCredentialID, v))
+}
+
+// CredentialIDContainsFold applies the ContainsFold predicate on the "credential_id" field.
+func CredentialIDContainsFold(v string) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldContainsFold(FieldCredentialID, v))
+}
+
+// PublicKeyEQ applies the EQ predicate on the "public_key" field.
+func PublicKeyEQ(v []byte) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldEQ(FieldPublicKey, v))
+}
+
+// PublicKeyNEQ applies the NEQ predicate on the "public_key" field.
+func PublicKeyNEQ(v []byte) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldNEQ(FieldPublicKey, v))
+}
+
+// PublicKeyIn applies the In predicate on the "public_key" field.
+func PublicKeyIn(vs ...[]byte) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldIn(FieldPublicKey, vs...))
+}
+
+// PublicKeyNotIn applies the NotIn predicate on the "public_key" field.
+func PublicKeyNotIn(vs ...[]byte) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldNotIn(FieldPublicKey, vs...))
+}
+
+// PublicKeyGT applies the GT predicate on the "public_key" field.
+func PublicKeyGT(v []byte) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldGT(FieldPublicKey, v))
+}
+
+// PublicKeyGTE applies the GTE predicate on the "public_key" field.
+func PublicKeyGTE(v []byte) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldGTE(FieldPublicKey, v))
+}
+
+// PublicKeyLT applies the LT predicate on the "public_key" field.
+func PublicKeyLT(v []byte) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldLT(FieldPublicKey, v))
+}
+
+// PublicKeyLTE applies the LTE predicate on the "public_key" field.
+func PublicKeyLTE(v []byte) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldLTE(FieldPublicKey, v))
+}
+
+// AttestationTypeEQ applies the EQ predicate on the "attestation_type" field.
+func AttestationTypeEQ(v string) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldEQ(FieldAttestationType, v))
+}
+
+// AttestationTypeNEQ applies the NEQ predicate on the "attestation_type" field.
+func AttestationTypeNEQ(v string) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldNEQ(FieldAttestationType, v))
+}
+
+// AttestationTypeIn applies the In predicate on the "attestation_type" field.
+func AttestationTypeIn(vs ...string) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldIn(FieldAttestationType, vs...))
+}
+
+// AttestationTypeNotIn applies the NotIn predicate on the "attestation_type" field.
+func AttestationTypeNotIn(vs ...string) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldNotIn(FieldAttestationType, vs...))
+}
+
+// AttestationTypeGT applies the GT predicate on the "attestation_type" field.
+func AttestationTypeGT(v string) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldGT(FieldAttestationType, v))
+}
+
+// AttestationTypeGTE applies the GTE predicate on the "attestation_type" field.
+func AttestationTypeGTE(v string) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldGTE(FieldAttestationType, v))
+}
+
+// AttestationTypeLT applies the LT predicate on the "attestation_type" field.
+func AttestationTypeLT(v string) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldLT(FieldAttestationType, v))
+}
+
+// AttestationTypeLTE applies the LTE predicate on the "attestation_type" field.
+func AttestationTypeLTE(v string) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldLTE(FieldAttestationType, v))
+}
+
+// AttestationTypeContains applies the Contains predicate on the "attestation_type" field.
+func AttestationTypeContains(v string) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldContains(FieldAttestationType, v))
+}
+
+// AttestationTypeHasPrefix applies the HasPrefix predicate on the "attestation_type" field.
+func AttestationTypeHasPrefix(v string) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldHasPrefix(FieldAttestationType, v))
+}
+
+// AttestationTypeHasSuffix applies the HasSuffix predicate on the "attestation_type" field.
+func AttestationTypeHasSuffix(v string) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldHasSuffix(FieldAttestationType, v))
+}
+
+// AttestationTypeIsNil applies the IsNil predicate on the "attestation_type" field.
+func AttestationTypeIsNil() predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldIsNull(FieldAttestationType))
+}
+
+// AttestationTypeNotNil applies the NotNil predicate on the "attestation_type" field.
+func AttestationTypeNotNil() predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldNotNull(FieldAttestationType))
+}
+
+// AttestationTypeEqualFold applies the EqualFold predicate on the "attestation_type" field.
+func AttestationTypeEqualFold(v string) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldEqualFold(FieldAttestationType, v))
+}
+
+// AttestationTypeContainsFold applies the ContainsFold predicate on the "attestation_type" field.
+func AttestationTypeContainsFold(v string) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldContainsFold(FieldAttestationType, v))
+}
+
+// AaguidEQ applies the EQ predicate on the "aaguid" field.
+func AaguidEQ(v []byte) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldEQ(FieldAaguid, v))
+}
+
+// AaguidNEQ applies the NEQ predicate on the "aaguid" field.
+func AaguidNEQ(v []byte) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldNEQ(FieldAaguid, v))
+}
+
+// AaguidIn applies the In predicate on the "aaguid" field.
+func AaguidIn(vs ...[]byte) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldIn(FieldAaguid, vs...))
+}
+
+// AaguidNotIn applies the NotIn predicate on the "aaguid" field.
+func AaguidNotIn(vs ...[]byte) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldNotIn(FieldAaguid, vs...))
+}
+
+// AaguidGT applies the GT predicate on the "aaguid" field.
+func AaguidGT(v []byte) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldGT(FieldAaguid, v))
+}
+
+// AaguidGTE applies the GTE predicate on the "aaguid" field.
+func AaguidGTE(v []byte) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldGTE(FieldAaguid, v))
+}
+
+// AaguidLT applies the LT predicate on the "aaguid" field.
+func AaguidLT(v []byte) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldLT(FieldAaguid, v))
+}
+
+// AaguidLTE applies the LTE predicate on the "aaguid" field.
+func AaguidLTE(v []byte) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldLTE(FieldAaguid, v))
+}
+
+// AaguidIsNil applies the IsNil predicate on the "aaguid" field.
+func AaguidIsNil() predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldIsNull(FieldAaguid))
+}
+
+// AaguidNotNil applies the NotNil predicate on the "aaguid" field.
+func AaguidNotNil() predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldNotNull(FieldAaguid))
+}
+
+// SignCountEQ applies the EQ predicate on the "sign_count" field.
+func SignCountEQ(v uint32) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldEQ(FieldSignCount, v))
+}
+
+// SignCountNEQ applies the NEQ predicate on the "sign_count" field.
+func SignCountNEQ(v uint32) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldNEQ(FieldSignCount, v))
+}
+
+// SignCountIn applies the In predicate on the "sign_count" field.
+func SignCountIn(vs ...uint32) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldIn(FieldSignCount, vs...))
+}
+
+// SignCountNotIn applies the NotIn predicate on the "sign_count" field.
+func SignCountNotIn(vs ...uint32) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldNotIn(FieldSignCount, vs...))
+}
+
+// SignCountGT applies the GT predicate on the "sign_count" field.
+func SignCountGT(v uint32) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldGT(FieldSignCount, v))
+}
+
+// SignCountGTE applies the GTE predicate on the "sign_count" field.
+func SignCountGTE(v uint32) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldGTE(FieldSignCount, v))
+}
+
+// SignCountLT applies the LT predicate on the "sign_count" field.
+func SignCountLT(v uint32) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldLT(FieldSignCount, v))
+}
+
+// SignCountLTE applies the LTE predicate on the "sign_count" field.
+func SignCountLTE(v uint32) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldLTE(FieldSignCount, v))
+}
+
+// TransportsIsNil applies the IsNil predicate on the "transports" field.
+func TransportsIsNil() predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldIsNull(FieldTransports))
+}
+
+// TransportsNotNil applies the NotNil predicate on the "transports" field.
+func TransportsNotNil() predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldNotNull(FieldTransports))
+}
+
+// FlagsEQ applies the EQ predicate on the "flags" field.
+func FlagsEQ(v uint8) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldEQ(FieldFlags, v))
+}
+
+// FlagsNEQ applies the NEQ predicate on the "flags" field.
+func FlagsNEQ(v uint8) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldNEQ(FieldFlags, v))
+}
+
+// FlagsIn applies the In predicate on the "flags" field.
+func FlagsIn(vs ...uint8) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldIn(FieldFlags, vs...))
+}
+
+// FlagsNotIn applies the NotIn predicate on the "flags" field.
+func FlagsNotIn(vs ...uint8) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldNotIn(FieldFlags, vs...))
+}
+
+// FlagsGT applies the GT predicate on the "flags" field.
+func FlagsGT(v uint8) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldGT(FieldFlags, v))
+}
+
+// FlagsGTE applies the GTE predicate on the "flags" field.
+func FlagsGTE(v uint8) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldGTE(FieldFlags, v))
+}
+
+// FlagsLT applies the LT predicate on the "flags" field.
+func FlagsLT(v uint8) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldLT(FieldFlags, v))
+}
+
+// FlagsLTE applies the LTE predicate on the "flags" field.
+func FlagsLTE(v uint8) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldLTE(FieldFlags, v))
+}
+
+// LastUsedAtEQ applies the EQ predicate on the "last_used_at" field.
+func LastUsedAtEQ(v time.Time) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldEQ(FieldLastUsedAt, v))
+}
+
+// LastUsedAtNEQ applies the NEQ predicate on the "last_used_at" field.
+func LastUsedAtNEQ(v time.Time) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldNEQ(FieldLastUsedAt, v))
+}
+
+// LastUsedAtIn applies the In predicate on the "last_used_at" field.
+func LastUsedAtIn(vs ...time.Time) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldIn(FieldLastUsedAt, vs...))
+}
+
+// LastUsedAtNotIn applies the NotIn predicate on the "last_used_at" field.
+func LastUsedAtNotIn(vs ...time.Time) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldNotIn(FieldLastUsedAt, vs...))
+}
+
+// LastUsedAtGT applies the GT predicate on the "last_used_at" field.
+func LastUsedAtGT(v time.Time) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldGT(FieldLastUsedAt, v))
+}
+
+// LastUsedAtGTE applies the GTE predicate on the "last_used_at" field.
+func LastUsedAtGTE(v time.Time) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldGTE(FieldLastUsedAt, v))
+}
+
+// LastUsedAtLT applies the LT predicate on the "last_used_at" field.
+func LastUsedAtLT(v time.Time) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldLT(FieldLastUsedAt, v))
+}
+
+// LastUsedAtLTE applies the LTE predicate on the "last_used_at" field.
+func LastUsedAtLTE(v time.Time) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldLTE(FieldLastUsedAt, v))
+}
+
+// LastUsedAtIsNil applies the IsNil predicate on the "last_used_at" field.
+func LastUsedAtIsNil() predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldIsNull(FieldLastUsedAt))
+}
+
+// LastUsedAtNotNil applies the NotNil predicate on the "last_used_at" field.
+func LastUsedAtNotNil() predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.FieldNotNull(FieldLastUsedAt))
+}
+
+// HasOwner applies the HasEdge predicate on the "owner" edge.
+func HasOwner() predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, OwnerTable, OwnerColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOwnerWith applies the HasEdge predicate on the "owner" edge with a given conditions (other predicates).
+func HasOwnerWith(preds ...predicate.User) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(func(s *sql.Selector) {
+		step := newOwnerStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.WebauthnCredential) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.WebauthnCredential) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.WebauthnCredential) predicate.WebauthnCredential {
+	return predicate.WebauthnCredential(sql.NotPredicates(p))
+}
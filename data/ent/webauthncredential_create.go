@@ -0,0 +1,441 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"formulago/data/ent/user"
+	"formulago/data/ent/webauthncredential"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// WebauthnCredentialCreate is the builder for creating a WebauthnCredential entity.
+type WebauthnCredentialCreate struct {
+	config
+	mutation *WebauthnCredentialMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *WebauthnCredentialCreate) SetCreatedAt(v time.Time) *WebauthnCredentialCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *WebauthnCredentialCreate) SetNillableCreatedAt(v *time.Time) *WebauthnCredentialCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *WebauthnCredentialCreate) SetUpdatedAt(v time.Time) *WebauthnCredentialCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *WebauthnCredentialCreate) SetNillableUpdatedAt(v *time.Time) *WebauthnCredentialCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *WebauthnCredentialCreate) SetUserID(v uint64) *WebauthnCredentialCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *WebauthnCredentialCreate) SetName(v string) *WebauthnCredentialCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_c *WebauthnCredentialCreate) SetNillableName(v *string) *WebauthnCredentialCreate {
+	if v != nil {
+		_c.SetName(*v)
+	}
+	return _c
+}
+
+// SetCredentialID sets the "credential_id" field.
+func (_c *WebauthnCredentialCreate) SetCredentialID(v string) *WebauthnCredentialCreate {
+	_c.mutation.SetCredentialID(v)
+	return _c
+}
+
+// SetPublicKey sets the "public_key" field.
+func (_c *WebauthnCredentialCreate) SetPublicKey(v []byte) *WebauthnCredentialCreate {
+	_c.mutation.SetPublicKey(v)
+	return _c
+}
+
+// SetAttestationType sets the "attestation_type" field.
+func (_c *WebauthnCredentialCreate) SetAttestationType(v string) *WebauthnCredentialCreate {
+	_c.mutation.SetAttestationType(v)
+	return _c
+}
+
+// SetNillableAttestationType sets the "attestation_type" field if the given value is not nil.
+func (_c *WebauthnCredentialCreate) SetNillableAttestationType(v *string) *WebauthnCredentialCreate {
+	if v != nil {
+		_c.SetAttestationType(*v)
+	}
+	return _c
+}
+
+// SetAaguid sets the "aaguid" field.
+func (_c *WebauthnCredentialCreate) SetAaguid(v []byte) *WebauthnCredentialCreate {
+	_c.mutation.SetAaguid(v)
+	return _c
+}
+
+// SetSignCount sets the "sign_count" field.
+func (_c *WebauthnCredentialCreate) SetSignCount(v uint32) *WebauthnCredentialCreate {
+	_c.mutation.SetSignCount(v)
+	return _c
+}
+
+// SetNillableSignCount sets the "sign_count" field if the given value is not nil.
+func (_c *WebauthnCredentialCreate) SetNillableSignCount(v *uint32) *WebauthnCredentialCreate {
+	if v != nil {
+		_c.SetSignCount(*v)
+	}
+	return _c
+}
+
+// SetTransports sets the "transports" field.
+func (_c *WebauthnCredentialCreate) SetTransports(v []string) *WebauthnCredentialCreate {
+	_c.mutation.SetTransports(v)
+	return _c
+}
+
+// SetFlags sets the "flags" field.
+func (_c *WebauthnCredentialCreate) SetFlags(v uint8) *WebauthnCredentialCreate {
+	_c.mutation.SetFlags(v)
+	return _c
+}
+
+// SetNillableFlags sets the "flags" field if the given value is not nil.
+func (_c *WebauthnCredentialCreate) SetNillableFlags(v *uint8) *WebauthnCredentialCreate {
+	if v != nil {
+		_c.SetFlags(*v)
+	}
+	return _c
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (_c *WebauthnCredentialCreate) SetLastUsedAt(v time.Time) *WebauthnCredentialCreate {
+	_c.mutation.SetLastUsedAt(v)
+	return _c
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (_c *WebauthnCredentialCreate) SetNillableLastUsedAt(v *time.Time) *WebauthnCredentialCreate {
+	if v != nil {
+		_c.SetLastUsedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *WebauthnCredentialCreate) SetID(v uint64) *WebauthnCredentialCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by ID.
+func (_c *WebauthnCredentialCreate) SetOwnerID(id uint64) *WebauthnCredentialCreate {
+	_c.mutation.SetOwnerID(id)
+	return _c
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (_c *WebauthnCredentialCreate) SetOwner(v *User) *WebauthnCredentialCreate {
+	return _c.SetOwnerID(v.ID)
+}
+
+// Mutation returns the WebauthnCredentialMutation object of the builder.
+func (_c *WebauthnCredentialCreate) Mutation() *WebauthnCredentialMutation {
+	return _c.mutation
+}
+
+// Save creates the WebauthnCredential in the database.
+func (_c *WebauthnCredentialCreate) Save(ctx context.Context) (*WebauthnCredential, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *WebauthnCredentialCreate) SaveX(ctx context.Context) *WebauthnCredential {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *WebauthnCredentialCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *WebauthnCredentialCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *WebauthnCredentialCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := webauthncredential.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := webauthncredential.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		v := webauthncredential.DefaultName
+		_c.mutation.SetName(v)
+	}
+	if _, ok := _c.mutation.AttestationType(); !ok {
+		v := webauthncredential.DefaultAttestationType
+		_c.mutation.SetAttestationType(v)
+	}
+	if _, ok := _c.mutation.SignCount(); !ok {
+		v := webauthncredential.DefaultSignCount
+		_c.mutation.SetSignCount(v)
+	}
+	if _, ok := _c.mutation.Flags(); !ok {
+		v := webauthncredential.DefaultFlags
+		_c.mutation.SetFlags(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *WebauthnCredentialCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "WebauthnCredential.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "WebauthnCredential.updated_at"`)}
+	}
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "WebauthnCredential.user_id"`)}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "WebauthnCredential.name"`)}
+	}
+	if _, ok := _c.mutation.CredentialID(); !ok {
+		return &ValidationError{Name: "credential_id", err: errors.New(`ent: missing required field "WebauthnCredential.credential_id"`)}
+	}
+	if _, ok := _c.mutation.PublicKey(); !ok {
+		return &ValidationError{Name: "public_key", err: errors.New(`ent: missing required field "WebauthnCredential.public_key"`)}
+	}
+	if _, ok := _c.mutation.SignCount(); !ok {
+		return &ValidationError{Name: "sign_count", err: errors.New(`ent: missing required field "WebauthnCredential.sign_count"`)}
+	}
+	if _, ok := _c.mutation.Flags(); !ok {
+		return &ValidationError{Name: "flags", err: errors.New(`ent: missing required field "WebauthnCredential.flags"`)}
+	}
+	if len(_c.mutation.OwnerIDs()) == 0 {
+		return &ValidationError{Name: "owner", err: errors.New(`ent: missing required edge "WebauthnCredential.owner"`)}
+	}
+	return nil
+}
+
+func (_c *WebauthnCredentialCreate) sqlSave(ctx context.Context) (*WebauthnCredential, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint64(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *WebauthnCredentialCreate) createSpec() (*WebauthnCredential, *sqlgraph.CreateSpec) {
+	var (
+		_node = &WebauthnCredential{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(webauthncredential.Table, sqlgraph.NewFieldSpec(webauthncredential.FieldID, field.TypeUint64))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(webauthncredential.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(webauthncredential.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(webauthncredential.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.CredentialID(); ok {
+		_spec.SetField(webauthncredential.FieldCredentialID, field.TypeString, value)
+		_node.CredentialID = value
+	}
+	if value, ok := _c.mutation.PublicKey(); ok {
+		_spec.SetField(webauthncredential.FieldPublicKey, field.TypeBytes, value)
+		_node.PublicKey = value
+	}
+	if value, ok := _c.mutation.AttestationType(); ok {
+		_spec.SetField(webauthncredential.FieldAttestationType, field.TypeString, value)
+		_node.AttestationType = value
+	}
+	if value, ok := _c.mutation.Aaguid(); ok {
+		_spec.SetField(webauthncredential.FieldAaguid, field.TypeBytes, value)
+		_node.Aaguid = value
+	}
+	if value, ok := _c.mutation.SignCount(); ok {
+		_spec.SetField(webauthncredential.FieldSignCount, field.TypeUint32, value)
+		_node.SignCount = value
+	}
+	if value, ok := _c.mutation.Transports(); ok {
+		_spec.SetField(webauthncredential.FieldTransports, field.TypeJSON, value)
+		_node.Transports = value
+	}
+	if value, ok := _c.mutation.Flags(); ok {
+		_spec.SetField(webauthncredential.FieldFlags, field.TypeUint8, value)
+		_node.Flags = value
+	}
+	if value, ok := _c.mutation.LastUsedAt(); ok {
+		_spec.SetField(webauthncredential.FieldLastUsedAt, field.TypeTime, value)
+		_node.LastUsedAt = &value
+	}
+	if nodes := _c.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webauthncredential.OwnerTable,
+			Columns: []string{webauthncredential.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// WebauthnCredentialCreateBulk is the builder for creating many WebauthnCredential entities in bulk.
+type WebauthnCredentialCreateBulk struct {
+	config
+	err      error
+	builders []*WebauthnCredentialCreate
+}
+
+// Save creates the WebauthnCredential entities in the database.
+func (_c *WebauthnCredentialCreateBulk) Save(ctx context.Context) ([]*WebauthnCredential, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*WebauthnCredential, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WebauthnCredentialMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *WebauthnCredentialCreateBulk) SaveX(ctx context.Context) []*WebauthnCredential {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *WebauthnCredentialCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *WebauthnCredentialCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
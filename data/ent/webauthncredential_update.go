@@ -0,0 +1,748 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"formulago/data/ent/predicate"
+	"formulago/data/ent/user"
+	"formulago/data/ent/webauthncredential"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// WebauthnCredentialUpdate is the builder for updating WebauthnCredential entities.
+type WebauthnCredentialUpdate struct {
+	config
+	hooks    []Hook
+	mutation *WebauthnCredentialMutation
+}
+
+// Where appends a list predicates to the WebauthnCredentialUpdate builder.
+func (_u *WebauthnCredentialUpdate) Where(ps ...predicate.WebauthnCredential) *WebauthnCredentialUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *WebauthnCredentialUpdate) SetUpdatedAt(v time.Time) *WebauthnCredentialUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *WebauthnCredentialUpdate) SetUserID(v uint64) *WebauthnCredentialUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *WebauthnCredentialUpdate) SetNillableUserID(v *uint64) *WebauthnCredentialUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *WebauthnCredentialUpdate) SetName(v string) *WebauthnCredentialUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *WebauthnCredentialUpdate) SetNillableName(v *string) *WebauthnCredentialUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetCredentialID sets the "credential_id" field.
+func (_u *WebauthnCredentialUpdate) SetCredentialID(v string) *WebauthnCredentialUpdate {
+	_u.mutation.SetCredentialID(v)
+	return _u
+}
+
+// SetNillableCredentialID sets the "credential_id" field if the given value is not nil.
+func (_u *WebauthnCredentialUpdate) SetNillableCredentialID(v *string) *WebauthnCredentialUpdate {
+	if v != nil {
+		_u.SetCredentialID(*v)
+	}
+	return _u
+}
+
+// SetPublicKey sets the "public_key" field.
+func (_u *WebauthnCredentialUpdate) SetPublicKey(v []byte) *WebauthnCredentialUpdate {
+	_u.mutation.SetPublicKey(v)
+	return _u
+}
+
+// SetAttestationType sets the "attestation_type" field.
+func (_u *WebauthnCredentialUpdate) SetAttestationType(v string) *WebauthnCredentialUpdate {
+	_u.mutation.SetAttestationType(v)
+	return _u
+}
+
+// SetNillableAttestationType sets the "attestation_type" field if the given value is not nil.
+func (_u *WebauthnCredentialUpdate) SetNillableAttestationType(v *string) *WebauthnCredentialUpdate {
+	if v != nil {
+		_u.SetAttestationType(*v)
+	}
+	return _u
+}
+
+// ClearAttestationType clears the value of the "attestation_type" field.
+func (_u *WebauthnCredentialUpdate) ClearAttestationType() *WebauthnCredentialUpdate {
+	_u.mutation.ClearAttestationType()
+	return _u
+}
+
+// SetAaguid sets the "aaguid" field.
+func (_u *WebauthnCredentialUpdate) SetAaguid(v []byte) *WebauthnCredentialUpdate {
+	_u.mutation.SetAaguid(v)
+	return _u
+}
+
+// ClearAaguid clears the value of the "aaguid" field.
+func (_u *WebauthnCredentialUpdate) ClearAaguid() *WebauthnCredentialUpdate {
+	_u.mutation.ClearAaguid()
+	return _u
+}
+
+// SetSignCount sets the "sign_count" field.
+func (_u *WebauthnCredentialUpdate) SetSignCount(v uint32) *WebauthnCredentialUpdate {
+	_u.mutation.ResetSignCount()
+	_u.mutation.SetSignCount(v)
+	return _u
+}
+
+// SetNillableSignCount sets the "sign_count" field if the given value is not nil.
+func (_u *WebauthnCredentialUpdate) SetNillableSignCount(v *uint32) *WebauthnCredentialUpdate {
+	if v != nil {
+		_u.SetSignCount(*v)
+	}
+	return _u
+}
+
+// AddSignCount adds value to the "sign_count" field.
+func (_u *WebauthnCredentialUpdate) AddSignCount(v int32) *WebauthnCredentialUpdate {
+	_u.mutation.AddSignCount(v)
+	return _u
+}
+
+// SetTransports sets the "transports" field.
+func (_u *WebauthnCredentialUpdate) SetTransports(v []string) *WebauthnCredentialUpdate {
+	_u.mutation.SetTransports(v)
+	return _u
+}
+
+// AppendTransports appends value to the "transports" field.
+func (_u *WebauthnCredentialUpdate) AppendTransports(v []string) *WebauthnCredentialUpdate {
+	_u.mutation.AppendTransports(v)
+	return _u
+}
+
+// ClearTransports clears the value of the "transports" field.
+func (_u *WebauthnCredentialUpdate) ClearTransports() *WebauthnCredentialUpdate {
+	_u.mutation.ClearTransports()
+	return _u
+}
+
+// SetFlags sets the "flags" field.
+func (_u *WebauthnCredentialUpdate) SetFlags(v uint8) *WebauthnCredentialUpdate {
+	_u.mutation.ResetFlags()
+	_u.mutation.SetFlags(v)
+	return _u
+}
+
+// SetNillableFlags sets the "flags" field if the given value is not nil.
+func (_u *WebauthnCredentialUpdate) SetNillableFlags(v *uint8) *WebauthnCredentialUpdate {
+	if v != nil {
+		_u.SetFlags(*v)
+	}
+	return _u
+}
+
+// AddFlags adds value to the "flags" field.
+func (_u *WebauthnCredentialUpdate) AddFlags(v int8) *WebauthnCredentialUpdate {
+	_u.mutation.AddFlags(v)
+	return _u
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (_u *WebauthnCredentialUpdate) SetLastUsedAt(v time.Time) *WebauthnCredentialUpdate {
+	_u.mutation.SetLastUsedAt(v)
+	return _u
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (_u *WebauthnCredentialUpdate) SetNillableLastUsedAt(v *time.Time) *WebauthnCredentialUpdate {
+	if v != nil {
+		_u.SetLastUsedAt(*v)
+	}
+	return _u
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (_u *WebauthnCredentialUpdate) ClearLastUsedAt() *WebauthnCredentialUpdate {
+	_u.mutation.ClearLastUsedAt()
+	return _u
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by ID.
+func (_u *WebauthnCredentialUpdate) SetOwnerID(id uint64) *WebauthnCredentialUpdate {
+	_u.mutation.SetOwnerID(id)
+	return _u
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (_u *WebauthnCredentialUpdate) SetOwner(v *User) *WebauthnCredentialUpdate {
+	return _u.SetOwnerID(v.ID)
+}
+
+// Mutation returns the WebauthnCredentialMutation object of the builder.
+func (_u *WebauthnCredentialUpdate) Mutation() *WebauthnCredentialMutation {
+	return _u.mutation
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (_u *WebauthnCredentialUpdate) ClearOwner() *WebauthnCredentialUpdate {
+	_u.mutation.ClearOwner()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *WebauthnCredentialUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *WebauthnCredentialUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *WebauthnCredentialUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *WebauthnCredentialUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *WebauthnCredentialUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := webauthncredential.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *WebauthnCredentialUpdate) check() error {
+	if _u.mutation.OwnerCleared() && len(_u.mutation.OwnerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "WebauthnCredential.owner"`)
+	}
+	return nil
+}
+
+func (_u *WebauthnCredentialUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(webauthncredential.Table, webauthncredential.Columns, sqlgraph.NewFieldSpec(webauthncredential.FieldID, field.TypeUint64))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(webauthncredential.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(webauthncredential.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CredentialID(); ok {
+		_spec.SetField(webauthncredential.FieldCredentialID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PublicKey(); ok {
+		_spec.SetField(webauthncredential.FieldPublicKey, field.TypeBytes, value)
+	}
+	if value, ok := _u.mutation.AttestationType(); ok {
+		_spec.SetField(webauthncredential.FieldAttestationType, field.TypeString, value)
+	}
+	if _u.mutation.AttestationTypeCleared() {
+		_spec.ClearField(webauthncredential.FieldAttestationType, field.TypeString)
+	}
+	if value, ok := _u.mutation.Aaguid(); ok {
+		_spec.SetField(webauthncredential.FieldAaguid, field.TypeBytes, value)
+	}
+	if _u.mutation.AaguidCleared() {
+		_spec.ClearField(webauthncredential.FieldAaguid, field.TypeBytes)
+	}
+	if value, ok := _u.mutation.SignCount(); ok {
+		_spec.SetField(webauthncredential.FieldSignCount, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedSignCount(); ok {
+		_spec.AddField(webauthncredential.FieldSignCount, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.Transports(); ok {
+		_spec.SetField(webauthncredential.FieldTransports, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTransports(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, webauthncredential.FieldTransports, value)
+		})
+	}
+	if _u.mutation.TransportsCleared() {
+		_spec.ClearField(webauthncredential.FieldTransports, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Flags(); ok {
+		_spec.SetField(webauthncredential.FieldFlags, field.TypeUint8, value)
+	}
+	if value, ok := _u.mutation.AddedFlags(); ok {
+		_spec.AddField(webauthncredential.FieldFlags, field.TypeUint8, value)
+	}
+	if value, ok := _u.mutation.LastUsedAt(); ok {
+		_spec.SetField(webauthncredential.FieldLastUsedAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastUsedAtCleared() {
+		_spec.ClearField(webauthncredential.FieldLastUsedAt, field.TypeTime)
+	}
+	if _u.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webauthncredential.OwnerTable,
+			Columns: []string{webauthncredential.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webauthncredential.OwnerTable,
+			Columns: []string{webauthncredential.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webauthncredential.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// WebauthnCredentialUpdateOne is the builder for updating a single WebauthnCredential entity.
+type WebauthnCredentialUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *WebauthnCredentialMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *WebauthnCredentialUpdateOne) SetUpdatedAt(v time.Time) *WebauthnCredentialUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *WebauthnCredentialUpdateOne) SetUserID(v uint64) *WebauthnCredentialUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *WebauthnCredentialUpdateOne) SetNillableUserID(v *uint64) *WebauthnCredentialUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *WebauthnCredentialUpdateOne) SetName(v string) *WebauthnCredentialUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *WebauthnCredentialUpdateOne) SetNillableName(v *string) *WebauthnCredentialUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetCredentialID sets the "credential_id" field.
+func (_u *WebauthnCredentialUpdateOne) SetCredentialID(v string) *WebauthnCredentialUpdateOne {
+	_u.mutation.SetCredentialID(v)
+	return _u
+}
+
+// SetNillableCredentialID sets the "credential_id" field if the given value is not nil.
+func (_u *WebauthnCredentialUpdateOne) SetNillableCredentialID(v *string) *WebauthnCredentialUpdateOne {
+	if v != nil {
+		_u.SetCredentialID(*v)
+	}
+	return _u
+}
+
+// SetPublicKey sets the "public_key" field.
+func (_u *WebauthnCredentialUpdateOne) SetPublicKey(v []byte) *WebauthnCredentialUpdateOne {
+	_u.mutation.SetPublicKey(v)
+	return _u
+}
+
+// SetAttestationType sets the "attestation_type" field.
+func (_u *WebauthnCredentialUpdateOne) SetAttestationType(v string) *WebauthnCredentialUpdateOne {
+	_u.mutation.SetAttestationType(v)
+	return _u
+}
+
+// SetNillableAttestationType sets the "attestation_type" field if the given value is not nil.
+func (_u *WebauthnCredentialUpdateOne) SetNillableAttestationType(v *string) *WebauthnCredentialUpdateOne {
+	if v != nil {
+		_u.SetAttestationType(*v)
+	}
+	return _u
+}
+
+// ClearAttestationType clears the value of the "attestation_type" field.
+func (_u *WebauthnCredentialUpdateOne) ClearAttestationType() *WebauthnCredentialUpdateOne {
+	_u.mutation.ClearAttestationType()
+	return _u
+}
+
+// SetAaguid sets the "aaguid" field.
+func (_u *WebauthnCredentialUpdateOne) SetAaguid(v []byte) *WebauthnCredentialUpdateOne {
+	_u.mutation.SetAaguid(v)
+	return _u
+}
+
+// ClearAaguid clears the value of the "aaguid" field.
+func (_u *WebauthnCredentialUpdateOne) ClearAaguid() *WebauthnCredentialUpdateOne {
+	_u.mutation.ClearAaguid()
+	return _u
+}
+
+// SetSignCount sets the "sign_count" field.
+func (_u *WebauthnCredentialUpdateOne) SetSignCount(v uint32) *WebauthnCredentialUpdateOne {
+	_u.mutation.ResetSignCount()
+	_u.mutation.SetSignCount(v)
+	return _u
+}
+
+// SetNillableSignCount sets the "sign_count" field if the given value is not nil.
+func (_u *WebauthnCredentialUpdateOne) SetNillableSignCount(v *uint32) *WebauthnCredentialUpdateOne {
+	if v != nil {
+		_u.SetSignCount(*v)
+	}
+	return _u
+}
+
+// AddSignCount adds value to the "sign_count" field.
+func (_u *WebauthnCredentialUpdateOne) AddSignCount(v int32) *WebauthnCredentialUpdateOne {
+	_u.mutation.AddSignCount(v)
+	return _u
+}
+
+// SetTransports sets the "transports" field.
+func (_u *WebauthnCredentialUpdateOne) SetTransports(v []string) *WebauthnCredentialUpdateOne {
+	_u.mutation.SetTransports(v)
+	return _u
+}
+
+// AppendTransports appends value to the "transports" field.
+func (_u *WebauthnCredentialUpdateOne) AppendTransports(v []string) *WebauthnCredentialUpdateOne {
+	_u.mutation.AppendTransports(v)
+	return _u
+}
+
+// ClearTransports clears the value of the "transports" field.
+func (_u *WebauthnCredentialUpdateOne) ClearTransports() *WebauthnCredentialUpdateOne {
+	_u.mutation.ClearTransports()
+	return _u
+}
+
+// SetFlags sets the "flags" field.
+func (_u *WebauthnCredentialUpdateOne) SetFlags(v uint8) *WebauthnCredentialUpdateOne {
+	_u.mutation.ResetFlags()
+	_u.mutation.SetFlags(v)
+	return _u
+}
+
+// SetNillableFlags sets the "flags" field if the given value is not nil.
+func (_u *WebauthnCredentialUpdateOne) SetNillableFlags(v *uint8) *WebauthnCredentialUpdateOne {
+	if v != nil {
+		_u.SetFlags(*v)
+	}
+	return _u
+}
+
+// AddFlags adds value to the "flags" field.
+func (_u *WebauthnCredentialUpdateOne) AddFlags(v int8) *WebauthnCredentialUpdateOne {
+	_u.mutation.AddFlags(v)
+	return _u
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (_u *WebauthnCredentialUpdateOne) SetLastUsedAt(v time.Time) *WebauthnCredentialUpdateOne {
+	_u.mutation.SetLastUsedAt(v)
+	return _u
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (_u *WebauthnCredentialUpdateOne) SetNillableLastUsedAt(v *time.Time) *WebauthnCredentialUpdateOne {
+	if v != nil {
+		_u.SetLastUsedAt(*v)
+	}
+	return _u
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (_u *WebauthnCredentialUpdateOne) ClearLastUsedAt() *WebauthnCredentialUpdateOne {
+	_u.mutation.ClearLastUsedAt()
+	return _u
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by ID.
+func (_u *WebauthnCredentialUpdateOne) SetOwnerID(id uint64) *WebauthnCredentialUpdateOne {
+	_u.mutation.SetOwnerID(id)
+	return _u
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (_u *WebauthnCredentialUpdateOne) SetOwner(v *User) *WebauthnCredentialUpdateOne {
+	return _u.SetOwnerID(v.ID)
+}
+
+// Mutation returns the WebauthnCredentialMutation object of the builder.
+func (_u *WebauthnCredentialUpdateOne) Mutation() *WebauthnCredentialMutation {
+	return _u.mutation
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (_u *WebauthnCredentialUpdateOne) ClearOwner() *WebauthnCredentialUpdateOne {
+	_u.mutation.ClearOwner()
+	return _u
+}
+
+// Where appends a list predicates to the WebauthnCredentialUpdate builder.
+func (_u *WebauthnCredentialUpdateOne) Where(ps ...predicate.WebauthnCredential) *WebauthnCredentialUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *WebauthnCredentialUpdateOne) Select(field string, fields ...string) *WebauthnCredentialUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated WebauthnCredential entity.
+func (_u *WebauthnCredentialUpdateOne) Save(ctx context.Context) (*WebauthnCredential, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *WebauthnCredentialUpdateOne) SaveX(ctx context.Context) *WebauthnCredential {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *WebauthnCredentialUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *WebauthnCredentialUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *WebauthnCredentialUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := webauthncredential.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *WebauthnCredentialUpdateOne) check() error {
+	if _u.mutation.OwnerCleared() && len(_u.mutation.OwnerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "WebauthnCredential.owner"`)
+	}
+	return nil
+}
+
+func (_u *WebauthnCredentialUpdateOne) sqlSave(ctx context.Context) (_node *WebauthnCredential, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(webauthncredential.Table, webauthncredential.Columns, sqlgraph.NewFieldSpec(webauthncredential.FieldID, field.TypeUint64))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "WebauthnCredential.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, webauthncredential.FieldID)
+		for _, f := range fields {
+			if !webauthncredential.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != webauthncredential.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(webauthncredential.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(webauthncredential.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CredentialID(); ok {
+		_spec.SetField(webauthncredential.FieldCredentialID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PublicKey(); ok {
+		_spec.SetField(webauthncredential.FieldPublicKey, field.TypeBytes, value)
+	}
+	if value, ok := _u.mutation.AttestationType(); ok {
+		_spec.SetField(webauthncredential.FieldAttestationType, field.TypeString, value)
+	}
+	if _u.mutation.AttestationTypeCleared() {
+		_spec.ClearField(webauthncredential.FieldAttestationType, field.TypeString)
+	}
+	if value, ok := _u.mutation.Aaguid(); ok {
+		_spec.SetField(webauthncredential.FieldAaguid, field.TypeBytes, value)
+	}
+	if _u.mutation.AaguidCleared() {
+		_spec.ClearField(webauthncredential.FieldAaguid, field.TypeBytes)
+	}
+	if value, ok := _u.mutation.SignCount(); ok {
+		_spec.SetField(webauthncredential.FieldSignCount, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedSignCount(); ok {
+		_spec.AddField(webauthncredential.FieldSignCount, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.Transports(); ok {
+		_spec.SetField(webauthncredential.FieldTransports, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTransports(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, webauthncredential.FieldTransports, value)
+		})
+	}
+	if _u.mutation.TransportsCleared() {
+		_spec.ClearField(webauthncredential.FieldTransports, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Flags(); ok {
+		_spec.SetField(webauthncredential.FieldFlags, field.TypeUint8, value)
+	}
+	if value, ok := _u.mutation.AddedFlags(); ok {
+		_spec.AddField(webauthncredential.FieldFlags, field.TypeUint8, value)
+	}
+	if value, ok := _u.mutation.LastUsedAt(); ok {
+		_spec.SetField(webauthncredential.FieldLastUsedAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastUsedAtCleared() {
+		_spec.ClearField(webauthncredential.FieldLastUsedAt, field.TypeTime)
+	}
+	if _u.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webauthncredential.OwnerTable,
+			Columns: []string{webauthncredential.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webauthncredential.OwnerTable,
+			Columns: []string{webauthncredential.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &WebauthnCredential{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webauthncredential.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
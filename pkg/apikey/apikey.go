@@ -0,0 +1,81 @@
+/*
+ * Copyright 2023 FormulaGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ */
+
+// package apikey generates the personal access tokens of machine clients and matches their scopes
+
+package apikey
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"strings"
+
+	"github.com/casbin/casbin/v3/util"
+)
+
+// Prefix tells an API key from a JWT in the Authorization header
+const Prefix = "fgk_"
+
+// displayLength of the key shown in the key list, the prefix and the first characters
+const displayLength = len(Prefix) + 6
+
+// Generate returns a new random key, its display prefix and its hash, only the hash and the prefix are stored
+func Generate() (key, display, hash string, err error) {
+	b := make([]byte, 32)
+	if _, err = rand.Read(b); err != nil {
+		return "", "", "", err
+	}
+	key = Prefix + base64.RawURLEncoding.EncodeToString(b)
+	return key, key[:displayLength], Hash(key), nil
+}
+
+// Hash returns the hex sha256 of the key, the key is random so a slow hash is not needed
+func Hash(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+// IsKey reports whether the bearer token is an API key
+func IsKey(token string) bool {
+	return strings.HasPrefix(token, Prefix)
+}
+
+// Scope is a casbin policy of the key, the path may use the keyMatch2 patterns like /api/admin/user/:id
+type Scope struct {
+	Method string
+	Path   string
+}
+
+// ParseScope parses "METHOD path", e.g. "POST /api/admin/user/list"
+func ParseScope(s string) (Scope, error) {
+	method, path, ok := strings.Cut(strings.TrimSpace(s), " ")
+	path = strings.TrimSpace(path)
+	if !ok || method == "" || !strings.HasPrefix(path, "/") {
+		return Scope{}, errors.New("invalid scope " + s + `, want "METHOD /path"`)
+	}
+	return Scope{Method: strings.ToUpper(method), Path: path}, nil
+}
+
+func (s Scope) String() string {
+	return s.Method + " " + s.Path
+}
+
+// Allowed reports whether one of the scopes matches the request like the casbin matcher does
+func Allowed(scopes []string, path, method string) bool {
+	for _, s := range scopes {
+		scope, err := ParseScope(s)
+		if err != nil {
+			continue
+		}
+		if scope.Method == method && util.KeyMatch2(path, scope.Path) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,86 @@
+/*
+ * Copyright 2023 FormulaGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ */
+
+package apikey
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGenerate(t *testing.T) {
+	key, display, hash, err := Generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !IsKey(key) {
+		t.Errorf("IsKey(%q) = false, want true", key)
+	}
+	if !strings.HasPrefix(key, display) || len(display) != displayLength {
+		t.Errorf("display = %q, want the first %d characters of %q", display, displayLength, key)
+	}
+	if hash != Hash(key) {
+		t.Errorf("hash = %q, want Hash(key) %q", hash, Hash(key))
+	}
+	other, _, _, err := Generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if other == key {
+		t.Errorf("Generate() returned the same key twice")
+	}
+	if IsKey("eyJhbGciOiJIUzI1NiJ9.e30.sig") {
+		t.Errorf("IsKey(jwt) = true, want false")
+	}
+}
+
+func TestParseScope(t *testing.T) {
+	tests := []struct {
+		name  string
+		scope string
+		want  Scope
+		err   bool
+	}{
+		{name: "valid", scope: "POST /api/admin/user/list", want: Scope{Method: "POST", Path: "/api/admin/user/list"}},
+		{name: "lower case method", scope: " get /api/admin/role/:id ", want: Scope{Method: "GET", Path: "/api/admin/role/:id"}},
+		{name: "no method", scope: "/api/admin/user/list", err: true},
+		{name: "relative path", scope: "GET api/admin/user/list", err: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseScope(tt.scope)
+			if (err != nil) != tt.err {
+				t.Fatalf("ParseScope() error = %v, want error %v", err, tt.err)
+			}
+			if got != tt.want {
+				t.Errorf("ParseScope() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAllowed(t *testing.T) {
+	scopes := []string{"POST /api/admin/user/list", "GET /api/admin/role/:id", "invalid"}
+	tests := []struct {
+		name   string
+		path   string
+		method string
+		want   bool
+	}{
+		{name: "exact", path: "/api/admin/user/list", method: "POST", want: true},
+		{name: "pattern", path: "/api/admin/role/3", method: "GET", want: true},
+		{name: "other method", path: "/api/admin/user/list", method: "GET", want: false},
+		{name: "other path", path: "/api/admin/user/create", method: "POST", want: false},
+		{name: "prefix is not a match", path: "/api/admin/user/list/all", method: "POST", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Allowed(scopes, tt.path, tt.method); got != tt.want {
+				t.Errorf("Allowed() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
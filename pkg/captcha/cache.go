@@ -47,5 +47,6 @@ func (c *CacheStore) Get(key string, clear bool) string {
 func (c *CacheStore) Verify(id, answer string, clear bool) bool {
 	key := c.PreKey + id
 	v := c.Get(key, clear)
-	return v == answer
+	// an expired or unknown captcha never matches, even an empty answer
+	return v != "" && v == answer
 }
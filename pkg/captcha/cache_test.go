@@ -135,6 +135,9 @@ func TestCacheStore_Verify(t *testing.T) {
 		want   bool
 	}{
 		{name: "cacheVerify", fields: field, args: arg, want: true},
+		// the answer was cleared by the first verify
+		{name: "cacheVerifyCleared", fields: field, args: arg, want: false},
+		{name: "cacheVerifyUnknownEmpty", fields: field, args: args{id: "unknown", answer: "", clear: true}, want: false},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -0,0 +1,54 @@
+/*
+ * Copyright 2023 FormulaGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ */
+
+package captcha
+
+import (
+	"context"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// NewRedisStore returns the store keeping the answers in redis, so any instance behind a load balancer can verify them
+func NewRedisStore(client *redis.Client) *RedisStore {
+	return &RedisStore{
+		Expiration: time.Minute * 5,
+		PreKey:     "CAPTCHA_",
+		Client:     client,
+	}
+}
+
+type RedisStore struct {
+	Expiration time.Duration
+	PreKey     string
+	Client     *redis.Client
+}
+
+func (r *RedisStore) Set(id string, value string) error {
+	return r.Client.Set(context.Background(), r.PreKey+id, value, r.Expiration).Err()
+}
+
+// Get returns "" if the key does not exist or redis fails, clear reads and deletes the answer atomically
+func (r *RedisStore) Get(key string, clear bool) string {
+	var val string
+	var err error
+	if clear {
+		val, err = r.Client.GetDel(context.Background(), key).Result()
+	} else {
+		val, err = r.Client.Get(context.Background(), key).Result()
+	}
+	if err != nil {
+		return ""
+	}
+	return val
+}
+
+func (r *RedisStore) Verify(id, answer string, clear bool) bool {
+	key := r.PreKey + id
+	v := r.Get(key, clear)
+	return v != "" && v == answer
+}
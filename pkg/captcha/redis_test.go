@@ -0,0 +1,65 @@
+/*
+ * Copyright 2023 FormulaGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ */
+
+package captcha
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+)
+
+func TestRedisStore_Verify(t *testing.T) {
+	server := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: server.Addr()})
+	defer client.Close()
+	store := NewRedisStore(client)
+	// another instance sharing the redis
+	other := NewRedisStore(client)
+
+	type args struct {
+		id     string
+		answer string
+		clear  bool
+	}
+	tests := []struct {
+		name string
+		// set the answer of the id before the verify, empty if not set
+		set string
+		// fastForward the time passed in redis before the verify
+		fastForward time.Duration
+		store       *RedisStore
+		args        args
+		want        bool
+		// afterWant the result of verifying the same answer once again
+		afterWant bool
+	}{
+		{name: "verify and clear", set: "12345", store: store, args: args{id: "a", answer: "12345", clear: true}, want: true, afterWant: false},
+		{name: "verify without clear", set: "12345", store: store, args: args{id: "b", answer: "12345"}, want: true, afterWant: true},
+		{name: "verified by another instance", set: "12345", store: other, args: args{id: "c", answer: "12345", clear: true}, want: true, afterWant: false},
+		{name: "wrong answer is cleared", set: "12345", store: store, args: args{id: "d", answer: "54321", clear: true}, want: false, afterWant: false},
+		{name: "expired", set: "12345", fastForward: 6 * time.Minute, store: store, args: args{id: "e", answer: "12345", clear: true}, want: false, afterWant: false},
+		{name: "unknown id with empty answer", store: store, args: args{id: "f", answer: "", clear: true}, want: false, afterWant: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.set != "" {
+				if err := store.Set(tt.args.id, tt.set); err != nil {
+					t.Fatalf("Set() error = %v", err)
+				}
+			}
+			server.FastForward(tt.fastForward)
+			if got := tt.store.Verify(tt.args.id, tt.args.answer, tt.args.clear); got != tt.want {
+				t.Errorf("Verify() = %v, want %v", got, tt.want)
+			}
+			if got := tt.store.Verify(tt.args.id, tt.set, tt.args.clear); got != tt.afterWant {
+				t.Errorf("After verify, once again, Verify() = %v, want %v", got, tt.afterWant)
+			}
+		})
+	}
+}
@@ -60,3 +60,48 @@ func TestBcryptEncrypt(t *testing.T) {
 		})
 	}
 }
+
+func TestRandomToken(t *testing.T) {
+	tests := []struct {
+		name    string
+		n       int
+		wantLen int
+	}{
+		{name: "32 bytes", n: 32, wantLen: 43},
+		{name: "16 bytes", n: 16, wantLen: 22},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := RandomToken(tt.n)
+			if err != nil {
+				t.Errorf("RandomToken() error = %v", err)
+				return
+			}
+			if len(got) != tt.wantLen {
+				t.Errorf("RandomToken() len = %v, want %v", len(got), tt.wantLen)
+			}
+			other, _ := RandomToken(tt.n)
+			if got == other {
+				t.Errorf("RandomToken() returned the same token twice: %v", got)
+			}
+		})
+	}
+}
+
+func TestSHA256Hex(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want string
+	}{
+		{name: "empty", s: "", want: "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"},
+		{name: "abc", s: "abc", want: "ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SHA256Hex(tt.s); got != tt.want {
+				t.Errorf("SHA256Hex() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,140 @@
+/*
+ * Copyright 2023 FormulaGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ */
+
+// package jsonpath reads values from decoded JSON documents by simple paths like data.user.emails[0].value
+
+package jsonpath
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// segment is a key, or an array index if key is empty
+type segment struct {
+	key   string
+	index int
+}
+
+// Path is a parsed path, "$." may prefix it
+type Path []segment
+
+// Parse parses the dot separated keys with optional array indexes, e.g. $.data.emails[0].value
+func Parse(path string) (Path, error) {
+	path = strings.TrimPrefix(strings.TrimPrefix(path, "$"), ".")
+	if path == "" {
+		return nil, errors.New("empty json path")
+	}
+	var p Path
+	for _, part := range strings.Split(path, ".") {
+		key, rest, _ := strings.Cut(part, "[")
+		if key == "" && rest == "" {
+			return nil, fmt.Errorf("invalid json path %q", path)
+		}
+		if key != "" {
+			p = append(p, segment{key: key})
+		}
+		for rest != "" {
+			idx, after, ok := strings.Cut(rest, "]")
+			if !ok {
+				return nil, fmt.Errorf("invalid json path %q", path)
+			}
+			i, err := strconv.Atoi(idx)
+			if err != nil || i < 0 {
+				return nil, fmt.Errorf("invalid index in json path %q", path)
+			}
+			p = append(p, segment{index: i})
+			if after == "" {
+				break
+			}
+			rest, ok = strings.CutPrefix(after, "[")
+			if !ok {
+				return nil, fmt.Errorf("invalid json path %q", path)
+			}
+		}
+	}
+	return p, nil
+}
+
+// Lookup returns the value at the path of the document
+func (p Path) Lookup(doc any) (any, bool) {
+	v := doc
+	for _, s := range p {
+		if s.key != "" {
+			m, ok := v.(map[string]any)
+			if !ok {
+				return nil, false
+			}
+			if v, ok = m[s.key]; !ok {
+				return nil, false
+			}
+			continue
+		}
+		a, ok := v.([]any)
+		if !ok || s.index >= len(a) {
+			return nil, false
+		}
+		v = a[s.index]
+	}
+	return v, true
+}
+
+// String returns the scalar at the path as a string, numbers keep their JSON form, objects, arrays and null are empty
+func (p Path) String(doc any) string {
+	v, ok := p.Lookup(doc)
+	if !ok {
+		return ""
+	}
+	switch t := v.(type) {
+	case string:
+		return t
+	case json.Number:
+		return t.String()
+	case float64:
+		return strconv.FormatFloat(t, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(t)
+	default:
+		return ""
+	}
+}
+
+// Strings returns the scalars of the array at the path as strings, a single scalar is a list of one
+func (p Path) Strings(doc any) []string {
+	v, ok := p.Lookup(doc)
+	if !ok {
+		return nil
+	}
+	a, ok := v.([]any)
+	if !ok {
+		if s := p.String(doc); s != "" {
+			return []string{s}
+		}
+		return nil
+	}
+	var list []string
+	for i := range a {
+		if s := append(p[:len(p):len(p)], segment{index: i}).String(doc); s != "" {
+			list = append(list, s)
+		}
+	}
+	return list
+}
+
+// Decode decodes the JSON document, numbers are kept as json.Number so that large ids are not rounded
+func Decode(data []byte) (any, error) {
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.UseNumber()
+	var doc any
+	if err := d.Decode(&doc); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
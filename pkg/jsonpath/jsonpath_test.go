@@ -0,0 +1,111 @@
+/*
+ * Copyright 2023 FormulaGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ */
+
+package jsonpath
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		path    string
+		wantLen int
+		wantErr bool
+	}{
+		{path: "login", wantLen: 1},
+		{path: "$.data.user.email", wantLen: 3},
+		{path: "emails[0].value", wantLen: 3},
+		{path: "matrix[1][2]", wantLen: 3},
+		{path: "", wantErr: true},
+		{path: "$", wantErr: true},
+		{path: "a..b", wantErr: true},
+		{path: "a[x]", wantErr: true},
+		{path: "a[-1]", wantErr: true},
+		{path: "a[0", wantErr: true},
+		{path: "a[0]b", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			p, err := Parse(tt.path)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Parse() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if len(p) != tt.wantLen {
+				t.Errorf("Parse() len = %d, want %d", len(p), tt.wantLen)
+			}
+		})
+	}
+}
+
+func TestString(t *testing.T) {
+	doc, err := Decode([]byte(`{
+		"id": 12345678901234567,
+		"login": "octocat",
+		"site_admin": false,
+		"data": {"user": {"email": "octo@example.com", "name": null}},
+		"emails": [{"value": "first@example.com"}, {"value": "second@example.com"}],
+		"matrix": [[1, 2], [3, 4]]
+	}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		path string
+		want string
+	}{
+		{path: "login", want: "octocat"},
+		{path: "id", want: "12345678901234567"},
+		{path: "site_admin", want: "false"},
+		{path: "$.data.user.email", want: "octo@example.com"},
+		{path: "data.user.name", want: ""},
+		{path: "data.user", want: ""},
+		{path: "emails[1].value", want: "second@example.com"},
+		{path: "emails[2].value", want: ""},
+		{path: "matrix[1][0]", want: "3"},
+		{path: "login.first", want: ""},
+		{path: "missing", want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			p, err := Parse(tt.path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := p.String(doc); got != tt.want {
+				t.Errorf("String() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStrings(t *testing.T) {
+	doc, err := Decode([]byte(`{"groups": ["dev", 7, {"x": 1}, "ops"], "group": "admins", "none": []}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		path string
+		want []string
+	}{
+		{path: "groups", want: []string{"dev", "7", "ops"}},
+		{path: "group", want: []string{"admins"}},
+		{path: "none", want: nil},
+		{path: "missing", want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			p, err := Parse(tt.path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := p.Strings(doc); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Strings() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
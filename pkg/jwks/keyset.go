@@ -0,0 +1,224 @@
+/*
+ * Copyright 2023 FormulaGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ */
+
+// package jwks provides asymmetric JWT signing keys identified by kid and their JSON Web Key Set.
+
+package jwks
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+// supported signing algorithms
+const (
+	RS256 = "RS256"
+	ES256 = "ES256"
+	EdDSA = "EdDSA"
+)
+
+// Key is a signing key, keys without a private key are only used for verification
+type Key struct {
+	Kid        string
+	Alg        string
+	PrivateKey crypto.Signer
+	PublicKey  crypto.PublicKey
+}
+
+// ParseKey parses a PEM encoded key for the algorithm, the public key is derived from the private key if given
+func ParseKey(kid, alg string, privatePEM, publicPEM []byte) (*Key, error) {
+	if kid == "" {
+		return nil, errors.New("kid is required")
+	}
+	key := &Key{Kid: kid, Alg: alg}
+	if len(privatePEM) > 0 {
+		var err error
+		switch alg {
+		case RS256:
+			key.PrivateKey, err = jwt.ParseRSAPrivateKeyFromPEM(privatePEM)
+		case ES256:
+			key.PrivateKey, err = jwt.ParseECPrivateKeyFromPEM(privatePEM)
+		case EdDSA:
+			var privateKey crypto.PrivateKey
+			privateKey, err = jwt.ParseEdPrivateKeyFromPEM(privatePEM)
+			if err == nil {
+				key.PrivateKey, _ = privateKey.(crypto.Signer)
+			}
+		default:
+			return nil, fmt.Errorf("unsupported signing algorithm %q", alg)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("parse private key %s failed: %w", kid, err)
+		}
+		key.PublicKey = key.PrivateKey.Public()
+	} else if len(publicPEM) > 0 {
+		var err error
+		switch alg {
+		case RS256:
+			key.PublicKey, err = jwt.ParseRSAPublicKeyFromPEM(publicPEM)
+		case ES256:
+			key.PublicKey, err = jwt.ParseECPublicKeyFromPEM(publicPEM)
+		case EdDSA:
+			key.PublicKey, err = jwt.ParseEdPublicKeyFromPEM(publicPEM)
+		default:
+			return nil, fmt.Errorf("unsupported signing algorithm %q", alg)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("parse public key %s failed: %w", kid, err)
+		}
+	} else {
+		return nil, fmt.Errorf("key %s has neither private nor public key", kid)
+	}
+	if err := key.validate(); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// validate checks the key type matches the algorithm
+func (k *Key) validate() error {
+	var ok bool
+	switch k.Alg {
+	case RS256:
+		_, ok = k.PublicKey.(*rsa.PublicKey)
+	case ES256:
+		var pub *ecdsa.PublicKey
+		pub, ok = k.PublicKey.(*ecdsa.PublicKey)
+		ok = ok && pub.Curve == elliptic.P256()
+	case EdDSA:
+		_, ok = k.PublicKey.(ed25519.PublicKey)
+	default:
+		return fmt.Errorf("unsupported signing algorithm %q", k.Alg)
+	}
+	if !ok {
+		return fmt.Errorf("key %s does not match algorithm %s", k.Kid, k.Alg)
+	}
+	return nil
+}
+
+// KeySet holds all keys, the active key signs new tokens and every key verifies tokens
+type KeySet struct {
+	active *Key
+	keys   map[string]*Key
+	order  []string
+}
+
+// NewKeySet creates a key set, the active key must have a private key
+func NewKeySet(activeKid string, keys ...*Key) (*KeySet, error) {
+	s := &KeySet{keys: make(map[string]*Key)}
+	for _, k := range keys {
+		if err := k.validate(); err != nil {
+			return nil, err
+		}
+		if _, exist := s.keys[k.Kid]; exist {
+			return nil, fmt.Errorf("duplicate kid %s", k.Kid)
+		}
+		s.keys[k.Kid] = k
+		s.order = append(s.order, k.Kid)
+	}
+	active, ok := s.keys[activeKid]
+	if !ok {
+		return nil, fmt.Errorf("active kid %s not found", activeKid)
+	}
+	if active.PrivateKey == nil {
+		return nil, fmt.Errorf("active key %s has no private key", activeKid)
+	}
+	s.active = active
+	return s, nil
+}
+
+// ActiveKid returns the kid of the key used for signing
+func (s *KeySet) ActiveKid() string {
+	return s.active.Kid
+}
+
+// ActiveAlg returns the algorithm of the key used for signing
+func (s *KeySet) ActiveAlg() string {
+	return s.active.Alg
+}
+
+// Sign signs the claims with the active key and sets the kid header
+func (s *KeySet) Sign(claims jwt.Claims) (string, error) {
+	token := jwt.NewWithClaims(jwt.GetSigningMethod(s.active.Alg), claims)
+	token.Header["kid"] = s.active.Kid
+	return token.SignedString(s.active.PrivateKey)
+}
+
+// KeyFunc returns the verification key by the kid header, to be used with jwt.Parse
+func (s *KeySet) KeyFunc(token *jwt.Token) (any, error) {
+	kid, _ := token.Header["kid"].(string)
+	key, ok := s.keys[kid]
+	if !ok {
+		return nil, fmt.Errorf("unknown kid %q", kid)
+	}
+	// never trust the alg header alone
+	if token.Method.Alg() != key.Alg {
+		return nil, fmt.Errorf("unexpected signing algorithm %s for kid %s", token.Method.Alg(), kid)
+	}
+	return key.PublicKey, nil
+}
+
+// JWK is a public JSON Web Key (RFC 7517)
+type JWK struct {
+	Kty string `json:"kty"`
+	Use string `json:"use"`
+	Kid string `json:"kid"`
+	Alg string `json:"alg"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+	Y   string `json:"y,omitempty"`
+}
+
+// JWKSet is the JSON Web Key Set document
+type JWKSet struct {
+	Keys []JWK `json:"keys"`
+}
+
+// JWKS returns the public keys of the key set
+func (s *KeySet) JWKS() JWKSet {
+	set := JWKSet{Keys: make([]JWK, 0, len(s.order))}
+	for _, kid := range s.order {
+		set.Keys = append(set.Keys, s.keys[kid].JWK())
+	}
+	return set
+}
+
+// JWK returns the public JSON Web Key
+func (k *Key) JWK() JWK {
+	jwk := JWK{Use: "sig", Kid: k.Kid, Alg: k.Alg}
+	switch pub := k.PublicKey.(type) {
+	case *rsa.PublicKey:
+		jwk.Kty = "RSA"
+		jwk.N = b64(pub.N.Bytes())
+		jwk.E = b64(big.NewInt(int64(pub.E)).Bytes())
+	case *ecdsa.PublicKey:
+		size := (pub.Curve.Params().BitSize + 7) / 8
+		jwk.Kty = "EC"
+		jwk.Crv = pub.Curve.Params().Name
+		jwk.X = b64(pub.X.FillBytes(make([]byte, size)))
+		jwk.Y = b64(pub.Y.FillBytes(make([]byte, size)))
+	case ed25519.PublicKey:
+		jwk.Kty = "OKP"
+		jwk.Crv = "Ed25519"
+		jwk.X = b64(pub)
+	}
+	return jwk
+}
+
+func b64(b []byte) string {
+	return base64.RawURLEncoding.EncodeToString(b)
+}
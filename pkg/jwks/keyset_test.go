@@ -0,0 +1,146 @@
+/*
+ * Copyright 2023 FormulaGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ */
+
+package jwks
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+func newTestKey(t *testing.T, kid, alg string) *Key {
+	t.Helper()
+	var der []byte
+	var err error
+	switch alg {
+	case RS256:
+		var k *rsa.PrivateKey
+		k, err = rsa.GenerateKey(rand.Reader, 2048)
+		if err == nil {
+			der, err = x509.MarshalPKCS8PrivateKey(k)
+		}
+	case ES256:
+		var k *ecdsa.PrivateKey
+		k, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		if err == nil {
+			der, err = x509.MarshalPKCS8PrivateKey(k)
+		}
+	case EdDSA:
+		var k ed25519.PrivateKey
+		_, k, err = ed25519.GenerateKey(rand.Reader)
+		if err == nil {
+			der, err = x509.MarshalPKCS8PrivateKey(k)
+		}
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	key, err := ParseKey(kid, alg, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key
+}
+
+func TestKeySetSignAndVerify(t *testing.T) {
+	tests := []struct {
+		name string
+		alg  string
+		kty  string
+	}{
+		{name: "RS256", alg: RS256, kty: "RSA"},
+		{name: "ES256", alg: ES256, kty: "EC"},
+		{name: "EdDSA", alg: EdDSA, kty: "OKP"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key := newTestKey(t, "k1", tt.alg)
+			set, err := NewKeySet("k1", key)
+			if err != nil {
+				t.Fatal(err)
+			}
+			tokenString, err := set.Sign(jwt.MapClaims{"sub": "1", "exp": time.Now().Add(time.Minute).Unix()})
+			if err != nil {
+				t.Fatal(err)
+			}
+			token, err := jwt.Parse(tokenString, set.KeyFunc)
+			if err != nil || !token.Valid {
+				t.Errorf("Parse() error = %v", err)
+			}
+			if token.Header["kid"] != "k1" {
+				t.Errorf("kid header = %v, want k1", token.Header["kid"])
+			}
+			jwk := set.JWKS().Keys[0]
+			if jwk.Kty != tt.kty || jwk.Alg != tt.alg || jwk.Kid != "k1" {
+				t.Errorf("JWKS() = %+v", jwk)
+			}
+		})
+	}
+}
+
+func TestKeySetRotation(t *testing.T) {
+	oldKey := newTestKey(t, "old", RS256)
+	newKey := newTestKey(t, "new", ES256)
+
+	oldSet, err := NewKeySet("old", oldKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	oldToken, err := oldSet.Sign(jwt.MapClaims{"sub": "1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// rotate, the old key only verifies from now on
+	verifyOnly := &Key{Kid: oldKey.Kid, Alg: oldKey.Alg, PublicKey: oldKey.PublicKey}
+	rotated, err := NewKeySet("new", newKey, verifyOnly)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = jwt.Parse(oldToken, rotated.KeyFunc); err != nil {
+		t.Errorf("old token should still be valid, error = %v", err)
+	}
+	newToken, err := rotated.Sign(jwt.MapClaims{"sub": "1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = jwt.Parse(newToken, oldSet.KeyFunc); err == nil {
+		t.Errorf("token of unknown kid should be rejected")
+	}
+	if len(rotated.JWKS().Keys) != 2 {
+		t.Errorf("JWKS() should publish both keys")
+	}
+	if _, err = NewKeySet("old", verifyOnly); err == nil {
+		t.Errorf("verify-only key can not be the active key")
+	}
+}
+
+func TestKeySetRejectAlgorithmMismatch(t *testing.T) {
+	key := newTestKey(t, "k1", RS256)
+	set, err := NewKeySet("k1", key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// a HMAC token claiming the RSA kid must not verify
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{"sub": "1"})
+	token.Header["kid"] = "k1"
+	forged, err := token.SignedString([]byte("secret"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = jwt.Parse(forged, set.KeyFunc); err == nil {
+		t.Errorf("token with mismatched algorithm should be rejected")
+	}
+}
@@ -0,0 +1,215 @@
+/*
+ * Copyright 2023 FormulaGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ */
+
+// package ldap verifies the passwords by binding to an LDAP or Active Directory server and reads the user attributes
+
+package ldap
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"time"
+
+	goldap "github.com/go-ldap/ldap/v3"
+)
+
+var (
+	// ErrUserNotFound the directory has no such user, or more than one
+	ErrUserNotFound = errors.New("ldap user not found")
+	// ErrInvalidCredentials the directory rejected the password of the user
+	ErrInvalidCredentials = errors.New("ldap invalid credentials")
+)
+
+// Config is the connection and the schema of the directory
+type Config struct {
+	// URL ldap://host:389 or ldaps://host:636
+	URL                string
+	StartTLS           bool
+	InsecureSkipVerify bool
+	// BindDN the service account searching the users and groups, empty binds anonymously
+	BindDN       string
+	BindPassword string
+	BaseDN       string
+	// UserFilter %s is replaced by the escaped username, e.g. (uid=%s) or (sAMAccountName=%s)
+	UserFilter  string
+	GroupBaseDN string
+	// GroupFilter %s is replaced by the escaped DN of the user, e.g. (member=%s), the memberOf attribute is read if empty
+	GroupFilter string
+	// the attributes, uid, mail, mobile, displayName and cn by default
+	UsernameAttribute    string
+	MailAttribute        string
+	MobileAttribute      string
+	DisplayNameAttribute string
+	GroupNameAttribute   string
+	Timeout              time.Duration
+}
+
+// Entry is the user read from the directory
+type Entry struct {
+	DN          string
+	Username    string
+	Mail        string
+	Mobile      string
+	DisplayName string
+	Groups      []string
+}
+
+// Authenticator verifies the users against the directory, a connection is opened for each login
+type Authenticator struct {
+	config Config
+}
+
+func New(config Config) *Authenticator {
+	c := config
+	c.UserFilter = orDefault(c.UserFilter, "(uid=%s)")
+	c.UsernameAttribute = orDefault(c.UsernameAttribute, "uid")
+	c.MailAttribute = orDefault(c.MailAttribute, "mail")
+	c.MobileAttribute = orDefault(c.MobileAttribute, "mobile")
+	c.DisplayNameAttribute = orDefault(c.DisplayNameAttribute, "displayName")
+	c.GroupNameAttribute = orDefault(c.GroupNameAttribute, "cn")
+	c.GroupBaseDN = orDefault(c.GroupBaseDN, c.BaseDN)
+	if c.Timeout <= 0 {
+		c.Timeout = 5 * time.Second
+	}
+	return &Authenticator{config: c}
+}
+
+// Authenticate finds the user by the service account, verifies the password by binding as the user,
+// and reads the attributes and groups of the user
+func (a *Authenticator) Authenticate(ctx context.Context, username, password string) (*Entry, error) {
+	// an empty password is an unauthenticated bind, which most servers accept (RFC 4513 5.1.2)
+	if username == "" || password == "" {
+		return nil, ErrInvalidCredentials
+	}
+	conn, err := a.dial(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	if err = a.bindService(conn); err != nil {
+		return nil, err
+	}
+	c := a.config
+	res, err := conn.Search(goldap.NewSearchRequest(
+		c.BaseDN, goldap.ScopeWholeSubtree, goldap.NeverDerefAliases, 2, int(c.Timeout.Seconds()), false,
+		fmt.Sprintf(c.UserFilter, goldap.EscapeFilter(username)),
+		[]string{c.UsernameAttribute, c.MailAttribute, c.MobileAttribute, c.DisplayNameAttribute, "memberOf"},
+		nil,
+	))
+	if err != nil {
+		if goldap.IsErrorWithCode(err, goldap.LDAPResultNoSuchObject) {
+			return nil, ErrUserNotFound
+		}
+		return nil, fmt.Errorf("ldap search user failed: %w", err)
+	}
+	if len(res.Entries) != 1 {
+		return nil, ErrUserNotFound
+	}
+	user := res.Entries[0]
+
+	if err = conn.Bind(user.DN, password); err != nil {
+		if goldap.IsErrorWithCode(err, goldap.LDAPResultInvalidCredentials) {
+			return nil, ErrInvalidCredentials
+		}
+		return nil, fmt.Errorf("ldap bind user failed: %w", err)
+	}
+
+	entry := &Entry{
+		DN:          user.DN,
+		Username:    orDefault(user.GetAttributeValue(c.UsernameAttribute), username),
+		Mail:        user.GetAttributeValue(c.MailAttribute),
+		Mobile:      user.GetAttributeValue(c.MobileAttribute),
+		DisplayName: user.GetAttributeValue(c.DisplayNameAttribute),
+	}
+	if c.GroupFilter == "" {
+		for _, dn := range user.GetAttributeValues("memberOf") {
+			if name := firstRDNValue(dn); name != "" {
+				entry.Groups = append(entry.Groups, name)
+			}
+		}
+		return entry, nil
+	}
+
+	// the user may not read the groups, search them as the service account again
+	if err = a.bindService(conn); err != nil {
+		return nil, err
+	}
+	groups, err := conn.Search(goldap.NewSearchRequest(
+		c.GroupBaseDN, goldap.ScopeWholeSubtree, goldap.NeverDerefAliases, 0, int(c.Timeout.Seconds()), false,
+		fmt.Sprintf(c.GroupFilter, goldap.EscapeFilter(user.DN)),
+		[]string{c.GroupNameAttribute},
+		nil,
+	))
+	if err != nil {
+		return nil, fmt.Errorf("ldap search groups failed: %w", err)
+	}
+	for _, g := range groups.Entries {
+		if name := g.GetAttributeValue(c.GroupNameAttribute); name != "" {
+			entry.Groups = append(entry.Groups, name)
+		}
+	}
+	return entry, nil
+}
+
+func (a *Authenticator) dial(ctx context.Context) (*goldap.Conn, error) {
+	c := a.config
+	u, err := url.Parse(c.URL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid ldap url: %w", err)
+	}
+	// the certificate is verified against the host of the URL, also for StartTLS
+	tlsConfig := &tls.Config{ServerName: u.Hostname(), InsecureSkipVerify: c.InsecureSkipVerify}
+	dialer := &net.Dialer{Timeout: c.Timeout}
+	conn, err := goldap.DialURL(c.URL, goldap.DialWithDialer(dialer), goldap.DialWithTLSConfig(tlsConfig))
+	if err != nil {
+		return nil, fmt.Errorf("ldap dial failed: %w", err)
+	}
+	conn.SetTimeout(c.Timeout)
+	if c.StartTLS {
+		if err = conn.StartTLS(tlsConfig); err != nil {
+			conn.Close()
+			return nil, fmt.Errorf("ldap start tls failed: %w", err)
+		}
+	}
+	if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < c.Timeout {
+		conn.SetTimeout(time.Until(deadline))
+	}
+	return conn, nil
+}
+
+func (a *Authenticator) bindService(conn *goldap.Conn) error {
+	var err error
+	if a.config.BindDN == "" {
+		err = conn.UnauthenticatedBind("")
+	} else {
+		err = conn.Bind(a.config.BindDN, a.config.BindPassword)
+	}
+	if err != nil {
+		return fmt.Errorf("ldap bind service account failed: %w", err)
+	}
+	return nil
+}
+
+// firstRDNValue the name of the group DN, e.g. admins of cn=admins,ou=groups,dc=example,dc=com
+func firstRDNValue(dn string) string {
+	parsed, err := goldap.ParseDN(dn)
+	if err != nil || len(parsed.RDNs) == 0 || len(parsed.RDNs[0].Attributes) == 0 {
+		return ""
+	}
+	return parsed.RDNs[0].Attributes[0].Value
+}
+
+func orDefault(v, def string) string {
+	if v == "" {
+		return def
+	}
+	return v
+}
@@ -0,0 +1,120 @@
+/*
+ * Copyright 2023 FormulaGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ */
+
+package ldap
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func stubDirectory() []stubEntry {
+	return []stubEntry{
+		{dn: "cn=service,dc=example,dc=com", password: "service-secret"},
+		{
+			dn:       "uid=alice,ou=people,dc=example,dc=com",
+			password: "alice-secret",
+			attrs: map[string][]string{
+				"objectClass": {"inetOrgPerson"},
+				"uid":         {"alice"},
+				"mail":        {"alice@example.com"},
+				"mobile":      {"13800000000"},
+				"displayName": {"Alice"},
+				"memberOf":    {"cn=admins,ou=groups,dc=example,dc=com", "cn=dev,ou=groups,dc=example,dc=com"},
+			},
+		},
+		{
+			dn:       "uid=bob,ou=people,dc=example,dc=com",
+			password: "bob-secret",
+			attrs:    map[string][]string{"objectClass": {"inetOrgPerson"}, "uid": {"bob"}},
+		},
+		{
+			dn:       "uid=deploy,ou=apps,dc=example,dc=com",
+			password: "deploy-secret",
+			attrs:    map[string][]string{"objectClass": {"inetOrgPerson"}, "uid": {"deploy"}},
+		},
+		{dn: "cn=admins,ou=groups,dc=example,dc=com", attrs: map[string][]string{
+			"cn": {"admins"}, "member": {"uid=alice,ou=people,dc=example,dc=com"},
+		}},
+		{dn: "cn=ops,ou=groups,dc=example,dc=com", attrs: map[string][]string{
+			"cn": {"ops"}, "member": {"uid=alice,ou=people,dc=example,dc=com", "uid=bob,ou=people,dc=example,dc=com"},
+		}},
+	}
+}
+
+func TestAuthenticate(t *testing.T) {
+	server := newStubServer(t, stubDirectory())
+	config := Config{
+		URL:          server.URL(),
+		BindDN:       "cn=service,dc=example,dc=com",
+		BindPassword: "service-secret",
+		BaseDN:       "ou=people,dc=example,dc=com",
+		UserFilter:   "(&(objectClass=inetOrgPerson)(uid=%s))",
+		GroupBaseDN:  "ou=groups,dc=example,dc=com",
+		GroupFilter:  "(member=%s)",
+		Timeout:      2 * time.Second,
+	}
+	memberOf := config
+	memberOf.GroupFilter = ""
+	wrongService := config
+	wrongService.BindPassword = "wrong"
+
+	tests := []struct {
+		name     string
+		config   Config
+		username string
+		password string
+		want     *Entry
+		wantErr  error
+		anyErr   bool
+	}{
+		{
+			name: "group search", config: config, username: "alice", password: "alice-secret",
+			want: &Entry{
+				DN: "uid=alice,ou=people,dc=example,dc=com", Username: "alice", Mail: "alice@example.com",
+				Mobile: "13800000000", DisplayName: "Alice", Groups: []string{"admins", "ops"},
+			},
+		},
+		{
+			name: "memberOf", config: memberOf, username: "alice", password: "alice-secret",
+			want: &Entry{
+				DN: "uid=alice,ou=people,dc=example,dc=com", Username: "alice", Mail: "alice@example.com",
+				Mobile: "13800000000", DisplayName: "Alice", Groups: []string{"admins", "dev"},
+			},
+		},
+		{
+			name: "no attributes", config: config, username: "bob", password: "bob-secret",
+			want: &Entry{DN: "uid=bob,ou=people,dc=example,dc=com", Username: "bob", Groups: []string{"ops"}},
+		},
+		{name: "wrong password", config: config, username: "alice", password: "bob-secret", wantErr: ErrInvalidCredentials},
+		{name: "empty password", config: config, username: "alice", password: "", wantErr: ErrInvalidCredentials},
+		{name: "unknown user", config: config, username: "carol", password: "x", wantErr: ErrUserNotFound},
+		{name: "filter injection", config: config, username: "*", password: "alice-secret", wantErr: ErrUserNotFound},
+		{name: "entry outside the base", config: config, username: "deploy", password: "deploy-secret", wantErr: ErrUserNotFound},
+		{name: "wrong service password", config: wrongService, username: "alice", password: "alice-secret", anyErr: true},
+		{name: "unreachable", config: Config{URL: "ldap://127.0.0.1:1", Timeout: time.Second}, username: "alice", password: "x", anyErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := New(tt.config).Authenticate(context.Background(), tt.username, tt.password)
+			if tt.anyErr {
+				if err == nil || errors.Is(err, ErrInvalidCredentials) || errors.Is(err, ErrUserNotFound) {
+					t.Fatalf("Authenticate() error = %v, want a connection error", err)
+				}
+				return
+			}
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Authenticate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Authenticate() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
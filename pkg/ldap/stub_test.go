@@ -0,0 +1,199 @@
+/*
+ * Copyright 2023 FormulaGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ */
+
+package ldap
+
+import (
+	"net"
+	"strings"
+	"sync"
+	"testing"
+
+	ber "github.com/go-asn1-ber/asn1-ber"
+	goldap "github.com/go-ldap/ldap/v3"
+)
+
+// stubEntry an entry of the stub directory, the entries with a password may bind
+type stubEntry struct {
+	dn       string
+	password string
+	attrs    map[string][]string
+}
+
+// stubServer is an in-process LDAP server of simple bind, search with and, or, not, equality and presence filters,
+// and unbind, enough for the authenticator
+type stubServer struct {
+	ln      net.Listener
+	entries []stubEntry
+	wg      sync.WaitGroup
+}
+
+func newStubServer(t *testing.T, entries []stubEntry) *stubServer {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &stubServer{ln: ln, entries: entries}
+	s.wg.Add(1)
+	go s.serve()
+	t.Cleanup(func() {
+		_ = ln.Close()
+		s.wg.Wait()
+	})
+	return s
+}
+
+func (s *stubServer) URL() string {
+	return "ldap://" + s.ln.Addr().String()
+}
+
+func (s *stubServer) serve() {
+	defer s.wg.Done()
+	for {
+		conn, err := s.ln.Accept()
+		if err != nil {
+			return
+		}
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			defer conn.Close()
+			s.handle(conn)
+		}()
+	}
+}
+
+func (s *stubServer) handle(conn net.Conn) {
+	bound := false
+	for {
+		p, err := ber.ReadPacket(conn)
+		if err != nil || len(p.Children) < 2 {
+			return
+		}
+		id, _ := p.Children[0].Value.(int64)
+		op := p.Children[1]
+		switch op.Tag {
+		case goldap.ApplicationBindRequest:
+			name, password := op.Children[1].Data.String(), op.Children[2].Data.String()
+			code := int64(goldap.LDAPResultInvalidCredentials)
+			if e := s.find(name); e != nil && password != "" && e.password == password {
+				code = goldap.LDAPResultSuccess
+			}
+			bound = code == goldap.LDAPResultSuccess
+			s.write(conn, id, result(goldap.ApplicationBindResponse, code))
+		case goldap.ApplicationSearchRequest:
+			if !bound {
+				s.write(conn, id, result(goldap.ApplicationSearchResultDone, goldap.LDAPResultInsufficientAccessRights))
+				continue
+			}
+			base := strings.ToLower(op.Children[0].Data.String())
+			var attrs []string
+			for _, a := range op.Children[7].Children {
+				attrs = append(attrs, a.Data.String())
+			}
+			for i := range s.entries {
+				e := &s.entries[i]
+				if strings.HasSuffix(strings.ToLower(e.dn), base) && e.match(op.Children[6]) {
+					s.write(conn, id, e.packet(attrs))
+				}
+			}
+			s.write(conn, id, result(goldap.ApplicationSearchResultDone, goldap.LDAPResultSuccess))
+		case goldap.ApplicationUnbindRequest:
+			return
+		default:
+			return
+		}
+	}
+}
+
+func (s *stubServer) find(dn string) *stubEntry {
+	for i := range s.entries {
+		if strings.EqualFold(s.entries[i].dn, dn) {
+			return &s.entries[i]
+		}
+	}
+	return nil
+}
+
+func (s *stubServer) write(conn net.Conn, id int64, op *ber.Packet) {
+	p := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "LDAP Response")
+	p.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagInteger, id, "MessageID"))
+	p.AppendChild(op)
+	_, _ = conn.Write(p.Bytes())
+}
+
+func result(tag ber.Tag, code int64) *ber.Packet {
+	op := ber.Encode(ber.ClassApplication, ber.TypeConstructed, tag, nil, "Response")
+	op.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagEnumerated, code, "resultCode"))
+	op.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "", "matchedDN"))
+	op.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "", "diagnosticMessage"))
+	return op
+}
+
+func (e *stubEntry) packet(attrs []string) *ber.Packet {
+	op := ber.Encode(ber.ClassApplication, ber.TypeConstructed, goldap.ApplicationSearchResultEntry, nil, "Search Result Entry")
+	op.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, e.dn, "objectName"))
+	list := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "attributes")
+	for _, name := range attrs {
+		values, ok := e.values(name)
+		if !ok {
+			continue
+		}
+		a := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "attribute")
+		a.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, name, "type"))
+		set := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSet, nil, "vals")
+		for _, v := range values {
+			set.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, v, "value"))
+		}
+		a.AppendChild(set)
+		list.AppendChild(a)
+	}
+	op.AppendChild(list)
+	return op
+}
+
+func (e *stubEntry) values(name string) ([]string, bool) {
+	for k, v := range e.attrs {
+		if strings.EqualFold(k, name) {
+			return v, true
+		}
+	}
+	return nil, false
+}
+
+func (e *stubEntry) match(f *ber.Packet) bool {
+	switch f.Tag {
+	case goldap.FilterAnd:
+		for _, c := range f.Children {
+			if !e.match(c) {
+				return false
+			}
+		}
+		return true
+	case goldap.FilterOr:
+		for _, c := range f.Children {
+			if e.match(c) {
+				return true
+			}
+		}
+		return false
+	case goldap.FilterNot:
+		return !e.match(f.Children[0])
+	case goldap.FilterEqualityMatch:
+		values, _ := e.values(f.Children[0].Data.String())
+		for _, v := range values {
+			if strings.EqualFold(v, f.Children[1].Data.String()) {
+				return true
+			}
+		}
+		return false
+	case goldap.FilterPresent:
+		_, ok := e.values(f.Data.String())
+		return ok
+	}
+	return false
+}
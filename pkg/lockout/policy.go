@@ -0,0 +1,46 @@
+/*
+ * Copyright 2023 FormulaGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ */
+
+// package lockout provides the progressive delay and lockout policy of failed logins
+
+package lockout
+
+import "time"
+
+// Policy counts the failures of a key (username or IP) in a sliding window, slows down each attempt
+// once DelayAfter failures are reached and locks the key when MaxFailures is reached.
+type Policy struct {
+	// MaxFailures failures before the key is locked, 0 never locks
+	MaxFailures int64
+	// DelayAfter failures without delay
+	DelayAfter int64
+	// BaseDelay the first delay, doubled by every further failure up to MaxDelay
+	BaseDelay time.Duration
+	MaxDelay  time.Duration
+}
+
+// Locked reports whether the failures reach the lockout threshold
+func (p Policy) Locked(failures int64) bool {
+	return p.MaxFailures > 0 && failures >= p.MaxFailures
+}
+
+// Delay returns the wait before the next attempt is checked
+func (p Policy) Delay(failures int64) time.Duration {
+	if p.BaseDelay <= 0 || failures <= p.DelayAfter {
+		return 0
+	}
+	delay := p.BaseDelay
+	for i := p.DelayAfter + 1; i < failures; i++ {
+		delay *= 2
+		if p.MaxDelay > 0 && delay >= p.MaxDelay {
+			return p.MaxDelay
+		}
+	}
+	if p.MaxDelay > 0 && delay > p.MaxDelay {
+		return p.MaxDelay
+	}
+	return delay
+}
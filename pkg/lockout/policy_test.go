@@ -0,0 +1,56 @@
+/*
+ * Copyright 2023 FormulaGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ */
+
+package lockout
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPolicyDelay(t *testing.T) {
+	p := Policy{MaxFailures: 10, DelayAfter: 2, BaseDelay: 500 * time.Millisecond, MaxDelay: 4 * time.Second}
+	tests := []struct {
+		name     string
+		failures int64
+		want     time.Duration
+	}{
+		{name: "no failure", failures: 0, want: 0},
+		{name: "within free attempts", failures: 2, want: 0},
+		{name: "first delay", failures: 3, want: 500 * time.Millisecond},
+		{name: "doubled", failures: 4, want: time.Second},
+		{name: "doubled twice", failures: 5, want: 2 * time.Second},
+		{name: "capped", failures: 7, want: 4 * time.Second},
+		{name: "capped far beyond", failures: 1000, want: 4 * time.Second},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := p.Delay(tt.failures); got != tt.want {
+				t.Errorf("Delay() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPolicyLocked(t *testing.T) {
+	tests := []struct {
+		name     string
+		policy   Policy
+		failures int64
+		want     bool
+	}{
+		{name: "below threshold", policy: Policy{MaxFailures: 5}, failures: 4, want: false},
+		{name: "at threshold", policy: Policy{MaxFailures: 5}, failures: 5, want: true},
+		{name: "lockout disabled", policy: Policy{}, failures: 100, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.policy.Locked(tt.failures); got != tt.want {
+				t.Errorf("Locked() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
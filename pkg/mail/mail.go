@@ -0,0 +1,220 @@
+/*
+ * Copyright 2023 FormulaGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ */
+
+// package mail sends the notification mails, Sender is implemented by SMTP and can be replaced by other providers
+
+package mail
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"mime"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Message is a plain text mail
+type Message struct {
+	To      []string
+	Subject string
+	Body    string
+}
+
+// Sender delivers the mails
+type Sender interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+// TLS modes of the SMTP connection
+const (
+	// TLSStartTLS upgrades the connection with STARTTLS, the server must support it
+	TLSStartTLS = "starttls"
+	// TLSImplicit connects with TLS, usually on port 465
+	TLSImplicit = "tls"
+	// TLSNone sends in plain text, only for local test servers
+	TLSNone = "none"
+)
+
+// SMTPConfig the server, an empty Username skips the authentication
+type SMTPConfig struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+	// From the sender, e.g. "FormulaGo <noreply@example.com>"
+	From string
+	// TLS one of starttls (default), tls and none
+	TLS string
+}
+
+// SMTP sends mails by an SMTP server
+type SMTP struct {
+	config SMTPConfig
+	// tlsConfig for tests, nil verifies the server name
+	tlsConfig *tls.Config
+}
+
+func NewSMTP(c SMTPConfig) *SMTP {
+	if c.TLS == "" {
+		c.TLS = TLSStartTLS
+	}
+	return &SMTP{config: c}
+}
+
+// defaultTimeout of a delivery when the context has no deadline
+const defaultTimeout = 30 * time.Second
+
+func (s *SMTP) Send(ctx context.Context, msg Message) error {
+	if s.config.Host == "" {
+		return errors.New("smtp host is not configured")
+	}
+	from, err := mail.ParseAddress(s.config.From)
+	if err != nil {
+		return fmt.Errorf("parse from address failed: %w", err)
+	}
+	if len(msg.To) == 0 {
+		return errors.New("mail has no recipient")
+	}
+	to := make([]*mail.Address, 0, len(msg.To))
+	for _, address := range msg.To {
+		a, err := mail.ParseAddress(address)
+		if err != nil {
+			return fmt.Errorf("parse recipient %q failed: %w", address, err)
+		}
+		to = append(to, a)
+	}
+	if strings.ContainsAny(msg.Subject, "\r\n") {
+		return errors.New("mail subject must be a single line")
+	}
+	body, err := s.build(from, to, msg)
+	if err != nil {
+		return err
+	}
+
+	client, err := s.dial(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	if s.config.Username != "" {
+		auth := smtp.PlainAuth("", s.config.Username, s.config.Password, s.config.Host)
+		if err = client.Auth(auth); err != nil {
+			return fmt.Errorf("smtp auth failed: %w", err)
+		}
+	}
+	if err = client.Mail(from.Address); err != nil {
+		return fmt.Errorf("smtp MAIL FROM failed: %w", err)
+	}
+	for _, a := range to {
+		if err = client.Rcpt(a.Address); err != nil {
+			return fmt.Errorf("smtp RCPT TO %s failed: %w", a.Address, err)
+		}
+	}
+	w, err := client.Data()
+	if err != nil {
+		return fmt.Errorf("smtp DATA failed: %w", err)
+	}
+	if _, err = w.Write(body); err != nil {
+		return fmt.Errorf("write mail failed: %w", err)
+	}
+	if err = w.Close(); err != nil {
+		return fmt.Errorf("send mail failed: %w", err)
+	}
+	return client.Quit()
+}
+
+// dial connects and upgrades the connection according to the TLS mode
+func (s *SMTP) dial(ctx context.Context) (*smtp.Client, error) {
+	addr := net.JoinHostPort(s.config.Host, strconv.Itoa(s.config.Port))
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return nil, fmt.Errorf("connect smtp server failed: %w", err)
+	}
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(defaultTimeout)
+	}
+	_ = conn.SetDeadline(deadline)
+
+	if s.config.TLS == TLSImplicit {
+		tlsConn := tls.Client(conn, s.tls())
+		if err = tlsConn.HandshakeContext(ctx); err != nil {
+			conn.Close()
+			return nil, fmt.Errorf("smtp tls handshake failed: %w", err)
+		}
+		conn = tlsConn
+	}
+	client, err := smtp.NewClient(conn, s.config.Host)
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("smtp handshake failed: %w", err)
+	}
+	if s.config.TLS == TLSStartTLS {
+		if ok, _ := client.Extension("STARTTLS"); !ok {
+			client.Close()
+			return nil, errors.New("smtp server does not support STARTTLS")
+		}
+		if err = client.StartTLS(s.tls()); err != nil {
+			client.Close()
+			return nil, fmt.Errorf("smtp STARTTLS failed: %w", err)
+		}
+	}
+	return client, nil
+}
+
+func (s *SMTP) tls() *tls.Config {
+	if s.tlsConfig != nil {
+		return s.tlsConfig
+	}
+	return &tls.Config{ServerName: s.config.Host, MinVersion: tls.VersionTLS12}
+}
+
+// build returns the headers and the quoted-printable UTF-8 body
+func (s *SMTP) build(from *mail.Address, to []*mail.Address, msg Message) ([]byte, error) {
+	recipients := make([]string, 0, len(to))
+	for _, a := range to {
+		recipients = append(recipients, a.String())
+	}
+	messageID := make([]byte, 16)
+	if _, err := rand.Read(messageID); err != nil {
+		return nil, err
+	}
+	domain := from.Address[strings.LastIndex(from.Address, "@")+1:]
+
+	var buf bytes.Buffer
+	header := func(key, value string) {
+		buf.WriteString(key + ": " + value + "\r\n")
+	}
+	header("From", from.String())
+	header("To", strings.Join(recipients, ", "))
+	header("Subject", mime.QEncoding.Encode("utf-8", msg.Subject))
+	header("Date", time.Now().Format(time.RFC1123Z))
+	header("Message-ID", "<"+hex.EncodeToString(messageID)+"@"+domain+">")
+	header("MIME-Version", "1.0")
+	header("Content-Type", "text/plain; charset=UTF-8")
+	header("Content-Transfer-Encoding", "quoted-printable")
+	buf.WriteString("\r\n")
+	w := quotedprintable.NewWriter(&buf)
+	if _, err := w.Write([]byte(strings.ReplaceAll(strings.ReplaceAll(msg.Body, "\r\n", "\n"), "\n", "\r\n"))); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,284 @@
+/*
+ * Copyright 2023 FormulaGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ */
+
+package mail
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"io"
+	"math/big"
+	"mime"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"net/textproto"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// received is a mail delivered to the test server
+type received struct {
+	auth string
+	from string
+	to   []string
+	data []byte
+}
+
+// testServer is a minimal local SMTP server, it offers STARTTLS if tlsConfig is set
+type testServer struct {
+	host      string
+	port      int
+	tlsConfig *tls.Config
+
+	mu    sync.Mutex
+	mails []received
+}
+
+func newTestServer(t *testing.T, tlsConfig *tls.Config) *testServer {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { l.Close() })
+	addr := l.Addr().(*net.TCPAddr)
+	s := &testServer{host: addr.IP.String(), port: addr.Port, tlsConfig: tlsConfig}
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go s.serve(conn)
+		}
+	}()
+	return s
+}
+
+func (s *testServer) serve(conn net.Conn) {
+	defer func() { conn.Close() }()
+	tp := textproto.NewConn(conn)
+	secure := false
+	var r received
+	_ = tp.PrintfLine("220 localhost ESMTP test")
+	for {
+		line, err := tp.ReadLine()
+		if err != nil {
+			return
+		}
+		command := strings.ToUpper(line)
+		switch {
+		case strings.HasPrefix(command, "EHLO"):
+			lines := []string{"localhost", "8BITMIME"}
+			if s.tlsConfig != nil && !secure {
+				lines = append(lines, "STARTTLS")
+			}
+			lines = append(lines, "AUTH PLAIN")
+			for i, l := range lines {
+				sep := "-"
+				if i == len(lines)-1 {
+					sep = " "
+				}
+				_ = tp.PrintfLine("250%s%s", sep, l)
+			}
+		case command == "STARTTLS":
+			_ = tp.PrintfLine("220 ready to start TLS")
+			tlsConn := tls.Server(conn, s.tlsConfig)
+			if err = tlsConn.Handshake(); err != nil {
+				return
+			}
+			conn = tlsConn
+			tp = textproto.NewConn(conn)
+			secure = true
+		case strings.HasPrefix(command, "AUTH PLAIN "):
+			auth, _ := base64.StdEncoding.DecodeString(line[len("AUTH PLAIN "):])
+			r.auth = string(auth)
+			_ = tp.PrintfLine("235 authenticated")
+		case strings.HasPrefix(command, "MAIL FROM:"):
+			from, _, _ := strings.Cut(line[len("MAIL FROM:"):], ">")
+			r.from = strings.Trim(from, "< ")
+			_ = tp.PrintfLine("250 ok")
+		case strings.HasPrefix(command, "RCPT TO:"):
+			r.to = append(r.to, strings.Trim(line[len("RCPT TO:"):], "<> "))
+			_ = tp.PrintfLine("250 ok")
+		case command == "DATA":
+			_ = tp.PrintfLine("354 end data with <CR><LF>.<CR><LF>")
+			r.data, err = tp.ReadDotBytes()
+			if err != nil {
+				return
+			}
+			s.mu.Lock()
+			s.mails = append(s.mails, r)
+			s.mu.Unlock()
+			r = received{}
+			_ = tp.PrintfLine("250 queued")
+		case command == "QUIT":
+			_ = tp.PrintfLine("221 bye")
+			return
+		default:
+			_ = tp.PrintfLine("250 ok")
+		}
+	}
+}
+
+func (s *testServer) received() []received {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]received{}, s.mails...)
+}
+
+// selfSigned returns the server certificate of 127.0.0.1 and a client config trusting it
+func selfSigned(t *testing.T) (server, client *tls.Config) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pool := x509.NewCertPool()
+	pool.AddCert(cert)
+	server = &tls.Config{Certificates: []tls.Certificate{{Certificate: [][]byte{der}, PrivateKey: key}}}
+	client = &tls.Config{RootCAs: pool, ServerName: "127.0.0.1"}
+	return server, client
+}
+
+func TestSMTPSend(t *testing.T) {
+	serverTLS, clientTLS := selfSigned(t)
+	msg := Message{
+		To:      []string{"Alice <alice@example.com>", "bob@example.com"},
+		Subject: "重置密码 | Reset your password",
+		Body:    "Open the link below:\nhttps://admin.example.com/#/reset-password?token=abc.def\n",
+	}
+	tests := []struct {
+		name      string
+		serverTLS *tls.Config
+		mode      string
+	}{
+		{name: "plain text on localhost", mode: TLSNone},
+		{name: "starttls", serverTLS: serverTLS, mode: TLSStartTLS},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newTestServer(t, tt.serverTLS)
+			s := NewSMTP(SMTPConfig{
+				Host:     server.host,
+				Port:     server.port,
+				Username: "noreply",
+				Password: "secret",
+				From:     "FormulaGo <noreply@example.com>",
+				TLS:      tt.mode,
+			})
+			s.tlsConfig = clientTLS
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			if err := s.Send(ctx, msg); err != nil {
+				t.Fatalf("Send() error = %v", err)
+			}
+
+			mails := server.received()
+			if len(mails) != 1 {
+				t.Fatalf("received %d mails, want 1", len(mails))
+			}
+			got := mails[0]
+			if got.auth != "\x00noreply\x00secret" {
+				t.Errorf("auth = %q, want PLAIN noreply/secret", got.auth)
+			}
+			if got.from != "noreply@example.com" {
+				t.Errorf("MAIL FROM = %q, want noreply@example.com", got.from)
+			}
+			if strings.Join(got.to, ",") != "alice@example.com,bob@example.com" {
+				t.Errorf("RCPT TO = %v, want alice and bob", got.to)
+			}
+
+			m, err := mail.ReadMessage(strings.NewReader(string(got.data)))
+			if err != nil {
+				t.Fatal(err)
+			}
+			subject, err := new(mime.WordDecoder).DecodeHeader(m.Header.Get("Subject"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if subject != msg.Subject {
+				t.Errorf("Subject = %q, want %q", subject, msg.Subject)
+			}
+			body, err := io.ReadAll(quotedprintable.NewReader(m.Body))
+			if err != nil {
+				t.Fatal(err)
+			}
+			// ReadDotBytes has turned the CRLF line endings into LF
+			if string(body) != msg.Body {
+				t.Errorf("body = %q, want %q", body, msg.Body)
+			}
+		})
+	}
+}
+
+func TestSMTPSendRejects(t *testing.T) {
+	server := newTestServer(t, nil)
+	tests := []struct {
+		name   string
+		config SMTPConfig
+		msg    Message
+		want   string
+	}{
+		{
+			name:   "starttls not supported",
+			config: SMTPConfig{Host: server.host, Port: server.port, From: "noreply@example.com"},
+			msg:    Message{To: []string{"alice@example.com"}, Subject: "hello"},
+			want:   "does not support STARTTLS",
+		},
+		{
+			name:   "header injection",
+			config: SMTPConfig{Host: server.host, Port: server.port, From: "noreply@example.com", TLS: TLSNone},
+			msg:    Message{To: []string{"alice@example.com"}, Subject: "hello\r\nBcc: eve@example.com"},
+			want:   "single line",
+		},
+		{
+			name:   "invalid recipient",
+			config: SMTPConfig{Host: server.host, Port: server.port, From: "noreply@example.com", TLS: TLSNone},
+			msg:    Message{To: []string{"alice@example.com\r\nRCPT TO:<eve@example.com>"}, Subject: "hello"},
+			want:   "parse recipient",
+		},
+		{
+			name:   "no host",
+			config: SMTPConfig{From: "noreply@example.com"},
+			msg:    Message{To: []string{"alice@example.com"}, Subject: "hello"},
+			want:   "not configured",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := NewSMTP(tt.config).Send(context.Background(), tt.msg)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("Send() error = %v, want %q", err, tt.want)
+			}
+		})
+	}
+	if n := len(server.received()); n != 0 {
+		t.Errorf("received %d mails, want 0", n)
+	}
+}
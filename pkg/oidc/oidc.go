@@ -0,0 +1,143 @@
+/*
+ * Copyright 2023 FormulaGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ */
+
+// package oidc provides the protocol helpers of the OpenID Connect provider: PKCE, scopes, redirect URIs and discovery
+
+package oidc
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"net/url"
+	"slices"
+	"strings"
+)
+
+// Scopes of the user claims
+const (
+	ScopeOpenID  = "openid"
+	ScopeProfile = "profile"
+	ScopeEmail   = "email"
+	ScopePhone   = "phone"
+	// ScopeRoles adds the role values as the roles claim
+	ScopeRoles = "roles"
+)
+
+// Grant types of the token endpoint
+const (
+	GrantAuthorizationCode = "authorization_code"
+	GrantClientCredentials = "client_credentials"
+)
+
+// CodeChallengeS256 is the only accepted PKCE method, plain would leak the verifier with the authorization request
+const CodeChallengeS256 = "S256"
+
+// VerifyPKCE reports whether the verifier matches the S256 challenge of the authorization request
+func VerifyPKCE(verifier, challenge, method string) bool {
+	// RFC 7636 4.1, 43 to 128 characters
+	if method != CodeChallengeS256 || len(verifier) < 43 || len(verifier) > 128 || challenge == "" {
+		return false
+	}
+	sum := sha256.Sum256([]byte(verifier))
+	return subtle.ConstantTimeCompare([]byte(base64.RawURLEncoding.EncodeToString(sum[:])), []byte(challenge)) == 1
+}
+
+// ParseScopes splits the space separated scope parameter, duplicates are removed
+func ParseScopes(scope string) []string {
+	var scopes []string
+	for _, s := range strings.Fields(scope) {
+		if !slices.Contains(scopes, s) {
+			scopes = append(scopes, s)
+		}
+	}
+	return scopes
+}
+
+// SubsetOf reports whether every scope is allowed
+func SubsetOf(scopes, allowed []string) bool {
+	for _, s := range scopes {
+		if !slices.Contains(allowed, s) {
+			return false
+		}
+	}
+	return true
+}
+
+// ValidRedirectURI reports whether the redirect URI is registered, the comparison is exact as required by OAuth 2.1
+func ValidRedirectURI(registered []string, redirectURI string) bool {
+	if redirectURI == "" {
+		return false
+	}
+	u, err := url.Parse(redirectURI)
+	if err != nil || !u.IsAbs() || u.Fragment != "" {
+		return false
+	}
+	return slices.Contains(registered, redirectURI)
+}
+
+// AppendQuery adds the parameters to the query of the redirect URI
+func AppendQuery(redirectURI string, params map[string]string) string {
+	u, err := url.Parse(redirectURI)
+	if err != nil {
+		return redirectURI
+	}
+	q := u.Query()
+	for k, v := range params {
+		if v != "" {
+			q.Set(k, v)
+		}
+	}
+	u.RawQuery = q.Encode()
+	return u.String()
+}
+
+// Metadata is the discovery document of /.well-known/openid-configuration
+type Metadata struct {
+	Issuer                            string   `json:"issuer"`
+	AuthorizationEndpoint             string   `json:"authorization_endpoint"`
+	TokenEndpoint                     string   `json:"token_endpoint"`
+	UserinfoEndpoint                  string   `json:"userinfo_endpoint"`
+	JWKSURI                           string   `json:"jwks_uri"`
+	ResponseTypesSupported            []string `json:"response_types_supported"`
+	GrantTypesSupported               []string `json:"grant_types_supported"`
+	SubjectTypesSupported             []string `json:"subject_types_supported"`
+	IDTokenSigningAlgValuesSupported  []string `json:"id_token_signing_alg_values_supported"`
+	ScopesSupported                   []string `json:"scopes_supported"`
+	TokenEndpointAuthMethodsSupported []string `json:"token_endpoint_auth_methods_supported"`
+	ClaimsSupported                   []string `json:"claims_supported"`
+	CodeChallengeMethodsSupported     []string `json:"code_challenge_methods_supported"`
+}
+
+// Endpoint paths, relative to the issuer
+const (
+	AuthorizationPath = "/oauth2/authorize"
+	TokenPath         = "/oauth2/token"
+	UserinfoPath      = "/oauth2/userinfo"
+	JWKSPath          = "/.well-known/jwks.json"
+	DiscoveryPath     = "/.well-known/openid-configuration"
+)
+
+// Discovery returns the metadata of the issuer, alg is the algorithm of the signing key
+func Discovery(issuer, alg string) Metadata {
+	issuer = strings.TrimSuffix(issuer, "/")
+	return Metadata{
+		Issuer:                            issuer,
+		AuthorizationEndpoint:             issuer + AuthorizationPath,
+		TokenEndpoint:                     issuer + TokenPath,
+		UserinfoEndpoint:                  issuer + UserinfoPath,
+		JWKSURI:                           issuer + JWKSPath,
+		ResponseTypesSupported:            []string{"code"},
+		GrantTypesSupported:               []string{GrantAuthorizationCode, GrantClientCredentials},
+		SubjectTypesSupported:             []string{"public"},
+		IDTokenSigningAlgValuesSupported:  []string{alg},
+		ScopesSupported:                   []string{ScopeOpenID, ScopeProfile, ScopeEmail, ScopePhone, ScopeRoles},
+		TokenEndpointAuthMethodsSupported: []string{"client_secret_basic", "client_secret_post", "none"},
+		ClaimsSupported: []string{"iss", "sub", "aud", "exp", "iat", "nonce",
+			"name", "preferred_username", "picture", "email", "phone_number", "roles"},
+		CodeChallengeMethodsSupported: []string{CodeChallengeS256},
+	}
+}
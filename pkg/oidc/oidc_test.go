@@ -0,0 +1,102 @@
+/*
+ * Copyright 2023 FormulaGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ */
+
+package oidc
+
+import (
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestVerifyPKCE(t *testing.T) {
+	// RFC 7636 appendix B
+	verifier := "dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk"
+	challenge := "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM"
+	tests := []struct {
+		name      string
+		verifier  string
+		challenge string
+		method    string
+		want      bool
+	}{
+		{name: "rfc example", verifier: verifier, challenge: challenge, method: "S256", want: true},
+		{name: "wrong verifier", verifier: strings.Repeat("a", 43), challenge: challenge, method: "S256", want: false},
+		{name: "plain method", verifier: verifier, challenge: verifier, method: "plain", want: false},
+		{name: "short verifier", verifier: "abc", challenge: challenge, method: "S256", want: false},
+		{name: "no challenge", verifier: verifier, challenge: "", method: "S256", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := VerifyPKCE(tt.verifier, tt.challenge, tt.method); got != tt.want {
+				t.Errorf("VerifyPKCE() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScopes(t *testing.T) {
+	got := ParseScopes(" openid  profile openid roles ")
+	want := []string{"openid", "profile", "roles"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseScopes() = %v, want %v", got, want)
+	}
+	if !SubsetOf(got, []string{"openid", "profile", "email", "roles"}) {
+		t.Errorf("SubsetOf() = false, want true")
+	}
+	if SubsetOf([]string{"openid", "admin"}, []string{"openid"}) {
+		t.Errorf("SubsetOf() = true, want false")
+	}
+}
+
+func TestValidRedirectURI(t *testing.T) {
+	registered := []string{"https://app.example.com/callback", "http://localhost:8080/cb"}
+	tests := []struct {
+		name string
+		uri  string
+		want bool
+	}{
+		{name: "registered", uri: "https://app.example.com/callback", want: true},
+		{name: "loopback", uri: "http://localhost:8080/cb", want: true},
+		{name: "other path", uri: "https://app.example.com/callback/../evil", want: false},
+		{name: "extra query", uri: "https://app.example.com/callback?next=evil", want: false},
+		{name: "fragment", uri: "https://app.example.com/callback#", want: false},
+		{name: "empty", uri: "", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ValidRedirectURI(registered, tt.uri); got != tt.want {
+				t.Errorf("ValidRedirectURI() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAppendQuery(t *testing.T) {
+	got := AppendQuery("https://app.example.com/cb?tenant=a", map[string]string{"code": "x y", "state": "s", "empty": ""})
+	u, err := url.Parse(got)
+	if err != nil {
+		t.Fatal(err)
+	}
+	q := u.Query()
+	if q.Get("tenant") != "a" || q.Get("code") != "x y" || q.Get("state") != "s" || q.Has("empty") {
+		t.Errorf("AppendQuery() = %v", got)
+	}
+}
+
+func TestDiscovery(t *testing.T) {
+	m := Discovery("https://sso.example.com/", "RS256")
+	if m.Issuer != "https://sso.example.com" {
+		t.Errorf("Issuer = %v, want the issuer without trailing slash", m.Issuer)
+	}
+	if m.TokenEndpoint != "https://sso.example.com/oauth2/token" || m.JWKSURI != "https://sso.example.com/.well-known/jwks.json" {
+		t.Errorf("endpoints = %v %v", m.TokenEndpoint, m.JWKSURI)
+	}
+	if !reflect.DeepEqual(m.IDTokenSigningAlgValuesSupported, []string{"RS256"}) {
+		t.Errorf("IDTokenSigningAlgValuesSupported = %v", m.IDTokenSigningAlgValuesSupported)
+	}
+}
@@ -0,0 +1,195 @@
+/*
+ * Copyright 2023 FormulaGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ */
+
+// package passkey wraps the WebAuthn registration and assertion ceremonies used by the passkey login
+
+package passkey
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/webauthn"
+)
+
+// Config the relying party, RPOrigins are the origins of the admin console, e.g. https://admin.example.com
+type Config struct {
+	RPID          string
+	RPDisplayName string
+	RPOrigins     []string
+}
+
+// User is the WebAuthn view of an user with the stored credentials
+type User struct {
+	ID          uint64
+	Name        string
+	DisplayName string
+	Credentials []webauthn.Credential
+}
+
+func (u *User) WebAuthnID() []byte {
+	return UserHandle(u.ID)
+}
+
+func (u *User) WebAuthnName() string {
+	return u.Name
+}
+
+func (u *User) WebAuthnDisplayName() string {
+	if u.DisplayName == "" {
+		return u.Name
+	}
+	return u.DisplayName
+}
+
+func (u *User) WebAuthnCredentials() []webauthn.Credential {
+	return u.Credentials
+}
+
+// UserHandle returns the user handle of the user ID, the big-endian 8 bytes of the ID
+func UserHandle(userID uint64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, userID)
+	return b
+}
+
+// UserID parses the user handle returned by a discoverable credential
+func UserID(userHandle []byte) (uint64, error) {
+	if len(userHandle) != 8 {
+		return 0, errors.New("invalid user handle")
+	}
+	return binary.BigEndian.Uint64(userHandle), nil
+}
+
+type Passkey struct {
+	webauthn *webauthn.WebAuthn
+}
+
+func New(c Config) (*Passkey, error) {
+	w, err := webauthn.New(&webauthn.Config{
+		RPID:          c.RPID,
+		RPDisplayName: c.RPDisplayName,
+		RPOrigins:     c.RPOrigins,
+		// passkeys replace the password and the second factor, so the user is always verified
+		AuthenticatorSelection: protocol.AuthenticatorSelection{
+			ResidentKey:      protocol.ResidentKeyRequirementPreferred,
+			UserVerification: protocol.VerificationRequired,
+		},
+		AttestationPreference: protocol.PreferNoAttestation,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("init webauthn failed: %w", err)
+	}
+	return &Passkey{webauthn: w}, nil
+}
+
+// BeginRegistration returns the PublicKeyCredentialCreationOptions for navigator.credentials.create
+// and the session to keep until FinishRegistration, the credentials of the user are excluded.
+func (p *Passkey) BeginRegistration(user *User) (options, session []byte, err error) {
+	creation, sessionData, err := p.webauthn.BeginRegistration(user,
+		webauthn.WithExclusions(webauthn.Credentials(user.Credentials).CredentialDescriptors()))
+	if err != nil {
+		return nil, nil, fmt.Errorf("begin registration failed: %w", err)
+	}
+	return marshal(creation, sessionData)
+}
+
+// FinishRegistration verifies the attestation response posted by the browser and returns the new credential
+func (p *Passkey) FinishRegistration(user *User, session, response []byte) (*webauthn.Credential, error) {
+	var sessionData webauthn.SessionData
+	if err := json.Unmarshal(session, &sessionData); err != nil {
+		return nil, fmt.Errorf("unmarshal registration session failed: %w", err)
+	}
+	parsed, err := protocol.ParseCredentialCreationResponseBytes(response)
+	if err != nil {
+		return nil, fmt.Errorf("parse registration response failed: %w", err)
+	}
+	credential, err := p.webauthn.CreateCredential(user, sessionData, parsed)
+	if err != nil {
+		return nil, fmt.Errorf("verify registration failed: %w", err)
+	}
+	return credential, nil
+}
+
+// BeginLogin returns the PublicKeyCredentialRequestOptions for navigator.credentials.get and the session to keep
+// until FinishLogin, a nil user starts a discoverable login where the authenticator chooses the account.
+func (p *Passkey) BeginLogin(user *User) (options, session []byte, err error) {
+	var assertion *protocol.CredentialAssertion
+	var sessionData *webauthn.SessionData
+	if user == nil {
+		assertion, sessionData, err = p.webauthn.BeginDiscoverableLogin()
+	} else {
+		assertion, sessionData, err = p.webauthn.BeginLogin(user)
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("begin login failed: %w", err)
+	}
+	return marshal(assertion, sessionData)
+}
+
+// FinishLogin verifies the assertion response posted by the browser, findUser loads the user with the credentials.
+// It returns the user and the used credential, whose sign count has been updated.
+func (p *Passkey) FinishLogin(session, response []byte, findUser func(userID uint64) (*User, error)) (*User, *webauthn.Credential, error) {
+	var sessionData webauthn.SessionData
+	if err := json.Unmarshal(session, &sessionData); err != nil {
+		return nil, nil, fmt.Errorf("unmarshal login session failed: %w", err)
+	}
+	parsed, err := protocol.ParseCredentialRequestResponseBytes(response)
+	if err != nil {
+		return nil, nil, fmt.Errorf("parse login response failed: %w", err)
+	}
+
+	var user *User
+	var credential *webauthn.Credential
+	if len(sessionData.UserID) == 0 {
+		// discoverable login, the user comes from the user handle
+		var u webauthn.User
+		u, credential, err = p.webauthn.ValidatePasskeyLogin(func(rawID, userHandle []byte) (webauthn.User, error) {
+			userID, err := UserID(userHandle)
+			if err != nil {
+				return nil, err
+			}
+			return findUser(userID)
+		}, sessionData, parsed)
+		if err == nil {
+			user, _ = u.(*User)
+		}
+	} else {
+		var userID uint64
+		userID, err = UserID(sessionData.UserID)
+		if err != nil {
+			return nil, nil, err
+		}
+		user, err = findUser(userID)
+		if err != nil {
+			return nil, nil, err
+		}
+		credential, err = p.webauthn.ValidateLogin(user, sessionData, parsed)
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("verify login failed: %w", err)
+	}
+	// a sign count going backwards means the private key has been copied
+	if credential.Authenticator.CloneWarning {
+		return nil, nil, errors.New("the authenticator may be cloned, sign count did not increase")
+	}
+	return user, credential, nil
+}
+
+func marshal(options any, session *webauthn.SessionData) ([]byte, []byte, error) {
+	optionsBytes, err := json.Marshal(options)
+	if err != nil {
+		return nil, nil, fmt.Errorf("marshal options failed: %w", err)
+	}
+	sessionBytes, err := json.Marshal(session)
+	if err != nil {
+		return nil, nil, fmt.Errorf("marshal session failed: %w", err)
+	}
+	return optionsBytes, sessionBytes, nil
+}
@@ -0,0 +1,323 @@
+/*
+ * Copyright 2023 FormulaGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ */
+
+package passkey
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/protocol/webauthncbor"
+	"github.com/go-webauthn/webauthn/protocol/webauthncose"
+	"github.com/go-webauthn/webauthn/webauthn"
+)
+
+const (
+	testRPID   = "admin.example.com"
+	testOrigin = "https://admin.example.com"
+)
+
+// softAuthenticator is a software platform authenticator with a single ES256 credential, it answers the
+// ceremonies like a browser does with "none" attestation
+type softAuthenticator struct {
+	origin       string
+	credentialID []byte
+	userHandle   []byte
+	key          *ecdsa.PrivateKey
+	signCount    uint32
+}
+
+func newSoftAuthenticator(t *testing.T, origin string) *softAuthenticator {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	credentialID := make([]byte, 16)
+	if _, err = rand.Read(credentialID); err != nil {
+		t.Fatal(err)
+	}
+	return &softAuthenticator{origin: origin, credentialID: credentialID, key: key}
+}
+
+func (a *softAuthenticator) clientData(ceremony protocol.CeremonyType, challenge string) []byte {
+	b, _ := json.Marshal(protocol.CollectedClientData{
+		Type:      ceremony,
+		Challenge: challenge,
+		Origin:    a.origin,
+	})
+	return b
+}
+
+// authData user present and verified, with the attested credential if attested is true
+func (a *softAuthenticator) authData(t *testing.T, rpID string, attested bool) []byte {
+	rpIDHash := sha256.Sum256([]byte(rpID))
+	flags := protocol.FlagUserPresent | protocol.FlagUserVerified
+	if attested {
+		flags |= protocol.FlagAttestedCredentialData
+	}
+	data := append([]byte{}, rpIDHash[:]...)
+	data = append(data, byte(flags))
+	data = binary.BigEndian.AppendUint32(data, a.signCount)
+	if !attested {
+		return data
+	}
+	publicKey, err := webauthncbor.Marshal(webauthncose.EC2PublicKeyData{
+		PublicKeyData: webauthncose.PublicKeyData{
+			KeyType:   int64(webauthncose.EllipticKey),
+			Algorithm: int64(webauthncose.AlgES256),
+		},
+		Curve:  int64(webauthncose.P256),
+		XCoord: a.key.PublicKey.X.FillBytes(make([]byte, 32)),
+		YCoord: a.key.PublicKey.Y.FillBytes(make([]byte, 32)),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	data = append(data, make([]byte, 16)...) // zero AAGUID
+	data = binary.BigEndian.AppendUint16(data, uint16(len(a.credentialID)))
+	data = append(data, a.credentialID...)
+	return append(data, publicKey...)
+}
+
+// create answers navigator.credentials.create
+func (a *softAuthenticator) create(t *testing.T, options []byte) []byte {
+	var creation protocol.CredentialCreation
+	if err := json.Unmarshal(options, &creation); err != nil {
+		t.Fatal(err)
+	}
+	// the browser receives the user handle base64url encoded
+	userHandle, err := base64.RawURLEncoding.DecodeString(creation.Response.User.ID.(string))
+	if err != nil {
+		t.Fatal(err)
+	}
+	a.userHandle = userHandle
+	a.signCount = 0
+
+	attestationObject, err := webauthncbor.Marshal(struct {
+		Fmt      string         `cbor:"fmt"`
+		AttStmt  map[string]any `cbor:"attStmt"`
+		AuthData []byte         `cbor:"authData"`
+	}{
+		Fmt:      "none",
+		AttStmt:  map[string]any{},
+		AuthData: a.authData(t, creation.Response.RelyingParty.ID, true),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return a.response(t, map[string]any{
+		"clientDataJSON":    a.encode(a.clientData(protocol.CreateCeremony, creation.Response.Challenge.String())),
+		"attestationObject": a.encode(attestationObject),
+		"transports":        []string{"internal"},
+	})
+}
+
+// get answers navigator.credentials.get
+func (a *softAuthenticator) get(t *testing.T, options []byte) []byte {
+	var assertion protocol.CredentialAssertion
+	if err := json.Unmarshal(options, &assertion); err != nil {
+		t.Fatal(err)
+	}
+	a.signCount++
+
+	authData := a.authData(t, assertion.Response.RelyingPartyID, false)
+	clientData := a.clientData(protocol.AssertCeremony, assertion.Response.Challenge.String())
+	clientDataHash := sha256.Sum256(clientData)
+	digest := sha256.Sum256(append(append([]byte{}, authData...), clientDataHash[:]...))
+	signature, err := ecdsa.SignASN1(rand.Reader, a.key, digest[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	return a.response(t, map[string]any{
+		"clientDataJSON":    a.encode(clientData),
+		"authenticatorData": a.encode(authData),
+		"signature":         a.encode(signature),
+		"userHandle":        a.encode(a.userHandle),
+	})
+}
+
+func (a *softAuthenticator) response(t *testing.T, response map[string]any) []byte {
+	b, err := json.Marshal(map[string]any{
+		"id":       a.encode(a.credentialID),
+		"rawId":    a.encode(a.credentialID),
+		"type":     "public-key",
+		"response": response,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+func (a *softAuthenticator) encode(b []byte) string {
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func newTestPasskey(t *testing.T) *Passkey {
+	p, err := New(Config{RPID: testRPID, RPDisplayName: "FormulaGo", RPOrigins: []string{testOrigin}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return p
+}
+
+// register runs the registration ceremony and stores the credential on the user
+func register(t *testing.T, p *Passkey, user *User, authenticator *softAuthenticator) {
+	options, session, err := p.BeginRegistration(user)
+	if err != nil {
+		t.Fatal(err)
+	}
+	credential, err := p.FinishRegistration(user, session, authenticator.create(t, options))
+	if err != nil {
+		t.Fatal(err)
+	}
+	user.Credentials = append(user.Credentials, *credential)
+}
+
+func TestRegisterAndLogin(t *testing.T) {
+	p := newTestPasskey(t)
+	user := &User{ID: 42, Name: "admin"}
+	authenticator := newSoftAuthenticator(t, testOrigin)
+	register(t, p, user, authenticator)
+	findUser := func(userID uint64) (*User, error) {
+		if userID != user.ID {
+			return nil, errors.New("user not found")
+		}
+		return user, nil
+	}
+
+	tests := []struct {
+		name string
+		user *User
+	}{
+		{name: "login by username", user: user},
+		{name: "discoverable login", user: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			options, session, err := p.BeginLogin(tt.user)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, credential, err := p.FinishLogin(session, authenticator.get(t, options), findUser)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.ID != user.ID {
+				t.Errorf("FinishLogin() user = %v, want %v", got.ID, user.ID)
+			}
+			if credential.Authenticator.SignCount != authenticator.signCount {
+				t.Errorf("FinishLogin() sign count = %v, want %v", credential.Authenticator.SignCount, authenticator.signCount)
+			}
+			user.Credentials[0] = *credential
+		})
+	}
+}
+
+func TestFinishLoginRejects(t *testing.T) {
+	p := newTestPasskey(t)
+	user := &User{ID: 7, Name: "operator"}
+	authenticator := newSoftAuthenticator(t, testOrigin)
+	register(t, p, user, authenticator)
+	findUser := func(userID uint64) (*User, error) {
+		return user, nil
+	}
+
+	tests := []struct {
+		name   string
+		modify func(a *softAuthenticator)
+	}{
+		{name: "phishing origin", modify: func(a *softAuthenticator) { a.origin = "https://admin.example.com.evil.io" }},
+		{name: "cloned authenticator", modify: func(a *softAuthenticator) { a.signCount = 0 }},
+		{name: "unknown credential", modify: func(a *softAuthenticator) { a.credentialID = []byte("unknown-credential") }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := *authenticator
+			// the stored sign count is ahead of the cloned authenticator
+			user.Credentials[0].Authenticator.SignCount = 5
+			a.signCount = 5
+			tt.modify(&a)
+			options, session, err := p.BeginLogin(nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, _, err = p.FinishLogin(session, a.get(t, options), findUser); err == nil {
+				t.Errorf("FinishLogin() error = nil, want an error")
+			}
+		})
+	}
+
+	t.Run("replayed challenge", func(t *testing.T) {
+		options, _, err := p.BeginLogin(user)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, otherSession, err := p.BeginLogin(user)
+		if err != nil {
+			t.Fatal(err)
+		}
+		user.Credentials[0].Authenticator.SignCount = authenticator.signCount
+		if _, _, err = p.FinishLogin(otherSession, authenticator.get(t, options), findUser); err == nil {
+			t.Errorf("FinishLogin() error = nil, want an error")
+		}
+	})
+}
+
+func TestBeginRegistrationExcludesCredentials(t *testing.T) {
+	p := newTestPasskey(t)
+	user := &User{ID: 1, Name: "admin"}
+	authenticator := newSoftAuthenticator(t, testOrigin)
+	register(t, p, user, authenticator)
+
+	options, _, err := p.BeginRegistration(user)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var creation protocol.CredentialCreation
+	if err = json.Unmarshal(options, &creation); err != nil {
+		t.Fatal(err)
+	}
+	if len(creation.Response.CredentialExcludeList) != 1 {
+		t.Fatalf("exclude list = %v, want the registered credential", creation.Response.CredentialExcludeList)
+	}
+	if string(creation.Response.CredentialExcludeList[0].CredentialID) != string(authenticator.credentialID) {
+		t.Errorf("excluded credential = %x, want %x", creation.Response.CredentialExcludeList[0].CredentialID, authenticator.credentialID)
+	}
+}
+
+func TestUserHandle(t *testing.T) {
+	tests := []struct {
+		name   string
+		handle []byte
+		want   uint64
+		err    bool
+	}{
+		{name: "round trip", handle: UserHandle(1234567), want: 1234567},
+		{name: "too short", handle: []byte{1, 2, 3}, err: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := UserID(tt.handle)
+			if (err != nil) != tt.err {
+				t.Fatalf("UserID() error = %v, want error %v", err, tt.err)
+			}
+			if got != tt.want {
+				t.Errorf("UserID() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+var _ webauthn.User = (*User)(nil)
@@ -0,0 +1,143 @@
+/*
+ * Copyright 2023 FormulaGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ */
+
+// package password provides the password strength policy and the common or breached password list
+
+package password
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha1"
+	_ "embed"
+	"encoding/hex"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// MaxLength bcrypt only uses the first 72 bytes
+const MaxLength = 72
+
+//go:embed common.txt
+var commonPasswords []byte
+
+// Policy is the strength requirement of a new password
+type Policy struct {
+	MinLength     int
+	RequireUpper  bool
+	RequireLower  bool
+	RequireDigit  bool
+	RequireSymbol bool
+	// Blocklist common or breached passwords, nil skips the check
+	Blocklist *Blocklist
+}
+
+// Validate returns a descriptive error listing every requirement the password misses
+func (p Policy) Validate(password, username string) error {
+	var problems []string
+	if len([]rune(password)) < p.MinLength {
+		problems = append(problems, "be at least "+strconv.Itoa(p.MinLength)+" characters")
+	}
+	if len(password) > MaxLength {
+		problems = append(problems, "be at most "+strconv.Itoa(MaxLength)+" bytes")
+	}
+
+	var upper, lower, digit, symbol bool
+	for _, r := range password {
+		switch {
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsDigit(r):
+			digit = true
+		case unicode.IsPunct(r) || unicode.IsSymbol(r) || unicode.IsSpace(r):
+			symbol = true
+		}
+	}
+	if p.RequireUpper && !upper {
+		problems = append(problems, "contain an upper case letter")
+	}
+	if p.RequireLower && !lower {
+		problems = append(problems, "contain a lower case letter")
+	}
+	if p.RequireDigit && !digit {
+		problems = append(problems, "contain a digit")
+	}
+	if p.RequireSymbol && !symbol {
+		problems = append(problems, "contain a symbol")
+	}
+
+	if username != "" && strings.Contains(strings.ToLower(password), strings.ToLower(username)) {
+		problems = append(problems, "not contain the username")
+	}
+	if p.Blocklist.Contains(password) {
+		problems = append(problems, "not be a common or breached password")
+	}
+
+	if len(problems) > 0 {
+		return errors.New("password must " + strings.Join(problems, ", "))
+	}
+	return nil
+}
+
+// Blocklist is a set of SHA-1 digests of forbidden passwords
+type Blocklist struct {
+	hashes map[string]struct{}
+}
+
+// DefaultBlocklist returns the built-in list of the most common passwords
+func DefaultBlocklist() *Blocklist {
+	b := &Blocklist{hashes: make(map[string]struct{})}
+	_ = b.Load(bytes.NewReader(commonPasswords))
+	return b
+}
+
+// Load adds one password per line, a line may also be the hex SHA-1 of a breached password
+// as in the Pwned Passwords downloads, optionally followed by ":count".
+func (b *Blocklist) Load(r io.Reader) error {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if hash, _, _ := strings.Cut(line, ":"); isSHA1(hash) {
+			b.hashes[strings.ToUpper(hash)] = struct{}{}
+			continue
+		}
+		b.hashes[digest(line)] = struct{}{}
+	}
+	return scanner.Err()
+}
+
+// Contains reports whether the password, or its lower case, is in the list
+func (b *Blocklist) Contains(password string) bool {
+	if b == nil {
+		return false
+	}
+	if _, ok := b.hashes[digest(password)]; ok {
+		return true
+	}
+	_, ok := b.hashes[digest(strings.ToLower(password))]
+	return ok
+}
+
+func digest(s string) string {
+	sum := sha1.Sum([]byte(s))
+	return strings.ToUpper(hex.EncodeToString(sum[:]))
+}
+
+func isSHA1(s string) bool {
+	if len(s) != sha1.Size*2 {
+		return false
+	}
+	_, err := hex.DecodeString(s)
+	return err == nil
+}
@@ -0,0 +1,90 @@
+/*
+ * Copyright 2023 FormulaGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ */
+
+package password
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestPolicyValidate(t *testing.T) {
+	p := Policy{
+		MinLength:     10,
+		RequireUpper:  true,
+		RequireLower:  true,
+		RequireDigit:  true,
+		RequireSymbol: true,
+		Blocklist:     DefaultBlocklist(),
+	}
+	tests := []struct {
+		name     string
+		password string
+		username string
+		// wantErr parts of the error message, empty for a valid password
+		wantErr []string
+	}{
+		{name: "valid", password: "Correct-Horse-7", username: "alice"},
+		{name: "valid unicode symbol", password: "Pässwort 2023€", username: "alice"},
+		{name: "too short", password: "Ab1-", wantErr: []string{"at least 10 characters"}},
+		{name: "too long", password: "Aa1-" + strings.Repeat("x", MaxLength), wantErr: []string{"at most 72 bytes"}},
+		{name: "missing classes", password: "abcdefghijkl", wantErr: []string{"upper case letter", "a digit", "a symbol"}},
+		{name: "contains username", password: "Alice-2023-pwd", username: "alice", wantErr: []string{"not contain the username"}},
+		{name: "common password", password: "P@ssw0rd", wantErr: []string{"at least 10 characters", "common or breached"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := p.Validate(tt.password, tt.username)
+			if len(tt.wantErr) == 0 {
+				if err != nil {
+					t.Errorf("Validate() error = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatalf("Validate() error = nil, want %v", tt.wantErr)
+			}
+			for _, want := range tt.wantErr {
+				if !strings.Contains(err.Error(), want) {
+					t.Errorf("Validate() error = %v, want it to contain %q", err, want)
+				}
+			}
+		})
+	}
+}
+
+func TestBlocklistLoad(t *testing.T) {
+	b := DefaultBlocklist()
+	// sha1("correct horse battery staple") as in a Pwned Passwords download, and a plain password
+	list := "# breached\nABF7AAD6438836DBE526AA231ABDE2D0EEF74D42:42\nHunter2\n\n"
+	if err := b.Load(strings.NewReader(list)); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name     string
+		password string
+		want     bool
+	}{
+		{name: "built-in", password: "password1", want: true},
+		{name: "built-in upper case", password: "PASSWORD1", want: true},
+		{name: "sha1 line", password: "correct horse battery staple", want: true},
+		{name: "plain line", password: "Hunter2", want: true},
+		{name: "comment is not a password", password: "# breached", want: false},
+		{name: "not listed", password: "t7#Vq!m2Lz", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := b.Contains(tt.password); got != tt.want {
+				t.Errorf("Contains() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	var nilList *Blocklist
+	if nilList.Contains("password") {
+		t.Errorf("nil Blocklist Contains() = true, want false")
+	}
+}
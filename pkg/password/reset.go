@@ -0,0 +1,67 @@
+/*
+ * Copyright 2023 FormulaGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ */
+
+package password
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"strings"
+	"time"
+)
+
+// ErrInvalidResetToken is returned for a forged, expired or already used reset token
+var ErrInvalidResetToken = errors.New("invalid or expired password reset token")
+
+// ResetToken signs a password reset token of the user which expires at expire. The signature covers the current
+// password hash, so the token is single-use: it is invalid as soon as the password has been changed.
+func ResetToken(secret []byte, userID uint64, passwordHash string, expire time.Time) string {
+	payload := make([]byte, 16)
+	binary.BigEndian.PutUint64(payload[:8], userID)
+	binary.BigEndian.PutUint64(payload[8:], uint64(expire.Unix()))
+	return base64.RawURLEncoding.EncodeToString(payload) + "." +
+		base64.RawURLEncoding.EncodeToString(resetSignature(secret, payload, passwordHash))
+}
+
+// VerifyResetToken returns the user ID of a valid token, passwordHash loads the current password hash of the user
+func VerifyResetToken(secret []byte, token string, passwordHash func(userID uint64) (string, error)) (uint64, error) {
+	encodedPayload, encodedSignature, ok := strings.Cut(token, ".")
+	if !ok {
+		return 0, ErrInvalidResetToken
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(encodedPayload)
+	if err != nil || len(payload) != 16 {
+		return 0, ErrInvalidResetToken
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(encodedSignature)
+	if err != nil {
+		return 0, ErrInvalidResetToken
+	}
+	userID := binary.BigEndian.Uint64(payload[:8])
+	expire := time.Unix(int64(binary.BigEndian.Uint64(payload[8:])), 0)
+	if time.Now().After(expire) {
+		return 0, ErrInvalidResetToken
+	}
+	hash, err := passwordHash(userID)
+	if err != nil {
+		return 0, ErrInvalidResetToken
+	}
+	if !hmac.Equal(signature, resetSignature(secret, payload, hash)) {
+		return 0, ErrInvalidResetToken
+	}
+	return userID, nil
+}
+
+func resetSignature(secret, payload []byte, passwordHash string) []byte {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte("password-reset\x00"))
+	mac.Write(payload)
+	mac.Write([]byte(passwordHash))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,57 @@
+/*
+ * Copyright 2023 FormulaGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ */
+
+package password
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestResetToken(t *testing.T) {
+	secret := []byte("test-secret")
+	hashes := map[uint64]string{7: "$2a$10$current", 8: "$2a$10$other"}
+	passwordHash := func(userID uint64) (string, error) {
+		hash, ok := hashes[userID]
+		if !ok {
+			return "", errors.New("user not found")
+		}
+		return hash, nil
+	}
+	valid := ResetToken(secret, 7, hashes[7], time.Now().Add(time.Hour))
+
+	tests := []struct {
+		name   string
+		token  string
+		secret []byte
+		want   uint64
+		err    bool
+	}{
+		{name: "valid", token: valid, secret: secret, want: 7},
+		{name: "expired", token: ResetToken(secret, 7, hashes[7], time.Now().Add(-time.Second)), secret: secret, err: true},
+		{name: "password already changed", token: ResetToken(secret, 7, "$2a$10$previous", time.Now().Add(time.Hour)), secret: secret, err: true},
+		{name: "other secret", token: valid, secret: []byte("other-secret"), err: true},
+		{name: "other user", token: ResetToken(secret, 8, hashes[7], time.Now().Add(time.Hour)), secret: secret, err: true},
+		{name: "unknown user", token: ResetToken(secret, 9, "", time.Now().Add(time.Hour)), secret: secret, err: true},
+		{name: "tampered payload", token: ResetToken(secret, 8, hashes[8], time.Now().Add(time.Hour))[:22] + valid[22:], secret: secret, err: true},
+		{name: "malformed", token: "not-a-token", secret: secret, err: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := VerifyResetToken(tt.secret, tt.token, passwordHash)
+			if (err != nil) != tt.err {
+				t.Fatalf("VerifyResetToken() error = %v, want error %v", err, tt.err)
+			}
+			if err != nil && !errors.Is(err, ErrInvalidResetToken) {
+				t.Errorf("VerifyResetToken() error = %v, want ErrInvalidResetToken", err)
+			}
+			if got != tt.want {
+				t.Errorf("VerifyResetToken() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
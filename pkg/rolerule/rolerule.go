@@ -0,0 +1,96 @@
+/*
+ * Copyright 2023 FormulaGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ */
+
+// package rolerule decides the role of the users provisioned from external accounts
+
+package rolerule
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// the types of the rules
+const (
+	// TypeEmailDomain matches the domain of the email, e.g. example.com, only if the provider verified the email
+	TypeEmailDomain = "email_domain"
+	// TypeWecomDepartment matches a WeCom department ID of the user
+	TypeWecomDepartment = "wecom_department"
+	// TypeGroup matches a group of the user at the provider
+	TypeGroup = "group"
+)
+
+// Rule gives the role to the users matching it
+type Rule struct {
+	Type   string `json:"type"`
+	Value  string `json:"value"`
+	RoleID uint64 `json:"roleID"`
+}
+
+// Profile the attributes of the user the rules match
+type Profile struct {
+	Email string
+	// EmailVerified the provider verified the email, an email anyone can set must not grant a role
+	EmailVerified bool
+	Departments   []string
+	Groups        []string
+}
+
+// Validate checks the type, value and role of the rules
+func Validate(rules []Rule) error {
+	for i, r := range rules {
+		switch r.Type {
+		case TypeEmailDomain, TypeWecomDepartment, TypeGroup:
+		default:
+			return fmt.Errorf("role rule %d: unknown type %q", i, r.Type)
+		}
+		if strings.TrimSpace(r.Value) == "" {
+			return fmt.Errorf("role rule %d: value is required", i)
+		}
+		if r.RoleID == 0 {
+			return fmt.Errorf("role rule %d: role is required", i)
+		}
+	}
+	return nil
+}
+
+// ErrNoMatch no rule matches the profile
+var ErrNoMatch = errors.New("no role rule matches the user")
+
+// Match returns the role of the first rule the profile matches
+func Match(rules []Rule, p Profile) (uint64, error) {
+	for _, r := range rules {
+		if r.matches(p) {
+			return r.RoleID, nil
+		}
+	}
+	return 0, ErrNoMatch
+}
+
+func (r Rule) matches(p Profile) bool {
+	value := strings.TrimSpace(r.Value)
+	switch r.Type {
+	case TypeEmailDomain:
+		_, domain, found := strings.Cut(p.Email, "@")
+		return found && p.EmailVerified && strings.EqualFold(domain, strings.TrimPrefix(value, "@"))
+	case TypeWecomDepartment:
+		return contains(p.Departments, value, false)
+	case TypeGroup:
+		return contains(p.Groups, value, true)
+	}
+	return false
+}
+
+// contains group names are compared case-insensitively, LDAP and most providers do not tell them apart by case
+func contains(list []string, value string, fold bool) bool {
+	for _, v := range list {
+		if v == value || (fold && strings.EqualFold(v, value)) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,67 @@
+/*
+ * Copyright 2023 FormulaGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ */
+
+package rolerule
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestMatch(t *testing.T) {
+	rules := []Rule{
+		{Type: TypeGroup, Value: "admins", RoleID: 1},
+		{Type: TypeWecomDepartment, Value: "12", RoleID: 3},
+		{Type: TypeEmailDomain, Value: "example.com", RoleID: 2},
+	}
+	tests := []struct {
+		name    string
+		profile Profile
+		want    uint64
+		wantErr error
+	}{
+		{name: "group", profile: Profile{Email: "a@example.com", EmailVerified: true, Groups: []string{"dev", "Admins"}}, want: 1},
+		{name: "department", profile: Profile{Departments: []string{"1", "12"}}, want: 3},
+		{name: "department is exact", profile: Profile{Departments: []string{"123"}}, wantErr: ErrNoMatch},
+		{name: "email domain", profile: Profile{Email: "a@EXAMPLE.com", EmailVerified: true}, want: 2},
+		{name: "email not verified", profile: Profile{Email: "a@example.com"}, wantErr: ErrNoMatch},
+		{name: "subdomain", profile: Profile{Email: "a@mail.example.com", EmailVerified: true}, wantErr: ErrNoMatch},
+		{name: "suffix", profile: Profile{Email: "a@badexample.com", EmailVerified: true}, wantErr: ErrNoMatch},
+		{name: "no email", profile: Profile{}, wantErr: ErrNoMatch},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Match(rules, tt.profile)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Match() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Match() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		rules   []Rule
+		wantErr bool
+	}{
+		{name: "empty", rules: nil},
+		{name: "valid", rules: []Rule{{Type: TypeEmailDomain, Value: "@example.com", RoleID: 2}}},
+		{name: "unknown type", rules: []Rule{{Type: "title", Value: "x", RoleID: 2}}, wantErr: true},
+		{name: "no value", rules: []Rule{{Type: TypeGroup, Value: " ", RoleID: 2}}, wantErr: true},
+		{name: "no role", rules: []Rule{{Type: TypeGroup, Value: "dev"}}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := Validate(tt.rules); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,265 @@
+/*
+ * Copyright 2023 FormulaGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ */
+
+// package samlsp is the SAML 2.0 service provider of the logins through the identity providers (IdP)
+
+package samlsp
+
+import (
+	"bytes"
+	"context"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/crewjam/saml"
+	xrv "github.com/mattermost/xml-roundtrip-validator"
+	dsig "github.com/russellhaering/goxmldsig"
+)
+
+// metadataLimit the largest IdP metadata read
+const metadataLimit = 1 << 20
+
+// Config is the service provider registered at one IdP
+type Config struct {
+	// EntityID the ID of the service provider known by the IdP, the metadata URL if empty
+	EntityID    string
+	MetadataURL string
+	// AcsURL the assertion consumer service the IdP posts the responses to
+	AcsURL string
+	// Key and Certificate sign the requests and decrypt the assertions, optional
+	Key         *rsa.PrivateKey
+	Certificate *x509.Certificate
+	// IDPMetadata the metadata XML of the IdP, its certificates verify the signatures
+	IDPMetadata []byte
+	// AllowIDPInitiated accepts the responses not answering a request of the service provider
+	AllowIDPInitiated bool
+}
+
+// ServiceProvider builds the authentication requests and verifies the responses of one IdP
+type ServiceProvider struct {
+	sp saml.ServiceProvider
+}
+
+// Assertion is the verified identity of the user
+type Assertion struct {
+	// ID the assertion ID, accepted once
+	ID     string
+	NameID string
+	// Expire the time the assertion is no longer accepted
+	Expire time.Time
+	// Attributes the values keyed by both the name and the friendly name of the attributes
+	Attributes map[string][]string
+}
+
+func New(config Config) (*ServiceProvider, error) {
+	metadataURL, err := url.Parse(config.MetadataURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid metadata url: %w", err)
+	}
+	acsURL, err := url.Parse(config.AcsURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid acs url: %w", err)
+	}
+	idpMetadata, err := ParseIDPMetadata(config.IDPMetadata)
+	if err != nil {
+		return nil, err
+	}
+	if (config.Key == nil) != (config.Certificate == nil) {
+		return nil, errors.New("the key and the certificate of the service provider are set together")
+	}
+
+	s := &ServiceProvider{sp: saml.ServiceProvider{
+		EntityID:          config.EntityID,
+		Key:               config.Key,
+		Certificate:       config.Certificate,
+		MetadataURL:       *metadataURL,
+		AcsURL:            *acsURL,
+		IDPMetadata:       idpMetadata,
+		AuthnNameIDFormat: saml.UnspecifiedNameIDFormat,
+		AllowIDPInitiated: config.AllowIDPInitiated,
+	}}
+	if s.sp.GetSSOBindingLocation(saml.HTTPRedirectBinding) == "" {
+		return nil, errors.New("the IdP metadata has no HTTP-Redirect single sign-on service")
+	}
+	// the requests are signed if the IdP wants it, which needs the key of the service provider
+	for _, d := range idpMetadata.IDPSSODescriptors {
+		if d.WantAuthnRequestsSigned != nil && *d.WantAuthnRequestsSigned {
+			if config.Key == nil {
+				return nil, errors.New("the IdP wants signed requests, the key of the service provider is required")
+			}
+			s.sp.SignatureMethod = dsig.RSASHA256SignatureMethod
+		}
+	}
+	return s, nil
+}
+
+// Metadata returns the metadata XML of the service provider to register at the IdP
+func (s *ServiceProvider) Metadata() ([]byte, error) {
+	b, err := xml.MarshalIndent(s.sp.Metadata(), "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal service provider metadata failed: %w", err)
+	}
+	return b, nil
+}
+
+// AuthnRequestURL returns the URL redirecting the user to the IdP, and the request ID the response has to answer,
+// the relay state must be URL safe
+func (s *ServiceProvider) AuthnRequestURL(relayState string) (redirectURL, requestID string, err error) {
+	req, err := s.sp.MakeAuthenticationRequest(s.sp.GetSSOBindingLocation(saml.HTTPRedirectBinding), saml.HTTPRedirectBinding, saml.HTTPPostBinding)
+	if err != nil {
+		return "", "", fmt.Errorf("make authentication request failed: %w", err)
+	}
+	u, err := req.Redirect(relayState, &s.sp)
+	if err != nil {
+		return "", "", fmt.Errorf("sign authentication request failed: %w", err)
+	}
+	return u.String(), req.ID, nil
+}
+
+// ParseResponse verifies the base64 encoded SAMLResponse posted to the ACS,
+// the response or the assertion is signed by the IdP, the assertion answers one of the request IDs
+// unless IdP initiated logins are allowed, and its audience, recipient and validity are checked
+func (s *ServiceProvider) ParseResponse(samlResponse string, requestIDs []string) (*Assertion, error) {
+	raw, err := base64.StdEncoding.DecodeString(strings.TrimSpace(samlResponse))
+	if err != nil {
+		return nil, fmt.Errorf("decode saml response failed: %w", err)
+	}
+	assertion, err := s.sp.ParseXMLResponse(raw, requestIDs)
+	if err != nil {
+		// the reason is kept out of Error() by the library
+		var invalid *saml.InvalidResponseError
+		if errors.As(err, &invalid) && invalid.PrivateErr != nil {
+			return nil, fmt.Errorf("invalid saml response: %w", invalid.PrivateErr)
+		}
+		return nil, fmt.Errorf("invalid saml response: %w", err)
+	}
+
+	a := &Assertion{
+		ID:         assertion.ID,
+		Expire:     assertion.Conditions.NotOnOrAfter,
+		Attributes: make(map[string][]string),
+	}
+	if assertion.Subject != nil && assertion.Subject.NameID != nil {
+		a.NameID = assertion.Subject.NameID.Value
+	}
+	for _, statement := range assertion.AttributeStatements {
+		for _, attr := range statement.Attributes {
+			var values []string
+			for _, v := range attr.Values {
+				if v.Value != "" {
+					values = append(values, v.Value)
+				}
+			}
+			for _, name := range []string{attr.Name, attr.FriendlyName} {
+				if name != "" {
+					a.Attributes[name] = append(a.Attributes[name], values...)
+				}
+			}
+		}
+	}
+	return a, nil
+}
+
+// Value returns the first value of the attribute
+func (a *Assertion) Value(name string) string {
+	if values := a.Attributes[name]; len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+// ParseIDPMetadata parses the metadata of the IdP, an EntitiesDescriptor is searched for the IdP
+func ParseIDPMetadata(data []byte) (*saml.EntityDescriptor, error) {
+	if len(bytes.TrimSpace(data)) == 0 {
+		return nil, errors.New("the IdP metadata is empty")
+	}
+	if err := xrv.Validate(bytes.NewReader(data)); err != nil {
+		return nil, fmt.Errorf("invalid IdP metadata: %w", err)
+	}
+
+	var root struct {
+		XMLName xml.Name
+	}
+	if err := xml.Unmarshal(data, &root); err != nil {
+		return nil, fmt.Errorf("invalid IdP metadata: %w", err)
+	}
+	var entity *saml.EntityDescriptor
+	switch root.XMLName.Local {
+	case "EntityDescriptor":
+		entity = new(saml.EntityDescriptor)
+		if err := xml.Unmarshal(data, entity); err != nil {
+			return nil, fmt.Errorf("invalid IdP metadata: %w", err)
+		}
+	case "EntitiesDescriptor":
+		entities := new(saml.EntitiesDescriptor)
+		if err := xml.Unmarshal(data, entities); err != nil {
+			return nil, fmt.Errorf("invalid IdP metadata: %w", err)
+		}
+		for i := range entities.EntityDescriptors {
+			if len(entities.EntityDescriptors[i].IDPSSODescriptors) > 0 {
+				entity = &entities.EntityDescriptors[i]
+				break
+			}
+		}
+	default:
+		return nil, fmt.Errorf("invalid IdP metadata: unexpected element %s", root.XMLName.Local)
+	}
+	if entity == nil || len(entity.IDPSSODescriptors) == 0 {
+		return nil, errors.New("invalid IdP metadata: no IDPSSODescriptor")
+	}
+	if entity.EntityID == "" {
+		return nil, errors.New("invalid IdP metadata: no entityID")
+	}
+	return entity, nil
+}
+
+// FetchIDPMetadata downloads the metadata of the IdP, the request is bound to the context
+func FetchIDPMetadata(ctx context.Context, client *http.Client, metadataURL string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, metadataURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("invalid IdP metadata url: %w", err)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("fetch IdP metadata failed: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetch IdP metadata failed: status %d", resp.StatusCode)
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, metadataLimit))
+	if err != nil {
+		return nil, fmt.Errorf("read IdP metadata failed: %w", err)
+	}
+	return data, nil
+}
+
+// ParseKeyPair parses the PEM encoded certificate and RSA private key of the service provider
+func ParseKeyPair(certPEM, keyPEM []byte) (*rsa.PrivateKey, *x509.Certificate, error) {
+	pair, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		return nil, nil, fmt.Errorf("parse service provider key pair failed: %w", err)
+	}
+	key, ok := pair.PrivateKey.(*rsa.PrivateKey)
+	if !ok {
+		return nil, nil, errors.New("the key of the service provider must be an RSA key")
+	}
+	cert, err := x509.ParseCertificate(pair.Certificate[0])
+	if err != nil {
+		return nil, nil, fmt.Errorf("parse service provider certificate failed: %w", err)
+	}
+	return key, cert, nil
+}
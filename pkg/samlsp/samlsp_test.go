@@ -0,0 +1,246 @@
+/*
+ * Copyright 2023 FormulaGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ */
+
+package samlsp
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"encoding/xml"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/beevik/etree"
+	"github.com/crewjam/saml"
+)
+
+func newTestKeyPair(t *testing.T, cn string) (*rsa.PrivateKey, *x509.Certificate) {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: cn},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key, cert
+}
+
+// testIdP is an IdP answering the requests of the one service provider
+type testIdP struct {
+	idp *saml.IdentityProvider
+	sp  *saml.EntityDescriptor
+}
+
+func (p *testIdP) GetServiceProvider(_ *http.Request, id string) (*saml.EntityDescriptor, error) {
+	if p.sp == nil || id != p.sp.EntityID {
+		return nil, os.ErrNotExist
+	}
+	return p.sp, nil
+}
+
+func newTestIdP(t *testing.T, entityID string) *testIdP {
+	key, cert := newTestKeyPair(t, "idp")
+	p := &testIdP{}
+	p.idp = &saml.IdentityProvider{
+		Key:                     key,
+		Certificate:             cert,
+		MetadataURL:             url.URL{Scheme: "https", Host: entityID, Path: "/metadata"},
+		SSOURL:                  url.URL{Scheme: "https", Host: entityID, Path: "/sso"},
+		ServiceProviderProvider: p,
+	}
+	return p
+}
+
+func (p *testIdP) metadata(t *testing.T) []byte {
+	b, err := xml.Marshal(p.idp.Metadata())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+// respond signs the response of the IdP to the redirected authentication request
+func (p *testIdP) respond(t *testing.T, redirectURL string) string {
+	t.Helper()
+	req, err := saml.NewIdpAuthnRequest(p.idp, httptest.NewRequest(http.MethodGet, redirectURL, nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = req.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	err = saml.DefaultAssertionMaker{}.MakeAssertion(req, &saml.Session{
+		ID:        "session",
+		NameID:    "alice@example.com",
+		UserName:  "alice",
+		UserEmail: "alice@example.com",
+		Groups:    []string{"admins", "dev"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = req.MakeResponse(); err != nil {
+		t.Fatal(err)
+	}
+	doc := etree.NewDocument()
+	doc.SetRoot(req.ResponseEl)
+	b, err := doc.WriteToBytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return base64.StdEncoding.EncodeToString(b)
+}
+
+func TestServiceProviderLogin(t *testing.T) {
+	idp := newTestIdP(t, "idp.example.com")
+	otherIdP := newTestIdP(t, "idp.example.com")
+	spKey, spCert := newTestKeyPair(t, "sp")
+
+	tests := []struct {
+		name              string
+		key               bool
+		allowIDPInitiated bool
+		// signer the IdP signing the response, the registered one if nil
+		signer     *testIdP
+		requestIDs func(requestID string) []string
+		wantErr    bool
+	}{
+		{name: "signed response", requestIDs: func(id string) []string { return []string{id} }},
+		{name: "encrypted assertion", key: true, requestIDs: func(id string) []string { return []string{id} }},
+		{name: "unknown request", requestIDs: func(string) []string { return []string{"id-other"} }, wantErr: true},
+		{name: "idp initiated rejected", requestIDs: func(string) []string { return nil }, wantErr: true},
+		{name: "idp initiated allowed", allowIDPInitiated: true, requestIDs: func(string) []string { return nil }},
+		{name: "signed by another key", signer: otherIdP, requestIDs: func(id string) []string { return []string{id} }, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := Config{
+				MetadataURL:       "https://sp.example.com/api/saml/metadata?provider=corp",
+				AcsURL:            "https://sp.example.com/api/saml/acs?provider=corp",
+				IDPMetadata:       idp.metadata(t),
+				AllowIDPInitiated: tt.allowIDPInitiated,
+			}
+			if tt.key {
+				config.Key, config.Certificate = spKey, spCert
+			}
+			sp, err := New(config)
+			if err != nil {
+				t.Fatal(err)
+			}
+			metadata, err := sp.Metadata()
+			if err != nil {
+				t.Fatal(err)
+			}
+			signer := idp
+			if tt.signer != nil {
+				signer = tt.signer
+			}
+			signer.sp = new(saml.EntityDescriptor)
+			if err = xml.Unmarshal(metadata, signer.sp); err != nil {
+				t.Fatal(err)
+			}
+
+			redirectURL, requestID, err := sp.AuthnRequestURL("relay-state")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !strings.HasPrefix(redirectURL, "https://idp.example.com/sso?SAMLRequest=") || !strings.Contains(redirectURL, "RelayState=relay-state") {
+				t.Fatalf("unexpected redirect url %s", redirectURL)
+			}
+
+			assertion, err := sp.ParseResponse(signer.respond(t, redirectURL), tt.requestIDs(requestID))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseResponse() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if assertion.ID == "" || assertion.Expire.Before(time.Now()) {
+				t.Errorf("unexpected assertion id %q or expire %v", assertion.ID, assertion.Expire)
+			}
+			if got := assertion.NameID; got != "alice@example.com" {
+				t.Errorf("NameID = %q", got)
+			}
+			// the attributes are found by their name and their friendly name
+			if got := assertion.Value("uid"); got != "alice" {
+				t.Errorf("uid = %q", got)
+			}
+			if got := assertion.Value("urn:oid:0.9.2342.19200300.100.1.1"); got != "alice" {
+				t.Errorf("urn:oid:0.9.2342.19200300.100.1.1 = %q", got)
+			}
+			if got := assertion.Attributes["eduPersonAffiliation"]; len(got) != 2 || got[0] != "admins" || got[1] != "dev" {
+				t.Errorf("eduPersonAffiliation = %v", got)
+			}
+		})
+	}
+}
+
+func TestParseIDPMetadata(t *testing.T) {
+	idp := newTestIdP(t, "idp.example.com")
+	entity := string(idp.metadata(t))
+	tests := []struct {
+		name     string
+		metadata string
+		wantErr  bool
+	}{
+		{name: "entity", metadata: entity},
+		{name: "entities", metadata: `<EntitiesDescriptor xmlns="urn:oasis:names:tc:SAML:2.0:metadata">` +
+			`<EntityDescriptor entityID="https://sp.example.com"><SPSSODescriptor protocolSupportEnumeration="urn:oasis:names:tc:SAML:2.0:protocol"></SPSSODescriptor></EntityDescriptor>` +
+			entity + `</EntitiesDescriptor>`},
+		{name: "empty", metadata: " ", wantErr: true},
+		{name: "not xml", metadata: "{}", wantErr: true},
+		{name: "service provider only", metadata: `<EntityDescriptor xmlns="urn:oasis:names:tc:SAML:2.0:metadata" entityID="https://sp.example.com">` +
+			`<SPSSODescriptor protocolSupportEnumeration="urn:oasis:names:tc:SAML:2.0:protocol"></SPSSODescriptor></EntityDescriptor>`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseIDPMetadata([]byte(tt.metadata))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseIDPMetadata() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && got.EntityID != "https://idp.example.com/metadata" {
+				t.Errorf("EntityID = %q", got.EntityID)
+			}
+		})
+	}
+}
+
+func TestParseKeyPair(t *testing.T) {
+	key, cert := newTestKeyPair(t, "sp")
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+	otherKey, _ := newTestKeyPair(t, "other")
+	otherPEM := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(otherKey)})
+
+	if _, _, err := ParseKeyPair(certPEM, keyPEM); err != nil {
+		t.Fatalf("ParseKeyPair() error = %v", err)
+	}
+	if _, _, err := ParseKeyPair(certPEM, otherPEM); err == nil {
+		t.Fatal("ParseKeyPair() accepted a key not matching the certificate")
+	}
+}
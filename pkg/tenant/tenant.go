@@ -0,0 +1,36 @@
+/*
+ * Copyright 2023 FormulaGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ */
+
+// package tenant carries the tenant of the caller in the context, the tenant-scoped entities
+// only see and touch the rows of that tenant.
+
+package tenant
+
+import (
+	"context"
+	"strconv"
+)
+
+// DefaultID the tenant created with the database, it owns the existing data and manages the other tenants
+const DefaultID uint64 = 1
+
+type contextKey struct{}
+
+// NewContext returns a context scoped to the tenant
+func NewContext(ctx context.Context, id uint64) context.Context {
+	return context.WithValue(ctx, contextKey{}, id)
+}
+
+// FromContext returns the tenant of the context, ok is false if the context is not scoped
+func FromContext(ctx context.Context) (id uint64, ok bool) {
+	id, ok = ctx.Value(contextKey{}).(uint64)
+	return id, ok && id != 0
+}
+
+// Domain the casbin domain of the tenant
+func Domain(id uint64) string {
+	return strconv.FormatUint(id, 10)
+}
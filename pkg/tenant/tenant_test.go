@@ -0,0 +1,40 @@
+/*
+ * Copyright 2023 FormulaGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ */
+
+package tenant
+
+import (
+	"context"
+	"testing"
+)
+
+func TestFromContext(t *testing.T) {
+	tests := []struct {
+		name   string
+		ctx    context.Context
+		wantID uint64
+		wantOK bool
+	}{
+		{name: "not scoped", ctx: context.Background()},
+		{name: "scoped", ctx: NewContext(context.Background(), 2), wantID: 2, wantOK: true},
+		{name: "zero is not a tenant", ctx: NewContext(context.Background(), 0)},
+		{name: "nested", ctx: NewContext(NewContext(context.Background(), 2), 3), wantID: 3, wantOK: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id, ok := FromContext(tt.ctx)
+			if id != tt.wantID || ok != tt.wantOK {
+				t.Errorf("FromContext() = %d, %v, want %d, %v", id, ok, tt.wantID, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestDomain(t *testing.T) {
+	if got := Domain(DefaultID); got != "1" {
+		t.Errorf("Domain() = %q", got)
+	}
+}
@@ -0,0 +1,80 @@
+/*
+ * Copyright 2023 FormulaGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ */
+
+package totp
+
+import (
+	"encoding/base32"
+	"strings"
+	"testing"
+	"time"
+)
+
+// RFC 6238 appendix B, SHA1 test vectors
+func TestGenerateCode(t *testing.T) {
+	secret := base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString([]byte("12345678901234567890"))
+	tests := []struct {
+		name string
+		unix int64
+		want string
+	}{
+		{name: "59", unix: 59, want: "94287082"},
+		{name: "1111111109", unix: 1111111109, want: "07081804"},
+		{name: "1111111111", unix: 1111111111, want: "14050471"},
+		{name: "1234567890", unix: 1234567890, want: "89005924"},
+		{name: "2000000000", unix: 2000000000, want: "69279037"},
+		{name: "20000000000", unix: 20000000000, want: "65353130"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := GenerateCode(secret, Step(time.Unix(tt.unix, 0)), 8)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("GenerateCode() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	secret, err := GenerateSecret()
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now()
+	current, _ := GenerateCode(secret, Step(now), Digits)
+	previous, _ := GenerateCode(secret, Step(now)-1, Digits)
+	expired, _ := GenerateCode(secret, Step(now)-3, Digits)
+	tests := []struct {
+		name string
+		code string
+		want bool
+	}{
+		{name: "current", code: current, want: true},
+		{name: "previous step within skew", code: previous, want: true},
+		{name: "expired", code: expired, want: current == expired},
+		{name: "wrong length", code: "123", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, got := Validate(tt.code, secret, now); got != tt.want {
+				t.Errorf("Validate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestProvisioningURI(t *testing.T) {
+	uri := ProvisioningURI("FormulaGo", "admin", "JBSWY3DPEHPK3PXP")
+	if !strings.HasPrefix(uri, "otpauth://totp/FormulaGo:admin?") {
+		t.Errorf("ProvisioningURI() = %v", uri)
+	}
+	if !strings.Contains(uri, "secret=JBSWY3DPEHPK3PXP") || !strings.Contains(uri, "issuer=FormulaGo") {
+		t.Errorf("ProvisioningURI() = %v", uri)
+	}
+}
@@ -0,0 +1,62 @@
+/*
+ * Copyright 2023 FormulaGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ */
+
+// package useragent provides helpers to describe the client device from a User-Agent header.
+
+package useragent
+
+import "strings"
+
+// ordered from the most specific to the most generic token
+var (
+	browsers = []struct{ token, name string }{
+		{"Edg/", "Edge"},
+		{"OPR/", "Opera"},
+		{"MicroMessenger", "WeChat"},
+		{"wxwork", "WeCom"},
+		{"Chrome/", "Chrome"},
+		{"Firefox/", "Firefox"},
+		{"Safari/", "Safari"},
+		{"curl/", "curl"},
+		{"PostmanRuntime", "Postman"},
+	}
+	systems = []struct{ token, name string }{
+		{"Windows", "Windows"},
+		{"iPhone", "iPhone"},
+		{"iPad", "iPad"},
+		{"Android", "Android"},
+		{"Mac OS X", "macOS"},
+		{"Linux", "Linux"},
+	}
+)
+
+// Device returns a short device label such as "Chrome on Windows" for the given User-Agent.
+func Device(ua string) string {
+	var browser, system string
+	for _, b := range browsers {
+		if strings.Contains(ua, b.token) {
+			browser = b.name
+			break
+		}
+	}
+	for _, s := range systems {
+		if strings.Contains(ua, s.token) {
+			system = s.name
+			break
+		}
+	}
+
+	switch {
+	case browser != "" && system != "":
+		return browser + " on " + system
+	case browser != "":
+		return browser
+	case system != "":
+		return system
+	default:
+		return "Unknown"
+	}
+}
@@ -20,9 +20,12 @@ type OAuthUser struct {
 
 // User 企业微信成员信息
 type User struct {
-	UserID string
-	Mobile string
-	Email  string
+	UserID     string
+	Name       string
+	Mobile     string
+	Email      string
+	Avatar     string
+	Department []int
 }
 
 // GetUserIDByPhone get user id from wecom by phone
@@ -55,9 +58,12 @@ func (w *Wecom) GetUserByID(ctx context.Context, userID string) (*User, error) {
 		return nil, fmt.Errorf("get wecom user failed: %w", res)
 	}
 	return &User{
-		UserID: res.UserID,
-		Mobile: res.Mobile,
-		Email:  res.Email,
+		UserID:     res.UserID,
+		Name:       res.Name,
+		Mobile:     res.Mobile,
+		Email:      res.Email,
+		Avatar:     res.Avatar,
+		Department: res.Department,
 	}, nil
 }
 
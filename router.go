@@ -16,8 +16,21 @@ import (
 // customizeRegister registers customize routers.
 func customizedRegister(r *server.Hertz) {
 	// your code ...
-	// login and refresh_token power by jwt Auth middleware
-	r.POST("/api/login", middleware.GetJWTMiddleware(configs.Data(), data.Default(), data.CasbinEnforcer()).LoginHandler)
+	// login power by jwt Auth middleware
+	r.POST("/api/login", middleware.GetLoginHandler(configs.Data(), data.Default(), data.CasbinEnforcer()))
+	r.POST("/api/login/2fa", middleware.GetTwoFactorLoginHandler(configs.Data(), data.Default(), data.CasbinEnforcer()))
+	// passkey login, begin returns the options of navigator.credentials.get, finish verifies the assertion
+	r.POST("/api/login/webauthn/begin", middleware.GetWebauthnBeginLoginHandler(configs.Data(), data.Default(), data.CasbinEnforcer()))
+	r.POST("/api/login/webauthn/finish", middleware.GetWebauthnLoginHandler(configs.Data(), data.Default(), data.CasbinEnforcer()))
 	r.POST("/api/logout", middleware.GetJWTMiddleware(configs.Data(), data.Default(), data.CasbinEnforcer()).LogoutHandler)
-	r.POST("/api/refresh_token", middleware.GetJWTMiddleware(configs.Data(), data.Default(), data.CasbinEnforcer()).RefreshHandler)
+	// refresh_token rotates the opaque refresh token issued at login
+	r.POST("/api/refresh_token", middleware.GetRefreshTokenHandler(configs.Data(), data.Default(), data.CasbinEnforcer()))
+	// public signing keys for services verifying the tokens offline
+	r.GET("/.well-known/jwks.json", middleware.GetJWKSHandler(configs.Data()))
+	// OpenID Connect provider, the consent is answered by the signed-in user through /api/admin/oidc/consent
+	r.GET("/.well-known/openid-configuration", middleware.GetOIDCDiscoveryHandler(configs.Data()))
+	r.GET("/oauth2/authorize", middleware.GetOIDCAuthorizeHandler(configs.Data(), data.Default()))
+	r.POST("/oauth2/token", middleware.GetOIDCTokenHandler(configs.Data(), data.Default()))
+	r.GET("/oauth2/userinfo", middleware.GetOIDCUserinfoHandler(configs.Data(), data.Default()))
+	r.POST("/oauth2/userinfo", middleware.GetOIDCUserinfoHandler(configs.Data(), data.Default()))
 }